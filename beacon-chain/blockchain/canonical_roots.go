@@ -0,0 +1,174 @@
+package blockchain
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// ErrSlotNotIndexed is returned for slots outside of the window of the canonical root index,
+// whose roots must be looked up in the database instead.
+var ErrSlotNotIndexed = errors.New("slot is not in the canonical root index")
+
+// CanonicalRootFetcher defines a common interface for methods in blockchain service which look
+// up the canonical block roots of recent slots in memory rather than scanning the database.
+type CanonicalRootFetcher interface {
+	CanonicalRootAtSlot(slot types.Slot) ([32]byte, bool, error)
+	HighestCanonicalRootAtOrBelow(slot types.Slot) ([32]byte, types.Slot, error)
+}
+
+// canonicalRootEntry is the canonical block of a slot. Skipped slots have no block.
+type canonicalRootEntry struct {
+	slot     types.Slot
+	root     [32]byte
+	hasBlock bool
+	set      bool
+}
+
+// canonicalRootIndex maps the slots of the chain of the head to their block roots, over the last
+// SlotsPerHistoricalRoot slots, which are those whose roots the head state records. Entries are
+// kept in a ring indexed by slot, so the index never grows.
+type canonicalRootIndex struct {
+	lock     sync.RWMutex
+	entries  []canonicalRootEntry
+	headSlot types.Slot
+	hasHead  bool
+}
+
+func newCanonicalRootIndex(slotsPerHistoricalRoot types.Slot) *canonicalRootIndex {
+	return &canonicalRootIndex{
+		entries: make([]canonicalRootEntry, slotsPerHistoricalRoot),
+	}
+}
+
+// update moves the index to a new head, whose state must be at the slot of the head block. Slots
+// are rewritten from the head down to the first slot whose block is already indexed, as a block
+// root commits to all of its ancestors. The first head and heads far from the previous one
+// rewrite the whole window.
+func (c *canonicalRootIndex) update(headRoot [32]byte, headSlot types.Slot, st iface.ReadOnlyBeaconState) error {
+	size := types.Slot(len(c.entries))
+	lowest := types.Slot(0)
+	if headSlot >= size {
+		lowest = headSlot - size + 1
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.entries[headSlot%size] = canonicalRootEntry{slot: headSlot, root: headRoot, hasBlock: true, set: true}
+	c.headSlot, c.hasHead = headSlot, true
+	if headSlot == 0 {
+		return nil
+	}
+	if st.Slot() < headSlot {
+		return errors.Errorf("state slot %d is before the head slot %d", st.Slot(), headSlot)
+	}
+	rootAt := func(slot types.Slot) ([32]byte, error) {
+		r, err := st.BlockRootAtIndex(uint64(slot % size))
+		if err != nil {
+			return [32]byte{}, err
+		}
+		return bytesutil.ToBytes32(r), nil
+	}
+	root, err := rootAt(headSlot - 1)
+	if err != nil {
+		return err
+	}
+	for slot := headSlot - 1; ; slot-- {
+		// The block roots of skipped slots repeat the root of the block before them.
+		hasBlock := slot == 0
+		var prev [32]byte
+		if slot > 0 {
+			if prev, err = rootAt(slot - 1); err != nil {
+				return err
+			}
+			hasBlock = root != prev
+		}
+		if hasBlock {
+			entry := c.entries[slot%size]
+			if entry.set && entry.slot == slot && entry.hasBlock && entry.root == root {
+				break
+			}
+			c.entries[slot%size] = canonicalRootEntry{slot: slot, root: root, hasBlock: true, set: true}
+		} else {
+			c.entries[slot%size] = canonicalRootEntry{slot: slot, set: true}
+		}
+		if slot == lowest {
+			break
+		}
+		root = prev
+	}
+	return nil
+}
+
+// rootAt returns the canonical block root of a slot, and false if the slot was skipped.
+func (c *canonicalRootIndex) rootAt(slot types.Slot) ([32]byte, bool, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	entry, err := c.entry(slot)
+	if err != nil {
+		return [32]byte{}, false, err
+	}
+	return entry.root, entry.hasBlock, nil
+}
+
+// highestAtOrBelow returns the root and slot of the latest canonical block at or before a slot.
+func (c *canonicalRootIndex) highestAtOrBelow(slot types.Slot) ([32]byte, types.Slot, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if c.hasHead && slot > c.headSlot {
+		slot = c.headSlot
+	}
+	for {
+		entry, err := c.entry(slot)
+		if err != nil {
+			return [32]byte{}, 0, err
+		}
+		if entry.hasBlock {
+			return entry.root, slot, nil
+		}
+		slot--
+	}
+}
+
+// entry returns the entry of a slot. The caller must hold the lock.
+func (c *canonicalRootIndex) entry(slot types.Slot) (canonicalRootEntry, error) {
+	if !c.hasHead || slot > c.headSlot {
+		return canonicalRootEntry{}, ErrSlotNotIndexed
+	}
+	entry := c.entries[slot%types.Slot(len(c.entries))]
+	if !entry.set || entry.slot != slot {
+		return canonicalRootEntry{}, ErrSlotNotIndexed
+	}
+	return entry, nil
+}
+
+// updateCanonicalRoots moves the canonical root index to the new head. Services built without
+// NewService have no index.
+func (s *Service) updateCanonicalRoots(root [32]byte, slot types.Slot, st iface.ReadOnlyBeaconState) {
+	if s.canonicalRoots == nil {
+		return
+	}
+	if err := s.canonicalRoots.update(root, slot, st); err != nil {
+		log.WithError(err).WithField("slot", slot).Error("Could not update canonical root index")
+	}
+}
+
+// CanonicalRootAtSlot returns the root of the canonical block at a recent slot, and false if the
+// slot was skipped. ErrSlotNotIndexed is returned for slots after the head or too old to be indexed.
+func (s *Service) CanonicalRootAtSlot(slot types.Slot) ([32]byte, bool, error) {
+	if s.canonicalRoots == nil {
+		return [32]byte{}, false, ErrSlotNotIndexed
+	}
+	return s.canonicalRoots.rootAt(slot)
+}
+
+// HighestCanonicalRootAtOrBelow returns the root and slot of the latest canonical block at or
+// before a recent slot. ErrSlotNotIndexed is returned if no indexed slot has a block.
+func (s *Service) HighestCanonicalRootAtOrBelow(slot types.Slot) ([32]byte, types.Slot, error) {
+	if s.canonicalRoots == nil {
+		return [32]byte{}, 0, ErrSlotNotIndexed
+	}
+	return s.canonicalRoots.highestAtOrBelow(slot)
+}
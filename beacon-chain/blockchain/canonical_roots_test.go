@@ -0,0 +1,106 @@
+package blockchain
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// canonicalRootsState returns a state at the slot after the last root, whose block roots are the
+// given roots from slot 0.
+func canonicalRootsState(t *testing.T, roots ...[32]byte) *stateV0.BeaconState {
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	for i, r := range roots {
+		require.NoError(t, st.UpdateBlockRootAtIndex(uint64(i), r))
+	}
+	require.NoError(t, st.SetSlot(types.Slot(len(roots))))
+	return st
+}
+
+func TestCanonicalRootIndex_UpdateAndReorg(t *testing.T) {
+	r0, r1, r3, r4 := bytesutil.ToBytes32([]byte{'a'}), bytesutil.ToBytes32([]byte{'b'}), bytesutil.ToBytes32([]byte{'c'}), bytesutil.ToBytes32([]byte{'d'})
+	c := newCanonicalRootIndex(params.BeaconConfig().SlotsPerHistoricalRoot)
+
+	_, _, err := c.rootAt(0)
+	assert.ErrorContains(t, ErrSlotNotIndexed.Error(), err)
+
+	// Blocks at slots 0, 1, 3 and 4, slot 2 being skipped.
+	require.NoError(t, c.update(r4, 4, canonicalRootsState(t, r0, r1, r1, r3)))
+	for slot, want := range map[types.Slot][32]byte{0: r0, 1: r1, 3: r3, 4: r4} {
+		root, ok, err := c.rootAt(slot)
+		require.NoError(t, err)
+		assert.Equal(t, true, ok, "Expected a block at slot %d", slot)
+		assert.Equal(t, want, root, "Unexpected root at slot %d", slot)
+	}
+	_, ok, err := c.rootAt(2)
+	require.NoError(t, err)
+	assert.Equal(t, false, ok, "Expected slot 2 to be skipped")
+	root, slot, err := c.highestAtOrBelow(2)
+	require.NoError(t, err)
+	assert.Equal(t, r1, root)
+	assert.Equal(t, types.Slot(1), slot)
+	_, _, err = c.rootAt(5)
+	assert.ErrorContains(t, ErrSlotNotIndexed.Error(), err)
+	root, slot, err = c.highestAtOrBelow(100)
+	require.NoError(t, err)
+	assert.Equal(t, r4, root)
+	assert.Equal(t, types.Slot(4), slot)
+
+	// A reorg to a block at slot 2 on top of slot 1 drops slots 3 and 4.
+	r2 := bytesutil.ToBytes32([]byte{'e'})
+	require.NoError(t, c.update(r2, 2, canonicalRootsState(t, r0, r1)))
+	root, ok, err = c.rootAt(2)
+	require.NoError(t, err)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, r2, root)
+	_, _, err = c.rootAt(3)
+	assert.ErrorContains(t, ErrSlotNotIndexed.Error(), err)
+	root, _, err = c.rootAt(1)
+	require.NoError(t, err)
+	assert.Equal(t, r1, root)
+}
+
+func TestCanonicalRootIndex_EvictsOldSlots(t *testing.T) {
+	c := &canonicalRootIndex{entries: make([]canonicalRootEntry, 4)}
+	roots := make([][32]byte, 6)
+	for i := range roots {
+		roots[i] = bytesutil.ToBytes32([]byte{byte(i + 1)})
+	}
+	head := bytesutil.ToBytes32([]byte{'h'})
+	require.NoError(t, c.update(head, 6, canonicalRootsState(t, roots...)))
+
+	_, _, err := c.rootAt(2)
+	assert.ErrorContains(t, ErrSlotNotIndexed.Error(), err)
+	root, ok, err := c.rootAt(3)
+	require.NoError(t, err)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, roots[3], root)
+}
+
+func TestService_CanonicalRootAtSlot_SetHead(t *testing.T) {
+	s := &Service{canonicalRoots: newCanonicalRootIndex(params.BeaconConfig().SlotsPerHistoricalRoot)}
+	r0 := bytesutil.ToBytes32([]byte{'a'})
+	head := bytesutil.ToBytes32([]byte{'b'})
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 1
+	s.setHead(head, blk, canonicalRootsState(t, r0))
+
+	root, ok, err := s.CanonicalRootAtSlot(1)
+	require.NoError(t, err)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, head, root)
+	root, slot, err := s.HighestCanonicalRootAtOrBelow(0)
+	require.NoError(t, err)
+	assert.Equal(t, r0, root)
+	assert.Equal(t, types.Slot(0), slot)
+
+	_, _, err = (&Service{}).CanonicalRootAtSlot(1)
+	assert.ErrorContains(t, ErrSlotNotIndexed.Error(), err)
+}
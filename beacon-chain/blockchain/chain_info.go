@@ -12,7 +12,6 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
-	"github.com/prysmaticlabs/prysm/shared/params"
 	"go.opencensus.io/trace"
 )
 
@@ -73,7 +72,7 @@ type FinalizationFetcher interface {
 // FinalizedCheckpt returns the latest finalized checkpoint from head state.
 func (s *Service) FinalizedCheckpt() *ethpb.Checkpoint {
 	if s.finalizedCheckpt == nil {
-		return &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+		return &ethpb.Checkpoint{Root: s.beaconConfig().ZeroHash[:]}
 	}
 
 	return stateV0.CopyCheckpoint(s.finalizedCheckpt)
@@ -82,7 +81,7 @@ func (s *Service) FinalizedCheckpt() *ethpb.Checkpoint {
 // CurrentJustifiedCheckpt returns the current justified checkpoint from head state.
 func (s *Service) CurrentJustifiedCheckpt() *ethpb.Checkpoint {
 	if s.justifiedCheckpt == nil {
-		return &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+		return &ethpb.Checkpoint{Root: s.beaconConfig().ZeroHash[:]}
 	}
 
 	return stateV0.CopyCheckpoint(s.justifiedCheckpt)
@@ -91,7 +90,7 @@ func (s *Service) CurrentJustifiedCheckpt() *ethpb.Checkpoint {
 // PreviousJustifiedCheckpt returns the previous justified checkpoint from head state.
 func (s *Service) PreviousJustifiedCheckpt() *ethpb.Checkpoint {
 	if s.prevJustifiedCheckpt == nil {
-		return &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+		return &ethpb.Checkpoint{Root: s.beaconConfig().ZeroHash[:]}
 	}
 
 	return stateV0.CopyCheckpoint(s.prevJustifiedCheckpt)
@@ -114,7 +113,7 @@ func (s *Service) HeadRoot(ctx context.Context) ([]byte, error) {
 	s.headLock.RLock()
 	defer s.headLock.RUnlock()
 
-	if s.headRoot() != params.BeaconConfig().ZeroHash {
+	if s.headRoot() != s.beaconConfig().ZeroHash {
 		r := s.headRoot()
 		return r[:], nil
 	}
@@ -124,7 +123,7 @@ func (s *Service) HeadRoot(ctx context.Context) ([]byte, error) {
 		return nil, err
 	}
 	if b == nil {
-		return params.BeaconConfig().ZeroHash[:], nil
+		return s.beaconConfig().ZeroHash[:], nil
 	}
 
 	r, err := b.Block.HashTreeRoot()
@@ -176,7 +175,7 @@ func (s *Service) HeadValidatorsIndices(ctx context.Context, epoch types.Epoch)
 	if !s.hasHeadState() {
 		return []types.ValidatorIndex{}, nil
 	}
-	return helpers.ActiveValidatorIndices(s.headState(ctx), epoch)
+	return helpers.ActiveValidatorIndices(s.committeeCache, s.headState(ctx), epoch)
 }
 
 // HeadSeed returns the seed from the head view of a given epoch.
@@ -188,7 +187,7 @@ func (s *Service) HeadSeed(ctx context.Context, epoch types.Epoch) ([32]byte, er
 		return [32]byte{}, nil
 	}
 
-	return helpers.Seed(s.headState(ctx), epoch, params.BeaconConfig().DomainBeaconAttester)
+	return helpers.Seed(s.headState(ctx), epoch, s.beaconConfig().DomainBeaconAttester)
 }
 
 // HeadGenesisValidatorRoot returns genesis validator root of the head state.
@@ -243,8 +242,8 @@ func (s *Service) CurrentFork() *pb.Fork {
 
 	if !s.hasHeadState() {
 		return &pb.Fork{
-			PreviousVersion: params.BeaconConfig().GenesisForkVersion,
-			CurrentVersion:  params.BeaconConfig().GenesisForkVersion,
+			PreviousVersion: s.beaconConfig().GenesisForkVersion,
+			CurrentVersion:  s.beaconConfig().GenesisForkVersion,
 		}
 	}
 	return s.head.state.Fork()
@@ -15,7 +15,6 @@ import (
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
-	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -50,7 +49,7 @@ func (s *Service) updateHead(ctx context.Context, balances []uint64) error {
 	// To get head before the first justified epoch, the fork choice will start with genesis root
 	// instead of zero hashes.
 	headStartRoot := bytesutil.ToBytes32(j.Root)
-	if headStartRoot == params.BeaconConfig().ZeroHash {
+	if headStartRoot == s.beaconConfig().ZeroHash {
 		headStartRoot = s.genesisRoot
 	}
 
@@ -124,11 +123,19 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 			"newSlot": fmt.Sprintf("%d", newHeadBlock.Block.Slot),
 			"oldSlot": fmt.Sprintf("%d", headSlot),
 		}).Debug("Chain reorg occurred")
+		// The reorg history is best effort, failing to record it must not hold back the head.
+		event, err := s.reorgEvent(ctx, bytesutil.ToBytes32(r), headRoot, newHeadBlock.Block.Slot)
+		if err != nil {
+			log.WithError(err).Debug("Could not determine common ancestor of reorg")
+		} else if err := s.cfg.BeaconDB.SaveReorg(ctx, event); err != nil {
+			log.WithError(err).Error("Could not save reorg event")
+		}
 		s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
 			Type: statefeed.Reorg,
 			Data: &statefeed.ReorgData{
 				NewSlot: newHeadBlock.Block.Slot,
 				OldSlot: headSlot,
+				Event:   event,
 			},
 		})
 
@@ -138,6 +145,19 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 	// Cache the new head info.
 	s.setHead(headRoot, newHeadBlock, newHeadState)
 
+	// The head advanced into a new epoch, so we notify the services which track epoch based duties.
+	// Heads moving back to an earlier epoch are covered by the reorg event above.
+	newHeadEpoch := helpers.SlotToEpoch(newHeadBlock.Block.Slot)
+	if newHeadEpoch > helpers.SlotToEpoch(headSlot) {
+		s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.EpochTransition,
+			Data: &statefeed.EpochTransitionData{
+				Epoch: newHeadEpoch,
+				Slot:  newHeadBlock.Block.Slot,
+			},
+		})
+	}
+
 	// Save the new head root to DB.
 	if err := s.cfg.BeaconDB.SaveHeadBlockRoot(ctx, headRoot); err != nil {
 		return errors.Wrap(err, "could not save head root in DB")
@@ -177,6 +197,7 @@ func (s *Service) setHead(root [32]byte, block *ethpb.SignedBeaconBlock, state i
 		block: stateV0.CopySignedBeaconBlock(block),
 		state: state.Copy(),
 	}
+	s.updateCanonicalRoots(root, block.Block.Slot, state)
 }
 
 // This sets head view object which is used to track the head slot, root, block and state. The method
@@ -193,6 +214,7 @@ func (s *Service) setHeadInitialSync(root [32]byte, block *ethpb.SignedBeaconBlo
 		block: stateV0.CopySignedBeaconBlock(block),
 		state: state,
 	}
+	s.updateCanonicalRoots(root, block.Block.Slot, state)
 }
 
 // This returns the head slot.
@@ -206,7 +228,7 @@ func (s *Service) headSlot() types.Slot {
 // This is a lock free version.
 func (s *Service) headRoot() [32]byte {
 	if s.head == nil {
-		return params.BeaconConfig().ZeroHash
+		return s.beaconConfig().ZeroHash
 	}
 
 	return s.head.root
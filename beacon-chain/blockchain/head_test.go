@@ -7,8 +7,12 @@ import (
 
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	blockchainTesting "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/reorg"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -95,6 +99,82 @@ func TestSaveHead_Different_Reorg(t *testing.T) {
 	require.LogsContain(t, hook, "Chain reorg occurred")
 }
 
+func TestSaveHead_Reorg_RecordsEvent(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := setupBeaconChain(t, beaconDB)
+	notifier := &blockchainTesting.MockStateNotifier{RecordEvents: true}
+	service.cfg.StateNotifier = notifier
+
+	saveBlock := func(slot types.Slot, parentRoot [32]byte) [32]byte {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ParentRoot = parentRoot[:]
+		require.NoError(t, beaconDB.SaveBlock(ctx, b))
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		return root
+	}
+	// The old chain has two blocks after the common ancestor, the new chain one.
+	ancestor := saveBlock(1, [32]byte{'P'})
+	oldRoot := saveBlock(3, saveBlock(2, ancestor))
+	newRoot := saveBlock(4, ancestor)
+	service.head = &head{slot: 3, root: oldRoot}
+
+	headState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, headState.SetSlot(4))
+	require.NoError(t, beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Slot: 4, Root: newRoot[:]}))
+	require.NoError(t, beaconDB.SaveState(ctx, headState, newRoot))
+	require.NoError(t, service.saveHead(ctx, newRoot))
+
+	events := notifier.ReceivedEvents()
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, statefeed.Reorg, int(events[0].Type))
+	data, ok := events[0].Data.(*statefeed.ReorgData)
+	require.Equal(t, true, ok)
+	require.NotNil(t, data.Event)
+	assert.Equal(t, oldRoot, data.Event.OldHeadRoot)
+	assert.Equal(t, newRoot, data.Event.NewHeadRoot)
+	assert.Equal(t, types.Slot(1), data.Event.CommonAncestorSlot)
+	assert.Equal(t, uint64(2), data.Event.Depth)
+
+	reorgs, err := beaconDB.Reorgs(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*reorg.Event{data.Event}, reorgs)
+}
+
+func TestSaveHead_EpochTransition(t *testing.T) {
+	beaconDB := testDB.SetupDB(t)
+	service := setupBeaconChain(t, beaconDB)
+	notifier := &blockchainTesting.MockStateNotifier{RecordEvents: true}
+	service.cfg.StateNotifier = notifier
+
+	oldRoot := [32]byte{'A'}
+	service.head = &head{slot: params.BeaconConfig().SlotsPerEpoch - 1, root: oldRoot}
+
+	newHeadSignedBlock := testutil.NewBeaconBlock()
+	newHeadSignedBlock.Block.Slot = params.BeaconConfig().SlotsPerEpoch
+	newHeadSignedBlock.Block.ParentRoot = oldRoot[:]
+	require.NoError(t, service.cfg.BeaconDB.SaveBlock(context.Background(), newHeadSignedBlock))
+	newRoot, err := newHeadSignedBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	headState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, headState.SetSlot(newHeadSignedBlock.Block.Slot))
+	require.NoError(t, service.cfg.BeaconDB.SaveStateSummary(context.Background(), &pb.StateSummary{Slot: newHeadSignedBlock.Block.Slot, Root: newRoot[:]}))
+	require.NoError(t, service.cfg.BeaconDB.SaveState(context.Background(), headState, newRoot))
+	require.NoError(t, service.saveHead(context.Background(), newRoot))
+
+	events := notifier.ReceivedEvents()
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, statefeed.EpochTransition, int(events[0].Type))
+	data, ok := events[0].Data.(*statefeed.EpochTransitionData)
+	require.Equal(t, true, ok)
+	assert.Equal(t, types.Epoch(1), data.Epoch)
+	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch, data.Slot)
+}
+
 func TestCacheJustifiedStateBalances_CanCache(t *testing.T) {
 	beaconDB := testDB.SetupDB(t)
 	service := setupBeaconChain(t, beaconDB)
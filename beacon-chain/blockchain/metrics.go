@@ -7,6 +7,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -106,6 +107,32 @@ var (
 			Buckets: []float64{1, 2, 3, 4, 6, 32, 64},
 		},
 	)
+	pendingPandoraBlocks = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "pandora_pending_blocks",
+		Help: "The number of blocks waiting for an orchestrator confirmation",
+	})
+	pandoraVerificationCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "pandora_verification_total",
+			Help: "Count of orchestrator verification results of received blocks",
+		},
+		[]string{"result"},
+	)
+	pandoraVerificationLatency = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "pandora_verification_latency_milliseconds",
+			Help:    "Captures the time received blocks wait for an orchestrator confirmation in milliseconds",
+			Buckets: []float64{50, 100, 250, 500, 1000, 2000, 4000, 8000, 12000},
+		},
+	)
+	proposerPrecomputationStalled = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "proposer_precomputation_stalled",
+		Help: "1 if the proposer precomputation of the current epoch has not completed in time, 0 otherwise",
+	})
+	proposerPrecomputationStalls = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "proposer_precomputation_stalls_total",
+		Help: "Count of epochs whose proposer precomputation did not complete in time",
+	})
 )
 
 // reportSlotMetrics reports slot related metrics.
@@ -120,7 +147,7 @@ func reportSlotMetrics(stateSlot, headSlot, clockSlot types.Slot, finalizedCheck
 }
 
 // reportEpochMetrics reports epoch related metrics.
-func reportEpochMetrics(ctx context.Context, postState, headState iface.BeaconState) error {
+func reportEpochMetrics(ctx context.Context, committeeCache *cache.CommitteeCache, postState, headState iface.BeaconState) error {
 	currentEpoch := types.Epoch(postState.Slot() / params.BeaconConfig().SlotsPerEpoch)
 
 	// Validator instances
@@ -210,7 +237,7 @@ func reportEpochMetrics(ctx context.Context, postState, headState iface.BeaconSt
 	if err != nil {
 		return err
 	}
-	_, b, err = precompute.ProcessAttestations(ctx, headState, v, b)
+	_, b, err = precompute.ProcessAttestations(ctx, committeeCache, headState, v, b)
 	if err != nil {
 		return err
 	}
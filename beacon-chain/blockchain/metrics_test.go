@@ -16,7 +16,7 @@ func TestReportEpochMetrics_BadHeadState(t *testing.T) {
 	h, err := testutil.NewBeaconState()
 	require.NoError(t, err)
 	require.NoError(t, h.SetValidators(nil))
-	err = reportEpochMetrics(context.Background(), s, h)
+	err = reportEpochMetrics(context.Background(), nil, s, h)
 	require.ErrorContains(t, "failed to initialize precompute: nil validators in state", err)
 }
 
@@ -26,7 +26,7 @@ func TestReportEpochMetrics_BadAttestation(t *testing.T) {
 	h, err := testutil.NewBeaconState()
 	require.NoError(t, err)
 	require.NoError(t, h.AppendCurrentEpochAttestations(&pb.PendingAttestation{InclusionDelay: 0}))
-	err = reportEpochMetrics(context.Background(), s, h)
+	err = reportEpochMetrics(context.Background(), nil, s, h)
 	require.ErrorContains(t, "attestation with inclusion delay of 0", err)
 }
 
@@ -37,6 +37,6 @@ func TestReportEpochMetrics_SlashedValidatorOutOfBound(t *testing.T) {
 	v.Slashed = true
 	require.NoError(t, h.UpdateValidatorAtIndex(0, v))
 	require.NoError(t, h.AppendCurrentEpochAttestations(&pb.PendingAttestation{InclusionDelay: 1, Data: testutil.HydrateAttestationData(&eth.AttestationData{})}))
-	err = reportEpochMetrics(context.Background(), h, h)
+	err = reportEpochMetrics(context.Background(), nil, h, h)
 	require.ErrorContains(t, "slot 0 out of bounds", err)
 }
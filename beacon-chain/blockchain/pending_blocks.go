@@ -0,0 +1,307 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// defaultPandoraVerificationTimeout is used when the service is not configured with a timeout.
+const defaultPandoraVerificationTimeout = 12 * time.Second
+
+// maxHeldConfirmations bounds the confirmations held for blocks the node has not received yet.
+const maxHeldConfirmations = 1024
+
+var errPandoraVerificationTimeout = errors.New("timed out waiting for orchestrator confirmation")
+
+// PandoraConfirmationReceiver interface defines the methods used by the orchestrator feedback
+// endpoint to resolve and release blocks held in the pending queue.
+type PandoraConfirmationReceiver interface {
+	PendingBlockRoots(slot types.Slot) [][32]byte
+	ReceivePandoraConfirmations(ctx context.Context, confirmations []*orchestrator.Confirmation) error
+	// HoldPandoraConfirmations keeps the confirmations of blocks the node has not received, so
+	// that they apply to these blocks once received.
+	HoldPandoraConfirmations(confirmations []*orchestrator.Confirmation)
+}
+
+// pendingBlockQueue holds the blocks received from sync or gossip until the orchestrator
+// confirms the Pandora block paired with them, and the confirmations reported before their
+// blocks were received, by block root.
+type pendingBlockQueue struct {
+	lock   sync.Mutex
+	blocks map[[32]byte]*pendingBlock
+	held   map[[32]byte]*orchestrator.Confirmation
+}
+
+// pendingBlock is shared by every goroutine waiting on the same block. It is removed from the
+// queue once the last of them stops waiting.
+type pendingBlock struct {
+	slot    types.Slot
+	waiters int
+	// released is closed once the status is set, which wakes every waiter.
+	released chan struct{}
+	status   orchestrator.Status
+}
+
+func newPendingBlockQueue() *pendingBlockQueue {
+	return &pendingBlockQueue{
+		blocks: make(map[[32]byte]*pendingBlock),
+		held:   make(map[[32]byte]*orchestrator.Confirmation),
+	}
+}
+
+// add registers a waiter of the block and returns the pending block its confirmation is
+// delivered on. Every add must be followed by a remove once the waiter stops waiting.
+func (q *pendingBlockQueue) add(slot types.Slot, root [32]byte) *pendingBlock {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	p, ok := q.blocks[root]
+	if !ok {
+		p = &pendingBlock{slot: slot, released: make(chan struct{})}
+		q.blocks[root] = p
+		pendingPandoraBlocks.Set(float64(len(q.blocks)))
+	}
+	p.waiters++
+	return p
+}
+
+// remove unregisters a waiter of the block, and removes the block from the queue once it has
+// no waiters left.
+func (q *pendingBlockQueue) remove(root [32]byte) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	p, ok := q.blocks[root]
+	if !ok {
+		return
+	}
+	p.waiters--
+	if p.waiters > 0 {
+		return
+	}
+	delete(q.blocks, root)
+	pendingPandoraBlocks.Set(float64(len(q.blocks)))
+}
+
+func (q *pendingBlockQueue) roots(slot types.Slot) [][32]byte {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	roots := make([][32]byte, 0)
+	for r, p := range q.blocks {
+		if p.slot == slot {
+			roots = append(roots, r)
+		}
+	}
+	return roots
+}
+
+// release delivers the status to every waiter of the block, if any. Only the first status
+// reported for the block is delivered.
+func (q *pendingBlockQueue) release(root [32]byte, status orchestrator.Status) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	p, ok := q.blocks[root]
+	if !ok {
+		return
+	}
+	select {
+	case <-p.released:
+		return
+	default:
+	}
+	p.status = status
+	close(p.released)
+}
+
+// hold keeps the confirmation of a block which was not received yet. Once the bound is reached,
+// the confirmation of the lowest slot is dropped.
+func (q *pendingBlockQueue) hold(c *orchestrator.Confirmation) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if _, ok := q.held[c.BlockRoot]; !ok && len(q.held) >= maxHeldConfirmations {
+		lowest, lowestRoot := c.Slot, c.BlockRoot
+		for r, held := range q.held {
+			if held.Slot < lowest {
+				lowest, lowestRoot = held.Slot, r
+			}
+		}
+		if lowestRoot == c.BlockRoot {
+			return
+		}
+		delete(q.held, lowestRoot)
+	}
+	cpy := *c
+	q.held[c.BlockRoot] = &cpy
+}
+
+// heldConfirmation returns the confirmation held for the block, or nil if there is none. A
+// confirmation reported for another slot than the one of the block does not apply to it.
+func (q *pendingBlockQueue) heldConfirmation(slot types.Slot, root [32]byte) *orchestrator.Confirmation {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	c, ok := q.held[root]
+	if !ok || c.Slot != slot {
+		return nil
+	}
+	cpy := *c
+	return &cpy
+}
+
+// forget drops the confirmation held for the block.
+func (q *pendingBlockQueue) forget(root [32]byte) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	delete(q.held, root)
+}
+
+// evictHeld drops the confirmations held for blocks at or before the given slot, which can no
+// longer be received once it is finalized.
+func (q *pendingBlockQueue) evictHeld(slot types.Slot) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	for r, c := range q.held {
+		if c.Slot <= slot {
+			delete(q.held, r)
+		}
+	}
+}
+
+// PendingBlockRoots returns the roots of the blocks at the given slot which are waiting
+// for an orchestrator confirmation.
+func (s *Service) PendingBlockRoots(slot types.Slot) [][32]byte {
+	return s.pendingBlocks.roots(slot)
+}
+
+// ReceivePandoraConfirmations releases the pending blocks the orchestrator reported on, and
+// removes the processed blocks marked invalid from fork choice, updating the head if they were
+// viable for it. Confirmations still marked as pending are ignored.
+func (s *Service) ReceivePandoraConfirmations(ctx context.Context, confirmations []*orchestrator.Confirmation) error {
+	invalidated := false
+	for _, c := range confirmations {
+		if c == nil || c.Status == orchestrator.Pending {
+			continue
+		}
+		s.pendingBlocks.release(c.BlockRoot, c.Status)
+		if c.Status != orchestrator.Invalid || s.cfg.ForkChoiceStore == nil || !s.cfg.ForkChoiceStore.HasNode(c.BlockRoot) {
+			continue
+		}
+		if err := s.cfg.ForkChoiceStore.MarkInvalid(ctx, c.BlockRoot); err != nil {
+			return errors.Wrapf(err, "could not mark block %#x invalid", c.BlockRoot)
+		}
+		log.WithField("root", fmt.Sprintf("%#x", c.BlockRoot)).Warn("Removed block marked invalid by the orchestrator from fork choice")
+		invalidated = true
+	}
+	if !invalidated {
+		return nil
+	}
+	return s.updateHead(ctx, s.getJustifiedBalances())
+}
+
+// HoldPandoraConfirmations keeps the confirmations of blocks the node has not received yet. The
+// blocks are resolved with them once received, as if the orchestrator reported on them after they
+// were received. Confirmations without a block root are ignored.
+func (s *Service) HoldPandoraConfirmations(confirmations []*orchestrator.Confirmation) {
+	for _, c := range confirmations {
+		if c == nil || c.Status == orchestrator.Pending || c.BlockRoot == [32]byte{} {
+			continue
+		}
+		s.pendingBlocks.hold(c)
+	}
+}
+
+// pandoraConfirmation returns the orchestrator confirmation of a block, or nil if the orchestrator
+// has not reported on it. A confirmation held for the block is saved for it, unless its Pandora
+// block is paired with another block already.
+func (s *Service) pandoraConfirmation(ctx context.Context, slot types.Slot, root [32]byte) (*orchestrator.Confirmation, error) {
+	confirmation, err := s.cfg.BeaconDB.PandoraConfirmation(ctx, root)
+	if err != nil || confirmation != nil {
+		return confirmation, err
+	}
+	confirmation = s.pendingBlocks.heldConfirmation(slot, root)
+	if confirmation == nil {
+		return nil, nil
+	}
+	paired, err := s.cfg.BeaconDB.PandoraConfirmationByHash(ctx, confirmation.PandoraHash)
+	if err != nil {
+		return nil, err
+	}
+	if paired != nil && paired.BlockRoot != root {
+		log.WithFields(logrus.Fields{
+			"root":        fmt.Sprintf("%#x", root),
+			"pandoraHash": fmt.Sprintf("%#x", confirmation.PandoraHash),
+			"pairedRoot":  fmt.Sprintf("%#x", paired.BlockRoot),
+		}).Warn("Ignored held orchestrator confirmation of a Pandora block paired with another block")
+		s.pendingBlocks.forget(root)
+		return nil, nil
+	}
+	if err := s.cfg.BeaconDB.SavePandoraConfirmations(ctx, []*orchestrator.Confirmation{confirmation}); err != nil {
+		return nil, err
+	}
+	s.pendingBlocks.forget(root)
+	return confirmation, nil
+}
+
+// waitForPandoraConfirmations holds the blocks in the pending queue until the orchestrator
+// verified all of them. It returns an error as soon as one of the blocks is marked invalid
+// or the confirmations do not arrive in time. The gate is a no-op unless enabled in the config.
+func (s *Service) waitForPandoraConfirmations(ctx context.Context, blks []*ethpb.SignedBeaconBlock, roots [][32]byte) error {
+	if !s.cfg.VerifyPandoraBlocks {
+		return nil
+	}
+	ctx, span := trace.StartSpan(ctx, "blockChain.waitForPandoraConfirmations")
+	defer span.End()
+
+	// Blocks are queued before the database is consulted so a confirmation saved
+	// in between is still delivered through the queue.
+	pending := make([]*pendingBlock, len(blks))
+	for i, b := range blks {
+		if b == nil || b.Block == nil {
+			return errors.New("nil block")
+		}
+		pending[i] = s.pendingBlocks.add(b.Block.Slot, roots[i])
+		defer s.pendingBlocks.remove(roots[i])
+	}
+
+	timeout := s.cfg.PandoraVerificationTimeout
+	if timeout == 0 {
+		timeout = defaultPandoraVerificationTimeout
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	start := time.Now()
+
+	for i, r := range roots {
+		confirmation, err := s.pandoraConfirmation(ctx, blks[i].Block.Slot, r)
+		if err != nil {
+			return errors.Wrap(err, "could not get orchestrator confirmation")
+		}
+		status := orchestrator.Pending
+		if confirmation != nil {
+			status = confirmation.Status
+		}
+		if status == orchestrator.Pending {
+			select {
+			case <-pending[i].released:
+				status = pending[i].status
+			case <-timer.C:
+				pandoraVerificationCount.WithLabelValues("timeout").Inc()
+				return errors.Wrapf(errPandoraVerificationTimeout, "block %#x", r)
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		pandoraVerificationCount.WithLabelValues(status.String()).Inc()
+		if status == orchestrator.Invalid {
+			return fmt.Errorf("block %#x was marked invalid by the orchestrator", r)
+		}
+	}
+	pandoraVerificationLatency.Observe(float64(time.Since(start).Milliseconds()))
+	return nil
+}
@@ -0,0 +1,303 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func pendingTestService(t *testing.T, timeout time.Duration) *Service {
+	beaconDB := testDB.SetupDB(t)
+	service, err := NewService(context.Background(), &Config{
+		BeaconDB:                   beaconDB,
+		VerifyPandoraBlocks:        true,
+		PandoraVerificationTimeout: timeout,
+	})
+	require.NoError(t, err)
+	return service
+}
+
+func pendingTestBlock(t *testing.T, slot types.Slot) (*ethpb.SignedBeaconBlock, [32]byte) {
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = slot
+	r, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	return b, r
+}
+
+func TestWaitForPandoraConfirmations_Disabled(t *testing.T) {
+	service := pendingTestService(t, time.Millisecond)
+	service.cfg.VerifyPandoraBlocks = false
+	b, r := pendingTestBlock(t, 1)
+	assert.NoError(t, service.waitForPandoraConfirmations(context.Background(), []*ethpb.SignedBeaconBlock{b}, [][32]byte{r}))
+}
+
+func TestWaitForPandoraConfirmations_AlreadyConfirmed(t *testing.T) {
+	ctx := context.Background()
+	service := pendingTestService(t, time.Millisecond)
+	b, r := pendingTestBlock(t, 1)
+	require.NoError(t, service.cfg.BeaconDB.SavePandoraConfirmations(ctx, []*orchestrator.Confirmation{
+		{Slot: 1, BlockRoot: r, Status: orchestrator.Verified},
+	}))
+	assert.NoError(t, service.waitForPandoraConfirmations(ctx, []*ethpb.SignedBeaconBlock{b}, [][32]byte{r}))
+	assert.Equal(t, 0, len(service.PendingBlockRoots(1)))
+}
+
+func TestWaitForPandoraConfirmations_ReleasedByConfirmation(t *testing.T) {
+	ctx := context.Background()
+	service := pendingTestService(t, 5*time.Second)
+	b, r := pendingTestBlock(t, 2)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- service.waitForPandoraConfirmations(ctx, []*ethpb.SignedBeaconBlock{b}, [][32]byte{r})
+	}()
+	require.NoError(t, waitForPendingRoot(service, 2, r))
+
+	require.NoError(t, service.ReceivePandoraConfirmations(ctx, []*orchestrator.Confirmation{{Slot: 2, BlockRoot: r, Status: orchestrator.Verified}}))
+	assert.NoError(t, <-errCh)
+	assert.Equal(t, 0, len(service.PendingBlockRoots(2)))
+}
+
+func TestWaitForPandoraConfirmations_ReleasesAllWaiters(t *testing.T) {
+	ctx := context.Background()
+	service := pendingTestService(t, 5*time.Second)
+	b, r := pendingTestBlock(t, 6)
+
+	errCh := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			errCh <- service.waitForPandoraConfirmations(ctx, []*ethpb.SignedBeaconBlock{b}, [][32]byte{r})
+		}()
+	}
+	require.NoError(t, waitForPendingWaiters(service, r, 2))
+
+	require.NoError(t, service.ReceivePandoraConfirmations(ctx, []*orchestrator.Confirmation{{Slot: 6, BlockRoot: r, Status: orchestrator.Verified}}))
+	assert.NoError(t, <-errCh)
+	assert.NoError(t, <-errCh)
+	assert.Equal(t, 0, len(service.PendingBlockRoots(6)))
+}
+
+func TestPendingBlockQueue_RemoveKeepsBlockForOtherWaiters(t *testing.T) {
+	q := newPendingBlockQueue()
+	r := [32]byte{'a'}
+	first := q.add(1, r)
+	second := q.add(1, r)
+	assert.Equal(t, first, second)
+
+	q.remove(r)
+	assert.DeepEqual(t, [][32]byte{r}, q.roots(1))
+	q.release(r, orchestrator.Verified)
+	// A later status does not override the delivered one.
+	q.release(r, orchestrator.Invalid)
+	select {
+	case <-second.released:
+	default:
+		t.Fatal("Waiter was not released")
+	}
+	assert.Equal(t, orchestrator.Verified, second.status)
+
+	q.remove(r)
+	assert.Equal(t, 0, len(q.roots(1)))
+}
+
+func TestWaitForPandoraConfirmations_Invalid(t *testing.T) {
+	ctx := context.Background()
+	service := pendingTestService(t, 5*time.Second)
+	b, r := pendingTestBlock(t, 3)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- service.waitForPandoraConfirmations(ctx, []*ethpb.SignedBeaconBlock{b}, [][32]byte{r})
+	}()
+	require.NoError(t, waitForPendingRoot(service, 3, r))
+
+	require.NoError(t, service.ReceivePandoraConfirmations(ctx, []*orchestrator.Confirmation{{Slot: 3, BlockRoot: r, Status: orchestrator.Invalid}}))
+	assert.ErrorContains(t, "marked invalid by the orchestrator", <-errCh)
+}
+
+func TestWaitForPandoraConfirmations_Timeout(t *testing.T) {
+	service := pendingTestService(t, 10*time.Millisecond)
+	b, r := pendingTestBlock(t, 4)
+	err := service.waitForPandoraConfirmations(context.Background(), []*ethpb.SignedBeaconBlock{b}, [][32]byte{r})
+	assert.ErrorContains(t, errPandoraVerificationTimeout.Error(), err)
+	assert.Equal(t, 0, len(service.PendingBlockRoots(4)))
+}
+
+func TestWaitForPandoraConfirmations_HeldConfirmation(t *testing.T) {
+	ctx := context.Background()
+	service := pendingTestService(t, 5*time.Second)
+	hash := [32]byte{'p'}
+	b, r := pendingTestBlock(t, 5)
+	service.HoldPandoraConfirmations([]*orchestrator.Confirmation{{Slot: 5, BlockRoot: r, PandoraHash: hash, Status: orchestrator.Invalid}})
+
+	// The confirmation reported before the block was received resolves it without waiting.
+	err := service.waitForPandoraConfirmations(ctx, []*ethpb.SignedBeaconBlock{b}, [][32]byte{r})
+	assert.ErrorContains(t, "marked invalid by the orchestrator", err)
+	c, err := service.cfg.BeaconDB.PandoraConfirmation(ctx, r)
+	require.NoError(t, err)
+	require.NotNil(t, c)
+	assert.Equal(t, r, c.BlockRoot)
+	assert.Equal(t, hash, c.PandoraHash)
+	assert.ErrorContains(t, "marked invalid by the orchestrator", service.verifyPandoraConfirmation(ctx, 5, r))
+	assert.Equal(t, 0, len(service.pendingBlocks.held))
+}
+
+func TestWaitForPandoraConfirmations_HeldConfirmationSkipsSibling(t *testing.T) {
+	ctx := context.Background()
+	service := pendingTestService(t, 10*time.Millisecond)
+	_, r := pendingTestBlock(t, 5)
+	sibling := testutil.NewBeaconBlock()
+	sibling.Block.Slot = 5
+	sibling.Block.Body.Graffiti = bytesutil.PadTo([]byte{'s'}, 32)
+	siblingRoot, err := sibling.Block.HashTreeRoot()
+	require.NoError(t, err)
+	service.HoldPandoraConfirmations([]*orchestrator.Confirmation{{Slot: 5, BlockRoot: r, PandoraHash: [32]byte{'p'}, Status: orchestrator.Verified}})
+
+	// An equivocating block of the same slot is not released by the confirmation of the other.
+	err = service.waitForPandoraConfirmations(ctx, []*ethpb.SignedBeaconBlock{sibling}, [][32]byte{siblingRoot})
+	assert.ErrorContains(t, errPandoraVerificationTimeout.Error(), err)
+	c, err := service.cfg.BeaconDB.PandoraConfirmation(ctx, siblingRoot)
+	require.NoError(t, err)
+	assert.Equal(t, true, c == nil)
+}
+
+func TestWaitForPandoraConfirmations_HeldConfirmationPairedElsewhere(t *testing.T) {
+	ctx := context.Background()
+	service := pendingTestService(t, 10*time.Millisecond)
+	hash := [32]byte{'p'}
+	require.NoError(t, service.cfg.BeaconDB.SavePandoraConfirmations(ctx, []*orchestrator.Confirmation{
+		{Slot: 4, BlockRoot: [32]byte{'o'}, PandoraHash: hash, Status: orchestrator.Verified},
+	}))
+	b, r := pendingTestBlock(t, 5)
+	service.HoldPandoraConfirmations([]*orchestrator.Confirmation{{Slot: 5, BlockRoot: r, PandoraHash: hash, Status: orchestrator.Verified}})
+
+	// The Pandora block is paired with another block, so the held confirmation does not apply.
+	err := service.waitForPandoraConfirmations(ctx, []*ethpb.SignedBeaconBlock{b}, [][32]byte{r})
+	assert.ErrorContains(t, errPandoraVerificationTimeout.Error(), err)
+	assert.Equal(t, 0, len(service.pendingBlocks.held))
+}
+
+func TestPendingBlockQueue_HeldConfirmation(t *testing.T) {
+	q := newPendingBlockQueue()
+	r := [32]byte{'a'}
+	q.hold(&orchestrator.Confirmation{Slot: 3, BlockRoot: r, Status: orchestrator.Verified})
+	assert.Equal(t, true, q.heldConfirmation(3, [32]byte{'b'}) == nil)
+	// A confirmation reported for another slot does not apply to the block.
+	assert.Equal(t, true, q.heldConfirmation(4, r) == nil)
+	c := q.heldConfirmation(3, r)
+	require.NotNil(t, c)
+	assert.Equal(t, r, c.BlockRoot)
+}
+
+func TestPendingBlockQueue_EvictHeld(t *testing.T) {
+	q := newPendingBlockQueue()
+	for slot := types.Slot(30); slot < 34; slot++ {
+		q.hold(&orchestrator.Confirmation{Slot: slot, BlockRoot: [32]byte{byte(slot)}, Status: orchestrator.Verified})
+	}
+	q.evictHeld(32)
+	assert.Equal(t, 1, len(q.held))
+	assert.NotNil(t, q.heldConfirmation(33, [32]byte{33}))
+}
+
+func TestPendingBlockQueue_HoldBound(t *testing.T) {
+	q := newPendingBlockQueue()
+	for i := 0; i < maxHeldConfirmations; i++ {
+		q.hold(&orchestrator.Confirmation{Slot: types.Slot(i + 1), BlockRoot: bytesutil.ToBytes32(bytesutil.Bytes8(uint64(i + 1)))})
+	}
+	// A confirmation below every held slot is dropped, while a higher one replaces the lowest.
+	q.hold(&orchestrator.Confirmation{Slot: 0, BlockRoot: [32]byte{31: 'z'}})
+	assert.Equal(t, true, q.heldConfirmation(0, [32]byte{31: 'z'}) == nil)
+	q.hold(&orchestrator.Confirmation{Slot: maxHeldConfirmations + 1, BlockRoot: [32]byte{31: 'y'}})
+	assert.Equal(t, maxHeldConfirmations, len(q.held))
+	assert.NotNil(t, q.heldConfirmation(maxHeldConfirmations+1, [32]byte{31: 'y'}))
+	assert.Equal(t, true, q.heldConfirmation(1, bytesutil.ToBytes32(bytesutil.Bytes8(1))) == nil)
+}
+
+func TestReceivePandoraConfirmations_InvalidBlockLeavesForkChoice(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := setupBeaconChain(t, beaconDB)
+	st, _ := testutil.DeterministicGenesisState(t, 1)
+
+	parent := testutil.NewBeaconBlock()
+	parentRoot, err := parent.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, service.cfg.ForkChoiceStore.ProcessBlock(ctx, 0, parentRoot, [32]byte{}, [32]byte{}, 0, 0))
+	require.NoError(t, beaconDB.SaveBlock(ctx, parent))
+	roots := [][32]byte{parentRoot}
+	for _, graffiti := range []byte{'a', 'b'} {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = 1
+		b.Block.ParentRoot = parentRoot[:]
+		b.Block.Body.Graffiti = bytesutil.PadTo([]byte{graffiti}, 32)
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, service.cfg.ForkChoiceStore.ProcessBlock(ctx, 1, r, parentRoot, [32]byte{}, 0, 0))
+		require.NoError(t, beaconDB.SaveBlock(ctx, b))
+		roots = append(roots, r)
+	}
+	for _, r := range roots {
+		require.NoError(t, beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Root: r[:]}))
+		require.NoError(t, beaconDB.SaveState(ctx, st, r))
+	}
+	service.genesisRoot = parentRoot
+	service.justifiedCheckpt = &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	service.finalizedCheckpt = &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	service.bestJustifiedCheckpt = &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	require.NoError(t, service.updateHead(ctx, []uint64{}))
+	head, err := service.HeadRoot(ctx)
+	require.NoError(t, err)
+	other := roots[1]
+	if bytesutil.ToBytes32(head) == other {
+		other = roots[2]
+	}
+
+	require.NoError(t, service.ReceivePandoraConfirmations(ctx, []*orchestrator.Confirmation{
+		{Slot: 1, BlockRoot: bytesutil.ToBytes32(head), Status: orchestrator.Invalid},
+	}))
+	assert.Equal(t, true, service.cfg.ForkChoiceStore.IsInvalid(bytesutil.ToBytes32(head)))
+	newHead, err := service.HeadRoot(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, other[:], newHead)
+}
+
+func waitForPendingWaiters(s *Service, root [32]byte, waiters int) error {
+	for i := 0; i < 100; i++ {
+		s.pendingBlocks.lock.Lock()
+		p, ok := s.pendingBlocks.blocks[root]
+		n := 0
+		if ok {
+			n = p.waiters
+		}
+		s.pendingBlocks.lock.Unlock()
+		if n == waiters {
+			return nil
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return errPandoraVerificationTimeout
+}
+
+func waitForPendingRoot(s *Service, slot types.Slot, root [32]byte) error {
+	for i := 0; i < 100; i++ {
+		for _, r := range s.PendingBlockRoots(slot) {
+			if r == root {
+				return nil
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return errPandoraVerificationTimeout
+}
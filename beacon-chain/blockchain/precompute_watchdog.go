@@ -0,0 +1,148 @@
+package blockchain
+
+import (
+	"sync"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/sirupsen/logrus"
+)
+
+// PrecomputationStatusFetcher defines a common interface for methods in blockchain service which
+// report whether the proposer precomputation of the current epoch is stalled.
+type PrecomputationStatusFetcher interface {
+	PrecomputationStatus() *PrecomputationStatus
+}
+
+// PrecomputationStatus describes the progress of the proposer list precomputation.
+type PrecomputationStatus struct {
+	// Enabled is false if the watchdog is disabled, in which case stalls are never reported.
+	Enabled bool
+	// LastPrecomputedEpoch is the latest epoch whose proposer list was precomputed.
+	LastPrecomputedEpoch types.Epoch
+	// Stalled is set while the proposer list of StalledEpoch is overdue.
+	Stalled      bool
+	StalledEpoch types.Epoch
+}
+
+// precomputationWatchdog tracks the proposer list precomputation, which is triggered for the next
+// epoch by the first block processed at or after the last slot of an epoch, and flags epochs whose
+// precomputation is overdue.
+type precomputationWatchdog struct {
+	stallSlots types.Slot
+
+	lock           sync.RWMutex
+	hasPrecomputed bool
+	lastEpoch      types.Epoch
+	stalled        bool
+	stalledEpoch   types.Epoch
+	// triggerSlot is the head slot at which the head was first seen to reach the last slot before
+	// triggerEpoch, from which the precomputation of triggerEpoch is due.
+	hasTrigger   bool
+	triggerEpoch types.Epoch
+	triggerSlot  types.Slot
+}
+
+func newPrecomputationWatchdog(stallSlots types.Slot) *precomputationWatchdog {
+	return &precomputationWatchdog{stallSlots: stallSlots}
+}
+
+// markPrecomputed records that the proposer list of an epoch was precomputed.
+func (w *precomputationWatchdog) markPrecomputed(epoch types.Epoch) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if !w.hasPrecomputed || epoch > w.lastEpoch {
+		w.hasPrecomputed = true
+		w.lastEpoch = epoch
+	}
+	if w.stalled && w.lastEpoch >= w.stalledEpoch {
+		log.WithField("epoch", w.stalledEpoch).Info("Proposer precomputation recovered")
+		w.stalled = false
+		proposerPrecomputationStalled.Set(0)
+	}
+}
+
+// check flags the current epoch as stalled if its proposer list has not been precomputed within
+// stallSlots slots of the head reaching the last slot of the previous epoch. Slots without blocks
+// delay the precomputation along with the head, so they are not reported as stalls.
+func (w *precomputationWatchdog) check(currentSlot, headSlot types.Slot) {
+	epoch := helpers.SlotToEpoch(currentSlot)
+	// The genesis epoch is never precomputed, and a node whose head is more than an epoch behind
+	// the clock is syncing, during which precomputation happens as blocks are processed.
+	if epoch == 0 || helpers.SlotToEpoch(headSlot)+1 < epoch {
+		return
+	}
+	epochStart, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return
+	}
+	// The block triggering the precomputation has not been processed yet.
+	if headSlot+1 < epochStart {
+		return
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.hasPrecomputed && w.lastEpoch >= epoch {
+		return
+	}
+	if !w.hasTrigger || w.triggerEpoch != epoch {
+		w.hasTrigger = true
+		w.triggerEpoch = epoch
+		w.triggerSlot = headSlot
+	}
+	if currentSlot < w.triggerSlot+w.stallSlots {
+		return
+	}
+	if w.stalled && w.stalledEpoch == epoch {
+		return
+	}
+	w.stalled = true
+	w.stalledEpoch = epoch
+	proposerPrecomputationStalled.Set(1)
+	proposerPrecomputationStalls.Inc()
+	log.WithFields(logrus.Fields{
+		"epoch":                epoch,
+		"lastPrecomputedEpoch": w.lastEpoch,
+		"slotsOverdue":         currentSlot - w.triggerSlot,
+	}).Warn("Proposer precomputation has not completed in time, state regeneration may be slow")
+}
+
+func (w *precomputationWatchdog) status() *PrecomputationStatus {
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+	return &PrecomputationStatus{
+		Enabled:              w.stallSlots > 0,
+		LastPrecomputedEpoch: w.lastEpoch,
+		Stalled:              w.stalled,
+		StalledEpoch:         w.stalledEpoch,
+	}
+}
+
+// PrecomputationStatus returns the progress of the proposer list precomputation.
+func (s *Service) PrecomputationStatus() *PrecomputationStatus {
+	return s.precomputeWatchdog.status()
+}
+
+// This routine checks the proposer list precomputation at every slot.
+func (s *Service) precomputationWatchdogRoutine() {
+	for s.genesisTime.IsZero() {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-time.After(time.Second):
+		}
+	}
+
+	st := s.SlotTimer().NewTicker(s.genesisTime)
+	defer st.Done()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case slot := <-st.C():
+			s.precomputeWatchdog.check(slot, s.HeadSlot())
+		}
+	}
+}
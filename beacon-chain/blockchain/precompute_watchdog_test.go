@@ -0,0 +1,77 @@
+package blockchain
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestPrecomputationWatchdog_ReportsOverdueEpoch(t *testing.T) {
+	hook := logTest.NewGlobal()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	w := newPrecomputationWatchdog(2)
+	w.markPrecomputed(1)
+
+	// The proposer list of epoch 2 is due at the last slot of epoch 1.
+	dueSlot := 2*slotsPerEpoch - 1
+	w.check(dueSlot+1, dueSlot)
+	assert.Equal(t, false, w.status().Stalled)
+
+	w.check(dueSlot+2, dueSlot)
+	status := w.status()
+	assert.Equal(t, true, status.Enabled)
+	assert.Equal(t, true, status.Stalled)
+	assert.Equal(t, types.Epoch(2), status.StalledEpoch)
+	assert.Equal(t, types.Epoch(1), status.LastPrecomputedEpoch)
+	require.LogsContain(t, hook, "Proposer precomputation has not completed in time")
+
+	// The stall is only reported once per epoch.
+	hook.Reset()
+	w.check(dueSlot+3, dueSlot)
+	require.LogsDoNotContain(t, hook, "Proposer precomputation has not completed in time")
+
+	w.markPrecomputed(2)
+	assert.Equal(t, false, w.status().Stalled)
+	require.LogsContain(t, hook, "Proposer precomputation recovered")
+}
+
+func TestPrecomputationWatchdog_IgnoresGenesisAndSyncing(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	w := newPrecomputationWatchdog(1)
+
+	w.check(slotsPerEpoch-1, 0)
+	assert.Equal(t, false, w.status().Stalled)
+
+	// The head is two epochs behind the clock while syncing.
+	w.check(3*slotsPerEpoch+1, slotsPerEpoch)
+	assert.Equal(t, false, w.status().Stalled)
+
+	w.check(3*slotsPerEpoch+1, 3*slotsPerEpoch-1)
+	assert.Equal(t, true, w.status().Stalled)
+}
+
+func TestPrecomputationWatchdog_WaitsForHeadAtEpochBoundary(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	w := newPrecomputationWatchdog(2)
+	w.markPrecomputed(1)
+
+	// The blocks around the boundary of epoch 2 are missing, so there is no block to trigger the
+	// precomputation and nothing is overdue.
+	for slot := 2 * slotsPerEpoch; slot < 2*slotsPerEpoch+5; slot++ {
+		w.check(slot, 2*slotsPerEpoch-3)
+		assert.Equal(t, false, w.status().Stalled)
+	}
+
+	// The first block of epoch 2 triggers the precomputation, which is due from its slot on.
+	headSlot := 2*slotsPerEpoch + 5
+	w.check(headSlot, headSlot)
+	w.check(headSlot+1, headSlot)
+	assert.Equal(t, false, w.status().Stalled)
+	w.check(headSlot+2, headSlot)
+	assert.Equal(t, true, w.status().Stalled)
+	assert.Equal(t, types.Epoch(2), w.status().StalledEpoch)
+}
@@ -14,7 +14,6 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/mputil"
-	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
 // getAttPreState retrieves the att pre state by either from the cache or the DB.
@@ -44,12 +43,12 @@ func (s *Service) getAttPreState(ctx context.Context, c *ethpb.Checkpoint) (ifac
 	}
 	if epochStartSlot > baseState.Slot() {
 		if featureconfig.Get().EnableNextSlotStateCache {
-			baseState, err = state.ProcessSlotsUsingNextSlotCache(ctx, baseState, c.Root, epochStartSlot)
+			baseState, err = state.ProcessSlotsUsingNextSlotCache(ctx, s.committeeCache, baseState, c.Root, epochStartSlot)
 			if err != nil {
 				return nil, errors.Wrapf(err, "could not process slots up to epoch %d", c.Epoch)
 			}
 		} else {
-			baseState, err = state.ProcessSlots(ctx, baseState, epochStartSlot)
+			baseState, err = state.ProcessSlots(ctx, s.committeeCache, baseState, epochStartSlot)
 			if err != nil {
 				return nil, errors.Wrapf(err, "could not process slots up to epoch %d", c.Epoch)
 			}
@@ -77,7 +76,7 @@ func (s *Service) getAttPreState(ctx context.Context, c *ethpb.Checkpoint) (ifac
 
 // verifyAttTargetEpoch validates attestation is from the current or previous epoch.
 func (s *Service) verifyAttTargetEpoch(_ context.Context, genesisTime, nowTime uint64, c *ethpb.Checkpoint) error {
-	currentSlot := types.Slot((nowTime - genesisTime) / params.BeaconConfig().SecondsPerSlot)
+	currentSlot := types.Slot((nowTime - genesisTime) / s.beaconConfig().SecondsPerSlot)
 	currentEpoch := helpers.SlotToEpoch(currentSlot)
 	var prevEpoch types.Epoch
 	// Prevents previous epoch under flow
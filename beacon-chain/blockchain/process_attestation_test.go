@@ -144,7 +144,7 @@ func TestStore_OnAttestation_Ok(t *testing.T) {
 	require.NoError(t, err)
 	tRoot := bytesutil.ToBytes32(att[0].Data.Target.Root)
 	copied := genesisState.Copy()
-	copied, err = state.ProcessSlots(ctx, copied, 1)
+	copied, err = state.ProcessSlots(ctx, nil, copied, 1)
 	require.NoError(t, err)
 	require.NoError(t, service.cfg.BeaconDB.SaveState(ctx, copied, tRoot))
 	require.NoError(t, service.cfg.ForkChoiceStore.ProcessBlock(ctx, 0, tRoot, tRoot, tRoot, 1, 1))
@@ -253,7 +253,7 @@ func TestStore_UpdateCheckpointState(t *testing.T) {
 	require.NoError(t, err)
 	s, err := helpers.StartSlot(newCheckpoint.Epoch)
 	require.NoError(t, err)
-	baseState, err = state.ProcessSlots(ctx, baseState, s)
+	baseState, err = state.ProcessSlots(ctx, nil, baseState, s)
 	require.NoError(t, err)
 	assert.Equal(t, returned.Slot(), baseState.Slot(), "Incorrectly returned base state")
 
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
@@ -89,12 +90,16 @@ func (s *Service) onBlock(ctx context.Context, signed *ethpb.SignedBeaconBlock,
 	}
 	b := signed.Block
 
+	if err := s.verifyPandoraConfirmation(ctx, b.Slot, blockRoot); err != nil {
+		return err
+	}
+
 	preState, err := s.getBlockPreState(ctx, b)
 	if err != nil {
 		return err
 	}
 
-	set, postState, err := state.ExecuteStateTransitionNoVerifyAnySig(ctx, preState, signed)
+	set, postState, err := state.ExecuteStateTransitionNoVerifyAnySig(ctx, s.committeeCache, preState, signed)
 	if err != nil {
 		return errors.Wrap(err, "could not execute state transition")
 	}
@@ -118,7 +123,7 @@ func (s *Service) onBlock(ctx context.Context, signed *ethpb.SignedBeaconBlock,
 			// with a custom deadline, therefore using the background context instead.
 			slotCtx, cancel := context.WithTimeout(context.Background(), slotDeadline)
 			defer cancel()
-			if err := state.UpdateNextSlotCache(slotCtx, blockRoot[:], postState); err != nil {
+			if err := state.UpdateNextSlotCache(slotCtx, s.committeeCache, blockRoot[:], postState); err != nil {
 				log.WithError(err).Debug("could not update next slot state cache")
 			}
 		}()
@@ -201,6 +206,15 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []*ethpb.SignedBeaconBl
 	}
 	b := blks[0].Block
 
+	for i, r := range blockRoots {
+		if blks[i] == nil || blks[i].Block == nil {
+			return nil, nil, errors.New("nil block")
+		}
+		if err := s.verifyPandoraConfirmation(ctx, blks[i].Block.Slot, r); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	// Retrieve incoming block's pre state.
 	if err := s.verifyBlkPreState(ctx, b); err != nil {
 		return nil, nil, err
@@ -223,7 +237,7 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []*ethpb.SignedBeaconBl
 	var set *bls.SignatureSet
 	boundaries := make(map[[32]byte]iface.BeaconState)
 	for i, b := range blks {
-		set, preState, err = state.ExecuteStateTransitionNoVerifyAnySig(ctx, preState, b)
+		set, preState, err = state.ExecuteStateTransitionNoVerifyAnySig(ctx, s.committeeCache, preState, b)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -306,19 +320,20 @@ func (s *Service) handleBlockAfterBatchVerify(ctx context.Context, signed *ethpb
 func (s *Service) handleEpochBoundary(ctx context.Context, postState iface.BeaconState) error {
 	if postState.Slot()+1 == s.nextEpochBoundarySlot {
 		// Update caches for the next epoch at epoch boundary slot - 1.
-		if err := helpers.UpdateCommitteeCache(postState, helpers.NextEpoch(postState)); err != nil {
+		if err := helpers.UpdateCommitteeCache(s.committeeCache, postState, helpers.NextEpoch(postState)); err != nil {
 			return err
 		}
 		copied := postState.Copy()
-		copied, err := state.ProcessSlots(ctx, copied, copied.Slot()+1)
+		copied, err := state.ProcessSlots(ctx, s.committeeCache, copied, copied.Slot()+1)
 		if err != nil {
 			return err
 		}
-		if err := helpers.UpdateProposerIndicesInCache(copied); err != nil {
+		if err := helpers.UpdateProposerIndicesInCache(s.committeeCache, copied); err != nil {
 			return err
 		}
+		s.precomputeWatchdog.markPrecomputed(helpers.CurrentEpoch(copied))
 	} else if postState.Slot() >= s.nextEpochBoundarySlot {
-		if err := reportEpochMetrics(ctx, postState, s.head.state); err != nil {
+		if err := reportEpochMetrics(ctx, s.committeeCache, postState, s.head.state); err != nil {
 			return err
 		}
 		var err error
@@ -329,19 +344,21 @@ func (s *Service) handleEpochBoundary(ctx context.Context, postState iface.Beaco
 
 		// Update caches at epoch boundary slot.
 		// The following updates have short cut to return nil cheaply if fulfilled during boundary slot - 1.
-		if err := helpers.UpdateCommitteeCache(postState, helpers.CurrentEpoch(postState)); err != nil {
+		if err := helpers.UpdateCommitteeCache(s.committeeCache, postState, helpers.CurrentEpoch(postState)); err != nil {
 			return err
 		}
-		if err := helpers.UpdateProposerIndicesInCache(postState); err != nil {
+		if err := helpers.UpdateProposerIndicesInCache(s.committeeCache, postState); err != nil {
 			return err
 		}
+		s.precomputeWatchdog.markPrecomputed(helpers.CurrentEpoch(postState))
 	}
 
 	return nil
 }
 
 // This feeds in the block and block's attestations to fork choice store. It's allows fork choice store
-// to gain information on the most current chain.
+// to gain information on the most current chain. The proposer and the attesters of the block are
+// also marked live in the liveness cache.
 func (s *Service) insertBlockAndAttestationsToForkChoiceStore(ctx context.Context, blk *ethpb.BeaconBlock, root [32]byte,
 	st iface.BeaconState) error {
 	fCheckpoint := st.FinalizedCheckpoint()
@@ -349,9 +366,12 @@ func (s *Service) insertBlockAndAttestationsToForkChoiceStore(ctx context.Contex
 	if err := s.insertBlockToForkChoiceStore(ctx, blk, root, fCheckpoint, jCheckpoint); err != nil {
 		return err
 	}
+	if s.livenessCache != nil {
+		s.livenessCache.MarkLive(helpers.SlotToEpoch(blk.Slot), blk.ProposerIndex)
+	}
 	// Feed in block's attestations to fork choice store.
 	for _, a := range blk.Body.Attestations {
-		committee, err := helpers.BeaconCommitteeFromState(st, a.Data.Slot, a.Data.CommitteeIndex)
+		committee, err := helpers.BeaconCommitteeFromState(s.committeeCache, st, a.Data.Slot, a.Data.CommitteeIndex)
 		if err != nil {
 			return err
 		}
@@ -360,6 +380,13 @@ func (s *Service) insertBlockAndAttestationsToForkChoiceStore(ctx context.Contex
 			return err
 		}
 		s.cfg.ForkChoiceStore.ProcessAttestation(ctx, indices, bytesutil.ToBytes32(a.Data.BeaconBlockRoot), a.Data.Target.Epoch)
+		if s.livenessCache != nil {
+			live := make([]types.ValidatorIndex, len(indices))
+			for i, index := range indices {
+				live[i] = types.ValidatorIndex(index)
+			}
+			s.livenessCache.MarkLive(a.Data.Target.Epoch, live...)
+		}
 	}
 	return nil
 }
@@ -8,7 +8,11 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/summary"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/shared/attestationutil"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -18,9 +22,9 @@ import (
 	"go.opencensus.io/trace"
 )
 
-// CurrentSlot returns the current slot based on time.
+// CurrentSlot returns the current slot according to the slot timer.
 func (s *Service) CurrentSlot() types.Slot {
-	return helpers.CurrentSlot(uint64(s.genesisTime.Unix()))
+	return s.SlotTimer().CurrentSlot(s.genesisTime)
 }
 
 // getBlockPreState returns the pre state of an incoming block. It uses the parent root of the block
@@ -131,12 +135,25 @@ func (s *Service) verifyBlkFinalizedSlot(b *ethpb.BeaconBlock) error {
 	return nil
 }
 
+// verifyPandoraConfirmation rejects a block whose paired Pandora block was reported invalid
+// by the orchestrator. Blocks the orchestrator has not reported on yet are accepted.
+func (s *Service) verifyPandoraConfirmation(ctx context.Context, slot types.Slot, blockRoot [32]byte) error {
+	confirmation, err := s.pandoraConfirmation(ctx, slot, blockRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get orchestrator confirmation")
+	}
+	if confirmation != nil && confirmation.Status == orchestrator.Invalid {
+		return fmt.Errorf("block %#x was marked invalid by the orchestrator", blockRoot)
+	}
+	return nil
+}
+
 // shouldUpdateCurrentJustified prevents bouncing attack, by only update conflicting justified
 // checkpoints in the fork choice if in the early slots of the epoch.
 // Otherwise, delay incorporation of new justified checkpoint until next epoch boundary.
 // See https://ethresear.ch/t/prevention-of-bouncing-attack-on-ffg/6114 for more detailed analysis and discussion.
 func (s *Service) shouldUpdateCurrentJustified(ctx context.Context, newJustifiedCheckpt *ethpb.Checkpoint) (bool, error) {
-	if helpers.SlotsSinceEpochStarts(s.CurrentSlot()) < params.BeaconConfig().SafeSlotsToUpdateJustified {
+	if helpers.SlotsSinceEpochStarts(s.CurrentSlot()) < s.beaconConfig().SafeSlotsToUpdateJustified {
 		return true, nil
 	}
 	var newJustifiedBlockSigned *ethpb.SignedBeaconBlock
@@ -241,10 +258,69 @@ func (s *Service) updateFinalized(ctx context.Context, cp *ethpb.Checkpoint) err
 	if err := s.cfg.StateGen.MigrateToCold(ctx, fRoot); err != nil {
 		return errors.Wrap(err, "could not migrate to cold")
 	}
+	if err := s.archiveFinalizedBalances(ctx, cp); err != nil {
+		return errors.Wrap(err, "could not archive finalized balances")
+	}
+	if err := s.archiveEpochSummary(ctx, cp); err != nil {
+		return errors.Wrap(err, "could not archive epoch summary")
+	}
+	// Blocks up to the finalized epoch are no longer received, so the confirmations held for them
+	// are dropped.
+	finalizedSlot, err := helpers.StartSlot(cp.Epoch)
+	if err != nil {
+		return err
+	}
+	s.pendingBlocks.evictHeld(finalizedSlot)
+	// Blocks before the finalized epoch are no longer processed, so their committees are dropped
+	// before any block of the new finalized chain is processed.
+	if s.committeeCache != nil {
+		s.committeeCache.OnFinalized(cp.Epoch, fRoot)
+	}
+	// The caches of the other services settle their entries asynchronously.
+	s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.Finalized,
+		Data: &statefeed.FinalizedData{
+			Epoch: cp.Epoch,
+			Root:  fRoot,
+		},
+	})
 
 	return nil
 }
 
+// archiveFinalizedBalances saves the validator balances of the finalized checkpoint state under
+// the checkpoint epoch, so the balance history can be served without replaying states.
+func (s *Service) archiveFinalizedBalances(ctx context.Context, cp *ethpb.Checkpoint) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.archiveFinalizedBalances")
+	defer span.End()
+
+	fState, err := s.cfg.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(cp.Root))
+	if err != nil {
+		return err
+	}
+	if fState == nil {
+		return errors.New("nil finalized state")
+	}
+	return s.cfg.BeaconDB.SaveValidatorBalances(ctx, cp.Epoch, fState.Balances())
+}
+
+// archiveEpochSummary saves the summary of the finalized checkpoint epoch. It is computed from
+// the checkpoint state, which is the state of the checkpoint block advanced to the epoch start.
+func (s *Service) archiveEpochSummary(ctx context.Context, cp *ethpb.Checkpoint) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.archiveEpochSummary")
+	defer span.End()
+
+	cpState, err := s.getAttPreState(ctx, cp)
+	if err != nil {
+		return err
+	}
+	sum, err := summary.New(ctx, s.committeeCache, cpState)
+	if err != nil {
+		return err
+	}
+	return s.cfg.BeaconDB.SaveEpochSummary(ctx, sum)
+}
+
 // ancestor returns the block root of an ancestry block from the input block root.
 //
 // Spec pseudocode definition:
@@ -449,7 +525,7 @@ func (s *Service) deletePoolAtts(atts []*ethpb.Attestation) error {
 // This ensures that the input root defaults to using genesis root instead of zero hashes. This is needed for handling
 // fork choice justification routine.
 func (s *Service) ensureRootNotZeros(root [32]byte) [32]byte {
-	if root == params.BeaconConfig().ZeroHash {
+	if root == s.beaconConfig().ZeroHash {
 		return s.genesisRoot
 	}
 	return root
@@ -11,12 +11,17 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	blockchainTesting "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
@@ -158,7 +163,7 @@ func TestStore_OnBlockBatch(t *testing.T) {
 	for i := 1; i < 10; i++ {
 		b, err := testutil.GenerateFullBlock(bState, keys, testutil.DefaultBlockGenConfig(), types.Slot(i))
 		require.NoError(t, err)
-		bState, err = state.ExecuteStateTransition(ctx, bState, b)
+		bState, err = state.ExecuteStateTransition(ctx, nil, bState, b)
 		require.NoError(t, err)
 		if i == 1 {
 			firstState = bState.Copy()
@@ -861,6 +866,43 @@ func TestVerifyBlkDescendant(t *testing.T) {
 	}
 }
 
+func TestStore_OnBlock_RejectsInvalidPandoraBlock(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service, err := NewService(ctx, &Config{BeaconDB: beaconDB, StateGen: stategen.New(beaconDB)})
+	require.NoError(t, err)
+
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 1
+	r, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SavePandoraConfirmations(ctx, []*orchestrator.Confirmation{
+		{Slot: 1, BlockRoot: r, Status: orchestrator.Invalid},
+	}))
+
+	err = service.onBlock(ctx, b, r)
+	assert.ErrorContains(t, "marked invalid by the orchestrator", err)
+	_, _, err = service.onBlockBatch(ctx, []*ethpb.SignedBeaconBlock{b}, [][32]byte{r})
+	assert.ErrorContains(t, "marked invalid by the orchestrator", err)
+}
+
+func TestVerifyPandoraConfirmation(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service, err := NewService(ctx, &Config{BeaconDB: beaconDB})
+	require.NoError(t, err)
+
+	verified, invalid, unknown := [32]byte{'a'}, [32]byte{'b'}, [32]byte{'c'}
+	require.NoError(t, beaconDB.SavePandoraConfirmations(ctx, []*orchestrator.Confirmation{
+		{Slot: 1, BlockRoot: verified, Status: orchestrator.Verified},
+		{Slot: 2, BlockRoot: invalid, Status: orchestrator.Invalid},
+	}))
+
+	assert.NoError(t, service.verifyPandoraConfirmation(ctx, 1, verified))
+	assert.NoError(t, service.verifyPandoraConfirmation(ctx, 3, unknown))
+	assert.ErrorContains(t, "marked invalid by the orchestrator", service.verifyPandoraConfirmation(ctx, 2, invalid))
+}
+
 func TestUpdateJustifiedInitSync(t *testing.T) {
 	beaconDB := testDB.SetupDB(t)
 	ctx := context.Background()
@@ -915,6 +957,7 @@ func TestHandleEpochBoundary_UpdateFirstSlot(t *testing.T) {
 	require.NoError(t, s.SetSlot(2*params.BeaconConfig().SlotsPerEpoch))
 	require.NoError(t, service.handleEpochBoundary(ctx, s))
 	require.Equal(t, 3*params.BeaconConfig().SlotsPerEpoch, service.nextEpochBoundarySlot)
+	require.Equal(t, types.Epoch(2), service.PrecomputationStatus().LastPrecomputedEpoch)
 }
 
 func TestOnBlock_CanFinalize(t *testing.T) {
@@ -927,6 +970,7 @@ func TestOnBlock_CanFinalize(t *testing.T) {
 		StateGen:        stategen.New(beaconDB),
 		ForkChoiceStore: protoarray.New(0, 0, [32]byte{}),
 		DepositCache:    depositCache,
+		StateNotifier:   &blockchainTesting.MockStateNotifier{RecordEvents: true},
 	}
 	service, err := NewService(ctx, cfg)
 	require.NoError(t, err)
@@ -951,6 +995,38 @@ func TestOnBlock_CanFinalize(t *testing.T) {
 	}
 	require.Equal(t, types.Epoch(3), service.CurrentJustifiedCheckpt().Epoch)
 	require.Equal(t, types.Epoch(2), service.FinalizedCheckpt().Epoch)
+
+	// The balances of the finalized checkpoint state are archived.
+	fState, err := service.cfg.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(service.FinalizedCheckpt().Root))
+	require.NoError(t, err)
+	balances, err := beaconDB.ValidatorBalances(ctx, 2)
+	require.NoError(t, err)
+	assert.DeepEqual(t, fState.Balances(), balances)
+
+	// So is the summary of the finalized epoch, whose previous epoch was fully attested.
+	sum, err := beaconDB.EpochSummary(ctx, 2)
+	require.NoError(t, err)
+	require.NotNil(t, sum)
+	assert.Equal(t, types.Epoch(2), sum.Epoch)
+	assert.Equal(t, uint64(32), sum.ValidatorCount)
+	assert.Equal(t, sum.EligibleBalance, sum.TargetAttestingBalance)
+	assert.Equal(t, float64(1), sum.ParticipationRate())
+
+	// The committees of the epochs before the finalized epoch are invalidated.
+	for _, e := range service.committeeCache.Epochs() {
+		assert.Equal(t, true, e >= 2, "Committees of epoch %d are still cached", e)
+	}
+
+	// The caches of the other services are notified of the new finalized checkpoint.
+	var finalized *statefeed.FinalizedData
+	for _, ev := range cfg.StateNotifier.(*blockchainTesting.MockStateNotifier).ReceivedEvents() {
+		if ev.Type == statefeed.Finalized {
+			finalized = ev.Data.(*statefeed.FinalizedData)
+		}
+	}
+	require.NotNil(t, finalized)
+	assert.Equal(t, types.Epoch(2), finalized.Epoch)
+	assert.Equal(t, bytesutil.ToBytes32(service.FinalizedCheckpt().Root), finalized.Root)
 }
 
 func TestInsertFinalizedDeposits(t *testing.T) {
@@ -995,3 +1071,48 @@ func TestInsertFinalizedDeposits(t *testing.T) {
 		assert.DeepEqual(t, [][]byte(nil), d.Proof, "Proofs are not empty")
 	}
 }
+
+func TestInsertBlockAndAttestations_MarksLiveness(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	cfg := &Config{
+		BeaconDB:        beaconDB,
+		StateGen:        stategen.New(beaconDB),
+		ForkChoiceStore: protoarray.New(0, 0, [32]byte{}),
+	}
+	service, err := NewService(ctx, cfg)
+	require.NoError(t, err)
+	service.finalizedCheckpt = &ethpb.Checkpoint{Root: make([]byte, 32)}
+
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	committee, err := helpers.BeaconCommitteeFromState(nil, st, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, true, len(committee) > 1)
+	aggregationBits := bitfield.NewBitlist(uint64(len(committee)))
+	aggregationBits.SetBitAt(0, true)
+	// The proposer does not attest in the included attestation.
+	attesters := make(map[types.ValidatorIndex]bool, len(committee))
+	for _, index := range committee {
+		attesters[index] = true
+	}
+	proposer := types.ValidatorIndex(0)
+	for attesters[proposer] {
+		proposer++
+	}
+
+	blk := testutil.NewBeaconBlock()
+	blk.Block.ProposerIndex = proposer
+	blk.Block.Body.Attestations = []*ethpb.Attestation{
+		testutil.HydrateAttestation(&ethpb.Attestation{
+			AggregationBits: aggregationBits,
+			Data:            &ethpb.AttestationData{Slot: 0, CommitteeIndex: 0},
+		}),
+	}
+	r, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, service.insertBlockAndAttestationsToForkChoiceStore(ctx, blk.Block, r, st))
+
+	live, ok := service.LivenessCache().Liveness(0, []types.ValidatorIndex{proposer, committee[0], committee[1]})
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, []bool{true, true, false}, live)
+}
@@ -37,6 +37,12 @@ func (s *Service) ReceiveBlock(ctx context.Context, block *ethpb.SignedBeaconBlo
 	receivedTime := timeutils.Now()
 	blockCopy := stateV0.CopySignedBeaconBlock(block)
 
+	// Hold the block until the orchestrator confirms its paired Pandora block.
+	if err := s.waitForPandoraConfirmations(ctx, []*ethpb.SignedBeaconBlock{blockCopy}, [][32]byte{blockRoot}); err != nil {
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+
 	// Apply state transition on the new block.
 	if err := s.onBlock(ctx, blockCopy, blockRoot); err != nil {
 		err := errors.Wrap(err, "could not process block")
@@ -91,6 +97,12 @@ func (s *Service) ReceiveBlockBatch(ctx context.Context, blocks []*ethpb.SignedB
 	ctx, span := trace.StartSpan(ctx, "blockChain.ReceiveBlockBatch")
 	defer span.End()
 
+	// Hold the batch until the orchestrator confirms the paired Pandora blocks.
+	if err := s.waitForPandoraConfirmations(ctx, blocks, blkRoots); err != nil {
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+
 	// Apply state transition on the incoming newly received blockCopy without verifying its BLS contents.
 	fCheckpoints, jCheckpoints, err := s.onBlockBatch(ctx, blocks, blkRoots)
 	if err != nil {
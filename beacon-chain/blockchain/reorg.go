@@ -0,0 +1,68 @@
+package blockchain
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/reorg"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// reorgEvent returns the event of a reorg from the old head to the new head. The chains of both
+// heads are walked back from the head with the higher slot until they meet at their common
+// ancestor, counting the blocks of the old chain passed on the way.
+func (s *Service) reorgEvent(ctx context.Context, oldRoot, newRoot [32]byte, newSlot types.Slot) (*reorg.Event, error) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.reorgEvent")
+	defer span.End()
+
+	block := func(root [32]byte) (*ethpb.BeaconBlock, error) {
+		b, err := s.cfg.BeaconDB.Block(ctx, root)
+		if err != nil {
+			return nil, err
+		}
+		if b == nil || b.Block == nil {
+			return nil, errors.Errorf("missing block %#x", root)
+		}
+		return b.Block, nil
+	}
+	parent := func(root [32]byte) ([32]byte, types.Slot, error) {
+		b, err := block(root)
+		if err != nil {
+			return [32]byte{}, 0, err
+		}
+		parentRoot := bytesutil.ToBytes32(b.ParentRoot)
+		pb, err := block(parentRoot)
+		if err != nil {
+			return [32]byte{}, 0, err
+		}
+		return parentRoot, pb.Slot, nil
+	}
+
+	// The slot of the old head is read from its block, HeadSlot reports 0 while no head state is set.
+	oldHead, err := block(oldRoot)
+	if err != nil {
+		return nil, err
+	}
+	oldSlot := oldHead.Slot
+	oldAncestor, oldAncestorSlot := oldRoot, oldSlot
+	newAncestor, newAncestorSlot := newRoot, newSlot
+	var depth uint64
+	for oldAncestor != newAncestor {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if oldAncestorSlot >= newAncestorSlot {
+			oldAncestor, oldAncestorSlot, err = parent(oldAncestor)
+			depth++
+		} else {
+			newAncestor, newAncestorSlot, err = parent(newAncestor)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return reorg.New(oldRoot, oldSlot, newRoot, newSlot, oldAncestorSlot, depth), nil
+}
@@ -60,11 +60,17 @@ type Service struct {
 	nextEpochBoundarySlot types.Slot
 	boundaryRoots         [][32]byte
 	checkpointStateCache  *cache.CheckpointStateCache
+	committeeCache        *cache.CommitteeCache
+	livenessCache         *cache.LivenessCache
 	initSyncBlocks        map[[32]byte]*ethpb.SignedBeaconBlock
 	initSyncBlocksLock    sync.RWMutex
 	justifiedBalances     []uint64
 	justifiedBalancesLock sync.RWMutex
 	wsVerified            bool
+	pendingBlocks         *pendingBlockQueue
+	precomputeWatchdog    *precomputationWatchdog
+	canonicalRoots        *canonicalRootIndex
+	slotTimer             SlotTimer
 }
 
 // Config options for the service.
@@ -84,23 +90,54 @@ type Config struct {
 	StateGen          *stategen.State
 	WspBlockRoot      []byte
 	WspEpoch          types.Epoch
+	// VerifyPandoraBlocks holds received blocks until the orchestrator confirms their Pandora block
+	// through the ConfirmPandoraBlockHashes endpoint, which the RPC service serves to the node's
+	// PandoraConfirmationReceiver.
+	VerifyPandoraBlocks        bool
+	PandoraVerificationTimeout time.Duration
+	// PrecomputationStallSlots is the number of slots after which an overdue proposer precomputation
+	// is reported. Zero disables the watchdog.
+	PrecomputationStallSlots types.Slot
+	// SlotTimer is the source of slot time. The wall clock is used if it is not set.
+	SlotTimer SlotTimer
+	// ChainConfig is the beacon chain config of the service. The global config is used if it is
+	// not set.
+	ChainConfig params.ChainConfig
 }
 
 // NewService instantiates a new block service instance that will
 // be registered into a running beacon node.
 func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 	ctx, cancel := context.WithCancel(ctx)
+	slotTimer := cfg.SlotTimer
+	if slotTimer == nil {
+		slotTimer = NewClockSlotTimer(cfg.ChainConfig)
+	}
 	return &Service{
 		cfg:                  cfg,
 		ctx:                  ctx,
 		cancel:               cancel,
 		boundaryRoots:        [][32]byte{},
 		checkpointStateCache: cache.NewCheckpointStateCache(),
+		committeeCache:       cache.NewCommitteesCache(),
+		livenessCache:        cache.NewLivenessCache(),
 		initSyncBlocks:       make(map[[32]byte]*ethpb.SignedBeaconBlock),
 		justifiedBalances:    make([]uint64, 0),
+		pendingBlocks:        newPendingBlockQueue(),
+		precomputeWatchdog:   newPrecomputationWatchdog(cfg.PrecomputationStallSlots),
+		canonicalRoots:       newCanonicalRootIndex(params.BeaconConfigOf(cfg.ChainConfig).SlotsPerHistoricalRoot),
+		slotTimer:            slotTimer,
 	}, nil
 }
 
+// beaconConfig returns the beacon chain config of the service.
+func (s *Service) beaconConfig() *params.BeaconChainConfig {
+	if s.cfg == nil {
+		return params.BeaconConfig()
+	}
+	return params.BeaconConfigOf(s.cfg.ChainConfig)
+}
+
 // Start a blockchain service's main event loop.
 func (s *Service) Start() {
 	// For running initial sync with state cache, in an event of restart, we use
@@ -115,7 +152,7 @@ func (s *Service) Start() {
 	// Before the first finalized epoch, in the current epoch,
 	// the finalized root is defined as zero hashes instead of genesis root hash.
 	// We want to use genesis root to retrieve for state.
-	if r == params.BeaconConfig().ZeroHash {
+	if r == s.beaconConfig().ZeroHash {
 		genesisBlock, err := s.cfg.BeaconDB.GenesisBlock(s.ctx)
 		if err != nil {
 			log.Fatalf("Could not fetch finalized cp: %v", err)
@@ -238,6 +275,9 @@ func (s *Service) Start() {
 	}
 
 	go s.processAttestationsRoutine(attestationProcessorSubscribed)
+	if s.cfg.PrecomputationStallSlots > 0 {
+		go s.precomputationWatchdogRoutine()
+	}
 }
 
 // processChainStartTime initializes a series of deposits from the ChainStart deposits in the eth1
@@ -294,10 +334,10 @@ func (s *Service) initializeBeaconChain(
 	s.cfg.ChainStartFetcher.ClearPreGenesisData()
 
 	// Update committee shuffled indices for genesis epoch.
-	if err := helpers.UpdateCommitteeCache(genesisState, 0 /* genesis epoch */); err != nil {
+	if err := helpers.UpdateCommitteeCache(s.committeeCache, genesisState, 0 /* genesis epoch */); err != nil {
 		return nil, err
 	}
-	if err := helpers.UpdateProposerIndicesInCache(genesisState); err != nil {
+	if err := helpers.UpdateProposerIndicesInCache(s.committeeCache, genesisState); err != nil {
 		return nil, err
 	}
 
@@ -316,14 +356,19 @@ func (s *Service) Stop() error {
 		}
 	}
 
-	// Save initial sync cached blocks to the DB before stop.
-	return s.cfg.BeaconDB.SaveBlocks(s.ctx, s.getInitSyncBlocks())
+	// Save initial sync cached blocks to the DB before stop, which may take a while after a large batch.
+	return s.cfg.BeaconDB.SaveBlocksWithProgress(s.ctx, s.getInitSyncBlocks(), func(saved, total int) {
+		log.WithFields(logrus.Fields{
+			"saved": saved,
+			"total": total,
+		}).Debug("Saving initial sync blocks")
+	})
 }
 
 // Status always returns nil unless there is an error condition that causes
 // this service to be unhealthy.
 func (s *Service) Status() error {
-	if s.genesisRoot == params.BeaconConfig().ZeroHash {
+	if s.genesisRoot == s.beaconConfig().ZeroHash {
 		return errors.New("genesis state has not been created")
 	}
 	if runtime.NumGoroutine() > s.cfg.MaxRoutines {
@@ -332,6 +377,17 @@ func (s *Service) Status() error {
 	return nil
 }
 
+// LivenessCache returns the cache of the validators observed to be active in recent epochs.
+func (s *Service) LivenessCache() *cache.LivenessCache {
+	return s.livenessCache
+}
+
+// CommitteeCache returns the committee cache of the service, which the state transitions of the
+// service read and fill and which is invalidated on finality.
+func (s *Service) CommitteeCache() *cache.CommitteeCache {
+	return s.committeeCache
+}
+
 // This gets called when beacon chain is first initialized to save genesis data (state, block, and more) in db.
 func (s *Service) saveGenesisData(ctx context.Context, genesisState iface.BeaconState) error {
 	if err := s.cfg.BeaconDB.SaveGenesisData(ctx, genesisState); err != nil {
@@ -364,7 +420,7 @@ func (s *Service) saveGenesisData(ctx context.Context, genesisState iface.Beacon
 	if err := s.cfg.ForkChoiceStore.ProcessBlock(ctx,
 		genesisBlk.Block.Slot,
 		genesisBlkRoot,
-		params.BeaconConfig().ZeroHash,
+		s.beaconConfig().ZeroHash,
 		[32]byte{},
 		genesisCheckpoint.Epoch,
 		genesisCheckpoint.Epoch); err != nil {
@@ -0,0 +1,233 @@
+package blockchain
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+// SlotTimer is the source of slot time of the blockchain service. The current slot, the per slot
+// routines and the epoch start times handed to the orchestrator are all derived from it. Besides
+// the wall clock, a simulated and a manually driven timer let integration tests of the
+// Vanguard/Pandora pairing run through epoch transitions deterministically.
+type SlotTimer interface {
+	// CurrentSlot returns the current slot of a chain with the given genesis time.
+	CurrentSlot(genesisTime time.Time) types.Slot
+	// SlotStartTime returns the time at which the slot of a chain with the given genesis time starts.
+	SlotStartTime(genesisTime time.Time, slot types.Slot) time.Time
+	// NewTicker returns a ticker firing the slot number at the start of every slot.
+	NewTicker(genesisTime time.Time) slotutil.Ticker
+}
+
+// SlotTimeFetcher retrieves slot start times from the slot timer of the blockchain service.
+type SlotTimeFetcher interface {
+	SlotStartTime(slot types.Slot) time.Time
+}
+
+// clockSlotTimer follows the wall clock and the slot duration of its chain config.
+type clockSlotTimer struct {
+	chainConfig params.ChainConfig
+}
+
+// NewClockSlotTimer returns the default slot timer, which follows the wall clock. The global config
+// is used if the chain config is nil.
+func NewClockSlotTimer(chainConfig params.ChainConfig) SlotTimer {
+	return clockSlotTimer{chainConfig: chainConfig}
+}
+
+// CurrentSlot --
+func (t clockSlotTimer) CurrentSlot(genesisTime time.Time) types.Slot {
+	since := timeutils.Now().Unix() - genesisTime.Unix()
+	if since < 0 {
+		return 0
+	}
+	return types.Slot(uint64(since) / t.secondsPerSlot())
+}
+
+// SlotStartTime --
+func (t clockSlotTimer) SlotStartTime(genesisTime time.Time, slot types.Slot) time.Time {
+	return genesisTime.Add(time.Duration(uint64(slot)*t.secondsPerSlot()) * time.Second)
+}
+
+// NewTicker --
+func (t clockSlotTimer) NewTicker(genesisTime time.Time) slotutil.Ticker {
+	return slotutil.NewSlotTicker(genesisTime, t.secondsPerSlot())
+}
+
+func (t clockSlotTimer) secondsPerSlot() uint64 {
+	return params.BeaconConfigOf(t.chainConfig).SecondsPerSlot
+}
+
+// SimulatedSlotTimer follows the wall clock with a slot duration independent of the chain
+// config, so that tests pass through many slots and epochs in a short time.
+type SimulatedSlotTimer struct {
+	slotDuration time.Duration
+}
+
+// NewSimulatedSlotTimer returns a slot timer whose slots last the given duration.
+func NewSimulatedSlotTimer(slotDuration time.Duration) (*SimulatedSlotTimer, error) {
+	if slotDuration <= 0 {
+		return nil, errors.New("simulated slot duration must be positive")
+	}
+	return &SimulatedSlotTimer{slotDuration: slotDuration}, nil
+}
+
+// CurrentSlot --
+func (t *SimulatedSlotTimer) CurrentSlot(genesisTime time.Time) types.Slot {
+	since := timeutils.Since(genesisTime)
+	if since < 0 {
+		return 0
+	}
+	return types.Slot(since / t.slotDuration)
+}
+
+// SlotStartTime --
+func (t *SimulatedSlotTimer) SlotStartTime(genesisTime time.Time, slot types.Slot) time.Time {
+	return genesisTime.Add(time.Duration(slot) * t.slotDuration)
+}
+
+// NewTicker --
+func (t *SimulatedSlotTimer) NewTicker(genesisTime time.Time) slotutil.Ticker {
+	ticker := &simulatedTicker{
+		c:    make(chan types.Slot),
+		done: make(chan struct{}),
+	}
+	go func() {
+		slot := t.CurrentSlot(genesisTime)
+		if timeutils.Since(genesisTime) >= 0 {
+			slot++
+		}
+		for {
+			select {
+			case <-time.After(timeutils.Until(t.SlotStartTime(genesisTime, slot))):
+				select {
+				case ticker.c <- slot:
+				case <-ticker.done:
+					return
+				}
+				slot++
+			case <-ticker.done:
+				return
+			}
+		}
+	}()
+	return ticker
+}
+
+type simulatedTicker struct {
+	c    chan types.Slot
+	done chan struct{}
+	once sync.Once
+}
+
+// C --
+func (t *simulatedTicker) C() <-chan types.Slot {
+	return t.c
+}
+
+// Done --
+func (t *simulatedTicker) Done() {
+	t.once.Do(func() {
+		close(t.done)
+	})
+}
+
+// ManualSlotTimer only advances when its slot is set, for instance by a test harness over the
+// debug RPC endpoints. Slot start times are the nominal ones of the chain config.
+type ManualSlotTimer struct {
+	lock    sync.Mutex
+	slot    types.Slot
+	tickers map[*manualTicker]bool
+	clock   clockSlotTimer
+}
+
+// NewManualSlotTimer returns a slot timer which stays at the genesis slot until it is advanced. The
+// global config is used if the chain config is nil.
+func NewManualSlotTimer(chainConfig params.ChainConfig) *ManualSlotTimer {
+	return &ManualSlotTimer{
+		tickers: make(map[*manualTicker]bool),
+		clock:   clockSlotTimer{chainConfig: chainConfig},
+	}
+}
+
+// CurrentSlot --
+func (t *ManualSlotTimer) CurrentSlot(_ time.Time) types.Slot {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.slot
+}
+
+// SlotStartTime --
+func (t *ManualSlotTimer) SlotStartTime(genesisTime time.Time, slot types.Slot) time.Time {
+	return t.clock.SlotStartTime(genesisTime, slot)
+}
+
+// NewTicker --
+func (t *ManualSlotTimer) NewTicker(_ time.Time) slotutil.Ticker {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	ticker := &manualTicker{
+		timer: t,
+		c:     make(chan types.Slot, 1),
+	}
+	t.tickers[ticker] = true
+	return ticker
+}
+
+// SetSlot moves the timer to the given slot and fires every ticker. The slot can not move back.
+// Like a wall clock ticker whose receiver falls behind, a ticker which has not consumed its
+// previous tick only receives the latest slot.
+func (t *ManualSlotTimer) SetSlot(slot types.Slot) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if slot < t.slot {
+		return errors.Errorf("slot %d is before the current slot %d", slot, t.slot)
+	}
+	if slot == t.slot {
+		return nil
+	}
+	t.slot = slot
+	for ticker := range t.tickers {
+		select {
+		case <-ticker.c:
+		default:
+		}
+		ticker.c <- slot
+	}
+	return nil
+}
+
+type manualTicker struct {
+	timer *ManualSlotTimer
+	c     chan types.Slot
+}
+
+// C --
+func (t *manualTicker) C() <-chan types.Slot {
+	return t.c
+}
+
+// Done --
+func (t *manualTicker) Done() {
+	t.timer.lock.Lock()
+	defer t.timer.lock.Unlock()
+	delete(t.timer.tickers, t)
+}
+
+// SlotTimer returns the slot timer of the service, which is the wall clock unless configured otherwise.
+func (s *Service) SlotTimer() SlotTimer {
+	if s.slotTimer == nil {
+		return NewClockSlotTimer(nil)
+	}
+	return s.slotTimer
+}
+
+// SlotStartTime returns the start time of a slot according to the slot timer of the service.
+func (s *Service) SlotStartTime(slot types.Slot) time.Time {
+	return s.SlotTimer().SlotStartTime(s.genesisTime, slot)
+}
@@ -0,0 +1,95 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestClockSlotTimer(t *testing.T) {
+	secondsPerSlot := params.BeaconConfig().SecondsPerSlot
+	genesis := time.Now().Add(-time.Duration(3*secondsPerSlot) * time.Second)
+	timer := NewClockSlotTimer(nil)
+	assert.Equal(t, types.Slot(3), timer.CurrentSlot(genesis))
+	assert.Equal(t, genesis.Add(time.Duration(5*secondsPerSlot)*time.Second), timer.SlotStartTime(genesis, 5))
+	assert.Equal(t, types.Slot(0), timer.CurrentSlot(time.Now().Add(time.Hour)))
+}
+
+func TestClockSlotTimer_ChainConfig(t *testing.T) {
+	cfg := params.BeaconConfig().Copy()
+	cfg.SecondsPerSlot = 2 * params.BeaconConfig().SecondsPerSlot
+	genesis := time.Now().Add(-time.Duration(3*cfg.SecondsPerSlot) * time.Second)
+	timer := NewClockSlotTimer(params.NewScopedChainConfig(cfg))
+	assert.Equal(t, types.Slot(3), timer.CurrentSlot(genesis))
+	assert.Equal(t, genesis.Add(time.Duration(5*cfg.SecondsPerSlot)*time.Second), timer.SlotStartTime(genesis, 5))
+	manual := NewManualSlotTimer(params.NewScopedChainConfig(cfg))
+	assert.Equal(t, timer.SlotStartTime(genesis, 5), manual.SlotStartTime(genesis, 5))
+}
+
+func TestSimulatedSlotTimer(t *testing.T) {
+	_, err := NewSimulatedSlotTimer(0)
+	assert.ErrorContains(t, "simulated slot duration must be positive", err)
+
+	timer, err := NewSimulatedSlotTimer(20 * time.Millisecond)
+	require.NoError(t, err)
+	genesis := time.Now().Add(-50 * time.Millisecond)
+	assert.Equal(t, types.Slot(2), timer.CurrentSlot(genesis))
+	assert.Equal(t, genesis.Add(100*time.Millisecond), timer.SlotStartTime(genesis, 5))
+	assert.Equal(t, types.Slot(0), timer.CurrentSlot(time.Now().Add(time.Hour)))
+
+	ticker := timer.NewTicker(genesis)
+	defer ticker.Done()
+	for want := types.Slot(3); want <= 5; want++ {
+		select {
+		case slot := <-ticker.C():
+			assert.Equal(t, want, slot)
+		case <-time.After(time.Second):
+			t.Fatalf("Timed out waiting for slot %d", want)
+		}
+	}
+}
+
+func TestManualSlotTimer(t *testing.T) {
+	timer := NewManualSlotTimer(nil)
+	assert.Equal(t, types.Slot(0), timer.CurrentSlot(time.Time{}))
+
+	ticker := timer.NewTicker(time.Time{})
+	require.NoError(t, timer.SetSlot(1))
+	assert.Equal(t, types.Slot(1), <-ticker.C())
+
+	// A ticker which fell behind only receives the latest slot.
+	require.NoError(t, timer.SetSlot(2))
+	require.NoError(t, timer.SetSlot(4))
+	assert.Equal(t, types.Slot(4), <-ticker.C())
+	assert.Equal(t, types.Slot(4), timer.CurrentSlot(time.Time{}))
+
+	assert.ErrorContains(t, "slot 3 is before the current slot 4", timer.SetSlot(3))
+
+	ticker.Done()
+	require.NoError(t, timer.SetSlot(5))
+	select {
+	case <-ticker.C():
+		t.Fatal("Stopped ticker received a slot")
+	default:
+	}
+}
+
+func TestService_UsesSlotTimer(t *testing.T) {
+	timer := NewManualSlotTimer(nil)
+	service, err := NewService(context.Background(), &Config{SlotTimer: timer})
+	require.NoError(t, err)
+	service.genesisTime = time.Unix(1600000000, 0)
+
+	require.NoError(t, timer.SetSlot(params.BeaconConfig().SlotsPerEpoch))
+	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch, service.CurrentSlot())
+	assert.Equal(t, timer.SlotStartTime(service.genesisTime, 7), service.SlotStartTime(7))
+
+	service, err = NewService(context.Background(), &Config{})
+	require.NoError(t, err)
+	assert.Equal(t, NewClockSlotTimer(nil), service.SlotTimer())
+}
@@ -296,7 +296,7 @@ func (s *ChainService) HeadValidatorsIndices(_ context.Context, epoch types.Epoc
 	if s.State == nil {
 		return []types.ValidatorIndex{}, nil
 	}
-	return helpers.ActiveValidatorIndices(s.State, epoch)
+	return helpers.ActiveValidatorIndices(nil, s.State, epoch)
 }
 
 // HeadSeed mocks the same method in the chain service.
@@ -319,6 +319,11 @@ func (s *ChainService) GenesisTime() time.Time {
 	return s.Genesis
 }
 
+// SlotStartTime mocks the same method in the chain service.
+func (s *ChainService) SlotStartTime(slot types.Slot) time.Time {
+	return s.Genesis.Add(time.Duration(uint64(slot)*params.BeaconConfig().SecondsPerSlot) * time.Second)
+}
+
 // GenesisValidatorRoot mocks the same method in the chain service.
 func (s *ChainService) GenesisValidatorRoot() [32]byte {
 	return s.ValidatorsRoot
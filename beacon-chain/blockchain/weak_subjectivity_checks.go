@@ -7,7 +7,6 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
-	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
 // VerifyWeakSubjectivityRoot verifies the weak subjectivity root in the service struct.
@@ -43,7 +42,7 @@ func (s *Service) VerifyWeakSubjectivityRoot(ctx context.Context) error {
 		return err
 	}
 	// A node should have the weak subjectivity block corresponds to the correct epoch in the DB.
-	filter := filters.NewFilter().SetStartSlot(startSlot).SetEndSlot(startSlot + params.BeaconConfig().SlotsPerEpoch)
+	filter := filters.NewFilter().SetStartSlot(startSlot).SetEndSlot(startSlot + s.beaconConfig().SlotsPerEpoch)
 	roots, err := s.cfg.BeaconDB.BlockRoots(ctx, filter)
 	if err != nil {
 		return err
@@ -4,20 +4,26 @@ package cache
 
 import (
 	"errors"
+	"sort"
 	"sync"
+	"sync/atomic"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
-	"k8s.io/client-go/tools/cache"
 )
 
 var (
-	// maxCommitteesCacheSize defines the max number of shuffled committees on per randao basis can cache.
-	// Due to reorgs and long finality, it's good to keep the old cache around for quickly switch over.
-	maxCommitteesCacheSize = uint64(32)
+	// maxCommitteeEpochs defines the max number of epochs the committees are cached for. Due to reorgs
+	// and long finality, it's good to keep the committees of old epochs around to quickly switch over.
+	maxCommitteeEpochs = 16
+	// maxCommitteesPerEpoch defines the max number of shuffled committees, one per seed, cached for an
+	// epoch. Competing forks may derive different seeds for the same epoch.
+	maxCommitteesPerEpoch = 2
+	// maxCommitteesCacheSize is the max number of shuffled committees the cache holds.
+	maxCommitteesCacheSize = uint64(maxCommitteeEpochs * maxCommitteesPerEpoch)
 
 	// CommitteeCacheMiss tracks the number of committee requests that aren't present in the cache.
 	CommitteeCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
@@ -29,12 +35,38 @@ var (
 		Name: "committee_cache_hit",
 		Help: "The number of committee requests that are present in the cache.",
 	})
+	committeeCacheEvictions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "committee_cache_evicted_epochs_total",
+		Help: "The number of epochs evicted from the committee cache, by reason.",
+	}, []string{"reason"})
+	committeeCacheEpochs = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "committee_cache_epochs",
+		Help: "The number of epochs the committee cache holds committees for.",
+	})
 )
 
-// CommitteeCache is a struct with 1 queue for looking up shuffled indices list by seed.
+// committeeShard holds the keys of the shuffled committees cached for an epoch.
+type committeeShard struct {
+	epoch types.Epoch
+	// keys are in insertion order, the oldest is evicted first when the shard is full.
+	keys []string
+	// lastUsed is the tick of the cache at the most recent access of the shard, accessed atomically.
+	lastUsed uint64
+}
+
+type committeeEntry struct {
+	committees *Committees
+	shard      *committeeShard
+}
+
+// CommitteeCache caches shuffled committees by seed, sharded by their epoch. Whole epochs are
+// evicted, the least recently used first, and the epochs before the finalized epoch are invalidated
+// on finality. A nil cache holds nothing, so that committees are computed without caching them.
 type CommitteeCache struct {
-	CommitteeCache *cache.FIFO
-	lock           sync.RWMutex
+	lock    sync.RWMutex
+	entries map[string]*committeeEntry
+	shards  map[types.Epoch]*committeeShard
+	tick    uint64
 }
 
 // committeeKeyFn takes the seed as the key to retrieve shuffled indices of a committee in a given epoch.
@@ -50,33 +82,19 @@ func committeeKeyFn(obj interface{}) (string, error) {
 // NewCommitteesCache creates a new committee cache for storing/accessing shuffled indices of a committee.
 func NewCommitteesCache() *CommitteeCache {
 	return &CommitteeCache{
-		CommitteeCache: cache.NewFIFO(committeeKeyFn),
+		entries: make(map[string]*committeeEntry),
+		shards:  make(map[types.Epoch]*committeeShard),
 	}
 }
 
 // Committee fetches the shuffled indices by slot and committee index. Every list of indices
 // represent one committee. Returns true if the list exists with slot and committee index. Otherwise returns false, nil.
 func (c *CommitteeCache) Committee(slot types.Slot, seed [32]byte, index types.CommitteeIndex) ([]types.ValidatorIndex, error) {
-	c.lock.RLock()
-	defer c.lock.RUnlock()
-
-	obj, exists, err := c.CommitteeCache.GetByKey(key(seed))
-	if err != nil {
-		return nil, err
-	}
-
-	if exists {
-		CommitteeCacheHit.Inc()
-	} else {
-		CommitteeCacheMiss.Inc()
+	item := c.get(key(seed))
+	if item == nil {
 		return nil, nil
 	}
 
-	item, ok := obj.(*Committees)
-	if !ok {
-		return nil, ErrNotCommittee
-	}
-
 	committeeCountPerSlot := uint64(1)
 	if item.CommitteeCount/uint64(params.BeaconConfig().SlotsPerEpoch) > 1 {
 		committeeCountPerSlot = item.CommitteeCount / uint64(params.BeaconConfig().SlotsPerEpoch)
@@ -92,71 +110,139 @@ func (c *CommitteeCache) Committee(slot types.Slot, seed [32]byte, index types.C
 	return item.ShuffledIndices[start:end], nil
 }
 
-// AddCommitteeShuffledList adds Committee shuffled list object to the cache. T
-// his method also trims the least recently list if the cache size has ready the max cache size limit.
+// AddCommitteeShuffledList adds Committee shuffled list object to the cache. If the epoch of the
+// committees is full its oldest committees are dropped, and if the cache holds too many epochs the
+// least recently used epoch is evicted.
 func (c *CommitteeCache) AddCommitteeShuffledList(committees *Committees) error {
+	if c == nil {
+		return nil
+	}
+	if committees == nil {
+		return ErrNotCommittee
+	}
+	k, err := committeeKeyFn(committees)
+	if err != nil {
+		return err
+	}
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	if err := c.CommitteeCache.AddIfNotPresent(committees); err != nil {
-		return err
+	if _, ok := c.entries[k]; ok {
+		return nil
+	}
+	shard, ok := c.shards[committees.Epoch]
+	if !ok {
+		shard = &committeeShard{epoch: committees.Epoch}
+		c.shards[committees.Epoch] = shard
+	}
+	atomic.StoreUint64(&shard.lastUsed, atomic.AddUint64(&c.tick, 1))
+	if len(shard.keys) >= maxCommitteesPerEpoch {
+		delete(c.entries, shard.keys[0])
+		shard.keys = shard.keys[1:]
+	}
+	shard.keys = append(shard.keys, k)
+	c.entries[k] = &committeeEntry{committees: committees, shard: shard}
+
+	for len(c.shards) > maxCommitteeEpochs {
+		var lru *committeeShard
+		for _, s := range c.shards {
+			if lru == nil || atomic.LoadUint64(&s.lastUsed) < atomic.LoadUint64(&lru.lastUsed) {
+				lru = s
+			}
+		}
+		c.evict(lru)
+		committeeCacheEvictions.WithLabelValues("lru").Inc()
 	}
-	trim(c.CommitteeCache, maxCommitteesCacheSize)
+	committeeCacheEpochs.Set(float64(len(c.shards)))
 	return nil
 }
 
 // ActiveIndices returns the active indices of a given seed stored in cache.
 func (c *CommitteeCache) ActiveIndices(seed [32]byte) ([]types.ValidatorIndex, error) {
-	c.lock.RLock()
-	defer c.lock.RUnlock()
-	obj, exists, err := c.CommitteeCache.GetByKey(key(seed))
-	if err != nil {
-		return nil, err
-	}
-
-	if exists {
-		CommitteeCacheHit.Inc()
-	} else {
-		CommitteeCacheMiss.Inc()
+	item := c.get(key(seed))
+	if item == nil {
 		return nil, nil
 	}
-
-	item, ok := obj.(*Committees)
-	if !ok {
-		return nil, ErrNotCommittee
-	}
-
 	return item.SortedIndices, nil
 }
 
 // ActiveIndicesCount returns the active indices count of a given seed stored in cache.
 func (c *CommitteeCache) ActiveIndicesCount(seed [32]byte) (int, error) {
+	item := c.get(key(seed))
+	if item == nil {
+		return 0, nil
+	}
+	return len(item.SortedIndices), nil
+}
+
+// HasEntry returns true if the committee cache has a value.
+func (c *CommitteeCache) HasEntry(seed string) bool {
+	if c == nil {
+		return false
+	}
 	c.lock.RLock()
 	defer c.lock.RUnlock()
-	obj, exists, err := c.CommitteeCache.GetByKey(key(seed))
-	if err != nil {
-		return 0, err
+	_, ok := c.entries[seed]
+	return ok
+}
+
+// PruneBefore invalidates the committees of the epochs before the given epoch. It is called with
+// the finalized epoch, as committees of finalized epochs are no longer needed to process blocks.
+func (c *CommitteeCache) PruneBefore(epoch types.Epoch) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for e, shard := range c.shards {
+		if e < epoch {
+			c.evict(shard)
+			committeeCacheEvictions.WithLabelValues("finalized").Inc()
+		}
 	}
+	committeeCacheEpochs.Set(float64(len(c.shards)))
+}
 
-	if exists {
-		CommitteeCacheHit.Inc()
-	} else {
-		CommitteeCacheMiss.Inc()
-		return 0, nil
+// OnFinalized invalidates the committees of the epochs before the finalized epoch. The committees
+// of competing forks in later epochs are left to the LRU eviction.
+func (c *CommitteeCache) OnFinalized(epoch types.Epoch, _ [32]byte) {
+	c.PruneBefore(epoch)
+}
+
+// Epochs returns the epochs the cache holds committees for, in ascending order.
+func (c *CommitteeCache) Epochs() []types.Epoch {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	epochs := make([]types.Epoch, 0, len(c.shards))
+	for e := range c.shards {
+		epochs = append(epochs, e)
 	}
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i] < epochs[j]
+	})
+	return epochs
+}
 
-	item, ok := obj.(*Committees)
+// get returns the committees of the seed key and marks their epoch as used, nil if they are not cached.
+func (c *CommitteeCache) get(k string) *Committees {
+	if c == nil {
+		return nil
+	}
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	entry, ok := c.entries[k]
 	if !ok {
-		return 0, ErrNotCommittee
+		CommitteeCacheMiss.Inc()
+		return nil
 	}
-
-	return len(item.SortedIndices), nil
+	CommitteeCacheHit.Inc()
+	atomic.StoreUint64(&entry.shard.lastUsed, atomic.AddUint64(&c.tick, 1))
+	return entry.committees
 }
 
-// HasEntry returns true if the committee cache has a value.
-func (c *CommitteeCache) HasEntry(seed string) bool {
-	_, ok, err := c.CommitteeCache.GetByKey(seed)
-	return err == nil && ok
+// evict removes the shard and its committees. The caller must hold the write lock.
+func (c *CommitteeCache) evict(shard *committeeShard) {
+	for _, k := range shard.keys {
+		delete(c.entries, k)
+	}
+	delete(c.shards, shard.epoch)
 }
 
 func startEndIndices(c *Committees, index uint64) (uint64, uint64) {
@@ -9,6 +9,9 @@ import types "github.com/prysmaticlabs/eth2-types"
 type FakeCommitteeCache struct {
 }
 
+// CommitteeCache is the committee cache type of fuzzer builds.
+type CommitteeCache = FakeCommitteeCache
+
 // NewCommitteesCache creates a new committee cache for storing/accessing shuffled indices of a committee.
 func NewCommitteesCache() *FakeCommitteeCache {
 	return &FakeCommitteeCache{}
@@ -50,3 +53,16 @@ func (c *FakeCommitteeCache) ProposerIndices(seed [32]byte) ([]types.ValidatorIn
 func (c *FakeCommitteeCache) HasEntry(string) bool {
 	return false
 }
+
+// PruneBefore invalidates the committees of the epochs before the given epoch.
+func (c *FakeCommitteeCache) PruneBefore(types.Epoch) {
+}
+
+// OnFinalized invalidates the committees of the epochs before the finalized epoch.
+func (c *FakeCommitteeCache) OnFinalized(types.Epoch, [32]byte) {
+}
+
+// Epochs returns the epochs the cache holds committees for.
+func (c *FakeCommitteeCache) Epochs() []types.Epoch {
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	fuzz "github.com/google/gofuzz"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
@@ -27,12 +29,16 @@ func TestCommitteeCache_FuzzCommitteesByEpoch(t *testing.T) {
 
 	for i := 0; i < 100000; i++ {
 		fuzzer.Fuzz(c)
+		// Distinct seeds filling every epoch, so that the cache ends up at its size limit.
+		copy(c.Seed[:], bytesutil.Bytes8(uint64(i)))
+		c.Epoch = types.Epoch(i / maxCommitteesPerEpoch)
 		require.NoError(t, cache.AddCommitteeShuffledList(c))
 		_, err := cache.Committee(0, c.Seed, 0)
 		require.NoError(t, err)
 	}
 
-	assert.Equal(t, maxCommitteesCacheSize, uint64(len(cache.CommitteeCache.ListKeys())), "Incorrect key size")
+	assert.Equal(t, maxCommitteesCacheSize, uint64(len(cache.entries)), "Incorrect key size")
+	assertFullShards(t, cache, c.Epoch)
 }
 
 func TestCommitteeCache_FuzzActiveIndices(t *testing.T) {
@@ -42,6 +48,9 @@ func TestCommitteeCache_FuzzActiveIndices(t *testing.T) {
 
 	for i := 0; i < 100000; i++ {
 		fuzzer.Fuzz(c)
+		// Distinct seeds filling every epoch, so that the cache ends up at its size limit.
+		copy(c.Seed[:], bytesutil.Bytes8(uint64(i)))
+		c.Epoch = types.Epoch(i / maxCommitteesPerEpoch)
 		require.NoError(t, cache.AddCommitteeShuffledList(c))
 
 		indices, err := cache.ActiveIndices(c.Seed)
@@ -49,5 +58,18 @@ func TestCommitteeCache_FuzzActiveIndices(t *testing.T) {
 		assert.DeepEqual(t, c.SortedIndices, indices)
 	}
 
-	assert.Equal(t, maxCommitteesCacheSize, uint64(len(cache.CommitteeCache.ListKeys())), "Incorrect key size")
+	assert.Equal(t, maxCommitteesCacheSize, uint64(len(cache.entries)), "Incorrect key size")
+	assertFullShards(t, cache, c.Epoch)
+}
+
+// assertFullShards checks that the cache holds the epochs up to the last one added, each with as
+// many committees as an epoch holds.
+func assertFullShards(t *testing.T, cache *CommitteeCache, last types.Epoch) {
+	epochs := cache.Epochs()
+	require.Equal(t, maxCommitteeEpochs, len(epochs), "Incorrect epoch count")
+	assert.Equal(t, last-types.Epoch(maxCommitteeEpochs-1), epochs[0], "Incorrect oldest epoch")
+	assert.Equal(t, last, epochs[len(epochs)-1], "Incorrect newest epoch")
+	for _, e := range epochs {
+		assert.Equal(t, maxCommitteesPerEpoch, len(cache.shards[e].keys), "Incorrect committee count of epoch %d", e)
+	}
 }
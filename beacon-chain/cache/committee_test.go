@@ -2,7 +2,6 @@ package cache
 
 import (
 	"math"
-	"sort"
 	"strconv"
 	"testing"
 
@@ -91,33 +90,85 @@ func TestCommitteeCache_ActiveCount(t *testing.T) {
 func TestCommitteeCache_CanRotate(t *testing.T) {
 	cache := NewCommitteesCache()
 
-	// Should rotate out all the epochs except 190 through 199.
+	// Should rotate out all the epochs except 184 through 199.
 	start := 100
 	end := 200
 	for i := start; i < end; i++ {
 		s := []byte(strconv.Itoa(i))
-		item := &Committees{Seed: bytesutil.ToBytes32(s)}
+		item := &Committees{Epoch: types.Epoch(i), Seed: bytesutil.ToBytes32(s)}
 		require.NoError(t, cache.AddCommitteeShuffledList(item))
 	}
 
-	k := cache.CommitteeCache.ListKeys()
-	assert.Equal(t, maxCommitteesCacheSize, uint64(len(k)))
+	epochs := cache.Epochs()
+	require.Equal(t, maxCommitteeEpochs, len(epochs))
+	assert.Equal(t, types.Epoch(end-maxCommitteeEpochs), epochs[0], "incorrect first epoch")
+	assert.Equal(t, types.Epoch(end-1), epochs[len(epochs)-1], "incorrect last epoch")
+	assert.Equal(t, false, cache.HasEntry(key(bytesutil.ToBytes32([]byte(strconv.Itoa(start))))))
+	assert.Equal(t, true, cache.HasEntry(key(bytesutil.ToBytes32([]byte(strconv.Itoa(end-1))))))
+}
 
-	sort.Slice(k, func(i, j int) bool {
-		return k[i] < k[j]
-	})
-	wanted := end - int(maxCommitteesCacheSize)
-	s := bytesutil.ToBytes32([]byte(strconv.Itoa(wanted)))
-	assert.Equal(t, key(s), k[0], "incorrect key received for slot 190")
+func TestCommitteeCache_EvictsLeastRecentlyUsedEpoch(t *testing.T) {
+	cache := NewCommitteesCache()
+	seed := func(i int) [32]byte {
+		return bytesutil.ToBytes32([]byte(strconv.Itoa(i)))
+	}
+	for i := 0; i < maxCommitteeEpochs; i++ {
+		require.NoError(t, cache.AddCommitteeShuffledList(&Committees{Epoch: types.Epoch(i), Seed: seed(i)}))
+	}
+	// Using the oldest epoch keeps it over the second oldest one.
+	_, err := cache.ActiveIndices(seed(0))
+	require.NoError(t, err)
+	require.NoError(t, cache.AddCommitteeShuffledList(&Committees{Epoch: types.Epoch(maxCommitteeEpochs), Seed: seed(maxCommitteeEpochs)}))
+
+	assert.Equal(t, true, cache.HasEntry(key(seed(0))))
+	assert.Equal(t, false, cache.HasEntry(key(seed(1))))
+	assert.Equal(t, maxCommitteeEpochs, len(cache.Epochs()))
+}
+
+func TestCommitteeCache_SeedsPerEpoch(t *testing.T) {
+	cache := NewCommitteesCache()
+	for i := 0; i <= maxCommitteesPerEpoch; i++ {
+		require.NoError(t, cache.AddCommitteeShuffledList(&Committees{Epoch: 5, Seed: [32]byte{byte(i)}}))
+	}
+	// The oldest seed of the epoch is dropped.
+	assert.Equal(t, false, cache.HasEntry(key([32]byte{0})))
+	for i := 1; i <= maxCommitteesPerEpoch; i++ {
+		assert.Equal(t, true, cache.HasEntry(key([32]byte{byte(i)})))
+	}
+	assert.DeepEqual(t, []types.Epoch{5}, cache.Epochs())
+}
+
+func TestCommitteeCache_PruneBefore(t *testing.T) {
+	cache := NewCommitteesCache()
+	for i := 0; i < 6; i++ {
+		require.NoError(t, cache.AddCommitteeShuffledList(&Committees{Epoch: types.Epoch(i), Seed: [32]byte{byte(i)}}))
+	}
+	cache.PruneBefore(4)
+	assert.DeepEqual(t, []types.Epoch{4, 5}, cache.Epochs())
+	assert.Equal(t, false, cache.HasEntry(key([32]byte{3})))
+	assert.Equal(t, true, cache.HasEntry(key([32]byte{4})))
+	indices, err := cache.ActiveIndices([32]byte{0})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(indices))
+}
 
-	s = bytesutil.ToBytes32([]byte(strconv.Itoa(199)))
-	assert.Equal(t, key(s), k[len(k)-1], "incorrect key received for slot 199")
+func TestCommitteeCache_Nil(t *testing.T) {
+	var cache *CommitteeCache
+	seed := [32]byte{'A'}
+	require.NoError(t, cache.AddCommitteeShuffledList(&Committees{Seed: seed, ShuffledIndices: []types.ValidatorIndex{1}}))
+	assert.Equal(t, false, cache.HasEntry(key(seed)))
+	indices, err := cache.Committee(0, seed, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(indices))
+	count, err := cache.ActiveIndicesCount(seed)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
 }
 
 func TestCommitteeCacheOutOfRange(t *testing.T) {
 	cache := NewCommitteesCache()
 	seed := bytesutil.ToBytes32([]byte("foo"))
-	err := cache.CommitteeCache.Add(&Committees{
+	err := cache.AddCommitteeShuffledList(&Committees{
 		CommitteeCount:  1,
 		Seed:            seed,
 		ShuffledIndices: []types.ValidatorIndex{0},
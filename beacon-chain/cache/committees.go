@@ -12,6 +12,8 @@ var ErrNotCommittee = errors.New("object is not a committee struct")
 
 // Committees defines the shuffled committees seed.
 type Committees struct {
+	// Epoch is the epoch the committees are shuffled for, which the cache shards them by.
+	Epoch           types.Epoch
 	CommitteeCount  uint64
 	Seed            [32]byte
 	ShuffledIndices []types.ValidatorIndex
@@ -0,0 +1,101 @@
+package cache
+
+import (
+	"sync"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// LivenessEpochs is the number of most recent epochs the liveness cache retains.
+const LivenessEpochs = 4
+
+// LivenessCache records which validators were observed to be active in recent epochs. A validator
+// is live in an epoch if a processed block proposed by it falls into the epoch, or included one of
+// its attestations targeting the epoch.
+type LivenessCache struct {
+	epochs  map[types.Epoch]bitfield.Bitlist
+	highest types.Epoch
+	lock    sync.RWMutex
+}
+
+// NewLivenessCache creates an empty liveness cache.
+func NewLivenessCache() *LivenessCache {
+	return &LivenessCache{
+		epochs: make(map[types.Epoch]bitfield.Bitlist),
+	}
+}
+
+// MarkLive marks the given validators as live in the epoch. Epochs older than the retained window
+// are ignored, and advancing the highest epoch prunes the epochs which fall out of the window.
+func (c *LivenessCache) MarkLive(epoch types.Epoch, indices ...types.ValidatorIndex) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if epoch > c.highest {
+		c.highest = epoch
+		for e := range c.epochs {
+			if !c.retains(e) {
+				delete(c.epochs, e)
+			}
+		}
+	}
+	if !c.retains(epoch) {
+		return
+	}
+	bits := c.epochs[epoch]
+	for _, index := range indices {
+		if bits == nil || uint64(index) >= bits.Len() {
+			bits = growBitlist(bits, uint64(index)+1)
+		}
+		bits.SetBitAt(uint64(index), true)
+	}
+	c.epochs[epoch] = bits
+}
+
+// Liveness returns whether each of the given validators was live in the epoch. It returns false if
+// the epoch is not within the retained window, in which case the liveness is unknown.
+func (c *LivenessCache) Liveness(epoch types.Epoch, indices []types.ValidatorIndex) ([]bool, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	if !c.retains(epoch) || epoch > c.highest {
+		return nil, false
+	}
+	bits := c.epochs[epoch]
+	live := make([]bool, len(indices))
+	for i, index := range indices {
+		live[i] = bits != nil && uint64(index) < bits.Len() && bits.BitAt(uint64(index))
+	}
+	return live, true
+}
+
+// OldestEpoch returns the oldest epoch within the retained window.
+func (c *LivenessCache) OldestEpoch() types.Epoch {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if c.highest < LivenessEpochs {
+		return 0
+	}
+	return c.highest - LivenessEpochs + 1
+}
+
+func (c *LivenessCache) retains(epoch types.Epoch) bool {
+	return epoch+LivenessEpochs > c.highest
+}
+
+// growBitlist returns a copy of the bitlist with room for at least length bits. The capacity is
+// doubled so that a growing validator registry does not reallocate on every new index.
+func growBitlist(bits bitfield.Bitlist, length uint64) bitfield.Bitlist {
+	size := length
+	if bits != nil && bits.Len()*2 > size {
+		size = bits.Len() * 2
+	}
+	grown := bitfield.NewBitlist(size)
+	if bits != nil {
+		for _, i := range bits.BitIndices() {
+			grown.SetBitAt(uint64(i), true)
+		}
+	}
+	return grown
+}
@@ -0,0 +1,52 @@
+package cache
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestLivenessCache_MarkLive(t *testing.T) {
+	c := NewLivenessCache()
+	indices := []types.ValidatorIndex{0, 3, 100, 2000}
+
+	live, ok := c.Liveness(0, indices)
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, []bool{false, false, false, false}, live)
+
+	c.MarkLive(0, 3)
+	c.MarkLive(0, 2000, 3)
+	live, ok = c.Liveness(0, indices)
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, []bool{false, true, false, true}, live)
+
+	// Epochs after the highest marked epoch have not been observed yet.
+	_, ok = c.Liveness(1, indices)
+	assert.Equal(t, false, ok)
+}
+
+func TestLivenessCache_PrunesOldEpochs(t *testing.T) {
+	c := NewLivenessCache()
+	c.MarkLive(1, 1)
+	c.MarkLive(LivenessEpochs, 2)
+
+	live, ok := c.Liveness(1, []types.ValidatorIndex{1, 2})
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, []bool{true, false}, live)
+	assert.Equal(t, types.Epoch(1), c.OldestEpoch())
+
+	c.MarkLive(LivenessEpochs+1, 3)
+	_, ok = c.Liveness(1, []types.ValidatorIndex{1})
+	assert.Equal(t, false, ok)
+	assert.Equal(t, types.Epoch(2), c.OldestEpoch())
+
+	// Activity observed late for a pruned epoch is dropped.
+	c.MarkLive(1, 1)
+	_, ok = c.Liveness(1, []types.ValidatorIndex{1})
+	assert.Equal(t, false, ok)
+	live, ok = c.Liveness(LivenessEpochs, []types.ValidatorIndex{1, 2})
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, []bool{false, true}, live)
+}
@@ -0,0 +1,172 @@
+package cache
+
+import (
+	"sort"
+	"sync"
+
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+// ProposerDigestEpochs is the number of most recent epochs the proposer digest cache retains.
+const ProposerDigestEpochs = 4
+
+// MaxProposerDigestPeers bounds the number of peers whose digests are retained per epoch. Gossiped
+// digests are unsigned, so their peer IDs are not authenticated and could be made up in bulk.
+const MaxProposerDigestPeers = 1024
+
+// ProposerDigestCache records the digest of the proposer list this node computed for recent
+// epochs, next to the digests its peers gossiped for the same epochs, so that peers whose proposer
+// assignments diverge are detected before they fork off the orchestrator.
+type ProposerDigestCache struct {
+	epochs  map[types.Epoch]*epochProposerDigests
+	highest types.Epoch
+	lock    sync.RWMutex
+}
+
+type epochProposerDigests struct {
+	digest    [32]byte
+	hasDigest bool
+	peers     map[string][32]byte
+}
+
+// ProposerDigestConsistency compares the proposer list digest of this node for an epoch with the
+// digests of its peers.
+type ProposerDigestConsistency struct {
+	Epoch types.Epoch
+	// Digest computed by this node, which is only set if HasDigest is true.
+	Digest    [32]byte
+	HasDigest bool
+	// Peers is the number of peers which gossiped a digest for the epoch, of which Matching
+	// agree with the digest of this node.
+	Peers    int
+	Matching int
+	// DivergentPeers are the IDs of the peers disagreeing with the digest of this node, in
+	// ascending order.
+	DivergentPeers []string
+}
+
+// Score returns the share of the peers agreeing with the digest of this node, from 0 to 1. It is
+// 0 if the node has no digest for the epoch or no peer gossiped one.
+func (c *ProposerDigestConsistency) Score() float64 {
+	if !c.HasDigest || c.Peers == 0 {
+		return 0
+	}
+	return float64(c.Matching) / float64(c.Peers)
+}
+
+// NewProposerDigestCache creates an empty proposer digest cache.
+func NewProposerDigestCache() *ProposerDigestCache {
+	return &ProposerDigestCache{
+		epochs: make(map[types.Epoch]*epochProposerDigests),
+	}
+}
+
+// SetDigest records the digest of the proposer list this node computed for the epoch, replacing
+// the digest it previously computed.
+func (c *ProposerDigestCache) SetDigest(epoch types.Epoch, digest [32]byte) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	e := c.epoch(epoch)
+	if e == nil {
+		return
+	}
+	e.digest = digest
+	e.hasDigest = true
+}
+
+// Digest returns the digest of the proposer list this node computed for the epoch, if any.
+func (c *ProposerDigestCache) Digest(epoch types.Epoch) ([32]byte, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	e, ok := c.epochs[epoch]
+	if !ok || !e.hasDigest {
+		return [32]byte{}, false
+	}
+	return e.digest, true
+}
+
+// AddPeerDigest records the digest a peer gossiped for the epoch, replacing the digest it
+// previously gossiped for the epoch. It returns false if the digest was dropped, because the
+// epoch is older than the retained window or the epoch already holds the digests of
+// MaxProposerDigestPeers other peers.
+func (c *ProposerDigestCache) AddPeerDigest(epoch types.Epoch, peer string, digest [32]byte) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	e := c.epoch(epoch)
+	if e == nil {
+		return false
+	}
+	if _, ok := e.peers[peer]; !ok && len(e.peers) >= MaxProposerDigestPeers {
+		return false
+	}
+	e.peers[peer] = digest
+	return true
+}
+
+// Consistency compares the digest of this node for the epoch with the digests of its peers. It
+// returns false if the epoch is not within the retained window.
+func (c *ProposerDigestCache) Consistency(epoch types.Epoch) (*ProposerDigestConsistency, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	if !c.retains(epoch) || epoch > c.highest {
+		return nil, false
+	}
+	res := &ProposerDigestConsistency{Epoch: epoch}
+	e, ok := c.epochs[epoch]
+	if !ok {
+		return res, true
+	}
+	res.Digest = e.digest
+	res.HasDigest = e.hasDigest
+	res.Peers = len(e.peers)
+	for peer, digest := range e.peers {
+		if e.hasDigest && digest == e.digest {
+			res.Matching++
+			continue
+		}
+		res.DivergentPeers = append(res.DivergentPeers, peer)
+	}
+	sort.Strings(res.DivergentPeers)
+	return res, true
+}
+
+// OldestEpoch returns the oldest epoch within the retained window.
+func (c *ProposerDigestCache) OldestEpoch() types.Epoch {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if c.highest < ProposerDigestEpochs {
+		return 0
+	}
+	return c.highest - ProposerDigestEpochs + 1
+}
+
+// epoch returns the digests of the epoch, creating them if needed. Advancing the highest epoch
+// prunes the epochs which fall out of the window, and nil is returned for epochs older than the
+// window.
+func (c *ProposerDigestCache) epoch(epoch types.Epoch) *epochProposerDigests {
+	if epoch > c.highest {
+		c.highest = epoch
+		for e := range c.epochs {
+			if !c.retains(e) {
+				delete(c.epochs, e)
+			}
+		}
+	}
+	if !c.retains(epoch) {
+		return nil
+	}
+	e, ok := c.epochs[epoch]
+	if !ok {
+		e = &epochProposerDigests{peers: make(map[string][32]byte)}
+		c.epochs[epoch] = e
+	}
+	return e
+}
+
+func (c *ProposerDigestCache) retains(epoch types.Epoch) bool {
+	return epoch+ProposerDigestEpochs > c.highest
+}
@@ -0,0 +1,88 @@
+package cache
+
+import (
+	"fmt"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestProposerDigestCache_Consistency(t *testing.T) {
+	c := NewProposerDigestCache()
+	require.Equal(t, true, c.AddPeerDigest(3, "b", [32]byte{'x'}))
+	require.Equal(t, true, c.AddPeerDigest(3, "a", [32]byte{'y'}))
+
+	// Without a digest of its own, the node agrees with no peer.
+	res, ok := c.Consistency(3)
+	require.Equal(t, true, ok)
+	assert.Equal(t, false, res.HasDigest)
+	assert.Equal(t, 2, res.Peers)
+	assert.DeepEqual(t, []string{"a", "b"}, res.DivergentPeers)
+	assert.Equal(t, float64(0), res.Score())
+
+	c.SetDigest(3, [32]byte{'x'})
+	require.Equal(t, true, c.AddPeerDigest(3, "c", [32]byte{'x'}))
+	res, ok = c.Consistency(3)
+	require.Equal(t, true, ok)
+	assert.Equal(t, true, res.HasDigest)
+	assert.Equal(t, [32]byte{'x'}, res.Digest)
+	assert.Equal(t, 3, res.Peers)
+	assert.Equal(t, 2, res.Matching)
+	assert.DeepEqual(t, []string{"a"}, res.DivergentPeers)
+	assert.Equal(t, float64(2)/3, res.Score())
+
+	// A peer gossiping a corrected digest replaces its previous one.
+	require.Equal(t, true, c.AddPeerDigest(3, "a", [32]byte{'x'}))
+	res, ok = c.Consistency(3)
+	require.Equal(t, true, ok)
+	assert.Equal(t, 3, res.Matching)
+	assert.Equal(t, 0, len(res.DivergentPeers))
+	assert.Equal(t, float64(1), res.Score())
+
+	digest, ok := c.Digest(3)
+	require.Equal(t, true, ok)
+	assert.Equal(t, [32]byte{'x'}, digest)
+	_, ok = c.Digest(2)
+	assert.Equal(t, false, ok)
+
+	// Epochs after the highest recorded epoch have not been observed yet.
+	_, ok = c.Consistency(4)
+	assert.Equal(t, false, ok)
+}
+
+func TestProposerDigestCache_PrunesOldEpochs(t *testing.T) {
+	c := NewProposerDigestCache()
+	c.SetDigest(1, [32]byte{'a'})
+	c.SetDigest(ProposerDigestEpochs, [32]byte{'b'})
+	assert.Equal(t, types.Epoch(1), c.OldestEpoch())
+	_, ok := c.Digest(1)
+	assert.Equal(t, true, ok)
+
+	require.Equal(t, true, c.AddPeerDigest(ProposerDigestEpochs+1, "a", [32]byte{'c'}))
+	assert.Equal(t, types.Epoch(2), c.OldestEpoch())
+	_, ok = c.Digest(1)
+	assert.Equal(t, false, ok)
+	_, ok = c.Consistency(1)
+	assert.Equal(t, false, ok)
+
+	// Digests gossiped late for a pruned epoch are dropped.
+	assert.Equal(t, false, c.AddPeerDigest(1, "a", [32]byte{'a'}))
+	c.SetDigest(1, [32]byte{'a'})
+	_, ok = c.Digest(1)
+	assert.Equal(t, false, ok)
+}
+
+func TestProposerDigestCache_BoundsPeers(t *testing.T) {
+	c := NewProposerDigestCache()
+	for i := 0; i < MaxProposerDigestPeers; i++ {
+		require.Equal(t, true, c.AddPeerDigest(1, fmt.Sprintf("peer%d", i), [32]byte{'a'}))
+	}
+	assert.Equal(t, false, c.AddPeerDigest(1, "other", [32]byte{'a'}))
+	// Known peers may still update their digest.
+	assert.Equal(t, true, c.AddPeerDigest(1, "peer0", [32]byte{'b'}))
+	res, ok := c.Consistency(1)
+	require.Equal(t, true, ok)
+	assert.Equal(t, MaxProposerDigestPeers, res.Peers)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -20,6 +21,7 @@ import (
 // records.
 func ProcessAttestations(
 	ctx context.Context,
+	committeeCache *cache.CommitteeCache,
 	beaconState iface.BeaconState,
 	b *ethpb.SignedBeaconBlock,
 ) (iface.BeaconState, error) {
@@ -29,7 +31,7 @@ func ProcessAttestations(
 
 	var err error
 	for idx, attestation := range b.Block.Body.Attestations {
-		beaconState, err = ProcessAttestation(ctx, beaconState, attestation)
+		beaconState, err = ProcessAttestation(ctx, committeeCache, beaconState, attestation)
 		if err != nil {
 			return nil, errors.Wrapf(err, "could not verify attestation at index %d in block", idx)
 		}
@@ -68,20 +70,22 @@ func ProcessAttestations(
 //    assert is_valid_indexed_attestation(state, get_indexed_attestation(state, attestation))
 func ProcessAttestation(
 	ctx context.Context,
+	committeeCache *cache.CommitteeCache,
 	beaconState iface.BeaconState,
 	att *ethpb.Attestation,
 ) (iface.BeaconState, error) {
-	beaconState, err := ProcessAttestationNoVerifySignature(ctx, beaconState, att)
+	beaconState, err := ProcessAttestationNoVerifySignature(ctx, committeeCache, beaconState, att)
 	if err != nil {
 		return nil, err
 	}
-	return beaconState, VerifyAttestationSignature(ctx, beaconState, att)
+	return beaconState, VerifyAttestationSignature(ctx, committeeCache, beaconState, att)
 }
 
 // ProcessAttestationsNoVerifySignature applies processing operations to a block's inner attestation
 // records. The only difference would be that the attestation signature would not be verified.
 func ProcessAttestationsNoVerifySignature(
 	ctx context.Context,
+	committeeCache *cache.CommitteeCache,
 	beaconState iface.BeaconState,
 	b *ethpb.SignedBeaconBlock,
 ) (iface.BeaconState, error) {
@@ -91,7 +95,7 @@ func ProcessAttestationsNoVerifySignature(
 	body := b.Block.Body
 	var err error
 	for idx, attestation := range body.Attestations {
-		beaconState, err = ProcessAttestationNoVerifySignature(ctx, beaconState, attestation)
+		beaconState, err = ProcessAttestationNoVerifySignature(ctx, committeeCache, beaconState, attestation)
 		if err != nil {
 			return nil, errors.Wrapf(err, "could not verify attestation at index %d in block", idx)
 		}
@@ -103,6 +107,7 @@ func ProcessAttestationsNoVerifySignature(
 // used before processing attestation with the beacon state.
 func VerifyAttestationNoVerifySignature(
 	ctx context.Context,
+	committeeCache *cache.CommitteeCache,
 	beaconState iface.ReadOnlyBeaconState,
 	att *ethpb.Attestation,
 ) error {
@@ -157,7 +162,7 @@ func VerifyAttestationNoVerifySignature(
 			params.BeaconConfig().SlotsPerEpoch,
 		)
 	}
-	activeValidatorCount, err := helpers.ActiveValidatorCount(beaconState, att.Data.Target.Epoch)
+	activeValidatorCount, err := helpers.ActiveValidatorCount(committeeCache, beaconState, att.Data.Target.Epoch)
 	if err != nil {
 		return err
 	}
@@ -166,12 +171,12 @@ func VerifyAttestationNoVerifySignature(
 		return fmt.Errorf("committee index %d >= committee count %d", att.Data.CommitteeIndex, c)
 	}
 
-	if err := helpers.VerifyAttestationBitfieldLengths(beaconState, att); err != nil {
+	if err := helpers.VerifyAttestationBitfieldLengths(committeeCache, beaconState, att); err != nil {
 		return errors.Wrap(err, "could not verify attestation bitfields")
 	}
 
 	// Verify attesting indices are correct.
-	committee, err := helpers.BeaconCommitteeFromState(beaconState, att.Data.Slot, att.Data.CommitteeIndex)
+	committee, err := helpers.BeaconCommitteeFromState(committeeCache, beaconState, att.Data.Slot, att.Data.CommitteeIndex)
 	if err != nil {
 		return err
 	}
@@ -187,20 +192,21 @@ func VerifyAttestationNoVerifySignature(
 // method is used to validate attestations whose signatures have already been verified.
 func ProcessAttestationNoVerifySignature(
 	ctx context.Context,
+	committeeCache *cache.CommitteeCache,
 	beaconState iface.BeaconState,
 	att *ethpb.Attestation,
 ) (iface.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "core.ProcessAttestationNoVerifySignature")
 	defer span.End()
 
-	if err := VerifyAttestationNoVerifySignature(ctx, beaconState, att); err != nil {
+	if err := VerifyAttestationNoVerifySignature(ctx, committeeCache, beaconState, att); err != nil {
 		return nil, err
 	}
 
 	currEpoch := helpers.CurrentEpoch(beaconState)
 	data := att.Data
 	s := att.Data.Slot
-	proposerIndex, err := helpers.BeaconProposerIndex(beaconState)
+	proposerIndex, err := helpers.BeaconProposerIndex(committeeCache, beaconState)
 	if err != nil {
 		return nil, err
 	}
@@ -226,11 +232,11 @@ func ProcessAttestationNoVerifySignature(
 
 // VerifyAttestationSignature converts and attestation into an indexed attestation and verifies
 // the signature in that attestation.
-func VerifyAttestationSignature(ctx context.Context, beaconState iface.ReadOnlyBeaconState, att *ethpb.Attestation) error {
+func VerifyAttestationSignature(ctx context.Context, committeeCache *cache.CommitteeCache, beaconState iface.ReadOnlyBeaconState, att *ethpb.Attestation) error {
 	if err := helpers.ValidateNilAttestation(att); err != nil {
 		return err
 	}
-	committee, err := helpers.BeaconCommitteeFromState(beaconState, att.Data.Slot, att.Data.CommitteeIndex)
+	committee, err := helpers.BeaconCommitteeFromState(committeeCache, beaconState, att.Data.Slot, att.Data.CommitteeIndex)
 	if err != nil {
 		return err
 	}
@@ -43,7 +43,7 @@ func TestProcessAttestationNoVerifySignature_BeaconFuzzIssue78(t *testing.T) {
 	}
 
 	ctx := context.Background()
-	_, err = blocks.ProcessAttestationNoVerifySignature(ctx, st, att)
+	_, err = blocks.ProcessAttestationNoVerifySignature(ctx, nil, st, att)
 	require.ErrorContains(t, "committee index 1 >= committee count 1", err)
 }
 
@@ -75,6 +75,6 @@ func TestVerifyAttestationNoVerifySignature_IncorrectSourceEpoch(t *testing.T) {
 	require.NoError(t, beaconState.SetCurrentJustifiedCheckpoint(ckp))
 	require.NoError(t, beaconState.AppendCurrentEpochAttestations(&pb.PendingAttestation{}))
 
-	err = blocks.VerifyAttestationNoVerifySignature(context.TODO(), beaconState, att)
+	err = blocks.VerifyAttestationNoVerifySignature(context.TODO(), nil, beaconState, att)
 	assert.NotEqual(t, nil, err)
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	v "github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
@@ -46,6 +47,7 @@ var ValidatorCannotExitYetMsg = "validator has not been active long enough to ex
 //    initiate_validator_exit(state, exit.validator_index)
 func ProcessVoluntaryExits(
 	_ context.Context,
+	committeeCache *cache.CommitteeCache,
 	beaconState iface.BeaconState,
 	b *ethpb.SignedBeaconBlock,
 ) (iface.BeaconState, error) {
@@ -66,7 +68,7 @@ func ProcessVoluntaryExits(
 		if err := VerifyExitAndSignature(val, beaconState.Slot(), beaconState.Fork(), exit, beaconState.GenesisValidatorRoot()); err != nil {
 			return nil, errors.Wrapf(err, "could not verify exit %d", idx)
 		}
-		beaconState, err = v.InitiateValidatorExit(beaconState, exit.Exit.ValidatorIndex)
+		beaconState, err = v.InitiateValidatorExit(committeeCache, beaconState, exit.Exit.ValidatorIndex)
 		if err != nil {
 			return nil, err
 		}
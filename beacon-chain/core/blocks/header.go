@@ -7,6 +7,7 @@ import (
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -38,10 +39,11 @@ import (
 //    assert bls_verify(proposer.pubkey, signing_root(block), block.signature, get_domain(state, DOMAIN_BEACON_PROPOSER))
 func ProcessBlockHeader(
 	_ context.Context,
+	committeeCache *cache.CommitteeCache,
 	beaconState iface.BeaconState,
 	block *ethpb.SignedBeaconBlock,
 ) (iface.BeaconState, error) {
-	beaconState, err := ProcessBlockHeaderNoVerify(beaconState, block.Block)
+	beaconState, err := ProcessBlockHeaderNoVerify(committeeCache, beaconState, block.Block)
 	if err != nil {
 		return nil, err
 	}
@@ -80,6 +82,7 @@ func ProcessBlockHeader(
 //    proposer = state.validators[get_beacon_proposer_index(state)]
 //    assert not proposer.slashed
 func ProcessBlockHeaderNoVerify(
+	committeeCache *cache.CommitteeCache,
 	beaconState iface.BeaconState,
 	block *ethpb.BeaconBlock,
 ) (iface.BeaconState, error) {
@@ -89,7 +92,7 @@ func ProcessBlockHeaderNoVerify(
 	if beaconState.Slot() != block.Slot {
 		return nil, fmt.Errorf("state slot: %d is different than block slot: %d", beaconState.Slot(), block.Slot)
 	}
-	idx, err := helpers.BeaconProposerIndex(beaconState)
+	idx, err := helpers.BeaconProposerIndex(committeeCache, beaconState)
 	if err != nil {
 		return nil, err
 	}
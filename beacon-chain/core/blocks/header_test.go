@@ -48,7 +48,7 @@ func TestProcessBlockHeader_ImproperBlockSlot(t *testing.T) {
 	currentEpoch := helpers.CurrentEpoch(state)
 	priv, err := bls.RandKey()
 	require.NoError(t, err)
-	pID, err := helpers.BeaconProposerIndex(state)
+	pID, err := helpers.BeaconProposerIndex(nil, state)
 	require.NoError(t, err)
 	block := testutil.NewBeaconBlock()
 	block.Block.ProposerIndex = pID
@@ -58,14 +58,14 @@ func TestProcessBlockHeader_ImproperBlockSlot(t *testing.T) {
 	block.Signature, err = helpers.ComputeDomainAndSign(state, currentEpoch, block.Block, params.BeaconConfig().DomainBeaconProposer, priv)
 	require.NoError(t, err)
 
-	proposerIdx, err := helpers.BeaconProposerIndex(state)
+	proposerIdx, err := helpers.BeaconProposerIndex(nil, state)
 	require.NoError(t, err)
 	validators[proposerIdx].Slashed = false
 	validators[proposerIdx].PublicKey = priv.PublicKey().Marshal()
 	err = state.UpdateValidatorAtIndex(proposerIdx, validators[proposerIdx])
 	require.NoError(t, err)
 
-	_, err = blocks.ProcessBlockHeader(context.Background(), state, block)
+	_, err = blocks.ProcessBlockHeader(context.Background(), nil, state, block)
 	assert.ErrorContains(t, "block.Slot 10 must be greater than state.LatestBlockHeader.Slot 10", err)
 }
 
@@ -80,7 +80,7 @@ func TestProcessBlockHeader_WrongProposerSig(t *testing.T) {
 	lbhdr, err := beaconState.LatestBlockHeader().HashTreeRoot()
 	require.NoError(t, err)
 
-	proposerIdx, err := helpers.BeaconProposerIndex(beaconState)
+	proposerIdx, err := helpers.BeaconProposerIndex(nil, beaconState)
 	require.NoError(t, err)
 
 	block := testutil.NewBeaconBlock()
@@ -91,7 +91,7 @@ func TestProcessBlockHeader_WrongProposerSig(t *testing.T) {
 	block.Signature, err = helpers.ComputeDomainAndSign(beaconState, 0, block.Block, params.BeaconConfig().DomainBeaconProposer, privKeys[proposerIdx+1])
 	require.NoError(t, err)
 
-	_, err = blocks.ProcessBlockHeader(context.Background(), beaconState, block)
+	_, err = blocks.ProcessBlockHeader(context.Background(), nil, beaconState, block)
 	want := "signature did not verify"
 	assert.ErrorContains(t, want, err)
 }
@@ -136,7 +136,7 @@ func TestProcessBlockHeader_DifferentSlots(t *testing.T) {
 		Signature: blockSig,
 	}
 
-	_, err = blocks.ProcessBlockHeader(context.Background(), state, block)
+	_, err = blocks.ProcessBlockHeader(context.Background(), nil, state, block)
 	want := "is different than block slot"
 	assert.ErrorContains(t, want, err)
 }
@@ -166,7 +166,7 @@ func TestProcessBlockHeader_PreviousBlockRootNotSignedRoot(t *testing.T) {
 	blockSig, err := helpers.ComputeDomainAndSign(state, currentEpoch, &sszBytes, params.BeaconConfig().DomainBeaconProposer, priv)
 	require.NoError(t, err)
 	validators[5896].PublicKey = priv.PublicKey().Marshal()
-	pID, err := helpers.BeaconProposerIndex(state)
+	pID, err := helpers.BeaconProposerIndex(nil, state)
 	require.NoError(t, err)
 	block := testutil.NewBeaconBlock()
 	block.Block.Slot = 10
@@ -175,7 +175,7 @@ func TestProcessBlockHeader_PreviousBlockRootNotSignedRoot(t *testing.T) {
 	block.Block.ParentRoot = bytesutil.PadTo([]byte{'A'}, 32)
 	block.Signature = blockSig
 
-	_, err = blocks.ProcessBlockHeader(context.Background(), state, block)
+	_, err = blocks.ProcessBlockHeader(context.Background(), nil, state, block)
 	want := "does not match"
 	assert.ErrorContains(t, want, err)
 }
@@ -208,7 +208,7 @@ func TestProcessBlockHeader_SlashedProposer(t *testing.T) {
 	require.NoError(t, err)
 
 	validators[12683].PublicKey = priv.PublicKey().Marshal()
-	pID, err := helpers.BeaconProposerIndex(state)
+	pID, err := helpers.BeaconProposerIndex(nil, state)
 	require.NoError(t, err)
 	block := testutil.NewBeaconBlock()
 	block.Block.Slot = 10
@@ -217,7 +217,7 @@ func TestProcessBlockHeader_SlashedProposer(t *testing.T) {
 	block.Block.ParentRoot = parentRoot[:]
 	block.Signature = blockSig
 
-	_, err = blocks.ProcessBlockHeader(context.Background(), state, block)
+	_, err = blocks.ProcessBlockHeader(context.Background(), nil, state, block)
 	want := "was previously slashed"
 	assert.ErrorContains(t, want, err)
 }
@@ -247,7 +247,7 @@ func TestProcessBlockHeader_OK(t *testing.T) {
 	currentEpoch := helpers.CurrentEpoch(state)
 	priv, err := bls.RandKey()
 	require.NoError(t, err)
-	pID, err := helpers.BeaconProposerIndex(state)
+	pID, err := helpers.BeaconProposerIndex(nil, state)
 	require.NoError(t, err)
 	block := testutil.NewBeaconBlock()
 	block.Block.ProposerIndex = pID
@@ -259,14 +259,14 @@ func TestProcessBlockHeader_OK(t *testing.T) {
 	bodyRoot, err := block.Block.Body.HashTreeRoot()
 	require.NoError(t, err, "Failed to hash block bytes got")
 
-	proposerIdx, err := helpers.BeaconProposerIndex(state)
+	proposerIdx, err := helpers.BeaconProposerIndex(nil, state)
 	require.NoError(t, err)
 	validators[proposerIdx].Slashed = false
 	validators[proposerIdx].PublicKey = priv.PublicKey().Marshal()
 	err = state.UpdateValidatorAtIndex(proposerIdx, validators[proposerIdx])
 	require.NoError(t, err)
 
-	newState, err := blocks.ProcessBlockHeader(context.Background(), state, block)
+	newState, err := blocks.ProcessBlockHeader(context.Background(), nil, state, block)
 	require.NoError(t, err, "Failed to process block header got")
 	var zeroHash [32]byte
 	nsh := newState.LatestBlockHeader()
@@ -306,7 +306,7 @@ func TestBlockSignatureSet_OK(t *testing.T) {
 	currentEpoch := helpers.CurrentEpoch(state)
 	priv, err := bls.RandKey()
 	require.NoError(t, err)
-	pID, err := helpers.BeaconProposerIndex(state)
+	pID, err := helpers.BeaconProposerIndex(nil, state)
 	require.NoError(t, err)
 	block := testutil.NewBeaconBlock()
 	block.Block.Slot = 10
@@ -315,7 +315,7 @@ func TestBlockSignatureSet_OK(t *testing.T) {
 	block.Block.ParentRoot = latestBlockSignedRoot[:]
 	block.Signature, err = helpers.ComputeDomainAndSign(state, currentEpoch, block.Block, params.BeaconConfig().DomainBeaconProposer, priv)
 	require.NoError(t, err)
-	proposerIdx, err := helpers.BeaconProposerIndex(state)
+	proposerIdx, err := helpers.BeaconProposerIndex(nil, state)
 	require.NoError(t, err)
 	validators[proposerIdx].Slashed = false
 	validators[proposerIdx].PublicKey = priv.PublicKey().Marshal()
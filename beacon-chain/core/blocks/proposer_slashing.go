@@ -7,6 +7,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	v "github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
@@ -37,6 +38,7 @@ import (
 //    slash_validator(state, proposer_slashing.proposer_index)
 func ProcessProposerSlashings(
 	_ context.Context,
+	committeeCache *cache.CommitteeCache,
 	beaconState iface.BeaconState,
 	b *ethpb.SignedBeaconBlock,
 ) (iface.BeaconState, error) {
@@ -54,7 +56,7 @@ func ProcessProposerSlashings(
 			return nil, errors.Wrapf(err, "could not verify proposer slashing %d", idx)
 		}
 		beaconState, err = v.SlashValidator(
-			beaconState, slashing.Header_1.Header.ProposerIndex,
+			committeeCache, beaconState, slashing.Header_1.Header.ProposerIndex,
 		)
 		if err != nil {
 			return nil, errors.Wrapf(err, "could not slash proposer index %d", slashing.Header_1.Header.ProposerIndex)
@@ -5,6 +5,7 @@ import (
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
@@ -27,6 +28,7 @@ import (
 //    state.randao_mixes[epoch % EPOCHS_PER_HISTORICAL_VECTOR] = mix
 func ProcessRandao(
 	_ context.Context,
+	committeeCache *cache.CommitteeCache,
 	beaconState iface.BeaconState,
 	b *ethpb.SignedBeaconBlock,
 ) (iface.BeaconState, error) {
@@ -34,7 +36,7 @@ func ProcessRandao(
 		return nil, err
 	}
 	body := b.Block.Body
-	buf, proposerPub, domain, err := randaoSigningData(beaconState)
+	buf, proposerPub, domain, err := randaoSigningData(committeeCache, beaconState)
 	if err != nil {
 		return nil, err
 	}
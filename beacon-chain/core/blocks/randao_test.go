@@ -19,7 +19,7 @@ import (
 func TestProcessRandao_IncorrectProposerFailsVerification(t *testing.T) {
 	beaconState, privKeys := testutil.DeterministicGenesisState(t, 100)
 	// We fetch the proposer's index as that is whom the RANDAO will be verified against.
-	proposerIdx, err := helpers.BeaconProposerIndex(beaconState)
+	proposerIdx, err := helpers.BeaconProposerIndex(nil, beaconState)
 	require.NoError(t, err)
 	epoch := types.Epoch(0)
 	buf := make([]byte, 32)
@@ -38,7 +38,7 @@ func TestProcessRandao_IncorrectProposerFailsVerification(t *testing.T) {
 	}
 
 	want := "block randao: signature did not verify"
-	_, err = blocks.ProcessRandao(context.Background(), beaconState, b)
+	_, err = blocks.ProcessRandao(context.Background(), nil, beaconState, b)
 	assert.ErrorContains(t, want, err)
 }
 
@@ -56,8 +56,7 @@ func TestProcessRandao_SignatureVerifiesAndUpdatesLatestStateMixes(t *testing.T)
 		},
 	}
 
-	newState, err := blocks.ProcessRandao(
-		context.Background(),
+	newState, err := blocks.ProcessRandao(context.Background(), nil,
 		beaconState,
 		b,
 	)
@@ -80,7 +79,7 @@ func TestRandaoSignatureSet_OK(t *testing.T) {
 		},
 	}
 
-	set, err := blocks.RandaoSignatureSet(beaconState, block.Body)
+	set, err := blocks.RandaoSignatureSet(nil, beaconState, block.Body)
 	require.NoError(t, err)
 	verified, err := set.Verify()
 	require.NoError(t, err)
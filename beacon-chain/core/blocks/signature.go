@@ -7,6 +7,7 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -91,10 +92,10 @@ func BlockSignatureSet(beaconState iface.ReadOnlyBeaconState, block *ethpb.Signe
 
 // RandaoSignatureSet retrieves the relevant randao specific signature set object
 // from a block and its corresponding state.
-func RandaoSignatureSet(beaconState iface.ReadOnlyBeaconState,
+func RandaoSignatureSet(committeeCache *cache.CommitteeCache, beaconState iface.ReadOnlyBeaconState,
 	body *ethpb.BeaconBlockBody,
 ) (*bls.SignatureSet, error) {
-	buf, proposerPub, domain, err := randaoSigningData(beaconState)
+	buf, proposerPub, domain, err := randaoSigningData(committeeCache, beaconState)
 	if err != nil {
 		return nil, err
 	}
@@ -106,8 +107,8 @@ func RandaoSignatureSet(beaconState iface.ReadOnlyBeaconState,
 }
 
 // retrieves the randao related signing data from the state.
-func randaoSigningData(beaconState iface.ReadOnlyBeaconState) ([]byte, []byte, []byte, error) {
-	proposerIdx, err := helpers.BeaconProposerIndex(beaconState)
+func randaoSigningData(committeeCache *cache.CommitteeCache, beaconState iface.ReadOnlyBeaconState) ([]byte, []byte, []byte, error) {
+	proposerIdx, err := helpers.BeaconProposerIndex(committeeCache, beaconState)
 	if err != nil {
 		return nil, nil, nil, errors.Wrap(err, "could not get beacon proposer index")
 	}
@@ -127,6 +128,7 @@ func randaoSigningData(beaconState iface.ReadOnlyBeaconState) ([]byte, []byte, [
 // Method to break down attestations of the same domain and collect them into a single signature set.
 func createAttestationSignatureSet(
 	ctx context.Context,
+	committeeCache *cache.CommitteeCache,
 	beaconState iface.ReadOnlyBeaconState,
 	atts []*ethpb.Attestation,
 	domain []byte,
@@ -140,7 +142,7 @@ func createAttestationSignatureSet(
 	msgs := make([][32]byte, len(atts))
 	for i, a := range atts {
 		sigs[i] = a.Signature
-		c, err := helpers.BeaconCommitteeFromState(beaconState, a.Data.Slot, a.Data.CommitteeIndex)
+		c, err := helpers.BeaconCommitteeFromState(committeeCache, beaconState, a.Data.Slot, a.Data.CommitteeIndex)
 		if err != nil {
 			return nil, err
 		}
@@ -178,7 +180,7 @@ func createAttestationSignatureSet(
 
 // AttestationSignatureSet retrieves all the related attestation signature data such as the relevant public keys,
 // signatures and attestation signing data and collate it into a signature set object.
-func AttestationSignatureSet(ctx context.Context, beaconState iface.ReadOnlyBeaconState, atts []*ethpb.Attestation) (*bls.SignatureSet, error) {
+func AttestationSignatureSet(ctx context.Context, committeeCache *cache.CommitteeCache, beaconState iface.ReadOnlyBeaconState, atts []*ethpb.Attestation) (*bls.SignatureSet, error) {
 	if len(atts) == 0 {
 		return bls.NewSet(), nil
 	}
@@ -205,7 +207,7 @@ func AttestationSignatureSet(ctx context.Context, beaconState iface.ReadOnlyBeac
 		if err != nil {
 			return nil, err
 		}
-		aSet, err := createAttestationSignatureSet(ctx, beaconState, preForkAtts, prevDomain)
+		aSet, err := createAttestationSignatureSet(ctx, committeeCache, beaconState, preForkAtts, prevDomain)
 		if err != nil {
 			return nil, err
 		}
@@ -222,7 +224,7 @@ func AttestationSignatureSet(ctx context.Context, beaconState iface.ReadOnlyBeac
 		return nil, err
 	}
 
-	aSet, err := createAttestationSignatureSet(ctx, beaconState, postForkAtts, currDomain)
+	aSet, err := createAttestationSignatureSet(ctx, committeeCache, beaconState, postForkAtts, currDomain)
 	if err != nil {
 		return nil, err
 	}
@@ -46,7 +46,7 @@ func runBlockHeaderTest(t *testing.T, config string) {
 			}
 
 			// Spectest blocks are not signed, so we'll call NoVerify to skip sig verification.
-			beaconState, err := blocks.ProcessBlockHeaderNoVerify(preBeaconState, block)
+			beaconState, err := blocks.ProcessBlockHeaderNoVerify(nil, preBeaconState, block)
 			if postSSZExists {
 				require.NoError(t, err)
 
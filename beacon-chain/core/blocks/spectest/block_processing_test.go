@@ -57,7 +57,7 @@ func runBlockProcessingTest(t *testing.T, config string) {
 				require.NoError(t, err)
 				block := &ethpb.SignedBeaconBlock{}
 				require.NoError(t, block.UnmarshalSSZ(blockFile), "Failed to unmarshal")
-				processedState, transitionError = state.ExecuteStateTransition(context.Background(), beaconState, block)
+				processedState, transitionError = state.ExecuteStateTransition(context.Background(), nil, beaconState, block)
 				if transitionError != nil {
 					break
 				}
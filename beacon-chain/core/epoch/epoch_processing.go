@@ -11,6 +11,7 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
@@ -46,8 +47,8 @@ func (s sortableIndices) Less(i, j int) bool {
 // Spec pseudocode definition:
 //  def get_attesting_balance(state: BeaconState, attestations: List[PendingAttestation]) -> Gwei:
 //    return get_total_balance(state, get_unslashed_attesting_indices(state, attestations))
-func AttestingBalance(state iface.ReadOnlyBeaconState, atts []*pb.PendingAttestation) (uint64, error) {
-	indices, err := UnslashedAttestingIndices(state, atts)
+func AttestingBalance(committeeCache *cache.CommitteeCache, state iface.ReadOnlyBeaconState, atts []*pb.PendingAttestation) (uint64, error) {
+	indices, err := UnslashedAttestingIndices(committeeCache, state, atts)
 	if err != nil {
 		return 0, errors.Wrap(err, "could not get attesting indices")
 	}
@@ -77,7 +78,7 @@ func AttestingBalance(state iface.ReadOnlyBeaconState, atts []*pb.PendingAttesta
 //    for index in activation_queue[:get_validator_churn_limit(state)]:
 //        validator = state.validators[index]
 //        validator.activation_epoch = compute_activation_exit_epoch(get_current_epoch(state))
-func ProcessRegistryUpdates(state iface.BeaconState) (iface.BeaconState, error) {
+func ProcessRegistryUpdates(committeeCache *cache.CommitteeCache, state iface.BeaconState) (iface.BeaconState, error) {
 	currentEpoch := helpers.CurrentEpoch(state)
 	vals := state.Validators()
 	var err error
@@ -96,7 +97,7 @@ func ProcessRegistryUpdates(state iface.BeaconState) (iface.BeaconState, error)
 		isActive := helpers.IsActiveValidator(validator, currentEpoch)
 		belowEjectionBalance := validator.EffectiveBalance <= ejectionBal
 		if isActive && belowEjectionBalance {
-			state, err = validators.InitiateValidatorExit(state, types.ValidatorIndex(idx))
+			state, err = validators.InitiateValidatorExit(committeeCache, state, types.ValidatorIndex(idx))
 			if err != nil {
 				return nil, errors.Wrapf(err, "could not initiate exit for validator %d", idx)
 			}
@@ -115,7 +116,7 @@ func ProcessRegistryUpdates(state iface.BeaconState) (iface.BeaconState, error)
 
 	// Only activate just enough validators according to the activation churn limit.
 	limit := uint64(len(activationQ))
-	activeValidatorCount, err := helpers.ActiveValidatorCount(state, currentEpoch)
+	activeValidatorCount, err := helpers.ActiveValidatorCount(committeeCache, state, currentEpoch)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get active validator count")
 	}
@@ -340,12 +341,12 @@ func ProcessFinalUpdates(state iface.BeaconState) (iface.BeaconState, error) {
 //    for a in attestations:
 //        output = output.union(get_attesting_indices(state, a.data, a.aggregation_bits))
 //    return set(filter(lambda index: not state.validators[index].slashed, output))
-func UnslashedAttestingIndices(state iface.ReadOnlyBeaconState, atts []*pb.PendingAttestation) ([]types.ValidatorIndex, error) {
+func UnslashedAttestingIndices(committeeCache *cache.CommitteeCache, state iface.ReadOnlyBeaconState, atts []*pb.PendingAttestation) ([]types.ValidatorIndex, error) {
 	var setIndices []types.ValidatorIndex
 	seen := make(map[uint64]bool)
 
 	for _, att := range atts {
-		committee, err := helpers.BeaconCommitteeFromState(state, att.Data.Slot, att.Data.CommitteeIndex)
+		committee, err := helpers.BeaconCommitteeFromState(committeeCache, state, att.Data.Slot, att.Data.CommitteeIndex)
 		if err != nil {
 			return nil, err
 		}
@@ -39,7 +39,7 @@ func TestProcessRewardsAndPenaltiesPrecompute(t *testing.T) {
 
 	vp, bp, err := New(context.Background(), beaconState)
 	require.NoError(t, err)
-	vp, bp, err = ProcessAttestations(context.Background(), beaconState, vp, bp)
+	vp, bp, err = ProcessAttestations(context.Background(), nil, beaconState, vp, bp)
 	require.NoError(t, err)
 
 	processedState, err := ProcessRewardsAndPenaltiesPrecompute(beaconState, bp, vp)
@@ -89,7 +89,7 @@ func TestAttestationDeltaPrecompute(t *testing.T) {
 
 	vp, bp, err := New(context.Background(), beaconState)
 	require.NoError(t, err)
-	vp, bp, err = ProcessAttestations(context.Background(), beaconState, vp, bp)
+	vp, bp, err = ProcessAttestations(context.Background(), nil, beaconState, vp, bp)
 	require.NoError(t, err)
 
 	// Add some variances to target and head balances.
@@ -98,7 +98,7 @@ func TestAttestationDeltaPrecompute(t *testing.T) {
 	bp.PrevEpochHeadAttested = bp.PrevEpochHeadAttested * 2 / 3
 	rewards, penalties, err := AttestationsDelta(beaconState, bp, vp)
 	require.NoError(t, err)
-	attestedBalance, err := epoch.AttestingBalance(beaconState, atts)
+	attestedBalance, err := epoch.AttestingBalance(nil, beaconState, atts)
 	require.NoError(t, err)
 	totalBalance, err := helpers.TotalActiveBalance(beaconState)
 	require.NoError(t, err)
@@ -167,7 +167,7 @@ func TestAttestationDeltas_ZeroEpoch(t *testing.T) {
 
 	pVals, pBal, err := New(context.Background(), beaconState)
 	assert.NoError(t, err)
-	pVals, pBal, err = ProcessAttestations(context.Background(), beaconState, pVals, pBal)
+	pVals, pBal, err = ProcessAttestations(context.Background(), nil, beaconState, pVals, pBal)
 	require.NoError(t, err)
 
 	pBal.ActiveCurrentEpoch = 0 // Could cause a divide by zero panic.
@@ -205,7 +205,7 @@ func TestAttestationDeltas_ZeroInclusionDelay(t *testing.T) {
 
 	pVals, pBal, err := New(context.Background(), beaconState)
 	require.NoError(t, err)
-	_, _, err = ProcessAttestations(context.Background(), beaconState, pVals, pBal)
+	_, _, err = ProcessAttestations(context.Background(), nil, beaconState, pVals, pBal)
 	require.ErrorContains(t, "attestation with inclusion delay of 0", err)
 }
 
@@ -239,7 +239,7 @@ func TestProcessRewardsAndPenaltiesPrecompute_SlashedInactivePenalty(t *testing.
 
 	vp, bp, err := New(context.Background(), beaconState)
 	require.NoError(t, err)
-	vp, bp, err = ProcessAttestations(context.Background(), beaconState, vp, bp)
+	vp, bp, err = ProcessAttestations(context.Background(), nil, beaconState, vp, bp)
 	require.NoError(t, err)
 	rewards, penalties, err := AttestationsDelta(beaconState, bp, vp)
 	require.NoError(t, err)
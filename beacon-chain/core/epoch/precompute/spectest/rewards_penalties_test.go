@@ -49,7 +49,7 @@ func runPrecomputeRewardsAndPenaltiesTest(t *testing.T, testFolderPath string) {
 
 	vp, bp, err := precompute.New(ctx, preBeaconState)
 	require.NoError(t, err)
-	vp, bp, err = precompute.ProcessAttestations(ctx, preBeaconState, vp, bp)
+	vp, bp, err = precompute.ProcessAttestations(ctx, nil, preBeaconState, vp, bp)
 	require.NoError(t, err)
 
 	rewards, penalties, err := precompute.AttestationsDelta(preBeaconState, bp, vp)
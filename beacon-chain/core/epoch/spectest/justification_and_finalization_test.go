@@ -29,7 +29,7 @@ func processJustificationAndFinalizationPrecomputeWrapper(t *testing.T, st iface
 	ctx := context.Background()
 	vp, bp, err := precompute.New(ctx, st)
 	require.NoError(t, err)
-	_, bp, err = precompute.ProcessAttestations(ctx, st, vp, bp)
+	_, bp, err = precompute.ProcessAttestations(ctx, nil, st, vp, bp)
 	require.NoError(t, err)
 
 	st, err = precompute.ProcessJustificationAndFinalizationPreCompute(st, bp)
@@ -24,7 +24,7 @@ func runRegistryUpdatesTests(t *testing.T, config string) {
 }
 
 func processRegistryUpdatesWrapper(t *testing.T, state iface.BeaconState) (iface.BeaconState, error) {
-	state, err := epoch.ProcessRegistryUpdates(state)
+	state, err := epoch.ProcessRegistryUpdates(nil, state)
 	require.NoError(t, err, "Could not process registry updates")
 	return state, nil
 }
@@ -31,7 +31,7 @@ func processRewardsAndPenaltiesPrecomputeWrapper(t *testing.T, st iface.BeaconSt
 	ctx := context.Background()
 	vp, bp, err := precompute.New(ctx, st)
 	require.NoError(t, err)
-	vp, bp, err = precompute.ProcessAttestations(ctx, st, vp, bp)
+	vp, bp, err = precompute.ProcessAttestations(ctx, nil, st, vp, bp)
 	require.NoError(t, err)
 
 	st, err = precompute.ProcessRewardsAndPenaltiesPrecompute(st, bp, vp)
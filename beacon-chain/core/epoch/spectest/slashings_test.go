@@ -36,7 +36,7 @@ func processSlashingsPrecomputeWrapper(t *testing.T, state iface.BeaconState) (i
 	ctx := context.Background()
 	vp, bp, err := precompute.New(ctx, state)
 	require.NoError(t, err)
-	_, bp, err = precompute.ProcessAttestations(ctx, state, vp, bp)
+	_, bp, err = precompute.ProcessAttestations(ctx, nil, state, vp, bp)
 	require.NoError(t, err)
 
 	return state, precompute.ProcessSlashingsPrecompute(state, bp)
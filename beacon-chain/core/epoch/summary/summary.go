@@ -0,0 +1,208 @@
+// Package summary computes the summary of an epoch which is archived at finalization, so that
+// participation and registry statistics of past epochs can be served without replaying states.
+package summary
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// sszSize is the length of the SSZ encoding of an epoch summary, which is a container of ten
+// uint64 fields and three 32 byte roots.
+const sszSize = 10*8 + 3*32
+
+// EpochSummary is the summary of a finalized epoch, computed from the state at its start.
+type EpochSummary struct {
+	Epoch types.Epoch
+	// ValidatorCount is the number of validators in the registry.
+	ValidatorCount uint64
+	// ActiveValidatorCount is the number of validators active at the epoch.
+	ActiveValidatorCount uint64
+	// TotalBalance is the sum of the balances of all validators in the registry, in Gwei.
+	TotalBalance uint64
+	// TotalActiveBalance is the sum of the effective balances of the active validators, in Gwei.
+	TotalActiveBalance uint64
+	// EligibleBalance is the effective balance of the validators active in the previous epoch.
+	EligibleBalance uint64
+	// TargetAttestingBalance is the effective balance of the validators whose attestations for the
+	// previous epoch voted for the correct target and were included within that epoch. This is
+	// the participation the justification of the previous epoch was decided on. Like in epoch
+	// processing, the attesting balances are at least one effective balance increment.
+	TargetAttestingBalance uint64
+	// HeadAttestingBalance is the part of the target attesting balance which also voted for the
+	// correct head.
+	HeadAttestingBalance uint64
+	// ProposerListHash is the hash of the proposer indices of every slot of the epoch, as
+	// consecutive little endian uint64s.
+	ProposerListHash [32]byte
+	JustifiedEpoch   types.Epoch
+	JustifiedRoot    [32]byte
+	FinalizedEpoch   types.Epoch
+	FinalizedRoot    [32]byte
+}
+
+// New computes the summary of the epoch of the given state, which must be at the start slot of
+// the epoch, as the state of a finalized checkpoint is.
+func New(ctx context.Context, committeeCache *cache.CommitteeCache, st iface.BeaconState) (*EpochSummary, error) {
+	epoch := helpers.CurrentEpoch(st)
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, err
+	}
+	if st.Slot() != startSlot {
+		return nil, errors.Errorf("state slot %d is not the start slot of epoch %d", st.Slot(), epoch)
+	}
+
+	s := &EpochSummary{
+		Epoch:          epoch,
+		ValidatorCount: uint64(st.NumValidators()),
+	}
+	for _, b := range st.Balances() {
+		s.TotalBalance += b
+	}
+	if err := st.ReadFromEveryValidator(func(idx int, val iface.ReadOnlyValidator) error {
+		if helpers.IsActiveValidatorUsingTrie(val, epoch) {
+			s.ActiveValidatorCount++
+			s.TotalActiveBalance += val.EffectiveBalance()
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	vp, bp, err := precompute.New(ctx, st)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not initialize precompute")
+	}
+	_, bp, err = precompute.ProcessAttestations(ctx, committeeCache, st, vp, bp)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process attestations")
+	}
+	// The genesis epoch has no previous epoch to participate in.
+	if epoch > 0 {
+		s.EligibleBalance = bp.ActivePrevEpoch
+		s.TargetAttestingBalance = bp.PrevEpochTargetAttested
+		s.HeadAttestingBalance = bp.PrevEpochHeadAttested
+	}
+
+	s.ProposerListHash, err = proposerListHash(committeeCache, st.Copy(), startSlot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute proposer list hash")
+	}
+	if cp := st.CurrentJustifiedCheckpoint(); cp != nil {
+		s.JustifiedEpoch = cp.Epoch
+		s.JustifiedRoot = bytesutil.ToBytes32(cp.Root)
+	}
+	if cp := st.FinalizedCheckpoint(); cp != nil {
+		s.FinalizedEpoch = cp.Epoch
+		s.FinalizedRoot = bytesutil.ToBytes32(cp.Root)
+	}
+	return s, nil
+}
+
+// proposerListHash hashes the proposer indices of the epoch starting at the given slot. The
+// genesis slot has no proposer, its index is left zero. The slot of the state is moved, so it
+// must not be shared.
+func proposerListHash(committeeCache *cache.CommitteeCache, st iface.BeaconState, startSlot types.Slot) ([32]byte, error) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	buf := make([]byte, 8*slotsPerEpoch)
+	for i := types.Slot(0); i < slotsPerEpoch; i++ {
+		slot := startSlot + i
+		if slot == 0 {
+			continue
+		}
+		if err := st.SetSlot(slot); err != nil {
+			return [32]byte{}, err
+		}
+		index, err := helpers.BeaconProposerIndex(committeeCache, st)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		binary.LittleEndian.PutUint64(buf[8*i:], uint64(index))
+	}
+	return hashutil.Hash(buf), nil
+}
+
+// ParticipationRate is the target attesting balance over the eligible balance.
+func (s *EpochSummary) ParticipationRate() float64 {
+	if s.EligibleBalance == 0 {
+		return 0
+	}
+	return float64(s.TargetAttestingBalance) / float64(s.EligibleBalance)
+}
+
+// SizeSSZ returns the length of the SSZ encoding of the summary.
+func (s *EpochSummary) SizeSSZ() int {
+	return sszSize
+}
+
+// MarshalSSZ encodes the summary as an SSZ container of its fields in declaration order. All
+// fields are fixed size, so the encoding has no offsets.
+func (s *EpochSummary) MarshalSSZ() ([]byte, error) {
+	return s.MarshalSSZTo(make([]byte, 0, sszSize))
+}
+
+// MarshalSSZTo appends the SSZ encoding of the summary to dst.
+func (s *EpochSummary) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var buf [8]byte
+	putUint64 := func(v uint64) {
+		binary.LittleEndian.PutUint64(buf[:], v)
+		dst = append(dst, buf[:]...)
+	}
+	putUint64(uint64(s.Epoch))
+	putUint64(s.ValidatorCount)
+	putUint64(s.ActiveValidatorCount)
+	putUint64(s.TotalBalance)
+	putUint64(s.TotalActiveBalance)
+	putUint64(s.EligibleBalance)
+	putUint64(s.TargetAttestingBalance)
+	putUint64(s.HeadAttestingBalance)
+	dst = append(dst, s.ProposerListHash[:]...)
+	putUint64(uint64(s.JustifiedEpoch))
+	dst = append(dst, s.JustifiedRoot[:]...)
+	putUint64(uint64(s.FinalizedEpoch))
+	dst = append(dst, s.FinalizedRoot[:]...)
+	return dst, nil
+}
+
+// UnmarshalSSZ decodes a summary encoded by MarshalSSZ.
+func (s *EpochSummary) UnmarshalSSZ(enc []byte) error {
+	if len(enc) != sszSize {
+		return errors.Errorf("invalid epoch summary encoding length %d, wanted %d", len(enc), sszSize)
+	}
+	uint64At := func() uint64 {
+		v := binary.LittleEndian.Uint64(enc[:8])
+		enc = enc[8:]
+		return v
+	}
+	rootAt := func() [32]byte {
+		var r [32]byte
+		copy(r[:], enc[:32])
+		enc = enc[32:]
+		return r
+	}
+	s.Epoch = types.Epoch(uint64At())
+	s.ValidatorCount = uint64At()
+	s.ActiveValidatorCount = uint64At()
+	s.TotalBalance = uint64At()
+	s.TotalActiveBalance = uint64At()
+	s.EligibleBalance = uint64At()
+	s.TargetAttestingBalance = uint64At()
+	s.HeadAttestingBalance = uint64At()
+	s.ProposerListHash = rootAt()
+	s.JustifiedEpoch = types.Epoch(uint64At())
+	s.JustifiedRoot = rootAt()
+	s.FinalizedEpoch = types.Epoch(uint64At())
+	s.FinalizedRoot = rootAt()
+	return nil
+}
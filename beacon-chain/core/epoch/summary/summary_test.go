@@ -0,0 +1,79 @@
+package summary
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	startSlot, err := helpers.StartSlot(1)
+	require.NoError(t, err)
+	st, err = state.ProcessSlots(ctx, nil, st, startSlot)
+	require.NoError(t, err)
+
+	s, err := New(ctx, nil, st)
+	require.NoError(t, err)
+	maxBalance := params.BeaconConfig().MaxEffectiveBalance
+	assert.Equal(t, uint64(1), uint64(s.Epoch))
+	assert.Equal(t, uint64(64), s.ValidatorCount)
+	assert.Equal(t, uint64(64), s.ActiveValidatorCount)
+	assert.Equal(t, 64*maxBalance, s.TotalBalance)
+	assert.Equal(t, 64*maxBalance, s.TotalActiveBalance)
+	assert.Equal(t, 64*maxBalance, s.EligibleBalance)
+	// No attestations were included in the genesis epoch, the attesting balances are floored at
+	// one increment like in epoch processing.
+	increment := params.BeaconConfig().EffectiveBalanceIncrement
+	assert.Equal(t, increment, s.TargetAttestingBalance)
+	assert.Equal(t, increment, s.HeadAttestingBalance)
+	assert.NotEqual(t, [32]byte{}, s.ProposerListHash)
+	// Computing the proposers does not move the state.
+	assert.Equal(t, startSlot, st.Slot())
+
+	again, err := New(ctx, nil, st)
+	require.NoError(t, err)
+	assert.Equal(t, s.ProposerListHash, again.ProposerListHash)
+
+	require.NoError(t, st.SetSlot(startSlot+1))
+	_, err = New(ctx, nil, st)
+	assert.ErrorContains(t, "is not the start slot of epoch 1", err)
+}
+
+func TestEpochSummary_SSZ(t *testing.T) {
+	s := &EpochSummary{
+		Epoch:                  7,
+		ValidatorCount:         100,
+		ActiveValidatorCount:   90,
+		TotalBalance:           3200e9,
+		TotalActiveBalance:     2880e9,
+		EligibleBalance:        2880e9,
+		TargetAttestingBalance: 2000e9,
+		HeadAttestingBalance:   1900e9,
+		ProposerListHash:       [32]byte{1, 2, 3},
+		JustifiedEpoch:         6,
+		JustifiedRoot:          [32]byte{4},
+		FinalizedEpoch:         5,
+		FinalizedRoot:          [32]byte{5},
+	}
+	enc, err := s.MarshalSSZ()
+	require.NoError(t, err)
+	assert.Equal(t, s.SizeSSZ(), len(enc))
+	// The fields are encoded in declaration order.
+	assert.Equal(t, byte(7), enc[0])
+	assert.Equal(t, byte(1), enc[64])
+
+	decoded := &EpochSummary{}
+	require.NoError(t, decoded.UnmarshalSSZ(enc))
+	assert.DeepEqual(t, s, decoded)
+	assert.Equal(t, float64(2000)/float64(2880), decoded.ParticipationRate())
+
+	assert.ErrorContains(t, "invalid epoch summary encoding length", decoded.UnmarshalSSZ(enc[1:]))
+}
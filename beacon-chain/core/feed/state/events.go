@@ -8,6 +8,7 @@ import (
 
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/reorg"
 )
 
 const (
@@ -22,6 +23,10 @@ const (
 	// Reorg is an event sent when the new head state's slot after a block
 	// transition is lower than its previous head state slot value.
 	Reorg
+	// EpochTransition is sent when the head of the chain advances into a new epoch.
+	EpochTransition
+	// Finalized is sent when the finalized checkpoint advances.
+	Finalized
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
@@ -62,4 +67,23 @@ type ReorgData struct {
 	NewSlot types.Slot
 	// OldSlot is the slot of the head state before the reorg.
 	OldSlot types.Slot
+	// Event is the archived record of the reorg. It is nil if the common ancestor of the heads
+	// could not be determined.
+	Event *reorg.Event
+}
+
+// EpochTransitionData is the data sent with EpochTransition events.
+type EpochTransitionData struct {
+	// Epoch the head advanced into.
+	Epoch types.Epoch
+	// Slot of the new head block.
+	Slot types.Slot
+}
+
+// FinalizedData is the data sent with Finalized events.
+type FinalizedData struct {
+	// Epoch of the new finalized checkpoint.
+	Epoch types.Epoch
+	// Root of the block of the new finalized checkpoint.
+	Root [32]byte
 }
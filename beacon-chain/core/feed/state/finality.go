@@ -0,0 +1,47 @@
+package state
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/shared/event"
+)
+
+// finalityHookBuffer is the number of state events buffered for finality hooks before the oldest
+// are dropped.
+const finalityHookBuffer = 16
+
+// FinalityHook is implemented by caches which evict the provisional entries of finalized epochs
+// and keep the finalized ones as immutable, once the finalized checkpoint advances.
+type FinalityHook interface {
+	OnFinalized(epoch types.Epoch, root [32]byte)
+}
+
+// RunFinalityHooks calls the hooks in order with every finalized checkpoint sent on the state
+// feed, until the context is done. Hooks which fall behind block processing miss the oldest
+// events rather than stalling it, which only delays their eviction as finality moves forward.
+func RunFinalityHooks(ctx context.Context, notifier Notifier, name string, hooks ...FinalityHook) {
+	stateChannel := make(chan *feed.Event, finalityHookBuffer)
+	stateSub := notifier.StateFeed().SubscribeWithPolicy(stateChannel, name, event.DropOldest)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case ev := <-stateChannel:
+			if ev.Type != Finalized {
+				continue
+			}
+			data, ok := ev.Data.(*FinalizedData)
+			if !ok || data == nil {
+				continue
+			}
+			for _, hook := range hooks {
+				hook.OnFinalized(data.Epoch, data.Root)
+			}
+		case <-stateSub.Err():
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
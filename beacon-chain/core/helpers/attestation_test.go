@@ -22,7 +22,7 @@ import (
 func TestAttestation_IsAggregator(t *testing.T) {
 	t.Run("aggregator", func(t *testing.T) {
 		beaconState, privKeys := testutil.DeterministicGenesisState(t, 100)
-		committee, err := helpers.BeaconCommitteeFromState(beaconState, 0, 0)
+		committee, err := helpers.BeaconCommitteeFromState(nil, beaconState, 0, 0)
 		require.NoError(t, err)
 		sig := privKeys[0].Sign([]byte{'A'})
 		agg, err := helpers.IsAggregator(uint64(len(committee)), sig.Marshal())
@@ -35,7 +35,7 @@ func TestAttestation_IsAggregator(t *testing.T) {
 		defer params.UseMainnetConfig()
 		beaconState, privKeys := testutil.DeterministicGenesisState(t, 2048)
 
-		committee, err := helpers.BeaconCommitteeFromState(beaconState, 0, 0)
+		committee, err := helpers.BeaconCommitteeFromState(nil, beaconState, 0, 0)
 		require.NoError(t, err)
 		sig := privKeys[0].Sign([]byte{'A'})
 		agg, err := helpers.IsAggregator(uint64(len(committee)), sig.Marshal())
@@ -120,7 +120,7 @@ func TestAttestation_ComputeSubnetForAttestation(t *testing.T) {
 		XXX_unrecognized:     nil,
 		XXX_sizecache:        0,
 	}
-	valCount, err := helpers.ActiveValidatorCount(state, helpers.SlotToEpoch(att.Data.Slot))
+	valCount, err := helpers.ActiveValidatorCount(nil, state, helpers.SlotToEpoch(att.Data.Slot))
 	require.NoError(t, err)
 	sub := helpers.ComputeSubnetForAttestation(valCount, att)
 	assert.Equal(t, uint64(6), sub, "Did not get correct subnet for attestation")
@@ -4,8 +4,11 @@ package helpers
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"runtime"
 	"sort"
+	"sync"
 
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
@@ -19,7 +22,6 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 )
 
-var committeeCache = cache.NewCommitteesCache()
 var proposerIndicesCache = cache.NewProposerIndicesCache()
 
 // SlotCommitteeCount returns the number of crosslink committees of a slot. The
@@ -53,7 +55,8 @@ func SlotCommitteeCount(activeValidatorCount uint64) uint64 {
 
 // BeaconCommitteeFromState returns the crosslink committee of a given slot and committee index. This
 // is a spec implementation where state is used as an argument. In case of state retrieval
-// becomes expensive, consider using BeaconCommittee below.
+// becomes expensive, consider using BeaconCommittee below. The committee is read from and added to
+// the committee cache, unless the cache is nil.
 //
 // Spec pseudocode definition:
 //   def get_beacon_committee(state: BeaconState, slot: Slot, index: CommitteeIndex) -> Sequence[ValidatorIndex]:
@@ -68,7 +71,7 @@ func SlotCommitteeCount(activeValidatorCount uint64) uint64 {
 //        index=(slot % SLOTS_PER_EPOCH) * committees_per_slot + index,
 //        count=committees_per_slot * SLOTS_PER_EPOCH,
 //    )
-func BeaconCommitteeFromState(state iface.ReadOnlyBeaconState, slot types.Slot, committeeIndex types.CommitteeIndex) ([]types.ValidatorIndex, error) {
+func BeaconCommitteeFromState(committeeCache *cache.CommitteeCache, state iface.ReadOnlyBeaconState, slot types.Slot, committeeIndex types.CommitteeIndex) ([]types.ValidatorIndex, error) {
 	epoch := SlotToEpoch(slot)
 	seed, err := Seed(state, epoch, params.BeaconConfig().DomainBeaconAttester)
 	if err != nil {
@@ -83,18 +86,19 @@ func BeaconCommitteeFromState(state iface.ReadOnlyBeaconState, slot types.Slot,
 		return indices, nil
 	}
 
-	activeIndices, err := ActiveValidatorIndices(state, epoch)
+	activeIndices, err := ActiveValidatorIndices(committeeCache, state, epoch)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get active indices")
 	}
 
-	return BeaconCommittee(activeIndices, seed, slot, committeeIndex)
+	return BeaconCommittee(committeeCache, activeIndices, seed, slot, committeeIndex)
 }
 
 // BeaconCommittee returns the crosslink committee of a given slot and committee index. The
 // validator indices and seed are provided as an argument rather than a imported implementation
 // from the spec definition. Having them as an argument allows for cheaper computation run time.
 func BeaconCommittee(
+	committeeCache *cache.CommitteeCache,
 	validatorIndices []types.ValidatorIndex,
 	seed [32]byte,
 	slot types.Slot,
@@ -171,7 +175,11 @@ type CommitteeAssignmentContainer struct {
 // 2. Compute all committees.
 // 3. Determine the attesting slot for each committee.
 // 4. Construct a map of validator indices pointing to the respective committees.
+//
+// The computation stops with the error of the context once the context is canceled.
 func CommitteeAssignments(
+	ctx context.Context,
+	committeeCache *cache.CommitteeCache,
 	state iface.BeaconState,
 	epoch types.Epoch,
 ) (map[types.ValidatorIndex]*CommitteeAssignmentContainer, map[types.ValidatorIndex][]types.Slot, error) {
@@ -195,6 +203,9 @@ func CommitteeAssignments(
 	// Proposal epochs do not have a look ahead, so we skip them over here.
 	validProposalEpoch := epoch < nextEpoch
 	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch && validProposalEpoch; slot++ {
+		if ctx.Err() != nil {
+			return nil, nil, ctx.Err()
+		}
 		// Skip proposer assignment for genesis slot.
 		if slot == 0 {
 			continue
@@ -202,14 +213,14 @@ func CommitteeAssignments(
 		if err := state.SetSlot(slot); err != nil {
 			return nil, nil, err
 		}
-		i, err := BeaconProposerIndex(state)
+		i, err := BeaconProposerIndex(committeeCache, state)
 		if err != nil {
 			return nil, nil, errors.Wrapf(err, "could not check proposer at slot %d", state.Slot())
 		}
 		proposerIndexToSlots[i] = append(proposerIndexToSlots[i], slot)
 	}
 
-	activeValidatorIndices, err := ActiveValidatorIndices(state, epoch)
+	activeValidatorIndices, err := ActiveValidatorIndices(committeeCache, state, epoch)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -220,10 +231,13 @@ func CommitteeAssignments(
 
 	// Compute all committees for all slots.
 	for i := types.Slot(0); i < params.BeaconConfig().SlotsPerEpoch; i++ {
+		if ctx.Err() != nil {
+			return nil, nil, ctx.Err()
+		}
 		// Compute committees.
 		for j := uint64(0); j < numCommitteesPerSlot; j++ {
 			slot := startSlot + i
-			committee, err := BeaconCommitteeFromState(state, slot, types.CommitteeIndex(j) /*committee index*/)
+			committee, err := BeaconCommitteeFromState(committeeCache, state, slot, types.CommitteeIndex(j) /*committee index*/)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -242,6 +256,115 @@ func CommitteeAssignments(
 	return validatorIndexToCommittee, proposerIndexToSlots, nil
 }
 
+// CommitteeAssignmentsForIndices is a variant of CommitteeAssignments which only returns the
+// committee and proposer assignments of the requested validator indices. The epoch shuffling is
+// computed once and the committee lookups are sharded across goroutines, which keeps the cost of
+// filtered queries low on states with a large number of active validators.
+func CommitteeAssignmentsForIndices(
+	ctx context.Context,
+	committeeCache *cache.CommitteeCache,
+	state iface.BeaconState,
+	epoch types.Epoch,
+	indices []types.ValidatorIndex,
+) (map[types.ValidatorIndex]*CommitteeAssignmentContainer, map[types.ValidatorIndex][]types.Slot, error) {
+	nextEpoch := NextEpoch(state)
+	if epoch > nextEpoch {
+		return nil, nil, fmt.Errorf(
+			"epoch %d can't be greater than next epoch %d",
+			epoch,
+			nextEpoch,
+		)
+	}
+	requested := make(map[types.ValidatorIndex]bool, len(indices))
+	for _, idx := range indices {
+		requested[idx] = true
+	}
+
+	startSlot, err := StartSlot(epoch)
+	if err != nil {
+		return nil, nil, err
+	}
+	proposerIndexToSlots := make(map[types.ValidatorIndex][]types.Slot)
+	// Proposal epochs do not have a look ahead, so we skip them over here.
+	validProposalEpoch := epoch < nextEpoch
+	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch && validProposalEpoch; slot++ {
+		if ctx.Err() != nil {
+			return nil, nil, ctx.Err()
+		}
+		// Skip proposer assignment for genesis slot.
+		if slot == 0 {
+			continue
+		}
+		if err := state.SetSlot(slot); err != nil {
+			return nil, nil, err
+		}
+		i, err := BeaconProposerIndex(committeeCache, state)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not check proposer at slot %d", state.Slot())
+		}
+		if requested[i] {
+			proposerIndexToSlots[i] = append(proposerIndexToSlots[i], slot)
+		}
+	}
+
+	shuffledIndices, err := ShuffledIndices(state, epoch)
+	if err != nil {
+		return nil, nil, err
+	}
+	validatorCount := uint64(len(shuffledIndices))
+	numCommitteesPerSlot := SlotCommitteeCount(validatorCount)
+	count := uint64(params.BeaconConfig().SlotsPerEpoch.Mul(numCommitteesPerSlot))
+
+	workers := uint64(runtime.GOMAXPROCS(0))
+	if workers > count {
+		workers = count
+	}
+	validatorIndexToCommittee := make(map[types.ValidatorIndex]*CommitteeAssignmentContainer, len(indices))
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	for w := uint64(0); w < workers; w++ {
+		wg.Add(1)
+		go func(from, to uint64) {
+			defer wg.Done()
+			found := make(map[types.ValidatorIndex]*CommitteeAssignmentContainer)
+			for position := from; position < to; position++ {
+				if ctx.Err() != nil {
+					return
+				}
+				start := sliceutil.SplitOffset(validatorCount, count, position)
+				end := sliceutil.SplitOffset(validatorCount, count, position+1)
+				committee := shuffledIndices[start:end]
+				var cac *CommitteeAssignmentContainer
+				for _, vIndex := range committee {
+					if !requested[vIndex] {
+						continue
+					}
+					if cac == nil {
+						cac = &CommitteeAssignmentContainer{
+							Committee:      committee,
+							CommitteeIndex: types.CommitteeIndex(position % numCommitteesPerSlot),
+							AttesterSlot:   startSlot + types.Slot(position/numCommitteesPerSlot),
+						}
+					}
+					found[vIndex] = cac
+				}
+			}
+			lock.Lock()
+			defer lock.Unlock()
+			for k, v := range found {
+				validatorIndexToCommittee[k] = v
+			}
+		}(sliceutil.SplitOffset(count, workers, w), sliceutil.SplitOffset(count, workers, w+1))
+	}
+	wg.Wait()
+	// Workers stop early once the context is canceled, leaving the assignments incomplete.
+	if ctx.Err() != nil {
+		return nil, nil, ctx.Err()
+	}
+
+	return validatorIndexToCommittee, proposerIndexToSlots, nil
+}
+
 // VerifyBitfieldLength verifies that a bitfield length matches the given committee size.
 func VerifyBitfieldLength(bf bitfield.Bitfield, committeeSize uint64) error {
 	if bf.Len() != committeeSize {
@@ -255,8 +378,8 @@ func VerifyBitfieldLength(bf bitfield.Bitfield, committeeSize uint64) error {
 
 // VerifyAttestationBitfieldLengths verifies that an attestations aggregation bitfields is
 // a valid length matching the size of the committee.
-func VerifyAttestationBitfieldLengths(state iface.ReadOnlyBeaconState, att *ethpb.Attestation) error {
-	committee, err := BeaconCommitteeFromState(state, att.Data.Slot, att.Data.CommitteeIndex)
+func VerifyAttestationBitfieldLengths(committeeCache *cache.CommitteeCache, state iface.ReadOnlyBeaconState, att *ethpb.Attestation) error {
+	committee, err := BeaconCommitteeFromState(committeeCache, state, att.Data.Slot, att.Data.CommitteeIndex)
 	if err != nil {
 		return errors.Wrap(err, "could not retrieve beacon committees")
 	}
@@ -295,7 +418,11 @@ func ShuffledIndices(state iface.ReadOnlyBeaconState, epoch types.Epoch) ([]type
 
 // UpdateCommitteeCache gets called at the beginning of every epoch to cache the committee shuffled indices
 // list with committee index and epoch number. It caches the shuffled indices for current epoch and next epoch.
-func UpdateCommitteeCache(state iface.ReadOnlyBeaconState, epoch types.Epoch) error {
+// Nothing is computed without a committee cache.
+func UpdateCommitteeCache(committeeCache *cache.CommitteeCache, state iface.ReadOnlyBeaconState, epoch types.Epoch) error {
+	if committeeCache == nil {
+		return nil
+	}
 	for _, e := range []types.Epoch{epoch, epoch + 1} {
 		seed, err := Seed(state, e, params.BeaconConfig().DomainBeaconAttester)
 		if err != nil {
@@ -323,6 +450,7 @@ func UpdateCommitteeCache(state iface.ReadOnlyBeaconState, epoch types.Epoch) er
 		})
 
 		if err := committeeCache.AddCommitteeShuffledList(&cache.Committees{
+			Epoch:           e,
 			ShuffledIndices: shuffledIndices,
 			CommitteeCount:  uint64(params.BeaconConfig().SlotsPerEpoch.Mul(count)),
 			Seed:            seed,
@@ -336,7 +464,7 @@ func UpdateCommitteeCache(state iface.ReadOnlyBeaconState, epoch types.Epoch) er
 }
 
 // UpdateProposerIndicesInCache updates proposer indices entry of the committee cache.
-func UpdateProposerIndicesInCache(state iface.ReadOnlyBeaconState) error {
+func UpdateProposerIndicesInCache(committeeCache *cache.CommitteeCache, state iface.ReadOnlyBeaconState) error {
 	// The cache uses the state root at the (current epoch - 2)'s slot as key. (e.g. for epoch 2, the key is root at slot 31)
 	// Which is the reason why we skip genesis epoch.
 	if CurrentEpoch(state) <= params.BeaconConfig().GenesisEpoch+params.BeaconConfig().MinSeedLookahead {
@@ -369,7 +497,7 @@ func UpdateProposerIndicesInCache(state iface.ReadOnlyBeaconState) error {
 		return nil
 	}
 
-	indices, err := ActiveValidatorIndices(state, CurrentEpoch(state))
+	indices, err := ActiveValidatorIndices(committeeCache, state, CurrentEpoch(state))
 	if err != nil {
 		return err
 	}
@@ -383,9 +511,9 @@ func UpdateProposerIndicesInCache(state iface.ReadOnlyBeaconState) error {
 	})
 }
 
-// ClearCache clears the committee cache
+// ClearCache clears the proposer indices cache. The committee cache is owned by the callers of the
+// helpers, which pass it in.
 func ClearCache() {
-	committeeCache = cache.NewCommitteesCache()
 	proposerIndicesCache = cache.NewProposerIndicesCache()
 }
 
@@ -1,6 +1,7 @@
 package helpers
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strconv"
@@ -9,6 +10,7 @@ import (
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -45,7 +47,7 @@ func TestComputeCommittee_WithoutCache(t *testing.T) {
 	require.NoError(t, err)
 
 	epoch := CurrentEpoch(state)
-	indices, err := ActiveValidatorIndices(state, epoch)
+	indices, err := ActiveValidatorIndices(nil, state, epoch)
 	require.NoError(t, err)
 	seed, err := Seed(state, epoch, params.BeaconConfig().DomainBeaconAttester)
 	require.NoError(t, err)
@@ -95,7 +97,7 @@ func TestCommitteeAssignments_CannotRetrieveFutureEpoch(t *testing.T) {
 		Slot: 0, // Epoch 0.
 	})
 	require.NoError(t, err)
-	_, _, err = CommitteeAssignments(state, epoch+1)
+	_, _, err = CommitteeAssignments(context.Background(), nil, state, epoch+1)
 	assert.ErrorContains(t, "can't be greater than next epoch", err)
 }
 
@@ -118,7 +120,7 @@ func TestCommitteeAssignments_NoProposerForSlot0(t *testing.T) {
 	})
 	require.NoError(t, err)
 	ClearCache()
-	_, proposerIndexToSlots, err := CommitteeAssignments(state, 0)
+	_, proposerIndexToSlots, err := CommitteeAssignments(context.Background(), nil, state, 0)
 	require.NoError(t, err, "Failed to determine CommitteeAssignments")
 	for _, slots := range proposerIndexToSlots {
 		for _, s := range slots {
@@ -190,7 +192,7 @@ func TestCommitteeAssignments_CanRetrieve(t *testing.T) {
 	for i, tt := range tests {
 		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
 			ClearCache()
-			validatorIndexToCommittee, proposerIndexToSlots, err := CommitteeAssignments(state, SlotToEpoch(tt.slot))
+			validatorIndexToCommittee, proposerIndexToSlots, err := CommitteeAssignments(context.Background(), nil, state, SlotToEpoch(tt.slot))
 			require.NoError(t, err, "Failed to determine CommitteeAssignments")
 			cac := validatorIndexToCommittee[tt.index]
 			assert.Equal(t, tt.committeeIndex, cac.CommitteeIndex, "Unexpected committeeIndex for validator index %d", tt.index)
@@ -204,6 +206,71 @@ func TestCommitteeAssignments_CanRetrieve(t *testing.T) {
 	}
 }
 
+func TestCommitteeAssignmentsForIndices_MatchesCommitteeAssignments(t *testing.T) {
+	validators := make([]*ethpb.Validator, 2048)
+	for i := 0; i < len(validators); i++ {
+		validators[i] = &ethpb.Validator{
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	state, err := stateV0.InitializeFromProto(&pb.BeaconState{
+		Validators:  validators,
+		Slot:        2 * params.BeaconConfig().SlotsPerEpoch, // epoch 2
+		RandaoMixes: make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+	})
+	require.NoError(t, err)
+
+	ClearCache()
+	wantedCommittees, wantedProposers, err := CommitteeAssignments(context.Background(), nil, state.Copy(), 2)
+	require.NoError(t, err)
+
+	ClearCache()
+	requested := []types.ValidatorIndex{0, 5, 777, 1024, 2047}
+	committees, proposers, err := CommitteeAssignmentsForIndices(context.Background(), nil, state.Copy(), 2, requested)
+	require.NoError(t, err)
+	require.Equal(t, len(requested), len(committees))
+	for _, idx := range requested {
+		assert.DeepEqual(t, wantedCommittees[idx], committees[idx], "Unexpected committee for validator index %d", idx)
+		assert.DeepEqual(t, wantedProposers[idx], proposers[idx], "Unexpected proposer slots for validator index %d", idx)
+	}
+	for idx := range proposers {
+		assert.Equal(t, true, sliceutil.IsInUint64(uint64(idx), []uint64{0, 5, 777, 1024, 2047}), "Unrequested proposer %d", idx)
+	}
+}
+
+func TestCommitteeAssignmentsForIndices_CannotRetrieveFutureEpoch(t *testing.T) {
+	ClearCache()
+	state, err := stateV0.InitializeFromProto(&pb.BeaconState{
+		Slot: 0, // Epoch 0.
+	})
+	require.NoError(t, err)
+	_, _, err = CommitteeAssignmentsForIndices(context.Background(), nil, state, 2, []types.ValidatorIndex{0})
+	assert.ErrorContains(t, "can't be greater than next epoch", err)
+}
+
+func TestCommitteeAssignments_CanceledContext(t *testing.T) {
+	validators := make([]*ethpb.Validator, 2048)
+	for i := 0; i < len(validators); i++ {
+		validators[i] = &ethpb.Validator{
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	state, err := stateV0.InitializeFromProto(&pb.BeaconState{
+		Validators:  validators,
+		Slot:        2 * params.BeaconConfig().SlotsPerEpoch, // epoch 2
+		RandaoMixes: make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	ClearCache()
+	_, _, err = CommitteeAssignments(ctx, nil, state.Copy(), 2)
+	assert.Equal(t, context.Canceled, err)
+	_, _, err = CommitteeAssignmentsForIndices(ctx, nil, state.Copy(), 2, []types.ValidatorIndex{0, 5})
+	assert.Equal(t, context.Canceled, err)
+}
+
 func TestCommitteeAssignments_CannotRetrieveFuture(t *testing.T) {
 	// Initialize test with 256 validators, each slot and each index gets 4 validators.
 	validators := make([]*ethpb.Validator, 4*params.BeaconConfig().SlotsPerEpoch)
@@ -225,11 +292,11 @@ func TestCommitteeAssignments_CannotRetrieveFuture(t *testing.T) {
 		RandaoMixes: make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
 	})
 	require.NoError(t, err)
-	_, proposerIndxs, err := CommitteeAssignments(state, CurrentEpoch(state))
+	_, proposerIndxs, err := CommitteeAssignments(context.Background(), nil, state, CurrentEpoch(state))
 	require.NoError(t, err)
 	require.NotEqual(t, 0, len(proposerIndxs), "wanted non-zero proposer index set")
 
-	_, proposerIndxs, err = CommitteeAssignments(state, CurrentEpoch(state)+1)
+	_, proposerIndxs, err = CommitteeAssignments(context.Background(), nil, state, CurrentEpoch(state)+1)
 	require.NoError(t, err)
 	require.Equal(t, 0, len(proposerIndxs), "wanted empty proposer index set")
 }
@@ -251,7 +318,7 @@ func TestCommitteeAssignments_EverySlotHasMin1Proposer(t *testing.T) {
 	require.NoError(t, err)
 	ClearCache()
 	epoch := types.Epoch(1)
-	_, proposerIndexToSlots, err := CommitteeAssignments(state, epoch)
+	_, proposerIndexToSlots, err := CommitteeAssignments(context.Background(), nil, state, epoch)
 	require.NoError(t, err, "Failed to determine CommitteeAssignments")
 
 	slotsWithProposers := make(map[types.Slot]bool)
@@ -359,7 +426,7 @@ func TestVerifyAttestationBitfieldLengths_OK(t *testing.T) {
 	for i, tt := range tests {
 		ClearCache()
 		require.NoError(t, state.SetSlot(tt.stateSlot))
-		err := VerifyAttestationBitfieldLengths(state, tt.attestation)
+		err := VerifyAttestationBitfieldLengths(nil, state, tt.attestation)
 		if tt.verificationFailure {
 			assert.NotNil(t, err, "Verification succeeded when it was supposed to fail")
 		} else {
@@ -416,7 +483,8 @@ func TestUpdateCommitteeCache_CanUpdate(t *testing.T) {
 		RandaoMixes: make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
 	})
 	require.NoError(t, err)
-	require.NoError(t, UpdateCommitteeCache(state, CurrentEpoch(state)))
+	committeeCache := cache.NewCommitteesCache()
+	require.NoError(t, UpdateCommitteeCache(committeeCache, state, CurrentEpoch(state)))
 
 	epoch := types.Epoch(1)
 	idx := types.CommitteeIndex(1)
@@ -442,7 +510,7 @@ func BenchmarkComputeCommittee300000_WithPreCache(b *testing.B) {
 	require.NoError(b, err)
 
 	epoch := CurrentEpoch(state)
-	indices, err := ActiveValidatorIndices(state, epoch)
+	indices, err := ActiveValidatorIndices(nil, state, epoch)
 	require.NoError(b, err)
 	seed, err := Seed(state, epoch, params.BeaconConfig().DomainBeaconAttester)
 	require.NoError(b, err)
@@ -476,7 +544,7 @@ func BenchmarkComputeCommittee3000000_WithPreCache(b *testing.B) {
 	require.NoError(b, err)
 
 	epoch := CurrentEpoch(state)
-	indices, err := ActiveValidatorIndices(state, epoch)
+	indices, err := ActiveValidatorIndices(nil, state, epoch)
 	require.NoError(b, err)
 	seed, err := Seed(state, epoch, params.BeaconConfig().DomainBeaconAttester)
 	require.NoError(b, err)
@@ -510,7 +578,7 @@ func BenchmarkComputeCommittee128000_WithOutPreCache(b *testing.B) {
 	require.NoError(b, err)
 
 	epoch := CurrentEpoch(state)
-	indices, err := ActiveValidatorIndices(state, epoch)
+	indices, err := ActiveValidatorIndices(nil, state, epoch)
 	require.NoError(b, err)
 	seed, err := Seed(state, epoch, params.BeaconConfig().DomainBeaconAttester)
 	require.NoError(b, err)
@@ -545,7 +613,7 @@ func BenchmarkComputeCommittee1000000_WithOutCache(b *testing.B) {
 	require.NoError(b, err)
 
 	epoch := CurrentEpoch(state)
-	indices, err := ActiveValidatorIndices(state, epoch)
+	indices, err := ActiveValidatorIndices(nil, state, epoch)
 	require.NoError(b, err)
 	seed, err := Seed(state, epoch, params.BeaconConfig().DomainBeaconAttester)
 	require.NoError(b, err)
@@ -580,7 +648,7 @@ func BenchmarkComputeCommittee4000000_WithOutCache(b *testing.B) {
 	require.NoError(b, err)
 
 	epoch := CurrentEpoch(state)
-	indices, err := ActiveValidatorIndices(state, epoch)
+	indices, err := ActiveValidatorIndices(nil, state, epoch)
 	require.NoError(b, err)
 	seed, err := Seed(state, epoch, params.BeaconConfig().DomainBeaconAttester)
 	require.NoError(b, err)
@@ -616,7 +684,8 @@ func TestBeaconCommitteeFromState_UpdateCacheForPreviousEpoch(t *testing.T) {
 		RandaoMixes: make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
 	})
 	require.NoError(t, err)
-	_, err = BeaconCommitteeFromState(state, 1 /* previous epoch */, 0)
+	committeeCache := cache.NewCommitteesCache()
+	_, err = BeaconCommitteeFromState(committeeCache, state, 1 /* previous epoch */, 0)
 	require.NoError(t, err)
 
 	// Verify previous epoch is cached
@@ -641,7 +710,7 @@ func TestPrecomputeProposerIndices_Ok(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	indices, err := ActiveValidatorIndices(state, 0)
+	indices, err := ActiveValidatorIndices(nil, state, 0)
 	require.NoError(t, err)
 
 	proposerIndices, err := precomputeProposerIndices(state, indices)
@@ -66,6 +66,31 @@ func UnShuffledIndex(index types.ValidatorIndex, indexCount uint64, seed [32]byt
 //
 //    return ValidatorIndex(index)
 func ComputeShuffledIndex(index types.ValidatorIndex, indexCount uint64, seed [32]byte, shuffle bool) (types.ValidatorIndex, error) {
+	return computeShuffledIndex(index, indexCount, seed, shuffle, nil)
+}
+
+// ShuffledIndexRounds returns the index after each round of ShuffledIndex, in round order. The
+// last entry is the shuffled index. It lets the swap or not shuffle of an index be verified round
+// by round against the spec.
+func ShuffledIndexRounds(index types.ValidatorIndex, indexCount uint64, seed [32]byte) ([]types.ValidatorIndex, error) {
+	rounds := make([]types.ValidatorIndex, 0, params.BeaconConfig().ShuffleRoundCount)
+	if _, err := computeShuffledIndex(index, indexCount, seed, true /* shuffle */, func(i types.ValidatorIndex) {
+		rounds = append(rounds, i)
+	}); err != nil {
+		return nil, err
+	}
+	return rounds, nil
+}
+
+// computeShuffledIndex shuffles or un-shuffles an index, calling onRound with the index after
+// every round if it is not nil.
+func computeShuffledIndex(
+	index types.ValidatorIndex,
+	indexCount uint64,
+	seed [32]byte,
+	shuffle bool,
+	onRound func(types.ValidatorIndex),
+) (types.ValidatorIndex, error) {
 	if params.BeaconConfig().ShuffleRoundCount == 0 {
 		return index, nil
 	}
@@ -116,6 +141,9 @@ func ComputeShuffledIndex(index types.ValidatorIndex, indexCount uint64, seed [3
 		if bitV == 1 {
 			index = types.ValidatorIndex(flip)
 		}
+		if onRound != nil {
+			onRound(index)
+		}
 		if shuffle {
 			round++
 			if round == rounds {
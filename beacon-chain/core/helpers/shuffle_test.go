@@ -146,6 +146,21 @@ func TestShuffledIndex(t *testing.T) {
 	assert.DeepEqual(t, list, unshuffledlist)
 }
 
+func TestShuffledIndexRounds(t *testing.T) {
+	listSize := uint64(399)
+	seed := [32]byte{123, 42}
+	for i := types.ValidatorIndex(0); uint64(i) < listSize; i += 37 {
+		rounds, err := ShuffledIndexRounds(i, listSize, seed)
+		require.NoError(t, err)
+		require.Equal(t, int(params.BeaconConfig().ShuffleRoundCount), len(rounds))
+		wanted, err := ShuffledIndex(i, listSize, seed)
+		require.NoError(t, err)
+		assert.Equal(t, wanted, rounds[len(rounds)-1])
+	}
+	_, err := ShuffledIndexRounds(types.ValidatorIndex(listSize), listSize, seed)
+	assert.ErrorContains(t, "out of bounds", err)
+}
+
 func TestSplitIndicesAndOffset_OK(t *testing.T) {
 	var l []uint64
 	validators := uint64(64000)
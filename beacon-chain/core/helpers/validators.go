@@ -6,6 +6,7 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
@@ -67,13 +68,16 @@ func checkValidatorSlashable(activationEpoch, withdrawableEpoch types.Epoch, sla
 // considered to be very memory expensive. Avoid using this unless you really
 // need the active validator indices for some specific reason.
 //
+// The indices are read from the committee cache, which is filled with the committees of the epoch,
+// unless the cache is nil.
+//
 // Spec pseudocode definition:
 //  def get_active_validator_indices(state: BeaconState, epoch: Epoch) -> Sequence[ValidatorIndex]:
 //    """
 //    Return the sequence of active validator indices at ``epoch``.
 //    """
 //    return [ValidatorIndex(i) for i, v in enumerate(state.validators) if is_active_validator(v, epoch)]
-func ActiveValidatorIndices(state iface.ReadOnlyBeaconState, epoch types.Epoch) ([]types.ValidatorIndex, error) {
+func ActiveValidatorIndices(committeeCache *cache.CommitteeCache, state iface.ReadOnlyBeaconState, epoch types.Epoch) ([]types.ValidatorIndex, error) {
 	seed, err := Seed(state, epoch, params.BeaconConfig().DomainBeaconAttester)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get seed")
@@ -95,7 +99,7 @@ func ActiveValidatorIndices(state iface.ReadOnlyBeaconState, epoch types.Epoch)
 		return nil, err
 	}
 
-	if err := UpdateCommitteeCache(state, epoch); err != nil {
+	if err := UpdateCommitteeCache(committeeCache, state, epoch); err != nil {
 		return nil, errors.Wrap(err, "could not update committee cache")
 	}
 
@@ -104,7 +108,7 @@ func ActiveValidatorIndices(state iface.ReadOnlyBeaconState, epoch types.Epoch)
 
 // ActiveValidatorCount returns the number of active validators in the state
 // at the given epoch.
-func ActiveValidatorCount(state iface.ReadOnlyBeaconState, epoch types.Epoch) (uint64, error) {
+func ActiveValidatorCount(committeeCache *cache.CommitteeCache, state iface.ReadOnlyBeaconState, epoch types.Epoch) (uint64, error) {
 	seed, err := Seed(state, epoch, params.BeaconConfig().DomainBeaconAttester)
 	if err != nil {
 		return 0, errors.Wrap(err, "could not get seed")
@@ -127,7 +131,7 @@ func ActiveValidatorCount(state iface.ReadOnlyBeaconState, epoch types.Epoch) (u
 		return 0, err
 	}
 
-	if err := UpdateCommitteeCache(state, epoch); err != nil {
+	if err := UpdateCommitteeCache(committeeCache, state, epoch); err != nil {
 		return 0, errors.Wrap(err, "could not update committee cache")
 	}
 
@@ -176,7 +180,7 @@ func ValidatorChurnLimit(activeValidatorCount uint64) (uint64, error) {
 //    seed = hash(get_seed(state, epoch, DOMAIN_BEACON_PROPOSER) + int_to_bytes(state.slot, length=8))
 //    indices = get_active_validator_indices(state, epoch)
 //    return compute_proposer_index(state, indices, seed)
-func BeaconProposerIndex(state iface.ReadOnlyBeaconState) (types.ValidatorIndex, error) {
+func BeaconProposerIndex(committeeCache *cache.CommitteeCache, state iface.ReadOnlyBeaconState) (types.ValidatorIndex, error) {
 	e := CurrentEpoch(state)
 	// The cache uses the state root of the previous epoch - minimum_seed_lookahead last slot as key. (e.g. Starting epoch 1, slot 32, the key would be block root at slot 31)
 	// For simplicity, the node will skip caching of genesis epoch.
@@ -204,7 +208,7 @@ func BeaconProposerIndex(state iface.ReadOnlyBeaconState) (types.ValidatorIndex,
 				}
 				return proposerIndices[state.Slot()%params.BeaconConfig().SlotsPerEpoch], nil
 			}
-			if err := UpdateProposerIndicesInCache(state); err != nil {
+			if err := UpdateProposerIndicesInCache(committeeCache, state); err != nil {
 				return 0, errors.Wrap(err, "could not update committee cache")
 			}
 		}
@@ -218,7 +222,7 @@ func BeaconProposerIndex(state iface.ReadOnlyBeaconState) (types.ValidatorIndex,
 	seedWithSlot := append(seed[:], bytesutil.Bytes8(uint64(state.Slot()))...)
 	seedWithSlotHash := hashutil.Hash(seedWithSlot)
 
-	indices, err := ActiveValidatorIndices(state, e)
+	indices, err := ActiveValidatorIndices(committeeCache, state, e)
 	if err != nil {
 		return 0, errors.Wrap(err, "could not get active indices")
 	}
@@ -0,0 +1,108 @@
+// Package reorg defines the record of a chain reorg, which is archived when the head moves to a
+// block which does not descend from the previous head, so that the reorgs which invalidated
+// proposer sets can be audited afterwards.
+package reorg
+
+import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+)
+
+// sszSize is the length of the SSZ encoding of a reorg event, which is a container of two 32 byte
+// roots and six uint64 fields.
+const sszSize = 2*32 + 6*8
+
+// Event is the record of a chain reorg.
+type Event struct {
+	OldHeadRoot [32]byte
+	NewHeadRoot [32]byte
+	OldHeadSlot types.Slot
+	NewHeadSlot types.Slot
+	// CommonAncestorSlot is the slot of the latest block both heads descend from.
+	CommonAncestorSlot types.Slot
+	// Depth is the number of blocks of the old chain after the common ancestor, which are no
+	// longer canonical.
+	Depth uint64
+	// FromEpoch is the first epoch with blocks changed by the reorg.
+	FromEpoch types.Epoch
+	// ToEpoch is the last epoch with blocks changed by the reorg.
+	ToEpoch types.Epoch
+}
+
+// New returns the event of a reorg from the old head to the new head, which descend from a
+// common ancestor at the given slot.
+func New(oldRoot [32]byte, oldSlot types.Slot, newRoot [32]byte, newSlot types.Slot, ancestorSlot types.Slot, depth uint64) *Event {
+	toSlot := oldSlot
+	if newSlot > toSlot {
+		toSlot = newSlot
+	}
+	return &Event{
+		OldHeadRoot:        oldRoot,
+		NewHeadRoot:        newRoot,
+		OldHeadSlot:        oldSlot,
+		NewHeadSlot:        newSlot,
+		CommonAncestorSlot: ancestorSlot,
+		Depth:              depth,
+		FromEpoch:          helpers.SlotToEpoch(ancestorSlot + 1),
+		ToEpoch:            helpers.SlotToEpoch(toSlot),
+	}
+}
+
+// Epoch is the epoch of the new head, which the event is archived under.
+func (e *Event) Epoch() types.Epoch {
+	return helpers.SlotToEpoch(e.NewHeadSlot)
+}
+
+// SizeSSZ returns the length of the SSZ encoding of the event.
+func (e *Event) SizeSSZ() int {
+	return sszSize
+}
+
+// MarshalSSZ encodes the event as an SSZ container of its fields in declaration order. All
+// fields are fixed size, so the encoding has no offsets.
+func (e *Event) MarshalSSZ() ([]byte, error) {
+	return e.MarshalSSZTo(make([]byte, 0, sszSize))
+}
+
+// MarshalSSZTo appends the SSZ encoding of the event to dst.
+func (e *Event) MarshalSSZTo(dst []byte) ([]byte, error) {
+	var buf [8]byte
+	putUint64 := func(v uint64) {
+		binary.LittleEndian.PutUint64(buf[:], v)
+		dst = append(dst, buf[:]...)
+	}
+	dst = append(dst, e.OldHeadRoot[:]...)
+	dst = append(dst, e.NewHeadRoot[:]...)
+	putUint64(uint64(e.OldHeadSlot))
+	putUint64(uint64(e.NewHeadSlot))
+	putUint64(uint64(e.CommonAncestorSlot))
+	putUint64(e.Depth)
+	putUint64(uint64(e.FromEpoch))
+	putUint64(uint64(e.ToEpoch))
+	return dst, nil
+}
+
+// UnmarshalSSZ decodes an event encoded by MarshalSSZ.
+func (e *Event) UnmarshalSSZ(enc []byte) error {
+	if len(enc) != sszSize {
+		return errors.Errorf("invalid reorg event encoding length %d, wanted %d", len(enc), sszSize)
+	}
+	uint64At := func() uint64 {
+		v := binary.LittleEndian.Uint64(enc[:8])
+		enc = enc[8:]
+		return v
+	}
+	copy(e.OldHeadRoot[:], enc[:32])
+	copy(e.NewHeadRoot[:], enc[32:64])
+	enc = enc[64:]
+	e.OldHeadSlot = types.Slot(uint64At())
+	e.NewHeadSlot = types.Slot(uint64At())
+	e.CommonAncestorSlot = types.Slot(uint64At())
+	e.Depth = uint64At()
+	e.FromEpoch = types.Epoch(uint64At())
+	e.ToEpoch = types.Epoch(uint64At())
+	return nil
+}
@@ -0,0 +1,48 @@
+package reorg
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestNew(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	e := New([32]byte{'a'}, 2*slotsPerEpoch+3, [32]byte{'b'}, 2*slotsPerEpoch+1, slotsPerEpoch-1, 5)
+	assert.Equal(t, types.Epoch(1), e.FromEpoch)
+	assert.Equal(t, types.Epoch(2), e.ToEpoch)
+	assert.Equal(t, types.Epoch(2), e.Epoch())
+
+	// A new head beyond the old head extends the affected epochs.
+	e = New([32]byte{'a'}, slotsPerEpoch, [32]byte{'b'}, 3*slotsPerEpoch, slotsPerEpoch, 1)
+	assert.Equal(t, types.Epoch(1), e.FromEpoch)
+	assert.Equal(t, types.Epoch(3), e.ToEpoch)
+}
+
+func TestEvent_SSZ(t *testing.T) {
+	e := &Event{
+		OldHeadRoot:        [32]byte{1},
+		NewHeadRoot:        [32]byte{2},
+		OldHeadSlot:        10,
+		NewHeadSlot:        9,
+		CommonAncestorSlot: 7,
+		Depth:              3,
+		FromEpoch:          0,
+		ToEpoch:            1,
+	}
+	enc, err := e.MarshalSSZ()
+	require.NoError(t, err)
+	assert.Equal(t, e.SizeSSZ(), len(enc))
+	// The fields are encoded in declaration order.
+	assert.Equal(t, byte(2), enc[32])
+	assert.Equal(t, byte(10), enc[64])
+
+	decoded := &Event{}
+	require.NoError(t, decoded.UnmarshalSSZ(enc))
+	assert.DeepEqual(t, e, decoded)
+
+	assert.ErrorContains(t, "invalid reorg event encoding length", decoded.UnmarshalSSZ(enc[1:]))
+}
@@ -30,11 +30,11 @@ func TestSkipSlotCache_OK(t *testing.T) {
 	// with the state
 	blk, err := testutil.GenerateFullBlock(bState, privs, blkCfg, originalState.Slot()+10)
 	require.NoError(t, err)
-	executedState, err := state.ExecuteStateTransition(context.Background(), originalState, blk)
+	executedState, err := state.ExecuteStateTransition(context.Background(), nil, originalState, blk)
 	require.NoError(t, err, "Could not run state transition")
 	originalState, ok := executedState.(*stateV0.BeaconState)
 	require.Equal(t, true, ok)
-	bState, err = state.ExecuteStateTransition(context.Background(), bState, blk)
+	bState, err = state.ExecuteStateTransition(context.Background(), nil, bState, blk)
 	require.NoError(t, err, "Could not process state transition")
 
 	assert.DeepEqual(t, originalState.CloneInnerState(), bState.CloneInnerState(), "Skipped slots cache leads to different states")
@@ -56,7 +56,7 @@ func TestSkipSlotCache_ConcurrentMixup(t *testing.T) {
 	// with the state
 	blk, err := testutil.GenerateFullBlock(bState, privs, blkCfg, originalState.Slot()+10)
 	require.NoError(t, err)
-	executedState, err := state.ExecuteStateTransition(context.Background(), originalState, blk)
+	executedState, err := state.ExecuteStateTransition(context.Background(), nil, originalState, blk)
 	require.NoError(t, err, "Could not run state transition")
 	originalState, ok := executedState.(*stateV0.BeaconState)
 	require.Equal(t, true, ok)
@@ -70,7 +70,7 @@ func TestSkipSlotCache_ConcurrentMixup(t *testing.T) {
 		signature, err := testutil.BlockSignature(originalState, blk.Block, privs)
 		require.NoError(t, err)
 		blk.Signature = signature.Marshal()
-		state1, err = state.ExecuteStateTransition(context.Background(), originalState.Copy(), blk)
+		state1, err = state.ExecuteStateTransition(context.Background(), nil, originalState.Copy(), blk)
 		require.NoError(t, err, "Could not run state transition")
 	}
 
@@ -81,7 +81,7 @@ func TestSkipSlotCache_ConcurrentMixup(t *testing.T) {
 		signature, err := testutil.BlockSignature(originalState, blk.Block, privs)
 		require.NoError(t, err)
 		blk.Signature = signature.Marshal()
-		state2, err = state.ExecuteStateTransition(context.Background(), originalState.Copy(), blk)
+		state2, err = state.ExecuteStateTransition(context.Background(), nil, originalState.Copy(), blk)
 		require.NoError(t, err, "Could not run state transition")
 	}
 
@@ -110,24 +110,24 @@ func TestSkipSlotCache_ConcurrentMixup(t *testing.T) {
 	}
 
 	problemSlot := state1.Slot() + 2
-	expected1, err := state.ProcessSlots(context.Background(), state1.Copy(), problemSlot)
+	expected1, err := state.ProcessSlots(context.Background(), nil, state1.Copy(), problemSlot)
 	require.NoError(t, err)
 	expectedRoot1, err := expected1.HashTreeRoot(context.Background())
 	require.NoError(t, err)
 	t.Logf("chain 1 (even i) expected root %x at slot %d", expectedRoot1[:], problemSlot)
 
-	tmp1, err := state.ProcessSlots(context.Background(), expected1.Copy(), problemSlot+1)
+	tmp1, err := state.ProcessSlots(context.Background(), nil, expected1.Copy(), problemSlot+1)
 	require.NoError(t, err)
 	gotRoot := tmp1.StateRoots()[problemSlot]
 	require.DeepEqual(t, expectedRoot1[:], gotRoot, "State roots for chain 1 are bad, expected root doesn't match")
 
-	expected2, err := state.ProcessSlots(context.Background(), state2.Copy(), problemSlot)
+	expected2, err := state.ProcessSlots(context.Background(), nil, state2.Copy(), problemSlot)
 	require.NoError(t, err)
 	expectedRoot2, err := expected2.HashTreeRoot(context.Background())
 	require.NoError(t, err)
 	t.Logf("chain 2 (odd i) expected root %x at slot %d", expectedRoot2[:], problemSlot)
 
-	tmp2, err := state.ProcessSlots(context.Background(), expected2.Copy(), problemSlot+1)
+	tmp2, err := state.ProcessSlots(context.Background(), nil, expected2.Copy(), problemSlot+1)
 	require.NoError(t, err)
 	gotRoot = tmp2.StateRoots()[problemSlot]
 	require.DeepEqual(t, expectedRoot2[:], gotRoot, "State roots for chain 2 are bad, expected root doesn't match")
@@ -137,7 +137,7 @@ func TestSkipSlotCache_ConcurrentMixup(t *testing.T) {
 
 	step := func(i int, setup iface.BeaconState) {
 		// go at least 1 past problemSlot, to ensure problem slot state root is available
-		outState, err := state.ProcessSlots(context.Background(), setup, problemSlot.Add(1+uint64(i))) // keep increasing, to hit and extend the cache
+		outState, err := state.ProcessSlots(context.Background(), nil, setup, problemSlot.Add(1+uint64(i))) // keep increasing, to hit and extend the cache
 		require.NoError(t, err, "Could not process state transition")
 		roots := outState.StateRoots()
 		gotRoot := roots[problemSlot]
@@ -7,6 +7,7 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 )
 
@@ -47,10 +48,10 @@ func NextSlotState(ctx context.Context, root []byte) (iface.BeaconState, error)
 // UpdateNextSlotCache updates the `nextSlotCache`. It saves the input state after advancing the state slot by 1
 // by calling `ProcessSlots`, it also saves the input root for later look up.
 // This is useful to call after successfully processing a block.
-func UpdateNextSlotCache(ctx context.Context, root []byte, state iface.BeaconState) error {
+func UpdateNextSlotCache(ctx context.Context, committeeCache *cache.CommitteeCache, root []byte, state iface.BeaconState) error {
 	// Advancing one slot by using a copied state.
 	copied := state.Copy()
-	copied, err := ProcessSlots(ctx, copied, copied.Slot()+1)
+	copied, err := ProcessSlots(ctx, committeeCache, copied, copied.Slot()+1)
 	if err != nil {
 		return err
 	}
@@ -18,12 +18,12 @@ func TestTrailingSlotState_RoundTrip(t *testing.T) {
 	require.Equal(t, nil, s)
 
 	s, _ = testutil.DeterministicGenesisState(t, 1)
-	require.NoError(t, state.UpdateNextSlotCache(ctx, r, s))
+	require.NoError(t, state.UpdateNextSlotCache(ctx, nil, r, s))
 	s, err = state.NextSlotState(ctx, r)
 	require.NoError(t, err)
 	require.Equal(t, types.Slot(1), s.Slot())
 
-	require.NoError(t, state.UpdateNextSlotCache(ctx, r, s))
+	require.NoError(t, state.UpdateNextSlotCache(ctx, nil, r, s))
 	s, err = state.NextSlotState(ctx, r)
 	require.NoError(t, err)
 	require.Equal(t, types.Slot(2), s.Slot())
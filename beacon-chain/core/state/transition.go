@@ -27,9 +27,17 @@ import (
 )
 
 // processFunc is a function that processes a block with a given state. State is mutated.
-type processFunc func(context.Context, iface.BeaconState, *ethpb.SignedBeaconBlock) (iface.BeaconState, error)
+type processFunc func(context.Context, *cache.CommitteeCache, iface.BeaconState, *ethpb.SignedBeaconBlock) (iface.BeaconState, error)
 
-var processDepositsFunc = func(ctx context.Context, s iface.BeaconState, blk *ethpb.SignedBeaconBlock) (iface.BeaconState, error) {
+var processEth1DataFunc = func(ctx context.Context, _ *cache.CommitteeCache, s iface.BeaconState, blk *ethpb.SignedBeaconBlock) (iface.BeaconState, error) {
+	return b.ProcessEth1DataInBlock(ctx, s, blk)
+}
+
+var verifyOperationLengthsFunc = func(ctx context.Context, _ *cache.CommitteeCache, s iface.BeaconState, blk *ethpb.SignedBeaconBlock) (iface.BeaconState, error) {
+	return VerifyOperationLengths(ctx, s, blk)
+}
+
+var processDepositsFunc = func(ctx context.Context, _ *cache.CommitteeCache, s iface.BeaconState, blk *ethpb.SignedBeaconBlock) (iface.BeaconState, error) {
 	return b.ProcessDeposits(ctx, s, blk.Block.Body.Deposits)
 }
 
@@ -38,8 +46,8 @@ var processDepositsFunc = func(ctx context.Context, s iface.BeaconState, blk *et
 var processingPipeline = []processFunc{
 	b.ProcessBlockHeader,
 	b.ProcessRandao,
-	b.ProcessEth1DataInBlock,
-	VerifyOperationLengths,
+	processEth1DataFunc,
+	verifyOperationLengthsFunc,
 	b.ProcessProposerSlashings,
 	b.ProcessAttesterSlashings,
 	b.ProcessAttestations,
@@ -66,6 +74,7 @@ var processingPipeline = []processFunc{
 //    return state
 func ExecuteStateTransition(
 	ctx context.Context,
+	committeeCache *cache.CommitteeCache,
 	state iface.BeaconState,
 	signed *ethpb.SignedBeaconBlock,
 ) (iface.BeaconState, error) {
@@ -80,13 +89,13 @@ func ExecuteStateTransition(
 	defer span.End()
 	var err error
 	// Execute per slots transition.
-	state, err = ProcessSlots(ctx, state, signed.Block.Slot)
+	state, err = ProcessSlots(ctx, committeeCache, state, signed.Block.Slot)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not process slot")
 	}
 
 	// Execute per block transition.
-	state, err = ProcessBlock(ctx, state, signed)
+	state, err = ProcessBlock(ctx, committeeCache, state, signed)
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not process block in slot %d", signed.Block.Slot)
 	}
@@ -122,6 +131,7 @@ func ExecuteStateTransition(
 //    return state
 func ExecuteStateTransitionNoVerifyAnySig(
 	ctx context.Context,
+	committeeCache *cache.CommitteeCache,
 	state iface.BeaconState,
 	signed *ethpb.SignedBeaconBlock,
 ) (*bls.SignatureSet, iface.BeaconState, error) {
@@ -137,19 +147,19 @@ func ExecuteStateTransitionNoVerifyAnySig(
 	var err error
 
 	if featureconfig.Get().EnableNextSlotStateCache {
-		state, err = ProcessSlotsUsingNextSlotCache(ctx, state, signed.Block.ParentRoot, signed.Block.Slot)
+		state, err = ProcessSlotsUsingNextSlotCache(ctx, committeeCache, state, signed.Block.ParentRoot, signed.Block.Slot)
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "could not process slots")
 		}
 	} else {
-		state, err = ProcessSlots(ctx, state, signed.Block.Slot)
+		state, err = ProcessSlots(ctx, committeeCache, state, signed.Block.Slot)
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "could not process slot")
 		}
 	}
 
 	// Execute per block transition.
-	set, state, err := ProcessBlockNoVerifyAnySig(ctx, state, signed)
+	set, state, err := ProcessBlockNoVerifyAnySig(ctx, committeeCache, state, signed)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "could not process block")
 	}
@@ -175,6 +185,7 @@ func ExecuteStateTransitionNoVerifyAnySig(
 //    return state
 func CalculateStateRoot(
 	ctx context.Context,
+	committeeCache *cache.CommitteeCache,
 	state iface.BeaconState,
 	signed *ethpb.SignedBeaconBlock,
 ) ([32]byte, error) {
@@ -197,19 +208,19 @@ func CalculateStateRoot(
 	// Execute per slots transition.
 	var err error
 	if featureconfig.Get().EnableNextSlotStateCache {
-		state, err = ProcessSlotsUsingNextSlotCache(ctx, state, signed.Block.ParentRoot, signed.Block.Slot)
+		state, err = ProcessSlotsUsingNextSlotCache(ctx, committeeCache, state, signed.Block.ParentRoot, signed.Block.Slot)
 		if err != nil {
 			return [32]byte{}, errors.Wrap(err, "could not process slots")
 		}
 	} else {
-		state, err = ProcessSlots(ctx, state, signed.Block.Slot)
+		state, err = ProcessSlots(ctx, committeeCache, state, signed.Block.Slot)
 		if err != nil {
 			return [32]byte{}, errors.Wrap(err, "could not process slot")
 		}
 	}
 
 	// Execute per block transition.
-	state, err = ProcessBlockForStateRoot(ctx, state, signed)
+	state, err = ProcessBlockForStateRoot(ctx, committeeCache, state, signed)
 	if err != nil {
 		return [32]byte{}, errors.Wrap(err, "could not process block")
 	}
@@ -276,6 +287,7 @@ func ProcessSlot(ctx context.Context, state iface.BeaconState) (iface.BeaconStat
 // ProcessSlotsUsingNextSlotCache processes slots by using next slot cache for higher efficiency.
 func ProcessSlotsUsingNextSlotCache(
 	ctx context.Context,
+	committeeCache *cache.CommitteeCache,
 	parentState iface.BeaconState,
 	parentRoot []byte,
 	slot types.Slot) (iface.BeaconState, error) {
@@ -296,7 +308,7 @@ func ProcessSlotsUsingNextSlotCache(
 	// Since next slot cache only advances state by 1 slot,
 	// we check if there's more slots that need to process.
 	if slot > parentState.Slot() {
-		parentState, err = ProcessSlots(ctx, parentState, slot)
+		parentState, err = ProcessSlots(ctx, committeeCache, parentState, slot)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not process slots")
 		}
@@ -316,7 +328,7 @@ func ProcessSlotsUsingNextSlotCache(
 //            process_epoch(state)
 //        state.slot += 1
 //    ]
-func ProcessSlots(ctx context.Context, state iface.BeaconState, slot types.Slot) (iface.BeaconState, error) {
+func ProcessSlots(ctx context.Context, committeeCache *cache.CommitteeCache, state iface.BeaconState, slot types.Slot) (iface.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "core.state.ProcessSlots")
 	defer span.End()
 	if state == nil {
@@ -383,7 +395,7 @@ func ProcessSlots(ctx context.Context, state iface.BeaconState, slot types.Slot)
 			return nil, errors.Wrap(err, "could not process slot")
 		}
 		if CanProcessEpoch(state) {
-			state, err = ProcessEpochPrecompute(ctx, state)
+			state, err = ProcessEpochPrecompute(ctx, committeeCache, state)
 			if err != nil {
 				traceutil.AnnotateError(span, err)
 				return nil, errors.Wrap(err, "could not process epoch with optimizations")
@@ -418,6 +430,7 @@ func ProcessSlots(ctx context.Context, state iface.BeaconState, slot types.Slot)
 //    process_operations(state, block.body)
 func ProcessBlock(
 	ctx context.Context,
+	committeeCache *cache.CommitteeCache,
 	state iface.BeaconState,
 	signed *ethpb.SignedBeaconBlock,
 ) (iface.BeaconState, error) {
@@ -426,7 +439,7 @@ func ProcessBlock(
 
 	var err error
 	for _, p := range processingPipeline {
-		state, err = p(ctx, state, signed)
+		state, err = p(ctx, committeeCache, state, signed)
 		if err != nil {
 			return nil, errors.Wrap(err, "Could not process block")
 		}
@@ -449,13 +462,14 @@ func ProcessBlock(
 //    process_operations(state, block.body)
 func ProcessBlockNoVerifyAnySig(
 	ctx context.Context,
+	committeeCache *cache.CommitteeCache,
 	state iface.BeaconState,
 	signed *ethpb.SignedBeaconBlock,
 ) (*bls.SignatureSet, iface.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "core.state.ProcessBlockNoVerifyAnySig")
 	defer span.End()
 
-	state, err := b.ProcessBlockHeaderNoVerify(state, signed.Block)
+	state, err := b.ProcessBlockHeaderNoVerify(committeeCache, state, signed.Block)
 	if err != nil {
 		traceutil.AnnotateError(span, err)
 		return nil, nil, errors.Wrap(err, "could not process block header")
@@ -465,7 +479,7 @@ func ProcessBlockNoVerifyAnySig(
 		traceutil.AnnotateError(span, err)
 		return nil, nil, errors.Wrap(err, "could not retrieve block signature set")
 	}
-	rSet, err := b.RandaoSignatureSet(state, signed.Block.Body)
+	rSet, err := b.RandaoSignatureSet(committeeCache, state, signed.Block.Body)
 	if err != nil {
 		traceutil.AnnotateError(span, err)
 		return nil, nil, errors.Wrap(err, "could not retrieve randao signature set")
@@ -482,12 +496,12 @@ func ProcessBlockNoVerifyAnySig(
 		return nil, nil, errors.Wrap(err, "could not process eth1 data")
 	}
 
-	state, err = ProcessOperationsNoVerifyAttsSigs(ctx, state, signed)
+	state, err = ProcessOperationsNoVerifyAttsSigs(ctx, committeeCache, state, signed)
 	if err != nil {
 		traceutil.AnnotateError(span, err)
 		return nil, nil, errors.Wrap(err, "could not process block operation")
 	}
-	aSet, err := b.AttestationSignatureSet(ctx, state, signed.Block.Body.Attestations)
+	aSet, err := b.AttestationSignatureSet(ctx, committeeCache, state, signed.Block.Body.Attestations)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "could not retrieve attestation signature set")
 	}
@@ -526,6 +540,7 @@ func ProcessBlockNoVerifyAnySig(
 //            function(state, operation)
 func ProcessOperationsNoVerifyAttsSigs(
 	ctx context.Context,
+	committeeCache *cache.CommitteeCache,
 	state iface.BeaconState,
 	signedBeaconBlock *ethpb.SignedBeaconBlock) (iface.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "core.state.ProcessOperationsNoVerifyAttsSigs")
@@ -535,15 +550,15 @@ func ProcessOperationsNoVerifyAttsSigs(
 		return nil, errors.Wrap(err, "could not verify operation lengths")
 	}
 
-	state, err := b.ProcessProposerSlashings(ctx, state, signedBeaconBlock)
+	state, err := b.ProcessProposerSlashings(ctx, committeeCache, state, signedBeaconBlock)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not process block proposer slashings")
 	}
-	state, err = b.ProcessAttesterSlashings(ctx, state, signedBeaconBlock)
+	state, err = b.ProcessAttesterSlashings(ctx, committeeCache, state, signedBeaconBlock)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not process block attester slashings")
 	}
-	state, err = b.ProcessAttestationsNoVerifySignature(ctx, state, signedBeaconBlock)
+	state, err = b.ProcessAttestationsNoVerifySignature(ctx, committeeCache, state, signedBeaconBlock)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not process block attestations")
 	}
@@ -551,7 +566,7 @@ func ProcessOperationsNoVerifyAttsSigs(
 	if err != nil {
 		return nil, errors.Wrap(err, "could not process block validator deposits")
 	}
-	state, err = b.ProcessVoluntaryExits(ctx, state, signedBeaconBlock)
+	state, err = b.ProcessVoluntaryExits(ctx, committeeCache, state, signedBeaconBlock)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not process validator exits")
 	}
@@ -625,7 +640,7 @@ func CanProcessEpoch(state iface.ReadOnlyBeaconState) bool {
 
 // ProcessEpochPrecompute describes the per epoch operations that are performed on the beacon state.
 // It's optimized by pre computing validator attested info and epoch total/attested balances upfront.
-func ProcessEpochPrecompute(ctx context.Context, state iface.BeaconState) (iface.BeaconState, error) {
+func ProcessEpochPrecompute(ctx context.Context, committeeCache *cache.CommitteeCache, state iface.BeaconState) (iface.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "core.state.ProcessEpochPrecompute")
 	defer span.End()
 	span.AddAttributes(trace.Int64Attribute("epoch", int64(helpers.CurrentEpoch(state))))
@@ -637,7 +652,7 @@ func ProcessEpochPrecompute(ctx context.Context, state iface.BeaconState) (iface
 	if err != nil {
 		return nil, err
 	}
-	vp, bp, err = precompute.ProcessAttestations(ctx, state, vp, bp)
+	vp, bp, err = precompute.ProcessAttestations(ctx, committeeCache, state, vp, bp)
 	if err != nil {
 		return nil, err
 	}
@@ -652,7 +667,7 @@ func ProcessEpochPrecompute(ctx context.Context, state iface.BeaconState) (iface
 		return nil, errors.Wrap(err, "could not process rewards and penalties")
 	}
 
-	state, err = e.ProcessRegistryUpdates(state)
+	state, err = e.ProcessRegistryUpdates(committeeCache, state)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not process registry updates")
 	}
@@ -673,13 +688,14 @@ func ProcessEpochPrecompute(ctx context.Context, state iface.BeaconState) (iface
 // and randao signature verifications.
 func ProcessBlockForStateRoot(
 	ctx context.Context,
+	committeeCache *cache.CommitteeCache,
 	state iface.BeaconState,
 	signed *ethpb.SignedBeaconBlock,
 ) (iface.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "core.state.ProcessBlockForStateRoot")
 	defer span.End()
 
-	state, err := b.ProcessBlockHeaderNoVerify(state, signed.Block)
+	state, err := b.ProcessBlockHeaderNoVerify(committeeCache, state, signed.Block)
 	if err != nil {
 		traceutil.AnnotateError(span, err)
 		return nil, errors.Wrap(err, "could not process block header")
@@ -697,7 +713,7 @@ func ProcessBlockForStateRoot(
 		return nil, errors.Wrap(err, "could not process eth1 data")
 	}
 
-	state, err = ProcessOperationsNoVerifyAttsSigs(ctx, state, signed)
+	state, err = ProcessOperationsNoVerifyAttsSigs(ctx, committeeCache, state, signed)
 	if err != nil {
 		traceutil.AnnotateError(span, err)
 		return nil, errors.Wrap(err, "could not process block operation")
@@ -43,7 +43,7 @@ func TestExecuteStateTransition_IncorrectSlot(t *testing.T) {
 		},
 	}
 	want := "expected state.slot"
-	_, err = state.ExecuteStateTransition(context.Background(), beaconState, block)
+	_, err = state.ExecuteStateTransition(context.Background(), nil, beaconState, block)
 	assert.ErrorContains(t, want, err)
 }
 
@@ -73,11 +73,11 @@ func TestExecuteStateTransition_FullProcess(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, beaconState.SetSlot(beaconState.Slot()-1))
 
-	nextSlotState, err := state.ProcessSlots(context.Background(), beaconState.Copy(), beaconState.Slot()+1)
+	nextSlotState, err := state.ProcessSlots(context.Background(), nil, beaconState.Copy(), beaconState.Slot()+1)
 	require.NoError(t, err)
 	parentRoot, err := nextSlotState.LatestBlockHeader().HashTreeRoot()
 	require.NoError(t, err)
-	proposerIdx, err := helpers.BeaconProposerIndex(nextSlotState)
+	proposerIdx, err := helpers.BeaconProposerIndex(nil, nextSlotState)
 	require.NoError(t, err)
 	block := testutil.NewBeaconBlock()
 	block.Block.ProposerIndex = proposerIdx
@@ -86,7 +86,7 @@ func TestExecuteStateTransition_FullProcess(t *testing.T) {
 	block.Block.Body.RandaoReveal = randaoReveal
 	block.Block.Body.Eth1Data = eth1Data
 
-	stateRoot, err := state.CalculateStateRoot(context.Background(), beaconState, block)
+	stateRoot, err := state.CalculateStateRoot(context.Background(), nil, beaconState, block)
 	require.NoError(t, err)
 
 	block.Block.StateRoot = stateRoot[:]
@@ -95,7 +95,7 @@ func TestExecuteStateTransition_FullProcess(t *testing.T) {
 	require.NoError(t, err)
 	block.Signature = sig.Marshal()
 
-	beaconState, err = state.ExecuteStateTransition(context.Background(), beaconState, block)
+	beaconState, err = state.ExecuteStateTransition(context.Background(), nil, beaconState, block)
 	require.NoError(t, err)
 
 	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch, beaconState.Slot(), "Unexpected Slot number")
@@ -128,11 +128,11 @@ func TestExecuteStateTransitionNoVerify_FullProcess(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, beaconState.SetSlot(beaconState.Slot()-1))
 
-	nextSlotState, err := state.ProcessSlots(context.Background(), beaconState.Copy(), beaconState.Slot()+1)
+	nextSlotState, err := state.ProcessSlots(context.Background(), nil, beaconState.Copy(), beaconState.Slot()+1)
 	require.NoError(t, err)
 	parentRoot, err := nextSlotState.LatestBlockHeader().HashTreeRoot()
 	require.NoError(t, err)
-	proposerIdx, err := helpers.BeaconProposerIndex(nextSlotState)
+	proposerIdx, err := helpers.BeaconProposerIndex(nil, nextSlotState)
 	require.NoError(t, err)
 	block := testutil.NewBeaconBlock()
 	block.Block.ProposerIndex = proposerIdx
@@ -141,7 +141,7 @@ func TestExecuteStateTransitionNoVerify_FullProcess(t *testing.T) {
 	block.Block.Body.RandaoReveal = randaoReveal
 	block.Block.Body.Eth1Data = eth1Data
 
-	stateRoot, err := state.CalculateStateRoot(context.Background(), beaconState, block)
+	stateRoot, err := state.CalculateStateRoot(context.Background(), nil, beaconState, block)
 	require.NoError(t, err)
 
 	block.Block.StateRoot = stateRoot[:]
@@ -150,7 +150,7 @@ func TestExecuteStateTransitionNoVerify_FullProcess(t *testing.T) {
 	require.NoError(t, err)
 	block.Signature = sig.Marshal()
 
-	set, _, err := state.ExecuteStateTransitionNoVerifyAnySig(context.Background(), beaconState, block)
+	set, _, err := state.ExecuteStateTransitionNoVerifyAnySig(context.Background(), nil, beaconState, block)
 	assert.NoError(t, err)
 	verified, err := set.Verify()
 	assert.NoError(t, err)
@@ -177,16 +177,16 @@ func TestProcessBlock_IncorrectProposerSlashing(t *testing.T) {
 	block.Block.Body.ProposerSlashings = []*ethpb.ProposerSlashing{slashing}
 
 	require.NoError(t, beaconState.SetSlot(beaconState.Slot()+1))
-	proposerIdx, err := helpers.BeaconProposerIndex(beaconState)
+	proposerIdx, err := helpers.BeaconProposerIndex(nil, beaconState)
 	require.NoError(t, err)
 	require.NoError(t, beaconState.SetSlot(beaconState.Slot()-1))
 	block.Signature, err = helpers.ComputeDomainAndSign(beaconState, helpers.CurrentEpoch(beaconState), block.Block, params.BeaconConfig().DomainBeaconProposer, privKeys[proposerIdx])
 	require.NoError(t, err)
 
-	beaconState, err = state.ProcessSlots(context.Background(), beaconState, 1)
+	beaconState, err = state.ProcessSlots(context.Background(), nil, beaconState, 1)
 	require.NoError(t, err)
 	want := "could not verify proposer slashing"
-	_, err = state.ProcessBlock(context.Background(), beaconState, block)
+	_, err = state.ProcessBlock(context.Background(), nil, beaconState, block)
 	assert.ErrorContains(t, want, err)
 }
 
@@ -203,17 +203,17 @@ func TestProcessBlock_IncorrectProcessBlockAttestations(t *testing.T) {
 	require.NoError(t, err)
 	block.Block.Body.Attestations = []*ethpb.Attestation{att}
 	require.NoError(t, beaconState.SetSlot(beaconState.Slot()+1))
-	proposerIdx, err := helpers.BeaconProposerIndex(beaconState)
+	proposerIdx, err := helpers.BeaconProposerIndex(nil, beaconState)
 	require.NoError(t, err)
 	require.NoError(t, beaconState.SetSlot(beaconState.Slot()-1))
 	block.Signature, err = helpers.ComputeDomainAndSign(beaconState, helpers.CurrentEpoch(beaconState), block.Block, params.BeaconConfig().DomainBeaconProposer, privKeys[proposerIdx])
 	require.NoError(t, err)
 
-	beaconState, err = state.ProcessSlots(context.Background(), beaconState, 1)
+	beaconState, err = state.ProcessSlots(context.Background(), nil, beaconState, 1)
 	require.NoError(t, err)
 
 	want := "could not verify attestation"
-	_, err = state.ProcessBlock(context.Background(), beaconState, block)
+	_, err = state.ProcessBlock(context.Background(), nil, beaconState, block)
 	assert.ErrorContains(t, want, err)
 }
 
@@ -412,7 +412,7 @@ func createFullBlockWithOperations(t *testing.T) (iface.BeaconState,
 		AggregationBits: aggBits,
 	})
 
-	committee, err := helpers.BeaconCommitteeFromState(beaconState, blockAtt.Data.Slot, blockAtt.Data.CommitteeIndex)
+	committee, err := helpers.BeaconCommitteeFromState(nil, beaconState, blockAtt.Data.Slot, blockAtt.Data.CommitteeIndex)
 	assert.NoError(t, err)
 	attestingIndices, err := attestationutil.AttestingIndices(blockAtt.AggregationBits, committee)
 	require.NoError(t, err)
@@ -446,7 +446,7 @@ func createFullBlockWithOperations(t *testing.T) (iface.BeaconState,
 	require.NoError(t, copied.SetSlot(beaconState.Slot()+1))
 	randaoReveal, err := testutil.RandaoReveal(copied, currentEpoch, privKeys)
 	require.NoError(t, err)
-	proposerIndex, err := helpers.BeaconProposerIndex(copied)
+	proposerIndex, err := helpers.BeaconProposerIndex(nil, copied)
 	require.NoError(t, err)
 	block := testutil.HydrateSignedBeaconBlock(&ethpb.SignedBeaconBlock{
 		Block: &ethpb.BeaconBlock{
@@ -475,7 +475,7 @@ func TestProcessBlock_PassesProcessingConditions(t *testing.T) {
 	beaconState, block, _, proposerSlashings, exits := createFullBlockWithOperations(t)
 	exit := exits[0]
 
-	beaconState, err := state.ProcessBlock(context.Background(), beaconState, block)
+	beaconState, err := state.ProcessBlock(context.Background(), nil, beaconState, block)
 	require.NoError(t, err, "Expected block to pass processing conditions")
 
 	v, err := beaconState.ValidatorAtIndex(proposerSlashings[0].Header_1.Header.ProposerIndex)
@@ -494,7 +494,7 @@ func TestProcessBlock_PassesProcessingConditions(t *testing.T) {
 
 func TestProcessBlockNoVerify_PassesProcessingConditions(t *testing.T) {
 	beaconState, block, _, _, _ := createFullBlockWithOperations(t)
-	set, _, err := state.ProcessBlockNoVerifyAnySig(context.Background(), beaconState, block)
+	set, _, err := state.ProcessBlockNoVerifyAnySig(context.Background(), nil, beaconState, block)
 	require.NoError(t, err)
 	// Test Signature set verifies.
 	verified, err := set.Verify()
@@ -520,7 +520,7 @@ func TestProcessEpochPrecompute_CanProcess(t *testing.T) {
 	}
 	s, err := stateV0.InitializeFromProto(base)
 	require.NoError(t, err)
-	newState, err := state.ProcessEpochPrecompute(context.Background(), s)
+	newState, err := state.ProcessEpochPrecompute(context.Background(), nil, s)
 	require.NoError(t, err)
 	assert.Equal(t, uint64(0), newState.Slashings()[2], "Unexpected slashed balance")
 }
@@ -616,7 +616,7 @@ func BenchmarkProcessBlk_65536Validators_FullBlock(b *testing.B) {
 	root := depositTrie.Root()
 
 	// Set up randao reveal object for block
-	proposerIdx, err := helpers.BeaconProposerIndex(s)
+	proposerIdx, err := helpers.BeaconProposerIndex(nil, s)
 	require.NoError(b, err)
 	priv, err := bls.RandKey()
 	require.NoError(b, err)
@@ -670,13 +670,13 @@ func BenchmarkProcessBlk_65536Validators_FullBlock(b *testing.B) {
 
 	// Precache the shuffled indices
 	for i := uint64(0); i < committeeCount; i++ {
-		_, err := helpers.BeaconCommitteeFromState(s, 0, types.CommitteeIndex(i))
+		_, err := helpers.BeaconCommitteeFromState(nil, s, 0, types.CommitteeIndex(i))
 		require.NoError(b, err)
 	}
 
 	b.ResetTimer()
 	for n := 0; n < b.N; n++ {
-		_, err := state.ProcessBlock(context.Background(), s, blk)
+		_, err := state.ProcessBlock(context.Background(), nil, s, blk)
 		require.NoError(b, err)
 		// Reset state fields to process block again
 		v := s.Validators()
@@ -710,7 +710,7 @@ func TestProcessBlk_AttsBasedOnValidatorCount(t *testing.T) {
 			AggregationBits: aggBits,
 		})
 
-		committee, err := helpers.BeaconCommitteeFromState(s, att.Data.Slot, att.Data.CommitteeIndex)
+		committee, err := helpers.BeaconCommitteeFromState(nil, s, att.Data.Slot, att.Data.CommitteeIndex)
 		assert.NoError(t, err)
 		attestingIndices, err := attestationutil.AttestingIndices(att.AggregationBits, committee)
 		require.NoError(t, err)
@@ -742,7 +742,7 @@ func TestProcessBlk_AttsBasedOnValidatorCount(t *testing.T) {
 
 	nextSlotState := s.Copy()
 	require.NoError(t, nextSlotState.SetSlot(s.Slot()+1))
-	proposerIdx, err := helpers.BeaconProposerIndex(nextSlotState)
+	proposerIdx, err := helpers.BeaconProposerIndex(nil, nextSlotState)
 	require.NoError(t, err)
 	blk := testutil.NewBeaconBlock()
 	blk.Block.ProposerIndex = proposerIdx
@@ -760,7 +760,7 @@ func TestProcessBlk_AttsBasedOnValidatorCount(t *testing.T) {
 	params.OverrideBeaconConfig(config)
 
 	require.NoError(t, s.SetSlot(s.Slot()+1))
-	_, err = state.ProcessBlock(context.Background(), s, blk)
+	_, err = state.ProcessBlock(context.Background(), nil, s, blk)
 	require.NoError(t, err)
 }
 
@@ -880,7 +880,7 @@ func TestProcessSlots_SameSlotAsParentState(t *testing.T) {
 	parentState, err := stateV0.InitializeFromProto(&pb.BeaconState{Slot: slot})
 	require.NoError(t, err)
 
-	_, err = state.ProcessSlots(context.Background(), parentState, slot)
+	_, err = state.ProcessSlots(context.Background(), nil, parentState, slot)
 	assert.ErrorContains(t, "expected state.slot 2 < slot 2", err)
 }
 
@@ -889,7 +889,7 @@ func TestProcessSlots_LowerSlotAsParentState(t *testing.T) {
 	parentState, err := stateV0.InitializeFromProto(&pb.BeaconState{Slot: slot})
 	require.NoError(t, err)
 
-	_, err = state.ProcessSlots(context.Background(), parentState, slot-1)
+	_, err = state.ProcessSlots(context.Background(), nil, parentState, slot-1)
 	assert.ErrorContains(t, "expected state.slot 2 < slot 1", err)
 }
 
@@ -897,7 +897,7 @@ func TestProcessSlotsUsingNextSlotCache(t *testing.T) {
 	ctx := context.Background()
 	s, _ := testutil.DeterministicGenesisState(t, 1)
 	r := []byte{'a'}
-	s, err := state.ProcessSlotsUsingNextSlotCache(ctx, s, r, 5)
+	s, err := state.ProcessSlotsUsingNextSlotCache(ctx, nil, s, r, 5)
 	require.NoError(t, err)
 	require.Equal(t, types.Slot(5), s.Slot())
 }
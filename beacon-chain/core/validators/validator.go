@@ -8,6 +8,7 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -36,7 +37,7 @@ import (
 //    # Set validator exit epoch and withdrawable epoch
 //    validator.exit_epoch = exit_queue_epoch
 //    validator.withdrawable_epoch = Epoch(validator.exit_epoch + MIN_VALIDATOR_WITHDRAWABILITY_DELAY)
-func InitiateValidatorExit(state iface.BeaconState, idx types.ValidatorIndex) (iface.BeaconState, error) {
+func InitiateValidatorExit(committeeCache *cache.CommitteeCache, state iface.BeaconState, idx types.ValidatorIndex) (iface.BeaconState, error) {
 	validator, err := state.ValidatorAtIndex(idx)
 	if err != nil {
 		return nil, err
@@ -75,7 +76,7 @@ func InitiateValidatorExit(state iface.BeaconState, idx types.ValidatorIndex) (i
 	if err != nil {
 		return nil, err
 	}
-	activeValidatorCount, err := helpers.ActiveValidatorCount(state, helpers.CurrentEpoch(state))
+	activeValidatorCount, err := helpers.ActiveValidatorCount(committeeCache, state, helpers.CurrentEpoch(state))
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get active validator count")
 	}
@@ -121,8 +122,8 @@ func InitiateValidatorExit(state iface.BeaconState, idx types.ValidatorIndex) (i
 //    proposer_reward = Gwei(whistleblower_reward // PROPOSER_REWARD_QUOTIENT)
 //    increase_balance(state, proposer_index, proposer_reward)
 //    increase_balance(state, whistleblower_index, Gwei(whistleblower_reward - proposer_reward))
-func SlashValidator(state iface.BeaconState, slashedIdx types.ValidatorIndex) (iface.BeaconState, error) {
-	state, err := InitiateValidatorExit(state, slashedIdx)
+func SlashValidator(committeeCache *cache.CommitteeCache, state iface.BeaconState, slashedIdx types.ValidatorIndex) (iface.BeaconState, error) {
+	state, err := InitiateValidatorExit(committeeCache, state, slashedIdx)
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not initiate validator %d exit", slashedIdx)
 	}
@@ -152,7 +153,7 @@ func SlashValidator(state iface.BeaconState, slashedIdx types.ValidatorIndex) (i
 		return nil, err
 	}
 
-	proposerIdx, err := helpers.BeaconProposerIndex(state)
+	proposerIdx, err := helpers.BeaconProposerIndex(committeeCache, state)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get proposer idx")
 	}
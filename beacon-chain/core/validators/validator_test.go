@@ -46,7 +46,7 @@ func TestInitiateValidatorExit_AlreadyExited(t *testing.T) {
 	}}
 	state, err := stateV0.InitializeFromProto(base)
 	require.NoError(t, err)
-	newState, err := InitiateValidatorExit(state, 0)
+	newState, err := InitiateValidatorExit(nil, state, 0)
 	require.NoError(t, err)
 	v, err := newState.ValidatorAtIndex(0)
 	require.NoError(t, err)
@@ -64,7 +64,7 @@ func TestInitiateValidatorExit_ProperExit(t *testing.T) {
 	}}
 	state, err := stateV0.InitializeFromProto(base)
 	require.NoError(t, err)
-	newState, err := InitiateValidatorExit(state, idx)
+	newState, err := InitiateValidatorExit(nil, state, idx)
 	require.NoError(t, err)
 	v, err := newState.ValidatorAtIndex(idx)
 	require.NoError(t, err)
@@ -83,7 +83,7 @@ func TestInitiateValidatorExit_ChurnOverflow(t *testing.T) {
 	}}
 	state, err := stateV0.InitializeFromProto(base)
 	require.NoError(t, err)
-	newState, err := InitiateValidatorExit(state, idx)
+	newState, err := InitiateValidatorExit(nil, state, idx)
 	require.NoError(t, err)
 
 	// Because of exit queue overflow,
@@ -121,11 +121,11 @@ func TestSlashValidator_OK(t *testing.T) {
 
 	slashedIdx := types.ValidatorIndex(2)
 
-	proposer, err := helpers.BeaconProposerIndex(state)
+	proposer, err := helpers.BeaconProposerIndex(nil, state)
 	require.NoError(t, err, "Could not get proposer")
 	proposerBal, err := state.BalanceAtIndex(proposer)
 	require.NoError(t, err)
-	slashedState, err := SlashValidator(state, slashedIdx)
+	slashedState, err := SlashValidator(nil, state, slashedIdx)
 	require.NoError(t, err, "Could not slash validator")
 	state, ok := slashedState.(*stateV0.BeaconState)
 	require.Equal(t, true, ok)
@@ -317,7 +317,7 @@ func TestExitedValidatorIndices(t *testing.T) {
 	for _, tt := range tests {
 		s, err := stateV0.InitializeFromProto(tt.state)
 		require.NoError(t, err)
-		activeCount, err := helpers.ActiveValidatorCount(s, helpers.PrevEpoch(s))
+		activeCount, err := helpers.ActiveValidatorCount(nil, s, helpers.PrevEpoch(s))
 		require.NoError(t, err)
 		exitedIndices, err := ExitedValidatorIndices(0, tt.state.Validators, activeCount)
 		require.NoError(t, err)
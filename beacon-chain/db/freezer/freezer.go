@@ -0,0 +1,318 @@
+// Package freezer defines an append-only, file segment based store for finalized
+// beacon states. States moved to the freezer are never mutated again, which lets them
+// live outside of the bolt DB and keeps the hot database small.
+package freezer
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+const (
+	// DirName is the name of the directory containing the freezer, relative to the data directory.
+	DirName = "freezer"
+	// DefaultSegmentSize is the size after which a new segment file is started.
+	DefaultSegmentSize = 512 * 1024 * 1024
+
+	indexFileName     = "states.idx"
+	segmentFileFormat = "states-%06d.seg"
+)
+
+// ErrCorruptedState is returned when a stored state does not match the checksum of its index entry.
+var ErrCorruptedState = errors.New("freezer state checksum mismatch")
+
+// Store is a read-optimized store for finalized states. States are snappy compressed SSZ
+// encodings appended to segment files, and an append-only index maps every block root to the
+// location of its state. The index is loaded into memory on open, so a read is a single
+// positioned read from a segment file.
+type Store struct {
+	dir         string
+	segmentSize int64
+
+	lock      sync.RWMutex
+	indexFile *os.File
+	segments  []*os.File
+	headSize  int64
+	entries   map[[32]byte]*indexEntry
+	// bySlot holds the entries sorted by state slot for slot range lookups.
+	bySlot []*indexEntry
+}
+
+// Open opens the freezer at the given directory, creating it if it does not exist. Index entries
+// which refer to data that was not fully written, for instance after a crash, are discarded.
+func Open(dir string, segmentSize int64) (*Store, error) {
+	if segmentSize <= 0 {
+		segmentSize = DefaultSegmentSize
+	}
+	if err := fileutil.MkdirAll(dir); err != nil {
+		return nil, err
+	}
+	s := &Store{
+		dir:         dir,
+		segmentSize: segmentSize,
+		entries:     make(map[[32]byte]*indexEntry),
+	}
+	if err := s.openSegments(); err != nil {
+		s.closeFiles()
+		return nil, err
+	}
+	if err := s.loadIndex(); err != nil {
+		s.closeFiles()
+		return nil, err
+	}
+	return s, nil
+}
+
+// Close closes the index and segment files.
+func (s *Store) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.closeFiles()
+}
+
+// HasState checks if the state of a block root is in the freezer.
+func (s *Store) HasState(_ context.Context, blockRoot [32]byte) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	_, ok := s.entries[blockRoot]
+	return ok
+}
+
+// State returns the state of a block root, or nil if it is not in the freezer.
+func (s *Store) State(ctx context.Context, blockRoot [32]byte) (iface.BeaconState, error) {
+	_, span := trace.StartSpan(ctx, "Freezer.State")
+	defer span.End()
+
+	s.lock.RLock()
+	e, ok := s.entries[blockRoot]
+	if !ok {
+		s.lock.RUnlock()
+		return nil, nil
+	}
+	enc := make([]byte, e.length)
+	_, err := s.segments[e.segment].ReadAt(enc, int64(e.offset))
+	s.lock.RUnlock()
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read state from segment %d", e.segment)
+	}
+	if crc32.ChecksumIEEE(enc) != e.checksum {
+		return nil, ErrCorruptedState
+	}
+	return decodeState(enc)
+}
+
+// SaveState appends the state of a block root to the freezer. Saving a state which is already
+// in the freezer is a no-op.
+func (s *Store) SaveState(ctx context.Context, st iface.ReadOnlyBeaconState, blockRoot [32]byte) error {
+	_, span := trace.StartSpan(ctx, "Freezer.SaveState")
+	defer span.End()
+
+	if st == nil {
+		return errors.New("nil state")
+	}
+	if s.HasState(ctx, blockRoot) {
+		return nil
+	}
+	enc, err := encodeState(st)
+	if err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.entries[blockRoot]; ok {
+		return nil
+	}
+	if s.headSize > 0 && s.headSize+int64(len(enc)) > s.segmentSize {
+		if err := s.newSegment(); err != nil {
+			return err
+		}
+	}
+	e := &indexEntry{
+		root:     blockRoot,
+		slot:     st.Slot(),
+		segment:  uint32(len(s.segments) - 1),
+		offset:   uint64(s.headSize),
+		length:   uint32(len(enc)),
+		checksum: crc32.ChecksumIEEE(enc),
+	}
+	// The segment is synced before the index entry is written, so an index entry never refers
+	// to data which is not on disk.
+	head := s.segments[e.segment]
+	if _, err := head.WriteAt(enc, s.headSize); err != nil {
+		return errors.Wrap(err, "could not write state to segment")
+	}
+	if err := head.Sync(); err != nil {
+		return err
+	}
+	s.headSize += int64(len(enc))
+	if _, err := s.indexFile.Write(e.marshal()); err != nil {
+		return errors.Wrap(err, "could not write index entry")
+	}
+	if err := s.indexFile.Sync(); err != nil {
+		return err
+	}
+	s.insert(e)
+	return nil
+}
+
+// HighestSlotStateBelow returns the block root and slot of the state with the highest slot
+// below the input slot. The last return value is false if there is no such state.
+func (s *Store) HighestSlotStateBelow(_ context.Context, slot types.Slot) ([32]byte, types.Slot, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	i := sort.Search(len(s.bySlot), func(i int) bool {
+		return s.bySlot[i].slot >= slot
+	})
+	if i == 0 {
+		return [32]byte{}, 0, false
+	}
+	e := s.bySlot[i-1]
+	return e.root, e.slot, true
+}
+
+// openSegments opens the existing segment files in order, or creates the first one.
+func (s *Store) openSegments() error {
+	for i := 0; ; i++ {
+		path := s.segmentPath(i)
+		if i > 0 && !fileutil.FileExists(path) {
+			break
+		}
+		f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, params.BeaconIoConfig().ReadWritePermissions)
+		if err != nil {
+			return err
+		}
+		s.segments = append(s.segments, f)
+	}
+	info, err := s.segments[len(s.segments)-1].Stat()
+	if err != nil {
+		return err
+	}
+	s.headSize = info.Size()
+	return nil
+}
+
+// loadIndex reads the index into memory and truncates it after the last complete entry which
+// refers to data within the segment files.
+func (s *Store) loadIndex() error {
+	f, err := os.OpenFile(filepath.Join(s.dir, indexFileName), os.O_RDWR|os.O_CREATE, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return err
+	}
+	s.indexFile = f
+
+	sizes := make([]int64, len(s.segments))
+	for i, seg := range s.segments {
+		info, err := seg.Stat()
+		if err != nil {
+			return err
+		}
+		sizes[i] = info.Size()
+	}
+
+	var valid int64
+	buf := make([]byte, indexEntrySize)
+	for {
+		if _, err := io.ReadFull(f, buf); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				break
+			}
+			return err
+		}
+		e, err := unmarshalIndexEntry(buf)
+		if err != nil {
+			return err
+		}
+		if int(e.segment) >= len(sizes) || int64(e.offset)+int64(e.length) > sizes[e.segment] {
+			break
+		}
+		valid += indexEntrySize
+		if _, ok := s.entries[e.root]; !ok {
+			s.insert(e)
+		}
+	}
+	if err := f.Truncate(valid); err != nil {
+		return err
+	}
+	_, err = f.Seek(valid, io.SeekStart)
+	return err
+}
+
+func (s *Store) newSegment() error {
+	f, err := os.OpenFile(s.segmentPath(len(s.segments)), os.O_RDWR|os.O_CREATE, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return err
+	}
+	s.segments = append(s.segments, f)
+	s.headSize = 0
+	return nil
+}
+
+func (s *Store) insert(e *indexEntry) {
+	s.entries[e.root] = e
+	i := sort.Search(len(s.bySlot), func(i int) bool {
+		return s.bySlot[i].slot > e.slot
+	})
+	s.bySlot = append(s.bySlot, nil)
+	copy(s.bySlot[i+1:], s.bySlot[i:])
+	s.bySlot[i] = e
+}
+
+func (s *Store) segmentPath(i int) string {
+	return filepath.Join(s.dir, fmt.Sprintf(segmentFileFormat, i))
+}
+
+func (s *Store) closeFiles() error {
+	var firstErr error
+	if s.indexFile != nil {
+		firstErr = s.indexFile.Close()
+		s.indexFile = nil
+	}
+	for _, seg := range s.segments {
+		if err := seg.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	s.segments = nil
+	return firstErr
+}
+
+func encodeState(st iface.ReadOnlyBeaconState) ([]byte, error) {
+	pbState, err := stateV0.ProtobufBeaconState(st.InnerStateUnsafe())
+	if err != nil {
+		return nil, err
+	}
+	enc, err := pbState.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return snappy.Encode(nil, enc), nil
+}
+
+func decodeState(enc []byte) (iface.BeaconState, error) {
+	dec, err := snappy.Decode(nil, enc)
+	if err != nil {
+		return nil, err
+	}
+	pbState := &pb.BeaconState{}
+	if err := pbState.UnmarshalSSZ(dec); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal encoding")
+	}
+	return stateV0.InitializeFromProtoUnsafe(pbState)
+}
@@ -0,0 +1,146 @@
+package freezer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func setupStore(t *testing.T, dir string, segmentSize int64) *Store {
+	s, err := Open(dir, segmentSize)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, s.Close())
+	})
+	return s
+}
+
+func stateAtSlot(t *testing.T, slot types.Slot) iface.BeaconState {
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(slot))
+	return st
+}
+
+func TestStore_SaveAndRetrieveState(t *testing.T) {
+	ctx := context.Background()
+	s := setupStore(t, filepath.Join(t.TempDir(), DirName), 0)
+
+	root := [32]byte{'a'}
+	assert.Equal(t, false, s.HasState(ctx, root))
+	st, err := s.State(ctx, root)
+	require.NoError(t, err)
+	assert.Equal(t, nil, st)
+
+	wanted := stateAtSlot(t, 64)
+	require.NoError(t, s.SaveState(ctx, wanted, root))
+	assert.Equal(t, true, s.HasState(ctx, root))
+	received, err := s.State(ctx, root)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, wanted.InnerStateUnsafe(), received.InnerStateUnsafe())
+
+	// Saving the same root again does not append a second copy.
+	require.NoError(t, s.SaveState(ctx, stateAtSlot(t, 96), root))
+	received, err = s.State(ctx, root)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(64), received.Slot())
+}
+
+func TestStore_ReopenAndRollover(t *testing.T) {
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), DirName)
+	// A tiny segment size puts every state in its own segment.
+	s, err := Open(dir, 1)
+	require.NoError(t, err)
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, s.SaveState(ctx, stateAtSlot(t, types.Slot(i*32)), [32]byte{byte(i)}))
+	}
+	require.NoError(t, s.Close())
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, true, fileutil.FileExists(s.segmentPath(i)))
+	}
+
+	s = setupStore(t, dir, 1)
+	for i := 1; i <= 3; i++ {
+		st, err := s.State(ctx, [32]byte{byte(i)})
+		require.NoError(t, err)
+		assert.Equal(t, types.Slot(i*32), st.Slot())
+	}
+}
+
+func TestStore_HighestSlotStateBelow(t *testing.T) {
+	ctx := context.Background()
+	s := setupStore(t, filepath.Join(t.TempDir(), DirName), 0)
+	// States are not necessarily saved in slot order.
+	require.NoError(t, s.SaveState(ctx, stateAtSlot(t, 64), [32]byte{'b'}))
+	require.NoError(t, s.SaveState(ctx, stateAtSlot(t, 32), [32]byte{'a'}))
+
+	_, _, ok := s.HighestSlotStateBelow(ctx, 32)
+	assert.Equal(t, false, ok)
+	root, slot, ok := s.HighestSlotStateBelow(ctx, 64)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, [32]byte{'a'}, root)
+	assert.Equal(t, types.Slot(32), slot)
+	root, slot, ok = s.HighestSlotStateBelow(ctx, 1000)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, [32]byte{'b'}, root)
+	assert.Equal(t, types.Slot(64), slot)
+}
+
+func TestStore_DiscardsIncompleteEntries(t *testing.T) {
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), DirName)
+	s, err := Open(dir, 0)
+	require.NoError(t, err)
+	require.NoError(t, s.SaveState(ctx, stateAtSlot(t, 32), [32]byte{'a'}))
+	require.NoError(t, s.SaveState(ctx, stateAtSlot(t, 64), [32]byte{'b'}))
+	require.NoError(t, s.Close())
+
+	// Simulate a crash which left a partial index entry, and cut the data of the second state.
+	indexPath := filepath.Join(dir, indexFileName)
+	f, err := os.OpenFile(indexPath, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{1, 2, 3})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	info, err := os.Stat(s.segmentPath(0))
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(s.segmentPath(0), info.Size()-1))
+
+	s = setupStore(t, dir, 0)
+	assert.Equal(t, true, s.HasState(ctx, [32]byte{'a'}))
+	assert.Equal(t, false, s.HasState(ctx, [32]byte{'b'}))
+	info, err = os.Stat(indexPath)
+	require.NoError(t, err)
+	assert.Equal(t, int64(indexEntrySize), info.Size())
+
+	// The state can be saved again after recovery.
+	require.NoError(t, s.SaveState(ctx, stateAtSlot(t, 64), [32]byte{'b'}))
+	st, err := s.State(ctx, [32]byte{'b'})
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(64), st.Slot())
+}
+
+func TestStore_DetectsCorruption(t *testing.T) {
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), DirName)
+	s := setupStore(t, dir, 0)
+	require.NoError(t, s.SaveState(ctx, stateAtSlot(t, 32), [32]byte{'a'}))
+
+	f, err := os.OpenFile(s.segmentPath(0), os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteAt([]byte{0xff, 0xff, 0xff, 0xff}, 8)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	_, err = s.State(ctx, [32]byte{'a'})
+	assert.ErrorContains(t, ErrCorruptedState.Error(), err)
+}
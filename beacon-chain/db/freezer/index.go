@@ -0,0 +1,48 @@
+package freezer
+
+import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+// indexEntrySize is the size of an encoded index entry:
+// root (32) | slot (8) | segment (4) | offset (8) | length (4) | checksum (4).
+const indexEntrySize = 60
+
+// indexEntry locates the encoded state of a block root within the segment files.
+type indexEntry struct {
+	root     [32]byte
+	slot     types.Slot
+	segment  uint32
+	offset   uint64
+	length   uint32
+	checksum uint32
+}
+
+func (e *indexEntry) marshal() []byte {
+	buf := make([]byte, indexEntrySize)
+	copy(buf[:32], e.root[:])
+	binary.BigEndian.PutUint64(buf[32:40], uint64(e.slot))
+	binary.BigEndian.PutUint32(buf[40:44], e.segment)
+	binary.BigEndian.PutUint64(buf[44:52], e.offset)
+	binary.BigEndian.PutUint32(buf[52:56], e.length)
+	binary.BigEndian.PutUint32(buf[56:60], e.checksum)
+	return buf
+}
+
+func unmarshalIndexEntry(buf []byte) (*indexEntry, error) {
+	if len(buf) != indexEntrySize {
+		return nil, errors.Errorf("wrong index entry size, wanted %d, received %d", indexEntrySize, len(buf))
+	}
+	e := &indexEntry{
+		slot:     types.Slot(binary.BigEndian.Uint64(buf[32:40])),
+		segment:  binary.BigEndian.Uint32(buf[40:44]),
+		offset:   binary.BigEndian.Uint64(buf[44:52]),
+		length:   binary.BigEndian.Uint32(buf[52:56]),
+		checksum: binary.BigEndian.Uint32(buf[56:60]),
+	}
+	copy(e.root[:], buf[:32])
+	return e, nil
+}
@@ -10,7 +10,10 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	types "github.com/prysmaticlabs/eth2-types"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/summary"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/reorg"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/proto/beacon/db"
 	ethereum_beacon_p2p_v1 "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -56,6 +59,23 @@ type ReadOnlyDatabase interface {
 	DepositContractAddress(ctx context.Context) ([]byte, error)
 	// Powchain operations.
 	PowchainData(ctx context.Context) (*db.ETH1ChainData, error)
+	// Orchestrator operations.
+	PandoraConfirmation(ctx context.Context, blockRoot [32]byte) (*orchestrator.Confirmation, error)
+	PandoraConfirmationByHash(ctx context.Context, pandoraHash [32]byte) (*orchestrator.Confirmation, error)
+	EpochInfo(ctx context.Context, epoch types.Epoch) (*orchestrator.EpochInfo, error)
+	EpochInfoAccumulator(ctx context.Context, epoch types.Epoch) (*orchestrator.EpochInfoAccumulator, error)
+	EpochInfoAccumulatorHead(ctx context.Context) (*orchestrator.EpochInfoAccumulator, error)
+	// Validator balance history operations.
+	ValidatorBalances(ctx context.Context, epoch types.Epoch) ([]uint64, error)
+	// Epoch summary operations.
+	EpochSummary(ctx context.Context, epoch types.Epoch) (*summary.EpochSummary, error)
+	EpochSummaries(ctx context.Context, fromEpoch, toEpoch types.Epoch) ([]*summary.EpochSummary, error)
+	// Reorg history operations.
+	Reorgs(ctx context.Context, fromEpoch types.Epoch, limit int) ([]*reorg.Event, error)
+	// Tracked validator operations.
+	TrackedValidatorKeys(ctx context.Context) ([][48]byte, error)
+	// Stream cursor operations.
+	StreamCursor(ctx context.Context, subscriber string) (types.Epoch, bool, error)
 }
 
 // NoHeadAccessDatabase defines a struct without access to chain head data.
@@ -65,6 +85,7 @@ type NoHeadAccessDatabase interface {
 	// Block related methods.
 	SaveBlock(ctx context.Context, block *eth.SignedBeaconBlock) error
 	SaveBlocks(ctx context.Context, blocks []*eth.SignedBeaconBlock) error
+	SaveBlocksWithProgress(ctx context.Context, blocks []*eth.SignedBeaconBlock, progress func(saved, total int)) error
 	SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte) error
 	// State related methods.
 	SaveState(ctx context.Context, state iface.ReadOnlyBeaconState, blockRoot [32]byte) error
@@ -85,6 +106,19 @@ type NoHeadAccessDatabase interface {
 	SaveDepositContractAddress(ctx context.Context, addr common.Address) error
 	// Powchain operations.
 	SavePowchainData(ctx context.Context, data *db.ETH1ChainData) error
+	// Orchestrator operations.
+	SavePandoraConfirmations(ctx context.Context, confirmations []*orchestrator.Confirmation) error
+	SaveEpochInfo(ctx context.Context, info *orchestrator.EpochInfo) error
+	// Validator balance history operations.
+	SaveValidatorBalances(ctx context.Context, epoch types.Epoch, balances []uint64) error
+	// Epoch summary operations.
+	SaveEpochSummary(ctx context.Context, sum *summary.EpochSummary) error
+	// Reorg history operations.
+	SaveReorg(ctx context.Context, event *reorg.Event) error
+	// Tracked validator operations.
+	SaveTrackedValidatorKeys(ctx context.Context, keys [][48]byte) error
+	// Stream cursor operations.
+	SaveStreamCursor(ctx context.Context, subscriber string, epoch types.Epoch) error
 
 	// Run any required database migrations.
 	RunMigrations(ctx context.Context) error
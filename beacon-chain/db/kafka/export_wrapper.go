@@ -108,3 +108,16 @@ func (e Exporter) SaveBlocks(ctx context.Context, blocks []*eth.SignedBeaconBloc
 
 	return e.db.SaveBlocks(ctx, blocks)
 }
+
+// SaveBlocksWithProgress publishes to the kafka topic for beacon blocks.
+func (e Exporter) SaveBlocksWithProgress(ctx context.Context, blocks []*eth.SignedBeaconBlock, progress func(saved, total int)) error {
+	go func() {
+		for _, block := range blocks {
+			if err := e.publish(ctx, "beacon_block", block); err != nil {
+				log.WithError(err).Error("Failed to publish block")
+			}
+		}
+	}()
+
+	return e.db.SaveBlocksWithProgress(ctx, blocks, progress)
+}
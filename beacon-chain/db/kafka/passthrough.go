@@ -7,7 +7,10 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	types "github.com/prysmaticlabs/eth2-types"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/summary"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/reorg"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/proto/beacon/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -282,3 +285,93 @@ func (e Exporter) SaveGenesisData(ctx context.Context, state iface.BeaconState)
 func (e Exporter) EnsureEmbeddedGenesis(ctx context.Context) error {
 	return e.db.EnsureEmbeddedGenesis(ctx)
 }
+
+// PandoraConfirmation -- passthrough.
+func (e Exporter) PandoraConfirmation(ctx context.Context, blockRoot [32]byte) (*orchestrator.Confirmation, error) {
+	return e.db.PandoraConfirmation(ctx, blockRoot)
+}
+
+// PandoraConfirmationByHash -- passthrough.
+func (e Exporter) PandoraConfirmationByHash(ctx context.Context, pandoraHash [32]byte) (*orchestrator.Confirmation, error) {
+	return e.db.PandoraConfirmationByHash(ctx, pandoraHash)
+}
+
+// SavePandoraConfirmations -- passthrough.
+func (e Exporter) SavePandoraConfirmations(ctx context.Context, confirmations []*orchestrator.Confirmation) error {
+	return e.db.SavePandoraConfirmations(ctx, confirmations)
+}
+
+// EpochInfo -- passthrough.
+func (e Exporter) EpochInfo(ctx context.Context, epoch types.Epoch) (*orchestrator.EpochInfo, error) {
+	return e.db.EpochInfo(ctx, epoch)
+}
+
+// SaveEpochInfo -- passthrough.
+func (e Exporter) SaveEpochInfo(ctx context.Context, info *orchestrator.EpochInfo) error {
+	return e.db.SaveEpochInfo(ctx, info)
+}
+
+// EpochInfoAccumulator -- passthrough.
+func (e Exporter) EpochInfoAccumulator(ctx context.Context, epoch types.Epoch) (*orchestrator.EpochInfoAccumulator, error) {
+	return e.db.EpochInfoAccumulator(ctx, epoch)
+}
+
+// EpochInfoAccumulatorHead -- passthrough.
+func (e Exporter) EpochInfoAccumulatorHead(ctx context.Context) (*orchestrator.EpochInfoAccumulator, error) {
+	return e.db.EpochInfoAccumulatorHead(ctx)
+}
+
+// ValidatorBalances -- passthrough.
+func (e Exporter) ValidatorBalances(ctx context.Context, epoch types.Epoch) ([]uint64, error) {
+	return e.db.ValidatorBalances(ctx, epoch)
+}
+
+// SaveValidatorBalances -- passthrough.
+func (e Exporter) SaveValidatorBalances(ctx context.Context, epoch types.Epoch, balances []uint64) error {
+	return e.db.SaveValidatorBalances(ctx, epoch, balances)
+}
+
+// EpochSummary -- passthrough.
+func (e Exporter) EpochSummary(ctx context.Context, epoch types.Epoch) (*summary.EpochSummary, error) {
+	return e.db.EpochSummary(ctx, epoch)
+}
+
+// EpochSummaries -- passthrough.
+func (e Exporter) EpochSummaries(ctx context.Context, fromEpoch, toEpoch types.Epoch) ([]*summary.EpochSummary, error) {
+	return e.db.EpochSummaries(ctx, fromEpoch, toEpoch)
+}
+
+// SaveEpochSummary -- passthrough.
+func (e Exporter) SaveEpochSummary(ctx context.Context, sum *summary.EpochSummary) error {
+	return e.db.SaveEpochSummary(ctx, sum)
+}
+
+// Reorgs -- passthrough.
+func (e Exporter) Reorgs(ctx context.Context, fromEpoch types.Epoch, limit int) ([]*reorg.Event, error) {
+	return e.db.Reorgs(ctx, fromEpoch, limit)
+}
+
+// SaveReorg -- passthrough.
+func (e Exporter) SaveReorg(ctx context.Context, event *reorg.Event) error {
+	return e.db.SaveReorg(ctx, event)
+}
+
+// TrackedValidatorKeys -- passthrough.
+func (e Exporter) TrackedValidatorKeys(ctx context.Context) ([][48]byte, error) {
+	return e.db.TrackedValidatorKeys(ctx)
+}
+
+// SaveTrackedValidatorKeys -- passthrough.
+func (e Exporter) SaveTrackedValidatorKeys(ctx context.Context, keys [][48]byte) error {
+	return e.db.SaveTrackedValidatorKeys(ctx, keys)
+}
+
+// StreamCursor -- passthrough.
+func (e Exporter) StreamCursor(ctx context.Context, subscriber string) (types.Epoch, bool, error) {
+	return e.db.StreamCursor(ctx, subscriber)
+}
+
+// SaveStreamCursor -- passthrough.
+func (e Exporter) SaveStreamCursor(ctx context.Context, subscriber string, epoch types.Epoch) error {
+	return e.db.SaveStreamCursor(ctx, subscriber, epoch)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
@@ -11,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/mputil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 	bolt "go.etcd.io/bbolt"
@@ -20,6 +22,10 @@ import (
 // used to represent errors for inconsistent slot ranges.
 var errInvalidSlotRange = errors.New("invalid end slot and start slot provided")
 
+// blockBatchSize is the number of blocks SaveBlocks writes per transaction, which keeps the write
+// lock from being held across a whole initial sync batch.
+const blockBatchSize = 256
+
 // Block retrieval by root.
 func (s *Store) Block(ctx context.Context, blockRoot [32]byte) (*ethpb.SignedBeaconBlock, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.Block")
@@ -253,36 +259,100 @@ func (s *Store) SaveBlock(ctx context.Context, signed *ethpb.SignedBeaconBlock)
 
 // SaveBlocks via bulk updates to the db.
 func (s *Store) SaveBlocks(ctx context.Context, blocks []*ethpb.SignedBeaconBlock) error {
+	return s.SaveBlocksWithProgress(ctx, blocks, nil)
+}
+
+// SaveBlocksWithProgress saves the blocks in transactions of up to blockBatchSize blocks. The block
+// roots and encodings are computed in parallel ahead of the writes, so that the transactions only
+// hold the write lock for the puts. The progress callback, if any, is called after every committed
+// batch with the number of blocks processed so far. A failed batch leaves the earlier batches saved.
+func (s *Store) SaveBlocksWithProgress(ctx context.Context, blocks []*ethpb.SignedBeaconBlock, progress func(saved, total int)) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBlocks")
 	defer span.End()
+	if len(blocks) == 0 {
+		return nil
+	}
 
-	return s.db.Update(func(tx *bolt.Tx) error {
-		bkt := tx.Bucket(blocksBucket)
-		for _, block := range blocks {
-			blockRoot, err := block.Block.HashTreeRoot()
-			if err != nil {
-				return err
+	encoded, err := encodeBlocks(ctx, blocks)
+	if err != nil {
+		return err
+	}
+	for start := 0; start < len(encoded); start += blockBatchSize {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		end := start + blockBatchSize
+		if end > len(encoded) {
+			end = len(encoded)
+		}
+		if err := s.db.Update(func(tx *bolt.Tx) error {
+			bkt := tx.Bucket(blocksBucket)
+			for _, b := range encoded[start:end] {
+				if existingBlock := bkt.Get(b.root[:]); existingBlock != nil {
+					continue
+				}
+				indicesByBucket := createBlockIndicesFromBlock(ctx, b.block.Block)
+				if err := updateValueForIndices(ctx, indicesByBucket, b.root[:], tx); err != nil {
+					return errors.Wrap(err, "could not update DB indices")
+				}
+				s.blockCache.Set(string(b.root[:]), b.block, int64(len(b.enc)))
+
+				if err := bkt.Put(b.root[:], b.enc); err != nil {
+					return err
+				}
 			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		if progress != nil {
+			progress(end, len(encoded))
+		}
+	}
+	return nil
+}
 
-			if existingBlock := bkt.Get(blockRoot[:]); existingBlock != nil {
-				continue
+// encodedBlock is a block with its root and database encoding.
+type encodedBlock struct {
+	block *ethpb.SignedBeaconBlock
+	root  [32]byte
+	enc   []byte
+}
+
+// encodeBlocks computes the roots and encodings of the blocks across multiple goroutines.
+func encodeBlocks(ctx context.Context, blocks []*ethpb.SignedBeaconBlock) ([]*encodedBlock, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.encodeBlocks")
+	defer span.End()
+	encoded := make([]*encodedBlock, len(blocks))
+	results, err := mputil.Scatter(len(blocks), func(offset int, entries int, _ *sync.RWMutex) (interface{}, error) {
+		extent := make([]*encodedBlock, entries)
+		for i, block := range blocks[offset : offset+entries] {
+			if block == nil || block.Block == nil {
+				return nil, errors.Errorf("nil block at position %d", offset+i)
 			}
-			enc, err := encode(ctx, block)
+			root, err := block.Block.HashTreeRoot()
 			if err != nil {
-				return err
+				return nil, err
 			}
-			indicesByBucket := createBlockIndicesFromBlock(ctx, block.Block)
-			if err := updateValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
-				return errors.Wrap(err, "could not update DB indices")
-			}
-			s.blockCache.Set(string(blockRoot[:]), block, int64(len(enc)))
-
-			if err := bkt.Put(blockRoot[:], enc); err != nil {
-				return err
+			enc, err := encode(ctx, block)
+			if err != nil {
+				return nil, err
 			}
+			extent[i] = &encodedBlock{block: block, root: root, enc: enc}
 		}
-		return nil
+		return extent, nil
 	})
+	if err != nil {
+		return nil, err
+	}
+	for _, result := range results {
+		extent, ok := result.Extent.([]*encodedBlock)
+		if !ok {
+			return nil, errors.New("extent not of expected type")
+		}
+		copy(encoded[result.Offset:], extent)
+	}
+	return encoded, nil
 }
 
 // SaveHeadBlockRoot to the db.
@@ -303,6 +303,41 @@ func TestStore_Blocks_Retrieve_SlotRange(t *testing.T) {
 	assert.Equal(t, 300, len(retrieved))
 }
 
+func TestStore_SaveBlocksWithProgress(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	count := 2*blockBatchSize + 10
+	blocks := make([]*ethpb.SignedBeaconBlock, count)
+	for i := 0; i < count; i++ {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = types.Slot(i)
+		b.Block.ParentRoot = bytesutil.PadTo([]byte("parent"), 32)
+		blocks[i] = b
+	}
+	// A block of the batch which is already saved is skipped.
+	require.NoError(t, db.SaveBlock(ctx, blocks[5]))
+
+	var progress []int
+	require.NoError(t, db.SaveBlocksWithProgress(ctx, blocks, func(saved, total int) {
+		assert.Equal(t, count, total)
+		progress = append(progress, saved)
+	}))
+	assert.DeepEqual(t, []int{blockBatchSize, 2 * blockBatchSize, count}, progress)
+	retrieved, roots, err := db.Blocks(ctx, filters.NewFilter().SetStartSlot(0).SetEndSlot(types.Slot(count)))
+	require.NoError(t, err)
+	require.Equal(t, count, len(retrieved))
+	for i, root := range roots {
+		wanted, err := retrieved[i].Block.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, wanted, root)
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.ErrorContains(t, "context canceled", db.SaveBlocksWithProgress(cancelled, blocks, nil))
+	assert.ErrorContains(t, "nil block at position 1", db.SaveBlocks(ctx, []*ethpb.SignedBeaconBlock{blocks[0], nil}))
+}
+
 func TestStore_Blocks_Retrieve_Epoch(t *testing.T) {
 	db := setupDB(t)
 	slots := params.BeaconConfig().SlotsPerEpoch.Mul(7)
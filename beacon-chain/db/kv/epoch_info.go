@@ -0,0 +1,146 @@
+package kv
+
+import (
+	"context"
+	"errors"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// EpochInfo retrieves the persisted epoch info of the given epoch.
+// It returns nil if no epoch info was saved for the epoch.
+func (s *Store) EpochInfo(ctx context.Context, epoch types.Epoch) (*orchestrator.EpochInfo, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.EpochInfo")
+	defer span.End()
+
+	var info *orchestrator.EpochInfo
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(epochInfosBucket).Get(bytesutil.EpochToBytesBigEndian(epoch))
+		if len(enc) == 0 {
+			return nil
+		}
+		info = &orchestrator.EpochInfo{}
+		return info.UnmarshalBinary(enc)
+	})
+	traceutil.AnnotateError(span, err)
+	return info, err
+}
+
+// SaveEpochInfo saves the epoch info keyed by its epoch, overriding any earlier one, and extends
+// the epoch info accumulator over it.
+func (s *Store) SaveEpochInfo(ctx context.Context, info *orchestrator.EpochInfo) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveEpochInfo")
+	defer span.End()
+
+	if info == nil {
+		return errors.New("cannot save nil epoch info")
+	}
+	enc, err := info.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(epochInfosBucket).Put(bytesutil.EpochToBytesBigEndian(info.Epoch), enc); err != nil {
+			return err
+		}
+		return extendEpochInfoAccumulator(tx, info.Epoch)
+	})
+	traceutil.AnnotateError(span, err)
+	return err
+}
+
+// EpochInfoAccumulator retrieves the accumulator of the given epoch.
+// It returns nil if the epoch is not accumulated.
+func (s *Store) EpochInfoAccumulator(ctx context.Context, epoch types.Epoch) (*orchestrator.EpochInfoAccumulator, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.EpochInfoAccumulator")
+	defer span.End()
+
+	var acc *orchestrator.EpochInfoAccumulator
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(epochInfoAccumulatorsBucket)
+		root := bkt.Get(bytesutil.EpochToBytesBigEndian(epoch))
+		if root == nil {
+			return nil
+		}
+		anchor, _ := bkt.Cursor().First()
+		acc = &orchestrator.EpochInfoAccumulator{
+			AnchorEpoch: bytesutil.BytesToEpochBigEndian(anchor),
+			Epoch:       epoch,
+			Root:        bytesutil.ToBytes32(root),
+		}
+		return nil
+	})
+	traceutil.AnnotateError(span, err)
+	return acc, err
+}
+
+// EpochInfoAccumulatorHead retrieves the accumulator of the last accumulated epoch.
+// It returns nil if no epoch info is accumulated.
+func (s *Store) EpochInfoAccumulatorHead(ctx context.Context) (*orchestrator.EpochInfoAccumulator, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.EpochInfoAccumulatorHead")
+	defer span.End()
+
+	var acc *orchestrator.EpochInfoAccumulator
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(epochInfoAccumulatorsBucket).Cursor()
+		anchor, _ := c.First()
+		if anchor == nil {
+			return nil
+		}
+		head, root := c.Last()
+		acc = &orchestrator.EpochInfoAccumulator{
+			AnchorEpoch: bytesutil.BytesToEpochBigEndian(anchor),
+			Epoch:       bytesutil.BytesToEpochBigEndian(head),
+			Root:        bytesutil.ToBytes32(root),
+		}
+		return nil
+	})
+	traceutil.AnnotateError(span, err)
+	return acc, err
+}
+
+// extendEpochInfoAccumulator accumulates the epoch info of the given epoch, and the persisted
+// epoch infos following it, if the epoch directly follows the last accumulated epoch. The first
+// epoch info saved anchors the accumulator. Accumulated epochs are never accumulated again, so
+// that the roots handed out stay valid, and epoch infos saved out of order are accumulated once
+// the gap before them is filled.
+func extendEpochInfoAccumulator(tx *bolt.Tx, epoch types.Epoch) error {
+	accs := tx.Bucket(epochInfoAccumulatorsBucket)
+	infos := tx.Bucket(epochInfosBucket)
+	var prev [32]byte
+	anchor, _ := accs.Cursor().First()
+	if anchor != nil {
+		if epoch == 0 || accs.Get(bytesutil.EpochToBytesBigEndian(epoch)) != nil {
+			return nil
+		}
+		root := accs.Get(bytesutil.EpochToBytesBigEndian(epoch - 1))
+		if root == nil {
+			return nil
+		}
+		prev = bytesutil.ToBytes32(root)
+	}
+	for e := epoch; ; e++ {
+		key := bytesutil.EpochToBytesBigEndian(e)
+		enc := infos.Get(key)
+		if enc == nil || accs.Get(key) != nil {
+			return nil
+		}
+		info := &orchestrator.EpochInfo{}
+		if err := info.UnmarshalBinary(enc); err != nil {
+			return err
+		}
+		root, err := orchestrator.AccumulateEpochInfo(prev, info)
+		if err != nil {
+			return err
+		}
+		if err := accs.Put(key, root[:]); err != nil {
+			return err
+		}
+		prev = root
+	}
+}
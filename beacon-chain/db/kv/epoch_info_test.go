@@ -0,0 +1,80 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_EpochInfo_CRUD(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	info, err := db.EpochInfo(ctx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, (*orchestrator.EpochInfo)(nil), info)
+
+	want := &orchestrator.EpochInfo{
+		Epoch:          3,
+		EpochStartTime: 1600000576,
+		SlotDuration:   6,
+		Proposers:      [][48]byte{{'a'}, {'b'}},
+	}
+	// The proposer list root is derived from the stored proposers.
+	want.ProposerListRoot, err = orchestrator.ProposerListRoot(want.Proposers)
+	require.NoError(t, err)
+	require.NoError(t, db.SaveEpochInfo(ctx, want))
+	info, err = db.EpochInfo(ctx, 3)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, info)
+
+	assert.ErrorContains(t, "cannot save nil epoch info", db.SaveEpochInfo(ctx, nil))
+}
+
+func TestStore_EpochInfoAccumulator(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	info := func(epoch types.Epoch) *orchestrator.EpochInfo {
+		return &orchestrator.EpochInfo{Epoch: epoch, Proposers: [][48]byte{{byte(epoch)}}}
+	}
+
+	head, err := db.EpochInfoAccumulatorHead(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*orchestrator.EpochInfoAccumulator)(nil), head)
+
+	// The first saved epoch info anchors the accumulator, and epoch infos saved after a gap are
+	// accumulated once the gap is filled.
+	require.NoError(t, db.SaveEpochInfo(ctx, info(3)))
+	require.NoError(t, db.SaveEpochInfo(ctx, info(5)))
+	require.NoError(t, db.SaveEpochInfo(ctx, info(6)))
+	head, err = db.EpochInfoAccumulatorHead(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, head)
+	assert.Equal(t, types.Epoch(3), head.AnchorEpoch)
+	assert.Equal(t, types.Epoch(3), head.Epoch)
+	acc, err := db.EpochInfoAccumulator(ctx, 5)
+	require.NoError(t, err)
+	assert.Equal(t, (*orchestrator.EpochInfoAccumulator)(nil), acc)
+
+	require.NoError(t, db.SaveEpochInfo(ctx, info(4)))
+	head, err = db.EpochInfoAccumulatorHead(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(6), head.Epoch)
+	assert.NoError(t, orchestrator.VerifyEpochInfoRange([32]byte{}, []*orchestrator.EpochInfo{info(3), info(4), info(5), info(6)}, head.Root))
+
+	// Epochs before the anchor are not accumulated.
+	require.NoError(t, db.SaveEpochInfo(ctx, info(2)))
+	acc, err = db.EpochInfoAccumulator(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, (*orchestrator.EpochInfoAccumulator)(nil), acc)
+
+	acc, err = db.EpochInfoAccumulator(ctx, 4)
+	require.NoError(t, err)
+	require.NotNil(t, acc)
+	assert.Equal(t, types.Epoch(3), acc.AnchorEpoch)
+	assert.NoError(t, orchestrator.VerifyEpochInfoRange(acc.Root, []*orchestrator.EpochInfo{info(5), info(6)}, head.Root))
+}
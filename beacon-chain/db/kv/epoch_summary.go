@@ -0,0 +1,76 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/summary"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// EpochSummary retrieves the summary archived for the given epoch.
+// It returns nil if no summary was archived for the epoch.
+func (s *Store) EpochSummary(ctx context.Context, epoch types.Epoch) (*summary.EpochSummary, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.EpochSummary")
+	defer span.End()
+
+	var sum *summary.EpochSummary
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(epochSummariesBucket).Get(bytesutil.EpochToBytesBigEndian(epoch))
+		if len(enc) == 0 {
+			return nil
+		}
+		sum = &summary.EpochSummary{}
+		return sum.UnmarshalSSZ(enc)
+	})
+	traceutil.AnnotateError(span, err)
+	return sum, err
+}
+
+// EpochSummaries retrieves the summaries archived for the epochs in the inclusive range, in
+// ascending epoch order. Epochs without a summary are left out.
+func (s *Store) EpochSummaries(ctx context.Context, fromEpoch, toEpoch types.Epoch) ([]*summary.EpochSummary, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.EpochSummaries")
+	defer span.End()
+
+	sums := make([]*summary.EpochSummary, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		// Epochs are keyed big endian, so the keys of the range are contiguous in the bucket.
+		max := bytesutil.EpochToBytesBigEndian(toEpoch)
+		c := tx.Bucket(epochSummariesBucket).Cursor()
+		for k, v := c.Seek(bytesutil.EpochToBytesBigEndian(fromEpoch)); k != nil && bytes.Compare(k, max) <= 0; k, v = c.Next() {
+			sum := &summary.EpochSummary{}
+			if err := sum.UnmarshalSSZ(v); err != nil {
+				return err
+			}
+			sums = append(sums, sum)
+		}
+		return nil
+	})
+	traceutil.AnnotateError(span, err)
+	return sums, err
+}
+
+// SaveEpochSummary archives the summary keyed by its epoch, overriding any earlier one.
+func (s *Store) SaveEpochSummary(ctx context.Context, sum *summary.EpochSummary) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveEpochSummary")
+	defer span.End()
+
+	if sum == nil {
+		return errors.New("cannot save nil epoch summary")
+	}
+	enc, err := sum.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(epochSummariesBucket).Put(bytesutil.EpochToBytesBigEndian(sum.Epoch), enc)
+	})
+	traceutil.AnnotateError(span, err)
+	return err
+}
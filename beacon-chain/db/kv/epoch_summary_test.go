@@ -0,0 +1,59 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/summary"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_EpochSummary_CRUD(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	sum, err := db.EpochSummary(ctx, 5)
+	require.NoError(t, err)
+	assert.Equal(t, (*summary.EpochSummary)(nil), sum)
+
+	want := &summary.EpochSummary{
+		Epoch:                  5,
+		ValidatorCount:         10,
+		ActiveValidatorCount:   8,
+		TotalBalance:           320e9,
+		TargetAttestingBalance: 200e9,
+		ProposerListHash:       [32]byte{'a'},
+		JustifiedEpoch:         4,
+		JustifiedRoot:          [32]byte{'b'},
+		FinalizedEpoch:         3,
+		FinalizedRoot:          [32]byte{'c'},
+	}
+	require.NoError(t, db.SaveEpochSummary(ctx, want))
+	sum, err = db.EpochSummary(ctx, 5)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, sum)
+
+	assert.ErrorContains(t, "cannot save nil epoch summary", db.SaveEpochSummary(ctx, nil))
+}
+
+func TestStore_EpochSummaries(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	for _, epoch := range []types.Epoch{1, 3, 4, 256, 300} {
+		require.NoError(t, db.SaveEpochSummary(ctx, &summary.EpochSummary{Epoch: epoch, ValidatorCount: uint64(epoch)}))
+	}
+	sums, err := db.EpochSummaries(ctx, 2, 256)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(sums))
+	for i, epoch := range []types.Epoch{3, 4, 256} {
+		assert.Equal(t, epoch, sums[i].Epoch)
+		assert.Equal(t, uint64(epoch), sums[i].ValidatorCount)
+	}
+
+	sums, err = db.EpochSummaries(ctx, 5, 255)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(sums))
+}
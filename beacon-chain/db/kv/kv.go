@@ -147,6 +147,18 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			newStateServiceCompatibleBucket,
 			// Migrations
 			migrationsBucket,
+			pandoraConfirmationsBucket,
+			pandoraHashIndicesBucket,
+			epochInfosBucket,
+			epochInfoAccumulatorsBucket,
+			validatorBalancesBucket,
+			epochSummariesBucket,
+			reorgsBucket,
+			stateValidatorsBucket,
+			stateValidatorRefsBucket,
+			stateValidatorKeysBucket,
+			trackedValidatorsBucket,
+			streamCursorsBucket,
 		)
 	}); err != nil {
 		return nil, err
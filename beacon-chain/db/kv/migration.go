@@ -3,28 +3,30 @@ package kv
 import (
 	"context"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/migrations"
 	bolt "go.etcd.io/bbolt"
 )
 
 var migrationCompleted = []byte("done")
 
-type migration func(*bolt.Tx) error
+// schemaMigrations are the versioned migrations of the database schema. Migrations are only
+// ever appended, with the next version.
+var schemaMigrations = []migrations.Migration{
+	{Version: 1, Name: "archived-index", Migrate: txMigration(migrateArchivedIndex)},
+	{Version: 2, Name: "block-slot-index", Migrate: txMigration(migrateBlockSlotIndex)},
+	{Version: 3, Name: "backfill-epoch-summaries", Migrate: migrateEpochSummaries},
+	{Version: 4, Name: "pandora-hash-index", Migrate: migratePandoraHashIndex},
+}
 
-var migrations = []migration{
-	migrateArchivedIndex,
-	migrateBlockSlotIndex,
+// txMigration adapts the migrations which predate schema versions. They record their own
+// completion, so they are skipped on databases they already ran against.
+func txMigration(m func(*bolt.Tx) error) func(context.Context, *bolt.Tx) error {
+	return func(_ context.Context, tx *bolt.Tx) error {
+		return m(tx)
+	}
 }
 
-// RunMigrations defined in the migrations array.
+// RunMigrations applies the schema migrations not yet applied to the database.
 func (s *Store) RunMigrations(ctx context.Context) error {
-	for _, m := range migrations {
-		if ctx.Err() != nil {
-			return ctx.Err()
-		}
-
-		if err := s.db.Update(m); err != nil {
-			return err
-		}
-	}
-	return nil
+	return migrations.Run(ctx, s.db, migrationsBucket, schemaMigrations)
 }
@@ -0,0 +1,62 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/summary"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+)
+
+// migrateEpochSummaries archives the summaries of the finalized epochs whose start state is
+// saved, for nodes which finalized those epochs before epoch summaries were archived.
+func migrateEpochSummaries(ctx context.Context, tx *bolt.Tx) error {
+	enc := tx.Bucket(checkpointBucket).Get(finalizedCheckpointKey)
+	if enc == nil {
+		return nil // Nothing finalized yet.
+	}
+	finalized := &ethpb.Checkpoint{}
+	if err := decode(ctx, enc, finalized); err != nil {
+		return err
+	}
+
+	sums := tx.Bucket(epochSummariesBucket)
+	return tx.Bucket(stateBucket).ForEach(func(k, v []byte) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		protoState, err := createState(ctx, v)
+		if err != nil {
+			return err
+		}
+		// Summaries are computed from the state at the start of a finalized epoch.
+		epoch := helpers.SlotToEpoch(protoState.Slot)
+		if !helpers.IsEpochStart(protoState.Slot) || epoch > finalized.Epoch {
+			return nil
+		}
+		key := bytesutil.EpochToBytesBigEndian(epoch)
+		if sums.Get(key) != nil {
+			return nil
+		}
+		if err := hydrateStateValidators(ctx, tx, k, protoState); err != nil {
+			return err
+		}
+		st, err := stateV0.InitializeFromProtoUnsafe(protoState)
+		if err != nil {
+			return err
+		}
+		sum, err := summary.New(ctx, nil, st)
+		if err != nil {
+			return errors.Wrapf(err, "could not compute summary of epoch %d", epoch)
+		}
+		sumEnc, err := sum.MarshalSSZ()
+		if err != nil {
+			return err
+		}
+		return sums.Put(key, sumEnc)
+	})
+}
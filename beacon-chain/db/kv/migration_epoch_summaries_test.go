@@ -0,0 +1,45 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func Test_migrateEpochSummaries(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	saveState := func(slot types.Slot, root byte) {
+		st, _ := testutil.DeterministicGenesisState(t, 64)
+		require.NoError(t, st.SetSlot(slot))
+		require.NoError(t, db.SaveState(ctx, st, [32]byte{root}))
+	}
+	saveState(slotsPerEpoch, 'a')
+	saveState(slotsPerEpoch+1, 'b')
+	saveState(3*slotsPerEpoch, 'c')
+	require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+		enc, err := encode(ctx, &ethpb.Checkpoint{Epoch: 2, Root: make([]byte, 32)})
+		if err != nil {
+			return err
+		}
+		return tx.Bucket(checkpointBucket).Put(finalizedCheckpointKey, enc)
+	}))
+
+	require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+		return migrateEpochSummaries(ctx, tx)
+	}))
+	sums, err := db.EpochSummaries(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(sums), "Expected only the finalized epoch start state to be summarized")
+	assert.Equal(t, types.Epoch(1), sums[0].Epoch)
+	assert.Equal(t, uint64(64), sums[0].ValidatorCount)
+}
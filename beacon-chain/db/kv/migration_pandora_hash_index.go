@@ -0,0 +1,24 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	bolt "go.etcd.io/bbolt"
+)
+
+// migratePandoraHashIndex indexes by Pandora block hash the orchestrator confirmations saved
+// before the index existed.
+func migratePandoraHashIndex(ctx context.Context, tx *bolt.Tx) error {
+	idx := tx.Bucket(pandoraHashIndicesBucket)
+	return tx.Bucket(pandoraConfirmationsBucket).ForEach(func(k, v []byte) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		c := &orchestrator.Confirmation{}
+		if err := c.UnmarshalBinary(v); err != nil {
+			return err
+		}
+		return idx.Put(c.PandoraHash[:], k)
+	})
+}
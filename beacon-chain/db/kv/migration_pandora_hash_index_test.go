@@ -0,0 +1,33 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func Test_migratePandoraHashIndex(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	confirmation := &orchestrator.Confirmation{Slot: 3, BlockRoot: [32]byte{'A'}, PandoraHash: [32]byte{'a'}, Status: orchestrator.Verified}
+	require.NoError(t, db.SavePandoraConfirmations(ctx, []*orchestrator.Confirmation{confirmation}))
+	// Drop the index, as on databases which saved confirmations before the index existed.
+	require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(pandoraHashIndicesBucket).Delete(confirmation.PandoraHash[:])
+	}))
+	c, err := db.PandoraConfirmationByHash(ctx, confirmation.PandoraHash)
+	require.NoError(t, err)
+	assert.Equal(t, (*orchestrator.Confirmation)(nil), c)
+
+	require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+		return migratePandoraHashIndex(ctx, tx)
+	}))
+	c, err = db.PandoraConfirmationByHash(ctx, confirmation.PandoraHash)
+	require.NoError(t, err)
+	assert.DeepEqual(t, confirmation, c)
+}
@@ -0,0 +1,105 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// PandoraConfirmation retrieves the orchestrator confirmation for the given block root.
+// It returns nil if the orchestrator has not reported on the block yet.
+func (s *Store) PandoraConfirmation(ctx context.Context, blockRoot [32]byte) (*orchestrator.Confirmation, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PandoraConfirmation")
+	defer span.End()
+
+	var confirmation *orchestrator.Confirmation
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(pandoraConfirmationsBucket).Get(blockRoot[:])
+		if len(enc) == 0 {
+			return nil
+		}
+		confirmation = &orchestrator.Confirmation{}
+		return confirmation.UnmarshalBinary(enc)
+	})
+	traceutil.AnnotateError(span, err)
+	return confirmation, err
+}
+
+// PandoraConfirmationByHash retrieves the orchestrator confirmation of the block paired with the
+// given Pandora block hash, which holds the root of the block. It returns nil if no confirmation
+// with the hash was saved.
+func (s *Store) PandoraConfirmationByHash(ctx context.Context, pandoraHash [32]byte) (*orchestrator.Confirmation, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PandoraConfirmationByHash")
+	defer span.End()
+
+	var confirmation *orchestrator.Confirmation
+	err := s.db.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket(pandoraHashIndicesBucket).Get(pandoraHash[:])
+		if len(root) == 0 {
+			return nil
+		}
+		enc := tx.Bucket(pandoraConfirmationsBucket).Get(root)
+		if len(enc) == 0 {
+			return nil
+		}
+		confirmation = &orchestrator.Confirmation{}
+		return confirmation.UnmarshalBinary(enc)
+	})
+	traceutil.AnnotateError(span, err)
+	return confirmation, err
+}
+
+// SavePandoraConfirmations saves the orchestrator confirmations keyed by block root, and indexes
+// the block roots by Pandora block hash. A later confirmation for the same block root overrides
+// the earlier one.
+func (s *Store) SavePandoraConfirmations(ctx context.Context, confirmations []*orchestrator.Confirmation) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SavePandoraConfirmations")
+	defer span.End()
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(pandoraConfirmationsBucket)
+		for _, c := range confirmations {
+			if c == nil {
+				return errors.New("cannot save nil confirmation")
+			}
+			if err := unindexPandoraHash(tx, bkt.Get(c.BlockRoot[:])); err != nil {
+				return err
+			}
+			enc, err := c.MarshalBinary()
+			if err != nil {
+				return err
+			}
+			if err := bkt.Put(c.BlockRoot[:], enc); err != nil {
+				return err
+			}
+			if err := tx.Bucket(pandoraHashIndicesBucket).Put(c.PandoraHash[:], c.BlockRoot[:]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	traceutil.AnnotateError(span, err)
+	return err
+}
+
+// unindexPandoraHash removes the Pandora block hash of an encoded confirmation from the index, if
+// the index still points at its block root.
+func unindexPandoraHash(tx *bolt.Tx, enc []byte) error {
+	if len(enc) == 0 {
+		return nil
+	}
+	prev := &orchestrator.Confirmation{}
+	if err := prev.UnmarshalBinary(enc); err != nil {
+		return err
+	}
+	idx := tx.Bucket(pandoraHashIndicesBucket)
+	if root := idx.Get(prev.PandoraHash[:]); bytes.Equal(root, prev.BlockRoot[:]) {
+		return idx.Delete(prev.PandoraHash[:])
+	}
+	return nil
+}
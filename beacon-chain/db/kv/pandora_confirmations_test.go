@@ -0,0 +1,69 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_PandoraConfirmations_CRUD(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	root := [32]byte{'A'}
+	c, err := db.PandoraConfirmation(ctx, root)
+	require.NoError(t, err)
+	assert.Equal(t, (*orchestrator.Confirmation)(nil), c)
+
+	confirmations := []*orchestrator.Confirmation{
+		{Slot: 1, BlockRoot: root, PandoraHash: [32]byte{'a'}, Status: orchestrator.Verified},
+		{Slot: 2, BlockRoot: [32]byte{'B'}, PandoraHash: [32]byte{'b'}, Status: orchestrator.Invalid},
+	}
+	require.NoError(t, db.SavePandoraConfirmations(ctx, confirmations))
+	for _, want := range confirmations {
+		got, err := db.PandoraConfirmation(ctx, want.BlockRoot)
+		require.NoError(t, err)
+		assert.DeepEqual(t, want, got)
+	}
+
+	// A later report overrides the earlier one.
+	updated := &orchestrator.Confirmation{Slot: 1, BlockRoot: root, PandoraHash: [32]byte{'a'}, Status: orchestrator.Invalid}
+	require.NoError(t, db.SavePandoraConfirmations(ctx, []*orchestrator.Confirmation{updated}))
+	got, err := db.PandoraConfirmation(ctx, root)
+	require.NoError(t, err)
+	assert.Equal(t, orchestrator.Invalid, got.Status)
+}
+
+func TestStore_SavePandoraConfirmations_Nil(t *testing.T) {
+	db := setupDB(t)
+	err := db.SavePandoraConfirmations(context.Background(), []*orchestrator.Confirmation{nil})
+	assert.ErrorContains(t, "cannot save nil confirmation", err)
+}
+
+func TestStore_PandoraConfirmationByHash(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	c, err := db.PandoraConfirmationByHash(ctx, [32]byte{'a'})
+	require.NoError(t, err)
+	assert.Equal(t, (*orchestrator.Confirmation)(nil), c)
+
+	first := &orchestrator.Confirmation{Slot: 1, BlockRoot: [32]byte{'A'}, PandoraHash: [32]byte{'a'}, Status: orchestrator.Pending}
+	require.NoError(t, db.SavePandoraConfirmations(ctx, []*orchestrator.Confirmation{first}))
+	c, err = db.PandoraConfirmationByHash(ctx, [32]byte{'a'})
+	require.NoError(t, err)
+	assert.DeepEqual(t, first, c)
+
+	// Pairing the block with another Pandora block moves the index entry.
+	repaired := &orchestrator.Confirmation{Slot: 1, BlockRoot: [32]byte{'A'}, PandoraHash: [32]byte{'b'}, Status: orchestrator.Verified}
+	require.NoError(t, db.SavePandoraConfirmations(ctx, []*orchestrator.Confirmation{repaired}))
+	c, err = db.PandoraConfirmationByHash(ctx, [32]byte{'a'})
+	require.NoError(t, err)
+	assert.Equal(t, (*orchestrator.Confirmation)(nil), c)
+	c, err = db.PandoraConfirmationByHash(ctx, [32]byte{'b'})
+	require.NoError(t, err)
+	assert.DeepEqual(t, repaired, c)
+}
@@ -0,0 +1,61 @@
+package kv
+
+import (
+	"context"
+	"errors"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/reorg"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// Reorgs retrieves up to limit reorg events with a new head in or after the given epoch, in
+// ascending new head slot order.
+func (s *Store) Reorgs(ctx context.Context, fromEpoch types.Epoch, limit int) ([]*reorg.Event, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.Reorgs")
+	defer span.End()
+
+	startSlot, err := helpers.StartSlot(fromEpoch)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]*reorg.Event, 0)
+	err = s.db.View(func(tx *bolt.Tx) error {
+		// Keys start with the big endian slot of the new head, so they are sorted by slot.
+		c := tx.Bucket(reorgsBucket).Cursor()
+		for k, v := c.Seek(bytesutil.SlotToBytesBigEndian(startSlot)); k != nil && len(events) < limit; k, v = c.Next() {
+			e := &reorg.Event{}
+			if err := e.UnmarshalSSZ(v); err != nil {
+				return err
+			}
+			events = append(events, e)
+		}
+		return nil
+	})
+	traceutil.AnnotateError(span, err)
+	return events, err
+}
+
+// SaveReorg archives the reorg event keyed by the slot and root of its new head.
+func (s *Store) SaveReorg(ctx context.Context, event *reorg.Event) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveReorg")
+	defer span.End()
+
+	if event == nil {
+		return errors.New("cannot save nil reorg event")
+	}
+	enc, err := event.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	key := append(bytesutil.SlotToBytesBigEndian(event.NewHeadSlot), event.NewHeadRoot[:]...)
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(reorgsBucket).Put(key, enc)
+	})
+	traceutil.AnnotateError(span, err)
+	return err
+}
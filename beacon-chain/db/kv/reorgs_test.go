@@ -0,0 +1,43 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/reorg"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_Reorgs(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	events := []*reorg.Event{
+		reorg.New([32]byte{'a'}, 3, [32]byte{'b'}, 3, 1, 2),
+		reorg.New([32]byte{'c'}, slotsPerEpoch+2, [32]byte{'d'}, slotsPerEpoch+2, slotsPerEpoch, 2),
+		reorg.New([32]byte{'d'}, slotsPerEpoch+2, [32]byte{'e'}, slotsPerEpoch+2, slotsPerEpoch+1, 1),
+		reorg.New([32]byte{'f'}, 3*slotsPerEpoch, [32]byte{'g'}, 3*slotsPerEpoch+1, 3*slotsPerEpoch-1, 1),
+	}
+	// Save out of order, the events are returned by slot.
+	for i := len(events) - 1; i >= 0; i-- {
+		require.NoError(t, db.SaveReorg(ctx, events[i]))
+	}
+
+	res, err := db.Reorgs(ctx, 0, 100)
+	require.NoError(t, err)
+	assert.DeepEqual(t, events, res)
+	res, err = db.Reorgs(ctx, 1, 100)
+	require.NoError(t, err)
+	assert.DeepEqual(t, events[1:], res)
+	res, err = db.Reorgs(ctx, 1, 2)
+	require.NoError(t, err)
+	assert.DeepEqual(t, events[1:3], res)
+	res, err = db.Reorgs(ctx, 4, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res))
+
+	assert.ErrorContains(t, "cannot save nil reorg event", db.SaveReorg(ctx, nil))
+}
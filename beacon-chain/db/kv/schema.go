@@ -52,4 +52,36 @@ var (
 
 	// Migrations
 	migrationsBucket = []byte("migrations")
+
+	// Orchestrator confirmations of the Pandora blocks paired with Vanguard blocks.
+	pandoraConfirmationsBucket = []byte("pandora-confirmations")
+	// Block roots of the orchestrator confirmations, keyed by Pandora block hash.
+	pandoraHashIndicesBucket = []byte("pandora-hash-indices")
+
+	// Epoch infos replayed to resuming epoch info streams.
+	epochInfosBucket = []byte("epoch-infos")
+	// Epoch info accumulator roots, keyed by epoch.
+	epochInfoAccumulatorsBucket = []byte("epoch-info-accumulators")
+
+	// Validator balances archived at finalization, keyed by epoch.
+	validatorBalancesBucket = []byte("validator-balances")
+
+	// Epoch summaries archived at finalization, keyed by epoch.
+	epochSummariesBucket = []byte("epoch-summaries")
+
+	// Reorg events, keyed by the slot and root of the new head.
+	reorgsBucket = []byte("reorgs")
+
+	// Validator entries of saved states, keyed by the hash of their encoding, the number of saved
+	// states referencing each entry, and the keys of the validator entries of each saved state,
+	// keyed by block root.
+	stateValidatorsBucket    = []byte("state-validators")
+	stateValidatorRefsBucket = []byte("state-validator-refs")
+	stateValidatorKeysBucket = []byte("state-validator-keys")
+
+	// Public keys of the validators the node operator is interested in.
+	trackedValidatorsBucket = []byte("tracked-validators")
+
+	// Last epoch acknowledged by each named epoch info stream subscriber.
+	streamCursorsBucket = []byte("stream-cursors")
 )
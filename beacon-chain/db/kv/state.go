@@ -13,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	bolt "go.etcd.io/bbolt"
@@ -25,19 +26,25 @@ func (s *Store) State(ctx context.Context, blockRoot [32]byte) (iface.BeaconStat
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.State")
 	defer span.End()
 	var st *pb.BeaconState
-	enc, err := s.stateBytes(ctx, blockRoot)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(stateBucket).Get(blockRoot[:])
+		if len(enc) == 0 {
+			return nil
+		}
+
+		var err error
+		st, err = createState(ctx, enc)
+		if err != nil {
+			return err
+		}
+		return hydrateStateValidators(ctx, tx, blockRoot[:], st)
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	if len(enc) == 0 {
+	if st == nil {
 		return nil, nil
 	}
-
-	st, err = createState(ctx, enc)
-	if err != nil {
-		return nil, err
-	}
 	return stateV0.InitializeFromProtoUnsafe(st)
 }
 
@@ -71,7 +78,10 @@ func (s *Store) GenesisState(ctx context.Context) (iface.BeaconState, error) {
 
 		var err error
 		st, err = createState(ctx, enc)
-		return err
+		if err != nil {
+			return err
+		}
+		return hydrateStateValidators(ctx, tx, genesisBlockRoot, st)
 	})
 	if err != nil {
 		return nil, err
@@ -97,12 +107,26 @@ func (s *Store) SaveStates(ctx context.Context, states []iface.ReadOnlyBeaconSta
 	if states == nil {
 		return errors.New("nil state")
 	}
+	dedup := featureconfig.Get().EnableStateValidatorDedup
 	multipleEncs := make([][]byte, len(states))
+	validatorKeys := make([][][]byte, len(states))
+	validatorEncs := make([][][]byte, len(states))
 	for i, st := range states {
 		pbState, err := stateV0.ProtobufBeaconState(st.InnerStateUnsafe())
 		if err != nil {
 			return err
 		}
+		if dedup {
+			validatorKeys[i], validatorEncs[i], err = encodeStateValidators(ctx, pbState.Validators)
+			if err != nil {
+				return err
+			}
+			// The validators are stored apart from the state, copy the state so that
+			// the inner state is left untouched.
+			stripped := *pbState
+			stripped.Validators = nil
+			pbState = &stripped
+		}
 		multipleEncs[i], err = encode(ctx, pbState)
 		if err != nil {
 			return err
@@ -116,6 +140,13 @@ func (s *Store) SaveStates(ctx context.Context, states []iface.ReadOnlyBeaconSta
 			if err := updateValueForIndices(ctx, indicesByBucket, rt[:], tx); err != nil {
 				return errors.Wrap(err, "could not update DB indices")
 			}
+			if dedup {
+				if err := saveStateValidators(tx, rt[:], validatorKeys[i], validatorEncs[i]); err != nil {
+					return errors.Wrap(err, "could not save state validators")
+				}
+			} else if err := releaseStateValidators(tx, rt[:]); err != nil {
+				return errors.Wrap(err, "could not release state validators")
+			}
 			if err := bucket.Put(rt[:], multipleEncs[i]); err != nil {
 				return err
 			}
@@ -169,6 +200,9 @@ func (s *Store) DeleteState(ctx context.Context, blockRoot [32]byte) error {
 		if err := deleteValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
 			return errors.Wrap(err, "could not delete root for DB indices")
 		}
+		if err := releaseStateValidators(tx, blockRoot[:]); err != nil {
+			return errors.Wrap(err, "could not release state validators")
+		}
 
 		return bkt.Delete(blockRoot[:])
 	})
@@ -9,10 +9,12 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
 	"gopkg.in/d4l3k/messagediff.v1"
 )
 
@@ -340,3 +342,105 @@ func TestStore_CleanUpDirtyStates_DontDeleteNonFinalized(t *testing.T) {
 		require.Equal(t, true, db.HasState(context.Background(), rt))
 	}
 }
+
+func TestStore_SaveStates_DedupValidators(t *testing.T) {
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{EnableStateValidatorDedup: true})
+	defer resetCfg()
+	db := setupDB(t)
+	ctx := context.Background()
+
+	st1, _ := testutil.DeterministicGenesisState(t, 64)
+	st2 := st1.Copy()
+	require.NoError(t, st2.SetSlot(1))
+	pubKey := st1.PubkeyAtIndex(3)
+	require.NoError(t, st2.UpdateValidatorAtIndex(3, &ethpb.Validator{
+		PublicKey:             pubKey[:],
+		WithdrawalCredentials: make([]byte, 32),
+		EffectiveBalance:      1,
+	}))
+	r1, r2 := [32]byte{'A'}, [32]byte{'B'}
+	require.NoError(t, db.SaveStates(ctx, []iface.ReadOnlyBeaconState{st1, st2}, [][32]byte{r1, r2}))
+	assert.Equal(t, 64, len(st1.Validators()), "Saving should leave the state untouched")
+
+	for r, st := range map[[32]byte]iface.BeaconState{r1: st1, r2: st2} {
+		saved, err := db.State(ctx, r)
+		require.NoError(t, err)
+		assert.DeepSSZEqual(t, st.InnerStateUnsafe(), saved.InnerStateUnsafe())
+	}
+	// Both states share all of their validator entries but one.
+	assert.Equal(t, 65, countStateValidators(t, db))
+
+	// Deleting a state deletes the entries only it references.
+	require.NoError(t, db.DeleteState(ctx, r2))
+	assert.Equal(t, 64, countStateValidators(t, db))
+	saved, err := db.State(ctx, r1)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, st1.InnerStateUnsafe(), saved.InnerStateUnsafe())
+}
+
+func TestStore_SaveStates_DedupValidatorsReleasedOnSave(t *testing.T) {
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{EnableStateValidatorDedup: true})
+	defer resetCfg()
+	db := setupDB(t)
+	ctx := context.Background()
+
+	st1, _ := testutil.DeterministicGenesisState(t, 16)
+	st2 := st1.Copy()
+	pubKey := st1.PubkeyAtIndex(3)
+	require.NoError(t, st2.UpdateValidatorAtIndex(3, &ethpb.Validator{
+		PublicKey:             pubKey[:],
+		WithdrawalCredentials: make([]byte, 32),
+		EffectiveBalance:      1,
+	}))
+	r := [32]byte{'A'}
+	require.NoError(t, db.SaveState(ctx, st1, r))
+	// Saving the state again only references its entries once.
+	require.NoError(t, db.SaveState(ctx, st1, r))
+	require.NoError(t, db.SaveState(ctx, st2, r))
+	assert.Equal(t, 16, countStateValidators(t, db))
+	saved, err := db.State(ctx, r)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, st2.InnerStateUnsafe(), saved.InnerStateUnsafe())
+
+	// Saving the state with its validators inline releases all of its entries.
+	defer featureconfig.InitWithReset(&featureconfig.Flags{})()
+	require.NoError(t, db.SaveState(ctx, st2, r))
+	assert.Equal(t, 0, countStateValidators(t, db))
+	saved, err = db.State(ctx, r)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, st2.InnerStateUnsafe(), saved.InnerStateUnsafe())
+}
+
+func TestStore_State_InlineValidatorsWithDedup(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	st, _ := testutil.DeterministicGenesisState(t, 16)
+	r := [32]byte{'A'}
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, r))
+	require.NoError(t, db.SaveState(ctx, st, r))
+
+	// States saved before enabling the deduplication keep their validators inline.
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{EnableStateValidatorDedup: true})
+	defer resetCfg()
+	saved, err := db.GenesisState(ctx)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, st.InnerStateUnsafe(), saved.InnerStateUnsafe())
+	assert.Equal(t, 0, countStateValidators(t, db))
+
+	// Saving the state again moves its validators out of the state.
+	require.NoError(t, db.SaveState(ctx, st, r))
+	assert.Equal(t, 16, countStateValidators(t, db))
+	saved, err = db.GenesisState(ctx)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, st.InnerStateUnsafe(), saved.InnerStateUnsafe())
+}
+
+func countStateValidators(t *testing.T, db *Store) int {
+	count := 0
+	require.NoError(t, db.db.View(func(tx *bolt.Tx) error {
+		count = tx.Bucket(stateValidatorsBucket).Stats().KeyN
+		return nil
+	}))
+	return count
+}
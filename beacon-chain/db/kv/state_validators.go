@@ -0,0 +1,131 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// Validator entries rarely change between two saved states, so with the state validator
+// deduplication feature enabled they are stored once in the state validators bucket, keyed by
+// the hash of their encoding. Each saved state then only references its validator entries by
+// key, in validator index order, in the state validator keys bucket. The number of references to
+// each entry is kept in the state validator refs bucket, and an entry is deleted as soon as the
+// last state referencing it is deleted or saved again.
+
+// StateValidatorKeyLength is the length of the key a deduplicated saved state references each of
+// its validator entries by.
+const StateValidatorKeyLength = 32
+
+// encodeStateValidators returns the keys and encodings of the validator entries of a state.
+func encodeStateValidators(ctx context.Context, validators []*ethpb.Validator) ([][]byte, [][]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.encodeStateValidators")
+	defer span.End()
+
+	keys := make([][]byte, len(validators))
+	encs := make([][]byte, len(validators))
+	for i, v := range validators {
+		enc, err := v.MarshalSSZ()
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not encode validator %d", i)
+		}
+		key := hashutil.Hash(enc)
+		keys[i] = key[:]
+		encs[i] = enc
+	}
+	return keys, encs, nil
+}
+
+// saveStateValidators stores the validator entries which are not stored yet, and the keys of
+// the validator entries of the state saved with the block root. The entries referenced by a
+// previous save of the state are released.
+func saveStateValidators(tx *bolt.Tx, blockRoot []byte, keys, encs [][]byte) error {
+	if err := releaseStateValidators(tx, blockRoot); err != nil {
+		return err
+	}
+	bkt := tx.Bucket(stateValidatorsBucket)
+	refs := tx.Bucket(stateValidatorRefsBucket)
+	for i, key := range keys {
+		count := uint64(0)
+		if enc := refs.Get(key); enc != nil {
+			count = bytesutil.BytesToUint64BigEndian(enc)
+		} else if err := bkt.Put(key, encs[i]); err != nil {
+			return err
+		}
+		if err := refs.Put(key, bytesutil.Uint64ToBytesBigEndian(count+1)); err != nil {
+			return err
+		}
+	}
+	return tx.Bucket(stateValidatorKeysBucket).Put(blockRoot, bytes.Join(keys, nil))
+}
+
+// releaseStateValidators drops the references of the state saved with the block root to its
+// validator entries, and deletes the entries no saved state references anymore. States saved
+// with their validators inline reference no entries.
+func releaseStateValidators(tx *bolt.Tx, blockRoot []byte) error {
+	keysBkt := tx.Bucket(stateValidatorKeysBucket)
+	keys := keysBkt.Get(blockRoot)
+	if keys == nil {
+		return nil
+	}
+	bkt := tx.Bucket(stateValidatorsBucket)
+	refs := tx.Bucket(stateValidatorRefsBucket)
+	for i := 0; i+StateValidatorKeyLength <= len(keys); i += StateValidatorKeyLength {
+		key := keys[i : i+StateValidatorKeyLength]
+		enc := refs.Get(key)
+		if enc == nil {
+			return errors.Errorf("missing reference count of validator %d of state %#x", i/StateValidatorKeyLength, blockRoot)
+		}
+		if count := bytesutil.BytesToUint64BigEndian(enc); count > 1 {
+			if err := refs.Put(key, bytesutil.Uint64ToBytesBigEndian(count-1)); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := refs.Delete(key); err != nil {
+			return err
+		}
+		if err := bkt.Delete(key); err != nil {
+			return err
+		}
+	}
+	return keysBkt.Delete(blockRoot)
+}
+
+// hydrateStateValidators sets the validator entries of a state saved with the block root, if
+// the state was saved without them. States saved with their validators inline are left as is.
+func hydrateStateValidators(ctx context.Context, tx *bolt.Tx, blockRoot []byte, st *pb.BeaconState) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.hydrateStateValidators")
+	defer span.End()
+
+	keys := tx.Bucket(stateValidatorKeysBucket).Get(blockRoot)
+	if keys == nil {
+		return nil
+	}
+	if len(keys)%StateValidatorKeyLength != 0 {
+		return errors.Errorf("invalid validator keys length %d for state %#x", len(keys), blockRoot)
+	}
+	bkt := tx.Bucket(stateValidatorsBucket)
+	validators := make([]*ethpb.Validator, len(keys)/StateValidatorKeyLength)
+	for i := range validators {
+		key := keys[i*StateValidatorKeyLength : (i+1)*StateValidatorKeyLength]
+		enc := bkt.Get(key)
+		if enc == nil {
+			return errors.Errorf("missing validator %d of state %#x", i, blockRoot)
+		}
+		v := &ethpb.Validator{}
+		if err := v.UnmarshalSSZ(enc); err != nil {
+			return errors.Wrapf(err, "could not decode validator %d of state %#x", i, blockRoot)
+		}
+		validators[i] = v
+	}
+	st.Validators = validators
+	return nil
+}
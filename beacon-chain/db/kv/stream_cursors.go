@@ -0,0 +1,52 @@
+package kv
+
+import (
+	"context"
+	"errors"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// StreamCursor retrieves the last epoch the named subscriber acknowledged. The returned bool is
+// false if the subscriber never acknowledged an epoch.
+func (s *Store) StreamCursor(ctx context.Context, subscriber string) (types.Epoch, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.StreamCursor")
+	defer span.End()
+
+	var epoch types.Epoch
+	found := false
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(streamCursorsBucket).Get([]byte(subscriber))
+		if len(enc) == 0 {
+			return nil
+		}
+		epoch, found = bytesutil.BytesToEpochBigEndian(enc), true
+		return nil
+	})
+	traceutil.AnnotateError(span, err)
+	return epoch, found, err
+}
+
+// SaveStreamCursor saves the last epoch the named subscriber acknowledged. Cursors never move
+// backwards, so an acknowledgement of an epoch before the saved one is ignored.
+func (s *Store) SaveStreamCursor(ctx context.Context, subscriber string, epoch types.Epoch) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveStreamCursor")
+	defer span.End()
+
+	if subscriber == "" {
+		return errors.New("cannot save stream cursor of unnamed subscriber")
+	}
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(streamCursorsBucket)
+		if enc := bkt.Get([]byte(subscriber)); len(enc) > 0 && bytesutil.BytesToEpochBigEndian(enc) >= epoch {
+			return nil
+		}
+		return bkt.Put([]byte(subscriber), bytesutil.EpochToBytesBigEndian(epoch))
+	})
+	traceutil.AnnotateError(span, err)
+	return err
+}
@@ -0,0 +1,45 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_StreamCursor(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	_, ok, err := db.StreamCursor(ctx, "orchestrator")
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+
+	require.NoError(t, db.SaveStreamCursor(ctx, "orchestrator", 5))
+	epoch, ok, err := db.StreamCursor(ctx, "orchestrator")
+	require.NoError(t, err)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, types.Epoch(5), epoch)
+
+	// Cursors never move backwards.
+	require.NoError(t, db.SaveStreamCursor(ctx, "orchestrator", 3))
+	epoch, _, err = db.StreamCursor(ctx, "orchestrator")
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(5), epoch)
+
+	require.NoError(t, db.SaveStreamCursor(ctx, "orchestrator", 8))
+	epoch, _, err = db.StreamCursor(ctx, "orchestrator")
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(8), epoch)
+
+	// Cursors are kept per subscriber.
+	require.NoError(t, db.SaveStreamCursor(ctx, "backup", 0))
+	epoch, ok, err = db.StreamCursor(ctx, "backup")
+	require.NoError(t, err)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, types.Epoch(0), epoch)
+
+	assert.ErrorContains(t, "unnamed subscriber", db.SaveStreamCursor(ctx, "", 1))
+}
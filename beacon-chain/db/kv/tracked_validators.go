@@ -0,0 +1,51 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// TrackedValidatorKeys retrieves the public keys of the tracked validators, in ascending order.
+func (s *Store) TrackedValidatorKeys(ctx context.Context) ([][48]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.TrackedValidatorKeys")
+	defer span.End()
+
+	keys := make([][48]byte, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(trackedValidatorsBucket).ForEach(func(k, _ []byte) error {
+			keys = append(keys, bytesutil.ToBytes48(k))
+			return nil
+		})
+	})
+	traceutil.AnnotateError(span, err)
+	return keys, err
+}
+
+// SaveTrackedValidatorKeys replaces the public keys of the tracked validators.
+func (s *Store) SaveTrackedValidatorKeys(ctx context.Context, keys [][48]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveTrackedValidatorKeys")
+	defer span.End()
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket(trackedValidatorsBucket); err != nil {
+			return err
+		}
+		bkt, err := tx.CreateBucket(trackedValidatorsBucket)
+		if err != nil {
+			return err
+		}
+		for _, k := range keys {
+			key := k
+			if err := bkt.Put(key[:], []byte{}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	traceutil.AnnotateError(span, err)
+	return err
+}
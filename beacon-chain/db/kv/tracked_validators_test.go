@@ -0,0 +1,35 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_TrackedValidatorKeys(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	keys, err := db.TrackedValidatorKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(keys))
+
+	// Keys are returned in ascending order.
+	require.NoError(t, db.SaveTrackedValidatorKeys(ctx, [][48]byte{{'c'}, {'a'}, {'b'}}))
+	keys, err = db.TrackedValidatorKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][48]byte{{'a'}, {'b'}, {'c'}}, keys)
+
+	// Saving replaces the previous keys.
+	require.NoError(t, db.SaveTrackedValidatorKeys(ctx, [][48]byte{{'d'}}))
+	keys, err = db.TrackedValidatorKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][48]byte{{'d'}}, keys)
+
+	require.NoError(t, db.SaveTrackedValidatorKeys(ctx, nil))
+	keys, err = db.TrackedValidatorKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(keys))
+}
@@ -0,0 +1,74 @@
+package kv
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// ValidatorBalances retrieves the validator balances archived for the given epoch, indexed by
+// validator index. It returns nil if no balances were archived for the epoch.
+func (s *Store) ValidatorBalances(ctx context.Context, epoch types.Epoch) ([]uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ValidatorBalances")
+	defer span.End()
+
+	var balances []uint64
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(validatorBalancesBucket).Get(bytesutil.EpochToBytesBigEndian(epoch))
+		if len(enc) == 0 {
+			return nil
+		}
+		var err error
+		balances, err = decodeBalances(enc)
+		return err
+	})
+	traceutil.AnnotateError(span, err)
+	return balances, err
+}
+
+// SaveValidatorBalances archives the validator balances of the given epoch, overriding any
+// earlier ones.
+func (s *Store) SaveValidatorBalances(ctx context.Context, epoch types.Epoch, balances []uint64) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveValidatorBalances")
+	defer span.End()
+
+	enc := encodeBalances(balances)
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(validatorBalancesBucket).Put(bytesutil.EpochToBytesBigEndian(epoch), enc)
+	})
+	traceutil.AnnotateError(span, err)
+	return err
+}
+
+// encodeBalances packs the balances as consecutive little endian uint64s and snappy compresses
+// them. Balances of neighbouring validators share most of their bytes, so the encoding shrinks
+// to a fraction of the registry size.
+func encodeBalances(balances []uint64) []byte {
+	buf := make([]byte, 8*len(balances))
+	for i, b := range balances {
+		binary.LittleEndian.PutUint64(buf[8*i:], b)
+	}
+	return snappy.Encode(nil, buf)
+}
+
+func decodeBalances(enc []byte) ([]uint64, error) {
+	buf, err := snappy.Decode(nil, enc)
+	if err != nil {
+		return nil, err
+	}
+	if len(buf)%8 != 0 {
+		return nil, errors.Errorf("invalid balances encoding length %d", len(buf))
+	}
+	balances := make([]uint64, len(buf)/8)
+	for i := range balances {
+		balances[i] = binary.LittleEndian.Uint64(buf[8*i:])
+	}
+	return balances, nil
+}
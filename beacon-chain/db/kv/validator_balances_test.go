@@ -0,0 +1,30 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_ValidatorBalances_CRUD(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	balances, err := db.ValidatorBalances(ctx, 5)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(balances))
+
+	want := []uint64{params.BeaconConfig().MaxEffectiveBalance, 31999123456, 0, 1}
+	require.NoError(t, db.SaveValidatorBalances(ctx, 5, want))
+	balances, err = db.ValidatorBalances(ctx, 5)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, balances)
+
+	// Other epochs are unaffected.
+	balances, err = db.ValidatorBalances(ctx, 6)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(balances))
+}
@@ -0,0 +1,79 @@
+// Package migrations versions the schema of the beacon node database. Forward migrations are
+// applied in order of their version at startup, each in its own transaction together with the
+// record of its version, so an interrupted upgrade resumes at the first migration not applied.
+package migrations
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+)
+
+var log = logrus.WithField("prefix", "migrations")
+
+// schemaVersionKey holds the version of the last applied migration.
+var schemaVersionKey = []byte("schema-version")
+
+// Migration is a forward migration of the database schema.
+type Migration struct {
+	// Version of the schema after the migration. Versions start at 1 and increase by one with
+	// every migration.
+	Version uint64
+	Name    string
+	Migrate func(context.Context, *bolt.Tx) error
+}
+
+// SchemaVersion returns the schema version recorded in the bucket, which is 0 when no
+// migration was applied yet.
+func SchemaVersion(tx *bolt.Tx, bucket []byte) uint64 {
+	enc := tx.Bucket(bucket).Get(schemaVersionKey)
+	if len(enc) == 0 {
+		return 0
+	}
+	return bytesutil.BytesToUint64BigEndian(enc)
+}
+
+// Run applies the migrations with a version above the schema version recorded in the bucket,
+// which must exist. It refuses to run against a database whose schema is newer than the latest
+// migration, as it was written by a newer node.
+func Run(ctx context.Context, db *bolt.DB, bucket []byte, migrations []Migration) error {
+	for i, m := range migrations {
+		if m.Version != uint64(i+1) {
+			return errors.Errorf("migration %q has version %d, expected %d", m.Name, m.Version, i+1)
+		}
+	}
+	latest := uint64(len(migrations))
+
+	var current uint64
+	if err := db.View(func(tx *bolt.Tx) error {
+		current = SchemaVersion(tx, bucket)
+		return nil
+	}); err != nil {
+		return err
+	}
+	if current > latest {
+		return errors.Errorf("database schema version %d is newer than the latest known version %d", current, latest)
+	}
+
+	for _, m := range migrations[current:] {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := db.Update(func(tx *bolt.Tx) error {
+			if err := m.Migrate(ctx, tx); err != nil {
+				return err
+			}
+			return tx.Bucket(bucket).Put(schemaVersionKey, bytesutil.Uint64ToBytesBigEndian(m.Version))
+		}); err != nil {
+			return errors.Wrapf(err, "could not apply migration %q", m.Name)
+		}
+		log.WithFields(logrus.Fields{
+			"version": m.Version,
+			"name":    m.Name,
+		}).Info("Applied database migration")
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+var testBucket = []byte("migrations")
+
+func setupDB(t *testing.T) *bolt.DB {
+	db, err := bolt.Open(filepath.Join(t.TempDir(), "test.db"), 0600, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+	require.NoError(t, db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(testBucket)
+		return err
+	}))
+	return db
+}
+
+func schemaVersion(t *testing.T, db *bolt.DB) uint64 {
+	var v uint64
+	require.NoError(t, db.View(func(tx *bolt.Tx) error {
+		v = SchemaVersion(tx, testBucket)
+		return nil
+	}))
+	return v
+}
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	var applied []uint64
+	failing := true
+	migration := func(version uint64) Migration {
+		return Migration{Version: version, Name: "test", Migrate: func(_ context.Context, tx *bolt.Tx) error {
+			if version == 2 && failing {
+				return errors.New("interrupted")
+			}
+			applied = append(applied, version)
+			return nil
+		}}
+	}
+	ms := []Migration{migration(1), migration(2), migration(3)}
+
+	assert.Equal(t, uint64(0), schemaVersion(t, db))
+	assert.ErrorContains(t, "interrupted", Run(ctx, db, testBucket, ms))
+	assert.Equal(t, uint64(1), schemaVersion(t, db))
+
+	// The upgrade resumes at the migration which failed.
+	failing = false
+	require.NoError(t, Run(ctx, db, testBucket, ms))
+	assert.Equal(t, uint64(3), schemaVersion(t, db))
+	assert.DeepEqual(t, []uint64{1, 2, 3}, applied)
+
+	require.NoError(t, Run(ctx, db, testBucket, ms))
+	assert.DeepEqual(t, []uint64{1, 2, 3}, applied)
+
+	// A database migrated by a newer node is refused.
+	assert.ErrorContains(t, "newer than the latest known version 2", Run(ctx, db, testBucket, ms[:2]))
+}
+
+func TestRun_InvalidVersions(t *testing.T) {
+	ms := []Migration{
+		{Version: 1, Name: "first", Migrate: func(context.Context, *bolt.Tx) error { return nil }},
+		{Version: 3, Name: "third", Migrate: func(context.Context, *bolt.Tx) error { return nil }},
+	}
+	assert.ErrorContains(t, "expected 2", Run(context.Background(), setupDB(t), testBucket, ms))
+}
@@ -13,6 +13,7 @@ type ForkChoicer interface {
 	BlockProcessor       // to track new block for fork choice.
 	AttestationProcessor // to track new attestation for fork choice.
 	Pruner               // to clean old data for fork choice.
+	InvalidMarker        // to exclude blocks found invalid after they were processed.
 	Getter               // to retrieve fork choice information.
 }
 
@@ -36,6 +37,12 @@ type Pruner interface {
 	Prune(context.Context, [32]byte) error
 }
 
+// InvalidMarker excludes a block found invalid after it was processed, along with its descendants,
+// from the head.
+type InvalidMarker interface {
+	MarkInvalid(context.Context, [32]byte) error
+}
+
 // Getter returns fork choice related information.
 type Getter interface {
 	Nodes() []*protoarray.Node
@@ -45,4 +52,5 @@ type Getter interface {
 	HasParent(root [32]byte) bool
 	AncestorRoot(ctx context.Context, root [32]byte, slot types.Slot) ([]byte, error)
 	IsCanonical(root [32]byte) bool
+	IsInvalid(root [32]byte) bool
 }
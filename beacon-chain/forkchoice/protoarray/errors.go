@@ -3,6 +3,7 @@ package protoarray
 import "errors"
 
 var errUnknownFinalizedRoot = errors.New("unknown finalized root")
+var errUnknownNodeRoot = errors.New("unknown node root")
 var errUnknownJustifiedRoot = errors.New("unknown justified root")
 var errInvalidNodeIndex = errors.New("node index is invalid")
 var errInvalidJustifiedIndex = errors.New("justified index is invalid")
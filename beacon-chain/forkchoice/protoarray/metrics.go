@@ -42,6 +42,12 @@ var (
 			Help: "The number of times an attestation is processed for fork choice.",
 		},
 	)
+	invalidatedNodeCount = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "proto_array_invalidated_node_count",
+			Help: "The number of blocks marked invalid after they were processed.",
+		},
+	)
 	prunedCount = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "proto_array_pruned_count",
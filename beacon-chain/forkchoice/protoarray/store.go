@@ -28,6 +28,7 @@ func New(justifiedEpoch, finalizedEpoch types.Epoch, finalizedRoot [32]byte) *Fo
 		nodes:          make([]*Node, 0),
 		nodesIndices:   make(map[[32]byte]uint64),
 		canonicalNodes: make(map[[32]byte]bool),
+		invalidNodes:   make(map[[32]byte]bool),
 		pruneThreshold: defaultPruneThreshold,
 	}
 
@@ -119,6 +120,21 @@ func (f *ForkChoice) Prune(ctx context.Context, finalizedRoot [32]byte) error {
 	return f.store.prune(ctx, finalizedRoot)
 }
 
+// MarkInvalid excludes the block with the input root and its descendants from head, as the block
+// was found invalid after it was processed. The head moves off the block on the next call of Head.
+func (f *ForkChoice) MarkInvalid(ctx context.Context, root [32]byte) error {
+	return f.store.markInvalid(ctx, root)
+}
+
+// IsInvalid returns true if the block with the input root was marked invalid, or descends from a
+// block marked invalid.
+func (f *ForkChoice) IsInvalid(root [32]byte) bool {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+
+	return f.store.invalidNodes[root]
+}
+
 // Nodes returns the copied list of block nodes in the fork choice store.
 func (f *ForkChoice) Nodes() []*Node {
 	f.store.nodesLock.RLock()
@@ -361,6 +377,11 @@ func (s *Store) insert(ctx context.Context,
 	s.nodesIndices[root] = index
 	s.nodes = append(s.nodes, n)
 
+	// A block building on an invalid block is invalid as well.
+	if n.parent != NonExistentNode && s.invalidNodes[parent] {
+		s.invalidNodes[root] = true
+	}
+
 	// Update parent with the best child and descendent only if it's available.
 	if n.parent != NonExistentNode {
 		if err := s.updateBestChildAndDescendant(parentIndex, index); err != nil {
@@ -375,6 +396,41 @@ func (s *Store) insert(ctx context.Context,
 	return nil
 }
 
+// markInvalid marks the node with the input root and all its descendants invalid, so that they are
+// no longer viable for head.
+func (s *Store) markInvalid(ctx context.Context, root [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.markInvalid")
+	defer span.End()
+
+	s.nodesLock.Lock()
+	defer s.nodesLock.Unlock()
+
+	index, ok := s.nodesIndices[root]
+	if !ok {
+		return errUnknownNodeRoot
+	}
+	if s.invalidNodes == nil {
+		s.invalidNodes = make(map[[32]byte]bool)
+	}
+	s.invalidNodes[root] = true
+
+	// Nodes are inserted after their parents, so a single pass over the later nodes reaches every
+	// descendant.
+	for i := index + 1; i < uint64(len(s.nodes)); i++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		n := s.nodes[i]
+		if n.parent != NonExistentNode && s.invalidNodes[s.nodes[n.parent].root] {
+			s.invalidNodes[n.root] = true
+		}
+	}
+
+	invalidatedNodeCount.Inc()
+
+	return nil
+}
+
 // applyWeightChanges iterates backwards through the nodes in store. It checks all nodes parent
 // and its best child. For each node, it updates the weight with input delta and
 // back propagate the nodes delta to its parents delta. After scoring changes,
@@ -579,6 +635,7 @@ func (s *Store) prune(ctx context.Context, finalizedRoot [32]byte) error {
 			return errInvalidNodeIndex
 		}
 		delete(s.nodesIndices, s.nodes[i].root)
+		delete(s.invalidNodes, s.nodes[i].root)
 	}
 
 	// Finalized index can not be greater than the length of the node.
@@ -648,8 +705,11 @@ func (s *Store) leadsToViableHead(node *Node) (bool, error) {
 
 // viableForHead returns true if the node is viable to head.
 // Any node with diff finalized or justified epoch than the ones in fork choice store
-// should not be viable to head.
+// should not be viable to head, nor any node marked invalid.
 func (s *Store) viableForHead(node *Node) bool {
+	if s.invalidNodes[node.root] {
+		return false
+	}
 	// `node` is viable if its justified epoch and finalized epoch are the same as the one in `Store`.
 	// It's also viable if we are in genesis epoch.
 	justified := s.justifiedEpoch == node.justifiedEpoch || s.justifiedEpoch == 0
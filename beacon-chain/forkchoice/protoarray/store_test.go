@@ -6,6 +6,7 @@ import (
 
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
@@ -589,3 +590,39 @@ func TestStore_UpdateCanonicalNodes_ContextCancelled(t *testing.T) {
 	cancel()
 	require.ErrorContains(t, "context canceled", f.store.updateCanonicalNodes(ctx, [32]byte{'c'}))
 }
+
+func TestForkChoice_MarkInvalid(t *testing.T) {
+	ctx := context.Background()
+	balances := []uint64{10, 10, 10}
+	f := setup(1, 1)
+
+	//            0
+	//           / 	//          1   2
+	//          |
+	//  head -> 3 <- voted by validator 0
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(2), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 2, indexToHash(3), indexToHash(1), [32]byte{}, 1, 1))
+	f.ProcessAttestation(ctx, []uint64{0}, indexToHash(3), 2)
+	r, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(3), r)
+
+	// Marking block 1 invalid excludes block 3 as well, so the head moves to block 2.
+	require.NoError(t, f.MarkInvalid(ctx, indexToHash(1)))
+	assert.Equal(t, true, f.IsInvalid(indexToHash(3)))
+	assert.Equal(t, false, f.IsInvalid(indexToHash(2)))
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(2), r)
+
+	// A block building on an invalid block is never viable, whatever its weight.
+	require.NoError(t, f.ProcessBlock(ctx, 3, indexToHash(4), indexToHash(3), [32]byte{}, 1, 1))
+	assert.Equal(t, true, f.IsInvalid(indexToHash(4)))
+	f.ProcessAttestation(ctx, []uint64{1, 2}, indexToHash(4), 2)
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(2), r)
+
+	require.ErrorContains(t, errUnknownNodeRoot.Error(), f.MarkInvalid(ctx, indexToHash(5)))
+}
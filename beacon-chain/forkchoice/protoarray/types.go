@@ -23,6 +23,7 @@ type Store struct {
 	nodes          []*Node             // list of block nodes, each node is a representation of one block.
 	nodesIndices   map[[32]byte]uint64 // the root of block node and the nodes index in the list.
 	canonicalNodes map[[32]byte]bool   // the canonical block nodes.
+	invalidNodes   map[[32]byte]bool   // the block nodes excluded from head, along with their descendants.
 	nodesLock      sync.RWMutex
 }
 
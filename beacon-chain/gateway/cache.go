@@ -0,0 +1,111 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+)
+
+// immutableCacheControl is the Cache-Control header of responses the beacon node reports as
+// immutable. They never change, so reverse proxies may keep them for a year, the conventional
+// maximum.
+const immutableCacheControl = "public, max-age=31536000, immutable"
+
+// markImmutableResponse is a forward response option of the gateway mux setting the cache headers
+// of the responses the beacon node reports as immutable.
+func markImmutableResponse(ctx context.Context, w http.ResponseWriter, _ proto.Message) error {
+	md, ok := gwruntime.ServerMetadataFromContext(ctx)
+	if !ok || len(md.HeaderMD.Get(grpcutils.ImmutableResponseHeader)) == 0 {
+		return nil
+	}
+	w.Header().Set("Cache-Control", immutableCacheControl)
+	return nil
+}
+
+// newConditionalHandler serves conditional requests of immutable responses. Immutable responses
+// are tagged with the hash of their body, and requests whose If-None-Match header holds the tag
+// are answered without a body. Other responses, streams included, are passed through as they are
+// written.
+func newConditionalHandler(srv http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			srv.ServeHTTP(w, r)
+			return
+		}
+		cw := &conditionalResponseWriter{ResponseWriter: w}
+		srv.ServeHTTP(cw, r)
+		if !cw.buffering {
+			return
+		}
+		sum := sha256.Sum256(cw.body.Bytes())
+		etag := `"` + hex.EncodeToString(sum[:]) + `"`
+		w.Header().Set("ETag", etag)
+		if etagMatches(r.Header.Get("If-None-Match"), etag) {
+			w.Header().Del("Content-Type")
+			w.Header().Del("Content-Length")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.WriteHeader(cw.status)
+		if _, err := w.Write(cw.body.Bytes()); err != nil {
+			log.WithError(err).Debug("Could not write response")
+		}
+	})
+}
+
+// conditionalResponseWriter buffers successful immutable responses, whose headers are known once
+// the response is first written, and writes any other response through.
+type conditionalResponseWriter struct {
+	http.ResponseWriter
+	status    int
+	written   bool
+	buffering bool
+	body      bytes.Buffer
+}
+
+// WriteHeader decides whether the response is buffered.
+func (w *conditionalResponseWriter) WriteHeader(status int) {
+	if w.written {
+		return
+	}
+	w.written = true
+	w.status = status
+	w.buffering = status == http.StatusOK && w.Header().Get("Cache-Control") == immutableCacheControl
+	if !w.buffering {
+		w.ResponseWriter.WriteHeader(status)
+	}
+}
+
+// Write buffers the body of immutable responses.
+func (w *conditionalResponseWriter) Write(b []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	if w.buffering {
+		return w.body.Write(b)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush flushes streamed responses.
+func (w *conditionalResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok && !w.buffering {
+		f.Flush()
+	}
+}
+
+// etagMatches reports whether the If-None-Match header of a request holds the entity tag.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, tag := range strings.Split(ifNoneMatch, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/metadata"
+)
+
+// immutableTestHandler serves a body, marked as immutable by the forward response option when
+// immutable is set, the way the gateway mux forwards a response.
+func immutableTestHandler(body string, immutable bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		md := gwruntime.ServerMetadata{HeaderMD: metadata.MD{}}
+		if immutable {
+			md.HeaderMD = metadata.Pairs(grpcutils.ImmutableResponseHeader, "true")
+		}
+		ctx := gwruntime.NewServerMetadataContext(context.Background(), md)
+		w.Header().Set("Content-Type", "application/json")
+		if err := markImmutableResponse(ctx, w, nil); err != nil {
+			panic(err)
+		}
+		if _, err := w.Write([]byte(body)); err != nil {
+			panic(err)
+		}
+	})
+}
+
+func TestConditionalHandler_ImmutableResponse(t *testing.T) {
+	handler := newConditionalHandler(immutableTestHandler(`{"epoch":"1"}`, true))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/eth/v1alpha1/beacon/committees?epoch=1", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, `{"epoch":"1"}`, rec.Body.String())
+	assert.Equal(t, immutableCacheControl, rec.Header().Get("Cache-Control"))
+	etag := rec.Header().Get("ETag")
+	require.NotEqual(t, "", etag)
+
+	// The tag only depends on the body.
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/eth/v1alpha1/beacon/committees?epoch=1", nil))
+	assert.Equal(t, etag, rec.Header().Get("ETag"))
+
+	tests := []struct {
+		ifNoneMatch string
+		status      int
+	}{
+		{ifNoneMatch: etag, status: http.StatusNotModified},
+		{ifNoneMatch: `"other", W/` + etag, status: http.StatusNotModified},
+		{ifNoneMatch: "*", status: http.StatusNotModified},
+		{ifNoneMatch: `"other"`, status: http.StatusOK},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/eth/v1alpha1/beacon/committees?epoch=1", nil)
+		req.Header.Set("If-None-Match", tt.ifNoneMatch)
+		rec = httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		assert.Equal(t, tt.status, rec.Code, "Unexpected status for If-None-Match %s", tt.ifNoneMatch)
+		assert.Equal(t, etag, rec.Header().Get("ETag"))
+		if tt.status == http.StatusNotModified {
+			assert.Equal(t, 0, rec.Body.Len())
+		}
+	}
+}
+
+func TestConditionalHandler_PassesThroughMutableResponse(t *testing.T) {
+	handler := newConditionalHandler(immutableTestHandler(`{"epoch":"9"}`, false))
+
+	req := httptest.NewRequest(http.MethodGet, "/eth/v1alpha1/beacon/committees", nil)
+	req.Header.Set("If-None-Match", "*")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, `{"epoch":"9"}`, rec.Body.String())
+	assert.Equal(t, "", rec.Header().Get("Cache-Control"))
+	assert.Equal(t, "", rec.Header().Get("ETag"))
+
+	// Errors are not cached either.
+	handler = newConditionalHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", immutableCacheControl)
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/eth/v1alpha1/beacon/committees", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Equal(t, "", rec.Header().Get("ETag"))
+}
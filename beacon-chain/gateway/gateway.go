@@ -12,6 +12,7 @@ import (
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1_gateway"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apikeys"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1_gateway"
 	"github.com/prysmaticlabs/prysm/shared"
 	"google.golang.org/grpc"
@@ -36,6 +37,7 @@ type Gateway struct {
 	startFailure            error
 	enableDebugRPCEndpoints bool
 	maxCallRecvMsgSize      uint64
+	apiKeys                 *apikeys.Keys
 }
 
 // Start the gateway service. This serves the HTTP JSON traffic on the specified
@@ -56,16 +58,15 @@ func (g *Gateway) Start() {
 	g.conn = conn
 
 	gwmux := gwruntime.NewServeMux(
-		gwruntime.WithMarshalerOption(
-			gwruntime.MIMEWildcard,
-			&gwruntime.JSONPb{OrigName: false, EmitDefaults: true},
-		),
+		gwruntime.WithMarshalerOption(gwruntime.MIMEWildcard, newMarshaler()),
+		gwruntime.WithForwardResponseOption(markImmutableResponse),
 	)
 	handlers := []func(context.Context, *gwruntime.ServeMux, *grpc.ClientConn) error{
 		ethpb.RegisterNodeHandler,
 		ethpb.RegisterBeaconChainHandler,
 		ethpb.RegisterBeaconNodeValidatorHandler,
 		pbrpc.RegisterHealthHandler,
+		pbrpc.RegisterBeaconQueryHandler,
 	}
 	if g.enableDebugRPCEndpoints {
 		handlers = append(handlers, pbrpc.RegisterDebugHandler)
@@ -78,11 +79,15 @@ func (g *Gateway) Start() {
 		}
 	}
 
-	g.mux.Handle("/", gwmux)
+	g.mux.Handle("/", newConditionalHandler(gwmux))
 
+	var handler http.Handler = g.mux
+	if g.apiKeys != nil {
+		handler = g.apiKeys.HTTPMiddleware(handler)
+	}
 	g.server = &http.Server{
 		Addr:    g.gatewayAddr,
-		Handler: newCorsHandler(g.mux, g.allowedOrigins),
+		Handler: newCorsHandler(handler, g.allowedOrigins),
 	}
 	go func() {
 		if err := g.server.ListenAndServe(); err != http.ErrServerClosed {
@@ -128,7 +133,8 @@ func (g *Gateway) Stop() error {
 }
 
 // New returns a new gateway server which translates HTTP into gRPC.
-// Accepts a context and optional http.ServeMux.
+// Accepts a context and optional http.ServeMux. When API keys are given, requests without a
+// known key are rejected before they are forwarded.
 func New(
 	ctx context.Context,
 	remoteAddress,
@@ -138,6 +144,7 @@ func New(
 	allowedOrigins []string,
 	enableDebugRPCEndpoints bool,
 	maxCallRecvMsgSize uint64,
+	apiKeys *apikeys.Keys,
 ) *Gateway {
 	if mux == nil {
 		mux = http.NewServeMux()
@@ -152,6 +159,7 @@ func New(
 		allowedOrigins:          allowedOrigins,
 		enableDebugRPCEndpoints: enableDebugRPCEndpoints,
 		maxCallRecvMsgSize:      maxCallRecvMsgSize,
+		apiKeys:                 apiKeys,
 	}
 }
 
@@ -0,0 +1,112 @@
+package gateway
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1_gateway"
+)
+
+// marshaler is the JSON marshaler of the gateway. Responses which grow with the number of
+// validators are encoded by hand into the exact JSON jsonpb produces, avoiding the reflection of
+// jsonpb which dominates the CPU time of large responses. Other responses go through jsonpb.
+type marshaler struct {
+	gwruntime.JSONPb
+}
+
+// newMarshaler returns the gateway marshaler. The hand-written encoders assume its jsonpb options,
+// which emit default values under their lowerCamelCase JSON names.
+func newMarshaler() *marshaler {
+	return &marshaler{JSONPb: gwruntime.JSONPb{OrigName: false, EmitDefaults: true}}
+}
+
+// Marshal marshals v into JSON.
+func (m *marshaler) Marshal(v interface{}) ([]byte, error) {
+	if res, ok := v.(*ethpb.ValidatorAssignments); ok && res != nil {
+		return appendValidatorAssignments(make([]byte, 0, 64+len(res.Assignments)*160), res)
+	}
+	return m.JSONPb.Marshal(v)
+}
+
+// appendValidatorAssignments appends the jsonpb encoding of the assignments to buf.
+func appendValidatorAssignments(buf []byte, res *ethpb.ValidatorAssignments) ([]byte, error) {
+	buf = append(buf, `{"epoch":`...)
+	buf = appendUint64(buf, res.Epoch)
+	buf = append(buf, `,"assignments":[`...)
+	for i, a := range res.Assignments {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		buf = appendCommitteeAssignment(buf, a)
+	}
+	buf = append(buf, `],"nextPageToken":`...)
+	// Page tokens are short, so the reflection of encoding/json costs little there.
+	token, err := json.Marshal(res.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	buf = append(buf, token...)
+	buf = append(buf, `,"totalSize":`...)
+	buf = strconv.AppendInt(buf, int64(res.TotalSize), 10)
+	return append(buf, '}'), nil
+}
+
+// appendCommitteeAssignment appends the jsonpb encoding of the assignment to buf.
+func appendCommitteeAssignment(buf []byte, a *ethpb.ValidatorAssignments_CommitteeAssignment) []byte {
+	if a == nil {
+		a = &ethpb.ValidatorAssignments_CommitteeAssignment{}
+	}
+	buf = append(buf, `{"beaconCommittees":`...)
+	buf = appendUint64s(buf, a.BeaconCommittees)
+	buf = append(buf, `,"committeeIndex":`...)
+	buf = appendUint64(buf, a.CommitteeIndex)
+	buf = append(buf, `,"attesterSlot":`...)
+	buf = appendUint64(buf, a.AttesterSlot)
+	buf = append(buf, `,"proposerSlots":`...)
+	buf = appendUint64s(buf, a.ProposerSlots)
+	buf = append(buf, `,"publicKey":`...)
+	buf = appendBytes(buf, a.PublicKey)
+	buf = append(buf, `,"validatorIndex":`...)
+	buf = appendUint64(buf, a.ValidatorIndex)
+	return append(buf, '}')
+}
+
+// appendUint64 appends a uint64 as jsonpb does, as a quoted decimal string.
+func appendUint64(buf []byte, v uint64) []byte {
+	buf = append(buf, '"')
+	buf = strconv.AppendUint(buf, v, 10)
+	return append(buf, '"')
+}
+
+// appendUint64s appends a repeated uint64 field as jsonpb does.
+func appendUint64s(buf []byte, vs []uint64) []byte {
+	buf = append(buf, '[')
+	for i, v := range vs {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		buf = appendUint64(buf, v)
+	}
+	return append(buf, ']')
+}
+
+// appendBytes appends a bytes field as jsonpb does, as a quoted standard base64 string, or null
+// if the field is unset.
+func appendBytes(buf []byte, b []byte) []byte {
+	if b == nil {
+		return append(buf, "null"...)
+	}
+	buf = append(buf, '"')
+	n := len(buf)
+	size := base64.StdEncoding.EncodedLen(len(b))
+	if cap(buf)-n < size {
+		grown := make([]byte, n, 2*cap(buf)+size)
+		copy(grown, buf)
+		buf = grown
+	}
+	buf = buf[:n+size]
+	base64.StdEncoding.Encode(buf[n:], b)
+	return append(buf, '"')
+}
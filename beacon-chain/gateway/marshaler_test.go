@@ -0,0 +1,88 @@
+package gateway
+
+import (
+	"fmt"
+	"testing"
+
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1_gateway"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func validatorAssignments(count int) *ethpb.ValidatorAssignments {
+	res := &ethpb.ValidatorAssignments{
+		Epoch:         1 << 40,
+		NextPageToken: "2",
+		TotalSize:     int32(count),
+	}
+	for i := 0; i < count; i++ {
+		pubKey := make([]byte, 48)
+		pubKey[0], pubKey[47] = byte(i), 0xff
+		a := &ethpb.ValidatorAssignments_CommitteeAssignment{
+			BeaconCommittees: []uint64{uint64(i), uint64(i) + 1, ^uint64(0)},
+			CommitteeIndex:   uint64(i % 4),
+			AttesterSlot:     uint64(i),
+			PublicKey:        pubKey,
+			ValidatorIndex:   uint64(i),
+		}
+		if i%3 == 0 {
+			a.ProposerSlots = []uint64{uint64(i) + 2}
+		}
+		res.Assignments = append(res.Assignments, a)
+	}
+	return res
+}
+
+func TestMarshaler_ValidatorAssignments(t *testing.T) {
+	jsonpb := &gwruntime.JSONPb{OrigName: false, EmitDefaults: true}
+	tests := []*ethpb.ValidatorAssignments{
+		{},
+		{NextPageToken: "<\"page\">é", TotalSize: -1},
+		{Assignments: []*ethpb.ValidatorAssignments_CommitteeAssignment{{}, {PublicKey: []byte{}}}},
+		validatorAssignments(7),
+	}
+	for i, res := range tests {
+		t.Run(fmt.Sprintf("case %d", i), func(t *testing.T) {
+			want, err := jsonpb.Marshal(res)
+			require.NoError(t, err)
+			got, err := newMarshaler().Marshal(res)
+			require.NoError(t, err)
+			assert.Equal(t, string(want), string(got))
+		})
+	}
+}
+
+func TestMarshaler_FallsBackToJSONPb(t *testing.T) {
+	jsonpb := &gwruntime.JSONPb{OrigName: false, EmitDefaults: true}
+	head := &ethpb.ChainHead{HeadSlot: 3, HeadBlockRoot: []byte{1, 2, 3}}
+	want, err := jsonpb.Marshal(head)
+	require.NoError(t, err)
+	got, err := newMarshaler().Marshal(head)
+	require.NoError(t, err)
+	assert.Equal(t, string(want), string(got))
+}
+
+func BenchmarkMarshal_ValidatorAssignments_JSONPb(b *testing.B) {
+	res := validatorAssignments(10000)
+	m := &gwruntime.JSONPb{OrigName: false, EmitDefaults: true}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := m.Marshal(res); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMarshal_ValidatorAssignments(b *testing.B) {
+	res := validatorAssignments(10000)
+	m := newMarshaler()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := m.Marshal(res); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
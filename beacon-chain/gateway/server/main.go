@@ -45,6 +45,7 @@ func main() {
 		strings.Split(*allowedOrigins, ","),
 		*enableDebugRPCEndpoints,
 		uint64(*grpcMaxMsgSize),
+		nil, // apiKeys
 	)
 	mux.HandleFunc("/swagger/", gateway.SwaggerServer())
 	mux.HandleFunc("/healthz", healthzServer(gw))
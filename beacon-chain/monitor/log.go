@@ -0,0 +1,7 @@
+package monitor
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "monitor")
@@ -0,0 +1,56 @@
+package monitor
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	dutiesAssignedTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "monitor_duties_assigned_total",
+			Help: "The number of attester and proposer duties assigned to a tracked validator.",
+		},
+		[]string{"validator_index", "duty"},
+	)
+	attestationsIncludedTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "monitor_attestations_included_total",
+			Help: "The number of epochs an attestation of a tracked validator was included in a processed block.",
+		},
+		[]string{"validator_index"},
+	)
+	attestationInclusionDistance = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "monitor_attestation_inclusion_distance_slots",
+			Help: "The number of slots between the latest included attestation of a tracked validator and its inclusion.",
+		},
+		[]string{"validator_index"},
+	)
+	blocksProposedTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "monitor_blocks_proposed_total",
+			Help: "The number of processed blocks proposed by a tracked validator.",
+		},
+		[]string{"validator_index"},
+	)
+	balanceGwei = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "monitor_balance_gwei",
+			Help: "The balance of a tracked validator in the head state at the start of the latest epoch.",
+		},
+		[]string{"validator_index"},
+	)
+)
+
+// deleteMetrics removes the series of a validator which is no longer tracked, so that it stops
+// being exported.
+func deleteMetrics(label string) {
+	for _, duty := range []string{attesterDuty, proposerDuty} {
+		dutiesAssignedTotal.DeleteLabelValues(label, duty)
+	}
+	attestationsIncludedTotal.DeleteLabelValues(label)
+	attestationInclusionDistance.DeleteLabelValues(label)
+	blocksProposedTotal.DeleteLabelValues(label)
+	balanceGwei.DeleteLabelValues(label)
+}
@@ -0,0 +1,385 @@
+// Package monitor exports per validator metrics of the tracked validators and logs the notable
+// events concerning them. It follows the blocks processed by the node, its epoch transitions and
+// the operations it receives, and counts the duties assigned to every tracked validator, the
+// attestations of it included on chain and the blocks it proposed, along with its balance.
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+var _ shared.Service = (*Service)(nil)
+
+const (
+	attesterDuty = "attester"
+	proposerDuty = "proposer"
+)
+
+// TrackedValidators provides the public keys of the monitored validators.
+type TrackedValidators interface {
+	PublicKeys() [][]byte
+}
+
+// Config options for the monitor service.
+type Config struct {
+	TrackedValidators TrackedValidators
+	HeadFetcher       blockchain.HeadFetcher
+	TimeFetcher       blockchain.TimeFetcher
+	StateNotifier     statefeed.Notifier
+	OperationNotifier opfeed.Notifier
+	CommitteeCache    *cache.CommitteeCache
+}
+
+// includedKey identifies the attestation of a validator for an epoch, which is counted once no
+// matter how many aggregates included it.
+type includedKey struct {
+	validatorIndex types.ValidatorIndex
+	epoch          types.Epoch
+}
+
+// Service subscribes to the processed blocks, the epoch transitions and the operations received by
+// the node, and updates the metrics of the tracked validators.
+type Service struct {
+	cfg    *Config
+	ctx    context.Context
+	cancel context.CancelFunc
+	lock   sync.Mutex
+	// tracked maps the indices of the tracked validators known to the head state to their public
+	// keys. It is replaced as a whole, never modified in place.
+	tracked   map[types.ValidatorIndex][]byte
+	balances  map[types.ValidatorIndex]uint64
+	slashed   map[types.ValidatorIndex]bool
+	included  map[includedKey]bool
+	epochLock sync.Mutex
+	lastEpoch types.Epoch
+}
+
+// NewService returns a monitor service of the tracked validators.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		cfg:      cfg,
+		ctx:      ctx,
+		cancel:   cancel,
+		tracked:  make(map[types.ValidatorIndex][]byte),
+		balances: make(map[types.ValidatorIndex]uint64),
+		slashed:  make(map[types.ValidatorIndex]bool),
+		included: make(map[includedKey]bool),
+	}
+}
+
+// Start the monitor service.
+func (s *Service) Start() {
+	log.WithField("validators", len(s.cfg.TrackedValidators.PublicKeys())).Info("Monitoring tracked validators")
+	go s.run()
+}
+
+// Stop the monitor service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the monitor service, which has no failure mode of its own.
+func (s *Service) Status() error {
+	return nil
+}
+
+func (s *Service) run() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	opChannel := make(chan *feed.Event, 1)
+	opSub := s.cfg.OperationNotifier.OperationFeed().Subscribe(opChannel)
+	defer opSub.Unsubscribe()
+
+	for {
+		select {
+		case ev := <-stateChannel:
+			// Blocks and epoch transitions of a syncing node are not monitored. Both are handled on
+			// their own routine, as the feeds block the chain service until every subscriber
+			// received the event.
+			currentEpoch := helpers.SlotToEpoch(s.cfg.TimeFetcher.CurrentSlot())
+			switch data := ev.Data.(type) {
+			case *statefeed.BlockProcessedData:
+				if ev.Type != statefeed.BlockProcessed || helpers.SlotToEpoch(data.Slot)+1 < currentEpoch {
+					continue
+				}
+				go s.onBlock(s.ctx, data.SignedBlock)
+			case *statefeed.EpochTransitionData:
+				if ev.Type != statefeed.EpochTransition || data.Epoch < currentEpoch {
+					continue
+				}
+				go s.onEpochTransition(s.ctx, data.Epoch)
+			}
+		case ev := <-opChannel:
+			if data, ok := ev.Data.(*opfeed.ExitReceivedData); ok && ev.Type == opfeed.ExitReceived {
+				s.onExit(data.Exit)
+			}
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Could not subscribe to state events")
+			return
+		case err := <-opSub.Err():
+			log.WithError(err).Error("Could not subscribe to operation events")
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// onBlock counts the block if a tracked validator proposed it, and the attestations of tracked
+// validators it includes.
+func (s *Service) onBlock(ctx context.Context, blk *ethpb.SignedBeaconBlock) {
+	if blk == nil || blk.Block == nil || blk.Block.Body == nil || len(s.cfg.TrackedValidators.PublicKeys()) == 0 {
+		return
+	}
+	st, err := s.cfg.HeadFetcher.HeadState(ctx)
+	if err != nil || st == nil {
+		log.WithError(err).Error("Could not get head state to monitor block")
+		return
+	}
+	tracked := s.refreshTracked(st)
+	if len(tracked) == 0 {
+		return
+	}
+
+	b := blk.Block
+	if pubKey, ok := tracked[b.ProposerIndex]; ok {
+		blocksProposedTotal.WithLabelValues(indexLabel(b.ProposerIndex)).Inc()
+		log.WithFields(logrus.Fields{
+			"slot":           b.Slot,
+			"validatorIndex": b.ProposerIndex,
+			"pubKey":         formatPubKey(pubKey),
+		}).Info("Processed block proposed by tracked validator")
+	}
+	for _, att := range b.Body.Attestations {
+		if err := s.recordInclusion(st, att, b.Slot, tracked); err != nil {
+			log.WithError(err).WithField("slot", b.Slot).Debug("Could not monitor included attestation")
+		}
+	}
+}
+
+// recordInclusion counts the attestation of every tracked validator of the attestation, unless
+// an attestation of the validator for the same epoch was counted already. The committees of the
+// attestation are derived from the head state, which is at most an epoch ahead of it.
+func (s *Service) recordInclusion(
+	st iface.ReadOnlyBeaconState, att *ethpb.Attestation, inclusionSlot types.Slot, tracked map[types.ValidatorIndex][]byte,
+) error {
+	if att == nil || att.Data == nil {
+		return nil
+	}
+	committee, err := helpers.BeaconCommitteeFromState(s.cfg.CommitteeCache, st, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return errors.Wrapf(err, "could not get committee %d of slot %d", att.Data.CommitteeIndex, att.Data.Slot)
+	}
+	indices, err := attestationutil.AttestingIndices(att.AggregationBits, committee)
+	if err != nil {
+		return err
+	}
+	epoch := helpers.SlotToEpoch(att.Data.Slot)
+	for _, i := range indices {
+		idx := types.ValidatorIndex(i)
+		pubKey, ok := tracked[idx]
+		if !ok {
+			continue
+		}
+		key := includedKey{validatorIndex: idx, epoch: epoch}
+		s.lock.Lock()
+		counted := s.included[key]
+		s.included[key] = true
+		s.lock.Unlock()
+		if counted {
+			continue
+		}
+		label := indexLabel(idx)
+		attestationsIncludedTotal.WithLabelValues(label).Inc()
+		attestationInclusionDistance.WithLabelValues(label).Set(float64(inclusionSlot - att.Data.Slot))
+		log.WithFields(logrus.Fields{
+			"attestationSlot":   att.Data.Slot,
+			"inclusionSlot":     inclusionSlot,
+			"inclusionDistance": inclusionSlot - att.Data.Slot,
+			"validatorIndex":    idx,
+			"pubKey":            formatPubKey(pubKey),
+		}).Info("Attestation of tracked validator included")
+	}
+	return nil
+}
+
+// onEpochTransition counts the duties of the tracked validators in the epoch, and updates their
+// balances from the head state.
+func (s *Service) onEpochTransition(ctx context.Context, epoch types.Epoch) {
+	s.epochLock.Lock()
+	defer s.epochLock.Unlock()
+	// Reorgs may move the head into the same epoch twice.
+	if epoch <= s.lastEpoch {
+		return
+	}
+	s.lastEpoch = epoch
+
+	st, err := s.cfg.HeadFetcher.HeadState(ctx)
+	if err != nil || st == nil {
+		log.WithError(err).Error("Could not get head state to monitor epoch")
+		return
+	}
+	st, err = stateInEpoch(ctx, s.cfg.CommitteeCache, st, epoch)
+	if err != nil {
+		log.WithError(err).WithField("epoch", epoch).Error("Could not advance head state to monitor epoch")
+		return
+	}
+	tracked := s.refreshTracked(st)
+	if len(tracked) == 0 {
+		return
+	}
+	attesters, proposerSlots, err := helpers.CommitteeAssignments(ctx, s.cfg.CommitteeCache, st, epoch)
+	if err != nil {
+		log.WithError(err).WithField("epoch", epoch).Error("Could not compute duties of tracked validators")
+		return
+	}
+
+	for idx, pubKey := range tracked {
+		label := indexLabel(idx)
+		fields := logrus.Fields{
+			"epoch":          epoch,
+			"validatorIndex": idx,
+			"pubKey":         formatPubKey(pubKey),
+		}
+		if a, ok := attesters[idx]; ok {
+			dutiesAssignedTotal.WithLabelValues(label, attesterDuty).Inc()
+			fields["attesterSlot"] = a.AttesterSlot
+			fields["committeeIndex"] = a.CommitteeIndex
+		}
+		if slots := proposerSlots[idx]; len(slots) > 0 {
+			dutiesAssignedTotal.WithLabelValues(label, proposerDuty).Add(float64(len(slots)))
+			log.WithFields(fields).WithField("proposerSlots", slots).Info("Tracked validator assigned to propose")
+		}
+		log.WithFields(fields).Debug("Tracked validator duties")
+		if err := s.updateBalance(st, idx, fields); err != nil {
+			log.WithError(err).WithFields(fields).Error("Could not update balance of tracked validator")
+		}
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	// Attestations are included up to an epoch after their own.
+	for key := range s.included {
+		if key.epoch+1 < epoch {
+			delete(s.included, key)
+		}
+	}
+}
+
+// updateBalance exports the balance of a tracked validator, and logs a decrease of it since the
+// previous epoch as well as the validator being slashed.
+func (s *Service) updateBalance(st iface.ReadOnlyBeaconState, idx types.ValidatorIndex, fields logrus.Fields) error {
+	balance, err := st.BalanceAtIndex(idx)
+	if err != nil {
+		return err
+	}
+	v, err := st.ValidatorAtIndexReadOnly(idx)
+	if err != nil {
+		return err
+	}
+	balanceGwei.WithLabelValues(indexLabel(idx)).Set(float64(balance))
+
+	s.lock.Lock()
+	previous, known := s.balances[idx]
+	s.balances[idx] = balance
+	newlySlashed := v.Slashed() && !s.slashed[idx]
+	s.slashed[idx] = v.Slashed()
+	s.lock.Unlock()
+
+	if known && balance < previous {
+		log.WithFields(fields).WithFields(logrus.Fields{
+			"previousBalance": previous,
+			"balance":         balance,
+		}).Warn("Balance of tracked validator decreased")
+	}
+	if newlySlashed {
+		log.WithFields(fields).Warn("Tracked validator was slashed")
+	}
+	return nil
+}
+
+// onExit logs the voluntary exits of tracked validators received by the node.
+func (s *Service) onExit(exit *ethpb.SignedVoluntaryExit) {
+	if exit == nil || exit.Exit == nil {
+		return
+	}
+	s.lock.Lock()
+	pubKey, ok := s.tracked[exit.Exit.ValidatorIndex]
+	s.lock.Unlock()
+	if !ok {
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"epoch":          exit.Exit.Epoch,
+		"validatorIndex": exit.Exit.ValidatorIndex,
+		"pubKey":         formatPubKey(pubKey),
+	}).Info("Received voluntary exit of tracked validator")
+}
+
+// refreshTracked maps the public keys of the tracked validators to their indices in the state, as
+// tracked validators may be added and removed at runtime, and deletes the metrics of validators
+// which are no longer tracked.
+func (s *Service) refreshTracked(st iface.ReadOnlyBeaconState) map[types.ValidatorIndex][]byte {
+	pubKeys := s.cfg.TrackedValidators.PublicKeys()
+	tracked := make(map[types.ValidatorIndex][]byte, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		if idx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubKey)); ok {
+			tracked[idx] = pubKey
+		}
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for idx := range s.tracked {
+		if _, ok := tracked[idx]; !ok {
+			deleteMetrics(indexLabel(idx))
+			delete(s.balances, idx)
+			delete(s.slashed, idx)
+		}
+	}
+	s.tracked = tracked
+	return tracked
+}
+
+// stateInEpoch returns the state, or a copy of it processed through the empty slots up to the
+// start of the epoch if it is in an earlier epoch.
+func stateInEpoch(ctx context.Context, committeeCache *cache.CommitteeCache, st iface.BeaconState, epoch types.Epoch) (iface.BeaconState, error) {
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, err
+	}
+	if st.Slot() >= startSlot {
+		return st, nil
+	}
+	return state.ProcessSlots(ctx, committeeCache, st.Copy(), startSlot)
+}
+
+func indexLabel(idx types.ValidatorIndex) string {
+	return strconv.FormatUint(uint64(idx), 10)
+}
+
+func formatPubKey(pubKey []byte) string {
+	return fmt.Sprintf("%#x", bytesutil.Trunc(pubKey))
+}
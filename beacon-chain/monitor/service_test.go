@@ -0,0 +1,145 @@
+package monitor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	sharedtestutil "github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type trackedKeys [][]byte
+
+func (k trackedKeys) PublicKeys() [][]byte {
+	return k
+}
+
+func monitorState(t *testing.T, slot types.Slot) iface.BeaconState {
+	helpers.ClearCache()
+	st, _ := sharedtestutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, st.SetSlot(slot))
+	return st
+}
+
+func pubKeysOf(st iface.ReadOnlyBeaconState, indices ...types.ValidatorIndex) trackedKeys {
+	keys := make(trackedKeys, len(indices))
+	for i, idx := range indices {
+		pubKey := st.PubkeyAtIndex(idx)
+		keys[i] = pubKey[:]
+	}
+	return keys
+}
+
+func TestService_OnBlock(t *testing.T) {
+	ctx := context.Background()
+	slot := params.BeaconConfig().SlotsPerEpoch + 3
+	st := monitorState(t, slot)
+	assignments, _, err := helpers.CommitteeAssignments(ctx, nil, st, 1)
+	require.NoError(t, err)
+	tracked := assignments[7]
+	s := NewService(ctx, &Config{
+		TrackedValidators: pubKeysOf(st, 7, 9),
+		HeadFetcher:       &mock.ChainService{State: st},
+	})
+
+	bits := bitfield.NewBitlist(uint64(len(tracked.Committee)))
+	for i := range tracked.Committee {
+		bits.SetBitAt(uint64(i), true)
+	}
+	att := &ethpb.Attestation{
+		AggregationBits: bits,
+		Data: &ethpb.AttestationData{
+			Slot:           tracked.AttesterSlot,
+			CommitteeIndex: tracked.CommitteeIndex,
+		},
+	}
+	blk := sharedtestutil.NewBeaconBlock()
+	blk.Block.Slot = tracked.AttesterSlot + 2
+	blk.Block.ProposerIndex = 9
+	// The second aggregate of the same attestation is not counted again.
+	blk.Block.Body.Attestations = []*ethpb.Attestation{att, att}
+
+	proposedBefore := testutil.ToFloat64(blocksProposedTotal.WithLabelValues("9"))
+	includedBefore := testutil.ToFloat64(attestationsIncludedTotal.WithLabelValues("7"))
+	s.onBlock(ctx, blk)
+	assert.Equal(t, proposedBefore+1, testutil.ToFloat64(blocksProposedTotal.WithLabelValues("9")))
+	assert.Equal(t, includedBefore+1, testutil.ToFloat64(attestationsIncludedTotal.WithLabelValues("7")))
+	assert.Equal(t, float64(2), testutil.ToFloat64(attestationInclusionDistance.WithLabelValues("7")))
+
+	// A later block including the attestation again does not count it either.
+	blk.Block.Slot++
+	s.onBlock(ctx, blk)
+	assert.Equal(t, includedBefore+1, testutil.ToFloat64(attestationsIncludedTotal.WithLabelValues("7")))
+}
+
+func TestService_OnEpochTransition(t *testing.T) {
+	ctx := context.Background()
+	st := monitorState(t, params.BeaconConfig().SlotsPerEpoch)
+	numValidators := st.NumValidators()
+	indices := make([]types.ValidatorIndex, numValidators)
+	for i := range indices {
+		indices[i] = types.ValidatorIndex(i)
+	}
+	chain := &mock.ChainService{State: st}
+	s := NewService(ctx, &Config{
+		TrackedValidators: pubKeysOf(st, indices...),
+		HeadFetcher:       chain,
+	})
+
+	attesterBefore := make([]float64, numValidators)
+	proposerBefore := make([]float64, numValidators)
+	for i, idx := range indices {
+		attesterBefore[i] = testutil.ToFloat64(dutiesAssignedTotal.WithLabelValues(indexLabel(idx), attesterDuty))
+		proposerBefore[i] = testutil.ToFloat64(dutiesAssignedTotal.WithLabelValues(indexLabel(idx), proposerDuty))
+	}
+	s.onEpochTransition(ctx, 1)
+	// The same epoch is only counted once.
+	s.onEpochTransition(ctx, 1)
+
+	var attesterDuties, proposerDuties float64
+	for i, idx := range indices {
+		attesterDuties += testutil.ToFloat64(dutiesAssignedTotal.WithLabelValues(indexLabel(idx), attesterDuty)) - attesterBefore[i]
+		proposerDuties += testutil.ToFloat64(dutiesAssignedTotal.WithLabelValues(indexLabel(idx), proposerDuty)) - proposerBefore[i]
+	}
+	assert.Equal(t, float64(numValidators), attesterDuties)
+	assert.Equal(t, float64(params.BeaconConfig().SlotsPerEpoch), proposerDuties)
+	assert.Equal(t, float64(params.BeaconConfig().MaxEffectiveBalance), testutil.ToFloat64(balanceGwei.WithLabelValues("3")))
+
+	// The balance of the next epoch is read from the head state of that epoch.
+	next := st.Copy()
+	require.NoError(t, next.SetSlot(2*params.BeaconConfig().SlotsPerEpoch))
+	require.NoError(t, next.UpdateBalancesAtIndex(3, params.BeaconConfig().MaxEffectiveBalance-1000))
+	chain.State = next
+	s.onEpochTransition(ctx, 2)
+	assert.Equal(t, float64(params.BeaconConfig().MaxEffectiveBalance-1000), testutil.ToFloat64(balanceGwei.WithLabelValues("3")))
+	s.lock.Lock()
+	assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance-1000, s.balances[3])
+	s.lock.Unlock()
+}
+
+func TestService_RefreshTracked(t *testing.T) {
+	st := monitorState(t, 0)
+	keys := pubKeysOf(st, 1, 2)
+	s := NewService(context.Background(), &Config{TrackedValidators: keys})
+	tracked := s.refreshTracked(st)
+	require.Equal(t, 2, len(tracked))
+	assert.DeepEqual(t, keys[1], tracked[2])
+	balanceGwei.WithLabelValues("2").Set(1)
+
+	// Validator 2 is no longer tracked, so its metrics stop being exported.
+	s.cfg.TrackedValidators = keys[:1]
+	tracked = s.refreshTracked(st)
+	require.Equal(t, 1, len(tracked))
+	_, ok := tracked[2]
+	assert.Equal(t, false, ok)
+	assert.Equal(t, false, balanceGwei.DeleteLabelValues("2"))
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
 	"os/signal"
 	"path/filepath"
@@ -21,20 +22,28 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/freezer"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	"github.com/prysmaticlabs/prysm/beacon-chain/gateway"
 	interopcoldstart "github.com/prysmaticlabs/prysm/beacon-chain/interop-cold-start"
+	"github.com/prysmaticlabs/prysm/beacon-chain/monitor"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/admin"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apikeys"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/beacon"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/genesis"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	regularsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
+	"github.com/prysmaticlabs/prysm/beacon-chain/webhook"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/backuputil"
@@ -50,6 +59,8 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"gopkg.in/yaml.v2"
 )
 
@@ -75,6 +86,16 @@ type BeaconNode struct {
 	opFeed          *event.Feed
 	forkChoiceStore forkchoice.ForkChoicer
 	stateGen        *stategen.State
+	coldStateStore  *freezer.Store
+	// trackedValidators is stored in --tracked-validators-file if set, and in the database otherwise.
+	trackedValidators *beacon.TrackedValidators
+	// apiKeys is nil unless --rpc-api-keys-file is set.
+	apiKeys *apikeys.Keys
+	// genesisServer is nil unless --await-orchestrator-genesis is set.
+	genesisServer *genesis.Server
+	// chainConfig is the beacon chain config once every flag override is applied. The services
+	// read it instead of the global config, which tests and forks may still override at runtime.
+	chainConfig params.ChainConfig
 }
 
 // New creates a new node instance, sets up configuration options, and registers
@@ -103,7 +124,7 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 	}
 
 	if cliCtx.Bool(flags.HistoricalSlasherNode.Name) {
-		c := params.BeaconConfig()
+		c := params.BeaconConfig().Copy()
 		// Save a state every 4 epochs.
 		c.SlotsPerArchivedPoint = params.BeaconConfig().SlotsPerEpoch * 4
 		params.OverrideBeaconConfig(c)
@@ -119,24 +140,24 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 	}
 
 	if cliCtx.IsSet(flags.SlotsPerArchivedPoint.Name) {
-		c := params.BeaconConfig()
+		c := params.BeaconConfig().Copy()
 		c.SlotsPerArchivedPoint = types.Slot(cliCtx.Int(flags.SlotsPerArchivedPoint.Name))
 		params.OverrideBeaconConfig(c)
 	}
 
 	// ETH PoW related flags.
 	if cliCtx.IsSet(flags.ChainID.Name) {
-		c := params.BeaconConfig()
+		c := params.BeaconConfig().Copy()
 		c.DepositChainID = cliCtx.Uint64(flags.ChainID.Name)
 		params.OverrideBeaconConfig(c)
 	}
 	if cliCtx.IsSet(flags.NetworkID.Name) {
-		c := params.BeaconConfig()
+		c := params.BeaconConfig().Copy()
 		c.DepositNetworkID = cliCtx.Uint64(flags.NetworkID.Name)
 		params.OverrideBeaconConfig(c)
 	}
 	if cliCtx.IsSet(flags.DepositContractFlag.Name) {
-		c := params.BeaconConfig()
+		c := params.BeaconConfig().Copy()
 		c.DepositContractAddress = cliCtx.String(flags.DepositContractFlag.Name)
 		params.OverrideBeaconConfig(c)
 	}
@@ -153,6 +174,8 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		params.OverrideBeaconNetworkConfig(networkCfg)
 	}
 
+	orchestrator.SetVerboseLogging(cliCtx.Bool(flags.LogVanDebug.Name))
+
 	registry := shared.NewServiceRegistry()
 
 	ctx, cancel := context.WithCancel(cliCtx.Context)
@@ -161,6 +184,7 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		ctx:             ctx,
 		cancel:          cancel,
 		services:        registry,
+		chainConfig:     params.NewScopedChainConfig(params.BeaconConfig()),
 		stop:            make(chan struct{}),
 		stateFeed:       new(event.Feed),
 		blockFeed:       new(event.Feed),
@@ -170,11 +194,18 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		slashingsPool:   slashings.NewPool(),
 	}
 
+	// The API keys guard the genesis service awaited while the database starts.
+	if err := beacon.loadAPIKeys(cliCtx); err != nil {
+		return nil, err
+	}
+
 	if err := beacon.startDB(cliCtx); err != nil {
 		return nil, err
 	}
 
-	beacon.startStateGen()
+	if err := beacon.startStateGen(cliCtx); err != nil {
+		return nil, err
+	}
 
 	if err := beacon.registerP2P(cliCtx); err != nil {
 		return nil, err
@@ -192,6 +223,10 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.loadTrackedValidators(cliCtx); err != nil {
+		return nil, err
+	}
+
 	beacon.startForkChoice()
 
 	if err := beacon.registerBlockchainService(); err != nil {
@@ -214,6 +249,14 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.registerWebhookService(); err != nil {
+		return nil, err
+	}
+
+	if err := beacon.registerMonitorService(); err != nil {
+		return nil, err
+	}
+
 	if !cliCtx.Bool(cmd.DisableMonitoringFlag.Name) {
 		if err := beacon.registerPrometheusService(cliCtx); err != nil {
 			return nil, err
@@ -282,6 +325,11 @@ func (b *BeaconNode) Close() {
 	if err := b.db.Close(); err != nil {
 		log.Errorf("Failed to close database: %v", err)
 	}
+	if b.coldStateStore != nil {
+		if err := b.coldStateStore.Close(); err != nil {
+			log.Errorf("Failed to close cold state store: %v", err)
+		}
+	}
 	b.cancel()
 	close(b.stop)
 }
@@ -323,6 +371,9 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context) error {
 		if err := d.ClearDB(); err != nil {
 			return errors.Wrap(err, "could not clear database")
 		}
+		if err := os.RemoveAll(filepath.Join(dbPath, freezer.DirName)); err != nil {
+			return errors.Wrap(err, "could not clear cold state store")
+		}
 		d, err = db.NewDB(b.ctx, dbPath, &kv.Config{
 			InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
 		})
@@ -363,12 +414,97 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context) error {
 			return errors.Wrap(err, "could not load genesis from file")
 		}
 	}
+	if cliCtx.Bool(flags.AwaitOrchestratorGenesis.Name) {
+		if err := b.awaitOrchestratorGenesis(cliCtx); err != nil {
+			return err
+		}
+	}
 
 	return b.db.EnsureEmbeddedGenesis(b.ctx)
 }
 
-func (b *BeaconNode) startStateGen() {
+// awaitOrchestratorGenesis serves the genesis service on the RPC address until the orchestrator
+// submitted the genesis, if none is known yet. The RPC service keeps serving it afterwards.
+func (b *BeaconNode) awaitOrchestratorGenesis(cliCtx *cli.Context) error {
+	b.genesisServer = genesis.NewServer(b.db)
+	existing, err := b.db.GenesisState(b.ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve genesis state")
+	}
+	if existing != nil {
+		return nil
+	}
+
+	address := fmt.Sprintf("%s:%d", cliCtx.String(flags.RPCHost.Name), cliCtx.Int(flags.RPCPort.Name))
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		return errors.Wrapf(err, "could not listen on %s", address)
+	}
+	opts := []grpc.ServerOption{grpc.MaxRecvMsgSize(cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name))}
+	cert, key := cliCtx.String(flags.CertFlag.Name), cliCtx.String(flags.KeyFlag.Name)
+	if cert != "" && key != "" {
+		creds, err := credentials.NewServerTLSFromFile(cert, key)
+		if err != nil {
+			return errors.Wrap(err, "could not load TLS keys")
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+	// Submitting the genesis requires the admin scope, as on the RPC service serving it afterwards.
+	if b.apiKeys != nil {
+		opts = append(opts, grpc.UnaryInterceptor(b.apiKeys.UnaryServerInterceptor()))
+	}
+	if err := b.genesisServer.Await(b.ctx, lis, opts...); err != nil {
+		return errors.Wrap(err, "could not receive genesis from the orchestrator")
+	}
+	return nil
+}
+
+func (b *BeaconNode) startStateGen(cliCtx *cli.Context) error {
 	b.stateGen = stategen.New(b.db)
+
+	if cliCtx.Bool(flags.EnableColdStateStore.Name) {
+		coldPath := filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName, freezer.DirName)
+		log.WithField("path", coldPath).Info("Opening cold state store")
+		store, err := freezer.Open(coldPath, freezer.DefaultSegmentSize)
+		if err != nil {
+			return errors.Wrap(err, "could not open cold state store")
+		}
+		b.coldStateStore = store
+		b.stateGen.EnableColdStateStore(store)
+	}
+	return nil
+}
+
+// loadTrackedValidators loads the tracked validators shared by the RPC, webhook and monitor services,
+// from --tracked-validators-file if set and from the database otherwise.
+func (b *BeaconNode) loadTrackedValidators(cliCtx *cli.Context) error {
+	var tracked *beacon.TrackedValidators
+	var err error
+	if path := cliCtx.String(flags.TrackedValidatorsFile.Name); path != "" {
+		tracked, err = beacon.NewTrackedValidators(path)
+	} else {
+		tracked, err = beacon.NewTrackedValidatorsFromDB(b.ctx, b.db)
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not load tracked validators")
+	}
+	log.WithField("count", tracked.Len()).Info("Loaded tracked validators")
+	b.trackedValidators = tracked
+	return nil
+}
+
+// loadAPIKeys loads the API keys shared by the RPC service and the gateway.
+func (b *BeaconNode) loadAPIKeys(cliCtx *cli.Context) error {
+	path := cliCtx.String(flags.RPCAPIKeysFile.Name)
+	if path == "" {
+		return nil
+	}
+	keys, err := apikeys.LoadKeys(path)
+	if err != nil {
+		return errors.Wrap(err, "could not load API keys")
+	}
+	b.apiKeys = keys
+	return nil
 }
 
 func readbootNodes(fileName string) ([]string, error) {
@@ -473,29 +609,58 @@ func (b *BeaconNode) registerBlockchainService() error {
 		return err
 	}
 
+	slotTimer, err := slotTimerFromFlags(b.cliCtx, b.chainConfig)
+	if err != nil {
+		return err
+	}
+
 	maxRoutines := b.cliCtx.Int(cmd.MaxGoroutines.Name)
 	blockchainService, err := blockchain.NewService(b.ctx, &blockchain.Config{
-		BeaconDB:          b.db,
-		DepositCache:      b.depositCache,
-		ChainStartFetcher: web3Service,
-		AttPool:           b.attestationPool,
-		ExitPool:          b.exitPool,
-		SlashingPool:      b.slashingsPool,
-		P2p:               b.fetchP2P(),
-		MaxRoutines:       maxRoutines,
-		StateNotifier:     b,
-		ForkChoiceStore:   b.forkChoiceStore,
-		OpsService:        opsService,
-		StateGen:          b.stateGen,
-		WspBlockRoot:      bRoot,
-		WspEpoch:          epoch,
+		BeaconDB:                   b.db,
+		DepositCache:               b.depositCache,
+		ChainStartFetcher:          web3Service,
+		AttPool:                    b.attestationPool,
+		ExitPool:                   b.exitPool,
+		SlashingPool:               b.slashingsPool,
+		P2p:                        b.fetchP2P(),
+		MaxRoutines:                maxRoutines,
+		StateNotifier:              b,
+		ForkChoiceStore:            b.forkChoiceStore,
+		OpsService:                 opsService,
+		StateGen:                   b.stateGen,
+		WspBlockRoot:               bRoot,
+		WspEpoch:                   epoch,
+		VerifyPandoraBlocks:        !b.cliCtx.Bool(flags.DisableOrchestratorVerification.Name),
+		PandoraVerificationTimeout: b.cliCtx.Duration(flags.OrchestratorVerificationTimeout.Name),
+		PrecomputationStallSlots:   types.Slot(b.cliCtx.Uint64(flags.PrecomputationStallSlots.Name)),
+		SlotTimer:                  slotTimer,
+		ChainConfig:                b.chainConfig,
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not register blockchain service")
 	}
+	b.stateGen.EnableCanonicalRootIndex(blockchainService)
+	b.stateGen.EnableCommitteeCache(blockchainService.CommitteeCache())
 	return b.services.RegisterService(blockchainService)
 }
 
+// slotTimerFromFlags returns the slot timer selected by the --slot-timer flag.
+func slotTimerFromFlags(cliCtx *cli.Context, chainConfig params.ChainConfig) (blockchain.SlotTimer, error) {
+	switch timer := cliCtx.String(flags.SlotTimer.Name); timer {
+	case "", "clock":
+		return blockchain.NewClockSlotTimer(chainConfig), nil
+	case "simulated":
+		slotDuration := cliCtx.Duration(flags.SimulatedSlotDuration.Name)
+		log.WithField("slotDuration", slotDuration).Warn("Running with a simulated slot timer")
+		return blockchain.NewSimulatedSlotTimer(slotDuration)
+	case "manual":
+		log.Warn("Running with a manual slot timer, slots only advance through the debug RPC endpoints")
+		return blockchain.NewManualSlotTimer(chainConfig), nil
+	default:
+		return nil, fmt.Errorf("unknown slot timer %q, expected clock, simulated or manual", timer)
+	}
+}
+
 func (b *BeaconNode) registerPOWChainService() error {
 	if b.cliCtx.Bool(testSkipPowFlag) {
 		return b.services.RegisterService(&powchain.Service{})
@@ -581,6 +746,7 @@ func (b *BeaconNode) registerSyncService() error {
 		ExitPool:            b.exitPool,
 		SlashingPool:        b.slashingsPool,
 		StateGen:            b.stateGen,
+		CommitteeCache:      chainService.CommitteeCache(),
 	})
 
 	return b.services.RegisterService(rs)
@@ -618,6 +784,11 @@ func (b *BeaconNode) registerRPCService() error {
 		return err
 	}
 
+	var regularSyncService *regularsync.Service
+	if err := b.services.FetchService(&regularSyncService); err != nil {
+		return err
+	}
+
 	genesisValidators := b.cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name)
 	genesisStatePath := b.cliCtx.String(flags.InteropGenesisStateFlag.Name)
 	var depositFetcher depositcache.DepositFetcher
@@ -643,6 +814,19 @@ func (b *BeaconNode) registerRPCService() error {
 	mockEth1DataVotes := b.cliCtx.Bool(flags.InteropMockEth1DataVotesFlag.Name)
 	enableDebugRPCEndpoints := b.cliCtx.Bool(flags.EnableDebugRPCEndpoints.Name)
 	maxMsgSize := b.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name)
+	nextEpochGraceSlots := types.Slot(b.cliCtx.Uint64(flags.NextEpochGraceSlots.Name))
+	maxEpochInfoLookback := types.Epoch(b.cliCtx.Uint64(flags.MaxEpochInfoLookback.Name))
+	dutyBroadcastLookahead := types.Epoch(b.cliCtx.Uint64(flags.DutyBroadcastLookahead.Name))
+	manualSlotTimer, _ := chainService.SlotTimer().(*blockchain.ManualSlotTimer)
+	if manualSlotTimer != nil && !enableDebugRPCEndpoints {
+		log.Warn("The manual slot timer can only be driven with --enable-debug-rpc-endpoints")
+	}
+
+	var adminOverridesPath string
+	if b.cliCtx.Bool(flags.EnableAdminRPC.Name) {
+		adminOverridesPath = filepath.Join(b.cliCtx.String(cmd.DataDirFlag.Name), admin.OverridesFileName)
+	}
+
 	p2pService := b.fetchP2P()
 	rpcService := rpc.NewService(b.ctx, &rpc.Config{
 		Host:                    host,
@@ -663,6 +847,7 @@ func (b *BeaconNode) registerRPCService() error {
 		FinalizationFetcher:     chainService,
 		BlockReceiver:           chainService,
 		AttestationReceiver:     chainService,
+		ConfirmationReceiver:    chainService,
 		GenesisTimeFetcher:      chainService,
 		GenesisFetcher:          chainService,
 		AttestationsPool:        b.attestationPool,
@@ -680,6 +865,29 @@ func (b *BeaconNode) registerRPCService() error {
 		StateGen:                b.stateGen,
 		EnableDebugRPCEndpoints: enableDebugRPCEndpoints,
 		MaxMsgSize:              maxMsgSize,
+		DatabasePath:            b.db.DatabasePath(),
+		NextEpochGraceSlots:     nextEpochGraceSlots,
+		MaxEpochInfoLookback:    maxEpochInfoLookback,
+		EpochInfoWorkers:        b.cliCtx.Int(flags.EpochInfoWorkers.Name),
+		DutyBroadcastLookahead:  dutyBroadcastLookahead,
+		PrecomputationFetcher:   chainService,
+		ChainConfig:             b.chainConfig,
+		CanonicalRootFetcher:    chainService,
+		TrackedValidators:       b.trackedValidators,
+		SlotTimeFetcher:         chainService,
+		ManualSlotTimer:         manualSlotTimer,
+		CommitteeCache:          chainService.CommitteeCache(),
+		LivenessCache:           chainService.LivenessCache(),
+		ProposerDigests:         regularSyncService.ProposerDigests(),
+		GenesisServer:           b.genesisServer,
+		APIKeys:                 b.apiKeys,
+		ShutdownDrainPeriod:     b.cliCtx.Duration(flags.RPCShutdownDrainPeriod.Name),
+		MaxStateReplays:         b.cliCtx.Int(flags.RPCMaxStateReplays.Name),
+		PriorityWorkers:         b.cliCtx.Int(flags.RPCPriorityWorkers.Name),
+		SharedWorkers:           b.cliCtx.Int(flags.RPCSharedWorkers.Name),
+		MaxResponseBytes:        b.cliCtx.Uint64(flags.RPCMaxResponseBytes.Name),
+		SlowCallThreshold:       b.cliCtx.Duration(flags.RPCSlowCallThreshold.Name),
+		AdminOverridesPath:      adminOverridesPath,
 	})
 
 	return b.services.RegisterService(rpcService)
@@ -710,6 +918,24 @@ func (b *BeaconNode) registerPrometheusService(cliCtx *cli.Context) error {
 
 	additionalHandlers = append(additionalHandlers, prometheus.Handler{Path: "/tree", Handler: c.TreeHandler})
 
+	var r *rpc.Service
+	if err := b.services.FetchService(&r); err != nil {
+		panic(err)
+	}
+	additionalHandlers = append(additionalHandlers, prometheus.Handler{Path: "/debug/apistats", Handler: r.APIStats().Handler})
+
+	if b.trackedValidators != nil {
+		calendar := &beacon.DutyCalendar{
+			HeadFetcher:        c,
+			GenesisTimeFetcher: c,
+			SlotTimeFetcher:    c,
+			TrackedValidators:  b.trackedValidators,
+			CommitteeCache:     c.CommitteeCache(),
+			ChainConfig:        b.chainConfig,
+		}
+		additionalHandlers = append(additionalHandlers, prometheus.Handler{Path: "/duties/calendar", Handler: calendar.Handler})
+	}
+
 	service := prometheus.NewService(
 		fmt.Sprintf("%s:%d", b.cliCtx.String(cmd.MonitoringHostFlag.Name), b.cliCtx.Int(flags.MonitoringPortFlag.Name)),
 		b.services,
@@ -742,10 +968,57 @@ func (b *BeaconNode) registerGRPCGateway() error {
 			allowedOrigins,
 			enableDebugRPCEndpoints,
 			b.cliCtx.Uint64(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
+			b.apiKeys,
 		),
 	)
 }
 
+func (b *BeaconNode) registerWebhookService() error {
+	path := b.cliCtx.String(flags.DutyWebhookConfig.Name)
+	if path == "" {
+		return nil
+	}
+	hooks, err := webhook.LoadHookConfig(path)
+	if err != nil {
+		return err
+	}
+
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+	svc := webhook.NewService(b.ctx, &webhook.Config{
+		Hooks:             hooks,
+		TrackedValidators: b.trackedValidators,
+		HeadFetcher:       chainService,
+		TimeFetcher:       chainService,
+		StateNotifier:     b,
+		OperationNotifier: b,
+		CommitteeCache:    chainService.CommitteeCache(),
+	})
+	return b.services.RegisterService(svc)
+}
+
+func (b *BeaconNode) registerMonitorService() error {
+	if !b.cliCtx.Bool(flags.EnableValidatorMonitor.Name) {
+		return nil
+	}
+
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+	svc := monitor.NewService(b.ctx, &monitor.Config{
+		TrackedValidators: b.trackedValidators,
+		HeadFetcher:       chainService,
+		TimeFetcher:       chainService,
+		StateNotifier:     b,
+		OperationNotifier: b,
+		CommitteeCache:    chainService.CommitteeCache(),
+	})
+	return b.services.RegisterService(svc)
+}
+
 func (b *BeaconNode) registerInteropServices() error {
 	genesisTime := b.cliCtx.Uint64(flags.InteropGenesisTimeFlag.Name)
 	genesisValidators := b.cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name)
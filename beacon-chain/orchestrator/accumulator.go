@@ -0,0 +1,102 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+// EpochInfoAccumulator is the root of the hash chain of the persisted epoch infos from the anchor
+// epoch up to an epoch. The root of the anchor epoch chains its epoch info root to a zeroed root,
+// and the root of every later epoch chains its epoch info root to the root of the epoch before.
+// Clients replaying a range of epoch infos fold them onto the root of the epoch before the range
+// and compare the result with the root of its last epoch, which detects both altered and missing
+// epoch infos.
+type EpochInfoAccumulator struct {
+	// AnchorEpoch is the first epoch of the chain. Epoch infos before it are not accumulated.
+	AnchorEpoch types.Epoch
+	Epoch       types.Epoch
+	Root        [32]byte
+}
+
+// EpochInfoRoot returns the root an epoch info contributes to the accumulator, the SHA-256
+// of its binary encoding. The reorg and provisional flags tell how the epoch info was delivered
+// rather than what it is, so they are cleared before hashing.
+func EpochInfoRoot(info *EpochInfo) ([32]byte, error) {
+	settled := *info
+	settled.ReorgFlag = false
+	settled.Provisional = false
+	enc, err := settled.MarshalBinary()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return hashutil.Hash(enc), nil
+}
+
+// AccumulateEpochInfo chains the root of an epoch info to the accumulator root of the epoch
+// before it.
+func AccumulateEpochInfo(prev [32]byte, info *EpochInfo) ([32]byte, error) {
+	root, err := EpochInfoRoot(info)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return hashutil.Hash(append(prev[:], root[:]...)), nil
+}
+
+// VerifyEpochInfoRange checks that infos are the epoch infos of consecutive epochs, which fold
+// onto prev, the accumulator root of the epoch before the first one, into want, the accumulator
+// root of the last one. Ranges starting at the anchor epoch fold onto a zeroed root.
+func VerifyEpochInfoRange(prev [32]byte, infos []*EpochInfo, want [32]byte) error {
+	root := prev
+	for i, info := range infos {
+		if i > 0 && info.Epoch != infos[i-1].Epoch+1 {
+			return fmt.Errorf("epoch info range has a gap between epochs %d and %d", infos[i-1].Epoch, info.Epoch)
+		}
+		var err error
+		root, err = AccumulateEpochInfo(root, info)
+		if err != nil {
+			return err
+		}
+	}
+	if root != want {
+		return fmt.Errorf("accumulated root %#x does not match the expected root %#x", root, want)
+	}
+	return nil
+}
+
+// ToProto returns the protobuf representation of the accumulator.
+func (a *EpochInfoAccumulator) ToProto() *pbrpc.EpochInfoAccumulator {
+	root := a.Root
+	return &pbrpc.EpochInfoAccumulator{
+		AnchorEpoch: a.AnchorEpoch,
+		Epoch:       a.Epoch,
+		Root:        root[:],
+	}
+}
+
+// EpochInfoAccumulatorFromProto decodes an accumulator from its protobuf representation.
+func EpochInfoAccumulatorFromProto(msg *pbrpc.EpochInfoAccumulator) (*EpochInfoAccumulator, error) {
+	if len(msg.Root) != 32 {
+		return nil, fmt.Errorf("accumulator root has length %d, wanted 32", len(msg.Root))
+	}
+	acc := &EpochInfoAccumulator{AnchorEpoch: msg.AnchorEpoch, Epoch: msg.Epoch}
+	copy(acc.Root[:], msg.Root)
+	return acc, nil
+}
+
+// GetEpochInfoAccumulator returns the accumulator of the given epoch from a beacon node, or the
+// accumulator of the last accumulated epoch if epoch is nil.
+func GetEpochInfoAccumulator(ctx context.Context, client pbrpc.BeaconQueryClient, epoch *types.Epoch) (*EpochInfoAccumulator, error) {
+	req := &pbrpc.EpochInfoAccumulatorRequest{}
+	if epoch != nil {
+		req.QueryFilter = &pbrpc.EpochInfoAccumulatorRequest_Epoch{Epoch: *epoch}
+	}
+	res, err := client.GetEpochInfoAccumulator(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return EpochInfoAccumulatorFromProto(res)
+}
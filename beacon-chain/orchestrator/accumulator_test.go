@@ -0,0 +1,59 @@
+package orchestrator
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestAccumulateEpochInfo(t *testing.T) {
+	infos := []*EpochInfo{
+		{Epoch: 4, SlotDuration: 6, Proposers: [][48]byte{{'a'}}},
+		{Epoch: 5, SlotDuration: 6, Proposers: [][48]byte{{'b'}}},
+		{Epoch: 6, SlotDuration: 6, Proposers: [][48]byte{{'c'}}},
+	}
+	var roots [][32]byte
+	prev := [32]byte{}
+	for _, info := range infos {
+		root, err := EpochInfoRoot(info)
+		require.NoError(t, err)
+		prev = hashutil.Hash(append(prev[:], root[:]...))
+		roots = append(roots, prev)
+	}
+	acc, err := AccumulateEpochInfo(roots[0], infos[1])
+	require.NoError(t, err)
+	assert.Equal(t, roots[1], acc)
+
+	// The delivery flags do not change the root.
+	flagged := *infos[1]
+	flagged.ReorgFlag = true
+	flagged.Provisional = true
+	acc, err = AccumulateEpochInfo(roots[0], &flagged)
+	require.NoError(t, err)
+	assert.Equal(t, roots[1], acc)
+
+	require.NoError(t, VerifyEpochInfoRange([32]byte{}, infos, roots[2]))
+	require.NoError(t, VerifyEpochInfoRange(roots[0], infos[1:], roots[2]))
+
+	altered := *infos[1]
+	altered.Proposers = [][48]byte{{'x'}}
+	err = VerifyEpochInfoRange(roots[0], []*EpochInfo{&altered, infos[2]}, roots[2])
+	assert.ErrorContains(t, "does not match the expected root", err)
+	err = VerifyEpochInfoRange(roots[0], []*EpochInfo{infos[0], infos[2]}, roots[2])
+	assert.ErrorContains(t, "gap between epochs 4 and 6", err)
+}
+
+func TestEpochInfoAccumulator_ToFromProto(t *testing.T) {
+	want := &EpochInfoAccumulator{AnchorEpoch: 2, Epoch: types.Epoch(1) << 40, Root: [32]byte{'r'}}
+	got, err := EpochInfoAccumulatorFromProto(want.ToProto())
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, got)
+
+	msg := want.ToProto()
+	msg.Root = msg.Root[1:]
+	_, err = EpochInfoAccumulatorFromProto(msg)
+	assert.ErrorContains(t, "accumulator root has length 31", err)
+}
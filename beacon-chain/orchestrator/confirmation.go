@@ -0,0 +1,82 @@
+// Package orchestrator defines the data exchanged between a Vanguard beacon node and
+// the orchestrator which pairs Vanguard blocks with Pandora execution blocks.
+package orchestrator
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+// Status of a Vanguard block as reported by the orchestrator.
+type Status uint8
+
+const (
+	// Pending means the orchestrator has not verified the paired Pandora block yet.
+	Pending Status = iota
+	// Verified means the paired Pandora block was confirmed by the orchestrator.
+	Verified
+	// Invalid means the paired Pandora block was rejected by the orchestrator.
+	Invalid
+)
+
+// confirmationLength is the length of an encoded confirmation: slot, block root, pandora hash and status.
+const confirmationLength = 8 + 32 + 32 + 1
+
+var errInvalidConfirmationLength = errors.New("invalid confirmation length")
+
+// String returns the name of the status.
+func (s Status) String() string {
+	switch s {
+	case Pending:
+		return "pending"
+	case Verified:
+		return "verified"
+	case Invalid:
+		return "invalid"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(s))
+	}
+}
+
+// Confirmation is the verification result of the Pandora block paired with a Vanguard block.
+type Confirmation struct {
+	Slot        types.Slot
+	BlockRoot   [32]byte
+	PandoraHash [32]byte
+	Status      Status
+}
+
+// ConfirmationStore persists and retrieves orchestrator confirmations.
+type ConfirmationStore interface {
+	PandoraConfirmation(ctx context.Context, blockRoot [32]byte) (*Confirmation, error)
+	// PandoraConfirmationByHash returns the confirmation of the block paired with the Pandora
+	// block hash, or nil if there is none.
+	PandoraConfirmationByHash(ctx context.Context, pandoraHash [32]byte) (*Confirmation, error)
+	SavePandoraConfirmations(ctx context.Context, confirmations []*Confirmation) error
+}
+
+// MarshalBinary encodes the confirmation into its fixed size binary representation.
+func (c *Confirmation) MarshalBinary() ([]byte, error) {
+	enc := make([]byte, confirmationLength)
+	binary.LittleEndian.PutUint64(enc[:8], uint64(c.Slot))
+	copy(enc[8:40], c.BlockRoot[:])
+	copy(enc[40:72], c.PandoraHash[:])
+	enc[72] = byte(c.Status)
+	return enc, nil
+}
+
+// UnmarshalBinary decodes a confirmation from its fixed size binary representation.
+func (c *Confirmation) UnmarshalBinary(enc []byte) error {
+	if len(enc) != confirmationLength {
+		return errInvalidConfirmationLength
+	}
+	c.Slot = types.Slot(binary.LittleEndian.Uint64(enc[:8]))
+	copy(c.BlockRoot[:], enc[8:40])
+	copy(c.PandoraHash[:], enc[40:72])
+	c.Status = Status(enc[72])
+	return nil
+}
@@ -0,0 +1,31 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestConfirmation_MarshalUnmarshal(t *testing.T) {
+	c := &Confirmation{
+		Slot:        1234,
+		BlockRoot:   [32]byte{'a'},
+		PandoraHash: [32]byte{'b'},
+		Status:      Invalid,
+	}
+	enc, err := c.MarshalBinary()
+	require.NoError(t, err)
+	decoded := &Confirmation{}
+	require.NoError(t, decoded.UnmarshalBinary(enc))
+	assert.DeepEqual(t, c, decoded)
+}
+
+func TestConfirmation_UnmarshalWrongLength(t *testing.T) {
+	assert.ErrorContains(t, errInvalidConfirmationLength.Error(), (&Confirmation{}).UnmarshalBinary([]byte{1, 2}))
+}
+
+func TestStatus_String(t *testing.T) {
+	assert.Equal(t, "verified", Verified.String())
+	assert.Equal(t, "unknown(7)", Status(7).String())
+}
@@ -0,0 +1,299 @@
+package orchestrator
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+)
+
+// epochInfoHeaderLength is the length of the fixed part of an encoded epoch info: epoch, epoch
+// start time, slot duration, proposer count, fork version, fork digest and flags.
+const epochInfoHeaderLength = 8 + 8 + 8 + 8 + 4 + 4 + 1
+
+// Bits of the flags byte of an encoded epoch info.
+const (
+	reorgFlagBit   = 1 << 0
+	provisionalBit = 1 << 1
+)
+
+var errInvalidEpochInfoLength = errors.New("invalid epoch info length")
+
+// EpochInfo is the consensus information the orchestrator needs to pair the Pandora blocks of an
+// epoch with their Vanguard proposers.
+type EpochInfo struct {
+	Epoch types.Epoch
+	// EpochStartTime is the unix time in seconds of the first slot of the epoch.
+	EpochStartTime uint64
+	// SlotDuration is the duration of a slot in seconds.
+	SlotDuration uint64
+	// Proposers holds an entry for every slot of the epoch, in slot order. The entry of a slot is
+	// the public key of its proposer, or the zeroed SkippedProposer if the slot has no computable
+	// proposer, like the genesis slot.
+	Proposers [][48]byte
+	// ProposerListRoot is the hash tree root of Proposers computed by ProposerListRoot. It is
+	// derived from the proposers when decoding, rather than encoded.
+	ProposerListRoot [32]byte
+	// ForkVersion is the fork version in effect at the epoch.
+	ForkVersion [4]byte
+	// ForkDigest is the digest of ForkVersion and the genesis validators root. Clients signing on
+	// the paired chain compare it against their own to fail fast on a fork mismatch.
+	ForkDigest [4]byte
+	// ReorgFlag is set when the epoch info supersedes an earlier one of the same epoch, because a
+	// reorg changed the state the epoch info was derived from.
+	ReorgFlag bool
+	// Provisional is set when the epoch was not finalized yet, so that a reorg may still change
+	// the epoch info. Once the epoch finalizes, the settled epoch info is sent again without it.
+	Provisional bool
+}
+
+// SkippedProposer is the proposer entry of a slot without a computable proposer. It is not a valid
+// BLS public key, so it cannot collide with the key of a validator.
+var SkippedProposer = [48]byte{}
+
+// Skipped returns true if the slot at the given offset in the epoch has no computable proposer.
+func (e *EpochInfo) Skipped(i int) bool {
+	return e.Proposers[i] == SkippedProposer
+}
+
+// EpochInfoStore persists and retrieves epoch infos, and accumulates the persisted epoch infos.
+type EpochInfoStore interface {
+	EpochInfo(ctx context.Context, epoch types.Epoch) (*EpochInfo, error)
+	SaveEpochInfo(ctx context.Context, info *EpochInfo) error
+	// EpochInfoAccumulator returns the accumulator of the epoch, or nil if the epoch is not
+	// accumulated.
+	EpochInfoAccumulator(ctx context.Context, epoch types.Epoch) (*EpochInfoAccumulator, error)
+	// EpochInfoAccumulatorHead returns the accumulator of the last accumulated epoch, or nil if no
+	// epoch info is accumulated.
+	EpochInfoAccumulatorHead(ctx context.Context) (*EpochInfoAccumulator, error)
+}
+
+// MarshalBinary encodes the epoch info into its binary representation.
+func (e *EpochInfo) MarshalBinary() ([]byte, error) {
+	enc := make([]byte, epochInfoHeaderLength+len(e.Proposers)*48)
+	binary.LittleEndian.PutUint64(enc[:8], uint64(e.Epoch))
+	binary.LittleEndian.PutUint64(enc[8:16], e.EpochStartTime)
+	binary.LittleEndian.PutUint64(enc[16:24], e.SlotDuration)
+	binary.LittleEndian.PutUint64(enc[24:32], uint64(len(e.Proposers)))
+	copy(enc[32:36], e.ForkVersion[:])
+	copy(enc[36:40], e.ForkDigest[:])
+	if e.ReorgFlag {
+		enc[40] |= reorgFlagBit
+	}
+	if e.Provisional {
+		enc[40] |= provisionalBit
+	}
+	for i, pubKey := range e.Proposers {
+		copy(enc[epochInfoHeaderLength+i*48:], pubKey[:])
+	}
+	return enc, nil
+}
+
+// UnmarshalBinary decodes an epoch info from its binary representation.
+func (e *EpochInfo) UnmarshalBinary(enc []byte) error {
+	if len(enc) < epochInfoHeaderLength {
+		return errInvalidEpochInfoLength
+	}
+	count := binary.LittleEndian.Uint64(enc[24:32])
+	if uint64(len(enc)-epochInfoHeaderLength) != count*48 {
+		return errInvalidEpochInfoLength
+	}
+	e.Epoch = types.Epoch(binary.LittleEndian.Uint64(enc[:8]))
+	e.EpochStartTime = binary.LittleEndian.Uint64(enc[8:16])
+	e.SlotDuration = binary.LittleEndian.Uint64(enc[16:24])
+	copy(e.ForkVersion[:], enc[32:36])
+	copy(e.ForkDigest[:], enc[36:40])
+	e.ReorgFlag = enc[40]&reorgFlagBit != 0
+	e.Provisional = enc[40]&provisionalBit != 0
+	e.Proposers = make([][48]byte, count)
+	for i := range e.Proposers {
+		copy(e.Proposers[i][:], enc[epochInfoHeaderLength+i*48:])
+	}
+	root, err := ProposerListRoot(e.Proposers)
+	if err != nil {
+		return err
+	}
+	e.ProposerListRoot = root
+	return nil
+}
+
+// ToProto returns the protobuf representation of the epoch info, as sent over the epoch info
+// stream. The proposer list root is computed from the proposers.
+func (e *EpochInfo) ToProto() (*pbrpc.EpochInfo, error) {
+	proposers := make([][]byte, len(e.Proposers))
+	for i := range e.Proposers {
+		proposers[i] = e.Proposers[i][:]
+	}
+	root, err := ProposerListRoot(e.Proposers)
+	if err != nil {
+		return nil, err
+	}
+	version, digest := e.ForkVersion, e.ForkDigest
+	return &pbrpc.EpochInfo{
+		Epoch:            e.Epoch,
+		EpochStartTime:   e.EpochStartTime,
+		SlotDuration:     e.SlotDuration,
+		Proposers:        proposers,
+		ProposerListRoot: root[:],
+		ForkVersion:      version[:],
+		ForkDigest:       digest[:],
+		ReorgFlag:        e.ReorgFlag,
+		Provisional:      e.Provisional,
+	}, nil
+}
+
+// EpochInfoFromProto decodes an epoch info from its protobuf representation. The proposer list
+// root is checked against the proposers, so that a mismatch is caught on decoding.
+func EpochInfoFromProto(msg *pbrpc.EpochInfo) (*EpochInfo, error) {
+	if len(msg.ForkVersion) != 4 || len(msg.ForkDigest) != 4 {
+		return nil, errInvalidEpochInfoLength
+	}
+	info := &EpochInfo{
+		Epoch:          msg.Epoch,
+		EpochStartTime: msg.EpochStartTime,
+		SlotDuration:   msg.SlotDuration,
+		Proposers:      make([][48]byte, len(msg.Proposers)),
+		ReorgFlag:      msg.ReorgFlag,
+		Provisional:    msg.Provisional,
+	}
+	for i, pubKey := range msg.Proposers {
+		if len(pubKey) != 48 {
+			return nil, fmt.Errorf("proposer %d has length %d, wanted 48", i, len(pubKey))
+		}
+		copy(info.Proposers[i][:], pubKey)
+	}
+	copy(info.ForkVersion[:], msg.ForkVersion)
+	copy(info.ForkDigest[:], msg.ForkDigest)
+	root, err := ProposerListRoot(info.Proposers)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(msg.ProposerListRoot, root[:]) {
+		return nil, fmt.Errorf("proposer list root %#x does not match proposers with root %#x", msg.ProposerListRoot, root)
+	}
+	info.ProposerListRoot = root
+	return info, nil
+}
+
+// epochInfoJSON is the canonical JSON representation of an epoch info. Byte strings are 0x
+// prefixed hex and times are in seconds.
+type epochInfoJSON struct {
+	Epoch            types.Epoch `json:"epoch"`
+	EpochStartTime   uint64      `json:"epoch_start_time"`
+	SlotDuration     uint64      `json:"slot_duration"`
+	Proposers        []string    `json:"proposers"`
+	Skipped          []bool      `json:"skipped"`
+	ProposerListRoot string      `json:"proposer_list_root"`
+	ForkVersion      string      `json:"fork_version"`
+	ForkDigest       string      `json:"fork_digest"`
+	ReorgFlag        bool        `json:"reorg"`
+	Provisional      bool        `json:"provisional"`
+}
+
+// MarshalJSON encodes the epoch info into its canonical JSON representation. The proposer list
+// root is computed from the proposers, and every slot is explicitly marked as skipped or not.
+func (e *EpochInfo) MarshalJSON() ([]byte, error) {
+	proposers := make([]string, len(e.Proposers))
+	skipped := make([]bool, len(e.Proposers))
+	for i, pubKey := range e.Proposers {
+		proposers[i] = fmt.Sprintf("%#x", pubKey)
+		skipped[i] = e.Skipped(i)
+	}
+	root, err := ProposerListRoot(e.Proposers)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&epochInfoJSON{
+		Epoch:            e.Epoch,
+		EpochStartTime:   e.EpochStartTime,
+		SlotDuration:     e.SlotDuration,
+		Proposers:        proposers,
+		Skipped:          skipped,
+		ProposerListRoot: fmt.Sprintf("%#x", root),
+		ForkVersion:      fmt.Sprintf("%#x", e.ForkVersion),
+		ForkDigest:       fmt.Sprintf("%#x", e.ForkDigest),
+		ReorgFlag:        e.ReorgFlag,
+		Provisional:      e.Provisional,
+	})
+}
+
+// UnmarshalJSON decodes an epoch info from its canonical JSON representation.
+func (e *EpochInfo) UnmarshalJSON(enc []byte) error {
+	var dec epochInfoJSON
+	if err := json.Unmarshal(enc, &dec); err != nil {
+		return err
+	}
+	proposers := make([][48]byte, len(dec.Proposers))
+	for i, pubKey := range dec.Proposers {
+		if err := decodeHex(pubKey, proposers[i][:]); err != nil {
+			return fmt.Errorf("invalid proposer %d: %v", i, err)
+		}
+	}
+	// The skipped markers are optional, but must agree with the proposers when present.
+	if dec.Skipped != nil {
+		if len(dec.Skipped) != len(proposers) {
+			return fmt.Errorf("got %d skipped markers for %d proposers", len(dec.Skipped), len(proposers))
+		}
+		for i, skipped := range dec.Skipped {
+			if skipped != (proposers[i] == SkippedProposer) {
+				return fmt.Errorf("skipped marker of proposer %d does not match its public key", i)
+			}
+		}
+	}
+	root, err := ProposerListRoot(proposers)
+	if err != nil {
+		return err
+	}
+	// The root is checked against the proposers, so that a mismatch is caught on decoding.
+	if dec.ProposerListRoot != "" {
+		var decRoot [32]byte
+		if err := decodeHex(dec.ProposerListRoot, decRoot[:]); err != nil {
+			return fmt.Errorf("invalid proposer list root: %v", err)
+		}
+		if decRoot != root {
+			return fmt.Errorf("proposer list root %#x does not match proposers with root %#x", decRoot, root)
+		}
+	}
+	var version, digest [4]byte
+	if err := decodeHex(dec.ForkVersion, version[:]); err != nil {
+		return fmt.Errorf("invalid fork version: %v", err)
+	}
+	if err := decodeHex(dec.ForkDigest, digest[:]); err != nil {
+		return fmt.Errorf("invalid fork digest: %v", err)
+	}
+	*e = EpochInfo{
+		Epoch:            dec.Epoch,
+		EpochStartTime:   dec.EpochStartTime,
+		SlotDuration:     dec.SlotDuration,
+		Proposers:        proposers,
+		ProposerListRoot: root,
+		ForkVersion:      version,
+		ForkDigest:       digest,
+		ReorgFlag:        dec.ReorgFlag,
+		Provisional:      dec.Provisional,
+	}
+	return nil
+}
+
+// decodeHex decodes a 0x prefixed hex string which must fill dst exactly.
+func decodeHex(s string, dst []byte) error {
+	if !strings.HasPrefix(s, "0x") {
+		return fmt.Errorf("%q is not 0x prefixed", s)
+	}
+	b, err := hex.DecodeString(s[2:])
+	if err != nil {
+		return err
+	}
+	if len(b) != len(dst) {
+		return fmt.Errorf("%q has length %d, wanted %d", s, len(b), len(dst))
+	}
+	copy(dst, b)
+	return nil
+}
@@ -0,0 +1,117 @@
+package orchestrator
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestEpochInfo_MarshalUnmarshal(t *testing.T) {
+	e := &EpochInfo{
+		Epoch:          12,
+		EpochStartTime: 1600000000,
+		SlotDuration:   6,
+		Proposers:      [][48]byte{{'a'}, {'b'}, {'c'}},
+		ForkVersion:    [4]byte{0, 0, 0, 1},
+		ForkDigest:     [4]byte{0xde, 0xad, 0xbe, 0xef},
+		ReorgFlag:      true,
+		Provisional:    true,
+	}
+	enc, err := e.MarshalBinary()
+	require.NoError(t, err)
+	// The proposer list root is derived when decoding.
+	e.ProposerListRoot, err = ProposerListRoot(e.Proposers)
+	require.NoError(t, err)
+	decoded := &EpochInfo{}
+	require.NoError(t, decoded.UnmarshalBinary(enc))
+	assert.DeepEqual(t, e, decoded)
+
+	// The flags are independent bits, so a settled epoch info only differs in the flags byte.
+	e.Provisional = false
+	settled, err := e.MarshalBinary()
+	require.NoError(t, err)
+	assert.DeepEqual(t, enc[:40], settled[:40])
+	assert.Equal(t, byte(reorgFlagBit), settled[40])
+	decoded = &EpochInfo{}
+	require.NoError(t, decoded.UnmarshalBinary(settled))
+	assert.Equal(t, false, decoded.Provisional)
+	assert.Equal(t, true, decoded.ReorgFlag)
+}
+
+func TestEpochInfo_ToFromProto(t *testing.T) {
+	e := &EpochInfo{
+		Epoch:          12,
+		EpochStartTime: 1600000000,
+		SlotDuration:   6,
+		Proposers:      [][48]byte{{'a'}, {'b'}, {'c'}},
+		ForkVersion:    [4]byte{0, 0, 0, 1},
+		ForkDigest:     [4]byte{0xde, 0xad, 0xbe, 0xef},
+		Provisional:    true,
+	}
+	msg, err := e.ToProto()
+	require.NoError(t, err)
+	decoded, err := EpochInfoFromProto(msg)
+	require.NoError(t, err)
+	e.ProposerListRoot, err = ProposerListRoot(e.Proposers)
+	require.NoError(t, err)
+	assert.DeepEqual(t, e, decoded)
+
+	msg.ProposerListRoot = make([]byte, 32)
+	_, err = EpochInfoFromProto(msg)
+	assert.ErrorContains(t, "does not match proposers", err)
+
+	msg.Proposers[0] = msg.Proposers[0][1:]
+	_, err = EpochInfoFromProto(msg)
+	assert.ErrorContains(t, "proposer 0 has length 47", err)
+}
+
+func TestEpochInfo_UnmarshalWrongLength(t *testing.T) {
+	enc, err := (&EpochInfo{Proposers: [][48]byte{{'a'}}}).MarshalBinary()
+	require.NoError(t, err)
+	assert.ErrorContains(t, errInvalidEpochInfoLength.Error(), (&EpochInfo{}).UnmarshalBinary(enc[:len(enc)-1]))
+	assert.ErrorContains(t, errInvalidEpochInfoLength.Error(), (&EpochInfo{}).UnmarshalBinary([]byte{1, 2}))
+}
+
+func TestEpochInfo_JSON(t *testing.T) {
+	e := &EpochInfo{
+		Epoch:          12,
+		EpochStartTime: 1600000000,
+		SlotDuration:   6,
+		Proposers:      [][48]byte{{'a'}, {}},
+		ForkVersion:    [4]byte{0, 0, 0, 1},
+		ForkDigest:     [4]byte{0xde, 0xad, 0xbe, 0xef},
+		ReorgFlag:      true,
+	}
+	enc, err := json.Marshal(e)
+	require.NoError(t, err)
+	e.ProposerListRoot, err = ProposerListRoot(e.Proposers)
+	require.NoError(t, err)
+	wanted := `{"epoch":12,"epoch_start_time":1600000000,"slot_duration":6,"proposers":["0x61` +
+		strings.Repeat("00", 47) + `","0x` + strings.Repeat("00", 48) +
+		`"],"skipped":[false,true],"proposer_list_root":"` + fmt.Sprintf("%#x", e.ProposerListRoot) +
+		`","fork_version":"0x00000001","fork_digest":"0xdeadbeef","reorg":true,"provisional":false}`
+	assert.Equal(t, wanted, string(enc))
+
+	decoded := &EpochInfo{}
+	require.NoError(t, json.Unmarshal(enc, decoded))
+	assert.DeepEqual(t, e, decoded)
+
+	// A proposer list root which does not match the proposers is rejected.
+	tampered := strings.Replace(string(enc), `"0x61`, `"0x62`, 1)
+	assert.ErrorContains(t, "does not match proposers", json.Unmarshal([]byte(tampered), decoded))
+
+	// The skipped markers must agree with the proposers.
+	tampered = strings.Replace(string(enc), `[false,true]`, `[false,false]`, 1)
+	assert.ErrorContains(t, "skipped marker of proposer 1", json.Unmarshal([]byte(tampered), decoded))
+	tampered = strings.Replace(string(enc), `[false,true]`, `[false]`, 1)
+	assert.ErrorContains(t, "got 1 skipped markers for 2 proposers", json.Unmarshal([]byte(tampered), decoded))
+
+	err = json.Unmarshal([]byte(`{"proposers":["0x61"],"fork_version":"0x00000001","fork_digest":"0xdeadbeef"}`), decoded)
+	assert.ErrorContains(t, "invalid proposer 0", err)
+	err = json.Unmarshal([]byte(`{"fork_version":"00000001","fork_digest":"0xdeadbeef"}`), decoded)
+	assert.ErrorContains(t, "invalid fork version", err)
+}
@@ -0,0 +1,98 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"google.golang.org/grpc/metadata"
+)
+
+// EpochShardMetadataKey is the gRPC request metadata key restricting the epoch info and duty
+// streams to the epochs of a shard, formatted as "remainder/modulus" as by EpochShard.String. It
+// lets several orchestrator workers split the consumption of the streams between them.
+const EpochShardMetadataKey = "x-epoch-shard"
+
+// EpochShard selects the epochs whose remainder of the division by Modulus equals Remainder.
+type EpochShard struct {
+	Modulus   uint64
+	Remainder uint64
+}
+
+// Includes returns true if the epoch belongs to the shard. A nil shard includes every epoch.
+func (s *EpochShard) Includes(epoch types.Epoch) bool {
+	if s == nil {
+		return true
+	}
+	return uint64(epoch)%s.Modulus == s.Remainder
+}
+
+// String returns the shard formatted as "remainder/modulus". A nil shard is formatted as the
+// equivalent "0/1".
+func (s *EpochShard) String() string {
+	if s == nil {
+		return "0/1"
+	}
+	return fmt.Sprintf("%d/%d", s.Remainder, s.Modulus)
+}
+
+// validate returns an error if the shard includes no epoch.
+func (s *EpochShard) validate() error {
+	if s.Modulus == 0 {
+		return errors.New("epoch shard modulus must be positive")
+	}
+	if s.Remainder >= s.Modulus {
+		return fmt.Errorf("epoch shard remainder %d must be lower than its modulus %d", s.Remainder, s.Modulus)
+	}
+	return nil
+}
+
+// ParseEpochShard parses a shard formatted as "remainder/modulus".
+func ParseEpochShard(v string) (*EpochShard, error) {
+	parts := strings.Split(v, "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("epoch shard %q is not formatted as remainder/modulus", v)
+	}
+	remainder, err := strconv.ParseUint(strings.TrimSpace(parts[0]), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid epoch shard remainder %q: %v", parts[0], err)
+	}
+	modulus, err := strconv.ParseUint(strings.TrimSpace(parts[1]), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid epoch shard modulus %q: %v", parts[1], err)
+	}
+	shard := &EpochShard{Modulus: modulus, Remainder: remainder}
+	if err := shard.validate(); err != nil {
+		return nil, err
+	}
+	return shard, nil
+}
+
+// WithEpochShard returns a context whose outgoing calls ask for the epochs of the shard only.
+func WithEpochShard(ctx context.Context, shard *EpochShard) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, EpochShardMetadataKey, shard.String())
+}
+
+// RequestedEpochShard returns the shard a stream is restricted to, either because the request
+// carries one or because the EpochShardMetadataKey is set on the incoming call. It returns nil if
+// the stream is not restricted.
+func RequestedEpochShard(ctx context.Context, requested *EpochShard) (*EpochShard, error) {
+	if requested != nil {
+		if err := requested.validate(); err != nil {
+			return nil, err
+		}
+		return requested, nil
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	values := md.Get(EpochShardMetadataKey)
+	if len(values) == 0 {
+		return nil, nil
+	}
+	return ParseEpochShard(values[0])
+}
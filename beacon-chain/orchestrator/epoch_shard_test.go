@@ -0,0 +1,60 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestEpochShard_Includes(t *testing.T) {
+	shard := &EpochShard{Modulus: 4, Remainder: 3}
+	var included []types.Epoch
+	for epoch := types.Epoch(0); epoch < 12; epoch++ {
+		if shard.Includes(epoch) {
+			included = append(included, epoch)
+		}
+	}
+	assert.DeepEqual(t, []types.Epoch{3, 7, 11}, included)
+
+	var all *EpochShard
+	assert.Equal(t, true, all.Includes(5))
+	assert.Equal(t, "0/1", all.String())
+}
+
+func TestParseEpochShard(t *testing.T) {
+	shard, err := ParseEpochShard("2/5")
+	require.NoError(t, err)
+	assert.DeepEqual(t, &EpochShard{Modulus: 5, Remainder: 2}, shard)
+	assert.Equal(t, "2/5", shard.String())
+
+	for _, v := range []string{"", "2", "2/5/1", "a/5", "2/b", "1/0", "5/5"} {
+		_, err := ParseEpochShard(v)
+		assert.NotNil(t, err, "Expected %q to be rejected", v)
+	}
+}
+
+func TestRequestedEpochShard(t *testing.T) {
+	ctx := context.Background()
+	shard, err := RequestedEpochShard(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, true, shard == nil)
+
+	// The shard set by a client travels as request metadata.
+	md, ok := metadata.FromOutgoingContext(WithEpochShard(ctx, &EpochShard{Modulus: 3, Remainder: 1}))
+	require.Equal(t, true, ok)
+	incoming := metadata.NewIncomingContext(ctx, md)
+	shard, err = RequestedEpochShard(incoming, nil)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &EpochShard{Modulus: 3, Remainder: 1}, shard)
+
+	// The shard of the request takes precedence over the metadata, and is validated alike.
+	shard, err = RequestedEpochShard(incoming, &EpochShard{Modulus: 2, Remainder: 0})
+	require.NoError(t, err)
+	assert.DeepEqual(t, &EpochShard{Modulus: 2, Remainder: 0}, shard)
+	_, err = RequestedEpochShard(incoming, &EpochShard{Modulus: 0})
+	assert.ErrorContains(t, "modulus must be positive", err)
+}
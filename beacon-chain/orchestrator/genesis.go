@@ -0,0 +1,141 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+)
+
+// GenesisSpec holds the parameters of a network the beacon node derives its genesis state from,
+// so that networks can be spun up without distributing genesis state files.
+type GenesisSpec struct {
+	// GenesisTime is the unix time in seconds of the genesis slot.
+	GenesisTime uint64
+	// Eth1BlockHash seeds the randao mixes of the genesis state. Networks sharing every other
+	// parameter are told apart by it.
+	Eth1BlockHash [32]byte
+	// ForkSchedule lists the fork versions of the network by ascending epoch, starting with the
+	// genesis fork version at epoch 0.
+	ForkSchedule []*ForkScheduleEntry
+	// Validators is the genesis validator roster, in validator index order.
+	Validators []*GenesisValidator
+}
+
+// ForkScheduleEntry is the fork version a network switches to at an epoch.
+type ForkScheduleEntry struct {
+	Epoch   types.Epoch
+	Version [4]byte
+}
+
+// GenesisValidator is a validator of the genesis roster. Validators with the maximum effective
+// balance are active from genesis.
+type GenesisValidator struct {
+	PublicKey             [48]byte
+	WithdrawalCredentials [32]byte
+	// Balance in Gwei.
+	Balance uint64
+}
+
+// GenesisReceipt identifies the genesis a beacon node persisted from a genesis spec, so that the
+// orchestrator can check that the nodes of a network agree on it.
+type GenesisReceipt struct {
+	StateRoot      [32]byte
+	BlockRoot      [32]byte
+	ValidatorsRoot [32]byte
+}
+
+// ToProto returns the protobuf representation of the genesis spec.
+func (s *GenesisSpec) ToProto() *pbrpc.GenesisSpec {
+	eth1BlockHash := s.Eth1BlockHash
+	msg := &pbrpc.GenesisSpec{
+		GenesisTime:   s.GenesisTime,
+		Eth1BlockHash: eth1BlockHash[:],
+		ForkSchedule:  make([]*pbrpc.GenesisFork, len(s.ForkSchedule)),
+		Validators:    make([]*pbrpc.GenesisValidator, len(s.Validators)),
+	}
+	for i, fork := range s.ForkSchedule {
+		version := fork.Version
+		msg.ForkSchedule[i] = &pbrpc.GenesisFork{Epoch: fork.Epoch, Version: version[:]}
+	}
+	for i, val := range s.Validators {
+		pubKey, withdrawalCredentials := val.PublicKey, val.WithdrawalCredentials
+		msg.Validators[i] = &pbrpc.GenesisValidator{
+			PublicKey:             pubKey[:],
+			WithdrawalCredentials: withdrawalCredentials[:],
+			Balance:               val.Balance,
+		}
+	}
+	return msg
+}
+
+// GenesisSpecFromProto decodes a genesis spec from its protobuf representation.
+func GenesisSpecFromProto(msg *pbrpc.GenesisSpec) (*GenesisSpec, error) {
+	if len(msg.Eth1BlockHash) != 32 {
+		return nil, fmt.Errorf("eth1 block hash has length %d, wanted 32", len(msg.Eth1BlockHash))
+	}
+	spec := &GenesisSpec{
+		GenesisTime:  msg.GenesisTime,
+		ForkSchedule: make([]*ForkScheduleEntry, len(msg.ForkSchedule)),
+		Validators:   make([]*GenesisValidator, len(msg.Validators)),
+	}
+	copy(spec.Eth1BlockHash[:], msg.Eth1BlockHash)
+	for i, fork := range msg.ForkSchedule {
+		if len(fork.Version) != 4 {
+			return nil, fmt.Errorf("fork %d version has length %d, wanted 4", i, len(fork.Version))
+		}
+		entry := &ForkScheduleEntry{Epoch: fork.Epoch}
+		copy(entry.Version[:], fork.Version)
+		spec.ForkSchedule[i] = entry
+	}
+	for i, val := range msg.Validators {
+		if len(val.PublicKey) != 48 {
+			return nil, fmt.Errorf("validator %d public key has length %d, wanted 48", i, len(val.PublicKey))
+		}
+		if len(val.WithdrawalCredentials) != 32 {
+			return nil, fmt.Errorf("validator %d withdrawal credentials have length %d, wanted 32", i, len(val.WithdrawalCredentials))
+		}
+		v := &GenesisValidator{Balance: val.Balance}
+		copy(v.PublicKey[:], val.PublicKey)
+		copy(v.WithdrawalCredentials[:], val.WithdrawalCredentials)
+		spec.Validators[i] = v
+	}
+	return spec, nil
+}
+
+// ToProto returns the protobuf representation of the genesis receipt.
+func (r *GenesisReceipt) ToProto() *pbrpc.GenesisReceipt {
+	stateRoot, blockRoot, validatorsRoot := r.StateRoot, r.BlockRoot, r.ValidatorsRoot
+	return &pbrpc.GenesisReceipt{
+		StateRoot:      stateRoot[:],
+		BlockRoot:      blockRoot[:],
+		ValidatorsRoot: validatorsRoot[:],
+	}
+}
+
+// GenesisReceiptFromProto decodes a genesis receipt from its protobuf representation.
+func GenesisReceiptFromProto(msg *pbrpc.GenesisReceipt) (*GenesisReceipt, error) {
+	if len(msg.StateRoot) != 32 || len(msg.BlockRoot) != 32 || len(msg.ValidatorsRoot) != 32 {
+		return nil, fmt.Errorf(
+			"genesis receipt roots have lengths %d, %d and %d, wanted 32",
+			len(msg.StateRoot), len(msg.BlockRoot), len(msg.ValidatorsRoot),
+		)
+	}
+	receipt := &GenesisReceipt{}
+	copy(receipt.StateRoot[:], msg.StateRoot)
+	copy(receipt.BlockRoot[:], msg.BlockRoot)
+	copy(receipt.ValidatorsRoot[:], msg.ValidatorsRoot)
+	return receipt, nil
+}
+
+// SubmitGenesis submits a genesis spec to the genesis service of a beacon node, and returns the
+// receipt of the genesis the node persisted. Submitting the spec of the genesis a node persisted
+// already returns the same receipt again, so that submissions can be retried.
+func SubmitGenesis(ctx context.Context, client pbrpc.GenesisClient, spec *GenesisSpec) (*GenesisReceipt, error) {
+	res, err := client.SubmitGenesis(ctx, spec.ToProto())
+	if err != nil {
+		return nil, err
+	}
+	return GenesisReceiptFromProto(res)
+}
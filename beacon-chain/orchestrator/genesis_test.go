@@ -0,0 +1,55 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestGenesisSpec_ToFromProto(t *testing.T) {
+	want := &GenesisSpec{
+		GenesisTime:   1620000000,
+		Eth1BlockHash: [32]byte{'h'},
+		ForkSchedule: []*ForkScheduleEntry{
+			{Epoch: 0, Version: [4]byte{0, 0, 0, 1}},
+			{Epoch: 100, Version: [4]byte{1, 0, 0, 1}},
+		},
+		Validators: []*GenesisValidator{
+			{PublicKey: [48]byte{'a'}, WithdrawalCredentials: [32]byte{0}, Balance: 32000000000},
+			{PublicKey: [48]byte{'b'}, WithdrawalCredentials: [32]byte{1}, Balance: 16000000000},
+		},
+	}
+	got, err := GenesisSpecFromProto(want.ToProto())
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, got)
+
+	msg := want.ToProto()
+	msg.Eth1BlockHash = msg.Eth1BlockHash[1:]
+	_, err = GenesisSpecFromProto(msg)
+	assert.ErrorContains(t, "eth1 block hash has length 31", err)
+	msg = want.ToProto()
+	msg.ForkSchedule[1].Version = msg.ForkSchedule[1].Version[1:]
+	_, err = GenesisSpecFromProto(msg)
+	assert.ErrorContains(t, "fork 1 version has length 3", err)
+	msg = want.ToProto()
+	msg.Validators[1].PublicKey = msg.Validators[1].PublicKey[1:]
+	_, err = GenesisSpecFromProto(msg)
+	assert.ErrorContains(t, "validator 1 public key has length 47", err)
+	msg = want.ToProto()
+	msg.Validators[0].WithdrawalCredentials = nil
+	_, err = GenesisSpecFromProto(msg)
+	assert.ErrorContains(t, "validator 0 withdrawal credentials have length 0", err)
+}
+
+func TestGenesisReceipt_ToFromProto(t *testing.T) {
+	want := &GenesisReceipt{StateRoot: [32]byte{'s'}, BlockRoot: [32]byte{'b'}, ValidatorsRoot: [32]byte{'v'}}
+	got, err := GenesisReceiptFromProto(want.ToProto())
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, got)
+
+	msg := want.ToProto()
+	msg.BlockRoot = msg.BlockRoot[1:]
+	_, err = GenesisReceiptFromProto(msg)
+	assert.ErrorContains(t, "genesis receipt roots have lengths 32, 31 and 32", err)
+}
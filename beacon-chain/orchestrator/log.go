@@ -0,0 +1,41 @@
+package orchestrator
+
+import (
+	"sync/atomic"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Log is the logger of the orchestrator code paths, which serve proposer lists to the
+// orchestrator and receive its confirmations of Pandora blocks. Entries carry the epoch, the
+// requester, the duration and the cache status as fields rather than in their message.
+var Log = logrus.WithField("prefix", "orchestrator")
+
+// verbose is 1 if the debug entries of the orchestrator code paths are logged at info level.
+var verbose int32
+
+// SetVerboseLogging toggles verbose logging of the orchestrator code paths. It can be called at
+// any time.
+func SetVerboseLogging(enabled bool) {
+	var v int32
+	if enabled {
+		v = 1
+	}
+	atomic.StoreInt32(&verbose, v)
+}
+
+// VerboseLogging reports whether verbose logging of the orchestrator code paths is enabled.
+func VerboseLogging() bool {
+	return atomic.LoadInt32(&verbose) == 1
+}
+
+// Debug logs an entry of the orchestrator code paths at debug level, or at info level while
+// verbose logging is enabled, so that the orchestrator paths can be traced without lowering the
+// verbosity of the whole node.
+func Debug(entry *logrus.Entry, msg string) {
+	if VerboseLogging() {
+		entry.Info(msg)
+		return
+	}
+	entry.Debug(msg)
+}
@@ -0,0 +1,26 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/sirupsen/logrus"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestDebug_VerboseLogging(t *testing.T) {
+	hook := logTest.NewGlobal()
+	defer SetVerboseLogging(false)
+
+	Debug(Log.WithField("epoch", 3), "Served epoch info")
+	require.LogsDoNotContain(t, hook, "Served epoch info")
+
+	SetVerboseLogging(true)
+	assert.Equal(t, true, VerboseLogging())
+	Debug(Log.WithField("epoch", 3), "Served epoch info")
+	require.LogsContain(t, hook, "Served epoch info")
+	assert.Equal(t, logrus.InfoLevel, hook.LastEntry().Level)
+	assert.Equal(t, 3, hook.LastEntry().Data["epoch"])
+	assert.Equal(t, "orchestrator", hook.LastEntry().Data["prefix"])
+}
@@ -0,0 +1,217 @@
+package orchestrator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+)
+
+// SlotProposer is the proposer of a slot.
+type SlotProposer struct {
+	Slot types.Slot
+	// PublicKey of the proposer, or the zeroed SkippedProposer if the slot has no computable
+	// proposer, like the genesis slot.
+	PublicKey [48]byte
+}
+
+// ProposerList holds the proposer of every slot of an epoch, in slot order and keyed by explicit
+// slot numbers, so that the orchestrator can look up the proposer of a Pandora block by its slot
+// instead of inverting the proposer slots of per validator assignments.
+type ProposerList struct {
+	Epoch     types.Epoch
+	Proposers []*SlotProposer
+	// ProposerListRoot is the hash tree root of the public keys of the proposers, computed by
+	// ProposerListRoot as for the epoch info of the epoch. It is checked against the proposers when
+	// decoding.
+	ProposerListRoot [32]byte
+	// Provisional is set when the epoch was not finalized yet, so that a reorg may still change
+	// the proposer list.
+	Provisional bool
+}
+
+// ProposerList returns the proposers of the epoch info keyed by their slot.
+func (e *EpochInfo) ProposerList() (*ProposerList, error) {
+	startSlot, err := helpers.StartSlot(e.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	proposers := make([]*SlotProposer, len(e.Proposers))
+	for i, pubKey := range e.Proposers {
+		proposers[i] = &SlotProposer{Slot: startSlot + types.Slot(i), PublicKey: pubKey}
+	}
+	root, err := ProposerListRoot(e.Proposers)
+	if err != nil {
+		return nil, err
+	}
+	return &ProposerList{
+		Epoch:            e.Epoch,
+		Proposers:        proposers,
+		ProposerListRoot: root,
+		Provisional:      e.Provisional,
+	}, nil
+}
+
+// Skipped returns true if the slot at the given offset in the epoch has no computable proposer.
+func (l *ProposerList) Skipped(i int) bool {
+	return l.Proposers[i].PublicKey == SkippedProposer
+}
+
+// verify checks that the proposers are the consecutive slots of the epoch from its start slot,
+// and returns the root of their public keys.
+func (l *ProposerList) verify() ([32]byte, error) {
+	startSlot, err := helpers.StartSlot(l.Epoch)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	pubKeys := make([][48]byte, len(l.Proposers))
+	for i, p := range l.Proposers {
+		if p.Slot != startSlot+types.Slot(i) {
+			return [32]byte{}, fmt.Errorf("proposer %d has slot %d, wanted slot %d", i, p.Slot, startSlot+types.Slot(i))
+		}
+		pubKeys[i] = p.PublicKey
+	}
+	return ProposerListRoot(pubKeys)
+}
+
+// ToProto returns the protobuf representation of the proposer list.
+func (l *ProposerList) ToProto() *pbrpc.ProposerList {
+	proposers := make([]*pbrpc.SlotProposer, len(l.Proposers))
+	for i, p := range l.Proposers {
+		pubKey := p.PublicKey
+		proposers[i] = &pbrpc.SlotProposer{Slot: p.Slot, PublicKey: pubKey[:]}
+	}
+	root := l.ProposerListRoot
+	return &pbrpc.ProposerList{
+		Epoch:            l.Epoch,
+		Proposers:        proposers,
+		ProposerListRoot: root[:],
+		Provisional:      l.Provisional,
+	}
+}
+
+// ProposerListFromProto decodes a proposer list from its protobuf representation. The proposers
+// must be the consecutive slots of the epoch, and the proposer list root is checked against them.
+func ProposerListFromProto(msg *pbrpc.ProposerList) (*ProposerList, error) {
+	list := &ProposerList{
+		Epoch:       msg.Epoch,
+		Proposers:   make([]*SlotProposer, len(msg.Proposers)),
+		Provisional: msg.Provisional,
+	}
+	for i, p := range msg.Proposers {
+		if len(p.PublicKey) != 48 {
+			return nil, fmt.Errorf("proposer %d has length %d, wanted 48", i, len(p.PublicKey))
+		}
+		list.Proposers[i] = &SlotProposer{Slot: p.Slot}
+		copy(list.Proposers[i].PublicKey[:], p.PublicKey)
+	}
+	root, err := list.verify()
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(msg.ProposerListRoot, root[:]) {
+		return nil, fmt.Errorf("proposer list root %#x does not match proposers with root %#x", msg.ProposerListRoot, root)
+	}
+	list.ProposerListRoot = root
+	return list, nil
+}
+
+// slotProposerJSON is the canonical JSON representation of a slot proposer.
+type slotProposerJSON struct {
+	Slot      types.Slot `json:"slot"`
+	PublicKey string     `json:"public_key"`
+	Skipped   bool       `json:"skipped"`
+}
+
+// proposerListJSON is the canonical JSON representation of a proposer list. Byte strings are 0x
+// prefixed hex.
+type proposerListJSON struct {
+	Epoch            types.Epoch         `json:"epoch"`
+	Proposers        []*slotProposerJSON `json:"proposers"`
+	ProposerListRoot string              `json:"proposer_list_root"`
+	Provisional      bool                `json:"provisional"`
+}
+
+// MarshalJSON encodes the proposer list into its canonical JSON representation, an array with an
+// entry per slot. The proposer list root is computed from the proposers, and every slot is
+// explicitly marked as skipped or not.
+func (l *ProposerList) MarshalJSON() ([]byte, error) {
+	root, err := l.verify()
+	if err != nil {
+		return nil, err
+	}
+	proposers := make([]*slotProposerJSON, len(l.Proposers))
+	for i, p := range l.Proposers {
+		proposers[i] = &slotProposerJSON{
+			Slot:      p.Slot,
+			PublicKey: fmt.Sprintf("%#x", p.PublicKey),
+			Skipped:   l.Skipped(i),
+		}
+	}
+	return json.Marshal(&proposerListJSON{
+		Epoch:            l.Epoch,
+		Proposers:        proposers,
+		ProposerListRoot: fmt.Sprintf("%#x", root),
+		Provisional:      l.Provisional,
+	})
+}
+
+// UnmarshalJSON decodes a proposer list from its canonical JSON representation.
+func (l *ProposerList) UnmarshalJSON(enc []byte) error {
+	var dec proposerListJSON
+	if err := json.Unmarshal(enc, &dec); err != nil {
+		return err
+	}
+	list := &ProposerList{
+		Epoch:       dec.Epoch,
+		Proposers:   make([]*SlotProposer, len(dec.Proposers)),
+		Provisional: dec.Provisional,
+	}
+	for i, p := range dec.Proposers {
+		if p == nil {
+			return fmt.Errorf("missing proposer %d", i)
+		}
+		list.Proposers[i] = &SlotProposer{Slot: p.Slot}
+		if err := decodeHex(p.PublicKey, list.Proposers[i].PublicKey[:]); err != nil {
+			return fmt.Errorf("invalid proposer of slot %d: %v", p.Slot, err)
+		}
+		if p.Skipped != list.Skipped(i) {
+			return fmt.Errorf("skipped marker of slot %d does not match its public key", p.Slot)
+		}
+	}
+	root, err := list.verify()
+	if err != nil {
+		return err
+	}
+	// The root is checked against the proposers, so that a mismatch is caught on decoding.
+	if dec.ProposerListRoot != "" {
+		var decRoot [32]byte
+		if err := decodeHex(dec.ProposerListRoot, decRoot[:]); err != nil {
+			return fmt.Errorf("invalid proposer list root: %v", err)
+		}
+		if decRoot != root {
+			return fmt.Errorf("proposer list root %#x does not match proposers with root %#x", decRoot, root)
+		}
+	}
+	list.ProposerListRoot = root
+	*l = *list
+	return nil
+}
+
+// GetNextEpochProposerList returns the proposer list of the given epoch from a beacon node, or
+// the proposer list of the epoch after the current epoch if epoch is nil.
+func GetNextEpochProposerList(ctx context.Context, client pbrpc.BeaconQueryClient, epoch *types.Epoch) (*ProposerList, error) {
+	req := &pbrpc.ProposerListRequest{}
+	if epoch != nil {
+		req.QueryFilter = &pbrpc.ProposerListRequest_Epoch{Epoch: *epoch}
+	}
+	res, err := client.NextEpochProposerList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return ProposerListFromProto(res)
+}
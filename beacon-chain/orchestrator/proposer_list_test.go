@@ -0,0 +1,76 @@
+package orchestrator
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestEpochInfo_ProposerList(t *testing.T) {
+	info := &EpochInfo{Epoch: 3, Proposers: [][48]byte{{'a'}, SkippedProposer, {'c'}}, Provisional: true}
+	list, err := info.ProposerList()
+	require.NoError(t, err)
+	startSlot := 3 * params.BeaconConfig().SlotsPerEpoch
+	require.Equal(t, 3, len(list.Proposers))
+	for i, p := range list.Proposers {
+		assert.Equal(t, startSlot+types.Slot(i), p.Slot)
+		assert.Equal(t, info.Proposers[i], p.PublicKey)
+	}
+	assert.Equal(t, true, list.Skipped(1))
+	assert.Equal(t, true, list.Provisional)
+	root, err := ProposerListRoot(info.Proposers)
+	require.NoError(t, err)
+	assert.Equal(t, root, list.ProposerListRoot)
+}
+
+func TestProposerList_ToFromProto(t *testing.T) {
+	want, err := (&EpochInfo{Epoch: 2, Proposers: [][48]byte{{'a'}, {'b'}}, Provisional: true}).ProposerList()
+	require.NoError(t, err)
+	got, err := ProposerListFromProto(want.ToProto())
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, got)
+
+	msg := want.ToProto()
+	msg.ProposerListRoot = make([]byte, 32)
+	_, err = ProposerListFromProto(msg)
+	assert.ErrorContains(t, "does not match proposers", err)
+
+	// Proposers out of slot order are rejected.
+	want.Proposers[0], want.Proposers[1] = want.Proposers[1], want.Proposers[0]
+	_, err = ProposerListFromProto(want.ToProto())
+	assert.ErrorContains(t, "wanted slot", err)
+}
+
+func TestProposerList_MarshalUnmarshalJSON(t *testing.T) {
+	want, err := (&EpochInfo{Epoch: 0, Proposers: [][48]byte{SkippedProposer, {'b'}}}).ProposerList()
+	require.NoError(t, err)
+	enc, err := json.Marshal(want)
+	require.NoError(t, err)
+
+	var raw struct {
+		Proposers []struct {
+			Slot    types.Slot `json:"slot"`
+			Skipped bool       `json:"skipped"`
+		} `json:"proposers"`
+	}
+	require.NoError(t, json.Unmarshal(enc, &raw))
+	require.Equal(t, 2, len(raw.Proposers))
+	assert.Equal(t, types.Slot(0), raw.Proposers[0].Slot)
+	assert.Equal(t, true, raw.Proposers[0].Skipped)
+	assert.Equal(t, types.Slot(1), raw.Proposers[1].Slot)
+	assert.Equal(t, false, raw.Proposers[1].Skipped)
+
+	got := &ProposerList{}
+	require.NoError(t, json.Unmarshal(enc, got))
+	assert.DeepEqual(t, want, got)
+
+	tampered := `{"epoch":0,"proposers":[{"slot":0,"public_key":"0x` + strings.Repeat("00", 48) + `","skipped":false}],"proposer_list_root":""}`
+	assert.ErrorContains(t, "skipped marker of slot 0", json.Unmarshal([]byte(tampered), got))
+	wrongRoot := `{"epoch":0,"proposers":[],"proposer_list_root":"0x` + strings.Repeat("00", 32) + `"}`
+	assert.ErrorContains(t, "does not match proposers", json.Unmarshal([]byte(wrongRoot), got))
+}
@@ -0,0 +1,39 @@
+package orchestrator
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/htrutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// ProposerListRoot returns the hash tree root of the proposer public keys of an epoch in slot
+// order, as a List[BLSPubkey, SLOTS_PER_EPOCH]. Orchestrator instances compare the roots to check
+// that they derived identical proposer lists without exchanging the lists themselves.
+func ProposerListRoot(proposers [][48]byte) ([32]byte, error) {
+	limit := uint64(params.BeaconConfig().SlotsPerEpoch)
+	if uint64(len(proposers)) > limit {
+		return [32]byte{}, fmt.Errorf("proposer list of length %d exceeds %d slots per epoch", len(proposers), limit)
+	}
+	hasher := hashutil.CustomSHA256Hasher()
+	roots := make([][32]byte, len(proposers))
+	for i, pubKey := range proposers {
+		chunks, err := htrutils.Pack([][]byte{pubKey[:]})
+		if err != nil {
+			return [32]byte{}, err
+		}
+		roots[i], err = htrutils.BitwiseMerkleize(hasher, chunks, uint64(len(chunks)), uint64(len(chunks)))
+		if err != nil {
+			return [32]byte{}, err
+		}
+	}
+	root, err := htrutils.BitwiseMerkleizeArrays(hasher, roots, uint64(len(roots)), limit)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	length := make([]byte, 32)
+	binary.LittleEndian.PutUint64(length, uint64(len(proposers)))
+	return htrutils.MixInLength(root, length), nil
+}
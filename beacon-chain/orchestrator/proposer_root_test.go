@@ -0,0 +1,42 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestProposerListRoot(t *testing.T) {
+	root, err := ProposerListRoot([][48]byte{{'a'}, {'b'}})
+	require.NoError(t, err)
+	same, err := ProposerListRoot([][48]byte{{'a'}, {'b'}})
+	require.NoError(t, err)
+	assert.Equal(t, root, same)
+
+	// The root commits to the order of the proposers.
+	swapped, err := ProposerListRoot([][48]byte{{'b'}, {'a'}})
+	require.NoError(t, err)
+	assert.NotEqual(t, root, swapped)
+
+	// The root of a single proposer is the root of its public key, merkleized up to the slots per
+	// epoch with the list length mixed in.
+	single, err := ProposerListRoot([][48]byte{{'a'}})
+	require.NoError(t, err)
+	var chunks [64]byte
+	chunks[0] = 'a'
+	node := hashutil.Hash(chunks[:])
+	var zero [32]byte
+	for width := uint64(1); width < uint64(params.BeaconConfig().SlotsPerEpoch); width *= 2 {
+		node = hashutil.Hash(append(node[:], zero[:]...))
+		zero = hashutil.Hash(append(zero[:], zero[:]...))
+	}
+	var length [32]byte
+	length[0] = 1
+	assert.Equal(t, hashutil.Hash(append(node[:], length[:]...)), single)
+
+	_, err = ProposerListRoot(make([][48]byte, params.BeaconConfig().SlotsPerEpoch+1))
+	assert.ErrorContains(t, "exceeds", err)
+}
@@ -0,0 +1,36 @@
+package orchestrator
+
+import (
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// EpochProposers returns the proposer entry of every slot of the epoch in slot order, marking the
+// genesis slot, which has no proposer, as skipped. The slot of the state, which must be in the
+// epoch, is moved.
+func EpochProposers(committeeCache *cache.CommitteeCache, st iface.BeaconState, epoch types.Epoch) ([][48]byte, error) {
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, err
+	}
+	proposers := make([][48]byte, params.BeaconConfig().SlotsPerEpoch)
+	for i := range proposers {
+		slot := startSlot + types.Slot(i)
+		if slot == 0 {
+			proposers[i] = SkippedProposer
+			continue
+		}
+		if err := st.SetSlot(slot); err != nil {
+			return nil, err
+		}
+		index, err := helpers.BeaconProposerIndex(committeeCache, st)
+		if err != nil {
+			return nil, err
+		}
+		proposers[i] = st.PubkeyAtIndex(index)
+	}
+	return proposers, nil
+}
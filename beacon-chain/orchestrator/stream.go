@@ -0,0 +1,100 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+)
+
+// MaxSubscriberNameLength is the maximum length of the name of an epoch info stream subscriber.
+const MaxSubscriberNameLength = 64
+
+// StreamCursorStore persists the last epoch each named subscriber of the epoch info stream
+// acknowledged, so that a subscriber reconnecting without a resume token resumes after it.
+type StreamCursorStore interface {
+	// StreamCursor returns the last epoch the subscriber acknowledged, and false if it never
+	// acknowledged an epoch.
+	StreamCursor(ctx context.Context, subscriber string) (types.Epoch, bool, error)
+	// SaveStreamCursor saves the last epoch the subscriber acknowledged. Cursors never move
+	// backwards.
+	SaveStreamCursor(ctx context.Context, subscriber string, epoch types.Epoch) error
+}
+
+// streamRequest returns the request opening an epoch info stream from the given epoch, or
+// resuming after the last received epoch if resumeFrom is set. The subscriber is empty for
+// unnamed streams.
+func streamRequest(from types.Epoch, resumeFrom *types.Epoch, subscriber string) *pbrpc.StreamEpochInfoRequest {
+	req := &pbrpc.StreamEpochInfoRequest{FromEpoch: from, Subscriber: subscriber}
+	if resumeFrom != nil {
+		req.Resume = &pbrpc.StreamEpochInfoRequest_ResumeFromEpoch{ResumeFromEpoch: *resumeFrom}
+	}
+	return req
+}
+
+// EpochInfoStreamClient receives the epoch infos of an epoch info stream.
+type EpochInfoStreamClient struct {
+	stream pbrpc.BeaconQuery_StreamEpochInfoClient
+}
+
+// NewEpochInfoStream opens an epoch info stream from the given epoch, or resuming after the last
+// received epoch if resumeFrom is set.
+func NewEpochInfoStream(
+	ctx context.Context, client pbrpc.BeaconQueryClient, from types.Epoch, resumeFrom *types.Epoch,
+) (*EpochInfoStreamClient, error) {
+	c, err := openEpochInfoStream(ctx, client, streamRequest(from, resumeFrom, ""))
+	if err != nil {
+		return nil, err
+	}
+	if err := c.stream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// NewSubscriberEpochInfoStream opens the epoch info stream of a named subscriber, which
+// acknowledges the epochs it processed through Ack. Without a resume token, the stream resumes
+// after the last epoch the subscriber acknowledged, or starts from the given epoch if it never
+// acknowledged one.
+func NewSubscriberEpochInfoStream(
+	ctx context.Context, client pbrpc.BeaconQueryClient, subscriber string, from types.Epoch, resumeFrom *types.Epoch,
+) (*EpochInfoStreamClient, error) {
+	if subscriber == "" || len(subscriber) > MaxSubscriberNameLength {
+		return nil, fmt.Errorf("subscriber name must have between 1 and %d bytes", MaxSubscriberNameLength)
+	}
+	return openEpochInfoStream(ctx, client, streamRequest(from, resumeFrom, subscriber))
+}
+
+func openEpochInfoStream(
+	ctx context.Context, client pbrpc.BeaconQueryClient, req *pbrpc.StreamEpochInfoRequest,
+) (*EpochInfoStreamClient, error) {
+	stream, err := client.StreamEpochInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.Send(&pbrpc.EpochInfoStreamMessage{
+		Message: &pbrpc.EpochInfoStreamMessage_Request{Request: req},
+	}); err != nil {
+		return nil, err
+	}
+	return &EpochInfoStreamClient{stream: stream}, nil
+}
+
+// Ack acknowledges the epoch infos up to the given epoch, which the stream resumes after when the
+// subscriber reconnects without a resume token. Only streams of named subscribers take
+// acknowledgements.
+func (c *EpochInfoStreamClient) Ack(epoch types.Epoch) error {
+	return c.stream.Send(&pbrpc.EpochInfoStreamMessage{
+		Message: &pbrpc.EpochInfoStreamMessage_AckEpoch{AckEpoch: epoch},
+	})
+}
+
+// Recv returns the next epoch info of the stream.
+func (c *EpochInfoStreamClient) Recv() (*EpochInfo, error) {
+	msg, err := c.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return EpochInfoFromProto(msg)
+}
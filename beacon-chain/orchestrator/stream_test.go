@@ -0,0 +1,25 @@
+package orchestrator
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStreamRequest(t *testing.T) {
+	req := streamRequest(5, nil, "")
+	assert.Equal(t, types.Epoch(5), req.FromEpoch)
+	assert.Equal(t, nil, req.Resume)
+	assert.Equal(t, "", req.Subscriber)
+
+	last := types.Epoch(9)
+	req = streamRequest(5, &last, "orchestrator")
+	assert.Equal(t, types.Epoch(5), req.FromEpoch)
+	resume, ok := req.Resume.(*pbrpc.StreamEpochInfoRequest_ResumeFromEpoch)
+	require.Equal(t, true, ok)
+	assert.Equal(t, last, resume.ResumeFromEpoch)
+	assert.Equal(t, "orchestrator", req.Subscriber)
+}
@@ -33,6 +33,9 @@ const (
 	// voluntaryExitWeight specifies the scoring weight that we apply to
 	// our voluntary exit topic.
 	voluntaryExitWeight = 0.05
+	// proposerListDigestWeight specifies the scoring weight that we apply to
+	// our proposer list digest topic.
+	proposerListDigestWeight = 0.05
 
 	// maxInMeshScore describes the max score a peer can attain from being in the mesh.
 	maxInMeshScore = 10
@@ -98,6 +101,8 @@ func (s *Service) topicScoreParams(topic string) (*pubsub.TopicScoreParams, erro
 		return defaultProposerSlashingTopicParams(), nil
 	case strings.Contains(topic, "attester_slashing"):
 		return defaultAttesterSlashingTopicParams(), nil
+	case strings.Contains(topic, "proposer_list_digest"):
+		return defaultProposerListDigestTopicParams(), nil
 	default:
 		return nil, errors.Errorf("unrecognized topic provided for parameter registration: %s", topic)
 	}
@@ -113,7 +118,7 @@ func (s *Service) retrieveActiveValidators() (uint64, error) {
 		if genState == nil {
 			return 0, errors.New("no genesis state exists")
 		}
-		return helpers.ActiveValidatorCount(genState, helpers.CurrentEpoch(genState))
+		return helpers.ActiveValidatorCount(nil, genState, helpers.CurrentEpoch(genState))
 	}
 	bState, err := s.cfg.DB.State(s.ctx, rt)
 	if err != nil {
@@ -122,7 +127,7 @@ func (s *Service) retrieveActiveValidators() (uint64, error) {
 	if bState == nil {
 		return 0, errors.Errorf("no state with root %#x exists", rt)
 	}
-	return helpers.ActiveValidatorCount(bState, helpers.CurrentEpoch(bState))
+	return helpers.ActiveValidatorCount(nil, bState, helpers.CurrentEpoch(bState))
 }
 
 // Based on the lighthouse parameters.
@@ -318,6 +323,30 @@ func defaultVoluntaryExitTopicParams() *pubsub.TopicScoreParams {
 	}
 }
 
+// Every node publishes at most one proposer list digest per epoch, so first deliveries are capped
+// at a few messages, like voluntary exits.
+func defaultProposerListDigestTopicParams() *pubsub.TopicScoreParams {
+	return &pubsub.TopicScoreParams{
+		TopicWeight:                     proposerListDigestWeight,
+		TimeInMeshWeight:                maxInMeshScore / inMeshCap(),
+		TimeInMeshQuantum:               inMeshTime(),
+		TimeInMeshCap:                   inMeshCap(),
+		FirstMessageDeliveriesWeight:    2,
+		FirstMessageDeliveriesDecay:     scoreDecay(100 * oneEpochDuration()),
+		FirstMessageDeliveriesCap:       5,
+		MeshMessageDeliveriesWeight:     0,
+		MeshMessageDeliveriesDecay:      0,
+		MeshMessageDeliveriesCap:        0,
+		MeshMessageDeliveriesThreshold:  0,
+		MeshMessageDeliveriesWindow:     0,
+		MeshMessageDeliveriesActivation: 0,
+		MeshFailurePenaltyWeight:        0,
+		MeshFailurePenaltyDecay:         0,
+		InvalidMessageDeliveriesWeight:  -2000,
+		InvalidMessageDeliveriesDecay:   scoreDecay(50 * oneEpochDuration()),
+	}
+}
+
 func oneSlotDuration() time.Duration {
 	return time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
 }
@@ -5,17 +5,19 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	pb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
 )
 
 // GossipTopicMappings represent the protocol ID to protobuf message type map for easy
 // lookup.
 var GossipTopicMappings = map[string]proto.Message{
-	BlockSubnetTopicFormat:             &pb.SignedBeaconBlock{},
-	AttestationSubnetTopicFormat:       &pb.Attestation{},
-	ExitSubnetTopicFormat:              &pb.SignedVoluntaryExit{},
-	ProposerSlashingSubnetTopicFormat:  &pb.ProposerSlashing{},
-	AttesterSlashingSubnetTopicFormat:  &pb.AttesterSlashing{},
-	AggregateAndProofSubnetTopicFormat: &pb.SignedAggregateAttestationAndProof{},
+	BlockSubnetTopicFormat:              &pb.SignedBeaconBlock{},
+	AttestationSubnetTopicFormat:        &pb.Attestation{},
+	ExitSubnetTopicFormat:               &pb.SignedVoluntaryExit{},
+	ProposerSlashingSubnetTopicFormat:   &pb.ProposerSlashing{},
+	AttesterSlashingSubnetTopicFormat:   &pb.AttesterSlashing{},
+	AggregateAndProofSubnetTopicFormat:  &pb.SignedAggregateAttestationAndProof{},
+	ProposerListDigestSubnetTopicFormat: &p2ptypes.ProposerListDigest{},
 }
 
 // GossipTypeMapping is the inverse of GossipTopicMappings so that an arbitrary protobuf message
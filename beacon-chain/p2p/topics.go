@@ -13,4 +13,7 @@ const (
 	AttesterSlashingSubnetTopicFormat = "/eth2/%x/attester_slashing"
 	// AggregateAndProofSubnetTopicFormat is the topic format for the aggregate and proof subnet.
 	AggregateAndProofSubnetTopicFormat = "/eth2/%x/beacon_aggregate_and_proof"
+	// ProposerListDigestSubnetTopicFormat is the topic format for the proposer list digest subnet,
+	// which is only joined by nodes enabling the proposer list digest exchange.
+	ProposerListDigestSubnetTopicFormat = "/eth2/%x/proposer_list_digest"
 )
@@ -0,0 +1,119 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+// MaxPeerIDLength is the maximum length of an encoded libp2p peer ID in a gossiped message.
+const MaxPeerIDLength = 64
+
+// proposerListDigestFixedSize is the size of the fixed part of an encoded proposer list digest:
+// the epoch, the digest and the offset of the peer ID.
+const proposerListDigestFixedSize = 8 + rootLength + 4
+
+// ProposerListDigest is the gossiped root of the proposer list a node computed for an epoch, as
+// returned by orchestrator.ProposerListRoot. Gossip messages are unsigned and deduplicated by
+// content, so the message carries the peer ID of the node which computed the digest.
+type ProposerListDigest struct {
+	Epoch  types.Epoch
+	Digest [rootLength]byte
+	PeerID []byte
+}
+
+// MarshalSSZTo marshals the proposer list digest with the provided byte slice.
+func (d *ProposerListDigest) MarshalSSZTo(dst []byte) ([]byte, error) {
+	marshalledObj, err := d.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return append(dst, marshalledObj...), nil
+}
+
+// MarshalSSZ marshals the proposer list digest into the serialized object.
+func (d *ProposerListDigest) MarshalSSZ() ([]byte, error) {
+	if len(d.PeerID) > MaxPeerIDLength {
+		return nil, errors.Errorf("peer ID exceeds max size: %d > %d", len(d.PeerID), MaxPeerIDLength)
+	}
+	buf := make([]byte, 0, d.SizeSSZ())
+	buf = ssz.MarshalUint64(buf, uint64(d.Epoch))
+	buf = append(buf, d.Digest[:]...)
+	buf = ssz.WriteOffset(buf, proposerListDigestFixedSize)
+	return append(buf, d.PeerID...), nil
+}
+
+// SizeSSZ returns the size of the serialized representation.
+func (d *ProposerListDigest) SizeSSZ() int {
+	return proposerListDigestFixedSize + len(d.PeerID)
+}
+
+// UnmarshalSSZ unmarshals the provided bytes buffer into the proposer list digest object.
+func (d *ProposerListDigest) UnmarshalSSZ(buf []byte) error {
+	bufLen := len(buf)
+	if bufLen < proposerListDigestFixedSize {
+		return ssz.ErrSize
+	}
+	if bufLen > proposerListDigestFixedSize+MaxPeerIDLength {
+		return errors.Errorf("expected buffer with length of upto %d but received length %d", proposerListDigestFixedSize+MaxPeerIDLength, bufLen)
+	}
+	if offset := binary.LittleEndian.Uint32(buf[8+rootLength:]); offset != proposerListDigestFixedSize {
+		return ssz.ErrOffset
+	}
+	d.Epoch = types.Epoch(ssz.UnmarshallUint64(buf[:8]))
+	copy(d.Digest[:], buf[8:8+rootLength])
+	d.PeerID = make([]byte, bufLen-proposerListDigestFixedSize)
+	copy(d.PeerID, buf[proposerListDigestFixedSize:])
+	return nil
+}
+
+// HashTreeRoot hashes the proposer list digest following the SSZ standard.
+func (d *ProposerListDigest) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(d)
+}
+
+// HashTreeRootWith hashes the proposer list digest with the given hasher.
+func (d *ProposerListDigest) HashTreeRootWith(hh *ssz.Hasher) error {
+	indx := hh.Index()
+	hh.PutUint64(uint64(d.Epoch))
+	hh.PutBytes(d.Digest[:])
+	if len(d.PeerID) > MaxPeerIDLength {
+		return ssz.ErrBytesLength
+	}
+	elemIndx := hh.Index()
+	hh.Append(d.PeerID)
+	hh.FillUpTo32()
+	hh.MerkleizeWithMixin(elemIndx, uint64(len(d.PeerID)), (MaxPeerIDLength+31)/32)
+	hh.Merkleize(indx)
+	return nil
+}
+
+// Reset clears the proposer list digest, as required by proto.Message so that the message can be
+// mapped to its gossip topic.
+func (d *ProposerListDigest) Reset() {
+	*d = ProposerListDigest{}
+}
+
+// String returns a description of the proposer list digest.
+func (d *ProposerListDigest) String() string {
+	return fmt.Sprintf("epoch:%d digest:%#x peer_id:%#x", d.Epoch, d.Digest, d.PeerID)
+}
+
+// Merge copies the fields of src, which proto.Clone relies on to create the gossip messages of the
+// topic from the mapped message.
+func (d *ProposerListDigest) Merge(src proto.Message) {
+	s, ok := src.(*ProposerListDigest)
+	if !ok || s == nil {
+		return
+	}
+	d.Epoch = s.Epoch
+	d.Digest = s.Digest
+	d.PeerID = append([]byte(nil), s.PeerID...)
+}
+
+// ProtoMessage is a no-op marking the proposer list digest as a proto.Message.
+func (*ProposerListDigest) ProtoMessage() {}
@@ -41,6 +41,7 @@ func TestErrorResponse_Limit(t *testing.T) {
 func TestRoundTripSerialization(t *testing.T) {
 	roundTripTestBlocksByRootReq(t)
 	roundTripTestErrorMessage(t)
+	roundTripTestProposerListDigest(t)
 }
 
 func roundTripTestBlocksByRootReq(t *testing.T) {
@@ -71,6 +72,44 @@ func roundTripTestErrorMessage(t *testing.T) {
 	assert.DeepEqual(t, []byte(newVal), errMsg)
 }
 
+func roundTripTestProposerListDigest(t *testing.T) {
+	digest := &ProposerListDigest{Epoch: 7, Digest: [32]byte{'a'}, PeerID: []byte{'p', 'e', 'e', 'r'}}
+
+	marshalledObj, err := digest.MarshalSSZ()
+	require.NoError(t, err)
+	assert.Equal(t, digest.SizeSSZ(), len(marshalledObj))
+	newVal := &ProposerListDigest{}
+
+	require.NoError(t, newVal.UnmarshalSSZ(marshalledObj))
+	assert.Equal(t, digest.Epoch, newVal.Epoch)
+	assert.Equal(t, digest.Digest, newVal.Digest)
+	assert.DeepEqual(t, digest.PeerID, newVal.PeerID)
+}
+
+func TestProposerListDigest_Limit(t *testing.T) {
+	digest := &ProposerListDigest{PeerID: make([]byte, MaxPeerIDLength+1)}
+	_, err := digest.MarshalSSZ()
+	require.ErrorContains(t, "peer ID exceeds max size", err)
+
+	buf := make([]byte, proposerListDigestFixedSize+MaxPeerIDLength+1)
+	require.ErrorContains(t, "expected buffer with length of upto", digest.UnmarshalSSZ(buf))
+	require.ErrorContains(t, "incorrect size", digest.UnmarshalSSZ(buf[:proposerListDigestFixedSize-1]))
+	// The offset of the peer ID must follow the fixed part.
+	require.ErrorContains(t, "incorrect offset", digest.UnmarshalSSZ(buf[:proposerListDigestFixedSize]))
+}
+
+func TestProposerListDigest_HashTreeRoot(t *testing.T) {
+	digest := &ProposerListDigest{Epoch: 7, Digest: [32]byte{'a'}, PeerID: []byte{'p', 'e', 'e', 'r'}}
+	root, err := digest.HashTreeRoot()
+	require.NoError(t, err)
+
+	// The root commits to the peer ID.
+	digest.PeerID = []byte{'p', 'e', 'e', 'r', '2'}
+	other, err := digest.HashTreeRoot()
+	require.NoError(t, err)
+	assert.NotEqual(t, root, other)
+}
+
 func TestSSZBytes_HashTreeRoot(t *testing.T) {
 	tests := []struct {
 		name        string
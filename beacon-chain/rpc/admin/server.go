@@ -0,0 +1,184 @@
+// Package admin serves the Admin gRPC service, through which an operator adjusts selected knobs of a
+// running beacon node, such as the maximum RPC page size, the state replay concurrency, cache
+// sizes and the log level, without restarting it. Overrides are persisted to the data directory
+// and applied again on the next start.
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OverridesFileName is the name of the file in the data directory holding the overrides.
+const OverridesFileName = "rpc-overrides.json"
+
+// PageSizeCap caps the page size of paginated RPC requests.
+type PageSizeCap interface {
+	MaxPageSize() int
+	SetMaxPageSize(size int)
+}
+
+// ReplayLimiter bounds the concurrent state regenerations of RPC calls.
+type ReplayLimiter interface {
+	MaxReplays() int
+	SetMaxReplays(maxReplays int)
+}
+
+// Caches are the RPC caches whose sizes are adjustable.
+type Caches interface {
+	EpochInfoCacheSize() int
+	SetEpochInfoCacheSize(size int)
+	EpochStateCacheSize() int
+	SetEpochStateCacheSize(size int)
+}
+
+// Server applies settings updates to the beacon node and persists them as overrides.
+type Server struct {
+	OverridesPath string
+	PageSizeCap   PageSizeCap
+	Limiter       ReplayLimiter
+	Caches        Caches
+
+	lock      sync.Mutex
+	overrides Settings
+}
+
+// NewServer creates an admin server persisting its overrides to the given file.
+func NewServer(overridesPath string, pageSizeCap PageSizeCap, limiter ReplayLimiter, caches Caches) *Server {
+	return &Server{
+		OverridesPath: overridesPath,
+		PageSizeCap:   pageSizeCap,
+		Limiter:       limiter,
+		Caches:        caches,
+	}
+}
+
+// LoadOverrides applies the overrides persisted by earlier updates, if any. It is called once at
+// startup, before the RPC server serves calls.
+func (s *Server) LoadOverrides() error {
+	enc, err := ioutil.ReadFile(s.OverridesPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not read settings overrides")
+	}
+	overrides, err := decodeSettings(enc)
+	if err != nil {
+		return errors.Wrapf(err, "could not decode settings overrides of %s", s.OverridesPath)
+	}
+	if err := overrides.validate(); err != nil {
+		return errors.Wrapf(err, "invalid settings overrides in %s", s.OverridesPath)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.apply(overrides)
+	s.overrides = *overrides
+	log.WithField("path", s.OverridesPath).Info("Applied persisted settings overrides")
+	return nil
+}
+
+// GetSettings returns the effective settings of the beacon node.
+func (s *Server) GetSettings(_ context.Context, _ *empty.Empty) (*pbrpc.AdminSettings, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.effective().toProto(), nil
+}
+
+// UpdateSettings applies the set fields of the update and persists them along with the earlier
+// overrides, so that they survive a restart. It returns the effective settings once applied.
+func (s *Server) UpdateSettings(_ context.Context, req *pbrpc.AdminSettings) (*pbrpc.AdminSettings, error) {
+	update := settingsFromProto(req)
+	if err := update.validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid settings: %v", err)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	overrides := s.overrides.merge(update)
+	if err := s.persist(&overrides); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not persist settings overrides: %v", err)
+	}
+	s.apply(update)
+	s.overrides = overrides
+	return s.effective().toProto(), nil
+}
+
+// apply adjusts the knobs of the set fields of the settings, which must be valid.
+func (s *Server) apply(settings *Settings) {
+	fields := logrus.Fields{}
+	if settings.MaxPageSize != nil && s.PageSizeCap != nil {
+		s.PageSizeCap.SetMaxPageSize(*settings.MaxPageSize)
+		fields["maxPageSize"] = *settings.MaxPageSize
+	}
+	if settings.MaxStateReplays != nil && s.Limiter != nil {
+		s.Limiter.SetMaxReplays(*settings.MaxStateReplays)
+		fields["maxStateReplays"] = *settings.MaxStateReplays
+	}
+	if settings.EpochInfoCacheSize != nil && s.Caches != nil {
+		s.Caches.SetEpochInfoCacheSize(*settings.EpochInfoCacheSize)
+		fields["epochInfoCacheSize"] = *settings.EpochInfoCacheSize
+	}
+	if settings.EpochStateCacheSize != nil && s.Caches != nil {
+		s.Caches.SetEpochStateCacheSize(*settings.EpochStateCacheSize)
+		fields["epochStateCacheSize"] = *settings.EpochStateCacheSize
+	}
+	if settings.LogLevel != nil {
+		// The level was validated already.
+		level, err := logrus.ParseLevel(*settings.LogLevel)
+		if err == nil {
+			logrus.SetLevel(level)
+			fields["logLevel"] = level.String()
+		}
+	}
+	log.WithFields(fields).Info("Adjusted settings")
+}
+
+// effective returns the current value of every knob. The caller must hold the lock.
+func (s *Server) effective() *Settings {
+	logLevel := logrus.GetLevel().String()
+	settings := &Settings{
+		LogLevel: &logLevel,
+	}
+	if s.PageSizeCap != nil {
+		maxPageSize := s.PageSizeCap.MaxPageSize()
+		settings.MaxPageSize = &maxPageSize
+	}
+	if s.Limiter != nil {
+		maxReplays := s.Limiter.MaxReplays()
+		settings.MaxStateReplays = &maxReplays
+	}
+	if s.Caches != nil {
+		epochInfoCacheSize := s.Caches.EpochInfoCacheSize()
+		epochStateCacheSize := s.Caches.EpochStateCacheSize()
+		settings.EpochInfoCacheSize = &epochInfoCacheSize
+		settings.EpochStateCacheSize = &epochStateCacheSize
+	}
+	return settings
+}
+
+// persist writes the overrides to the overrides file, replacing it atomically so that a crash
+// never leaves a truncated file behind.
+func (s *Server) persist(overrides *Settings) error {
+	enc, err := json.MarshalIndent(overrides, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := s.OverridesPath + ".tmp"
+	if err := fileutil.WriteFile(tmp, enc); err != nil {
+		return errors.Wrap(err, "could not write settings overrides")
+	}
+	return os.Rename(tmp, s.OverridesPath)
+}
@@ -0,0 +1,214 @@
+package admin
+
+import (
+	"context"
+	"io/ioutil"
+	"math"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockPageSizeCap struct {
+	maxPageSize int
+}
+
+func (m *mockPageSizeCap) MaxPageSize() int {
+	return m.maxPageSize
+}
+
+func (m *mockPageSizeCap) SetMaxPageSize(size int) {
+	m.maxPageSize = size
+}
+
+type mockLimiter struct {
+	maxReplays int
+}
+
+func (m *mockLimiter) MaxReplays() int {
+	return m.maxReplays
+}
+
+func (m *mockLimiter) SetMaxReplays(maxReplays int) {
+	m.maxReplays = maxReplays
+}
+
+type mockCaches struct {
+	epochInfoCacheSize  int
+	epochStateCacheSize int
+}
+
+func (m *mockCaches) EpochInfoCacheSize() int {
+	return m.epochInfoCacheSize
+}
+
+func (m *mockCaches) SetEpochInfoCacheSize(size int) {
+	m.epochInfoCacheSize = size
+}
+
+func (m *mockCaches) EpochStateCacheSize() int {
+	return m.epochStateCacheSize
+}
+
+func (m *mockCaches) SetEpochStateCacheSize(size int) {
+	m.epochStateCacheSize = size
+}
+
+// setupGlobals restores the log level changed by the test.
+func setupGlobals(t *testing.T) {
+	level := logrus.GetLevel()
+	t.Cleanup(func() {
+		logrus.SetLevel(level)
+	})
+}
+
+func TestServer_UpdateSettings(t *testing.T) {
+	setupGlobals(t)
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), OverridesFileName)
+	pageSizeCap := &mockPageSizeCap{maxPageSize: 250}
+	limiter := &mockLimiter{}
+	caches := &mockCaches{epochInfoCacheSize: 64, epochStateCacheSize: 4}
+	srv := NewServer(path, pageSizeCap, limiter, caches)
+
+	settings, err := srv.UpdateSettings(ctx, &pbrpc.AdminSettings{
+		MaxPageSizeSetting:     &pbrpc.AdminSettings_MaxPageSize{MaxPageSize: 500},
+		MaxStateReplaysSetting: &pbrpc.AdminSettings_MaxStateReplays{MaxStateReplays: 2},
+		LogLevelSetting:        &pbrpc.AdminSettings_LogLevel{LogLevel: "debug"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 500, pageSizeCap.maxPageSize)
+	assert.Equal(t, 2, limiter.maxReplays)
+	assert.Equal(t, logrus.DebugLevel, logrus.GetLevel())
+	assert.Equal(t, uint64(500), settings.GetMaxPageSize())
+	assert.Equal(t, uint64(2), settings.GetMaxStateReplays())
+	assert.Equal(t, uint64(64), settings.GetEpochInfoCacheSize())
+	assert.Equal(t, uint64(4), settings.GetEpochStateCacheSize())
+	assert.Equal(t, "debug", settings.GetLogLevel())
+
+	// Later updates are persisted along with the earlier overrides.
+	_, err = srv.UpdateSettings(ctx, &pbrpc.AdminSettings{
+		EpochStateCacheSizeSetting: &pbrpc.AdminSettings_EpochStateCacheSize{EpochStateCacheSize: 8},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 8, caches.epochStateCacheSize)
+
+	// A restarted node applies the persisted overrides.
+	logrus.SetLevel(logrus.InfoLevel)
+	restartedPageSizeCap := &mockPageSizeCap{maxPageSize: 250}
+	restartedLimiter := &mockLimiter{}
+	restartedCaches := &mockCaches{epochInfoCacheSize: 64, epochStateCacheSize: 4}
+	restarted := NewServer(path, restartedPageSizeCap, restartedLimiter, restartedCaches)
+	require.NoError(t, restarted.LoadOverrides())
+	assert.Equal(t, 500, restartedPageSizeCap.maxPageSize)
+	assert.Equal(t, 2, restartedLimiter.maxReplays)
+	assert.Equal(t, 64, restartedCaches.epochInfoCacheSize)
+	assert.Equal(t, 8, restartedCaches.epochStateCacheSize)
+	assert.Equal(t, logrus.DebugLevel, logrus.GetLevel())
+}
+
+func TestServer_UpdateSettings_Invalid(t *testing.T) {
+	setupGlobals(t)
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), OverridesFileName)
+	limiter := &mockLimiter{maxReplays: 3}
+	srv := NewServer(path, &mockPageSizeCap{}, limiter, &mockCaches{})
+
+	tests := []struct {
+		name     string
+		update   *pbrpc.AdminSettings
+		expected string
+	}{
+		{
+			"page size",
+			&pbrpc.AdminSettings{MaxPageSizeSetting: &pbrpc.AdminSettings_MaxPageSize{MaxPageSize: 0}},
+			"max page size must be positive",
+		},
+		{
+			"state replays",
+			&pbrpc.AdminSettings{MaxStateReplaysSetting: &pbrpc.AdminSettings_MaxStateReplays{MaxStateReplays: math.MaxUint64}},
+			"max state replays must not be negative",
+		},
+		{
+			"epoch info cache",
+			&pbrpc.AdminSettings{EpochInfoCacheSizeSetting: &pbrpc.AdminSettings_EpochInfoCacheSize{EpochInfoCacheSize: 0}},
+			"epoch info cache size must be positive",
+		},
+		{
+			"epoch state cache",
+			&pbrpc.AdminSettings{EpochStateCacheSizeSetting: &pbrpc.AdminSettings_EpochStateCacheSize{EpochStateCacheSize: 0}},
+			"epoch state cache size must be positive",
+		},
+		{
+			"log level",
+			&pbrpc.AdminSettings{LogLevelSetting: &pbrpc.AdminSettings_LogLevel{LogLevel: "loud"}},
+			"not a valid logrus Level",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := srv.UpdateSettings(ctx, tt.update)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.ErrorContains(t, tt.expected, err)
+		})
+	}
+
+	// Rejected updates are neither applied nor persisted.
+	assert.Equal(t, 3, limiter.maxReplays)
+	require.NoError(t, NewServer(path, &mockPageSizeCap{}, limiter, &mockCaches{}).LoadOverrides())
+	assert.Equal(t, 3, limiter.maxReplays)
+}
+
+func TestServer_LoadOverrides_RejectsUnknownFields(t *testing.T) {
+	path := filepath.Join(t.TempDir(), OverridesFileName)
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{"max_pagesize": 10}`), 0600))
+	err := NewServer(path, &mockPageSizeCap{}, &mockLimiter{}, &mockCaches{}).LoadOverrides()
+	assert.ErrorContains(t, "unknown field", err)
+}
+
+func TestAdminService_RoundTrip(t *testing.T) {
+	setupGlobals(t)
+	limiter := &mockLimiter{}
+	srv := NewServer(filepath.Join(t.TempDir(), OverridesFileName), &mockPageSizeCap{maxPageSize: 250}, limiter, &mockCaches{})
+	grpcServer := grpc.NewServer()
+	pbrpc.RegisterAdminServer(grpcServer, srv)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() {
+		_ = grpcServer.Serve(lis)
+	}()
+	defer grpcServer.Stop()
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure(), grpc.WithBlock())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, conn.Close())
+	}()
+
+	ctx := context.Background()
+	client := pbrpc.NewAdminClient(conn)
+	settings, err := client.UpdateSettings(ctx, &pbrpc.AdminSettings{
+		MaxStateReplaysSetting: &pbrpc.AdminSettings_MaxStateReplays{MaxStateReplays: 5},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), settings.GetMaxStateReplays())
+	assert.Equal(t, 5, limiter.maxReplays)
+
+	settings, err = client.GetSettings(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), settings.GetMaxStateReplays())
+	assert.Equal(t, uint64(250), settings.GetMaxPageSize())
+
+	_, err = client.UpdateSettings(ctx, &pbrpc.AdminSettings{
+		MaxPageSizeSetting: &pbrpc.AdminSettings_MaxPageSize{MaxPageSize: 0},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,138 @@
+package admin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// Settings holds the knobs of the beacon node which the admin service adjusts at runtime. Unset
+// fields are left unchanged by an update.
+type Settings struct {
+	// MaxPageSize caps the page size of paginated RPC requests.
+	MaxPageSize *int `json:"max_page_size,omitempty"`
+	// MaxStateReplays is the number of concurrent state regenerations admitted for RPC calls, zero
+	// admitting every regeneration.
+	MaxStateReplays *int `json:"max_state_replays,omitempty"`
+	// EpochInfoCacheSize is the number of encoded epoch infos kept by the epoch info hub.
+	EpochInfoCacheSize *int `json:"epoch_info_cache_size,omitempty"`
+	// EpochStateCacheSize is the number of epoch start states kept for assignment requests.
+	EpochStateCacheSize *int `json:"epoch_state_cache_size,omitempty"`
+	// LogLevel is the logging verbosity of the beacon node.
+	LogLevel *string `json:"log_level,omitempty"`
+}
+
+// validate checks the values of the set fields.
+func (s *Settings) validate() error {
+	if s.MaxPageSize != nil && *s.MaxPageSize < 1 {
+		return fmt.Errorf("max page size must be positive, got %d", *s.MaxPageSize)
+	}
+	if s.MaxStateReplays != nil && *s.MaxStateReplays < 0 {
+		return fmt.Errorf("max state replays must not be negative, got %d", *s.MaxStateReplays)
+	}
+	if s.EpochInfoCacheSize != nil && *s.EpochInfoCacheSize < 1 {
+		return fmt.Errorf("epoch info cache size must be positive, got %d", *s.EpochInfoCacheSize)
+	}
+	if s.EpochStateCacheSize != nil && *s.EpochStateCacheSize < 1 {
+		return fmt.Errorf("epoch state cache size must be positive, got %d", *s.EpochStateCacheSize)
+	}
+	if s.LogLevel != nil {
+		if _, err := logrus.ParseLevel(*s.LogLevel); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// merge returns the settings with the set fields of the update applied on top.
+func (s Settings) merge(update *Settings) Settings {
+	if update.MaxPageSize != nil {
+		s.MaxPageSize = update.MaxPageSize
+	}
+	if update.MaxStateReplays != nil {
+		s.MaxStateReplays = update.MaxStateReplays
+	}
+	if update.EpochInfoCacheSize != nil {
+		s.EpochInfoCacheSize = update.EpochInfoCacheSize
+	}
+	if update.EpochStateCacheSize != nil {
+		s.EpochStateCacheSize = update.EpochStateCacheSize
+	}
+	if update.LogLevel != nil {
+		s.LogLevel = update.LogLevel
+	}
+	return s
+}
+
+// settingsFromProto returns the settings of their protobuf representation. Values which do not
+// fit an int are turned negative, so that validation rejects them.
+func settingsFromProto(msg *pbrpc.AdminSettings) *Settings {
+	s := &Settings{}
+	if v, ok := msg.MaxPageSizeSetting.(*pbrpc.AdminSettings_MaxPageSize); ok {
+		s.MaxPageSize = intFromUint64(v.MaxPageSize)
+	}
+	if v, ok := msg.MaxStateReplaysSetting.(*pbrpc.AdminSettings_MaxStateReplays); ok {
+		s.MaxStateReplays = intFromUint64(v.MaxStateReplays)
+	}
+	if v, ok := msg.EpochInfoCacheSizeSetting.(*pbrpc.AdminSettings_EpochInfoCacheSize); ok {
+		s.EpochInfoCacheSize = intFromUint64(v.EpochInfoCacheSize)
+	}
+	if v, ok := msg.EpochStateCacheSizeSetting.(*pbrpc.AdminSettings_EpochStateCacheSize); ok {
+		s.EpochStateCacheSize = intFromUint64(v.EpochStateCacheSize)
+	}
+	if v, ok := msg.LogLevelSetting.(*pbrpc.AdminSettings_LogLevel); ok {
+		logLevel := v.LogLevel
+		s.LogLevel = &logLevel
+	}
+	return s
+}
+
+// toProto returns the protobuf representation of the settings.
+func (s *Settings) toProto() *pbrpc.AdminSettings {
+	msg := &pbrpc.AdminSettings{}
+	if s.MaxPageSize != nil {
+		msg.MaxPageSizeSetting = &pbrpc.AdminSettings_MaxPageSize{MaxPageSize: uint64(*s.MaxPageSize)}
+	}
+	if s.MaxStateReplays != nil {
+		msg.MaxStateReplaysSetting = &pbrpc.AdminSettings_MaxStateReplays{MaxStateReplays: uint64(*s.MaxStateReplays)}
+	}
+	if s.EpochInfoCacheSize != nil {
+		msg.EpochInfoCacheSizeSetting = &pbrpc.AdminSettings_EpochInfoCacheSize{EpochInfoCacheSize: uint64(*s.EpochInfoCacheSize)}
+	}
+	if s.EpochStateCacheSize != nil {
+		msg.EpochStateCacheSizeSetting = &pbrpc.AdminSettings_EpochStateCacheSize{EpochStateCacheSize: uint64(*s.EpochStateCacheSize)}
+	}
+	if s.LogLevel != nil {
+		msg.LogLevelSetting = &pbrpc.AdminSettings_LogLevel{LogLevel: *s.LogLevel}
+	}
+	return msg
+}
+
+// intFromUint64 returns a pointer to the value as an int, which is negative if the value does not
+// fit.
+func intFromUint64(v uint64) *int {
+	i := int(v)
+	if v > math.MaxInt32 {
+		i = -1
+	}
+	return &i
+}
+
+// decodeSettings decodes settings from their JSON representation, rejecting unknown fields so
+// that a misspelled knob is not silently ignored.
+func decodeSettings(enc []byte) (*Settings, error) {
+	s := &Settings{}
+	if len(enc) == 0 {
+		return s, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(enc))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
@@ -0,0 +1,276 @@
+// Package apikeys restricts the beacon node RPC endpoints to callers presenting an API key. Every
+// key grants a set of scopes, and every gRPC method requires one scope, so that heavy archival
+// endpoints can be reserved to the orchestrator while public dashboards only reach light reads.
+package apikeys
+
+import (
+	"crypto/sha256"
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// Scope is a class of RPC methods an API key may call.
+type Scope string
+
+const (
+	// ReadScope covers the light read endpoints.
+	ReadScope Scope = "read"
+	// ArchiveScope covers the endpoints serving historical data or scanning the validator registry.
+	ArchiveScope Scope = "archive"
+	// ValidatorScope covers the validator service, the duties and the submission of blocks,
+	// attestations, slashings and exits.
+	ValidatorScope Scope = "validator"
+	// DebugScope covers the debug service.
+	DebugScope Scope = "debug"
+	// AdminScope covers the calls changing the state of the node: the admin service, which adjusts
+	// the settings of the node at runtime, the genesis submission, the tracked validator updates, the
+	// epoch info prefetching and the orchestrator feedback which decides the blocks fork choice
+	// keeps. It is also required by the methods without a scope.
+	AdminScope Scope = "admin"
+	// AllScopes grants every scope.
+	AllScopes Scope = "*"
+)
+
+// serviceScopes are the scopes required by every method of the services whose methods all need the
+// same scope.
+var serviceScopes = map[string]Scope{
+	"ethereum.eth.v1alpha1.Node":                ReadScope,
+	"ethereum.eth.v1alpha1.BeaconNodeValidator": ValidatorScope,
+	"ethereum.eth.v1.BeaconNode":                ReadScope,
+	"ethereum.eth.v1.BeaconDebug":               DebugScope,
+	"ethereum.beacon.rpc.v1.Health":             ReadScope,
+	"ethereum.beacon.rpc.v1.Debug":              DebugScope,
+	"ethereum.beacon.rpc.v1.Admin":              AdminScope,
+	"ethereum.beacon.rpc.v1.Genesis":            AdminScope,
+	"grpc.reflection.v1alpha.ServerReflection":  ReadScope,
+}
+
+// methodScopes are the scopes required by the methods of the services mixing light reads with
+// archival reads, validator submissions or calls changing the state of the node.
+var methodScopes = map[string]Scope{
+	"/ethereum.eth.v1alpha1.BeaconChain/ListAttestations":              ArchiveScope,
+	"/ethereum.eth.v1alpha1.BeaconChain/ListIndexedAttestations":       ArchiveScope,
+	"/ethereum.eth.v1alpha1.BeaconChain/AttestationPool":               ReadScope,
+	"/ethereum.eth.v1alpha1.BeaconChain/ListBlocks":                    ArchiveScope,
+	"/ethereum.eth.v1alpha1.BeaconChain/GetChainHead":                  ReadScope,
+	"/ethereum.eth.v1alpha1.BeaconChain/GetWeakSubjectivityCheckpoint": ReadScope,
+	"/ethereum.eth.v1alpha1.BeaconChain/ListBeaconCommittees":          ArchiveScope,
+	"/ethereum.eth.v1alpha1.BeaconChain/ListValidatorBalances":         ArchiveScope,
+	"/ethereum.eth.v1alpha1.BeaconChain/ListValidators":                ReadScope,
+	"/ethereum.eth.v1alpha1.BeaconChain/GetValidator":                  ReadScope,
+	"/ethereum.eth.v1alpha1.BeaconChain/GetValidatorActiveSetChanges":  ReadScope,
+	"/ethereum.eth.v1alpha1.BeaconChain/GetValidatorQueue":             ReadScope,
+	"/ethereum.eth.v1alpha1.BeaconChain/GetValidatorPerformance":       ReadScope,
+	"/ethereum.eth.v1alpha1.BeaconChain/ListValidatorAssignments":      ArchiveScope,
+	"/ethereum.eth.v1alpha1.BeaconChain/GetValidatorParticipation":     ArchiveScope,
+	"/ethereum.eth.v1alpha1.BeaconChain/GetBeaconConfig":               ReadScope,
+	"/ethereum.eth.v1alpha1.BeaconChain/SubmitAttesterSlashing":        ValidatorScope,
+	"/ethereum.eth.v1alpha1.BeaconChain/SubmitProposerSlashing":        ValidatorScope,
+	"/ethereum.eth.v1alpha1.BeaconChain/GetIndividualVotes":            ArchiveScope,
+	"/ethereum.eth.v1alpha1.BeaconChain/StreamAttestations":            ReadScope,
+	"/ethereum.eth.v1alpha1.BeaconChain/StreamIndexedAttestations":     ReadScope,
+	"/ethereum.eth.v1alpha1.BeaconChain/StreamBlocks":                  ReadScope,
+	"/ethereum.eth.v1alpha1.BeaconChain/StreamChainHead":               ReadScope,
+	"/ethereum.eth.v1alpha1.BeaconChain/StreamValidatorsInfo":          ReadScope,
+
+	"/ethereum.eth.v1.BeaconChain/GetGenesis":                ReadScope,
+	"/ethereum.eth.v1.BeaconChain/GetStateRoot":              ReadScope,
+	"/ethereum.eth.v1.BeaconChain/GetStateFork":              ReadScope,
+	"/ethereum.eth.v1.BeaconChain/GetFinalityCheckpoints":    ReadScope,
+	"/ethereum.eth.v1.BeaconChain/GetValidator":              ReadScope,
+	"/ethereum.eth.v1.BeaconChain/ListValidators":            ReadScope,
+	"/ethereum.eth.v1.BeaconChain/ListValidatorBalances":     ReadScope,
+	"/ethereum.eth.v1.BeaconChain/ListCommittees":            ReadScope,
+	"/ethereum.eth.v1.BeaconChain/GetBlockHeader":            ReadScope,
+	"/ethereum.eth.v1.BeaconChain/ListBlockHeaders":          ReadScope,
+	"/ethereum.eth.v1.BeaconChain/SubmitBlock":               ValidatorScope,
+	"/ethereum.eth.v1.BeaconChain/GetBlock":                  ReadScope,
+	"/ethereum.eth.v1.BeaconChain/GetBlockRoot":              ReadScope,
+	"/ethereum.eth.v1.BeaconChain/ListBlockAttestations":     ReadScope,
+	"/ethereum.eth.v1.BeaconChain/ListPoolAttestations":      ReadScope,
+	"/ethereum.eth.v1.BeaconChain/SubmitAttestations":        ValidatorScope,
+	"/ethereum.eth.v1.BeaconChain/ListPoolAttesterSlashings": ReadScope,
+	"/ethereum.eth.v1.BeaconChain/SubmitAttesterSlashing":    ValidatorScope,
+	"/ethereum.eth.v1.BeaconChain/ListPoolProposerSlashings": ReadScope,
+	"/ethereum.eth.v1.BeaconChain/SubmitProposerSlashing":    ValidatorScope,
+	"/ethereum.eth.v1.BeaconChain/ListPoolVoluntaryExits":    ReadScope,
+	"/ethereum.eth.v1.BeaconChain/SubmitVoluntaryExit":       ValidatorScope,
+	"/ethereum.eth.v1.BeaconChain/GetForkSchedule":           ReadScope,
+	"/ethereum.eth.v1.BeaconChain/GetSpec":                   ReadScope,
+	"/ethereum.eth.v1.BeaconChain/GetDepositContract":        ReadScope,
+
+	"/ethereum.beacon.rpc.v1.BeaconQuery/GetValidatorLiveness":                  ReadScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/GetCommitteeRoots":                     ArchiveScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/ExplainShuffle":                        ArchiveScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/GetStateDiff":                          ArchiveScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/GetRandaoMixes":                        ArchiveScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/ListReorgs":                            ReadScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/StreamReorgs":                          ReadScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/GetDuties":                             ValidatorScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/ListValidatorBalanceHistory":           ArchiveScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/StreamSlotParticipation":               ReadScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/GetEpochSummary":                       ReadScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/ListEpochSummaries":                    ArchiveScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/ListValidatorPublicKeys":               ReadScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/GetValidatorQueueDetails":              ReadScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/StreamAnnotatedChainHead":              ReadScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/GetBlockAvailability":                  ReadScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/GetNetworkConfig":                      ReadScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/GetValidatorSetDelta":                  ArchiveScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/PrefetchEpochInfo":                     AdminScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/GetPrefetchStatus":                     ReadScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/ListCanonicalBlockRoots":               ReadScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/StreamProposerAudit":                   ReadScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/UpdateTrackedValidators":               AdminScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/ImportTrackedValidators":               AdminScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/ExportTrackedValidators":               ReadScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/ListPoolAttestations":                  ReadScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/GetEth1DataStatus":                     ReadScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/StreamDepositInclusions":               ReadScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/SimulateEpochTransition":               ArchiveScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/ListCanonicalBlockHeaders":             ArchiveScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/GetBlockByPandoraHash":                 ReadScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/GetProposerListConsistency":            ReadScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/GetCurrentEpochParticipation":          ReadScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/ListAnnotatedValidatorAssignments":     ArchiveScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/ListTrackedValidatorBalances":          ArchiveScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/GetTrackedValidatorPerformance":        ReadScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/ListValidatorsByWithdrawalCredentials": ReadScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/EstimateStorageGrowth":                 ReadScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/ConfirmPandoraBlockHashes":             AdminScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/GetProposerStats":                      ArchiveScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/GetSubnetAssignments":                  ReadScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/ListValidatorAssignmentsRange":         ArchiveScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/GetPrecomputationStatus":               ReadScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/StreamEpochInfo":                       ArchiveScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/GetEpochInfoAccumulator":               ArchiveScope,
+	"/ethereum.beacon.rpc.v1.BeaconQuery/NextEpochProposerList":                 ReadScope,
+}
+
+// MethodScope returns the scope mapped to the gRPC method with the given full name, and false if the
+// method has no scope.
+func MethodScope(fullMethod string) (Scope, bool) {
+	if scope, ok := methodScopes[fullMethod]; ok {
+		return scope, true
+	}
+	service := strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(service, "/"); i >= 0 {
+		service = service[:i]
+	}
+	scope, ok := serviceScopes[service]
+	return scope, ok
+}
+
+// RequiredScope returns the scope needed to call the gRPC method with the given full name. Methods
+// without a scope, such as the methods added to a service after the scopes were mapped, require the
+// admin scope so that they are not served to every key until they are mapped.
+func RequiredScope(fullMethod string) Scope {
+	if scope, ok := MethodScope(fullMethod); ok {
+		return scope
+	}
+	return AdminScope
+}
+
+// KeysConfig is the content of the file passed with --rpc-api-keys-file, for instance:
+//
+//	keys:
+//	  - name: orchestrator
+//	    token: 0f3a...
+//	    scopes: ["*"]
+//	    priority: true
+//	  - name: dashboard
+//	    token: 9c1e...
+//	    scopes: [read]
+type KeysConfig struct {
+	Keys []*Key `yaml:"keys"`
+}
+
+// Key is an API key and the scopes it grants.
+type Key struct {
+	// Name identifies the key in logs, the token is never logged.
+	Name   string  `yaml:"name"`
+	Token  string  `yaml:"token"`
+	Scopes []Scope `yaml:"scopes"`
+	// Priority marks the orchestrator traffic, whose unary calls are served by the workers the RPC
+	// server reserves for them, so that other callers cannot starve them.
+	Priority bool `yaml:"priority"`
+}
+
+// Keys authorizes RPC calls by the API key they present.
+type Keys struct {
+	// byHash holds the keys by the hash of their token, so that looking a token up does not depend on
+	// how many of its leading bytes match a configured token.
+	byHash map[[32]byte]*Key
+}
+
+// LoadKeys reads and validates the API keys file at the given path.
+func LoadKeys(path string) (*Keys, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &KeysConfig{}
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, errors.Wrapf(err, "could not parse %s", path)
+	}
+	keys, err := NewKeys(cfg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid API keys file %s", path)
+	}
+	return keys, nil
+}
+
+// NewKeys validates the configured keys.
+func NewKeys(cfg *KeysConfig) (*Keys, error) {
+	if len(cfg.Keys) == 0 {
+		return nil, errors.New("no API keys configured")
+	}
+	keys := &Keys{byHash: make(map[[32]byte]*Key, len(cfg.Keys))}
+	names := make(map[string]bool, len(cfg.Keys))
+	for i, k := range cfg.Keys {
+		if k == nil || k.Name == "" {
+			return nil, errors.Errorf("key %d has no name", i)
+		}
+		if names[k.Name] {
+			return nil, errors.Errorf("key name %q is used twice", k.Name)
+		}
+		names[k.Name] = true
+		if len(k.Token) < 16 {
+			return nil, errors.Errorf("token of key %q is shorter than 16 characters", k.Name)
+		}
+		if len(k.Scopes) == 0 {
+			return nil, errors.Errorf("key %q grants no scopes", k.Name)
+		}
+		for _, s := range k.Scopes {
+			switch s {
+			case ReadScope, ArchiveScope, ValidatorScope, DebugScope, AdminScope, AllScopes:
+			default:
+				return nil, errors.Errorf("unknown scope %q of key %q", s, k.Name)
+			}
+		}
+		h := sha256.Sum256([]byte(k.Token))
+		if _, ok := keys.byHash[h]; ok {
+			return nil, errors.Errorf("token of key %q is used twice", k.Name)
+		}
+		keys.byHash[h] = k
+	}
+	return keys, nil
+}
+
+// Lookup returns the key with the given token, nil if there is none.
+func (k *Keys) Lookup(token string) *Key {
+	return k.byHash[sha256.Sum256([]byte(token))]
+}
+
+// Allows returns true if the key grants the scope.
+func (k *Key) Allows(scope Scope) bool {
+	for _, s := range k.Scopes {
+		if s == scope || s == AllScopes {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,260 @@
+package apikeys
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	ethpbv1 "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	orchestratorToken = "orchestrator-token-0123456789"
+	dashboardToken    = "dashboard-token-0123456789"
+)
+
+func testKeys(t *testing.T) *Keys {
+	keys, err := NewKeys(&KeysConfig{Keys: []*Key{
+		{Name: "orchestrator", Token: orchestratorToken, Scopes: []Scope{AllScopes}, Priority: true},
+		{Name: "dashboard", Token: dashboardToken, Scopes: []Scope{ReadScope}},
+	}})
+	require.NoError(t, err)
+	return keys
+}
+
+func TestLoadKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`
+keys:
+  - name: orchestrator
+    token: orchestrator-token-0123456789
+    scopes: ["*"]
+    priority: true
+  - name: dashboard
+    token: dashboard-token-0123456789
+    scopes: [read, debug]
+`), params.BeaconIoConfig().ReadWritePermissions))
+	keys, err := LoadKeys(path)
+	require.NoError(t, err)
+	key := keys.Lookup(dashboardToken)
+	require.NotNil(t, key)
+	assert.Equal(t, "dashboard", key.Name)
+	assert.Equal(t, true, key.Allows(DebugScope))
+	assert.Equal(t, false, key.Allows(ArchiveScope))
+	assert.Equal(t, true, keys.Lookup(orchestratorToken).Allows(ArchiveScope))
+	assert.Equal(t, true, keys.Lookup(orchestratorToken).Priority)
+	assert.Equal(t, false, key.Priority)
+	assert.Equal(t, true, keys.Lookup("unknown-token-0123456789") == nil)
+
+	require.NoError(t, ioutil.WriteFile(path, []byte("keys:\n  - name: a\n    token: abc\n    scopes: [read]\n"), params.BeaconIoConfig().ReadWritePermissions))
+	_, err = LoadKeys(path)
+	assert.ErrorContains(t, "shorter than 16 characters", err)
+}
+
+func TestNewKeys_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		keys []*Key
+		err  string
+	}{
+		{name: "no keys", err: "no API keys configured"},
+		{name: "no name", keys: []*Key{{Token: orchestratorToken, Scopes: []Scope{ReadScope}}}, err: "has no name"},
+		{name: "no scopes", keys: []*Key{{Name: "a", Token: orchestratorToken}}, err: "grants no scopes"},
+		{name: "unknown scope", keys: []*Key{{Name: "a", Token: orchestratorToken, Scopes: []Scope{"write"}}}, err: "unknown scope"},
+		{
+			name: "duplicate name",
+			keys: []*Key{
+				{Name: "a", Token: orchestratorToken, Scopes: []Scope{ReadScope}},
+				{Name: "a", Token: dashboardToken, Scopes: []Scope{ReadScope}},
+			},
+			err: "used twice",
+		},
+		{
+			name: "duplicate token",
+			keys: []*Key{
+				{Name: "a", Token: orchestratorToken, Scopes: []Scope{ReadScope}},
+				{Name: "b", Token: orchestratorToken, Scopes: []Scope{ReadScope}},
+			},
+			err: "used twice",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewKeys(&KeysConfig{Keys: tt.keys})
+			assert.ErrorContains(t, tt.err, err)
+		})
+	}
+}
+
+func TestRequiredScope(t *testing.T) {
+	assert.Equal(t, ArchiveScope, RequiredScope("/ethereum.eth.v1alpha1.BeaconChain/ListValidatorAssignments"))
+	assert.Equal(t, ArchiveScope, RequiredScope("/ethereum.beacon.rpc.v1.BeaconQuery/GetProposerStats"))
+	assert.Equal(t, ArchiveScope, RequiredScope("/ethereum.beacon.rpc.v1.BeaconQuery/StreamEpochInfo"))
+	assert.Equal(t, ArchiveScope, RequiredScope("/ethereum.beacon.rpc.v1.BeaconQuery/GetEpochInfoAccumulator"))
+	assert.Equal(t, ArchiveScope, RequiredScope("/ethereum.beacon.rpc.v1.BeaconQuery/ListAnnotatedValidatorAssignments"))
+	assert.Equal(t, ArchiveScope, RequiredScope("/ethereum.beacon.rpc.v1.BeaconQuery/ListTrackedValidatorBalances"))
+	assert.Equal(t, ArchiveScope, RequiredScope("/ethereum.beacon.rpc.v1.BeaconQuery/GetStateDiff"))
+	assert.Equal(t, ReadScope, RequiredScope("/ethereum.eth.v1alpha1.BeaconChain/GetChainHead"))
+	assert.Equal(t, ReadScope, RequiredScope("/ethereum.beacon.rpc.v1.BeaconQuery/GetNetworkConfig"))
+	assert.Equal(t, ValidatorScope, RequiredScope("/ethereum.eth.v1alpha1.BeaconChain/SubmitProposerSlashing"))
+	assert.Equal(t, ValidatorScope, RequiredScope("/ethereum.eth.v1alpha1.BeaconNodeValidator/GetDuties"))
+	assert.Equal(t, ValidatorScope, RequiredScope("/ethereum.beacon.rpc.v1.BeaconQuery/GetDuties"))
+	assert.Equal(t, ValidatorScope, RequiredScope("/ethereum.eth.v1.BeaconChain/SubmitBlock"))
+	assert.Equal(t, DebugScope, RequiredScope("/ethereum.beacon.rpc.v1.Debug/GetBeaconState"))
+	assert.Equal(t, AdminScope, RequiredScope("/ethereum.beacon.rpc.v1.Admin/UpdateSettings"))
+	assert.Equal(t, AdminScope, RequiredScope("/ethereum.beacon.rpc.v1.Genesis/SubmitGenesis"))
+	assert.Equal(t, AdminScope, RequiredScope("/ethereum.beacon.rpc.v1.BeaconQuery/ConfirmPandoraBlockHashes"))
+	assert.Equal(t, AdminScope, RequiredScope("/ethereum.beacon.rpc.v1.BeaconQuery/UpdateTrackedValidators"))
+	assert.Equal(t, AdminScope, RequiredScope("/ethereum.beacon.rpc.v1.BeaconQuery/ImportTrackedValidators"))
+	assert.Equal(t, AdminScope, RequiredScope("/ethereum.beacon.rpc.v1.BeaconQuery/PrefetchEpochInfo"))
+	assert.Equal(t, ReadScope, RequiredScope("/ethereum.eth.v1alpha1.Node/GetSyncStatus"))
+
+	// Methods without a scope are restricted to admin keys.
+	assert.Equal(t, AdminScope, RequiredScope("/ethereum.beacon.rpc.v1.BeaconQuery/Unknown"))
+	assert.Equal(t, AdminScope, RequiredScope("/ethereum.eth.v1alpha1.BeaconChain/Unknown"))
+	assert.Equal(t, AdminScope, RequiredScope("/ethereum.beacon.rpc.v1.Unknown/GetSettings"))
+}
+
+func TestMethodScope_EveryServedMethod(t *testing.T) {
+	s := grpc.NewServer()
+	ethpb.RegisterNodeServer(s, &ethpb.UnimplementedNodeServer{})
+	ethpb.RegisterBeaconChainServer(s, &ethpb.UnimplementedBeaconChainServer{})
+	ethpb.RegisterBeaconNodeValidatorServer(s, &ethpb.UnimplementedBeaconNodeValidatorServer{})
+	ethpbv1.RegisterBeaconNodeServer(s, &ethpbv1.UnimplementedBeaconNodeServer{})
+	ethpbv1.RegisterBeaconChainServer(s, &ethpbv1.UnimplementedBeaconChainServer{})
+	ethpbv1.RegisterBeaconDebugServer(s, &ethpbv1.UnimplementedBeaconDebugServer{})
+	pbrpc.RegisterHealthServer(s, &pbrpc.UnimplementedHealthServer{})
+	pbrpc.RegisterDebugServer(s, &pbrpc.UnimplementedDebugServer{})
+	pbrpc.RegisterBeaconQueryServer(s, &pbrpc.UnimplementedBeaconQueryServer{})
+	pbrpc.RegisterGenesisServer(s, &pbrpc.UnimplementedGenesisServer{})
+	pbrpc.RegisterAdminServer(s, &pbrpc.UnimplementedAdminServer{})
+	reflection.Register(s)
+
+	for service, info := range s.GetServiceInfo() {
+		for _, method := range info.Methods {
+			fullMethod := "/" + service + "/" + method.Name
+			_, ok := MethodScope(fullMethod)
+			assert.Equal(t, true, ok, "No scope for %s", fullMethod)
+		}
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := testKeys(t).UnaryServerInterceptor()
+	var authorized *Key
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		authorized = KeyFromContext(ctx)
+		return "ok", nil
+	}
+	call := func(token, method string) error {
+		ctx := context.Background()
+		if token != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+		}
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+	archive := "/ethereum.eth.v1alpha1.BeaconChain/ListBeaconCommittees"
+	head := "/ethereum.eth.v1alpha1.BeaconChain/GetChainHead"
+
+	assert.Equal(t, codes.Unauthenticated, status.Code(call("", head)))
+	assert.Equal(t, codes.Unauthenticated, status.Code(call("unknown-token-0123456789", head)))
+	assert.NoError(t, call(dashboardToken, head))
+	assert.Equal(t, codes.PermissionDenied, status.Code(call(dashboardToken, archive)))
+	assert.NoError(t, call(orchestratorToken, archive))
+	require.NotNil(t, authorized)
+	assert.Equal(t, "orchestrator", authorized.Name, "Expected the key of the call in its context")
+	assert.Equal(t, true, KeyFromContext(context.Background()) == nil)
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	interceptor := testKeys(t).StreamServerInterceptor()
+	handled := false
+	var authorized *Key
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		handled = true
+		authorized = KeyFromContext(stream.Context())
+		return nil
+	}
+	info := &grpc.StreamServerInfo{FullMethod: "/ethereum.eth.v1alpha1.BeaconNodeValidator/StreamDuties"}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+dashboardToken))
+	err := interceptor(nil, &testServerStream{ctx: ctx}, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Equal(t, false, handled)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "bearer "+orchestratorToken))
+	require.NoError(t, interceptor(nil, &testServerStream{ctx: ctx}, info, handler))
+	assert.Equal(t, true, handled)
+	require.NotNil(t, authorized)
+	assert.Equal(t, "orchestrator", authorized.Name)
+}
+
+func TestInterceptors_EpochInfoRangeRequiresArchiveScope(t *testing.T) {
+	keys := testKeys(t)
+	unary := keys.UnaryServerInterceptor()
+	stream := keys.StreamServerInterceptor()
+	call := func(token string) (error, error) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+		_, unaryErr := unary(ctx, nil, &grpc.UnaryServerInfo{
+			FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetEpochInfoAccumulator",
+		}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "ok", nil
+		})
+		streamErr := stream(nil, &testServerStream{ctx: ctx}, &grpc.StreamServerInfo{
+			FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/StreamEpochInfo",
+		}, func(srv interface{}, stream grpc.ServerStream) error {
+			return nil
+		})
+		return unaryErr, streamErr
+	}
+
+	// A read-scoped key can not replay the epoch infos of past epochs.
+	unaryErr, streamErr := call(dashboardToken)
+	assert.Equal(t, codes.PermissionDenied, status.Code(unaryErr))
+	assert.Equal(t, codes.PermissionDenied, status.Code(streamErr))
+	unaryErr, streamErr = call(orchestratorToken)
+	assert.NoError(t, unaryErr)
+	assert.NoError(t, streamErr)
+}
+
+func TestHTTPMiddleware(t *testing.T) {
+	handler := testKeys(t).HTTPMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	serve := func(method, authorization string) int {
+		req := httptest.NewRequest(method, "/eth/v1alpha1/beacon/chainhead", nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+	assert.Equal(t, http.StatusUnauthorized, serve(http.MethodGet, ""))
+	assert.Equal(t, http.StatusUnauthorized, serve(http.MethodGet, "Bearer unknown-token-0123456789"))
+	assert.Equal(t, http.StatusOK, serve(http.MethodGet, "Bearer "+dashboardToken))
+	assert.Equal(t, http.StatusOK, serve(http.MethodOptions, ""))
+}
@@ -0,0 +1,29 @@
+package apikeys
+
+import (
+	"net/http"
+)
+
+// HTTPMiddleware rejects HTTP requests without a known API key in their Authorization header. The
+// scope of the requested endpoint is checked by the gRPC server the gateway forwards the header to.
+// CORS preflight requests carry no credentials and are passed through.
+func (k *Keys) HTTPMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodOptions {
+			next.ServeHTTP(w, r)
+			return
+		}
+		value := r.Header.Get("Authorization")
+		if value == "" {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "Missing API key", http.StatusUnauthorized)
+			return
+		}
+		if k.Lookup(bearerToken(value)) == nil {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "Unknown API key", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,78 @@
+package apikeys
+
+import (
+	"context"
+	"strings"
+
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// authorizationKey is the metadata key carrying the API key, as "Bearer <token>". The gRPC gateway
+// forwards the Authorization header of HTTP requests under the same key.
+const authorizationKey = "authorization"
+
+type keyContextKey struct{}
+
+// KeyFromContext returns the API key a call was authorized with, nil if the call did not pass
+// through the interceptors of the keys.
+func KeyFromContext(ctx context.Context) *Key {
+	key, _ := ctx.Value(keyContextKey{}).(*Key)
+	return key
+}
+
+// UnaryServerInterceptor rejects unary calls without an API key granting the scope of the method.
+// The key of an authorized call is available through KeyFromContext.
+func (k *Keys) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		key, err := k.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(context.WithValue(ctx, keyContextKey{}, key), req)
+	}
+}
+
+// StreamServerInterceptor rejects streams without an API key granting the scope of the method.
+// The key of an authorized stream is available through KeyFromContext.
+func (k *Keys) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		key, err := k.authorize(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		wrapped := middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = context.WithValue(ss.Context(), keyContextKey{}, key)
+		return handler(srv, wrapped)
+	}
+}
+
+func (k *Keys) authorize(ctx context.Context, fullMethod string) (*Key, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(authorizationKey)
+	if len(values) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "Missing API key")
+	}
+	key := k.Lookup(bearerToken(values[0]))
+	if key == nil {
+		return nil, status.Error(codes.Unauthenticated, "Unknown API key")
+	}
+	scope := RequiredScope(fullMethod)
+	if !key.Allows(scope) {
+		apiKeyDenials.WithLabelValues(key.Name, string(scope)).Inc()
+		return nil, status.Errorf(codes.PermissionDenied, "API key %q does not grant the %s scope", key.Name, scope)
+	}
+	return key, nil
+}
+
+// bearerToken strips the "Bearer " prefix of an authorization value.
+func bearerToken(value string) string {
+	const prefix = "bearer "
+	if len(value) >= len(prefix) && strings.EqualFold(value[:len(prefix)], prefix) {
+		return strings.TrimSpace(value[len(prefix):])
+	}
+	return strings.TrimSpace(value)
+}
@@ -0,0 +1,14 @@
+package apikeys
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var apiKeyDenials = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "rpc_api_key_denials_total",
+		Help: "The number of RPC calls denied because the API key lacks the scope of the method.",
+	},
+	[]string{"key", "scope"},
+)
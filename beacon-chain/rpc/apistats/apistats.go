@@ -0,0 +1,187 @@
+// Package apistats records the usage of the beacon node RPC methods per consumer, so that operators
+// can tell which of the orchestrator, the explorers or the validator clients load the node.
+package apistats
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "rpc")
+
+// maxConsumers bounds the number of consumers recorded apart, as the user agents identifying the
+// callers without an API key are chosen by the callers. Calls of further consumers are recorded
+// under OtherConsumer.
+const maxConsumers = 64
+
+const (
+	// UnknownConsumer is the consumer of calls without an API key nor a user agent.
+	UnknownConsumer = "unknown"
+	// OtherConsumer is the consumer of calls once maxConsumers consumers are recorded.
+	OtherConsumer = "other"
+)
+
+// MethodStats is the usage of a method by a consumer.
+type MethodStats struct {
+	Method   string `json:"method"`
+	Consumer string `json:"consumer"`
+	// Calls is the number of unary calls and streams served.
+	Calls uint64 `json:"calls"`
+	// Errors is the number of calls and streams which ended with an error.
+	Errors uint64 `json:"errors"`
+	// BytesServed is the encoded size of the responses and stream messages sent.
+	BytesServed uint64 `json:"bytes_served"`
+	// AverageLatency is the average duration of the unary calls. Streams are long lived, so their
+	// duration is not averaged.
+	AverageLatency time.Duration `json:"-"`
+	// AverageLatencySeconds is the AverageLatency in seconds, for JSON consumers.
+	AverageLatencySeconds float64 `json:"average_latency_seconds"`
+}
+
+// Stats is the usage of the RPC methods since the node started.
+type Stats struct {
+	Since   time.Time      `json:"since"`
+	Methods []*MethodStats `json:"methods"`
+}
+
+type statsKey struct {
+	method   string
+	consumer string
+}
+
+type methodCounters struct {
+	calls        uint64
+	errors       uint64
+	bytesServed  uint64
+	unaryCalls   uint64
+	totalLatency time.Duration
+}
+
+// Recorder records the usage of the RPC methods.
+type Recorder struct {
+	since     time.Time
+	lock      sync.Mutex
+	counters  map[statsKey]*methodCounters
+	consumers map[string]bool
+}
+
+// NewRecorder returns a recorder of the calls from now on.
+func NewRecorder() *Recorder {
+	return &Recorder{
+		since:     timeutils.Now(),
+		counters:  make(map[statsKey]*methodCounters),
+		consumers: make(map[string]bool),
+	}
+}
+
+// recordUnary records a completed unary call.
+func (r *Recorder) recordUnary(method, consumer string, bytesServed int, latency time.Duration, failed bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	c := r.countersOf(method, consumer)
+	c.calls++
+	c.unaryCalls++
+	c.totalLatency += latency
+	c.bytesServed += uint64(bytesServed)
+	if failed {
+		c.errors++
+	}
+}
+
+// recordStream records an opened stream.
+func (r *Recorder) recordStream(method, consumer string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.countersOf(method, consumer).calls++
+}
+
+// recordStreamMessage records a message sent on a stream.
+func (r *Recorder) recordStreamMessage(method, consumer string, bytesServed int) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.countersOf(method, consumer).bytesServed += uint64(bytesServed)
+}
+
+// recordStreamError records a stream which ended with an error.
+func (r *Recorder) recordStreamError(method, consumer string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.countersOf(method, consumer).errors++
+}
+
+// countersOf returns the counters of a method and consumer, creating them if needed. The lock must
+// be held.
+func (r *Recorder) countersOf(method, consumer string) *methodCounters {
+	if !r.consumers[consumer] {
+		if len(r.consumers) >= maxConsumers {
+			consumer = OtherConsumer
+		}
+		r.consumers[consumer] = true
+	}
+	key := statsKey{method: method, consumer: consumer}
+	c, ok := r.counters[key]
+	if !ok {
+		c = &methodCounters{}
+		r.counters[key] = c
+	}
+	return c
+}
+
+// Stats returns the usage of every method called since the recorder was created, by method and
+// consumer.
+func (r *Recorder) Stats() *Stats {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	methods := make([]*MethodStats, 0, len(r.counters))
+	for key, c := range r.counters {
+		stats := &MethodStats{
+			Method:      key.method,
+			Consumer:    key.consumer,
+			Calls:       c.calls,
+			Errors:      c.errors,
+			BytesServed: c.bytesServed,
+		}
+		if c.unaryCalls > 0 {
+			stats.AverageLatency = c.totalLatency / time.Duration(c.unaryCalls)
+			stats.AverageLatencySeconds = stats.AverageLatency.Seconds()
+		}
+		methods = append(methods, stats)
+	}
+	sort.Slice(methods, func(i, j int) bool {
+		if methods[i].Method != methods[j].Method {
+			return methods[i].Method < methods[j].Method
+		}
+		return methods[i].Consumer < methods[j].Consumer
+	})
+	return &Stats{Since: r.since, Methods: methods}
+}
+
+// Handler serves the usage of the RPC methods as JSON. The consumer query parameter restricts the
+// usage to a single consumer.
+func (r *Recorder) Handler(w http.ResponseWriter, req *http.Request) {
+	stats := r.Stats()
+	if consumer := req.URL.Query().Get("consumer"); consumer != "" {
+		methods := make([]*MethodStats, 0, len(stats.Methods))
+		for _, m := range stats.Methods {
+			if m.Consumer == consumer {
+				methods = append(methods, m)
+			}
+		}
+		stats.Methods = methods
+	}
+	body, err := json.Marshal(stats)
+	if err != nil {
+		http.Error(w, "Could not marshal API stats", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(body); err != nil {
+		log.WithError(err).Debug("Could not write API stats")
+	}
+}
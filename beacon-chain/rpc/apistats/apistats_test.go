@@ -0,0 +1,143 @@
+package apistats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	chainHeadMethod  = "/ethereum.eth.v1alpha1.BeaconChain/GetChainHead"
+	streamBlocksName = "/ethereum.eth.v1alpha1.BeaconChain/StreamBlocks"
+)
+
+func userAgentContext(userAgent string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("user-agent", userAgent))
+}
+
+func TestRecorder_UnaryCalls(t *testing.T) {
+	r := NewRecorder()
+	interceptor := r.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: chainHeadMethod}
+	head := &ethpb.ChainHead{HeadSlot: 10, HeadBlockRoot: make([]byte, 32)}
+	ok := func(context.Context, interface{}) (interface{}, error) { return head, nil }
+	failing := func(context.Context, interface{}) (interface{}, error) { return nil, errors.New("failed") }
+
+	for i := 0; i < 2; i++ {
+		_, err := interceptor(userAgentContext("explorer/1.0"), nil, info, ok)
+		require.NoError(t, err)
+	}
+	_, err := interceptor(userAgentContext("explorer/1.0"), nil, info, failing)
+	require.ErrorContains(t, "failed", err)
+	_, err = interceptor(context.Background(), nil, info, ok)
+	require.NoError(t, err)
+	// The user agent of HTTP requests forwarded by the gateway identifies their consumer.
+	gatewayCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"user-agent", "grpc-go/1.29.1",
+		"grpcgateway-user-agent", "curl/7.68.0",
+	))
+	_, err = interceptor(gatewayCtx, nil, info, ok)
+	require.NoError(t, err)
+
+	stats := r.Stats().Methods
+	require.Equal(t, 3, len(stats))
+	assert.Equal(t, "curl/7.68.0", stats[0].Consumer)
+	explorer := stats[1]
+	assert.Equal(t, chainHeadMethod, explorer.Method)
+	assert.Equal(t, "explorer/1.0", explorer.Consumer)
+	assert.Equal(t, uint64(3), explorer.Calls)
+	assert.Equal(t, uint64(1), explorer.Errors)
+	assert.Equal(t, uint64(2*head.Size()), explorer.BytesServed)
+	assert.Equal(t, UnknownConsumer, stats[2].Consumer)
+	assert.Equal(t, uint64(1), stats[2].Calls)
+}
+
+func TestRecorder_BoundsConsumers(t *testing.T) {
+	r := NewRecorder()
+	interceptor := r.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: chainHeadMethod}
+	ok := func(context.Context, interface{}) (interface{}, error) { return &ethpb.ChainHead{}, nil }
+	for i := 0; i < maxConsumers+10; i++ {
+		_, err := interceptor(userAgentContext(fmt.Sprintf("client/%d", i)), nil, info, ok)
+		require.NoError(t, err)
+	}
+	stats := r.Stats().Methods
+	require.Equal(t, maxConsumers+1, len(stats))
+	var other *MethodStats
+	for _, s := range stats {
+		if s.Consumer == OtherConsumer {
+			other = s
+		}
+	}
+	require.NotNil(t, other)
+	assert.Equal(t, uint64(10), other.Calls)
+}
+
+type sendingStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *sendingStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *sendingStream) SendMsg(interface{}) error {
+	return nil
+}
+
+func TestRecorder_Streams(t *testing.T) {
+	r := NewRecorder()
+	interceptor := r.StreamServerInterceptor()
+	info := &grpc.StreamServerInfo{FullMethod: streamBlocksName, IsServerStream: true}
+	block := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 3}, Signature: make([]byte, 96)}
+	ss := &sendingStream{ctx: userAgentContext("orchestrator")}
+	err := interceptor(nil, ss, info, func(_ interface{}, stream grpc.ServerStream) error {
+		for i := 0; i < 3; i++ {
+			if err := stream.SendMsg(block); err != nil {
+				return err
+			}
+		}
+		return errors.New("stream ended")
+	})
+	require.ErrorContains(t, "stream ended", err)
+
+	stats := r.Stats().Methods
+	require.Equal(t, 1, len(stats))
+	assert.Equal(t, "orchestrator", stats[0].Consumer)
+	assert.Equal(t, uint64(1), stats[0].Calls)
+	assert.Equal(t, uint64(1), stats[0].Errors)
+	assert.Equal(t, uint64(3*block.Size()), stats[0].BytesServed)
+	assert.Equal(t, float64(0), stats[0].AverageLatencySeconds)
+}
+
+func TestRecorder_Handler(t *testing.T) {
+	r := NewRecorder()
+	interceptor := r.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: chainHeadMethod}
+	ok := func(context.Context, interface{}) (interface{}, error) { return &ethpb.ChainHead{HeadSlot: 1}, nil }
+	for _, consumer := range []string{"explorer", "orchestrator"} {
+		_, err := interceptor(userAgentContext(consumer), nil, info, ok)
+		require.NoError(t, err)
+	}
+
+	rec := httptest.NewRecorder()
+	r.Handler(rec, httptest.NewRequest(http.MethodGet, "/debug/apistats?consumer=orchestrator", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	stats := &Stats{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), stats))
+	require.Equal(t, 1, len(stats.Methods))
+	assert.Equal(t, "orchestrator", stats.Methods[0].Consumer)
+	assert.Equal(t, uint64(1), stats.Methods[0].Calls)
+}
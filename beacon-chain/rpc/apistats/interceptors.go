@@ -0,0 +1,86 @@
+package apistats
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apikeys"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// userAgentKeys are the metadata keys identifying the callers without an API key, by precedence.
+// The gRPC gateway forwards the User-Agent of HTTP requests under the grpcgateway prefix, while
+// its own gRPC client sets the user-agent.
+var userAgentKeys = []string{"grpcgateway-user-agent", "user-agent"}
+
+// UnaryServerInterceptor records the unary calls, their response size and their duration. It
+// identifies the consumer by its API key, so it must come after the interceptors of the keys.
+func (r *Recorder) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		res, err := handler(ctx, req)
+		r.recordUnary(info.FullMethod, consumerOf(ctx), messageSize(res), time.Since(start), err != nil)
+		return res, err
+	}
+}
+
+// StreamServerInterceptor records the streams and the size of the messages they send. It
+// identifies the consumer by its API key, so it must come after the interceptors of the keys.
+func (r *Recorder) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		consumer := consumerOf(ss.Context())
+		r.recordStream(info.FullMethod, consumer)
+		err := handler(srv, &recordingStream{
+			ServerStream: ss,
+			recorder:     r,
+			method:       info.FullMethod,
+			consumer:     consumer,
+		})
+		if err != nil {
+			r.recordStreamError(info.FullMethod, consumer)
+		}
+		return err
+	}
+}
+
+// recordingStream records the size of the messages sent on a stream.
+type recordingStream struct {
+	grpc.ServerStream
+	recorder *Recorder
+	method   string
+	consumer string
+}
+
+// SendMsg sends a message and records its size once sent.
+func (s *recordingStream) SendMsg(m interface{}) error {
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+	s.recorder.recordStreamMessage(s.method, s.consumer, messageSize(m))
+	return nil
+}
+
+// consumerOf returns the name of the API key of a call, and otherwise the user agent of its caller.
+func consumerOf(ctx context.Context) string {
+	if key := apikeys.KeyFromContext(ctx); key != nil {
+		return key.Name
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, k := range userAgentKeys {
+		if values := md.Get(k); len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+	return UnknownConsumer
+}
+
+// messageSize returns the encoded size of a protobuf message, and zero for anything else.
+func messageSize(m interface{}) int {
+	msg, ok := m.(proto.Message)
+	if !ok || msg == nil {
+		return 0
+	}
+	return proto.Size(msg)
+}
@@ -4,66 +4,207 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
-	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/pagination"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 const errEpoch = "Cannot retrieve information about an epoch in the future, current epoch %d, requesting %d"
 
+// maxAssignmentEpochRange is the maximum number of epochs a single assignments range request may cover.
+const maxAssignmentEpochRange = types.Epoch(32)
+
+// futureEpochError returns an InvalidArgument error carrying structured epoch details
+// for requests targeting an epoch after the current one.
+func futureEpochError(currentEpoch, requestedEpoch types.Epoch) error {
+	return grpcutils.EpochOutOfRangeError(
+		codes.InvalidArgument,
+		&grpcutils.EpochOutOfRange{
+			Current:   currentEpoch,
+			Requested: requestedEpoch,
+		},
+		errEpoch,
+		currentEpoch,
+		requestedEpoch,
+	)
+}
+
+// checkEpochAdmission returns a future epoch error if the requested epoch is after the current
+// epoch. Requests for the next epoch are admitted during the last NextEpochGraceSlots slots of the
+// current epoch, as its duties are already determined by then.
+func (bs *Server) checkEpochAdmission(requestedEpoch types.Epoch) error {
+	currentSlot := bs.GenesisTimeFetcher.CurrentSlot()
+	currentEpoch := helpers.SlotToEpoch(currentSlot)
+	if requestedEpoch <= currentEpoch {
+		return nil
+	}
+	if requestedEpoch == currentEpoch+1 && bs.NextEpochGraceSlots > 0 {
+		nextEpochStart, err := helpers.StartSlot(requestedEpoch)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "Could not compute start slot of epoch %d: %v", requestedEpoch, err)
+		}
+		if nextEpochStart-currentSlot <= bs.NextEpochGraceSlots {
+			return nil
+		}
+	}
+	return futureEpochError(currentEpoch, requestedEpoch)
+}
+
 // ListValidatorAssignments retrieves the validator assignments for a given epoch,
 // optional validator indices or public keys may be included to filter validator assignments.
 func (bs *Server) ListValidatorAssignments(
 	ctx context.Context, req *ethpb.ListValidatorAssignmentsRequest,
 ) (*ethpb.ValidatorAssignments, error) {
-	if int(req.PageSize) > cmd.Get().MaxRPCPageSize {
+	annotatedReq := &pbrpc.AnnotatedValidatorAssignmentsRequest{
+		PublicKeys: req.PublicKeys,
+		Indices:    req.Indices,
+		PageSize:   req.PageSize,
+		PageToken:  req.PageToken,
+	}
+	switch q := req.QueryFilter.(type) {
+	case *ethpb.ListValidatorAssignmentsRequest_Genesis:
+		annotatedReq.QueryFilter = &pbrpc.AnnotatedValidatorAssignmentsRequest_Genesis{Genesis: q.Genesis}
+	case *ethpb.ListValidatorAssignmentsRequest_Epoch:
+		annotatedReq.QueryFilter = &pbrpc.AnnotatedValidatorAssignmentsRequest_Epoch{Epoch: q.Epoch}
+	}
+	res, err := bs.ListAnnotatedValidatorAssignments(ctx, annotatedReq)
+	if err != nil {
+		return nil, err
+	}
+	return res.Assignments, nil
+}
+
+// ListAnnotatedValidatorAssignments retrieves the validator assignments for a given epoch like
+// ListValidatorAssignments, along with the proposer list root of the epoch.
+func (bs *Server) ListAnnotatedValidatorAssignments(
+	ctx context.Context, req *pbrpc.AnnotatedValidatorAssignmentsRequest,
+) (*pbrpc.AnnotatedValidatorAssignments, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconChainServer.ListAnnotatedValidatorAssignments")
+	defer span.End()
+
+	if err := bs.waitForAssignmentsWarmUp(ctx); err != nil {
+		return nil, err
+	}
+
+	if int(req.PageSize) > bs.MaxPageSize() {
 		return nil, status.Errorf(
 			codes.InvalidArgument,
 			"Requested page size %d can not be greater than max size %d",
 			req.PageSize,
-			cmd.Get().MaxRPCPageSize,
+			bs.MaxPageSize(),
 		)
 	}
 
-	var res []*ethpb.ValidatorAssignments_CommitteeAssignment
-	filtered := map[types.ValidatorIndex]bool{} // track filtered validators to prevent duplication in the response.
-	filteredIndices := make([]types.ValidatorIndex, 0)
-	var requestedEpoch types.Epoch
-	switch q := req.QueryFilter.(type) {
-	case *ethpb.ListValidatorAssignmentsRequest_Genesis:
-		if q.Genesis {
-			requestedEpoch = 0
+	blockRoot, requestedEpoch, byRoot, err := bs.assignmentsRootFilter(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if !byRoot {
+		switch q := req.QueryFilter.(type) {
+		case *pbrpc.AnnotatedValidatorAssignmentsRequest_Genesis:
+			if q.Genesis {
+				requestedEpoch = 0
+			}
+		case *pbrpc.AnnotatedValidatorAssignmentsRequest_Epoch:
+			requestedEpoch = q.Epoch
 		}
-	case *ethpb.ListValidatorAssignmentsRequest_Epoch:
-		requestedEpoch = q.Epoch
 	}
 
-	currentEpoch := helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
-	if requestedEpoch > currentEpoch {
-		return nil, status.Errorf(
-			codes.InvalidArgument,
-			errEpoch,
-			currentEpoch,
-			requestedEpoch,
-		)
+	span.AddAttributes(trace.Int64Attribute("epoch", int64(requestedEpoch)))
+
+	if err := bs.checkEpochAdmission(requestedEpoch); err != nil {
+		return nil, err
+	}
+	trackedOnly, err := bs.trackedOnly(req.TrackedOnly)
+	if err != nil {
+		return nil, err
 	}
 
-	startSlot, err := helpers.StartSlot(requestedEpoch)
+	filter, err := hashutil.HashProto(req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not hash request: %v", err)
+	}
+	withdrawalPrefix, err := withdrawalCredentialsPrefix(req.WithdrawalCredentialsPrefix)
 	if err != nil {
 		return nil, err
 	}
-	requestedState, err := bs.StateGen.StateBySlot(ctx, startSlot)
+	if req.QueryFilter != nil && !byRoot && !trackedOnly && !req.IndexOnly && !req.CommitteeWeights &&
+		!req.IncludeValidatorFields && !req.Compact && withdrawalPrefix == nil {
+		bs.reportImmutableResponse(ctx, requestedEpoch)
+	}
+	key := assignmentsKey{
+		epoch:     requestedEpoch,
+		blockRoot: blockRoot,
+		filter:    filter,
+	}
+	return bs.assignmentCalls.do(ctx, key, func(ctx context.Context) (*pbrpc.AnnotatedValidatorAssignments, error) {
+		return bs.listValidatorAssignments(
+			ctx, req, requestedEpoch, blockRoot, withdrawalPrefix, trackedOnly,
+		)
+	})
+}
+
+// listValidatorAssignments computes the validator assignments of the request at the given epoch,
+// from the post-state of the block root if it is set. If the withdrawal credentials prefix is set,
+// the assignments are restricted to the validators with matching credentials. If the request asks
+// for them, the weights of the committees and the registry fields of the validators of the page are
+// returned with the assignments. The committee positions of the page are always returned, and the
+// committee members are left out of the assignments of compact requests.
+func (bs *Server) listValidatorAssignments(
+	ctx context.Context,
+	req *pbrpc.AnnotatedValidatorAssignmentsRequest,
+	requestedEpoch types.Epoch,
+	blockRoot [32]byte,
+	withdrawalPrefix []byte,
+	trackedOnly bool,
+) (*pbrpc.AnnotatedValidatorAssignments, error) {
+	filtered := map[types.ValidatorIndex]bool{} // track filtered validators to prevent duplication in the response.
+	filteredIndices := make([]types.ValidatorIndex, 0)
+
+	requestedState, err := bs.assignmentsState(ctx, requestedEpoch, blockRoot)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not retrieve archived state for epoch %d: %v", requestedEpoch, err)
 	}
+	root, err := proposerListRoot(bs.CommitteeCache, requestedState, requestedEpoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute proposer list root: %v", err)
+	}
+
+	pubKeys, indices := req.PublicKeys, req.Indices
+	if trackedOnly {
+		pubKeys, indices = bs.TrackedValidators.restrict(requestedState, pubKeys, indices)
+	}
+	if withdrawalPrefix != nil && (!trackedOnly || len(pubKeys) > 0 || len(indices) > 0) {
+		pubKeys, indices, err = restrictByWithdrawalCredentials(requestedState, withdrawalPrefix, pubKeys, indices)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if (trackedOnly || withdrawalPrefix != nil) && len(pubKeys) == 0 && len(indices) == 0 {
+		return &pbrpc.AnnotatedValidatorAssignments{
+			Assignments: &ethpb.ValidatorAssignments{
+				Epoch:         requestedEpoch,
+				Assignments:   make([]*ethpb.ValidatorAssignments_CommitteeAssignment, 0),
+				TotalSize:     int32(0),
+				NextPageToken: strconv.Itoa(0),
+			},
+			ProposerListRoot: root[:],
+		}, nil
+	}
 
 	// Filter out assignments by public keys.
-	for _, pubKey := range req.PublicKeys {
+	for _, pubKey := range pubKeys {
 		index, ok := requestedState.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubKey))
 		if !ok {
 			return nil, status.Errorf(codes.NotFound, "Could not find validator index for public key %#x", pubKey)
@@ -71,63 +212,308 @@ func (bs *Server) ListValidatorAssignments(
 		filtered[index] = true
 		filteredIndices = append(filteredIndices, index)
 	}
+	mismatches, err := bs.validatorIndexMismatches(ctx, requestedState, pubKeys)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compare validator indices against head: %v", err)
+	}
+	logIndexMismatches(requestedEpoch, mismatches)
 
 	// Filter out assignments by validator indices.
-	for _, index := range req.Indices {
+	for _, index := range indices {
 		if !filtered[index] {
 			filteredIndices = append(filteredIndices, index)
 		}
 	}
 
-	activeIndices, err := helpers.ActiveValidatorIndices(requestedState, requestedEpoch)
+	activeIndices, err := helpers.ActiveValidatorIndices(bs.CommitteeCache, requestedState, requestedEpoch)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not retrieve active validator indices: %v", err)
 	}
 	if len(filteredIndices) == 0 {
 		if len(activeIndices) == 0 {
-			return &ethpb.ValidatorAssignments{
-				Assignments:   make([]*ethpb.ValidatorAssignments_CommitteeAssignment, 0),
-				TotalSize:     int32(0),
-				NextPageToken: strconv.Itoa(0),
+			return &pbrpc.AnnotatedValidatorAssignments{
+				Assignments: &ethpb.ValidatorAssignments{
+					Assignments:   make([]*ethpb.ValidatorAssignments_CommitteeAssignment, 0),
+					TotalSize:     int32(0),
+					NextPageToken: strconv.Itoa(0),
+				},
+				ProposerListRoot: root[:],
+				IndexMismatches:  mismatches,
 			}, nil
 		}
 		// If no filter was specified, return assignments from active validator indices with pagination.
 		filteredIndices = activeIndices
 	}
 
-	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), len(filteredIndices))
+	// Committees grow with the active validator count, so large pages are capped to keep the
+	// response within the byte budget. Compact assignments leave their committee out.
+	entrySize := assignmentEntrySize(uint64(len(activeIndices)), params.BeaconConfigOf(bs.ChainConfig).SlotsPerEpoch)
+	if req.Compact {
+		entrySize = assignmentEntryOverhead
+	}
+	pageSize, capped := bs.cappedPageSize(int(req.PageSize), entrySize)
+	var appliedPageSize int32
+	if capped {
+		reportCappedPage("ListValidatorAssignments")
+		appliedPageSize = int32(pageSize)
+	}
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, pageSize, len(filteredIndices))
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not paginate results: %v", err)
 	}
 
-	// Initialize all committee related data.
-	committeeAssignments, proposerIndexToSlots, err := helpers.CommitteeAssignments(requestedState, requestedEpoch)
+	// Initialize committee related data only for the validators on the requested page.
+	res, err := bs.assignmentsForIndices(ctx, requestedState, requestedEpoch, filteredIndices[start:end], req.IndexOnly)
+	if err != nil {
+		return nil, err
+	}
+	var weights []*pbrpc.CommitteeWeight
+	if req.CommitteeWeights {
+		weights, err = committeeWeights(requestedState, res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not compute committee weights: %v", err)
+		}
+	}
+	var fields []*pbrpc.ValidatorFields
+	if req.IncludeValidatorFields {
+		fields, err = validatorFields(requestedState, res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve validator fields: %v", err)
+		}
+	}
+	positions := committeePositions(res)
+	if req.Compact {
+		res = compactAssignments(res)
+	}
+
+	return &pbrpc.AnnotatedValidatorAssignments{
+		Assignments: &ethpb.ValidatorAssignments{
+			Epoch:         requestedEpoch,
+			Assignments:   res,
+			NextPageToken: nextPageToken,
+			TotalSize:     int32(len(filteredIndices)),
+		},
+		ProposerListRoot:   root[:],
+		IndexMismatches:    mismatches,
+		CommitteeWeights:   weights,
+		AppliedPageSize:    appliedPageSize,
+		ValidatorFields:    fields,
+		CommitteePositions: positions,
+	}, nil
+}
+
+// ListValidatorAssignmentsRange retrieves the assignments of the requested validators for every
+// epoch of a bounded range in a single call. The state of the first epoch is regenerated once and
+// advanced epoch by epoch, instead of regenerating a state per epoch as sequential
+// ListValidatorAssignments calls would.
+func (bs *Server) ListValidatorAssignmentsRange(
+	ctx context.Context, req *pbrpc.ListValidatorAssignmentsRangeRequest,
+) (*pbrpc.ValidatorAssignmentsRange, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconChainServer.ListValidatorAssignmentsRange")
+	defer span.End()
+
+	if req.FromEpoch > req.ToEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "From epoch %d is after to epoch %d", req.FromEpoch, req.ToEpoch)
+	}
+	if req.ToEpoch-req.FromEpoch >= maxAssignmentEpochRange {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested epoch range exceeds the maximum of %d epochs", maxAssignmentEpochRange)
+	}
+	trackedOnly, err := bs.trackedOnly(req.TrackedOnly)
+	if err != nil {
+		return nil, err
+	}
+	indexOnly := req.IndexOnly
+	withWeights := req.CommitteeWeights
+	withFields := req.IncludeValidatorFields
+	compact := req.Compact
+	withdrawalPrefix, err := withdrawalCredentialsPrefix(req.WithdrawalCredentialsPrefix)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.PublicKeys) == 0 && len(req.Indices) == 0 && !trackedOnly && withdrawalPrefix == nil {
+		return nil, status.Error(codes.InvalidArgument, "Must specify at least one public key or validator index")
+	}
+	if len(req.PublicKeys)+len(req.Indices) > bs.MaxPageSize() {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Requested validator count %d can not be greater than max size %d",
+			len(req.PublicKeys)+len(req.Indices),
+			bs.MaxPageSize(),
+		)
+	}
+	if err := bs.checkEpochAdmission(req.ToEpoch); err != nil {
+		return nil, err
+	}
+
+	st, err := bs.StateGen.CanonicalStateInEpoch(ctx, req.FromEpoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve archived state for epoch %d: %v", req.FromEpoch, err)
+	}
+	// The regenerated state may be shared with the state caches, so it is copied before being advanced.
+	st = st.Copy()
+
+	pubKeys, requestedIndices := req.PublicKeys, req.Indices
+	if trackedOnly {
+		// Without tracked validators to assign, only the proposer list roots are computed.
+		pubKeys, requestedIndices = bs.TrackedValidators.restrict(st, pubKeys, requestedIndices)
+	}
+	if withdrawalPrefix != nil && (!trackedOnly || len(pubKeys) > 0 || len(requestedIndices) > 0) {
+		pubKeys, requestedIndices, err = restrictByWithdrawalCredentials(st, withdrawalPrefix, pubKeys, requestedIndices)
+		if err != nil {
+			return nil, err
+		}
+		if len(pubKeys)+len(requestedIndices) > bs.MaxPageSize() {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"Matched validator count %d can not be greater than max size %d",
+				len(pubKeys)+len(requestedIndices),
+				bs.MaxPageSize(),
+			)
+		}
+	}
+
+	filtered := map[types.ValidatorIndex]bool{}
+	indices := make([]types.ValidatorIndex, 0, len(pubKeys)+len(requestedIndices))
+	for _, pubKey := range pubKeys {
+		index, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubKey))
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "Could not find validator index for public key %#x", pubKey)
+		}
+		filtered[index] = true
+		indices = append(indices, index)
+	}
+	mismatches, err := bs.validatorIndexMismatches(ctx, st, pubKeys)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compare validator indices against head: %v", err)
+	}
+	logIndexMismatches(req.FromEpoch, mismatches)
+	for _, index := range requestedIndices {
+		if !filtered[index] {
+			filtered[index] = true
+			indices = append(indices, index)
+		}
+	}
+
+	res := &pbrpc.ValidatorAssignmentsRange{
+		Epochs:            make([]*ethpb.ValidatorAssignments, 0, req.ToEpoch-req.FromEpoch+1),
+		IndexMismatches:   mismatches,
+		ProposerListRoots: make([][]byte, 0, req.ToEpoch-req.FromEpoch+1),
+	}
+	for epoch := req.FromEpoch; epoch <= req.ToEpoch; epoch++ {
+		if epoch > req.FromEpoch {
+			startSlot, err := helpers.StartSlot(epoch)
+			if err != nil {
+				return nil, err
+			}
+			st, err = bs.advanceState(ctx, st, startSlot)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not advance state to epoch %d: %v", epoch, err)
+			}
+		}
+		var assignments []*ethpb.ValidatorAssignments_CommitteeAssignment
+		if len(indices) > 0 {
+			assignments, err = bs.assignmentsForIndices(ctx, st, epoch, indices, indexOnly)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if withWeights {
+			weights, err := committeeWeights(st, assignments)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not compute committee weights of epoch %d: %v", epoch, err)
+			}
+			res.CommitteeWeights = append(res.CommitteeWeights, &pbrpc.EpochCommitteeWeights{Weights: weights})
+		}
+		if withFields {
+			fields, err := validatorFields(st, assignments)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not retrieve validator fields of epoch %d: %v", epoch, err)
+			}
+			res.ValidatorFields = append(res.ValidatorFields, &pbrpc.EpochValidatorFields{Fields: fields})
+		}
+		res.CommitteePositions = append(res.CommitteePositions, &pbrpc.EpochCommitteePositions{Positions: committeePositions(assignments)})
+		if compact {
+			assignments = compactAssignments(assignments)
+		}
+		root, err := proposerListRoot(bs.CommitteeCache, st, epoch)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not compute proposer list root of epoch %d: %v", epoch, err)
+		}
+		res.Epochs = append(res.Epochs, &ethpb.ValidatorAssignments{
+			Epoch:       epoch,
+			Assignments: assignments,
+			TotalSize:   int32(len(indices)),
+		})
+		res.ProposerListRoots = append(res.ProposerListRoots, root[:])
+	}
+	return res, nil
+}
+
+// advanceState replays the canonical blocks between the slot of the state and the target slot
+// on top of the state, processing any skipped slots up to the target slot.
+func (bs *Server) advanceState(ctx context.Context, st iface.BeaconState, slot types.Slot) (iface.BeaconState, error) {
+	root, blkSlot, err := bs.canonicalBlockAtOrBelow(ctx, slot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get canonical block at or before slot %d", slot)
+	}
+	var replay []*ethpb.SignedBeaconBlock
+	if blkSlot > st.Slot() {
+		replay, err = bs.StateGen.LoadBlocks(ctx, st.Slot()+1, blkSlot, root)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return bs.StateGen.ReplayBlocks(ctx, st, replay, slot)
+}
+
+// canonicalBlockAtOrBelow returns the root and slot of the latest canonical block at or before a
+// slot, from the canonical root index when the slot is indexed and from state gen otherwise, which
+// refuses to pick between the blocks of competing forks.
+func (bs *Server) canonicalBlockAtOrBelow(ctx context.Context, slot types.Slot) ([32]byte, types.Slot, error) {
+	if bs.CanonicalRootFetcher != nil {
+		root, blkSlot, err := bs.CanonicalRootFetcher.HighestCanonicalRootAtOrBelow(slot)
+		if err == nil {
+			return root, blkSlot, nil
+		}
+	}
+	return bs.StateGen.CanonicalBlockAtOrBelow(ctx, slot)
+}
+
+// assignmentsForIndices computes the committee and proposer assignments of the given
+// validator indices at the requested epoch. The public keys are left out if indexOnly is set.
+func (bs *Server) assignmentsForIndices(
+	ctx context.Context, st iface.BeaconState, epoch types.Epoch, indices []types.ValidatorIndex, indexOnly bool,
+) ([]*ethpb.ValidatorAssignments_CommitteeAssignment, error) {
+	_, span := trace.StartSpan(ctx, "BeaconChainServer.assignmentsForIndices")
+	defer span.End()
+	span.AddAttributes(
+		trace.Int64Attribute("epoch", int64(epoch)),
+		trace.Int64Attribute("validators", int64(len(indices))),
+	)
+
+	committeeAssignments, proposerIndexToSlots, err := helpers.CommitteeAssignmentsForIndices(ctx, bs.CommitteeCache, st, epoch, indices)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not compute committee assignments: %v", err)
 	}
 
-	for _, index := range filteredIndices[start:end] {
-		if uint64(index) >= uint64(requestedState.NumValidators()) {
+	var res []*ethpb.ValidatorAssignments_CommitteeAssignment
+	for _, index := range indices {
+		if uint64(index) >= uint64(st.NumValidators()) {
 			return nil, status.Errorf(codes.OutOfRange, "Validator index %d >= validator count %d",
-				index, requestedState.NumValidators())
+				index, st.NumValidators())
 		}
 		comAssignment := committeeAssignments[index]
-		pubkey := requestedState.PubkeyAtIndex(index)
 		assign := &ethpb.ValidatorAssignments_CommitteeAssignment{
 			BeaconCommittees: comAssignment.Committee,
 			CommitteeIndex:   comAssignment.CommitteeIndex,
 			AttesterSlot:     comAssignment.AttesterSlot,
 			ProposerSlots:    proposerIndexToSlots[index],
-			PublicKey:        pubkey[:],
 			ValidatorIndex:   index,
 		}
+		if !indexOnly {
+			assign.PublicKey = bs.pubKeys.intern(st.PubkeyAtIndex(index))
+		}
 		res = append(res, assign)
 	}
-
-	return &ethpb.ValidatorAssignments{
-		Epoch:         requestedEpoch,
-		Assignments:   res,
-		NextPageToken: nextPageToken,
-		TotalSize:     int32(len(filteredIndices)),
-	}, nil
+	return res, nil
 }
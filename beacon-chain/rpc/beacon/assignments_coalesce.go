@@ -0,0 +1,86 @@
+package beacon
+
+import (
+	"context"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	types "github.com/prysmaticlabs/eth2-types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+)
+
+var assignmentsCoalesced = promauto.NewCounter(
+	prometheus.CounterOpts{
+		Name: "validator_assignments_coalesced_total",
+		Help: "The number of validator assignments requests served by an identical request in progress.",
+	},
+)
+
+// assignmentsKey identifies identical validator assignments requests.
+type assignmentsKey struct {
+	epoch types.Epoch
+	// blockRoot is the block whose post-state block root filtered requests are served from.
+	blockRoot [32]byte
+	// filter is the hash of the request, which covers the filters and the page.
+	filter [32]byte
+}
+
+// assignmentsCall is a validator assignments computation in progress.
+type assignmentsCall struct {
+	done chan struct{}
+	res  *pbrpc.AnnotatedValidatorAssignments
+	err  error
+	// canceled is set if the computation was abandoned because the request running it was
+	// canceled, rather than failing on its own.
+	canceled bool
+}
+
+// assignmentsCoalescer lets concurrent identical validator assignments requests share a single
+// computation, as each of them would otherwise replay the state of the epoch on its own. Results
+// are not kept once the computation is done. The zero value is ready to use.
+type assignmentsCoalescer struct {
+	lock  sync.Mutex
+	calls map[assignmentsKey]*assignmentsCall
+}
+
+// do returns the result of compute for the key, waiting for an identical computation in progress
+// instead of starting another one. The result is shared by all the waiting requests, so it must
+// not be modified.
+func (c *assignmentsCoalescer) do(
+	ctx context.Context, key assignmentsKey, compute func(context.Context) (*pbrpc.AnnotatedValidatorAssignments, error),
+) (*pbrpc.AnnotatedValidatorAssignments, error) {
+	for {
+		c.lock.Lock()
+		if c.calls == nil {
+			c.calls = make(map[assignmentsKey]*assignmentsCall)
+		}
+		call, ok := c.calls[key]
+		if !ok {
+			call = &assignmentsCall{done: make(chan struct{})}
+			c.calls[key] = call
+		}
+		c.lock.Unlock()
+
+		if !ok {
+			call.res, call.err = compute(ctx)
+			call.canceled = call.err != nil && ctx.Err() != nil
+			c.lock.Lock()
+			delete(c.calls, key)
+			c.lock.Unlock()
+			close(call.done)
+			return call.res, call.err
+		}
+
+		assignmentsCoalesced.Inc()
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		// A computation abandoned by its own request is retried rather than failing the others.
+		if !call.canceled {
+			return call.res, call.err
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package beacon
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// waitForCall waits until a computation for the key is in progress.
+func waitForCall(t *testing.T, c *assignmentsCoalescer, key assignmentsKey) {
+	for i := 0; i < 100; i++ {
+		c.lock.Lock()
+		_, ok := c.calls[key]
+		c.lock.Unlock()
+		if ok {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("computation did not start")
+}
+
+func TestAssignmentsCoalescer_SharesComputation(t *testing.T) {
+	c := &assignmentsCoalescer{}
+	key := assignmentsKey{epoch: 3, filter: [32]byte{'a'}}
+	release := make(chan struct{})
+	var computations int32
+	compute := func(ctx context.Context) (*pbrpc.AnnotatedValidatorAssignments, error) {
+		atomic.AddInt32(&computations, 1)
+		<-release
+		return &pbrpc.AnnotatedValidatorAssignments{Assignments: &ethpb.ValidatorAssignments{Epoch: 3}}, nil
+	}
+
+	results := make([]*pbrpc.AnnotatedValidatorAssignments, 5)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		res, err := c.do(context.Background(), key, compute)
+		require.NoError(t, err)
+		results[0] = res
+	}()
+	waitForCall(t, c, key)
+	for i := 1; i < len(results); i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			res, err := c.do(context.Background(), key, compute)
+			require.NoError(t, err)
+			results[i] = res
+		}(i)
+	}
+	// A request with another key is computed on its own.
+	other, err := c.do(context.Background(), assignmentsKey{epoch: 3, filter: [32]byte{1}}, func(ctx context.Context) (*pbrpc.AnnotatedValidatorAssignments, error) {
+		return &pbrpc.AnnotatedValidatorAssignments{Assignments: &ethpb.ValidatorAssignments{Epoch: 4}}, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), uint64(other.Assignments.Epoch))
+
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&computations))
+	for _, res := range results {
+		assert.Equal(t, results[0], res)
+	}
+	// Nothing is kept once the computation is done.
+	assert.Equal(t, 0, len(c.calls))
+}
+
+func TestAssignmentsCoalescer_RetriesCanceledComputation(t *testing.T) {
+	c := &assignmentsCoalescer{}
+	key := assignmentsKey{epoch: 3}
+	leaderCtx, cancel := context.WithCancel(context.Background())
+	var computations int32
+	compute := func(ctx context.Context) (*pbrpc.AnnotatedValidatorAssignments, error) {
+		atomic.AddInt32(&computations, 1)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.do(leaderCtx, key, compute)
+		done <- err
+	}()
+	waitForCall(t, c, key)
+
+	waiter := make(chan *pbrpc.AnnotatedValidatorAssignments, 1)
+	go func() {
+		res, err := c.do(context.Background(), key, func(ctx context.Context) (*pbrpc.AnnotatedValidatorAssignments, error) {
+			atomic.AddInt32(&computations, 1)
+			return &pbrpc.AnnotatedValidatorAssignments{Assignments: &ethpb.ValidatorAssignments{Epoch: 3}}, nil
+		})
+		require.NoError(t, err)
+		waiter <- res
+	}()
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	assert.ErrorContains(t, "context canceled", <-done)
+	// The waiting request computes the assignments itself instead of failing.
+	res := <-waiter
+	assert.Equal(t, uint64(3), uint64(res.Assignments.Epoch))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&computations))
+}
@@ -0,0 +1,108 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// assignmentsRootFilter resolves the root filter of the request to the epoch to serve. A block
+// root filter also returns the root of the block whose post-state the assignments are computed
+// from. The returned bool is false if the request has no root filter.
+func (bs *Server) assignmentsRootFilter(
+	ctx context.Context, req *pbrpc.AnnotatedValidatorAssignmentsRequest,
+) ([32]byte, types.Epoch, bool, error) {
+	switch q := req.QueryFilter.(type) {
+	case *pbrpc.AnnotatedValidatorAssignmentsRequest_BlockRoot:
+		blockRoot, err := filterRoot("block root", q.BlockRoot)
+		if err != nil {
+			return [32]byte{}, 0, false, err
+		}
+		blk, err := bs.BeaconDB.Block(ctx, blockRoot)
+		if err != nil {
+			return [32]byte{}, 0, false, status.Errorf(codes.Internal, "Could not get block %#x: %v", blockRoot, err)
+		}
+		if blk == nil || blk.Block == nil {
+			return [32]byte{}, 0, false, status.Errorf(codes.NotFound, "Could not find block %#x", blockRoot)
+		}
+		return blockRoot, helpers.SlotToEpoch(blk.Block.Slot), true, nil
+	case *pbrpc.AnnotatedValidatorAssignmentsRequest_StateRoot:
+		stateRoot, err := filterRoot("state root", q.StateRoot)
+		if err != nil {
+			return [32]byte{}, 0, false, err
+		}
+		slot, ok, err := bs.canonicalStateRootSlot(ctx, stateRoot)
+		if err != nil {
+			return [32]byte{}, 0, false, status.Errorf(codes.Internal, "Could not resolve state root %#x: %v", stateRoot, err)
+		}
+		if !ok {
+			return [32]byte{}, 0, false, status.Errorf(codes.NotFound, "Could not find state %#x in the recent canonical states", stateRoot)
+		}
+		return [32]byte{}, helpers.SlotToEpoch(slot), true, nil
+	}
+	return [32]byte{}, 0, false, nil
+}
+
+// filterRoot checks the root of a root filter of the request.
+func filterRoot(name string, raw []byte) ([32]byte, error) {
+	if len(raw) != 32 {
+		return [32]byte{}, status.Errorf(codes.InvalidArgument, "Invalid %s %#x, want a 32 bytes root", name, raw)
+	}
+	root := bytesutil.ToBytes32(raw)
+	if root == params.BeaconConfig().ZeroHash {
+		return [32]byte{}, status.Errorf(codes.InvalidArgument, "Invalid %s, the root is zeroed", name)
+	}
+	return root, nil
+}
+
+// canonicalStateRootSlot returns the slot of a state root of the canonical chain, looking at the
+// head block and at the state roots of the SlotsPerHistoricalRoot slots before the head state.
+func (bs *Server) canonicalStateRootSlot(ctx context.Context, root [32]byte) (types.Slot, bool, error) {
+	headBlock, err := bs.HeadFetcher.HeadBlock(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+	if headBlock != nil && headBlock.Block != nil && bytes.Equal(headBlock.Block.StateRoot, root[:]) {
+		return headBlock.Block.Slot, true, nil
+	}
+	headState, err := bs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+	if headState == nil || headState.Slot() == 0 {
+		return 0, false, nil
+	}
+	last := headState.Slot() - 1
+	historical := params.BeaconConfigOf(bs.ChainConfig).SlotsPerHistoricalRoot
+	for i, r := range headState.StateRoots() {
+		index := types.Slot(i)
+		// Entries after the last slot are not set before the chain is SlotsPerHistoricalRoot long.
+		if index > last || !bytes.Equal(r, root[:]) {
+			continue
+		}
+		return last - (last-index)%historical, true, nil
+	}
+	return 0, false, nil
+}
+
+// assignmentsState returns the state the assignments of the epoch are computed from, which is the
+// post-state of the given block, or the canonical start state of the epoch if the root is zeroed.
+func (bs *Server) assignmentsState(ctx context.Context, epoch types.Epoch, blockRoot [32]byte) (iface.BeaconState, error) {
+	if blockRoot == params.BeaconConfigOf(bs.ChainConfig).ZeroHash {
+		return bs.epochStartState(ctx, epoch)
+	}
+	st, err := bs.StateGen.StateByRoot(ctx, blockRoot)
+	if err != nil {
+		return nil, err
+	}
+	// The state may be shared with the state caches, and computing the proposers moves its slot.
+	return st.Copy(), nil
+}
@@ -0,0 +1,140 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_ListAssignments_BlockRootFilter(t *testing.T) {
+	helpers.ClearCache()
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	canonical := setupActiveValidators(t, 128)
+	require.NoError(t, canonical.SetSlot(slotsPerEpoch))
+	// The fork has other randao mixes, and so other committees and proposers.
+	fork := canonical.Copy()
+	require.NoError(t, fork.SetSlot(slotsPerEpoch+1))
+	mixes := make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector)
+	for i := range mixes {
+		mixes[i] = bytesutil.PadTo([]byte{'f'}, 32)
+	}
+	require.NoError(t, fork.SetRandaoMixes(mixes))
+	forkBlock := testutil.NewBeaconBlock()
+	forkBlock.Block.Slot = slotsPerEpoch + 1
+	require.NoError(t, db.SaveBlock(ctx, forkBlock))
+	forkRoot, err := forkBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	stateGen := stategen.NewMockService()
+	stateGen.AddStateForSlot(canonical, slotsPerEpoch)
+	stateGen.AddStateForRoot(fork, forkRoot)
+	currentSlot := 2 * slotsPerEpoch
+	bs := &Server{
+		BeaconDB:           db,
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		StateGen:           stateGen,
+	}
+
+	req := &ethpb.ListValidatorAssignmentsRequest{
+		QueryFilter: &ethpb.ListValidatorAssignmentsRequest_Epoch{Epoch: 1},
+		PageSize:    128,
+	}
+	canonicalRes, err := bs.ListValidatorAssignments(ctx, req)
+	require.NoError(t, err)
+
+	rootReq := &pbrpc.AnnotatedValidatorAssignmentsRequest{
+		QueryFilter: &pbrpc.AnnotatedValidatorAssignmentsRequest_BlockRoot{BlockRoot: forkRoot[:]},
+		PageSize:    128,
+	}
+	annotated, err := bs.ListAnnotatedValidatorAssignments(ctx, rootReq)
+	require.NoError(t, err)
+	forkRes := annotated.Assignments
+	assert.Equal(t, types.Epoch(1), forkRes.Epoch)
+	activeIndices, err := helpers.ActiveValidatorIndices(nil, fork, 1)
+	require.NoError(t, err)
+	want, err := bs.assignmentsForIndices(ctx, fork.Copy(), 1, activeIndices, false)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, forkRes.Assignments)
+	assert.DeepNotEqual(t, canonicalRes.Assignments, forkRes.Assignments)
+
+	unknownRoot := [32]byte{'u'}
+	rootReq.QueryFilter = &pbrpc.AnnotatedValidatorAssignmentsRequest_BlockRoot{BlockRoot: unknownRoot[:]}
+	_, err = bs.ListAnnotatedValidatorAssignments(ctx, rootReq)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestServer_ListAssignments_StateRootFilter(t *testing.T) {
+	helpers.ClearCache()
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	st := setupActiveValidators(t, 128)
+	require.NoError(t, st.SetSlot(slotsPerEpoch))
+	headState := st.Copy()
+	require.NoError(t, headState.SetSlot(2*slotsPerEpoch+6))
+	stateRoot := [32]byte{'s'}
+	require.NoError(t, headState.UpdateStateRootAtIndex(uint64(slotsPerEpoch+3), stateRoot))
+	headBlock := testutil.NewBeaconBlock()
+	headBlock.Block.Slot = headState.Slot()
+	headBlock.Block.StateRoot = bytesutil.PadTo([]byte{'h'}, 32)
+
+	stateGen := stategen.NewMockService()
+	stateGen.AddStateForSlot(st, slotsPerEpoch)
+	currentSlot := headState.Slot()
+	bs := &Server{
+		BeaconDB:           db,
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		HeadFetcher:        &mock.ChainService{State: headState, Block: headBlock},
+		StateGen:           stateGen,
+	}
+
+	annotated, err := bs.ListAnnotatedValidatorAssignments(ctx, &pbrpc.AnnotatedValidatorAssignmentsRequest{
+		QueryFilter: &pbrpc.AnnotatedValidatorAssignmentsRequest_StateRoot{StateRoot: stateRoot[:]},
+		PageSize:    128,
+	})
+	require.NoError(t, err)
+	res := annotated.Assignments
+	assert.Equal(t, types.Epoch(1), res.Epoch)
+	assert.Equal(t, 128, len(res.Assignments))
+
+	slot, ok, err := bs.canonicalStateRootSlot(ctx, bytesutil.ToBytes32(headBlock.Block.StateRoot))
+	require.NoError(t, err)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, headBlock.Block.Slot, slot)
+	_, ok, err = bs.canonicalStateRootSlot(ctx, [32]byte{'u'})
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+}
+
+func TestServer_ListAssignments_InvalidRootFilter(t *testing.T) {
+	bs := &Server{}
+	ctx := context.Background()
+
+	_, err := bs.ListAnnotatedValidatorAssignments(ctx, &pbrpc.AnnotatedValidatorAssignmentsRequest{
+		QueryFilter: &pbrpc.AnnotatedValidatorAssignmentsRequest_BlockRoot{BlockRoot: []byte{0x12, 0x34}},
+	})
+	assert.ErrorContains(t, "want a 32 bytes root", err)
+
+	_, err = bs.ListAnnotatedValidatorAssignments(ctx, &pbrpc.AnnotatedValidatorAssignmentsRequest{
+		QueryFilter: &pbrpc.AnnotatedValidatorAssignmentsRequest_StateRoot{StateRoot: make([]byte, 32)},
+	})
+	assert.ErrorContains(t, "the root is zeroed", err)
+}
@@ -14,7 +14,10 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
@@ -40,6 +43,47 @@ func TestServer_ListAssignments_CannotRequestFutureEpoch(t *testing.T) {
 		},
 	)
 	assert.ErrorContains(t, wanted, err)
+	details, ok := grpcutils.EpochOutOfRangeFromError(err)
+	require.Equal(t, true, ok)
+	assert.Equal(t, helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot())+1, details.Requested)
+	assert.Equal(t, helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot()), details.Current)
+}
+
+func TestServer_CheckEpochAdmission_NextEpochGraceWindow(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	tests := []struct {
+		name        string
+		currentSlot types.Slot
+		graceSlots  types.Slot
+		requested   types.Epoch
+		admitted    bool
+	}{
+		{name: "current epoch", currentSlot: slotsPerEpoch, graceSlots: 0, requested: 1, admitted: true},
+		{name: "next epoch without grace", currentSlot: 2*slotsPerEpoch - 1, graceSlots: 0, requested: 2, admitted: false},
+		{name: "next epoch in last slot", currentSlot: 2*slotsPerEpoch - 1, graceSlots: 1, requested: 2, admitted: true},
+		{name: "next epoch before grace window", currentSlot: 2*slotsPerEpoch - 2, graceSlots: 1, requested: 2, admitted: false},
+		{name: "next epoch in wider grace window", currentSlot: 2*slotsPerEpoch - 2, graceSlots: 2, requested: 2, admitted: true},
+		{name: "two epochs ahead", currentSlot: 2*slotsPerEpoch - 1, graceSlots: slotsPerEpoch, requested: 3, admitted: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slot := tt.currentSlot
+			bs := &Server{
+				GenesisTimeFetcher:  &mock.ChainService{Slot: &slot},
+				NextEpochGraceSlots: tt.graceSlots,
+			}
+			err := bs.checkEpochAdmission(tt.requested)
+			if tt.admitted {
+				require.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, errNoEpochInfoError, err)
+			details, ok := grpcutils.EpochOutOfRangeFromError(err)
+			require.Equal(t, true, ok)
+			assert.Equal(t, tt.requested, details.Requested)
+			assert.Equal(t, helpers.SlotToEpoch(slot), details.Current)
+		})
+	}
 }
 
 func TestServer_ListAssignments_NoResults(t *testing.T) {
@@ -206,9 +250,9 @@ func TestServer_ListAssignments_Pagination_DefaultPageSize_NoArchive(t *testing.
 	// Construct the wanted assignments.
 	var wanted []*ethpb.ValidatorAssignments_CommitteeAssignment
 
-	activeIndices, err := helpers.ActiveValidatorIndices(s, 0)
+	activeIndices, err := helpers.ActiveValidatorIndices(nil, s, 0)
 	require.NoError(t, err)
-	committeeAssignments, proposerIndexToSlots, err := helpers.CommitteeAssignments(s, 0)
+	committeeAssignments, proposerIndexToSlots, err := helpers.CommitteeAssignments(context.Background(), nil, s, 0)
 	require.NoError(t, err)
 	for _, index := range activeIndices[0:params.BeaconConfig().DefaultPageSize] {
 		val, err := s.ValidatorAtIndex(index)
@@ -275,9 +319,9 @@ func TestServer_ListAssignments_FilterPubkeysIndices_NoPagination(t *testing.T)
 	// Construct the wanted assignments.
 	var wanted []*ethpb.ValidatorAssignments_CommitteeAssignment
 
-	activeIndices, err := helpers.ActiveValidatorIndices(s, 0)
+	activeIndices, err := helpers.ActiveValidatorIndices(nil, s, 0)
 	require.NoError(t, err)
-	committeeAssignments, proposerIndexToSlots, err := helpers.CommitteeAssignments(s, 0)
+	committeeAssignments, proposerIndexToSlots, err := helpers.CommitteeAssignments(context.Background(), nil, s, 0)
 	require.NoError(t, err)
 	for _, index := range activeIndices[1:4] {
 		val, err := s.ValidatorAtIndex(index)
@@ -340,9 +384,9 @@ func TestServer_ListAssignments_CanFilterPubkeysIndices_WithPagination(t *testin
 	// Construct the wanted assignments.
 	var assignments []*ethpb.ValidatorAssignments_CommitteeAssignment
 
-	activeIndices, err := helpers.ActiveValidatorIndices(s, 0)
+	activeIndices, err := helpers.ActiveValidatorIndices(nil, s, 0)
 	require.NoError(t, err)
-	committeeAssignments, proposerIndexToSlots, err := helpers.CommitteeAssignments(s, 0)
+	committeeAssignments, proposerIndexToSlots, err := helpers.CommitteeAssignments(context.Background(), nil, s, 0)
 	require.NoError(t, err)
 	for _, index := range activeIndices[3:5] {
 		val, err := s.ValidatorAtIndex(index)
@@ -370,7 +414,7 @@ func TestServer_ListAssignments_CanFilterPubkeysIndices_WithPagination(t *testin
 	req = &ethpb.ListValidatorAssignmentsRequest{Indices: []types.ValidatorIndex{1, 2, 3, 4, 5, 6}, PageSize: 5, PageToken: "1"}
 	res, err = bs.ListValidatorAssignments(context.Background(), req)
 	require.NoError(t, err)
-	cAssignments, proposerIndexToSlots, err := helpers.CommitteeAssignments(s, 0)
+	cAssignments, proposerIndexToSlots, err := helpers.CommitteeAssignments(context.Background(), nil, s, 0)
 	require.NoError(t, err)
 	for _, index := range activeIndices[6:7] {
 		val, err := s.ValidatorAtIndex(index)
@@ -393,3 +437,112 @@ func TestServer_ListAssignments_CanFilterPubkeysIndices_WithPagination(t *testin
 
 	assert.DeepEqual(t, wantedRes, res, "Did not receive wanted assignments")
 }
+
+func TestServer_ListAssignmentsRange_MatchesSingleEpochQueries(t *testing.T) {
+	// Advancing the state requires the state vectors to match the configuration.
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	blockRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	s := setupActiveValidators(t, 128)
+	require.NoError(t, db.SaveState(ctx, s, blockRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, blockRoot))
+
+	currentSlot := params.BeaconConfig().SlotsPerEpoch * 3
+	bs := &Server{
+		BeaconDB:           db,
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		StateGen:           stategen.New(db),
+	}
+
+	pubKey := s.PubkeyAtIndex(7)
+	res, err := bs.ListValidatorAssignmentsRange(ctx, &pbrpc.ListValidatorAssignmentsRangeRequest{
+		FromEpoch:  0,
+		ToEpoch:    2,
+		PublicKeys: [][]byte{pubKey[:]},
+		Indices:    []types.ValidatorIndex{7, 42},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(res.Epochs))
+	require.Equal(t, 3, len(res.ProposerListRoots))
+
+	for i, epochRes := range res.Epochs {
+		epoch := types.Epoch(i)
+		assert.Equal(t, epoch, epochRes.Epoch)
+		wanted, err := bs.ListAnnotatedValidatorAssignments(ctx, &pbrpc.AnnotatedValidatorAssignmentsRequest{
+			QueryFilter: &pbrpc.AnnotatedValidatorAssignmentsRequest_Epoch{Epoch: epoch},
+			Indices:     []types.ValidatorIndex{7, 42},
+		})
+		require.NoError(t, err)
+		assert.DeepEqual(t, wanted.Assignments.Assignments, epochRes.Assignments, "Unexpected assignments for epoch %d", epoch)
+
+		// The proposer list roots match the single epoch queries and the epoch infos.
+		assert.DeepEqual(t, res.ProposerListRoots[i][:], wanted.ProposerListRoot)
+		info, err := bs.computeEpochInfo(ctx, epoch)
+		require.NoError(t, err)
+		assert.DeepEqual(t, info.ProposerListRoot[:], res.ProposerListRoots[i], "Unexpected proposer list root for epoch %d", epoch)
+	}
+
+}
+
+func TestServer_ListAssignmentsRange_CompetingForks(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	blockRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	s := setupActiveValidators(t, 128)
+	require.NoError(t, db.SaveState(ctx, s, blockRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, blockRoot))
+	// Two forks have a block at the highest slot of the first epoch, and no index tells which one
+	// is canonical.
+	for _, graffiti := range []byte{'a', 'b'} {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = 5
+		b.Block.ParentRoot = blockRoot[:]
+		b.Block.Body.Graffiti = bytesutil.PadTo([]byte{graffiti}, 32)
+		require.NoError(t, db.SaveBlock(ctx, b))
+	}
+
+	currentSlot := params.BeaconConfig().SlotsPerEpoch * 3
+	bs := &Server{
+		BeaconDB:           db,
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		StateGen:           stategen.New(db),
+	}
+	_, err = bs.ListValidatorAssignmentsRange(ctx, &pbrpc.ListValidatorAssignmentsRangeRequest{
+		FromEpoch: 0,
+		ToEpoch:   1,
+		Indices:   []types.ValidatorIndex{7},
+	})
+	assert.ErrorContains(t, "could not get canonical block at or before slot 32", err)
+}
+
+func TestServer_ListAssignmentsRange_InvalidRequest(t *testing.T) {
+	currentSlot := params.BeaconConfig().SlotsPerEpoch * 100
+	bs := &Server{GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot}}
+	ctx := context.Background()
+
+	_, err := bs.ListValidatorAssignmentsRange(ctx, &pbrpc.ListValidatorAssignmentsRangeRequest{FromEpoch: 2, ToEpoch: 1, Indices: []types.ValidatorIndex{0}})
+	assert.ErrorContains(t, "From epoch 2 is after to epoch 1", err)
+
+	_, err = bs.ListValidatorAssignmentsRange(ctx, &pbrpc.ListValidatorAssignmentsRangeRequest{ToEpoch: maxAssignmentEpochRange, Indices: []types.ValidatorIndex{0}})
+	assert.ErrorContains(t, "exceeds the maximum", err)
+
+	_, err = bs.ListValidatorAssignmentsRange(ctx, &pbrpc.ListValidatorAssignmentsRangeRequest{ToEpoch: 1})
+	assert.ErrorContains(t, "Must specify at least one public key or validator index", err)
+
+	_, err = bs.ListValidatorAssignmentsRange(ctx, &pbrpc.ListValidatorAssignmentsRangeRequest{FromEpoch: 100, ToEpoch: 101, Indices: []types.ValidatorIndex{0}})
+	assert.ErrorContains(t, "Cannot retrieve information about an epoch in the future", err)
+}
@@ -0,0 +1,310 @@
+package beacon
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxCachedEpochStates is the number of epoch start states kept for assignment requests, which
+// covers the previous, current and next epoch with room for a reorg.
+const maxCachedEpochStates = 4
+
+var (
+	epochStateCacheHits = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "assignments_epoch_state_cache_hits_total",
+			Help: "The number of assignment requests served from a cached epoch start state.",
+		},
+	)
+	epochStateCacheMisses = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "assignments_epoch_state_cache_misses_total",
+			Help: "The number of assignment requests which replayed the epoch start state.",
+		},
+	)
+)
+
+// epochStateKey identifies the start state of an epoch on a chain. The start state only depends
+// on the blocks up to the slot before the epoch, so its dependent root is the block root at that
+// slot.
+type epochStateKey struct {
+	epoch         types.Epoch
+	dependentRoot [32]byte
+}
+
+// epochStateCache keeps the start states of recent epochs, so that assignment requests do not
+// replay the same state over and over. The zero value is ready to use.
+type epochStateCache struct {
+	lock    sync.Mutex
+	entries map[epochStateKey]iface.BeaconState
+	order   []epochStateKey
+	// size is the number of entries kept, maxCachedEpochStates if it is zero.
+	size int
+	// finalized holds the keys of the entries of finalized epochs which are on the finalized
+	// chain. Finality makes them immutable, so they are looked up by epoch alone.
+	finalized map[types.Epoch]epochStateKey
+}
+
+func (c *epochStateCache) get(key epochStateKey) iface.BeaconState {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.entries[key]
+}
+
+// getFinalized returns the cached start state of a finalized epoch, nil if it is not cached.
+func (c *epochStateCache) getFinalized(epoch types.Epoch) iface.BeaconState {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	key, ok := c.finalized[epoch]
+	if !ok {
+		return nil
+	}
+	return c.entries[key]
+}
+
+// finalize evicts the entries of the epochs up to the finalized epoch whose dependent root is
+// not canonical, which were computed for forks that finality discarded, and marks the others as
+// finalized.
+func (c *epochStateCache) finalize(epoch types.Epoch, canonical func(key epochStateKey) (bool, error)) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	kept := c.order[:0]
+	for i, key := range c.order {
+		if key.epoch > epoch {
+			kept = append(kept, key)
+			continue
+		}
+		if finalizedKey, ok := c.finalized[key.epoch]; ok {
+			if finalizedKey == key {
+				kept = append(kept, key)
+			} else {
+				delete(c.entries, key)
+			}
+			continue
+		}
+		ok, err := canonical(key)
+		if err != nil {
+			// The remaining entries are settled with the next finalized checkpoint.
+			c.order = append(kept, c.order[i:]...)
+			return err
+		}
+		if !ok {
+			delete(c.entries, key)
+			continue
+		}
+		if c.finalized == nil {
+			c.finalized = make(map[types.Epoch]epochStateKey)
+		}
+		c.finalized[key.epoch] = key
+		kept = append(kept, key)
+	}
+	c.order = kept
+	return nil
+}
+
+func (c *epochStateCache) put(key epochStateKey, st iface.BeaconState) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.entries == nil {
+		c.entries = make(map[epochStateKey]iface.BeaconState)
+	}
+	if _, ok := c.entries[key]; ok {
+		return
+	}
+	c.entries[key] = st
+	c.order = append(c.order, key)
+	c.evictOverflow()
+}
+
+// resize changes the number of entries kept, evicting the oldest entries beyond it.
+func (c *epochStateCache) resize(size int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.size = size
+	c.evictOverflow()
+}
+
+// maxEntries returns the number of entries kept. The caller must hold the lock.
+func (c *epochStateCache) maxEntries() int {
+	if c.size == 0 {
+		return maxCachedEpochStates
+	}
+	return c.size
+}
+
+// evictOverflow evicts the oldest entries beyond the number of entries kept. The caller must hold
+// the lock.
+func (c *epochStateCache) evictOverflow() {
+	for len(c.order) > c.maxEntries() {
+		evicted := c.order[0]
+		delete(c.entries, evicted)
+		if c.finalized[evicted.epoch] == evicted {
+			delete(c.finalized, evicted.epoch)
+		}
+		c.order = c.order[1:]
+	}
+}
+
+// OnFinalized evicts the cached epoch start states of the finalized epochs which were computed
+// for forks that finality discarded, and keeps the others as immutable. It implements
+// statefeed.FinalityHook.
+func (bs *Server) OnFinalized(epoch types.Epoch, _ [32]byte) {
+	if bs.CanonicalFetcher == nil {
+		return
+	}
+	err := bs.epochStates.finalize(epoch, func(key epochStateKey) (bool, error) {
+		// The genesis state has no dependent block.
+		if key.epoch == 0 {
+			return true, nil
+		}
+		return bs.CanonicalFetcher.IsCanonical(bs.Ctx, key.dependentRoot)
+	})
+	if err != nil {
+		log.WithError(err).WithField("epoch", epoch).Error("Could not settle cached epoch start states")
+	}
+}
+
+// epochStartState returns a copy of the state at the start slot of the epoch, which callers may
+// modify. The state is cached by its dependent root on the chain of the head, epochs too old
+// to be covered by the block roots of the head state are replayed every time unless they were
+// cached before they finalized.
+func (bs *Server) epochStartState(ctx context.Context, epoch types.Epoch) (iface.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconChainServer.epochStartState")
+	defer span.End()
+
+	if st := bs.epochStates.getFinalized(epoch); st != nil {
+		epochStateCacheHits.Inc()
+		return st.Copy(), nil
+	}
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, err
+	}
+	key, ok, err := bs.epochStateKey(ctx, epoch, startSlot)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		if st := bs.epochStates.get(key); st != nil {
+			epochStateCacheHits.Inc()
+			return st.Copy(), nil
+		}
+	}
+	epochStateCacheMisses.Inc()
+	st, err := bs.StateGen.CanonicalStateInEpoch(ctx, epoch)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		bs.epochStates.put(key, st.Copy())
+	}
+	return st, nil
+}
+
+// epochStateKey returns the cache key of the start state of the epoch, and false if the state
+// can not be cached.
+func (bs *Server) epochStateKey(ctx context.Context, epoch types.Epoch, startSlot types.Slot) (epochStateKey, bool, error) {
+	key := epochStateKey{epoch: epoch}
+	// The genesis state has no dependent block.
+	if startSlot == 0 {
+		return key, true, nil
+	}
+	if bs.HeadFetcher == nil {
+		return key, false, nil
+	}
+	headState, err := bs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return key, false, err
+	}
+	if headState == nil {
+		return key, false, nil
+	}
+	if startSlot-1 >= headState.Slot() {
+		headRoot, err := bs.HeadFetcher.HeadRoot(ctx)
+		if err != nil {
+			return key, false, err
+		}
+		key.dependentRoot = bytesutil.ToBytes32(headRoot)
+		return key, true, nil
+	}
+	if headState.Slot()-(startSlot-1) >= params.BeaconConfigOf(bs.ChainConfig).SlotsPerHistoricalRoot {
+		return key, false, nil
+	}
+	root, err := helpers.BlockRootAtSlot(headState, startSlot-1)
+	if err != nil {
+		return key, false, err
+	}
+	key.dependentRoot = bytesutil.ToBytes32(root)
+	return key, true, nil
+}
+
+// WarmUpAssignments replays the start states of the previous and the current epoch and computes
+// their committee and proposer assignments, so that the first assignment requests after a
+// restart are served from the caches instead of timing out while the states are replayed.
+func (bs *Server) WarmUpAssignments(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconChainServer.WarmUpAssignments")
+	defer span.End()
+
+	start := time.Now()
+	headState, err := bs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	if headState == nil {
+		log.Debug("Skipping assignments warm-up before chain start")
+		return nil
+	}
+	currentEpoch := helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
+	// The epochs of the clock can not be served from the head of a node which is still syncing.
+	if currentEpoch > helpers.CurrentEpoch(headState)+1 {
+		log.WithField("headSlot", headState.Slot()).Info("Skipping assignments warm-up while the head is behind")
+		return nil
+	}
+	epochs := []types.Epoch{currentEpoch}
+	if currentEpoch > 0 {
+		epochs = []types.Epoch{currentEpoch - 1, currentEpoch}
+	}
+	for _, epoch := range epochs {
+		st, err := bs.epochStartState(ctx, epoch)
+		if err != nil {
+			return errors.Wrapf(err, "could not get start state of epoch %d", epoch)
+		}
+		if _, _, err := helpers.CommitteeAssignments(ctx, bs.CommitteeCache, st, epoch); err != nil {
+			return errors.Wrapf(err, "could not compute assignments of epoch %d", epoch)
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"epochs":   epochs,
+		"duration": time.Since(start),
+	}).Info("Warmed up validator assignments")
+	return nil
+}
+
+// waitForAssignmentsWarmUp holds an assignment request back until the assignments warm-up is
+// done, so that it does not replay the same states concurrently. Requests are not held back if
+// the server runs no warm-up.
+func (bs *Server) waitForAssignmentsWarmUp(ctx context.Context) error {
+	if bs.AssignmentsWarmedUp == nil {
+		return nil
+	}
+	select {
+	case <-bs.AssignmentsWarmedUp:
+		return nil
+	case <-ctx.Done():
+		return status.Errorf(codes.Unavailable, "Validator assignments are warming up: %v", ctx.Err())
+	}
+}
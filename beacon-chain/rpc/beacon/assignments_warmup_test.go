@@ -0,0 +1,140 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_WarmUpAssignments(t *testing.T) {
+	// Advancing the state requires the state vectors to match the configuration.
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	s := setupActiveValidators(t, 128)
+	require.NoError(t, db.SaveState(ctx, s, genesisRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+
+	currentSlot := params.BeaconConfig().SlotsPerEpoch
+	head := &mock.ChainService{State: s, Root: genesisRoot[:]}
+	bs := &Server{
+		BeaconDB:           db,
+		HeadFetcher:        head,
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		StateGen:           stategen.New(db),
+	}
+	require.NoError(t, bs.WarmUpAssignments(ctx))
+
+	// The start states of the previous and the current epoch are cached.
+	assert.NotNil(t, bs.epochStates.get(epochStateKey{epoch: 0}))
+	assert.NotNil(t, bs.epochStates.get(epochStateKey{epoch: 1, dependentRoot: genesisRoot}))
+	st, err := bs.epochStartState(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch, st.Slot())
+
+	res, err := bs.ListValidatorAssignments(ctx, &ethpb.ListValidatorAssignmentsRequest{
+		QueryFilter: &ethpb.ListValidatorAssignmentsRequest_Epoch{Epoch: 1},
+		PageSize:    1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(res.Assignments))
+	// Serving the request does not move the cached state.
+	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch, bs.epochStates.get(epochStateKey{epoch: 1, dependentRoot: genesisRoot}).Slot())
+
+	// A head on another chain depends on another block.
+	head.Root = []byte{'a'}
+	key, ok, err := bs.epochStateKey(ctx, 1, params.BeaconConfig().SlotsPerEpoch)
+	require.NoError(t, err)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, true, bs.epochStates.get(key) == nil)
+}
+
+func TestServer_WarmUpAssignments_SkipsBehindHead(t *testing.T) {
+	s, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	currentSlot := params.BeaconConfig().SlotsPerEpoch * 5
+	bs := &Server{
+		HeadFetcher:        &mock.ChainService{State: s},
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+	}
+	require.NoError(t, bs.WarmUpAssignments(context.Background()))
+	assert.Equal(t, 0, len(bs.epochStates.entries))
+
+	// Neither is anything warmed up before chain start.
+	bs.HeadFetcher = &mock.ChainService{}
+	require.NoError(t, bs.WarmUpAssignments(context.Background()))
+	assert.Equal(t, 0, len(bs.epochStates.entries))
+}
+
+func TestServer_ListValidatorAssignments_WaitsForWarmUp(t *testing.T) {
+	warmedUp := make(chan struct{})
+	bs := &Server{AssignmentsWarmedUp: warmedUp}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := bs.ListValidatorAssignments(ctx, &ethpb.ListValidatorAssignmentsRequest{})
+	assert.ErrorContains(t, "Validator assignments are warming up", err)
+
+	close(warmedUp)
+	require.NoError(t, bs.waitForAssignmentsWarmUp(context.Background()))
+}
+
+func TestServer_OnFinalized_SettlesEpochStates(t *testing.T) {
+	ctx := context.Background()
+	canonical, fork, later := [32]byte{'a'}, [32]byte{'b'}, [32]byte{'c'}
+	bs := &Server{
+		Ctx:              ctx,
+		CanonicalFetcher: &mock.ChainService{CanonicalRoots: map[[32]byte]bool{canonical: true, later: true}},
+	}
+	newState := func(slot types.Slot) iface.BeaconState {
+		st, err := testutil.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(slot))
+		return st
+	}
+	genesisKey := epochStateKey{epoch: 0}
+	canonicalKey := epochStateKey{epoch: 2, dependentRoot: canonical}
+	forkKey := epochStateKey{epoch: 2, dependentRoot: fork}
+	laterKey := epochStateKey{epoch: 3, dependentRoot: [32]byte{'d'}}
+	bs.epochStates.put(genesisKey, newState(0))
+	bs.epochStates.put(canonicalKey, newState(64))
+	bs.epochStates.put(forkKey, newState(65))
+	bs.epochStates.put(laterKey, newState(96))
+
+	bs.OnFinalized(2, canonical)
+	assert.Equal(t, true, bs.epochStates.get(forkKey) == nil, "Expected the state of the discarded fork to be evicted")
+	assert.NotNil(t, bs.epochStates.getFinalized(0))
+	assert.NotNil(t, bs.epochStates.getFinalized(2))
+	assert.NotNil(t, bs.epochStates.get(laterKey), "Expected the state of the epoch after finality to be kept")
+	assert.Equal(t, true, bs.epochStates.getFinalized(3) == nil)
+
+	// Finalized epochs are served by epoch, without looking up their dependent root.
+	st, err := bs.epochStartState(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(64), st.Slot())
+
+	// Evicted finalized entries are forgotten.
+	for epoch := types.Epoch(4); epoch < 4+maxCachedEpochStates; epoch++ {
+		bs.epochStates.put(epochStateKey{epoch: epoch, dependentRoot: later}, newState(0))
+	}
+	assert.Equal(t, true, bs.epochStates.getFinalized(2) == nil)
+	assert.Equal(t, 0, len(bs.epochStates.finalized))
+}
@@ -0,0 +1,72 @@
+package beacon
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxBalanceHistoryEpochs bounds the number of epochs a single balance history request may cover.
+// Every epoch is a single read of the archived balances, so the bound is far looser than the ones
+// of endpoints which regenerate states.
+const maxBalanceHistoryEpochs = types.Epoch(1024)
+
+// ListValidatorBalanceHistory returns the balances of a validator over an epoch range, read from
+// the balances archived at finalization. Epochs which are not finalized yet, which were skipped
+// when finalization advanced by several epochs at once, or at which the validator was not yet in
+// the registry are left out of the response.
+func (bs *Server) ListValidatorBalanceHistory(
+	ctx context.Context, req *pbrpc.ListValidatorBalanceHistoryRequest,
+) (*pbrpc.ValidatorBalanceHistory, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconChainServer.ListValidatorBalanceHistory")
+	defer span.End()
+
+	currentEpoch := helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
+	if req.ToEpoch > currentEpoch {
+		return nil, futureEpochError(currentEpoch, req.ToEpoch)
+	}
+	if req.FromEpoch > req.ToEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "From epoch %d is after to epoch %d", req.FromEpoch, req.ToEpoch)
+	}
+	if req.ToEpoch-req.FromEpoch >= maxBalanceHistoryEpochs {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested epoch range exceeds the maximum of %d epochs", maxBalanceHistoryEpochs)
+	}
+
+	index := req.Index
+	if len(req.PublicKey) > 0 {
+		headState, err := bs.HeadFetcher.HeadState(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+		}
+		var ok bool
+		index, ok = headState.ValidatorIndexByPubkey(bytesutil.ToBytes48(req.PublicKey))
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "Could not find validator index for public key %#x", req.PublicKey)
+		}
+	}
+
+	history := &pbrpc.ValidatorBalanceHistory{
+		Index:    index,
+		Balances: make([]*pbrpc.EpochBalance, 0),
+	}
+	for epoch := req.FromEpoch; epoch <= req.ToEpoch; epoch++ {
+		balances, err := bs.BeaconDB.ValidatorBalances(ctx, epoch)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve archived balances for epoch %d: %v", epoch, err)
+		}
+		if uint64(index) >= uint64(len(balances)) {
+			continue
+		}
+		history.Balances = append(history.Balances, &pbrpc.EpochBalance{
+			Epoch:   epoch,
+			Balance: balances[index],
+		})
+	}
+	return history, nil
+}
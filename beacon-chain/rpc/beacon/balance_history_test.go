@@ -0,0 +1,87 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_ListValidatorBalanceHistory(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	headState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	validators := make([]*ethpb.Validator, 3)
+	for i := range validators {
+		validators[i] = &ethpb.Validator{PublicKey: pubKey(uint64(i)), WithdrawalCredentials: make([]byte, 32)}
+	}
+	require.NoError(t, headState.SetValidators(validators))
+
+	// Epoch 2 is missing, and validator 2 only joined at epoch 3.
+	require.NoError(t, db.SaveValidatorBalances(ctx, 1, []uint64{32e9, 31e9}))
+	require.NoError(t, db.SaveValidatorBalances(ctx, 3, []uint64{32e9 + 3, 31e9 + 3, 32e9}))
+	require.NoError(t, db.SaveValidatorBalances(ctx, 4, []uint64{32e9 + 4, 31e9 + 4, 32e9 + 4}))
+
+	currentSlot := params.BeaconConfig().SlotsPerEpoch * 10
+	bs := &Server{
+		BeaconDB:           db,
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		HeadFetcher:        &mock.ChainService{State: headState},
+	}
+
+	res, err := bs.ListValidatorBalanceHistory(ctx, &pbrpc.ListValidatorBalanceHistoryRequest{
+		PublicKey: pubKey(1),
+		FromEpoch: 0,
+		ToEpoch:   3,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, types.ValidatorIndex(1), res.Index)
+	assert.DeepEqual(t, []*pbrpc.EpochBalance{
+		{Epoch: 1, Balance: 31e9},
+		{Epoch: 3, Balance: 31e9 + 3},
+	}, res.Balances)
+
+	res, err = bs.ListValidatorBalanceHistory(ctx, &pbrpc.ListValidatorBalanceHistoryRequest{
+		Index:     2,
+		FromEpoch: 1,
+		ToEpoch:   10,
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*pbrpc.EpochBalance{
+		{Epoch: 3, Balance: 32e9},
+		{Epoch: 4, Balance: 32e9 + 4},
+	}, res.Balances)
+}
+
+func TestServer_ListValidatorBalanceHistory_InvalidRequests(t *testing.T) {
+	headState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	currentSlot := params.BeaconConfig().SlotsPerEpoch * 10
+	bs := &Server{
+		BeaconDB:           dbTest.SetupDB(t),
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		HeadFetcher:        &mock.ChainService{State: headState},
+	}
+	ctx := context.Background()
+
+	_, err = bs.ListValidatorBalanceHistory(ctx, &pbrpc.ListValidatorBalanceHistoryRequest{FromEpoch: 5, ToEpoch: 4})
+	assert.ErrorContains(t, "From epoch 5 is after to epoch 4", err)
+	_, err = bs.ListValidatorBalanceHistory(ctx, &pbrpc.ListValidatorBalanceHistoryRequest{ToEpoch: 11})
+	assert.ErrorContains(t, "Cannot retrieve information about an epoch in the future", err)
+	_, err = bs.ListValidatorBalanceHistory(ctx, &pbrpc.ListValidatorBalanceHistoryRequest{PublicKey: pubKey(1)})
+	assert.ErrorContains(t, "Could not find validator index for public key", err)
+
+	currentSlot = params.BeaconConfig().SlotsPerEpoch * 2000
+	_, err = bs.ListValidatorBalanceHistory(ctx, &pbrpc.ListValidatorBalanceHistoryRequest{ToEpoch: maxBalanceHistoryEpochs})
+	assert.ErrorContains(t, "Requested epoch range exceeds the maximum", err)
+}
@@ -0,0 +1,57 @@
+package beacon
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxBlockAvailabilitySlots bounds the number of slots a single block availability request may cover.
+const maxBlockAvailabilitySlots = 8192
+
+// GetBlockAvailability returns a bitfield of the slots in the range which have a canonical block
+// stored, so that backfill tooling and the orchestrator can detect gaps without requesting the
+// blocks of every slot.
+func (bs *Server) GetBlockAvailability(ctx context.Context, req *pbrpc.BlockAvailabilityRequest) (*pbrpc.BlockAvailability, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconChainServer.GetBlockAvailability")
+	defer span.End()
+
+	if req.FromSlot > req.ToSlot {
+		return nil, status.Errorf(codes.InvalidArgument, "From slot %d is after to slot %d", req.FromSlot, req.ToSlot)
+	}
+	if req.ToSlot-req.FromSlot >= maxBlockAvailabilitySlots {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested slot range exceeds the maximum of %d slots", maxBlockAvailabilitySlots)
+	}
+	if currentSlot := bs.GenesisTimeFetcher.CurrentSlot(); req.ToSlot > currentSlot {
+		return nil, status.Errorf(codes.InvalidArgument, "Cannot retrieve block availability of slot %d after the current slot %d", req.ToSlot, currentSlot)
+	}
+
+	blks, roots, err := bs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartSlot(req.FromSlot).SetEndSlot(req.ToSlot))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get blocks: %v", err)
+	}
+	available := bitfield.NewBitlist(uint64(req.ToSlot-req.FromSlot) + 1)
+	for i, blk := range blks {
+		if blk == nil || blk.Block == nil || blk.Block.Slot < req.FromSlot || blk.Block.Slot > req.ToSlot {
+			continue
+		}
+		// Slots may hold blocks of abandoned forks, which do not fill a gap of the canonical chain.
+		canonical, err := bs.CanonicalFetcher.IsCanonical(ctx, roots[i])
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not determine if block is canonical: %v", err)
+		}
+		if canonical {
+			available.SetBitAt(uint64(blk.Block.Slot-req.FromSlot), true)
+		}
+	}
+	return &pbrpc.BlockAvailability{
+		FromSlot:  req.FromSlot,
+		ToSlot:    req.ToSlot,
+		Available: available,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetBlockAvailability(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	canonical := make(map[[32]byte]bool)
+	for _, slot := range []types.Slot{1, 2, 4, 5, 9} {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = slot
+		require.NoError(t, db.SaveBlock(ctx, blk))
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		// The block at slot 5 is on an abandoned fork.
+		if slot != 5 {
+			canonical[root] = true
+		}
+	}
+	currentSlot := types.Slot(10)
+	bs := &Server{
+		BeaconDB:           db,
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		CanonicalFetcher:   &mock.ChainService{CanonicalRoots: canonical},
+	}
+
+	res, err := bs.GetBlockAvailability(ctx, &pbrpc.BlockAvailabilityRequest{FromSlot: 2, ToSlot: 8})
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(2), res.FromSlot)
+	assert.Equal(t, types.Slot(8), res.ToSlot)
+	require.Equal(t, uint64(7), res.Available.Len())
+	var available []types.Slot
+	for i := uint64(0); i < res.Available.Len(); i++ {
+		if res.Available.BitAt(i) {
+			available = append(available, res.FromSlot+types.Slot(i))
+		}
+	}
+	assert.DeepEqual(t, []types.Slot{2, 4}, available)
+}
+
+func TestServer_GetBlockAvailability_InvalidRange(t *testing.T) {
+	currentSlot := types.Slot(10)
+	bs := &Server{GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot}}
+	ctx := context.Background()
+
+	_, err := bs.GetBlockAvailability(ctx, &pbrpc.BlockAvailabilityRequest{FromSlot: 3, ToSlot: 2})
+	assert.ErrorContains(t, "From slot 3 is after to slot 2", err)
+	_, err = bs.GetBlockAvailability(ctx, &pbrpc.BlockAvailabilityRequest{FromSlot: 0, ToSlot: maxBlockAvailabilitySlots})
+	assert.ErrorContains(t, "exceeds the maximum", err)
+	_, err = bs.GetBlockAvailability(ctx, &pbrpc.BlockAvailabilityRequest{FromSlot: 5, ToSlot: 11})
+	assert.ErrorContains(t, "after the current slot", err)
+}
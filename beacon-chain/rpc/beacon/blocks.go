@@ -13,8 +13,8 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
-	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"github.com/prysmaticlabs/prysm/shared/pagination"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"google.golang.org/grpc/codes"
@@ -27,12 +27,27 @@ import (
 // provided as the filter criteria. The server may return an empty list when
 // no blocks in their database match the filter criteria. This RPC should
 // not return NOT_FOUND. Only one filter criteria should be used.
+//
+// The Pandora blocks paired with the returned blocks are reported in the response headers.
 func (bs *Server) ListBlocks(
 	ctx context.Context, req *ethpb.ListBlocksRequest,
 ) (*ethpb.ListBlocksResponse, error) {
-	if int(req.PageSize) > cmd.Get().MaxRPCPageSize {
+	res, err := bs.listBlocks(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := bs.reportPandoraPairings(ctx, res.BlockContainers); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (bs *Server) listBlocks(
+	ctx context.Context, req *ethpb.ListBlocksRequest,
+) (*ethpb.ListBlocksResponse, error) {
+	if int(req.PageSize) > bs.MaxPageSize() {
 		return nil, status.Errorf(codes.InvalidArgument, "Requested page size %d can not be greater than max size %d",
-			req.PageSize, cmd.Get().MaxRPCPageSize)
+			req.PageSize, bs.MaxPageSize())
 	}
 
 	switch q := req.QueryFilter.(type) {
@@ -271,7 +286,7 @@ func (bs *Server) StreamChainHead(_ *ptypes.Empty, stream ethpb.BeaconChain_Stre
 		case <-stateSub.Err():
 			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
 		case <-bs.Ctx.Done():
-			return status.Error(codes.Canceled, "Context canceled")
+			return grpcutils.ShuttingDownError()
 		case <-stream.Context().Done():
 			return status.Error(codes.Canceled, "Context canceled")
 		}
@@ -293,7 +308,7 @@ func (bs *Server) chainHeadRetrieval(ctx context.Context) (*ethpb.ChainHead, err
 	}
 
 	isGenesis := func(cp *ethpb.Checkpoint) bool {
-		return bytesutil.ToBytes32(cp.Root) == params.BeaconConfig().ZeroHash && cp.Epoch == 0
+		return bytesutil.ToBytes32(cp.Root) == params.BeaconConfigOf(bs.ChainConfig).ZeroHash && cp.Epoch == 0
 	}
 	// Retrieve genesis block in the event we have genesis checkpoints.
 	genBlock, err := bs.BeaconDB.GenesisBlock(ctx)
@@ -368,7 +383,7 @@ func (bs *Server) GetWeakSubjectivityCheckpoint(ctx context.Context, _ *ptypes.E
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Could not get head state")
 	}
-	wsEpoch, err := helpers.ComputeWeakSubjectivityPeriod(hs)
+	wsEpoch, err := helpers.ComputeWeakSubjectivityPeriod(bs.CommitteeCache, hs)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Could not get weak subjectivity epoch")
 	}
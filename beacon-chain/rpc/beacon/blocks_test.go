@@ -22,11 +22,14 @@ import (
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"github.com/prysmaticlabs/prysm/shared/mock"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestServer_ListBlocks_NoResults(t *testing.T) {
@@ -430,7 +433,7 @@ func TestServer_StreamChainHead_ContextCanceled(t *testing.T) {
 	db := dbTest.SetupDB(t)
 	ctx := context.Background()
 
-	ctx, cancel := context.WithCancel(ctx)
+	streamCtx, cancel := context.WithCancel(ctx)
 	chainService := &chainMock.ChainService{}
 	server := &Server{
 		Ctx:           ctx,
@@ -442,7 +445,7 @@ func TestServer_StreamChainHead_ContextCanceled(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockStream := mock.NewMockBeaconChain_StreamChainHeadServer(ctrl)
-	mockStream.EXPECT().Context().Return(ctx)
+	mockStream.EXPECT().Context().Return(streamCtx).AnyTimes()
 	go func(tt *testing.T) {
 		assert.ErrorContains(tt, "Context canceled", server.StreamChainHead(&ptypes.Empty{}, mockStream))
 		<-exitRoutine
@@ -451,6 +454,30 @@ func TestServer_StreamChainHead_ContextCanceled(t *testing.T) {
 	exitRoutine <- true
 }
 
+func TestServer_StreamChainHead_ShuttingDown(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	chainService := &chainMock.ChainService{}
+	server := &Server{
+		Ctx:           ctx,
+		StateNotifier: chainService.StateNotifier(),
+		BeaconDB:      db,
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStream := mock.NewMockBeaconChain_StreamChainHeadServer(ctrl)
+	mockStream.EXPECT().Context().Return(context.Background()).AnyTimes()
+	errs := make(chan error, 1)
+	go func() {
+		errs <- server.StreamChainHead(&ptypes.Empty{}, mockStream)
+	}()
+	cancel()
+	err := <-errs
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, true, grpcutils.IsShuttingDown(err))
+}
+
 func TestServer_StreamChainHead_OnHeadUpdated(t *testing.T) {
 	db := dbTest.SetupDB(t)
 	params.UseMainnetConfig()
@@ -0,0 +1,48 @@
+package beacon
+
+import (
+	"sync/atomic"
+
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+)
+
+// MaxPageSize returns the cap on the page size of paginated requests, which is the
+// --rpc-max-page-size flag unless the admin service overrode it.
+func (bs *Server) MaxPageSize() int {
+	if size := atomic.LoadInt64(&bs.maxPageSize); size > 0 {
+		return int(size)
+	}
+	return cmd.Get().MaxRPCPageSize
+}
+
+// SetMaxPageSize overrides the cap on the page size of paginated requests.
+func (bs *Server) SetMaxPageSize(size int) {
+	atomic.StoreInt64(&bs.maxPageSize, int64(size))
+}
+
+// EpochInfoCacheSize returns the number of encoded epoch infos kept by the epoch info hub.
+func (bs *Server) EpochInfoCacheSize() int {
+	if size := atomic.LoadInt64(&bs.epochInfoCacheSize); size > 0 {
+		return int(size)
+	}
+	return maxCachedEpochInfos
+}
+
+// SetEpochInfoCacheSize changes the number of encoded epoch infos kept by the epoch info hub. A
+// smaller cache evicts the oldest epoch infos as the next epoch info is cached.
+func (bs *Server) SetEpochInfoCacheSize(size int) {
+	atomic.StoreInt64(&bs.epochInfoCacheSize, int64(size))
+}
+
+// EpochStateCacheSize returns the number of epoch start states kept for assignment requests.
+func (bs *Server) EpochStateCacheSize() int {
+	bs.epochStates.lock.Lock()
+	defer bs.epochStates.lock.Unlock()
+	return bs.epochStates.maxEntries()
+}
+
+// SetEpochStateCacheSize changes the number of epoch start states kept for assignment requests,
+// evicting the oldest states beyond it.
+func (bs *Server) SetEpochStateCacheSize(size int) {
+	bs.epochStates.resize(size)
+}
@@ -0,0 +1,61 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_SetMaxPageSize(t *testing.T) {
+	resetCfg := cmd.InitWithReset(&cmd.Flags{MaxRPCPageSize: 250})
+	defer resetCfg()
+	bs := &Server{}
+	assert.Equal(t, 250, bs.MaxPageSize())
+	bs.SetMaxPageSize(2)
+	assert.Equal(t, 2, bs.MaxPageSize())
+	assert.Equal(t, 250, cmd.Get().MaxRPCPageSize, "Expected the flags to be left unchanged")
+
+	_, err := bs.GetSubnetAssignments(context.Background(), &pbrpc.SubnetAssignmentsRequest{PublicKeys: make([][]byte, 3)})
+	assert.ErrorContains(t, "can not be greater than max size 2", err)
+}
+
+func TestServer_SetEpochInfoCacheSize(t *testing.T) {
+	bs := &Server{}
+	assert.Equal(t, maxCachedEpochInfos, bs.EpochInfoCacheSize())
+	bs.SetEpochInfoCacheSize(2)
+	assert.Equal(t, 2, bs.EpochInfoCacheSize())
+
+	hub := newEpochInfoHub(func(_ context.Context, epoch types.Epoch) (*orchestrator.EpochInfo, error) {
+		return &orchestrator.EpochInfo{Epoch: epoch}, nil
+	})
+	hub.maxEntries = bs.EpochInfoCacheSize
+	for epoch := types.Epoch(1); epoch <= 3; epoch++ {
+		_, err := hub.epochInfo(context.Background(), epoch)
+		require.NoError(t, err)
+	}
+	assert.DeepEqual(t, []types.Epoch{2, 3}, hub.order)
+	_, ok := hub.entries[1]
+	assert.Equal(t, false, ok)
+}
+
+func TestServer_SetEpochStateCacheSize(t *testing.T) {
+	bs := &Server{}
+	assert.Equal(t, maxCachedEpochStates, bs.EpochStateCacheSize())
+	for epoch := types.Epoch(1); epoch <= 3; epoch++ {
+		bs.epochStates.put(epochStateKey{epoch: epoch}, nil)
+	}
+	bs.epochStates.finalized = map[types.Epoch]epochStateKey{1: {epoch: 1}}
+
+	// Shrinking the cache evicts the oldest states right away.
+	bs.SetEpochStateCacheSize(1)
+	assert.Equal(t, 1, bs.EpochStateCacheSize())
+	assert.DeepEqual(t, []epochStateKey{{epoch: 3}}, bs.epochStates.order)
+	assert.Equal(t, 1, len(bs.epochStates.entries))
+	assert.Equal(t, 0, len(bs.epochStates.finalized))
+}
@@ -0,0 +1,141 @@
+package beacon
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/blockutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListCanonicalBlockHeaders walks the canonical chain over a slot range page by page, so that
+// block explorers can follow the Vanguard chain without a request per slot. Skipped slots and
+// blocks of other forks are left out. Page tokens are slots, so a page lists the same headers
+// regardless of the blocks saved after the previous page.
+func (bs *Server) ListCanonicalBlockHeaders(
+	ctx context.Context, req *pbrpc.ListCanonicalBlockHeadersRequest,
+) (*pbrpc.CanonicalBlockHeaders, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconChainServer.ListCanonicalBlockHeaders")
+	defer span.End()
+
+	if req.FromSlot > req.ToSlot {
+		return nil, status.Errorf(codes.InvalidArgument, "From slot %d is after to slot %d", req.FromSlot, req.ToSlot)
+	}
+	if int(req.PageSize) > bs.MaxPageSize() {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Requested page size %d can not be greater than max size %d",
+			req.PageSize,
+			bs.MaxPageSize(),
+		)
+	}
+	pageSize := int(req.PageSize)
+	if pageSize == 0 {
+		pageSize = params.BeaconConfig().DefaultPageSize
+	}
+	cursor := req.FromSlot
+	if req.PageToken != "" {
+		slot, err := strconv.ParseUint(req.PageToken, 10, 64)
+		if err != nil || types.Slot(slot) < req.FromSlot || types.Slot(slot) > req.ToSlot {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid page token %q", req.PageToken)
+		}
+		cursor = types.Slot(slot)
+	}
+
+	res := &pbrpc.CanonicalBlockHeaders{Headers: make([]*pbrpc.CanonicalBlockHeader, 0, pageSize)}
+	// A slot holds at most one canonical block, so the slots are read a page size at a time.
+	window := types.Slot(pageSize)
+	for {
+		end := req.ToSlot
+		if req.ToSlot-cursor >= window {
+			end = cursor + window - 1
+		}
+		blks, roots, err := bs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartSlot(cursor).SetEndSlot(end))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get blocks of slots %d to %d: %v", cursor, end, err)
+		}
+		order := make([]int, len(blks))
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(i, j int) bool { return blks[order[i]].Block.Slot < blks[order[j]].Block.Slot })
+		for _, i := range order {
+			canonical, err := bs.isCanonicalBlock(ctx, blks[i].Block.Slot, roots[i])
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not determine if block %#x is canonical: %v", roots[i], err)
+			}
+			if !canonical {
+				continue
+			}
+			header, err := bs.canonicalBlockHeader(ctx, blks[i], roots[i])
+			if err != nil {
+				return nil, err
+			}
+			res.Headers = append(res.Headers, header)
+			if len(res.Headers) == pageSize {
+				if slot := blks[i].Block.Slot; slot < req.ToSlot {
+					res.NextPageToken = strconv.FormatUint(uint64(slot+1), 10)
+				}
+				return res, nil
+			}
+		}
+		if end == req.ToSlot {
+			return res, nil
+		}
+		cursor = end + 1
+	}
+}
+
+// isCanonicalBlock reports whether the block of a slot is on the canonical chain, from the
+// canonical root index when the slot is indexed and from fork choice otherwise.
+func (bs *Server) isCanonicalBlock(ctx context.Context, slot types.Slot, root [32]byte) (bool, error) {
+	if bs.CanonicalRootFetcher != nil {
+		canonicalRoot, ok, err := bs.CanonicalRootFetcher.CanonicalRootAtSlot(slot)
+		if err == nil {
+			return ok && canonicalRoot == root, nil
+		}
+	}
+	return bs.CanonicalFetcher.IsCanonical(ctx, root)
+}
+
+// canonicalBlockHeader returns the header of a block along with its orchestrator confirmation.
+func (bs *Server) canonicalBlockHeader(
+	ctx context.Context, blk *ethpb.SignedBeaconBlock, root [32]byte,
+) (*pbrpc.CanonicalBlockHeader, error) {
+	header, err := blockutil.BeaconBlockHeaderFromBlock(blk.Block)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get header of block %#x: %v", root, err)
+	}
+	res := &pbrpc.CanonicalBlockHeader{Root: bytesutil.SafeCopyBytes(root[:]), Header: header}
+	if bs.ConfirmationStore != nil {
+		confirmation, err := bs.ConfirmationStore.PandoraConfirmation(ctx, root)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get orchestrator confirmation of block %#x: %v", root, err)
+		}
+		res.Confirmation = pandoraConfirmation(confirmation)
+	}
+	return res, nil
+}
+
+// pandoraConfirmation converts an orchestrator confirmation, which may be nil, to its protobuf form.
+func pandoraConfirmation(c *orchestrator.Confirmation) *pbrpc.PandoraConfirmation {
+	if c == nil {
+		return nil
+	}
+	return &pbrpc.PandoraConfirmation{
+		Slot:        c.Slot,
+		BlockRoot:   bytesutil.SafeCopyBytes(c.BlockRoot[:]),
+		PandoraHash: bytesutil.SafeCopyBytes(c.PandoraHash[:]),
+		Status:      pbrpc.PandoraConfirmation_Status(c.Status),
+	}
+}
@@ -0,0 +1,90 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_ListCanonicalBlockHeaders(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	canonical := make(map[[32]byte]bool)
+	rootsBySlot := make(map[types.Slot][32]byte)
+	// Slot 4 is skipped and slot 3 has a block of another fork as well.
+	for _, slot := range []types.Slot{1, 2, 3, 5, 6, 7} {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = slot
+		blk.Block.ProposerIndex = types.ValidatorIndex(slot + 10)
+		require.NoError(t, db.SaveBlock(ctx, blk))
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		canonical[root] = true
+		rootsBySlot[slot] = root
+	}
+	fork := testutil.NewBeaconBlock()
+	fork.Block.Slot = 3
+	fork.Block.ParentRoot = bytesutil.PadTo([]byte("fork"), 32)
+	require.NoError(t, db.SaveBlock(ctx, fork))
+	require.NoError(t, db.SavePandoraConfirmations(ctx, []*orchestrator.Confirmation{{
+		Slot:        2,
+		BlockRoot:   rootsBySlot[2],
+		PandoraHash: [32]byte{'p'},
+		Status:      orchestrator.Verified,
+	}}))
+
+	bs := &Server{
+		BeaconDB:          db,
+		CanonicalFetcher:  &mock.ChainService{CanonicalRoots: canonical},
+		ConfirmationStore: db,
+	}
+	var slots []types.Slot
+	var tokens []string
+	req := &pbrpc.ListCanonicalBlockHeadersRequest{FromSlot: 1, ToSlot: 7, PageSize: 2}
+	for {
+		res, err := bs.ListCanonicalBlockHeaders(ctx, req)
+		require.NoError(t, err)
+		for _, h := range res.Headers {
+			slots = append(slots, h.Header.Slot)
+			wantRoot := rootsBySlot[h.Header.Slot]
+			assert.DeepEqual(t, wantRoot[:], h.Root)
+			assert.Equal(t, types.ValidatorIndex(h.Header.Slot+10), h.Header.ProposerIndex)
+			if h.Header.Slot == 2 {
+				require.NotNil(t, h.Confirmation)
+				assert.DeepEqual(t, bytesutil.PadTo([]byte{'p'}, 32), h.Confirmation.PandoraHash)
+				assert.Equal(t, pbrpc.PandoraConfirmation_VERIFIED, h.Confirmation.Status)
+			} else {
+				assert.Equal(t, (*pbrpc.PandoraConfirmation)(nil), h.Confirmation)
+			}
+		}
+		if res.NextPageToken == "" {
+			break
+		}
+		tokens = append(tokens, res.NextPageToken)
+		req.PageToken = res.NextPageToken
+	}
+	assert.DeepEqual(t, []types.Slot{1, 2, 3, 5, 6, 7}, slots)
+	assert.DeepEqual(t, []string{"3", "6"}, tokens)
+}
+
+func TestServer_ListCanonicalBlockHeaders_InvalidRequest(t *testing.T) {
+	bs := &Server{BeaconDB: dbTest.SetupDB(t)}
+	ctx := context.Background()
+
+	_, err := bs.ListCanonicalBlockHeaders(ctx, &pbrpc.ListCanonicalBlockHeadersRequest{FromSlot: 5, ToSlot: 4})
+	assert.ErrorContains(t, "From slot 5 is after to slot 4", err)
+	_, err = bs.ListCanonicalBlockHeaders(ctx, &pbrpc.ListCanonicalBlockHeadersRequest{FromSlot: 5, ToSlot: 10, PageToken: "11"})
+	assert.ErrorContains(t, "Invalid page token", err)
+	_, err = bs.ListCanonicalBlockHeaders(ctx, &pbrpc.ListCanonicalBlockHeadersRequest{FromSlot: 5, ToSlot: 10, PageToken: "x"})
+	assert.ErrorContains(t, "Invalid page token", err)
+}
@@ -0,0 +1,63 @@
+package beacon
+
+import (
+	"context"
+	"errors"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxCanonicalRootSlots bounds the number of slots a single ListCanonicalBlockRoots call may cover.
+const maxCanonicalRootSlots = types.Slot(1024)
+
+// ListCanonicalBlockRoots returns the canonical block roots of a range of recent slots from the
+// in-memory index of the chain of the head, without reading the database. Slots after the head or
+// too old to be indexed are out of range.
+func (bs *Server) ListCanonicalBlockRoots(ctx context.Context, req *pbrpc.ListCanonicalBlockRootsRequest) (*pbrpc.CanonicalBlockRoots, error) {
+	_, span := trace.StartSpan(ctx, "BeaconChainServer.ListCanonicalBlockRoots")
+	defer span.End()
+
+	if bs.CanonicalRootFetcher == nil {
+		return nil, status.Error(codes.Unavailable, "Canonical root index is not available")
+	}
+	if req.FromSlot > req.ToSlot {
+		return nil, status.Errorf(codes.InvalidArgument, "From slot %d is after to slot %d", req.FromSlot, req.ToSlot)
+	}
+	if req.ToSlot-req.FromSlot >= maxCanonicalRootSlots {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested slot range exceeds the maximum of %d slots", maxCanonicalRootSlots)
+	}
+	res := &pbrpc.CanonicalBlockRoots{Roots: make([]*pbrpc.CanonicalBlockRoot, 0)}
+	for slot := req.FromSlot; slot <= req.ToSlot; slot++ {
+		root, ok, err := bs.CanonicalRootFetcher.CanonicalRootAtSlot(slot)
+		if errors.Is(err, blockchain.ErrSlotNotIndexed) {
+			return nil, status.Errorf(codes.OutOfRange, "Slot %d is not in the canonical root index", slot)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get canonical root of slot %d: %v", slot, err)
+		}
+		if ok {
+			res.Roots = append(res.Roots, &pbrpc.CanonicalBlockRoot{Slot: slot, Root: bytesutil.SafeCopyBytes(root[:])})
+		}
+	}
+	return res, nil
+}
+
+// indexedCanonicalSlot reports whether a slot has a canonical block according to the canonical
+// root index. The returned bool is false if the slot is not indexed, so that the caller falls
+// back to the database.
+func (bs *Server) indexedCanonicalSlot(slot types.Slot) (canonical, indexed bool) {
+	if bs.CanonicalRootFetcher == nil {
+		return false, false
+	}
+	_, ok, err := bs.CanonicalRootFetcher.CanonicalRootAtSlot(slot)
+	if err != nil {
+		return false, false
+	}
+	return ok, true
+}
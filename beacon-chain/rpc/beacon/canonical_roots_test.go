@@ -0,0 +1,89 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mockCanonicalRootFetcher indexes the canonical roots of the slots up to the head slot, from
+// the lowest slot on. Slots missing from the roots are skipped.
+type mockCanonicalRootFetcher struct {
+	lowest types.Slot
+	head   types.Slot
+	roots  map[types.Slot][32]byte
+}
+
+func (m *mockCanonicalRootFetcher) CanonicalRootAtSlot(slot types.Slot) ([32]byte, bool, error) {
+	if slot < m.lowest || slot > m.head {
+		return [32]byte{}, false, blockchain.ErrSlotNotIndexed
+	}
+	root, ok := m.roots[slot]
+	return root, ok, nil
+}
+
+func (m *mockCanonicalRootFetcher) HighestCanonicalRootAtOrBelow(slot types.Slot) ([32]byte, types.Slot, error) {
+	if slot > m.head {
+		slot = m.head
+	}
+	for ; slot >= m.lowest; slot-- {
+		if root, ok := m.roots[slot]; ok {
+			return root, slot, nil
+		}
+		if slot == 0 {
+			break
+		}
+	}
+	return [32]byte{}, 0, blockchain.ErrSlotNotIndexed
+}
+
+func TestServer_ListCanonicalBlockRoots(t *testing.T) {
+	r10, r12 := bytesutil.ToBytes32([]byte{'a'}), bytesutil.ToBytes32([]byte{'b'})
+	bs := &Server{CanonicalRootFetcher: &mockCanonicalRootFetcher{
+		lowest: 10,
+		head:   12,
+		roots:  map[types.Slot][32]byte{10: r10, 12: r12},
+	}}
+	ctx := context.Background()
+
+	res, err := bs.ListCanonicalBlockRoots(ctx, &pbrpc.ListCanonicalBlockRootsRequest{FromSlot: 10, ToSlot: 12})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*pbrpc.CanonicalBlockRoot{{Slot: 10, Root: r10[:]}, {Slot: 12, Root: r12[:]}}, res.Roots)
+
+	_, err = bs.ListCanonicalBlockRoots(ctx, &pbrpc.ListCanonicalBlockRootsRequest{FromSlot: 9, ToSlot: 12})
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+	_, err = bs.ListCanonicalBlockRoots(ctx, &pbrpc.ListCanonicalBlockRootsRequest{FromSlot: 11, ToSlot: 13})
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+	_, err = bs.ListCanonicalBlockRoots(ctx, &pbrpc.ListCanonicalBlockRootsRequest{FromSlot: 12, ToSlot: 10})
+	assert.ErrorContains(t, "From slot 12 is after to slot 10", err)
+	_, err = bs.ListCanonicalBlockRoots(ctx, &pbrpc.ListCanonicalBlockRootsRequest{FromSlot: 0, ToSlot: maxCanonicalRootSlots})
+	assert.ErrorContains(t, "exceeds the maximum", err)
+
+	_, err = (&Server{}).ListCanonicalBlockRoots(ctx, &pbrpc.ListCanonicalBlockRootsRequest{FromSlot: 10, ToSlot: 12})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestServer_IsSlotCanonical_UsesIndex(t *testing.T) {
+	bs := &Server{CanonicalRootFetcher: &mockCanonicalRootFetcher{
+		lowest: 10,
+		head:   12,
+		roots:  map[types.Slot][32]byte{10: {'a'}, 12: {'b'}},
+	}}
+	ctx := context.Background()
+
+	// The indexed slots are answered without the database, which the server does not have.
+	canonical, err := bs.isSlotCanonical(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, true, canonical)
+	canonical, err = bs.isSlotCanonical(ctx, 11)
+	require.NoError(t, err)
+	assert.Equal(t, false, canonical)
+}
@@ -0,0 +1,103 @@
+package beacon
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamAnnotatedChainHead sends the chain head on every processed block like StreamChainHead,
+// annotated with the proposer of the next slot, so that clients learn who proposes next without
+// a follow-up request every slot.
+func (bs *Server) StreamAnnotatedChainHead(_ *empty.Empty, stream pbrpc.BeaconQuery_StreamAnnotatedChainHeadServer) error {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := bs.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case stateEvent := <-stateChannel:
+			if stateEvent.Type != statefeed.BlockProcessed {
+				continue
+			}
+			res, err := bs.annotatedChainHead(stream.Context())
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(res); err != nil {
+				return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+			}
+		case <-stateSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-bs.Ctx.Done():
+			return grpcutils.ShuttingDownError()
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
+
+func (bs *Server) annotatedChainHead(ctx context.Context) (*pbrpc.AnnotatedChainHead, error) {
+	head, err := bs.chainHeadRetrieval(ctx)
+	if err != nil {
+		return nil, err
+	}
+	headState, err := bs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil {
+		return nil, status.Error(codes.Internal, "Head state of chain was nil")
+	}
+	nextSlot := bs.GenesisTimeFetcher.CurrentSlot() + 1
+	if nextSlot <= headState.Slot() {
+		nextSlot = headState.Slot() + 1
+	}
+	index, err := proposerAtSlot(ctx, bs.CommitteeCache, headState, nextSlot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute proposer of slot %d: %v", nextSlot, err)
+	}
+	pubKey := headState.PubkeyAtIndex(index)
+	return &pbrpc.AnnotatedChainHead{
+		Head:                  head,
+		NextSlot:              nextSlot,
+		NextProposerIndex:     index,
+		NextProposerPublicKey: pubKey[:],
+	}, nil
+}
+
+// proposerAtSlot returns the proposer of a slot after the given state on its chain. The proposers
+// of the epoch of the state and of the next one are usually precomputed, so they are served from
+// the proposer indices cache.
+func proposerAtSlot(ctx context.Context, committeeCache *cache.CommitteeCache, st iface.BeaconState, slot types.Slot) (types.ValidatorIndex, error) {
+	if slot <= st.Slot() {
+		return 0, errors.Errorf("slot %d is not after the state slot %d", slot, st.Slot())
+	}
+	st = st.Copy()
+	epoch := helpers.SlotToEpoch(slot)
+	if epoch > helpers.CurrentEpoch(st) {
+		startSlot, err := helpers.StartSlot(epoch)
+		if err != nil {
+			return 0, err
+		}
+		st, err = state.ProcessSlots(ctx, committeeCache, st, startSlot)
+		if err != nil {
+			return 0, errors.Wrapf(err, "could not process slots up to %d", startSlot)
+		}
+	}
+	if err := st.SetSlot(slot); err != nil {
+		return 0, err
+	}
+	return helpers.BeaconProposerIndex(committeeCache, st)
+}
@@ -0,0 +1,134 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+type annotatedChainHeadTestStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *pbrpc.AnnotatedChainHead
+}
+
+func (s *annotatedChainHeadTestStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *annotatedChainHeadTestStream) Send(h *pbrpc.AnnotatedChainHead) error {
+	s.sent <- h
+	return nil
+}
+
+func TestProposerAtSlot(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, st.SetSlot(5))
+
+	_, err := proposerAtSlot(ctx, nil, st, 5)
+	assert.ErrorContains(t, "is not after the state slot", err)
+
+	for _, slot := range []types.Slot{6, params.BeaconConfig().SlotsPerEpoch + 2} {
+		want := st.Copy()
+		want, err = state.ProcessSlots(ctx, nil, want, slot)
+		require.NoError(t, err)
+		wantIndex, err := helpers.BeaconProposerIndex(nil, want)
+		require.NoError(t, err)
+
+		index, err := proposerAtSlot(ctx, nil, st, slot)
+		require.NoError(t, err)
+		assert.Equal(t, wantIndex, index, "Wrong proposer of slot %d", slot)
+	}
+	assert.Equal(t, types.Slot(5), st.Slot(), "State was modified")
+}
+
+func TestServer_StreamAnnotatedChainHead(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	db := dbTest.SetupDB(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	genBlock := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, genBlock))
+	gRoot, err := genBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, gRoot))
+
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, st.SetSlot(5))
+	headBlock := testutil.NewBeaconBlock()
+	headBlock.Block.Slot = 5
+	headRoot, err := headBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	// The clock is behind the head, so the next slot follows the head.
+	slot := types.Slot(3)
+	chainService := &mock.ChainService{
+		State:                       st,
+		Block:                       headBlock,
+		Slot:                        &slot,
+		Genesis:                     time.Now(),
+		FinalizedCheckPoint:         &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]},
+		CurrentJustifiedCheckPoint:  &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]},
+		PreviousJustifiedCheckPoint: &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]},
+	}
+	notifier := &mock.MockStateNotifier{}
+	bs := &Server{
+		Ctx:                 ctx,
+		BeaconDB:            db,
+		HeadFetcher:         chainService,
+		FinalizationFetcher: chainService,
+		GenesisTimeFetcher:  chainService,
+		StateNotifier:       notifier,
+	}
+	streamCtx, cancelStream := context.WithCancel(ctx)
+	stream := &annotatedChainHeadTestStream{ctx: streamCtx, sent: make(chan *pbrpc.AnnotatedChainHead, 1)}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- bs.StreamAnnotatedChainHead(&empty.Empty{}, stream)
+	}()
+
+	next := st.Copy()
+	require.NoError(t, next.SetSlot(6))
+	wantIndex, err := helpers.BeaconProposerIndex(nil, next)
+	require.NoError(t, err)
+	wantPubKey := st.PubkeyAtIndex(wantIndex)
+
+	ev := &feed.Event{Type: statefeed.BlockProcessed, Data: &statefeed.BlockProcessedData{Slot: 5}}
+	for notifier.StateFeed().Send(ev) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	select {
+	case h := <-stream.sent:
+		assert.DeepEqual(t, headRoot[:], h.Head.HeadBlockRoot)
+		assert.Equal(t, types.Slot(6), h.NextSlot)
+		assert.Equal(t, wantIndex, h.NextProposerIndex)
+		assert.DeepEqual(t, wantPubKey[:], h.NextProposerPublicKey)
+	case <-time.After(10 * time.Second):
+		t.Fatal("Timed out waiting for chain head")
+	}
+	cancelStream()
+	assert.ErrorContains(t, "Context canceled", <-errs)
+}
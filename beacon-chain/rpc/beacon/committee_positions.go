@@ -0,0 +1,25 @@
+package beacon
+
+import (
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+)
+
+// committeePositions returns the committee position of every validator of the assignments which
+// has a committee, in the order of the assignments. Validators which are not active have none.
+func committeePositions(assignments []*ethpb.ValidatorAssignments_CommitteeAssignment) []*pbrpc.CommitteePosition {
+	positions := make([]*pbrpc.CommitteePosition, 0, len(assignments))
+	for _, a := range assignments {
+		for i, index := range a.BeaconCommittees {
+			if index == a.ValidatorIndex {
+				positions = append(positions, &pbrpc.CommitteePosition{
+					ValidatorIndex: a.ValidatorIndex,
+					Position:       uint64(i),
+					CommitteeSize:  uint64(len(a.BeaconCommittees)),
+				})
+				break
+			}
+		}
+	}
+	return positions
+}
@@ -0,0 +1,84 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestCommitteePositions(t *testing.T) {
+	committee := []types.ValidatorIndex{4, 2, 9}
+	positions := committeePositions([]*ethpb.ValidatorAssignments_CommitteeAssignment{
+		{BeaconCommittees: committee, ValidatorIndex: 9},
+		{BeaconCommittees: committee, ValidatorIndex: 4},
+		// Validators which are not active have no committee.
+		{ValidatorIndex: 7},
+	})
+	assert.DeepEqual(t, []*pbrpc.CommitteePosition{
+		{ValidatorIndex: 9, Position: 2, CommitteeSize: 3},
+		{ValidatorIndex: 4, Position: 0, CommitteeSize: 3},
+	}, positions)
+}
+
+func TestServer_ListAssignments_CommitteePositions(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	blockRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	s, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, db.SaveState(ctx, s, blockRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, blockRoot))
+
+	currentSlot := types.Slot(0)
+	bs := &Server{
+		BeaconDB:           db,
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		StateGen:           stategen.New(db),
+	}
+
+	res, err := bs.ListAnnotatedValidatorAssignments(ctx, &pbrpc.AnnotatedValidatorAssignmentsRequest{
+		QueryFilter: &pbrpc.AnnotatedValidatorAssignmentsRequest_Epoch{Epoch: 0},
+		Indices:     []types.ValidatorIndex{3, 10, 42},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(res.Assignments.Assignments))
+	var want []*pbrpc.CommitteePosition
+	for _, a := range res.Assignments.Assignments {
+		committee := a.BeaconCommittees
+		for i, index := range committee {
+			if index == a.ValidatorIndex {
+				want = append(want, &pbrpc.CommitteePosition{
+					ValidatorIndex: index,
+					Position:       uint64(i),
+					CommitteeSize:  uint64(len(committee)),
+				})
+			}
+		}
+	}
+	require.Equal(t, 3, len(want))
+	assert.DeepEqual(t, want, res.CommitteePositions)
+
+	rangeRes, err := bs.ListValidatorAssignmentsRange(ctx, &pbrpc.ListValidatorAssignmentsRangeRequest{
+		Indices: []types.ValidatorIndex{3, 10, 42},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rangeRes.CommitteePositions))
+	assert.DeepEqual(t, want, rangeRes.CommitteePositions[0].Positions)
+}
@@ -0,0 +1,117 @@
+package beacon
+
+import (
+	"context"
+	"encoding/binary"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/htrutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxCommitteeRootsEpochs bounds the number of epochs a single committee roots request may cover.
+const maxCommitteeRootsEpochs = types.Epoch(256)
+
+// GetCommitteeRoots returns the seeds and the active index root of every epoch in the range, so
+// that external tools can verify proposer lists and committees without the node sending the
+// full assignments. The whole range is derived from the state at the start of its last epoch,
+// which holds the randao mixes and the activation and exit epochs of all earlier epochs.
+func (bs *Server) GetCommitteeRoots(ctx context.Context, req *pbrpc.CommitteeRootsRequest) (*pbrpc.CommitteeRoots, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconChainServer.GetCommitteeRoots")
+	defer span.End()
+
+	currentEpoch := helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
+	if req.ToEpoch > currentEpoch {
+		return nil, futureEpochError(currentEpoch, req.ToEpoch)
+	}
+	if req.FromEpoch > req.ToEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "From epoch %d is after to epoch %d", req.FromEpoch, req.ToEpoch)
+	}
+	if req.ToEpoch-req.FromEpoch >= maxCommitteeRootsEpochs {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested epoch range exceeds the maximum of %d epochs", maxCommitteeRootsEpochs)
+	}
+
+	st, err := bs.StateGen.CanonicalStateInEpoch(ctx, req.ToEpoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve archived state for epoch %d: %v", req.ToEpoch, err)
+	}
+
+	res := &pbrpc.CommitteeRoots{Roots: make([]*pbrpc.CommitteeRoot, 0, req.ToEpoch-req.FromEpoch+1)}
+	for epoch := req.FromEpoch; epoch <= req.ToEpoch; epoch++ {
+		root, err := committeeRoot(st, epoch)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not compute committee root of epoch %d: %v", epoch, err)
+		}
+		res.Roots = append(res.Roots, root)
+	}
+	return res, nil
+}
+
+func committeeRoot(st iface.ReadOnlyBeaconState, epoch types.Epoch) (*pbrpc.CommitteeRoot, error) {
+	cfg := params.BeaconConfig()
+	mix, err := helpers.RandaoMix(st, epoch+cfg.EpochsPerHistoricalVector-cfg.MinSeedLookahead-1)
+	if err != nil {
+		return nil, err
+	}
+	proposerSeed, err := helpers.Seed(st, epoch, cfg.DomainBeaconProposer)
+	if err != nil {
+		return nil, err
+	}
+	attesterSeed, err := helpers.Seed(st, epoch, cfg.DomainBeaconAttester)
+	if err != nil {
+		return nil, err
+	}
+	// The active indices are collected directly rather than through the committee cache, which
+	// would otherwise be filled with the committees of every epoch in the range.
+	var indices []types.ValidatorIndex
+	if err := st.ReadFromEveryValidator(func(idx int, val iface.ReadOnlyValidator) error {
+		if helpers.IsActiveValidatorUsingTrie(val, epoch) {
+			indices = append(indices, types.ValidatorIndex(idx))
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	indexRoot, err := activeIndexRoot(indices)
+	if err != nil {
+		return nil, err
+	}
+	return &pbrpc.CommitteeRoot{
+		Epoch:                epoch,
+		RandaoMix:            mix,
+		ProposerSeed:         proposerSeed[:],
+		AttesterSeed:         attesterSeed[:],
+		ActiveIndexRoot:      indexRoot[:],
+		ActiveValidatorCount: uint64(len(indices)),
+	}, nil
+}
+
+// activeIndexRoot returns the hash tree root of the indices as a
+// List[ValidatorIndex, VALIDATOR_REGISTRY_LIMIT].
+func activeIndexRoot(indices []types.ValidatorIndex) ([32]byte, error) {
+	enc := make([][]byte, len(indices))
+	for i, index := range indices {
+		enc[i] = make([]byte, 8)
+		binary.LittleEndian.PutUint64(enc[i], uint64(index))
+	}
+	chunks, err := htrutils.Pack(enc)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	// Four indices fit in a chunk.
+	limit := (params.BeaconConfig().ValidatorRegistryLimit + 3) / 4
+	root, err := htrutils.BitwiseMerkleize(hashutil.CustomSHA256Hasher(), chunks, uint64(len(chunks)), limit)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	length := make([]byte, 32)
+	binary.LittleEndian.PutUint64(length, uint64(len(indices)))
+	return htrutils.MixInLength(root, length), nil
+}
@@ -0,0 +1,107 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetCommitteeRoots(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	count := 16
+	validators := make([]*ethpb.Validator, count)
+	for i := 0; i < count; i++ {
+		validators[i] = &ethpb.Validator{
+			PublicKey:             pubKey(uint64(i)),
+			WithdrawalCredentials: make([]byte, 32),
+			EffectiveBalance:      params.BeaconConfig().MaxEffectiveBalance,
+			ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+			WithdrawableEpoch:     params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	// Validator 14 joins at epoch 1 and validator 15 leaves at epoch 1.
+	validators[14].ActivationEpoch = 1
+	validators[15].ExitEpoch = 1
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetValidators(validators))
+	balances := make([]uint64, count)
+	for i := range balances {
+		balances[i] = params.BeaconConfig().MaxEffectiveBalance
+	}
+	require.NoError(t, st.SetBalances(balances))
+	b := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, b))
+	gRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, gRoot))
+	require.NoError(t, db.SaveState(ctx, st, gRoot))
+
+	currentSlot := params.BeaconConfig().SlotsPerEpoch
+	bs := &Server{
+		BeaconDB:           db,
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		StateGen:           stategen.New(db),
+	}
+
+	res, err := bs.GetCommitteeRoots(ctx, &pbrpc.CommitteeRootsRequest{FromEpoch: 0, ToEpoch: 1})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Roots))
+
+	expectedIndices := map[types.Epoch][]uint64{
+		0: {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 15},
+		1: {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14},
+	}
+	for i, root := range res.Roots {
+		epoch := types.Epoch(i)
+		assert.Equal(t, epoch, root.Epoch)
+		assert.Equal(t, uint64(len(expectedIndices[epoch])), root.ActiveValidatorCount)
+		hasher := ssz.NewHasher()
+		hasher.PutUint64Array(expectedIndices[epoch], params.BeaconConfig().ValidatorRegistryLimit)
+		wanted, err := hasher.HashRoot()
+		require.NoError(t, err)
+		assert.DeepEqual(t, wanted[:], root.ActiveIndexRoot)
+
+		// The roots derived from the last state of the range match the ones of the epoch's own state.
+		startSlot, err := helpers.StartSlot(epoch)
+		require.NoError(t, err)
+		epochState, err := bs.StateGen.StateBySlot(ctx, startSlot)
+		require.NoError(t, err)
+		proposerSeed, err := helpers.Seed(epochState, epoch, params.BeaconConfig().DomainBeaconProposer)
+		require.NoError(t, err)
+		assert.DeepEqual(t, proposerSeed[:], root.ProposerSeed)
+		attesterSeed, err := helpers.Seed(epochState, epoch, params.BeaconConfig().DomainBeaconAttester)
+		require.NoError(t, err)
+		assert.DeepEqual(t, attesterSeed[:], root.AttesterSeed)
+	}
+}
+
+func TestServer_GetCommitteeRoots_InvalidRequests(t *testing.T) {
+	currentSlot := params.BeaconConfig().SlotsPerEpoch * 1000
+	bs := &Server{GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot}}
+	ctx := context.Background()
+
+	_, err := bs.GetCommitteeRoots(ctx, &pbrpc.CommitteeRootsRequest{FromEpoch: 3, ToEpoch: 2})
+	assert.ErrorContains(t, "From epoch 3 is after to epoch 2", err)
+	_, err = bs.GetCommitteeRoots(ctx, &pbrpc.CommitteeRootsRequest{ToEpoch: 1001})
+	assert.ErrorContains(t, "Cannot retrieve information about an epoch in the future", err)
+	_, err = bs.GetCommitteeRoots(ctx, &pbrpc.CommitteeRootsRequest{ToEpoch: maxCommitteeRootsEpochs})
+	assert.ErrorContains(t, "Requested epoch range exceeds the maximum", err)
+}
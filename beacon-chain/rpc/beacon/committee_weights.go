@@ -0,0 +1,45 @@
+package beacon
+
+import (
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+)
+
+// committeeWeights computes the weights of the distinct committees of the assignments on the
+// state the assignments were computed from, in order of first appearance.
+func committeeWeights(
+	st iface.ReadOnlyBeaconState, assignments []*ethpb.ValidatorAssignments_CommitteeAssignment,
+) ([]*pbrpc.CommitteeWeight, error) {
+	totalActiveBalance, err := helpers.TotalActiveBalance(st)
+	if err != nil {
+		return nil, err
+	}
+	type committeeKey struct {
+		slot  types.Slot
+		index types.CommitteeIndex
+	}
+	seen := make(map[committeeKey]bool)
+	weights := make([]*pbrpc.CommitteeWeight, 0)
+	for _, a := range assignments {
+		key := committeeKey{slot: a.AttesterSlot, index: a.CommitteeIndex}
+		// Validators which are not active have no committee.
+		if len(a.BeaconCommittees) == 0 || seen[key] {
+			continue
+		}
+		seen[key] = true
+		w := &pbrpc.CommitteeWeight{Slot: a.AttesterSlot, CommitteeIndex: a.CommitteeIndex}
+		for _, index := range a.BeaconCommittees {
+			val, err := st.ValidatorAtIndexReadOnly(index)
+			if err != nil {
+				return nil, err
+			}
+			w.TotalEffectiveBalance += val.EffectiveBalance()
+		}
+		w.AttestationWeight = float64(w.TotalEffectiveBalance) / float64(totalActiveBalance)
+		weights = append(weights, w)
+	}
+	return weights, nil
+}
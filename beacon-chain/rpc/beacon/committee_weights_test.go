@@ -0,0 +1,82 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_ListAssignments_CommitteeWeights(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	blockRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	s, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, db.SaveState(ctx, s, blockRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, blockRoot))
+
+	currentSlot := types.Slot(0)
+	bs := &Server{
+		BeaconDB:           db,
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		StateGen:           stategen.New(db),
+	}
+
+	res, err := bs.ListValidatorAssignmentsRange(ctx, &pbrpc.ListValidatorAssignmentsRangeRequest{
+		Indices:          []types.ValidatorIndex{3, 10, 42},
+		CommitteeWeights: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.CommitteeWeights))
+	weights := res.CommitteeWeights[0].Weights
+	require.NotEqual(t, 0, len(weights))
+
+	// Every committee member has the maximum effective balance.
+	maxBalance := params.BeaconConfig().MaxEffectiveBalance
+	type committeeKey struct {
+		slot  types.Slot
+		index types.CommitteeIndex
+	}
+	committeeSizes := make(map[committeeKey]int)
+	for _, a := range res.Epochs[0].Assignments {
+		committeeSizes[committeeKey{slot: a.AttesterSlot, index: a.CommitteeIndex}] = len(a.BeaconCommittees)
+	}
+	for _, w := range weights {
+		size := committeeSizes[committeeKey{slot: w.Slot, index: w.CommitteeIndex}]
+		require.NotEqual(t, 0, size)
+		assert.Equal(t, uint64(size)*maxBalance, w.TotalEffectiveBalance)
+		assert.Equal(t, float64(size)/64, w.AttestationWeight)
+	}
+
+	// Annotated requests opt in through the request and receive the weights in the response.
+	annotated, err := bs.ListAnnotatedValidatorAssignments(ctx, &pbrpc.AnnotatedValidatorAssignmentsRequest{
+		QueryFilter:      &pbrpc.AnnotatedValidatorAssignmentsRequest_Epoch{Epoch: 0},
+		Indices:          []types.ValidatorIndex{3, 10, 42},
+		CommitteeWeights: true,
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, weights, annotated.CommitteeWeights)
+
+	annotated, err = bs.ListAnnotatedValidatorAssignments(ctx, &pbrpc.AnnotatedValidatorAssignmentsRequest{
+		QueryFilter: &pbrpc.AnnotatedValidatorAssignmentsRequest_Epoch{Epoch: 0},
+		Indices:     []types.ValidatorIndex{3, 10, 42},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(annotated.CommitteeWeights))
+}
@@ -6,6 +6,7 @@ import (
 
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -57,6 +58,9 @@ func (bs *Server) ListBeaconCommittees(
 		)
 	}
 
+	if req.QueryFilter != nil {
+		bs.reportImmutableResponse(ctx, requestedEpoch)
+	}
 	return &ethpb.BeaconCommittees{
 		Epoch:                requestedEpoch,
 		Committees:           committees.SlotToUint64(),
@@ -72,7 +76,7 @@ func (bs *Server) retrieveCommitteesForEpoch(
 	if err != nil {
 		return nil, nil, err
 	}
-	requestedState, err := bs.StateGen.StateBySlot(ctx, startSlot)
+	requestedState, err := bs.StateGen.CanonicalStateInEpoch(ctx, epoch)
 	if err != nil {
 		return nil, nil, status.Errorf(codes.Internal, "Could not get state: %v", err)
 	}
@@ -80,12 +84,12 @@ func (bs *Server) retrieveCommitteesForEpoch(
 	if err != nil {
 		return nil, nil, status.Error(codes.Internal, "Could not get seed")
 	}
-	activeIndices, err := helpers.ActiveValidatorIndices(requestedState, epoch)
+	activeIndices, err := helpers.ActiveValidatorIndices(bs.CommitteeCache, requestedState, epoch)
 	if err != nil {
 		return nil, nil, status.Error(codes.Internal, "Could not get active indices")
 	}
 
-	committeesListsBySlot, err := computeCommittees(startSlot, activeIndices, seed)
+	committeesListsBySlot, err := computeCommittees(bs.CommitteeCache, startSlot, activeIndices, seed)
 	if err != nil {
 		return nil, nil, status.Errorf(
 			codes.InvalidArgument,
@@ -113,7 +117,7 @@ func (bs *Server) retrieveCommitteesForRoot(
 	if err != nil {
 		return nil, nil, status.Error(codes.Internal, "Could not get seed")
 	}
-	activeIndices, err := helpers.ActiveValidatorIndices(requestedState, epoch)
+	activeIndices, err := helpers.ActiveValidatorIndices(bs.CommitteeCache, requestedState, epoch)
 	if err != nil {
 		return nil, nil, status.Error(codes.Internal, "Could not get active indices")
 	}
@@ -122,7 +126,7 @@ func (bs *Server) retrieveCommitteesForRoot(
 	if err != nil {
 		return nil, nil, err
 	}
-	committeesListsBySlot, err := computeCommittees(startSlot, activeIndices, seed)
+	committeesListsBySlot, err := computeCommittees(bs.CommitteeCache, startSlot, activeIndices, seed)
 	if err != nil {
 		return nil, nil, status.Errorf(
 			codes.InvalidArgument,
@@ -137,6 +141,7 @@ func (bs *Server) retrieveCommitteesForRoot(
 // Compute committees given a start slot, active validator indices, and
 // the attester seeds value.
 func computeCommittees(
+	committeeCache *cache.CommitteeCache,
 	startSlot types.Slot,
 	activeIndices []types.ValidatorIndex,
 	attesterSeed [32]byte,
@@ -152,7 +157,7 @@ func computeCommittees(
 		}
 		committeeItems := make([]*ethpb.BeaconCommittees_CommitteeItem, countAtSlot)
 		for committeeIndex := uint64(0); committeeIndex < countAtSlot; committeeIndex++ {
-			committee, err := helpers.BeaconCommittee(activeIndices, attesterSeed, slot, types.CommitteeIndex(committeeIndex))
+			committee, err := helpers.BeaconCommittee(committeeCache, activeIndices, attesterSeed, slot, types.CommitteeIndex(committeeIndex))
 			if err != nil {
 				return nil, status.Errorf(
 					codes.Internal,
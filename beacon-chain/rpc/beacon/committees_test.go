@@ -46,11 +46,11 @@ func TestServer_ListBeaconCommittees_CurrentEpoch(t *testing.T) {
 	require.NoError(t, db.SaveGenesisBlockRoot(ctx, gRoot))
 	require.NoError(t, db.SaveState(ctx, headState, gRoot))
 
-	activeIndices, err := helpers.ActiveValidatorIndices(headState, 0)
+	activeIndices, err := helpers.ActiveValidatorIndices(nil, headState, 0)
 	require.NoError(t, err)
 	attesterSeed, err := helpers.Seed(headState, 0, params.BeaconConfig().DomainBeaconAttester)
 	require.NoError(t, err)
-	committees, err := computeCommittees(0, activeIndices, attesterSeed)
+	committees, err := computeCommittees(nil, 0, activeIndices, attesterSeed)
 	require.NoError(t, err)
 
 	wanted := &ethpb.BeaconCommittees{
@@ -102,13 +102,13 @@ func TestServer_ListBeaconCommittees_PreviousEpoch(t *testing.T) {
 		StateGen:           stategen.New(db),
 	}
 
-	activeIndices, err := helpers.ActiveValidatorIndices(headState, 1)
+	activeIndices, err := helpers.ActiveValidatorIndices(nil, headState, 1)
 	require.NoError(t, err)
 	attesterSeed, err := helpers.Seed(headState, 1, params.BeaconConfig().DomainBeaconAttester)
 	require.NoError(t, err)
 	startSlot, err := helpers.StartSlot(1)
 	require.NoError(t, err)
-	wanted, err := computeCommittees(startSlot, activeIndices, attesterSeed)
+	wanted, err := computeCommittees(nil, startSlot, activeIndices, attesterSeed)
 	require.NoError(t, err)
 
 	tests := []struct {
@@ -172,10 +172,10 @@ func TestRetrieveCommitteesForRoot(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, headState.SetSlot(params.BeaconConfig().SlotsPerEpoch*10))
 
-	activeIndices, err := helpers.ActiveValidatorIndices(headState, 0)
+	activeIndices, err := helpers.ActiveValidatorIndices(nil, headState, 0)
 	require.NoError(t, err)
 
-	wanted, err := computeCommittees(0, activeIndices, seed)
+	wanted, err := computeCommittees(nil, 0, activeIndices, seed)
 	require.NoError(t, err)
 	committees, activeIndices, err := bs.retrieveCommitteesForRoot(context.Background(), gRoot[:])
 	require.NoError(t, err)
@@ -0,0 +1,23 @@
+package beacon
+
+import (
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+// compactAssignments returns copies of the assignments without their committee members. The
+// assignments themselves are left untouched, as their committees are shared with the cache.
+func compactAssignments(
+	assignments []*ethpb.ValidatorAssignments_CommitteeAssignment,
+) []*ethpb.ValidatorAssignments_CommitteeAssignment {
+	compact := make([]*ethpb.ValidatorAssignments_CommitteeAssignment, len(assignments))
+	for i, a := range assignments {
+		compact[i] = &ethpb.ValidatorAssignments_CommitteeAssignment{
+			CommitteeIndex: a.CommitteeIndex,
+			AttesterSlot:   a.AttesterSlot,
+			ProposerSlots:  a.ProposerSlots,
+			PublicKey:      a.PublicKey,
+			ValidatorIndex: a.ValidatorIndex,
+		}
+	}
+	return compact
+}
@@ -0,0 +1,109 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestCompactAssignments(t *testing.T) {
+	committee := []types.ValidatorIndex{4, 2, 9}
+	assignments := []*ethpb.ValidatorAssignments_CommitteeAssignment{
+		{BeaconCommittees: committee, CommitteeIndex: 1, AttesterSlot: 3, ValidatorIndex: 9, ProposerSlots: []types.Slot{5}},
+		{BeaconCommittees: committee, CommitteeIndex: 1, AttesterSlot: 3, ValidatorIndex: 4},
+		{ValidatorIndex: 7},
+	}
+
+	compact := compactAssignments(assignments)
+	require.Equal(t, 3, len(compact))
+	for i, a := range compact {
+		assert.Equal(t, 0, len(a.BeaconCommittees))
+		assert.Equal(t, assignments[i].ValidatorIndex, a.ValidatorIndex)
+		assert.Equal(t, assignments[i].CommitteeIndex, a.CommitteeIndex)
+		assert.Equal(t, assignments[i].AttesterSlot, a.AttesterSlot)
+	}
+	assert.DeepEqual(t, []types.Slot{5}, compact[0].ProposerSlots)
+
+	// The shared committees of the original assignments are left untouched.
+	assert.DeepEqual(t, committee, assignments[0].BeaconCommittees)
+	assert.DeepEqual(t, committee, assignments[1].BeaconCommittees)
+}
+
+func TestServer_ListAssignments_Compact(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	blockRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	s, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, db.SaveState(ctx, s, blockRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, blockRoot))
+
+	currentSlot := types.Slot(0)
+	bs := &Server{
+		BeaconDB:           db,
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		StateGen:           stategen.New(db),
+	}
+	indices := []types.ValidatorIndex{3, 10}
+
+	full, err := bs.ListValidatorAssignmentsRange(ctx, &pbrpc.ListValidatorAssignmentsRangeRequest{Indices: indices})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(full.Epochs))
+	require.Equal(t, 1, len(full.CommitteePositions))
+	want := full.CommitteePositions[0].Positions
+	require.Equal(t, 2, len(want))
+
+	res, err := bs.ListValidatorAssignmentsRange(ctx, &pbrpc.ListValidatorAssignmentsRangeRequest{
+		Indices: indices,
+		Compact: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Epochs))
+	require.Equal(t, 1, len(res.CommitteePositions))
+	assert.DeepEqual(t, want, res.CommitteePositions[0].Positions)
+	require.Equal(t, 2, len(res.Epochs[0].Assignments))
+	for i, a := range res.Epochs[0].Assignments {
+		assert.Equal(t, 0, len(a.BeaconCommittees))
+		assert.Equal(t, full.Epochs[0].Assignments[i].AttesterSlot, a.AttesterSlot)
+		assert.Equal(t, full.Epochs[0].Assignments[i].CommitteeIndex, a.CommitteeIndex)
+	}
+
+	// Annotated requests opt in through the request, and still receive the positions.
+	annotated, err := bs.ListAnnotatedValidatorAssignments(ctx, &pbrpc.AnnotatedValidatorAssignmentsRequest{
+		QueryFilter: &pbrpc.AnnotatedValidatorAssignmentsRequest_Epoch{Epoch: 0},
+		Indices:     indices,
+		Compact:     true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(annotated.Assignments.Assignments))
+	for _, a := range annotated.Assignments.Assignments {
+		assert.Equal(t, 0, len(a.BeaconCommittees))
+	}
+	assert.DeepEqual(t, want, annotated.CommitteePositions)
+
+	// Compact requests do not share the coalesced or cached full responses.
+	pbRes, err := bs.ListValidatorAssignments(ctx, &ethpb.ListValidatorAssignmentsRequest{
+		QueryFilter: &ethpb.ListValidatorAssignmentsRequest_Epoch{Epoch: 0},
+		Indices:     indices,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(pbRes.Assignments))
+	assert.NotEqual(t, 0, len(pbRes.Assignments[0].BeaconCommittees))
+}
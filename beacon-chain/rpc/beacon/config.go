@@ -6,13 +6,15 @@ import (
 	"reflect"
 
 	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/ptypes/empty"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
 // GetBeaconConfig retrieves the current configuration parameters of the beacon chain.
 func (bs *Server) GetBeaconConfig(_ context.Context, _ *ptypes.Empty) (*ethpb.BeaconConfig, error) {
-	conf := params.BeaconConfig()
+	conf := params.BeaconConfigOf(bs.ChainConfig)
 	val := reflect.ValueOf(conf).Elem()
 	numFields := val.Type().NumField()
 	res := make(map[string]string, numFields)
@@ -23,3 +25,18 @@ func (bs *Server) GetBeaconConfig(_ context.Context, _ *ptypes.Empty) (*ethpb.Be
 		Config: res,
 	}, nil
 }
+
+// GetNetworkConfig retrieves the parameters of the network the beacon node is configured for.
+func (bs *Server) GetNetworkConfig(_ context.Context, _ *empty.Empty) (*pbrpc.NetworkConfig, error) {
+	conf := params.BeaconConfigOf(bs.ChainConfig)
+	return &pbrpc.NetworkConfig{
+		Name:                   conf.ConfigName,
+		SlotsPerEpoch:          conf.SlotsPerEpoch,
+		SecondsPerSlot:         conf.SecondsPerSlot,
+		GenesisForkVersion:     conf.GenesisForkVersion,
+		DepositContractAddress: conf.DepositContractAddress,
+		DepositChainId:         conf.DepositChainID,
+		DepositNetworkId:       conf.DepositNetworkID,
+		Presets:                params.PresetNames(),
+	}, nil
+}
@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/ptypes/empty"
+	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -27,3 +29,30 @@ func TestServer_GetBeaconConfig(t *testing.T) {
 	// Check that an element is properly populated from the config.
 	assert.Equal(t, want, res.Config["Eth1FollowDistance"], "Unexpected follow distance")
 }
+
+func TestServer_GetNetworkConfig(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	require.NoError(t, params.UsePreset("l16"))
+	bs := &Server{}
+	res, err := bs.GetNetworkConfig(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, "l16", res.Name)
+	assert.Equal(t, types.Slot(32), res.SlotsPerEpoch)
+	assert.Equal(t, uint64(12), res.SecondsPerSlot)
+	assert.DeepEqual(t, []byte{0x60, 0x00, 0x00, 0x69}, res.GenesisForkVersion)
+	assert.Equal(t, uint64(2828), res.DepositChainId)
+	assert.DeepEqual(t, params.PresetNames(), res.Presets)
+}
+
+func TestServer_GetNetworkConfig_ScopedChainConfig(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	p, ok := params.PresetByName("l15")
+	require.Equal(t, true, ok)
+	bs := &Server{ChainConfig: p.ChainConfig()}
+	res, err := bs.GetNetworkConfig(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, "l15", res.Name, "Expected the scoped config to be served over the global config")
+	assert.Equal(t, types.Slot(32), res.SlotsPerEpoch)
+	assert.Equal(t, uint64(6), res.SecondsPerSlot)
+}
@@ -0,0 +1,127 @@
+package beacon
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetDuties returns the duties of the requested validators at the requested epoch and at the epoch
+// after it, with the semantics of GetDuties of the validator service, so that validator clients
+// fetch the duties of both epochs at an epoch boundary in a single call. Both epochs are computed
+// from a single state at the requested epoch. Proposers of the next epoch depend on effective
+// balances updated by the epoch transition, so next epoch duties carry no proposer slots. Public
+// keys unknown to the state are reported with an unknown status.
+func (bs *Server) GetDuties(ctx context.Context, req *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconChainServer.GetDuties")
+	defer span.End()
+	span.AddAttributes(trace.Int64Attribute("epoch", int64(req.Epoch)))
+
+	currentEpoch := helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
+	if req.Epoch > currentEpoch+1 {
+		return nil, status.Errorf(codes.Unavailable, "Request epoch %d can not be greater than next epoch %d", req.Epoch, currentEpoch+1)
+	}
+	if len(req.PublicKeys) > bs.MaxPageSize() {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Requested validator count %d can not be greater than max size %d",
+			len(req.PublicKeys),
+			bs.MaxPageSize(),
+		)
+	}
+
+	st, err := bs.dutiesState(ctx, req.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	indices := make([]types.ValidatorIndex, 0, len(req.PublicKeys))
+	for _, pubKey := range req.PublicKeys {
+		if idx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubKey)); ok {
+			indices = append(indices, idx)
+		}
+	}
+	committeeAssignments, proposerIndexToSlots, err := helpers.CommitteeAssignmentsForIndices(ctx, bs.CommitteeCache, st, req.Epoch, indices)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute committee assignments: %v", err)
+	}
+	nextCommitteeAssignments, _, err := helpers.CommitteeAssignmentsForIndices(ctx, bs.CommitteeCache, st, req.Epoch+1, indices)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute next committee assignments: %v", err)
+	}
+
+	res := &ethpb.DutiesResponse{
+		CurrentEpochDuties: make([]*ethpb.DutiesResponse_Duty, 0, len(req.PublicKeys)),
+		NextEpochDuties:    make([]*ethpb.DutiesResponse_Duty, 0, len(req.PublicKeys)),
+	}
+	for _, pubKey := range req.PublicKeys {
+		if ctx.Err() != nil {
+			return nil, status.Errorf(codes.Aborted, "Could not continue fetching assignments: %v", ctx.Err())
+		}
+		duty := &ethpb.DutiesResponse_Duty{PublicKey: pubKey, Status: ethpb.ValidatorStatus_UNKNOWN_STATUS}
+		nextDuty := &ethpb.DutiesResponse_Duty{PublicKey: pubKey, Status: ethpb.ValidatorStatus_UNKNOWN_STATUS}
+		if idx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubKey)); ok {
+			val, err := st.ValidatorAtIndex(idx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not get validator %d: %v", idx, err)
+			}
+			duty.ValidatorIndex, nextDuty.ValidatorIndex = idx, idx
+			duty.Status = validatorStatus(val, req.Epoch)
+			nextDuty.Status = validatorStatus(val, req.Epoch+1)
+			duty.ProposerSlots = proposerIndexToSlots[idx]
+			if ca, ok := committeeAssignments[idx]; ok {
+				duty.Committee = ca.Committee
+				duty.AttesterSlot = ca.AttesterSlot
+				duty.CommitteeIndex = ca.CommitteeIndex
+			}
+			if ca, ok := nextCommitteeAssignments[idx]; ok {
+				nextDuty.Committee = ca.Committee
+				nextDuty.AttesterSlot = ca.AttesterSlot
+				nextDuty.CommitteeIndex = ca.CommitteeIndex
+			}
+		}
+		res.CurrentEpochDuties = append(res.CurrentEpochDuties, duty)
+		res.NextEpochDuties = append(res.NextEpochDuties, nextDuty)
+	}
+	return res, nil
+}
+
+// dutiesState returns a state of the epoch which the caller may modify. Epochs the head did not
+// reach yet are served by the head state advanced to their start slot, and earlier epochs by the
+// state at their start slot.
+func (bs *Server) dutiesState(ctx context.Context, epoch types.Epoch) (iface.BeaconState, error) {
+	headState, err := bs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil {
+		return nil, status.Error(codes.Internal, "Head state of chain was nil")
+	}
+	if helpers.CurrentEpoch(headState) < epoch {
+		startSlot, err := helpers.StartSlot(epoch)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Could not compute start slot of epoch %d: %v", epoch, err)
+		}
+		st, err := state.ProcessSlots(ctx, bs.CommitteeCache, headState.Copy(), startSlot)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not process slots up to %d: %v", startSlot, err)
+		}
+		return st, nil
+	}
+	if helpers.CurrentEpoch(headState) == epoch {
+		// Computing the proposers moves the slot of the state.
+		return headState.Copy(), nil
+	}
+	st, err := bs.epochStartState(ctx, epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve archived state for epoch %d: %v", epoch, err)
+	}
+	return st, nil
+}
@@ -0,0 +1,100 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetDuties_CurrentAndNextEpoch(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	state.SkipSlotCache.Disable()
+	defer state.SkipSlotCache.Enable()
+	ctx := context.Background()
+
+	genesis, _ := testutil.DeterministicGenesisState(t, 64)
+	slot := params.BeaconConfig().SlotsPerEpoch + 3
+	st, err := state.ProcessSlots(ctx, nil, genesis.Copy(), slot)
+	require.NoError(t, err)
+	bs := &Server{
+		HeadFetcher:        &mock.ChainService{State: st},
+		GenesisTimeFetcher: &mock.ChainService{Slot: &slot},
+	}
+
+	unknown := make([]byte, params.BeaconConfig().BLSPubkeyLength)
+	unknown[0] = 0xff
+	pubKeys := [][]byte{st.Validators()[3].PublicKey, st.Validators()[40].PublicKey, unknown}
+	res, err := bs.GetDuties(ctx, &ethpb.DutiesRequest{Epoch: 1, PublicKeys: pubKeys})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(res.CurrentEpochDuties))
+	require.Equal(t, 3, len(res.NextEpochDuties))
+
+	assignments, proposerSlots, err := helpers.CommitteeAssignments(context.Background(), nil, st.Copy(), 1)
+	require.NoError(t, err)
+	nextAssignments, _, err := helpers.CommitteeAssignments(context.Background(), nil, st.Copy(), 2)
+	require.NoError(t, err)
+	for i, index := range []types.ValidatorIndex{3, 40} {
+		duty := res.CurrentEpochDuties[i]
+		assert.Equal(t, index, duty.ValidatorIndex)
+		assert.Equal(t, ethpb.ValidatorStatus_ACTIVE, duty.Status)
+		assert.DeepEqual(t, assignments[index].Committee, duty.Committee)
+		assert.Equal(t, assignments[index].AttesterSlot, duty.AttesterSlot)
+		assert.Equal(t, assignments[index].CommitteeIndex, duty.CommitteeIndex)
+		assert.DeepEqual(t, proposerSlots[index], duty.ProposerSlots)
+
+		next := res.NextEpochDuties[i]
+		assert.Equal(t, index, next.ValidatorIndex)
+		assert.DeepEqual(t, nextAssignments[index].Committee, next.Committee)
+		assert.Equal(t, nextAssignments[index].AttesterSlot, next.AttesterSlot)
+		assert.Equal(t, nextAssignments[index].CommitteeIndex, next.CommitteeIndex)
+		assert.Equal(t, 0, len(next.ProposerSlots))
+	}
+	assert.Equal(t, ethpb.ValidatorStatus_UNKNOWN_STATUS, res.CurrentEpochDuties[2].Status)
+	assert.Equal(t, ethpb.ValidatorStatus_UNKNOWN_STATUS, res.NextEpochDuties[2].Status)
+	assert.DeepEqual(t, unknown, res.NextEpochDuties[2].PublicKey)
+
+	// The head state is not modified.
+	assert.Equal(t, slot, st.Slot())
+}
+
+func TestServer_GetDuties_NextEpochFromHead(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	state.SkipSlotCache.Disable()
+	defer state.SkipSlotCache.Enable()
+	ctx := context.Background()
+
+	genesis, _ := testutil.DeterministicGenesisState(t, 64)
+	slot := params.BeaconConfig().SlotsPerEpoch - 1
+	st, err := state.ProcessSlots(ctx, nil, genesis.Copy(), slot)
+	require.NoError(t, err)
+	bs := &Server{
+		HeadFetcher:        &mock.ChainService{State: st},
+		GenesisTimeFetcher: &mock.ChainService{Slot: &slot},
+	}
+
+	// The head did not reach epoch 1 yet, so the head state is advanced to its start.
+	res, err := bs.GetDuties(ctx, &ethpb.DutiesRequest{Epoch: 1, PublicKeys: [][]byte{st.Validators()[5].PublicKey}})
+	require.NoError(t, err)
+	advanced, err := state.ProcessSlots(ctx, nil, st.Copy(), params.BeaconConfig().SlotsPerEpoch)
+	require.NoError(t, err)
+	assignments, proposerSlots, err := helpers.CommitteeAssignments(context.Background(), nil, advanced, 1)
+	require.NoError(t, err)
+	assert.Equal(t, assignments[5].AttesterSlot, res.CurrentEpochDuties[0].AttesterSlot)
+	assert.DeepEqual(t, proposerSlots[5], res.CurrentEpochDuties[0].ProposerSlots)
+
+	_, err = bs.GetDuties(ctx, &ethpb.DutiesRequest{Epoch: 2})
+	assert.ErrorContains(t, "can not be greater than next epoch 1", err)
+}
@@ -0,0 +1,226 @@
+package beacon
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+// icsTimeFormat is the UTC date-time format of iCalendar.
+const icsTimeFormat = "20060102T150405Z"
+
+// Duty types of the duty calendar.
+const (
+	proposalDuty    = "proposal"
+	attestationDuty = "attestation"
+)
+
+// CalendarDuty is a proposer or attester duty of a tracked validator.
+type CalendarDuty struct {
+	Type           string               `json:"type"`
+	Epoch          types.Epoch          `json:"epoch"`
+	Slot           types.Slot           `json:"slot"`
+	ValidatorIndex types.ValidatorIndex `json:"validator_index"`
+	PublicKey      string               `json:"public_key"`
+	// CommitteeIndex is only set for attestations.
+	CommitteeIndex *types.CommitteeIndex `json:"committee_index,omitempty"`
+	StartTime      time.Time             `json:"start_time"`
+	EndTime        time.Time             `json:"end_time"`
+	// Tentative is set for proposals of epochs the head did not reach yet. Their proposers depend
+	// on effective balances which blocks of the current epoch may still change.
+	Tentative bool `json:"tentative"`
+}
+
+// DutyCalendar serves the upcoming duties of the tracked validators over HTTP, as JSON or as an
+// iCalendar feed which operators can subscribe to when planning maintenance windows.
+type DutyCalendar struct {
+	HeadFetcher        blockchain.HeadFetcher
+	GenesisTimeFetcher blockchain.TimeFetcher
+	SlotTimeFetcher    blockchain.SlotTimeFetcher
+	TrackedValidators  *TrackedValidators
+	CommitteeCache     *cache.CommitteeCache
+	// ChainConfig is the beacon chain config of the calendar. The global config is used if it is
+	// not set.
+	ChainConfig params.ChainConfig
+}
+
+// Handler serves the duties of the current epoch and the epochs after it. The "epochs" query
+// parameter sets the number of epochs, which is bounded by the seed lookahead since later
+// committees are not determined yet. The "format" query parameter selects "json", the default,
+// or "ics".
+func (c *DutyCalendar) Handler(w http.ResponseWriter, r *http.Request) {
+	maxEpochs := uint64(params.BeaconConfigOf(c.ChainConfig).MinSeedLookahead) + 1
+	epochs := maxEpochs
+	if v := r.URL.Query().Get("epochs"); v != "" {
+		n, err := strconv.ParseUint(v, 10, 64)
+		if err != nil || n == 0 || n > maxEpochs {
+			http.Error(w, fmt.Sprintf("epochs must be between 1 and %d", maxEpochs), http.StatusBadRequest)
+			return
+		}
+		epochs = n
+	}
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = "json"
+	}
+	if format != "json" && format != "ics" {
+		http.Error(w, fmt.Sprintf("unknown format %q, wanted json or ics", format), http.StatusBadRequest)
+		return
+	}
+
+	duties, err := c.duties(r, types.Epoch(epochs))
+	if err != nil {
+		log.WithError(err).Error("Could not compute duty calendar")
+		http.Error(w, "Could not compute duties", http.StatusServiceUnavailable)
+		return
+	}
+	var body []byte
+	if format == "ics" {
+		w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		body = []byte(dutiesToICS(duties, timeutils.Now()))
+	} else {
+		w.Header().Set("Content-Type", "application/json")
+		body, err = json.Marshal(struct {
+			Duties []*CalendarDuty `json:"duties"`
+		}{Duties: duties})
+		if err != nil {
+			http.Error(w, "Could not marshal duties", http.StatusInternalServerError)
+			return
+		}
+	}
+	if _, err := w.Write(body); err != nil {
+		log.WithError(err).Error("Could not write duty calendar")
+	}
+}
+
+// duties computes the duties of the tracked validators in the given number of epochs from the
+// current one, in slot order.
+func (c *DutyCalendar) duties(r *http.Request, epochs types.Epoch) ([]*CalendarDuty, error) {
+	ctx := r.Context()
+	headState, err := c.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if headState == nil {
+		return nil, errors.New("no head state")
+	}
+	headEpoch := helpers.SlotToEpoch(headState.Slot())
+	currentEpoch := helpers.SlotToEpoch(c.GenesisTimeFetcher.CurrentSlot())
+	validators := make(map[types.ValidatorIndex][]byte)
+	for _, pubKey := range c.TrackedValidators.PublicKeys() {
+		if idx, ok := headState.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubKey)); ok {
+			validators[idx] = pubKey
+		}
+	}
+
+	st := headState.Copy()
+	var duties []*CalendarDuty
+	for epoch := currentEpoch; epoch < currentEpoch+epochs; epoch++ {
+		// The state is advanced into every epoch, as proposers are only known from within it.
+		startSlot, err := helpers.StartSlot(epoch)
+		if err != nil {
+			return nil, err
+		}
+		if st.Slot() < startSlot {
+			st, err = state.ProcessSlots(ctx, c.CommitteeCache, st, startSlot)
+			if err != nil {
+				return nil, err
+			}
+		}
+		assignments, proposerIndexToSlots, err := helpers.CommitteeAssignments(ctx, c.CommitteeCache, st, epoch)
+		if err != nil {
+			return nil, err
+		}
+		for idx, pubKey := range validators {
+			for _, slot := range proposerIndexToSlots[idx] {
+				duties = append(duties, &CalendarDuty{
+					Type:           proposalDuty,
+					Epoch:          epoch,
+					Slot:           slot,
+					ValidatorIndex: idx,
+					PublicKey:      fmt.Sprintf("%#x", pubKey),
+					StartTime:      c.SlotTimeFetcher.SlotStartTime(slot).UTC(),
+					EndTime:        c.SlotTimeFetcher.SlotStartTime(slot + 1).UTC(),
+					Tentative:      epoch > headEpoch,
+				})
+			}
+			if a, ok := assignments[idx]; ok {
+				committeeIndex := a.CommitteeIndex
+				duties = append(duties, &CalendarDuty{
+					Type:           attestationDuty,
+					Epoch:          epoch,
+					Slot:           a.AttesterSlot,
+					ValidatorIndex: idx,
+					PublicKey:      fmt.Sprintf("%#x", pubKey),
+					CommitteeIndex: &committeeIndex,
+					StartTime:      c.SlotTimeFetcher.SlotStartTime(a.AttesterSlot).UTC(),
+					EndTime:        c.SlotTimeFetcher.SlotStartTime(a.AttesterSlot + 1).UTC(),
+				})
+			}
+		}
+	}
+	sort.Slice(duties, func(i, j int) bool {
+		if duties[i].Slot != duties[j].Slot {
+			return duties[i].Slot < duties[j].Slot
+		}
+		if duties[i].Type != duties[j].Type {
+			return duties[i].Type == proposalDuty
+		}
+		return duties[i].ValidatorIndex < duties[j].ValidatorIndex
+	})
+	return duties, nil
+}
+
+// dutiesToICS renders the duties as an iCalendar with an event spanning the slot of every duty.
+func dutiesToICS(duties []*CalendarDuty, now time.Time) string {
+	var b strings.Builder
+	line := func(s string) {
+		// Content lines are folded at 75 octets, continuation lines start with a space.
+		limit := 75
+		for len(s) > limit {
+			b.WriteString(s[:limit])
+			b.WriteString("\r\n ")
+			s = s[limit:]
+			limit = 74
+		}
+		b.WriteString(s)
+		b.WriteString("\r\n")
+	}
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//Prysmatic Labs//Prysm duty calendar//EN")
+	line("CALSCALE:GREGORIAN")
+	for _, d := range duties {
+		summary := fmt.Sprintf("Validator %d attests at slot %d", d.ValidatorIndex, d.Slot)
+		if d.Type == proposalDuty {
+			summary = fmt.Sprintf("Validator %d proposes at slot %d", d.ValidatorIndex, d.Slot)
+			if d.Tentative {
+				summary = "Tentative: " + summary
+			}
+		}
+		line("BEGIN:VEVENT")
+		line(fmt.Sprintf("UID:%s-%d-%d@prysm", d.Type, d.Slot, d.ValidatorIndex))
+		line("DTSTAMP:" + now.UTC().Format(icsTimeFormat))
+		line("DTSTART:" + d.StartTime.UTC().Format(icsTimeFormat))
+		line("DTEND:" + d.EndTime.UTC().Format(icsTimeFormat))
+		line("SUMMARY:" + summary)
+		line(fmt.Sprintf("DESCRIPTION:Epoch %d\\, public key %s", d.Epoch, d.PublicKey))
+		line("END:VEVENT")
+	}
+	line("END:VCALENDAR")
+	return b.String()
+}
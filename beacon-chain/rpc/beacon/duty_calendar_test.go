@@ -0,0 +1,111 @@
+package beacon
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func setupDutyCalendar(t *testing.T, numValidators uint64) *DutyCalendar {
+	helpers.ClearCache()
+	st, _ := testutil.DeterministicGenesisState(t, numValidators)
+	tracked, err := NewTrackedValidators(filepath.Join(t.TempDir(), "tracked.txt"))
+	require.NoError(t, err)
+	pubKeys := make([][]byte, numValidators)
+	for i := types.ValidatorIndex(0); uint64(i) < numValidators; i++ {
+		pubKey := st.PubkeyAtIndex(i)
+		pubKeys[i] = pubKey[:]
+	}
+	require.NoError(t, tracked.Update(pubKeys, nil))
+	slot := types.Slot(0)
+	chain := &mock.ChainService{State: st, Genesis: time.Unix(1600000000, 0), Slot: &slot}
+	return &DutyCalendar{
+		HeadFetcher:        chain,
+		GenesisTimeFetcher: chain,
+		SlotTimeFetcher:    chain,
+		TrackedValidators:  tracked,
+	}
+}
+
+func TestDutyCalendar_JSON(t *testing.T) {
+	numValidators := uint64(64)
+	c := setupDutyCalendar(t, numValidators)
+
+	rec := httptest.NewRecorder()
+	c.Handler(rec, httptest.NewRequest(http.MethodGet, "/duties/calendar", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	var res struct {
+		Duties []*CalendarDuty `json:"duties"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	attestations := make(map[types.Epoch]int)
+	proposals := make(map[types.Slot]*CalendarDuty)
+	for i, d := range res.Duties {
+		if i > 0 {
+			assert.Equal(t, true, res.Duties[i-1].Slot <= d.Slot, "Duties are not in slot order")
+		}
+		assert.Equal(t, d.Epoch, helpers.SlotToEpoch(d.Slot))
+		assert.Equal(t, d.StartTime.Add(time.Duration(params.BeaconConfig().SecondsPerSlot)*time.Second), d.EndTime)
+		switch d.Type {
+		case attestationDuty:
+			require.NotNil(t, d.CommitteeIndex)
+			attestations[d.Epoch]++
+		case proposalDuty:
+			assert.Equal(t, d.Epoch > 0, d.Tentative)
+			proposals[d.Slot] = d
+		}
+	}
+	// Every validator attests once per epoch and every slot but the genesis slot has a proposer.
+	assert.Equal(t, int(numValidators), attestations[0])
+	assert.Equal(t, int(numValidators), attestations[1])
+	assert.Equal(t, 2*int(slotsPerEpoch)-1, len(proposals))
+	assert.Equal(t, (*CalendarDuty)(nil), proposals[0])
+	assert.Equal(t, time.Unix(1600000000, 0).UTC(), proposals[1].StartTime.Add(-time.Duration(params.BeaconConfig().SecondsPerSlot)*time.Second))
+
+	rec = httptest.NewRecorder()
+	c.Handler(rec, httptest.NewRequest(http.MethodGet, "/duties/calendar?epochs=1", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	assert.Equal(t, int(numValidators)+int(slotsPerEpoch)-1, len(res.Duties))
+}
+
+func TestDutyCalendar_ICS(t *testing.T) {
+	c := setupDutyCalendar(t, 64)
+
+	rec := httptest.NewRecorder()
+	c.Handler(rec, httptest.NewRequest(http.MethodGet, "/duties/calendar?format=ics&epochs=1", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/calendar; charset=utf-8", rec.Header().Get("Content-Type"))
+	body := rec.Body.String()
+	assert.Equal(t, true, strings.HasPrefix(body, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.Equal(t, true, strings.HasSuffix(body, "END:VCALENDAR\r\n"))
+	assert.Equal(t, 64+int(params.BeaconConfig().SlotsPerEpoch)-1, strings.Count(body, "BEGIN:VEVENT"))
+	assert.Equal(t, true, strings.Contains(body, "DTSTART:20200913T122640Z\r\n"))
+	for _, line := range strings.Split(strings.TrimSuffix(body, "\r\n"), "\r\n") {
+		assert.Equal(t, true, len(line) <= 75, "Line %q is not folded", line)
+	}
+}
+
+func TestDutyCalendar_InvalidRequests(t *testing.T) {
+	c := setupDutyCalendar(t, 8)
+	for _, query := range []string{"?epochs=0", "?epochs=3", "?epochs=x", "?format=xml"} {
+		rec := httptest.NewRecorder()
+		c.Handler(rec, httptest.NewRequest(http.MethodGet, "/duties/calendar"+query, nil))
+		assert.Equal(t, http.StatusBadRequest, rec.Code, "Query %s", query)
+	}
+}
@@ -0,0 +1,598 @@
+package beacon
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	epochInfoComputations = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "epoch_info_computations_total",
+			Help: "The number of times an epoch info payload was computed and marshaled.",
+		},
+	)
+	epochInfoCacheHits = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "epoch_info_cache_hits_total",
+			Help: "The number of times an epoch info payload was served from the hub cache.",
+		},
+	)
+	epochInfoResumes = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "epoch_info_stream_resumes_total",
+			Help: "The number of epoch info streams resumed from a previously received epoch.",
+		},
+	)
+	epochInfoAcks = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "epoch_info_stream_acks_total",
+			Help: "The number of epochs acknowledged by named epoch info stream subscribers.",
+		},
+	)
+	epochInfoReorgs = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "epoch_info_reorgs_total",
+			Help: "The number of epoch infos which changed after a reorg and were sent again.",
+		},
+	)
+	epochInfoLateBlockRecomputations = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "epoch_info_late_block_recomputations_total",
+			Help: "The number of epoch infos which changed after a late block at the end of the previous epoch and were sent again.",
+		},
+	)
+	epochInfoGaps = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "epoch_info_gaps_total",
+			Help: "The number of epochs skipped by epoch info ranges allowing gaps, as their epoch info could not be computed.",
+		},
+	)
+	epochInfoSubscribers = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "epoch_info_subscribers",
+			Help: "The number of active epoch info stream subscribers.",
+		},
+	)
+)
+
+// EpochInfoStream is the server side of an epoch info stream. Payloads are the binary encoding
+// of orchestrator.EpochInfo cached by the epoch info hub, which is shared by all subscribers.
+type EpochInfoStream interface {
+	Context() context.Context
+	SendEncoded(payload []byte) error
+}
+
+// EpochInfoAckStream is an epoch info stream whose client acknowledges the epochs it processed.
+type EpochInfoAckStream interface {
+	EpochInfoStream
+	// RecvAck blocks until the client acknowledges an epoch, and returns an error once the client
+	// stops sending.
+	RecvAck() (types.Epoch, error)
+}
+
+// StreamEpochInfo serves the epoch info stream of a client whose first message carries the
+// request. The messages after it acknowledge the epochs processed by a named subscriber.
+func (bs *Server) StreamEpochInfo(stream pbrpc.BeaconQuery_StreamEpochInfoServer) error {
+	msg, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	req := msg.GetRequest()
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "Epoch info stream must start with a request")
+	}
+	if len(req.Subscriber) > orchestrator.MaxSubscriberNameLength {
+		return status.Errorf(codes.InvalidArgument, "Subscriber name can not be longer than %d bytes", orchestrator.MaxSubscriberNameLength)
+	}
+	return bs.streamEpochInfo(req, &grpcEpochInfoStream{stream: stream})
+}
+
+// grpcEpochInfoStream sends the epoch infos of a gRPC stream decoded from their cached payload, and
+// receives the acknowledgements of named subscribers.
+type grpcEpochInfoStream struct {
+	stream pbrpc.BeaconQuery_StreamEpochInfoServer
+}
+
+// Context returns the context of the stream.
+func (s *grpcEpochInfoStream) Context() context.Context {
+	return s.stream.Context()
+}
+
+// SendEncoded sends an encoded epoch info.
+func (s *grpcEpochInfoStream) SendEncoded(payload []byte) error {
+	info := &orchestrator.EpochInfo{}
+	if err := info.UnmarshalBinary(payload); err != nil {
+		return err
+	}
+	msg, err := info.ToProto()
+	if err != nil {
+		return err
+	}
+	return s.stream.Send(msg)
+}
+
+// RecvAck receives the next acknowledged epoch.
+func (s *grpcEpochInfoStream) RecvAck() (types.Epoch, error) {
+	msg, err := s.stream.Recv()
+	if err != nil {
+		return 0, err
+	}
+	ack, ok := msg.Message.(*pbrpc.EpochInfoStreamMessage_AckEpoch)
+	if !ok {
+		return 0, status.Error(codes.InvalidArgument, "Expected an epoch acknowledgement")
+	}
+	return ack.AckEpoch, nil
+}
+
+// streamEpochInfo sends the proposer list, timing and fork of every epoch from the requested epoch
+// onwards, followed by the info of each new epoch as the head advances into it. The epoch info
+// is computed and encoded once per epoch and multicast to every subscriber. When a reorg changes
+// the info of an epoch which was already sent, the new info is sent with its reorg flag set.
+// Epoch infos of epochs which are not finalized are sent with their provisional flag set, and sent
+// again without it once the epoch finalizes. With a duty broadcast lookahead, the infos of the
+// epochs following the head epoch are sent ahead of time, and sent again with their reorg flag set
+// if they changed by the time the head advances into their epoch. A stream restricted to an epoch
+// shard only computes and sends the epoch infos of the epochs of the shard.
+func (bs *Server) streamEpochInfo(req *pbrpc.StreamEpochInfoRequest, stream EpochInfoStream) error {
+	var requestedShard *orchestrator.EpochShard
+	if req.Shard != nil {
+		requestedShard = &orchestrator.EpochShard{Modulus: req.Shard.Modulus, Remainder: req.Shard.Remainder}
+	}
+	shard, err := orchestrator.RequestedEpochShard(stream.Context(), requestedShard)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid epoch shard: %v", err)
+	}
+	fromEpoch := req.FromEpoch
+	var resumeFrom *types.Epoch
+	if r, ok := req.Resume.(*pbrpc.StreamEpochInfoRequest_ResumeFromEpoch); ok {
+		resumeFrom = &r.ResumeFromEpoch
+	}
+	if req.Subscriber != "" && bs.StreamCursorStore != nil {
+		if resumeFrom == nil {
+			cursor, ok, err := bs.StreamCursorStore.StreamCursor(stream.Context(), req.Subscriber)
+			if err != nil {
+				return status.Errorf(codes.Internal, "Could not get stream cursor of subscriber %s: %v", req.Subscriber, err)
+			}
+			if ok {
+				resumeFrom = &cursor
+			}
+		}
+		if ackStream, ok := stream.(EpochInfoAckStream); ok {
+			go bs.receiveEpochInfoAcks(req.Subscriber, ackStream)
+		}
+	}
+	sent := false
+	var lastSent types.Epoch
+	if resumeFrom != nil {
+		if err := bs.checkEpochAdmission(*resumeFrom); err != nil {
+			return err
+		}
+		// The epochs up to the resume token were delivered before the client reconnected.
+		fromEpoch = *resumeFrom + 1
+		sent, lastSent = true, *resumeFrom
+		epochInfoResumes.Inc()
+	} else if err := bs.checkEpochAdmission(fromEpoch); err != nil {
+		return err
+	}
+	hub := bs.epochInfoHubInstance()
+	requester := epochInfoRequester(stream.Context())
+	start := time.Now()
+	orchestrator.Debug(orchestrator.Log.WithFields(logrus.Fields{
+		"requester":  requester,
+		"subscriber": req.Subscriber,
+		"epoch":      fromEpoch,
+		"shard":      shard,
+	}), "Epoch info stream opened")
+	defer func() {
+		orchestrator.Debug(orchestrator.Log.WithFields(logrus.Fields{
+			"requester": requester,
+			"epoch":     lastSent,
+			"duration":  time.Since(start),
+		}), "Epoch info stream closed")
+	}()
+	// Subscribe before catching up so that no epoch transition is missed in between.
+	sub, unsubscribe := hub.subscribe()
+	defer unsubscribe()
+
+	send := func(info *encodedEpochInfo) error {
+		if !shard.Includes(info.epoch) {
+			return nil
+		}
+		// Epoch infos superseded by a reorg or settled by finality are resent even if their epoch
+		// was already sent.
+		if sent && info.epoch <= lastSent && !info.reorg && !info.settled {
+			return nil
+		}
+		if err := stream.SendEncoded(info.payload); err != nil {
+			return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+		}
+		if !sent || info.epoch > lastSent {
+			sent, lastSent = true, info.epoch
+		}
+		return nil
+	}
+
+	// The epochs the client is behind on are computed concurrently and sent in order. The stream
+	// ends at the first epoch whose epoch info could not be computed, with a gap error telling the
+	// client which epochs it received, unless it allows gaps.
+	currentEpoch := helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
+	err = bs.forEachShardEpochInfo(stream.Context(), fromEpoch, currentEpoch, shard, func(epoch types.Epoch, info *encodedEpochInfo, err error) error {
+		if _, ok := grpcutils.EpochOutOfRangeFromError(err); ok {
+			return err
+		}
+		if err != nil && req.AllowGaps && recoverableEpochInfoError(err) {
+			orchestrator.Log.WithError(err).WithField("epoch", epoch).Warn("Skipping epoch info gap")
+			epochInfoGaps.Inc()
+			return nil
+		}
+		if err != nil {
+			return grpcutils.EpochGapError(
+				codes.Internal,
+				&grpcutils.EpochGap{Epoch: epoch, Reason: err.Error()},
+				"Could not compute epoch info for epoch %d: %v",
+				epoch,
+				err,
+			)
+		}
+		return send(info)
+	})
+	if err != nil {
+		return err
+	}
+	if lookahead := bs.dutyBroadcastLookahead(); lookahead > 0 && bs.HeadFetcher != nil {
+		headEpoch := helpers.SlotToEpoch(bs.HeadFetcher.HeadSlot())
+		for epoch := currentEpoch + 1; epoch <= headEpoch+lookahead; epoch++ {
+			if epoch < fromEpoch || !shard.Includes(epoch) {
+				continue
+			}
+			info, err := hub.epochInfoAhead(stream.Context(), epoch)
+			if err != nil {
+				// The hub multicasts it once the head advances far enough.
+				break
+			}
+			if err := send(info); err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		select {
+		case info, ok := <-sub:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "Subscriber fell behind, resubscribe from the last received epoch")
+			}
+			if err := send(info); err != nil {
+				return err
+			}
+		case <-bs.Ctx.Done():
+			return grpcutils.ShuttingDownError()
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
+
+// receiveEpochInfoAcks persists the epochs a named subscriber acknowledges until its stream stops
+// receiving. Acknowledgements of epochs which cannot have been sent yet are ignored, as the stream
+// could not resume after them.
+func (bs *Server) receiveEpochInfoAcks(subscriber string, stream EpochInfoAckStream) {
+	for {
+		epoch, err := stream.RecvAck()
+		if err != nil {
+			return
+		}
+		currentEpoch := helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
+		if epoch > currentEpoch+bs.dutyBroadcastLookahead() {
+			orchestrator.Debug(orchestrator.Log.WithFields(logrus.Fields{
+				"subscriber": subscriber,
+				"epoch":      epoch,
+			}), "Ignoring acknowledgement of an epoch in the future")
+			continue
+		}
+		if err := bs.StreamCursorStore.SaveStreamCursor(stream.Context(), subscriber, epoch); err != nil {
+			orchestrator.Log.WithError(err).WithField("subscriber", subscriber).Error("Could not save stream cursor")
+			continue
+		}
+		epochInfoAcks.Inc()
+	}
+}
+
+// epochInfoRequester returns the address of the client of an epoch info stream.
+func epochInfoRequester(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return "unknown"
+}
+
+// epochInfoHubInstance returns the epoch info hub, starting it on first use.
+func (bs *Server) epochInfoHubInstance() *epochInfoHub {
+	bs.epochInfoHub.once.Do(func() {
+		bs.epochInfoHub.hub = newEpochInfoHub(bs.loadEpochInfo)
+		bs.epochInfoHub.hub.finalizedEpoch = bs.finalizedEpoch
+		bs.epochInfoHub.hub.dependentRoot = bs.epochInfoDependentRoot
+		bs.epochInfoHub.hub.maxEntries = bs.EpochInfoCacheSize
+		bs.epochInfoHub.hub.lookahead = bs.dutyBroadcastLookahead()
+		if bs.DutyBroadcastLookahead > bs.epochInfoHub.hub.lookahead {
+			orchestrator.Log.WithField("lookahead", bs.epochInfoHub.hub.lookahead).Warn(
+				"Capping the duty broadcast lookahead at the seed lookahead, beyond which proposers are not determined",
+			)
+		}
+		go bs.epochInfoHub.hub.run(bs.Ctx, bs.StateNotifier)
+	})
+	return bs.epochInfoHub.hub
+}
+
+// dutyBroadcastLookahead returns the number of epochs after the head epoch whose epoch infos are
+// sent ahead of time. Proposers are only determined by seeds already revealed up to the seed
+// lookahead, so the configured lookahead is capped at it.
+func (bs *Server) dutyBroadcastLookahead() types.Epoch {
+	if maxLookahead := params.BeaconConfigOf(bs.ChainConfig).MinSeedLookahead; bs.DutyBroadcastLookahead > maxLookahead {
+		return maxLookahead
+	}
+	return bs.DutyBroadcastLookahead
+}
+
+// loadEpochInfo returns the persisted epoch info of an epoch, or computes it. Epoch infos of
+// finalized epochs can no longer change and are persisted, so that streams resuming after the
+// hub cache evicted them replay the exact payload without regenerating states. Epoch infos of
+// epochs which are not finalized are marked provisional.
+func (bs *Server) loadEpochInfo(ctx context.Context, epoch types.Epoch) (*orchestrator.EpochInfo, error) {
+	if bs.EpochInfoStore != nil {
+		info, err := bs.EpochInfoStore.EpochInfo(ctx, epoch)
+		if err != nil {
+			return nil, err
+		}
+		if info != nil {
+			return info, nil
+		}
+	}
+	info, err := bs.computeEpochInfo(ctx, epoch)
+	if err != nil {
+		return nil, err
+	}
+	if bs.FinalizationFetcher == nil {
+		return info, nil
+	}
+	if finalized := bs.FinalizationFetcher.FinalizedCheckpt(); finalized == nil || epoch > finalized.Epoch {
+		info.Provisional = true
+		return info, nil
+	}
+	if bs.EpochInfoStore != nil {
+		if err := bs.EpochInfoStore.SaveEpochInfo(ctx, info); err != nil {
+			orchestrator.Log.WithError(err).WithField("epoch", epoch).Error("Could not persist epoch info")
+		}
+	}
+	return info, nil
+}
+
+// finalizedEpoch returns the epoch of the finalized checkpoint.
+func (bs *Server) finalizedEpoch() (types.Epoch, bool) {
+	if bs.FinalizationFetcher == nil {
+		return 0, false
+	}
+	finalized := bs.FinalizationFetcher.FinalizedCheckpt()
+	if finalized == nil {
+		return 0, false
+	}
+	return finalized.Epoch, true
+}
+
+// epochInfoDependentRoot returns the root of the block the proposers of an epoch depend on, as
+// seen from the head, and false if the head does not know it.
+func (bs *Server) epochInfoDependentRoot(ctx context.Context, epoch types.Epoch) ([32]byte, bool, error) {
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return [32]byte{}, false, err
+	}
+	key, ok, err := bs.epochStateKey(ctx, epoch, startSlot)
+	if err != nil || !ok {
+		return [32]byte{}, false, err
+	}
+	return key.dependentRoot, true, nil
+}
+
+// FlushEpochInfos persists the cached epoch infos of finalized epochs which were computed before
+// their epoch finalized, so that streams resuming after a restart replay them from the database.
+func (bs *Server) FlushEpochInfos(ctx context.Context) error {
+	if bs.EpochInfoStore == nil || bs.FinalizationFetcher == nil {
+		return nil
+	}
+	finalized := bs.FinalizationFetcher.FinalizedCheckpt()
+	if finalized == nil {
+		return nil
+	}
+	// The no-op marks the hub as started, so that no stream starts it while it is flushed, and
+	// makes a hub started by an earlier stream visible.
+	bs.epochInfoHub.once.Do(func() {})
+	hub := bs.epochInfoHub.hub
+	if hub == nil {
+		return nil
+	}
+	for _, cached := range hub.completed(finalized.Epoch) {
+		stored, err := bs.EpochInfoStore.EpochInfo(ctx, cached.epoch)
+		if err != nil {
+			return err
+		}
+		if stored != nil {
+			continue
+		}
+		info := &orchestrator.EpochInfo{}
+		if err := info.UnmarshalBinary(cached.payload); err != nil {
+			return err
+		}
+		info.ReorgFlag = false
+		info.Provisional = false
+		if err := bs.EpochInfoStore.SaveEpochInfo(ctx, info); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetEpochInfoAccumulator returns the root of the hash chain of the persisted epoch infos up to
+// the requested epoch, so that orchestrators replaying a range of epoch infos after reconnecting
+// can detect altered or missing epoch infos. Only finalized epoch infos are persisted, so the
+// accumulator trails the finalized epoch.
+func (bs *Server) GetEpochInfoAccumulator(ctx context.Context, req *pbrpc.EpochInfoAccumulatorRequest) (*pbrpc.EpochInfoAccumulator, error) {
+	if bs.EpochInfoStore == nil {
+		return nil, status.Error(codes.Unimplemented, "Epoch infos are not persisted")
+	}
+	var acc *orchestrator.EpochInfoAccumulator
+	var err error
+	if q, ok := req.QueryFilter.(*pbrpc.EpochInfoAccumulatorRequest_Epoch); ok {
+		acc, err = bs.EpochInfoStore.EpochInfoAccumulator(ctx, q.Epoch)
+	} else {
+		acc, err = bs.EpochInfoStore.EpochInfoAccumulatorHead(ctx)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get epoch info accumulator: %v", err)
+	}
+	if acc == nil {
+		return nil, status.Error(codes.NotFound, "Epoch is not accumulated")
+	}
+	return acc.ToProto(), nil
+}
+
+// checkEpochLookback returns an out of range error if the epoch is more than MaxEpochInfoLookback
+// epochs before the current epoch, as regenerating states that far back replays an unbounded
+// number of blocks.
+func (bs *Server) checkEpochLookback(epoch types.Epoch) error {
+	if bs.MaxEpochInfoLookback == 0 {
+		return nil
+	}
+	currentEpoch := helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
+	if epoch >= currentEpoch || currentEpoch-epoch <= bs.MaxEpochInfoLookback {
+		return nil
+	}
+	oldest := currentEpoch - bs.MaxEpochInfoLookback
+	return grpcutils.EpochOutOfRangeError(
+		codes.OutOfRange,
+		&grpcutils.EpochOutOfRange{
+			Current:         currentEpoch,
+			Requested:       epoch,
+			OldestAvailable: oldest,
+		},
+		"Epoch %d is older than the oldest available epoch %d",
+		epoch,
+		oldest,
+	)
+}
+
+// epochInfoState returns a state the epoch info of an epoch can be derived from. The head state
+// serves the epoch of the head, as the proposers and the fork of an epoch are fixed by the state at
+// its start, and earlier epochs are served by the state at their start slot. A state outside of
+// the epoch is rejected rather than deriving the proposers of another epoch from it.
+func (bs *Server) epochInfoState(ctx context.Context, epoch types.Epoch, startSlot types.Slot) (iface.BeaconState, error) {
+	if bs.HeadFetcher != nil {
+		headState, err := bs.HeadFetcher.HeadState(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if headState != nil {
+			headEpoch := helpers.SlotToEpoch(headState.Slot())
+			if headEpoch == epoch {
+				return headState, nil
+			}
+			if epoch > headEpoch && epoch <= headEpoch+bs.dutyBroadcastLookahead() {
+				// The seeds of the lookahead epochs are already revealed, but blocks until the
+				// epoch starts may still change effective balances and so the proposers.
+				st, err := state.ProcessSlots(ctx, bs.CommitteeCache, headState.Copy(), startSlot)
+				if err != nil {
+					return nil, errors.Wrapf(err, "could not process slots up to %d", startSlot)
+				}
+				return st, nil
+			}
+		}
+	}
+	if err := bs.checkEpochLookback(epoch); err != nil {
+		return nil, err
+	}
+	st, err := bs.StateGen.CanonicalStateInEpoch(ctx, epoch)
+	if err != nil {
+		return nil, err
+	}
+	if st == nil {
+		return nil, errors.Errorf("no state available at slot %d", startSlot)
+	}
+	if stateEpoch := helpers.SlotToEpoch(st.Slot()); stateEpoch != epoch {
+		return nil, errors.Errorf("state at slot %d is in epoch %d, wanted epoch %d", st.Slot(), stateEpoch, epoch)
+	}
+	return st, nil
+}
+
+// computeEpochInfo derives the proposer of every slot of an epoch from the state at its start slot.
+func (bs *Server) computeEpochInfo(ctx context.Context, epoch types.Epoch) (*orchestrator.EpochInfo, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconChainServer.computeEpochInfo")
+	defer span.End()
+	span.AddAttributes(trace.Int64Attribute("epoch", int64(epoch)))
+
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, err
+	}
+	st, err := bs.epochInfoState(ctx, epoch, startSlot)
+	if err != nil {
+		return nil, err
+	}
+	// Computing proposers moves the state slot, so the possibly cached state is copied first.
+	st = st.Copy()
+
+	secondsPerSlot := params.BeaconConfigOf(bs.ChainConfig).SecondsPerSlot
+	// The epoch start time follows the slot timer of the chain, which tests may simulate or drive.
+	epochStartTime := uint64(bs.GenesisTimeFetcher.GenesisTime().Unix()) + uint64(startSlot)*secondsPerSlot
+	if bs.SlotTimeFetcher != nil {
+		epochStartTime = uint64(bs.SlotTimeFetcher.SlotStartTime(startSlot).Unix())
+	}
+	info := &orchestrator.EpochInfo{
+		Epoch:          epoch,
+		EpochStartTime: epochStartTime,
+		SlotDuration:   secondsPerSlot,
+	}
+	// The fork of the start state decides the version in effect at the epoch, as a fork scheduled
+	// for a later epoch is already recorded in the state before it activates.
+	fork := st.Fork()
+	if fork == nil {
+		return nil, errors.New("state has no fork")
+	}
+	version := fork.CurrentVersion
+	if epoch < fork.Epoch {
+		version = fork.PreviousVersion
+	}
+	copy(info.ForkVersion[:], version)
+	info.ForkDigest, err = helpers.ComputeForkDigest(version, st.GenesisValidatorRoot())
+	if err != nil {
+		return nil, err
+	}
+	// The proposer shuffling is traced separately from the state regeneration above.
+	_, proposerSpan := trace.StartSpan(ctx, "BeaconChainServer.computeEpochProposers")
+	defer proposerSpan.End()
+	info.Proposers, err = orchestrator.EpochProposers(bs.CommitteeCache, st, epoch)
+	if err != nil {
+		return nil, err
+	}
+	info.ProposerListRoot, err = orchestrator.ProposerListRoot(info.Proposers)
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
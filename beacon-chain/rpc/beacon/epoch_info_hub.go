@@ -0,0 +1,578 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// maxCachedEpochInfos is the number of encoded epoch infos kept by the hub.
+	maxCachedEpochInfos = 64
+	// epochInfoSubscriberBuffer is the number of payloads buffered per subscriber before it is
+	// considered too slow and dropped.
+	epochInfoSubscriberBuffer = 8
+	// epochInfoHubEventBuffer is the number of state events buffered for the hub before the oldest
+	// are dropped.
+	epochInfoHubEventBuffer = 64
+)
+
+// encodedEpochInfo is an epoch info payload marshaled once and shared by all subscribers.
+type encodedEpochInfo struct {
+	epoch   types.Epoch
+	payload []byte
+	// reorg is true if the payload supersedes an earlier payload of the same epoch.
+	reorg bool
+	// provisional is true if the epoch was not finalized when the payload was computed.
+	provisional bool
+	// settled is true if the payload supersedes a provisional payload of an epoch which finalized.
+	settled bool
+	// dependentRoot is the root of the block the proposers of the epoch depended on when the
+	// payload was computed, if hasDependentRoot is set.
+	dependentRoot    [32]byte
+	hasDependentRoot bool
+}
+
+// epochInfoEntry is a cached epoch info. Concurrent requests for an epoch whose payload is
+// still being computed wait on done instead of computing it again.
+type epochInfoEntry struct {
+	done chan struct{}
+	info *encodedEpochInfo
+	err  error
+	// canceled is true if the computation failed because the context of the request computing it
+	// was done, which says nothing about the requests waiting on it.
+	canceled bool
+}
+
+// epochInfoHub computes the epoch info of every epoch once, caches the encoded payload and
+// multicasts it to all epoch info stream subscribers.
+type epochInfoHub struct {
+	compute func(ctx context.Context, epoch types.Epoch) (*orchestrator.EpochInfo, error)
+	// finalizedEpoch returns the finalized epoch, if known. Provisional epoch infos are only
+	// settled if it is set.
+	finalizedEpoch func() (types.Epoch, bool)
+	// dependentRoot returns the root of the block the proposers of an epoch depend on, that is the
+	// block at the last slot before the epoch, and false if it is not known. Cached epoch infos
+	// are only checked against late blocks if it is set.
+	dependentRoot func(ctx context.Context, epoch types.Epoch) ([32]byte, bool, error)
+	// lookahead is the number of epochs after the head epoch whose epoch infos are multicast as
+	// the head advances, ahead of their epoch.
+	lookahead types.Epoch
+	// maxEntries returns the number of encoded epoch infos kept by the hub, maxCachedEpochInfos if
+	// it is not set.
+	maxEntries func() int
+
+	lock        sync.Mutex
+	settledUpTo types.Epoch
+	// ahead holds the epochs whose epoch info was computed before the head advanced into them.
+	ahead       map[types.Epoch]bool
+	entries     map[types.Epoch]*epochInfoEntry
+	order       []types.Epoch
+	subscribers map[uint64]chan *encodedEpochInfo
+	nextID      uint64
+}
+
+// lazyEpochInfoHub holds an epoch info hub which is only started once a stream needs it.
+type lazyEpochInfoHub struct {
+	once sync.Once
+	hub  *epochInfoHub
+}
+
+func newEpochInfoHub(compute func(ctx context.Context, epoch types.Epoch) (*orchestrator.EpochInfo, error)) *epochInfoHub {
+	return &epochInfoHub{
+		compute:     compute,
+		ahead:       make(map[types.Epoch]bool),
+		entries:     make(map[types.Epoch]*epochInfoEntry),
+		subscribers: make(map[uint64]chan *encodedEpochInfo),
+	}
+}
+
+// run multicasts the epoch info of every epoch the head advances into along with its lookahead
+// epochs, the epoch infos changed by reorgs or by late blocks and the epoch infos settled by
+// finality, until the context is done.
+func (h *epochInfoHub) run(ctx context.Context, notifier statefeed.Notifier) {
+	// A hub computing epoch infos must not stall block processing, so it misses the oldest events
+	// once it falls behind by a full buffer.
+	stateChannel := make(chan *feed.Event, epochInfoHubEventBuffer)
+	stateSub := notifier.StateFeed().SubscribeWithPolicy(stateChannel, "epoch_info_hub", event.DropOldest)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case ev := <-stateChannel:
+			switch ev.Type {
+			case statefeed.Finalized:
+				h.settle(ctx)
+			case statefeed.BlockProcessed:
+				data, ok := ev.Data.(*statefeed.BlockProcessedData)
+				if !ok || data == nil || !helpers.IsEpochEnd(data.Slot) {
+					continue
+				}
+				h.lateBlock(ctx, helpers.SlotToEpoch(data.Slot)+1)
+			case statefeed.EpochTransition:
+				data, ok := ev.Data.(*statefeed.EpochTransitionData)
+				if !ok || data == nil {
+					continue
+				}
+				h.advance(ctx, data.Epoch)
+			case statefeed.Reorg:
+				data, ok := ev.Data.(*statefeed.ReorgData)
+				if !ok || data == nil {
+					continue
+				}
+				// The recorded event knows the first epoch the reorg changed blocks in, which may
+				// be before the epochs of both heads.
+				if data.Event != nil {
+					h.reorg(ctx, data.Event.FromEpoch)
+					continue
+				}
+				fromSlot := data.NewSlot
+				if data.OldSlot < fromSlot {
+					fromSlot = data.OldSlot
+				}
+				h.reorg(ctx, helpers.SlotToEpoch(fromSlot))
+			}
+		case <-stateSub.Err():
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// epochInfo returns the encoded epoch info of an epoch, computing it only if it is not cached.
+// Requests waiting on a computation which failed because its requester went away compute the
+// epoch info again instead of failing with the context error of that requester.
+func (h *epochInfoHub) epochInfo(ctx context.Context, epoch types.Epoch) (*encodedEpochInfo, error) {
+	for {
+		entry, ok := h.entry(epoch)
+		if !ok {
+			return h.computeEntry(ctx, epoch, entry)
+		}
+		select {
+		case <-entry.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if entry.canceled && ctx.Err() == nil {
+			continue
+		}
+		epochInfoCacheHits.Inc()
+		orchestrator.Debug(orchestrator.Log.WithFields(logrus.Fields{
+			"epoch":       epoch,
+			"cacheStatus": "hit",
+		}), "Served epoch info")
+		return entry.info, entry.err
+	}
+}
+
+// entry returns the cached entry of an epoch and true, or a new entry the caller must compute and
+// false if there is none.
+func (h *epochInfoHub) entry(epoch types.Epoch) (*epochInfoEntry, bool) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if entry, ok := h.entries[epoch]; ok {
+		return entry, true
+	}
+	entry := &epochInfoEntry{done: make(chan struct{})}
+	h.entries[epoch] = entry
+	h.order = append(h.order, epoch)
+	maxEntries := maxCachedEpochInfos
+	if h.maxEntries != nil {
+		maxEntries = h.maxEntries()
+	}
+	for len(h.order) > maxEntries {
+		delete(h.entries, h.order[0])
+		h.order = h.order[1:]
+	}
+	return entry, false
+}
+
+// computeEntry computes the epoch info of a new entry and releases the requests waiting on it.
+func (h *epochInfoHub) computeEntry(ctx context.Context, epoch types.Epoch, entry *epochInfoEntry) (*encodedEpochInfo, error) {
+	epochInfoComputations.Inc()
+	start := time.Now()
+	entry.info, entry.err = h.encode(ctx, epoch)
+	logEntry := orchestrator.Log.WithFields(logrus.Fields{
+		"epoch":       epoch,
+		"cacheStatus": "miss",
+		"duration":    time.Since(start),
+	})
+	if entry.err != nil {
+		logEntry = logEntry.WithError(entry.err)
+	}
+	orchestrator.Debug(logEntry, "Computed epoch info")
+	if entry.err != nil {
+		entry.canceled = ctx.Err() != nil
+		// Failed computations are not cached so that the next request retries.
+		h.lock.Lock()
+		if h.entries[epoch] == entry {
+			delete(h.entries, epoch)
+		}
+		h.lock.Unlock()
+	}
+	close(entry.done)
+	return entry.info, entry.err
+}
+
+// advance multicasts the epoch info of the epoch the head advanced into, followed by the epoch
+// infos of its lookahead epochs which were not multicast yet. An epoch info computed ahead of its
+// epoch is recomputed from the state of the epoch, and multicast again with its reorg flag set
+// only if it changed in the meantime.
+func (h *epochInfoHub) advance(ctx context.Context, epoch types.Epoch) {
+	h.lock.Lock()
+	wasAhead := h.ahead[epoch]
+	for e := range h.ahead {
+		if e <= epoch {
+			delete(h.ahead, e)
+		}
+	}
+	h.lock.Unlock()
+
+	info, err := h.epochInfo(ctx, epoch)
+	if err != nil {
+		orchestrator.Log.WithError(err).WithField("epoch", epoch).Error("Could not compute epoch info")
+	} else if !wasAhead {
+		h.broadcast(info)
+	} else if updated, changed, err := h.recompute(ctx, info); err != nil {
+		orchestrator.Log.WithError(err).WithField("epoch", epoch).Error("Could not recompute epoch info pushed ahead")
+	} else if changed {
+		h.replace(info, updated)
+		epochInfoReorgs.Inc()
+		h.broadcast(updated)
+	}
+
+	for next := epoch + 1; next <= epoch+h.lookahead; next++ {
+		// The hub stops, so the computations left would only fail one after another.
+		if ctx.Err() != nil {
+			return
+		}
+		h.lock.Lock()
+		pushed := h.ahead[next]
+		h.lock.Unlock()
+		if pushed {
+			continue
+		}
+		info, err := h.epochInfoAhead(ctx, next)
+		if err != nil {
+			orchestrator.Log.WithError(err).WithField("epoch", next).Debug("Could not compute epoch info ahead of its epoch")
+			continue
+		}
+		h.broadcast(info)
+	}
+}
+
+// epochInfoAhead returns the encoded epoch info of an epoch the head has not advanced into yet,
+// recording that it must be checked again once the head does.
+func (h *epochInfoHub) epochInfoAhead(ctx context.Context, epoch types.Epoch) (*encodedEpochInfo, error) {
+	h.lock.Lock()
+	h.ahead[epoch] = true
+	h.lock.Unlock()
+	info, err := h.epochInfo(ctx, epoch)
+	if err != nil {
+		h.lock.Lock()
+		delete(h.ahead, epoch)
+		h.lock.Unlock()
+	}
+	return info, err
+}
+
+// reorg recomputes the cached epoch infos from the given epoch onwards. Epoch infos which changed
+// replace the cached ones and are multicast with their reorg flag set.
+func (h *epochInfoHub) reorg(ctx context.Context, fromEpoch types.Epoch) {
+	h.lock.Lock()
+	var epochs []types.Epoch
+	for _, epoch := range h.order {
+		if epoch >= fromEpoch {
+			epochs = append(epochs, epoch)
+		}
+	}
+	h.lock.Unlock()
+
+	for _, epoch := range epochs {
+		if ctx.Err() != nil {
+			return
+		}
+		h.lock.Lock()
+		entry, ok := h.entries[epoch]
+		h.lock.Unlock()
+		if !ok {
+			continue
+		}
+		select {
+		case <-entry.done:
+		default:
+			// Still being computed, from the state after the reorg.
+			continue
+		}
+		if entry.err != nil {
+			continue
+		}
+		updated, changed, err := h.recompute(ctx, entry.info)
+		if err != nil {
+			orchestrator.Log.WithError(err).WithField("epoch", epoch).Error("Could not recompute epoch info after reorg")
+			continue
+		}
+		if !changed {
+			continue
+		}
+		h.replace(entry.info, updated)
+		epochInfoReorgs.Inc()
+		h.broadcast(updated)
+	}
+}
+
+// lateBlock recomputes the cached epoch infos from the given epoch onwards whose dependent root
+// changed since they were computed. This happens when the block of the last slot before an epoch
+// is processed after the epoch info was computed from an earlier head, which the head does not
+// report as a reorg. Epoch infos which changed replace the cached ones and are multicast with
+// their reorg flag set.
+func (h *epochInfoHub) lateBlock(ctx context.Context, fromEpoch types.Epoch) {
+	if h.dependentRoot == nil {
+		return
+	}
+	for _, cached := range h.completed(math.MaxUint64) {
+		if ctx.Err() != nil {
+			return
+		}
+		if cached.epoch < fromEpoch {
+			continue
+		}
+		root, ok := h.lookupDependentRoot(ctx, cached.epoch)
+		if ok && cached.hasDependentRoot && root == cached.dependentRoot {
+			continue
+		}
+		updated, changed, err := h.recompute(ctx, cached)
+		if err != nil {
+			orchestrator.Log.WithError(err).WithField("epoch", cached.epoch).Error("Could not recompute epoch info after late block")
+			continue
+		}
+		if !changed {
+			// The proposers did not change, so only the dependent root of the payload is updated
+			// to skip the check on the next late block.
+			refreshed := *cached
+			refreshed.dependentRoot, refreshed.hasDependentRoot = root, ok
+			h.replace(cached, &refreshed)
+			continue
+		}
+		h.replace(cached, updated)
+		epochInfoLateBlockRecomputations.Inc()
+		h.broadcast(updated)
+	}
+}
+
+// lookupDependentRoot returns the dependent root of an epoch, and false if it is not known.
+func (h *epochInfoHub) lookupDependentRoot(ctx context.Context, epoch types.Epoch) ([32]byte, bool) {
+	if h.dependentRoot == nil {
+		return [32]byte{}, false
+	}
+	root, ok, err := h.dependentRoot(ctx, epoch)
+	if err != nil {
+		orchestrator.Log.WithError(err).WithField("epoch", epoch).Debug("Could not determine dependent root of epoch")
+		return [32]byte{}, false
+	}
+	return root, ok
+}
+
+// recompute computes the epoch info of a cached payload again and reports whether anything but
+// its flags changed. The returned payload has the reorg flag set.
+func (h *epochInfoHub) recompute(ctx context.Context, cached *encodedEpochInfo) (*encodedEpochInfo, bool, error) {
+	// The dependent root is looked up first, so that a block processed during the computation
+	// leaves a stale root to be checked again rather than a stale payload.
+	root, hasRoot := h.lookupDependentRoot(ctx, cached.epoch)
+	info, err := h.compute(ctx, cached.epoch)
+	if err != nil {
+		return nil, false, err
+	}
+	same, err := sameEpochInfo(cached.payload, info)
+	if err != nil || same {
+		return nil, false, err
+	}
+	info.ReorgFlag = true
+	payload, err := info.MarshalBinary()
+	if err != nil {
+		return nil, false, err
+	}
+	return &encodedEpochInfo{
+		epoch:            cached.epoch,
+		payload:          payload,
+		reorg:            true,
+		provisional:      info.Provisional,
+		dependentRoot:    root,
+		hasDependentRoot: hasRoot,
+	}, true, nil
+}
+
+// settle recomputes the cached provisional epoch infos of epochs which finalized since the last
+// call, and multicasts them again without the provisional flag. Epoch infos which changed since
+// their provisional payload was sent also have their reorg flag set.
+func (h *epochInfoHub) settle(ctx context.Context) {
+	if h.finalizedEpoch == nil {
+		return
+	}
+	finalized, ok := h.finalizedEpoch()
+	if !ok {
+		return
+	}
+	h.lock.Lock()
+	if finalized <= h.settledUpTo {
+		h.lock.Unlock()
+		return
+	}
+	h.settledUpTo = finalized
+	h.lock.Unlock()
+
+	for _, cached := range h.completed(finalized) {
+		if ctx.Err() != nil {
+			return
+		}
+		if !cached.provisional {
+			continue
+		}
+		info, err := h.compute(ctx, cached.epoch)
+		if err != nil {
+			orchestrator.Log.WithError(err).WithField("epoch", cached.epoch).Error("Could not settle epoch info")
+			continue
+		}
+		same, err := sameEpochInfo(cached.payload, info)
+		if err != nil {
+			orchestrator.Log.WithError(err).WithField("epoch", cached.epoch).Error("Could not settle epoch info")
+			continue
+		}
+		info.ReorgFlag = !same
+		payload, err := info.MarshalBinary()
+		if err != nil {
+			orchestrator.Log.WithError(err).WithField("epoch", cached.epoch).Error("Could not settle epoch info")
+			continue
+		}
+		settled := &encodedEpochInfo{
+			epoch:            cached.epoch,
+			payload:          payload,
+			reorg:            info.ReorgFlag,
+			provisional:      info.Provisional,
+			settled:          true,
+			dependentRoot:    cached.dependentRoot,
+			hasDependentRoot: cached.hasDependentRoot,
+		}
+		h.replace(cached, settled)
+		h.broadcast(settled)
+	}
+}
+
+// replace caches the updated payload of an epoch, unless the cached payload was replaced or
+// evicted in the meantime.
+func (h *epochInfoHub) replace(cached, updated *encodedEpochInfo) {
+	done := make(chan struct{})
+	close(done)
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if entry, ok := h.entries[updated.epoch]; ok && entry.info == cached {
+		h.entries[updated.epoch] = &epochInfoEntry{done: done, info: updated}
+	}
+}
+
+// sameEpochInfo reports whether an encoded epoch info and an epoch info only differ in their flags.
+func sameEpochInfo(payload []byte, info *orchestrator.EpochInfo) (bool, error) {
+	previous := &orchestrator.EpochInfo{}
+	if err := previous.UnmarshalBinary(payload); err != nil {
+		return false, err
+	}
+	previous.ReorgFlag, previous.Provisional = info.ReorgFlag, info.Provisional
+	previousPayload, err := previous.MarshalBinary()
+	if err != nil {
+		return false, err
+	}
+	currentPayload, err := info.MarshalBinary()
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(previousPayload, currentPayload), nil
+}
+
+// completed returns the cached epoch infos up to the given epoch whose computation succeeded, in
+// the order they were cached.
+func (h *epochInfoHub) completed(maxEpoch types.Epoch) []*encodedEpochInfo {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	var infos []*encodedEpochInfo
+	for _, epoch := range h.order {
+		entry, ok := h.entries[epoch]
+		if !ok || epoch > maxEpoch {
+			continue
+		}
+		select {
+		case <-entry.done:
+			if entry.err == nil {
+				infos = append(infos, entry.info)
+			}
+		default:
+		}
+	}
+	return infos
+}
+
+func (h *epochInfoHub) encode(ctx context.Context, epoch types.Epoch) (*encodedEpochInfo, error) {
+	root, hasRoot := h.lookupDependentRoot(ctx, epoch)
+	info, err := h.compute(ctx, epoch)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := info.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &encodedEpochInfo{
+		epoch:            epoch,
+		payload:          payload,
+		provisional:      info.Provisional,
+		dependentRoot:    root,
+		hasDependentRoot: hasRoot,
+	}, nil
+}
+
+// subscribe registers a subscriber for multicast epoch infos. The returned channel is closed if
+// the subscriber falls behind; the returned function unregisters the subscriber.
+func (h *epochInfoHub) subscribe() (<-chan *encodedEpochInfo, func()) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	id := h.nextID
+	h.nextID++
+	ch := make(chan *encodedEpochInfo, epochInfoSubscriberBuffer)
+	h.subscribers[id] = ch
+	epochInfoSubscribers.Set(float64(len(h.subscribers)))
+	return ch, func() {
+		h.lock.Lock()
+		defer h.lock.Unlock()
+		if _, ok := h.subscribers[id]; ok {
+			delete(h.subscribers, id)
+			close(ch)
+		}
+		epochInfoSubscribers.Set(float64(len(h.subscribers)))
+	}
+}
+
+// broadcast sends an epoch info to every subscriber. Subscribers whose buffer is full are dropped
+// rather than blocking delivery to the others.
+func (h *epochInfoHub) broadcast(info *encodedEpochInfo) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	for id, ch := range h.subscribers {
+		select {
+		case ch <- info:
+		default:
+			orchestrator.Log.WithField("epoch", info.epoch).Warn("Dropping epoch info subscriber which fell behind")
+			delete(h.subscribers, id)
+			close(ch)
+		}
+	}
+	epochInfoSubscribers.Set(float64(len(h.subscribers)))
+}
@@ -0,0 +1,175 @@
+package beacon
+
+import (
+	"context"
+	"sync"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxPrefetchEpochs bounds the number of epochs a single prefetch job may cover.
+	maxPrefetchEpochs = types.Epoch(1024)
+	// maxRunningPrefetches bounds the number of prefetch jobs warming epoch infos at once, as every
+	// job may regenerate a state per epoch.
+	maxRunningPrefetches = 2
+	// maxPrefetchJobs is the number of prefetch jobs whose status is kept, finished jobs being
+	// forgotten first.
+	maxPrefetchJobs = 16
+)
+
+// prefetchJobs keeps the status of the prefetch jobs. The zero value is ready to use.
+type prefetchJobs struct {
+	lock    sync.Mutex
+	nextID  uint64
+	running int
+	jobs    map[uint64]*pbrpc.PrefetchStatus
+	order   []uint64
+}
+
+// start registers a job for the range, unless too many jobs are running.
+func (p *prefetchJobs) start(fromEpoch, toEpoch types.Epoch) (uint64, bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.running >= maxRunningPrefetches {
+		return 0, false
+	}
+	if p.jobs == nil {
+		p.jobs = make(map[uint64]*pbrpc.PrefetchStatus)
+	}
+	p.nextID++
+	p.running++
+	p.jobs[p.nextID] = &pbrpc.PrefetchStatus{
+		JobId:     p.nextID,
+		FromEpoch: fromEpoch,
+		ToEpoch:   toEpoch,
+		Total:     uint64(toEpoch-fromEpoch) + 1,
+	}
+	p.order = append(p.order, p.nextID)
+	// Forget the oldest finished jobs. Running jobs are at most maxRunningPrefetches, so they are
+	// always kept.
+	for i := 0; len(p.order) > maxPrefetchJobs && i < len(p.order); {
+		if id := p.order[i]; p.jobs[id].Done {
+			delete(p.jobs, id)
+			p.order = append(p.order[:i], p.order[i+1:]...)
+			continue
+		}
+		i++
+	}
+	return p.nextID, true
+}
+
+// progress records that one more epoch of a job was warmed.
+func (p *prefetchJobs) progress(id uint64) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.jobs[id].Completed++
+}
+
+// gap records an epoch of a job whose epoch info could not be computed.
+func (p *prefetchJobs) gap(id uint64, gap *pbrpc.EpochGap) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.jobs[id].Gaps = append(p.jobs[id].Gaps, gap)
+}
+
+// finish marks a job as done, with the error which stopped it if any.
+func (p *prefetchJobs) finish(id uint64, err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	job := p.jobs[id]
+	job.Done = true
+	if err != nil {
+		job.Error = err.Error()
+	}
+	p.running--
+}
+
+// status returns a copy of the status of a job.
+func (p *prefetchJobs) status(id uint64) (*pbrpc.PrefetchStatus, bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	job, ok := p.jobs[id]
+	if !ok {
+		return nil, false
+	}
+	copied := *job
+	copied.Gaps = append([]*pbrpc.EpochGap(nil), job.Gaps...)
+	return &copied, true
+}
+
+// PrefetchEpochInfo starts warming the epoch infos of an epoch range in the background and
+// returns the ID of the job, whose progress is reported by GetPrefetchStatus. Orchestrators
+// starting from far behind prefetch the range they are about to stream, rather than blocking a
+// stream on the computation of every epoch. The epoch infos of finalized epochs are persisted,
+// the others are only kept in the cache of the most recent epochs.
+func (bs *Server) PrefetchEpochInfo(ctx context.Context, req *pbrpc.PrefetchEpochInfoRequest) (*pbrpc.PrefetchJob, error) {
+	_, span := trace.StartSpan(ctx, "BeaconChainServer.PrefetchEpochInfo")
+	defer span.End()
+
+	currentEpoch := helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
+	if req.ToEpoch > currentEpoch {
+		return nil, futureEpochError(currentEpoch, req.ToEpoch)
+	}
+	if req.FromEpoch > req.ToEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "From epoch %d is after to epoch %d", req.FromEpoch, req.ToEpoch)
+	}
+	if req.ToEpoch-req.FromEpoch >= maxPrefetchEpochs {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested epoch range exceeds the maximum of %d epochs", maxPrefetchEpochs)
+	}
+	if err := bs.checkEpochLookback(req.FromEpoch); err != nil {
+		return nil, err
+	}
+	id, ok := bs.epochInfoPrefetches.start(req.FromEpoch, req.ToEpoch)
+	if !ok {
+		return nil, status.Errorf(codes.ResourceExhausted, "Already running %d prefetch jobs, retry once one is done", maxRunningPrefetches)
+	}
+	orchestrator.Debug(orchestrator.Log.WithFields(logrus.Fields{
+		"jobID":     id,
+		"fromEpoch": req.FromEpoch,
+		"toEpoch":   req.ToEpoch,
+		"requester": epochInfoRequester(ctx),
+	}), "Started epoch info prefetch")
+
+	// The job outlives the call, so it only stops with the node.
+	go bs.prefetchEpochInfo(bs.Ctx, id, req.FromEpoch, req.ToEpoch, req.AllowGaps)
+	return &pbrpc.PrefetchJob{JobId: id}, nil
+}
+
+// prefetchEpochInfo warms the epoch infos of the range concurrently, reporting the progress in
+// ascending epoch order. Epochs whose epoch info could not be computed are reported as gaps.
+func (bs *Server) prefetchEpochInfo(ctx context.Context, id uint64, fromEpoch, toEpoch types.Epoch, allowGaps bool) {
+	err := bs.forEachEpochInfo(ctx, fromEpoch, toEpoch, func(epoch types.Epoch, _ *encodedEpochInfo, err error) error {
+		if err != nil {
+			orchestrator.Log.WithError(err).WithFields(logrus.Fields{
+				"jobID": id,
+				"epoch": epoch,
+			}).Error("Could not prefetch epoch info")
+			bs.epochInfoPrefetches.gap(id, &pbrpc.EpochGap{Epoch: epoch, Reason: err.Error()})
+			if allowGaps && recoverableEpochInfoError(err) {
+				epochInfoGaps.Inc()
+				return nil
+			}
+			return err
+		}
+		bs.epochInfoPrefetches.progress(id)
+		return nil
+	})
+	bs.epochInfoPrefetches.finish(id, err)
+}
+
+// GetPrefetchStatus reports the progress of a prefetch job started by PrefetchEpochInfo.
+func (bs *Server) GetPrefetchStatus(_ context.Context, req *pbrpc.GetPrefetchStatusRequest) (*pbrpc.PrefetchStatus, error) {
+	job, ok := bs.epochInfoPrefetches.status(req.JobId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "No prefetch job %d", req.JobId)
+	}
+	return job, nil
+}
@@ -0,0 +1,118 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_PrefetchEpochInfo(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	db := dbTest.SetupDB(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := setupActiveValidators(t, 64)
+	blk := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, blk))
+	blockRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, s, blockRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, blockRoot))
+
+	currentSlot := params.BeaconConfig().SlotsPerEpoch * 3
+	bs := &Server{
+		Ctx:                 ctx,
+		BeaconDB:            db,
+		GenesisTimeFetcher:  &mock.ChainService{Slot: &currentSlot, Genesis: time.Unix(1600000000, 0)},
+		FinalizationFetcher: &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 1}},
+		StateNotifier:       &mock.MockStateNotifier{},
+		StateGen:            stategen.New(db),
+		EpochInfoStore:      db,
+	}
+
+	job, err := bs.PrefetchEpochInfo(ctx, &pbrpc.PrefetchEpochInfoRequest{FromEpoch: 0, ToEpoch: 2})
+	require.NoError(t, err)
+	var res *pbrpc.PrefetchStatus
+	for start := time.Now(); time.Since(start) < 10*time.Second; time.Sleep(10 * time.Millisecond) {
+		res, err = bs.GetPrefetchStatus(ctx, &pbrpc.GetPrefetchStatusRequest{JobId: job.JobId})
+		require.NoError(t, err)
+		if res.Done {
+			break
+		}
+	}
+	assert.DeepEqual(t, &pbrpc.PrefetchStatus{
+		JobId:     job.JobId,
+		FromEpoch: 0,
+		ToEpoch:   2,
+		Completed: 3,
+		Total:     3,
+		Done:      true,
+	}, res)
+
+	// The finalized epochs are persisted, and every epoch is cached.
+	for epoch := types.Epoch(0); epoch <= 2; epoch++ {
+		info, err := db.EpochInfo(ctx, epoch)
+		require.NoError(t, err)
+		assert.Equal(t, epoch <= 1, info != nil, "Unexpected persisted epoch info for epoch %d", epoch)
+	}
+	cached := len(bs.epochInfoHubInstance().completed(2))
+	assert.Equal(t, 3, cached)
+
+	_, err = bs.GetPrefetchStatus(ctx, &pbrpc.GetPrefetchStatusRequest{JobId: job.JobId + 1})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestServer_PrefetchEpochInfo_InvalidRequest(t *testing.T) {
+	currentSlot := params.BeaconConfig().SlotsPerEpoch * 2000
+	bs := &Server{
+		GenesisTimeFetcher:   &mock.ChainService{Slot: &currentSlot},
+		MaxEpochInfoLookback: 100,
+	}
+	ctx := context.Background()
+
+	_, err := bs.PrefetchEpochInfo(ctx, &pbrpc.PrefetchEpochInfoRequest{FromEpoch: 2, ToEpoch: 1})
+	assert.ErrorContains(t, "From epoch 2 is after to epoch 1", err)
+	_, err = bs.PrefetchEpochInfo(ctx, &pbrpc.PrefetchEpochInfoRequest{FromEpoch: 0, ToEpoch: maxPrefetchEpochs})
+	assert.ErrorContains(t, "exceeds the maximum", err)
+	_, err = bs.PrefetchEpochInfo(ctx, &pbrpc.PrefetchEpochInfoRequest{FromEpoch: 1999, ToEpoch: 2001})
+	assert.ErrorContains(t, "Cannot retrieve information about an epoch in the future", err)
+	_, err = bs.PrefetchEpochInfo(ctx, &pbrpc.PrefetchEpochInfoRequest{FromEpoch: 1000, ToEpoch: 1001})
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+}
+
+func TestPrefetchJobs_BoundsRunningAndKeptJobs(t *testing.T) {
+	var jobs prefetchJobs
+	first, ok := jobs.start(0, 1)
+	require.Equal(t, true, ok)
+	_, ok = jobs.start(0, 1)
+	require.Equal(t, true, ok)
+	_, ok = jobs.start(0, 1)
+	assert.Equal(t, false, ok, "Expected the running jobs to be bounded")
+
+	jobs.finish(first, nil)
+	for i := 0; i < maxPrefetchJobs; i++ {
+		id, ok := jobs.start(0, 1)
+		require.Equal(t, true, ok)
+		jobs.finish(id, nil)
+	}
+	_, ok = jobs.status(first)
+	assert.Equal(t, false, ok, "Expected the oldest finished job to be forgotten")
+	assert.Equal(t, maxPrefetchJobs, len(jobs.jobs))
+}
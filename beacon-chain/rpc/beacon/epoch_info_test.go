@@ -0,0 +1,910 @@
+package beacon
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type epochInfoTestStream struct {
+	ctx      context.Context
+	payloads chan []byte
+}
+
+func (s *epochInfoTestStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *epochInfoTestStream) SendEncoded(payload []byte) error {
+	s.payloads <- payload
+	return nil
+}
+
+func (s *epochInfoTestStream) receive(t *testing.T) []byte {
+	select {
+	case payload := <-s.payloads:
+		return payload
+	case <-time.After(10 * time.Second):
+		t.Fatal("Timed out waiting for epoch info")
+	}
+	return nil
+}
+
+type epochInfoGRPCTestStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	recv chan *pbrpc.EpochInfoStreamMessage
+	sent chan *pbrpc.EpochInfo
+}
+
+func (s *epochInfoGRPCTestStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *epochInfoGRPCTestStream) Send(info *pbrpc.EpochInfo) error {
+	s.sent <- info
+	return nil
+}
+
+func (s *epochInfoGRPCTestStream) Recv() (*pbrpc.EpochInfoStreamMessage, error) {
+	select {
+	case msg := <-s.recv:
+		return msg, nil
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+func TestServer_StreamEpochInfo_GRPC(t *testing.T) {
+	ctx := context.Background()
+	stream := &epochInfoGRPCTestStream{
+		ctx:  ctx,
+		recv: make(chan *pbrpc.EpochInfoStreamMessage, 2),
+		sent: make(chan *pbrpc.EpochInfo, 1),
+	}
+
+	// The stream must be opened by a request.
+	stream.recv <- &pbrpc.EpochInfoStreamMessage{Message: &pbrpc.EpochInfoStreamMessage_AckEpoch{AckEpoch: 1}}
+	err := (&Server{}).StreamEpochInfo(stream)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	stream.recv <- &pbrpc.EpochInfoStreamMessage{Message: &pbrpc.EpochInfoStreamMessage_Request{
+		Request: &pbrpc.StreamEpochInfoRequest{Subscriber: strings.Repeat("a", orchestrator.MaxSubscriberNameLength+1)},
+	}}
+	err = (&Server{}).StreamEpochInfo(stream)
+	assert.ErrorContains(t, "Subscriber name can not be longer", err)
+
+	// Cached payloads are sent as epoch info messages, and acknowledgements are received.
+	info := &orchestrator.EpochInfo{Epoch: 3, Proposers: [][48]byte{{'a'}, {'b'}}, Provisional: true}
+	payload, err := info.MarshalBinary()
+	require.NoError(t, err)
+	adapter := &grpcEpochInfoStream{stream: stream}
+	require.NoError(t, adapter.SendEncoded(payload))
+	received, err := orchestrator.EpochInfoFromProto(<-stream.sent)
+	require.NoError(t, err)
+	info.ProposerListRoot = received.ProposerListRoot
+	assert.DeepEqual(t, info, received)
+
+	stream.recv <- &pbrpc.EpochInfoStreamMessage{Message: &pbrpc.EpochInfoStreamMessage_AckEpoch{AckEpoch: 3}}
+	acked, err := adapter.RecvAck()
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(3), acked)
+}
+
+func TestEpochInfoHub_ComputesOncePerEpoch(t *testing.T) {
+	var computations int32
+	release := make(chan struct{})
+	hub := newEpochInfoHub(func(_ context.Context, epoch types.Epoch) (*orchestrator.EpochInfo, error) {
+		atomic.AddInt32(&computations, 1)
+		<-release
+		return &orchestrator.EpochInfo{Epoch: epoch}, nil
+	})
+
+	results := make([]*encodedEpochInfo, 5)
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			info, err := hub.epochInfo(context.Background(), 3)
+			require.NoError(t, err)
+			results[i] = info
+		}(i)
+	}
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&computations))
+	for _, info := range results {
+		assert.Equal(t, results[0], info)
+	}
+	assert.Equal(t, types.Epoch(3), results[0].epoch)
+}
+
+func TestEpochInfoHub_FailedComputationIsRetried(t *testing.T) {
+	fail := true
+	hub := newEpochInfoHub(func(_ context.Context, epoch types.Epoch) (*orchestrator.EpochInfo, error) {
+		if fail {
+			return nil, errors.New("state unavailable")
+		}
+		return &orchestrator.EpochInfo{Epoch: epoch}, nil
+	})
+
+	_, err := hub.epochInfo(context.Background(), 1)
+	assert.ErrorContains(t, "state unavailable", err)
+	fail = false
+	info, err := hub.epochInfo(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(1), info.epoch)
+}
+
+func TestEpochInfoHub_WaiterOutlivesCanceledRequester(t *testing.T) {
+	var computations int32
+	started := make(chan struct{})
+	hub := newEpochInfoHub(func(ctx context.Context, epoch types.Epoch) (*orchestrator.EpochInfo, error) {
+		if atomic.AddInt32(&computations, 1) == 1 {
+			close(started)
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		return &orchestrator.EpochInfo{Epoch: epoch}, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := hub.epochInfo(ctx, 2)
+		leaderErr <- err
+	}()
+	<-started
+	waiter := make(chan *encodedEpochInfo, 1)
+	go func() {
+		info, err := hub.epochInfo(context.Background(), 2)
+		require.NoError(t, err)
+		waiter <- info
+	}()
+	cancel()
+
+	assert.ErrorContains(t, context.Canceled.Error(), <-leaderErr)
+	// The request still waiting computes the epoch info itself instead of failing with the
+	// context error of the request which went away.
+	info := <-waiter
+	assert.Equal(t, types.Epoch(2), info.epoch)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&computations))
+}
+
+func TestEpochInfoHub_DropsSlowSubscriber(t *testing.T) {
+	hub := newEpochInfoHub(nil)
+	slow, unsubscribeSlow := hub.subscribe()
+	defer unsubscribeSlow()
+	fast, unsubscribeFast := hub.subscribe()
+	defer unsubscribeFast()
+
+	for i := 0; i <= epochInfoSubscriberBuffer; i++ {
+		hub.broadcast(&encodedEpochInfo{epoch: types.Epoch(i)})
+		info := <-fast
+		assert.Equal(t, types.Epoch(i), info.epoch)
+	}
+
+	for i := 0; i < epochInfoSubscriberBuffer; i++ {
+		info := <-slow
+		assert.Equal(t, types.Epoch(i), info.epoch)
+	}
+	_, ok := <-slow
+	assert.Equal(t, false, ok, "Expected slow subscriber to be dropped")
+}
+
+func TestEpochInfoHub_ReorgResendsChangedEpochs(t *testing.T) {
+	var lock sync.Mutex
+	proposers := map[types.Epoch][48]byte{1: {'a'}, 2: {'b'}, 3: {'c'}}
+	hub := newEpochInfoHub(func(_ context.Context, epoch types.Epoch) (*orchestrator.EpochInfo, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		return &orchestrator.EpochInfo{Epoch: epoch, Proposers: [][48]byte{proposers[epoch]}}, nil
+	})
+	ctx := context.Background()
+	for epoch := types.Epoch(1); epoch <= 3; epoch++ {
+		_, err := hub.epochInfo(ctx, epoch)
+		require.NoError(t, err)
+	}
+	sub, unsubscribe := hub.subscribe()
+	defer unsubscribe()
+
+	// The reorg changes the proposers of epochs 1 and 3, but only epochs from 2 onwards are affected.
+	lock.Lock()
+	proposers[1], proposers[3] = [48]byte{'x'}, [48]byte{'z'}
+	lock.Unlock()
+	hub.reorg(ctx, 2)
+
+	require.Equal(t, 1, len(sub))
+	resent := <-sub
+	assert.Equal(t, types.Epoch(3), resent.epoch)
+	assert.Equal(t, true, resent.reorg)
+	info := &orchestrator.EpochInfo{}
+	require.NoError(t, info.UnmarshalBinary(resent.payload))
+	assert.Equal(t, true, info.ReorgFlag)
+	assert.Equal(t, [48]byte{'z'}, info.Proposers[0])
+
+	// The cache serves the superseding payload.
+	cached, err := hub.epochInfo(ctx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, resent, cached)
+
+	// Recomputing unchanged epochs does not resend them.
+	hub.reorg(ctx, 0)
+	require.Equal(t, 1, len(sub))
+	assert.Equal(t, types.Epoch(1), (<-sub).epoch)
+}
+
+func TestEpochInfoHub_ReorgStopsOnCanceledContext(t *testing.T) {
+	var lock sync.Mutex
+	computed := 0
+	hub := newEpochInfoHub(func(ctx context.Context, epoch types.Epoch) (*orchestrator.EpochInfo, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		computed++
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return &orchestrator.EpochInfo{Epoch: epoch}, nil
+	})
+	for epoch := types.Epoch(1); epoch <= 3; epoch++ {
+		_, err := hub.epochInfo(context.Background(), epoch)
+		require.NoError(t, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	hub.reorg(ctx, 0)
+	lock.Lock()
+	defer lock.Unlock()
+	assert.Equal(t, 3, computed, "Recomputed epoch info after the context was canceled")
+}
+
+func TestEpochInfoHub_SettleResendsFinalizedEpochs(t *testing.T) {
+	var lock sync.Mutex
+	finalized := types.Epoch(0)
+	proposers := map[types.Epoch][48]byte{1: {'a'}, 2: {'b'}, 3: {'c'}}
+	hub := newEpochInfoHub(func(_ context.Context, epoch types.Epoch) (*orchestrator.EpochInfo, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		return &orchestrator.EpochInfo{
+			Epoch:       epoch,
+			Proposers:   [][48]byte{proposers[epoch]},
+			Provisional: epoch > finalized,
+		}, nil
+	})
+	hub.finalizedEpoch = func() (types.Epoch, bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		return finalized, true
+	}
+	ctx := context.Background()
+	for epoch := types.Epoch(1); epoch <= 3; epoch++ {
+		info, err := hub.epochInfo(ctx, epoch)
+		require.NoError(t, err)
+		assert.Equal(t, true, info.provisional)
+	}
+	sub, unsubscribe := hub.subscribe()
+	defer unsubscribe()
+
+	// Epochs 1 and 2 finalize, and the proposers of epoch 2 changed since it was cached.
+	lock.Lock()
+	finalized, proposers[2] = 2, [48]byte{'y'}
+	lock.Unlock()
+	hub.settle(ctx)
+
+	require.Equal(t, 2, len(sub))
+	for epoch := types.Epoch(1); epoch <= 2; epoch++ {
+		resent := <-sub
+		assert.Equal(t, epoch, resent.epoch)
+		assert.Equal(t, true, resent.settled)
+		assert.Equal(t, false, resent.provisional)
+		assert.Equal(t, epoch == 2, resent.reorg)
+		info := &orchestrator.EpochInfo{}
+		require.NoError(t, info.UnmarshalBinary(resent.payload))
+		assert.Equal(t, false, info.Provisional)
+		assert.Equal(t, proposers[epoch], info.Proposers[0])
+
+		cached, err := hub.epochInfo(ctx, epoch)
+		require.NoError(t, err)
+		assert.Equal(t, resent, cached)
+	}
+
+	// Epochs are only settled once.
+	hub.settle(ctx)
+	assert.Equal(t, 0, len(sub))
+}
+
+func TestEpochInfoHub_SettlesOnFinalizedEvents(t *testing.T) {
+	var finalized uint64
+	hub := newEpochInfoHub(func(_ context.Context, epoch types.Epoch) (*orchestrator.EpochInfo, error) {
+		return &orchestrator.EpochInfo{
+			Epoch:       epoch,
+			Proposers:   [][48]byte{{'a'}},
+			Provisional: uint64(epoch) > atomic.LoadUint64(&finalized),
+		}, nil
+	})
+	hub.finalizedEpoch = func() (types.Epoch, bool) {
+		return types.Epoch(atomic.LoadUint64(&finalized)), true
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	notifier := &mock.MockStateNotifier{}
+	go hub.run(ctx, notifier)
+
+	info, err := hub.epochInfo(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, true, info.provisional)
+	sub, unsubscribe := hub.subscribe()
+	defer unsubscribe()
+
+	atomic.StoreUint64(&finalized, 1)
+	// The hub subscribes to the state feed asynchronously, so the event is sent until it is received.
+	ev := &feed.Event{Type: statefeed.Finalized, Data: &statefeed.FinalizedData{Epoch: 1}}
+	for notifier.StateFeed().Send(ev) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	select {
+	case settled := <-sub:
+		assert.Equal(t, types.Epoch(1), settled.epoch)
+		assert.Equal(t, true, settled.settled)
+		assert.Equal(t, false, settled.provisional)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the finalized epoch to be settled")
+	}
+}
+
+func TestEpochInfoHub_AdvancePushesLookaheadEpochs(t *testing.T) {
+	var lock sync.Mutex
+	proposers := map[types.Epoch][48]byte{5: {'a'}, 6: {'b'}, 7: {'c'}, 8: {'d'}}
+	hub := newEpochInfoHub(func(_ context.Context, epoch types.Epoch) (*orchestrator.EpochInfo, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		return &orchestrator.EpochInfo{Epoch: epoch, Proposers: [][48]byte{proposers[epoch]}}, nil
+	})
+	hub.lookahead = 1
+	ctx := context.Background()
+	sub, unsubscribe := hub.subscribe()
+	defer unsubscribe()
+
+	hub.advance(ctx, 5)
+	require.Equal(t, 2, len(sub))
+	for epoch := types.Epoch(5); epoch <= 6; epoch++ {
+		info := <-sub
+		assert.Equal(t, epoch, info.epoch)
+		assert.Equal(t, false, info.reorg)
+	}
+
+	// Blocks of epoch 5 changed the proposers of epoch 6 before the head advanced into it.
+	lock.Lock()
+	proposers[6] = [48]byte{'y'}
+	lock.Unlock()
+	hub.advance(ctx, 6)
+	require.Equal(t, 2, len(sub))
+	resent := <-sub
+	assert.Equal(t, types.Epoch(6), resent.epoch)
+	assert.Equal(t, true, resent.reorg)
+	info := &orchestrator.EpochInfo{}
+	require.NoError(t, info.UnmarshalBinary(resent.payload))
+	assert.Equal(t, true, info.ReorgFlag)
+	assert.Equal(t, [48]byte{'y'}, info.Proposers[0])
+	assert.Equal(t, types.Epoch(7), (<-sub).epoch)
+
+	// Epoch infos pushed ahead which did not change are not pushed again.
+	hub.advance(ctx, 7)
+	require.Equal(t, 1, len(sub))
+	assert.Equal(t, types.Epoch(8), (<-sub).epoch)
+}
+
+func TestEpochInfoHub_LateBlockResendsChangedEpochs(t *testing.T) {
+	var lock sync.Mutex
+	proposers := map[types.Epoch][48]byte{5: {'a'}, 6: {'b'}, 7: {'c'}}
+	dependentRoots := map[types.Epoch][32]byte{5: {'r'}, 6: {'s'}, 7: {'s'}}
+	computations := 0
+	hub := newEpochInfoHub(func(_ context.Context, epoch types.Epoch) (*orchestrator.EpochInfo, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		computations++
+		return &orchestrator.EpochInfo{Epoch: epoch, Proposers: [][48]byte{proposers[epoch]}}, nil
+	})
+	hub.dependentRoot = func(_ context.Context, epoch types.Epoch) ([32]byte, bool, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		root, ok := dependentRoots[epoch]
+		return root, ok, nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	notifier := &mock.MockStateNotifier{}
+	go hub.run(ctx, notifier)
+	for epoch := types.Epoch(5); epoch <= 7; epoch++ {
+		_, err := hub.epochInfo(ctx, epoch)
+		require.NoError(t, err)
+	}
+	sub, unsubscribe := hub.subscribe()
+	defer unsubscribe()
+
+	// A block for the last slot of epoch 5 became the head after epochs 6 and 7 were computed
+	// from an earlier head, and changed the proposers of epoch 6 only.
+	lock.Lock()
+	dependentRoots[6], dependentRoots[7], proposers[6] = [32]byte{'t'}, [32]byte{'t'}, [48]byte{'y'}
+	computations = 0
+	lock.Unlock()
+	lastSlot := params.BeaconConfig().SlotsPerEpoch.Mul(6) - 1
+	ev := &feed.Event{Type: statefeed.BlockProcessed, Data: &statefeed.BlockProcessedData{Slot: lastSlot}}
+	// The hub subscribes to the state feed asynchronously, so the event is sent until it is received.
+	for notifier.StateFeed().Send(ev) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	select {
+	case resent := <-sub:
+		assert.Equal(t, types.Epoch(6), resent.epoch)
+		assert.Equal(t, true, resent.reorg)
+		info := &orchestrator.EpochInfo{}
+		require.NoError(t, info.UnmarshalBinary(resent.payload))
+		assert.Equal(t, true, info.ReorgFlag)
+		assert.Equal(t, [48]byte{'y'}, info.Proposers[0])
+		cached, err := hub.epochInfo(ctx, 6)
+		require.NoError(t, err)
+		assert.Equal(t, resent, cached)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the epoch info changed by the late block to be sent again")
+	}
+
+	// The unchanged epoch 7 is not sent again, and neither epoch is recomputed on the next late
+	// block once their dependent roots are up to date.
+	hub.lateBlock(ctx, 6)
+	assert.Equal(t, 0, len(sub))
+	lock.Lock()
+	assert.Equal(t, 2, computations)
+	lock.Unlock()
+}
+
+func TestServer_StreamEpochInfo_MulticastsSharedPayload(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	db := dbTest.SetupDB(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := setupActiveValidators(t, 64)
+	blk := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, blk))
+	blockRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, s, blockRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, blockRoot))
+
+	genesis := time.Unix(1600000000, 0)
+	currentSlot := types.Slot(0)
+	notifier := &mock.MockStateNotifier{}
+	bs := &Server{
+		Ctx:                ctx,
+		BeaconDB:           db,
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot, Genesis: genesis},
+		StateNotifier:      notifier,
+		StateGen:           stategen.New(db),
+	}
+
+	streams := []*epochInfoTestStream{
+		{ctx: context.Background(), payloads: make(chan []byte, 2)},
+		{ctx: context.Background(), payloads: make(chan []byte, 2)},
+	}
+	errs := make(chan error, len(streams))
+	for _, stream := range streams {
+		go func(stream *epochInfoTestStream) {
+			errs <- bs.streamEpochInfo(&pbrpc.StreamEpochInfoRequest{}, stream)
+		}(stream)
+	}
+
+	for _, stream := range streams {
+		info := &orchestrator.EpochInfo{}
+		require.NoError(t, info.UnmarshalBinary(stream.receive(t)))
+		assert.Equal(t, types.Epoch(0), info.Epoch)
+		assert.Equal(t, uint64(genesis.Unix()), info.EpochStartTime)
+		require.Equal(t, int(params.BeaconConfig().SlotsPerEpoch), len(info.Proposers))
+		assert.Equal(t, true, info.Skipped(0), "The genesis slot has no proposer")
+		assert.Equal(t, false, info.Skipped(1))
+		st := s.Copy()
+		require.NoError(t, st.SetSlot(1))
+		proposer, err := helpers.BeaconProposerIndex(nil, st)
+		require.NoError(t, err)
+		assert.Equal(t, st.PubkeyAtIndex(proposer), info.Proposers[1])
+		digest, err := helpers.ComputeForkDigest(s.Fork().CurrentVersion, s.GenesisValidatorRoot())
+		require.NoError(t, err)
+		assert.DeepEqual(t, s.Fork().CurrentVersion, info.ForkVersion[:])
+		assert.Equal(t, digest, info.ForkDigest)
+		assert.Equal(t, false, info.ReorgFlag)
+	}
+
+	// The hub subscribes to the state feed asynchronously, so the event is sent until it is received.
+	ev := &feed.Event{Type: statefeed.EpochTransition, Data: &statefeed.EpochTransitionData{Epoch: 1, Slot: 32}}
+	for notifier.StateFeed().Send(ev) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	first := streams[0].receive(t)
+	second := streams[1].receive(t)
+	require.Equal(t, true, len(first) > 0)
+	assert.Equal(t, &first[0], &second[0], "Expected subscribers to share the encoded payload")
+	info := &orchestrator.EpochInfo{}
+	require.NoError(t, info.UnmarshalBinary(first))
+	assert.Equal(t, types.Epoch(1), info.Epoch)
+
+	// Streams still open when the server stops end with a shutting down status.
+	cancel()
+	for range streams {
+		err := <-errs
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, true, grpcutils.IsShuttingDown(err))
+	}
+}
+
+func TestServer_StreamEpochInfo_ResumeReplaysMissedEpochs(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for epoch := types.Epoch(0); epoch <= 2; epoch++ {
+		require.NoError(t, db.SaveEpochInfo(ctx, &orchestrator.EpochInfo{
+			Epoch:     epoch,
+			Proposers: [][48]byte{{byte(epoch)}},
+		}))
+	}
+	currentSlot := params.BeaconConfig().SlotsPerEpoch * 2
+	bs := &Server{
+		Ctx:                ctx,
+		BeaconDB:           db,
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		StateNotifier:      &mock.MockStateNotifier{},
+		EpochInfoStore:     db,
+	}
+
+	streamCtx, cancelStream := context.WithCancel(ctx)
+	stream := &epochInfoTestStream{ctx: streamCtx, payloads: make(chan []byte, 4)}
+	errs := make(chan error, 1)
+	resumeFrom := types.Epoch(0)
+	go func() {
+		errs <- bs.streamEpochInfo(&pbrpc.StreamEpochInfoRequest{Resume: &pbrpc.StreamEpochInfoRequest_ResumeFromEpoch{ResumeFromEpoch: resumeFrom}}, stream)
+	}()
+
+	for _, wanted := range []types.Epoch{1, 2} {
+		info := &orchestrator.EpochInfo{}
+		require.NoError(t, info.UnmarshalBinary(stream.receive(t)))
+		assert.Equal(t, wanted, info.Epoch)
+		assert.Equal(t, [48]byte{byte(wanted)}, info.Proposers[0])
+	}
+	cancelStream()
+	assert.ErrorContains(t, "canceled", <-errs)
+	assert.Equal(t, 0, len(stream.payloads), "Expected no epoch to be sent twice")
+
+	future := types.Epoch(5)
+	err := bs.streamEpochInfo(&pbrpc.StreamEpochInfoRequest{Resume: &pbrpc.StreamEpochInfoRequest_ResumeFromEpoch{ResumeFromEpoch: future}}, stream)
+	assert.ErrorContains(t, "Cannot retrieve information about an epoch in the future", err)
+}
+
+type epochInfoAckTestStream struct {
+	*epochInfoTestStream
+	acks chan types.Epoch
+}
+
+func (s *epochInfoAckTestStream) RecvAck() (types.Epoch, error) {
+	select {
+	case epoch := <-s.acks:
+		return epoch, nil
+	case <-s.ctx.Done():
+		return 0, s.ctx.Err()
+	}
+}
+
+func TestServer_StreamEpochInfo_SubscriberResumesFromCursor(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for epoch := types.Epoch(0); epoch <= 3; epoch++ {
+		require.NoError(t, db.SaveEpochInfo(ctx, &orchestrator.EpochInfo{
+			Epoch:     epoch,
+			Proposers: [][48]byte{{byte(epoch)}},
+		}))
+	}
+	currentSlot := params.BeaconConfig().SlotsPerEpoch * 3
+	bs := &Server{
+		Ctx:                ctx,
+		BeaconDB:           db,
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		StateNotifier:      &mock.MockStateNotifier{},
+		EpochInfoStore:     db,
+		StreamCursorStore:  db,
+	}
+
+	// Without a cursor, the subscriber starts from the requested epoch.
+	streamCtx, cancelStream := context.WithCancel(ctx)
+	stream := &epochInfoAckTestStream{
+		epochInfoTestStream: &epochInfoTestStream{ctx: streamCtx, payloads: make(chan []byte, 4)},
+		acks:                make(chan types.Epoch, 4),
+	}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- bs.streamEpochInfo(&pbrpc.StreamEpochInfoRequest{Subscriber: "orchestrator"}, stream)
+	}()
+	for _, wanted := range []types.Epoch{0, 1, 2, 3} {
+		info := &orchestrator.EpochInfo{}
+		require.NoError(t, info.UnmarshalBinary(stream.receive(t)))
+		assert.Equal(t, wanted, info.Epoch)
+	}
+	// Acknowledgements are processed in order, and those of future epochs are ignored.
+	stream.acks <- 10
+	stream.acks <- 1
+	require.NoError(t, waitForStreamCursor(ctx, db, "orchestrator", 1))
+	cancelStream()
+	assert.ErrorContains(t, "canceled", <-errs)
+	cursor, _, err := db.StreamCursor(ctx, "orchestrator")
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(1), cursor)
+
+	// Reconnecting without a resume token replays the epochs after the cursor.
+	streamCtx, cancelStream = context.WithCancel(ctx)
+	defer cancelStream()
+	stream = &epochInfoAckTestStream{
+		epochInfoTestStream: &epochInfoTestStream{ctx: streamCtx, payloads: make(chan []byte, 4)},
+		acks:                make(chan types.Epoch, 4),
+	}
+	go func() {
+		errs <- bs.streamEpochInfo(&pbrpc.StreamEpochInfoRequest{Subscriber: "orchestrator"}, stream)
+	}()
+	for _, wanted := range []types.Epoch{2, 3} {
+		info := &orchestrator.EpochInfo{}
+		require.NoError(t, info.UnmarshalBinary(stream.receive(t)))
+		assert.Equal(t, wanted, info.Epoch)
+	}
+	cancelStream()
+	assert.ErrorContains(t, "canceled", <-errs)
+	assert.Equal(t, 0, len(stream.payloads), "Expected no epoch to be sent twice")
+
+	// A resume token overrides the cursor. The acknowledgements of the previous stream may still
+	// be read, so the stream is not reused.
+	streamCtx, cancelStream = context.WithCancel(ctx)
+	defer cancelStream()
+	stream = &epochInfoAckTestStream{
+		epochInfoTestStream: &epochInfoTestStream{ctx: streamCtx, payloads: make(chan []byte, 4)},
+		acks:                make(chan types.Epoch, 4),
+	}
+	resumeFrom := types.Epoch(2)
+	go func() {
+		errs <- bs.streamEpochInfo(&pbrpc.StreamEpochInfoRequest{Subscriber: "orchestrator", Resume: &pbrpc.StreamEpochInfoRequest_ResumeFromEpoch{ResumeFromEpoch: resumeFrom}}, stream)
+	}()
+	info := &orchestrator.EpochInfo{}
+	require.NoError(t, info.UnmarshalBinary(stream.receive(t)))
+	assert.Equal(t, types.Epoch(3), info.Epoch)
+	cancelStream()
+	assert.ErrorContains(t, "canceled", <-errs)
+}
+
+// waitForStreamCursor waits until the stream cursor of the subscriber reaches the epoch.
+func waitForStreamCursor(ctx context.Context, store orchestrator.StreamCursorStore, subscriber string, epoch types.Epoch) error {
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		cursor, ok, err := store.StreamCursor(ctx, subscriber)
+		if err != nil {
+			return err
+		}
+		if ok && cursor >= epoch {
+			return nil
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return errors.New("timed out waiting for stream cursor")
+}
+
+func TestServer_LoadEpochInfo_PersistsFinalizedEpochs(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	s := setupActiveValidators(t, 64)
+	blk := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, blk))
+	blockRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, s, blockRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, blockRoot))
+
+	bs := &Server{
+		BeaconDB:            db,
+		GenesisTimeFetcher:  &mock.ChainService{Genesis: time.Unix(1600000000, 0)},
+		FinalizationFetcher: &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 0}},
+		StateGen:            stategen.New(db),
+		EpochInfoStore:      db,
+	}
+
+	computed, err := bs.loadEpochInfo(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, false, computed.Provisional)
+	persisted, err := db.EpochInfo(ctx, 0)
+	require.NoError(t, err)
+	assert.DeepEqual(t, computed, persisted)
+
+	// Epoch infos of epochs which are not finalized may still change, are marked provisional and
+	// are not persisted.
+	computed, err = bs.loadEpochInfo(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, true, computed.Provisional)
+	persisted, err = db.EpochInfo(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, (*orchestrator.EpochInfo)(nil), persisted)
+}
+
+func TestServer_ComputeEpochInfo_LookbackLimit(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	s := setupActiveValidators(t, 64)
+	blk := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, blk))
+	blockRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, s, blockRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, blockRoot))
+
+	currentSlot := params.BeaconConfig().SlotsPerEpoch * 5
+	bs := &Server{
+		BeaconDB:             db,
+		GenesisTimeFetcher:   &mock.ChainService{Genesis: time.Unix(1600000000, 0), Slot: &currentSlot},
+		StateGen:             stategen.New(db),
+		MaxEpochInfoLookback: 2,
+	}
+
+	_, err = bs.computeEpochInfo(ctx, 2)
+	require.ErrorContains(t, "Epoch 2 is older than the oldest available epoch 3", err)
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+	details, ok := grpcutils.EpochOutOfRangeFromError(err)
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, &grpcutils.EpochOutOfRange{Current: 5, Requested: 2, OldestAvailable: 3}, details)
+
+	info, err := bs.computeEpochInfo(ctx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(3), info.Epoch)
+
+	// Without a limit, every epoch is regenerated from the state at its start slot.
+	bs.MaxEpochInfoLookback = 0
+	info, err = bs.computeEpochInfo(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(2), info.Epoch)
+}
+
+func TestServer_ComputeEpochInfo_Lookahead(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	ctx := context.Background()
+
+	s := setupActiveValidators(t, 64)
+	currentSlot := types.Slot(0)
+	bs := &Server{
+		GenesisTimeFetcher:     &mock.ChainService{Genesis: time.Unix(1600000000, 0), Slot: &currentSlot},
+		HeadFetcher:            &mock.ChainService{State: s},
+		DutyBroadcastLookahead: 4,
+	}
+	// Proposers are not determined beyond the seed lookahead.
+	assert.Equal(t, params.BeaconConfig().MinSeedLookahead, bs.dutyBroadcastLookahead())
+
+	// The lookahead epoch is derived from the head state, without regenerating a state.
+	info, err := bs.computeEpochInfo(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(1), info.Epoch)
+	st, err := state.ProcessSlots(ctx, nil, s.Copy(), params.BeaconConfig().SlotsPerEpoch)
+	require.NoError(t, err)
+	for i := range info.Proposers {
+		require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch+types.Slot(i)))
+		proposer, err := helpers.BeaconProposerIndex(nil, st)
+		require.NoError(t, err)
+		assert.Equal(t, st.PubkeyAtIndex(proposer), info.Proposers[i])
+	}
+	assert.Equal(t, types.Slot(0), s.Slot(), "The head state should not be modified")
+}
+
+func TestServer_FlushEpochInfos(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+	bs := &Server{
+		FinalizationFetcher: &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 1}},
+		EpochInfoStore:      db,
+	}
+	// Nothing is flushed before a stream started the hub.
+	require.NoError(t, bs.FlushEpochInfos(ctx))
+
+	hub := newEpochInfoHub(func(_ context.Context, epoch types.Epoch) (*orchestrator.EpochInfo, error) {
+		return &orchestrator.EpochInfo{Epoch: epoch, Proposers: [][48]byte{{byte(epoch)}}}, nil
+	})
+	bs = &Server{
+		FinalizationFetcher: &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 1}},
+		EpochInfoStore:      db,
+	}
+	bs.epochInfoHub.once.Do(func() {
+		bs.epochInfoHub.hub = hub
+	})
+	for epoch := types.Epoch(0); epoch <= 2; epoch++ {
+		_, err := hub.epochInfo(ctx, epoch)
+		require.NoError(t, err)
+	}
+	require.NoError(t, bs.FlushEpochInfos(ctx))
+
+	for epoch := types.Epoch(0); epoch <= 1; epoch++ {
+		persisted, err := db.EpochInfo(ctx, epoch)
+		require.NoError(t, err)
+		require.NotNil(t, persisted)
+		assert.Equal(t, [48]byte{byte(epoch)}, persisted.Proposers[0])
+	}
+	// Epoch infos of epochs which are not finalized may still change and are not flushed.
+	persisted, err := db.EpochInfo(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, (*orchestrator.EpochInfo)(nil), persisted)
+}
+
+func TestServer_GetEpochInfoAccumulator(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+	bs := &Server{EpochInfoStore: db}
+
+	_, err := bs.GetEpochInfoAccumulator(ctx, &pbrpc.EpochInfoAccumulatorRequest{})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	infos := []*orchestrator.EpochInfo{
+		{Epoch: 7, Proposers: [][48]byte{{'a'}}},
+		{Epoch: 8, Proposers: [][48]byte{{'b'}}},
+	}
+	for _, info := range infos {
+		require.NoError(t, db.SaveEpochInfo(ctx, info))
+	}
+	head, err := bs.GetEpochInfoAccumulator(ctx, &pbrpc.EpochInfoAccumulatorRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(8), head.Epoch)
+	assert.NoError(t, orchestrator.VerifyEpochInfoRange([32]byte{}, infos, bytesutil.ToBytes32(head.Root)))
+
+	acc, err := bs.GetEpochInfoAccumulator(ctx, &pbrpc.EpochInfoAccumulatorRequest{
+		QueryFilter: &pbrpc.EpochInfoAccumulatorRequest_Epoch{Epoch: 7},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(7), acc.Epoch)
+	assert.NoError(t, orchestrator.VerifyEpochInfoRange(bytesutil.ToBytes32(acc.Root), infos[1:], bytesutil.ToBytes32(head.Root)))
+
+	_, err = bs.GetEpochInfoAccumulator(ctx, &pbrpc.EpochInfoAccumulatorRequest{
+		QueryFilter: &pbrpc.EpochInfoAccumulatorRequest_Epoch{Epoch: 9},
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = (&Server{}).GetEpochInfoAccumulator(ctx, &pbrpc.EpochInfoAccumulatorRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
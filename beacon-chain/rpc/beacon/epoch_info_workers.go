@@ -0,0 +1,141 @@
+package beacon
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// epochInfoRetries is the number of times the computation of an epoch info of a range is
+	// retried before the epoch is reported as a gap.
+	epochInfoRetries = 2
+	// epochInfoRetryBackoff is the delay before the first retry, doubled before every further one.
+	epochInfoRetryBackoff = 100 * time.Millisecond
+)
+
+// epochInfoResult is the epoch info of an epoch computed by a worker, or the error computing it.
+type epochInfoResult struct {
+	epoch types.Epoch
+	info  *encodedEpochInfo
+	err   error
+}
+
+// epochInfoWorkers returns the number of epoch infos of a range computed concurrently.
+func (bs *Server) epochInfoWorkers() int {
+	if bs.EpochInfoWorkers < 1 {
+		return 1
+	}
+	return bs.EpochInfoWorkers
+}
+
+// forEachEpochInfo computes the epoch infos of the epochs from fromEpoch to toEpoch with up to
+// EpochInfoWorkers concurrent computations, and calls f with the epoch info or the computation
+// error of every epoch in ascending epoch order. At most EpochInfoWorkers epochs are queued ahead
+// of f. It stops once f returns an error, which it returns, or once the context is done.
+func (bs *Server) forEachEpochInfo(
+	ctx context.Context,
+	fromEpoch, toEpoch types.Epoch,
+	f func(epoch types.Epoch, info *encodedEpochInfo, err error) error,
+) error {
+	return bs.forEachShardEpochInfo(ctx, fromEpoch, toEpoch, nil, f)
+}
+
+// forEachShardEpochInfo is forEachEpochInfo restricted to the epochs of a shard, whose epoch infos
+// are neither computed nor passed to f. A nil shard includes every epoch.
+func (bs *Server) forEachShardEpochInfo(
+	ctx context.Context,
+	fromEpoch, toEpoch types.Epoch,
+	shard *orchestrator.EpochShard,
+	f func(epoch types.Epoch, info *encodedEpochInfo, err error) error,
+) error {
+	if fromEpoch > toEpoch {
+		return nil
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	hub := bs.epochInfoHubInstance()
+	workers := bs.epochInfoWorkers()
+
+	// Results are queued in epoch order as their computation starts, so that they are consumed in
+	// order whichever computation completes first.
+	pending := make(chan chan epochInfoResult, workers)
+	running := make(chan struct{}, workers)
+	go func() {
+		defer close(pending)
+		for epoch := fromEpoch; ; epoch++ {
+			if shard.Includes(epoch) {
+				select {
+				case running <- struct{}{}:
+				case <-ctx.Done():
+					return
+				}
+				result := make(chan epochInfoResult, 1)
+				select {
+				case pending <- result:
+				case <-ctx.Done():
+					<-running
+					return
+				}
+				go func(epoch types.Epoch) {
+					info, err := epochInfoWithRetry(ctx, hub, epoch)
+					<-running
+					result <- epochInfoResult{epoch: epoch, info: info, err: err}
+				}(epoch)
+			}
+			// Checked here rather than in the loop condition, which would overflow at the maximum epoch.
+			if epoch == toEpoch {
+				return
+			}
+		}
+	}()
+
+	for result := range pending {
+		select {
+		case r := <-result:
+			if err := f(r.epoch, r.info, r.err); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+	return nil
+}
+
+// epochInfoWithRetry computes the epoch info of an epoch of a range, retrying recoverable failures
+// with an exponential backoff. Failed computations are not cached by the hub, so every retry
+// computes the epoch info again.
+func epochInfoWithRetry(ctx context.Context, hub *epochInfoHub, epoch types.Epoch) (*encodedEpochInfo, error) {
+	backoff := epochInfoRetryBackoff
+	for attempt := 0; ; attempt++ {
+		info, err := hub.epochInfo(ctx, epoch)
+		if err == nil || attempt == epochInfoRetries || !recoverableEpochInfoError(err) {
+			return info, err
+		}
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return nil, err
+		}
+		backoff *= 2
+	}
+}
+
+// recoverableEpochInfoError returns true if the failed computation of an epoch info may succeed
+// when retried, or does not prevent computing the epoch infos of the following epochs, such as a
+// missing state. Out of range epochs and ended calls are not recoverable.
+func recoverableEpochInfoError(err error) bool {
+	if _, ok := grpcutils.EpochOutOfRangeFromError(err); ok {
+		return false
+	}
+	return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+}
@@ -0,0 +1,252 @@
+package beacon
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// serverWithEpochInfoHub returns a server whose epoch info hub computes epoch infos with load.
+func serverWithEpochInfoHub(workers int, load func(context.Context, types.Epoch) (*orchestrator.EpochInfo, error)) *Server {
+	bs := &Server{EpochInfoWorkers: workers}
+	bs.epochInfoHub.once.Do(func() {
+		bs.epochInfoHub.hub = newEpochInfoHub(load)
+	})
+	return bs
+}
+
+func TestServer_ForEachEpochInfo_ConcurrentInOrder(t *testing.T) {
+	var running, maxRunning int32
+	bs := serverWithEpochInfoHub(3, func(_ context.Context, epoch types.Epoch) (*orchestrator.EpochInfo, error) {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+		// Later epochs complete first.
+		time.Sleep(time.Duration(10-epoch) * 2 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return &orchestrator.EpochInfo{Epoch: epoch}, nil
+	})
+
+	var epochs []types.Epoch
+	err := bs.forEachEpochInfo(context.Background(), 0, 9, func(epoch types.Epoch, info *encodedEpochInfo, err error) error {
+		require.NoError(t, err)
+		assert.Equal(t, epoch, info.epoch)
+		epochs = append(epochs, epoch)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []types.Epoch{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, epochs)
+	assert.Equal(t, true, atomic.LoadInt32(&maxRunning) > 1, "Epoch infos were not computed concurrently")
+	assert.Equal(t, true, atomic.LoadInt32(&maxRunning) <= 3, "More epoch infos computed concurrently than workers")
+}
+
+func TestServer_ForEachEpochInfo_StopsAtError(t *testing.T) {
+	bs := serverWithEpochInfoHub(4, func(_ context.Context, epoch types.Epoch) (*orchestrator.EpochInfo, error) {
+		if epoch == 4 {
+			return nil, errors.New("state unavailable")
+		}
+		return &orchestrator.EpochInfo{Epoch: epoch}, nil
+	})
+
+	var epochs []types.Epoch
+	err := bs.forEachEpochInfo(context.Background(), 2, 20, func(epoch types.Epoch, info *encodedEpochInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		epochs = append(epochs, epoch)
+		return nil
+	})
+	assert.ErrorContains(t, "state unavailable", err)
+	assert.DeepEqual(t, []types.Epoch{2, 3}, epochs)
+}
+
+func TestServer_ForEachEpochInfo_ContextTimeout(t *testing.T) {
+	// The computations of the later epochs take longer than the call may.
+	stalled := make(chan struct{})
+	defer close(stalled)
+	bs := serverWithEpochInfoHub(2, func(_ context.Context, epoch types.Epoch) (*orchestrator.EpochInfo, error) {
+		if epoch > 0 {
+			<-stalled
+		}
+		return &orchestrator.EpochInfo{Epoch: epoch}, nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	var epochs []types.Epoch
+	err := bs.forEachEpochInfo(ctx, 0, 1000, func(epoch types.Epoch, _ *encodedEpochInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		epochs = append(epochs, epoch)
+		return nil
+	})
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	assert.DeepEqual(t, []types.Epoch{0}, epochs)
+}
+
+func TestServer_ForEachShardEpochInfo_SkipsOtherEpochs(t *testing.T) {
+	var computed int32
+	bs := serverWithEpochInfoHub(2, func(_ context.Context, epoch types.Epoch) (*orchestrator.EpochInfo, error) {
+		atomic.AddInt32(&computed, 1)
+		return &orchestrator.EpochInfo{Epoch: epoch}, nil
+	})
+
+	var epochs []types.Epoch
+	shard := &orchestrator.EpochShard{Modulus: 3, Remainder: 1}
+	err := bs.forEachShardEpochInfo(context.Background(), 0, 9, shard, func(epoch types.Epoch, info *encodedEpochInfo, err error) error {
+		require.NoError(t, err)
+		assert.Equal(t, epoch, info.epoch)
+		epochs = append(epochs, epoch)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []types.Epoch{1, 4, 7}, epochs)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&computed), "Epoch infos of other shards were computed")
+}
+
+func TestServer_StreamEpochInfo_EpochShard(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	bs := serverWithEpochInfoHub(2, func(_ context.Context, epoch types.Epoch) (*orchestrator.EpochInfo, error) {
+		return &orchestrator.EpochInfo{Epoch: epoch}, nil
+	})
+	currentSlot := params.BeaconConfig().SlotsPerEpoch * 5
+	bs.Ctx = ctx
+	bs.GenesisTimeFetcher = &mock.ChainService{Slot: &currentSlot}
+
+	shard := &orchestrator.EpochShard{Modulus: 2, Remainder: 0}
+	streamCtx, cancelStream := context.WithCancel(metadata.NewIncomingContext(ctx, metadata.Pairs(orchestrator.EpochShardMetadataKey, shard.String())))
+	stream := &epochInfoTestStream{ctx: streamCtx, payloads: make(chan []byte, 8)}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- bs.streamEpochInfo(&pbrpc.StreamEpochInfoRequest{FromEpoch: 1}, stream)
+	}()
+	for _, wanted := range []types.Epoch{2, 4} {
+		info := &orchestrator.EpochInfo{}
+		require.NoError(t, info.UnmarshalBinary(stream.receive(t)))
+		assert.Equal(t, wanted, info.Epoch)
+	}
+	cancelStream()
+	assert.ErrorContains(t, "canceled", <-errs)
+	assert.Equal(t, 0, len(stream.payloads), "Expected no epoch of another shard to be sent")
+
+	invalidCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(orchestrator.EpochShardMetadataKey, "2/2"))
+	err := bs.streamEpochInfo(&pbrpc.StreamEpochInfoRequest{}, &epochInfoTestStream{ctx: invalidCtx})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// gappedEpochInfoServer returns a server at epoch 4 whose state of epoch 2 is missing, and whose
+// first computation of the epoch info of epoch 1 fails.
+func gappedEpochInfoServer(ctx context.Context) (*Server, *int32) {
+	var epoch1Attempts int32
+	bs := serverWithEpochInfoHub(2, func(_ context.Context, epoch types.Epoch) (*orchestrator.EpochInfo, error) {
+		switch {
+		case epoch == 1 && atomic.AddInt32(&epoch1Attempts, 1) == 1:
+			return nil, errors.New("state regeneration interrupted")
+		case epoch == 2:
+			return nil, errors.New("no state available at slot 64")
+		}
+		return &orchestrator.EpochInfo{Epoch: epoch}, nil
+	})
+	currentSlot := params.BeaconConfig().SlotsPerEpoch * 4
+	bs.Ctx = ctx
+	bs.GenesisTimeFetcher = &mock.ChainService{Slot: &currentSlot}
+	return bs, &epoch1Attempts
+}
+
+func TestServer_StreamEpochInfo_EndsAtGap(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	bs, epoch1Attempts := gappedEpochInfoServer(ctx)
+
+	stream := &epochInfoTestStream{ctx: ctx, payloads: make(chan []byte, 8)}
+	err := bs.streamEpochInfo(&pbrpc.StreamEpochInfoRequest{}, stream)
+	gap, ok := grpcutils.EpochGapFromError(err)
+	require.Equal(t, true, ok, "Expected a gap error, got %v", err)
+	assert.DeepEqual(t, &grpcutils.EpochGap{Epoch: 2, Reason: "no state available at slot 64"}, gap)
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	// The epochs before the gap were sent, the failure of epoch 1 being retried.
+	for _, wanted := range []types.Epoch{0, 1} {
+		info := &orchestrator.EpochInfo{}
+		require.NoError(t, info.UnmarshalBinary(stream.receive(t)))
+		assert.Equal(t, wanted, info.Epoch)
+	}
+	assert.Equal(t, 0, len(stream.payloads))
+	assert.Equal(t, int32(2), atomic.LoadInt32(epoch1Attempts))
+}
+
+func TestServer_StreamEpochInfo_SkipsAllowedGaps(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	bs, _ := gappedEpochInfoServer(ctx)
+
+	streamCtx, cancelStream := context.WithCancel(ctx)
+	stream := &epochInfoTestStream{ctx: streamCtx, payloads: make(chan []byte, 8)}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- bs.streamEpochInfo(&pbrpc.StreamEpochInfoRequest{AllowGaps: true}, stream)
+	}()
+	for _, wanted := range []types.Epoch{0, 1, 3, 4} {
+		info := &orchestrator.EpochInfo{}
+		require.NoError(t, info.UnmarshalBinary(stream.receive(t)))
+		assert.Equal(t, wanted, info.Epoch)
+	}
+	cancelStream()
+	assert.ErrorContains(t, "canceled", <-errs)
+}
+
+func TestServer_PrefetchEpochInfo_ReportsGaps(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for _, allowGaps := range []bool{false, true} {
+		bs, _ := gappedEpochInfoServer(ctx)
+		id, ok := bs.epochInfoPrefetches.start(0, 4)
+		require.Equal(t, true, ok)
+		bs.prefetchEpochInfo(ctx, id, 0, 4, allowGaps)
+
+		job, ok := bs.epochInfoPrefetches.status(id)
+		require.Equal(t, true, ok)
+		assert.Equal(t, true, job.Done)
+		assert.DeepEqual(t, []*pbrpc.EpochGap{{Epoch: 2, Reason: "no state available at slot 64"}}, job.Gaps)
+		if allowGaps {
+			assert.Equal(t, uint64(4), job.Completed)
+			assert.Equal(t, "", job.Error)
+		} else {
+			assert.Equal(t, uint64(2), job.Completed)
+			assert.Equal(t, "no state available at slot 64", job.Error)
+		}
+	}
+}
+
+func TestRecoverableEpochInfoError(t *testing.T) {
+	assert.Equal(t, true, recoverableEpochInfoError(errors.New("no state available at slot 64")))
+	assert.Equal(t, false, recoverableEpochInfoError(context.Canceled))
+	assert.Equal(t, false, recoverableEpochInfoError(grpcutils.EpochOutOfRangeError(
+		codes.OutOfRange,
+		&grpcutils.EpochOutOfRange{Current: 2000, Requested: 1, OldestAvailable: 976},
+		"Epoch %d is older than the oldest available epoch %d",
+		1,
+		976,
+	)))
+}
@@ -0,0 +1,151 @@
+package beacon
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetCurrentEpochParticipation reports, for every committee of the slots of the current epoch
+// up to the current slot, the share of members whose attestation was already seen, either in the
+// attestation pool or included on the chain of the head. Operators see a degrading participation
+// before the epoch ends, while the participation of GetValidatorParticipation is only known once
+// the epoch was processed.
+func (bs *Server) GetCurrentEpochParticipation(ctx context.Context, _ *empty.Empty) (*pbrpc.CurrentEpochParticipation, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconChainServer.GetCurrentEpochParticipation")
+	defer span.End()
+
+	currentSlot := bs.GenesisTimeFetcher.CurrentSlot()
+	epoch := helpers.SlotToEpoch(currentSlot)
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get start slot of epoch %d: %v", epoch, err)
+	}
+	headState, err := bs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil {
+		return nil, status.Error(codes.Internal, "Head state is not available")
+	}
+	st := headState
+	switch headEpoch := helpers.SlotToEpoch(headState.Slot()); {
+	case headEpoch > epoch:
+		return nil, status.Errorf(codes.Internal, "Head state at slot %d is ahead of the current slot %d", headState.Slot(), currentSlot)
+	case headEpoch < epoch:
+		// No block of the epoch was processed yet, so none of its attestations were included.
+		st, err = state.ProcessSlots(ctx, bs.CommitteeCache, headState.Copy(), startSlot)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not process slots up to %d: %v", startSlot, err)
+		}
+	}
+	included, err := st.CurrentEpochAttestations()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get current epoch attestations: %v", err)
+	}
+	activeCount, err := helpers.ActiveValidatorCount(bs.CommitteeCache, st, epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get active validator count: %v", err)
+	}
+	committeeCount := helpers.SlotCommitteeCount(activeCount)
+
+	// Committee members are identified by their position, which is their aggregation bit.
+	type committeeKey struct {
+		slot  types.Slot
+		index types.CommitteeIndex
+	}
+	seen := make(map[committeeKey]map[uint64]bool)
+	includedSeen := make(map[committeeKey]map[uint64]bool)
+	mark := func(dst map[committeeKey]map[uint64]bool, data *ethpb.AttestationData, bits bitfield.Bitlist) {
+		if data == nil || data.Slot < startSlot || data.Slot > currentSlot {
+			return
+		}
+		key := committeeKey{slot: data.Slot, index: data.CommitteeIndex}
+		if dst[key] == nil {
+			dst[key] = make(map[uint64]bool)
+		}
+		for _, i := range bits.BitIndices() {
+			dst[key][uint64(i)] = true
+		}
+	}
+	for _, att := range included {
+		mark(seen, att.Data, att.AggregationBits)
+		mark(includedSeen, att.Data, att.AggregationBits)
+	}
+	unaggregated, err := bs.AttestationsPool.UnaggregatedAttestations()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get unaggregated attestations: %v", err)
+	}
+	pooled := append(unaggregated, bs.AttestationsPool.AggregatedAttestations()...)
+	pooled = append(pooled, bs.AttestationsPool.ForkchoiceAttestations()...)
+	for _, att := range pooled {
+		if att != nil {
+			mark(seen, att.Data, att.AggregationBits)
+		}
+	}
+
+	// Aggregation bits beyond the committee size are malformed and not counted.
+	count := func(members map[uint64]bool, size uint64) uint64 {
+		var n uint64
+		for i := range members {
+			if i < size {
+				n++
+			}
+		}
+		return n
+	}
+	res := &pbrpc.CurrentEpochParticipation{Epoch: epoch, CurrentSlot: currentSlot}
+	for slot := startSlot; slot <= currentSlot; slot++ {
+		if ctx.Err() != nil {
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+		sp := &pbrpc.SlotCommitteeParticipation{
+			Slot:       slot,
+			Committees: make([]*pbrpc.CommitteeParticipation, 0, committeeCount),
+		}
+		for i := uint64(0); i < committeeCount; i++ {
+			index := types.CommitteeIndex(i)
+			committee, err := helpers.BeaconCommitteeFromState(bs.CommitteeCache, st, slot, index)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not get committee %d of slot %d: %v", index, slot, err)
+			}
+			key := committeeKey{slot: slot, index: index}
+			size := uint64(len(committee))
+			cp := &pbrpc.CommitteeParticipation{
+				CommitteeIndex: index,
+				Expected:       size,
+				Seen:           count(seen[key], size),
+				Included:       count(includedSeen[key], size),
+			}
+			cp.Rate = participationRate(cp.Seen, cp.Expected)
+			sp.Committees = append(sp.Committees, cp)
+			sp.Expected += cp.Expected
+			sp.Seen += cp.Seen
+			sp.Included += cp.Included
+		}
+		sp.Rate = participationRate(sp.Seen, sp.Expected)
+		res.Slots = append(res.Slots, sp)
+		res.Expected += sp.Expected
+		res.Seen += sp.Seen
+		res.Included += sp.Included
+	}
+	res.Rate = participationRate(res.Seen, res.Expected)
+	return res, nil
+}
+
+// participationRate returns seen / expected, zero if nothing is expected.
+func participationRate(seen, expected uint64) float64 {
+	if expected == 0 {
+		return 0
+	}
+	return float64(seen) / float64(expected)
+}
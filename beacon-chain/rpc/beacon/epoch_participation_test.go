@@ -0,0 +1,92 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetCurrentEpochParticipation(t *testing.T) {
+	ctx := context.Background()
+	// Four members of the committee of slot 2 had their attestations included.
+	st, _ := participationState(t)
+	pooled := func(slot types.Slot, positions ...uint64) *ethpb.Attestation {
+		att := testutil.NewAttestation()
+		att.Data.Slot = slot
+		att.AggregationBits = bitfield.NewBitlist(8)
+		for _, i := range positions {
+			att.AggregationBits.SetBitAt(i, true)
+		}
+		return att
+	}
+	pool := attestations.NewPool()
+	require.NoError(t, pool.SaveUnaggregatedAttestations([]*ethpb.Attestation{pooled(2, 3), pooled(2, 4)}))
+	require.NoError(t, pool.SaveAggregatedAttestation(pooled(4, 0, 1, 2, 3, 4, 5, 6, 7)))
+	// Attestations of slots after the current slot are not counted.
+	require.NoError(t, pool.SaveUnaggregatedAttestation(pooled(7, 0)))
+
+	currentSlot := types.Slot(5)
+	bs := &Server{
+		HeadFetcher:        &mock.ChainService{State: st},
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		AttestationsPool:   pool,
+	}
+	res, err := bs.GetCurrentEpochParticipation(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(0), res.Epoch)
+	require.Equal(t, 6, len(res.Slots))
+	assert.Equal(t, uint64(48), res.Expected)
+	assert.Equal(t, uint64(13), res.Seen)
+	assert.Equal(t, uint64(4), res.Included)
+
+	slot2 := res.Slots[2]
+	assert.Equal(t, types.Slot(2), slot2.Slot)
+	require.Equal(t, 1, len(slot2.Committees))
+	assert.Equal(t, uint64(8), slot2.Committees[0].Expected)
+	assert.Equal(t, uint64(5), slot2.Committees[0].Seen, "Expected pooled and included attestations to be merged")
+	assert.Equal(t, uint64(4), slot2.Committees[0].Included)
+	assert.Equal(t, 0.625, slot2.Rate)
+	assert.Equal(t, 1.0, res.Slots[4].Rate)
+	assert.Equal(t, uint64(0), res.Slots[4].Included)
+	assert.Equal(t, 0.0, res.Slots[5].Rate)
+}
+
+func TestServer_GetCurrentEpochParticipation_HeadInPreviousEpoch(t *testing.T) {
+	ctx := context.Background()
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	st, _ := testutil.DeterministicGenesisState(t, 256)
+	require.NoError(t, st.SetSlot(5))
+	currentSlot := params.BeaconConfig().SlotsPerEpoch + 1
+	att := testutil.NewAttestation()
+	att.Data.Slot = params.BeaconConfig().SlotsPerEpoch
+	att.AggregationBits = bitfield.NewBitlist(8)
+	att.AggregationBits.SetBitAt(0, true)
+	pool := attestations.NewPool()
+	require.NoError(t, pool.SaveUnaggregatedAttestation(att))
+
+	bs := &Server{
+		HeadFetcher:        &mock.ChainService{State: st},
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		AttestationsPool:   pool,
+	}
+	res, err := bs.GetCurrentEpochParticipation(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(1), res.Epoch)
+	require.Equal(t, 2, len(res.Slots))
+	assert.Equal(t, uint64(16), res.Expected)
+	assert.Equal(t, uint64(0), res.Included)
+	assert.Equal(t, uint64(1), res.Seen)
+	assert.Equal(t, types.Slot(5), st.Slot(), "Expected the head state to be left untouched")
+}
@@ -0,0 +1,84 @@
+package beacon
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/summary"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxEpochSummariesEpochs bounds the number of epochs a single epoch summaries request may cover.
+// The summaries of the range are read in a single pass over the archive.
+const maxEpochSummariesEpochs = types.Epoch(1024)
+
+// GetEpochSummary returns the summary archived when the epoch was finalized.
+func (bs *Server) GetEpochSummary(ctx context.Context, req *pbrpc.GetEpochSummaryRequest) (*pbrpc.EpochSummary, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconChainServer.GetEpochSummary")
+	defer span.End()
+
+	currentEpoch := helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
+	if req.Epoch > currentEpoch {
+		return nil, futureEpochError(currentEpoch, req.Epoch)
+	}
+	sum, err := bs.BeaconDB.EpochSummary(ctx, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve epoch summary for epoch %d: %v", req.Epoch, err)
+	}
+	if sum == nil {
+		return nil, status.Errorf(codes.NotFound, "No epoch summary archived for epoch %d", req.Epoch)
+	}
+	return epochSummary(sum), nil
+}
+
+// ListEpochSummaries returns the summaries archived at finalization for an epoch range. Epochs
+// which are not finalized yet, or which were skipped when finalization advanced by several
+// epochs at once, are left out of the response.
+func (bs *Server) ListEpochSummaries(ctx context.Context, req *pbrpc.ListEpochSummariesRequest) (*pbrpc.EpochSummaries, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconChainServer.ListEpochSummaries")
+	defer span.End()
+
+	currentEpoch := helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
+	if req.ToEpoch > currentEpoch {
+		return nil, futureEpochError(currentEpoch, req.ToEpoch)
+	}
+	if req.FromEpoch > req.ToEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "From epoch %d is after to epoch %d", req.FromEpoch, req.ToEpoch)
+	}
+	if req.ToEpoch-req.FromEpoch >= maxEpochSummariesEpochs {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested epoch range exceeds the maximum of %d epochs", maxEpochSummariesEpochs)
+	}
+
+	sums, err := bs.BeaconDB.EpochSummaries(ctx, req.FromEpoch, req.ToEpoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve epoch summaries: %v", err)
+	}
+	res := &pbrpc.EpochSummaries{Summaries: make([]*pbrpc.EpochSummary, len(sums))}
+	for i, sum := range sums {
+		res.Summaries[i] = epochSummary(sum)
+	}
+	return res, nil
+}
+
+func epochSummary(sum *summary.EpochSummary) *pbrpc.EpochSummary {
+	return &pbrpc.EpochSummary{
+		Epoch:                  sum.Epoch,
+		ValidatorCount:         sum.ValidatorCount,
+		ActiveValidatorCount:   sum.ActiveValidatorCount,
+		TotalBalance:           sum.TotalBalance,
+		TotalActiveBalance:     sum.TotalActiveBalance,
+		EligibleBalance:        sum.EligibleBalance,
+		TargetAttestingBalance: sum.TargetAttestingBalance,
+		HeadAttestingBalance:   sum.HeadAttestingBalance,
+		ProposerListHash:       bytesutil.SafeCopyBytes(sum.ProposerListHash[:]),
+		JustifiedEpoch:         sum.JustifiedEpoch,
+		JustifiedRoot:          bytesutil.SafeCopyBytes(sum.JustifiedRoot[:]),
+		FinalizedEpoch:         sum.FinalizedEpoch,
+		FinalizedRoot:          bytesutil.SafeCopyBytes(sum.FinalizedRoot[:]),
+	}
+}
@@ -0,0 +1,71 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/summary"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_EpochSummaries(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	// Finalization skipped epoch 2.
+	for _, epoch := range []types.Epoch{1, 3, 4} {
+		require.NoError(t, db.SaveEpochSummary(ctx, &summary.EpochSummary{
+			Epoch:          epoch,
+			ValidatorCount: 64,
+			FinalizedEpoch: epoch - 1,
+		}))
+	}
+	currentSlot := params.BeaconConfig().SlotsPerEpoch * 10
+	bs := &Server{
+		BeaconDB:           db,
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+	}
+
+	sum, err := bs.GetEpochSummary(ctx, &pbrpc.GetEpochSummaryRequest{Epoch: 3})
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(3), sum.Epoch)
+	assert.Equal(t, types.Epoch(2), sum.FinalizedEpoch)
+	_, err = bs.GetEpochSummary(ctx, &pbrpc.GetEpochSummaryRequest{Epoch: 2})
+	assert.ErrorContains(t, "No epoch summary archived for epoch 2", err)
+
+	res, err := bs.ListEpochSummaries(ctx, &pbrpc.ListEpochSummariesRequest{FromEpoch: 0, ToEpoch: 3})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Summaries))
+	assert.Equal(t, types.Epoch(1), res.Summaries[0].Epoch)
+	assert.Equal(t, types.Epoch(3), res.Summaries[1].Epoch)
+
+	res, err = bs.ListEpochSummaries(ctx, &pbrpc.ListEpochSummariesRequest{FromEpoch: 5, ToEpoch: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Summaries))
+}
+
+func TestServer_EpochSummaries_InvalidRequests(t *testing.T) {
+	currentSlot := params.BeaconConfig().SlotsPerEpoch * 10
+	bs := &Server{
+		BeaconDB:           dbTest.SetupDB(t),
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+	}
+	ctx := context.Background()
+
+	_, err := bs.GetEpochSummary(ctx, &pbrpc.GetEpochSummaryRequest{Epoch: 11})
+	assert.ErrorContains(t, "Cannot retrieve information about an epoch in the future", err)
+	_, err = bs.ListEpochSummaries(ctx, &pbrpc.ListEpochSummariesRequest{FromEpoch: 5, ToEpoch: 4})
+	assert.ErrorContains(t, "From epoch 5 is after to epoch 4", err)
+	_, err = bs.ListEpochSummaries(ctx, &pbrpc.ListEpochSummariesRequest{ToEpoch: 11})
+	assert.ErrorContains(t, "Cannot retrieve information about an epoch in the future", err)
+
+	currentSlot = params.BeaconConfig().SlotsPerEpoch * 2000
+	_, err = bs.ListEpochSummaries(ctx, &pbrpc.ListEpochSummariesRequest{ToEpoch: maxEpochSummariesEpochs})
+	assert.ErrorContains(t, "Requested epoch range exceeds the maximum", err)
+}
@@ -0,0 +1,136 @@
+package beacon
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SimulateEpochTransition runs the next epoch transition of a canonical state on a copy of the
+// state, and returns the resulting changes of justification and finalization, the penalties and
+// the proposers of the next epoch. Nothing is persisted, so that the orchestrator can validate the
+// outcome of an epoch boundary before it happens.
+func (bs *Server) SimulateEpochTransition(
+	ctx context.Context, req *pbrpc.SimulateEpochTransitionRequest,
+) (*pbrpc.EpochTransitionSimulation, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconChainServer.SimulateEpochTransition")
+	defer span.End()
+
+	pre, err := bs.simulationState(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	epoch := helpers.CurrentEpoch(pre)
+	nextEpochStart, err := helpers.StartSlot(epoch + 1)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not compute start slot of epoch %d: %v", epoch+1, err)
+	}
+	post, err := state.ProcessSlots(ctx, bs.CommitteeCache, pre.Copy(), nextEpochStart)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not process the transition of epoch %d: %v", epoch, err)
+	}
+
+	res := &pbrpc.EpochTransitionSimulation{
+		Slot:                            pre.Slot(),
+		Epoch:                           epoch,
+		PreviousJustifiedCheckpoint:     pre.PreviousJustifiedCheckpoint(),
+		CurrentJustifiedCheckpoint:      pre.CurrentJustifiedCheckpoint(),
+		FinalizedCheckpoint:             pre.FinalizedCheckpoint(),
+		PostPreviousJustifiedCheckpoint: post.PreviousJustifiedCheckpoint(),
+		PostCurrentJustifiedCheckpoint:  post.CurrentJustifiedCheckpoint(),
+		PostFinalizedCheckpoint:         post.FinalizedCheckpoint(),
+		Penalties:                       make([]*pbrpc.ValidatorPenalty, 0),
+		EjectedValidators:               make([]types.ValidatorIndex, 0),
+	}
+	res.JustificationChanged = !attestationutil.CheckPointIsEqual(res.CurrentJustifiedCheckpoint, res.PostCurrentJustifiedCheckpoint)
+	res.FinalizationChanged = !attestationutil.CheckPointIsEqual(res.FinalizedCheckpoint, res.PostFinalizedCheckpoint)
+
+	preBalances, postBalances := pre.Balances(), post.Balances()
+	farFutureEpoch := params.BeaconConfigOf(bs.ChainConfig).FarFutureEpoch
+	for i := 0; i < len(preBalances) && i < len(postBalances); i++ {
+		index := types.ValidatorIndex(i)
+		if postBalances[i] < preBalances[i] {
+			amount := preBalances[i] - postBalances[i]
+			res.Penalties = append(res.Penalties, &pbrpc.ValidatorPenalty{Index: index, Amount: amount})
+			res.TotalPenalties += amount
+		}
+		preVal, err := pre.ValidatorAtIndexReadOnly(index)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get validator %d: %v", index, err)
+		}
+		postVal, err := post.ValidatorAtIndexReadOnly(index)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get validator %d: %v", index, err)
+		}
+		if preVal.ExitEpoch() == farFutureEpoch && postVal.ExitEpoch() != farFutureEpoch {
+			res.EjectedValidators = append(res.EjectedValidators, index)
+		}
+	}
+
+	// Computing the proposers moves the slot of the post state, which is discarded afterwards.
+	proposers, err := orchestrator.EpochProposers(bs.CommitteeCache, post, epoch+1)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute proposers of epoch %d: %v", epoch+1, err)
+	}
+	proposerListRoot, err := orchestrator.ProposerListRoot(proposers)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute proposer list root: %v", err)
+	}
+	res.NextEpochProposers = make([][]byte, len(proposers))
+	for i := range proposers {
+		res.NextEpochProposers[i] = proposers[i][:]
+	}
+	res.ProposerListRoot = proposerListRoot[:]
+	return res, nil
+}
+
+// simulationState returns the canonical state selected by the request. The returned state may be
+// shared with the state caches and must not be modified.
+func (bs *Server) simulationState(ctx context.Context, req *pbrpc.SimulateEpochTransitionRequest) (iface.BeaconState, error) {
+	headState, err := bs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil {
+		return nil, status.Error(codes.Internal, "Head state of chain was nil")
+	}
+	slot := headState.Slot()
+	switch filter := req.StateFilter.(type) {
+	case *pbrpc.SimulateEpochTransitionRequest_StateRoot:
+		if len(filter.StateRoot) != 32 {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid state root %#x, want 32 bytes", filter.StateRoot)
+		}
+		var ok bool
+		slot, ok, err = bs.canonicalStateRootSlot(ctx, bytesutil.ToBytes32(filter.StateRoot))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not resolve state root %#x: %v", filter.StateRoot, err)
+		}
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "Could not find state %#x in the recent canonical states", filter.StateRoot)
+		}
+	case *pbrpc.SimulateEpochTransitionRequest_Slot:
+		if filter.Slot > headState.Slot() {
+			return nil, status.Errorf(codes.InvalidArgument, "Slot %d is after the head slot %d", filter.Slot, headState.Slot())
+		}
+		slot = filter.Slot
+	}
+	if slot == headState.Slot() {
+		return headState, nil
+	}
+	st, err := bs.StateGen.StateBySlot(ctx, slot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get state at slot %d: %v", slot, err)
+	}
+	return st, nil
+}
@@ -0,0 +1,96 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_SimulateEpochTransition(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	state.SkipSlotCache.Disable()
+	defer state.SkipSlotCache.Enable()
+	ctx := context.Background()
+
+	genesis, _ := testutil.DeterministicGenesisState(t, 64)
+	slot := params.BeaconConfig().SlotsPerEpoch*2 + 5
+	st, err := state.ProcessSlots(ctx, nil, genesis, slot)
+	require.NoError(t, err)
+	// Validator 3 falls to the ejection balance, so the transition exits it.
+	val, err := st.ValidatorAtIndex(3)
+	require.NoError(t, err)
+	val.EffectiveBalance = params.BeaconConfig().EjectionBalance
+	require.NoError(t, st.UpdateValidatorAtIndex(3, val))
+	preBalances := st.Balances()
+
+	headBlock := testutil.NewBeaconBlock()
+	headBlock.Block.Slot = slot
+	headBlock.Block.StateRoot = bytesutil.PadTo([]byte("head state"), 32)
+	bs := &Server{HeadFetcher: &mock.ChainService{State: st, Block: headBlock}}
+
+	res, err := bs.SimulateEpochTransition(ctx, &pbrpc.SimulateEpochTransitionRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, slot, res.Slot)
+	assert.Equal(t, types.Epoch(2), res.Epoch)
+	// Nobody attested, so every validator is penalized and nothing is justified.
+	assert.Equal(t, 64, len(res.Penalties))
+	var total uint64
+	for _, p := range res.Penalties {
+		total += p.Amount
+	}
+	assert.Equal(t, total, res.TotalPenalties)
+	assert.Equal(t, false, res.JustificationChanged)
+	assert.Equal(t, false, res.FinalizationChanged)
+	assert.DeepEqual(t, []types.ValidatorIndex{3}, res.EjectedValidators)
+
+	post, err := state.ProcessSlots(ctx, nil, st.Copy(), params.BeaconConfig().SlotsPerEpoch*3)
+	require.NoError(t, err)
+	wantProposers, err := orchestrator.EpochProposers(nil, post, 3)
+	require.NoError(t, err)
+	require.Equal(t, len(wantProposers), len(res.NextEpochProposers))
+	for i := range wantProposers {
+		assert.DeepEqual(t, wantProposers[i][:], res.NextEpochProposers[i])
+	}
+	wantRoot, err := orchestrator.ProposerListRoot(wantProposers)
+	require.NoError(t, err)
+	assert.DeepEqual(t, wantRoot[:], res.ProposerListRoot)
+
+	assert.Equal(t, slot, st.Slot(), "Expected the head state to be left untouched")
+	assert.DeepEqual(t, preBalances, st.Balances())
+
+	// The state root of the head block selects the head state.
+	byRoot, err := bs.SimulateEpochTransition(ctx, stateRootRequest(headBlock.Block.StateRoot))
+	require.NoError(t, err)
+	assert.DeepEqual(t, res.ProposerListRoot, byRoot.ProposerListRoot)
+
+	future := slot + 1
+	_, err = bs.SimulateEpochTransition(ctx, &pbrpc.SimulateEpochTransitionRequest{
+		StateFilter: &pbrpc.SimulateEpochTransitionRequest_Slot{Slot: future},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = bs.SimulateEpochTransition(ctx, stateRootRequest([]byte{1}))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = bs.SimulateEpochTransition(ctx, stateRootRequest(bytesutil.PadTo([]byte("unknown"), 32)))
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func stateRootRequest(root []byte) *pbrpc.SimulateEpochTransitionRequest {
+	return &pbrpc.SimulateEpochTransitionRequest{
+		StateFilter: &pbrpc.SimulateEpochTransitionRequest_StateRoot{StateRoot: root},
+	}
+}
@@ -0,0 +1,142 @@
+package beacon
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// depositInclusionEventBuffer is the number of state events buffered per deposit inclusion stream
+// before the oldest are dropped.
+const depositInclusionEventBuffer = 64
+
+// Eth1DataVoteFetcher returns the eth1 data the node votes for in the next block it proposes.
+type Eth1DataVoteFetcher interface {
+	Eth1DataVote(ctx context.Context) (*ethpb.Eth1Data, error)
+}
+
+// GetEth1DataStatus returns the eth1 voting status of the head: the current voting period, the
+// deposits pending inclusion and the eth1 data the node votes for.
+func (bs *Server) GetEth1DataStatus(ctx context.Context, _ *empty.Empty) (*pbrpc.Eth1DataStatus, error) {
+	headState, err := bs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil {
+		return nil, status.Error(codes.Internal, "Head state of chain was nil")
+	}
+	cfg := params.BeaconConfigOf(bs.ChainConfig)
+	periodSlots := cfg.SlotsPerEpoch.Mul(uint64(cfg.EpochsPerEth1VotingPeriod))
+	startSlot := headState.Slot() - headState.Slot().ModSlot(periodSlots)
+	eth1Data := headState.Eth1Data()
+	res := &pbrpc.Eth1DataStatus{
+		VotingPeriodStartSlot:     startSlot,
+		NextVotingPeriodStartSlot: startSlot + periodSlots,
+		Eth1Data:                  eth1Data,
+		Eth1DepositIndex:          headState.Eth1DepositIndex(),
+		Votes:                     uint64(len(headState.Eth1DataVotes())),
+		RequiredSupport:           uint64(periodSlots)/2 + 1,
+	}
+	if eth1Data != nil && eth1Data.DepositCount > res.Eth1DepositIndex {
+		res.PendingDeposits = eth1Data.DepositCount - res.Eth1DepositIndex
+	}
+	if bs.Eth1DataVoteFetcher == nil {
+		return res, nil
+	}
+	res.Vote, err = bs.Eth1DataVoteFetcher.Eth1DataVote(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, vote := range headState.Eth1DataVotes() {
+		if blocks.AreEth1DataEqual(vote, res.Vote) {
+			res.VoteSupport++
+		}
+	}
+	return res, nil
+}
+
+// StreamDepositInclusions sends the deposits of every processed block, so that the orchestrator
+// can reconcile the deposits of the paired chain with the deposits the beacon chain included.
+// Blocks of every fork are processed, so clients must check that the block of a deposit became
+// canonical. Streams falling behind miss the deposits of the oldest blocks.
+func (bs *Server) StreamDepositInclusions(_ *empty.Empty, stream pbrpc.BeaconQuery_StreamDepositInclusionsServer) error {
+	stateChannel := make(chan *feed.Event, depositInclusionEventBuffer)
+	stateSub := bs.StateNotifier.StateFeed().SubscribeWithPolicy(stateChannel, "deposit_inclusions", event.DropOldest)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case ev := <-stateChannel:
+			if ev.Type != statefeed.BlockProcessed {
+				continue
+			}
+			data, ok := ev.Data.(*statefeed.BlockProcessedData)
+			if !ok || data == nil {
+				continue
+			}
+			inclusions, err := bs.depositInclusions(stream.Context(), data)
+			if err != nil {
+				log.WithError(err).WithField("slot", data.Slot).Error("Could not get included deposits")
+				continue
+			}
+			for _, inclusion := range inclusions {
+				if err := stream.Send(inclusion); err != nil {
+					return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+				}
+			}
+		case <-stateSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-bs.Ctx.Done():
+			return grpcutils.ShuttingDownError()
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
+
+// depositInclusions returns the deposits included by a processed block. Their contract indices
+// are counted back from the deposit index of the post state of the block, which is only looked up
+// for the blocks with deposits.
+func (bs *Server) depositInclusions(ctx context.Context, data *statefeed.BlockProcessedData) ([]*pbrpc.DepositInclusion, error) {
+	if data.SignedBlock == nil || data.SignedBlock.Block == nil || data.SignedBlock.Block.Body == nil {
+		return nil, nil
+	}
+	deposits := data.SignedBlock.Block.Body.Deposits
+	if len(deposits) == 0 {
+		return nil, nil
+	}
+	st, err := bs.StateGen.StateByRoot(ctx, data.BlockRoot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get post state of block %#x", data.BlockRoot)
+	}
+	if st == nil || st.Eth1DepositIndex() < uint64(len(deposits)) {
+		return nil, errors.Errorf("post state of block %#x does not include its deposits", data.BlockRoot)
+	}
+	first := st.Eth1DepositIndex() - uint64(len(deposits))
+	inclusions := make([]*pbrpc.DepositInclusion, 0, len(deposits))
+	for i, dep := range deposits {
+		if dep == nil || dep.Data == nil {
+			continue
+		}
+		inclusions = append(inclusions, &pbrpc.DepositInclusion{
+			Slot:                  data.Slot,
+			BlockRoot:             bytesutil.SafeCopyBytes(data.BlockRoot[:]),
+			Index:                 first + uint64(i),
+			PublicKey:             dep.Data.PublicKey,
+			WithdrawalCredentials: dep.Data.WithdrawalCredentials,
+			Amount:                dep.Data.Amount,
+		})
+	}
+	return inclusions, nil
+}
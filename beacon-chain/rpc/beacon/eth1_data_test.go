@@ -0,0 +1,152 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+type mockEth1DataVoteFetcher struct {
+	vote *ethpb.Eth1Data
+}
+
+func (m *mockEth1DataVoteFetcher) Eth1DataVote(_ context.Context) (*ethpb.Eth1Data, error) {
+	return m.vote, nil
+}
+
+type depositInclusionTestStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *pbrpc.DepositInclusion
+}
+
+func (s *depositInclusionTestStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *depositInclusionTestStream) Send(d *pbrpc.DepositInclusion) error {
+	s.sent <- d
+	return nil
+}
+
+func TestServer_GetEth1DataStatus(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	periodSlots := params.BeaconConfig().SlotsPerEpoch.Mul(uint64(params.BeaconConfig().EpochsPerEth1VotingPeriod))
+	require.NoError(t, st.SetSlot(periodSlots+5))
+	require.NoError(t, st.SetEth1Data(&ethpb.Eth1Data{
+		DepositRoot:  bytesutil.PadTo([]byte{1}, 32),
+		DepositCount: 70,
+		BlockHash:    bytesutil.PadTo([]byte{1}, 32),
+	}))
+	require.NoError(t, st.SetEth1DepositIndex(64))
+	vote := &ethpb.Eth1Data{
+		DepositRoot:  bytesutil.PadTo([]byte{2}, 32),
+		DepositCount: 72,
+		BlockHash:    bytesutil.PadTo([]byte{2}, 32),
+	}
+	other := &ethpb.Eth1Data{
+		DepositRoot:  bytesutil.PadTo([]byte{3}, 32),
+		DepositCount: 71,
+		BlockHash:    bytesutil.PadTo([]byte{3}, 32),
+	}
+	require.NoError(t, st.SetEth1DataVotes([]*ethpb.Eth1Data{vote, other, vote}))
+
+	bs := &Server{HeadFetcher: &mock.ChainService{State: st}}
+	res, err := bs.GetEth1DataStatus(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, periodSlots, res.VotingPeriodStartSlot)
+	assert.Equal(t, 2*periodSlots, res.NextVotingPeriodStartSlot)
+	assert.Equal(t, uint64(64), res.Eth1DepositIndex)
+	assert.Equal(t, uint64(6), res.PendingDeposits)
+	assert.Equal(t, uint64(3), res.Votes)
+	assert.Equal(t, uint64(periodSlots)/2+1, res.RequiredSupport)
+	assert.Equal(t, true, res.Vote == nil, "Expected no vote without a vote fetcher")
+
+	bs.Eth1DataVoteFetcher = &mockEth1DataVoteFetcher{vote: vote}
+	res, err = bs.GetEth1DataStatus(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, vote, res.Vote)
+	assert.Equal(t, uint64(2), res.VoteSupport)
+}
+
+func TestServer_StreamDepositInclusions(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	db := dbTest.SetupDB(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, st.SetEth1DepositIndex(66))
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 1
+	deposit := func(i uint64, amount uint64) *ethpb.Deposit {
+		proof := make([][]byte, params.BeaconConfig().DepositContractTreeDepth+1)
+		for j := range proof {
+			proof[j] = make([]byte, 32)
+		}
+		return &ethpb.Deposit{Proof: proof, Data: &ethpb.Deposit_Data{
+			PublicKey:             pubKey(i),
+			WithdrawalCredentials: make([]byte, 32),
+			Amount:                amount,
+			Signature:             make([]byte, params.BeaconConfig().BLSSignatureLength),
+		}}
+	}
+	blk.Block.Body.Deposits = []*ethpb.Deposit{deposit(64, 32), deposit(65, 1)}
+	require.NoError(t, db.SaveBlock(ctx, blk))
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, root))
+
+	notifier := &mock.MockStateNotifier{}
+	bs := &Server{Ctx: ctx, StateNotifier: notifier, StateGen: stategen.New(db)}
+	stream := &depositInclusionTestStream{ctx: context.Background(), sent: make(chan *pbrpc.DepositInclusion, 2)}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- bs.StreamDepositInclusions(&empty.Empty{}, stream)
+	}()
+
+	// Blocks without deposits are skipped.
+	noDeposits := &feed.Event{Type: statefeed.BlockProcessed, Data: &statefeed.BlockProcessedData{Slot: 0, SignedBlock: testutil.NewBeaconBlock()}}
+	for notifier.StateFeed().Send(noDeposits) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	notifier.StateFeed().Send(&feed.Event{Type: statefeed.BlockProcessed, Data: &statefeed.BlockProcessedData{
+		Slot:        1,
+		BlockRoot:   root,
+		SignedBlock: blk,
+	}})
+	for i, want := range []types.ValidatorIndex{64, 65} {
+		select {
+		case d := <-stream.sent:
+			assert.Equal(t, uint64(want), d.Index)
+			assert.Equal(t, types.Slot(1), d.Slot)
+			assert.DeepEqual(t, root[:], d.BlockRoot)
+			assert.DeepEqual(t, pubKey(uint64(want)), d.PublicKey)
+			assert.Equal(t, blk.Block.Body.Deposits[i].Data.Amount, d.Amount)
+		case <-time.After(10 * time.Second):
+			t.Fatal("Timed out waiting for deposit inclusion")
+		}
+	}
+	cancel()
+	assert.ErrorContains(t, "shutting down", <-errs)
+}
@@ -0,0 +1,100 @@
+package beacon
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExplainShuffle returns the seed, the output of every shuffle round and the resulting committee
+// position of a validator in an epoch, so that auditors can verify the assignment computation of
+// the node against the spec. The explanation is cross-checked against the committee the node
+// assigns the validator to, and an error is returned if they disagree.
+func (bs *Server) ExplainShuffle(ctx context.Context, req *pbrpc.ExplainShuffleRequest) (*pbrpc.ShuffleExplanation, error) {
+	if err := bs.checkEpochAdmission(req.Epoch); err != nil {
+		return nil, err
+	}
+	st, err := bs.epochStartState(ctx, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve archived state for epoch %d: %v", req.Epoch, err)
+	}
+	activeIndices, err := helpers.ActiveValidatorIndices(bs.CommitteeCache, st, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get active validator indices: %v", err)
+	}
+	activePosition := -1
+	for i, index := range activeIndices {
+		if index == req.Index {
+			activePosition = i
+			break
+		}
+	}
+	if activePosition < 0 {
+		return nil, status.Errorf(codes.NotFound, "Validator %d is not active in epoch %d", req.Index, req.Epoch)
+	}
+	seed, err := helpers.Seed(st, req.Epoch, params.BeaconConfigOf(bs.ChainConfig).DomainBeaconAttester)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get seed: %v", err)
+	}
+
+	count := uint64(len(activeIndices))
+	shuffledPosition, err := helpers.UnShuffledIndex(types.ValidatorIndex(activePosition), count, seed)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not un-shuffle active position: %v", err)
+	}
+	rounds, err := helpers.ShuffledIndexRounds(shuffledPosition, count, seed)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not shuffle position: %v", err)
+	}
+
+	// The committees of an epoch split the shuffled order in SlotsPerEpoch * committeesPerSlot
+	// contiguous parts, in slot order.
+	committeesPerSlot := helpers.SlotCommitteeCount(count)
+	committeeCount := committeesPerSlot * uint64(params.BeaconConfigOf(bs.ChainConfig).SlotsPerEpoch)
+	position := uint64(shuffledPosition)
+	var committee, start uint64
+	for ; committee < committeeCount; committee++ {
+		start = sliceutil.SplitOffset(count, committeeCount, committee)
+		if position < sliceutil.SplitOffset(count, committeeCount, committee+1) {
+			break
+		}
+	}
+	startSlot, err := helpers.StartSlot(req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get start slot: %v", err)
+	}
+	explanation := &pbrpc.ShuffleExplanation{
+		Epoch:                req.Epoch,
+		Index:                req.Index,
+		Seed:                 seed[:],
+		ActiveValidatorCount: count,
+		ActivePosition:       uint64(activePosition),
+		ShuffledPosition:     position,
+		RoundOutputs:         rounds,
+		Slot:                 startSlot + types.Slot(committee/committeesPerSlot),
+		CommitteeIndex:       types.CommitteeIndex(committee % committeesPerSlot),
+		CommitteePosition:    position - start,
+	}
+
+	assigned, err := helpers.BeaconCommitteeFromState(bs.CommitteeCache, st, explanation.Slot, explanation.CommitteeIndex)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get committee: %v", err)
+	}
+	if explanation.CommitteePosition >= uint64(len(assigned)) || assigned[explanation.CommitteePosition] != req.Index {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Committee %d at slot %d does not hold validator %d at position %d as the spec shuffle does",
+			explanation.CommitteeIndex,
+			explanation.Slot,
+			req.Index,
+			explanation.CommitteePosition,
+		)
+	}
+	return explanation, nil
+}
@@ -0,0 +1,65 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_ExplainShuffle(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	s := setupActiveValidators(t, 128)
+	require.NoError(t, db.SaveState(ctx, s, genesisRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+
+	currentSlot := types.Slot(0)
+	bs := &Server{
+		BeaconDB:           db,
+		HeadFetcher:        &mock.ChainService{State: s, Root: genesisRoot[:]},
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		StateGen:           stategen.New(db),
+	}
+
+	assignments, _, err := helpers.CommitteeAssignments(context.Background(), nil, s, 0)
+	require.NoError(t, err)
+	seed, err := helpers.Seed(s, 0, params.BeaconConfig().DomainBeaconAttester)
+	require.NoError(t, err)
+	for _, index := range []types.ValidatorIndex{0, 17, 127} {
+		explanation, err := bs.ExplainShuffle(ctx, &pbrpc.ExplainShuffleRequest{Epoch: 0, Index: index})
+		require.NoError(t, err)
+		assert.DeepEqual(t, seed[:], explanation.Seed)
+		assert.Equal(t, uint64(128), explanation.ActiveValidatorCount)
+		assert.Equal(t, uint64(index), explanation.ActivePosition)
+		require.Equal(t, int(params.BeaconConfig().ShuffleRoundCount), len(explanation.RoundOutputs))
+		assert.Equal(t, types.ValidatorIndex(explanation.ActivePosition), explanation.RoundOutputs[len(explanation.RoundOutputs)-1])
+
+		assignment := assignments[index]
+		assert.Equal(t, assignment.AttesterSlot, explanation.Slot)
+		assert.Equal(t, assignment.CommitteeIndex, explanation.CommitteeIndex)
+		assert.Equal(t, index, assignment.Committee[explanation.CommitteePosition])
+	}
+
+	_, err = bs.ExplainShuffle(ctx, &pbrpc.ExplainShuffleRequest{Epoch: 0, Index: 128})
+	assert.ErrorContains(t, "Validator 128 is not active in epoch 0", err)
+	_, err = bs.ExplainShuffle(ctx, &pbrpc.ExplainShuffleRequest{Epoch: 2})
+	assert.ErrorContains(t, "Cannot retrieve information about an epoch in the future", err)
+}
@@ -0,0 +1,27 @@
+package beacon
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// reportImmutableResponse marks the response as immutable if it is computed from the start state
+// of a finalized epoch, so that the gateway serves it with cache headers. Callers only report
+// responses which are fully determined by the request message, since reverse proxies cache by URL
+// and do not see the request metadata.
+func (bs *Server) reportImmutableResponse(ctx context.Context, epoch types.Epoch) {
+	if bs.FinalizationFetcher == nil {
+		return
+	}
+	finalized := bs.FinalizationFetcher.FinalizedCheckpt()
+	if finalized == nil || epoch > finalized.Epoch {
+		return
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs(grpcutils.ImmutableResponseHeader, "true")); err != nil {
+		log.WithError(err).Debug("Could not set immutable response header")
+	}
+}
@@ -0,0 +1,95 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type headerCapturingStream struct {
+	header metadata.MD
+}
+
+func (s *headerCapturingStream) Method() string { return "" }
+
+func (s *headerCapturingStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *headerCapturingStream) SendHeader(md metadata.MD) error { return s.SetHeader(md) }
+
+func (s *headerCapturingStream) SetTrailer(_ metadata.MD) error { return nil }
+
+func TestServer_ReportImmutableResponse(t *testing.T) {
+	bs := &Server{FinalizationFetcher: &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 3}}}
+	tests := []struct {
+		name      string
+		epoch     types.Epoch
+		immutable bool
+	}{
+		{name: "finalized epoch", epoch: 3, immutable: true},
+		{name: "epoch before finality", epoch: 1, immutable: true},
+		{name: "epoch after finality", epoch: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := &headerCapturingStream{}
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+			bs.reportImmutableResponse(ctx, tt.epoch)
+			assert.Equal(t, tt.immutable, len(stream.header.Get(grpcutils.ImmutableResponseHeader)) == 1)
+		})
+	}
+
+	// Nothing is reported without a finalized checkpoint.
+	stream := &headerCapturingStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	(&Server{FinalizationFetcher: &mock.ChainService{}}).reportImmutableResponse(ctx, 0)
+	assert.Equal(t, 0, len(stream.header))
+}
+
+func TestServer_ListBeaconCommittees_ReportsImmutableResponse(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	helpers.ClearCache()
+	ctx := context.Background()
+
+	b := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, b))
+	gRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, gRoot))
+	require.NoError(t, db.SaveState(ctx, setupActiveValidators(t, 64), gRoot))
+
+	var currentSlot types.Slot
+	m := &mock.ChainService{Slot: &currentSlot, FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 0}}
+	bs := &Server{
+		GenesisTimeFetcher:  m,
+		FinalizationFetcher: m,
+		StateGen:            stategen.New(db),
+	}
+	stream := &headerCapturingStream{}
+	_, err = bs.ListBeaconCommittees(grpc.NewContextWithServerTransportStream(ctx, stream), &ethpb.ListCommitteesRequest{
+		QueryFilter: &ethpb.ListCommitteesRequest_Genesis{Genesis: true},
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{"true"}, stream.header.Get(grpcutils.ImmutableResponseHeader))
+
+	// The committees of the current epoch are served without a filter, and change every epoch, even
+	// while the current epoch is finalized.
+	stream = &headerCapturingStream{}
+	_, err = bs.ListBeaconCommittees(grpc.NewContextWithServerTransportStream(ctx, stream), &ethpb.ListCommitteesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(stream.header.Get(grpcutils.ImmutableResponseHeader)))
+}
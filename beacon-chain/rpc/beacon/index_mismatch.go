@@ -0,0 +1,65 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+// validatorIndexMismatches compares the indices the public keys resolve to in the requested state
+// against the head state. Public keys unknown to the requested state are skipped, and nothing is
+// reported if the server has no access to the head state.
+func (bs *Server) validatorIndexMismatches(
+	ctx context.Context, requestedState iface.ReadOnlyBeaconState, pubKeys [][]byte,
+) ([]*pbrpc.ValidatorIndexMismatch, error) {
+	if bs.HeadFetcher == nil || len(pubKeys) == 0 {
+		return nil, nil
+	}
+	headState, err := bs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if headState == nil {
+		return nil, nil
+	}
+	var mismatches []*pbrpc.ValidatorIndexMismatch
+	for _, pubKey := range pubKeys {
+		key := bytesutil.ToBytes48(pubKey)
+		requestedIndex, ok := requestedState.ValidatorIndexByPubkey(key)
+		if !ok {
+			continue
+		}
+		headIndex, inHead := headState.ValidatorIndexByPubkey(key)
+		if inHead && headIndex == requestedIndex {
+			continue
+		}
+		mismatch := &pbrpc.ValidatorIndexMismatch{
+			PublicKey:      pubKey,
+			RequestedIndex: requestedIndex,
+			InHead:         inHead,
+		}
+		if inHead {
+			mismatch.HeadIndex = headIndex
+		}
+		mismatches = append(mismatches, mismatch)
+	}
+	return mismatches, nil
+}
+
+// logIndexMismatches logs the mismatches found for the requested epoch.
+func logIndexMismatches(epoch types.Epoch, mismatches []*pbrpc.ValidatorIndexMismatch) {
+	for _, m := range mismatches {
+		log.WithFields(logrus.Fields{
+			"epoch":          epoch,
+			"pubkey":         fmt.Sprintf("%#x", bytesutil.Trunc(m.PublicKey)),
+			"requestedIndex": m.RequestedIndex,
+			"inHead":         m.InHead,
+			"headIndex":      m.HeadIndex,
+		}).Warn("Validator index differs between requested epoch and head")
+	}
+}
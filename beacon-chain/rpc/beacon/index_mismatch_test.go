@@ -0,0 +1,96 @@
+package beacon
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func indexMismatchTestState(t *testing.T, pubKeyOrder []uint64) iface.BeaconState {
+	validators := make([]*ethpb.Validator, len(pubKeyOrder))
+	for i, k := range pubKeyOrder {
+		pubKey := make([]byte, params.BeaconConfig().BLSPubkeyLength)
+		binary.LittleEndian.PutUint64(pubKey, k)
+		validators[i] = &ethpb.Validator{
+			PublicKey:             pubKey,
+			WithdrawalCredentials: make([]byte, 32),
+			ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	s, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, s.SetValidators(validators))
+	return s
+}
+
+func TestServer_ListAssignments_FlagsIndexMismatches(t *testing.T) {
+	helpers.ClearCache()
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	// The deposits of public keys 1 and 2 were reorged into a different order at the head,
+	// and public key 3 is not part of the head registry anymore.
+	requestedState := indexMismatchTestState(t, []uint64{0, 1, 2, 3})
+	headState := indexMismatchTestState(t, []uint64{0, 2, 1})
+
+	blk := testutil.NewBeaconBlock().Block
+	blockRoot, err := blk.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, requestedState, blockRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, blockRoot))
+
+	bs := &Server{
+		BeaconDB:           db,
+		HeadFetcher:        &mock.ChainService{State: headState},
+		GenesisTimeFetcher: &mock.ChainService{},
+		StateGen:           stategen.New(db),
+	}
+
+	pubKeys := make([][]byte, 4)
+	for i := range pubKeys {
+		pubKeys[i] = make([]byte, params.BeaconConfig().BLSPubkeyLength)
+		binary.LittleEndian.PutUint64(pubKeys[i], uint64(i))
+	}
+	res, err := bs.ListAnnotatedValidatorAssignments(ctx, &pbrpc.AnnotatedValidatorAssignmentsRequest{
+		QueryFilter: &pbrpc.AnnotatedValidatorAssignmentsRequest_Genesis{Genesis: true},
+		PublicKeys:  pubKeys,
+	})
+	require.NoError(t, err)
+
+	wanted := []*pbrpc.ValidatorIndexMismatch{
+		{PublicKey: pubKeys[1], RequestedIndex: 1, HeadIndex: 2, InHead: true},
+		{PublicKey: pubKeys[2], RequestedIndex: 2, HeadIndex: 1, InHead: true},
+		{PublicKey: pubKeys[3], RequestedIndex: 3},
+	}
+	assert.DeepEqual(t, wanted, res.IndexMismatches)
+}
+
+func TestServer_ValidatorIndexMismatches_NoHead(t *testing.T) {
+	bs := &Server{}
+	st := indexMismatchTestState(t, []uint64{0})
+	pubKey := st.PubkeyAtIndex(0)
+	mismatches, err := bs.validatorIndexMismatches(context.Background(), st, [][]byte{pubKey[:]})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(mismatches))
+}
+
+func TestServer_ValidatorIndexMismatches_Consistent(t *testing.T) {
+	st := indexMismatchTestState(t, []uint64{0, 1})
+	bs := &Server{HeadFetcher: &mock.ChainService{State: st}}
+	pubKey := st.PubkeyAtIndex(1)
+	mismatches, err := bs.validatorIndexMismatches(context.Background(), st, [][]byte{pubKey[:]})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(mismatches))
+}
@@ -0,0 +1,64 @@
+package beacon
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetValidatorLiveness reports whether each of the given validators was observed to be active in
+// an epoch, which is the case if a processed block was proposed by the validator in the epoch, or
+// included an attestation of the validator targeting the epoch. Phase 0 has no sync committee, so
+// sync contributions do not count towards liveness. Liveness is tracked for the most recent
+// epochs only, and older epochs are rejected as out of range.
+func (bs *Server) GetValidatorLiveness(ctx context.Context, req *pbrpc.ValidatorLivenessRequest) (*pbrpc.ValidatorLivenessResponse, error) {
+	if bs.LivenessCache == nil {
+		return nil, status.Error(codes.Unimplemented, "Validator liveness is not tracked by this node")
+	}
+	currentEpoch := helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
+	if req.Epoch > currentEpoch {
+		return nil, futureEpochError(currentEpoch, req.Epoch)
+	}
+	if oldest := bs.LivenessCache.OldestEpoch(); req.Epoch < oldest {
+		return nil, grpcutils.EpochOutOfRangeError(
+			codes.OutOfRange,
+			&grpcutils.EpochOutOfRange{
+				Current:         currentEpoch,
+				Requested:       req.Epoch,
+				OldestAvailable: oldest,
+			},
+			"Liveness of epoch %d is no longer tracked, the oldest tracked epoch is %d",
+			req.Epoch,
+			oldest,
+		)
+	}
+
+	headState, err := bs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	numValidators := uint64(headState.NumValidators())
+	for _, index := range req.Indices {
+		if uint64(index) >= numValidators {
+			return nil, status.Errorf(codes.InvalidArgument, "Validator index %d is out of range", index)
+		}
+	}
+
+	live, ok := bs.LivenessCache.Liveness(req.Epoch, req.Indices)
+	if !ok {
+		// No block of the epoch was processed yet, so no activity was observed.
+		live = make([]bool, len(req.Indices))
+	}
+	res := &pbrpc.ValidatorLivenessResponse{
+		Epoch:    req.Epoch,
+		Liveness: make([]*pbrpc.ValidatorLiveness, len(req.Indices)),
+	}
+	for i, index := range req.Indices {
+		res.Liveness[i] = &pbrpc.ValidatorLiveness{Index: index, IsLive: live[i]}
+	}
+	return res, nil
+}
@@ -0,0 +1,59 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_GetValidatorLiveness(t *testing.T) {
+	ctx := context.Background()
+	headState, _ := testutil.DeterministicGenesisState(t, 16)
+
+	liveness := cache.NewLivenessCache()
+	liveness.MarkLive(8, 2, 5)
+	liveness.MarkLive(9, 5)
+	currentSlot := params.BeaconConfig().SlotsPerEpoch * 10
+	bs := &Server{
+		HeadFetcher:        &mock.ChainService{State: headState},
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		LivenessCache:      liveness,
+	}
+
+	res, err := bs.GetValidatorLiveness(ctx, &pbrpc.ValidatorLivenessRequest{Epoch: 8, Indices: []types.ValidatorIndex{2, 3, 5}})
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(8), res.Epoch)
+	assert.DeepEqual(t, []*pbrpc.ValidatorLiveness{
+		{Index: 2, IsLive: true},
+		{Index: 3, IsLive: false},
+		{Index: 5, IsLive: true},
+	}, res.Liveness)
+
+	// No block of the current epoch was processed yet.
+	res, err = bs.GetValidatorLiveness(ctx, &pbrpc.ValidatorLivenessRequest{Epoch: 10, Indices: []types.ValidatorIndex{5}})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*pbrpc.ValidatorLiveness{{Index: 5, IsLive: false}}, res.Liveness)
+
+	_, err = bs.GetValidatorLiveness(ctx, &pbrpc.ValidatorLivenessRequest{Epoch: 8, Indices: []types.ValidatorIndex{16}})
+	assert.ErrorContains(t, "Validator index 16 is out of range", err)
+
+	_, err = bs.GetValidatorLiveness(ctx, &pbrpc.ValidatorLivenessRequest{Epoch: 11})
+	assert.ErrorContains(t, "Cannot retrieve information about an epoch in the future", err)
+
+	_, err = bs.GetValidatorLiveness(ctx, &pbrpc.ValidatorLivenessRequest{Epoch: 5})
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+	details, ok := grpcutils.EpochOutOfRangeFromError(err)
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, &grpcutils.EpochOutOfRange{Current: 10, Requested: 5, OldestAvailable: 6}, details)
+}
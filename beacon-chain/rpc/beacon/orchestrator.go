@@ -0,0 +1,138 @@
+package beacon
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ConfirmPandoraBlockHashes lets the orchestrator report which Vanguard slots were confirmed
+// or found invalid on the Pandora chain. Confirmations are saved for the reported block, or for
+// every block the node knows at the reported slot if none is given, including blocks held in the
+// pending queue. Processed blocks marked invalid are removed from fork choice, and the
+// confirmations of reported blocks the node has not received are held until they are.
+func (bs *Server) ConfirmPandoraBlockHashes(
+	ctx context.Context, req *pbrpc.ConfirmPandoraBlockHashesRequest,
+) (*pbrpc.ConfirmPandoraBlockHashesResponse, error) {
+	if req == nil || len(req.Confirmations) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No confirmations provided")
+	}
+	if bs.ConfirmationStore == nil {
+		return nil, status.Error(codes.Unavailable, "Orchestrator confirmations are not supported")
+	}
+
+	res := &pbrpc.ConfirmPandoraBlockHashesResponse{}
+	confirmations := make([]*orchestrator.Confirmation, 0, len(req.Confirmations))
+	var held []*orchestrator.Confirmation
+	for _, c := range req.Confirmations {
+		if c == nil {
+			return nil, status.Error(codes.InvalidArgument, "Nil confirmation")
+		}
+		if len(c.Hash) != 32 {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid pandora hash length for slot %d: %d", c.Slot, len(c.Hash))
+		}
+		if len(c.BlockRoot) != 0 && len(c.BlockRoot) != 32 {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid block root length for slot %d: %d", c.Slot, len(c.BlockRoot))
+		}
+		if c.Status != pbrpc.PandoraConfirmation_VERIFIED && c.Status != pbrpc.PandoraConfirmation_INVALID {
+			return nil, status.Errorf(codes.InvalidArgument, "Unsupported confirmation status for slot %d: %s", c.Slot, c.Status)
+		}
+		roots, err := bs.blockRootsForConfirmation(ctx, c.Slot)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve blocks for slot %d: %v", c.Slot, err)
+		}
+		if len(c.BlockRoot) != 0 {
+			roots = reportedRoot(roots, bytesutil.ToBytes32(c.BlockRoot))
+		}
+		if len(roots) == 0 {
+			res.UnknownSlots = append(res.UnknownSlots, c.Slot)
+			if len(c.BlockRoot) != 0 {
+				held = append(held, &orchestrator.Confirmation{
+					Slot:        c.Slot,
+					BlockRoot:   bytesutil.ToBytes32(c.BlockRoot),
+					PandoraHash: bytesutil.ToBytes32(c.Hash),
+					Status:      orchestrator.Status(c.Status),
+				})
+			}
+			continue
+		}
+		for _, r := range roots {
+			if err := bs.checkPandoraPairing(ctx, r, bytesutil.ToBytes32(c.Hash)); err != nil {
+				return nil, err
+			}
+			confirmations = append(confirmations, &orchestrator.Confirmation{
+				Slot:        c.Slot,
+				BlockRoot:   r,
+				PandoraHash: bytesutil.ToBytes32(c.Hash),
+				Status:      orchestrator.Status(c.Status),
+			})
+		}
+	}
+
+	if err := bs.ConfirmationStore.SavePandoraConfirmations(ctx, confirmations); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save confirmations: %v", err)
+	}
+	if bs.PandoraConfirmationReceiver != nil {
+		bs.PandoraConfirmationReceiver.HoldPandoraConfirmations(held)
+		if err := bs.PandoraConfirmationReceiver.ReceivePandoraConfirmations(ctx, confirmations); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not apply confirmations: %v", err)
+		}
+	}
+	res.Stored = uint64(len(confirmations))
+	return res, nil
+}
+
+// blockRootsForConfirmation returns the roots of the saved and the pending blocks at the given slot.
+func (bs *Server) blockRootsForConfirmation(ctx context.Context, slot types.Slot) ([][32]byte, error) {
+	_, roots, err := bs.BeaconDB.BlockRootsBySlot(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	if bs.PandoraConfirmationReceiver == nil {
+		return roots, nil
+	}
+	seen := make(map[[32]byte]bool, len(roots))
+	for _, r := range roots {
+		seen[r] = true
+	}
+	for _, r := range bs.PandoraConfirmationReceiver.PendingBlockRoots(slot) {
+		if !seen[r] {
+			roots = append(roots, r)
+		}
+	}
+	return roots, nil
+}
+
+// reportedRoot returns the given root if it is among the roots, and no root otherwise.
+func reportedRoot(roots [][32]byte, root [32]byte) [][32]byte {
+	for _, r := range roots {
+		if r == root {
+			return [][32]byte{root}
+		}
+	}
+	return nil
+}
+
+// checkPandoraPairing rejects a confirmation pairing a block with another Pandora block than the
+// one confirmed for it before.
+func (bs *Server) checkPandoraPairing(ctx context.Context, root, pandoraHash [32]byte) error {
+	existing, err := bs.ConfirmationStore.PandoraConfirmation(ctx, root)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not retrieve confirmation of block %#x: %v", root, err)
+	}
+	if existing != nil && existing.PandoraHash != pandoraHash {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"Block %#x is paired with Pandora block %#x, not %#x",
+			root,
+			existing.PandoraHash,
+			pandoraHash,
+		)
+	}
+	return nil
+}
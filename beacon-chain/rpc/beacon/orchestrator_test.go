@@ -0,0 +1,165 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_ConfirmPandoraBlockHashes(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 3
+	require.NoError(t, beaconDB.SaveBlock(ctx, b))
+	r, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	bs := &Server{BeaconDB: beaconDB, ConfirmationStore: beaconDB}
+	hash := bytesutil.PadTo([]byte{'p'}, 32)
+	res, err := bs.ConfirmPandoraBlockHashes(ctx, &pbrpc.ConfirmPandoraBlockHashesRequest{
+		Confirmations: []*pbrpc.PandoraBlockHashConfirmation{
+			{Slot: 3, Hash: hash, Status: pbrpc.PandoraConfirmation_INVALID},
+			{Slot: 4, Hash: hash, Status: pbrpc.PandoraConfirmation_VERIFIED},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), res.Stored)
+	assert.DeepEqual(t, []types.Slot{4}, res.UnknownSlots)
+
+	c, err := beaconDB.PandoraConfirmation(ctx, r)
+	require.NoError(t, err)
+	require.NotNil(t, c)
+	assert.Equal(t, orchestrator.Invalid, c.Status)
+	assert.Equal(t, types.Slot(3), c.Slot)
+	assert.DeepEqual(t, hash, c.PandoraHash[:])
+}
+
+type mockConfirmationReceiver struct {
+	pending  map[types.Slot][][32]byte
+	received []*orchestrator.Confirmation
+	held     []*orchestrator.Confirmation
+}
+
+func (m *mockConfirmationReceiver) PendingBlockRoots(slot types.Slot) [][32]byte {
+	return m.pending[slot]
+}
+
+func (m *mockConfirmationReceiver) ReceivePandoraConfirmations(_ context.Context, confirmations []*orchestrator.Confirmation) error {
+	m.received = append(m.received, confirmations...)
+	return nil
+}
+
+func (m *mockConfirmationReceiver) HoldPandoraConfirmations(confirmations []*orchestrator.Confirmation) {
+	m.held = append(m.held, confirmations...)
+}
+
+func TestServer_ConfirmPandoraBlockHashes_PendingBlocks(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	pendingRoot := [32]byte{'p'}
+	unknownRoot := [32]byte{'u'}
+	receiver := &mockConfirmationReceiver{pending: map[types.Slot][][32]byte{5: {pendingRoot}}}
+	bs := &Server{BeaconDB: beaconDB, ConfirmationStore: beaconDB, PandoraConfirmationReceiver: receiver}
+	res, err := bs.ConfirmPandoraBlockHashes(ctx, &pbrpc.ConfirmPandoraBlockHashesRequest{
+		Confirmations: []*pbrpc.PandoraBlockHashConfirmation{
+			{Slot: 5, Hash: make([]byte, 32), Status: pbrpc.PandoraConfirmation_VERIFIED},
+			{Slot: 6, Hash: make([]byte, 32), Status: pbrpc.PandoraConfirmation_INVALID, BlockRoot: unknownRoot[:]},
+			{Slot: 7, Hash: make([]byte, 32), Status: pbrpc.PandoraConfirmation_INVALID},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), res.Stored)
+	assert.DeepEqual(t, []types.Slot{6, 7}, res.UnknownSlots)
+	require.Equal(t, 1, len(receiver.received))
+	assert.Equal(t, pendingRoot, receiver.received[0].BlockRoot)
+	// The confirmation of the block not received yet is held for it, while the confirmation
+	// without a block root has no block to be held for.
+	require.Equal(t, 1, len(receiver.held))
+	assert.Equal(t, types.Slot(6), receiver.held[0].Slot)
+	assert.Equal(t, unknownRoot, receiver.held[0].BlockRoot)
+	assert.Equal(t, orchestrator.Invalid, receiver.held[0].Status)
+
+	c, err := beaconDB.PandoraConfirmation(ctx, pendingRoot)
+	require.NoError(t, err)
+	require.NotNil(t, c)
+	assert.Equal(t, orchestrator.Verified, c.Status)
+}
+
+func TestServer_ConfirmPandoraBlockHashes_BlockRoot(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	roots := make([][32]byte, 2)
+	for i, graffiti := range []byte{'a', 'b'} {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = 3
+		b.Block.Body.Graffiti = bytesutil.PadTo([]byte{graffiti}, 32)
+		require.NoError(t, beaconDB.SaveBlock(ctx, b))
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		roots[i] = r
+	}
+
+	bs := &Server{BeaconDB: beaconDB, ConfirmationStore: beaconDB}
+	hash := bytesutil.PadTo([]byte{'p'}, 32)
+	res, err := bs.ConfirmPandoraBlockHashes(ctx, &pbrpc.ConfirmPandoraBlockHashesRequest{
+		Confirmations: []*pbrpc.PandoraBlockHashConfirmation{
+			{Slot: 3, Hash: hash, Status: pbrpc.PandoraConfirmation_VERIFIED, BlockRoot: roots[0][:]},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), res.Stored)
+	// The equivocating block of the slot is left unconfirmed.
+	c, err := beaconDB.PandoraConfirmation(ctx, roots[1])
+	require.NoError(t, err)
+	assert.Equal(t, true, c == nil)
+
+	// The block is paired with the confirmed Pandora block only.
+	_, err = bs.ConfirmPandoraBlockHashes(ctx, &pbrpc.ConfirmPandoraBlockHashesRequest{
+		Confirmations: []*pbrpc.PandoraBlockHashConfirmation{
+			{Slot: 3, Hash: bytesutil.PadTo([]byte{'q'}, 32), Status: pbrpc.PandoraConfirmation_INVALID, BlockRoot: roots[0][:]},
+		},
+	})
+	assert.ErrorContains(t, "is paired with Pandora block", err)
+	c, err = beaconDB.PandoraConfirmation(ctx, roots[0])
+	require.NoError(t, err)
+	require.NotNil(t, c)
+	assert.Equal(t, orchestrator.Verified, c.Status)
+}
+
+func TestServer_ConfirmPandoraBlockHashes_InvalidRequest(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	ctx := context.Background()
+	bs := &Server{BeaconDB: beaconDB, ConfirmationStore: beaconDB}
+
+	_, err := bs.ConfirmPandoraBlockHashes(ctx, &pbrpc.ConfirmPandoraBlockHashesRequest{})
+	assert.ErrorContains(t, "No confirmations provided", err)
+
+	_, err = bs.ConfirmPandoraBlockHashes(ctx, &pbrpc.ConfirmPandoraBlockHashesRequest{
+		Confirmations: []*pbrpc.PandoraBlockHashConfirmation{{Slot: 1, Hash: []byte{'a'}, Status: pbrpc.PandoraConfirmation_VERIFIED}},
+	})
+	assert.ErrorContains(t, "Invalid pandora hash length", err)
+
+	_, err = bs.ConfirmPandoraBlockHashes(ctx, &pbrpc.ConfirmPandoraBlockHashesRequest{
+		Confirmations: []*pbrpc.PandoraBlockHashConfirmation{{Slot: 1, Hash: make([]byte, 32), Status: pbrpc.PandoraConfirmation_PENDING}},
+	})
+	assert.ErrorContains(t, "Unsupported confirmation status", err)
+
+	_, err = bs.ConfirmPandoraBlockHashes(ctx, &pbrpc.ConfirmPandoraBlockHashesRequest{
+		Confirmations: []*pbrpc.PandoraBlockHashConfirmation{
+			{Slot: 1, Hash: make([]byte, 32), Status: pbrpc.PandoraConfirmation_VERIFIED, BlockRoot: []byte{'a'}},
+		},
+	})
+	assert.ErrorContains(t, "Invalid block root length", err)
+}
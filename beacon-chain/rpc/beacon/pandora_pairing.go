@@ -0,0 +1,90 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// pandoraPairingHeader is the gRPC response header listing the Pandora blocks paired with the
+// returned blocks, one value per block the orchestrator reported on, formatted as
+// <block root>:<pandora hash>:<status>.
+const pandoraPairingHeader = "x-pandora-pairing"
+
+// reportPandoraPairings sets the Pandora pairing headers of the returned blocks. Blocks carry no
+// Pandora block hash of their own, so the pairings are read from the orchestrator confirmations.
+func (bs *Server) reportPandoraPairings(ctx context.Context, containers []*ethpb.BeaconBlockContainer) error {
+	if bs.ConfirmationStore == nil || len(containers) == 0 {
+		return nil
+	}
+	values := make([]string, 0, 2*len(containers))
+	for _, c := range containers {
+		confirmation, err := bs.ConfirmationStore.PandoraConfirmation(ctx, bytesutil.ToBytes32(c.BlockRoot))
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not get orchestrator confirmation of block %#x: %v", c.BlockRoot, err)
+		}
+		if confirmation == nil {
+			continue
+		}
+		values = append(values, pandoraPairingHeader, pandoraPairing(confirmation))
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs(values...)); err != nil {
+		log.WithError(err).Debug("Could not set Pandora pairing header")
+	}
+	return nil
+}
+
+// pandoraPairing formats the pairing of a confirmation as a Pandora pairing header value.
+func pandoraPairing(c *orchestrator.Confirmation) string {
+	return fmt.Sprintf("%#x:%#x:%s", c.BlockRoot, c.PandoraHash, c.Status)
+}
+
+// GetBlockByPandoraHash returns the block paired with a Pandora block, so that the orchestrator
+// can map execution blocks back to the beacon chain. Only pairings the orchestrator reported on
+// are indexed.
+func (bs *Server) GetBlockByPandoraHash(ctx context.Context, req *pbrpc.GetBlockByPandoraHashRequest) (*pbrpc.PairedBlock, error) {
+	pandoraHash := req.PandoraHash
+	if bs.ConfirmationStore == nil {
+		return nil, status.Error(codes.Unimplemented, "Orchestrator confirmations are not persisted")
+	}
+	if len(pandoraHash) != 32 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid Pandora block hash %#x, want 32 bytes", pandoraHash)
+	}
+	confirmation, err := bs.ConfirmationStore.PandoraConfirmationByHash(ctx, bytesutil.ToBytes32(pandoraHash))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get orchestrator confirmation: %v", err)
+	}
+	if confirmation == nil {
+		return nil, status.Errorf(codes.NotFound, "No block is paired with Pandora block %#x", pandoraHash)
+	}
+	blk, err := bs.BeaconDB.Block(ctx, confirmation.BlockRoot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve block: %v", err)
+	}
+	if blk == nil {
+		return nil, status.Errorf(codes.NotFound, "Could not find block %#x paired with Pandora block %#x", confirmation.BlockRoot, pandoraHash)
+	}
+	canonical, err := bs.CanonicalFetcher.IsCanonical(ctx, confirmation.BlockRoot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not determine if block is canonical: %v", err)
+	}
+	return &pbrpc.PairedBlock{
+		Block: &ethpb.BeaconBlockContainer{
+			Block:     blk,
+			BlockRoot: confirmation.BlockRoot[:],
+			Canonical: canonical,
+		},
+		Confirmation: pandoraConfirmation(confirmation),
+	}, nil
+}
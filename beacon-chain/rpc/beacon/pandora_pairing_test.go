@@ -0,0 +1,87 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+func TestServer_ListBlocks_ReportsPandoraPairings(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	var roots [][32]byte
+	for i := 0; i < 2; i++ {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = 4
+		blk.Block.Body.Graffiti = make([]byte, 32)
+		blk.Block.Body.Graffiti[0] = byte(i)
+		require.NoError(t, db.SaveBlock(ctx, blk))
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		roots = append(roots, root)
+	}
+	confirmation := &orchestrator.Confirmation{Slot: 4, BlockRoot: roots[1], PandoraHash: [32]byte{'p'}, Status: orchestrator.Verified}
+	require.NoError(t, db.SavePandoraConfirmations(ctx, []*orchestrator.Confirmation{confirmation}))
+
+	bs := &Server{
+		BeaconDB:          db,
+		CanonicalFetcher:  &mock.ChainService{},
+		ConfirmationStore: db,
+	}
+	stream := &headerCapturingStream{}
+	res, err := bs.ListBlocks(grpc.NewContextWithServerTransportStream(ctx, stream), &ethpb.ListBlocksRequest{
+		QueryFilter: &ethpb.ListBlocksRequest_Slot{Slot: 4},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(res.BlockContainers))
+	wanted := []string{fmt.Sprintf("%#x:%#x:verified", roots[1], [32]byte{'p'})}
+	assert.DeepEqual(t, wanted, stream.header.Get(pandoraPairingHeader))
+}
+
+func TestServer_GetBlockByPandoraHash(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 9
+	require.NoError(t, db.SaveBlock(ctx, blk))
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	confirmation := &orchestrator.Confirmation{Slot: 9, BlockRoot: root, PandoraHash: [32]byte{'p'}, Status: orchestrator.Pending}
+	require.NoError(t, db.SavePandoraConfirmations(ctx, []*orchestrator.Confirmation{confirmation}))
+
+	bs := &Server{
+		BeaconDB:          db,
+		CanonicalFetcher:  &mock.ChainService{},
+		ConfirmationStore: db,
+	}
+	hash := [32]byte{'p'}
+	res, err := bs.GetBlockByPandoraHash(ctx, &pbrpc.GetBlockByPandoraHashRequest{PandoraHash: hash[:]})
+	require.NoError(t, err)
+	assert.DeepEqual(t, root[:], res.Block.BlockRoot)
+	assert.Equal(t, true, res.Block.Canonical)
+	assert.DeepEqual(t, blk, res.Block.Block)
+	assert.DeepEqual(t, &pbrpc.PandoraConfirmation{
+		Slot:        9,
+		BlockRoot:   root[:],
+		PandoraHash: hash[:],
+		Status:      pbrpc.PandoraConfirmation_PENDING,
+	}, res.Confirmation)
+
+	other := [32]byte{'q'}
+	_, err = bs.GetBlockByPandoraHash(ctx, &pbrpc.GetBlockByPandoraHashRequest{PandoraHash: other[:]})
+	assert.ErrorContains(t, "No block is paired with Pandora block", err)
+	_, err = bs.GetBlockByPandoraHash(ctx, &pbrpc.GetBlockByPandoraHashRequest{PandoraHash: []byte{1, 2}})
+	assert.ErrorContains(t, "want 32 bytes", err)
+}
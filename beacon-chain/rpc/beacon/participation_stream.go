@@ -0,0 +1,184 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamSlotParticipation sends the participation of the slots attested to in every processed
+// block, so that the orchestrator can down-weight epochs with poor participation as they happen.
+func (bs *Server) StreamSlotParticipation(_ *empty.Empty, stream pbrpc.BeaconQuery_StreamSlotParticipationServer) error {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := bs.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+
+	for {
+		select {
+		case ev := <-stateChannel:
+			if ev.Type != statefeed.BlockProcessed {
+				continue
+			}
+			data, ok := ev.Data.(*statefeed.BlockProcessedData)
+			if !ok || data == nil || data.SignedBlock == nil || data.SignedBlock.Block == nil {
+				continue
+			}
+			participation, err := bs.blockParticipation(stream.Context(), data)
+			if err != nil {
+				log.WithError(err).WithField("blockSlot", data.Slot).Error("Could not compute slot participation")
+				continue
+			}
+			for _, p := range participation {
+				if err := stream.Send(p); err != nil {
+					return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+				}
+			}
+		case <-stateSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-bs.Ctx.Done():
+			return status.Error(codes.Canceled, "RPC context canceled")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
+
+// blockParticipation returns the participation of every slot attested to in the block, in slot order.
+func (bs *Server) blockParticipation(ctx context.Context, data *statefeed.BlockProcessedData) ([]*pbrpc.SlotParticipation, error) {
+	atts := data.SignedBlock.Block.Body.Attestations
+	if len(atts) == 0 {
+		return nil, nil
+	}
+	st, err := bs.StateGen.StateByRoot(ctx, data.BlockRoot)
+	if err != nil {
+		return nil, err
+	}
+	if st == nil {
+		return nil, errors.New("nil post state")
+	}
+	slots := make(map[types.Slot]bool)
+	for _, att := range atts {
+		if att.Data != nil {
+			slots[att.Data.Slot] = true
+		}
+	}
+	res := make([]*pbrpc.SlotParticipation, 0, len(slots))
+	for slot := range slots {
+		p, err := slotParticipation(bs.CommitteeCache, st, slot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not compute participation of slot %d", slot)
+		}
+		p.BlockSlot = data.Slot
+		p.BlockRoot = bytesutil.SafeCopyBytes(data.BlockRoot[:])
+		res = append(res, p)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Slot < res[j].Slot
+	})
+	return res, nil
+}
+
+// slotParticipation computes the participation of a slot from the pending attestations of the
+// state, which must be in the epoch of the slot or the one after.
+func slotParticipation(committeeCache *cache.CommitteeCache, st iface.BeaconState, slot types.Slot) (*pbrpc.SlotParticipation, error) {
+	epoch := helpers.SlotToEpoch(slot)
+	var pending []*pbp2p.PendingAttestation
+	var err error
+	switch epoch {
+	case helpers.CurrentEpoch(st):
+		pending, err = st.CurrentEpochAttestations()
+	case helpers.PrevEpoch(st):
+		pending, err = st.PreviousEpochAttestations()
+	default:
+		return nil, errors.Errorf("slot is not in the current or previous epoch of the state at slot %d", st.Slot())
+	}
+	if err != nil {
+		return nil, err
+	}
+	activeCount, err := helpers.ActiveValidatorCount(committeeCache, st, epoch)
+	if err != nil {
+		return nil, err
+	}
+	root, err := helpers.BlockRootAtSlot(st, slot)
+	if err != nil {
+		return nil, err
+	}
+
+	effectiveBalance := func(idx types.ValidatorIndex) (uint64, error) {
+		val, err := st.ValidatorAtIndexReadOnly(idx)
+		if err != nil {
+			return 0, err
+		}
+		return val.EffectiveBalance(), nil
+	}
+	p := &pbrpc.SlotParticipation{Slot: slot}
+	committees := make(map[types.CommitteeIndex][]types.ValidatorIndex)
+	for i := uint64(0); i < helpers.SlotCommitteeCount(activeCount); i++ {
+		committee, err := helpers.BeaconCommitteeFromState(committeeCache, st, slot, types.CommitteeIndex(i))
+		if err != nil {
+			return nil, err
+		}
+		committees[types.CommitteeIndex(i)] = committee
+		for _, idx := range committee {
+			balance, err := effectiveBalance(idx)
+			if err != nil {
+				return nil, err
+			}
+			p.ExpectedBalance += balance
+		}
+	}
+
+	// A validator may be included more than once, so attesters are counted by their first vote.
+	attested := make(map[types.ValidatorIndex]bool)
+	for _, att := range pending {
+		if att.Data == nil || att.Data.Slot != slot {
+			continue
+		}
+		committee, ok := committees[att.Data.CommitteeIndex]
+		if !ok {
+			continue
+		}
+		indices, err := attestationutil.AttestingIndices(att.AggregationBits, committee)
+		if err != nil {
+			return nil, err
+		}
+		headCorrect := bytes.Equal(att.Data.BeaconBlockRoot, root)
+		for _, i := range indices {
+			idx := types.ValidatorIndex(i)
+			if attested[idx] {
+				continue
+			}
+			attested[idx] = true
+			balance, err := effectiveBalance(idx)
+			if err != nil {
+				return nil, err
+			}
+			p.AttestingBalance += balance
+			if headCorrect {
+				p.HeadCorrectBalance += balance
+			}
+		}
+	}
+	if p.ExpectedBalance > 0 {
+		p.ParticipationRate = float64(p.AttestingBalance) / float64(p.ExpectedBalance)
+	}
+	if p.AttestingBalance > 0 {
+		p.HeadCorrectRate = float64(p.HeadCorrectBalance) / float64(p.AttestingBalance)
+	}
+	return p, nil
+}
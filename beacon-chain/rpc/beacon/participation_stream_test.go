@@ -0,0 +1,153 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+type participationTestStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *pbrpc.SlotParticipation
+}
+
+func (s *participationTestStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *participationTestStream) Send(p *pbrpc.SlotParticipation) error {
+	s.sent <- p
+	return nil
+}
+
+// participationState returns a state at slot 5 whose pending attestations for slot 2 include half
+// of its committee, half of which voted for a wrong head.
+func participationState(t *testing.T) (iface.BeaconState, []*ethpb.Attestation) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	st, _ := testutil.DeterministicGenesisState(t, 256)
+	require.NoError(t, st.SetSlot(5))
+	headRoot := bytesutil.PadTo([]byte("head"), 32)
+	require.NoError(t, st.UpdateBlockRootAtIndex(2, bytesutil.ToBytes32(headRoot)))
+
+	committee, err := helpers.BeaconCommitteeFromState(nil, st, 2, 0)
+	require.NoError(t, err)
+	require.Equal(t, 8, len(committee))
+	correct := bitfield.NewBitlist(uint64(len(committee)))
+	wrong := bitfield.NewBitlist(uint64(len(committee)))
+	correct.SetBitAt(0, true)
+	correct.SetBitAt(1, true)
+	// The second attestation includes validator 1 again, which counts for its first vote only.
+	wrong.SetBitAt(1, true)
+	wrong.SetBitAt(2, true)
+	wrong.SetBitAt(3, true)
+	att := func(bits bitfield.Bitlist, root []byte) *ethpb.Attestation {
+		return &ethpb.Attestation{
+			AggregationBits: bits,
+			Data: &ethpb.AttestationData{
+				Slot:            2,
+				BeaconBlockRoot: root,
+				Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			},
+			Signature: make([]byte, 96),
+		}
+	}
+	atts := []*ethpb.Attestation{att(correct, headRoot), att(wrong, make([]byte, 32))}
+	for _, att := range atts {
+		require.NoError(t, st.AppendCurrentEpochAttestations(&pbp2p.PendingAttestation{
+			AggregationBits: att.AggregationBits,
+			Data:            att.Data,
+		}))
+	}
+	return st, atts
+}
+
+func TestSlotParticipation(t *testing.T) {
+	st, _ := participationState(t)
+	balance := params.BeaconConfig().MaxEffectiveBalance
+
+	p, err := slotParticipation(nil, st, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 8*balance, p.ExpectedBalance)
+	assert.Equal(t, 4*balance, p.AttestingBalance)
+	assert.Equal(t, 2*balance, p.HeadCorrectBalance)
+	assert.Equal(t, 0.5, p.ParticipationRate)
+	assert.Equal(t, 0.5, p.HeadCorrectRate)
+
+	p, err = slotParticipation(nil, st, 3)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), p.AttestingBalance)
+	assert.Equal(t, float64(0), p.ParticipationRate)
+
+	_, err = slotParticipation(nil, st, params.BeaconConfig().SlotsPerEpoch)
+	assert.ErrorContains(t, "slot is not in the current or previous epoch", err)
+}
+
+func TestServer_StreamSlotParticipation(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	st, atts := participationState(t)
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 5
+	blk.Block.Body.Attestations = atts
+	require.NoError(t, db.SaveBlock(ctx, blk))
+	blockRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, blockRoot))
+
+	notifier := &mock.MockStateNotifier{}
+	bs := &Server{
+		Ctx:           ctx,
+		StateNotifier: notifier,
+		StateGen:      stategen.New(db),
+	}
+	streamCtx, cancelStream := context.WithCancel(ctx)
+	stream := &participationTestStream{ctx: streamCtx, sent: make(chan *pbrpc.SlotParticipation, 1)}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- bs.StreamSlotParticipation(&empty.Empty{}, stream)
+	}()
+
+	ev := &feed.Event{
+		Type: statefeed.BlockProcessed,
+		Data: &statefeed.BlockProcessedData{Slot: 5, BlockRoot: blockRoot, SignedBlock: blk},
+	}
+	for notifier.StateFeed().Send(ev) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	select {
+	case p := <-stream.sent:
+		assert.DeepEqual(t, blockRoot[:], p.BlockRoot)
+		assert.Equal(t, st.Slot(), p.BlockSlot)
+		assert.Equal(t, st.Slot()-3, p.Slot)
+		assert.Equal(t, 0.5, p.ParticipationRate)
+	case <-time.After(10 * time.Second):
+		t.Fatal("Timed out waiting for slot participation")
+	}
+	cancelStream()
+	assert.ErrorContains(t, "Context canceled", <-errs)
+}
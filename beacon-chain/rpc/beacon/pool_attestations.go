@@ -0,0 +1,86 @@
+package beacon
+
+import (
+	"context"
+	"sort"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListPoolAttestations returns the unaggregated and aggregated attestations currently in the
+// operations pool, grouped by the committee they attest for, so that operators can find out why
+// the attestations of a committee are missing from blocks. Attestations already included in a
+// block or only kept for fork choice are not listed.
+func (bs *Server) ListPoolAttestations(ctx context.Context, req *pbrpc.ListPoolAttestationsRequest) (*pbrpc.PoolAttestations, error) {
+	if req == nil {
+		req = &pbrpc.ListPoolAttestationsRequest{}
+	}
+	var unaggregated, aggregated []*ethpb.Attestation
+	if req.GetSlotFilter() != nil && req.GetCommitteeFilter() != nil {
+		unaggregated = bs.AttestationsPool.UnaggregatedAttestationsBySlotIndex(ctx, req.GetSlot(), req.GetCommitteeIndex())
+		aggregated = bs.AttestationsPool.AggregatedAttestationsBySlotIndex(ctx, req.GetSlot(), req.GetCommitteeIndex())
+	} else {
+		var err error
+		unaggregated, err = bs.AttestationsPool.UnaggregatedAttestations()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get unaggregated attestations: %v", err)
+		}
+		aggregated = bs.AttestationsPool.AggregatedAttestations()
+	}
+
+	type committeeKey struct {
+		slot  types.Slot
+		index types.CommitteeIndex
+	}
+	groups := make(map[committeeKey]*pbrpc.PoolCommitteeAttestations)
+	participants := make(map[committeeKey]map[uint64]bool)
+	group := func(att *ethpb.Attestation) *pbrpc.PoolCommitteeAttestations {
+		if att == nil || att.Data == nil {
+			return nil
+		}
+		if req.GetSlotFilter() != nil && att.Data.Slot != req.GetSlot() {
+			return nil
+		}
+		if req.GetCommitteeFilter() != nil && att.Data.CommitteeIndex != req.GetCommitteeIndex() {
+			return nil
+		}
+		key := committeeKey{slot: att.Data.Slot, index: att.Data.CommitteeIndex}
+		g, ok := groups[key]
+		if !ok {
+			g = &pbrpc.PoolCommitteeAttestations{Slot: key.slot, CommitteeIndex: key.index}
+			groups[key] = g
+			participants[key] = make(map[uint64]bool)
+		}
+		for _, i := range att.AggregationBits.BitIndices() {
+			participants[key][uint64(i)] = true
+		}
+		return g
+	}
+	for _, att := range unaggregated {
+		if g := group(att); g != nil {
+			g.Unaggregated = append(g.Unaggregated, att)
+		}
+	}
+	for _, att := range aggregated {
+		if g := group(att); g != nil {
+			g.Aggregated = append(g.Aggregated, att)
+		}
+	}
+
+	res := &pbrpc.PoolAttestations{Committees: make([]*pbrpc.PoolCommitteeAttestations, 0, len(groups))}
+	for key, g := range groups {
+		g.Participants = uint64(len(participants[key]))
+		res.Committees = append(res.Committees, g)
+	}
+	sort.Slice(res.Committees, func(i, j int) bool {
+		if res.Committees[i].Slot != res.Committees[j].Slot {
+			return res.Committees[i].Slot < res.Committees[j].Slot
+		}
+		return res.Committees[i].CommitteeIndex < res.Committees[j].CommitteeIndex
+	})
+	return res, nil
+}
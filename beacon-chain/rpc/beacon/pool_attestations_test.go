@@ -0,0 +1,76 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_ListPoolAttestations(t *testing.T) {
+	ctx := context.Background()
+	bs := &Server{AttestationsPool: attestations.NewPool()}
+	newAtt := func(slot types.Slot, index types.CommitteeIndex, bits bitfield.Bitlist) *ethpb.Attestation {
+		att := testutil.NewAttestation()
+		att.Data.Slot = slot
+		att.Data.CommitteeIndex = index
+		att.AggregationBits = bits
+		return att
+	}
+	require.NoError(t, bs.AttestationsPool.SaveUnaggregatedAttestations([]*ethpb.Attestation{
+		newAtt(2, 1, bitfield.Bitlist{0b10001}),
+		newAtt(2, 1, bitfield.Bitlist{0b10010}),
+		newAtt(1, 0, bitfield.Bitlist{0b10100}),
+	}))
+	require.NoError(t, bs.AttestationsPool.SaveAggregatedAttestations([]*ethpb.Attestation{
+		newAtt(2, 1, bitfield.Bitlist{0b10011}),
+		newAtt(2, 0, bitfield.Bitlist{0b11110}),
+	}))
+
+	res, err := bs.ListPoolAttestations(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(res.Committees))
+	got := make([][2]uint64, 0, len(res.Committees))
+	for _, c := range res.Committees {
+		got = append(got, [2]uint64{uint64(c.Slot), uint64(c.CommitteeIndex)})
+	}
+	assert.DeepEqual(t, [][2]uint64{{1, 0}, {2, 0}, {2, 1}}, got, "Expected committees ordered by slot and index")
+	c := res.Committees[2]
+	assert.Equal(t, 2, len(c.Unaggregated))
+	assert.Equal(t, 1, len(c.Aggregated))
+	assert.Equal(t, uint64(2), c.Participants, "Expected members attesting twice to be counted once")
+	assert.Equal(t, uint64(3), res.Committees[1].Participants)
+	assert.Equal(t, 0, len(res.Committees[1].Unaggregated))
+
+	slot := types.Slot(2)
+	res, err = bs.ListPoolAttestations(ctx, &pbrpc.ListPoolAttestationsRequest{SlotFilter: &pbrpc.ListPoolAttestationsRequest_Slot{Slot: slot}})
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(res.Committees))
+
+	index := types.CommitteeIndex(1)
+	res, err = bs.ListPoolAttestations(ctx, &pbrpc.ListPoolAttestationsRequest{
+		SlotFilter:      &pbrpc.ListPoolAttestationsRequest_Slot{Slot: slot},
+		CommitteeFilter: &pbrpc.ListPoolAttestationsRequest_CommitteeIndex{CommitteeIndex: index},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Committees))
+	assert.Equal(t, 2, len(res.Committees[0].Unaggregated))
+	assert.Equal(t, 1, len(res.Committees[0].Aggregated))
+
+	index = 0
+	res, err = bs.ListPoolAttestations(ctx, &pbrpc.ListPoolAttestationsRequest{CommitteeFilter: &pbrpc.ListPoolAttestationsRequest_CommitteeIndex{CommitteeIndex: index}})
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(res.Committees))
+
+	slot = 7
+	res, err = bs.ListPoolAttestations(ctx, &pbrpc.ListPoolAttestationsRequest{SlotFilter: &pbrpc.ListPoolAttestationsRequest_Slot{Slot: slot}})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Committees))
+}
@@ -0,0 +1,25 @@
+package beacon
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetPrecomputationStatus reports whether the proposer list precomputation of the current epoch
+// is overdue, which usually points at slow state regeneration on the node.
+func (bs *Server) GetPrecomputationStatus(_ context.Context, _ *empty.Empty) (*pbrpc.PrecomputationStatus, error) {
+	if bs.PrecomputationStatusFetcher == nil {
+		return nil, status.Error(codes.Unavailable, "Precomputation status is not available")
+	}
+	st := bs.PrecomputationStatusFetcher.PrecomputationStatus()
+	return &pbrpc.PrecomputationStatus{
+		Enabled:              st.Enabled,
+		LastPrecomputedEpoch: st.LastPrecomputedEpoch,
+		Stalled:              st.Stalled,
+		StalledEpoch:         st.StalledEpoch,
+	}, nil
+}
@@ -0,0 +1,34 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type mockPrecomputationFetcher struct {
+	status *blockchain.PrecomputationStatus
+}
+
+func (m *mockPrecomputationFetcher) PrecomputationStatus() *blockchain.PrecomputationStatus {
+	return m.status
+}
+
+func TestServer_GetPrecomputationStatus(t *testing.T) {
+	bs := &Server{PrecomputationStatusFetcher: &mockPrecomputationFetcher{
+		status: &blockchain.PrecomputationStatus{Enabled: true, LastPrecomputedEpoch: 4, Stalled: true, StalledEpoch: 5},
+	}}
+	res, err := bs.GetPrecomputationStatus(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	wanted := &pbrpc.PrecomputationStatus{Enabled: true, LastPrecomputedEpoch: 4, Stalled: true, StalledEpoch: 5}
+	assert.DeepEqual(t, wanted, res)
+
+	bs = &Server{}
+	_, err = bs.GetPrecomputationStatus(context.Background(), &empty.Empty{})
+	assert.ErrorContains(t, "Precomputation status is not available", err)
+}
@@ -0,0 +1,219 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// proposerAuditDelay is the number of slots between a slot and its audit. A slot is audited at the
+// start of the slot after next, so that its block had a whole slot past its deadline to arrive.
+const proposerAuditDelay = types.Slot(2)
+
+// proposerAuditStreamBuffer is the number of audits buffered per stream before the oldest are dropped.
+const proposerAuditStreamBuffer = 32
+
+var proposerAudits = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "proposer_audit_slots_total",
+		Help: "The number of audited slots, by whether their expected proposer produced the canonical block.",
+	},
+	[]string{"outcome"},
+)
+
+// StreamProposerAudit sends the audit of every slot once it is past its deadline, reporting
+// whether its expected proposer produced the canonical block, so that the orchestrator can
+// penalize the validators missing their proposals. Slots are audited once for all streams, and
+// streams falling behind miss the oldest audits. Slots are not audited while the node syncs.
+// Tracked only streams are restricted to the slots where a tracked validator was the expected or
+// actual proposer.
+func (bs *Server) StreamProposerAudit(req *pbrpc.StreamProposerAuditRequest, stream pbrpc.BeaconQuery_StreamProposerAuditServer) error {
+	trackedOnly, err := bs.trackedOnly(req.TrackedOnly)
+	if err != nil {
+		return err
+	}
+	auditor := bs.proposerAuditorInstance()
+	auditChannel := make(chan *pbrpc.ProposerAudit, proposerAuditStreamBuffer)
+	auditSub := auditor.feed.SubscribeWithPolicy(auditChannel, "proposer_audit_stream", event.DropOldest)
+	defer auditSub.Unsubscribe()
+
+	for {
+		select {
+		case audit := <-auditChannel:
+			if trackedOnly &&
+				!bs.TrackedValidators.Has(bytesutil.ToBytes48(audit.ExpectedProposer)) &&
+				!bs.TrackedValidators.Has(bytesutil.ToBytes48(audit.ActualProposer)) {
+				continue
+			}
+			if err := stream.Send(audit); err != nil {
+				return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+			}
+		case <-auditSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-bs.Ctx.Done():
+			return grpcutils.ShuttingDownError()
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
+
+// lazyProposerAuditor holds a proposer auditor which is only started once a stream needs it.
+type lazyProposerAuditor struct {
+	once    sync.Once
+	auditor *proposerAuditor
+}
+
+// proposerAuditor audits every slot once, counting the outcomes in metrics and multicasting the
+// audits to the streams.
+type proposerAuditor struct {
+	audit func(ctx context.Context, slot types.Slot) (*pbrpc.ProposerAudit, bool, error)
+	feed  event.Feed
+}
+
+// proposerAuditorInstance returns the proposer auditor, starting it on first use.
+func (bs *Server) proposerAuditorInstance() *proposerAuditor {
+	bs.proposerAuditor.once.Do(func() {
+		a := &proposerAuditor{audit: bs.auditSlot}
+		bs.proposerAuditor.auditor = a
+		ticker := slotutil.NewSlotTicker(bs.GenesisTimeFetcher.GenesisTime(), params.BeaconConfigOf(bs.ChainConfig).SecondsPerSlot)
+		go func() {
+			defer ticker.Done()
+			a.run(bs.Ctx, ticker.C())
+		}()
+	})
+	return bs.proposerAuditor.auditor
+}
+
+// run audits the slot proposerAuditDelay slots before every slot ticked, until the context is done.
+func (a *proposerAuditor) run(ctx context.Context, ticks <-chan types.Slot) {
+	for {
+		select {
+		case slot := <-ticks:
+			if slot < proposerAuditDelay {
+				continue
+			}
+			audited := slot - proposerAuditDelay
+			audit, ok, err := a.audit(ctx, audited)
+			if err != nil {
+				log.WithError(err).WithField("slot", audited).Error("Could not audit proposer")
+				continue
+			}
+			if !ok {
+				continue
+			}
+			proposerAudits.WithLabelValues(strings.ToLower(audit.Outcome.String())).Inc()
+			a.feed.Send(audit)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// auditSlot compares the expected proposer of a slot with its canonical block. The returned bool
+// is false if the slot is not audited, because the node is syncing or the slot has no computable
+// proposer.
+func (bs *Server) auditSlot(ctx context.Context, slot types.Slot) (*pbrpc.ProposerAudit, bool, error) {
+	if bs.SyncChecker != nil && bs.SyncChecker.Syncing() {
+		return nil, false, nil
+	}
+	epoch := helpers.SlotToEpoch(slot)
+	encoded, err := bs.epochInfoHubInstance().epochInfo(ctx, epoch)
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "could not get epoch info of epoch %d", epoch)
+	}
+	info := &orchestrator.EpochInfo{}
+	if err := info.UnmarshalBinary(encoded.payload); err != nil {
+		return nil, false, err
+	}
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, false, err
+	}
+	offset := int(slot - startSlot)
+	if offset >= len(info.Proposers) {
+		return nil, false, errors.Errorf("epoch info of epoch %d has no proposer at slot %d", epoch, slot)
+	}
+	if info.Skipped(offset) {
+		return nil, false, nil
+	}
+
+	audit := &pbrpc.ProposerAudit{
+		Slot:             slot,
+		ExpectedProposer: bytesutil.SafeCopyBytes(info.Proposers[offset][:]),
+		Outcome:          pbrpc.ProposerAudit_MISSED,
+	}
+	root, ok, err := bs.canonicalBlockRoot(ctx, slot)
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "could not get canonical block of slot %d", slot)
+	}
+	if !ok {
+		return audit, true, nil
+	}
+	blk, err := bs.BeaconDB.Block(ctx, root)
+	if err != nil {
+		return nil, false, err
+	}
+	if blk == nil || blk.Block == nil {
+		return nil, false, errors.Errorf("could not find canonical block %#x", root)
+	}
+	headState, err := bs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	// Validator indices are never reused, so the head state knows the key of every proposer.
+	actual := headState.PubkeyAtIndex(blk.Block.ProposerIndex)
+	audit.BlockRoot = root[:]
+	audit.ActualProposer = actual[:]
+	audit.Outcome = pbrpc.ProposerAudit_PRODUCED
+	if actual != info.Proposers[offset] {
+		audit.Outcome = pbrpc.ProposerAudit_MISMATCH
+		log.WithFields(logrus.Fields{
+			"slot":     slot,
+			"expected": fmt.Sprintf("%#x", audit.ExpectedProposer),
+			"actual":   fmt.Sprintf("%#x", audit.ActualProposer),
+		}).Warn("Canonical block was produced by another proposer than expected")
+	}
+	return audit, true, nil
+}
+
+// canonicalBlockRoot returns the root of the canonical block of a slot, and false if the slot has
+// none. The canonical root index is preferred over the database.
+func (bs *Server) canonicalBlockRoot(ctx context.Context, slot types.Slot) ([32]byte, bool, error) {
+	if bs.CanonicalRootFetcher != nil {
+		if root, ok, err := bs.CanonicalRootFetcher.CanonicalRootAtSlot(slot); err == nil {
+			return root, ok, nil
+		}
+	}
+	hasBlockRoots, roots, err := bs.BeaconDB.BlockRootsBySlot(ctx, slot)
+	if err != nil || !hasBlockRoots {
+		return [32]byte{}, false, err
+	}
+	for _, root := range roots {
+		canonical, err := bs.CanonicalFetcher.IsCanonical(ctx, root)
+		if err != nil {
+			return [32]byte{}, false, err
+		}
+		if canonical {
+			return root, true, nil
+		}
+	}
+	return [32]byte{}, false, nil
+}
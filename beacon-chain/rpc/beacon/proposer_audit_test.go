@@ -0,0 +1,216 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+type proposerAuditTestStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	audits chan *pbrpc.ProposerAudit
+}
+
+func (s *proposerAuditTestStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *proposerAuditTestStream) Send(audit *pbrpc.ProposerAudit) error {
+	s.audits <- audit
+	return nil
+}
+
+func TestServer_AuditSlot(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	db := dbTest.SetupDB(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := setupActiveValidators(t, 64)
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, s, genesisRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+
+	expected := func(slot types.Slot) types.ValidatorIndex {
+		st := s.Copy()
+		require.NoError(t, st.SetSlot(slot))
+		proposer, err := helpers.BeaconProposerIndex(nil, st)
+		require.NoError(t, err)
+		return proposer
+	}
+	saveBlock := func(slot types.Slot, proposer types.ValidatorIndex) [32]byte {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = slot
+		blk.Block.ProposerIndex = proposer
+		require.NoError(t, db.SaveBlock(ctx, blk))
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		return root
+	}
+	pubKeyAt := func(idx types.ValidatorIndex) []byte {
+		pubKey := s.PubkeyAtIndex(idx)
+		return pubKey[:]
+	}
+	// Slot 1 is proposed as expected, slot 2 is missed and slot 3 is proposed by another validator.
+	r1 := saveBlock(1, expected(1))
+	other := (expected(3) + 1) % 64
+	r3 := saveBlock(3, other)
+
+	currentSlot := types.Slot(5)
+	bs := &Server{
+		Ctx:                ctx,
+		BeaconDB:           db,
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot, Genesis: time.Unix(1600000000, 0)},
+		HeadFetcher:        &mock.ChainService{State: s},
+		StateNotifier:      &mock.MockStateNotifier{},
+		StateGen:           stategen.New(db),
+		CanonicalRootFetcher: &mockCanonicalRootFetcher{
+			head:  5,
+			roots: map[types.Slot][32]byte{0: genesisRoot, 1: r1, 3: r3},
+		},
+	}
+
+	_, ok, err := bs.auditSlot(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, false, ok, "The genesis slot has no proposer to audit")
+
+	audit, ok, err := bs.auditSlot(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, &pbrpc.ProposerAudit{
+		Slot:             1,
+		ExpectedProposer: pubKeyAt(expected(1)),
+		BlockRoot:        r1[:],
+		ActualProposer:   pubKeyAt(expected(1)),
+		Outcome:          pbrpc.ProposerAudit_PRODUCED,
+	}, audit)
+
+	audit, ok, err = bs.auditSlot(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, &pbrpc.ProposerAudit{
+		Slot:             2,
+		ExpectedProposer: pubKeyAt(expected(2)),
+		Outcome:          pbrpc.ProposerAudit_MISSED,
+	}, audit)
+
+	audit, ok, err = bs.auditSlot(ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, &pbrpc.ProposerAudit{
+		Slot:             3,
+		ExpectedProposer: pubKeyAt(expected(3)),
+		BlockRoot:        r3[:],
+		ActualProposer:   pubKeyAt(other),
+		Outcome:          pbrpc.ProposerAudit_MISMATCH,
+	}, audit)
+
+	// Slots which are not indexed are looked up in the database.
+	bs.CanonicalRootFetcher = nil
+	bs.CanonicalFetcher = &mock.ChainService{CanonicalRoots: map[[32]byte]bool{r1: true}}
+	audit, ok, err = bs.auditSlot(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, true, ok)
+	assert.Equal(t, pbrpc.ProposerAudit_PRODUCED, audit.Outcome)
+	audit, ok, err = bs.auditSlot(ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, true, ok)
+	assert.Equal(t, pbrpc.ProposerAudit_MISSED, audit.Outcome, "Expected the non canonical block to be ignored")
+
+	bs.SyncChecker = &mockSync.Sync{IsSyncing: true}
+	_, ok, err = bs.auditSlot(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, false, ok, "Expected no audit while syncing")
+}
+
+func TestServer_StreamProposerAudit(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	audited := make(chan types.Slot, 1)
+	auditor := &proposerAuditor{audit: func(_ context.Context, slot types.Slot) (*pbrpc.ProposerAudit, bool, error) {
+		audited <- slot
+		return &pbrpc.ProposerAudit{Slot: slot, Outcome: pbrpc.ProposerAudit_MISSED}, slot%2 == 0, nil
+	}}
+	bs := &Server{Ctx: ctx}
+	bs.proposerAuditor.once.Do(func() {
+		bs.proposerAuditor.auditor = auditor
+	})
+	ticks := make(chan types.Slot)
+	go auditor.run(ctx, ticks)
+
+	stream := &proposerAuditTestStream{ctx: context.Background(), audits: make(chan *pbrpc.ProposerAudit, 1)}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- bs.StreamProposerAudit(&pbrpc.StreamProposerAuditRequest{}, stream)
+	}()
+	// The stream subscribes asynchronously, so the audit is sent until it is received.
+	for auditor.feed.Send(&pbrpc.ProposerAudit{Slot: 1}) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, types.Slot(1), (<-stream.audits).Slot)
+
+	// Slots are audited proposerAuditDelay slots after the tick, and unaudited slots are not sent.
+	ticks <- 1
+	ticks <- 5
+	assert.Equal(t, types.Slot(3), <-audited)
+	ticks <- 6
+	assert.Equal(t, types.Slot(4), <-audited)
+	audit := <-stream.audits
+	assert.Equal(t, types.Slot(4), audit.Slot)
+	assert.Equal(t, pbrpc.ProposerAudit_MISSED, audit.Outcome)
+
+	cancel()
+	assert.ErrorContains(t, "shutting down", <-errs)
+}
+
+func TestServer_StreamProposerAudit_TrackedOnly(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	tracked, err := NewTrackedValidatorsFromDB(ctx, dbTest.SetupDB(t))
+	require.NoError(t, err)
+	require.NoError(t, tracked.Update([][]byte{pubKey(1), pubKey(2)}, nil))
+	bs := &Server{Ctx: ctx, TrackedValidators: tracked}
+	auditor := &proposerAuditor{}
+	bs.proposerAuditor.once.Do(func() {
+		bs.proposerAuditor.auditor = auditor
+	})
+
+	stream := &proposerAuditTestStream{ctx: context.Background(), audits: make(chan *pbrpc.ProposerAudit, 3)}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- bs.StreamProposerAudit(&pbrpc.StreamProposerAuditRequest{TrackedOnly: true}, stream)
+	}()
+	for auditor.feed.Send(&pbrpc.ProposerAudit{Slot: 1, ExpectedProposer: pubKey(1)}) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	auditor.feed.Send(&pbrpc.ProposerAudit{Slot: 2, ExpectedProposer: pubKey(3)})
+	auditor.feed.Send(&pbrpc.ProposerAudit{
+		Slot:             3,
+		ExpectedProposer: pubKey(3),
+		ActualProposer:   pubKey(2),
+	})
+	assert.Equal(t, types.Slot(1), (<-stream.audits).Slot)
+	assert.Equal(t, types.Slot(3), (<-stream.audits).Slot, "Expected the untracked slot to be skipped")
+
+	cancel()
+	assert.ErrorContains(t, "shutting down", <-errs)
+}
@@ -0,0 +1,53 @@
+package beacon
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetProposerListConsistency reports how many peers computed the same proposer list for an epoch
+// as this node, from the proposer list digests exchanged over gossip. Peers whose proposer list
+// diverges would fork off the orchestrator once the epoch starts. Digests are only exchanged by
+// nodes enabling the exchange, and are retained for the most recent epochs only.
+func (bs *Server) GetProposerListConsistency(_ context.Context, req *pbrpc.ProposerListConsistencyRequest) (*pbrpc.ProposerListConsistency, error) {
+	if bs.ProposerDigests == nil {
+		return nil, status.Error(codes.Unimplemented, "Proposer list digests are not exchanged by this node")
+	}
+	currentEpoch := helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
+	if req.Epoch > currentEpoch+1 {
+		return nil, futureEpochError(currentEpoch, req.Epoch)
+	}
+	if oldest := bs.ProposerDigests.OldestEpoch(); req.Epoch < oldest {
+		return nil, grpcutils.EpochOutOfRangeError(
+			codes.OutOfRange,
+			&grpcutils.EpochOutOfRange{
+				Current:         currentEpoch,
+				Requested:       req.Epoch,
+				OldestAvailable: oldest,
+			},
+			"Proposer list digests of epoch %d are no longer retained, the oldest retained epoch is %d",
+			req.Epoch,
+			oldest,
+		)
+	}
+
+	res := &pbrpc.ProposerListConsistency{Epoch: req.Epoch}
+	consistency, ok := bs.ProposerDigests.Consistency(req.Epoch)
+	if !ok {
+		// No digest of the epoch was computed or gossiped yet.
+		return res, nil
+	}
+	if consistency.HasDigest {
+		res.Digest = consistency.Digest[:]
+	}
+	res.Peers = uint64(consistency.Peers)
+	res.MatchingPeers = uint64(consistency.Matching)
+	res.Score = consistency.Score()
+	res.DivergentPeers = consistency.DivergentPeers
+	return res, nil
+}
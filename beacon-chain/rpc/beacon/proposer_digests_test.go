@@ -0,0 +1,68 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_GetProposerListConsistency(t *testing.T) {
+	ctx := context.Background()
+	digests := cache.NewProposerDigestCache()
+	digests.SetDigest(10, [32]byte{'a'})
+	digests.AddPeerDigest(10, "peer1", [32]byte{'a'})
+	digests.AddPeerDigest(10, "peer2", [32]byte{'b'})
+	digests.AddPeerDigest(11, "peer1", [32]byte{'c'})
+	currentSlot := params.BeaconConfig().SlotsPerEpoch * 10
+	bs := &Server{
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		ProposerDigests:    digests,
+	}
+
+	res, err := bs.GetProposerListConsistency(ctx, &pbrpc.ProposerListConsistencyRequest{Epoch: 10})
+	require.NoError(t, err)
+	want := [32]byte{'a'}
+	assert.DeepEqual(t, &pbrpc.ProposerListConsistency{
+		Epoch:          10,
+		Digest:         want[:],
+		Peers:          2,
+		MatchingPeers:  1,
+		Score:          0.5,
+		DivergentPeers: []string{"peer2"},
+	}, res)
+
+	// The next epoch was gossiped by a peer before this node computed its proposer list.
+	res, err = bs.GetProposerListConsistency(ctx, &pbrpc.ProposerListConsistencyRequest{Epoch: 11})
+	require.NoError(t, err)
+	assert.DeepEqual(t, &pbrpc.ProposerListConsistency{
+		Epoch:          11,
+		Peers:          1,
+		DivergentPeers: []string{"peer1"},
+	}, res)
+
+	_, err = bs.GetProposerListConsistency(ctx, &pbrpc.ProposerListConsistencyRequest{Epoch: 12})
+	assert.ErrorContains(t, "Cannot retrieve information about an epoch in the future", err)
+
+	_, err = bs.GetProposerListConsistency(ctx, &pbrpc.ProposerListConsistencyRequest{Epoch: 7})
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+	details, ok := grpcutils.EpochOutOfRangeFromError(err)
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, &grpcutils.EpochOutOfRange{Current: 10, Requested: 7, OldestAvailable: 8}, details)
+}
+
+func TestServer_GetProposerListConsistency_Disabled(t *testing.T) {
+	currentSlot := types.Slot(0)
+	bs := &Server{GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot}}
+	_, err := bs.GetProposerListConsistency(context.Background(), &pbrpc.ProposerListConsistencyRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
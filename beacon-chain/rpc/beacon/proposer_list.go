@@ -0,0 +1,86 @@
+package beacon
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NextEpochProposerList returns the proposer of every slot of an epoch in slot order, keyed by
+// explicit slot numbers, so that the orchestrator can pair the Pandora blocks of the epoch with
+// their proposers without inverting the proposer slots of the validator assignments. Proposers
+// are determined up to MinSeedLookahead epochs after the current epoch, and the proposer list of
+// an epoch which is not finalized is provisional.
+func (bs *Server) NextEpochProposerList(ctx context.Context, req *pbrpc.ProposerListRequest) (*pbrpc.ProposerList, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconChainServer.NextEpochProposerList")
+	defer span.End()
+
+	currentEpoch := helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
+	epoch := currentEpoch + 1
+	if q, ok := req.QueryFilter.(*pbrpc.ProposerListRequest_Epoch); ok {
+		epoch = q.Epoch
+	}
+	if epoch > currentEpoch+params.BeaconConfigOf(bs.ChainConfig).MinSeedLookahead {
+		return nil, futureEpochError(currentEpoch, epoch)
+	}
+	info, err := bs.proposerListEpochInfo(ctx, epoch)
+	if err != nil {
+		if _, ok := grpcutils.EpochOutOfRangeFromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "Could not compute proposers of epoch %d: %v", epoch, err)
+	}
+	list, err := info.ProposerList()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not build proposer list of epoch %d: %v", epoch, err)
+	}
+	return list.ToProto(), nil
+}
+
+// proposerListEpochInfo returns the epoch info of an epoch from the epoch info hub, so that the
+// proposer list matches the epoch info stream. Epochs after the lookahead of the hub are derived
+// from the head state advanced to their start slot instead, and are always provisional.
+func (bs *Server) proposerListEpochInfo(ctx context.Context, epoch types.Epoch) (*orchestrator.EpochInfo, error) {
+	headState, err := bs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head state")
+	}
+	if headState == nil {
+		return nil, errors.New("head state is not available")
+	}
+	if epoch <= helpers.SlotToEpoch(headState.Slot())+bs.dutyBroadcastLookahead() {
+		encoded, err := epochInfoWithRetry(ctx, bs.epochInfoHubInstance(), epoch)
+		if err != nil {
+			return nil, err
+		}
+		info := &orchestrator.EpochInfo{}
+		if err := info.UnmarshalBinary(encoded.payload); err != nil {
+			return nil, err
+		}
+		return info, nil
+	}
+
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, err
+	}
+	st, err := state.ProcessSlots(ctx, bs.CommitteeCache, headState.Copy(), startSlot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not process slots up to %d", startSlot)
+	}
+	proposers, err := orchestrator.EpochProposers(bs.CommitteeCache, st, epoch)
+	if err != nil {
+		return nil, err
+	}
+	return &orchestrator.EpochInfo{Epoch: epoch, Proposers: proposers, Provisional: true}, nil
+}
@@ -0,0 +1,27 @@
+package beacon
+
+import (
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+)
+
+// proposerListRoot returns the root of the proposer list of the epoch, which the state must be in.
+// The slot of the state is moved. An epoch without active validators has no proposers, and its
+// root is left zeroed.
+func proposerListRoot(committeeCache *cache.CommitteeCache, st iface.BeaconState, epoch types.Epoch) ([32]byte, error) {
+	activeCount, err := helpers.ActiveValidatorCount(committeeCache, st, epoch)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	if activeCount == 0 {
+		return [32]byte{}, nil
+	}
+	proposers, err := orchestrator.EpochProposers(committeeCache, st, epoch)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return orchestrator.ProposerListRoot(proposers)
+}
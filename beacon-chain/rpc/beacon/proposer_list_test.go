@@ -0,0 +1,80 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_NextEpochProposerList_HeadLookahead(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	ctx := context.Background()
+
+	s := setupActiveValidators(t, 64)
+	currentSlot := types.Slot(0)
+	bs := &Server{
+		GenesisTimeFetcher: &mock.ChainService{Genesis: time.Unix(1600000000, 0), Slot: &currentSlot},
+		HeadFetcher:        &mock.ChainService{State: s},
+	}
+	want, err := (&Server{
+		GenesisTimeFetcher:     bs.GenesisTimeFetcher,
+		HeadFetcher:            bs.HeadFetcher,
+		DutyBroadcastLookahead: 1,
+	}).computeEpochInfo(ctx, 1)
+	require.NoError(t, err)
+
+	// The next epoch is after the lookahead of the hub, so it is derived from the head state.
+	list, err := bs.NextEpochProposerList(ctx, &pbrpc.ProposerListRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(1), list.Epoch)
+	assert.Equal(t, true, list.Provisional)
+	require.Equal(t, len(want.Proposers), len(list.Proposers))
+	for i, p := range list.Proposers {
+		assert.Equal(t, params.BeaconConfig().SlotsPerEpoch+types.Slot(i), p.Slot)
+		assert.DeepEqual(t, want.Proposers[i][:], p.PublicKey)
+	}
+	assert.DeepEqual(t, want.ProposerListRoot[:], list.ProposerListRoot)
+	assert.Equal(t, types.Slot(0), s.Slot(), "The head state should not be modified")
+}
+
+func TestServer_NextEpochProposerList_FromEpochInfoHub(t *testing.T) {
+	currentSlot := 2 * params.BeaconConfig().SlotsPerEpoch
+	bs := serverWithEpochInfoHub(1, func(_ context.Context, epoch types.Epoch) (*orchestrator.EpochInfo, error) {
+		return &orchestrator.EpochInfo{Epoch: epoch, Proposers: [][48]byte{{'a'}, {'b'}}}, nil
+	})
+	bs.GenesisTimeFetcher = &mock.ChainService{Slot: &currentSlot}
+	bs.HeadFetcher = &mock.ChainService{State: setupActiveValidators(t, 64)}
+	require.NoError(t, bs.HeadFetcher.(*mock.ChainService).State.SetSlot(currentSlot))
+
+	epoch := types.Epoch(1)
+	list, err := bs.NextEpochProposerList(context.Background(), &pbrpc.ProposerListRequest{QueryFilter: &pbrpc.ProposerListRequest_Epoch{Epoch: epoch}})
+	require.NoError(t, err)
+	assert.Equal(t, epoch, list.Epoch)
+	require.Equal(t, 2, len(list.Proposers))
+	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch, list.Proposers[0].Slot)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte{'a'}, 48), list.Proposers[0].PublicKey)
+	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch+1, list.Proposers[1].Slot)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte{'b'}, 48), list.Proposers[1].PublicKey)
+}
+
+func TestServer_NextEpochProposerList_FutureEpoch(t *testing.T) {
+	currentSlot := types.Slot(0)
+	bs := &Server{GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot}}
+	epoch := params.BeaconConfig().MinSeedLookahead + 1
+	_, err := bs.NextEpochProposerList(context.Background(), &pbrpc.ProposerListRequest{QueryFilter: &pbrpc.ProposerListRequest_Epoch{Epoch: epoch}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,106 @@
+package beacon
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxProposerStatsEpochs bounds the number of epochs a single proposer stats request may cover,
+// as every epoch requires the regeneration of its start state.
+const maxProposerStatsEpochs = types.Epoch(256)
+
+// GetProposerStats returns the number of proposal duties, proposed blocks, missed proposals and
+// the average attestation inclusion delay of the proposed blocks for a validator over an epoch range.
+// Duties are derived from the archived states, proposals from the canonical blocks stored in the
+// database, so that a block of the validator orphaned by a reorg counts as a missed proposal.
+func (bs *Server) GetProposerStats(ctx context.Context, req *pbrpc.ProposerStatsRequest) (*pbrpc.ProposerStats, error) {
+	currentSlot := bs.GenesisTimeFetcher.CurrentSlot()
+	currentEpoch := helpers.SlotToEpoch(currentSlot)
+	if req.ToEpoch > currentEpoch {
+		return nil, futureEpochError(currentEpoch, req.ToEpoch)
+	}
+	if req.FromEpoch > req.ToEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "From epoch %d is after to epoch %d", req.FromEpoch, req.ToEpoch)
+	}
+	if req.ToEpoch-req.FromEpoch >= maxProposerStatsEpochs {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested epoch range exceeds the maximum of %d epochs", maxProposerStatsEpochs)
+	}
+
+	index := req.Index
+	if len(req.PublicKey) > 0 {
+		headState, err := bs.HeadFetcher.HeadState(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+		}
+		var ok bool
+		index, ok = headState.ValidatorIndexByPubkey(bytesutil.ToBytes48(req.PublicKey))
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "Could not find validator index for public key %#x", req.PublicKey)
+		}
+	}
+
+	stats := &pbrpc.ProposerStats{Index: index}
+	var totalDelay, includedAtts uint64
+	for epoch := req.FromEpoch; epoch <= req.ToEpoch; epoch++ {
+		st, err := bs.StateGen.CanonicalStateInEpoch(ctx, epoch)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve archived state for epoch %d: %v", epoch, err)
+		}
+		if uint64(index) >= uint64(st.NumValidators()) {
+			continue
+		}
+		_, proposerSlots, err := helpers.CommitteeAssignmentsForIndices(ctx, bs.CommitteeCache, st, epoch, []types.ValidatorIndex{index})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not compute proposer duties for epoch %d: %v", epoch, err)
+		}
+		for _, slot := range proposerSlots[index] {
+			// Duties in the future can not have been missed yet.
+			if slot > currentSlot {
+				continue
+			}
+			stats.ProposalDuties++
+			_, blks, err := bs.BeaconDB.BlocksBySlot(ctx, slot)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not retrieve blocks for slot %d: %v", slot, err)
+			}
+			proposed := false
+			for _, b := range blks {
+				if b == nil || b.Block == nil || b.Block.ProposerIndex != index {
+					continue
+				}
+				root, err := b.Block.HashTreeRoot()
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "Could not compute block root: %v", err)
+				}
+				canonical, err := bs.isCanonicalBlock(ctx, slot, root)
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "Could not determine if block is canonical: %v", err)
+				}
+				if !canonical {
+					continue
+				}
+				proposed = true
+				for _, att := range b.Block.Body.Attestations {
+					totalDelay += uint64(b.Block.Slot - att.Data.Slot)
+					includedAtts++
+				}
+				break
+			}
+			if proposed {
+				stats.ProposedBlocks++
+			} else {
+				stats.MissedProposals++
+			}
+		}
+	}
+	if includedAtts > 0 {
+		stats.AverageInclusionDelay = float64(totalDelay) / float64(includedAtts)
+	}
+	return stats, nil
+}
@@ -0,0 +1,109 @@
+package beacon
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetProposerStats(t *testing.T) {
+	helpers.ClearCache()
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	count := 100
+	validators := make([]*ethpb.Validator, 0, count)
+	for i := 0; i < count; i++ {
+		pubKey := make([]byte, params.BeaconConfig().BLSPubkeyLength)
+		binary.LittleEndian.PutUint64(pubKey, uint64(i))
+		validators = append(validators, &ethpb.Validator{
+			PublicKey:             pubKey,
+			WithdrawalCredentials: make([]byte, 32),
+			ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+		})
+	}
+	blk := testutil.NewBeaconBlock().Block
+	blockRoot, err := blk.HashTreeRoot()
+	require.NoError(t, err)
+	s, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, s.SetValidators(validators))
+	require.NoError(t, db.SaveState(ctx, s, blockRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, blockRoot))
+
+	_, proposerIndexToSlots, err := helpers.CommitteeAssignments(context.Background(), nil, s, 0)
+	require.NoError(t, err)
+	var index types.ValidatorIndex
+	var slots []types.Slot
+	for i, ss := range proposerIndexToSlots {
+		if len(ss) > 0 && ss[0] > 2 {
+			index, slots = i, ss
+			break
+		}
+	}
+	require.NotEqual(t, 0, len(slots), "No proposer found")
+
+	// The validator proposed at its first assigned slot, including a single attestation two slots late.
+	proposed := testutil.NewBeaconBlock()
+	proposed.Block.Slot = slots[0]
+	proposed.Block.ProposerIndex = index
+	att := testutil.HydrateAttestation(&ethpb.Attestation{
+		AggregationBits: bitfield.Bitlist{0b11},
+		Data:            &ethpb.AttestationData{Slot: slots[0] - 2},
+	})
+	proposed.Block.Body.Attestations = []*ethpb.Attestation{att}
+	require.NoError(t, db.SaveBlock(ctx, proposed))
+	proposedRoot, err := proposed.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	currentSlot := params.BeaconConfig().SlotsPerEpoch - 1
+	bs := &Server{
+		BeaconDB:           db,
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		HeadFetcher:        &mock.ChainService{State: s},
+		CanonicalFetcher:   &mock.ChainService{CanonicalRoots: map[[32]byte]bool{proposedRoot: true}},
+		StateGen:           stategen.New(db),
+	}
+
+	res, err := bs.GetProposerStats(ctx, &pbrpc.ProposerStatsRequest{PublicKey: validators[index].PublicKey})
+	require.NoError(t, err)
+	assert.Equal(t, index, res.Index)
+	assert.Equal(t, uint64(len(slots)), res.ProposalDuties)
+	assert.Equal(t, uint64(1), res.ProposedBlocks)
+	assert.Equal(t, uint64(len(slots)-1), res.MissedProposals)
+	assert.Equal(t, float64(2), res.AverageInclusionDelay)
+
+	// Once the block is orphaned by a reorg, the proposal counts as missed.
+	bs.CanonicalFetcher = &mock.ChainService{CanonicalRoots: map[[32]byte]bool{}}
+	res, err = bs.GetProposerStats(ctx, &pbrpc.ProposerStatsRequest{PublicKey: validators[index].PublicKey})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), res.ProposedBlocks)
+	assert.Equal(t, uint64(len(slots)), res.MissedProposals)
+}
+
+func TestServer_GetProposerStats_InvalidRange(t *testing.T) {
+	currentSlot := params.BeaconConfig().SlotsPerEpoch * 1000
+	bs := &Server{GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot}}
+
+	_, err := bs.GetProposerStats(context.Background(), &pbrpc.ProposerStatsRequest{ToEpoch: 1001})
+	assert.ErrorContains(t, "Cannot retrieve information about an epoch in the future", err)
+
+	_, err = bs.GetProposerStats(context.Background(), &pbrpc.ProposerStatsRequest{FromEpoch: 5, ToEpoch: 4})
+	assert.ErrorContains(t, "From epoch 5 is after to epoch 4", err)
+
+	_, err = bs.GetProposerStats(context.Background(), &pbrpc.ProposerStatsRequest{FromEpoch: 0, ToEpoch: maxProposerStatsEpochs})
+	assert.ErrorContains(t, "exceeds the maximum", err)
+}
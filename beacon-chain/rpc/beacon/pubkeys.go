@@ -0,0 +1,106 @@
+package beacon
+
+import (
+	"context"
+	"sync"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/pagination"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// pubKeyInterner hands out a single shared slice per public key, so that responses listing the
+// same validators over and over do not allocate their keys again. The slices are shared by all
+// responses and must not be modified. The set is bounded by the size of the validator registry.
+// The zero value is ready to use.
+type pubKeyInterner struct {
+	lock sync.RWMutex
+	keys map[[48]byte][]byte
+}
+
+func (p *pubKeyInterner) intern(pubKey [48]byte) []byte {
+	p.lock.RLock()
+	key, ok := p.keys[pubKey]
+	p.lock.RUnlock()
+	if ok {
+		return key
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if key, ok := p.keys[pubKey]; ok {
+		return key
+	}
+	if p.keys == nil {
+		p.keys = make(map[[48]byte][]byte)
+	}
+	key = pubKey[:]
+	p.keys[pubKey] = key
+	return key
+}
+
+// ListValidatorPublicKeys maps validator indices to public keys, so that clients of index only
+// assignments can resolve the keys once instead of receiving them with every response. A
+// validator keeps its index for good, so the mapping is read from the head state.
+func (bs *Server) ListValidatorPublicKeys(
+	ctx context.Context, req *pbrpc.ListValidatorPublicKeysRequest,
+) (*pbrpc.ValidatorPublicKeys, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconChainServer.ListValidatorPublicKeys")
+	defer span.End()
+
+	if int(req.PageSize) > bs.MaxPageSize() {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Requested page size %d can not be greater than max size %d",
+			req.PageSize,
+			bs.MaxPageSize(),
+		)
+	}
+	if len(req.Indices) > bs.MaxPageSize() {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Requested validator count %d can not be greater than max size %d",
+			len(req.Indices),
+			bs.MaxPageSize(),
+		)
+	}
+	headState, err := bs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil {
+		return nil, status.Error(codes.Unavailable, "No head state")
+	}
+
+	numValidators := headState.NumValidators()
+	res := &pbrpc.ValidatorPublicKeys{}
+	indices := req.Indices
+	if len(indices) == 0 {
+		start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), numValidators)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Could not paginate results: %v", err)
+		}
+		indices = make([]types.ValidatorIndex, 0, end-start)
+		for i := start; i < end; i++ {
+			indices = append(indices, types.ValidatorIndex(i))
+		}
+		res.NextPageToken = nextPageToken
+		res.TotalSize = int32(numValidators)
+	} else {
+		res.TotalSize = int32(len(indices))
+	}
+
+	res.PublicKeys = make([]*pbrpc.ValidatorPublicKey, 0, len(indices))
+	for _, index := range indices {
+		if uint64(index) >= uint64(numValidators) {
+			return nil, status.Errorf(codes.OutOfRange, "Validator index %d >= validator count %d", index, numValidators)
+		}
+		res.PublicKeys = append(res.PublicKeys, &pbrpc.ValidatorPublicKey{
+			Index:     index,
+			PublicKey: bs.pubKeys.intern(headState.PubkeyAtIndex(index)),
+		})
+	}
+	return res, nil
+}
@@ -0,0 +1,115 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_ListValidatorPublicKeys(t *testing.T) {
+	ctx := context.Background()
+	s := setupActiveValidators(t, 10)
+	bs := &Server{HeadFetcher: &mock.ChainService{State: s}}
+
+	res, err := bs.ListValidatorPublicKeys(ctx, &pbrpc.ListValidatorPublicKeysRequest{Indices: []types.ValidatorIndex{7, 2}})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.PublicKeys))
+	for i, index := range []types.ValidatorIndex{7, 2} {
+		pubKey := s.PubkeyAtIndex(index)
+		assert.Equal(t, index, res.PublicKeys[i].Index)
+		assert.DeepEqual(t, pubKey[:], res.PublicKeys[i].PublicKey)
+	}
+	assert.Equal(t, int32(2), res.TotalSize)
+
+	// The registry is listed page by page.
+	res, err = bs.ListValidatorPublicKeys(ctx, &pbrpc.ListValidatorPublicKeysRequest{PageSize: 4, PageToken: "2"})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.PublicKeys))
+	assert.Equal(t, types.ValidatorIndex(8), res.PublicKeys[0].Index)
+	assert.Equal(t, types.ValidatorIndex(9), res.PublicKeys[1].Index)
+	assert.Equal(t, "", res.NextPageToken)
+	assert.Equal(t, int32(10), res.TotalSize)
+
+	_, err = bs.ListValidatorPublicKeys(ctx, &pbrpc.ListValidatorPublicKeysRequest{Indices: []types.ValidatorIndex{10}})
+	assert.ErrorContains(t, "Validator index 10 >= validator count 10", err)
+	_, err = bs.ListValidatorPublicKeys(ctx, &pbrpc.ListValidatorPublicKeysRequest{PageSize: 4, PageToken: "3"})
+	assert.ErrorContains(t, "Could not paginate results", err)
+}
+
+func TestPubKeyInterner(t *testing.T) {
+	p := &pubKeyInterner{}
+	a := p.intern([48]byte{1})
+	b := p.intern([48]byte{1})
+	c := p.intern([48]byte{2})
+	assert.DeepEqual(t, a, b)
+	// The same key is handed out as the same slice.
+	assert.Equal(t, &a[0], &b[0])
+	assert.Equal(t, byte(2), c[0])
+}
+
+func TestServer_ListValidatorAssignments_IndexOnly(t *testing.T) {
+	// Advancing the state requires the state vectors to match the configuration.
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	blockRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	s := setupActiveValidators(t, 128)
+	require.NoError(t, db.SaveState(ctx, s, blockRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, blockRoot))
+
+	currentSlot := params.BeaconConfig().SlotsPerEpoch
+	bs := &Server{
+		BeaconDB:           db,
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		StateGen:           stategen.New(db),
+	}
+	req := &ethpb.ListValidatorAssignmentsRequest{
+		QueryFilter: &ethpb.ListValidatorAssignmentsRequest_Epoch{Epoch: 1},
+		Indices:     []types.ValidatorIndex{3, 42},
+	}
+	full, err := bs.ListValidatorAssignments(ctx, req)
+	require.NoError(t, err)
+	annotated, err := bs.ListAnnotatedValidatorAssignments(ctx, &pbrpc.AnnotatedValidatorAssignmentsRequest{
+		QueryFilter: &pbrpc.AnnotatedValidatorAssignmentsRequest_Epoch{Epoch: 1},
+		Indices:     []types.ValidatorIndex{3, 42},
+		IndexOnly:   true,
+	})
+	require.NoError(t, err)
+	res := annotated.Assignments
+	require.Equal(t, len(full.Assignments), len(res.Assignments))
+	for i, a := range res.Assignments {
+		assert.Equal(t, 0, len(a.PublicKey))
+		assert.Equal(t, 48, len(full.Assignments[i].PublicKey))
+		a.PublicKey = full.Assignments[i].PublicKey
+		assert.DeepEqual(t, full.Assignments[i], a)
+	}
+
+	rangeRes, err := bs.ListValidatorAssignmentsRange(ctx, &pbrpc.ListValidatorAssignmentsRangeRequest{
+		FromEpoch: 0,
+		ToEpoch:   1,
+		Indices:   []types.ValidatorIndex{3},
+		IndexOnly: true,
+	})
+	require.NoError(t, err)
+	for _, epoch := range rangeRes.Epochs {
+		require.Equal(t, 1, len(epoch.Assignments))
+		assert.Equal(t, 0, len(epoch.Assignments[0].PublicKey))
+	}
+}
@@ -0,0 +1,126 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxRandaoMixesEpochs bounds the number of epochs a single randao mixes request may cover, as
+// every block of the range is read.
+const maxRandaoMixesEpochs = types.Epoch(64)
+
+// GetRandaoMixes returns the randao mix of every completed epoch in the range along with the
+// reveals of the proposers which contributed to it, so that external parties can audit the
+// entropy the proposer lists served to the orchestrator were derived from. The mixes are read
+// from the state at the start of the epoch after the range, and the reveals from the canonical
+// blocks of the range.
+func (bs *Server) GetRandaoMixes(ctx context.Context, req *pbrpc.RandaoMixesRequest) (*pbrpc.RandaoMixes, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconChainServer.GetRandaoMixes")
+	defer span.End()
+
+	currentEpoch := helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
+	if req.ToEpoch >= currentEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Randao mix of epoch %d is not final before the epoch ends, the current epoch is %d",
+			req.ToEpoch,
+			currentEpoch,
+		)
+	}
+	if req.FromEpoch > req.ToEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "From epoch %d is after to epoch %d", req.FromEpoch, req.ToEpoch)
+	}
+	if req.ToEpoch-req.FromEpoch >= maxRandaoMixesEpochs {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested epoch range exceeds the maximum of %d epochs", maxRandaoMixesEpochs)
+	}
+
+	st, err := bs.StateGen.CanonicalStateInEpoch(ctx, req.ToEpoch+1)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve archived state for epoch %d: %v", req.ToEpoch+1, err)
+	}
+	startSlot, err := helpers.StartSlot(req.FromEpoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get start slot of epoch %d: %v", req.FromEpoch, err)
+	}
+	endSlot, err := helpers.EndSlot(req.ToEpoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get end slot of epoch %d: %v", req.ToEpoch, err)
+	}
+	blks, roots, err := bs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartSlot(startSlot).SetEndSlot(endSlot))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get blocks of slots %d to %d: %v", startSlot, endSlot, err)
+	}
+	contributions := make(map[types.Epoch][]*pbrpc.RandaoContribution)
+	for i, blk := range blks {
+		// The genesis block has no proposer, so it reveals nothing.
+		if blk == nil || blk.Block == nil || blk.Block.Slot == 0 {
+			continue
+		}
+		canonical, err := bs.isCanonicalBlock(ctx, blk.Block.Slot, roots[i])
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not determine if block %#x is canonical: %v", roots[i], err)
+		}
+		if !canonical {
+			continue
+		}
+		epoch := helpers.SlotToEpoch(blk.Block.Slot)
+		pubKey := st.PubkeyAtIndex(blk.Block.ProposerIndex)
+		contributions[epoch] = append(contributions[epoch], &pbrpc.RandaoContribution{
+			Slot:          blk.Block.Slot,
+			BlockRoot:     roots[i][:],
+			ProposerIndex: blk.Block.ProposerIndex,
+			PublicKey:     pubKey[:],
+			Reveal:        blk.Block.Body.RandaoReveal,
+		})
+	}
+
+	cfg := params.BeaconConfigOf(bs.ChainConfig)
+	res := &pbrpc.RandaoMixes{Mixes: make([]*pbrpc.EpochRandaoMix, 0, req.ToEpoch-req.FromEpoch+1)}
+	for epoch := req.FromEpoch; epoch <= req.ToEpoch; epoch++ {
+		startMix, err := helpers.RandaoMix(st, epoch+cfg.EpochsPerHistoricalVector-1)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get randao mix of epoch %d: %v", epoch-1, err)
+		}
+		mix, err := helpers.RandaoMix(st, epoch)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get randao mix of epoch %d: %v", epoch, err)
+		}
+		epochContributions := contributions[epoch]
+		sort.Slice(epochContributions, func(i, j int) bool {
+			return epochContributions[i].Slot < epochContributions[j].Slot
+		})
+		res.Mixes = append(res.Mixes, &pbrpc.EpochRandaoMix{
+			Epoch:         epoch,
+			SeedEpoch:     epoch + cfg.MinSeedLookahead + 1,
+			StartMix:      bytesutil.SafeCopyBytes(startMix),
+			Mix:           bytesutil.SafeCopyBytes(mix),
+			Contributions: epochContributions,
+			Verified:      bytes.Equal(mixReveals(startMix, epochContributions), mix),
+		})
+	}
+	return res, nil
+}
+
+// mixReveals mixes the hashes of the reveals into the mix, as block processing does.
+func mixReveals(mix []byte, contributions []*pbrpc.RandaoContribution) []byte {
+	mixed := bytesutil.SafeCopyBytes(mix)
+	for _, c := range contributions {
+		revealHash := hashutil.Hash(c.Reveal)
+		for i := range mixed {
+			mixed[i] ^= revealHash[i]
+		}
+	}
+	return mixed
+}
@@ -0,0 +1,96 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetRandaoMixes(t *testing.T) {
+	// States saved to the database must match the mainnet vector lengths.
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	ctx := context.Background()
+	db := dbTest.SetupDB(t)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	chain := testutil.NewChainBuilder(uint64(slotsPerEpoch+4), testutil.WithMissedSlots(3)).BuildInto(t, db)
+
+	currentSlot := 2 * slotsPerEpoch
+	bs := &Server{
+		BeaconDB:           db,
+		StateGen:           stategen.New(db),
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		CanonicalFetcher:   &mock.ChainService{},
+	}
+	res, err := bs.GetRandaoMixes(ctx, &pbrpc.RandaoMixesRequest{FromEpoch: 0, ToEpoch: 1})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Mixes))
+	wantContributions := []int{int(slotsPerEpoch) - 2, 6}
+	for i, mix := range res.Mixes {
+		epoch := types.Epoch(i)
+		assert.Equal(t, epoch, mix.Epoch)
+		assert.Equal(t, epoch+params.BeaconConfig().MinSeedLookahead+1, mix.SeedEpoch)
+		assert.Equal(t, wantContributions[i], len(mix.Contributions))
+		assert.Equal(t, true, mix.Verified, "Expected the mix of epoch %d to match its reveals", epoch)
+		if i > 0 {
+			assert.DeepEqual(t, res.Mixes[i-1].Mix, mix.StartMix)
+		}
+	}
+	first := res.Mixes[0].Contributions[0]
+	assert.Equal(t, types.Slot(1), first.Slot)
+	assert.DeepEqual(t, chain.Roots[1][:], first.BlockRoot)
+	assert.DeepEqual(t, chain.Blocks[1].Block.Body.RandaoReveal, first.Reveal)
+	pubKey := chain.Head.PubkeyAtIndex(first.ProposerIndex)
+	assert.DeepEqual(t, pubKey[:], first.PublicKey)
+	headMix, err := helpers.RandaoMix(chain.Head, 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, headMix, res.Mixes[1].Mix)
+
+	// Reveals of blocks off the canonical chain are left out, so the mix no longer verifies.
+	canonicalRoots := make(map[[32]byte]bool)
+	for i, root := range chain.Roots {
+		if chain.Blocks[i].Block.Slot != slotsPerEpoch+2 {
+			canonicalRoots[root] = true
+		}
+	}
+	bs.CanonicalFetcher = &mock.ChainService{CanonicalRoots: canonicalRoots}
+	res, err = bs.GetRandaoMixes(ctx, &pbrpc.RandaoMixesRequest{FromEpoch: 1, ToEpoch: 1})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Mixes))
+	assert.Equal(t, 5, len(res.Mixes[0].Contributions))
+	assert.Equal(t, false, res.Mixes[0].Verified)
+}
+
+func TestServer_GetRandaoMixes_InvalidRange(t *testing.T) {
+	currentSlot := 10 * params.BeaconConfig().SlotsPerEpoch
+	bs := &Server{GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot}}
+	tests := []struct {
+		name string
+		req  *pbrpc.RandaoMixesRequest
+		err  string
+	}{
+		{"current epoch", &pbrpc.RandaoMixesRequest{FromEpoch: 9, ToEpoch: 10}, "is not final before the epoch ends"},
+		{"reversed", &pbrpc.RandaoMixesRequest{FromEpoch: 5, ToEpoch: 4}, "is after to epoch"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := bs.GetRandaoMixes(context.Background(), tt.req)
+			assert.ErrorContains(t, tt.err, err)
+		})
+	}
+
+	currentSlot = params.BeaconConfig().SlotsPerEpoch.Mul(uint64(maxRandaoMixesEpochs) + 1)
+	_, err := bs.GetRandaoMixes(context.Background(), &pbrpc.RandaoMixesRequest{FromEpoch: 0, ToEpoch: maxRandaoMixesEpochs})
+	assert.ErrorContains(t, "exceeds the maximum", err)
+}
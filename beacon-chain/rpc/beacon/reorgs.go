@@ -0,0 +1,99 @@
+package beacon
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/reorg"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxReorgsPerRequest bounds the number of reorg events returned by a single request. Clients
+// page through the history by requesting again from the epoch of the last event received.
+const maxReorgsPerRequest = 1024
+
+// ListReorgs returns the recorded reorgs with a new head in or after the requested epoch, so
+// that the orchestrator and analytics can audit how often proposer sets were invalidated.
+func (bs *Server) ListReorgs(ctx context.Context, req *pbrpc.ListReorgsRequest) (*pbrpc.Reorgs, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconChainServer.ListReorgs")
+	defer span.End()
+
+	currentEpoch := helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
+	if req.FromEpoch > currentEpoch {
+		return nil, futureEpochError(currentEpoch, req.FromEpoch)
+	}
+	events, err := bs.BeaconDB.Reorgs(ctx, req.FromEpoch, maxReorgsPerRequest)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve reorgs: %v", err)
+	}
+	res := &pbrpc.Reorgs{Events: make([]*pbrpc.ReorgEvent, len(events))}
+	for i, e := range events {
+		res.Events[i] = reorgEvent(e)
+	}
+	return res, nil
+}
+
+// StreamReorgs sends the recorded reorgs from the requested epoch onwards, followed by every new
+// reorg as it occurs.
+func (bs *Server) StreamReorgs(req *pbrpc.ListReorgsRequest, stream pbrpc.BeaconQuery_StreamReorgsServer) error {
+	// Subscribe before catching up so that no reorg is missed in between.
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := bs.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+
+	res, err := bs.ListReorgs(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+	// Reorgs recorded while catching up are also received from the feed, they are sent once.
+	sent := make(map[[32]byte]bool, len(res.Events))
+	for _, e := range res.Events {
+		if err := stream.Send(e); err != nil {
+			return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+		}
+		sent[bytesutil.ToBytes32(e.NewHeadRoot)] = true
+	}
+
+	for {
+		select {
+		case ev := <-stateChannel:
+			if ev.Type != statefeed.Reorg {
+				continue
+			}
+			data, ok := ev.Data.(*statefeed.ReorgData)
+			if !ok || data == nil || data.Event == nil || sent[data.Event.NewHeadRoot] {
+				continue
+			}
+			if err := stream.Send(reorgEvent(data.Event)); err != nil {
+				return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+			}
+		case <-stateSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-bs.Ctx.Done():
+			return grpcutils.ShuttingDownError()
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
+
+// reorgEvent returns the message of a recorded reorg event.
+func reorgEvent(e *reorg.Event) *pbrpc.ReorgEvent {
+	return &pbrpc.ReorgEvent{
+		OldHeadRoot:        bytesutil.SafeCopyBytes(e.OldHeadRoot[:]),
+		NewHeadRoot:        bytesutil.SafeCopyBytes(e.NewHeadRoot[:]),
+		OldHeadSlot:        e.OldHeadSlot,
+		NewHeadSlot:        e.NewHeadSlot,
+		CommonAncestorSlot: e.CommonAncestorSlot,
+		Depth:              e.Depth,
+		FromEpoch:          e.FromEpoch,
+		ToEpoch:            e.ToEpoch,
+	}
+}
@@ -0,0 +1,104 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/reorg"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+type reorgTestStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *pbrpc.ReorgEvent
+}
+
+func (s *reorgTestStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *reorgTestStream) Send(e *pbrpc.ReorgEvent) error {
+	s.sent <- e
+	return nil
+}
+
+func TestServer_ListReorgs(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+	bs := &Server{
+		BeaconDB:           db,
+		GenesisTimeFetcher: &mock.ChainService{Genesis: time.Now()},
+	}
+
+	events := []*reorg.Event{
+		reorg.New([32]byte{'a'}, 2, [32]byte{'b'}, 2, 1, 1),
+		reorg.New([32]byte{'b'}, 2, [32]byte{'c'}, 3, 1, 1),
+	}
+	for _, e := range events {
+		require.NoError(t, db.SaveReorg(ctx, e))
+	}
+	res, err := bs.ListReorgs(ctx, &pbrpc.ListReorgsRequest{FromEpoch: 0})
+	require.NoError(t, err)
+	require.Equal(t, len(events), len(res.Events))
+	for i, e := range events {
+		assert.DeepEqual(t, reorgEvent(e), res.Events[i])
+	}
+
+	_, err = bs.ListReorgs(ctx, &pbrpc.ListReorgsRequest{FromEpoch: 1})
+	assert.ErrorContains(t, "Cannot retrieve information about an epoch in the future", err)
+}
+
+func TestServer_StreamReorgs(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	notifier := &mock.MockStateNotifier{}
+	bs := &Server{
+		Ctx:                ctx,
+		BeaconDB:           db,
+		GenesisTimeFetcher: &mock.ChainService{Genesis: time.Now()},
+		StateNotifier:      notifier,
+	}
+
+	recorded := reorg.New([32]byte{'a'}, 2, [32]byte{'b'}, 2, 1, 1)
+	require.NoError(t, db.SaveReorg(ctx, recorded))
+
+	streamCtx, cancelStream := context.WithCancel(ctx)
+	stream := &reorgTestStream{ctx: streamCtx, sent: make(chan *pbrpc.ReorgEvent, 2)}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- bs.StreamReorgs(&pbrpc.ListReorgsRequest{}, stream)
+	}()
+	select {
+	case e := <-stream.sent:
+		assert.DeepEqual(t, reorgEvent(recorded), e)
+	case <-time.After(10 * time.Second):
+		t.Fatal("Timed out waiting for recorded reorg")
+	}
+
+	// The recorded reorg is not sent again when it arrives over the feed.
+	next := reorg.New([32]byte{'b'}, 2, [32]byte{'c'}, 3, 1, 1)
+	for _, e := range []*reorg.Event{recorded, next} {
+		ev := &feed.Event{Type: statefeed.Reorg, Data: &statefeed.ReorgData{NewSlot: e.NewHeadSlot, OldSlot: e.OldHeadSlot, Event: e}}
+		for notifier.StateFeed().Send(ev) == 0 {
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	select {
+	case e := <-stream.sent:
+		assert.DeepEqual(t, reorgEvent(next), e)
+	case <-time.After(10 * time.Second):
+		t.Fatal("Timed out waiting for new reorg")
+	}
+	cancelStream()
+	assert.ErrorContains(t, "Context canceled", <-errs)
+}
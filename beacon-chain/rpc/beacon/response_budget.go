@@ -0,0 +1,54 @@
+package beacon
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/mathutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// assignmentEntryOverhead bounds the encoded size of a committee assignment besides its committee,
+// that is its public key, indices, slots and field tags.
+const assignmentEntryOverhead = 128
+
+var cappedPages = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "rpc_capped_pages_total",
+		Help: "The number of paginated calls whose page size was capped to fit the response byte budget.",
+	},
+	[]string{"method"},
+)
+
+// cappedPageSize returns the page size a paginated call is served with, so that its response
+// stays within the response byte budget given an upper bound of the encoded size of an entry.
+// Calls without a page size are served with the default page size. The applied page size only
+// depends on the requested page size and the entry size, so it is the same for every page of a
+// listing. The returned bool reports whether the requested page size was capped.
+func (bs *Server) cappedPageSize(pageSize, entrySize int) (int, bool) {
+	if pageSize == 0 {
+		pageSize = params.BeaconConfigOf(bs.ChainConfig).DefaultPageSize
+	}
+	if bs.MaxResponseBytes == 0 || entrySize <= 0 {
+		return pageSize, false
+	}
+	budget := int(mathutil.Max(bs.MaxResponseBytes/uint64(entrySize), 1))
+	if pageSize <= budget {
+		return pageSize, false
+	}
+	return budget, true
+}
+
+// assignmentEntrySize bounds the encoded size of a committee assignment of an epoch with the given
+// number of active validators, whose committee indices are encoded in at most 8 bytes each.
+func assignmentEntrySize(activeCount uint64, slotsPerEpoch types.Slot) int {
+	committees := helpers.SlotCommitteeCount(activeCount) * uint64(slotsPerEpoch)
+	committeeSize := (activeCount + committees - 1) / committees
+	return assignmentEntryOverhead + 8*int(committeeSize)
+}
+
+// reportCappedPage records that a page of the method was capped to the response byte budget.
+func reportCappedPage(method string) {
+	cappedPages.WithLabelValues(method).Inc()
+}
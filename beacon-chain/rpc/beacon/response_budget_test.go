@@ -0,0 +1,89 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_CappedPageSize(t *testing.T) {
+	bs := &Server{MaxResponseBytes: 1000}
+	size, capped := bs.cappedPageSize(5, 100)
+	assert.Equal(t, 5, size)
+	assert.Equal(t, false, capped)
+	size, capped = bs.cappedPageSize(50, 100)
+	assert.Equal(t, 10, size)
+	assert.Equal(t, true, capped)
+	// Calls without a page size are served with the default page size, capped to the budget.
+	size, capped = bs.cappedPageSize(0, 100)
+	assert.Equal(t, 10, size)
+	assert.Equal(t, true, capped)
+	// At least one entry is served, whatever its size.
+	size, capped = bs.cappedPageSize(50, 5000)
+	assert.Equal(t, 1, size)
+	assert.Equal(t, true, capped)
+
+	bs.MaxResponseBytes = 0
+	size, capped = bs.cappedPageSize(0, 100)
+	assert.Equal(t, params.BeaconConfig().DefaultPageSize, size)
+	assert.Equal(t, false, capped)
+}
+
+func TestServer_ListAssignments_CapsPageToResponseBudget(t *testing.T) {
+	helpers.ClearCache()
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	blockRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	s := setupActiveValidators(t, 128)
+	require.NoError(t, db.SaveState(ctx, s, blockRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, blockRoot))
+
+	currentSlot := types.Slot(0)
+	bs := &Server{
+		BeaconDB:           db,
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		StateGen:           stategen.New(db),
+		MaxResponseBytes:   uint64(assignmentEntrySize(128, params.BeaconConfig().SlotsPerEpoch) * 10),
+	}
+
+	var seen []types.ValidatorIndex
+	for _, token := range []string{"", "1"} {
+		res, err := bs.ListAnnotatedValidatorAssignments(ctx, &pbrpc.AnnotatedValidatorAssignmentsRequest{
+			QueryFilter: &pbrpc.AnnotatedValidatorAssignmentsRequest_Genesis{Genesis: true},
+			PageToken:   token,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(10), res.AppliedPageSize)
+		require.Equal(t, 10, len(res.Assignments.Assignments))
+		assert.Equal(t, int32(128), res.Assignments.TotalSize)
+		for _, assignment := range res.Assignments.Assignments {
+			seen = append(seen, assignment.ValidatorIndex)
+		}
+	}
+	activeIndices, err := helpers.ActiveValidatorIndices(nil, s, 0)
+	require.NoError(t, err)
+	assert.DeepEqual(t, activeIndices[:20], seen)
+
+	// Pages within the budget are served as requested.
+	res, err := bs.ListAnnotatedValidatorAssignments(ctx, &pbrpc.AnnotatedValidatorAssignmentsRequest{
+		QueryFilter: &pbrpc.AnnotatedValidatorAssignmentsRequest_Genesis{Genesis: true},
+		PageSize:    4,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 4, len(res.Assignments.Assignments))
+	assert.Equal(t, int32(0), res.AppliedPageSize)
+}
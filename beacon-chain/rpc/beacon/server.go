@@ -7,8 +7,10 @@ import (
 	"context"
 	"time"
 
+	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
@@ -16,11 +18,13 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
 // Server defines a server implementation of the gRPC Beacon Chain service,
@@ -36,6 +40,7 @@ type Server struct {
 	DepositFetcher              depositcache.DepositFetcher
 	BlockFetcher                powchain.POWBlockFetcher
 	GenesisTimeFetcher          blockchain.TimeFetcher
+	SlotTimeFetcher             blockchain.SlotTimeFetcher
 	StateNotifier               statefeed.Notifier
 	BlockNotifier               blockfeed.Notifier
 	AttestationNotifier         operation.Notifier
@@ -48,4 +53,32 @@ type Server struct {
 	CollectedAttestationsBuffer chan []*ethpb.Attestation
 	StateGen                    stategen.StateManager
 	SyncChecker                 sync.Checker
+	DatabasePath                string
+	ColdStateStoreEnabled       bool
+	ConfirmationStore           orchestrator.ConfirmationStore
+	PandoraConfirmationReceiver blockchain.PandoraConfirmationReceiver
+	NextEpochGraceSlots         types.Slot
+	MaxEpochInfoLookback        types.Epoch
+	DutyBroadcastLookahead      types.Epoch
+	EpochInfoWorkers            int
+	MaxResponseBytes            uint64
+	LivenessCache               *cache.LivenessCache
+	ProposerDigests             *cache.ProposerDigestCache
+	CommitteeCache              *cache.CommitteeCache
+	PrecomputationStatusFetcher blockchain.PrecomputationStatusFetcher
+	CanonicalRootFetcher        blockchain.CanonicalRootFetcher
+	TrackedValidators           *TrackedValidators
+	EpochInfoStore              orchestrator.EpochInfoStore
+	StreamCursorStore           orchestrator.StreamCursorStore
+	Eth1DataVoteFetcher         Eth1DataVoteFetcher
+	ChainConfig                 params.ChainConfig
+	AssignmentsWarmedUp         <-chan struct{}
+	epochInfoHub                lazyEpochInfoHub
+	epochInfoCacheSize          int64
+	maxPageSize                 int64
+	epochInfoPrefetches         prefetchJobs
+	proposerAuditor             lazyProposerAuditor
+	assignmentCalls             assignmentsCoalescer
+	epochStates                 epochStateCache
+	pubKeys                     pubKeyInterner
 }
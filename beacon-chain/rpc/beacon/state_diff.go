@@ -0,0 +1,132 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetStateDiff compares the states at the start of two epochs server side, and returns the
+// validators whose registry entry changed, the balance deltas and the checkpoints of both states,
+// so that clients do not download and diff two full states.
+func (bs *Server) GetStateDiff(ctx context.Context, req *pbrpc.GetStateDiffRequest) (*pbrpc.StateDiff, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconChainServer.GetStateDiff")
+	defer span.End()
+
+	if req.FromEpoch > req.ToEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "From epoch %d is after to epoch %d", req.FromEpoch, req.ToEpoch)
+	}
+	if len(req.Indices) > bs.MaxPageSize() {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Requested validator count %d can not be greater than max size %d",
+			len(req.Indices),
+			bs.MaxPageSize(),
+		)
+	}
+	if err := bs.checkEpochAdmission(req.ToEpoch); err != nil {
+		return nil, err
+	}
+	from, err := bs.epochStartState(ctx, req.FromEpoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve archived state for epoch %d: %v", req.FromEpoch, err)
+	}
+	to, err := bs.epochStartState(ctx, req.ToEpoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve archived state for epoch %d: %v", req.ToEpoch, err)
+	}
+
+	res := &pbrpc.StateDiff{
+		FromEpoch:                       req.FromEpoch,
+		ToEpoch:                         req.ToEpoch,
+		ChangedValidators:               make([]*pbrpc.ValidatorChange, 0),
+		BalanceDeltas:                   make([]*pbrpc.BalanceDelta, 0),
+		PreviousJustifiedCheckpoint:     from.PreviousJustifiedCheckpoint(),
+		CurrentJustifiedCheckpoint:      from.CurrentJustifiedCheckpoint(),
+		FinalizedCheckpoint:             from.FinalizedCheckpoint(),
+		PostPreviousJustifiedCheckpoint: to.PreviousJustifiedCheckpoint(),
+		PostCurrentJustifiedCheckpoint:  to.CurrentJustifiedCheckpoint(),
+		PostFinalizedCheckpoint:         to.FinalizedCheckpoint(),
+	}
+	indices := req.Indices
+	if len(indices) == 0 {
+		indices = make([]types.ValidatorIndex, to.NumValidators())
+		for i := range indices {
+			indices[i] = types.ValidatorIndex(i)
+		}
+	}
+	for _, index := range indices {
+		if ctx.Err() != nil {
+			return nil, status.Errorf(codes.Aborted, "Could not continue diffing states: %v", ctx.Err())
+		}
+		if uint64(index) >= uint64(to.NumValidators()) {
+			return nil, status.Errorf(codes.OutOfRange, "Validator index %d >= validator count %d", index, to.NumValidators())
+		}
+		joined := uint64(index) >= uint64(from.NumValidators())
+		changed, err := validatorChanged(from, to, index, joined)
+		if err != nil {
+			return nil, err
+		}
+		if changed {
+			change := &pbrpc.ValidatorChange{Index: index}
+			if !joined {
+				if change.Previous, err = from.ValidatorAtIndex(index); err != nil {
+					return nil, status.Errorf(codes.Internal, "Could not get validator %d: %v", index, err)
+				}
+			}
+			if change.Current, err = to.ValidatorAtIndex(index); err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not get validator %d: %v", index, err)
+			}
+			res.ChangedValidators = append(res.ChangedValidators, change)
+		}
+
+		var previous uint64
+		if !joined {
+			if previous, err = from.BalanceAtIndex(index); err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not get balance of validator %d: %v", index, err)
+			}
+		}
+		current, err := to.BalanceAtIndex(index)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get balance of validator %d: %v", index, err)
+		}
+		if previous != current {
+			res.BalanceDeltas = append(res.BalanceDeltas, &pbrpc.BalanceDelta{
+				Index:    index,
+				Previous: previous,
+				Current:  current,
+				Delta:    int64(current) - int64(previous),
+			})
+		}
+	}
+	return res, nil
+}
+
+// validatorChanged reports whether the registry entry of a validator differs between two states.
+// Validators which joined the registry after the first state always changed.
+func validatorChanged(from, to iface.ReadOnlyBeaconState, index types.ValidatorIndex, joined bool) (bool, error) {
+	if joined {
+		return true, nil
+	}
+	prev, err := from.ValidatorAtIndexReadOnly(index)
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "Could not get validator %d: %v", index, err)
+	}
+	cur, err := to.ValidatorAtIndexReadOnly(index)
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "Could not get validator %d: %v", index, err)
+	}
+	return prev.EffectiveBalance() != cur.EffectiveBalance() ||
+		prev.Slashed() != cur.Slashed() ||
+		prev.ActivationEligibilityEpoch() != cur.ActivationEligibilityEpoch() ||
+		prev.ActivationEpoch() != cur.ActivationEpoch() ||
+		prev.ExitEpoch() != cur.ExitEpoch() ||
+		prev.WithdrawableEpoch() != cur.WithdrawableEpoch() ||
+		!bytes.Equal(prev.WithdrawalCredentials(), cur.WithdrawalCredentials()), nil
+}
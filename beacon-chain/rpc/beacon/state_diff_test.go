@@ -0,0 +1,87 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetStateDiff(t *testing.T) {
+	ctx := context.Background()
+	canonical := [32]byte{'a'}
+	currentSlot := params.BeaconConfig().SlotsPerEpoch * 3
+	bs := &Server{
+		Ctx:                ctx,
+		CanonicalFetcher:   &mock.ChainService{CanonicalRoots: map[[32]byte]bool{canonical: true}},
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+	}
+
+	from := setupActiveValidators(t, 4)
+	to := from.Copy()
+	require.NoError(t, to.SetSlot(params.BeaconConfig().SlotsPerEpoch*2))
+	require.NoError(t, to.UpdateBalancesAtIndex(1, 11))
+	require.NoError(t, to.UpdateBalancesAtIndex(2, 0))
+	slashed, err := to.ValidatorAtIndex(3)
+	require.NoError(t, err)
+	slashed.Slashed = true
+	require.NoError(t, to.UpdateValidatorAtIndex(3, slashed))
+	joined := &ethpb.Validator{
+		PublicKey:             []byte{'j'},
+		WithdrawalCredentials: make([]byte, 32),
+		ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+	}
+	require.NoError(t, to.AppendValidator(joined))
+	require.NoError(t, to.AppendBalance(32))
+	finalized := &ethpb.Checkpoint{Epoch: 1, Root: canonical[:]}
+	require.NoError(t, to.SetFinalizedCheckpoint(finalized))
+
+	bs.epochStates.put(epochStateKey{epoch: 0}, from)
+	bs.epochStates.put(epochStateKey{epoch: 2, dependentRoot: canonical}, to)
+	bs.OnFinalized(2, canonical)
+
+	res, err := bs.GetStateDiff(ctx, &pbrpc.GetStateDiffRequest{FromEpoch: 0, ToEpoch: 2})
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(2), res.ToEpoch)
+	require.Equal(t, 2, len(res.ChangedValidators))
+	assert.Equal(t, types.ValidatorIndex(3), res.ChangedValidators[0].Index)
+	assert.Equal(t, false, res.ChangedValidators[0].Previous.Slashed)
+	assert.Equal(t, true, res.ChangedValidators[0].Current.Slashed)
+	assert.Equal(t, types.ValidatorIndex(4), res.ChangedValidators[1].Index)
+	assert.Equal(t, true, res.ChangedValidators[1].Previous == nil, "Expected no previous entry of a joined validator")
+	assert.DeepEqual(t, joined.PublicKey, res.ChangedValidators[1].Current.PublicKey)
+
+	want := []*pbrpc.BalanceDelta{
+		{Index: 1, Previous: 1, Current: 11, Delta: 10},
+		{Index: 2, Previous: 2, Current: 0, Delta: -2},
+		{Index: 4, Previous: 0, Current: 32, Delta: 32},
+	}
+	assert.DeepEqual(t, want, res.BalanceDeltas)
+	assert.Equal(t, types.Epoch(0), res.FinalizedCheckpoint.Epoch)
+	assert.DeepEqual(t, finalized, res.PostFinalizedCheckpoint)
+
+	// Filtering by index leaves out the other validators.
+	res, err = bs.GetStateDiff(ctx, &pbrpc.GetStateDiffRequest{FromEpoch: 0, ToEpoch: 2, Indices: []types.ValidatorIndex{0, 1}})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.ChangedValidators))
+	assert.DeepEqual(t, want[:1], res.BalanceDeltas)
+
+	_, err = bs.GetStateDiff(ctx, &pbrpc.GetStateDiffRequest{FromEpoch: 0, ToEpoch: 2, Indices: []types.ValidatorIndex{5}})
+	assert.ErrorContains(t, "Validator index 5 >= validator count 5", err)
+}
+
+func TestServer_GetStateDiff_InvalidRequest(t *testing.T) {
+	currentSlot := params.BeaconConfig().SlotsPerEpoch
+	bs := &Server{GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot}}
+
+	_, err := bs.GetStateDiff(context.Background(), &pbrpc.GetStateDiffRequest{FromEpoch: 1, ToEpoch: 0})
+	assert.ErrorContains(t, "From epoch 1 is after to epoch 0", err)
+	_, err = bs.GetStateDiff(context.Background(), &pbrpc.GetStateDiffRequest{FromEpoch: 0, ToEpoch: 3})
+	assert.ErrorContains(t, "Cannot retrieve information about an epoch in the future", err)
+}
@@ -0,0 +1,128 @@
+package beacon
+
+import (
+	"context"
+	"os"
+	"path"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultStorageProjectionDays is the projection horizon used when the request does not specify one.
+const defaultStorageProjectionDays = 30
+
+// EstimateStorageGrowth projects the growth of the beacon node database based on the
+// current validator count, the archive interval and the retention flags the node runs with.
+// It is meant to help operators of archival nodes plan disk capacity ahead of time.
+func (bs *Server) EstimateStorageGrowth(
+	ctx context.Context, req *pbrpc.StorageGrowthRequest,
+) (*pbrpc.StorageGrowthEstimate, error) {
+	headState, err := bs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil {
+		return nil, status.Error(codes.Internal, "Head state is nil")
+	}
+	headBlock, err := bs.HeadFetcher.HeadBlock(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head block: %v", err)
+	}
+	if headBlock == nil || headBlock.Block == nil {
+		return nil, status.Error(codes.Internal, "Head block is nil")
+	}
+
+	days := req.Days
+	if days == 0 {
+		days = defaultStorageProjectionDays
+	}
+	cfg := params.BeaconConfigOf(bs.ChainConfig)
+	slotsPerArchivedPoint := cfg.SlotsPerArchivedPoint
+	if slotsPerArchivedPoint == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "Slots per archived point is not configured")
+	}
+
+	enc, err := headState.MarshalSSZ()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not marshal head state: %v", err)
+	}
+	stateSize := uint64(len(enc))
+	blockSize := uint64(headBlock.SizeSSZ())
+	validatorCount := uint64(headState.NumValidators())
+	validatorDedup := featureconfig.Get().EnableStateValidatorDedup
+	archivedStateSize := archivedStateSize(stateSize, validatorCount, validatorDedup)
+
+	slotsPerDay := uint64(86400) / cfg.SecondsPerSlot
+	archivedStatesPerDay := slotsPerDay / uint64(slotsPerArchivedPoint)
+	if slotsPerDay%uint64(slotsPerArchivedPoint) != 0 {
+		archivedStatesPerDay++
+	}
+	// Blocks are always kept in the database, while archived states move to the cold state store
+	// once finalized if it is enabled.
+	bytesPerDay := slotsPerDay * blockSize
+	var coldStoreBytesPerDay uint64
+	if bs.ColdStateStoreEnabled {
+		coldStoreBytesPerDay = archivedStatesPerDay * archivedStateSize
+	} else {
+		bytesPerDay += archivedStatesPerDay * archivedStateSize
+	}
+
+	currentSize, err := bs.databaseSize()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not determine database size: %v", err)
+	}
+
+	return &pbrpc.StorageGrowthEstimate{
+		ValidatorCount:                validatorCount,
+		SlotsPerArchivedPoint:         slotsPerArchivedPoint,
+		ColdStateStore:                bs.ColdStateStoreEnabled,
+		ValidatorDedup:                validatorDedup,
+		CurrentSizeBytes:              currentSize,
+		StateSizeBytes:                stateSize,
+		ArchivedStateSizeBytes:        archivedStateSize,
+		BlockSizeBytes:                blockSize,
+		BytesPerDay:                   bytesPerDay,
+		ColdStoreBytesPerDay:          coldStoreBytesPerDay,
+		Days:                          days,
+		ProjectedSizeBytes:            currentSize + days*bytesPerDay,
+		ProjectedColdStoreGrowthBytes: days * coldStoreBytesPerDay,
+	}, nil
+}
+
+// archivedStateSize returns the number of bytes written for an archived state of the given size.
+// With the validator deduplication, the state references each of its validators by a key instead
+// of holding its entry, and entries are only written again once they change. Validator entries
+// change rarely between two archived points, so their rewrites are not accounted for.
+func archivedStateSize(stateSize, validatorCount uint64, validatorDedup bool) uint64 {
+	if !validatorDedup {
+		return stateSize
+	}
+	entrySize := uint64((&ethpb.Validator{}).SizeSSZ())
+	registrySize := validatorCount * entrySize
+	if registrySize > stateSize {
+		return stateSize
+	}
+	return stateSize - registrySize + validatorCount*kv.StateValidatorKeyLength
+}
+
+// databaseSize returns the size of the database file in bytes, or zero if
+// the server was not configured with a database path.
+func (bs *Server) databaseSize() (uint64, error) {
+	if bs.DatabasePath == "" {
+		return 0, nil
+	}
+	info, err := os.Stat(path.Join(bs.DatabasePath, kv.DatabaseFileName))
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return uint64(info.Size()), nil
+}
@@ -0,0 +1,134 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_EstimateStorageGrowth(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.SlotsPerArchivedPoint = 2048
+	params.OverrideBeaconConfig(cfg)
+
+	headState := storageTestState(t, 64)
+	headBlock := testutil.NewBeaconBlock()
+	bs := &Server{
+		HeadFetcher: &mock.ChainService{State: headState, Block: headBlock},
+	}
+
+	res, err := bs.EstimateStorageGrowth(context.Background(), &pbrpc.StorageGrowthRequest{})
+	require.NoError(t, err)
+
+	enc, err := headState.MarshalSSZ()
+	require.NoError(t, err)
+	slotsPerDay := uint64(86400) / cfg.SecondsPerSlot
+	archivedStatesPerDay := slotsPerDay / uint64(cfg.SlotsPerArchivedPoint)
+	if slotsPerDay%uint64(cfg.SlotsPerArchivedPoint) != 0 {
+		archivedStatesPerDay++
+	}
+	wantedPerDay := slotsPerDay*uint64(headBlock.SizeSSZ()) + archivedStatesPerDay*uint64(len(enc))
+
+	assert.Equal(t, uint64(64), res.ValidatorCount)
+	assert.Equal(t, uint64(defaultStorageProjectionDays), res.Days)
+	assert.Equal(t, uint64(len(enc)), res.StateSizeBytes)
+	assert.Equal(t, wantedPerDay, res.BytesPerDay)
+	assert.Equal(t, uint64(0), res.CurrentSizeBytes)
+	assert.Equal(t, res.Days*wantedPerDay, res.ProjectedSizeBytes)
+}
+
+func TestServer_EstimateStorageGrowth_ShorterArchiveIntervalGrowsFaster(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	headState := storageTestState(t, 64)
+	bs := &Server{
+		HeadFetcher: &mock.ChainService{State: headState, Block: testutil.NewBeaconBlock()},
+	}
+
+	cfg := params.BeaconConfig()
+	cfg.SlotsPerArchivedPoint = 2048
+	params.OverrideBeaconConfig(cfg)
+	defaultRes, err := bs.EstimateStorageGrowth(context.Background(), &pbrpc.StorageGrowthRequest{Days: 1})
+	require.NoError(t, err)
+
+	cfg.SlotsPerArchivedPoint = cfg.SlotsPerEpoch * 4
+	params.OverrideBeaconConfig(cfg)
+	historicalRes, err := bs.EstimateStorageGrowth(context.Background(), &pbrpc.StorageGrowthRequest{Days: 1})
+	require.NoError(t, err)
+
+	assert.Equal(t, true, historicalRes.BytesPerDay > defaultRes.BytesPerDay)
+}
+
+func TestServer_EstimateStorageGrowth_Retention(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.SlotsPerArchivedPoint = 2048
+	params.OverrideBeaconConfig(cfg)
+	headState := storageTestState(t, 64)
+	headBlock := testutil.NewBeaconBlock()
+	bs := &Server{
+		HeadFetcher: &mock.ChainService{State: headState, Block: headBlock},
+	}
+	ctx := context.Background()
+	inline, err := bs.EstimateStorageGrowth(ctx, &pbrpc.StorageGrowthRequest{Days: 1})
+	require.NoError(t, err)
+
+	// Archived states move to the cold state store, so the database only grows by the blocks.
+	bs.ColdStateStoreEnabled = true
+	cold, err := bs.EstimateStorageGrowth(ctx, &pbrpc.StorageGrowthRequest{Days: 1})
+	require.NoError(t, err)
+	slotsPerDay := uint64(86400) / cfg.SecondsPerSlot
+	assert.Equal(t, true, cold.ColdStateStore)
+	assert.Equal(t, slotsPerDay*uint64(headBlock.SizeSSZ()), cold.BytesPerDay)
+	assert.Equal(t, inline.BytesPerDay, cold.BytesPerDay+cold.ColdStoreBytesPerDay)
+	assert.Equal(t, cold.ColdStoreBytesPerDay, cold.ProjectedColdStoreGrowthBytes)
+
+	// Deduplicated states only reference their validators, so each one is smaller the more
+	// validators there are.
+	bs.ColdStateStoreEnabled = false
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{EnableStateValidatorDedup: true})
+	defer resetCfg()
+	dedup, err := bs.EstimateStorageGrowth(ctx, &pbrpc.StorageGrowthRequest{Days: 1})
+	require.NoError(t, err)
+	assert.Equal(t, true, dedup.ValidatorDedup)
+	entrySize := uint64((&ethpb.Validator{}).SizeSSZ())
+	assert.Equal(t, dedup.StateSizeBytes-64*(entrySize-kv.StateValidatorKeyLength), dedup.ArchivedStateSizeBytes)
+	assert.Equal(t, true, dedup.BytesPerDay < inline.BytesPerDay)
+
+	bs.HeadFetcher = &mock.ChainService{State: storageTestState(t, 256), Block: headBlock}
+	larger, err := bs.EstimateStorageGrowth(ctx, &pbrpc.StorageGrowthRequest{Days: 1})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(256), larger.ValidatorCount)
+	assert.Equal(t, larger.StateSizeBytes-256*(entrySize-kv.StateValidatorKeyLength), larger.ArchivedStateSizeBytes)
+}
+
+func storageTestState(t *testing.T, count int) iface.BeaconState {
+	validators := make([]*ethpb.Validator, count)
+	balances := make([]uint64, count)
+	for i := 0; i < count; i++ {
+		pubKey := make([]byte, params.BeaconConfig().BLSPubkeyLength)
+		pubKey[0] = byte(i)
+		validators[i] = &ethpb.Validator{
+			PublicKey:             pubKey,
+			WithdrawalCredentials: make([]byte, 32),
+			EffectiveBalance:      params.BeaconConfig().MaxEffectiveBalance,
+			ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+		}
+		balances[i] = params.BeaconConfig().MaxEffectiveBalance
+	}
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetValidators(validators))
+	require.NoError(t, st.SetBalances(balances))
+	return st
+}
@@ -0,0 +1,73 @@
+package beacon
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetSubnetAssignments returns the attestation subnet each requested validator must subscribe to
+// for an epoch, derived from its committee index and attester slot. Validators which are not
+// active in the epoch have no attester duty and are omitted from the response.
+func (bs *Server) GetSubnetAssignments(ctx context.Context, req *pbrpc.SubnetAssignmentsRequest) (*pbrpc.SubnetAssignments, error) {
+	if len(req.PublicKeys) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Must specify at least one public key")
+	}
+	if len(req.PublicKeys) > bs.MaxPageSize() {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Requested validator count %d can not be greater than max size %d",
+			len(req.PublicKeys),
+			bs.MaxPageSize(),
+		)
+	}
+	if err := bs.checkEpochAdmission(req.Epoch); err != nil {
+		return nil, err
+	}
+
+	st, err := bs.StateGen.CanonicalStateInEpoch(ctx, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve archived state for epoch %d: %v", req.Epoch, err)
+	}
+
+	indices := make([]types.ValidatorIndex, 0, len(req.PublicKeys))
+	for _, pubKey := range req.PublicKeys {
+		index, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubKey))
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "Could not find validator index for public key %#x", pubKey)
+		}
+		indices = append(indices, index)
+	}
+	activeCount, err := helpers.ActiveValidatorCount(bs.CommitteeCache, st, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve active validator count: %v", err)
+	}
+	committeeAssignments, _, err := helpers.CommitteeAssignmentsForIndices(ctx, bs.CommitteeCache, st, req.Epoch, indices)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute committee assignments: %v", err)
+	}
+
+	res := &pbrpc.SubnetAssignments{
+		Epoch:       req.Epoch,
+		Assignments: make([]*pbrpc.SubnetAssignment, 0, len(indices)),
+	}
+	for i, index := range indices {
+		comAssignment, ok := committeeAssignments[index]
+		if !ok {
+			continue
+		}
+		res.Assignments = append(res.Assignments, &pbrpc.SubnetAssignment{
+			PublicKey:      req.PublicKeys[i],
+			ValidatorIndex: index,
+			AttesterSlot:   comAssignment.AttesterSlot,
+			CommitteeIndex: comAssignment.CommitteeIndex,
+			SubnetId:       helpers.ComputeSubnetFromCommitteeAndSlot(activeCount, comAssignment.CommitteeIndex, comAssignment.AttesterSlot),
+		})
+	}
+	return res, nil
+}
@@ -0,0 +1,103 @@
+package beacon
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetSubnetAssignments(t *testing.T) {
+	helpers.ClearCache()
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	count := 100
+	validators := make([]*ethpb.Validator, 0, count)
+	for i := 0; i < count; i++ {
+		pubKey := make([]byte, params.BeaconConfig().BLSPubkeyLength)
+		binary.LittleEndian.PutUint64(pubKey, uint64(i))
+		validators = append(validators, &ethpb.Validator{
+			PublicKey:             pubKey,
+			WithdrawalCredentials: make([]byte, 32),
+			ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+		})
+	}
+	// The last validator is not active yet and has no attester duty.
+	validators[count-1].ActivationEpoch = params.BeaconConfig().FarFutureEpoch
+
+	blk := testutil.NewBeaconBlock().Block
+	blockRoot, err := blk.HashTreeRoot()
+	require.NoError(t, err)
+	s, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, s.SetValidators(validators))
+	require.NoError(t, db.SaveState(ctx, s, blockRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, blockRoot))
+
+	bs := &Server{
+		BeaconDB:           db,
+		GenesisTimeFetcher: &mock.ChainService{},
+		StateGen:           stategen.New(db),
+	}
+
+	req := &pbrpc.SubnetAssignmentsRequest{
+		PublicKeys: [][]byte{validators[3].PublicKey, validators[count-1].PublicKey, validators[7].PublicKey},
+	}
+	res, err := bs.GetSubnetAssignments(ctx, req)
+	require.NoError(t, err)
+
+	committeeAssignments, _, err := helpers.CommitteeAssignments(context.Background(), nil, s, 0)
+	require.NoError(t, err)
+	activeCount := uint64(count - 1)
+	require.Equal(t, 2, len(res.Assignments))
+	for i, index := range []types.ValidatorIndex{3, 7} {
+		wanted := committeeAssignments[index]
+		assert.DeepEqual(t, &pbrpc.SubnetAssignment{
+			PublicKey:      validators[index].PublicKey,
+			ValidatorIndex: index,
+			AttesterSlot:   wanted.AttesterSlot,
+			CommitteeIndex: wanted.CommitteeIndex,
+			SubnetId:       helpers.ComputeSubnetFromCommitteeAndSlot(activeCount, wanted.CommitteeIndex, wanted.AttesterSlot),
+		}, res.Assignments[i])
+	}
+
+}
+
+func TestServer_GetSubnetAssignments_InvalidRequest(t *testing.T) {
+	helpers.ClearCache()
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	s, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	blockRoot := [32]byte{'a'}
+	require.NoError(t, db.SaveState(ctx, s, blockRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, blockRoot))
+	currentSlot := types.Slot(0)
+	bs := &Server{
+		BeaconDB:           db,
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		StateGen:           stategen.New(db),
+	}
+
+	_, err = bs.GetSubnetAssignments(ctx, &pbrpc.SubnetAssignmentsRequest{})
+	assert.ErrorContains(t, "Must specify at least one public key", err)
+
+	_, err = bs.GetSubnetAssignments(ctx, &pbrpc.SubnetAssignmentsRequest{Epoch: 1, PublicKeys: [][]byte{{'a'}}})
+	assert.ErrorContains(t, errNoEpochInfoError, err)
+
+	_, err = bs.GetSubnetAssignments(ctx, &pbrpc.SubnetAssignmentsRequest{PublicKeys: [][]byte{{'a'}}})
+	assert.ErrorContains(t, "Could not find validator index for public key", err)
+}
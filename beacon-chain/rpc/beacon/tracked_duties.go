@@ -0,0 +1,125 @@
+package beacon
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/sirupsen/logrus"
+)
+
+// trackedDutiesEventBuffer is the number of state events buffered before the oldest are dropped.
+const trackedDutiesEventBuffer = 16
+
+var trackedDutySubscriptions = promauto.NewCounter(
+	prometheus.CounterOpts{
+		Name: "tracked_duty_subnet_subscriptions_total",
+		Help: "The number of attestation subnet subscriptions made for the duties of tracked validators.",
+	},
+)
+
+// PreSubscribeTrackedDuties precomputes the duties of the tracked validators for the current and
+// next epoch whenever the head advances into a new epoch or the tracked validators change, and
+// subscribes the attestation subnets of their committees, so that the node follows the subnets of
+// the tracked validators before any validator client asks for them. It runs until the context is
+// done.
+func (bs *Server) PreSubscribeTrackedDuties(ctx context.Context) {
+	if bs.TrackedValidators == nil {
+		return
+	}
+	stateChannel := make(chan *feed.Event, trackedDutiesEventBuffer)
+	stateSub := bs.StateNotifier.StateFeed().SubscribeWithPolicy(stateChannel, "tracked_duties", event.DropOldest)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case ev := <-stateChannel:
+			if ev.Type != statefeed.EpochTransition {
+				continue
+			}
+			data, ok := ev.Data.(*statefeed.EpochTransitionData)
+			if !ok || data == nil {
+				continue
+			}
+			bs.logPreSubscribe(ctx, data.Epoch)
+		case <-bs.TrackedValidators.Updated():
+			bs.logPreSubscribe(ctx, helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot()))
+		case <-stateSub.Err():
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// logPreSubscribe pre-subscribes the duties of the epoch, logging the failures.
+func (bs *Server) logPreSubscribe(ctx context.Context, epoch types.Epoch) {
+	if bs.SyncChecker != nil && bs.SyncChecker.Syncing() {
+		return
+	}
+	subscribed, err := bs.preSubscribeTrackedDuties(ctx, epoch)
+	if err != nil {
+		log.WithError(err).WithField("epoch", epoch).Error("Could not pre-subscribe tracked validator duties")
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"epoch":         epoch,
+		"subscriptions": subscribed,
+	}).Debug("Pre-subscribed tracked validator duties")
+}
+
+// preSubscribeTrackedDuties computes the attester duties of the tracked validators for the epoch
+// and the next one from the start state of the epoch, which also warms the epoch state and
+// committee caches the assignment queries use, and subscribes the attestation subnet of every
+// duty. It returns the number of subscriptions, counting committees shared by several tracked
+// validators once.
+func (bs *Server) preSubscribeTrackedDuties(ctx context.Context, epoch types.Epoch) (int, error) {
+	st, err := bs.epochStartState(ctx, epoch)
+	if err != nil {
+		return 0, errors.Wrapf(err, "could not get start state of epoch %d", epoch)
+	}
+	if st == nil {
+		return 0, errors.Errorf("no state available at the start of epoch %d", epoch)
+	}
+	pubKeys, _ := bs.TrackedValidators.restrict(st, nil, nil)
+	if len(pubKeys) == 0 {
+		return 0, nil
+	}
+	indices := make([]types.ValidatorIndex, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		index, _ := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubKey))
+		indices = append(indices, index)
+	}
+
+	type subscription struct {
+		slot   types.Slot
+		subnet uint64
+	}
+	subscriptions := make(map[subscription]bool)
+	for _, e := range []types.Epoch{epoch, epoch + 1} {
+		assignments, _, err := helpers.CommitteeAssignmentsForIndices(ctx, bs.CommitteeCache, st, e, indices)
+		if err != nil {
+			return 0, errors.Wrapf(err, "could not compute assignments of epoch %d", e)
+		}
+		activeCount, err := helpers.ActiveValidatorCount(bs.CommitteeCache, st, e)
+		if err != nil {
+			return 0, err
+		}
+		for _, a := range assignments {
+			subnet := helpers.ComputeSubnetFromCommitteeAndSlot(activeCount, a.CommitteeIndex, a.AttesterSlot)
+			subscriptions[subscription{slot: a.AttesterSlot, subnet: subnet}] = true
+		}
+	}
+	for sub := range subscriptions {
+		cache.SubnetIDs.AddAttesterSubnetID(sub.slot, sub.subnet)
+	}
+	trackedDutySubscriptions.Add(float64(len(subscriptions)))
+	return len(subscriptions), nil
+}
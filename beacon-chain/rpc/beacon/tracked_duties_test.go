@@ -0,0 +1,65 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_PreSubscribeTrackedDuties(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	cache.SubnetIDs.EmptyAllCaches()
+	defer cache.SubnetIDs.EmptyAllCaches()
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	s := setupActiveValidators(t, 256)
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, s, genesisRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+
+	tracked, err := NewTrackedValidatorsFromDB(ctx, db)
+	require.NoError(t, err)
+	bs := &Server{
+		BeaconDB:          db,
+		HeadFetcher:       &mock.ChainService{State: s},
+		StateGen:          stategen.New(db),
+		TrackedValidators: tracked,
+	}
+
+	subscribed, err := bs.preSubscribeTrackedDuties(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, subscribed, "Expected no subscription without tracked validators")
+
+	// An untracked validator known to no state is ignored.
+	require.NoError(t, tracked.Update([][]byte{pubKey(3), pubKey(1000)}, nil))
+	subscribed, err = bs.preSubscribeTrackedDuties(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, subscribed, "Expected a subscription per epoch")
+
+	activeCount, err := helpers.ActiveValidatorCount(nil, s, 0)
+	require.NoError(t, err)
+	for _, epoch := range []types.Epoch{0, 1} {
+		assignments, _, err := helpers.CommitteeAssignmentsForIndices(context.Background(), nil, s, epoch, []types.ValidatorIndex{3})
+		require.NoError(t, err)
+		a := assignments[3]
+		require.NotNil(t, a)
+		subnet := helpers.ComputeSubnetFromCommitteeAndSlot(activeCount, a.CommitteeIndex, a.AttesterSlot)
+		assert.DeepEqual(t, []uint64{subnet}, cache.SubnetIDs.GetAttesterSubnetIDs(a.AttesterSlot))
+	}
+}
@@ -0,0 +1,317 @@
+package beacon
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const errNoTrackedValidators = "No tracked validators configured"
+
+// TrackedValidatorsStore persists the tracked validators in the database.
+type TrackedValidatorsStore interface {
+	TrackedValidatorKeys(ctx context.Context) ([][48]byte, error)
+	SaveTrackedValidatorKeys(ctx context.Context, keys [][48]byte) error
+}
+
+// TrackedValidators is the persistent set of public keys the node operator is interested in.
+// The set is stored either in the database or in a file holding one hex encoded public key per
+// line, where empty lines and lines starting with '#' are ignored.
+type TrackedValidators struct {
+	path  string
+	store TrackedValidatorsStore
+	lock  sync.RWMutex
+	keys  map[[48]byte]bool
+	// updated is notified after every change of the set, without blocking.
+	updated chan struct{}
+}
+
+// NewTrackedValidators loads the tracked validators from the file at the given path. A missing
+// file is treated as an empty set and is created on the first update.
+func NewTrackedValidators(path string) (*TrackedValidators, error) {
+	t := &TrackedValidators{
+		path:    path,
+		keys:    make(map[[48]byte]bool),
+		updated: make(chan struct{}, 1),
+	}
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return t, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Could not close tracked validators file")
+		}
+	}()
+	keys, err := parseTrackedValidators(f, path)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range keys {
+		t.keys[k] = true
+	}
+	return t, nil
+}
+
+// parseTrackedValidators reads public keys in the tracked validators file format, naming the
+// source in errors.
+func parseTrackedValidators(r io.Reader, source string) ([][48]byte, error) {
+	var keys [][48]byte
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		var key [48]byte
+		pubKey, err := hex.DecodeString(strings.TrimPrefix(text, "0x"))
+		if err != nil || len(pubKey) != len(key) {
+			return nil, fmt.Errorf("invalid public key %q on line %d of %s", text, line, source)
+		}
+		copy(key[:], pubKey)
+		keys = append(keys, key)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// encodeTrackedValidators encodes public keys in the tracked validators file format, in
+// ascending order.
+func encodeTrackedValidators(pubKeys [][]byte) []byte {
+	var buf bytes.Buffer
+	for _, pubKey := range pubKeys {
+		buf.WriteString(fmt.Sprintf("%#x", pubKey))
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes()
+}
+
+// NewTrackedValidatorsFromDB loads the tracked validators from the database.
+func NewTrackedValidatorsFromDB(ctx context.Context, store TrackedValidatorsStore) (*TrackedValidators, error) {
+	keys, err := store.TrackedValidatorKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t := &TrackedValidators{
+		store:   store,
+		keys:    make(map[[48]byte]bool, len(keys)),
+		updated: make(chan struct{}, 1),
+	}
+	for _, k := range keys {
+		t.keys[k] = true
+	}
+	return t, nil
+}
+
+// Has returns true if the public key is tracked.
+func (t *TrackedValidators) Has(pubKey [48]byte) bool {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.keys[pubKey]
+}
+
+// Len returns the number of tracked public keys.
+func (t *TrackedValidators) Len() int {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return len(t.keys)
+}
+
+// PublicKeys returns the tracked public keys in ascending order.
+func (t *TrackedValidators) PublicKeys() [][]byte {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	keys := make([][]byte, 0, len(t.keys))
+	for k := range t.keys {
+		key := k
+		keys = append(keys, key[:])
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+	return keys
+}
+
+// Update adds and removes public keys from the set and persists it.
+func (t *TrackedValidators) Update(add, remove [][]byte) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for _, pubKey := range add {
+		t.keys[bytesutil.ToBytes48(pubKey)] = true
+	}
+	for _, pubKey := range remove {
+		delete(t.keys, bytesutil.ToBytes48(pubKey))
+	}
+	return t.persist()
+}
+
+// Replace replaces the set with the given public keys and persists it.
+func (t *TrackedValidators) Replace(pubKeys [][]byte) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.keys = make(map[[48]byte]bool, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		t.keys[bytesutil.ToBytes48(pubKey)] = true
+	}
+	return t.persist()
+}
+
+// Updated returns a channel notified after the set changes. Changes made while a notification is
+// pending are coalesced into it.
+func (t *TrackedValidators) Updated() <-chan struct{} {
+	return t.updated
+}
+
+// persist saves the set and notifies the update.
+func (t *TrackedValidators) persist() error {
+	var err error
+	if t.store != nil {
+		keys := make([][48]byte, 0, len(t.keys))
+		for k := range t.keys {
+			keys = append(keys, k)
+		}
+		err = t.store.SaveTrackedValidatorKeys(context.Background(), keys)
+	} else {
+		err = t.persistFile()
+	}
+	if err != nil {
+		return err
+	}
+	select {
+	case t.updated <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// persistFile writes the set to a temporary file which then replaces the tracked validators file,
+// so that a crash never leaves a partially written file behind.
+func (t *TrackedValidators) persistFile() error {
+	keys := make([][]byte, 0, len(t.keys))
+	for k := range t.keys {
+		key := k
+		keys = append(keys, key[:])
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+	tmp := t.path + ".tmp"
+	if err := fileutil.WriteFile(tmp, encodeTrackedValidators(keys)); err != nil {
+		return errors.Wrap(err, "could not write tracked validators")
+	}
+	return os.Rename(tmp, t.path)
+}
+
+// restrict narrows the requested validators down to the tracked ones, resolving indices against
+// the given state. Without requested validators, every tracked validator known to the state is
+// selected.
+func (t *TrackedValidators) restrict(
+	st iface.ReadOnlyBeaconState, pubKeys [][]byte, indices []types.ValidatorIndex,
+) ([][]byte, []types.ValidatorIndex) {
+	if len(pubKeys) == 0 && len(indices) == 0 {
+		var tracked [][]byte
+		for _, pubKey := range t.PublicKeys() {
+			if _, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubKey)); ok {
+				tracked = append(tracked, pubKey)
+			}
+		}
+		return tracked, nil
+	}
+	var trackedKeys [][]byte
+	for _, pubKey := range pubKeys {
+		if t.Has(bytesutil.ToBytes48(pubKey)) {
+			trackedKeys = append(trackedKeys, pubKey)
+		}
+	}
+	var trackedIndices []types.ValidatorIndex
+	for _, index := range indices {
+		if uint64(index) < uint64(st.NumValidators()) && t.Has(st.PubkeyAtIndex(index)) {
+			trackedIndices = append(trackedIndices, index)
+		}
+	}
+	return trackedKeys, trackedIndices
+}
+
+// trackedOnly returns the requested tracked only scope, or an error if the response must be
+// restricted to the tracked validators while none are configured.
+func (bs *Server) trackedOnly(requested bool) (bool, error) {
+	if requested && bs.TrackedValidators == nil {
+		return false, status.Error(codes.FailedPrecondition, errNoTrackedValidators)
+	}
+	return requested, nil
+}
+
+// UpdateTrackedValidators adds and removes public keys from the persistent tracked validators set
+// and returns the updated set.
+func (bs *Server) UpdateTrackedValidators(_ context.Context, req *pbrpc.TrackValidatorsRequest) (*pbrpc.TrackedValidatorsResponse, error) {
+	if bs.TrackedValidators == nil {
+		return nil, status.Error(codes.FailedPrecondition, errNoTrackedValidators)
+	}
+	for _, pubKey := range append(append([][]byte{}, req.Add...), req.Remove...) {
+		if len(pubKey) != params.BeaconConfigOf(bs.ChainConfig).BLSPubkeyLength {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid public key length %d", len(pubKey))
+		}
+	}
+	if err := bs.TrackedValidators.Update(req.Add, req.Remove); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not update tracked validators: %v", err)
+	}
+	return &pbrpc.TrackedValidatorsResponse{PublicKeys: bs.TrackedValidators.PublicKeys()}, nil
+}
+
+// ImportTrackedValidators adds the imported public keys to the tracked validators, or replaces the
+// tracked validators with them, and returns the updated set. Validators added to the set have
+// their duties precomputed and their attestation subnets subscribed from the next update on.
+func (bs *Server) ImportTrackedValidators(_ context.Context, req *pbrpc.ImportTrackedValidatorsRequest) (*pbrpc.TrackedValidatorsResponse, error) {
+	if bs.TrackedValidators == nil {
+		return nil, status.Error(codes.FailedPrecondition, errNoTrackedValidators)
+	}
+	keys, err := parseTrackedValidators(bytes.NewReader(req.Data), "import")
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	pubKeys := make([][]byte, len(keys))
+	for i := range keys {
+		pubKeys[i] = keys[i][:]
+	}
+	if req.Replace {
+		err = bs.TrackedValidators.Replace(pubKeys)
+	} else {
+		err = bs.TrackedValidators.Update(pubKeys, nil)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not import tracked validators: %v", err)
+	}
+	return &pbrpc.TrackedValidatorsResponse{PublicKeys: bs.TrackedValidators.PublicKeys()}, nil
+}
+
+// ExportTrackedValidators returns the tracked public keys in a format ImportTrackedValidators
+// and --tracked-validators-file accept.
+func (bs *Server) ExportTrackedValidators(_ context.Context, _ *empty.Empty) (*pbrpc.TrackedValidatorsExport, error) {
+	if bs.TrackedValidators == nil {
+		return nil, status.Error(codes.FailedPrecondition, errNoTrackedValidators)
+	}
+	return &pbrpc.TrackedValidatorsExport{Data: encodeTrackedValidators(bs.TrackedValidators.PublicKeys())}, nil
+}
@@ -0,0 +1,255 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestTrackedValidators_LoadAndPersist(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tracked.txt")
+	contents := fmt.Sprintf("# my validators\n\n%#x\n  %x  \n", pubKey(1), pubKey(2))
+	require.NoError(t, ioutil.WriteFile(path, []byte(contents), params.BeaconIoConfig().ReadWritePermissions))
+
+	tracked, err := NewTrackedValidators(path)
+	require.NoError(t, err)
+	assert.Equal(t, 2, tracked.Len())
+	assert.Equal(t, true, tracked.Has(bytesutil.ToBytes48(pubKey(1))))
+	assert.Equal(t, true, tracked.Has(bytesutil.ToBytes48(pubKey(2))))
+
+	require.NoError(t, tracked.Update([][]byte{pubKey(3)}, [][]byte{pubKey(1)}))
+	reloaded, err := NewTrackedValidators(path)
+	require.NoError(t, err)
+	assert.DeepEqual(t, tracked.PublicKeys(), reloaded.PublicKeys())
+	assert.Equal(t, false, reloaded.Has(bytesutil.ToBytes48(pubKey(1))))
+	assert.Equal(t, true, reloaded.Has(bytesutil.ToBytes48(pubKey(3))))
+}
+
+func TestTrackedValidators_MissingAndInvalidFile(t *testing.T) {
+	dir := t.TempDir()
+	tracked, err := NewTrackedValidators(filepath.Join(dir, "missing.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, 0, tracked.Len())
+
+	path := filepath.Join(dir, "invalid.txt")
+	require.NoError(t, ioutil.WriteFile(path, []byte("0x1234\n"), params.BeaconIoConfig().ReadWritePermissions))
+	_, err = NewTrackedValidators(path)
+	assert.ErrorContains(t, "invalid public key \"0x1234\" on line 1", err)
+}
+
+func TestTrackedValidators_Database(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+	tracked, err := NewTrackedValidatorsFromDB(ctx, db)
+	require.NoError(t, err)
+	assert.Equal(t, 0, tracked.Len())
+
+	require.NoError(t, tracked.Update([][]byte{pubKey(1), pubKey(2)}, nil))
+	select {
+	case <-tracked.Updated():
+	default:
+		t.Fatal("Expected the update to be notified")
+	}
+	require.NoError(t, tracked.Update(nil, [][]byte{pubKey(1)}))
+	reloaded, err := NewTrackedValidatorsFromDB(ctx, db)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][]byte{pubKey(2)}, reloaded.PublicKeys())
+
+	require.NoError(t, tracked.Replace([][]byte{pubKey(3)}))
+	reloaded, err = NewTrackedValidatorsFromDB(ctx, db)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][]byte{pubKey(3)}, reloaded.PublicKeys())
+}
+
+func TestServer_ImportExportTrackedValidators(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+	tracked, err := NewTrackedValidatorsFromDB(ctx, db)
+	require.NoError(t, err)
+	bs := &Server{TrackedValidators: tracked}
+
+	data := []byte(fmt.Sprintf("# exported\n%#x\n%#x\n", pubKey(2), pubKey(1)))
+	res, err := bs.ImportTrackedValidators(ctx, &pbrpc.ImportTrackedValidatorsRequest{Data: data})
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][]byte{pubKey(1), pubKey(2)}, res.PublicKeys)
+
+	res, err = bs.ImportTrackedValidators(ctx, &pbrpc.ImportTrackedValidatorsRequest{Data: []byte(fmt.Sprintf("%#x\n", pubKey(3)))})
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][]byte{pubKey(1), pubKey(2), pubKey(3)}, res.PublicKeys)
+
+	exported, err := bs.ExportTrackedValidators(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("%#x\n%#x\n%#x\n", pubKey(1), pubKey(2), pubKey(3)), string(exported.Data))
+
+	// An export imported with Replace restores the exported set.
+	require.NoError(t, tracked.Update([][]byte{pubKey(4)}, nil))
+	res, err = bs.ImportTrackedValidators(ctx, &pbrpc.ImportTrackedValidatorsRequest{Data: exported.Data, Replace: true})
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][]byte{pubKey(1), pubKey(2), pubKey(3)}, res.PublicKeys)
+
+	_, err = bs.ImportTrackedValidators(ctx, &pbrpc.ImportTrackedValidatorsRequest{Data: []byte("0x1234\n"), Replace: true})
+	assert.ErrorContains(t, "invalid public key \"0x1234\" on line 1 of import", err)
+	assert.Equal(t, 3, tracked.Len(), "Expected an invalid import to leave the set unchanged")
+
+	_, err = (&Server{}).ExportTrackedValidators(ctx, &empty.Empty{})
+	assert.ErrorContains(t, errNoTrackedValidators, err)
+}
+
+func TestServer_TrackedOnly_NotConfigured(t *testing.T) {
+	bs := &Server{}
+	ctx := context.Background()
+	_, err := bs.trackedOnly(true)
+	assert.ErrorContains(t, errNoTrackedValidators, err)
+	_, err = bs.ListTrackedValidatorBalances(ctx, &ethpb.ListValidatorBalancesRequest{})
+	assert.ErrorContains(t, errNoTrackedValidators, err)
+	_, err = bs.GetTrackedValidatorPerformance(ctx, &ethpb.ValidatorPerformanceRequest{})
+	assert.ErrorContains(t, errNoTrackedValidators, err)
+
+	trackedOnly, err := bs.trackedOnly(false)
+	require.NoError(t, err)
+	assert.Equal(t, false, trackedOnly)
+}
+
+func TestServer_ListValidatorBalances_TrackedOnly(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	numItems := 10
+	validators := make([]*ethpb.Validator, numItems)
+	balances := make([]uint64, numItems)
+	for i := 0; i < numItems; i++ {
+		validators[i] = &ethpb.Validator{
+			PublicKey:             pubKey(uint64(i)),
+			WithdrawalCredentials: make([]byte, 32),
+		}
+		balances[i] = params.BeaconConfig().MaxEffectiveBalance
+	}
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetValidators(validators))
+	require.NoError(t, st.SetBalances(balances))
+	b := testutil.NewBeaconBlock()
+	require.NoError(t, beaconDB.SaveBlock(ctx, b))
+	gRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, gRoot))
+	require.NoError(t, beaconDB.SaveState(ctx, st, gRoot))
+
+	tracked, err := NewTrackedValidators(filepath.Join(t.TempDir(), "tracked.txt"))
+	require.NoError(t, err)
+	// The third tracked validator is not part of the registry yet.
+	require.NoError(t, tracked.Update([][]byte{pubKey(2), pubKey(5), pubKey(100)}, nil))
+	bs := &Server{
+		GenesisTimeFetcher: &mock.ChainService{},
+		StateGen:           stategen.New(beaconDB),
+		TrackedValidators:  tracked,
+	}
+
+	res, err := bs.ListTrackedValidatorBalances(ctx, &ethpb.ListValidatorBalancesRequest{
+		QueryFilter: &ethpb.ListValidatorBalancesRequest_Epoch{Epoch: 0},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Balances))
+	assert.Equal(t, types.ValidatorIndex(2), res.Balances[0].Index)
+	assert.Equal(t, types.ValidatorIndex(5), res.Balances[1].Index)
+	assert.Equal(t, int32(2), res.TotalSize)
+
+	// Requested validators outside of the tracked set are dropped.
+	res, err = bs.ListTrackedValidatorBalances(ctx, &ethpb.ListValidatorBalancesRequest{
+		QueryFilter: &ethpb.ListValidatorBalancesRequest_Epoch{Epoch: 0},
+		PublicKeys:  [][]byte{pubKey(1), pubKey(5)},
+		Indices:     []types.ValidatorIndex{2, 3},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Balances))
+	assert.Equal(t, types.ValidatorIndex(2), res.Balances[0].Index)
+	assert.Equal(t, types.ValidatorIndex(5), res.Balances[1].Index)
+
+	res, err = bs.ListTrackedValidatorBalances(ctx, &ethpb.ListValidatorBalancesRequest{
+		QueryFilter: &ethpb.ListValidatorBalancesRequest_Epoch{Epoch: 0},
+		PublicKeys:  [][]byte{pubKey(1)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Balances))
+
+	// ListValidatorBalances returns every balance.
+	res, err = bs.ListValidatorBalances(ctx, &ethpb.ListValidatorBalancesRequest{
+		QueryFilter: &ethpb.ListValidatorBalancesRequest_Epoch{Epoch: 0},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, numItems, len(res.Balances))
+}
+
+func TestServer_ListAssignmentsRange_TrackedOnly(t *testing.T) {
+	helpers.ClearCache()
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	count := 64
+	validators := make([]*ethpb.Validator, 0, count)
+	for i := 0; i < count; i++ {
+		validators = append(validators, &ethpb.Validator{
+			PublicKey:             pubKey(uint64(i)),
+			WithdrawalCredentials: make([]byte, 32),
+			ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+		})
+	}
+	blk := testutil.NewBeaconBlock().Block
+	blockRoot, err := blk.HashTreeRoot()
+	require.NoError(t, err)
+	s, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, s.SetValidators(validators))
+	require.NoError(t, db.SaveState(ctx, s, blockRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, blockRoot))
+
+	tracked, err := NewTrackedValidators(filepath.Join(t.TempDir(), "tracked.txt"))
+	require.NoError(t, err)
+	require.NoError(t, tracked.Update([][]byte{pubKey(7), pubKey(3)}, nil))
+	bs := &Server{
+		BeaconDB:           db,
+		GenesisTimeFetcher: &mock.ChainService{},
+		StateGen:           stategen.New(db),
+		TrackedValidators:  tracked,
+	}
+
+	res, err := bs.ListValidatorAssignmentsRange(ctx, &pbrpc.ListValidatorAssignmentsRangeRequest{TrackedOnly: true})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Epochs))
+	require.Equal(t, 2, len(res.Epochs[0].Assignments))
+	assert.Equal(t, types.ValidatorIndex(3), res.Epochs[0].Assignments[0].ValidatorIndex)
+	assert.Equal(t, types.ValidatorIndex(7), res.Epochs[0].Assignments[1].ValidatorIndex)
+
+	res, err = bs.ListValidatorAssignmentsRange(ctx, &pbrpc.ListValidatorAssignmentsRangeRequest{
+		Indices:     []types.ValidatorIndex{1, 2},
+		TrackedOnly: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Epochs))
+	assert.Equal(t, 0, len(res.Epochs[0].Assignments))
+
+	annotated, err := bs.ListAnnotatedValidatorAssignments(ctx, &pbrpc.AnnotatedValidatorAssignmentsRequest{
+		QueryFilter: &pbrpc.AnnotatedValidatorAssignmentsRequest_Genesis{Genesis: true},
+		TrackedOnly: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(annotated.Assignments.Assignments))
+	assert.Equal(t, types.ValidatorIndex(3), annotated.Assignments.Assignments[0].ValidatorIndex)
+	assert.Equal(t, types.ValidatorIndex(7), annotated.Assignments.Assignments[1].ValidatorIndex)
+}
@@ -0,0 +1,29 @@
+package beacon
+
+import (
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+)
+
+// validatorFields returns the registry fields of the validator of every assignment, in the order
+// of the assignments, as recorded in the state the assignments were computed from.
+func validatorFields(
+	st iface.ReadOnlyBeaconState, assignments []*ethpb.ValidatorAssignments_CommitteeAssignment,
+) ([]*pbrpc.ValidatorFields, error) {
+	fields := make([]*pbrpc.ValidatorFields, 0, len(assignments))
+	for _, a := range assignments {
+		val, err := st.ValidatorAtIndexReadOnly(a.ValidatorIndex)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, &pbrpc.ValidatorFields{
+			ValidatorIndex:             a.ValidatorIndex,
+			EffectiveBalance:           val.EffectiveBalance(),
+			ActivationEligibilityEpoch: val.ActivationEligibilityEpoch(),
+			ActivationEpoch:            val.ActivationEpoch(),
+			ExitEpoch:                  val.ExitEpoch(),
+		})
+	}
+	return fields, nil
+}
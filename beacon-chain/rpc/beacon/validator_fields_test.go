@@ -0,0 +1,75 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_ListAssignments_ValidatorFields(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	blockRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	s, _ := testutil.DeterministicGenesisState(t, 64)
+	val, err := s.ValidatorAtIndex(10)
+	require.NoError(t, err)
+	val.EffectiveBalance = params.BeaconConfig().MaxEffectiveBalance / 2
+	val.ExitEpoch = 9
+	require.NoError(t, s.UpdateValidatorAtIndex(10, val))
+	require.NoError(t, db.SaveState(ctx, s, blockRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, blockRoot))
+
+	currentSlot := types.Slot(0)
+	bs := &Server{
+		BeaconDB:           db,
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		StateGen:           stategen.New(db),
+	}
+
+	res, err := bs.ListValidatorAssignmentsRange(ctx, &pbrpc.ListValidatorAssignmentsRangeRequest{
+		Indices:                []types.ValidatorIndex{3, 10},
+		IncludeValidatorFields: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.ValidatorFields))
+	fields := res.ValidatorFields[0].Fields
+	maxBalance := params.BeaconConfig().MaxEffectiveBalance
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	assert.DeepEqual(t, []*pbrpc.ValidatorFields{
+		{ValidatorIndex: 3, EffectiveBalance: maxBalance, ActivationEpoch: 0, ExitEpoch: farFuture},
+		{ValidatorIndex: 10, EffectiveBalance: maxBalance / 2, ActivationEpoch: 0, ExitEpoch: 9},
+	}, fields)
+
+	// Annotated requests opt in through the request and receive the fields in the response.
+	annotated, err := bs.ListAnnotatedValidatorAssignments(ctx, &pbrpc.AnnotatedValidatorAssignmentsRequest{
+		QueryFilter:            &pbrpc.AnnotatedValidatorAssignmentsRequest_Epoch{Epoch: 0},
+		Indices:                []types.ValidatorIndex{3, 10},
+		IncludeValidatorFields: true,
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, fields, annotated.ValidatorFields)
+
+	annotated, err = bs.ListAnnotatedValidatorAssignments(ctx, &pbrpc.AnnotatedValidatorAssignmentsRequest{
+		QueryFilter: &pbrpc.AnnotatedValidatorAssignmentsRequest_Epoch{Epoch: 0},
+		Indices:     []types.ValidatorIndex{3, 10},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(annotated.ValidatorFields))
+}
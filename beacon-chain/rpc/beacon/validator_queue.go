@@ -0,0 +1,184 @@
+package beacon
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// finalityDelay is the number of epochs finalization is assumed to lag behind the current epoch
+// when estimating when pending validators become eligible for the activation queue.
+const finalityDelay = types.Epoch(2)
+
+// GetValidatorQueueDetails returns the epoch every pending validator is expected to activate or
+// exit at, along with the time left until then, so that changes of the duty set can be
+// anticipated. Exit epochs are assigned when an exit is initiated and are exact. Activation
+// epochs are assigned by the epoch processing once a validator reaches the front of the queue,
+// so the later ones are estimated with the churn limit of the head, assuming the chain keeps
+// finalizing two epochs behind.
+func (bs *Server) GetValidatorQueueDetails(
+	ctx context.Context, req *pbrpc.ValidatorQueueDetailsRequest,
+) (*pbrpc.ValidatorQueueDetails, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconChainServer.GetValidatorQueueDetails")
+	defer span.End()
+
+	trackedOnly, err := bs.trackedOnly(req.TrackedOnly)
+	if err != nil {
+		return nil, err
+	}
+	headState, err := bs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil {
+		return nil, status.Error(codes.Unavailable, "No head state")
+	}
+	currentEpoch := helpers.CurrentEpoch(headState)
+	activeCount, err := helpers.ActiveValidatorCount(bs.CommitteeCache, headState, currentEpoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get active validator count: %v", err)
+	}
+	churnLimit, err := helpers.ValidatorChurnLimit(activeCount)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute churn limit: %v", err)
+	}
+
+	cfg := params.BeaconConfigOf(bs.ChainConfig)
+	res := &pbrpc.ValidatorQueueDetails{
+		Epoch:       currentEpoch,
+		ChurnLimit:  churnLimit,
+		Activations: make([]*pbrpc.QueuedValidator, 0),
+		Exits:       make([]*pbrpc.QueuedValidator, 0),
+	}
+	// The activation queue is the whole registry ordered by eligibility, including the validators
+	// which are not tracked, as they take up the churn of the tracked ones before them.
+	var queue []*pbrpc.QueuedValidator
+	for idx, val := range headState.Validators() {
+		index := types.ValidatorIndex(idx)
+		switch {
+		case val.ExitEpoch != cfg.FarFutureEpoch && val.ExitEpoch > currentEpoch:
+			res.Exits = append(res.Exits, &pbrpc.QueuedValidator{
+				Index:                      index,
+				PublicKey:                  val.PublicKey,
+				ActivationEligibilityEpoch: val.ActivationEligibilityEpoch,
+				ExpectedEpoch:              val.ExitEpoch,
+				WithdrawableEpoch:          val.WithdrawableEpoch,
+				Exact:                      true,
+			})
+		case val.ActivationEpoch != cfg.FarFutureEpoch && val.ActivationEpoch > currentEpoch:
+			res.Activations = append(res.Activations, &pbrpc.QueuedValidator{
+				Index:                      index,
+				PublicKey:                  val.PublicKey,
+				ActivationEligibilityEpoch: val.ActivationEligibilityEpoch,
+				ExpectedEpoch:              val.ActivationEpoch,
+				Exact:                      true,
+			})
+		case val.ActivationEpoch == cfg.FarFutureEpoch:
+			eligibility := val.ActivationEligibilityEpoch
+			if eligibility == cfg.FarFutureEpoch {
+				// Fully deposited validators become eligible at the next epoch processing.
+				if val.EffectiveBalance != cfg.MaxEffectiveBalance {
+					continue
+				}
+				eligibility = currentEpoch + 1
+			}
+			queue = append(queue, &pbrpc.QueuedValidator{
+				Index:                      index,
+				PublicKey:                  val.PublicKey,
+				ActivationEligibilityEpoch: eligibility,
+			})
+		}
+	}
+	estimateActivations(queue, currentEpoch, headState.FinalizedCheckpointEpoch(), churnLimit)
+	res.Activations = append(res.Activations, queue...)
+
+	if trackedOnly {
+		res.Activations = bs.TrackedValidators.filterQueued(res.Activations)
+		res.Exits = bs.TrackedValidators.filterQueued(res.Exits)
+	}
+	now := timeutils.Now()
+	for _, queued := range [][]*pbrpc.QueuedValidator{res.Activations, res.Exits} {
+		for _, v := range queued {
+			v.EstimatedWait, err = bs.timeUntilEpoch(now, v.ExpectedEpoch)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not compute start of epoch %d: %v", v.ExpectedEpoch, err)
+			}
+		}
+		sortQueued(queued)
+	}
+	return res, nil
+}
+
+// estimateActivations assigns the expected activation epochs of the queue, simulating the epoch
+// processing which activates up to the churn limit of the eligible validators every epoch, in
+// order of eligibility and index.
+func estimateActivations(queue []*pbrpc.QueuedValidator, currentEpoch, finalizedEpoch types.Epoch, churnLimit uint64) {
+	sort.Slice(queue, func(i, j int) bool {
+		if queue[i].ActivationEligibilityEpoch != queue[j].ActivationEligibilityEpoch {
+			return queue[i].ActivationEligibilityEpoch < queue[j].ActivationEligibilityEpoch
+		}
+		return queue[i].Index < queue[j].Index
+	})
+	pos := 0
+	for epoch := currentEpoch; pos < len(queue); epoch++ {
+		finalized := finalizedEpoch
+		if epoch > finalityDelay && epoch-finalityDelay > finalized {
+			finalized = epoch - finalityDelay
+		}
+		for dequeued := uint64(0); dequeued < churnLimit && pos < len(queue); dequeued++ {
+			if queue[pos].ActivationEligibilityEpoch > finalized {
+				break
+			}
+			queue[pos].ExpectedEpoch = helpers.ActivationExitEpoch(epoch)
+			pos++
+		}
+	}
+}
+
+// timeUntilEpoch returns the time from now until the start of the epoch, zero if it started.
+func (bs *Server) timeUntilEpoch(now time.Time, epoch types.Epoch) (time.Duration, error) {
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return 0, err
+	}
+	var start time.Time
+	if bs.SlotTimeFetcher != nil {
+		start = bs.SlotTimeFetcher.SlotStartTime(startSlot)
+	} else {
+		start = bs.GenesisTimeFetcher.GenesisTime().Add(time.Duration(uint64(startSlot)*params.BeaconConfigOf(bs.ChainConfig).SecondsPerSlot) * time.Second)
+	}
+	if wait := start.Sub(now); wait > 0 {
+		return wait, nil
+	}
+	return 0, nil
+}
+
+func sortQueued(queued []*pbrpc.QueuedValidator) {
+	sort.Slice(queued, func(i, j int) bool {
+		if queued[i].ExpectedEpoch != queued[j].ExpectedEpoch {
+			return queued[i].ExpectedEpoch < queued[j].ExpectedEpoch
+		}
+		return queued[i].Index < queued[j].Index
+	})
+}
+
+// filterQueued returns the tracked validators of the queue.
+func (t *TrackedValidators) filterQueued(queued []*pbrpc.QueuedValidator) []*pbrpc.QueuedValidator {
+	res := make([]*pbrpc.QueuedValidator, 0)
+	for _, v := range queued {
+		if t.Has(bytesutil.ToBytes48(v.PublicKey)) {
+			res = append(res, v)
+		}
+	}
+	return res
+}
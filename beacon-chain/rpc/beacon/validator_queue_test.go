@@ -0,0 +1,105 @@
+package beacon
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetValidatorQueueDetails(t *testing.T) {
+	cfg := params.BeaconConfig()
+	far := cfg.FarFutureEpoch
+	validator := func(i uint64, eligibility, activation, exit types.Epoch) *ethpb.Validator {
+		return &ethpb.Validator{
+			PublicKey:                  pubKey(i),
+			WithdrawalCredentials:      make([]byte, 32),
+			EffectiveBalance:           cfg.MaxEffectiveBalance,
+			ActivationEligibilityEpoch: eligibility,
+			ActivationEpoch:            activation,
+			ExitEpoch:                  exit,
+			WithdrawableEpoch:          far,
+		}
+	}
+	var validators []*ethpb.Validator
+	for i := uint64(0); i < 8; i++ {
+		validators = append(validators, validator(i, 0, 0, far))
+	}
+	// Validator 8 exits at epoch 12, validator 9 activates at epoch 13.
+	exiting := validator(8, 0, 0, 12)
+	exiting.WithdrawableEpoch = 12 + cfg.MinValidatorWithdrawabilityDelay
+	validators = append(validators, exiting, validator(9, 4, 13, far))
+	// Validators 10 to 15 are eligible, and more than the churn limit of 4 of them.
+	for i := uint64(10); i < 16; i++ {
+		validators = append(validators, validator(i, 5, far, far))
+	}
+	// Validator 16 is fully deposited but not eligible yet, validator 17 is not fully deposited.
+	validators = append(validators, validator(16, far, far, far))
+	partial := validator(17, far, far, far)
+	partial.EffectiveBalance = cfg.MaxEffectiveBalance / 2
+	validators = append(validators, partial)
+
+	s, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, s.SetValidators(validators))
+	require.NoError(t, s.SetSlot(10*cfg.SlotsPerEpoch))
+	require.NoError(t, s.SetFinalizedCheckpoint(&ethpb.Checkpoint{Epoch: 8, Root: make([]byte, 32)}))
+
+	epochDuration := time.Duration(uint64(cfg.SlotsPerEpoch)*cfg.SecondsPerSlot) * time.Second
+	genesis := time.Now().Add(-10 * epochDuration)
+	bs := &Server{
+		HeadFetcher:        &mock.ChainService{State: s},
+		GenesisTimeFetcher: &mock.ChainService{Genesis: genesis},
+	}
+	res, err := bs.GetValidatorQueueDetails(context.Background(), &pbrpc.ValidatorQueueDetailsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(10), res.Epoch)
+	assert.Equal(t, cfg.MinPerEpochChurnLimit, res.ChurnLimit)
+
+	require.Equal(t, 1, len(res.Exits))
+	assert.Equal(t, types.ValidatorIndex(8), res.Exits[0].Index)
+	assert.Equal(t, types.Epoch(12), res.Exits[0].ExpectedEpoch)
+	assert.Equal(t, exiting.WithdrawableEpoch, res.Exits[0].WithdrawableEpoch)
+	assert.Equal(t, true, res.Exits[0].Exact)
+	assert.Equal(t, true, res.Exits[0].EstimatedWait > 2*epochDuration-time.Minute)
+	assert.Equal(t, true, res.Exits[0].EstimatedWait <= 2*epochDuration)
+
+	// Four validators are activated per epoch, the one becoming eligible at epoch 11 waits
+	// until that epoch is assumed finalized at epoch 13.
+	wanted := map[types.ValidatorIndex]types.Epoch{
+		10: 15, 11: 15, 12: 15, 13: 15,
+		14: 16, 15: 16,
+		9:  13,
+		16: 18,
+	}
+	require.Equal(t, len(wanted), len(res.Activations))
+	for i, v := range res.Activations {
+		assert.Equal(t, wanted[v.Index], v.ExpectedEpoch, "Unexpected activation epoch of validator %d", v.Index)
+		assert.Equal(t, v.Index == 9, v.Exact)
+		if i > 0 {
+			assert.Equal(t, true, res.Activations[i-1].ExpectedEpoch <= v.ExpectedEpoch)
+		}
+	}
+	assert.Equal(t, types.ValidatorIndex(9), res.Activations[0].Index)
+	assert.Equal(t, types.Epoch(11), res.Activations[len(res.Activations)-1].ActivationEligibilityEpoch)
+
+	tracked, err := NewTrackedValidators(filepath.Join(t.TempDir(), "tracked.txt"))
+	require.NoError(t, err)
+	require.NoError(t, tracked.Update([][]byte{pubKey(16)}, nil))
+	bs.TrackedValidators = tracked
+	res, err = bs.GetValidatorQueueDetails(context.Background(), &pbrpc.ValidatorQueueDetailsRequest{TrackedOnly: true})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Activations))
+	assert.Equal(t, types.ValidatorIndex(16), res.Activations[0].Index)
+	assert.Equal(t, types.Epoch(18), res.Activations[0].ExpectedEpoch)
+	assert.Equal(t, 0, len(res.Exits))
+}
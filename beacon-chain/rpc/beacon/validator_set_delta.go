@@ -0,0 +1,104 @@
+package beacon
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetValidatorSetDelta returns the validator records which were added, changed or removed between
+// two epochs, so that indexers can follow the registry without downloading it every epoch. The
+// records are compared on the states at the start slots of both epochs. Epoch summaries only
+// archive registry totals, so the states are regenerated if they are not cached.
+func (bs *Server) GetValidatorSetDelta(ctx context.Context, req *pbrpc.GetValidatorSetDeltaRequest) (*pbrpc.ValidatorSetDelta, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconChainServer.GetValidatorSetDelta")
+	defer span.End()
+
+	currentEpoch := helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
+	if req.ToEpoch > currentEpoch {
+		return nil, futureEpochError(currentEpoch, req.ToEpoch)
+	}
+	if req.FromEpoch > req.ToEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "From epoch %d is after to epoch %d", req.FromEpoch, req.ToEpoch)
+	}
+
+	fromState, err := bs.validatorSetState(ctx, req.FromEpoch)
+	if err != nil {
+		return nil, err
+	}
+	toState, err := bs.validatorSetState(ctx, req.ToEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	delta := &pbrpc.ValidatorSetDelta{
+		FromEpoch:      req.FromEpoch,
+		ToEpoch:        req.ToEpoch,
+		ValidatorCount: uint64(toState.NumValidators()),
+		Added:          make([]*pbrpc.ValidatorRecord, 0),
+		Changed:        make([]*pbrpc.ValidatorRecord, 0),
+		Removed:        make([]*pbrpc.ValidatorRecord, 0),
+	}
+	numFrom := fromState.NumValidators()
+	if err := toState.ReadFromEveryValidator(func(idx int, val iface.ReadOnlyValidator) error {
+		record := validatorRecord(types.ValidatorIndex(idx), val, req.ToEpoch)
+		if idx >= numFrom {
+			delta.Added = append(delta.Added, record)
+			return nil
+		}
+		fromVal, err := fromState.ValidatorAtIndexReadOnly(types.ValidatorIndex(idx))
+		if err != nil {
+			return err
+		}
+		previous := validatorRecord(types.ValidatorIndex(idx), fromVal, req.FromEpoch)
+		switch {
+		case record.Status == ethpb.ValidatorStatus_EXITED && previous.Status != ethpb.ValidatorStatus_EXITED:
+			delta.Removed = append(delta.Removed, record)
+		case record.Status != previous.Status || record.EffectiveBalance != previous.EffectiveBalance:
+			delta.Changed = append(delta.Changed, record)
+		}
+		return nil
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compare validator registries: %v", err)
+	}
+	return delta, nil
+}
+
+// validatorSetState returns the canonical state at the start slot of an epoch.
+func (bs *Server) validatorSetState(ctx context.Context, epoch types.Epoch) (iface.BeaconState, error) {
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not compute start slot of epoch %d: %v", epoch, err)
+	}
+	st, err := bs.StateGen.CanonicalStateInEpoch(ctx, epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get state of epoch %d: %v", epoch, err)
+	}
+	if st == nil {
+		return nil, status.Errorf(codes.NotFound, "No state available at slot %d", startSlot)
+	}
+	return st, nil
+}
+
+// validatorRecord returns the record of a validator with its status at the given epoch.
+func validatorRecord(idx types.ValidatorIndex, val iface.ReadOnlyValidator, epoch types.Epoch) *pbrpc.ValidatorRecord {
+	pubKey := val.PublicKey()
+	return &pbrpc.ValidatorRecord{
+		Index:     idx,
+		PublicKey: pubKey[:],
+		Status: validatorStatus(&ethpb.Validator{
+			ActivationEligibilityEpoch: val.ActivationEligibilityEpoch(),
+			ActivationEpoch:            val.ActivationEpoch(),
+			ExitEpoch:                  val.ExitEpoch(),
+			Slashed:                    val.Slashed(),
+		}, epoch),
+		EffectiveBalance: val.EffectiveBalance(),
+	}
+}
@@ -0,0 +1,99 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_GetValidatorSetDelta(t *testing.T) {
+	ctx := context.Background()
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	maxBalance := params.BeaconConfig().MaxEffectiveBalance
+	newValidator := func(i uint64) *ethpb.Validator {
+		return &ethpb.Validator{
+			PublicKey:                  pubKey(i),
+			WithdrawalCredentials:      make([]byte, 32),
+			EffectiveBalance:           maxBalance,
+			ActivationEligibilityEpoch: 0,
+			ActivationEpoch:            0,
+			ExitEpoch:                  farFuture,
+			WithdrawableEpoch:          farFuture,
+		}
+	}
+
+	fromState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	fromValidators := make([]*ethpb.Validator, 4)
+	for i := range fromValidators {
+		fromValidators[i] = newValidator(uint64(i))
+	}
+	require.NoError(t, fromState.SetValidators(fromValidators))
+
+	// Validator 1 lost balance, validator 2 exited at epoch 2, validator 3 is unchanged and
+	// validator 4 joined.
+	toState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	toValidators := make([]*ethpb.Validator, 5)
+	for i := range toValidators {
+		toValidators[i] = newValidator(uint64(i))
+	}
+	toValidators[1].EffectiveBalance = maxBalance - params.BeaconConfig().EffectiveBalanceIncrement
+	toValidators[2].ExitEpoch = 2
+	toValidators[4].ActivationEligibilityEpoch = farFuture
+	toValidators[4].ActivationEpoch = farFuture
+	require.NoError(t, toState.SetValidators(toValidators))
+
+	stateGen := stategen.NewMockService()
+	stateGen.AddStateForSlot(fromState, params.BeaconConfig().SlotsPerEpoch)
+	stateGen.AddStateForSlot(toState, params.BeaconConfig().SlotsPerEpoch*3)
+	currentSlot := params.BeaconConfig().SlotsPerEpoch * 4
+	bs := &Server{
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		StateGen:           stateGen,
+	}
+
+	delta, err := bs.GetValidatorSetDelta(ctx, &pbrpc.GetValidatorSetDeltaRequest{FromEpoch: 1, ToEpoch: 3})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), delta.ValidatorCount)
+	assert.DeepEqual(t, []*pbrpc.ValidatorRecord{{
+		Index:            4,
+		PublicKey:        pubKey(4),
+		Status:           ethpb.ValidatorStatus_DEPOSITED,
+		EffectiveBalance: maxBalance,
+	}}, delta.Added)
+	assert.DeepEqual(t, []*pbrpc.ValidatorRecord{{
+		Index:            1,
+		PublicKey:        pubKey(1),
+		Status:           ethpb.ValidatorStatus_ACTIVE,
+		EffectiveBalance: maxBalance - params.BeaconConfig().EffectiveBalanceIncrement,
+	}}, delta.Changed)
+	assert.DeepEqual(t, []*pbrpc.ValidatorRecord{{
+		Index:            2,
+		PublicKey:        pubKey(2),
+		Status:           ethpb.ValidatorStatus_EXITED,
+		EffectiveBalance: maxBalance,
+	}}, delta.Removed)
+
+	// Comparing an epoch with itself yields an empty delta.
+	delta, err = bs.GetValidatorSetDelta(ctx, &pbrpc.GetValidatorSetDeltaRequest{FromEpoch: 3, ToEpoch: 3})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(delta.Added)+len(delta.Changed)+len(delta.Removed))
+
+	_, err = bs.GetValidatorSetDelta(ctx, &pbrpc.GetValidatorSetDeltaRequest{FromEpoch: 3, ToEpoch: 1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = bs.GetValidatorSetDelta(ctx, &pbrpc.GetValidatorSetDeltaRequest{FromEpoch: 1, ToEpoch: 5})
+	assert.ErrorContains(t, "Cannot retrieve information about an epoch in the future", err)
+	_, err = bs.GetValidatorSetDelta(ctx, &pbrpc.GetValidatorSetDeltaRequest{FromEpoch: 0, ToEpoch: 3})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
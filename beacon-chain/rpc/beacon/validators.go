@@ -1,6 +1,7 @@
 package beacon
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"sort"
@@ -15,8 +16,8 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
-	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/pagination"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"google.golang.org/grpc/codes"
@@ -32,12 +33,32 @@ const BalancesTimeout = time.Second * 30
 func (bs *Server) ListValidatorBalances(
 	ctx context.Context,
 	req *ethpb.ListValidatorBalancesRequest,
+) (*ethpb.ValidatorBalances, error) {
+	return bs.listValidatorBalances(ctx, req, false)
+}
+
+// ListTrackedValidatorBalances retrieves the balances of the tracked validators like
+// ListValidatorBalances. Requested validators outside of the tracked validators are left out.
+func (bs *Server) ListTrackedValidatorBalances(
+	ctx context.Context,
+	req *ethpb.ListValidatorBalancesRequest,
+) (*ethpb.ValidatorBalances, error) {
+	if _, err := bs.trackedOnly(true); err != nil {
+		return nil, err
+	}
+	return bs.listValidatorBalances(ctx, req, true)
+}
+
+func (bs *Server) listValidatorBalances(
+	ctx context.Context,
+	req *ethpb.ListValidatorBalancesRequest,
+	trackedOnly bool,
 ) (*ethpb.ValidatorBalances, error) {
 	ctx, cancel := context.WithTimeout(ctx, BalancesTimeout)
 	defer cancel()
-	if int(req.PageSize) > cmd.Get().MaxRPCPageSize {
+	if int(req.PageSize) > bs.MaxPageSize() {
 		return nil, status.Errorf(codes.InvalidArgument, "Requested page size %d can not be greater than max size %d",
-			req.PageSize, cmd.Get().MaxRPCPageSize)
+			req.PageSize, bs.MaxPageSize())
 	}
 
 	if bs.GenesisTimeFetcher == nil {
@@ -63,19 +84,28 @@ func (bs *Server) ListValidatorBalances(
 	res := make([]*ethpb.ValidatorBalances_Balance, 0)
 	filtered := map[types.ValidatorIndex]bool{} // Track filtered validators to prevent duplication in the response.
 
-	startSlot, err := helpers.StartSlot(requestedEpoch)
-	if err != nil {
-		return nil, err
-	}
-	requestedState, err := bs.StateGen.StateBySlot(ctx, startSlot)
+	requestedState, err := bs.StateGen.CanonicalStateInEpoch(ctx, requestedEpoch)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not get state")
 	}
 
+	pubKeys, indices := req.PublicKeys, req.Indices
+	if trackedOnly {
+		pubKeys, indices = bs.TrackedValidators.restrict(requestedState, pubKeys, indices)
+		if len(pubKeys) == 0 && len(indices) == 0 {
+			return &ethpb.ValidatorBalances{
+				Epoch:         requestedEpoch,
+				Balances:      make([]*ethpb.ValidatorBalances_Balance, 0),
+				TotalSize:     int32(0),
+				NextPageToken: strconv.Itoa(0),
+			}, nil
+		}
+	}
+
 	vals := requestedState.Validators()
 	balances := requestedState.Balances()
 	balancesCount := len(balances)
-	for _, pubKey := range req.PublicKeys {
+	for _, pubKey := range pubKeys {
 		// Skip empty public key.
 		if len(pubKey) == 0 {
 			continue
@@ -108,7 +138,7 @@ func (bs *Server) ListValidatorBalances(
 		balancesCount = len(res)
 	}
 
-	for _, index := range req.Indices {
+	for _, index := range indices {
 		if uint64(index) >= uint64(len(balances)) {
 			return nil, status.Errorf(codes.OutOfRange, "Validator index %d >= balance list %d",
 				index, len(balances))
@@ -151,7 +181,7 @@ func (bs *Server) ListValidatorBalances(
 		)
 	}
 
-	if len(req.Indices) == 0 && len(req.PublicKeys) == 0 {
+	if len(indices) == 0 && len(pubKeys) == 0 {
 		// Return everything.
 		for i := start; i < end; i++ {
 			pubkey := requestedState.PubkeyAtIndex(types.ValidatorIndex(i))
@@ -190,9 +220,34 @@ func (bs *Server) ListValidators(
 	ctx context.Context,
 	req *ethpb.ListValidatorsRequest,
 ) (*ethpb.Validators, error) {
-	if int(req.PageSize) > cmd.Get().MaxRPCPageSize {
+	return bs.listValidators(ctx, req, nil)
+}
+
+// ListValidatorsByWithdrawalCredentials retrieves the validators like ListValidators, restricted to
+// the validators whose withdrawal credentials start with the prefix of the request.
+func (bs *Server) ListValidatorsByWithdrawalCredentials(
+	ctx context.Context,
+	req *pbrpc.ValidatorsByWithdrawalCredentialsRequest,
+) (*ethpb.Validators, error) {
+	withdrawalPrefix, err := withdrawalCredentialsPrefix(req.WithdrawalCredentialsPrefix)
+	if err != nil {
+		return nil, err
+	}
+	listReq := req.Request
+	if listReq == nil {
+		listReq = &ethpb.ListValidatorsRequest{}
+	}
+	return bs.listValidators(ctx, listReq, withdrawalPrefix)
+}
+
+func (bs *Server) listValidators(
+	ctx context.Context,
+	req *ethpb.ListValidatorsRequest,
+	withdrawalPrefix []byte,
+) (*ethpb.Validators, error) {
+	if int(req.PageSize) > bs.MaxPageSize() {
 		return nil, status.Errorf(codes.InvalidArgument, "Requested page size %d can not be greater than max size %d",
-			req.PageSize, cmd.Get().MaxRPCPageSize)
+			req.PageSize, bs.MaxPageSize())
 	}
 
 	currentEpoch := helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
@@ -236,7 +291,7 @@ func (bs *Server) ListValidators(
 	}
 	if s > reqState.Slot() {
 		reqState = reqState.Copy()
-		reqState, err = state.ProcessSlots(ctx, reqState, s)
+		reqState, err = state.ProcessSlots(ctx, bs.CommitteeCache, reqState, s)
 		if err != nil {
 			return nil, status.Errorf(
 				codes.Internal,
@@ -297,14 +352,18 @@ func (bs *Server) ListValidators(
 		}
 	}
 
-	// Filter active validators if the request specifies it.
+	// Filter active validators and withdrawal credentials if the request specifies it.
 	res := validatorList
-	if req.Active {
+	if req.Active || withdrawalPrefix != nil {
 		filteredValidators := make([]*ethpb.Validators_ValidatorContainer, 0)
 		for _, item := range validatorList {
-			if helpers.IsActiveValidator(item.Validator, requestedEpoch) {
-				filteredValidators = append(filteredValidators, item)
+			if req.Active && !helpers.IsActiveValidator(item.Validator, requestedEpoch) {
+				continue
 			}
+			if withdrawalPrefix != nil && !bytes.HasPrefix(item.Validator.WithdrawalCredentials, withdrawalPrefix) {
+				continue
+			}
+			filteredValidators = append(filteredValidators, item)
 		}
 		res = filteredValidators
 	}
@@ -416,7 +475,7 @@ func (bs *Server) GetValidatorActiveSetChanges(
 		return nil, status.Errorf(codes.Internal, "Could not get state: %v", err)
 	}
 
-	activeValidatorCount, err := helpers.ActiveValidatorCount(requestedState, helpers.CurrentEpoch(requestedState))
+	activeValidatorCount, err := helpers.ActiveValidatorCount(bs.CommitteeCache, requestedState, helpers.CurrentEpoch(requestedState))
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not get active validator count: %v", err)
 	}
@@ -524,7 +583,7 @@ func (bs *Server) GetValidatorParticipation(
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not set up pre compute instance: %v", err)
 	}
-	_, b, err = precompute.ProcessAttestations(ctx, beaconState, v, b)
+	_, b, err = precompute.ProcessAttestations(ctx, bs.CommitteeCache, beaconState, v, b)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not pre compute attestations: %v", err)
 	}
@@ -583,7 +642,7 @@ func (bs *Server) GetValidatorQueue(
 	})
 
 	// Only activate just enough validators according to the activation churn limit.
-	activeValidatorCount, err := helpers.ActiveValidatorCount(headState, helpers.CurrentEpoch(headState))
+	activeValidatorCount, err := helpers.ActiveValidatorCount(bs.CommitteeCache, headState, helpers.CurrentEpoch(headState))
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not get active validator count: %v", err)
 	}
@@ -644,6 +703,23 @@ func (bs *Server) GetValidatorQueue(
 // rewards and penalties throughout its lifecycle in the beacon chain.
 func (bs *Server) GetValidatorPerformance(
 	ctx context.Context, req *ethpb.ValidatorPerformanceRequest,
+) (*ethpb.ValidatorPerformanceResponse, error) {
+	return bs.getValidatorPerformance(ctx, req, false)
+}
+
+// GetTrackedValidatorPerformance reports the performance of the tracked validators like
+// GetValidatorPerformance. Requested validators outside of the tracked validators are left out.
+func (bs *Server) GetTrackedValidatorPerformance(
+	ctx context.Context, req *ethpb.ValidatorPerformanceRequest,
+) (*ethpb.ValidatorPerformanceResponse, error) {
+	if _, err := bs.trackedOnly(true); err != nil {
+		return nil, err
+	}
+	return bs.getValidatorPerformance(ctx, req, true)
+}
+
+func (bs *Server) getValidatorPerformance(
+	ctx context.Context, req *ethpb.ValidatorPerformanceRequest, trackedOnly bool,
 ) (*ethpb.ValidatorPerformanceResponse, error) {
 	if bs.SyncChecker.Syncing() {
 		return nil, status.Errorf(codes.Unavailable, "Syncing to latest head, not ready to respond")
@@ -654,8 +730,16 @@ func (bs *Server) GetValidatorPerformance(
 		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
 	}
 
+	reqPubKeys, reqIndices := req.PublicKeys, req.Indices
+	if trackedOnly {
+		reqPubKeys, reqIndices = bs.TrackedValidators.restrict(headState, reqPubKeys, reqIndices)
+		if len(reqPubKeys) == 0 && len(reqIndices) == 0 {
+			return &ethpb.ValidatorPerformanceResponse{}, nil
+		}
+	}
+
 	if bs.GenesisTimeFetcher.CurrentSlot() > headState.Slot() {
-		headState, err = state.ProcessSlots(ctx, headState, bs.GenesisTimeFetcher.CurrentSlot())
+		headState, err = state.ProcessSlots(ctx, bs.CommitteeCache, headState, bs.GenesisTimeFetcher.CurrentSlot())
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not process slots: %v", err)
 		}
@@ -664,7 +748,7 @@ func (bs *Server) GetValidatorPerformance(
 	if err != nil {
 		return nil, err
 	}
-	vp, bp, err = precompute.ProcessAttestations(ctx, headState, vp, bp)
+	vp, bp, err = precompute.ProcessAttestations(ctx, bs.CommitteeCache, headState, vp, bp)
 	if err != nil {
 		return nil, err
 	}
@@ -674,13 +758,13 @@ func (bs *Server) GetValidatorPerformance(
 	}
 	validatorSummary := vp
 
-	responseCap := len(req.Indices) + len(req.PublicKeys)
+	responseCap := len(reqIndices) + len(reqPubKeys)
 	validatorIndices := make([]types.ValidatorIndex, 0, responseCap)
 	missingValidators := make([][]byte, 0, responseCap)
 
 	filtered := map[types.ValidatorIndex]bool{} // Track filtered validators to prevent duplication in the response.
 	// Convert the list of validator public keys to validator indices and add to the indices set.
-	for _, pubKey := range req.PublicKeys {
+	for _, pubKey := range reqPubKeys {
 		// Skip empty public key.
 		if len(pubKey) == 0 {
 			continue
@@ -698,7 +782,7 @@ func (bs *Server) GetValidatorPerformance(
 		}
 	}
 	// Add provided indices to the indices set.
-	for _, idx := range req.Indices {
+	for _, idx := range reqIndices {
 		if !filtered[idx] {
 			validatorIndices = append(validatorIndices, idx)
 			filtered[idx] = true
@@ -816,7 +900,7 @@ func (bs *Server) GetIndividualVotes(
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not set up pre compute instance: %v", err)
 	}
-	v, _, err = precompute.ProcessAttestations(ctx, requestedState, v, bal)
+	v, _, err = precompute.ProcessAttestations(ctx, bs.CommitteeCache, requestedState, v, bal)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not pre compute attestations: %v", err)
 	}
@@ -862,6 +946,9 @@ func (bs *Server) isSlotCanonical(ctx context.Context, slot types.Slot) (bool, e
 	if slot == 0 {
 		return true, nil
 	}
+	if canonical, ok := bs.indexedCanonicalSlot(slot); ok {
+		return canonical, nil
+	}
 
 	hasBlockRoots, roots, err := bs.BeaconDB.BlockRootsBySlot(ctx, slot)
 	if err != nil {
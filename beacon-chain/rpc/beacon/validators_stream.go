@@ -91,8 +91,8 @@ var (
 // over time.  If this is not required then the client can either wait until the beacon node is synced, or filter results
 // based on the epoch value in the returned validator info.
 func (bs *Server) StreamValidatorsInfo(stream ethpb.BeaconChain_StreamValidatorsInfoServer) error {
-	stateChannel := make(chan *feed.Event, params.BeaconConfig().SlotsPerEpoch)
-	epochDuration := time.Duration(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().SecondsPerSlot)) * time.Second
+	stateChannel := make(chan *feed.Event, params.BeaconConfigOf(bs.ChainConfig).SlotsPerEpoch)
+	epochDuration := time.Duration(params.BeaconConfigOf(bs.ChainConfig).SlotsPerEpoch.Mul(params.BeaconConfigOf(bs.ChainConfig).SecondsPerSlot)) * time.Second
 
 	// Fetch our current epoch.
 	headState, err := bs.HeadFetcher.HeadState(bs.Ctx)
@@ -118,7 +118,7 @@ func (bs *Server) StreamValidatorsInfo(stream ethpb.BeaconChain_StreamValidators
 		eth1DepositsMutex:   &sync.RWMutex{},
 		eth1Blocktimes:      cache.New(epochDuration*12, epochDuration*24),
 		eth1BlocktimesMutex: &sync.RWMutex{},
-		currentEpoch:        types.Epoch(headState.Slot() / params.BeaconConfig().SlotsPerEpoch),
+		currentEpoch:        types.Epoch(headState.Slot() / params.BeaconConfigOf(bs.ChainConfig).SlotsPerEpoch),
 		stream:              stream,
 		genesisTime:         headState.GenesisTime(),
 	}
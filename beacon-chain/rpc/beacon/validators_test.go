@@ -1312,7 +1312,7 @@ func TestServer_GetValidatorQueue_PendingActivation(t *testing.T) {
 		pubKey(2),
 		pubKey(3),
 	}
-	activeValidatorCount, err := helpers.ActiveValidatorCount(headState, helpers.CurrentEpoch(headState))
+	activeValidatorCount, err := helpers.ActiveValidatorCount(nil, headState, helpers.CurrentEpoch(headState))
 	require.NoError(t, err)
 	wantChurn, err := helpers.ValidatorChurnLimit(activeValidatorCount)
 	require.NoError(t, err)
@@ -1354,7 +1354,7 @@ func TestServer_GetValidatorQueue_ExitedValidatorLeavesQueue(t *testing.T) {
 	wanted := [][]byte{
 		bytesutil.PadTo([]byte("2"), 48),
 	}
-	activeValidatorCount, err := helpers.ActiveValidatorCount(headState, helpers.CurrentEpoch(headState))
+	activeValidatorCount, err := helpers.ActiveValidatorCount(nil, headState, helpers.CurrentEpoch(headState))
 	require.NoError(t, err)
 	wantChurn, err := helpers.ValidatorChurnLimit(activeValidatorCount)
 	require.NoError(t, err)
@@ -1414,7 +1414,7 @@ func TestServer_GetValidatorQueue_PendingExit(t *testing.T) {
 		pubKey(2),
 		pubKey(3),
 	}
-	activeValidatorCount, err := helpers.ActiveValidatorCount(headState, helpers.CurrentEpoch(headState))
+	activeValidatorCount, err := helpers.ActiveValidatorCount(nil, headState, helpers.CurrentEpoch(headState))
 	require.NoError(t, err)
 	wantChurn, err := helpers.ValidatorChurnLimit(activeValidatorCount)
 	require.NoError(t, err)
@@ -1781,7 +1781,7 @@ func TestGetValidatorPerformance_Indices(t *testing.T) {
 	c := headState.Copy()
 	vp, bp, err := precompute.New(ctx, c)
 	require.NoError(t, err)
-	vp, bp, err = precompute.ProcessAttestations(ctx, c, vp, bp)
+	vp, bp, err = precompute.ProcessAttestations(ctx, nil, c, vp, bp)
 	require.NoError(t, err)
 	_, err = precompute.ProcessRewardsAndPenaltiesPrecompute(c, bp, vp)
 	require.NoError(t, err)
@@ -1854,7 +1854,7 @@ func TestGetValidatorPerformance_IndicesPubkeys(t *testing.T) {
 	c := headState.Copy()
 	vp, bp, err := precompute.New(ctx, c)
 	require.NoError(t, err)
-	vp, bp, err = precompute.ProcessAttestations(ctx, c, vp, bp)
+	vp, bp, err = precompute.ProcessAttestations(ctx, nil, c, vp, bp)
 	require.NoError(t, err)
 	_, err = precompute.ProcessRewardsAndPenaltiesPrecompute(c, bp, vp)
 	require.NoError(t, err)
@@ -0,0 +1,88 @@
+package beacon
+
+import (
+	"bytes"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// withdrawalCredentialsLength is the length of the withdrawal credentials of a validator.
+const withdrawalCredentialsLength = 32
+
+// withdrawalCredentialsPrefix checks the withdrawal credentials prefix of a request. An empty
+// prefix leaves the response unrestricted and is returned as nil.
+func withdrawalCredentialsPrefix(prefix []byte) ([]byte, error) {
+	if len(prefix) == 0 {
+		return nil, nil
+	}
+	if len(prefix) > withdrawalCredentialsLength {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Invalid withdrawal credentials prefix length %d, want 1 to %d bytes",
+			len(prefix),
+			withdrawalCredentialsLength,
+		)
+	}
+	return prefix, nil
+}
+
+// restrictByWithdrawalCredentials narrows the requested validators down to the ones whose
+// withdrawal credentials start with the prefix, resolving public keys against the given state.
+// Without requested validators, every validator of the state with matching credentials is
+// selected, by increasing index.
+func restrictByWithdrawalCredentials(
+	st iface.ReadOnlyBeaconState, prefix []byte, pubKeys [][]byte, indices []types.ValidatorIndex,
+) ([][]byte, []types.ValidatorIndex, error) {
+	matches := func(index types.ValidatorIndex) (bool, error) {
+		val, err := st.ValidatorAtIndexReadOnly(index)
+		if err != nil {
+			return false, err
+		}
+		return bytes.HasPrefix(val.WithdrawalCredentials(), prefix), nil
+	}
+	if len(pubKeys) == 0 && len(indices) == 0 {
+		var matched []types.ValidatorIndex
+		err := st.ReadFromEveryValidator(func(idx int, val iface.ReadOnlyValidator) error {
+			if bytes.HasPrefix(val.WithdrawalCredentials(), prefix) {
+				matched = append(matched, types.ValidatorIndex(idx))
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "Could not read validators: %v", err)
+		}
+		return nil, matched, nil
+	}
+	var matchedKeys [][]byte
+	for _, pubKey := range pubKeys {
+		index, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubKey))
+		if !ok {
+			continue
+		}
+		ok, err := matches(index)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "Could not get validator %d: %v", index, err)
+		}
+		if ok {
+			matchedKeys = append(matchedKeys, pubKey)
+		}
+	}
+	var matchedIndices []types.ValidatorIndex
+	for _, index := range indices {
+		if uint64(index) >= uint64(st.NumValidators()) {
+			continue
+		}
+		ok, err := matches(index)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "Could not get validator %d: %v", index, err)
+		}
+		if ok {
+			matchedIndices = append(matchedIndices, index)
+		}
+	}
+	return matchedKeys, matchedIndices, nil
+}
@@ -0,0 +1,154 @@
+package beacon
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// executionCredentials returns the 0x01 withdrawal credentials of an execution address.
+func executionCredentials(address byte) []byte {
+	creds := make([]byte, 32)
+	creds[0] = 0x01
+	creds[31] = address
+	return creds
+}
+
+// withdrawalCredentialsTestServer returns a server over a state of 8 validators, whose odd indices
+// withdraw to the execution address 0xaa.
+func withdrawalCredentialsTestServer(t *testing.T) (*Server, iface.BeaconState) {
+	helpers.ClearCache()
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	validators := make([]*ethpb.Validator, 8)
+	for i := range validators {
+		pubKey := make([]byte, params.BeaconConfig().BLSPubkeyLength)
+		binary.LittleEndian.PutUint64(pubKey, uint64(i))
+		creds := make([]byte, 32)
+		if i%2 == 1 {
+			creds = executionCredentials(0xaa)
+		}
+		validators[i] = &ethpb.Validator{
+			PublicKey:             pubKey,
+			WithdrawalCredentials: creds,
+			ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	s, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, s.SetValidators(validators))
+	blockRoot, err := testutil.NewBeaconBlock().Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, s, blockRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, blockRoot))
+
+	var currentSlot types.Slot
+	return &Server{
+		BeaconDB:           db,
+		HeadFetcher:        &mock.ChainService{State: s},
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		StateGen:           stategen.New(db),
+	}, s
+}
+
+func TestWithdrawalCredentialsPrefix(t *testing.T) {
+	prefix, err := withdrawalCredentialsPrefix(nil)
+	require.NoError(t, err)
+	assert.Equal(t, true, prefix == nil)
+
+	prefix, err = withdrawalCredentialsPrefix([]byte{0x01})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{0x01}, prefix)
+
+	_, err = withdrawalCredentialsPrefix(make([]byte, 33))
+	assert.ErrorContains(t, "Invalid withdrawal credentials prefix length 33", err)
+}
+
+func TestServer_ListAssignments_WithdrawalCredentialsFilter(t *testing.T) {
+	bs, _ := withdrawalCredentialsTestServer(t)
+	ctx := context.Background()
+
+	res, err := bs.ListAnnotatedValidatorAssignments(ctx, &pbrpc.AnnotatedValidatorAssignmentsRequest{
+		WithdrawalCredentialsPrefix: executionCredentials(0xaa),
+	})
+	require.NoError(t, err)
+	indices := make([]types.ValidatorIndex, len(res.Assignments.Assignments))
+	for i, a := range res.Assignments.Assignments {
+		indices[i] = a.ValidatorIndex
+	}
+	assert.DeepEqual(t, []types.ValidatorIndex{1, 3, 5, 7}, indices)
+
+	// Requested validators are narrowed down to the matching ones.
+	res, err = bs.ListAnnotatedValidatorAssignments(ctx, &pbrpc.AnnotatedValidatorAssignmentsRequest{
+		Indices:                     []types.ValidatorIndex{1, 2},
+		WithdrawalCredentialsPrefix: executionCredentials(0xaa),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Assignments.Assignments))
+	assert.Equal(t, types.ValidatorIndex(1), res.Assignments.Assignments[0].ValidatorIndex)
+
+	// Credentials of another address match no validator.
+	res, err = bs.ListAnnotatedValidatorAssignments(ctx, &pbrpc.AnnotatedValidatorAssignmentsRequest{
+		WithdrawalCredentialsPrefix: executionCredentials(0xbb),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Assignments.Assignments))
+}
+
+func TestServer_ListAssignmentsRange_WithdrawalCredentialsFilter(t *testing.T) {
+	bs, _ := withdrawalCredentialsTestServer(t)
+
+	res, err := bs.ListValidatorAssignmentsRange(context.Background(), &pbrpc.ListValidatorAssignmentsRangeRequest{
+		WithdrawalCredentialsPrefix: []byte{0x01},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Epochs))
+	indices := make([]types.ValidatorIndex, len(res.Epochs[0].Assignments))
+	for i, a := range res.Epochs[0].Assignments {
+		indices[i] = a.ValidatorIndex
+	}
+	assert.DeepEqual(t, []types.ValidatorIndex{1, 3, 5, 7}, indices)
+}
+
+func TestServer_ListValidators_WithdrawalCredentialsFilter(t *testing.T) {
+	bs, _ := withdrawalCredentialsTestServer(t)
+	ctx := context.Background()
+
+	res, err := bs.ListValidatorsByWithdrawalCredentials(ctx, &pbrpc.ValidatorsByWithdrawalCredentialsRequest{
+		WithdrawalCredentialsPrefix: executionCredentials(0xaa),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), res.TotalSize)
+	for _, item := range res.ValidatorList {
+		assert.Equal(t, types.ValidatorIndex(1), item.Index%2)
+		assert.DeepEqual(t, executionCredentials(0xaa), item.Validator.WithdrawalCredentials)
+	}
+
+	res, err = bs.ListValidatorsByWithdrawalCredentials(ctx, &pbrpc.ValidatorsByWithdrawalCredentialsRequest{
+		Request:                     &ethpb.ListValidatorsRequest{Indices: []types.ValidatorIndex{0, 1, 2}},
+		WithdrawalCredentialsPrefix: []byte{0x00},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.ValidatorList))
+	assert.Equal(t, types.ValidatorIndex(0), res.ValidatorList[0].Index)
+	assert.Equal(t, types.ValidatorIndex(2), res.ValidatorList[1].Index)
+
+	// ListValidators returns every validator.
+	res, err = bs.ListValidators(ctx, &ethpb.ListValidatorsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(8), res.TotalSize)
+}
@@ -35,11 +35,11 @@ func (bs *Server) SubmitAttestations(ctx context.Context, req *ethpb.SubmitAttes
 	var validAttestations []*ethpb_alpha.Attestation
 	for _, sourceAtt := range req.Data {
 		att := migration.V1AttToV1Alpha1(sourceAtt)
-		err = blocks.VerifyAttestationNoVerifySignature(ctx, headState, att)
+		err = blocks.VerifyAttestationNoVerifySignature(ctx, bs.CommitteeCache, headState, att)
 		if err != nil {
 			continue
 		}
-		err = blocks.VerifyAttestationSignature(ctx, headState, att)
+		err = blocks.VerifyAttestationSignature(ctx, bs.CommitteeCache, headState, att)
 		if err == nil {
 			validAttestations = append(validAttestations, att)
 		}
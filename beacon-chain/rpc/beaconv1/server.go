@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
@@ -48,4 +49,5 @@ type Server struct {
 	StateGenService     stategen.StateManager
 	SyncChecker         sync.Checker
 	StateFetcher        statefetcher.StateProvider
+	CommitteeCache      *cache.CommitteeCache
 }
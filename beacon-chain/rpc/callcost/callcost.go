@@ -0,0 +1,107 @@
+// Package callcost estimates the cost of RPC calls from their requests, so that operators can
+// see which methods and which queries load the node when planning the capacity of orchestrator
+// deployments.
+package callcost
+
+import (
+	"context"
+	"reflect"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+)
+
+// Cost is the estimated cost of an RPC call.
+type Cost struct {
+	// EpochDistance is the number of epochs between the head and the epoch the call queries. Calls
+	// querying older epochs regenerate older states.
+	EpochDistance uint64
+	// Filters is the number of public keys and validator indices the call filters by.
+	Filters uint64
+	// PageSize is the page size the call requests.
+	PageSize uint64
+}
+
+// Units returns the cost as a single number, growing with the epoch distance of the call times the
+// number of items it filters by or returns. A call of the head without filters costs one unit.
+func (c Cost) Units() uint64 {
+	return (1 + c.EpochDistance) * (1 + c.Filters + c.PageSize)
+}
+
+type costKey struct{}
+
+// FromContext returns the estimated cost of the call of the context, if it was estimated.
+func FromContext(ctx context.Context) (Cost, bool) {
+	cost, ok := ctx.Value(costKey{}).(Cost)
+	return cost, ok
+}
+
+type epochRequest interface {
+	GetEpoch() types.Epoch
+}
+
+type pageRequest interface {
+	GetPageSize() int32
+}
+
+type publicKeysRequest interface {
+	GetPublicKeys() [][]byte
+}
+
+type indicesRequest interface {
+	GetIndices() []types.ValidatorIndex
+}
+
+// Estimate returns the estimated cost of a request given the epoch of the head.
+func Estimate(req interface{}, headEpoch types.Epoch) Cost {
+	cost := Cost{}
+	if epoch, ok := requestEpoch(req); ok && epoch < headEpoch {
+		cost.EpochDistance = uint64(headEpoch - epoch)
+	}
+	if r, ok := req.(publicKeysRequest); ok {
+		cost.Filters += uint64(len(r.GetPublicKeys()))
+	}
+	if r, ok := req.(indicesRequest); ok {
+		cost.Filters += uint64(len(r.GetIndices()))
+	}
+	if r, ok := req.(pageRequest); ok && r.GetPageSize() > 0 {
+		cost.PageSize = uint64(r.GetPageSize())
+	}
+	return cost
+}
+
+// requestEpoch returns the epoch a request queries, and false if it queries the head.
+func requestEpoch(req interface{}) (types.Epoch, bool) {
+	v := reflect.ValueOf(req)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return 0, false
+	}
+	// Most list requests select their epoch through a query filter oneof, which defaults to the
+	// head when unset and may select a slot instead of an epoch.
+	filter := v.Elem().FieldByName("QueryFilter")
+	if !filter.IsValid() {
+		r, ok := req.(epochRequest)
+		if !ok {
+			return 0, false
+		}
+		return r.GetEpoch(), true
+	}
+	if filter.Kind() != reflect.Interface || filter.IsNil() {
+		return 0, false
+	}
+	wrapper := filter.Elem()
+	if wrapper.Kind() != reflect.Ptr || wrapper.IsNil() {
+		return 0, false
+	}
+	wrapper = wrapper.Elem()
+	if epoch := wrapper.FieldByName("Epoch"); epoch.IsValid() && epoch.Kind() == reflect.Uint64 {
+		return types.Epoch(epoch.Uint()), true
+	}
+	if slot := wrapper.FieldByName("Slot"); slot.IsValid() && slot.Kind() == reflect.Uint64 {
+		return helpers.SlotToEpoch(types.Slot(slot.Uint())), true
+	}
+	if genesis := wrapper.FieldByName("Genesis"); genesis.IsValid() && genesis.Kind() == reflect.Bool {
+		return 0, genesis.Bool()
+	}
+	return 0, false
+}
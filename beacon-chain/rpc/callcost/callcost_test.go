@@ -0,0 +1,120 @@
+package callcost
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc"
+)
+
+type headSlot types.Slot
+
+func (h headSlot) HeadSlot() types.Slot {
+	return types.Slot(h)
+}
+
+func TestEstimate(t *testing.T) {
+	tests := []struct {
+		name string
+		req  interface{}
+		want Cost
+	}{
+		{
+			name: "head query",
+			req:  &ethpb.ListValidatorBalancesRequest{PageSize: 10},
+			want: Cost{PageSize: 10},
+		},
+		{
+			name: "epoch query",
+			req: &ethpb.ListValidatorBalancesRequest{
+				QueryFilter: &ethpb.ListValidatorBalancesRequest_Epoch{Epoch: 4},
+				PublicKeys:  [][]byte{{'a'}, {'b'}},
+				Indices:     []types.ValidatorIndex{1},
+			},
+			want: Cost{EpochDistance: 6, Filters: 3},
+		},
+		{
+			name: "genesis query",
+			req: &ethpb.ListValidatorAssignmentsRequest{
+				QueryFilter: &ethpb.ListValidatorAssignmentsRequest_Genesis{Genesis: true},
+			},
+			want: Cost{EpochDistance: 10},
+		},
+		{
+			name: "slot query",
+			req: &ethpb.ListBlocksRequest{
+				QueryFilter: &ethpb.ListBlocksRequest_Slot{Slot: params.BeaconConfig().SlotsPerEpoch * 8},
+			},
+			want: Cost{EpochDistance: 2},
+		},
+		{
+			name: "root query",
+			req: &ethpb.ListBlocksRequest{
+				QueryFilter: &ethpb.ListBlocksRequest_Root{Root: []byte{'a'}},
+			},
+			want: Cost{},
+		},
+		{
+			name: "no epoch",
+			req:  &ethpb.ValidatorActivationRequest{},
+			want: Cost{},
+		},
+		{
+			name: "future epoch",
+			req:  &ethpb.Checkpoint{Epoch: 12},
+			want: Cost{},
+		},
+		{
+			name: "plain epoch field",
+			req:  &ethpb.Checkpoint{Epoch: 7},
+			want: Cost{EpochDistance: 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.DeepEqual(t, tt.want, Estimate(tt.req, 10))
+		})
+	}
+	assert.Equal(t, uint64(7*4), Cost{EpochDistance: 6, Filters: 3}.Units())
+}
+
+func TestEstimator_UnaryServerInterceptor(t *testing.T) {
+	hook := logTest.NewGlobal()
+	e := NewEstimator(headSlot(params.BeaconConfig().SlotsPerEpoch*10), 50*time.Millisecond)
+	info := &grpc.UnaryServerInfo{FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorBalances"}
+	req := &ethpb.ListValidatorBalancesRequest{QueryFilter: &ethpb.ListValidatorBalancesRequest_Epoch{Epoch: 4}}
+
+	var seen Cost
+	_, err := e.UnaryServerInterceptor()(context.Background(), req, info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+		var ok bool
+		seen, ok = FromContext(ctx)
+		require.Equal(t, true, ok)
+		return nil, nil
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, Cost{EpochDistance: 6}, seen)
+	require.LogsDoNotContain(t, hook, "Slow or expensive RPC call")
+
+	_, err = e.UnaryServerInterceptor()(context.Background(), req, info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+		time.Sleep(100 * time.Millisecond)
+		return nil, nil
+	})
+	require.NoError(t, err)
+	require.LogsContain(t, hook, "Slow or expensive RPC call")
+	hook.Reset()
+
+	// Expensive calls are logged however fast they are.
+	req.PageSize = ExpensiveCallUnits
+	_, err = e.UnaryServerInterceptor()(context.Background(), req, info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	require.NoError(t, err)
+	require.LogsContain(t, hook, "costUnits=")
+}
@@ -0,0 +1,75 @@
+package callcost
+
+import (
+	"context"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc"
+)
+
+var log = logrus.WithField("prefix", "rpc")
+
+// ExpensiveCallUnits is the estimated cost above which calls are logged, whatever their duration.
+const ExpensiveCallUnits = 1 << 16
+
+// HeadSlotFetcher returns the slot of the head, which the epoch distance of calls is measured from.
+type HeadSlotFetcher interface {
+	HeadSlot() types.Slot
+}
+
+// Estimator annotates RPC calls with their estimated cost.
+type Estimator struct {
+	headFetcher   HeadSlotFetcher
+	slowThreshold time.Duration
+}
+
+// NewEstimator returns an estimator measuring epoch distances from the head of the given fetcher,
+// and logging the calls taking longer than the slow threshold. A zero threshold only logs the
+// expensive calls.
+func NewEstimator(headFetcher HeadSlotFetcher, slowThreshold time.Duration) *Estimator {
+	return &Estimator{
+		headFetcher:   headFetcher,
+		slowThreshold: slowThreshold,
+	}
+}
+
+// UnaryServerInterceptor estimates the cost of unary calls, which handlers may read with
+// FromContext, records it in the trace span and the per method cost histogram, and logs slow or
+// expensive calls once they complete. Streams are long lived, so their duration says nothing of
+// their cost and they are not estimated.
+func (e *Estimator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		cost := Estimate(req, helpers.SlotToEpoch(e.headFetcher.HeadSlot()))
+		units := cost.Units()
+		callCost.WithLabelValues(info.FullMethod).Observe(float64(units))
+		if span := trace.FromContext(ctx); span != nil {
+			span.AddAttributes(
+				trace.Int64Attribute("cost_units", int64(units)),
+				trace.Int64Attribute("cost_epoch_distance", int64(cost.EpochDistance)),
+				trace.Int64Attribute("cost_filters", int64(cost.Filters)),
+				trace.Int64Attribute("cost_page_size", int64(cost.PageSize)),
+			)
+		}
+
+		start := time.Now()
+		res, err := handler(context.WithValue(ctx, costKey{}, cost), req)
+		elapsed := time.Since(start)
+		slow := e.slowThreshold > 0 && elapsed > e.slowThreshold
+		if slow || units > ExpensiveCallUnits {
+			log.WithFields(logrus.Fields{
+				"method":        info.FullMethod,
+				"duration":      elapsed,
+				"costUnits":     units,
+				"epochDistance": cost.EpochDistance,
+				"filters":       cost.Filters,
+				"pageSize":      cost.PageSize,
+				"failed":        err != nil,
+			}).Warn("Slow or expensive RPC call")
+		}
+		return res, err
+	}
+}
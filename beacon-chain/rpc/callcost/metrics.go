@@ -0,0 +1,15 @@
+package callcost
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var callCost = promauto.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "rpc_call_cost_units",
+		Help:    "The estimated cost of unary RPC calls, by method.",
+		Buckets: prometheus.ExponentialBuckets(1, 4, 10),
+	},
+	[]string{"method"},
+)
@@ -0,0 +1,48 @@
+// Package cancellation reports the RPC calls abandoned by their callers, either canceled or past
+// their deadline, so that operators can tell how much of the load of the node is spent on calls
+// whose responses are never read, such as the state replays of orchestrator requests which timed out.
+package cancellation
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor counts the unary calls which ended with an error after their context was
+// done, and replaces their error with the Canceled or DeadlineExceeded status of the context, as
+// handlers usually wrap the context error into an Internal status.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		res, err := handler(ctx, req)
+		if err != nil && ctx.Err() != nil {
+			return nil, abandoned(ctx, info.FullMethod)
+		}
+		return res, err
+	}
+}
+
+// StreamServerInterceptor counts the streams which ended with an error after their context was
+// done, and replaces their error with the Canceled or DeadlineExceeded status of the context.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, ss)
+		if err != nil && ss.Context().Err() != nil {
+			return abandoned(ss.Context(), info.FullMethod)
+		}
+		return err
+	}
+}
+
+// abandoned records a call abandoned by its caller and returns the status of its context.
+func abandoned(ctx context.Context, method string) error {
+	st := status.FromContextError(ctx.Err())
+	reason := "canceled"
+	if st.Code() == codes.DeadlineExceeded {
+		reason = "deadline_exceeded"
+	}
+	canceledRequests.WithLabelValues(method, reason).Inc()
+	return st.Err()
+}
@@ -0,0 +1,79 @@
+package cancellation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor_CanceledCall(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorAssignments"}
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve archived state: %v", ctx.Err())
+	}
+	counter := canceledRequests.WithLabelValues(info.FullMethod, "canceled")
+	before := testutil.ToFloat64(counter)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := UnaryServerInterceptor()(ctx, nil, info, handler)
+	assert.Equal(t, codes.Canceled, status.Code(err))
+	assert.Equal(t, before+1, testutil.ToFloat64(counter))
+}
+
+func TestUnaryServerInterceptor_DeadlineExceeded(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListBeaconCommittees"}
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	counter := canceledRequests.WithLabelValues(info.FullMethod, "deadline_exceeded")
+	before := testutil.ToFloat64(counter)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	_, err := UnaryServerInterceptor()(ctx, nil, info, handler)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	assert.Equal(t, before+1, testutil.ToFloat64(counter))
+}
+
+func TestUnaryServerInterceptor_IgnoresLiveCalls(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetChainHead"}
+	handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "Could not find head")
+	}
+	_, err := UnaryServerInterceptor()(context.Background(), nil, info, handler)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, float64(0), testutil.ToFloat64(canceledRequests.WithLabelValues(info.FullMethod, "canceled")))
+}
+
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *mockServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestStreamServerInterceptor_CanceledStream(t *testing.T) {
+	info := &grpc.StreamServerInfo{FullMethod: "/ethereum.eth.v1alpha1.BeaconNodeValidator/StreamDuties", IsServerStream: true}
+	handler := func(_ interface{}, _ grpc.ServerStream) error {
+		return errors.New("could not send duties")
+	}
+	counter := canceledRequests.WithLabelValues(info.FullMethod, "canceled")
+	before := testutil.ToFloat64(counter)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := StreamServerInterceptor()(nil, &mockServerStream{ctx: ctx}, info, handler)
+	assert.Equal(t, codes.Canceled, status.Code(err))
+	assert.Equal(t, before+1, testutil.ToFloat64(counter))
+}
@@ -0,0 +1,14 @@
+package cancellation
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var canceledRequests = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "rpc_canceled_requests_total",
+		Help: "The number of RPC calls which stopped because their caller canceled them or their deadline passed.",
+	},
+	[]string{"method", "reason"},
+)
@@ -0,0 +1,117 @@
+// Package client is a typed Go client of the beacon node APIs of this fork. It wraps the beacon
+// chain gRPC client along with the epoch info and proposer list endpoints served to the
+// orchestrator, and handles the pagination and chain traversal every integration needs.
+package client
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc"
+)
+
+// Client calls the APIs of a beacon node over a gRPC connection.
+type Client struct {
+	beacon ethpb.BeaconChainClient
+	query  pbrpc.BeaconQueryClient
+}
+
+// New returns a client of the beacon node at the other end of the connection.
+func New(conn *grpc.ClientConn) *Client {
+	return &Client{
+		beacon: ethpb.NewBeaconChainClient(conn),
+		query:  pbrpc.NewBeaconQueryClient(conn),
+	}
+}
+
+// BeaconChain returns the beacon chain client, for the endpoints this client does not wrap.
+func (c *Client) BeaconChain() ethpb.BeaconChainClient {
+	return c.beacon
+}
+
+// Assignments retrieves the committee and proposer assignments of the given public keys for an
+// epoch, following the pagination of ListValidatorAssignments until all pages were received.
+func (c *Client) Assignments(
+	ctx context.Context, epoch types.Epoch, pubKeys [][]byte,
+) ([]*ethpb.ValidatorAssignments_CommitteeAssignment, error) {
+	req := &ethpb.ListValidatorAssignmentsRequest{
+		QueryFilter: &ethpb.ListValidatorAssignmentsRequest_Epoch{Epoch: epoch},
+		PublicKeys:  pubKeys,
+	}
+	var assignments []*ethpb.ValidatorAssignments_CommitteeAssignment
+	for {
+		res, err := c.beacon.ListValidatorAssignments(ctx, req)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not list assignments of epoch %d", epoch)
+		}
+		assignments = append(assignments, res.Assignments...)
+		if res.NextPageToken == "" || len(assignments) >= int(res.TotalSize) {
+			return assignments, nil
+		}
+		req.PageToken = res.NextPageToken
+	}
+}
+
+// Block returns the block with the given root, or an error if the node does not have it.
+func (c *Client) Block(ctx context.Context, root [32]byte) (*ethpb.SignedBeaconBlock, error) {
+	res, err := c.beacon.ListBlocks(ctx, &ethpb.ListBlocksRequest{
+		QueryFilter: &ethpb.ListBlocksRequest_Root{Root: root[:]},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get block %#x", root)
+	}
+	for _, ctr := range res.BlockContainers {
+		if ctr.Block != nil && ctr.Block.Block != nil && bytesutil.ToBytes32(ctr.BlockRoot) == root {
+			return ctr.Block, nil
+		}
+	}
+	return nil, errors.Errorf("block %#x not found", root)
+}
+
+// IsAncestor returns true if the block with the ancestor root at the ancestor slot is the block
+// with the given root or one of its ancestors. It follows the parent roots of the block down to
+// the ancestor slot, so its cost grows with the distance between both slots.
+func (c *Client) IsAncestor(ctx context.Context, ancestor [32]byte, ancestorSlot types.Slot, root [32]byte) (bool, error) {
+	for root != ancestor {
+		blk, err := c.Block(ctx, root)
+		if err != nil {
+			return false, err
+		}
+		if blk.Block.Slot <= ancestorSlot {
+			return false, nil
+		}
+		root = bytesutil.ToBytes32(blk.Block.ParentRoot)
+	}
+	return true, nil
+}
+
+// ChainHeads subscribes to the chain head stream, which sends the head of the node after every
+// block it processed.
+func (c *Client) ChainHeads(ctx context.Context) (ethpb.BeaconChain_StreamChainHeadClient, error) {
+	stream, err := c.beacon.StreamChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not subscribe to chain head stream")
+	}
+	return stream, nil
+}
+
+// EpochInfos opens the epoch info stream of the node from the given epoch.
+func (c *Client) EpochInfos(ctx context.Context, from types.Epoch) (*orchestrator.EpochInfoStreamClient, error) {
+	stream, err := orchestrator.NewEpochInfoStream(ctx, c.query, from, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open epoch info stream")
+	}
+	return stream, nil
+}
+
+// ProposerList returns the proposer list of an epoch, or of the epoch after the current one if
+// epoch is nil.
+func (c *Client) ProposerList(ctx context.Context, epoch *types.Epoch) (*orchestrator.ProposerList, error) {
+	return orchestrator.GetNextEpochProposerList(ctx, c.query, epoch)
+}
@@ -44,16 +44,16 @@ func (ds *Server) GetInclusionSlot(ctx context.Context, req *pbrpc.InclusionSlot
 
 	// Attestation has one epoch to get included in the chain. This blocks users from requesting too soon.
 	epochBack := types.Slot(0)
-	if ds.GenesisTimeFetcher.CurrentSlot() > params.BeaconConfig().SlotsPerEpoch {
-		epochBack = ds.GenesisTimeFetcher.CurrentSlot() - params.BeaconConfig().SlotsPerEpoch
+	if ds.GenesisTimeFetcher.CurrentSlot() > params.BeaconConfigOf(ds.ChainConfig).SlotsPerEpoch {
+		epochBack = ds.GenesisTimeFetcher.CurrentSlot() - params.BeaconConfigOf(ds.ChainConfig).SlotsPerEpoch
 	}
 	if epochBack < req.Slot {
 		return nil, fmt.Errorf("attestation has one epoch window, please request slot older than %d", epochBack)
 	}
 
 	// Attestation could be in blocks between slot + 1 to slot + epoch_duration.
-	startSlot := req.Slot + params.BeaconConfig().MinAttestationInclusionDelay
-	endSlot := req.Slot + params.BeaconConfig().SlotsPerEpoch
+	startSlot := req.Slot + params.BeaconConfigOf(ds.ChainConfig).MinAttestationInclusionDelay
+	endSlot := req.Slot + params.BeaconConfigOf(ds.ChainConfig).SlotsPerEpoch
 
 	filter := filters.NewFilter().SetStartSlot(startSlot).SetEndSlot(endSlot)
 	blks, _, err := ds.BeaconDB.Blocks(ctx, filter)
@@ -74,7 +74,7 @@ func (ds *Server) GetInclusionSlot(ctx context.Context, req *pbrpc.InclusionSlot
 				}
 				targetStates[tr] = s
 			}
-			c, err := helpers.BeaconCommitteeFromState(s, a.Data.Slot, a.Data.CommitteeIndex)
+			c, err := helpers.BeaconCommitteeFromState(ds.CommitteeCache, s, a.Data.Slot, a.Data.CommitteeIndex)
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "Could not get committee: %v", err)
 			}
@@ -0,0 +1,24 @@
+package debug
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CommitteeCacheStatus describes the contents of the committee cache.
+type CommitteeCacheStatus struct {
+	// Epochs are the epochs the cache holds committees for, in ascending order.
+	Epochs []types.Epoch
+}
+
+// GetCommitteeCacheStatus returns the epochs whose committees are cached by the node, which helps
+// to tell apart slow committee computations from cache misses.
+func (ds *Server) GetCommitteeCacheStatus(_ context.Context) (*CommitteeCacheStatus, error) {
+	if ds.CommitteeCache == nil {
+		return nil, status.Error(codes.Unavailable, "Committee cache is not available")
+	}
+	return &CommitteeCacheStatus{Epochs: ds.CommitteeCache.Epochs()}, nil
+}
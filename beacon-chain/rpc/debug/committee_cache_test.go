@@ -0,0 +1,24 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestDebugServer_GetCommitteeCacheStatus(t *testing.T) {
+	ctx := context.Background()
+	_, err := (&Server{}).GetCommitteeCacheStatus(ctx)
+	assert.ErrorContains(t, "Committee cache is not available", err)
+
+	c := cache.NewCommitteesCache()
+	require.NoError(t, c.AddCommitteeShuffledList(&cache.Committees{Epoch: 3, Seed: [32]byte{'a'}}))
+	require.NoError(t, c.AddCommitteeShuffledList(&cache.Committees{Epoch: 2, Seed: [32]byte{'b'}}))
+	res, err := (&Server{CommitteeCache: c}).GetCommitteeCacheStatus(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []types.Epoch{2, 3}, res.Epochs)
+}
@@ -0,0 +1,21 @@
+package debug
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+)
+
+// SetOrchestratorLoggingRequest defines whether the orchestrator code paths are logged verbosely.
+type SetOrchestratorLoggingRequest struct {
+	Verbose bool
+}
+
+// SetOrchestratorLogging toggles the verbose logging of the orchestrator code paths at runtime,
+// as the --log-van-debug flag does at startup.
+func (ds *Server) SetOrchestratorLogging(_ context.Context, req *SetOrchestratorLoggingRequest) (*empty.Empty, error) {
+	orchestrator.SetVerboseLogging(req.Verbose)
+	orchestrator.Log.WithField("verbose", req.Verbose).Info("Toggled orchestrator logging")
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,23 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestDebugServer_SetOrchestratorLogging(t *testing.T) {
+	ctx := context.Background()
+	defer orchestrator.SetVerboseLogging(false)
+
+	_, err := (&Server{}).SetOrchestratorLogging(ctx, &SetOrchestratorLoggingRequest{Verbose: true})
+	require.NoError(t, err)
+	assert.Equal(t, true, orchestrator.VerboseLogging())
+
+	_, err = (&Server{}).SetOrchestratorLogging(ctx, &SetOrchestratorLoggingRequest{Verbose: false})
+	require.NoError(t, err)
+	assert.Equal(t, false, orchestrator.VerboseLogging())
+}
@@ -0,0 +1,27 @@
+package debug
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	types "github.com/prysmaticlabs/eth2-types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetSlotRequest defines the slot to move the manual slot timer to.
+type SetSlotRequest struct {
+	Slot types.Slot
+}
+
+// SetSlot moves the manual slot timer of the node to the given slot, which lets a test harness
+// drive slots and epoch transitions deterministically.
+func (ds *Server) SetSlot(_ context.Context, req *SetSlotRequest) (*empty.Empty, error) {
+	if ds.SlotTimer == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Slot timer is not driven manually, start the node with --slot-timer=manual")
+	}
+	if err := ds.SlotTimer.SetSlot(req.Slot); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not set slot: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
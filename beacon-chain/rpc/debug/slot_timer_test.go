@@ -0,0 +1,27 @@
+package debug
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestDebugServer_SetSlot(t *testing.T) {
+	ctx := context.Background()
+	_, err := (&Server{}).SetSlot(ctx, &SetSlotRequest{Slot: 1})
+	assert.ErrorContains(t, "Slot timer is not driven manually", err)
+
+	timer := blockchain.NewManualSlotTimer(nil)
+	ds := &Server{SlotTimer: timer}
+	_, err = ds.SetSlot(ctx, &SetSlotRequest{Slot: 64})
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(64), timer.CurrentSlot(time.Time{}))
+
+	_, err = ds.SetSlot(ctx, &SetSlotRequest{Slot: 63})
+	assert.ErrorContains(t, "slot 63 is before the current slot 64", err)
+}
@@ -0,0 +1,5 @@
+package genesis
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "rpc")
@@ -0,0 +1,151 @@
+// Package genesis defines the gRPC service through which the orchestrator supplies the genesis of
+// a network to a beacon node, which derives its genesis state from it and persists it before
+// starting its services.
+package genesis
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server persists the genesis state derived from the genesis spec submitted by the orchestrator.
+type Server struct {
+	BeaconDB db.HeadAccessDatabase
+
+	lock      sync.Mutex
+	persisted chan struct{}
+	once      sync.Once
+}
+
+// NewServer creates a genesis server persisting the genesis into the given database.
+func NewServer(beaconDB db.HeadAccessDatabase) *Server {
+	return &Server{
+		BeaconDB:  beaconDB,
+		persisted: make(chan struct{}),
+	}
+}
+
+// SubmitGenesis validates the genesis spec, and persists the genesis state derived from it if the
+// database has no genesis yet. A spec deriving the genesis state persisted already is accepted
+// again, so that the orchestrator can retry its submissions, while any other spec is rejected.
+func (s *Server) SubmitGenesis(ctx context.Context, req *pbrpc.GenesisSpec) (*pbrpc.GenesisReceipt, error) {
+	spec, err := orchestrator.GenesisSpecFromProto(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not decode genesis spec: %v", err)
+	}
+	receipt, err := s.submitGenesis(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	return receipt.ToProto(), nil
+}
+
+func (s *Server) submitGenesis(ctx context.Context, spec *orchestrator.GenesisSpec) (*orchestrator.GenesisReceipt, error) {
+	st, err := genesisState(spec)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := genesisReceipt(ctx, st)
+	if err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	existing, err := s.BeaconDB.GenesisState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve genesis state: %v", err)
+	}
+	if existing != nil {
+		existingRoot, err := existing.HashTreeRoot(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not compute existing genesis state root: %v", err)
+		}
+		if existingRoot != receipt.StateRoot {
+			return nil, status.Errorf(
+				codes.FailedPrecondition,
+				"A different genesis state with root %#x exists already",
+				existingRoot,
+			)
+		}
+		s.markPersisted()
+		return receipt, nil
+	}
+	if err := s.BeaconDB.SaveGenesisData(ctx, st); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save genesis state: %v", err)
+	}
+	log.WithFields(logrus.Fields{
+		"genesisTime":    spec.GenesisTime,
+		"validators":     len(spec.Validators),
+		"stateRoot":      bytesutil.Trunc(receipt.StateRoot[:]),
+		"validatorsRoot": bytesutil.Trunc(receipt.ValidatorsRoot[:]),
+	}).Info("Persisted genesis state submitted by the orchestrator")
+	s.markPersisted()
+	return receipt, nil
+}
+
+// Persisted is closed once a genesis was persisted or accepted again.
+func (s *Server) Persisted() <-chan struct{} {
+	return s.persisted
+}
+
+// Await serves the genesis service on the listener until a genesis spec was persisted, or until
+// the context is canceled. It lets a beacon node started without a genesis wait for the
+// orchestrator to supply it, before the node starts its own gRPC server on the same address.
+func (s *Server) Await(ctx context.Context, lis net.Listener, opts ...grpc.ServerOption) error {
+	grpcServer := grpc.NewServer(opts...)
+	pbrpc.RegisterGenesisServer(grpcServer, s)
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- grpcServer.Serve(lis)
+	}()
+	log.WithField("address", lis.Addr().String()).Info("Waiting for the orchestrator to submit the genesis")
+
+	select {
+	case <-s.persisted:
+		// The response of the submission is sent before the server stops.
+		grpcServer.GracefulStop()
+		return nil
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
+		grpcServer.Stop()
+		return ctx.Err()
+	}
+}
+
+func (s *Server) markPersisted() {
+	s.once.Do(func() {
+		close(s.persisted)
+	})
+}
+
+// genesisReceipt returns the receipt identifying the genesis state and the genesis block derived
+// from it.
+func genesisReceipt(ctx context.Context, st iface.BeaconState) (*orchestrator.GenesisReceipt, error) {
+	stateRoot, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute genesis state root: %v", err)
+	}
+	blockRoot, err := blocks.NewGenesisBlock(stateRoot[:]).Block.HashTreeRoot()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute genesis block root: %v", err)
+	}
+	return &orchestrator.GenesisReceipt{
+		StateRoot:      stateRoot,
+		BlockRoot:      blockRoot,
+		ValidatorsRoot: bytesutil.ToBytes32(st.GenesisValidatorRoot()),
+	}, nil
+}
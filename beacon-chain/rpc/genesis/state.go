@@ -0,0 +1,156 @@
+package genesis
+
+import (
+	"bytes"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// genesisState derives the genesis state of a genesis spec, as the deposits of its validators
+// would at chain start. The validators are registered directly rather than through signed
+// deposits, and the eth1 data commits to their deposit data with empty signatures, so that the
+// deposits are all considered processed.
+func genesisState(spec *orchestrator.GenesisSpec) (iface.BeaconState, error) {
+	if err := checkGenesisSpec(spec); err != nil {
+		return nil, err
+	}
+	cfg := params.BeaconConfig()
+	validators := make([]*ethpb.Validator, len(spec.Validators))
+	balances := make([]uint64, len(spec.Validators))
+	leaves := make([][]byte, len(spec.Validators))
+	active := 0
+	for i, v := range spec.Validators {
+		effectiveBalance := v.Balance - v.Balance%cfg.EffectiveBalanceIncrement
+		if effectiveBalance > cfg.MaxEffectiveBalance {
+			effectiveBalance = cfg.MaxEffectiveBalance
+		}
+		val := &ethpb.Validator{
+			PublicKey:                  append([]byte(nil), v.PublicKey[:]...),
+			WithdrawalCredentials:      append([]byte(nil), v.WithdrawalCredentials[:]...),
+			ActivationEligibilityEpoch: cfg.FarFutureEpoch,
+			ActivationEpoch:            cfg.FarFutureEpoch,
+			ExitEpoch:                  cfg.FarFutureEpoch,
+			WithdrawableEpoch:          cfg.FarFutureEpoch,
+			EffectiveBalance:           effectiveBalance,
+		}
+		if effectiveBalance == cfg.MaxEffectiveBalance {
+			val.ActivationEligibilityEpoch = 0
+			val.ActivationEpoch = 0
+			active++
+		}
+		validators[i] = val
+		balances[i] = v.Balance
+		leaf, err := (&ethpb.Deposit_Data{
+			PublicKey:             val.PublicKey,
+			WithdrawalCredentials: val.WithdrawalCredentials,
+			Amount:                v.Balance,
+			Signature:             make([]byte, 96),
+		}).HashTreeRoot()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not hash deposit data of validator %d: %v", i, err)
+		}
+		leaves[i] = leaf[:]
+	}
+	if active == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Genesis spec has no validator with the maximum effective balance")
+	}
+
+	trie, err := trieutil.GenerateTrieFromItems(leaves, cfg.DepositContractTreeDepth)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute deposit trie: %v", err)
+	}
+	depositRoot := trie.Root()
+	preState, err := state.EmptyGenesisState()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not create empty genesis state: %v", err)
+	}
+	if err := preState.SetValidators(validators); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not set genesis validators: %v", err)
+	}
+	if err := preState.SetBalances(balances); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not set genesis balances: %v", err)
+	}
+	if err := preState.SetEth1DepositIndex(uint64(len(validators))); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not set eth1 deposit index: %v", err)
+	}
+	st, err := state.OptimizedGenesisBeaconState(spec.GenesisTime, preState, &ethpb.Eth1Data{
+		DepositRoot:  depositRoot[:],
+		DepositCount: uint64(len(validators)),
+		BlockHash:    append([]byte(nil), spec.Eth1BlockHash[:]...),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not create genesis state: %v", err)
+	}
+	return st, nil
+}
+
+// checkGenesisSpec checks that the genesis spec is well formed, and that its fork schedule is
+// the one this node is configured with, as the configuration rather than the persisted genesis
+// drives the forks of the node once started.
+func checkGenesisSpec(spec *orchestrator.GenesisSpec) error {
+	if spec.GenesisTime == 0 {
+		return status.Error(codes.InvalidArgument, "Genesis time is not set")
+	}
+	if len(spec.Validators) == 0 {
+		return status.Error(codes.InvalidArgument, "Genesis spec has no validators")
+	}
+	seen := make(map[[48]byte]bool, len(spec.Validators))
+	for i, v := range spec.Validators {
+		if seen[v.PublicKey] {
+			return status.Errorf(codes.InvalidArgument, "Duplicate public key %#x of validator %d", v.PublicKey, i)
+		}
+		seen[v.PublicKey] = true
+	}
+
+	schedule := spec.ForkSchedule
+	if len(schedule) == 0 || schedule[0].Epoch != 0 {
+		return status.Error(codes.InvalidArgument, "Fork schedule does not start with the genesis fork at epoch 0")
+	}
+	for i := 1; i < len(schedule); i++ {
+		if schedule[i].Epoch <= schedule[i-1].Epoch {
+			return status.Errorf(codes.InvalidArgument, "Fork schedule is not in ascending epoch order at epoch %d", schedule[i].Epoch)
+		}
+	}
+	cfg := params.BeaconConfig()
+	if !bytes.Equal(schedule[0].Version[:], cfg.GenesisForkVersion) {
+		return status.Errorf(
+			codes.FailedPrecondition,
+			"Genesis fork version %#x does not match the configured genesis fork version %#x",
+			schedule[0].Version,
+			cfg.GenesisForkVersion,
+		)
+	}
+	configured := 0
+	for epoch := range cfg.ForkVersionSchedule {
+		if epoch != 0 {
+			configured++
+		}
+	}
+	for _, fork := range schedule[1:] {
+		version, ok := cfg.ForkVersionSchedule[fork.Epoch]
+		if !ok || !bytes.Equal(fork.Version[:], version) {
+			return status.Errorf(
+				codes.FailedPrecondition,
+				"Fork version %#x at epoch %d is not in the configured fork schedule",
+				fork.Version,
+				fork.Epoch,
+			)
+		}
+	}
+	if len(schedule)-1 != configured {
+		return status.Errorf(
+			codes.FailedPrecondition,
+			"Fork schedule has %d forks after genesis but the configuration has %d",
+			len(schedule)-1,
+			configured,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package genesis
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// testGenesisSpec returns a genesis spec of n validators with the maximum effective balance,
+// following the fork schedule of the configuration.
+func testGenesisSpec(n int) *orchestrator.GenesisSpec {
+	spec := &orchestrator.GenesisSpec{
+		GenesisTime:   1620000000,
+		Eth1BlockHash: [32]byte{'h'},
+	}
+	genesisFork := &orchestrator.ForkScheduleEntry{}
+	copy(genesisFork.Version[:], params.BeaconConfig().GenesisForkVersion)
+	spec.ForkSchedule = []*orchestrator.ForkScheduleEntry{genesisFork}
+	for i := 0; i < n; i++ {
+		val := &orchestrator.GenesisValidator{Balance: params.BeaconConfig().MaxEffectiveBalance}
+		val.PublicKey[0] = byte(i)
+		val.PublicKey[1] = byte(i >> 8)
+		spec.Validators = append(spec.Validators, val)
+	}
+	return spec
+}
+
+func TestGenesisState(t *testing.T) {
+	spec := testGenesisSpec(4)
+	spec.Validators[3].Balance = params.BeaconConfig().MaxEffectiveBalance / 2
+
+	st, err := genesisState(spec)
+	require.NoError(t, err)
+	assert.Equal(t, spec.GenesisTime, st.GenesisTime())
+	assert.Equal(t, types.Slot(0), st.Slot())
+	require.Equal(t, 4, st.NumValidators())
+	assert.DeepEqual(t, spec.Eth1BlockHash[:], st.Eth1Data().BlockHash)
+	assert.Equal(t, uint64(4), st.Eth1Data().DepositCount)
+	assert.Equal(t, uint64(4), st.Eth1DepositIndex())
+	assert.DeepEqual(t, params.BeaconConfig().GenesisForkVersion, st.Fork().CurrentVersion)
+
+	val, err := st.ValidatorAtIndex(0)
+	require.NoError(t, err)
+	assert.DeepEqual(t, spec.Validators[0].PublicKey[:], val.PublicKey)
+	assert.Equal(t, types.Epoch(0), val.ActivationEpoch)
+	// Validators below the maximum effective balance are not active at genesis.
+	val, err = st.ValidatorAtIndex(3)
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconConfig().FarFutureEpoch, val.ActivationEpoch)
+	assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance/2, val.EffectiveBalance)
+
+	// The genesis state only depends on the spec.
+	other, err := genesisState(testGenesisSpec(4))
+	require.NoError(t, err)
+	again, err := genesisState(spec)
+	require.NoError(t, err)
+	root, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	againRoot, err := again.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, root, againRoot)
+	assert.NotEqual(t, bytesutil.ToBytes32(st.GenesisValidatorRoot()), bytesutil.ToBytes32(other.GenesisValidatorRoot()))
+}
+
+func TestGenesisState_InvalidSpec(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.ForkVersionSchedule = map[types.Epoch][]byte{10: {1, 0, 0, 0}}
+	params.OverrideBeaconConfig(cfg)
+	withForks := func(forks ...*orchestrator.ForkScheduleEntry) *orchestrator.GenesisSpec {
+		spec := testGenesisSpec(2)
+		spec.ForkSchedule = append(spec.ForkSchedule, forks...)
+		return spec
+	}
+	_, err := genesisState(withForks(&orchestrator.ForkScheduleEntry{Epoch: 10, Version: [4]byte{1}}))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		spec func() *orchestrator.GenesisSpec
+		err  string
+	}{
+		{
+			name: "no genesis time",
+			spec: func() *orchestrator.GenesisSpec {
+				spec := withForks(&orchestrator.ForkScheduleEntry{Epoch: 10, Version: [4]byte{1}})
+				spec.GenesisTime = 0
+				return spec
+			},
+			err: "Genesis time is not set",
+		},
+		{
+			name: "no validators",
+			spec: func() *orchestrator.GenesisSpec {
+				spec := withForks(&orchestrator.ForkScheduleEntry{Epoch: 10, Version: [4]byte{1}})
+				spec.Validators = nil
+				return spec
+			},
+			err: "Genesis spec has no validators",
+		},
+		{
+			name: "duplicate public key",
+			spec: func() *orchestrator.GenesisSpec {
+				spec := withForks(&orchestrator.ForkScheduleEntry{Epoch: 10, Version: [4]byte{1}})
+				spec.Validators[1].PublicKey = spec.Validators[0].PublicKey
+				return spec
+			},
+			err: "Duplicate public key",
+		},
+		{
+			name: "no active validator",
+			spec: func() *orchestrator.GenesisSpec {
+				spec := withForks(&orchestrator.ForkScheduleEntry{Epoch: 10, Version: [4]byte{1}})
+				for _, val := range spec.Validators {
+					val.Balance = 1
+				}
+				return spec
+			},
+			err: "no validator with the maximum effective balance",
+		},
+		{
+			name: "no genesis fork",
+			spec: func() *orchestrator.GenesisSpec {
+				spec := withForks(&orchestrator.ForkScheduleEntry{Epoch: 10, Version: [4]byte{1}})
+				spec.ForkSchedule = spec.ForkSchedule[1:]
+				return spec
+			},
+			err: "does not start with the genesis fork",
+		},
+		{
+			name: "unordered forks",
+			spec: func() *orchestrator.GenesisSpec {
+				return withForks(
+					&orchestrator.ForkScheduleEntry{Epoch: 10, Version: [4]byte{1}},
+					&orchestrator.ForkScheduleEntry{Epoch: 5, Version: [4]byte{2}},
+				)
+			},
+			err: "not in ascending epoch order at epoch 5",
+		},
+		{
+			name: "other genesis fork version",
+			spec: func() *orchestrator.GenesisSpec {
+				spec := withForks(&orchestrator.ForkScheduleEntry{Epoch: 10, Version: [4]byte{1}})
+				spec.ForkSchedule[0].Version = [4]byte{9}
+				return spec
+			},
+			err: "does not match the configured genesis fork version",
+		},
+		{
+			name: "other fork version",
+			spec: func() *orchestrator.GenesisSpec {
+				return withForks(&orchestrator.ForkScheduleEntry{Epoch: 10, Version: [4]byte{2}})
+			},
+			err: "at epoch 10 is not in the configured fork schedule",
+		},
+		{
+			name: "missing fork",
+			spec: func() *orchestrator.GenesisSpec {
+				return withForks()
+			},
+			err: "has 0 forks after genesis but the configuration has 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := genesisState(tt.spec())
+			assert.ErrorContains(t, tt.err, err)
+		})
+	}
+}
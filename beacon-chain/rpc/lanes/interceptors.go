@@ -0,0 +1,20 @@
+package lanes
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// UnaryServerInterceptor holds unary calls back until a worker of their lane is free. Streams are
+// not scheduled, as they would hold a worker for as long as they are open.
+func (s *Scheduler) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		release, err := s.acquire(ctx, s.laneOf(ctx))
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,86 @@
+// Package lanes schedules the unary RPC calls of the beacon node on two worker pools, so that the
+// calls of the orchestrator, which the duties of its validators depend on, are served even when
+// dashboards and backfills saturate the node at epoch boundaries.
+package lanes
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Lane is the worker pool a call is scheduled on.
+type Lane string
+
+const (
+	// PriorityLane serves the calls of the orchestrator with the reserved workers first, and with
+	// the shared workers once the reserved ones are busy.
+	PriorityLane Lane = "priority"
+	// SharedLane serves every other call with the shared workers only.
+	SharedLane Lane = "shared"
+)
+
+// Scheduler bounds the unary calls in flight, holding back the calls without a free worker until
+// one is released.
+type Scheduler struct {
+	reserved   chan struct{}
+	shared     chan struct{}
+	isPriority func(ctx context.Context) bool
+}
+
+// NewScheduler returns a scheduler with reservedWorkers workers serving only the calls for which
+// isPriority returns true, and sharedWorkers workers serving every call.
+func NewScheduler(reservedWorkers, sharedWorkers int, isPriority func(ctx context.Context) bool) *Scheduler {
+	return &Scheduler{
+		reserved:   make(chan struct{}, reservedWorkers),
+		shared:     make(chan struct{}, sharedWorkers),
+		isPriority: isPriority,
+	}
+}
+
+// laneOf returns the lane of a call.
+func (s *Scheduler) laneOf(ctx context.Context) Lane {
+	if s.isPriority != nil && s.isPriority(ctx) {
+		return PriorityLane
+	}
+	return SharedLane
+}
+
+// acquire waits for a worker of the lane, and returns the function releasing it. It fails once the
+// context of the call is done.
+func (s *Scheduler) acquire(ctx context.Context, lane Lane) (func(), error) {
+	start := time.Now()
+	var worker chan struct{}
+	if lane == PriorityLane {
+		select {
+		case s.reserved <- struct{}{}:
+			worker = s.reserved
+		default:
+			select {
+			case s.reserved <- struct{}{}:
+				worker = s.reserved
+			case s.shared <- struct{}{}:
+				worker = s.shared
+			case <-ctx.Done():
+			}
+		}
+	} else {
+		select {
+		case s.shared <- struct{}{}:
+			worker = s.shared
+		case <-ctx.Done():
+		}
+	}
+	waitTime.WithLabelValues(string(lane)).Observe(time.Since(start).Seconds())
+	if worker == nil {
+		abandonedCalls.WithLabelValues(string(lane)).Inc()
+		return nil, status.Errorf(codes.ResourceExhausted, "No RPC worker of the %s lane became free: %v", lane, ctx.Err())
+	}
+	callsInFlight.WithLabelValues(string(lane)).Inc()
+	return func() {
+		callsInFlight.WithLabelValues(string(lane)).Dec()
+		<-worker
+	}, nil
+}
@@ -0,0 +1,75 @@
+package lanes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type priorityKey struct{}
+
+func TestScheduler_ReservesWorkers(t *testing.T) {
+	scheduler := NewScheduler(1, 1, func(ctx context.Context) bool {
+		return ctx.Value(priorityKey{}) != nil
+	})
+	interceptor := scheduler.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/NextEpochProposerList"}
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+	blocking := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		started <- struct{}{}
+		<-release
+		return "blocked", nil
+	}
+	immediate := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	priority := context.WithValue(context.Background(), priorityKey{}, true)
+
+	// A dashboard call holds the shared worker.
+	done := make(chan error, 2)
+	go func() {
+		_, err := interceptor(context.Background(), nil, info, blocking)
+		done <- err
+	}()
+	<-started
+
+	// Other dashboard calls wait for the shared worker until their deadline.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := interceptor(ctx, nil, info, immediate)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Orchestrator calls are served by the reserved worker.
+	res, err := interceptor(priority, nil, info, immediate)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", res)
+
+	// With the reserved worker busy as well, orchestrator calls take the first worker released.
+	go func() {
+		_, err := interceptor(priority, nil, info, blocking)
+		done <- err
+	}()
+	<-started
+	served := make(chan error, 1)
+	go func() {
+		_, err := interceptor(priority, nil, info, immediate)
+		served <- err
+	}()
+	select {
+	case <-served:
+		t.Fatal("Expected the call to wait for a worker")
+	case <-time.After(50 * time.Millisecond):
+	}
+	release <- struct{}{}
+	require.NoError(t, <-served)
+	close(release)
+	require.NoError(t, <-done)
+	require.NoError(t, <-done)
+}
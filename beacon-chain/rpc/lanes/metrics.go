@@ -0,0 +1,31 @@
+package lanes
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	callsInFlight = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "rpc_lane_calls_in_flight",
+			Help: "The number of unary RPC calls served per lane.",
+		},
+		[]string{"lane"},
+	)
+	waitTime = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "rpc_lane_wait_seconds",
+			Help:    "The time unary RPC calls waited for a worker of their lane.",
+			Buckets: []float64{0.001, 0.01, 0.05, 0.1, 0.5, 1, 5},
+		},
+		[]string{"lane"},
+	)
+	abandonedCalls = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "rpc_lane_abandoned_total",
+			Help: "The number of unary RPC calls which ended before a worker of their lane became free.",
+		},
+		[]string{"lane"},
+	)
+)
@@ -0,0 +1,65 @@
+package loadshed
+
+import (
+	"context"
+	"strconv"
+	"sync/atomic"
+
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// retryAfterKey is the response header carrying the number of seconds after which a shed call may
+// be retried. The gRPC gateway forwards it to HTTP clients.
+const retryAfterKey = "retry-after"
+
+type callKey struct{}
+
+// callState records whether the state regenerations of a call were shed.
+type callState struct {
+	shed int32
+}
+
+// UnaryServerInterceptor admits the state regenerations of unary calls through the limiter, and
+// turns the error of a call which was shed into an Unavailable status with a retry hint.
+func (l *Limiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		call := &callState{}
+		res, err := handler(context.WithValue(ctx, callKey{}, call), req)
+		if err != nil && atomic.LoadInt32(&call.shed) == 1 {
+			return nil, l.shedError(func(md metadata.MD) error {
+				return grpc.SetHeader(ctx, md)
+			})
+		}
+		return res, err
+	}
+}
+
+// StreamServerInterceptor admits the state regenerations of streams through the limiter, and turns
+// the error of a stream which was shed into an Unavailable status with a retry hint.
+func (l *Limiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		call := &callState{}
+		wrapped := middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = context.WithValue(ss.Context(), callKey{}, call)
+		err := handler(srv, wrapped)
+		if err != nil && atomic.LoadInt32(&call.shed) == 1 {
+			return l.shedError(ss.SetHeader)
+		}
+		return err
+	}
+}
+
+// shedError sets the retry-after header and returns the status of a shed call.
+func (l *Limiter) shedError(setHeader func(metadata.MD) error) error {
+	seconds := int64(l.retryAfter.Seconds())
+	if seconds < 1 {
+		seconds = 1
+	}
+	// Headers can no longer be set once a stream sent its first message, the retry info of the
+	// status carries the hint in that case.
+	_ = setHeader(metadata.Pairs(retryAfterKey, strconv.FormatInt(seconds, 10)))
+	return grpcutils.OverloadedError(l.retryAfter, "State replay queue is saturated, retry in %d seconds", seconds)
+}
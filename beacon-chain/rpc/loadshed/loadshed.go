@@ -0,0 +1,156 @@
+// Package loadshed sheds RPC calls which would regenerate historical states while the state
+// replays of earlier calls saturate the node, so that calls served from caches and the head keep
+// being answered instead of every call timing out behind the replay queue.
+package loadshed
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+)
+
+// ErrSaturated is returned by the state manager of a limiter when the state replay queue is full.
+var ErrSaturated = errors.New("state replay queue is saturated")
+
+// Limiter bounds the number of state regenerations RPC calls may have queued or in flight.
+type Limiter struct {
+	lock       sync.Mutex
+	maxReplays int
+	inFlight   int
+	retryAfter time.Duration
+}
+
+// NewLimiter returns a limiter admitting at most maxReplays concurrent state regenerations, or
+// every regeneration if maxReplays is zero. Calls shed while the limit is reached are told to
+// retry after the given delay.
+func NewLimiter(maxReplays int, retryAfter time.Duration) *Limiter {
+	return &Limiter{
+		maxReplays: maxReplays,
+		retryAfter: retryAfter,
+	}
+}
+
+// MaxReplays returns the number of concurrent state regenerations the limiter admits, zero if it
+// admits every regeneration.
+func (l *Limiter) MaxReplays() int {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.maxReplays
+}
+
+// SetMaxReplays changes the number of concurrent state regenerations the limiter admits, zero
+// admitting every regeneration. Regenerations in flight beyond a lowered limit complete, and
+// further ones are shed until the limit is no longer reached.
+func (l *Limiter) SetMaxReplays(maxReplays int) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.maxReplays = maxReplays
+}
+
+// StateManager wraps a state manager so that the state regenerations of RPC calls pass through the
+// limiter. States derived from a cached or saved state without replaying blocks are served
+// without a slot of the limiter. Calls which were not
+// received through the interceptors of the limiter are never shed.
+func (l *Limiter) StateManager(sm stategen.StateManager) stategen.StateManager {
+	return &limitedStateManager{StateManager: sm, limiter: l}
+}
+
+// acquire takes a slot of the limiter for a call, marking the call as shed if none is free.
+func (l *Limiter) acquire(ctx context.Context) (func(), error) {
+	call, ok := ctx.Value(callKey{}).(*callState)
+	if !ok {
+		return func() {}, nil
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.maxReplays > 0 && l.inFlight >= l.maxReplays {
+		atomic.StoreInt32(&call.shed, 1)
+		shedCalls.Inc()
+		return nil, ErrSaturated
+	}
+	l.inFlight++
+	replaysInFlight.Inc()
+	return func() {
+		l.lock.Lock()
+		defer l.lock.Unlock()
+		l.inFlight--
+		replaysInFlight.Dec()
+	}, nil
+}
+
+type limitedStateManager struct {
+	stategen.StateManager
+	limiter *Limiter
+}
+
+// StateBySlot returns the state of the slot right away if the state of the canonical block at or
+// before the slot is available, and regenerates it once a slot of the limiter is free otherwise.
+func (m *limitedStateManager) StateBySlot(ctx context.Context, slot types.Slot) (iface.BeaconState, error) {
+	release, err := m.acquireBelow(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return m.StateManager.StateBySlot(ctx, slot)
+}
+
+// CanonicalStateInEpoch returns the epoch boundary state of the epoch right away if the state it
+// is advanced from is available, and regenerates it once a slot of the limiter is free otherwise.
+func (m *limitedStateManager) CanonicalStateInEpoch(ctx context.Context, epoch types.Epoch) (iface.BeaconState, error) {
+	slot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, err
+	}
+	release, err := m.acquireBelow(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return m.StateManager.CanonicalStateInEpoch(ctx, epoch)
+}
+
+// StateByRoot returns an available state right away, and regenerates any other state once a slot
+// of the limiter is free.
+func (m *limitedStateManager) StateByRoot(ctx context.Context, blockRoot [32]byte) (iface.BeaconState, error) {
+	release, err := m.acquireFor(ctx, blockRoot)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return m.StateManager.StateByRoot(ctx, blockRoot)
+}
+
+// acquireBelow takes a slot of the limiter unless the state of the canonical block at or before the
+// slot is available, from which the state of the slot is derived without replaying blocks.
+func (m *limitedStateManager) acquireBelow(ctx context.Context, slot types.Slot) (func(), error) {
+	if slot == 0 {
+		// The genesis state is saved.
+		return func() {}, nil
+	}
+	root, _, err := m.StateManager.CanonicalBlockAtOrBelow(ctx, slot)
+	if err != nil {
+		// The regeneration fails the same way, or finds its start state by other means.
+		return m.limiter.acquire(ctx)
+	}
+	return m.acquireFor(ctx, root)
+}
+
+// acquireFor takes a slot of the limiter unless the state of the block root is in the caches or
+// saved, so that only calls replaying blocks wait for a slot.
+func (m *limitedStateManager) acquireFor(ctx context.Context, blockRoot [32]byte) (func(), error) {
+	available, err := m.StateManager.HasState(ctx, blockRoot)
+	if err != nil {
+		return nil, err
+	}
+	if available {
+		return func() {}, nil
+	}
+	return m.limiter.acquire(ctx)
+}
@@ -0,0 +1,178 @@
+package loadshed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// blockingStateManager regenerates states once released, and holds the state of cachedRoot,
+// which is the canonical block at or before cachedSlot.
+type blockingStateManager struct {
+	stategen.StateManager
+	started    chan struct{}
+	release    chan struct{}
+	cachedRoot [32]byte
+	cachedSlot types.Slot
+}
+
+func (m *blockingStateManager) regenerate() {
+	m.started <- struct{}{}
+	<-m.release
+}
+
+func (m *blockingStateManager) StateBySlot(_ context.Context, slot types.Slot) (iface.BeaconState, error) {
+	if slot != m.cachedSlot {
+		m.regenerate()
+	}
+	return nil, nil
+}
+
+func (m *blockingStateManager) CanonicalStateInEpoch(_ context.Context, epoch types.Epoch) (iface.BeaconState, error) {
+	if epoch != helpers.SlotToEpoch(m.cachedSlot) {
+		m.regenerate()
+	}
+	return nil, nil
+}
+
+func (m *blockingStateManager) StateByRoot(_ context.Context, blockRoot [32]byte) (iface.BeaconState, error) {
+	if blockRoot != m.cachedRoot {
+		m.regenerate()
+	}
+	return nil, nil
+}
+
+func (m *blockingStateManager) CanonicalBlockAtOrBelow(_ context.Context, slot types.Slot) ([32]byte, types.Slot, error) {
+	if slot == m.cachedSlot {
+		return m.cachedRoot, slot, nil
+	}
+	return [32]byte{'b'}, slot, nil
+}
+
+func (m *blockingStateManager) HasState(_ context.Context, blockRoot [32]byte) (bool, error) {
+	return blockRoot == m.cachedRoot, nil
+}
+
+func TestLimiter_ShedsSaturatedReplays(t *testing.T) {
+	limiter := NewLimiter(1, 12*time.Second)
+	backend := &blockingStateManager{
+		started:    make(chan struct{}, 1),
+		release:    make(chan struct{}),
+		cachedRoot: [32]byte{'a'},
+		cachedSlot: 64,
+	}
+	sm := limiter.StateManager(backend)
+	interceptor := limiter.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorAssignments"}
+	historical := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		if _, err := sm.StateBySlot(ctx, 100); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve archived state: %v", err)
+		}
+		return "replayed", nil
+	}
+	cached := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		if _, err := sm.StateByRoot(ctx, [32]byte{'a'}); err != nil {
+			return nil, err
+		}
+		if _, err := sm.StateBySlot(ctx, 64); err != nil {
+			return nil, err
+		}
+		if _, err := sm.CanonicalStateInEpoch(ctx, 2); err != nil {
+			return nil, err
+		}
+		return "cached", nil
+	}
+
+	ctx := context.Background()
+	done := make(chan error, 1)
+	go func() {
+		_, err := interceptor(ctx, nil, info, historical)
+		done <- err
+	}()
+	<-backend.started
+
+	// The replay queue is saturated, so the next historical call is shed.
+	_, err := interceptor(ctx, nil, info, historical)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.ErrorContains(t, "State replay queue is saturated, retry in 12 seconds", err)
+	retryAfter, ok := grpcutils.RetryAfterFromError(err)
+	require.Equal(t, true, ok)
+	assert.Equal(t, 12*time.Second, retryAfter)
+	epochState := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		return sm.CanonicalStateInEpoch(ctx, 3)
+	}
+	_, err = interceptor(ctx, nil, info, epochState)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	// States derived from a cached state without replaying blocks are still served.
+	res, err := interceptor(ctx, nil, info, cached)
+	require.NoError(t, err)
+	assert.Equal(t, "cached", res)
+
+	// Regenerations outside of RPC calls are never shed.
+	go func() {
+		_, err := sm.StateBySlot(ctx, 100)
+		done <- err
+	}()
+	<-backend.started
+
+	close(backend.release)
+	require.NoError(t, <-done)
+	require.NoError(t, <-done)
+	res, err = interceptor(ctx, nil, info, historical)
+	require.NoError(t, err)
+	assert.Equal(t, "replayed", res)
+}
+
+func TestLimiter_SetMaxReplays(t *testing.T) {
+	limiter := NewLimiter(0, time.Second)
+	backend := &blockingStateManager{
+		started: make(chan struct{}, 1),
+		release: make(chan struct{}),
+	}
+	sm := limiter.StateManager(backend)
+	interceptor := limiter.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorAssignments"}
+	historical := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		if _, err := sm.StateBySlot(ctx, 100); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve archived state: %v", err)
+		}
+		return "replayed", nil
+	}
+
+	ctx := context.Background()
+	done := make(chan error, 1)
+	go func() {
+		_, err := interceptor(ctx, nil, info, historical)
+		done <- err
+	}()
+	<-backend.started
+
+	// Lowering the limit below the replays in flight sheds further calls.
+	limiter.SetMaxReplays(1)
+	assert.Equal(t, 1, limiter.MaxReplays())
+	_, err := interceptor(ctx, nil, info, historical)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	// Raising the limit admits them again.
+	limiter.SetMaxReplays(2)
+	go func() {
+		_, err := interceptor(ctx, nil, info, historical)
+		done <- err
+	}()
+	<-backend.started
+	close(backend.release)
+	require.NoError(t, <-done)
+	require.NoError(t, <-done)
+}
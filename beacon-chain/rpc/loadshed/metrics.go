@@ -0,0 +1,21 @@
+package loadshed
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	shedCalls = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "rpc_state_replay_shed_total",
+			Help: "The number of RPC calls rejected because the state replay queue was saturated.",
+		},
+	)
+	replaysInFlight = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "rpc_state_replays_in_flight",
+			Help: "The number of state regenerations of RPC calls in flight.",
+		},
+	)
+)
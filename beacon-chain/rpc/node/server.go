@@ -17,6 +17,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apistats"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/logutil"
@@ -41,6 +42,7 @@ type Server struct {
 	GenesisFetcher       blockchain.GenesisFetcher
 	BeaconMonitoringHost string
 	BeaconMonitoringPort int
+	APIStats             *apistats.Recorder
 }
 
 // GetSyncStatus checks the current network sync status of the node.
@@ -222,6 +224,16 @@ func (ns *Server) ListPeers(ctx context.Context, _ *ptypes.Empty) (*ethpb.Peers,
 	}, nil
 }
 
+// GetAPIStats returns the number of calls, the bytes served and the average latency of every RPC
+// method per consumer since the node started, the consumer being the name of the API key of the
+// calls or the user agent of their caller.
+func (ns *Server) GetAPIStats(_ context.Context, _ *ptypes.Empty) (*apistats.Stats, error) {
+	if ns.APIStats == nil {
+		return nil, status.Error(codes.Unavailable, "API usage is not recorded")
+	}
+	return ns.APIStats.Stats(), nil
+}
+
 // StreamBeaconLogs from the beacon node via a gRPC server-side stream.
 func (ns *Server) StreamBeaconLogs(_ *empty.Empty, stream pb.Health_StreamBeaconLogsServer) error {
 	ch := make(chan []byte, ns.StreamLogsBufferSize)
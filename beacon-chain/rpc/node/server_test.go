@@ -17,6 +17,7 @@ import (
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apistats"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -149,3 +150,15 @@ func TestNodeServer_ListPeers(t *testing.T) {
 	assert.Equal(t, int(ethpb.PeerDirection_INBOUND), int(res.Peers[0].Direction))
 	assert.Equal(t, ethpb.PeerDirection_OUTBOUND, res.Peers[1].Direction)
 }
+
+func TestNodeServer_GetAPIStats(t *testing.T) {
+	ns := &Server{}
+	_, err := ns.GetAPIStats(context.Background(), &ptypes.Empty{})
+	assert.ErrorContains(t, "API usage is not recorded", err)
+
+	ns.APIStats = apistats.NewRecorder()
+	res, err := ns.GetAPIStats(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Methods))
+	assert.Equal(t, false, res.Since.IsZero())
+}
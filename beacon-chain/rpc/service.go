@@ -8,11 +8,13 @@ import (
 	"fmt"
 	"net"
 	"sync"
+	"time"
 
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	types "github.com/prysmaticlabs/eth2-types"
 	ethpbv1 "github.com/prysmaticlabs/ethereumapis/eth/v1"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
@@ -27,10 +29,18 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/admin"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apikeys"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apistats"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/beacon"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/beaconv1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/callcost"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/cancellation"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/debug"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/debugv1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/genesis"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/lanes"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/loadshed"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/node"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/nodev1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher"
@@ -47,12 +57,26 @@ import (
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/reflection"
 )
 
 const attestationBufferSize = 100
 
+// assignmentsWarmUpTimeout bounds how long assignment requests wait for the assignments warm-up
+// before being served regardless.
+const assignmentsWarmUpTimeout = 2 * time.Minute
+
+const (
+	// keepaliveTime is the idle time after which the server pings a client.
+	keepaliveTime = 30 * time.Second
+	// keepaliveTimeout is how long the server waits for a ping acknowledgement before closing the connection.
+	keepaliveTimeout = 10 * time.Second
+	// keepaliveMinTime is the minimum interval clients may send keepalive pings at.
+	keepaliveMinTime = 10 * time.Second
+)
+
 // Service defining an RPC server for a beacon node.
 type Service struct {
 	cfg                  *Config
@@ -65,6 +89,8 @@ type Service struct {
 	credentialError      error
 	connectedRPCClients  map[net.Addr]bool
 	clientConnectionLock sync.Mutex
+	beaconChainServer    *beacon.Server
+	apiStats             *apistats.Recorder
 }
 
 // Config options for the beacon node RPC server.
@@ -83,10 +109,13 @@ type Config struct {
 	FinalizationFetcher     blockchain.FinalizationFetcher
 	AttestationReceiver     blockchain.AttestationReceiver
 	BlockReceiver           blockchain.BlockReceiver
+	ConfirmationReceiver    blockchain.PandoraConfirmationReceiver
 	POWChainService         powchain.Chain
 	ChainStartFetcher       powchain.ChainStartFetcher
 	GenesisTimeFetcher      blockchain.TimeFetcher
 	GenesisFetcher          blockchain.GenesisFetcher
+	SlotTimeFetcher         blockchain.SlotTimeFetcher
+	ManualSlotTimer         *blockchain.ManualSlotTimer
 	EnableDebugRPCEndpoints bool
 	MockEth1Votes           bool
 	AttestationsPool        attestations.Pool
@@ -104,22 +133,74 @@ type Config struct {
 	OperationNotifier       opfeed.Notifier
 	StateGen                *stategen.State
 	MaxMsgSize              int
+	DatabasePath            string
+	NextEpochGraceSlots     types.Slot
+	MaxEpochInfoLookback    types.Epoch
+	DutyBroadcastLookahead  types.Epoch
+	PrecomputationFetcher   blockchain.PrecomputationStatusFetcher
+	CanonicalRootFetcher    blockchain.CanonicalRootFetcher
+	TrackedValidators       *beacon.TrackedValidators
+	CommitteeCache          *cache.CommitteeCache
+	LivenessCache           *cache.LivenessCache
+	ProposerDigests         *cache.ProposerDigestCache
+	APIKeys                 *apikeys.Keys
+	// GenesisServer keeps serving the genesis service the orchestrator submitted the genesis to,
+	// so that its retried submissions are answered. Nil if the genesis is not supplied by the
+	// orchestrator.
+	GenesisServer *genesis.Server
+	// ShutdownDrainPeriod bounds how long in-flight calls may take to complete when the service
+	// stops, before the remaining connections are closed.
+	ShutdownDrainPeriod time.Duration
+	// MaxStateReplays bounds the historical state regenerations RPC calls may have in flight.
+	// Calls needing one beyond the bound are shed. Zero disables load shedding.
+	MaxStateReplays int
+	// PriorityWorkers is the number of unary calls served concurrently for the API keys marked as
+	// priority, on top of the SharedWorkers serving every caller. Zero disables the scheduling.
+	PriorityWorkers int
+	// SharedWorkers is the number of unary calls of any caller served concurrently when the
+	// scheduling is enabled.
+	SharedWorkers int
+	// MaxResponseBytes is the byte budget of paginated assignment responses. Pages which would
+	// exceed it are served smaller. Zero disables the budget.
+	MaxResponseBytes uint64
+	// SlowCallThreshold is the duration after which unary calls are logged as slow. Zero only
+	// logs the calls with a high estimated cost.
+	SlowCallThreshold time.Duration
+	// EpochInfoWorkers is the number of epoch infos of a range computed concurrently, when
+	// streams catch up and prefetch jobs run.
+	EpochInfoWorkers int
+	// ChainConfig is the beacon chain config served and used by the service. The global config
+	// is used if it is not set.
+	ChainConfig params.ChainConfig
+	// AdminOverridesPath is the file the admin service persists its settings overrides to. The
+	// admin service is disabled if it is empty.
+	AdminOverridesPath string
 }
 
 // NewService instantiates a new RPC service instance that will
 // be registered into a running beacon node.
 func NewService(ctx context.Context, cfg *Config) *Service {
 	ctx, cancel := context.WithCancel(ctx)
+	if cfg.ChainConfig == nil {
+		cfg.ChainConfig = params.GlobalChainConfig()
+	}
+	bufferSize := cfg.ChainConfig.BeaconConfig().DefaultBufferSize
 	return &Service{
 		cfg:                 cfg,
 		ctx:                 ctx,
 		cancel:              cancel,
-		canonicalStateChan:  make(chan *pbp2p.BeaconState, params.BeaconConfig().DefaultBufferSize),
-		incomingAttestation: make(chan *ethpb.Attestation, params.BeaconConfig().DefaultBufferSize),
+		canonicalStateChan:  make(chan *pbp2p.BeaconState, bufferSize),
+		incomingAttestation: make(chan *ethpb.Attestation, bufferSize),
 		connectedRPCClients: make(map[net.Addr]bool),
+		apiStats:            apistats.NewRecorder(),
 	}
 }
 
+// APIStats returns the recorder of the usage of the RPC methods.
+func (s *Service) APIStats() *apistats.Recorder {
+	return s.apiStats
+}
+
 // Start the gRPC server.
 func (s *Service) Start() {
 	address := fmt.Sprintf("%s:%s", s.cfg.Host, s.cfg.Port)
@@ -130,25 +211,78 @@ func (s *Service) Start() {
 	s.listener = lis
 	log.WithField("address", address).Info("gRPC server listening on port")
 
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		recovery.StreamServerInterceptor(
+			recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
+		),
+		grpc_prometheus.StreamServerInterceptor,
+		grpc_opentracing.StreamServerInterceptor(),
+		s.validatorStreamConnectionInterceptor,
+		// Calls abandoned by their callers are reported with the status of their context, which
+		// the Prometheus interceptor records as well.
+		cancellation.StreamServerInterceptor(),
+	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		recovery.UnaryServerInterceptor(
+			recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
+		),
+		grpc_prometheus.UnaryServerInterceptor,
+		grpc_opentracing.UnaryServerInterceptor(),
+		s.validatorUnaryConnectionInterceptor,
+		cancellation.UnaryServerInterceptor(),
+	}
+	if s.cfg.APIKeys != nil {
+		log.Info("Requiring API keys for gRPC calls")
+		streamInterceptors = append(streamInterceptors, s.cfg.APIKeys.StreamServerInterceptor())
+		unaryInterceptors = append(unaryInterceptors, s.cfg.APIKeys.UnaryServerInterceptor())
+	}
+	// Calls are scheduled once authorized, as their lane depends on their API key, and before the
+	// cost estimation, so that the time spent waiting for a worker is not reported as slowness.
+	if s.cfg.PriorityWorkers > 0 && s.cfg.SharedWorkers > 0 {
+		if s.cfg.APIKeys == nil {
+			log.Warn("Priority RPC workers are unreachable without API keys, every call is served by the shared workers")
+		}
+		scheduler := lanes.NewScheduler(s.cfg.PriorityWorkers, s.cfg.SharedWorkers, func(ctx context.Context) bool {
+			key := apikeys.KeyFromContext(ctx)
+			return key != nil && key.Priority
+		})
+		unaryInterceptors = append(unaryInterceptors, scheduler.UnaryServerInterceptor())
+	}
+	// The usage is recorded once the consumer is known from its API key, and once scheduled, so that
+	// the latency recorded is the time spent serving the call.
+	streamInterceptors = append(streamInterceptors, s.apiStats.StreamServerInterceptor())
+	unaryInterceptors = append(unaryInterceptors, s.apiStats.UnaryServerInterceptor())
+	// The cost is estimated before shedding, so that shed calls are accounted for as well.
+	estimator := callcost.NewEstimator(s.cfg.HeadFetcher, s.cfg.SlowCallThreshold)
+	unaryInterceptors = append(unaryInterceptors, estimator.UnaryServerInterceptor())
+	// Historical queries are shed once their state replays saturate the node, so that the queries
+	// served from caches and the head are still answered.
+	// The limiter is kept with the admin service enabled, so that load shedding can be turned on
+	// at runtime.
+	var historicalStateGen stategen.StateManager = s.cfg.StateGen
+	var limiter *loadshed.Limiter
+	if s.cfg.MaxStateReplays > 0 || s.cfg.AdminOverridesPath != "" {
+		retryAfter := time.Duration(s.cfg.ChainConfig.BeaconConfig().SecondsPerSlot) * time.Second
+		limiter = loadshed.NewLimiter(s.cfg.MaxStateReplays, retryAfter)
+		streamInterceptors = append(streamInterceptors, limiter.StreamServerInterceptor())
+		unaryInterceptors = append(unaryInterceptors, limiter.UnaryServerInterceptor())
+		historicalStateGen = limiter.StateManager(s.cfg.StateGen)
+	}
 	opts := []grpc.ServerOption{
 		grpc.StatsHandler(&ocgrpc.ServerHandler{}),
-		grpc.StreamInterceptor(middleware.ChainStreamServer(
-			recovery.StreamServerInterceptor(
-				recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
-			),
-			grpc_prometheus.StreamServerInterceptor,
-			grpc_opentracing.StreamServerInterceptor(),
-			s.validatorStreamConnectionInterceptor,
-		)),
-		grpc.UnaryInterceptor(middleware.ChainUnaryServer(
-			recovery.UnaryServerInterceptor(
-				recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
-			),
-			grpc_prometheus.UnaryServerInterceptor,
-			grpc_opentracing.UnaryServerInterceptor(),
-			s.validatorUnaryConnectionInterceptor,
-		)),
+		grpc.StreamInterceptor(middleware.ChainStreamServer(streamInterceptors...)),
+		grpc.UnaryInterceptor(middleware.ChainUnaryServer(unaryInterceptors...)),
 		grpc.MaxRecvMsgSize(s.cfg.MaxMsgSize),
+		// Keepalive pings detect broken connections of idle streams, so that clients reconnect
+		// and resume their streams instead of waiting on a dead connection.
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:    keepaliveTime,
+			Timeout: keepaliveTimeout,
+		}),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             keepaliveMinTime,
+			PermitWithoutStream: true,
+		}),
 	}
 	grpc_prometheus.EnableHandlingTimeHistogram()
 	if s.cfg.CertFlag != "" && s.cfg.KeyFlag != "" {
@@ -166,6 +300,7 @@ func (s *Service) Start() {
 
 	validatorServer := &validator.Server{
 		Ctx:                    s.ctx,
+		ChainConfig:            s.cfg.ChainConfig,
 		BeaconDB:               s.cfg.BeaconDB,
 		AttestationCache:       cache.NewAttestationCache(),
 		AttPool:                s.cfg.AttestationsPool,
@@ -190,6 +325,7 @@ func (s *Service) Start() {
 		PendingDepositsFetcher: s.cfg.PendingDepositFetcher,
 		SlashingsPool:          s.cfg.SlashingsPool,
 		StateGen:               s.cfg.StateGen,
+		CommitteeCache:         s.cfg.CommitteeCache,
 	}
 	nodeServer := &node.Server{
 		LogsStreamer:         logutil.NewStreamServer(),
@@ -203,6 +339,7 @@ func (s *Service) Start() {
 		GenesisFetcher:       s.cfg.GenesisFetcher,
 		BeaconMonitoringHost: s.cfg.BeaconMonitoringHost,
 		BeaconMonitoringPort: s.cfg.BeaconMonitoringPort,
+		APIStats:             s.apiStats,
 	}
 	nodeServerV1 := &nodev1.Server{
 		BeaconDB:           s.cfg.BeaconDB,
@@ -216,6 +353,7 @@ func (s *Service) Start() {
 		HeadFetcher:        s.cfg.HeadFetcher,
 	}
 
+	assignmentsWarmedUp := make(chan struct{})
 	beaconChainServer := &beacon.Server{
 		Ctx:                         s.ctx,
 		BeaconDB:                    s.cfg.BeaconDB,
@@ -229,12 +367,33 @@ func (s *Service) Start() {
 		BlockFetcher:                s.cfg.POWChainService,
 		CanonicalStateChan:          s.canonicalStateChan,
 		GenesisTimeFetcher:          s.cfg.GenesisTimeFetcher,
+		SlotTimeFetcher:             s.cfg.SlotTimeFetcher,
 		StateNotifier:               s.cfg.StateNotifier,
 		BlockNotifier:               s.cfg.BlockNotifier,
 		AttestationNotifier:         s.cfg.OperationNotifier,
 		Broadcaster:                 s.cfg.Broadcaster,
-		StateGen:                    s.cfg.StateGen,
+		StateGen:                    historicalStateGen,
 		SyncChecker:                 s.cfg.SyncService,
+		DatabasePath:                s.cfg.DatabasePath,
+		ColdStateStoreEnabled:       s.cfg.StateGen != nil && s.cfg.StateGen.ColdStateStoreEnabled(),
+		ConfirmationStore:           s.cfg.BeaconDB,
+		PandoraConfirmationReceiver: s.cfg.ConfirmationReceiver,
+		NextEpochGraceSlots:         s.cfg.NextEpochGraceSlots,
+		MaxEpochInfoLookback:        s.cfg.MaxEpochInfoLookback,
+		EpochInfoWorkers:            s.cfg.EpochInfoWorkers,
+		DutyBroadcastLookahead:      s.cfg.DutyBroadcastLookahead,
+		MaxResponseBytes:            s.cfg.MaxResponseBytes,
+		CommitteeCache:              s.cfg.CommitteeCache,
+		LivenessCache:               s.cfg.LivenessCache,
+		ProposerDigests:             s.cfg.ProposerDigests,
+		PrecomputationStatusFetcher: s.cfg.PrecomputationFetcher,
+		CanonicalRootFetcher:        s.cfg.CanonicalRootFetcher,
+		TrackedValidators:           s.cfg.TrackedValidators,
+		EpochInfoStore:              s.cfg.BeaconDB,
+		StreamCursorStore:           s.cfg.BeaconDB,
+		Eth1DataVoteFetcher:         validatorServer,
+		ChainConfig:                 s.cfg.ChainConfig,
+		AssignmentsWarmedUp:         assignmentsWarmedUp,
 		ReceivedAttestationsBuffer:  make(chan *ethpb.Attestation, attestationBufferSize),
 		CollectedAttestationsBuffer: make(chan []*ethpb.Attestation, attestationBufferSize),
 	}
@@ -253,29 +412,49 @@ func (s *Service) Start() {
 		BlockNotifier:       s.cfg.BlockNotifier,
 		AttestationNotifier: s.cfg.OperationNotifier,
 		Broadcaster:         s.cfg.Broadcaster,
-		StateGenService:     s.cfg.StateGen,
+		StateGenService:     historicalStateGen,
 		SyncChecker:         s.cfg.SyncService,
 		StateFetcher: statefetcher.StateProvider{
 			BeaconDB:           s.cfg.BeaconDB,
 			ChainInfoFetcher:   s.cfg.ChainInfoFetcher,
 			GenesisTimeFetcher: s.cfg.GenesisTimeFetcher,
-			StateGenService:    s.cfg.StateGen,
+			StateGenService:    historicalStateGen,
 		},
+		CommitteeCache: s.cfg.CommitteeCache,
 	}
 	ethpb.RegisterNodeServer(s.grpcServer, nodeServer)
 	ethpbv1.RegisterBeaconNodeServer(s.grpcServer, nodeServerV1)
 	pbrpc.RegisterHealthServer(s.grpcServer, nodeServer)
+	s.beaconChainServer = beaconChainServer
+	// The caches of the beacon chain server settle their entries as the finalized checkpoint advances.
+	go statefeed.RunFinalityHooks(s.ctx, s.cfg.StateNotifier, "rpc_finality_hooks", beaconChainServer)
+	go beaconChainServer.PreSubscribeTrackedDuties(s.ctx)
 	ethpb.RegisterBeaconChainServer(s.grpcServer, beaconChainServer)
+	pbrpc.RegisterBeaconQueryServer(s.grpcServer, beaconChainServer)
+	if s.cfg.GenesisServer != nil {
+		pbrpc.RegisterGenesisServer(s.grpcServer, s.cfg.GenesisServer)
+	}
+	if s.cfg.AdminOverridesPath != "" {
+		adminServer := admin.NewServer(s.cfg.AdminOverridesPath, beaconChainServer, limiter, beaconChainServer)
+		if err := adminServer.LoadOverrides(); err != nil {
+			log.WithError(err).Error("Could not apply persisted settings overrides")
+		}
+		pbrpc.RegisterAdminServer(s.grpcServer, adminServer)
+		log.Info("Enabled admin gRPC endpoints")
+	}
 	ethpbv1.RegisterBeaconChainServer(s.grpcServer, beaconChainServerV1)
 	if s.cfg.EnableDebugRPCEndpoints {
 		log.Info("Enabled debug gRPC endpoints")
 		debugServer := &debug.Server{
+			ChainConfig:        s.cfg.ChainConfig,
 			GenesisTimeFetcher: s.cfg.GenesisTimeFetcher,
 			BeaconDB:           s.cfg.BeaconDB,
 			StateGen:           s.cfg.StateGen,
 			HeadFetcher:        s.cfg.HeadFetcher,
 			PeerManager:        s.cfg.PeerManager,
 			PeersFetcher:       s.cfg.PeersFetcher,
+			SlotTimer:          s.cfg.ManualSlotTimer,
+			CommitteeCache:     s.cfg.CommitteeCache,
 		}
 		debugServerV1 := &debugv1.Server{
 			Ctx:      s.ctx,
@@ -295,6 +474,17 @@ func (s *Service) Start() {
 	// Register reflection service on gRPC server.
 	reflection.Register(s.grpcServer)
 
+	// Assignment requests are held back until the assignments of the recent epochs are cached, as
+	// replaying their states could make the first requests time out. The other methods are served
+	// in the meantime.
+	go func() {
+		defer close(assignmentsWarmedUp)
+		ctx, cancel := context.WithTimeout(s.ctx, assignmentsWarmUpTimeout)
+		defer cancel()
+		if err := beaconChainServer.WarmUpAssignments(ctx); err != nil {
+			log.WithError(err).Warn("Could not warm up validator assignments")
+		}
+	}()
 	go func() {
 		if s.listener != nil {
 			if err := s.grpcServer.Serve(s.listener); err != nil {
@@ -304,16 +494,39 @@ func (s *Service) Start() {
 	}()
 }
 
-// Stop the service.
+// Stop the service. The duty and consensus info streams end with a shutting down status, so that clients fail over
+// to another node, and the cached epoch infos of finalized epochs are persisted before the
+// in-flight calls are drained.
 func (s *Service) Stop() error {
 	s.cancel()
+	if s.beaconChainServer != nil {
+		if err := s.beaconChainServer.FlushEpochInfos(context.Background()); err != nil {
+			log.WithError(err).Error("Could not flush epoch infos")
+		}
+	}
 	if s.listener != nil {
-		s.grpcServer.GracefulStop()
-		log.Debug("Initiated graceful stop of gRPC server")
+		s.drain()
 	}
 	return nil
 }
 
+// drain stops the gRPC server gracefully, closing the connections still open once the drain
+// period elapsed.
+func (s *Service) drain() {
+	stopped := make(chan struct{})
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	log.WithField("drainPeriod", s.cfg.ShutdownDrainPeriod).Debug("Initiated graceful stop of gRPC server")
+	select {
+	case <-stopped:
+	case <-time.After(s.cfg.ShutdownDrainPeriod):
+		log.Warn("Drain period elapsed, closing remaining gRPC connections")
+		s.grpcServer.Stop()
+	}
+}
+
 // Status returns nil or credentialError
 func (s *Service) Status() error {
 	if s.cfg.SyncService.Syncing() {
@@ -37,15 +37,15 @@ func (vs *Server) SubmitAggregateSelectionProof(ctx context.Context, req *ethpb.
 	}
 
 	epoch := helpers.SlotToEpoch(req.Slot)
-	activeValidatorIndices, err := helpers.ActiveValidatorIndices(st, epoch)
+	activeValidatorIndices, err := helpers.ActiveValidatorIndices(vs.CommitteeCache, st, epoch)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not get validators: %v", err)
 	}
-	seed, err := helpers.Seed(st, epoch, params.BeaconConfig().DomainBeaconAttester)
+	seed, err := helpers.Seed(st, epoch, params.BeaconConfigOf(vs.ChainConfig).DomainBeaconAttester)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not get seed: %v", err)
 	}
-	committee, err := helpers.BeaconCommittee(activeValidatorIndices, seed, req.Slot, req.CommitteeIndex)
+	committee, err := helpers.BeaconCommittee(vs.CommitteeCache, activeValidatorIndices, seed, req.Slot, req.CommitteeIndex)
 	if err != nil {
 		return nil, err
 	}
@@ -11,7 +11,10 @@ import (
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/rand"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
@@ -31,11 +34,40 @@ func (vs *Server) GetDuties(ctx context.Context, req *ethpb.DutiesRequest) (*eth
 
 // StreamDuties returns the duties assigned to a list of validators specified
 // in the request object via a server-side stream. The stream sends out new assignments in case
-// a chain re-org occurred.
+// a chain re-org occurred. When the orchestrator.EpochShardMetadataKey is set on the call, only
+// the duties of the epochs of the shard are sent.
 func (vs *Server) StreamDuties(req *ethpb.DutiesRequest, stream ethpb.BeaconNodeValidator_StreamDutiesServer) error {
 	if vs.SyncChecker.Syncing() {
 		return status.Error(codes.Unavailable, "Syncing to latest head, not ready to respond")
 	}
+	shard, err := orchestrator.RequestedEpochShard(stream.Context(), nil)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid epoch shard: %v", err)
+	}
+	// Both the epoch ticker and the head advancing into a new epoch announce an epoch, so the
+	// duties of an epoch that already went out are only sent again after a reorg.
+	var sentEpoch types.Epoch
+	sent := false
+	send := func(reorg bool) error {
+		if !shard.Includes(req.Epoch) {
+			return nil
+		}
+		if sent && !reorg && req.Epoch <= sentEpoch {
+			return nil
+		}
+		res, err := vs.duties(stream.Context(), req)
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not compute validator duties: %v", err)
+		}
+		if err := stream.Send(res); err != nil {
+			return status.Errorf(codes.Internal, "Could not send response over stream: %v", err)
+		}
+		if !sent || req.Epoch > sentEpoch {
+			sentEpoch = req.Epoch
+		}
+		sent = true
+		return nil
+	}
 
 	// If we are post-genesis time, then set the current epoch to
 	// the number epochs since the genesis time, otherwise 0 by default.
@@ -48,32 +80,25 @@ func (vs *Server) StreamDuties(req *ethpb.DutiesRequest, stream ethpb.BeaconNode
 		currentEpoch = slotutil.EpochsSinceGenesis(vs.TimeFetcher.GenesisTime())
 	}
 	req.Epoch = currentEpoch
-	res, err := vs.duties(stream.Context(), req)
-	if err != nil {
-		return status.Errorf(codes.Internal, "Could not compute validator duties: %v", err)
-	}
-	if err := stream.Send(res); err != nil {
-		return status.Errorf(codes.Internal, "Could not send response over stream: %v", err)
+	if err := send(false); err != nil {
+		return err
 	}
 
 	// We start a for loop which ticks on every epoch or a chain reorg.
+	// A slow duties stream misses the oldest state events rather than stalling block processing.
 	stateChannel := make(chan *feed.Event, 1)
-	stateSub := vs.StateNotifier.StateFeed().Subscribe(stateChannel)
+	stateSub := vs.StateNotifier.StateFeed().SubscribeWithPolicy(stateChannel, "stream_duties", event.DropOldest)
 	defer stateSub.Unsubscribe()
 
-	secondsPerEpoch := params.BeaconConfig().SecondsPerSlot * uint64(params.BeaconConfig().SlotsPerEpoch)
+	secondsPerEpoch := params.BeaconConfigOf(vs.ChainConfig).SecondsPerSlot * uint64(params.BeaconConfigOf(vs.ChainConfig).SlotsPerEpoch)
 	epochTicker := slotutil.NewSlotTicker(vs.TimeFetcher.GenesisTime(), secondsPerEpoch)
 	for {
 		select {
 		// Ticks every epoch to submit assignments to connected validator clients.
 		case slot := <-epochTicker.C():
 			req.Epoch = types.Epoch(slot)
-			res, err := vs.duties(stream.Context(), req)
-			if err != nil {
-				return status.Errorf(codes.Internal, "Could not compute validator duties: %v", err)
-			}
-			if err := stream.Send(res); err != nil {
-				return status.Errorf(codes.Internal, "Could not send response over stream: %v", err)
+			if err := send(false); err != nil {
+				return err
 			}
 		case ev := <-stateChannel:
 			// If a reorg occurred, we recompute duties for the connected validator clients
@@ -92,18 +117,26 @@ func (vs *Server) StreamDuties(req *ethpb.DutiesRequest, stream ethpb.BeaconNode
 					continue
 				}
 				req.Epoch = currentEpoch
-				res, err := vs.duties(stream.Context(), req)
-				if err != nil {
-					return status.Errorf(codes.Internal, "Could not compute validator duties: %v", err)
+				if err := send(true); err != nil {
+					return err
+				}
+			}
+			// If the head advanced into a new epoch, we push the duties of that epoch right away
+			// instead of waiting for the epoch ticker.
+			if ev.Type == statefeed.EpochTransition {
+				data, ok := ev.Data.(*statefeed.EpochTransitionData)
+				if !ok {
+					return status.Errorf(codes.Internal, "Received incorrect data type over epoch transition feed: %v", data)
 				}
-				if err := stream.Send(res); err != nil {
-					return status.Errorf(codes.Internal, "Could not send response over stream: %v", err)
+				req.Epoch = data.Epoch
+				if err := send(false); err != nil {
+					return err
 				}
 			}
 		case <-stream.Context().Done():
 			return status.Error(codes.Canceled, "Stream context canceled")
 		case <-vs.Ctx.Done():
-			return status.Error(codes.Canceled, "RPC context canceled")
+			return grpcutils.ShuttingDownError()
 		}
 	}
 }
@@ -127,17 +160,17 @@ func (vs *Server) duties(ctx context.Context, req *ethpb.DutiesRequest) (*ethpb.
 		return nil, err
 	}
 	if s.Slot() < epochStartSlot {
-		s, err = state.ProcessSlots(ctx, s, epochStartSlot)
+		s, err = state.ProcessSlots(ctx, vs.CommitteeCache, s, epochStartSlot)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not process slots up to %d: %v", epochStartSlot, err)
 		}
 	}
-	committeeAssignments, proposerIndexToSlots, err := helpers.CommitteeAssignments(s, req.Epoch)
+	committeeAssignments, proposerIndexToSlots, err := helpers.CommitteeAssignments(ctx, vs.CommitteeCache, s, req.Epoch)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not compute committee assignments: %v", err)
 	}
 	// Query the next epoch assignments for committee subnet subscriptions.
-	nextCommitteeAssignments, _, err := helpers.CommitteeAssignments(s, req.Epoch+1)
+	nextCommitteeAssignments, _, err := helpers.CommitteeAssignments(ctx, vs.CommitteeCache, s, req.Epoch+1)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not compute next committee assignments: %v", err)
 	}
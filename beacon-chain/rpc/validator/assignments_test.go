@@ -15,6 +15,7 @@ import (
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/mock"
@@ -22,6 +23,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/metadata"
 )
 
 // pubKey is a helper to generate a well-formed public key.
@@ -275,9 +277,9 @@ func TestStreamDuties_OK(t *testing.T) {
 	mockStream.EXPECT().Send(wantedRes).Do(func(arg0 interface{}) {
 		exitRoutine <- true
 	})
-	mockStream.EXPECT().Context().Return(ctx).AnyTimes()
+	mockStream.EXPECT().Context().Return(context.Background()).AnyTimes()
 	go func(tt *testing.T) {
-		assert.ErrorContains(t, "context canceled", vs.StreamDuties(req, mockStream))
+		assert.ErrorContains(t, "shutting down", vs.StreamDuties(req, mockStream))
 	}(t)
 	<-exitRoutine
 	cancel()
@@ -336,9 +338,9 @@ func TestStreamDuties_OK_ChainReorg(t *testing.T) {
 	mockStream.EXPECT().Send(wantedRes).Do(func(arg0 interface{}) {
 		exitRoutine <- true
 	})
-	mockStream.EXPECT().Context().Return(ctx).AnyTimes()
+	mockStream.EXPECT().Context().Return(context.Background()).AnyTimes()
 	go func(tt *testing.T) {
-		assert.ErrorContains(t, "context canceled", vs.StreamDuties(req, mockStream))
+		assert.ErrorContains(t, "shutting down", vs.StreamDuties(req, mockStream))
 	}(t)
 	// Fire a reorg event within the same epoch. This should NOT
 	// trigger a recomputation not resending of duties over the stream.
@@ -360,6 +362,150 @@ func TestStreamDuties_OK_ChainReorg(t *testing.T) {
 	cancel()
 }
 
+func TestStreamDuties_OK_EpochTransition(t *testing.T) {
+	db := dbutil.SetupDB(t)
+
+	genesis := testutil.NewBeaconBlock()
+	depChainStart := params.BeaconConfig().MinGenesisActiveValidatorCount
+	deposits, _, err := testutil.DeterministicDepositsAndKeys(depChainStart)
+	require.NoError(t, err)
+	eth1Data, err := testutil.DeterministicEth1Data(len(deposits))
+	require.NoError(t, err)
+	bs, err := state.GenesisBeaconState(deposits, 0, eth1Data)
+	require.NoError(t, err, "Could not setup genesis bs")
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err, "Could not get signing root")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	// Start half way through epoch 1 so the epoch ticker does not fire during the test.
+	secondsPerEpoch := params.BeaconConfig().SecondsPerSlot * uint64(params.BeaconConfig().SlotsPerEpoch)
+	c := &mockChain.ChainService{
+		Genesis: time.Now().Add(-time.Duration(secondsPerEpoch*3/2) * time.Second),
+	}
+	vs := &Server{
+		Ctx:           ctx,
+		BeaconDB:      db,
+		HeadFetcher:   &mockChain.ChainService{State: bs, Root: genesisRoot[:]},
+		SyncChecker:   &mockSync.Sync{IsSyncing: false},
+		TimeFetcher:   c,
+		StateNotifier: &mockChain.MockStateNotifier{},
+	}
+
+	req := &ethpb.DutiesRequest{
+		PublicKeys: [][]byte{deposits[0].Data.PublicKey},
+		Epoch:      1,
+	}
+	wantedRes, err := vs.duties(ctx, req)
+	require.NoError(t, err)
+	wantedNextRes, err := vs.duties(ctx, &ethpb.DutiesRequest{
+		PublicKeys: [][]byte{deposits[0].Data.PublicKey},
+		Epoch:      2,
+	})
+	require.NoError(t, err)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	exitRoutine := make(chan bool)
+	mockStream := mock.NewMockBeaconNodeValidator_StreamDutiesServer(ctrl)
+	firstSent := make(chan bool, 1)
+	// The duties of each epoch are sent only once.
+	mockStream.EXPECT().Send(wantedRes).Do(func(arg0 interface{}) {
+		firstSent <- true
+	}).Times(1)
+	mockStream.EXPECT().Send(wantedNextRes).Do(func(arg0 interface{}) {
+		exitRoutine <- true
+	}).Times(1)
+	mockStream.EXPECT().Context().Return(context.Background()).AnyTimes()
+	go func(tt *testing.T) {
+		assert.ErrorContains(t, "shutting down", vs.StreamDuties(req, mockStream))
+	}(t)
+	<-firstSent
+	// The head reaching the epoch whose duties were already sent pushes nothing, and the head
+	// advancing into the next epoch pushes the duties of that epoch right away.
+	for _, epoch := range []types.Epoch{1, 2} {
+		for sent := 0; sent == 0; {
+			sent = vs.StateNotifier.StateFeed().Send(&feed.Event{
+				Type: statefeed.EpochTransition,
+				Data: &statefeed.EpochTransitionData{Epoch: epoch, Slot: params.BeaconConfig().SlotsPerEpoch.Mul(uint64(epoch))},
+			})
+		}
+	}
+	<-exitRoutine
+	cancel()
+}
+
+func TestStreamDuties_EpochShard(t *testing.T) {
+	db := dbutil.SetupDB(t)
+
+	genesis := testutil.NewBeaconBlock()
+	depChainStart := params.BeaconConfig().MinGenesisActiveValidatorCount
+	deposits, _, err := testutil.DeterministicDepositsAndKeys(depChainStart)
+	require.NoError(t, err)
+	eth1Data, err := testutil.DeterministicEth1Data(len(deposits))
+	require.NoError(t, err)
+	bs, err := state.GenesisBeaconState(deposits, 0, eth1Data)
+	require.NoError(t, err, "Could not setup genesis bs")
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err, "Could not get signing root")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	// Start half way through epoch 1 so the epoch ticker does not fire during the test.
+	secondsPerEpoch := params.BeaconConfig().SecondsPerSlot * uint64(params.BeaconConfig().SlotsPerEpoch)
+	c := &mockChain.ChainService{
+		Genesis: time.Now().Add(-time.Duration(secondsPerEpoch*3/2) * time.Second),
+	}
+	vs := &Server{
+		Ctx:           ctx,
+		BeaconDB:      db,
+		HeadFetcher:   &mockChain.ChainService{State: bs, Root: genesisRoot[:]},
+		SyncChecker:   &mockSync.Sync{IsSyncing: false},
+		TimeFetcher:   c,
+		StateNotifier: &mockChain.MockStateNotifier{},
+	}
+
+	req := &ethpb.DutiesRequest{
+		PublicKeys: [][]byte{deposits[0].Data.PublicKey},
+	}
+	wantedRes, err := vs.duties(ctx, &ethpb.DutiesRequest{
+		PublicKeys: [][]byte{deposits[0].Data.PublicKey},
+		Epoch:      2,
+	})
+	require.NoError(t, err)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	exitRoutine := make(chan bool)
+	mockStream := mock.NewMockBeaconNodeValidator_StreamDutiesServer(ctrl)
+	// The duties of the current epoch 1 are left out of the shard of even epochs.
+	mockStream.EXPECT().Send(wantedRes).Do(func(arg0 interface{}) {
+		exitRoutine <- true
+	})
+	shard := &orchestrator.EpochShard{Modulus: 2, Remainder: 0}
+	streamCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(orchestrator.EpochShardMetadataKey, shard.String()))
+	mockStream.EXPECT().Context().Return(streamCtx).AnyTimes()
+	go func(tt *testing.T) {
+		assert.ErrorContains(t, "shutting down", vs.StreamDuties(req, mockStream))
+	}(t)
+	for sent := 0; sent == 0; {
+		sent = vs.StateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.EpochTransition,
+			Data: &statefeed.EpochTransitionData{Epoch: 2, Slot: 2 * params.BeaconConfig().SlotsPerEpoch},
+		})
+	}
+	<-exitRoutine
+	cancel()
+}
+
+func TestStreamDuties_InvalidEpochShard(t *testing.T) {
+	vs := &Server{
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStream := mock.NewMockBeaconNodeValidator_StreamDutiesServer(ctrl)
+	streamCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(orchestrator.EpochShardMetadataKey, "3/2"))
+	mockStream.EXPECT().Context().Return(streamCtx).AnyTimes()
+	assert.ErrorContains(t, "Invalid epoch shard", vs.StreamDuties(&ethpb.DutiesRequest{}, mockStream))
+}
+
 func TestAssignValidatorToSubnet(t *testing.T) {
 	k := pubKey(3)
 
@@ -96,12 +96,12 @@ func (vs *Server) GetAttestationData(ctx context.Context, req *ethpb.Attestation
 
 	if helpers.CurrentEpoch(headState) < helpers.SlotToEpoch(req.Slot) {
 		if featureconfig.Get().EnableNextSlotStateCache {
-			headState, err = state.ProcessSlotsUsingNextSlotCache(ctx, headState, headRoot, req.Slot)
+			headState, err = state.ProcessSlotsUsingNextSlotCache(ctx, vs.CommitteeCache, headState, headRoot, req.Slot)
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "Could not process slots up to %d: %v", req.Slot, err)
 			}
 		} else {
-			headState, err = state.ProcessSlots(ctx, headState, req.Slot)
+			headState, err = state.ProcessSlots(ctx, vs.CommitteeCache, headState, req.Slot)
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "Could not process slots up to %d: %v", req.Slot, err)
 			}
@@ -121,7 +121,7 @@ func (vs *Server) GetAttestationData(ctx context.Context, req *ethpb.Attestation
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not get target block for slot %d: %v", epochStartSlot, err)
 		}
-		if bytesutil.ToBytes32(targetRoot) == params.BeaconConfig().ZeroHash {
+		if bytesutil.ToBytes32(targetRoot) == params.BeaconConfigOf(vs.ChainConfig).ZeroHash {
 			targetRoot = headRoot
 		}
 	}
@@ -0,0 +1,26 @@
+package validator
+
+import (
+	"context"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Eth1DataVote returns the eth1 data the node votes for in the next block it proposes on the
+// head, chosen the same way as for block proposals.
+func (vs *Server) Eth1DataVote(ctx context.Context) (*ethpb.Eth1Data, error) {
+	headState, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil {
+		return nil, status.Error(codes.Internal, "Head state of chain was nil")
+	}
+	vote, err := vs.eth1DataMajorityVote(ctx, headState)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get eth1 data vote: %v", err)
+	}
+	return vote, nil
+}
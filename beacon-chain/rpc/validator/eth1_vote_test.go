@@ -0,0 +1,30 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_Eth1DataVote(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 16)
+	require.NoError(t, st.SetSlot(5))
+	require.NoError(t, st.SetEth1DepositIndex(16))
+	vs := &Server{
+		HeadFetcher:     &mock.ChainService{State: st},
+		Eth1InfoFetcher: &mockPOW.POWChain{},
+		MockEth1Votes:   true,
+	}
+	want, err := vs.mockETH1DataVote(ctx, 5)
+	require.NoError(t, err)
+	vote, err := vs.Eth1DataVote(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, vote, "Expected the vote of a proposal on the head")
+	assert.Equal(t, uint64(16), vote.DepositCount)
+}
@@ -24,7 +24,7 @@ func (vs *Server) ProposeExit(ctx context.Context, req *ethpb.SignedVoluntaryExi
 	if req.Exit == nil {
 		return nil, status.Error(codes.InvalidArgument, "voluntary exit does not exist")
 	}
-	if req.Signature == nil || len(req.Signature) != params.BeaconConfig().BLSSignatureLength {
+	if req.Signature == nil || len(req.Signature) != params.BeaconConfigOf(vs.ChainConfig).BLSSignatureLength {
 		return nil, status.Error(codes.InvalidArgument, "invalid signature provided")
 	}
 
@@ -59,29 +59,63 @@ func (vs *Server) GetBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb
 		return nil, status.Errorf(codes.Unavailable, "Syncing to latest head, not ready to respond")
 	}
 
+	head, parentRoot, err := vs.proposalState(ctx, req.Slot)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := vs.buildBlock(ctx, head, parentRoot, req.Slot, req.RandaoReveal, req.Graffiti)
+	if err != nil {
+		return nil, err
+	}
+
+	// Compute state root with the newly constructed block.
+	stateRoot, err := vs.computeStateRoot(ctx, &ethpb.SignedBeaconBlock{Block: blk, Signature: make([]byte, 96)})
+	if err != nil {
+		interop.WriteBlockToDisk(&ethpb.SignedBeaconBlock{Block: blk}, true /*failed*/)
+		return nil, status.Errorf(codes.Internal, "Could not compute state root: %v", err)
+	}
+	blk.StateRoot = stateRoot
+
+	return blk, nil
+}
+
+// proposalState returns the head state advanced to the slot of a proposal, and the head root
+// which the block of the proposal builds on.
+func (vs *Server) proposalState(ctx context.Context, slot types.Slot) (iface.BeaconState, []byte, error) {
 	// Retrieve the parent block as the current head of the canonical chain.
 	parentRoot, err := vs.HeadFetcher.HeadRoot(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not retrieve head root: %v", err)
+		return nil, nil, status.Errorf(codes.Internal, "Could not retrieve head root: %v", err)
 	}
 
 	head, err := vs.HeadFetcher.HeadState(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not get head state %v", err)
+		return nil, nil, status.Errorf(codes.Internal, "Could not get head state %v", err)
 	}
 
 	if featureconfig.Get().EnableNextSlotStateCache {
-		head, err = state.ProcessSlotsUsingNextSlotCache(ctx, head, parentRoot, req.Slot)
+		head, err = state.ProcessSlotsUsingNextSlotCache(ctx, vs.CommitteeCache, head, parentRoot, slot)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not advance slots to calculate proposer index: %v", err)
+			return nil, nil, status.Errorf(codes.Internal, "Could not advance slots to calculate proposer index: %v", err)
 		}
 	} else {
-		head, err = state.ProcessSlots(ctx, head, req.Slot)
+		head, err = state.ProcessSlots(ctx, vs.CommitteeCache, head, slot)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not advance slot to calculate proposer index: %v", err)
+			return nil, nil, status.Errorf(codes.Internal, "Could not advance slot to calculate proposer index: %v", err)
 		}
 	}
+	return head, parentRoot, nil
+}
 
+// buildBlock builds a block of the slot on the head state advanced to the slot, leaving the state
+// root at the zero hash.
+func (vs *Server) buildBlock(
+	ctx context.Context,
+	head iface.BeaconState,
+	parentRoot []byte,
+	slot types.Slot,
+	randaoReveal, graffiti []byte,
+) (*ethpb.BeaconBlock, error) {
 	eth1Data, err := vs.eth1DataMajorityVote(ctx, head)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not get ETH1 data: %v", err)
@@ -102,16 +136,16 @@ func (vs *Server) GetBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb
 	// Use zero hash as stub for state root to compute later.
 	stateRoot := params.BeaconConfig().ZeroHash[:]
 
-	graffiti := bytesutil.ToBytes32(req.Graffiti)
+	graffitiBytes := bytesutil.ToBytes32(graffiti)
 
 	// Calculate new proposer index.
-	idx, err := helpers.BeaconProposerIndex(head)
+	idx, err := helpers.BeaconProposerIndex(vs.CommitteeCache, head)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not calculate proposer index %v", err)
 	}
 
 	blk := &ethpb.BeaconBlock{
-		Slot:          req.Slot,
+		Slot:          slot,
 		ParentRoot:    parentRoot,
 		StateRoot:     stateRoot,
 		ProposerIndex: idx,
@@ -119,22 +153,13 @@ func (vs *Server) GetBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb
 			Eth1Data:          eth1Data,
 			Deposits:          deposits,
 			Attestations:      atts,
-			RandaoReveal:      req.RandaoReveal,
+			RandaoReveal:      randaoReveal,
 			ProposerSlashings: vs.SlashingsPool.PendingProposerSlashings(ctx, head, false /*noLimit*/),
 			AttesterSlashings: vs.SlashingsPool.PendingAttesterSlashings(ctx, head, false /*noLimit*/),
-			VoluntaryExits:    vs.ExitPool.PendingExits(head, req.Slot, false /*noLimit*/),
-			Graffiti:          graffiti[:],
+			VoluntaryExits:    vs.ExitPool.PendingExits(head, slot, false /*noLimit*/),
+			Graffiti:          graffitiBytes[:],
 		},
 	}
-
-	// Compute state root with the newly constructed block.
-	stateRoot, err = vs.computeStateRoot(ctx, &ethpb.SignedBeaconBlock{Block: blk, Signature: make([]byte, 96)})
-	if err != nil {
-		interop.WriteBlockToDisk(&ethpb.SignedBeaconBlock{Block: blk}, true /*failed*/)
-		return nil, status.Errorf(codes.Internal, "Could not compute state root: %v", err)
-	}
-	blk.StateRoot = stateRoot
-
 	return blk, nil
 }
 
@@ -175,16 +200,16 @@ func (vs *Server) ProposeBlock(ctx context.Context, blk *ethpb.SignedBeaconBlock
 
 // eth1DataMajorityVote determines the appropriate eth1data for a block proposal using
 // an algorithm called Voting with the Majority. The algorithm works as follows:
-//  - Determine the timestamp for the start slot for the eth1 voting period.
-//  - Determine the earliest and latest timestamps that a valid block can have.
-//  - Determine the first block not before the earliest timestamp. This block is the lower bound.
-//  - Determine the last block not after the latest timestamp. This block is the upper bound.
-//  - If the last block is too early, use current eth1data from the beacon state.
-//  - Filter out votes on unknown blocks and blocks which are outside of the range determined by the lower and upper bounds.
-//  - If no blocks are left after filtering votes, use eth1data from the latest valid block.
-//  - Otherwise:
-//    - Determine the vote with the highest count. Prefer the vote with the highest eth1 block height in the event of a tie.
-//    - This vote's block is the eth1 block to use for the block proposal.
+//   - Determine the timestamp for the start slot for the eth1 voting period.
+//   - Determine the earliest and latest timestamps that a valid block can have.
+//   - Determine the first block not before the earliest timestamp. This block is the lower bound.
+//   - Determine the last block not after the latest timestamp. This block is the upper bound.
+//   - If the last block is too early, use current eth1data from the beacon state.
+//   - Filter out votes on unknown blocks and blocks which are outside of the range determined by the lower and upper bounds.
+//   - If no blocks are left after filtering votes, use eth1data from the latest valid block.
+//   - Otherwise:
+//   - Determine the vote with the highest count. Prefer the vote with the highest eth1 block height in the event of a tie.
+//   - This vote's block is the eth1 block to use for the block proposal.
 func (vs *Server) eth1DataMajorityVote(ctx context.Context, beaconState iface.BeaconState) (*ethpb.Eth1Data, error) {
 	ctx, cancel := context.WithTimeout(ctx, eth1dataTimeout)
 	defer cancel()
@@ -200,9 +225,9 @@ func (vs *Server) eth1DataMajorityVote(ctx context.Context, beaconState iface.Be
 	}
 	eth1DataNotification = false
 
-	eth1FollowDistance := params.BeaconConfig().Eth1FollowDistance
-	earliestValidTime := votingPeriodStartTime - 2*params.BeaconConfig().SecondsPerETH1Block*eth1FollowDistance
-	latestValidTime := votingPeriodStartTime - params.BeaconConfig().SecondsPerETH1Block*eth1FollowDistance
+	eth1FollowDistance := params.BeaconConfigOf(vs.ChainConfig).Eth1FollowDistance
+	earliestValidTime := votingPeriodStartTime - 2*params.BeaconConfigOf(vs.ChainConfig).SecondsPerETH1Block*eth1FollowDistance
+	latestValidTime := votingPeriodStartTime - params.BeaconConfigOf(vs.ChainConfig).SecondsPerETH1Block*eth1FollowDistance
 
 	lastBlockByEarliestValidTime, err := vs.Eth1BlockFetcher.BlockByTimestamp(ctx, earliestValidTime)
 	if err != nil {
@@ -332,7 +357,7 @@ func (vs *Server) mockETH1DataVote(ctx context.Context, slot types.Slot) (*ethpb
 	//   DepositCount = state.eth1_deposit_index,
 	//   BlockHash = hash(hash(current_epoch + slot_in_voting_period)),
 	// )
-	slotInVotingPeriod := slot.ModSlot(params.BeaconConfig().SlotsPerEpoch.Mul(uint64(params.BeaconConfig().EpochsPerEth1VotingPeriod)))
+	slotInVotingPeriod := slot.ModSlot(params.BeaconConfigOf(vs.ChainConfig).SlotsPerEpoch.Mul(uint64(params.BeaconConfigOf(vs.ChainConfig).EpochsPerEth1VotingPeriod)))
 	headState, err := vs.HeadFetcher.HeadState(ctx)
 	if err != nil {
 		return nil, err
@@ -379,6 +404,7 @@ func (vs *Server) computeStateRoot(ctx context.Context, block *ethpb.SignedBeaco
 	}
 	root, err := state.CalculateStateRoot(
 		ctx,
+		vs.CommitteeCache,
 		beaconState,
 		block,
 	)
@@ -518,7 +544,7 @@ func (vs *Server) defaultEth1DataResponse(ctx context.Context, currentHeight *bi
 	if ctx.Err() != nil {
 		return nil, ctx.Err()
 	}
-	eth1FollowDistance := int64(params.BeaconConfig().Eth1FollowDistance)
+	eth1FollowDistance := int64(params.BeaconConfigOf(vs.ChainConfig).Eth1FollowDistance)
 	ancestorHeight := big.NewInt(0).Sub(currentHeight, big.NewInt(eth1FollowDistance))
 	blockHash, err := vs.Eth1BlockFetcher.BlockHashByHeight(ctx, ancestorHeight)
 	if err != nil {
@@ -546,7 +572,7 @@ func (vs *Server) filterAttestationsForBlockInclusion(ctx context.Context, st if
 	ctx, span := trace.StartSpan(ctx, "ProposerServer.filterAttestationsForBlockInclusion")
 	defer span.End()
 
-	validAtts, invalidAtts := proposerAtts(atts).filter(ctx, st)
+	validAtts, invalidAtts := proposerAtts(atts).filter(ctx, vs.CommitteeCache, st)
 	if err := vs.deleteAttsInPool(ctx, invalidAtts); err != nil {
 		return nil, err
 	}
@@ -600,7 +626,7 @@ func (vs *Server) packAttestations(ctx context.Context, latestState iface.Beacon
 
 	// If there is any room left in the block, consider unaggregated attestations as well.
 	numAtts := uint64(len(atts))
-	if numAtts < params.BeaconConfig().MaxAttestations {
+	if numAtts < params.BeaconConfigOf(vs.ChainConfig).MaxAttestations {
 		uAtts, err := vs.AttPool.UnaggregatedAttestations()
 		if err != nil {
 			return nil, errors.Wrap(err, "could not get unaggregated attestations")
@@ -63,6 +63,8 @@ type Server struct {
 	PendingDepositsFetcher depositcache.PendingDepositsFetcher
 	OperationNotifier      opfeed.Notifier
 	StateGen               *stategen.State
+	CommitteeCache         *cache.CommitteeCache
+	ChainConfig            params.ChainConfig
 }
 
 // WaitForActivation checks if a validator public key exists in the active validator registry of the current
@@ -86,7 +88,7 @@ func (vs *Server) WaitForActivation(req *ethpb.ValidatorActivationRequest, strea
 	for {
 		select {
 		// Pinging every slot for activation.
-		case <-time.After(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second):
+		case <-time.After(time.Duration(params.BeaconConfigOf(vs.ChainConfig).SecondsPerSlot) * time.Second):
 			activeValidatorExists, validatorStatuses, err := vs.activationStatus(stream.Context(), req.PublicKeys)
 			if err != nil {
 				return status.Errorf(codes.Internal, "Could not fetch validator status: %v", err)
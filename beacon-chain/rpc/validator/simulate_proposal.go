@@ -0,0 +1,199 @@
+package validator
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/mathutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SimulateBlockProposalRequest defines the proposal to simulate.
+type SimulateBlockProposalRequest struct {
+	Slot      types.Slot
+	PublicKey []byte
+}
+
+// BlockProposalSimulation is the outcome of a simulated block proposal.
+type BlockProposalSimulation struct {
+	ProposerIndex types.ValidatorIndex
+	// Block is the unsigned block the proposer would be served, with the state root of its post state.
+	Block *ethpb.BeaconBlock
+	// SlashingReward is the whistleblower reward credited to the proposer when processing the
+	// slashings of the block.
+	SlashingReward uint64
+	// InclusionReward is the reward for the attestations first included by the block, which is
+	// credited to the proposer at the end of the epoch after the attestations.
+	InclusionReward uint64
+	// ExpectedReward is the sum of the slashing and inclusion rewards.
+	ExpectedReward uint64
+}
+
+// SimulateBlockProposal checks that the validator is the proposer of the slot, builds the block it
+// would be served, and runs it through the state transition without signatures. This allows the
+// orchestrator to validate a proposal before pairing it with a Pandora shard block.
+func (vs *Server) SimulateBlockProposal(ctx context.Context, req *SimulateBlockProposalRequest) (*BlockProposalSimulation, error) {
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.SimulateBlockProposal")
+	defer span.End()
+	span.AddAttributes(trace.Int64Attribute("slot", int64(req.Slot)))
+
+	if vs.SyncChecker.Syncing() {
+		return nil, status.Errorf(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+	if headSlot := vs.HeadFetcher.HeadSlot(); req.Slot <= headSlot {
+		return nil, status.Errorf(codes.InvalidArgument, "Cannot simulate a proposal at slot %d, which is not after the head slot %d", req.Slot, headSlot)
+	}
+	// Proposers are only determined within the epoch after the current one.
+	if currentEpoch := helpers.SlotToEpoch(vs.TimeFetcher.CurrentSlot()); helpers.SlotToEpoch(req.Slot) > currentEpoch+1 {
+		return nil, status.Errorf(codes.InvalidArgument, "Cannot simulate a proposal beyond the epoch after the current epoch %d", currentEpoch)
+	}
+
+	head, parentRoot, err := vs.proposalState(ctx, req.Slot)
+	if err != nil {
+		return nil, err
+	}
+	validatorIndex, ok := head.ValidatorIndexByPubkey(bytesutil.ToBytes48(req.PublicKey))
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "Could not find validator index for public key %#x", req.PublicKey)
+	}
+	// The proposer index is served from the proposer indices cache of the advanced state.
+	proposerIndex, err := helpers.BeaconProposerIndex(vs.CommitteeCache, head)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not calculate proposer index %v", err)
+	}
+	if proposerIndex != validatorIndex {
+		return nil, status.Errorf(codes.FailedPrecondition, "Validator %d is not the proposer of slot %d, validator %d is", validatorIndex, req.Slot, proposerIndex)
+	}
+
+	blk, err := vs.buildBlock(ctx, head, parentRoot, req.Slot, make([]byte, params.BeaconConfigOf(vs.ChainConfig).BLSSignatureLength), nil)
+	if err != nil {
+		return nil, err
+	}
+	preBalance, err := head.BalanceAtIndex(proposerIndex)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get proposer balance: %v", err)
+	}
+	inclusionReward, err := attestationInclusionReward(vs.CommitteeCache, head, blk.Body.Attestations)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute attestation inclusion reward: %v", err)
+	}
+	_, post, err := state.ProcessBlockNoVerifyAnySig(ctx, vs.CommitteeCache, head.Copy(), &ethpb.SignedBeaconBlock{
+		Block:     blk,
+		Signature: make([]byte, params.BeaconConfigOf(vs.ChainConfig).BLSSignatureLength),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Simulated block failed the state transition: %v", err)
+	}
+	stateRoot, err := post.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute state root: %v", err)
+	}
+	blk.StateRoot = stateRoot[:]
+	postBalance, err := post.BalanceAtIndex(proposerIndex)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get proposer balance: %v", err)
+	}
+
+	res := &BlockProposalSimulation{
+		ProposerIndex:   proposerIndex,
+		Block:           blk,
+		InclusionReward: inclusionReward,
+	}
+	if postBalance > preBalance {
+		res.SlashingReward = postBalance - preBalance
+	}
+	res.ExpectedReward = res.SlashingReward + res.InclusionReward
+	return res, nil
+}
+
+// attestationInclusionReward computes the proposer reward for the attestations of a block on the
+// state at the slot of the block. Only the first inclusion of an attester earns the reward, so
+// attesters already included in the pending attestations of the state are skipped.
+func attestationInclusionReward(committeeCache *cache.CommitteeCache, st iface.BeaconState, atts []*ethpb.Attestation) (uint64, error) {
+	if len(atts) == 0 {
+		return 0, nil
+	}
+	currentEpoch := helpers.CurrentEpoch(st)
+	included := map[types.Epoch]map[types.ValidatorIndex]bool{
+		currentEpoch:          make(map[types.ValidatorIndex]bool),
+		helpers.PrevEpoch(st): make(map[types.ValidatorIndex]bool),
+	}
+	attestingIndices := func(data *ethpb.AttestationData, bits bitfield.Bitlist) ([]uint64, error) {
+		committee, err := helpers.BeaconCommitteeFromState(committeeCache, st, data.Slot, data.CommitteeIndex)
+		if err != nil {
+			return nil, err
+		}
+		return attestationutil.AttestingIndices(bits, committee)
+	}
+	pendingSets := []func() ([]*pbp2p.PendingAttestation, error){st.CurrentEpochAttestations, st.PreviousEpochAttestations}
+	for _, pendingSet := range pendingSets {
+		pending, err := pendingSet()
+		if err != nil {
+			return 0, err
+		}
+		for _, att := range pending {
+			if att.Data == nil || att.Data.Target == nil || included[att.Data.Target.Epoch] == nil {
+				continue
+			}
+			indices, err := attestingIndices(att.Data, att.AggregationBits)
+			if err != nil {
+				return 0, err
+			}
+			for _, idx := range indices {
+				included[att.Data.Target.Epoch][types.ValidatorIndex(idx)] = true
+			}
+		}
+	}
+
+	totalBalance, err := helpers.TotalActiveBalance(st)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not calculate active balance")
+	}
+	balanceSqrt := mathutil.IntegerSquareRoot(totalBalance)
+	// Balance square root cannot be 0, this prevents division by 0.
+	if balanceSqrt == 0 {
+		balanceSqrt = 1
+	}
+	cfg := params.BeaconConfig()
+	var reward uint64
+	for _, att := range atts {
+		if att.Data == nil || att.Data.Target == nil || included[att.Data.Target.Epoch] == nil {
+			continue
+		}
+		indices, err := attestingIndices(att.Data, att.AggregationBits)
+		if err != nil {
+			return 0, err
+		}
+		for _, i := range indices {
+			idx := types.ValidatorIndex(i)
+			if included[att.Data.Target.Epoch][idx] {
+				continue
+			}
+			included[att.Data.Target.Epoch][idx] = true
+			val, err := st.ValidatorAtIndexReadOnly(idx)
+			if err != nil {
+				return 0, err
+			}
+			// Slashed attesters do not earn their proposer an inclusion reward.
+			if val.Slashed() {
+				continue
+			}
+			baseReward := val.EffectiveBalance() * cfg.BaseRewardFactor / balanceSqrt / cfg.BaseRewardsPerEpoch
+			reward += baseReward / cfg.ProposerRewardQuotient
+		}
+	}
+	return reward, nil
+}
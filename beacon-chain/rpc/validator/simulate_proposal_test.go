@@ -0,0 +1,94 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+func TestProposer_SimulateBlockProposal(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	ctx := context.Background()
+
+	testutil.ResetCache()
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+
+	stateRoot, err := beaconState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis := b.NewGenesisBlock(stateRoot[:])
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	parentRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, beaconState, parentRoot))
+
+	advanced, err := state.ProcessSlots(ctx, nil, beaconState.Copy(), 1)
+	require.NoError(t, err)
+	proposerIndex, err := helpers.BeaconProposerIndex(nil, advanced)
+	require.NoError(t, err)
+	proposerKey := privKeys[proposerIndex].PublicKey().Marshal()
+	otherKey := privKeys[(proposerIndex+1)%64].PublicKey().Marshal()
+
+	newServer := func() *Server {
+		return &Server{
+			BeaconDB:          db,
+			HeadFetcher:       &mock.ChainService{State: beaconState.Copy(), Root: parentRoot[:]},
+			TimeFetcher:       &mock.ChainService{Genesis: timeutils.Now()},
+			SyncChecker:       &mockSync.Sync{IsSyncing: false},
+			ChainStartFetcher: &mockPOW.POWChain{},
+			Eth1InfoFetcher:   &mockPOW.POWChain{},
+			Eth1BlockFetcher:  &mockPOW.POWChain{},
+			MockEth1Votes:     true,
+			AttPool:           attestations.NewPool(),
+			SlashingsPool:     slashings.NewPool(),
+			ExitPool:          voluntaryexits.NewPool(),
+			StateGen:          stategen.New(db),
+		}
+	}
+
+	t.Run("not the proposer", func(t *testing.T) {
+		_, err := newServer().SimulateBlockProposal(ctx, &SimulateBlockProposalRequest{Slot: 1, PublicKey: otherKey})
+		assert.ErrorContains(t, "is not the proposer of slot 1", err)
+	})
+
+	t.Run("slot not after head", func(t *testing.T) {
+		_, err := newServer().SimulateBlockProposal(ctx, &SimulateBlockProposalRequest{Slot: 0, PublicKey: proposerKey})
+		assert.ErrorContains(t, "not after the head slot", err)
+	})
+
+	t.Run("proposer", func(t *testing.T) {
+		server := newServer()
+		slashedIndex := (proposerIndex + 2) % 64
+		slashing, err := testutil.GenerateProposerSlashingForValidator(beaconState, privKeys[slashedIndex], slashedIndex)
+		require.NoError(t, err)
+		server.SlashingsPool = &slashings.PoolMock{PendingPropSlashings: []*ethpb.ProposerSlashing{slashing}}
+
+		res, err := server.SimulateBlockProposal(ctx, &SimulateBlockProposalRequest{Slot: 1, PublicKey: proposerKey})
+		require.NoError(t, err)
+		assert.Equal(t, proposerIndex, res.ProposerIndex)
+		assert.Equal(t, proposerIndex, res.Block.ProposerIndex)
+		assert.DeepEqual(t, parentRoot[:], res.Block.ParentRoot)
+		require.Equal(t, 1, len(res.Block.Body.ProposerSlashings))
+		assert.NotEqual(t, uint64(0), res.SlashingReward)
+		assert.Equal(t, res.SlashingReward+res.InclusionReward, res.ExpectedReward)
+		assert.DeepNotEqual(t, params.BeaconConfig().ZeroHash[:], res.Block.StateRoot)
+	})
+}
@@ -0,0 +1,143 @@
+package stategen
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// CanonicalRootFetcher looks up the roots of the canonical blocks of recent slots, along the chain
+// of the head. It is implemented by the blockchain service, whose index returns an error for the
+// slots it does not cover.
+type CanonicalRootFetcher interface {
+	HighestCanonicalRootAtOrBelow(slot types.Slot) ([32]byte, types.Slot, error)
+}
+
+// EnableCanonicalRootIndex lets CanonicalStateInEpoch resolve the canonical blocks of the hot
+// section from the chain of the head. Without it, the hot section falls back to the only block
+// saved at or before a slot, as StateBySlot does.
+func (s *State) EnableCanonicalRootIndex(fetcher CanonicalRootFetcher) {
+	s.canonicalRoots = fetcher
+}
+
+// CanonicalStateInEpoch returns the epoch boundary state of an epoch on the canonical chain: the
+// post state of the latest canonical block at or before the start slot of the epoch, advanced
+// through the skipped slots up to the start slot. Unlike StateBySlot, the block is never taken from
+// a fork orphaned by a reorg, nor from a slot after the epoch start.
+//
+// Canonical blocks are resolved from the canonical root index for recent slots, from the ancestors
+// of the finalized block for finalized slots, and from the blocks saved in the DB otherwise.
+func (s *State) CanonicalStateInEpoch(ctx context.Context, epoch types.Epoch) (iface.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.CanonicalStateInEpoch")
+	defer span.End()
+	span.AddAttributes(trace.Int64Attribute("epoch", int64(epoch)))
+
+	slot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, err
+	}
+	if slot == 0 {
+		return s.beaconDB.GenesisState(ctx)
+	}
+	root, _, err := s.CanonicalBlockAtOrBelow(ctx, slot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get canonical block at or before slot %d", slot)
+	}
+	st, err := s.StateByRoot(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	if st == nil {
+		return nil, errUnknownState
+	}
+	if st.Slot() > slot {
+		return nil, errors.Errorf("canonical state at slot %d is after epoch start slot %d", st.Slot(), slot)
+	}
+	if st.Slot() < slot {
+		// The regenerated state may be shared with the state caches, so it is copied before being advanced.
+		st, err = processSlotsStateGen(ctx, s.committeeCache, st.Copy(), slot)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return st, nil
+}
+
+// CanonicalBlockAtOrBelow returns the root and slot of the latest canonical block at or before a
+// slot. Unindexed slots of the hot section have no chain to follow, so an error is returned when
+// several blocks were saved at the highest slot, rather than picking one of them.
+func (s *State) CanonicalBlockAtOrBelow(ctx context.Context, slot types.Slot) ([32]byte, types.Slot, error) {
+	if s.canonicalRoots != nil {
+		root, blkSlot, err := s.canonicalRoots.HighestCanonicalRootAtOrBelow(slot)
+		if err == nil {
+			return root, blkSlot, nil
+		}
+	}
+
+	s.finalizedInfo.lock.RLock()
+	fSlot := s.finalizedInfo.slot
+	fRoot := s.finalizedInfo.root
+	fState := s.finalizedInfo.state
+	s.finalizedInfo.lock.RUnlock()
+
+	// Slots of the hot section which are not indexed have no chain to follow, so the saved block is
+	// only taken when no other fork saved a block at its slot.
+	if slot >= fSlot {
+		root, blkSlot, err := s.lastSavedBlock(ctx, slot)
+		if err != nil {
+			return [32]byte{}, 0, err
+		}
+		_, roots, err := s.beaconDB.BlockRootsBySlot(ctx, blkSlot)
+		if err != nil {
+			return [32]byte{}, 0, err
+		}
+		if len(roots) > 1 {
+			return [32]byte{}, 0, errors.Errorf("%d competing blocks saved at slot %d", len(roots), blkSlot)
+		}
+		return root, blkSlot, nil
+	}
+
+	// Finalized slots are resolved from the ancestors of the finalized block. The finalized state
+	// records the roots of the last SlotsPerHistoricalRoot slots, which shortens the walk.
+	start := fRoot
+	if fState != nil && fState.Slot() > slot {
+		size := params.BeaconConfig().SlotsPerHistoricalRoot
+		idx := slot
+		if fState.Slot() > size && idx < fState.Slot()-size {
+			idx = fState.Slot() - size
+		}
+		r, err := fState.BlockRootAtIndex(uint64(idx % size))
+		if err != nil {
+			return [32]byte{}, 0, err
+		}
+		start = bytesutil.ToBytes32(r)
+	}
+	return s.ancestorRootAtOrBelow(ctx, start, slot)
+}
+
+// ancestorRootAtOrBelow walks back the parents of a block until it reaches a block at or before
+// the slot, and returns its root and slot.
+func (s *State) ancestorRootAtOrBelow(ctx context.Context, root [32]byte, slot types.Slot) ([32]byte, types.Slot, error) {
+	for {
+		if ctx.Err() != nil {
+			return [32]byte{}, 0, ctx.Err()
+		}
+		b, err := s.beaconDB.Block(ctx, root)
+		if err != nil {
+			return [32]byte{}, 0, err
+		}
+		if b == nil || b.Block == nil {
+			return [32]byte{}, 0, errUnknownBlock
+		}
+		if b.Block.Slot <= slot {
+			return root, b.Block.Slot, nil
+		}
+		root = bytesutil.ToBytes32(b.Block.ParentRoot)
+	}
+}
@@ -0,0 +1,147 @@
+package stategen
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type mockCanonicalRoots struct {
+	root [32]byte
+	slot types.Slot
+}
+
+func (m *mockCanonicalRoots) HighestCanonicalRootAtOrBelow(slot types.Slot) ([32]byte, types.Slot, error) {
+	if slot < m.slot {
+		return [32]byte{}, 0, errors.New("not indexed")
+	}
+	return m.root, m.slot, nil
+}
+
+// setupForkedChain saves a genesis state and two competing children of the genesis block: a
+// canonical block at slot 1 and an orphaned block at slot 5.
+func setupForkedChain(t *testing.T, service *State) (iface.BeaconState, [32]byte, [32]byte) {
+	ctx := context.Background()
+	genesis, keys := testutil.DeterministicGenesisState(t, 64)
+	stateRoot, err := genesis.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesisBlk := blocks.NewGenesisBlock(stateRoot[:])
+	require.NoError(t, service.beaconDB.SaveBlock(ctx, genesisBlk))
+	genesisRoot, err := genesisBlk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, service.beaconDB.SaveState(ctx, genesis, genesisRoot))
+	require.NoError(t, service.beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+
+	canonical, err := testutil.GenerateFullBlock(genesis.Copy(), keys, testutil.DefaultBlockGenConfig(), 1)
+	require.NoError(t, err)
+	require.NoError(t, service.beaconDB.SaveBlock(ctx, canonical))
+	canonicalRoot, err := canonical.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	orphaned, err := testutil.GenerateFullBlock(genesis.Copy(), keys, testutil.DefaultBlockGenConfig(), 5)
+	require.NoError(t, err)
+	require.NoError(t, service.beaconDB.SaveBlock(ctx, orphaned))
+	orphanedRoot, err := orphaned.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	canonicalState, err := state.ExecuteStateTransition(ctx, nil, genesis.Copy(), canonical)
+	require.NoError(t, err)
+	return canonicalState, canonicalRoot, orphanedRoot
+}
+
+func TestCanonicalStateInEpoch_Genesis(t *testing.T) {
+	ctx := context.Background()
+	service := New(testDB.SetupDB(t))
+	setupForkedChain(t, service)
+
+	st, err := service.CanonicalStateInEpoch(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(0), st.Slot())
+}
+
+func TestCanonicalStateInEpoch_SkipsOrphanedBlocks(t *testing.T) {
+	ctx := context.Background()
+	service := New(testDB.SetupDB(t))
+	_, canonicalRoot, orphanedRoot := setupForkedChain(t, service)
+	service.EnableCanonicalRootIndex(&mockCanonicalRoots{root: canonicalRoot, slot: 1})
+
+	// Without the index, the orphaned block is the latest block saved before the epoch start.
+	startSlot, err := helpers.StartSlot(1)
+	require.NoError(t, err)
+	root, _, err := service.lastSavedBlock(ctx, startSlot)
+	require.NoError(t, err)
+	assert.Equal(t, orphanedRoot, root)
+
+	st, err := service.CanonicalStateInEpoch(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, startSlot, st.Slot())
+	assert.Equal(t, types.Slot(1), st.LatestBlockHeader().Slot)
+}
+
+func TestCanonicalBlockAtOrBelow_CompetingBlocks(t *testing.T) {
+	ctx := context.Background()
+	service := New(testDB.SetupDB(t))
+	_, _, orphanedRoot := setupForkedChain(t, service)
+
+	root, slot, err := service.CanonicalBlockAtOrBelow(ctx, 6)
+	require.NoError(t, err)
+	assert.Equal(t, orphanedRoot, root)
+	assert.Equal(t, types.Slot(5), slot)
+
+	// Without the index, a block competing for the highest slot leaves the canonical block unknown.
+	competing := testutil.NewBeaconBlock()
+	competing.Block.Slot = 5
+	require.NoError(t, service.beaconDB.SaveBlock(ctx, competing))
+	_, _, err = service.CanonicalBlockAtOrBelow(ctx, 6)
+	assert.ErrorContains(t, "2 competing blocks saved at slot 5", err)
+}
+
+func TestCanonicalStateInEpoch_FinalizedAncestors(t *testing.T) {
+	ctx := context.Background()
+	service := New(testDB.SetupDB(t))
+	canonicalState, canonicalRoot, _ := setupForkedChain(t, service)
+	startSlot, err := helpers.StartSlot(1)
+	require.NoError(t, err)
+
+	// The finalized state is past the epoch start, and records the canonical block at slot 1.
+	finalizedState, err := state.ProcessSlots(ctx, nil, canonicalState.Copy(), startSlot+2)
+	require.NoError(t, err)
+	service.SaveFinalizedState(startSlot+2, [32]byte{'f'}, finalizedState)
+	r, err := finalizedState.BlockRootAtIndex(uint64(startSlot))
+	require.NoError(t, err)
+	require.Equal(t, canonicalRoot, bytesutil.ToBytes32(r))
+
+	st, err := service.CanonicalStateInEpoch(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, startSlot, st.Slot())
+	assert.Equal(t, types.Slot(1), st.LatestBlockHeader().Slot)
+}
+
+func TestCanonicalStateInEpoch_DoesNotMutateCachedState(t *testing.T) {
+	ctx := context.Background()
+	service := New(testDB.SetupDB(t))
+	canonicalState, canonicalRoot, _ := setupForkedChain(t, service)
+	require.NoError(t, service.epochBoundaryStateCache.put(canonicalRoot, canonicalState))
+	service.EnableCanonicalRootIndex(&mockCanonicalRoots{root: canonicalRoot, slot: 1})
+
+	st, err := service.CanonicalStateInEpoch(ctx, 1)
+	require.NoError(t, err)
+	startSlot, err := helpers.StartSlot(1)
+	require.NoError(t, err)
+	assert.Equal(t, startSlot, st.Slot())
+	cached, ok, err := service.epochBoundaryStateCache.getByRoot(canonicalRoot)
+	require.NoError(t, err)
+	require.Equal(t, true, ok)
+	assert.Equal(t, types.Slot(1), cached.state.Slot())
+}
@@ -0,0 +1,83 @@
+package stategen
+
+import (
+	"context"
+	"encoding/hex"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// ColdStateStore is a read-optimized store for finalized archived point states, kept outside
+// of the DB so that the DB only holds the states of the hot section.
+type ColdStateStore interface {
+	HasState(ctx context.Context, blockRoot [32]byte) bool
+	State(ctx context.Context, blockRoot [32]byte) (iface.BeaconState, error)
+	SaveState(ctx context.Context, st iface.ReadOnlyBeaconState, blockRoot [32]byte) error
+	HighestSlotStateBelow(ctx context.Context, slot types.Slot) ([32]byte, types.Slot, bool)
+}
+
+// EnableColdStateStore moves the archived point states migrated by MigrateToCold into the cold
+// state store, including the ones already saved in the DB. States are looked up in the cold state
+// store first and then in the DB, so archived point states finalized before the cold state store
+// was enabled remain readable.
+func (s *State) EnableColdStateStore(store ColdStateStore) {
+	s.coldStore = store
+}
+
+// ColdStateStoreEnabled returns true if archived point states are moved into a cold state store.
+func (s *State) ColdStateStoreEnabled() bool {
+	return s.coldStore != nil
+}
+
+// hasColdState returns true if the state of the block root is in the cold state store.
+func (s *State) hasColdState(ctx context.Context, blockRoot [32]byte) bool {
+	return s.coldStore != nil && s.coldStore.HasState(ctx, blockRoot)
+}
+
+// coldState retrieves the state of the block root from the cold state store.
+func (s *State) coldState(ctx context.Context, blockRoot [32]byte) (iface.BeaconState, error) {
+	coldStateReadCount.Inc()
+	return s.coldStore.State(ctx, blockRoot)
+}
+
+// moveStateToCold writes the state of the block root saved in the DB to the cold state store, then
+// deletes it from the DB. The genesis and finalized states are kept in the DB as well, as the DB
+// guards them against deletion.
+func (s *State) moveStateToCold(ctx context.Context, blockRoot, fRoot [32]byte) error {
+	if !s.coldStore.HasState(ctx, blockRoot) {
+		st, err := s.beaconDB.State(ctx, blockRoot)
+		if err != nil {
+			return err
+		}
+		if st == nil {
+			return errUnknownState
+		}
+		if err := s.coldStore.SaveState(ctx, st, blockRoot); err != nil {
+			return err
+		}
+	}
+
+	if blockRoot == fRoot {
+		return nil
+	}
+	genesis, err := s.beaconDB.GenesisBlock(ctx)
+	if err != nil {
+		return err
+	}
+	if genesis != nil && genesis.Block != nil {
+		gRoot, err := genesis.Block.HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		if blockRoot == gRoot {
+			return nil
+		}
+	}
+	if err := s.beaconDB.DeleteState(ctx, blockRoot); err != nil {
+		return err
+	}
+	log.WithField("root", hex.EncodeToString(bytesutil.Trunc(blockRoot[:]))).Info("Moved state from DB to cold state store")
+	return nil
+}
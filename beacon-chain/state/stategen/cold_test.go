@@ -0,0 +1,165 @@
+package stategen
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/freezer"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func setupColdStateStore(t *testing.T) *freezer.Store {
+	store, err := freezer.Open(filepath.Join(t.TempDir(), freezer.DirName), 0)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, store.Close())
+	})
+	return store
+}
+
+func TestMigrateToCold_ColdStateStore(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+
+	service := New(beaconDB)
+	coldStore := setupColdStateStore(t)
+	service.EnableColdStateStore(coldStore)
+	service.slotsPerArchivedPoint = 1
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	require.NoError(t, beaconState.SetSlot(1))
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 2
+	fRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, service.beaconDB.SaveBlock(ctx, b))
+	require.NoError(t, service.epochBoundaryStateCache.put(fRoot, beaconState))
+	require.NoError(t, service.MigrateToCold(ctx, fRoot))
+
+	assert.Equal(t, false, beaconDB.HasState(ctx, fRoot), "Archived point state saved in DB")
+	gotState, err := coldStore.State(ctx, fRoot)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, beaconState.InnerStateUnsafe(), gotState.InnerStateUnsafe(), "Did not save state")
+	has, err := service.HasState(ctx, fRoot)
+	require.NoError(t, err)
+	assert.Equal(t, true, has)
+
+	require.LogsContain(t, hook, "Saved state in cold state store")
+	require.LogsDoNotContain(t, hook, "Saved state in DB")
+}
+
+func TestMigrateToCold_MovesDBStateToColdStateStore(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+
+	service := New(beaconDB)
+	coldStore := setupColdStateStore(t)
+	service.EnableColdStateStore(coldStore)
+	service.slotsPerArchivedPoint = 1
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	require.NoError(t, beaconState.SetSlot(1))
+	b1 := testutil.NewBeaconBlock()
+	b1.Block.Slot = 1
+	r1, err := b1.Block.HashTreeRoot()
+	require.NoError(t, err)
+	b2 := testutil.NewBeaconBlock()
+	b2.Block.Slot = 2
+	b2.Block.ParentRoot = r1[:]
+	fRoot, err := b2.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, service.beaconDB.SaveBlock(ctx, b1))
+	require.NoError(t, service.beaconDB.SaveBlock(ctx, b2))
+	require.NoError(t, service.beaconDB.SaveState(ctx, beaconState, r1))
+	require.NoError(t, service.epochBoundaryStateCache.put(r1, beaconState))
+	service.saveHotStateDB.savedStateRoots = [][32]byte{r1}
+
+	// The archived point state saved in the DB is moved to the cold state store.
+	require.NoError(t, service.MigrateToCold(ctx, fRoot))
+	assert.Equal(t, false, beaconDB.HasState(ctx, r1), "Archived point state kept in DB")
+	assert.Equal(t, 0, len(service.saveHotStateDB.savedStateRoots))
+	gotState, err := coldStore.State(ctx, r1)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, beaconState.InnerStateUnsafe(), gotState.InnerStateUnsafe(), "Did not move state")
+	service.epochBoundaryStateCache = newBoundaryStateCache()
+	gotState, err = service.StateByRoot(ctx, r1)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, beaconState.InnerStateUnsafe(), gotState.InnerStateUnsafe(), "Did not read moved state")
+	require.LogsContain(t, hook, "Moved state from DB to cold state store")
+}
+
+func TestLastAncestorState_CanGetUsingColdStateStore(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB)
+	coldStore := setupColdStateStore(t)
+	service.EnableColdStateStore(coldStore)
+
+	b0 := testutil.NewBeaconBlock()
+	b0.Block.ParentRoot = bytesutil.PadTo([]byte{'a'}, 32)
+	r0, err := b0.Block.HashTreeRoot()
+	require.NoError(t, err)
+	b1 := testutil.NewBeaconBlock()
+	b1.Block.Slot = 1
+	b1.Block.ParentRoot = bytesutil.PadTo(r0[:], 32)
+	r1, err := b1.Block.HashTreeRoot()
+	require.NoError(t, err)
+	b2 := testutil.NewBeaconBlock()
+	b2.Block.Slot = 2
+	b2.Block.ParentRoot = bytesutil.PadTo(r1[:], 32)
+	r2, err := b2.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	b0State, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	b1State, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, b1State.SetSlot(1))
+
+	require.NoError(t, service.beaconDB.SaveBlock(ctx, b0))
+	require.NoError(t, service.beaconDB.SaveBlock(ctx, b1))
+	require.NoError(t, service.beaconDB.SaveBlock(ctx, b2))
+	require.NoError(t, service.beaconDB.SaveState(ctx, b0State, r0))
+	require.NoError(t, coldStore.SaveState(ctx, b1State, r1))
+
+	lastState, err := service.lastAncestorState(ctx, r2)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(1), lastState.Slot(), "Did not get wanted state")
+
+	loadedState, err := service.loadStateByRoot(ctx, r1)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(1), loadedState.Slot(), "Did not get wanted state")
+}
+
+func TestLastSavedState_OnlyInColdStateStore(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB)
+	service.finalizedInfo = &finalizedInfo{slot: 128}
+	coldStore := setupColdStateStore(t)
+
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 127
+	require.NoError(t, service.beaconDB.SaveBlock(ctx, b))
+	r, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(127))
+	require.NoError(t, coldStore.SaveState(ctx, st, r))
+
+	// The DB holds no state, so the state is unknown until the cold state store is consulted.
+	_, err = service.lastSavedState(ctx, 129)
+	assert.ErrorContains(t, errUnknownState.Error(), err)
+	service.EnableColdStateStore(coldStore)
+	savedState, err := service.lastSavedState(ctx, 129)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(127), savedState.Slot())
+}
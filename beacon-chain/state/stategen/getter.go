@@ -9,6 +9,7 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
 
@@ -21,7 +22,7 @@ func (s *State) HasState(ctx context.Context, blockRoot [32]byte) (bool, error)
 	if has {
 		return true, nil
 	}
-	return s.beaconDB.HasState(ctx, blockRoot), nil
+	return s.hasColdState(ctx, blockRoot) || s.beaconDB.HasState(ctx, blockRoot), nil
 }
 
 // HasStateInCache returns true if the state exists in cache.
@@ -105,6 +106,7 @@ func (s *State) StateByRootInitialSync(ctx context.Context, blockRoot [32]byte)
 func (s *State) StateBySlot(ctx context.Context, slot types.Slot) (iface.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "stateGen.StateBySlot")
 	defer span.End()
+	span.AddAttributes(trace.Int64Attribute("slot", int64(slot)))
 
 	return s.loadStateBySlot(ctx, slot)
 }
@@ -150,6 +152,7 @@ func (s *State) loadStateByRoot(ctx context.Context, blockRoot [32]byte) (iface.
 	// First, it checks if the state exists in hot state cache.
 	cachedState := s.hotStateCache.get(blockRoot)
 	if cachedState != nil {
+		span.AddAttributes(trace.StringAttribute("source", "hotStateCache"))
 		return cachedState, nil
 	}
 
@@ -159,11 +162,18 @@ func (s *State) loadStateByRoot(ctx context.Context, blockRoot [32]byte) (iface.
 		return nil, err
 	}
 	if ok {
+		span.AddAttributes(trace.StringAttribute("source", "epochBoundaryStateCache"))
 		return cachedInfo.state, nil
 	}
 
+	// Finalized archived point states are migrated to the cold state store.
+	if s.hasColdState(ctx, blockRoot) {
+		span.AddAttributes(trace.StringAttribute("source", "coldStore"))
+		return s.coldState(ctx, blockRoot)
+	}
 	// Short cut if the cachedState is already in the DB.
 	if s.beaconDB.HasState(ctx, blockRoot) {
+		span.AddAttributes(trace.StringAttribute("source", "db"))
 		return s.beaconDB.State(ctx, blockRoot)
 	}
 
@@ -194,6 +204,10 @@ func (s *State) loadStateByRoot(ctx context.Context, blockRoot [32]byte) (iface.
 	}
 
 	replayBlockCount.Observe(float64(len(blks)))
+	span.AddAttributes(
+		trace.StringAttribute("source", "replay"),
+		trace.Int64Attribute("replayedBlocks", int64(len(blks))),
+	)
 
 	return s.ReplayBlocks(ctx, startState, blks, targetSlot)
 }
@@ -213,14 +227,31 @@ func (s *State) loadStateBySlot(ctx context.Context, slot types.Slot) (iface.Bea
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get last valid block for hot state using slot")
 	}
-
-	replayStartState, err := s.loadStateByRoot(ctx, lastValidRoot)
+	strategy := s.replayStrategy(slot)
+	span.AddAttributes(
+		trace.Int64Attribute("lastValidSlot", int64(lastValidSlot)),
+		trace.Int64Attribute("processedSlots", int64(slot-lastValidSlot)),
+		trace.StringAttribute("strategy", string(strategy)),
+	)
+	log.WithFields(logrus.Fields{
+		"slot":          slot,
+		"lastValidSlot": lastValidSlot,
+		"strategy":      strategy,
+	}).Trace("Regenerating state by slot")
+
+	var replayStartState iface.BeaconState
+	if strategy == snapshotReplay {
+		replayStartState, err = s.loadStateFromSnapshot(ctx, lastValidRoot, lastValidSlot)
+	} else {
+		replayStartState, err = s.loadStateByRoot(ctx, lastValidRoot)
+	}
 	if err != nil {
 		return nil, err
 	}
+	stateRegenerations.WithLabelValues(string(strategy)).Inc()
 
 	if lastValidSlot < slot {
-		replayStartState, err = processSlotsStateGen(ctx, replayStartState, slot)
+		replayStartState, err = processSlotsStateGen(ctx, s.committeeCache, replayStartState, slot)
 		if err != nil {
 			return nil, err
 		}
@@ -232,10 +263,11 @@ func (s *State) loadStateBySlot(ctx context.Context, slot types.Slot) (iface.Bea
 // It recursively look up block's parent until a corresponding state of the block root
 // is found in the caches or DB.
 //
-// There's three ways to derive block parent state:
+// There's four ways to derive block parent state:
 // 1.) block parent state is the last finalized state
 // 2.) block parent state is the epoch boundary state and exists in epoch boundary cache.
 // 3.) block parent state is in DB.
+// 4.) block parent state is in cold state store.
 func (s *State) lastAncestorState(ctx context.Context, root [32]byte) (iface.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "stateGen.lastAncestorState")
 	defer span.End()
@@ -281,6 +313,11 @@ func (s *State) lastAncestorState(ctx context.Context, root [32]byte) (iface.Bea
 			return cachedInfo.state, nil
 		}
 
+		// Does the state exist in cold state store.
+		if s.hasColdState(ctx, parentRoot) {
+			return s.coldState(ctx, parentRoot)
+		}
+
 		// Does the state exists in DB.
 		if s.beaconDB.HasState(ctx, parentRoot) {
 			return s.beaconDB.State(ctx, parentRoot)
@@ -13,4 +13,17 @@ var (
 			Buckets: []float64{64, 256, 1024, 2048, 4096},
 		},
 	)
+	coldStateReadCount = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "cold_state_reads_total",
+			Help: "The number of states read from the cold state store",
+		},
+	)
+	stateRegenerations = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "state_regenerations_by_slot_total",
+			Help: "The number of states regenerated by slot, by replay strategy",
+		},
+		[]string{"strategy"},
+	)
 )
@@ -71,7 +71,7 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 				aRoot = missingRoot
 				// There's no need to generate the state if the state already exists on the DB.
 				// We can skip saving the state.
-				if !s.beaconDB.HasState(ctx, aRoot) {
+				if !s.beaconDB.HasState(ctx, aRoot) && !s.hasColdState(ctx, aRoot) {
 					aState, err = s.StateByRoot(ctx, missingRoot)
 					if err != nil {
 						return err
@@ -92,6 +92,28 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 					}
 				}
 				s.saveHotStateDB.lock.Unlock()
+				// Archived point states already in the DB are moved to the cold state store when enabled.
+				if s.coldStore != nil {
+					if err := s.moveStateToCold(ctx, aRoot, fRoot); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+
+			// Archived point states are kept in the cold state store rather than the DB when enabled.
+			if s.coldStore != nil {
+				if s.coldStore.HasState(ctx, aRoot) {
+					continue
+				}
+				if err := s.coldStore.SaveState(ctx, aState, aRoot); err != nil {
+					return err
+				}
+				log.WithFields(
+					logrus.Fields{
+						"slot": aState.Slot(),
+						"root": hex.EncodeToString(bytesutil.Trunc(aRoot[:])),
+					}).Info("Saved state in cold state store")
 				continue
 			}
 
@@ -5,6 +5,7 @@ import (
 
 	types "github.com/prysmaticlabs/eth2-types"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	ethereum_beacon_p2p_v1 "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 )
@@ -82,6 +83,20 @@ func (m *MockStateManager) StateBySlot(ctx context.Context, slot types.Slot) (if
 	return m.StatesBySlot[slot], nil
 }
 
+// CanonicalStateInEpoch --
+func (m *MockStateManager) CanonicalStateInEpoch(ctx context.Context, epoch types.Epoch) (iface.BeaconState, error) {
+	slot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, err
+	}
+	return m.StatesBySlot[slot], nil
+}
+
+// CanonicalBlockAtOrBelow --
+func (m *MockStateManager) CanonicalBlockAtOrBelow(ctx context.Context, slot types.Slot) ([32]byte, types.Slot, error) {
+	panic("implement me")
+}
+
 // RecoverStateSummary --
 func (m *MockStateManager) RecoverStateSummary(
 	ctx context.Context,
@@ -7,6 +7,7 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	transition "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
@@ -14,6 +15,12 @@ import (
 	"go.opencensus.io/trace"
 )
 
+// EnableCommitteeCache lets the replayed state transitions read and fill the committee cache of the
+// node. Without it, the committees of the replayed blocks are computed for every block.
+func (s *State) EnableCommitteeCache(committeeCache *cache.CommitteeCache) {
+	s.committeeCache = committeeCache
+}
+
 // ReplayBlocks replays the input blocks on the input state until the target slot is reached.
 func (s *State) ReplayBlocks(
 	ctx context.Context,
@@ -38,7 +45,7 @@ func (s *State) ReplayBlocks(
 			if state.Slot() >= signed[i].Block.Slot {
 				continue
 			}
-			state, err = executeStateTransitionStateGen(ctx, state, signed[i])
+			state, err = executeStateTransitionStateGen(ctx, s.committeeCache, state, signed[i])
 			if err != nil {
 				return nil, err
 			}
@@ -47,7 +54,7 @@ func (s *State) ReplayBlocks(
 
 	// If there is skip slots at the end.
 	if targetSlot > state.Slot() {
-		state, err = processSlotsStateGen(ctx, state, targetSlot)
+		state, err = processSlotsStateGen(ctx, s.committeeCache, state, targetSlot)
 		if err != nil {
 			return nil, err
 		}
@@ -118,6 +125,7 @@ func (s *State) LoadBlocks(ctx context.Context, startSlot, endSlot types.Slot, e
 // WARNING: This method should not be used on an unverified new block.
 func executeStateTransitionStateGen(
 	ctx context.Context,
+	committeeCache *cache.CommitteeCache,
 	state iface.BeaconState,
 	signed *ethpb.SignedBeaconBlock,
 ) (iface.BeaconState, error) {
@@ -134,7 +142,7 @@ func executeStateTransitionStateGen(
 
 	// Execute per slots transition.
 	// Given this is for state gen, a node uses the version process slots without skip slots cache.
-	state, err = processSlotsStateGen(ctx, state, signed.Block.Slot)
+	state, err = processSlotsStateGen(ctx, committeeCache, state, signed.Block.Slot)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not process slot")
 	}
@@ -142,7 +150,7 @@ func executeStateTransitionStateGen(
 	// Execute per block transition.
 	// Given this is for state gen, a node only cares about the post state without proposer
 	// and randao signature verifications.
-	state, err = transition.ProcessBlockForStateRoot(ctx, state, signed)
+	state, err = transition.ProcessBlockForStateRoot(ctx, committeeCache, state, signed)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not process block")
 	}
@@ -153,7 +161,7 @@ func executeStateTransitionStateGen(
 // processSlotsStateGen to process old slots for state gen usages.
 // There's no skip slot cache involved given state gen only works with already stored block and state in DB.
 // WARNING: This method should not be used for future slot.
-func processSlotsStateGen(ctx context.Context, state iface.BeaconState, slot types.Slot) (iface.BeaconState, error) {
+func processSlotsStateGen(ctx context.Context, committeeCache *cache.CommitteeCache, state iface.BeaconState, slot types.Slot) (iface.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "stategen.ProcessSlotsStateGen")
 	defer span.End()
 	if state == nil {
@@ -171,12 +179,15 @@ func processSlotsStateGen(ctx context.Context, state iface.BeaconState, slot typ
 
 	var err error
 	for state.Slot() < slot {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
 		state, err = transition.ProcessSlot(ctx, state)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not process slot")
 		}
 		if transition.CanProcessEpoch(state) {
-			state, err = transition.ProcessEpochPrecompute(ctx, state)
+			state, err = transition.ProcessEpochPrecompute(ctx, committeeCache, state)
 			if err != nil {
 				return nil, errors.Wrap(err, "could not process epoch with optimizations")
 			}
@@ -246,6 +257,15 @@ func (s *State) lastSavedState(ctx context.Context, slot types.Slot) (iface.Read
 	if len(lastSaved) != 1 {
 		return nil, fmt.Errorf("highest saved state does not equal to 1, it equals to %d", len(lastSaved))
 	}
+
+	// The cold state store may hold a later archived point state than the DB, or the only one once
+	// the states of the DB were pruned.
+	if s.coldStore != nil {
+		root, coldSlot, ok := s.coldStore.HighestSlotStateBelow(ctx, slot+1)
+		if ok && (lastSaved[0] == nil || coldSlot > lastSaved[0].Slot()) {
+			return s.coldState(ctx, root)
+		}
+	}
 	if lastSaved[0] == nil {
 		return nil, errUnknownState
 	}
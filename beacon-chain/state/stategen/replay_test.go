@@ -108,6 +108,18 @@ func TestReplayBlocks_LowerSlotBlock(t *testing.T) {
 	assert.Equal(t, targetSlot, newState.Slot(), "Did not advance slots")
 }
 
+func TestReplayBlocks_CanceledContext(t *testing.T) {
+	beaconDB := testDB.SetupDB(t)
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	service := New(beaconDB)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := service.ReplayBlocks(ctx, beaconState, []*ethpb.SignedBeaconBlock{}, params.BeaconConfig().SlotsPerEpoch-1)
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, types.Slot(0), beaconState.Slot(), "Advanced slots after cancellation")
+}
+
 func TestLoadBlocks_FirstBranch(t *testing.T) {
 	beaconDB := testDB.SetupDB(t)
 	ctx := context.Background()
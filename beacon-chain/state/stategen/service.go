@@ -10,6 +10,7 @@ import (
 
 	types "github.com/prysmaticlabs/eth2-types"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	ethereum_beacon_p2p_v1 "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -33,6 +34,8 @@ type StateManager interface {
 	StateByRoot(ctx context.Context, blockRoot [32]byte) (iface.BeaconState, error)
 	StateByRootInitialSync(ctx context.Context, blockRoot [32]byte) (iface.BeaconState, error)
 	StateBySlot(ctx context.Context, slot types.Slot) (iface.BeaconState, error)
+	CanonicalStateInEpoch(ctx context.Context, epoch types.Epoch) (iface.BeaconState, error)
+	CanonicalBlockAtOrBelow(ctx context.Context, slot types.Slot) ([32]byte, types.Slot, error)
 	RecoverStateSummary(ctx context.Context, blockRoot [32]byte) (*ethereum_beacon_p2p_v1.StateSummary, error)
 	SaveState(ctx context.Context, root [32]byte, st iface.BeaconState) error
 	ForceCheckpoint(ctx context.Context, root []byte) error
@@ -48,6 +51,10 @@ type State struct {
 	finalizedInfo           *finalizedInfo
 	epochBoundaryStateCache *epochBoundaryState
 	saveHotStateDB          *saveHotStateDbConfig
+	coldStore               ColdStateStore
+	canonicalRoots          CanonicalRootFetcher
+	committeeCache          *cache.CommitteeCache
+	snapshotReplayDistance  types.Slot
 }
 
 // This tracks the config in the event of long non-finality,
@@ -80,6 +87,7 @@ func New(beaconDB db.NoHeadAccessDatabase) *State {
 		saveHotStateDB: &saveHotStateDbConfig{
 			duration: defaultHotStateDBInterval,
 		},
+		snapshotReplayDistance: types.Slot(maxCacheSize) * params.BeaconConfig().SlotsPerEpoch,
 	}
 }
 
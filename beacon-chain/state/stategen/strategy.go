@@ -0,0 +1,67 @@
+package stategen
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"go.opencensus.io/trace"
+)
+
+// replayStrategy is the way the state of a past slot is regenerated.
+type replayStrategy string
+
+const (
+	// ancestorReplay walks back from the last block at or before the slot until the state of an
+	// ancestor is found in the caches, the DB or the cold state store, and replays the blocks in
+	// between. Close to the head and the finalized checkpoint, the caches usually end the walk after
+	// a few blocks.
+	ancestorReplay replayStrategy = "ancestor"
+	// snapshotReplay looks up the highest archived point state at or before the slot through the
+	// state slot index of the DB and the cold state store, and replays the blocks after it. Deep in
+	// the finalized section the ancestor walk would end at the same state, after reading every
+	// block in between one by one.
+	snapshotReplay replayStrategy = "snapshot"
+)
+
+// replayStrategy returns the strategy regenerating the state of the slot. The snapshot strategy is
+// used for slots further before the finalized slot than the epochs held by the epoch boundary
+// state cache, from where an ancestor walk can no longer end in a cache.
+func (s *State) replayStrategy(slot types.Slot) replayStrategy {
+	s.finalizedInfo.lock.RLock()
+	finalizedSlot := s.finalizedInfo.slot
+	s.finalizedInfo.lock.RUnlock()
+	if slot+s.snapshotReplayDistance >= finalizedSlot {
+		return ancestorReplay
+	}
+	return snapshotReplay
+}
+
+// loadStateFromSnapshot regenerates the state of the block with the given root and slot from the
+// highest archived point state at or before the slot.
+func (s *State) loadStateFromSnapshot(ctx context.Context, blockRoot [32]byte, blockSlot types.Slot) (iface.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.loadStateFromSnapshot")
+	defer span.End()
+
+	snapshot, err := s.lastSavedState(ctx, blockSlot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get last saved state")
+	}
+	st, ok := snapshot.(iface.BeaconState)
+	if !ok || st == nil {
+		return nil, errUnknownState
+	}
+	span.AddAttributes(trace.Int64Attribute("snapshotSlot", int64(st.Slot())))
+	if st.Slot() >= blockSlot {
+		return st, nil
+	}
+
+	blks, err := s.LoadBlocks(ctx, st.Slot()+1, blockSlot, blockRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load blocks after snapshot")
+	}
+	replayBlockCount.Observe(float64(len(blks)))
+	span.AddAttributes(trace.Int64Attribute("replayedBlocks", int64(len(blks))))
+	return s.ReplayBlocks(ctx, st, blks, blockSlot)
+}
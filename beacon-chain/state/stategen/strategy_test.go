@@ -0,0 +1,68 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestReplayStrategy(t *testing.T) {
+	service := New(testDB.SetupDB(t))
+	service.snapshotReplayDistance = 64
+	service.finalizedInfo.slot = 100
+
+	assert.Equal(t, ancestorReplay, service.replayStrategy(120))
+	assert.Equal(t, ancestorReplay, service.replayStrategy(36))
+	assert.Equal(t, snapshotReplay, service.replayStrategy(35))
+	assert.Equal(t, snapshotReplay, service.replayStrategy(0))
+}
+
+func TestStateBySlot_SnapshotReplayMatchesAncestorReplay(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+
+	genesisState, keys := testutil.DeterministicGenesisState(t, 32)
+	genesisStateRoot, err := genesisState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis := blocks.NewGenesisBlock(genesisStateRoot[:])
+	require.NoError(t, beaconDB.SaveBlock(ctx, genesis))
+	gRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveState(ctx, genesisState, gRoot))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, gRoot))
+
+	st := genesisState.Copy()
+	for slot := types.Slot(1); slot <= 4; slot++ {
+		b, err := testutil.GenerateFullBlock(st, keys, testutil.DefaultBlockGenConfig(), slot)
+		require.NoError(t, err)
+		st, err = state.ExecuteStateTransition(ctx, nil, st, b)
+		require.NoError(t, err)
+		require.NoError(t, beaconDB.SaveBlock(ctx, b))
+	}
+
+	snapshot := New(beaconDB)
+	snapshot.snapshotReplayDistance = 8
+	snapshot.finalizedInfo.slot = 100
+	require.Equal(t, snapshotReplay, snapshot.replayStrategy(6))
+	fromSnapshot, err := snapshot.StateBySlot(ctx, 6)
+	require.NoError(t, err)
+
+	ancestor := New(beaconDB)
+	require.Equal(t, ancestorReplay, ancestor.replayStrategy(6))
+	fromAncestor, err := ancestor.StateBySlot(ctx, 6)
+	require.NoError(t, err)
+
+	assert.Equal(t, types.Slot(6), fromSnapshot.Slot())
+	snapshotRoot, err := fromSnapshot.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	ancestorRoot, err := fromAncestor.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, ancestorRoot, snapshotRoot)
+}
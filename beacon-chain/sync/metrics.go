@@ -47,6 +47,12 @@ var (
 			Help: "Count the number of times a node resyncs.",
 		},
 	)
+	proposerListDigestMismatches = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "proposer_list_digest_mismatches_total",
+			Help: "Count of proposer list digests of peers which differ from the digest computed by this node.",
+		},
+	)
 
 	arrivalBlockPropagationHistogram = promauto.NewHistogram(
 		prometheus.HistogramOpts{
@@ -16,6 +16,7 @@ import (
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
@@ -61,6 +62,7 @@ type Config struct {
 	BlockNotifier       blockfeed.Notifier
 	AttestationNotifier operation.Notifier
 	StateGen            *stategen.State
+	CommitteeCache      *cache.CommitteeCache
 }
 
 // This defines the interface for interacting with block chain service
@@ -101,6 +103,7 @@ type Service struct {
 	seenAttesterSlashingCache map[uint64]bool
 	badBlockCache             *lru.Cache
 	badBlockLock              sync.RWMutex
+	proposerDigests           *cache.ProposerDigestCache
 }
 
 // NewService initializes new regular sync service.
@@ -119,6 +122,9 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		blkRootToPendingAtts: make(map[[32]byte][]*ethpb.SignedAggregateAttestationAndProof),
 		rateLimiter:          rLimiter,
 	}
+	if flags.Get().ProposerListDigests {
+		r.proposerDigests = cache.NewProposerDigestCache()
+	}
 
 	go r.registerHandlers()
 
@@ -180,6 +186,12 @@ func (s *Service) Status() error {
 	return nil
 }
 
+// ProposerDigests returns the cache of the proposer list digests computed by this node and its
+// peers, which is nil unless the proposer list digest exchange is enabled.
+func (s *Service) ProposerDigests() *cache.ProposerDigestCache {
+	return s.proposerDigests
+}
+
 // This initializes the caches to update seen beacon objects coming in from the wire
 // and prevent DoS.
 func (s *Service) initCaches() error {
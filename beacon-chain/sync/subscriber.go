@@ -80,6 +80,14 @@ func (s *Service) registerSubscribers() {
 			s.committeeIndexBeaconAttestationSubscriber, /* message handler */
 		)
 	}
+	if s.proposerDigests != nil {
+		s.subscribe(
+			p2p.ProposerListDigestSubnetTopicFormat,
+			s.validateProposerListDigest,
+			s.proposerListDigestSubscriber,
+		)
+		go s.publishProposerListDigests()
+	}
 }
 
 // subscribe to a given topic with a given validator and subscription handler.
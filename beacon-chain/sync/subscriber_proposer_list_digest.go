@@ -0,0 +1,106 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	"github.com/sirupsen/logrus"
+)
+
+func (s *Service) proposerListDigestSubscriber(_ context.Context, msg proto.Message) error {
+	digest, ok := msg.(*p2ptypes.ProposerListDigest)
+	if !ok {
+		return fmt.Errorf("wrong type, expected: *types.ProposerListDigest got: %T", msg)
+	}
+	origin := peer.ID(digest.PeerID)
+	if origin == s.cfg.P2P.PeerID() {
+		return nil
+	}
+	if !s.proposerDigests.AddPeerDigest(digest.Epoch, origin.String(), digest.Digest) {
+		return nil
+	}
+	if own, ok := s.proposerDigests.Digest(digest.Epoch); ok && own != digest.Digest {
+		proposerListDigestMismatches.Inc()
+		log.WithFields(logrus.Fields{
+			"epoch":      digest.Epoch,
+			"peer":       origin,
+			"digest":     fmt.Sprintf("%#x", own),
+			"peerDigest": fmt.Sprintf("%#x", digest.Digest),
+		}).Warn("Peer computed a different proposer list")
+	}
+	return nil
+}
+
+// publishProposerListDigests publishes the digest of the proposer list of the next epoch once the
+// head reaches the last slot of the current epoch. If that slot is skipped, the digest is published
+// once the head enters the epoch instead.
+func (s *Service) publishProposerListDigests() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type != statefeed.BlockProcessed || s.cfg.InitialSync.Syncing() {
+				continue
+			}
+			epoch := helpers.SlotToEpoch(s.cfg.Chain.HeadSlot() + 1)
+			if _, ok := s.proposerDigests.Digest(epoch); ok {
+				continue
+			}
+			if err := s.publishProposerListDigest(s.ctx, epoch); err != nil {
+				log.WithError(err).WithField("epoch", epoch).Error("Could not publish proposer list digest")
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting goroutine")
+			return
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Could not subscribe to state notifier")
+			return
+		}
+	}
+}
+
+// publishProposerListDigest computes the proposer list of the epoch from the head state, records
+// its digest and gossips it to the peers.
+func (s *Service) publishProposerListDigest(ctx context.Context, epoch types.Epoch) error {
+	headState, err := s.cfg.Chain.HeadState(ctx)
+	if err != nil {
+		return err
+	}
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return err
+	}
+	// Computing proposers moves the state slot, so the head state is copied first.
+	st := headState.Copy()
+	if st.Slot() < startSlot {
+		st, err = state.ProcessSlots(ctx, s.cfg.CommitteeCache, st, startSlot)
+		if err != nil {
+			return err
+		}
+	}
+	proposers, err := orchestrator.EpochProposers(s.cfg.CommitteeCache, st, epoch)
+	if err != nil {
+		return err
+	}
+	digest, err := orchestrator.ProposerListRoot(proposers)
+	if err != nil {
+		return err
+	}
+	s.proposerDigests.SetDigest(epoch, digest)
+	return s.cfg.P2P.Broadcast(ctx, &p2ptypes.ProposerListDigest{
+		Epoch:  epoch,
+		Digest: digest,
+		PeerID: []byte(s.cfg.P2P.PeerID()),
+	})
+}
@@ -0,0 +1,75 @@
+package sync
+
+import (
+	"context"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestProposerListDigestSubscriber(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	other := p2ptest.NewTestP2P(t)
+	r := &Service{
+		cfg:             &Config{P2P: p},
+		proposerDigests: cache.NewProposerDigestCache(),
+	}
+	r.proposerDigests.SetDigest(3, [32]byte{'a'})
+
+	ctx := context.Background()
+	require.NoError(t, r.proposerListDigestSubscriber(ctx, &p2ptypes.ProposerListDigest{
+		Epoch:  3,
+		Digest: [32]byte{'b'},
+		PeerID: []byte(other.PeerID()),
+	}))
+	// The node does not count its own digest.
+	require.NoError(t, r.proposerListDigestSubscriber(ctx, &p2ptypes.ProposerListDigest{
+		Epoch:  3,
+		Digest: [32]byte{'a'},
+		PeerID: []byte(p.PeerID()),
+	}))
+
+	res, ok := r.proposerDigests.Consistency(3)
+	require.Equal(t, true, ok)
+	assert.Equal(t, 1, res.Peers)
+	assert.DeepEqual(t, []string{other.PeerID().String()}, res.DivergentPeers)
+}
+
+func TestPublishProposerListDigest(t *testing.T) {
+	headState, _ := testutil.DeterministicGenesisState(t, 64)
+	// The head is at the last slot of the first epoch, so the proposer list of the next epoch is due.
+	require.NoError(t, headState.SetSlot(params.BeaconConfig().SlotsPerEpoch-1))
+	p := p2ptest.NewTestP2P(t)
+	r := &Service{
+		cfg: &Config{
+			P2P:   p,
+			Chain: &mock.ChainService{State: headState},
+		},
+		proposerDigests: cache.NewProposerDigestCache(),
+	}
+
+	ctx := context.Background()
+	require.NoError(t, r.publishProposerListDigest(ctx, 1))
+	assert.Equal(t, true, p.BroadcastCalled)
+	// The head state is left untouched.
+	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch-1, headState.Slot())
+
+	st, err := state.ProcessSlots(ctx, nil, headState.Copy(), params.BeaconConfig().SlotsPerEpoch)
+	require.NoError(t, err)
+	proposers, err := orchestrator.EpochProposers(nil, st, 1)
+	require.NoError(t, err)
+	want, err := orchestrator.ProposerListRoot(proposers)
+	require.NoError(t, err)
+	digest, ok := r.proposerDigests.Digest(1)
+	require.Equal(t, true, ok)
+	assert.Equal(t, want, digest)
+}
@@ -198,12 +198,12 @@ func (s *Service) validateBeaconBlock(ctx context.Context, blk *ethpb.SignedBeac
 	} else {
 		// In the event the block is more than an epoch ahead from its
 		// parent state, we have to advance the state forward.
-		parentState, err = state.ProcessSlots(ctx, parentState, blk.Block.Slot)
+		parentState, err = state.ProcessSlots(ctx, s.cfg.CommitteeCache, parentState, blk.Block.Slot)
 		if err != nil {
 			return err
 		}
 	}
-	idx, err := helpers.BeaconProposerIndex(parentState)
+	idx, err := helpers.BeaconProposerIndex(s.cfg.CommitteeCache, parentState)
 	if err != nil {
 		return err
 	}
@@ -136,7 +136,7 @@ func TestValidateBeaconBlockPubSub_CanRecoverStateSummary(t *testing.T) {
 	require.NoError(t, db.SaveState(ctx, beaconState, bRoot))
 	copied := beaconState.Copy()
 	require.NoError(t, copied.SetSlot(1))
-	proposerIdx, err := helpers.BeaconProposerIndex(copied)
+	proposerIdx, err := helpers.BeaconProposerIndex(nil, copied)
 	require.NoError(t, err)
 	msg := testutil.NewBeaconBlock()
 	msg.Block.ParentRoot = bRoot[:]
@@ -199,7 +199,7 @@ func TestValidateBeaconBlockPubSub_ValidProposerSignature(t *testing.T) {
 	require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Root: bRoot[:]}))
 	copied := beaconState.Copy()
 	require.NoError(t, copied.SetSlot(1))
-	proposerIdx, err := helpers.BeaconProposerIndex(copied)
+	proposerIdx, err := helpers.BeaconProposerIndex(nil, copied)
 	require.NoError(t, err)
 	msg := testutil.NewBeaconBlock()
 	msg.Block.ParentRoot = bRoot[:]
@@ -263,9 +263,9 @@ func TestValidateBeaconBlockPubSub_WithLookahead(t *testing.T) {
 	copied := beaconState.Copy()
 	// The next block is only 1 epoch ahead so as to not induce a new seed.
 	blkSlot := params.BeaconConfig().SlotsPerEpoch.Mul(uint64(helpers.NextEpoch(copied)))
-	copied, err = state.ProcessSlots(context.Background(), copied, blkSlot)
+	copied, err = state.ProcessSlots(context.Background(), nil, copied, blkSlot)
 	require.NoError(t, err)
-	proposerIdx, err := helpers.BeaconProposerIndex(copied)
+	proposerIdx, err := helpers.BeaconProposerIndex(nil, copied)
 	require.NoError(t, err)
 	msg := testutil.NewBeaconBlock()
 	msg.Block.ProposerIndex = proposerIdx
@@ -328,9 +328,9 @@ func TestValidateBeaconBlockPubSub_AdvanceEpochsForState(t *testing.T) {
 	copied := beaconState.Copy()
 	// The next block is at least 2 epochs ahead to induce shuffling and a new seed.
 	blkSlot := params.BeaconConfig().SlotsPerEpoch * 2
-	copied, err = state.ProcessSlots(context.Background(), copied, blkSlot)
+	copied, err = state.ProcessSlots(context.Background(), nil, copied, blkSlot)
 	require.NoError(t, err)
-	proposerIdx, err := helpers.BeaconProposerIndex(copied)
+	proposerIdx, err := helpers.BeaconProposerIndex(nil, copied)
 	require.NoError(t, err)
 	msg := testutil.NewBeaconBlock()
 	msg.Block.ProposerIndex = proposerIdx
@@ -526,7 +526,7 @@ func TestValidateBeaconBlockPubSub_SeenProposerSlot(t *testing.T) {
 	bRoot, err := parentBlock.Block.HashTreeRoot()
 	require.NoError(t, err)
 	require.NoError(t, db.SaveState(ctx, beaconState, bRoot))
-	proposerIdx, err := helpers.BeaconProposerIndex(beaconState)
+	proposerIdx, err := helpers.BeaconProposerIndex(nil, beaconState)
 	require.NoError(t, err)
 
 	msg := testutil.NewBeaconBlock()
@@ -652,7 +652,7 @@ func TestValidateBeaconBlockPubSub_ParentNotFinalizedDescendant(t *testing.T) {
 	require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Root: bRoot[:]}))
 	copied := beaconState.Copy()
 	require.NoError(t, copied.SetSlot(1))
-	proposerIdx, err := helpers.BeaconProposerIndex(copied)
+	proposerIdx, err := helpers.BeaconProposerIndex(nil, copied)
 	require.NoError(t, err)
 	msg := testutil.NewBeaconBlock()
 	msg.Block.Slot = 1
@@ -715,7 +715,7 @@ func TestValidateBeaconBlockPubSub_InvalidParentBlock(t *testing.T) {
 	require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Root: bRoot[:]}))
 	copied := beaconState.Copy()
 	require.NoError(t, copied.SetSlot(1))
-	proposerIdx, err := helpers.BeaconProposerIndex(copied)
+	proposerIdx, err := helpers.BeaconProposerIndex(nil, copied)
 	require.NoError(t, err)
 	msg := testutil.NewBeaconBlock()
 	msg.Block.ProposerIndex = proposerIdx
@@ -767,7 +767,7 @@ func TestValidateBeaconBlockPubSub_InvalidParentBlock(t *testing.T) {
 	assert.Equal(t, false, result)
 
 	require.NoError(t, copied.SetSlot(2))
-	proposerIdx, err = helpers.BeaconProposerIndex(copied)
+	proposerIdx, err = helpers.BeaconProposerIndex(nil, copied)
 	require.NoError(t, err)
 
 	msg = testutil.NewBeaconBlock()
@@ -806,9 +806,9 @@ func TestValidateBeaconBlockPubSub_RejectEvilBlocksFromFuture(t *testing.T) {
 	copied := beaconState.Copy()
 	// The next block is at least 2 epochs ahead to induce shuffling and a new seed.
 	blkSlot := params.BeaconConfig().SlotsPerEpoch * 2
-	copied, err = state.ProcessSlots(context.Background(), copied, blkSlot)
+	copied, err = state.ProcessSlots(context.Background(), nil, copied, blkSlot)
 	require.NoError(t, err)
-	proposerIdx, err := helpers.BeaconProposerIndex(copied)
+	proposerIdx, err := helpers.BeaconProposerIndex(nil, copied)
 	require.NoError(t, err)
 
 	msg := testutil.NewBeaconBlock()
@@ -0,0 +1,60 @@
+package sync
+
+import (
+	"context"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"go.opencensus.io/trace"
+)
+
+// Proposer list digests are only forwarded for the current and the next epoch, so that digests of
+// made up epochs cannot flood the network or evict the digests of recent epochs.
+func (s *Service) validateProposerListDigest(ctx context.Context, pid peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.P2P.PeerID() {
+		return pubsub.ValidationAccept
+	}
+
+	// The digests of a syncing node cannot be compared.
+	if s.cfg.InitialSync.Syncing() {
+		return pubsub.ValidationIgnore
+	}
+
+	_, span := trace.StartSpan(ctx, "sync.validateProposerListDigest")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		log.WithError(err).Debug("Could not decode message")
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationReject
+	}
+
+	digest, ok := m.(*p2ptypes.ProposerListDigest)
+	if !ok {
+		return pubsub.ValidationReject
+	}
+	origin, err := peer.IDFromBytes(digest.PeerID)
+	if err != nil {
+		return pubsub.ValidationReject
+	}
+	if origin == s.cfg.P2P.PeerID() {
+		return pubsub.ValidationIgnore
+	}
+
+	// Peers whose clock is slightly off publish for an epoch before or after this node does, which
+	// is not penalized.
+	currentEpoch := helpers.SlotToEpoch(s.cfg.Chain.CurrentSlot())
+	if digest.Epoch < currentEpoch || digest.Epoch > currentEpoch+1 {
+		return pubsub.ValidationIgnore
+	}
+
+	msg.ValidatorData = digest // Used in downstream subscriber
+
+	return pubsub.ValidationAccept
+}
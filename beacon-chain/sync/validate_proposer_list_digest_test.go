@@ -0,0 +1,87 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func proposerListDigestMessage(t *testing.T, p *p2ptest.TestP2P, digest *p2ptypes.ProposerListDigest) *pubsub.Message {
+	buf := new(bytes.Buffer)
+	_, err := p.Encoding().EncodeGossip(buf, digest)
+	require.NoError(t, err)
+	topic := p2p.GossipTypeMapping[reflect.TypeOf(digest)]
+	return &pubsub.Message{
+		Message: &pubsubpb.Message{
+			Data:  buf.Bytes(),
+			Topic: &topic,
+		},
+	}
+}
+
+func TestValidateProposerListDigest(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	other := p2ptest.NewTestP2P(t)
+	ctx := context.Background()
+
+	currentSlot := params.BeaconConfig().SlotsPerEpoch * 5
+	r := &Service{
+		cfg: &Config{
+			P2P:         p,
+			Chain:       &mock.ChainService{Slot: &currentSlot},
+			InitialSync: &mockSync.Sync{IsSyncing: false},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		epoch  types.Epoch
+		peerID []byte
+		want   pubsub.ValidationResult
+	}{
+		{name: "current epoch", epoch: 5, peerID: []byte(other.PeerID()), want: pubsub.ValidationAccept},
+		{name: "next epoch", epoch: 6, peerID: []byte(other.PeerID()), want: pubsub.ValidationAccept},
+		{name: "previous epoch", epoch: 4, peerID: []byte(other.PeerID()), want: pubsub.ValidationIgnore},
+		{name: "future epoch", epoch: 7, peerID: []byte(other.PeerID()), want: pubsub.ValidationIgnore},
+		{name: "invalid peer ID", epoch: 5, peerID: []byte("peer"), want: pubsub.ValidationReject},
+		{name: "own peer ID", epoch: 5, peerID: []byte(p.PeerID()), want: pubsub.ValidationIgnore},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := proposerListDigestMessage(t, p, &p2ptypes.ProposerListDigest{Epoch: tt.epoch, Digest: [32]byte{'a'}, PeerID: tt.peerID})
+			assert.Equal(t, tt.want, r.validateProposerListDigest(ctx, "", m))
+			if tt.want == pubsub.ValidationAccept {
+				assert.NotNil(t, m.ValidatorData, "Decoded message was not set on the message validator data")
+			}
+		})
+	}
+}
+
+func TestValidateProposerListDigest_Syncing(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	other := p2ptest.NewTestP2P(t)
+
+	currentSlot := types.Slot(0)
+	r := &Service{
+		cfg: &Config{
+			P2P:         p,
+			Chain:       &mock.ChainService{Slot: &currentSlot},
+			InitialSync: &mockSync.Sync{IsSyncing: true},
+		},
+	}
+	m := proposerListDigestMessage(t, p, &p2ptypes.ProposerListDigest{PeerID: []byte(other.PeerID())})
+	assert.Equal(t, pubsub.ValidationIgnore, r.validateProposerListDigest(context.Background(), "", m))
+}
@@ -0,0 +1,113 @@
+package webhook
+
+import (
+	"io/ioutil"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// EventType identifies the kind of duty notification a webhook receives.
+type EventType string
+
+const (
+	// ProposerDutyEvent is sent when a tracked validator is assigned a proposer slot in the
+	// upcoming epoch.
+	ProposerDutyEvent EventType = "proposer_duty"
+	// MissedAttestationEvent is sent when no attestation of a tracked validator was observed for
+	// its committee assignment.
+	MissedAttestationEvent EventType = "missed_attestation"
+)
+
+// defaultTimeout bounds a single webhook delivery when the config does not set a timeout.
+const defaultTimeout = 5 * time.Second
+
+// HookConfig is the content of the file passed with --duty-webhook-config, for instance:
+//
+//   timeout: 3s
+//   webhooks:
+//     - url: https://example.com/duties
+//       headers:
+//         Authorization: Bearer secret
+//       events: [proposer_duty, missed_attestation]
+type HookConfig struct {
+	// Timeout of a single delivery, 5s when unset.
+	Timeout  time.Duration `yaml:"timeout,omitempty"`
+	Webhooks []*Endpoint   `yaml:"webhooks"`
+}
+
+// Endpoint is a URL notifications are POSTed to.
+type Endpoint struct {
+	URL     string            `yaml:"url"`
+	Headers map[string]string `yaml:"headers,omitempty"`
+	// Events the endpoint subscribes to, every event when empty.
+	Events []EventType `yaml:"events,omitempty"`
+}
+
+// LoadHookConfig reads and validates the webhook config file at the given path.
+func LoadHookConfig(path string) (*HookConfig, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &HookConfig{}
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, errors.Wrapf(err, "could not parse %s", path)
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid webhook config %s", path)
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = defaultTimeout
+	}
+	return cfg, nil
+}
+
+func (c *HookConfig) validate() error {
+	if len(c.Webhooks) == 0 {
+		return errors.New("no webhooks configured")
+	}
+	if c.Timeout < 0 {
+		return errors.New("timeout can not be negative")
+	}
+	for i, e := range c.Webhooks {
+		if e == nil {
+			return errors.Errorf("webhook %d is empty", i)
+		}
+		u, err := url.Parse(e.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.Errorf("webhook %d has an invalid url %q", i, e.URL)
+		}
+		for _, ev := range e.Events {
+			if ev != ProposerDutyEvent && ev != MissedAttestationEvent {
+				return errors.Errorf("webhook %d subscribes to unknown event %q", i, ev)
+			}
+		}
+	}
+	return nil
+}
+
+// subscribed returns true if the endpoint receives events of the given type.
+func (e *Endpoint) subscribed(event EventType) bool {
+	if len(e.Events) == 0 {
+		return true
+	}
+	for _, ev := range e.Events {
+		if ev == event {
+			return true
+		}
+	}
+	return false
+}
+
+// wants returns true if any endpoint receives events of the given type.
+func (c *HookConfig) wants(event EventType) bool {
+	for _, e := range c.Webhooks {
+		if e.subscribed(event) {
+			return true
+		}
+	}
+	return false
+}
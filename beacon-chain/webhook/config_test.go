@@ -0,0 +1,76 @@
+package webhook
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func writeConfig(t *testing.T, contents string) string {
+	path := filepath.Join(t.TempDir(), "webhooks.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(contents), params.BeaconIoConfig().ReadWritePermissions))
+	return path
+}
+
+func TestLoadHookConfig(t *testing.T) {
+	cfg, err := LoadHookConfig(writeConfig(t, `
+webhooks:
+  - url: https://example.com/duties
+    headers:
+      Authorization: Bearer secret
+    events: [proposer_duty]
+  - url: http://localhost:8080
+`))
+	require.NoError(t, err)
+	assert.Equal(t, defaultTimeout, cfg.Timeout)
+	require.Equal(t, 2, len(cfg.Webhooks))
+	assert.Equal(t, "Bearer secret", cfg.Webhooks[0].Headers["Authorization"])
+	assert.Equal(t, true, cfg.Webhooks[0].subscribed(ProposerDutyEvent))
+	assert.Equal(t, false, cfg.Webhooks[0].subscribed(MissedAttestationEvent))
+	assert.Equal(t, true, cfg.Webhooks[1].subscribed(MissedAttestationEvent))
+	assert.Equal(t, true, cfg.wants(MissedAttestationEvent))
+
+	cfg, err = LoadHookConfig(writeConfig(t, "timeout: 3s\nwebhooks:\n  - url: https://example.com\n"))
+	require.NoError(t, err)
+	assert.Equal(t, 3*time.Second, cfg.Timeout)
+}
+
+func TestLoadHookConfig_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		wantErr  string
+	}{
+		{
+			name:     "no webhooks",
+			contents: "timeout: 1s\n",
+			wantErr:  "no webhooks configured",
+		},
+		{
+			name:     "invalid url",
+			contents: "webhooks:\n  - url: example.com/duties\n",
+			wantErr:  "webhook 0 has an invalid url \"example.com/duties\"",
+		},
+		{
+			name:     "unknown event",
+			contents: "webhooks:\n  - url: https://example.com\n    events: [slashing]\n",
+			wantErr:  "webhook 0 subscribes to unknown event \"slashing\"",
+		},
+		{
+			name:     "unknown field",
+			contents: "webhooks:\n  - url: https://example.com\n    method: PUT\n",
+			wantErr:  "field method not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadHookConfig(writeConfig(t, tt.contents))
+			assert.ErrorContains(t, tt.wantErr, err)
+		})
+	}
+}
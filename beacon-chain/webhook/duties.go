@@ -0,0 +1,150 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// attKey identifies the committee of an attestation.
+type attKey struct {
+	slot           types.Slot
+	committeeIndex types.CommitteeIndex
+}
+
+// trackedIndices maps the indices of the tracked validators known to the state to their public keys.
+func trackedIndices(st iface.ReadOnlyBeaconState, pubKeys [][]byte) map[types.ValidatorIndex][]byte {
+	indices := make(map[types.ValidatorIndex][]byte, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		if idx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubKey)); ok {
+			indices[idx] = pubKey
+		}
+	}
+	return indices
+}
+
+// proposerDuties returns a notification for every proposer slot of the tracked validators in the
+// given epoch. The proposers are only known once the state is advanced into the epoch, so a copy of
+// the state is processed through the empty slots up to its start.
+func proposerDuties(
+	ctx context.Context, committeeCache *cache.CommitteeCache, st iface.BeaconState, epoch types.Epoch, validators map[types.ValidatorIndex][]byte,
+) ([]*Notification, error) {
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, err
+	}
+	if st.Slot() < startSlot {
+		st, err = state.ProcessSlots(ctx, committeeCache, st.Copy(), startSlot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not process slots up to %d", startSlot)
+		}
+	}
+	_, proposerIndexToSlots, err := helpers.CommitteeAssignments(ctx, committeeCache, st, epoch)
+	if err != nil {
+		return nil, err
+	}
+	var notifications []*Notification
+	for idx, pubKey := range validators {
+		for _, slot := range proposerIndexToSlots[idx] {
+			notifications = append(notifications, &Notification{
+				Event:          ProposerDutyEvent,
+				Epoch:          epoch,
+				Slot:           slot,
+				ValidatorIndex: idx,
+				PublicKey:      formatPubKey(pubKey),
+			})
+		}
+	}
+	return notifications, nil
+}
+
+// recordAttestation keeps the aggregation bits of an observed attestation until its epoch is
+// checked for missed attestations. Attestations from the operation feed are not validated yet, so
+// their bits are only merged with bits of the same length and checked against the committee later.
+func (s *Service) recordAttestation(att *ethpb.Attestation) {
+	if att == nil || att.Data == nil || att.AggregationBits == nil {
+		return
+	}
+	// Attestations of epochs which were checked already, or which are too far ahead, are ignored.
+	epoch := helpers.SlotToEpoch(att.Data.Slot)
+	currentEpoch := helpers.SlotToEpoch(s.cfg.TimeFetcher.CurrentSlot())
+	if epoch+2 < currentEpoch || epoch > currentEpoch {
+		return
+	}
+	key := attKey{slot: att.Data.Slot, committeeIndex: att.Data.CommitteeIndex}
+	s.attLock.Lock()
+	defer s.attLock.Unlock()
+	byLen, ok := s.seen[key]
+	if !ok {
+		byLen = make(map[uint64][]byte)
+		s.seen[key] = byLen
+	}
+	bits := att.AggregationBits
+	if seen, ok := byLen[bits.Len()]; ok {
+		bits = bitfield.Bitlist(seen).Or(bits)
+	}
+	byLen[bits.Len()] = bits
+}
+
+// missedAttestations returns a notification for every tracked validator with a committee assignment
+// in the given epoch whose bit was not set in any observed attestation of its committee. The
+// recorded attestations of the epoch and earlier ones are released afterwards.
+func (s *Service) missedAttestations(
+	ctx context.Context, st iface.BeaconState, epoch types.Epoch, validators map[types.ValidatorIndex][]byte,
+) ([]*Notification, error) {
+	s.attLock.Lock()
+	defer s.attLock.Unlock()
+	defer func() {
+		for key := range s.seen {
+			if helpers.SlotToEpoch(key.slot) <= epoch {
+				delete(s.seen, key)
+			}
+		}
+	}()
+
+	assignments, _, err := helpers.CommitteeAssignments(ctx, s.cfg.CommitteeCache, st, epoch)
+	if err != nil {
+		return nil, err
+	}
+	var notifications []*Notification
+	for idx, pubKey := range validators {
+		assignment, ok := assignments[idx]
+		if !ok {
+			continue
+		}
+		key := attKey{slot: assignment.AttesterSlot, committeeIndex: assignment.CommitteeIndex}
+		if attested(s.seen[key][uint64(len(assignment.Committee))], assignment.Committee, idx) {
+			continue
+		}
+		committeeIndex := assignment.CommitteeIndex
+		notifications = append(notifications, &Notification{
+			Event:          MissedAttestationEvent,
+			Epoch:          epoch,
+			Slot:           assignment.AttesterSlot,
+			ValidatorIndex: idx,
+			PublicKey:      formatPubKey(pubKey),
+			CommitteeIndex: &committeeIndex,
+		})
+	}
+	return notifications, nil
+}
+
+func attested(bits bitfield.Bitlist, committee []types.ValidatorIndex, idx types.ValidatorIndex) bool {
+	if bits == nil {
+		return false
+	}
+	for i, member := range committee {
+		if member == idx {
+			return bits.BitAt(uint64(i))
+		}
+	}
+	return false
+}
@@ -0,0 +1,7 @@
+package webhook
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "webhook")
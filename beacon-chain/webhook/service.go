@@ -0,0 +1,270 @@
+// Package webhook notifies external endpoints about the duties of tracked validators. It POSTs a
+// JSON notification when a tracked validator is assigned a proposer slot in the upcoming epoch,
+// and when no attestation of a tracked validator was observed for its committee assignment.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared"
+)
+
+var _ shared.Service = (*Service)(nil)
+
+// queueSize bounds the number of notifications waiting for delivery.
+const queueSize = 256
+
+var notificationsTotal = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "duty_webhook_notifications_total",
+		Help: "The number of duty webhook notifications by event and result.",
+	},
+	[]string{"event", "result"},
+)
+
+// TrackedValidators provides the public keys of the validators notifications are sent for.
+type TrackedValidators interface {
+	PublicKeys() [][]byte
+}
+
+// Config options for the webhook service.
+type Config struct {
+	Hooks             *HookConfig
+	TrackedValidators TrackedValidators
+	HeadFetcher       blockchain.HeadFetcher
+	TimeFetcher       blockchain.TimeFetcher
+	StateNotifier     statefeed.Notifier
+	OperationNotifier opfeed.Notifier
+	CommitteeCache    *cache.CommitteeCache
+}
+
+// Notification is the JSON body POSTed to the webhooks.
+type Notification struct {
+	Event EventType   `json:"event"`
+	Epoch types.Epoch `json:"epoch"`
+	// Slot is the proposer slot of a proposer duty, or the attester slot of a missed attestation.
+	Slot           types.Slot           `json:"slot"`
+	ValidatorIndex types.ValidatorIndex `json:"validator_index"`
+	PublicKey      string               `json:"public_key"`
+	// CommitteeIndex is only set for missed attestations.
+	CommitteeIndex *types.CommitteeIndex `json:"committee_index,omitempty"`
+}
+
+// Service subscribes to the epoch transitions and the attestations received by the node, and
+// delivers the resulting duty notifications to the configured webhooks.
+type Service struct {
+	cfg        *Config
+	ctx        context.Context
+	cancel     context.CancelFunc
+	client     *http.Client
+	queue      chan *Notification
+	attLock    sync.Mutex
+	seen       map[attKey]map[uint64][]byte
+	epochLock  sync.Mutex
+	lastEpoch  types.Epoch
+	failedLock sync.RWMutex
+	failed     error
+}
+
+// NewService returns a webhook service delivering notifications to the configured endpoints.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		cfg:    cfg,
+		ctx:    ctx,
+		cancel: cancel,
+		client: &http.Client{Timeout: cfg.Hooks.Timeout},
+		queue:  make(chan *Notification, queueSize),
+		seen:   make(map[attKey]map[uint64][]byte),
+	}
+}
+
+// Start the webhook service.
+func (s *Service) Start() {
+	log.WithField("webhooks", len(s.cfg.Hooks.Webhooks)).Info("Sending duty notifications to webhooks")
+	go s.deliverLoop()
+	go s.run()
+}
+
+// Stop the webhook service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status returns the error of the most recent delivery, nil if it succeeded.
+func (s *Service) Status() error {
+	s.failedLock.RLock()
+	defer s.failedLock.RUnlock()
+	return s.failed
+}
+
+func (s *Service) run() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	opChannel := make(chan *feed.Event, 1)
+	opSub := s.cfg.OperationNotifier.OperationFeed().Subscribe(opChannel)
+	defer opSub.Unsubscribe()
+
+	watchAttestations := s.cfg.Hooks.wants(MissedAttestationEvent)
+	for {
+		select {
+		case ev := <-stateChannel:
+			if ev.Type != statefeed.EpochTransition {
+				continue
+			}
+			data, ok := ev.Data.(*statefeed.EpochTransitionData)
+			if !ok {
+				log.Errorf("Received epoch transition event of unexpected type %T", ev.Data)
+				continue
+			}
+			// Epoch transitions of a syncing node are not worth a notification.
+			if data.Epoch < helpers.SlotToEpoch(s.cfg.TimeFetcher.CurrentSlot()) {
+				continue
+			}
+			// The duties are computed on their own routine, as the feeds block the chain service
+			// until every subscriber received the event.
+			go s.onEpochTransition(s.ctx, data.Epoch)
+		case ev := <-opChannel:
+			if !watchAttestations {
+				continue
+			}
+			switch data := ev.Data.(type) {
+			case *opfeed.UnAggregatedAttReceivedData:
+				s.recordAttestation(data.Attestation)
+			case *opfeed.AggregatedAttReceivedData:
+				if data.Attestation != nil {
+					s.recordAttestation(data.Attestation.Aggregate)
+				}
+			}
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Could not subscribe to state events")
+			return
+		case err := <-opSub.Err():
+			log.WithError(err).Error("Could not subscribe to operation events")
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// onEpochTransition notifies the proposer duties of the epoch after the given one and the missed
+// attestations of the epoch two epochs before, which leaves late attestations and aggregates a
+// full epoch to arrive.
+func (s *Service) onEpochTransition(ctx context.Context, epoch types.Epoch) {
+	s.epochLock.Lock()
+	defer s.epochLock.Unlock()
+	// Reorgs may move the head into the same epoch twice.
+	if epoch <= s.lastEpoch {
+		return
+	}
+	s.lastEpoch = epoch
+
+	st, err := s.cfg.HeadFetcher.HeadState(ctx)
+	if err != nil || st == nil {
+		log.WithError(err).Error("Could not get head state for duty notifications")
+		return
+	}
+	validators := trackedIndices(st, s.cfg.TrackedValidators.PublicKeys())
+	if len(validators) == 0 {
+		return
+	}
+	if s.cfg.Hooks.wants(ProposerDutyEvent) {
+		notifications, err := proposerDuties(ctx, s.cfg.CommitteeCache, st, epoch+1, validators)
+		if err != nil {
+			log.WithError(err).WithField("epoch", epoch+1).Error("Could not compute proposer duties")
+		}
+		s.enqueue(notifications)
+	}
+	if s.cfg.Hooks.wants(MissedAttestationEvent) && epoch >= 2 {
+		notifications, err := s.missedAttestations(ctx, st, epoch-2, validators)
+		if err != nil {
+			log.WithError(err).WithField("epoch", epoch-2).Error("Could not compute missed attestations")
+		}
+		s.enqueue(notifications)
+	}
+}
+
+func (s *Service) enqueue(notifications []*Notification) {
+	for _, n := range notifications {
+		select {
+		case s.queue <- n:
+		default:
+			notificationsTotal.WithLabelValues(string(n.Event), "dropped").Inc()
+			log.WithField("event", n.Event).Warn("Duty webhook queue is full, dropping notification")
+		}
+	}
+}
+
+func (s *Service) deliverLoop() {
+	for {
+		select {
+		case n := <-s.queue:
+			body, err := json.Marshal(n)
+			if err != nil {
+				log.WithError(err).Error("Could not marshal duty notification")
+				continue
+			}
+			for _, e := range s.cfg.Hooks.Webhooks {
+				if !e.subscribed(n.Event) {
+					continue
+				}
+				err := s.post(e, body)
+				s.failedLock.Lock()
+				s.failed = err
+				s.failedLock.Unlock()
+				if err != nil {
+					notificationsTotal.WithLabelValues(string(n.Event), "failed").Inc()
+					log.WithError(err).WithField("url", e.URL).Warn("Could not deliver duty notification")
+					continue
+				}
+				notificationsTotal.WithLabelValues(string(n.Event), "delivered").Inc()
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Service) post(e *Endpoint, body []byte) error {
+	req, err := http.NewRequestWithContext(s.ctx, http.MethodPost, e.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range e.Headers {
+		req.Header.Set(k, v)
+	}
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	if err := res.Body.Close(); err != nil {
+		log.WithError(err).Debug("Could not close webhook response body")
+	}
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return errors.Errorf("webhook responded with status %d", res.StatusCode)
+	}
+	return nil
+}
+
+func formatPubKey(pubKey []byte) string {
+	return fmt.Sprintf("%#x", pubKey)
+}
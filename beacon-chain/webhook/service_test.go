@@ -0,0 +1,186 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type trackedKeys [][]byte
+
+func (k trackedKeys) PublicKeys() [][]byte {
+	return k
+}
+
+type receiver struct {
+	lock          sync.Mutex
+	notifications []*Notification
+	authorization string
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	n := &Notification{}
+	if err := json.NewDecoder(req.Body).Decode(n); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.notifications = append(r.notifications, n)
+	r.authorization = req.Header.Get("Authorization")
+}
+
+func (r *receiver) received() []*Notification {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return append([]*Notification{}, r.notifications...)
+}
+
+func TestService_NotifiesDuties(t *testing.T) {
+	helpers.ClearCache()
+	ctx := context.Background()
+	numValidators := uint64(64)
+	st, _ := testutil.DeterministicGenesisState(t, numValidators)
+	currentSlot := 2 * params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, st.SetSlot(currentSlot))
+	pubKeys := make([][]byte, numValidators)
+	for i := types.ValidatorIndex(0); uint64(i) < numValidators; i++ {
+		pubKey := st.PubkeyAtIndex(i)
+		pubKeys[i] = pubKey[:]
+	}
+
+	r := &receiver{}
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+	chain := &mock.ChainService{State: st, Slot: &currentSlot}
+	s := NewService(ctx, &Config{
+		Hooks: &HookConfig{
+			Timeout: time.Second,
+			Webhooks: []*Endpoint{{
+				URL:     srv.URL,
+				Headers: map[string]string{"Authorization": "Bearer secret"},
+			}},
+		},
+		TrackedValidators: trackedKeys(pubKeys),
+		HeadFetcher:       chain,
+		TimeFetcher:       chain,
+		StateNotifier:     chain.StateNotifier(),
+		OperationNotifier: chain.OperationNotifier(),
+	})
+	defer func() {
+		require.NoError(t, s.Stop())
+	}()
+
+	// Validator 5 attested in epoch 0, every other validator missed its attestation.
+	assignments, _, err := helpers.CommitteeAssignments(context.Background(), nil, st, 0)
+	require.NoError(t, err)
+	assignment := assignments[5]
+	bits := bitfield.NewBitlist(uint64(len(assignment.Committee)))
+	for i, idx := range assignment.Committee {
+		if idx == 5 {
+			bits.SetBitAt(uint64(i), true)
+		}
+	}
+	s.recordAttestation(&ethpb.Attestation{
+		AggregationBits: bits,
+		Data: &ethpb.AttestationData{
+			Slot:           assignment.AttesterSlot,
+			CommitteeIndex: assignment.CommitteeIndex,
+		},
+	})
+
+	s.Start()
+	ev := &feed.Event{
+		Type: statefeed.EpochTransition,
+		Data: &statefeed.EpochTransitionData{Epoch: 2, Slot: currentSlot},
+	}
+	for chain.StateNotifier().StateFeed().Send(ev) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	wanted := int(params.BeaconConfig().SlotsPerEpoch) + int(numValidators) - 1
+	deadline := time.Now().Add(10 * time.Second)
+	for len(r.received()) < wanted && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	notifications := r.received()
+	require.Equal(t, wanted, len(notifications))
+	assert.Equal(t, "Bearer secret", r.authorization)
+	assert.NoError(t, s.Status())
+
+	proposerSlots := make(map[types.Slot]bool)
+	missed := make(map[types.ValidatorIndex]bool)
+	for _, n := range notifications {
+		switch n.Event {
+		case ProposerDutyEvent:
+			assert.Equal(t, types.Epoch(3), n.Epoch)
+			assert.Equal(t, types.Epoch(3), helpers.SlotToEpoch(n.Slot))
+			proposerSlots[n.Slot] = true
+		case MissedAttestationEvent:
+			assert.Equal(t, types.Epoch(0), n.Epoch)
+			require.NotNil(t, n.CommitteeIndex)
+			assert.Equal(t, assignments[n.ValidatorIndex].CommitteeIndex, *n.CommitteeIndex)
+			assert.Equal(t, formatPubKey(pubKeys[n.ValidatorIndex]), n.PublicKey)
+			missed[n.ValidatorIndex] = true
+		default:
+			t.Errorf("Unexpected event %q", n.Event)
+		}
+	}
+	assert.Equal(t, int(params.BeaconConfig().SlotsPerEpoch), len(proposerSlots))
+	assert.Equal(t, int(numValidators)-1, len(missed))
+	assert.Equal(t, false, missed[5])
+}
+
+func TestService_DeliversSubscribedEvents(t *testing.T) {
+	proposals := &receiver{}
+	proposalSrv := httptest.NewServer(proposals)
+	defer proposalSrv.Close()
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+
+	s := NewService(context.Background(), &Config{
+		Hooks: &HookConfig{
+			Timeout: time.Second,
+			Webhooks: []*Endpoint{
+				{URL: proposalSrv.URL, Events: []EventType{ProposerDutyEvent}},
+				{URL: failing.URL, Events: []EventType{MissedAttestationEvent}},
+			},
+		},
+	})
+	defer func() {
+		require.NoError(t, s.Stop())
+	}()
+	go s.deliverLoop()
+
+	s.enqueue([]*Notification{
+		{Event: MissedAttestationEvent, Epoch: 1, Slot: 40},
+		{Event: ProposerDutyEvent, Epoch: 2, Slot: 70, ValidatorIndex: 3},
+	})
+	deadline := time.Now().Add(5 * time.Second)
+	for len(proposals.received()) < 1 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	notifications := proposals.received()
+	require.Equal(t, 1, len(notifications))
+	assert.Equal(t, types.Slot(70), notifications[0].Slot)
+	assert.Equal(t, types.ValidatorIndex(3), notifications[0].ValidatorIndex)
+	assert.Equal(t, (*types.CommitteeIndex)(nil), notifications[0].CommitteeIndex)
+}
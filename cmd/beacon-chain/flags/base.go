@@ -3,6 +3,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/urfave/cli/v2"
 )
@@ -134,10 +136,24 @@ var (
 		Name:  "enable-debug-rpc-endpoints",
 		Usage: "Enables the debug rpc service, containing utility endpoints such as /eth/v1alpha1/beacon/state.",
 	}
+	// EnableAdminRPC enables the admin gRPC service.
+	EnableAdminRPC = &cli.BoolFlag{
+		Name: "enable-admin-rpc",
+		Usage: "Enables the admin rpc service, adjusting the max RPC page size, the state replay concurrency, " +
+			"the RPC cache sizes and the log level at runtime. Overrides are persisted to the data directory " +
+			"and applied again on restart. Restrict it with the admin scope of --rpc-api-keys-file",
+	}
 	SubscribeToAllSubnets = &cli.BoolFlag{
 		Name:  "subscribe-all-subnets",
 		Usage: "Subscribe to all possible attestation subnets.",
 	}
+	// EnableProposerListDigests joins the gossip topic on which Vanguard nodes exchange the digests of their next
+	// epoch proposer lists.
+	EnableProposerListDigests = &cli.BoolFlag{
+		Name: "enable-proposer-list-digests",
+		Usage: "Publishes the digest of the proposer list computed for the next epoch on a gossip topic and collects " +
+			"the digests of peers, to detect peers whose proposer assignments diverge from the ones of this node.",
+	}
 	// HistoricalSlasherNode is a set of beacon node flags required for performing historical detection with a slasher.
 	HistoricalSlasherNode = &cli.BoolFlag{
 		Name:  "historical-slasher-node",
@@ -172,4 +188,158 @@ var (
 		Usage: "Load a genesis state from ssz file. Testnet genesis files can be found in the " +
 			"eth2-clients/eth2-testnets repository on github.",
 	}
+	// AwaitOrchestratorGenesis makes a beacon node without a genesis wait for the orchestrator to submit it.
+	AwaitOrchestratorGenesis = &cli.BoolFlag{
+		Name: "await-orchestrator-genesis",
+		Usage: "If no genesis state is known, serves the genesis service on the RPC address and waits for the " +
+			"orchestrator to submit the genesis time, validator roster and fork schedule of the network before " +
+			"starting. The fork schedule must match the configured one",
+	}
+	// DisableOrchestratorVerification imports received blocks without waiting for the orchestrator to confirm them.
+	DisableOrchestratorVerification = &cli.BoolFlag{
+		Name: "disable-orchestrator-verification",
+		Usage: "Imports blocks without waiting for the orchestrator to confirm their Pandora block through the " +
+			"ConfirmPandoraBlockHashes endpoint of the RPC service. Use for standalone operation without an orchestrator",
+	}
+	// OrchestratorVerificationTimeout defines how long a received block waits for the orchestrator confirmation.
+	OrchestratorVerificationTimeout = &cli.DurationFlag{
+		Name:  "orchestrator-verification-timeout",
+		Usage: "Maximum time a received block is held while waiting for the orchestrator to confirm its Pandora block",
+		Value: 12 * time.Second,
+	}
+	// LogVanDebug enables verbose logging of the orchestrator code paths.
+	LogVanDebug = &cli.BoolFlag{
+		Name: "log-van-debug",
+		Usage: "Logs the debug entries of the orchestrator code paths, such as epoch info computations and " +
+			"streams, at info level. Can be toggled at runtime through the debug rpc endpoints",
+	}
+	// NextEpochGraceSlots defines how many slots before an epoch boundary the next epoch may be queried.
+	NextEpochGraceSlots = &cli.Uint64Flag{
+		Name: "next-epoch-grace-slots",
+		Usage: "Number of slots at the end of an epoch during which assignment queries for the next epoch " +
+			"are admitted instead of rejected as a future epoch. Set to 0 to disable",
+		Value: 1,
+	}
+	// DutyBroadcastLookahead defines how many epochs ahead proposer lists are pushed to orchestrator streams.
+	DutyBroadcastLookahead = &cli.Uint64Flag{
+		Name: "duty-broadcast-lookahead",
+		Usage: "Number of epochs ahead of the head epoch for which proposer lists are pushed to epoch info " +
+			"streams as the head advances. Proposer lists pushed ahead are pushed again with the reorg flag " +
+			"set if they change by the time their epoch starts. Capped at the seed lookahead, beyond which " +
+			"proposers are not yet determined. Set to 0 to disable",
+		Value: 0,
+	}
+	// MaxEpochInfoLookback defines how many epochs before the current epoch proposer lists are served for.
+	MaxEpochInfoLookback = &cli.Uint64Flag{
+		Name: "max-epoch-info-lookback",
+		Usage: "Maximum number of epochs before the current epoch for which proposer lists are regenerated " +
+			"from historical states. Older epochs are rejected as out of range. Set to 0 to disable the limit",
+		Value: 1024,
+	}
+	// EpochInfoWorkers defines how many epoch infos of a range are computed concurrently.
+	EpochInfoWorkers = &cli.IntFlag{
+		Name: "epoch-info-workers",
+		Usage: "Number of proposer lists computed concurrently when a consensus info stream catches up from " +
+			"a past epoch or an epoch info prefetch job runs. They are still sent in epoch order",
+		Value: 4,
+	}
+	// PrecomputationStallSlots defines after how many slots an overdue proposer precomputation is reported.
+	PrecomputationStallSlots = &cli.Uint64Flag{
+		Name: "precomputation-stall-slots",
+		Usage: "Number of slots after the last slot of an epoch within which the proposer list of the next epoch " +
+			"must be precomputed before a stall is reported. Set to 0 to disable the watchdog",
+		Value: 2,
+	}
+	// TrackedValidatorsFile defines the file holding the public keys of the locally relevant validators.
+	TrackedValidatorsFile = &cli.StringFlag{
+		Name: "tracked-validators-file",
+		Usage: "File with one hex encoded validator public key per line. Assignment, balance and performance " +
+			"queries may be restricted to these validators to reduce response sizes. The tracked validators " +
+			"are kept in the database when unset",
+	}
+	// EnableColdStateStore moves finalized archived point states out of the DB into a separate cold state store.
+	EnableColdStateStore = &cli.BoolFlag{
+		Name: "enable-cold-state-store",
+		Usage: "Stores finalized archived point states in append-only segment files next to the beacon chain " +
+			"database instead of inside it, keeping the database small while historical states stay readable",
+	}
+	// SlotTimer defines the source of slot time of the blockchain service.
+	SlotTimer = &cli.StringFlag{
+		Name: "slot-timer",
+		Usage: "Source of slot time: 'clock' follows the wall clock, 'simulated' runs slots of " +
+			"--simulated-slot-duration and 'manual' only advances through the debug RPC endpoints. " +
+			"Anything but 'clock' is meant for testing",
+		Value: "clock",
+	}
+	// SimulatedSlotDuration defines the slot duration of the simulated slot timer.
+	SimulatedSlotDuration = &cli.DurationFlag{
+		Name:  "simulated-slot-duration",
+		Usage: "Duration of a slot when running with --slot-timer=simulated",
+		Value: time.Second,
+	}
+	// DutyWebhookConfig defines the YAML file configuring the duty notification webhooks.
+	DutyWebhookConfig = &cli.StringFlag{
+		Name: "duty-webhook-config",
+		Usage: "YAML file with the webhooks receiving a JSON POST when a tracked validator is assigned a " +
+			"proposer slot in the upcoming epoch or misses an attestation",
+	}
+	// EnableValidatorMonitor enables the monitor service of the tracked validators.
+	EnableValidatorMonitor = &cli.BoolFlag{
+		Name: "enable-validator-monitor",
+		Usage: "Exports per validator metrics of the tracked validators, counting their assigned duties, " +
+			"included attestations and proposed blocks along with their balance, and logs notable events " +
+			"concerning them",
+	}
+	// RPCAPIKeysFile defines the file holding the API keys required to call the gRPC and JSON-HTTP endpoints.
+	RPCAPIKeysFile = &cli.StringFlag{
+		Name: "rpc-api-keys-file",
+		Usage: "YAML file with the API keys callers must present as an \"Authorization: Bearer <token>\" header, " +
+			"each granting a subset of the read, archive, validator, debug and admin scopes. All endpoints are open if unset",
+	}
+	// RPCShutdownDrainPeriod defines how long in-flight RPC calls may take to complete on shutdown.
+	RPCShutdownDrainPeriod = &cli.DurationFlag{
+		Name: "rpc-shutdown-drain-period",
+		Usage: "Maximum time in-flight RPC calls may take to complete on shutdown, after the duty and consensus " +
+			"info streams were ended with a shutting down status, before the remaining connections are closed",
+		Value: 5 * time.Second,
+	}
+	// RPCMaxStateReplays defines how many state regenerations RPC calls may have in flight.
+	RPCMaxStateReplays = &cli.IntFlag{
+		Name: "rpc-max-state-replays",
+		Usage: "Maximum number of historical state regenerations RPC calls may have in flight. Further calls " +
+			"needing one are rejected as unavailable with a retry hint, while cached and head queries are " +
+			"still served. Set to 0 to disable load shedding",
+		Value: 8,
+	}
+	// RPCPriorityWorkers defines how many unary RPC calls are reserved to the orchestrator traffic.
+	RPCPriorityWorkers = &cli.IntFlag{
+		Name: "rpc-priority-workers",
+		Usage: "Number of unary RPC calls served concurrently for the API keys marked as priority, on top of " +
+			"--rpc-shared-workers, so that other callers cannot starve the orchestrator. Requires " +
+			"--rpc-api-keys-file. Set to 0 to serve every call without limit",
+		Value: 0,
+	}
+	// RPCSharedWorkers defines how many unary RPC calls of any caller are served concurrently.
+	RPCSharedWorkers = &cli.IntFlag{
+		Name: "rpc-shared-workers",
+		Usage: "Number of unary RPC calls of any caller served concurrently when --rpc-priority-workers is set. " +
+			"Further calls wait for a worker until their deadline",
+		Value: 64,
+	}
+	// RPCMaxResponseBytes defines the byte budget of paginated assignment responses.
+	RPCMaxResponseBytes = &cli.Uint64Flag{
+		Name: "rpc-max-response-bytes",
+		Usage: "Byte budget of paginated validator assignment responses. Requests whose page would exceed it " +
+			"are served with a smaller page, whose size is returned in the applied_page_size field of " +
+			"ListAnnotatedValidatorAssignments. " +
+			"Set to 0 to disable",
+		Value: 16 << 20,
+	}
+	// RPCSlowCallThreshold defines after how long unary RPC calls are logged as slow.
+	RPCSlowCallThreshold = &cli.DurationFlag{
+		Name: "rpc-slow-call-threshold",
+		Usage: "Duration after which unary RPC calls are logged as slow, along with their estimated cost. " +
+			"Calls with a high estimated cost are logged regardless. Set to 0 to only log expensive calls",
+		Value: 2 * time.Second,
+	}
 )
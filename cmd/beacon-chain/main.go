@@ -14,6 +14,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/node"
 	dbcommands "github.com/prysmaticlabs/prysm/cmd/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/proposers"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
@@ -54,13 +55,37 @@ var appFlags = []cli.Flag{
 	flags.InteropGenesisTimeFlag,
 	flags.SlotsPerArchivedPoint,
 	flags.EnableDebugRPCEndpoints,
+	flags.EnableAdminRPC,
 	flags.SubscribeToAllSubnets,
+	flags.EnableProposerListDigests,
 	flags.HistoricalSlasherNode,
 	flags.ChainID,
 	flags.NetworkID,
 	flags.WeakSubjectivityCheckpt,
 	flags.Eth1HeaderReqLimit,
 	flags.GenesisStatePath,
+	flags.AwaitOrchestratorGenesis,
+	flags.DisableOrchestratorVerification,
+	flags.OrchestratorVerificationTimeout,
+	flags.LogVanDebug,
+	flags.NextEpochGraceSlots,
+	flags.MaxEpochInfoLookback,
+	flags.EpochInfoWorkers,
+	flags.DutyBroadcastLookahead,
+	flags.PrecomputationStallSlots,
+	flags.EnableColdStateStore,
+	flags.TrackedValidatorsFile,
+	flags.SlotTimer,
+	flags.SimulatedSlotDuration,
+	flags.DutyWebhookConfig,
+	flags.EnableValidatorMonitor,
+	flags.RPCAPIKeysFile,
+	flags.RPCShutdownDrainPeriod,
+	flags.RPCMaxStateReplays,
+	flags.RPCPriorityWorkers,
+	flags.RPCSharedWorkers,
+	flags.RPCMaxResponseBytes,
+	flags.RPCSlowCallThreshold,
 	cmd.EnableBackupWebhookFlag,
 	cmd.BackupWebhookOutputDir,
 	cmd.MinimalConfigFlag,
@@ -124,6 +149,7 @@ func main() {
 	app.Version = version.Version()
 	app.Commands = []*cli.Command{
 		dbcommands.Commands,
+		proposers.Command,
 	}
 
 	app.Flags = appFlags
@@ -0,0 +1,217 @@
+// Package proposers defines a command to check the proposer list of an epoch offline.
+package proposers
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/freezer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc"
+)
+
+var log = logrus.WithField("prefix", "proposers")
+
+// remoteTimeout bounds the time to receive the epoch info of the remote node.
+const remoteTimeout = 30 * time.Second
+
+var (
+	epochFlag = &cli.Uint64Flag{
+		Name:     "epoch",
+		Usage:    "The epoch to verify the proposer list of",
+		Required: true,
+	}
+	remoteNodeFlag = &cli.StringFlag{
+		Name:  "remote-node",
+		Usage: "The gRPC endpoint of a beacon node to compare the proposer list against, e.g. 127.0.0.1:4000",
+	}
+)
+
+// Command recomputes the proposer list of an epoch from the database of a stopped beacon node,
+// and compares it against the persisted epoch info and the epoch info served by another node.
+var Command = &cli.Command{
+	Name:     "verify-proposers",
+	Category: "debug",
+	Usage:    "recomputes the proposer list of an epoch from the database and reports any divergence",
+	Flags: cmd.WrapFlags([]cli.Flag{
+		cmd.DataDirFlag,
+		cmd.ChainConfigFileFlag,
+		flags.EnableColdStateStore,
+		epochFlag,
+		remoteNodeFlag,
+	}),
+	Action: func(cliCtx *cli.Context) error {
+		if err := verify(cliCtx); err != nil {
+			log.Fatalf("Could not verify proposers: %v", err)
+		}
+		return nil
+	},
+}
+
+// divergence is a slot whose proposer differs between the recomputed proposer list and another
+// source of it.
+type divergence struct {
+	source   string
+	slot     types.Slot
+	computed [48]byte
+	found    [48]byte
+}
+
+func verify(cliCtx *cli.Context) error {
+	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
+		params.LoadChainConfigFile(cliCtx.String(cmd.ChainConfigFileFlag.Name))
+	}
+	ctx := cliCtx.Context
+	dbPath := filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName)
+	beaconDB, err := db.NewDB(ctx, dbPath, &kv.Config{})
+	if err != nil {
+		return errors.Wrap(err, "could not open database")
+	}
+	defer func() {
+		if err := beaconDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+	stateGen := stategen.New(beaconDB)
+	if cliCtx.Bool(flags.EnableColdStateStore.Name) {
+		store, err := freezer.Open(filepath.Join(dbPath, freezer.DirName), freezer.DefaultSegmentSize)
+		if err != nil {
+			return errors.Wrap(err, "could not open cold state store")
+		}
+		defer func() {
+			if err := store.Close(); err != nil {
+				log.WithError(err).Error("Could not close cold state store")
+			}
+		}()
+		stateGen.EnableColdStateStore(store)
+	}
+
+	var client pbrpc.BeaconQueryClient
+	if endpoint := cliCtx.String(remoteNodeFlag.Name); endpoint != "" {
+		c, err := grpc.DialContext(ctx, endpoint, grpc.WithInsecure())
+		if err != nil {
+			return errors.Wrapf(err, "could not dial %s", endpoint)
+		}
+		defer func() {
+			if err := c.Close(); err != nil {
+				log.WithError(err).Error("Could not close remote node connection")
+			}
+		}()
+		client = pbrpc.NewBeaconQueryClient(c)
+	}
+
+	epoch := types.Epoch(cliCtx.Uint64(epochFlag.Name))
+	divergences, err := verifyEpoch(ctx, beaconDB, stateGen, client, epoch)
+	if err != nil {
+		return err
+	}
+	for _, d := range divergences {
+		log.WithFields(logrus.Fields{
+			"source":   d.source,
+			"slot":     d.slot,
+			"computed": fmt.Sprintf("%#x", d.computed),
+			"found":    fmt.Sprintf("%#x", d.found),
+		}).Error("Proposer diverges from the recomputed proposer list")
+	}
+	if len(divergences) > 0 {
+		return errors.Errorf("%d proposers of epoch %d diverge", len(divergences), epoch)
+	}
+	log.WithField("epoch", epoch).Info("Proposer list matches every source")
+	return nil
+}
+
+// verifyEpoch recomputes the proposer list of an epoch from the state at its start slot, and
+// returns the slots whose proposer differs in the persisted epoch info or in the epoch info
+// served by client, if set. Only finalized epoch infos are persisted, so a missing one is not a
+// divergence.
+func verifyEpoch(
+	ctx context.Context, store orchestrator.EpochInfoStore, stateGen stategen.StateManager, client pbrpc.BeaconQueryClient, epoch types.Epoch,
+) ([]divergence, error) {
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, err
+	}
+	st, err := stateGen.StateBySlot(ctx, startSlot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not regenerate state at slot %d", startSlot)
+	}
+	if st == nil {
+		return nil, errors.Errorf("no state available at slot %d", startSlot)
+	}
+	computed, err := orchestrator.EpochProposers(nil, st.Copy(), epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute proposers")
+	}
+	root, err := orchestrator.ProposerListRoot(computed)
+	if err != nil {
+		return nil, err
+	}
+	log.WithFields(logrus.Fields{
+		"epoch":            epoch,
+		"proposerListRoot": fmt.Sprintf("%#x", root),
+	}).Info("Recomputed proposer list")
+
+	var divergences []divergence
+	persisted, err := store.EpochInfo(ctx, epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read persisted epoch info")
+	}
+	if persisted == nil {
+		log.WithField("epoch", epoch).Info("No persisted epoch info, the epoch may not be finalized")
+	} else {
+		divergences = append(divergences, compareProposers("database", startSlot, computed, persisted.Proposers)...)
+	}
+
+	if client != nil {
+		remoteCtx, cancel := context.WithTimeout(ctx, remoteTimeout)
+		defer cancel()
+		stream, err := orchestrator.NewEpochInfoStream(remoteCtx, client, epoch, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not open remote epoch info stream")
+		}
+		remote, err := stream.Recv()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not receive remote epoch info")
+		}
+		if remote.Epoch != epoch {
+			return nil, errors.Errorf("remote node sent epoch %d, wanted epoch %d", remote.Epoch, epoch)
+		}
+		divergences = append(divergences, compareProposers("remote", startSlot, computed, remote.Proposers)...)
+	}
+	return divergences, nil
+}
+
+// compareProposers returns the slots whose proposer in found differs from computed. Slots missing
+// from found are compared as zeroed proposers.
+func compareProposers(source string, startSlot types.Slot, computed, found [][48]byte) []divergence {
+	var divergences []divergence
+	for i, proposer := range computed {
+		var other [48]byte
+		if i < len(found) {
+			other = found[i]
+		}
+		if other != proposer {
+			divergences = append(divergences, divergence{
+				source:   source,
+				slot:     startSlot + types.Slot(i),
+				computed: proposer,
+				found:    other,
+			})
+		}
+	}
+	return divergences
+}
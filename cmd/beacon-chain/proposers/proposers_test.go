@@ -0,0 +1,88 @@
+package proposers
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+// epochInfoClient serves an epoch info stream sending a single epoch info.
+type epochInfoClient struct {
+	pbrpc.BeaconQueryClient
+	info *orchestrator.EpochInfo
+}
+
+func (c *epochInfoClient) StreamEpochInfo(context.Context, ...grpc.CallOption) (pbrpc.BeaconQuery_StreamEpochInfoClient, error) {
+	return &epochInfoClientStream{info: c.info}, nil
+}
+
+type epochInfoClientStream struct {
+	grpc.ClientStream
+	info *orchestrator.EpochInfo
+}
+
+func (s *epochInfoClientStream) Send(*pbrpc.EpochInfoStreamMessage) error { return nil }
+
+func (s *epochInfoClientStream) CloseSend() error { return nil }
+
+func (s *epochInfoClientStream) Recv() (*pbrpc.EpochInfo, error) {
+	return s.info.ToProto()
+}
+
+func TestVerifyEpoch(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	genesisState, _ := testutil.DeterministicGenesisState(t, 64)
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, beaconDB.SaveBlock(ctx, genesis))
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveState(ctx, genesisState, genesisRoot))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+	stateGen := stategen.New(beaconDB)
+
+	epoch := types.Epoch(1)
+	startSlot := params.BeaconConfig().SlotsPerEpoch
+	st, err := stateGen.StateBySlot(ctx, startSlot)
+	require.NoError(t, err)
+	want, err := orchestrator.EpochProposers(nil, st, epoch)
+	require.NoError(t, err)
+
+	// Nothing to compare against yet.
+	divergences, err := verifyEpoch(ctx, beaconDB, stateGen, nil, epoch)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(divergences))
+
+	require.NoError(t, beaconDB.SaveEpochInfo(ctx, &orchestrator.EpochInfo{Epoch: epoch, Proposers: want}))
+	tampered := append([][48]byte{}, want...)
+	tampered[3] = [48]byte{'x'}
+	client := &epochInfoClient{info: &orchestrator.EpochInfo{Epoch: epoch, Proposers: tampered}}
+	divergences, err = verifyEpoch(ctx, beaconDB, stateGen, client, epoch)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []divergence{{
+		source:   "remote",
+		slot:     startSlot + 3,
+		computed: want[3],
+		found:    [48]byte{'x'},
+	}}, divergences)
+
+	client.info.Epoch = epoch + 1
+	_, err = verifyEpoch(ctx, beaconDB, stateGen, client, epoch)
+	assert.ErrorContains(t, "remote node sent epoch 2, wanted epoch 1", err)
+}
+
+func TestCompareProposers_MissingSlots(t *testing.T) {
+	computed := [][48]byte{{'a'}, {'b'}}
+	divergences := compareProposers("database", 32, computed, computed[:1])
+	assert.DeepEqual(t, []divergence{{source: "database", slot: 33, computed: [48]byte{'b'}}}, divergences)
+}
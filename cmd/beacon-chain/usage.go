@@ -113,13 +113,37 @@ var appHelpFlagGroups = []flagGroup{
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
 			flags.EnableDebugRPCEndpoints,
+			flags.EnableAdminRPC,
 			flags.SubscribeToAllSubnets,
+			flags.EnableProposerListDigests,
 			flags.HistoricalSlasherNode,
 			flags.ChainID,
 			flags.NetworkID,
 			flags.WeakSubjectivityCheckpt,
 			flags.Eth1HeaderReqLimit,
 			flags.GenesisStatePath,
+			flags.AwaitOrchestratorGenesis,
+			flags.DisableOrchestratorVerification,
+			flags.OrchestratorVerificationTimeout,
+			flags.LogVanDebug,
+			flags.NextEpochGraceSlots,
+			flags.MaxEpochInfoLookback,
+			flags.EpochInfoWorkers,
+			flags.DutyBroadcastLookahead,
+			flags.PrecomputationStallSlots,
+			flags.EnableColdStateStore,
+			flags.TrackedValidatorsFile,
+			flags.SlotTimer,
+			flags.SimulatedSlotDuration,
+			flags.DutyWebhookConfig,
+			flags.EnableValidatorMonitor,
+			flags.RPCAPIKeysFile,
+			flags.RPCShutdownDrainPeriod,
+			flags.RPCMaxStateReplays,
+			flags.RPCPriorityWorkers,
+			flags.RPCSharedWorkers,
+			flags.RPCMaxResponseBytes,
+			flags.RPCSlowCallThreshold,
 		},
 	},
 	{
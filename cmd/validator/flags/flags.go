@@ -317,6 +317,13 @@ var (
 		Usage: "Enables more verbose logging for counting down to duty",
 		Value: false,
 	}
+	// ProposerDutiesFromStream sources the proposer duties from the epoch info stream of the beacon node.
+	ProposerDutiesFromStream = &cli.BoolFlag{
+		Name: "proposer-duties-from-stream",
+		Usage: "Follows the epoch info stream of the beacon node for the proposer duties, which are pushed " +
+			"once per epoch and updated on reorgs. The duties are updated when the stream sends an epoch, " +
+			"and only polled at every epoch while the stream is down",
+	}
 )
 
 // DefaultValidatorDir returns OS-specific default validator directory.
@@ -107,6 +107,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.WalletPasswordFileFlag,
 			flags.GraffitiFileFlag,
 			flags.EnableDutyCountDown,
+			flags.ProposerDutiesFromStream,
 			pandora.PandoraRpcIpcProviderFlag,
 			pandora.PandoraRpcHttpProviderFlag,
 		},
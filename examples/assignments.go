@@ -0,0 +1,30 @@
+package examples
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/client"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// FetchAssignments retrieves the committee and proposer assignments of the given public keys for
+// an epoch, keyed by public key. Keys of validators unknown to the node have no assignment.
+func FetchAssignments(
+	ctx context.Context,
+	c *client.Client,
+	epoch types.Epoch,
+	pubKeys [][]byte,
+) (map[[48]byte]*ethpb.ValidatorAssignments_CommitteeAssignment, error) {
+	assignments, err := c.Assignments(ctx, epoch, pubKeys)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch assignments")
+	}
+	byKey := make(map[[48]byte]*ethpb.ValidatorAssignments_CommitteeAssignment, len(assignments))
+	for _, a := range assignments {
+		byKey[bytesutil.ToBytes48(a.PublicKey)] = a
+	}
+	return byKey, nil
+}
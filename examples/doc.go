@@ -0,0 +1,6 @@
+// Package examples contains small, runnable programs showing how to integrate against the beacon
+// node APIs of this fork through the beacon-chain/rpc/client package. Every example is exercised
+// by the tests of this package against an in-process beacon node RPC service backed by a real
+// database and a mock chain, so the examples always compile and stay in sync with the API they
+// document.
+package examples
@@ -0,0 +1,40 @@
+package examples
+
+import (
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/client"
+)
+
+// EpochInfoHandler is invoked with every epoch info received from the epoch info stream. An epoch
+// info is received again if a reorg changed its proposers.
+type EpochInfoHandler func(info *orchestrator.EpochInfo) error
+
+// SubscribeEpochInfo follows the epoch info stream of a beacon node from the given epoch, and
+// calls the handler with the epoch info of every epoch. It returns when the stream ends, the
+// context is canceled or the handler returns an error.
+func SubscribeEpochInfo(ctx context.Context, c *client.Client, from types.Epoch, handler EpochInfoHandler) error {
+	stream, err := c.EpochInfos(ctx, from)
+	if ctx.Err() != nil {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for {
+		info, err := stream.Recv()
+		if err == io.EOF || ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "could not receive epoch info")
+		}
+		if err := handler(info); err != nil {
+			return err
+		}
+	}
+}
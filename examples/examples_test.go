@@ -0,0 +1,139 @@
+package examples
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+var errDone = errors.New("done")
+
+func TestFetchAssignments(t *testing.T) {
+	h := setupHarness(t)
+	st := h.chain.States[params.BeaconConfig().SlotsPerEpoch]
+	pubKeys := make([][]byte, 4)
+	for i := range pubKeys {
+		pubKey := st.PubkeyAtIndex(types.ValidatorIndex(i))
+		pubKeys[i] = pubKey[:]
+	}
+
+	assignments, err := FetchAssignments(context.Background(), h.client, 1, pubKeys)
+	require.NoError(t, err)
+	wanted, _, err := helpers.CommitteeAssignments(context.Background(), nil, st, 1)
+	require.NoError(t, err)
+	require.Equal(t, len(pubKeys), len(assignments))
+	for i, pubKey := range pubKeys {
+		a, ok := assignments[bytesutil.ToBytes48(pubKey)]
+		require.Equal(t, true, ok)
+		assert.Equal(t, types.ValidatorIndex(i), a.ValidatorIndex)
+		assert.Equal(t, wanted[types.ValidatorIndex(i)].AttesterSlot, a.AttesterSlot)
+		assert.Equal(t, wanted[types.ValidatorIndex(i)].CommitteeIndex, a.CommitteeIndex)
+	}
+}
+
+func TestSubscribeEpochInfo(t *testing.T) {
+	h := setupHarness(t)
+
+	var infos []*orchestrator.EpochInfo
+	err := SubscribeEpochInfo(context.Background(), h.client, 0, func(info *orchestrator.EpochInfo) error {
+		infos = append(infos, info)
+		if info.Epoch == 2 {
+			return errDone
+		}
+		return nil
+	})
+	require.ErrorContains(t, errDone.Error(), err)
+	require.Equal(t, 3, len(infos))
+	for i, info := range infos {
+		assert.Equal(t, types.Epoch(i), info.Epoch)
+	}
+	// The proposers of a past epoch are the proposers of its blocks.
+	for _, blk := range h.chain.Blocks[1:] {
+		if helpers.SlotToEpoch(blk.Block.Slot) != 1 {
+			continue
+		}
+		idx := blk.Block.Slot % params.BeaconConfig().SlotsPerEpoch
+		assert.Equal(t, h.chain.Genesis.PubkeyAtIndex(blk.Block.ProposerIndex), infos[1].Proposers[idx])
+	}
+}
+
+func TestWatchReorgs(t *testing.T) {
+	h := setupHarness(t)
+	go h.playHeads(h.reorgScript()...)
+
+	var reorgs [][2]types.Slot
+	err := WatchReorgs(context.Background(), h.client, func(oldHead, newHead *ethpb.ChainHead) error {
+		reorgs = append(reorgs, [2]types.Slot{oldHead.HeadSlot, newHead.HeadSlot})
+		if len(reorgs) == 3 {
+			return errDone
+		}
+		return nil
+	})
+	require.ErrorContains(t, errDone.Error(), err)
+	// The extension from block 66 to block 67 is not a reorg.
+	assert.DeepEqual(t, [][2]types.Slot{{67, 67}, {67, 69}, {69, 68}}, reorgs)
+}
+
+func TestWatchReorgs_ContextCanceled(t *testing.T) {
+	h := setupHarness(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.NoError(t, WatchReorgs(ctx, h.client, func(*ethpb.ChainHead, *ethpb.ChainHead) error {
+		return errDone
+	}))
+}
+
+func ExampleFetchAssignments() {
+	h, cleanup, err := startHarness()
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	pubKey := h.chain.Genesis.PubkeyAtIndex(0)
+	assignments, err := FetchAssignments(context.Background(), h.client, 1, [][]byte{pubKey[:]})
+	if err != nil {
+		panic(err)
+	}
+	for _, a := range assignments {
+		fmt.Printf("validator %d attests in epoch 1: %t\n", a.ValidatorIndex, helpers.SlotToEpoch(a.AttesterSlot) == 1)
+	}
+	// Output:
+	// validator 0 attests in epoch 1: true
+}
+
+func ExampleWatchReorgs() {
+	h, cleanup, err := startHarness()
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+	go h.playHeads(h.reorgScript()...)
+
+	reorgs := 0
+	err = WatchReorgs(context.Background(), h.client, func(oldHead, newHead *ethpb.ChainHead) error {
+		fmt.Printf("reorg from slot %d to slot %d\n", oldHead.HeadSlot, newHead.HeadSlot)
+		if reorgs++; reorgs == 3 {
+			return errDone
+		}
+		return nil
+	})
+	if err != errDone {
+		panic(err)
+	}
+	// Output:
+	// reorg from slot 67 to slot 67
+	// reorg from slot 67 to slot 69
+	// reorg from slot 69 to slot 68
+}
@@ -0,0 +1,266 @@
+package examples
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/client"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+const (
+	// harnessBlocks is the number of blocks of the chain served by the harness, which spans the
+	// first three epochs.
+	harnessBlocks = 68
+	// harnessMaxMsgSize is the largest message the RPC service of the harness receives.
+	harnessMaxMsgSize = 1 << 20
+	// headStreamMethod is the method whose calls of HeadBlock serve the chain head stream.
+	headStreamMethod = "/ethereum.eth.v1alpha1.BeaconChain/StreamChainHead"
+)
+
+func init() {
+	logrus.SetLevel(logrus.DebugLevel)
+	logrus.SetOutput(ioutil.Discard)
+}
+
+// scriptedChain is a mock chain whose head block is set by the test. It reports every head block
+// served to the chain head stream, so that the test moves the head only once a subscriber saw it.
+type scriptedChain struct {
+	*mock.ChainService
+	lock   sync.Mutex
+	head   *ethpb.SignedBeaconBlock
+	served chan struct{}
+}
+
+// HeadBlock returns the head block set by the test.
+func (c *scriptedChain) HeadBlock(ctx context.Context) (*ethpb.SignedBeaconBlock, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if method, ok := grpc.Method(ctx); ok && method == headStreamMethod {
+		select {
+		case c.served <- struct{}{}:
+		default:
+		}
+	}
+	return c.head, nil
+}
+
+// harness is a beacon node RPC service serving a deterministic chain from a database, along with
+// blocks of other branches, and a client connected to it.
+type harness struct {
+	chain  *testutil.Chain
+	forks  map[string]*ethpb.SignedBeaconBlock
+	mock   *scriptedChain
+	client *client.Client
+}
+
+// startHarness starts the RPC service and returns the harness along with a function tearing it
+// down.
+func startHarness() (*harness, func(), error) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "examples")
+	if err != nil {
+		return nil, nil, err
+	}
+	db, err := kv.NewKVStore(ctx, dir, &kv.Config{})
+	if err != nil {
+		return nil, nil, err
+	}
+	teardown := []func(){func() {
+		_ = db.Close()
+		_ = os.RemoveAll(dir)
+	}}
+	cleanup := func() {
+		for i := len(teardown) - 1; i >= 0; i-- {
+			teardown[i]()
+		}
+	}
+	h, err := newHarness(ctx, db)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	port, err := freePort()
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	service := rpc.NewService(ctx, &rpc.Config{
+		Host:                "127.0.0.1",
+		Port:                port,
+		BeaconDB:            db,
+		HeadFetcher:         h.mock,
+		CanonicalFetcher:    h.mock,
+		ChainInfoFetcher:    h.mock,
+		FinalizationFetcher: h.mock,
+		GenesisTimeFetcher:  h.mock,
+		GenesisFetcher:      h.mock,
+		ForkFetcher:         h.mock,
+		BlockReceiver:       h.mock,
+		AttestationReceiver: h.mock,
+		StateNotifier:       h.mock.StateNotifier(),
+		BlockNotifier:       h.mock.BlockNotifier(),
+		SyncService:         &mockSync.Sync{IsSyncing: false},
+		POWChainService:     &mockPOW.POWChain{},
+		StateGen:            stategen.New(db),
+		MaxMsgSize:          harnessMaxMsgSize,
+		ShutdownDrainPeriod: time.Second,
+	})
+	service.Start()
+	teardown = append(teardown, func() {
+		_ = service.Stop()
+	})
+	dialCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(dialCtx, "127.0.0.1:"+port, grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	teardown = append(teardown, func() {
+		_ = conn.Close()
+	})
+	h.client = client.New(conn)
+	return h, cleanup, nil
+}
+
+func setupHarness(t *testing.T) *harness {
+	h, cleanup, err := startHarness()
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+	return h
+}
+
+// newHarness saves the chain of the harness, which has a block at every slot, and two blocks of
+// other branches: "a" competes with block 67 for its slot and "b" at slot 69 builds on block 67.
+func newHarness(ctx context.Context, db *kv.Store) (*harness, error) {
+	chain, err := testutil.NewChainBuilder(harnessBlocks).Build(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := chain.SaveTo(ctx, db); err != nil {
+		return nil, err
+	}
+	forks := map[string]*ethpb.SignedBeaconBlock{
+		"a": forkBlock(harnessBlocks-1, chain.Roots[harnessBlocks-2], 'a'),
+		"b": forkBlock(harnessBlocks+1, chain.Roots[harnessBlocks-1], 'b'),
+	}
+	canonical := make(map[[32]byte]bool, len(chain.Roots))
+	for _, root := range chain.Roots {
+		canonical[root] = true
+	}
+	for _, blk := range forks {
+		if err := db.SaveBlock(ctx, blk); err != nil {
+			return nil, err
+		}
+	}
+	headSlot := chain.Head.Slot()
+	headRoot := chain.Roots[len(chain.Roots)-1]
+	genesisCheckpoint := &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	return &harness{
+		chain: chain,
+		forks: forks,
+		mock: &scriptedChain{
+			ChainService: &mock.ChainService{
+				State:                       chain.Head,
+				Root:                        headRoot[:],
+				Slot:                        &headSlot,
+				Genesis:                     time.Unix(int64(chain.Genesis.GenesisTime()), 0),
+				ValidatorsRoot:              bytesutil.ToBytes32(chain.Genesis.GenesisValidatorRoot()),
+				Fork:                        chain.Head.Fork(),
+				CanonicalRoots:              canonical,
+				FinalizedCheckPoint:         genesisCheckpoint,
+				CurrentJustifiedCheckPoint:  genesisCheckpoint,
+				PreviousJustifiedCheckPoint: genesisCheckpoint,
+			},
+			head:   chain.Blocks[len(chain.Blocks)-1],
+			served: make(chan struct{}, 1),
+		},
+	}, nil
+}
+
+// forkBlock returns an empty block of the slot on the given parent, whose graffiti tells it apart
+// from the blocks of other branches.
+func forkBlock(slot types.Slot, parent [32]byte, graffiti byte) *ethpb.SignedBeaconBlock {
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = slot
+	blk.Block.ParentRoot = parent[:]
+	blk.Block.Body.Graffiti[0] = graffiti
+	return blk
+}
+
+// playHeads makes each block the head in turn, notifying the chain head stream until it served
+// the block. Notifications sent before the stream subscribed are lost, so they are repeated.
+func (h *harness) playHeads(blocks ...*ethpb.SignedBeaconBlock) {
+	for _, blk := range blocks {
+		h.mock.lock.Lock()
+		h.mock.head = blk
+		select {
+		case <-h.mock.served:
+		default:
+		}
+		h.mock.lock.Unlock()
+		root, err := blk.Block.HashTreeRoot()
+		if err != nil {
+			panic(err)
+		}
+		for served := false; !served; {
+			h.mock.StateNotifier().StateFeed().Send(&feed.Event{
+				Type: statefeed.BlockProcessed,
+				Data: &statefeed.BlockProcessedData{Slot: blk.Block.Slot, BlockRoot: root, SignedBlock: blk},
+			})
+			select {
+			case <-h.mock.served:
+				served = true
+			case <-time.After(50 * time.Millisecond):
+			}
+		}
+	}
+}
+
+// reorgScript is the head sequence of the reorg examples: the chain extends to block 67, the
+// competing block "a" replaces it, block "b" of another branch takes over at a higher slot, and
+// block 68 becomes the head again at a lower slot.
+func (h *harness) reorgScript() []*ethpb.SignedBeaconBlock {
+	return []*ethpb.SignedBeaconBlock{
+		h.chain.Blocks[harnessBlocks-2],
+		h.chain.Blocks[harnessBlocks-1],
+		h.forks["a"],
+		h.forks["b"],
+		h.chain.Blocks[harnessBlocks],
+	}
+}
+
+// freePort returns a port which was free when it was picked.
+func freePort() (string, error) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+	port := lis.Addr().(*net.TCPAddr).Port
+	if err := lis.Close(); err != nil {
+		return "", err
+	}
+	return strconv.Itoa(port), nil
+}
@@ -0,0 +1,64 @@
+package examples
+
+import (
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/client"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// ReorgHandler is invoked with the previous and the new head whenever a reorg is detected.
+type ReorgHandler func(oldHead, newHead *ethpb.ChainHead) error
+
+// WatchReorgs subscribes to the chain head stream of a beacon node and calls the handler whenever
+// the new head does not descend from the previous one. This covers a different block for the same
+// slot and the head slot going backwards, as well as a switch to another branch at a higher slot.
+// It returns when the stream ends, the context is canceled or the handler returns an error.
+func WatchReorgs(ctx context.Context, c *client.Client, handler ReorgHandler) error {
+	stream, err := c.ChainHeads(ctx)
+	if ctx.Err() != nil {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var previous *ethpb.ChainHead
+	for {
+		head, err := stream.Recv()
+		if err == io.EOF || ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "could not receive chain head")
+		}
+		if previous != nil {
+			reorg, err := isReorg(ctx, c, previous, head)
+			if err != nil {
+				return err
+			}
+			if reorg {
+				if err := handler(previous, head); err != nil {
+					return err
+				}
+			}
+		}
+		previous = head
+	}
+}
+
+// isReorg returns true if the previous head is not an ancestor of the new head.
+func isReorg(ctx context.Context, c *client.Client, oldHead, newHead *ethpb.ChainHead) (bool, error) {
+	descends, err := c.IsAncestor(
+		ctx,
+		bytesutil.ToBytes32(oldHead.HeadBlockRoot),
+		oldHead.HeadSlot,
+		bytesutil.ToBytes32(newHead.HeadBlockRoot),
+	)
+	if err != nil {
+		return false, errors.Wrap(err, "could not check the ancestry of the new head")
+	}
+	return !descends, nil
+}
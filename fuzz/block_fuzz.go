@@ -188,7 +188,7 @@ func BeaconFuzzBlock(b []byte) {
 		_ = err
 	}
 
-	if _, err := state.ProcessBlock(ctx, st, input.Block); err != nil {
+	if _, err := state.ProcessBlock(ctx, nil, st, input.Block); err != nil {
 		_ = err
 	}
 }
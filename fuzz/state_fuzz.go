@@ -33,7 +33,7 @@ func BeaconStateFuzz(input []byte) {
 	if err != nil {
 		return
 	}
-	if _, err := stateutil.ProcessSlots(context.Background(), s, slot); err != nil {
+	if _, err := stateutil.ProcessSlots(context.Background(), nil, s, slot); err != nil {
 		_ = err
 		return
 	}
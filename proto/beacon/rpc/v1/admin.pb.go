@@ -0,0 +1,831 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/rpc/v1/admin.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type AdminSettings struct {
+	// Types that are valid to be assigned to MaxPageSizeSetting:
+	//	*AdminSettings_MaxPageSize
+	MaxPageSizeSetting isAdminSettings_MaxPageSizeSetting `protobuf_oneof:"max_page_size_setting"`
+	// Types that are valid to be assigned to MaxStateReplaysSetting:
+	//	*AdminSettings_MaxStateReplays
+	MaxStateReplaysSetting isAdminSettings_MaxStateReplaysSetting `protobuf_oneof:"max_state_replays_setting"`
+	// Types that are valid to be assigned to EpochInfoCacheSizeSetting:
+	//	*AdminSettings_EpochInfoCacheSize
+	EpochInfoCacheSizeSetting isAdminSettings_EpochInfoCacheSizeSetting `protobuf_oneof:"epoch_info_cache_size_setting"`
+	// Types that are valid to be assigned to EpochStateCacheSizeSetting:
+	//	*AdminSettings_EpochStateCacheSize
+	EpochStateCacheSizeSetting isAdminSettings_EpochStateCacheSizeSetting `protobuf_oneof:"epoch_state_cache_size_setting"`
+	// Types that are valid to be assigned to LogLevelSetting:
+	//	*AdminSettings_LogLevel
+	LogLevelSetting      isAdminSettings_LogLevelSetting `protobuf_oneof:"log_level_setting"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *AdminSettings) Reset()         { *m = AdminSettings{} }
+func (m *AdminSettings) String() string { return proto.CompactTextString(m) }
+func (*AdminSettings) ProtoMessage()    {}
+func (*AdminSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dc8eca9b17943ec, []int{0}
+}
+func (m *AdminSettings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminSettings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminSettings.Merge(m, src)
+}
+func (m *AdminSettings) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminSettings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminSettings proto.InternalMessageInfo
+
+type isAdminSettings_MaxPageSizeSetting interface {
+	isAdminSettings_MaxPageSizeSetting()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+type isAdminSettings_MaxStateReplaysSetting interface {
+	isAdminSettings_MaxStateReplaysSetting()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+type isAdminSettings_EpochInfoCacheSizeSetting interface {
+	isAdminSettings_EpochInfoCacheSizeSetting()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+type isAdminSettings_EpochStateCacheSizeSetting interface {
+	isAdminSettings_EpochStateCacheSizeSetting()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+type isAdminSettings_LogLevelSetting interface {
+	isAdminSettings_LogLevelSetting()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type AdminSettings_MaxPageSize struct {
+	MaxPageSize uint64 `protobuf:"varint,1,opt,name=max_page_size,json=maxPageSize,proto3,oneof" json:"max_page_size,omitempty"`
+}
+type AdminSettings_MaxStateReplays struct {
+	MaxStateReplays uint64 `protobuf:"varint,2,opt,name=max_state_replays,json=maxStateReplays,proto3,oneof" json:"max_state_replays,omitempty"`
+}
+type AdminSettings_EpochInfoCacheSize struct {
+	EpochInfoCacheSize uint64 `protobuf:"varint,3,opt,name=epoch_info_cache_size,json=epochInfoCacheSize,proto3,oneof" json:"epoch_info_cache_size,omitempty"`
+}
+type AdminSettings_EpochStateCacheSize struct {
+	EpochStateCacheSize uint64 `protobuf:"varint,4,opt,name=epoch_state_cache_size,json=epochStateCacheSize,proto3,oneof" json:"epoch_state_cache_size,omitempty"`
+}
+type AdminSettings_LogLevel struct {
+	LogLevel string `protobuf:"bytes,5,opt,name=log_level,json=logLevel,proto3,oneof" json:"log_level,omitempty"`
+}
+
+func (*AdminSettings_MaxPageSize) isAdminSettings_MaxPageSizeSetting()                 {}
+func (*AdminSettings_MaxStateReplays) isAdminSettings_MaxStateReplaysSetting()         {}
+func (*AdminSettings_EpochInfoCacheSize) isAdminSettings_EpochInfoCacheSizeSetting()   {}
+func (*AdminSettings_EpochStateCacheSize) isAdminSettings_EpochStateCacheSizeSetting() {}
+func (*AdminSettings_LogLevel) isAdminSettings_LogLevelSetting()                       {}
+
+func (m *AdminSettings) GetMaxPageSizeSetting() isAdminSettings_MaxPageSizeSetting {
+	if m != nil {
+		return m.MaxPageSizeSetting
+	}
+	return nil
+}
+func (m *AdminSettings) GetMaxStateReplaysSetting() isAdminSettings_MaxStateReplaysSetting {
+	if m != nil {
+		return m.MaxStateReplaysSetting
+	}
+	return nil
+}
+func (m *AdminSettings) GetEpochInfoCacheSizeSetting() isAdminSettings_EpochInfoCacheSizeSetting {
+	if m != nil {
+		return m.EpochInfoCacheSizeSetting
+	}
+	return nil
+}
+func (m *AdminSettings) GetEpochStateCacheSizeSetting() isAdminSettings_EpochStateCacheSizeSetting {
+	if m != nil {
+		return m.EpochStateCacheSizeSetting
+	}
+	return nil
+}
+func (m *AdminSettings) GetLogLevelSetting() isAdminSettings_LogLevelSetting {
+	if m != nil {
+		return m.LogLevelSetting
+	}
+	return nil
+}
+
+func (m *AdminSettings) GetMaxPageSize() uint64 {
+	if x, ok := m.GetMaxPageSizeSetting().(*AdminSettings_MaxPageSize); ok {
+		return x.MaxPageSize
+	}
+	return 0
+}
+
+func (m *AdminSettings) GetMaxStateReplays() uint64 {
+	if x, ok := m.GetMaxStateReplaysSetting().(*AdminSettings_MaxStateReplays); ok {
+		return x.MaxStateReplays
+	}
+	return 0
+}
+
+func (m *AdminSettings) GetEpochInfoCacheSize() uint64 {
+	if x, ok := m.GetEpochInfoCacheSizeSetting().(*AdminSettings_EpochInfoCacheSize); ok {
+		return x.EpochInfoCacheSize
+	}
+	return 0
+}
+
+func (m *AdminSettings) GetEpochStateCacheSize() uint64 {
+	if x, ok := m.GetEpochStateCacheSizeSetting().(*AdminSettings_EpochStateCacheSize); ok {
+		return x.EpochStateCacheSize
+	}
+	return 0
+}
+
+func (m *AdminSettings) GetLogLevel() string {
+	if x, ok := m.GetLogLevelSetting().(*AdminSettings_LogLevel); ok {
+		return x.LogLevel
+	}
+	return ""
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*AdminSettings) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*AdminSettings_MaxPageSize)(nil),
+		(*AdminSettings_MaxStateReplays)(nil),
+		(*AdminSettings_EpochInfoCacheSize)(nil),
+		(*AdminSettings_EpochStateCacheSize)(nil),
+		(*AdminSettings_LogLevel)(nil),
+	}
+}
+
+func init() {
+	proto.RegisterType((*AdminSettings)(nil), "ethereum.beacon.rpc.v1.AdminSettings")
+}
+
+func init() { proto.RegisterFile("proto/beacon/rpc/v1/admin.proto", fileDescriptor_4dc8eca9b17943ec) }
+
+var fileDescriptor_4dc8eca9b17943ec = []byte{
+	// 376 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xdf, 0x6a, 0xe2, 0x40,
+	0x14, 0xc6, 0x1d, 0xff, 0x2c, 0xeb, 0x71, 0xdd, 0xc5, 0x88, 0xae, 0xab, 0x18, 0x45, 0x76, 0xc1,
+	0x8b, 0x65, 0x82, 0x2b, 0xfb, 0x00, 0xb5, 0x94, 0x5a, 0x28, 0xa5, 0x44, 0x7a, 0x9d, 0x8e, 0xf1,
+	0x18, 0x03, 0x49, 0x66, 0x48, 0x46, 0x51, 0xdf, 0xac, 0x6f, 0xd0, 0xcb, 0x3e, 0x42, 0xf1, 0x39,
+	0x7a, 0x51, 0x32, 0xa9, 0x69, 0xa5, 0x5e, 0x78, 0x3b, 0xdf, 0xf7, 0x3b, 0xe7, 0x7c, 0xf3, 0x41,
+	0x47, 0x84, 0x5c, 0x72, 0x63, 0x8a, 0xcc, 0xe6, 0x81, 0x11, 0x0a, 0xdb, 0x58, 0x0d, 0x0c, 0x36,
+	0xf3, 0xdd, 0x80, 0x2a, 0x45, 0xab, 0xa3, 0x5c, 0x60, 0x88, 0x4b, 0x9f, 0x26, 0x1e, 0x1a, 0x0a,
+	0x9b, 0xae, 0x06, 0xcd, 0x96, 0xc3, 0xb9, 0xe3, 0xa1, 0xa1, 0x5c, 0xd3, 0xe5, 0xdc, 0x40, 0x5f,
+	0xc8, 0x4d, 0x02, 0xf5, 0x5e, 0xb2, 0x50, 0x3e, 0x8b, 0x87, 0x4c, 0x50, 0x4a, 0x37, 0x70, 0x22,
+	0xed, 0x37, 0x94, 0x7d, 0xb6, 0xb6, 0x04, 0x73, 0xd0, 0x8a, 0xdc, 0x2d, 0x36, 0x48, 0x97, 0xf4,
+	0xf3, 0xe3, 0x8c, 0x59, 0xf2, 0xd9, 0xfa, 0x96, 0x39, 0x38, 0x71, 0xb7, 0xa8, 0xfd, 0x85, 0x4a,
+	0xec, 0x8a, 0x24, 0x93, 0x68, 0x85, 0x28, 0x3c, 0xb6, 0x89, 0x1a, 0x59, 0xe5, 0x24, 0xe6, 0x0f,
+	0x9f, 0xad, 0x27, 0xb1, 0x62, 0x26, 0x82, 0x36, 0x84, 0x1a, 0x0a, 0x6e, 0x2f, 0x2c, 0x37, 0x98,
+	0x73, 0xcb, 0x66, 0xf6, 0xe2, 0x6d, 0x76, 0x4e, 0x11, 0x59, 0x53, 0x53, 0xf2, 0x55, 0x30, 0xe7,
+	0xe7, 0xb1, 0xa8, 0x56, 0xfc, 0x87, 0x7a, 0x02, 0x25, 0x4b, 0x3e, 0x50, 0x79, 0x45, 0xe5, 0xcc,
+	0xaa, 0xd2, 0xd5, 0xa6, 0x77, 0xac, 0x0d, 0x45, 0x8f, 0x3b, 0x96, 0x87, 0x2b, 0xf4, 0x1a, 0x85,
+	0x2e, 0xe9, 0x17, 0xc7, 0x79, 0xf3, 0xab, 0xc7, 0x9d, 0xeb, 0xf8, 0x65, 0xf4, 0x13, 0x6a, 0x07,
+	0xf1, 0xac, 0x28, 0x09, 0x3e, 0x6a, 0xc1, 0xaf, 0x4f, 0x89, 0x52, 0xb1, 0x03, 0xed, 0xa3, 0x01,
+	0x52, 0x43, 0x17, 0xf4, 0xe3, 0xc7, 0xa6, 0x8e, 0x2a, 0x54, 0xd2, 0xbb, 0xf6, 0x8f, 0xff, 0x1e,
+	0x08, 0x14, 0xd4, 0xf7, 0x6b, 0x37, 0x50, 0xba, 0x44, 0x99, 0xb6, 0x50, 0xa7, 0x49, 0x6b, 0x74,
+	0xdf, 0x1a, 0xbd, 0x88, 0x5b, 0x6b, 0xfe, 0xa1, 0xc7, 0x5b, 0xa6, 0x07, 0x25, 0xf6, 0x32, 0xda,
+	0x3d, 0x7c, 0xbf, 0x13, 0x33, 0x26, 0x31, 0x1d, 0x79, 0x1a, 0x7a, 0xf2, 0x86, 0xd1, 0xb7, 0xc7,
+	0x9d, 0x4e, 0x9e, 0x76, 0x3a, 0x79, 0xde, 0xe9, 0x64, 0xfa, 0x45, 0x1d, 0x3a, 0x7c, 0x1d, 0x00,
+	0x88, 0xcc, 0xb8, 0x2e, 0xa7, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AdminClient interface {
+	GetSettings(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*AdminSettings, error)
+	UpdateSettings(ctx context.Context, in *AdminSettings, opts ...grpc.CallOption) (*AdminSettings, error)
+}
+
+type adminClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAdminClient(cc *grpc.ClientConn) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) GetSettings(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*AdminSettings, error) {
+	out := new(AdminSettings)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Admin/GetSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) UpdateSettings(ctx context.Context, in *AdminSettings, opts ...grpc.CallOption) (*AdminSettings, error) {
+	out := new(AdminSettings)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Admin/UpdateSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServer is the server API for Admin service.
+type AdminServer interface {
+	GetSettings(context.Context, *empty.Empty) (*AdminSettings, error)
+	UpdateSettings(context.Context, *AdminSettings) (*AdminSettings, error)
+}
+
+// UnimplementedAdminServer can be embedded to have forward compatible implementations.
+type UnimplementedAdminServer struct {
+}
+
+func (*UnimplementedAdminServer) GetSettings(ctx context.Context, req *empty.Empty) (*AdminSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSettings not implemented")
+}
+func (*UnimplementedAdminServer) UpdateSettings(ctx context.Context, req *AdminSettings) (*AdminSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSettings not implemented")
+}
+
+func RegisterAdminServer(s *grpc.Server, srv AdminServer) {
+	s.RegisterService(&_Admin_serviceDesc, srv)
+}
+
+func _Admin_GetSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Admin/GetSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetSettings(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_UpdateSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSettings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).UpdateSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Admin/UpdateSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).UpdateSettings(ctx, req.(*AdminSettings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Admin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.Admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSettings",
+			Handler:    _Admin_GetSettings_Handler,
+		},
+		{
+			MethodName: "UpdateSettings",
+			Handler:    _Admin_UpdateSettings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/admin.proto",
+}
+
+func (m *AdminSettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminSettings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LogLevelSetting != nil {
+		{
+			size := m.LogLevelSetting.Size()
+			i -= size
+			if _, err := m.LogLevelSetting.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.EpochStateCacheSizeSetting != nil {
+		{
+			size := m.EpochStateCacheSizeSetting.Size()
+			i -= size
+			if _, err := m.EpochStateCacheSizeSetting.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.EpochInfoCacheSizeSetting != nil {
+		{
+			size := m.EpochInfoCacheSizeSetting.Size()
+			i -= size
+			if _, err := m.EpochInfoCacheSizeSetting.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.MaxStateReplaysSetting != nil {
+		{
+			size := m.MaxStateReplaysSetting.Size()
+			i -= size
+			if _, err := m.MaxStateReplaysSetting.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.MaxPageSizeSetting != nil {
+		{
+			size := m.MaxPageSizeSetting.Size()
+			i -= size
+			if _, err := m.MaxPageSizeSetting.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminSettings_MaxPageSize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminSettings_MaxPageSize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintAdmin(dAtA, i, uint64(m.MaxPageSize))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+func (m *AdminSettings_MaxStateReplays) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminSettings_MaxStateReplays) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintAdmin(dAtA, i, uint64(m.MaxStateReplays))
+	i--
+	dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+func (m *AdminSettings_EpochInfoCacheSize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminSettings_EpochInfoCacheSize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintAdmin(dAtA, i, uint64(m.EpochInfoCacheSize))
+	i--
+	dAtA[i] = 0x18
+	return len(dAtA) - i, nil
+}
+func (m *AdminSettings_EpochStateCacheSize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminSettings_EpochStateCacheSize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintAdmin(dAtA, i, uint64(m.EpochStateCacheSize))
+	i--
+	dAtA[i] = 0x20
+	return len(dAtA) - i, nil
+}
+func (m *AdminSettings_LogLevel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminSettings_LogLevel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.LogLevel)
+	copy(dAtA[i:], m.LogLevel)
+	i = encodeVarintAdmin(dAtA, i, uint64(len(m.LogLevel)))
+	i--
+	dAtA[i] = 0x2a
+	return len(dAtA) - i, nil
+}
+func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAdmin(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AdminSettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxPageSizeSetting != nil {
+		n += m.MaxPageSizeSetting.Size()
+	}
+	if m.MaxStateReplaysSetting != nil {
+		n += m.MaxStateReplaysSetting.Size()
+	}
+	if m.EpochInfoCacheSizeSetting != nil {
+		n += m.EpochInfoCacheSizeSetting.Size()
+	}
+	if m.EpochStateCacheSizeSetting != nil {
+		n += m.EpochStateCacheSizeSetting.Size()
+	}
+	if m.LogLevelSetting != nil {
+		n += m.LogLevelSetting.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AdminSettings_MaxPageSize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovAdmin(uint64(m.MaxPageSize))
+	return n
+}
+func (m *AdminSettings_MaxStateReplays) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovAdmin(uint64(m.MaxStateReplays))
+	return n
+}
+func (m *AdminSettings_EpochInfoCacheSize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovAdmin(uint64(m.EpochInfoCacheSize))
+	return n
+}
+func (m *AdminSettings_EpochStateCacheSize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovAdmin(uint64(m.EpochStateCacheSize))
+	return n
+}
+func (m *AdminSettings_LogLevel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LogLevel)
+	n += 1 + l + sovAdmin(uint64(l))
+	return n
+}
+
+func sovAdmin(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAdmin(x uint64) (n int) {
+	return sovAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AdminSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPageSize", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxPageSizeSetting = &AdminSettings_MaxPageSize{v}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStateReplays", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxStateReplaysSetting = &AdminSettings_MaxStateReplays{v}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochInfoCacheSize", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EpochInfoCacheSizeSetting = &AdminSettings_EpochInfoCacheSize{v}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStateCacheSize", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EpochStateCacheSizeSetting = &AdminSettings_EpochStateCacheSize{v}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogLevel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogLevelSetting = &AdminSettings_LogLevel{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAdmin(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAdmin
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAdmin
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAdmin
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAdmin        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAdmin          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAdmin = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,34437 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/rpc/v1/beacon_query.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	github_com_prysmaticlabs_eth2_types "github.com/prysmaticlabs/eth2-types"
+	v1alpha1 "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	github_com_prysmaticlabs_go_bitfield "github.com/prysmaticlabs/go-bitfield"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ProposerAudit_Outcome int32
+
+const (
+	ProposerAudit_PRODUCED ProposerAudit_Outcome = 0
+	ProposerAudit_MISSED   ProposerAudit_Outcome = 1
+	ProposerAudit_MISMATCH ProposerAudit_Outcome = 2
+)
+
+var ProposerAudit_Outcome_name = map[int32]string{
+	0: "PRODUCED",
+	1: "MISSED",
+	2: "MISMATCH",
+}
+
+var ProposerAudit_Outcome_value = map[string]int32{
+	"PRODUCED": 0,
+	"MISSED":   1,
+	"MISMATCH": 2,
+}
+
+func (x ProposerAudit_Outcome) String() string {
+	return proto.EnumName(ProposerAudit_Outcome_name, int32(x))
+}
+
+func (ProposerAudit_Outcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{49, 0}
+}
+
+type PandoraConfirmation_Status int32
+
+const (
+	PandoraConfirmation_PENDING  PandoraConfirmation_Status = 0
+	PandoraConfirmation_VERIFIED PandoraConfirmation_Status = 1
+	PandoraConfirmation_INVALID  PandoraConfirmation_Status = 2
+)
+
+var PandoraConfirmation_Status_name = map[int32]string{
+	0: "PENDING",
+	1: "VERIFIED",
+	2: "INVALID",
+}
+
+var PandoraConfirmation_Status_value = map[string]int32{
+	"PENDING":  0,
+	"VERIFIED": 1,
+	"INVALID":  2,
+}
+
+func (x PandoraConfirmation_Status) String() string {
+	return proto.EnumName(PandoraConfirmation_Status_name, int32(x))
+}
+
+func (PandoraConfirmation_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{65, 0}
+}
+
+type ValidatorLivenessRequest struct {
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch            `protobuf:"varint,1,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	Indices              []github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,2,rep,packed,name=indices,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"indices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                             `json:"-"`
+	XXX_unrecognized     []byte                                               `json:"-"`
+	XXX_sizecache        int32                                                `json:"-"`
+}
+
+func (m *ValidatorLivenessRequest) Reset()         { *m = ValidatorLivenessRequest{} }
+func (m *ValidatorLivenessRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorLivenessRequest) ProtoMessage()    {}
+func (*ValidatorLivenessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{0}
+}
+func (m *ValidatorLivenessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorLivenessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorLivenessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorLivenessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorLivenessRequest.Merge(m, src)
+}
+func (m *ValidatorLivenessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorLivenessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorLivenessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorLivenessRequest proto.InternalMessageInfo
+
+func (m *ValidatorLivenessRequest) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorLivenessRequest) GetIndices() []github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
+}
+
+type ValidatorLivenessResponse struct {
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	Liveness             []*ValidatorLiveness                      `protobuf:"bytes,2,rep,name=liveness,proto3" json:"liveness,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *ValidatorLivenessResponse) Reset()         { *m = ValidatorLivenessResponse{} }
+func (m *ValidatorLivenessResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorLivenessResponse) ProtoMessage()    {}
+func (*ValidatorLivenessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{1}
+}
+func (m *ValidatorLivenessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorLivenessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorLivenessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorLivenessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorLivenessResponse.Merge(m, src)
+}
+func (m *ValidatorLivenessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorLivenessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorLivenessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorLivenessResponse proto.InternalMessageInfo
+
+func (m *ValidatorLivenessResponse) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorLivenessResponse) GetLiveness() []*ValidatorLiveness {
+	if m != nil {
+		return m.Liveness
+	}
+	return nil
+}
+
+type ValidatorLiveness struct {
+	Index                github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,1,opt,name=index,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"index,omitempty"`
+	IsLive               bool                                               `protobuf:"varint,2,opt,name=is_live,json=isLive,proto3" json:"is_live,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *ValidatorLiveness) Reset()         { *m = ValidatorLiveness{} }
+func (m *ValidatorLiveness) String() string { return proto.CompactTextString(m) }
+func (*ValidatorLiveness) ProtoMessage()    {}
+func (*ValidatorLiveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{2}
+}
+func (m *ValidatorLiveness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorLiveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorLiveness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorLiveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorLiveness.Merge(m, src)
+}
+func (m *ValidatorLiveness) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorLiveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorLiveness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorLiveness proto.InternalMessageInfo
+
+func (m *ValidatorLiveness) GetIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ValidatorLiveness) GetIsLive() bool {
+	if m != nil {
+		return m.IsLive
+	}
+	return false
+}
+
+type CommitteeRootsRequest struct {
+	FromEpoch            github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"from_epoch,omitempty"`
+	ToEpoch              github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,2,opt,name=to_epoch,json=toEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"to_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *CommitteeRootsRequest) Reset()         { *m = CommitteeRootsRequest{} }
+func (m *CommitteeRootsRequest) String() string { return proto.CompactTextString(m) }
+func (*CommitteeRootsRequest) ProtoMessage()    {}
+func (*CommitteeRootsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{3}
+}
+func (m *CommitteeRootsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitteeRootsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitteeRootsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitteeRootsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitteeRootsRequest.Merge(m, src)
+}
+func (m *CommitteeRootsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitteeRootsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitteeRootsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitteeRootsRequest proto.InternalMessageInfo
+
+func (m *CommitteeRootsRequest) GetFromEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
+}
+
+func (m *CommitteeRootsRequest) GetToEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.ToEpoch
+	}
+	return 0
+}
+
+type CommitteeRoots struct {
+	Roots                []*CommitteeRoot `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CommitteeRoots) Reset()         { *m = CommitteeRoots{} }
+func (m *CommitteeRoots) String() string { return proto.CompactTextString(m) }
+func (*CommitteeRoots) ProtoMessage()    {}
+func (*CommitteeRoots) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{4}
+}
+func (m *CommitteeRoots) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitteeRoots) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitteeRoots.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitteeRoots) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitteeRoots.Merge(m, src)
+}
+func (m *CommitteeRoots) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitteeRoots) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitteeRoots.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitteeRoots proto.InternalMessageInfo
+
+func (m *CommitteeRoots) GetRoots() []*CommitteeRoot {
+	if m != nil {
+		return m.Roots
+	}
+	return nil
+}
+
+type CommitteeRoot struct {
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	RandaoMix            []byte                                    `protobuf:"bytes,2,opt,name=randao_mix,json=randaoMix,proto3" json:"randao_mix,omitempty" ssz-size:"32"`
+	ProposerSeed         []byte                                    `protobuf:"bytes,3,opt,name=proposer_seed,json=proposerSeed,proto3" json:"proposer_seed,omitempty" ssz-size:"32"`
+	AttesterSeed         []byte                                    `protobuf:"bytes,4,opt,name=attester_seed,json=attesterSeed,proto3" json:"attester_seed,omitempty" ssz-size:"32"`
+	ActiveIndexRoot      []byte                                    `protobuf:"bytes,5,opt,name=active_index_root,json=activeIndexRoot,proto3" json:"active_index_root,omitempty" ssz-size:"32"`
+	ActiveValidatorCount uint64                                    `protobuf:"varint,6,opt,name=active_validator_count,json=activeValidatorCount,proto3" json:"active_validator_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *CommitteeRoot) Reset()         { *m = CommitteeRoot{} }
+func (m *CommitteeRoot) String() string { return proto.CompactTextString(m) }
+func (*CommitteeRoot) ProtoMessage()    {}
+func (*CommitteeRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{5}
+}
+func (m *CommitteeRoot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitteeRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitteeRoot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitteeRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitteeRoot.Merge(m, src)
+}
+func (m *CommitteeRoot) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitteeRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitteeRoot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitteeRoot proto.InternalMessageInfo
+
+func (m *CommitteeRoot) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *CommitteeRoot) GetRandaoMix() []byte {
+	if m != nil {
+		return m.RandaoMix
+	}
+	return nil
+}
+
+func (m *CommitteeRoot) GetProposerSeed() []byte {
+	if m != nil {
+		return m.ProposerSeed
+	}
+	return nil
+}
+
+func (m *CommitteeRoot) GetAttesterSeed() []byte {
+	if m != nil {
+		return m.AttesterSeed
+	}
+	return nil
+}
+
+func (m *CommitteeRoot) GetActiveIndexRoot() []byte {
+	if m != nil {
+		return m.ActiveIndexRoot
+	}
+	return nil
+}
+
+func (m *CommitteeRoot) GetActiveValidatorCount() uint64 {
+	if m != nil {
+		return m.ActiveValidatorCount
+	}
+	return 0
+}
+
+type ExplainShuffleRequest struct {
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch          `protobuf:"varint,1,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	Index                github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,2,opt,name=index,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *ExplainShuffleRequest) Reset()         { *m = ExplainShuffleRequest{} }
+func (m *ExplainShuffleRequest) String() string { return proto.CompactTextString(m) }
+func (*ExplainShuffleRequest) ProtoMessage()    {}
+func (*ExplainShuffleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{6}
+}
+func (m *ExplainShuffleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExplainShuffleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExplainShuffleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExplainShuffleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainShuffleRequest.Merge(m, src)
+}
+func (m *ExplainShuffleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExplainShuffleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainShuffleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExplainShuffleRequest proto.InternalMessageInfo
+
+func (m *ExplainShuffleRequest) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ExplainShuffleRequest) GetIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type ShuffleExplanation struct {
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch            `protobuf:"varint,1,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	Index                github_com_prysmaticlabs_eth2_types.ValidatorIndex   `protobuf:"varint,2,opt,name=index,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"index,omitempty"`
+	Seed                 []byte                                               `protobuf:"bytes,3,opt,name=seed,proto3" json:"seed,omitempty" ssz-size:"32"`
+	ActiveValidatorCount uint64                                               `protobuf:"varint,4,opt,name=active_validator_count,json=activeValidatorCount,proto3" json:"active_validator_count,omitempty"`
+	ActivePosition       uint64                                               `protobuf:"varint,5,opt,name=active_position,json=activePosition,proto3" json:"active_position,omitempty"`
+	ShuffledPosition     uint64                                               `protobuf:"varint,6,opt,name=shuffled_position,json=shuffledPosition,proto3" json:"shuffled_position,omitempty"`
+	RoundOutputs         []github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,7,rep,packed,name=round_outputs,json=roundOutputs,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"round_outputs,omitempty"`
+	Slot                 github_com_prysmaticlabs_eth2_types.Slot             `protobuf:"varint,8,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+	CommitteeIndex       github_com_prysmaticlabs_eth2_types.CommitteeIndex   `protobuf:"varint,9,opt,name=committee_index,json=committeeIndex,proto3,casttype=github.com/prysmaticlabs/eth2-types.CommitteeIndex" json:"committee_index,omitempty"`
+	CommitteePosition    uint64                                               `protobuf:"varint,10,opt,name=committee_position,json=committeePosition,proto3" json:"committee_position,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                             `json:"-"`
+	XXX_unrecognized     []byte                                               `json:"-"`
+	XXX_sizecache        int32                                                `json:"-"`
+}
+
+func (m *ShuffleExplanation) Reset()         { *m = ShuffleExplanation{} }
+func (m *ShuffleExplanation) String() string { return proto.CompactTextString(m) }
+func (*ShuffleExplanation) ProtoMessage()    {}
+func (*ShuffleExplanation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{7}
+}
+func (m *ShuffleExplanation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShuffleExplanation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShuffleExplanation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShuffleExplanation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShuffleExplanation.Merge(m, src)
+}
+func (m *ShuffleExplanation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShuffleExplanation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShuffleExplanation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShuffleExplanation proto.InternalMessageInfo
+
+func (m *ShuffleExplanation) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ShuffleExplanation) GetIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ShuffleExplanation) GetSeed() []byte {
+	if m != nil {
+		return m.Seed
+	}
+	return nil
+}
+
+func (m *ShuffleExplanation) GetActiveValidatorCount() uint64 {
+	if m != nil {
+		return m.ActiveValidatorCount
+	}
+	return 0
+}
+
+func (m *ShuffleExplanation) GetActivePosition() uint64 {
+	if m != nil {
+		return m.ActivePosition
+	}
+	return 0
+}
+
+func (m *ShuffleExplanation) GetShuffledPosition() uint64 {
+	if m != nil {
+		return m.ShuffledPosition
+	}
+	return 0
+}
+
+func (m *ShuffleExplanation) GetRoundOutputs() []github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.RoundOutputs
+	}
+	return nil
+}
+
+func (m *ShuffleExplanation) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *ShuffleExplanation) GetCommitteeIndex() github_com_prysmaticlabs_eth2_types.CommitteeIndex {
+	if m != nil {
+		return m.CommitteeIndex
+	}
+	return 0
+}
+
+func (m *ShuffleExplanation) GetCommitteePosition() uint64 {
+	if m != nil {
+		return m.CommitteePosition
+	}
+	return 0
+}
+
+type GetStateDiffRequest struct {
+	FromEpoch            github_com_prysmaticlabs_eth2_types.Epoch            `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"from_epoch,omitempty"`
+	ToEpoch              github_com_prysmaticlabs_eth2_types.Epoch            `protobuf:"varint,2,opt,name=to_epoch,json=toEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"to_epoch,omitempty"`
+	Indices              []github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,3,rep,packed,name=indices,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"indices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                             `json:"-"`
+	XXX_unrecognized     []byte                                               `json:"-"`
+	XXX_sizecache        int32                                                `json:"-"`
+}
+
+func (m *GetStateDiffRequest) Reset()         { *m = GetStateDiffRequest{} }
+func (m *GetStateDiffRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStateDiffRequest) ProtoMessage()    {}
+func (*GetStateDiffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{8}
+}
+func (m *GetStateDiffRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetStateDiffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetStateDiffRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetStateDiffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateDiffRequest.Merge(m, src)
+}
+func (m *GetStateDiffRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetStateDiffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateDiffRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStateDiffRequest proto.InternalMessageInfo
+
+func (m *GetStateDiffRequest) GetFromEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
+}
+
+func (m *GetStateDiffRequest) GetToEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.ToEpoch
+	}
+	return 0
+}
+
+func (m *GetStateDiffRequest) GetIndices() []github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
+}
+
+type StateDiff struct {
+	FromEpoch                       github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"from_epoch,omitempty"`
+	ToEpoch                         github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,2,opt,name=to_epoch,json=toEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"to_epoch,omitempty"`
+	ChangedValidators               []*ValidatorChange                        `protobuf:"bytes,3,rep,name=changed_validators,json=changedValidators,proto3" json:"changed_validators,omitempty"`
+	BalanceDeltas                   []*BalanceDelta                           `protobuf:"bytes,4,rep,name=balance_deltas,json=balanceDeltas,proto3" json:"balance_deltas,omitempty"`
+	PreviousJustifiedCheckpoint     *v1alpha1.Checkpoint                      `protobuf:"bytes,5,opt,name=previous_justified_checkpoint,json=previousJustifiedCheckpoint,proto3" json:"previous_justified_checkpoint,omitempty"`
+	CurrentJustifiedCheckpoint      *v1alpha1.Checkpoint                      `protobuf:"bytes,6,opt,name=current_justified_checkpoint,json=currentJustifiedCheckpoint,proto3" json:"current_justified_checkpoint,omitempty"`
+	FinalizedCheckpoint             *v1alpha1.Checkpoint                      `protobuf:"bytes,7,opt,name=finalized_checkpoint,json=finalizedCheckpoint,proto3" json:"finalized_checkpoint,omitempty"`
+	PostPreviousJustifiedCheckpoint *v1alpha1.Checkpoint                      `protobuf:"bytes,8,opt,name=post_previous_justified_checkpoint,json=postPreviousJustifiedCheckpoint,proto3" json:"post_previous_justified_checkpoint,omitempty"`
+	PostCurrentJustifiedCheckpoint  *v1alpha1.Checkpoint                      `protobuf:"bytes,9,opt,name=post_current_justified_checkpoint,json=postCurrentJustifiedCheckpoint,proto3" json:"post_current_justified_checkpoint,omitempty"`
+	PostFinalizedCheckpoint         *v1alpha1.Checkpoint                      `protobuf:"bytes,10,opt,name=post_finalized_checkpoint,json=postFinalizedCheckpoint,proto3" json:"post_finalized_checkpoint,omitempty"`
+	XXX_NoUnkeyedLiteral            struct{}                                  `json:"-"`
+	XXX_unrecognized                []byte                                    `json:"-"`
+	XXX_sizecache                   int32                                     `json:"-"`
+}
+
+func (m *StateDiff) Reset()         { *m = StateDiff{} }
+func (m *StateDiff) String() string { return proto.CompactTextString(m) }
+func (*StateDiff) ProtoMessage()    {}
+func (*StateDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{9}
+}
+func (m *StateDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateDiff.Merge(m, src)
+}
+func (m *StateDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateDiff proto.InternalMessageInfo
+
+func (m *StateDiff) GetFromEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
+}
+
+func (m *StateDiff) GetToEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.ToEpoch
+	}
+	return 0
+}
+
+func (m *StateDiff) GetChangedValidators() []*ValidatorChange {
+	if m != nil {
+		return m.ChangedValidators
+	}
+	return nil
+}
+
+func (m *StateDiff) GetBalanceDeltas() []*BalanceDelta {
+	if m != nil {
+		return m.BalanceDeltas
+	}
+	return nil
+}
+
+func (m *StateDiff) GetPreviousJustifiedCheckpoint() *v1alpha1.Checkpoint {
+	if m != nil {
+		return m.PreviousJustifiedCheckpoint
+	}
+	return nil
+}
+
+func (m *StateDiff) GetCurrentJustifiedCheckpoint() *v1alpha1.Checkpoint {
+	if m != nil {
+		return m.CurrentJustifiedCheckpoint
+	}
+	return nil
+}
+
+func (m *StateDiff) GetFinalizedCheckpoint() *v1alpha1.Checkpoint {
+	if m != nil {
+		return m.FinalizedCheckpoint
+	}
+	return nil
+}
+
+func (m *StateDiff) GetPostPreviousJustifiedCheckpoint() *v1alpha1.Checkpoint {
+	if m != nil {
+		return m.PostPreviousJustifiedCheckpoint
+	}
+	return nil
+}
+
+func (m *StateDiff) GetPostCurrentJustifiedCheckpoint() *v1alpha1.Checkpoint {
+	if m != nil {
+		return m.PostCurrentJustifiedCheckpoint
+	}
+	return nil
+}
+
+func (m *StateDiff) GetPostFinalizedCheckpoint() *v1alpha1.Checkpoint {
+	if m != nil {
+		return m.PostFinalizedCheckpoint
+	}
+	return nil
+}
+
+type ValidatorChange struct {
+	Index                github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,1,opt,name=index,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"index,omitempty"`
+	Previous             *v1alpha1.Validator                                `protobuf:"bytes,2,opt,name=previous,proto3" json:"previous,omitempty"`
+	Current              *v1alpha1.Validator                                `protobuf:"bytes,3,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *ValidatorChange) Reset()         { *m = ValidatorChange{} }
+func (m *ValidatorChange) String() string { return proto.CompactTextString(m) }
+func (*ValidatorChange) ProtoMessage()    {}
+func (*ValidatorChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{10}
+}
+func (m *ValidatorChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorChange.Merge(m, src)
+}
+func (m *ValidatorChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorChange proto.InternalMessageInfo
+
+func (m *ValidatorChange) GetIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ValidatorChange) GetPrevious() *v1alpha1.Validator {
+	if m != nil {
+		return m.Previous
+	}
+	return nil
+}
+
+func (m *ValidatorChange) GetCurrent() *v1alpha1.Validator {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type BalanceDelta struct {
+	Index                github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,1,opt,name=index,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"index,omitempty"`
+	Previous             uint64                                             `protobuf:"varint,2,opt,name=previous,proto3" json:"previous,omitempty"`
+	Current              uint64                                             `protobuf:"varint,3,opt,name=current,proto3" json:"current,omitempty"`
+	Delta                int64                                              `protobuf:"varint,4,opt,name=delta,proto3" json:"delta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *BalanceDelta) Reset()         { *m = BalanceDelta{} }
+func (m *BalanceDelta) String() string { return proto.CompactTextString(m) }
+func (*BalanceDelta) ProtoMessage()    {}
+func (*BalanceDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{11}
+}
+func (m *BalanceDelta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BalanceDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BalanceDelta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BalanceDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceDelta.Merge(m, src)
+}
+func (m *BalanceDelta) XXX_Size() int {
+	return m.Size()
+}
+func (m *BalanceDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceDelta proto.InternalMessageInfo
+
+func (m *BalanceDelta) GetIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *BalanceDelta) GetPrevious() uint64 {
+	if m != nil {
+		return m.Previous
+	}
+	return 0
+}
+
+func (m *BalanceDelta) GetCurrent() uint64 {
+	if m != nil {
+		return m.Current
+	}
+	return 0
+}
+
+func (m *BalanceDelta) GetDelta() int64 {
+	if m != nil {
+		return m.Delta
+	}
+	return 0
+}
+
+type RandaoMixesRequest struct {
+	FromEpoch            github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"from_epoch,omitempty"`
+	ToEpoch              github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,2,opt,name=to_epoch,json=toEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"to_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *RandaoMixesRequest) Reset()         { *m = RandaoMixesRequest{} }
+func (m *RandaoMixesRequest) String() string { return proto.CompactTextString(m) }
+func (*RandaoMixesRequest) ProtoMessage()    {}
+func (*RandaoMixesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{12}
+}
+func (m *RandaoMixesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RandaoMixesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RandaoMixesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RandaoMixesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RandaoMixesRequest.Merge(m, src)
+}
+func (m *RandaoMixesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RandaoMixesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RandaoMixesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RandaoMixesRequest proto.InternalMessageInfo
+
+func (m *RandaoMixesRequest) GetFromEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
+}
+
+func (m *RandaoMixesRequest) GetToEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.ToEpoch
+	}
+	return 0
+}
+
+type RandaoMixes struct {
+	Mixes                []*EpochRandaoMix `protobuf:"bytes,1,rep,name=mixes,proto3" json:"mixes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RandaoMixes) Reset()         { *m = RandaoMixes{} }
+func (m *RandaoMixes) String() string { return proto.CompactTextString(m) }
+func (*RandaoMixes) ProtoMessage()    {}
+func (*RandaoMixes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{13}
+}
+func (m *RandaoMixes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RandaoMixes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RandaoMixes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RandaoMixes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RandaoMixes.Merge(m, src)
+}
+func (m *RandaoMixes) XXX_Size() int {
+	return m.Size()
+}
+func (m *RandaoMixes) XXX_DiscardUnknown() {
+	xxx_messageInfo_RandaoMixes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RandaoMixes proto.InternalMessageInfo
+
+func (m *RandaoMixes) GetMixes() []*EpochRandaoMix {
+	if m != nil {
+		return m.Mixes
+	}
+	return nil
+}
+
+type EpochRandaoMix struct {
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	SeedEpoch            github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,2,opt,name=seed_epoch,json=seedEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"seed_epoch,omitempty"`
+	StartMix             []byte                                    `protobuf:"bytes,3,opt,name=start_mix,json=startMix,proto3" json:"start_mix,omitempty" ssz-size:"32"`
+	Mix                  []byte                                    `protobuf:"bytes,4,opt,name=mix,proto3" json:"mix,omitempty" ssz-size:"32"`
+	Contributions        []*RandaoContribution                     `protobuf:"bytes,5,rep,name=contributions,proto3" json:"contributions,omitempty"`
+	Verified             bool                                      `protobuf:"varint,6,opt,name=verified,proto3" json:"verified,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *EpochRandaoMix) Reset()         { *m = EpochRandaoMix{} }
+func (m *EpochRandaoMix) String() string { return proto.CompactTextString(m) }
+func (*EpochRandaoMix) ProtoMessage()    {}
+func (*EpochRandaoMix) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{14}
+}
+func (m *EpochRandaoMix) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochRandaoMix) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochRandaoMix.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochRandaoMix) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochRandaoMix.Merge(m, src)
+}
+func (m *EpochRandaoMix) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochRandaoMix) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochRandaoMix.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochRandaoMix proto.InternalMessageInfo
+
+func (m *EpochRandaoMix) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochRandaoMix) GetSeedEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.SeedEpoch
+	}
+	return 0
+}
+
+func (m *EpochRandaoMix) GetStartMix() []byte {
+	if m != nil {
+		return m.StartMix
+	}
+	return nil
+}
+
+func (m *EpochRandaoMix) GetMix() []byte {
+	if m != nil {
+		return m.Mix
+	}
+	return nil
+}
+
+func (m *EpochRandaoMix) GetContributions() []*RandaoContribution {
+	if m != nil {
+		return m.Contributions
+	}
+	return nil
+}
+
+func (m *EpochRandaoMix) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+type RandaoContribution struct {
+	Slot                 github_com_prysmaticlabs_eth2_types.Slot           `protobuf:"varint,1,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+	BlockRoot            []byte                                             `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty" ssz-size:"32"`
+	ProposerIndex        github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,3,opt,name=proposer_index,json=proposerIndex,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"proposer_index,omitempty"`
+	PublicKey            []byte                                             `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty" ssz-size:"48"`
+	Reveal               []byte                                             `protobuf:"bytes,5,opt,name=reveal,proto3" json:"reveal,omitempty" ssz-size:"96"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *RandaoContribution) Reset()         { *m = RandaoContribution{} }
+func (m *RandaoContribution) String() string { return proto.CompactTextString(m) }
+func (*RandaoContribution) ProtoMessage()    {}
+func (*RandaoContribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{15}
+}
+func (m *RandaoContribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RandaoContribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RandaoContribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RandaoContribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RandaoContribution.Merge(m, src)
+}
+func (m *RandaoContribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *RandaoContribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_RandaoContribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RandaoContribution proto.InternalMessageInfo
+
+func (m *RandaoContribution) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *RandaoContribution) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
+	}
+	return nil
+}
+
+func (m *RandaoContribution) GetProposerIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.ProposerIndex
+	}
+	return 0
+}
+
+func (m *RandaoContribution) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *RandaoContribution) GetReveal() []byte {
+	if m != nil {
+		return m.Reveal
+	}
+	return nil
+}
+
+type ListReorgsRequest struct {
+	FromEpoch            github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"from_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *ListReorgsRequest) Reset()         { *m = ListReorgsRequest{} }
+func (m *ListReorgsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListReorgsRequest) ProtoMessage()    {}
+func (*ListReorgsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{16}
+}
+func (m *ListReorgsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListReorgsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListReorgsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListReorgsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReorgsRequest.Merge(m, src)
+}
+func (m *ListReorgsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListReorgsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReorgsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReorgsRequest proto.InternalMessageInfo
+
+func (m *ListReorgsRequest) GetFromEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
+}
+
+type Reorgs struct {
+	Events               []*ReorgEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *Reorgs) Reset()         { *m = Reorgs{} }
+func (m *Reorgs) String() string { return proto.CompactTextString(m) }
+func (*Reorgs) ProtoMessage()    {}
+func (*Reorgs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{17}
+}
+func (m *Reorgs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Reorgs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Reorgs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Reorgs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reorgs.Merge(m, src)
+}
+func (m *Reorgs) XXX_Size() int {
+	return m.Size()
+}
+func (m *Reorgs) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reorgs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Reorgs proto.InternalMessageInfo
+
+func (m *Reorgs) GetEvents() []*ReorgEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type ReorgEvent struct {
+	OldHeadRoot          []byte                                    `protobuf:"bytes,1,opt,name=old_head_root,json=oldHeadRoot,proto3" json:"old_head_root,omitempty" ssz-size:"32"`
+	NewHeadRoot          []byte                                    `protobuf:"bytes,2,opt,name=new_head_root,json=newHeadRoot,proto3" json:"new_head_root,omitempty" ssz-size:"32"`
+	OldHeadSlot          github_com_prysmaticlabs_eth2_types.Slot  `protobuf:"varint,3,opt,name=old_head_slot,json=oldHeadSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"old_head_slot,omitempty"`
+	NewHeadSlot          github_com_prysmaticlabs_eth2_types.Slot  `protobuf:"varint,4,opt,name=new_head_slot,json=newHeadSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"new_head_slot,omitempty"`
+	CommonAncestorSlot   github_com_prysmaticlabs_eth2_types.Slot  `protobuf:"varint,5,opt,name=common_ancestor_slot,json=commonAncestorSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"common_ancestor_slot,omitempty"`
+	Depth                uint64                                    `protobuf:"varint,6,opt,name=depth,proto3" json:"depth,omitempty"`
+	FromEpoch            github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,7,opt,name=from_epoch,json=fromEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"from_epoch,omitempty"`
+	ToEpoch              github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,8,opt,name=to_epoch,json=toEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"to_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *ReorgEvent) Reset()         { *m = ReorgEvent{} }
+func (m *ReorgEvent) String() string { return proto.CompactTextString(m) }
+func (*ReorgEvent) ProtoMessage()    {}
+func (*ReorgEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{18}
+}
+func (m *ReorgEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReorgEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReorgEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReorgEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReorgEvent.Merge(m, src)
+}
+func (m *ReorgEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReorgEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReorgEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReorgEvent proto.InternalMessageInfo
+
+func (m *ReorgEvent) GetOldHeadRoot() []byte {
+	if m != nil {
+		return m.OldHeadRoot
+	}
+	return nil
+}
+
+func (m *ReorgEvent) GetNewHeadRoot() []byte {
+	if m != nil {
+		return m.NewHeadRoot
+	}
+	return nil
+}
+
+func (m *ReorgEvent) GetOldHeadSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.OldHeadSlot
+	}
+	return 0
+}
+
+func (m *ReorgEvent) GetNewHeadSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.NewHeadSlot
+	}
+	return 0
+}
+
+func (m *ReorgEvent) GetCommonAncestorSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.CommonAncestorSlot
+	}
+	return 0
+}
+
+func (m *ReorgEvent) GetDepth() uint64 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *ReorgEvent) GetFromEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
+}
+
+func (m *ReorgEvent) GetToEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.ToEpoch
+	}
+	return 0
+}
+
+type ListValidatorBalanceHistoryRequest struct {
+	PublicKey            []byte                                             `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty" ssz-size:"48"`
+	Index                github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,2,opt,name=index,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"index,omitempty"`
+	FromEpoch            github_com_prysmaticlabs_eth2_types.Epoch          `protobuf:"varint,3,opt,name=from_epoch,json=fromEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"from_epoch,omitempty"`
+	ToEpoch              github_com_prysmaticlabs_eth2_types.Epoch          `protobuf:"varint,4,opt,name=to_epoch,json=toEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"to_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *ListValidatorBalanceHistoryRequest) Reset()         { *m = ListValidatorBalanceHistoryRequest{} }
+func (m *ListValidatorBalanceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*ListValidatorBalanceHistoryRequest) ProtoMessage()    {}
+func (*ListValidatorBalanceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{19}
+}
+func (m *ListValidatorBalanceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListValidatorBalanceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListValidatorBalanceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListValidatorBalanceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListValidatorBalanceHistoryRequest.Merge(m, src)
+}
+func (m *ListValidatorBalanceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListValidatorBalanceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListValidatorBalanceHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListValidatorBalanceHistoryRequest proto.InternalMessageInfo
+
+func (m *ListValidatorBalanceHistoryRequest) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *ListValidatorBalanceHistoryRequest) GetIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ListValidatorBalanceHistoryRequest) GetFromEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
+}
+
+func (m *ListValidatorBalanceHistoryRequest) GetToEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.ToEpoch
+	}
+	return 0
+}
+
+type ValidatorBalanceHistory struct {
+	Index                github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,1,opt,name=index,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"index,omitempty"`
+	Balances             []*EpochBalance                                    `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *ValidatorBalanceHistory) Reset()         { *m = ValidatorBalanceHistory{} }
+func (m *ValidatorBalanceHistory) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBalanceHistory) ProtoMessage()    {}
+func (*ValidatorBalanceHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{20}
+}
+func (m *ValidatorBalanceHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorBalanceHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorBalanceHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorBalanceHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBalanceHistory.Merge(m, src)
+}
+func (m *ValidatorBalanceHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorBalanceHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBalanceHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorBalanceHistory proto.InternalMessageInfo
+
+func (m *ValidatorBalanceHistory) GetIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ValidatorBalanceHistory) GetBalances() []*EpochBalance {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+type EpochBalance struct {
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	Balance              uint64                                    `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *EpochBalance) Reset()         { *m = EpochBalance{} }
+func (m *EpochBalance) String() string { return proto.CompactTextString(m) }
+func (*EpochBalance) ProtoMessage()    {}
+func (*EpochBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{21}
+}
+func (m *EpochBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochBalance.Merge(m, src)
+}
+func (m *EpochBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochBalance proto.InternalMessageInfo
+
+func (m *EpochBalance) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochBalance) GetBalance() uint64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+type SlotParticipation struct {
+	Slot                 github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+	BlockSlot            github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,2,opt,name=block_slot,json=blockSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"block_slot,omitempty"`
+	BlockRoot            []byte                                   `protobuf:"bytes,3,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty" ssz-size:"32"`
+	ExpectedBalance      uint64                                   `protobuf:"varint,4,opt,name=expected_balance,json=expectedBalance,proto3" json:"expected_balance,omitempty"`
+	AttestingBalance     uint64                                   `protobuf:"varint,5,opt,name=attesting_balance,json=attestingBalance,proto3" json:"attesting_balance,omitempty"`
+	HeadCorrectBalance   uint64                                   `protobuf:"varint,6,opt,name=head_correct_balance,json=headCorrectBalance,proto3" json:"head_correct_balance,omitempty"`
+	ParticipationRate    float64                                  `protobuf:"fixed64,7,opt,name=participation_rate,json=participationRate,proto3" json:"participation_rate,omitempty"`
+	HeadCorrectRate      float64                                  `protobuf:"fixed64,8,opt,name=head_correct_rate,json=headCorrectRate,proto3" json:"head_correct_rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
+}
+
+func (m *SlotParticipation) Reset()         { *m = SlotParticipation{} }
+func (m *SlotParticipation) String() string { return proto.CompactTextString(m) }
+func (*SlotParticipation) ProtoMessage()    {}
+func (*SlotParticipation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{22}
+}
+func (m *SlotParticipation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlotParticipation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlotParticipation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlotParticipation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlotParticipation.Merge(m, src)
+}
+func (m *SlotParticipation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlotParticipation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlotParticipation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlotParticipation proto.InternalMessageInfo
+
+func (m *SlotParticipation) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *SlotParticipation) GetBlockSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.BlockSlot
+	}
+	return 0
+}
+
+func (m *SlotParticipation) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
+	}
+	return nil
+}
+
+func (m *SlotParticipation) GetExpectedBalance() uint64 {
+	if m != nil {
+		return m.ExpectedBalance
+	}
+	return 0
+}
+
+func (m *SlotParticipation) GetAttestingBalance() uint64 {
+	if m != nil {
+		return m.AttestingBalance
+	}
+	return 0
+}
+
+func (m *SlotParticipation) GetHeadCorrectBalance() uint64 {
+	if m != nil {
+		return m.HeadCorrectBalance
+	}
+	return 0
+}
+
+func (m *SlotParticipation) GetParticipationRate() float64 {
+	if m != nil {
+		return m.ParticipationRate
+	}
+	return 0
+}
+
+func (m *SlotParticipation) GetHeadCorrectRate() float64 {
+	if m != nil {
+		return m.HeadCorrectRate
+	}
+	return 0
+}
+
+type GetEpochSummaryRequest struct {
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *GetEpochSummaryRequest) Reset()         { *m = GetEpochSummaryRequest{} }
+func (m *GetEpochSummaryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetEpochSummaryRequest) ProtoMessage()    {}
+func (*GetEpochSummaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{23}
+}
+func (m *GetEpochSummaryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetEpochSummaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetEpochSummaryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetEpochSummaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEpochSummaryRequest.Merge(m, src)
+}
+func (m *GetEpochSummaryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetEpochSummaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEpochSummaryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEpochSummaryRequest proto.InternalMessageInfo
+
+func (m *GetEpochSummaryRequest) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+type ListEpochSummariesRequest struct {
+	FromEpoch            github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"from_epoch,omitempty"`
+	ToEpoch              github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,2,opt,name=to_epoch,json=toEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"to_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *ListEpochSummariesRequest) Reset()         { *m = ListEpochSummariesRequest{} }
+func (m *ListEpochSummariesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListEpochSummariesRequest) ProtoMessage()    {}
+func (*ListEpochSummariesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{24}
+}
+func (m *ListEpochSummariesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListEpochSummariesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListEpochSummariesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListEpochSummariesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEpochSummariesRequest.Merge(m, src)
+}
+func (m *ListEpochSummariesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListEpochSummariesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEpochSummariesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEpochSummariesRequest proto.InternalMessageInfo
+
+func (m *ListEpochSummariesRequest) GetFromEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
+}
+
+func (m *ListEpochSummariesRequest) GetToEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.ToEpoch
+	}
+	return 0
+}
+
+type EpochSummaries struct {
+	Summaries            []*EpochSummary `protobuf:"bytes,1,rep,name=summaries,proto3" json:"summaries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *EpochSummaries) Reset()         { *m = EpochSummaries{} }
+func (m *EpochSummaries) String() string { return proto.CompactTextString(m) }
+func (*EpochSummaries) ProtoMessage()    {}
+func (*EpochSummaries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{25}
+}
+func (m *EpochSummaries) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochSummaries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochSummaries.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochSummaries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochSummaries.Merge(m, src)
+}
+func (m *EpochSummaries) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochSummaries) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochSummaries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochSummaries proto.InternalMessageInfo
+
+func (m *EpochSummaries) GetSummaries() []*EpochSummary {
+	if m != nil {
+		return m.Summaries
+	}
+	return nil
+}
+
+type EpochSummary struct {
+	Epoch                  github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	ValidatorCount         uint64                                    `protobuf:"varint,2,opt,name=validator_count,json=validatorCount,proto3" json:"validator_count,omitempty"`
+	ActiveValidatorCount   uint64                                    `protobuf:"varint,3,opt,name=active_validator_count,json=activeValidatorCount,proto3" json:"active_validator_count,omitempty"`
+	TotalBalance           uint64                                    `protobuf:"varint,4,opt,name=total_balance,json=totalBalance,proto3" json:"total_balance,omitempty"`
+	TotalActiveBalance     uint64                                    `protobuf:"varint,5,opt,name=total_active_balance,json=totalActiveBalance,proto3" json:"total_active_balance,omitempty"`
+	EligibleBalance        uint64                                    `protobuf:"varint,6,opt,name=eligible_balance,json=eligibleBalance,proto3" json:"eligible_balance,omitempty"`
+	TargetAttestingBalance uint64                                    `protobuf:"varint,7,opt,name=target_attesting_balance,json=targetAttestingBalance,proto3" json:"target_attesting_balance,omitempty"`
+	HeadAttestingBalance   uint64                                    `protobuf:"varint,8,opt,name=head_attesting_balance,json=headAttestingBalance,proto3" json:"head_attesting_balance,omitempty"`
+	ProposerListHash       []byte                                    `protobuf:"bytes,9,opt,name=proposer_list_hash,json=proposerListHash,proto3" json:"proposer_list_hash,omitempty" ssz-size:"32"`
+	JustifiedEpoch         github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,10,opt,name=justified_epoch,json=justifiedEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"justified_epoch,omitempty"`
+	JustifiedRoot          []byte                                    `protobuf:"bytes,11,opt,name=justified_root,json=justifiedRoot,proto3" json:"justified_root,omitempty" ssz-size:"32"`
+	FinalizedEpoch         github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,12,opt,name=finalized_epoch,json=finalizedEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"finalized_epoch,omitempty"`
+	FinalizedRoot          []byte                                    `protobuf:"bytes,13,opt,name=finalized_root,json=finalizedRoot,proto3" json:"finalized_root,omitempty" ssz-size:"32"`
+	XXX_NoUnkeyedLiteral   struct{}                                  `json:"-"`
+	XXX_unrecognized       []byte                                    `json:"-"`
+	XXX_sizecache          int32                                     `json:"-"`
+}
+
+func (m *EpochSummary) Reset()         { *m = EpochSummary{} }
+func (m *EpochSummary) String() string { return proto.CompactTextString(m) }
+func (*EpochSummary) ProtoMessage()    {}
+func (*EpochSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{26}
+}
+func (m *EpochSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochSummary.Merge(m, src)
+}
+func (m *EpochSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochSummary proto.InternalMessageInfo
+
+func (m *EpochSummary) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochSummary) GetValidatorCount() uint64 {
+	if m != nil {
+		return m.ValidatorCount
+	}
+	return 0
+}
+
+func (m *EpochSummary) GetActiveValidatorCount() uint64 {
+	if m != nil {
+		return m.ActiveValidatorCount
+	}
+	return 0
+}
+
+func (m *EpochSummary) GetTotalBalance() uint64 {
+	if m != nil {
+		return m.TotalBalance
+	}
+	return 0
+}
+
+func (m *EpochSummary) GetTotalActiveBalance() uint64 {
+	if m != nil {
+		return m.TotalActiveBalance
+	}
+	return 0
+}
+
+func (m *EpochSummary) GetEligibleBalance() uint64 {
+	if m != nil {
+		return m.EligibleBalance
+	}
+	return 0
+}
+
+func (m *EpochSummary) GetTargetAttestingBalance() uint64 {
+	if m != nil {
+		return m.TargetAttestingBalance
+	}
+	return 0
+}
+
+func (m *EpochSummary) GetHeadAttestingBalance() uint64 {
+	if m != nil {
+		return m.HeadAttestingBalance
+	}
+	return 0
+}
+
+func (m *EpochSummary) GetProposerListHash() []byte {
+	if m != nil {
+		return m.ProposerListHash
+	}
+	return nil
+}
+
+func (m *EpochSummary) GetJustifiedEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.JustifiedEpoch
+	}
+	return 0
+}
+
+func (m *EpochSummary) GetJustifiedRoot() []byte {
+	if m != nil {
+		return m.JustifiedRoot
+	}
+	return nil
+}
+
+func (m *EpochSummary) GetFinalizedEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.FinalizedEpoch
+	}
+	return 0
+}
+
+func (m *EpochSummary) GetFinalizedRoot() []byte {
+	if m != nil {
+		return m.FinalizedRoot
+	}
+	return nil
+}
+
+type ListValidatorPublicKeysRequest struct {
+	Indices              []github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,1,rep,packed,name=indices,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"indices,omitempty"`
+	PageSize             int32                                                `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string                                               `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                             `json:"-"`
+	XXX_unrecognized     []byte                                               `json:"-"`
+	XXX_sizecache        int32                                                `json:"-"`
+}
+
+func (m *ListValidatorPublicKeysRequest) Reset()         { *m = ListValidatorPublicKeysRequest{} }
+func (m *ListValidatorPublicKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*ListValidatorPublicKeysRequest) ProtoMessage()    {}
+func (*ListValidatorPublicKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{27}
+}
+func (m *ListValidatorPublicKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListValidatorPublicKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListValidatorPublicKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListValidatorPublicKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListValidatorPublicKeysRequest.Merge(m, src)
+}
+func (m *ListValidatorPublicKeysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListValidatorPublicKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListValidatorPublicKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListValidatorPublicKeysRequest proto.InternalMessageInfo
+
+func (m *ListValidatorPublicKeysRequest) GetIndices() []github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
+}
+
+func (m *ListValidatorPublicKeysRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListValidatorPublicKeysRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ValidatorPublicKeys struct {
+	PublicKeys           []*ValidatorPublicKey `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	NextPageToken        string                `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize            int32                 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ValidatorPublicKeys) Reset()         { *m = ValidatorPublicKeys{} }
+func (m *ValidatorPublicKeys) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPublicKeys) ProtoMessage()    {}
+func (*ValidatorPublicKeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{28}
+}
+func (m *ValidatorPublicKeys) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPublicKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPublicKeys.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPublicKeys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPublicKeys.Merge(m, src)
+}
+func (m *ValidatorPublicKeys) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPublicKeys) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPublicKeys.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPublicKeys proto.InternalMessageInfo
+
+func (m *ValidatorPublicKeys) GetPublicKeys() []*ValidatorPublicKey {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+func (m *ValidatorPublicKeys) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *ValidatorPublicKeys) GetTotalSize() int32 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+type ValidatorPublicKey struct {
+	Index                github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,1,opt,name=index,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"index,omitempty"`
+	PublicKey            []byte                                             `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty" ssz-size:"48"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *ValidatorPublicKey) Reset()         { *m = ValidatorPublicKey{} }
+func (m *ValidatorPublicKey) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPublicKey) ProtoMessage()    {}
+func (*ValidatorPublicKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{29}
+}
+func (m *ValidatorPublicKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPublicKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPublicKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPublicKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPublicKey.Merge(m, src)
+}
+func (m *ValidatorPublicKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPublicKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPublicKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPublicKey proto.InternalMessageInfo
+
+func (m *ValidatorPublicKey) GetIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ValidatorPublicKey) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+type ValidatorQueueDetailsRequest struct {
+	TrackedOnly          bool     `protobuf:"varint,1,opt,name=tracked_only,json=trackedOnly,proto3" json:"tracked_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorQueueDetailsRequest) Reset()         { *m = ValidatorQueueDetailsRequest{} }
+func (m *ValidatorQueueDetailsRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorQueueDetailsRequest) ProtoMessage()    {}
+func (*ValidatorQueueDetailsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{30}
+}
+func (m *ValidatorQueueDetailsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorQueueDetailsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorQueueDetailsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorQueueDetailsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorQueueDetailsRequest.Merge(m, src)
+}
+func (m *ValidatorQueueDetailsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorQueueDetailsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorQueueDetailsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorQueueDetailsRequest proto.InternalMessageInfo
+
+func (m *ValidatorQueueDetailsRequest) GetTrackedOnly() bool {
+	if m != nil {
+		return m.TrackedOnly
+	}
+	return false
+}
+
+type ValidatorQueueDetails struct {
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	ChurnLimit           uint64                                    `protobuf:"varint,2,opt,name=churn_limit,json=churnLimit,proto3" json:"churn_limit,omitempty"`
+	Activations          []*QueuedValidator                        `protobuf:"bytes,3,rep,name=activations,proto3" json:"activations,omitempty"`
+	Exits                []*QueuedValidator                        `protobuf:"bytes,4,rep,name=exits,proto3" json:"exits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *ValidatorQueueDetails) Reset()         { *m = ValidatorQueueDetails{} }
+func (m *ValidatorQueueDetails) String() string { return proto.CompactTextString(m) }
+func (*ValidatorQueueDetails) ProtoMessage()    {}
+func (*ValidatorQueueDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{31}
+}
+func (m *ValidatorQueueDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorQueueDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorQueueDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorQueueDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorQueueDetails.Merge(m, src)
+}
+func (m *ValidatorQueueDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorQueueDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorQueueDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorQueueDetails proto.InternalMessageInfo
+
+func (m *ValidatorQueueDetails) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorQueueDetails) GetChurnLimit() uint64 {
+	if m != nil {
+		return m.ChurnLimit
+	}
+	return 0
+}
+
+func (m *ValidatorQueueDetails) GetActivations() []*QueuedValidator {
+	if m != nil {
+		return m.Activations
+	}
+	return nil
+}
+
+func (m *ValidatorQueueDetails) GetExits() []*QueuedValidator {
+	if m != nil {
+		return m.Exits
+	}
+	return nil
+}
+
+type QueuedValidator struct {
+	Index                      github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,1,opt,name=index,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"index,omitempty"`
+	PublicKey                  []byte                                             `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty" ssz-size:"48"`
+	ActivationEligibilityEpoch github_com_prysmaticlabs_eth2_types.Epoch          `protobuf:"varint,3,opt,name=activation_eligibility_epoch,json=activationEligibilityEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"activation_eligibility_epoch,omitempty"`
+	ExpectedEpoch              github_com_prysmaticlabs_eth2_types.Epoch          `protobuf:"varint,4,opt,name=expected_epoch,json=expectedEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"expected_epoch,omitempty"`
+	WithdrawableEpoch          github_com_prysmaticlabs_eth2_types.Epoch          `protobuf:"varint,5,opt,name=withdrawable_epoch,json=withdrawableEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"withdrawable_epoch,omitempty"`
+	Exact                      bool                                               `protobuf:"varint,6,opt,name=exact,proto3" json:"exact,omitempty"`
+	EstimatedWait              time.Duration                                      `protobuf:"varint,7,opt,name=estimated_wait,json=estimatedWait,proto3,casttype=time.Duration" json:"estimated_wait,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{}                                           `json:"-"`
+	XXX_unrecognized           []byte                                             `json:"-"`
+	XXX_sizecache              int32                                              `json:"-"`
+}
+
+func (m *QueuedValidator) Reset()         { *m = QueuedValidator{} }
+func (m *QueuedValidator) String() string { return proto.CompactTextString(m) }
+func (*QueuedValidator) ProtoMessage()    {}
+func (*QueuedValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{32}
+}
+func (m *QueuedValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedValidator.Merge(m, src)
+}
+func (m *QueuedValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedValidator proto.InternalMessageInfo
+
+func (m *QueuedValidator) GetIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *QueuedValidator) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *QueuedValidator) GetActivationEligibilityEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.ActivationEligibilityEpoch
+	}
+	return 0
+}
+
+func (m *QueuedValidator) GetExpectedEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.ExpectedEpoch
+	}
+	return 0
+}
+
+func (m *QueuedValidator) GetWithdrawableEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.WithdrawableEpoch
+	}
+	return 0
+}
+
+func (m *QueuedValidator) GetExact() bool {
+	if m != nil {
+		return m.Exact
+	}
+	return false
+}
+
+func (m *QueuedValidator) GetEstimatedWait() time.Duration {
+	if m != nil {
+		return m.EstimatedWait
+	}
+	return 0
+}
+
+type AnnotatedChainHead struct {
+	Head                  *v1alpha1.ChainHead                                `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	NextSlot              github_com_prysmaticlabs_eth2_types.Slot           `protobuf:"varint,2,opt,name=next_slot,json=nextSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"next_slot,omitempty"`
+	NextProposerIndex     github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,3,opt,name=next_proposer_index,json=nextProposerIndex,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"next_proposer_index,omitempty"`
+	NextProposerPublicKey []byte                                             `protobuf:"bytes,4,opt,name=next_proposer_public_key,json=nextProposerPublicKey,proto3" json:"next_proposer_public_key,omitempty" ssz-size:"48"`
+	XXX_NoUnkeyedLiteral  struct{}                                           `json:"-"`
+	XXX_unrecognized      []byte                                             `json:"-"`
+	XXX_sizecache         int32                                              `json:"-"`
+}
+
+func (m *AnnotatedChainHead) Reset()         { *m = AnnotatedChainHead{} }
+func (m *AnnotatedChainHead) String() string { return proto.CompactTextString(m) }
+func (*AnnotatedChainHead) ProtoMessage()    {}
+func (*AnnotatedChainHead) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{33}
+}
+func (m *AnnotatedChainHead) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AnnotatedChainHead) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AnnotatedChainHead.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AnnotatedChainHead) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnnotatedChainHead.Merge(m, src)
+}
+func (m *AnnotatedChainHead) XXX_Size() int {
+	return m.Size()
+}
+func (m *AnnotatedChainHead) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnnotatedChainHead.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnnotatedChainHead proto.InternalMessageInfo
+
+func (m *AnnotatedChainHead) GetHead() *v1alpha1.ChainHead {
+	if m != nil {
+		return m.Head
+	}
+	return nil
+}
+
+func (m *AnnotatedChainHead) GetNextSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.NextSlot
+	}
+	return 0
+}
+
+func (m *AnnotatedChainHead) GetNextProposerIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.NextProposerIndex
+	}
+	return 0
+}
+
+func (m *AnnotatedChainHead) GetNextProposerPublicKey() []byte {
+	if m != nil {
+		return m.NextProposerPublicKey
+	}
+	return nil
+}
+
+type BlockAvailabilityRequest struct {
+	FromSlot             github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=from_slot,json=fromSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"from_slot,omitempty"`
+	ToSlot               github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,2,opt,name=to_slot,json=toSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"to_slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
+}
+
+func (m *BlockAvailabilityRequest) Reset()         { *m = BlockAvailabilityRequest{} }
+func (m *BlockAvailabilityRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockAvailabilityRequest) ProtoMessage()    {}
+func (*BlockAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{34}
+}
+func (m *BlockAvailabilityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockAvailabilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockAvailabilityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockAvailabilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockAvailabilityRequest.Merge(m, src)
+}
+func (m *BlockAvailabilityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockAvailabilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockAvailabilityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockAvailabilityRequest proto.InternalMessageInfo
+
+func (m *BlockAvailabilityRequest) GetFromSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.FromSlot
+	}
+	return 0
+}
+
+func (m *BlockAvailabilityRequest) GetToSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.ToSlot
+	}
+	return 0
+}
+
+type BlockAvailability struct {
+	FromSlot             github_com_prysmaticlabs_eth2_types.Slot     `protobuf:"varint,1,opt,name=from_slot,json=fromSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"from_slot,omitempty"`
+	ToSlot               github_com_prysmaticlabs_eth2_types.Slot     `protobuf:"varint,2,opt,name=to_slot,json=toSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"to_slot,omitempty"`
+	Available            github_com_prysmaticlabs_go_bitfield.Bitlist `protobuf:"bytes,3,opt,name=available,proto3,casttype=github.com/prysmaticlabs/go-bitfield.Bitlist" json:"available,omitempty" ssz-max:"8192"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *BlockAvailability) Reset()         { *m = BlockAvailability{} }
+func (m *BlockAvailability) String() string { return proto.CompactTextString(m) }
+func (*BlockAvailability) ProtoMessage()    {}
+func (*BlockAvailability) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{35}
+}
+func (m *BlockAvailability) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockAvailability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockAvailability.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockAvailability) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockAvailability.Merge(m, src)
+}
+func (m *BlockAvailability) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockAvailability) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockAvailability.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockAvailability proto.InternalMessageInfo
+
+func (m *BlockAvailability) GetFromSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.FromSlot
+	}
+	return 0
+}
+
+func (m *BlockAvailability) GetToSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.ToSlot
+	}
+	return 0
+}
+
+func (m *BlockAvailability) GetAvailable() github_com_prysmaticlabs_go_bitfield.Bitlist {
+	if m != nil {
+		return m.Available
+	}
+	return nil
+}
+
+type NetworkConfig struct {
+	Name                   string                                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SlotsPerEpoch          github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,2,opt,name=slots_per_epoch,json=slotsPerEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slots_per_epoch,omitempty"`
+	SecondsPerSlot         uint64                                   `protobuf:"varint,3,opt,name=seconds_per_slot,json=secondsPerSlot,proto3" json:"seconds_per_slot,omitempty"`
+	GenesisForkVersion     []byte                                   `protobuf:"bytes,4,opt,name=genesis_fork_version,json=genesisForkVersion,proto3" json:"genesis_fork_version,omitempty" ssz-size:"4"`
+	DepositContractAddress string                                   `protobuf:"bytes,5,opt,name=deposit_contract_address,json=depositContractAddress,proto3" json:"deposit_contract_address,omitempty"`
+	DepositChainId         uint64                                   `protobuf:"varint,6,opt,name=deposit_chain_id,json=depositChainId,proto3" json:"deposit_chain_id,omitempty"`
+	DepositNetworkId       uint64                                   `protobuf:"varint,7,opt,name=deposit_network_id,json=depositNetworkId,proto3" json:"deposit_network_id,omitempty"`
+	Presets                []string                                 `protobuf:"bytes,8,rep,name=presets,proto3" json:"presets,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                                 `json:"-"`
+	XXX_unrecognized       []byte                                   `json:"-"`
+	XXX_sizecache          int32                                    `json:"-"`
+}
+
+func (m *NetworkConfig) Reset()         { *m = NetworkConfig{} }
+func (m *NetworkConfig) String() string { return proto.CompactTextString(m) }
+func (*NetworkConfig) ProtoMessage()    {}
+func (*NetworkConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{36}
+}
+func (m *NetworkConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NetworkConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NetworkConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NetworkConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkConfig.Merge(m, src)
+}
+func (m *NetworkConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *NetworkConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkConfig proto.InternalMessageInfo
+
+func (m *NetworkConfig) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NetworkConfig) GetSlotsPerEpoch() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.SlotsPerEpoch
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetSecondsPerSlot() uint64 {
+	if m != nil {
+		return m.SecondsPerSlot
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetGenesisForkVersion() []byte {
+	if m != nil {
+		return m.GenesisForkVersion
+	}
+	return nil
+}
+
+func (m *NetworkConfig) GetDepositContractAddress() string {
+	if m != nil {
+		return m.DepositContractAddress
+	}
+	return ""
+}
+
+func (m *NetworkConfig) GetDepositChainId() uint64 {
+	if m != nil {
+		return m.DepositChainId
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetDepositNetworkId() uint64 {
+	if m != nil {
+		return m.DepositNetworkId
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetPresets() []string {
+	if m != nil {
+		return m.Presets
+	}
+	return nil
+}
+
+type GetValidatorSetDeltaRequest struct {
+	FromEpoch            github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"from_epoch,omitempty"`
+	ToEpoch              github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,2,opt,name=to_epoch,json=toEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"to_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *GetValidatorSetDeltaRequest) Reset()         { *m = GetValidatorSetDeltaRequest{} }
+func (m *GetValidatorSetDeltaRequest) String() string { return proto.CompactTextString(m) }
+func (*GetValidatorSetDeltaRequest) ProtoMessage()    {}
+func (*GetValidatorSetDeltaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{37}
+}
+func (m *GetValidatorSetDeltaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetValidatorSetDeltaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetValidatorSetDeltaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetValidatorSetDeltaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValidatorSetDeltaRequest.Merge(m, src)
+}
+func (m *GetValidatorSetDeltaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetValidatorSetDeltaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetValidatorSetDeltaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetValidatorSetDeltaRequest proto.InternalMessageInfo
+
+func (m *GetValidatorSetDeltaRequest) GetFromEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
+}
+
+func (m *GetValidatorSetDeltaRequest) GetToEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.ToEpoch
+	}
+	return 0
+}
+
+type ValidatorRecord struct {
+	Index                github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,1,opt,name=index,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"index,omitempty"`
+	PublicKey            []byte                                             `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty" ssz-size:"48"`
+	Status               v1alpha1.ValidatorStatus                           `protobuf:"varint,3,opt,name=status,proto3,enum=ethereum.eth.v1alpha1.ValidatorStatus" json:"status,omitempty"`
+	EffectiveBalance     uint64                                             `protobuf:"varint,4,opt,name=effective_balance,json=effectiveBalance,proto3" json:"effective_balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *ValidatorRecord) Reset()         { *m = ValidatorRecord{} }
+func (m *ValidatorRecord) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRecord) ProtoMessage()    {}
+func (*ValidatorRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{38}
+}
+func (m *ValidatorRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRecord.Merge(m, src)
+}
+func (m *ValidatorRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRecord proto.InternalMessageInfo
+
+func (m *ValidatorRecord) GetIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ValidatorRecord) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *ValidatorRecord) GetStatus() v1alpha1.ValidatorStatus {
+	if m != nil {
+		return m.Status
+	}
+	return v1alpha1.ValidatorStatus_UNKNOWN_STATUS
+}
+
+func (m *ValidatorRecord) GetEffectiveBalance() uint64 {
+	if m != nil {
+		return m.EffectiveBalance
+	}
+	return 0
+}
+
+type ValidatorSetDelta struct {
+	FromEpoch            github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"from_epoch,omitempty"`
+	ToEpoch              github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,2,opt,name=to_epoch,json=toEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"to_epoch,omitempty"`
+	ValidatorCount       uint64                                    `protobuf:"varint,3,opt,name=validator_count,json=validatorCount,proto3" json:"validator_count,omitempty"`
+	Added                []*ValidatorRecord                        `protobuf:"bytes,4,rep,name=added,proto3" json:"added,omitempty"`
+	Changed              []*ValidatorRecord                        `protobuf:"bytes,5,rep,name=changed,proto3" json:"changed,omitempty"`
+	Removed              []*ValidatorRecord                        `protobuf:"bytes,6,rep,name=removed,proto3" json:"removed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *ValidatorSetDelta) Reset()         { *m = ValidatorSetDelta{} }
+func (m *ValidatorSetDelta) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSetDelta) ProtoMessage()    {}
+func (*ValidatorSetDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{39}
+}
+func (m *ValidatorSetDelta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSetDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSetDelta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSetDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSetDelta.Merge(m, src)
+}
+func (m *ValidatorSetDelta) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSetDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSetDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSetDelta proto.InternalMessageInfo
+
+func (m *ValidatorSetDelta) GetFromEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
+}
+
+func (m *ValidatorSetDelta) GetToEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.ToEpoch
+	}
+	return 0
+}
+
+func (m *ValidatorSetDelta) GetValidatorCount() uint64 {
+	if m != nil {
+		return m.ValidatorCount
+	}
+	return 0
+}
+
+func (m *ValidatorSetDelta) GetAdded() []*ValidatorRecord {
+	if m != nil {
+		return m.Added
+	}
+	return nil
+}
+
+func (m *ValidatorSetDelta) GetChanged() []*ValidatorRecord {
+	if m != nil {
+		return m.Changed
+	}
+	return nil
+}
+
+func (m *ValidatorSetDelta) GetRemoved() []*ValidatorRecord {
+	if m != nil {
+		return m.Removed
+	}
+	return nil
+}
+
+type PrefetchEpochInfoRequest struct {
+	FromEpoch            github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"from_epoch,omitempty"`
+	ToEpoch              github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,2,opt,name=to_epoch,json=toEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"to_epoch,omitempty"`
+	AllowGaps            bool                                      `protobuf:"varint,3,opt,name=allow_gaps,json=allowGaps,proto3" json:"allow_gaps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *PrefetchEpochInfoRequest) Reset()         { *m = PrefetchEpochInfoRequest{} }
+func (m *PrefetchEpochInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*PrefetchEpochInfoRequest) ProtoMessage()    {}
+func (*PrefetchEpochInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{40}
+}
+func (m *PrefetchEpochInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrefetchEpochInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrefetchEpochInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrefetchEpochInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrefetchEpochInfoRequest.Merge(m, src)
+}
+func (m *PrefetchEpochInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrefetchEpochInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrefetchEpochInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrefetchEpochInfoRequest proto.InternalMessageInfo
+
+func (m *PrefetchEpochInfoRequest) GetFromEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
+}
+
+func (m *PrefetchEpochInfoRequest) GetToEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.ToEpoch
+	}
+	return 0
+}
+
+func (m *PrefetchEpochInfoRequest) GetAllowGaps() bool {
+	if m != nil {
+		return m.AllowGaps
+	}
+	return false
+}
+
+type PrefetchJob struct {
+	JobId                uint64   `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrefetchJob) Reset()         { *m = PrefetchJob{} }
+func (m *PrefetchJob) String() string { return proto.CompactTextString(m) }
+func (*PrefetchJob) ProtoMessage()    {}
+func (*PrefetchJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{41}
+}
+func (m *PrefetchJob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrefetchJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrefetchJob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrefetchJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrefetchJob.Merge(m, src)
+}
+func (m *PrefetchJob) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrefetchJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrefetchJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrefetchJob proto.InternalMessageInfo
+
+func (m *PrefetchJob) GetJobId() uint64 {
+	if m != nil {
+		return m.JobId
+	}
+	return 0
+}
+
+type GetPrefetchStatusRequest struct {
+	JobId                uint64   `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPrefetchStatusRequest) Reset()         { *m = GetPrefetchStatusRequest{} }
+func (m *GetPrefetchStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPrefetchStatusRequest) ProtoMessage()    {}
+func (*GetPrefetchStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{42}
+}
+func (m *GetPrefetchStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPrefetchStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPrefetchStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetPrefetchStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPrefetchStatusRequest.Merge(m, src)
+}
+func (m *GetPrefetchStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPrefetchStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPrefetchStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPrefetchStatusRequest proto.InternalMessageInfo
+
+func (m *GetPrefetchStatusRequest) GetJobId() uint64 {
+	if m != nil {
+		return m.JobId
+	}
+	return 0
+}
+
+type PrefetchStatus struct {
+	JobId                uint64                                    `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	FromEpoch            github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,2,opt,name=from_epoch,json=fromEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"from_epoch,omitempty"`
+	ToEpoch              github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,3,opt,name=to_epoch,json=toEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"to_epoch,omitempty"`
+	Completed            uint64                                    `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
+	Total                uint64                                    `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	Done                 bool                                      `protobuf:"varint,6,opt,name=done,proto3" json:"done,omitempty"`
+	Error                string                                    `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	Gaps                 []*EpochGap                               `protobuf:"bytes,8,rep,name=gaps,proto3" json:"gaps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *PrefetchStatus) Reset()         { *m = PrefetchStatus{} }
+func (m *PrefetchStatus) String() string { return proto.CompactTextString(m) }
+func (*PrefetchStatus) ProtoMessage()    {}
+func (*PrefetchStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{43}
+}
+func (m *PrefetchStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrefetchStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrefetchStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrefetchStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrefetchStatus.Merge(m, src)
+}
+func (m *PrefetchStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrefetchStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrefetchStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrefetchStatus proto.InternalMessageInfo
+
+func (m *PrefetchStatus) GetJobId() uint64 {
+	if m != nil {
+		return m.JobId
+	}
+	return 0
+}
+
+func (m *PrefetchStatus) GetFromEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
+}
+
+func (m *PrefetchStatus) GetToEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.ToEpoch
+	}
+	return 0
+}
+
+func (m *PrefetchStatus) GetCompleted() uint64 {
+	if m != nil {
+		return m.Completed
+	}
+	return 0
+}
+
+func (m *PrefetchStatus) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *PrefetchStatus) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+func (m *PrefetchStatus) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *PrefetchStatus) GetGaps() []*EpochGap {
+	if m != nil {
+		return m.Gaps
+	}
+	return nil
+}
+
+type EpochGap struct {
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	Reason               string                                    `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *EpochGap) Reset()         { *m = EpochGap{} }
+func (m *EpochGap) String() string { return proto.CompactTextString(m) }
+func (*EpochGap) ProtoMessage()    {}
+func (*EpochGap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{44}
+}
+func (m *EpochGap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochGap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochGap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochGap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochGap.Merge(m, src)
+}
+func (m *EpochGap) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochGap) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochGap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochGap proto.InternalMessageInfo
+
+func (m *EpochGap) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochGap) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type ListCanonicalBlockRootsRequest struct {
+	FromSlot             github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=from_slot,json=fromSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"from_slot,omitempty"`
+	ToSlot               github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,2,opt,name=to_slot,json=toSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"to_slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
+}
+
+func (m *ListCanonicalBlockRootsRequest) Reset()         { *m = ListCanonicalBlockRootsRequest{} }
+func (m *ListCanonicalBlockRootsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCanonicalBlockRootsRequest) ProtoMessage()    {}
+func (*ListCanonicalBlockRootsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{45}
+}
+func (m *ListCanonicalBlockRootsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListCanonicalBlockRootsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListCanonicalBlockRootsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListCanonicalBlockRootsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCanonicalBlockRootsRequest.Merge(m, src)
+}
+func (m *ListCanonicalBlockRootsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListCanonicalBlockRootsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCanonicalBlockRootsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCanonicalBlockRootsRequest proto.InternalMessageInfo
+
+func (m *ListCanonicalBlockRootsRequest) GetFromSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.FromSlot
+	}
+	return 0
+}
+
+func (m *ListCanonicalBlockRootsRequest) GetToSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.ToSlot
+	}
+	return 0
+}
+
+type CanonicalBlockRoots struct {
+	Roots                []*CanonicalBlockRoot `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CanonicalBlockRoots) Reset()         { *m = CanonicalBlockRoots{} }
+func (m *CanonicalBlockRoots) String() string { return proto.CompactTextString(m) }
+func (*CanonicalBlockRoots) ProtoMessage()    {}
+func (*CanonicalBlockRoots) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{46}
+}
+func (m *CanonicalBlockRoots) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CanonicalBlockRoots) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CanonicalBlockRoots.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CanonicalBlockRoots) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanonicalBlockRoots.Merge(m, src)
+}
+func (m *CanonicalBlockRoots) XXX_Size() int {
+	return m.Size()
+}
+func (m *CanonicalBlockRoots) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanonicalBlockRoots.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanonicalBlockRoots proto.InternalMessageInfo
+
+func (m *CanonicalBlockRoots) GetRoots() []*CanonicalBlockRoot {
+	if m != nil {
+		return m.Roots
+	}
+	return nil
+}
+
+type CanonicalBlockRoot struct {
+	Slot                 github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+	Root                 []byte                                   `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty" ssz-size:"32"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
+}
+
+func (m *CanonicalBlockRoot) Reset()         { *m = CanonicalBlockRoot{} }
+func (m *CanonicalBlockRoot) String() string { return proto.CompactTextString(m) }
+func (*CanonicalBlockRoot) ProtoMessage()    {}
+func (*CanonicalBlockRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{47}
+}
+func (m *CanonicalBlockRoot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CanonicalBlockRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CanonicalBlockRoot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CanonicalBlockRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanonicalBlockRoot.Merge(m, src)
+}
+func (m *CanonicalBlockRoot) XXX_Size() int {
+	return m.Size()
+}
+func (m *CanonicalBlockRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanonicalBlockRoot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanonicalBlockRoot proto.InternalMessageInfo
+
+func (m *CanonicalBlockRoot) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *CanonicalBlockRoot) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+type StreamProposerAuditRequest struct {
+	TrackedOnly          bool     `protobuf:"varint,1,opt,name=tracked_only,json=trackedOnly,proto3" json:"tracked_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamProposerAuditRequest) Reset()         { *m = StreamProposerAuditRequest{} }
+func (m *StreamProposerAuditRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamProposerAuditRequest) ProtoMessage()    {}
+func (*StreamProposerAuditRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{48}
+}
+func (m *StreamProposerAuditRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamProposerAuditRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamProposerAuditRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamProposerAuditRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamProposerAuditRequest.Merge(m, src)
+}
+func (m *StreamProposerAuditRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamProposerAuditRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamProposerAuditRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamProposerAuditRequest proto.InternalMessageInfo
+
+func (m *StreamProposerAuditRequest) GetTrackedOnly() bool {
+	if m != nil {
+		return m.TrackedOnly
+	}
+	return false
+}
+
+type ProposerAudit struct {
+	Slot                 github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+	ExpectedProposer     []byte                                   `protobuf:"bytes,2,opt,name=expected_proposer,json=expectedProposer,proto3" json:"expected_proposer,omitempty" ssz-size:"48"`
+	BlockRoot            []byte                                   `protobuf:"bytes,3,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty" ssz-size:"32"`
+	ActualProposer       []byte                                   `protobuf:"bytes,4,opt,name=actual_proposer,json=actualProposer,proto3" json:"actual_proposer,omitempty" ssz-size:"48"`
+	Outcome              ProposerAudit_Outcome                    `protobuf:"varint,5,opt,name=outcome,proto3,enum=ethereum.beacon.rpc.v1.ProposerAudit_Outcome" json:"outcome,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
+}
+
+func (m *ProposerAudit) Reset()         { *m = ProposerAudit{} }
+func (m *ProposerAudit) String() string { return proto.CompactTextString(m) }
+func (*ProposerAudit) ProtoMessage()    {}
+func (*ProposerAudit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{49}
+}
+func (m *ProposerAudit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposerAudit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposerAudit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposerAudit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerAudit.Merge(m, src)
+}
+func (m *ProposerAudit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposerAudit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerAudit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerAudit proto.InternalMessageInfo
+
+func (m *ProposerAudit) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *ProposerAudit) GetExpectedProposer() []byte {
+	if m != nil {
+		return m.ExpectedProposer
+	}
+	return nil
+}
+
+func (m *ProposerAudit) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
+	}
+	return nil
+}
+
+func (m *ProposerAudit) GetActualProposer() []byte {
+	if m != nil {
+		return m.ActualProposer
+	}
+	return nil
+}
+
+func (m *ProposerAudit) GetOutcome() ProposerAudit_Outcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return ProposerAudit_PRODUCED
+}
+
+type TrackValidatorsRequest struct {
+	Add                  [][]byte `protobuf:"bytes,1,rep,name=add,proto3" json:"add,omitempty" ssz-size:"?,48"`
+	Remove               [][]byte `protobuf:"bytes,2,rep,name=remove,proto3" json:"remove,omitempty" ssz-size:"?,48"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrackValidatorsRequest) Reset()         { *m = TrackValidatorsRequest{} }
+func (m *TrackValidatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*TrackValidatorsRequest) ProtoMessage()    {}
+func (*TrackValidatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{50}
+}
+func (m *TrackValidatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrackValidatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrackValidatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrackValidatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrackValidatorsRequest.Merge(m, src)
+}
+func (m *TrackValidatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrackValidatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrackValidatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrackValidatorsRequest proto.InternalMessageInfo
+
+func (m *TrackValidatorsRequest) GetAdd() [][]byte {
+	if m != nil {
+		return m.Add
+	}
+	return nil
+}
+
+func (m *TrackValidatorsRequest) GetRemove() [][]byte {
+	if m != nil {
+		return m.Remove
+	}
+	return nil
+}
+
+type TrackedValidatorsResponse struct {
+	PublicKeys           [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty" ssz-size:"?,48"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrackedValidatorsResponse) Reset()         { *m = TrackedValidatorsResponse{} }
+func (m *TrackedValidatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*TrackedValidatorsResponse) ProtoMessage()    {}
+func (*TrackedValidatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{51}
+}
+func (m *TrackedValidatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrackedValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrackedValidatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrackedValidatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrackedValidatorsResponse.Merge(m, src)
+}
+func (m *TrackedValidatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrackedValidatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrackedValidatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrackedValidatorsResponse proto.InternalMessageInfo
+
+func (m *TrackedValidatorsResponse) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+type ImportTrackedValidatorsRequest struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Replace              bool     `protobuf:"varint,2,opt,name=replace,proto3" json:"replace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportTrackedValidatorsRequest) Reset()         { *m = ImportTrackedValidatorsRequest{} }
+func (m *ImportTrackedValidatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportTrackedValidatorsRequest) ProtoMessage()    {}
+func (*ImportTrackedValidatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{52}
+}
+func (m *ImportTrackedValidatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportTrackedValidatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportTrackedValidatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportTrackedValidatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportTrackedValidatorsRequest.Merge(m, src)
+}
+func (m *ImportTrackedValidatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportTrackedValidatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportTrackedValidatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportTrackedValidatorsRequest proto.InternalMessageInfo
+
+func (m *ImportTrackedValidatorsRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ImportTrackedValidatorsRequest) GetReplace() bool {
+	if m != nil {
+		return m.Replace
+	}
+	return false
+}
+
+type TrackedValidatorsExport struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrackedValidatorsExport) Reset()         { *m = TrackedValidatorsExport{} }
+func (m *TrackedValidatorsExport) String() string { return proto.CompactTextString(m) }
+func (*TrackedValidatorsExport) ProtoMessage()    {}
+func (*TrackedValidatorsExport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{53}
+}
+func (m *TrackedValidatorsExport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrackedValidatorsExport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrackedValidatorsExport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrackedValidatorsExport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrackedValidatorsExport.Merge(m, src)
+}
+func (m *TrackedValidatorsExport) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrackedValidatorsExport) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrackedValidatorsExport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrackedValidatorsExport proto.InternalMessageInfo
+
+func (m *TrackedValidatorsExport) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ListPoolAttestationsRequest struct {
+	// Types that are valid to be assigned to SlotFilter:
+	//	*ListPoolAttestationsRequest_Slot
+	SlotFilter isListPoolAttestationsRequest_SlotFilter `protobuf_oneof:"slot_filter"`
+	// Types that are valid to be assigned to CommitteeFilter:
+	//	*ListPoolAttestationsRequest_CommitteeIndex
+	CommitteeFilter      isListPoolAttestationsRequest_CommitteeFilter `protobuf_oneof:"committee_filter"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *ListPoolAttestationsRequest) Reset()         { *m = ListPoolAttestationsRequest{} }
+func (m *ListPoolAttestationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPoolAttestationsRequest) ProtoMessage()    {}
+func (*ListPoolAttestationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{54}
+}
+func (m *ListPoolAttestationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListPoolAttestationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListPoolAttestationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListPoolAttestationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPoolAttestationsRequest.Merge(m, src)
+}
+func (m *ListPoolAttestationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListPoolAttestationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPoolAttestationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPoolAttestationsRequest proto.InternalMessageInfo
+
+type isListPoolAttestationsRequest_SlotFilter interface {
+	isListPoolAttestationsRequest_SlotFilter()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+type isListPoolAttestationsRequest_CommitteeFilter interface {
+	isListPoolAttestationsRequest_CommitteeFilter()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type ListPoolAttestationsRequest_Slot struct {
+	Slot github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=slot,proto3,oneof,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+}
+type ListPoolAttestationsRequest_CommitteeIndex struct {
+	CommitteeIndex github_com_prysmaticlabs_eth2_types.CommitteeIndex `protobuf:"varint,2,opt,name=committee_index,json=committeeIndex,proto3,oneof,casttype=github.com/prysmaticlabs/eth2-types.CommitteeIndex" json:"committee_index,omitempty"`
+}
+
+func (*ListPoolAttestationsRequest_Slot) isListPoolAttestationsRequest_SlotFilter()                {}
+func (*ListPoolAttestationsRequest_CommitteeIndex) isListPoolAttestationsRequest_CommitteeFilter() {}
+
+func (m *ListPoolAttestationsRequest) GetSlotFilter() isListPoolAttestationsRequest_SlotFilter {
+	if m != nil {
+		return m.SlotFilter
+	}
+	return nil
+}
+func (m *ListPoolAttestationsRequest) GetCommitteeFilter() isListPoolAttestationsRequest_CommitteeFilter {
+	if m != nil {
+		return m.CommitteeFilter
+	}
+	return nil
+}
+
+func (m *ListPoolAttestationsRequest) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if x, ok := m.GetSlotFilter().(*ListPoolAttestationsRequest_Slot); ok {
+		return x.Slot
+	}
+	return 0
+}
+
+func (m *ListPoolAttestationsRequest) GetCommitteeIndex() github_com_prysmaticlabs_eth2_types.CommitteeIndex {
+	if x, ok := m.GetCommitteeFilter().(*ListPoolAttestationsRequest_CommitteeIndex); ok {
+		return x.CommitteeIndex
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ListPoolAttestationsRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ListPoolAttestationsRequest_Slot)(nil),
+		(*ListPoolAttestationsRequest_CommitteeIndex)(nil),
+	}
+}
+
+type PoolAttestations struct {
+	Committees           []*PoolCommitteeAttestations `protobuf:"bytes,1,rep,name=committees,proto3" json:"committees,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *PoolAttestations) Reset()         { *m = PoolAttestations{} }
+func (m *PoolAttestations) String() string { return proto.CompactTextString(m) }
+func (*PoolAttestations) ProtoMessage()    {}
+func (*PoolAttestations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{55}
+}
+func (m *PoolAttestations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolAttestations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolAttestations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolAttestations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolAttestations.Merge(m, src)
+}
+func (m *PoolAttestations) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolAttestations) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolAttestations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolAttestations proto.InternalMessageInfo
+
+func (m *PoolAttestations) GetCommittees() []*PoolCommitteeAttestations {
+	if m != nil {
+		return m.Committees
+	}
+	return nil
+}
+
+type PoolCommitteeAttestations struct {
+	Slot                 github_com_prysmaticlabs_eth2_types.Slot           `protobuf:"varint,1,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+	CommitteeIndex       github_com_prysmaticlabs_eth2_types.CommitteeIndex `protobuf:"varint,2,opt,name=committee_index,json=committeeIndex,proto3,casttype=github.com/prysmaticlabs/eth2-types.CommitteeIndex" json:"committee_index,omitempty"`
+	Unaggregated         []*v1alpha1.Attestation                            `protobuf:"bytes,3,rep,name=unaggregated,proto3" json:"unaggregated,omitempty"`
+	Aggregated           []*v1alpha1.Attestation                            `protobuf:"bytes,4,rep,name=aggregated,proto3" json:"aggregated,omitempty"`
+	Participants         uint64                                             `protobuf:"varint,5,opt,name=participants,proto3" json:"participants,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *PoolCommitteeAttestations) Reset()         { *m = PoolCommitteeAttestations{} }
+func (m *PoolCommitteeAttestations) String() string { return proto.CompactTextString(m) }
+func (*PoolCommitteeAttestations) ProtoMessage()    {}
+func (*PoolCommitteeAttestations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{56}
+}
+func (m *PoolCommitteeAttestations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolCommitteeAttestations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolCommitteeAttestations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolCommitteeAttestations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolCommitteeAttestations.Merge(m, src)
+}
+func (m *PoolCommitteeAttestations) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolCommitteeAttestations) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolCommitteeAttestations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolCommitteeAttestations proto.InternalMessageInfo
+
+func (m *PoolCommitteeAttestations) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *PoolCommitteeAttestations) GetCommitteeIndex() github_com_prysmaticlabs_eth2_types.CommitteeIndex {
+	if m != nil {
+		return m.CommitteeIndex
+	}
+	return 0
+}
+
+func (m *PoolCommitteeAttestations) GetUnaggregated() []*v1alpha1.Attestation {
+	if m != nil {
+		return m.Unaggregated
+	}
+	return nil
+}
+
+func (m *PoolCommitteeAttestations) GetAggregated() []*v1alpha1.Attestation {
+	if m != nil {
+		return m.Aggregated
+	}
+	return nil
+}
+
+func (m *PoolCommitteeAttestations) GetParticipants() uint64 {
+	if m != nil {
+		return m.Participants
+	}
+	return 0
+}
+
+type Eth1DataStatus struct {
+	VotingPeriodStartSlot     github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=voting_period_start_slot,json=votingPeriodStartSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"voting_period_start_slot,omitempty"`
+	NextVotingPeriodStartSlot github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,2,opt,name=next_voting_period_start_slot,json=nextVotingPeriodStartSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"next_voting_period_start_slot,omitempty"`
+	Eth1Data                  *v1alpha1.Eth1Data                       `protobuf:"bytes,3,opt,name=eth1_data,json=eth1Data,proto3" json:"eth1_data,omitempty"`
+	Eth1DepositIndex          uint64                                   `protobuf:"varint,4,opt,name=eth1_deposit_index,json=eth1DepositIndex,proto3" json:"eth1_deposit_index,omitempty"`
+	PendingDeposits           uint64                                   `protobuf:"varint,5,opt,name=pending_deposits,json=pendingDeposits,proto3" json:"pending_deposits,omitempty"`
+	Votes                     uint64                                   `protobuf:"varint,6,opt,name=votes,proto3" json:"votes,omitempty"`
+	Vote                      *v1alpha1.Eth1Data                       `protobuf:"bytes,7,opt,name=vote,proto3" json:"vote,omitempty"`
+	VoteSupport               uint64                                   `protobuf:"varint,8,opt,name=vote_support,json=voteSupport,proto3" json:"vote_support,omitempty"`
+	RequiredSupport           uint64                                   `protobuf:"varint,9,opt,name=required_support,json=requiredSupport,proto3" json:"required_support,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{}                                 `json:"-"`
+	XXX_unrecognized          []byte                                   `json:"-"`
+	XXX_sizecache             int32                                    `json:"-"`
+}
+
+func (m *Eth1DataStatus) Reset()         { *m = Eth1DataStatus{} }
+func (m *Eth1DataStatus) String() string { return proto.CompactTextString(m) }
+func (*Eth1DataStatus) ProtoMessage()    {}
+func (*Eth1DataStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{57}
+}
+func (m *Eth1DataStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Eth1DataStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Eth1DataStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Eth1DataStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eth1DataStatus.Merge(m, src)
+}
+func (m *Eth1DataStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *Eth1DataStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eth1DataStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Eth1DataStatus proto.InternalMessageInfo
+
+func (m *Eth1DataStatus) GetVotingPeriodStartSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.VotingPeriodStartSlot
+	}
+	return 0
+}
+
+func (m *Eth1DataStatus) GetNextVotingPeriodStartSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.NextVotingPeriodStartSlot
+	}
+	return 0
+}
+
+func (m *Eth1DataStatus) GetEth1Data() *v1alpha1.Eth1Data {
+	if m != nil {
+		return m.Eth1Data
+	}
+	return nil
+}
+
+func (m *Eth1DataStatus) GetEth1DepositIndex() uint64 {
+	if m != nil {
+		return m.Eth1DepositIndex
+	}
+	return 0
+}
+
+func (m *Eth1DataStatus) GetPendingDeposits() uint64 {
+	if m != nil {
+		return m.PendingDeposits
+	}
+	return 0
+}
+
+func (m *Eth1DataStatus) GetVotes() uint64 {
+	if m != nil {
+		return m.Votes
+	}
+	return 0
+}
+
+func (m *Eth1DataStatus) GetVote() *v1alpha1.Eth1Data {
+	if m != nil {
+		return m.Vote
+	}
+	return nil
+}
+
+func (m *Eth1DataStatus) GetVoteSupport() uint64 {
+	if m != nil {
+		return m.VoteSupport
+	}
+	return 0
+}
+
+func (m *Eth1DataStatus) GetRequiredSupport() uint64 {
+	if m != nil {
+		return m.RequiredSupport
+	}
+	return 0
+}
+
+type DepositInclusion struct {
+	Slot                  github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+	BlockRoot             []byte                                   `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty" ssz-size:"32"`
+	Index                 uint64                                   `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	PublicKey             []byte                                   `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty" ssz-size:"48"`
+	WithdrawalCredentials []byte                                   `protobuf:"bytes,5,opt,name=withdrawal_credentials,json=withdrawalCredentials,proto3" json:"withdrawal_credentials,omitempty" ssz-size:"32"`
+	Amount                uint64                                   `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                                 `json:"-"`
+	XXX_unrecognized      []byte                                   `json:"-"`
+	XXX_sizecache         int32                                    `json:"-"`
+}
+
+func (m *DepositInclusion) Reset()         { *m = DepositInclusion{} }
+func (m *DepositInclusion) String() string { return proto.CompactTextString(m) }
+func (*DepositInclusion) ProtoMessage()    {}
+func (*DepositInclusion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{58}
+}
+func (m *DepositInclusion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositInclusion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositInclusion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositInclusion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositInclusion.Merge(m, src)
+}
+func (m *DepositInclusion) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositInclusion) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositInclusion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositInclusion proto.InternalMessageInfo
+
+func (m *DepositInclusion) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *DepositInclusion) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
+	}
+	return nil
+}
+
+func (m *DepositInclusion) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *DepositInclusion) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *DepositInclusion) GetWithdrawalCredentials() []byte {
+	if m != nil {
+		return m.WithdrawalCredentials
+	}
+	return nil
+}
+
+func (m *DepositInclusion) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type SimulateEpochTransitionRequest struct {
+	// Types that are valid to be assigned to StateFilter:
+	//	*SimulateEpochTransitionRequest_StateRoot
+	//	*SimulateEpochTransitionRequest_Slot
+	StateFilter          isSimulateEpochTransitionRequest_StateFilter `protobuf_oneof:"state_filter"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *SimulateEpochTransitionRequest) Reset()         { *m = SimulateEpochTransitionRequest{} }
+func (m *SimulateEpochTransitionRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateEpochTransitionRequest) ProtoMessage()    {}
+func (*SimulateEpochTransitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{59}
+}
+func (m *SimulateEpochTransitionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateEpochTransitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateEpochTransitionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateEpochTransitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateEpochTransitionRequest.Merge(m, src)
+}
+func (m *SimulateEpochTransitionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateEpochTransitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateEpochTransitionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateEpochTransitionRequest proto.InternalMessageInfo
+
+type isSimulateEpochTransitionRequest_StateFilter interface {
+	isSimulateEpochTransitionRequest_StateFilter()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type SimulateEpochTransitionRequest_StateRoot struct {
+	StateRoot []byte `protobuf:"bytes,1,opt,name=state_root,json=stateRoot,proto3,oneof" json:"state_root,omitempty" ssz-size:"32"`
+}
+type SimulateEpochTransitionRequest_Slot struct {
+	Slot github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,2,opt,name=slot,proto3,oneof,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+}
+
+func (*SimulateEpochTransitionRequest_StateRoot) isSimulateEpochTransitionRequest_StateFilter() {}
+func (*SimulateEpochTransitionRequest_Slot) isSimulateEpochTransitionRequest_StateFilter()      {}
+
+func (m *SimulateEpochTransitionRequest) GetStateFilter() isSimulateEpochTransitionRequest_StateFilter {
+	if m != nil {
+		return m.StateFilter
+	}
+	return nil
+}
+
+func (m *SimulateEpochTransitionRequest) GetStateRoot() []byte {
+	if x, ok := m.GetStateFilter().(*SimulateEpochTransitionRequest_StateRoot); ok {
+		return x.StateRoot
+	}
+	return nil
+}
+
+func (m *SimulateEpochTransitionRequest) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if x, ok := m.GetStateFilter().(*SimulateEpochTransitionRequest_Slot); ok {
+		return x.Slot
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*SimulateEpochTransitionRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*SimulateEpochTransitionRequest_StateRoot)(nil),
+		(*SimulateEpochTransitionRequest_Slot)(nil),
+	}
+}
+
+type EpochTransitionSimulation struct {
+	Slot                            github_com_prysmaticlabs_eth2_types.Slot             `protobuf:"varint,1,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+	Epoch                           github_com_prysmaticlabs_eth2_types.Epoch            `protobuf:"varint,2,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	PreviousJustifiedCheckpoint     *v1alpha1.Checkpoint                                 `protobuf:"bytes,3,opt,name=previous_justified_checkpoint,json=previousJustifiedCheckpoint,proto3" json:"previous_justified_checkpoint,omitempty"`
+	CurrentJustifiedCheckpoint      *v1alpha1.Checkpoint                                 `protobuf:"bytes,4,opt,name=current_justified_checkpoint,json=currentJustifiedCheckpoint,proto3" json:"current_justified_checkpoint,omitempty"`
+	FinalizedCheckpoint             *v1alpha1.Checkpoint                                 `protobuf:"bytes,5,opt,name=finalized_checkpoint,json=finalizedCheckpoint,proto3" json:"finalized_checkpoint,omitempty"`
+	PostPreviousJustifiedCheckpoint *v1alpha1.Checkpoint                                 `protobuf:"bytes,6,opt,name=post_previous_justified_checkpoint,json=postPreviousJustifiedCheckpoint,proto3" json:"post_previous_justified_checkpoint,omitempty"`
+	PostCurrentJustifiedCheckpoint  *v1alpha1.Checkpoint                                 `protobuf:"bytes,7,opt,name=post_current_justified_checkpoint,json=postCurrentJustifiedCheckpoint,proto3" json:"post_current_justified_checkpoint,omitempty"`
+	PostFinalizedCheckpoint         *v1alpha1.Checkpoint                                 `protobuf:"bytes,8,opt,name=post_finalized_checkpoint,json=postFinalizedCheckpoint,proto3" json:"post_finalized_checkpoint,omitempty"`
+	JustificationChanged            bool                                                 `protobuf:"varint,9,opt,name=justification_changed,json=justificationChanged,proto3" json:"justification_changed,omitempty"`
+	FinalizationChanged             bool                                                 `protobuf:"varint,10,opt,name=finalization_changed,json=finalizationChanged,proto3" json:"finalization_changed,omitempty"`
+	Penalties                       []*ValidatorPenalty                                  `protobuf:"bytes,11,rep,name=penalties,proto3" json:"penalties,omitempty"`
+	TotalPenalties                  uint64                                               `protobuf:"varint,12,opt,name=total_penalties,json=totalPenalties,proto3" json:"total_penalties,omitempty"`
+	EjectedValidators               []github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,13,rep,packed,name=ejected_validators,json=ejectedValidators,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"ejected_validators,omitempty"`
+	NextEpochProposers              [][]byte                                             `protobuf:"bytes,14,rep,name=next_epoch_proposers,json=nextEpochProposers,proto3" json:"next_epoch_proposers,omitempty" ssz-size:"?,48"`
+	ProposerListRoot                []byte                                               `protobuf:"bytes,15,opt,name=proposer_list_root,json=proposerListRoot,proto3" json:"proposer_list_root,omitempty" ssz-size:"32"`
+	XXX_NoUnkeyedLiteral            struct{}                                             `json:"-"`
+	XXX_unrecognized                []byte                                               `json:"-"`
+	XXX_sizecache                   int32                                                `json:"-"`
+}
+
+func (m *EpochTransitionSimulation) Reset()         { *m = EpochTransitionSimulation{} }
+func (m *EpochTransitionSimulation) String() string { return proto.CompactTextString(m) }
+func (*EpochTransitionSimulation) ProtoMessage()    {}
+func (*EpochTransitionSimulation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{60}
+}
+func (m *EpochTransitionSimulation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochTransitionSimulation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochTransitionSimulation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochTransitionSimulation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochTransitionSimulation.Merge(m, src)
+}
+func (m *EpochTransitionSimulation) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochTransitionSimulation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochTransitionSimulation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochTransitionSimulation proto.InternalMessageInfo
+
+func (m *EpochTransitionSimulation) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *EpochTransitionSimulation) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochTransitionSimulation) GetPreviousJustifiedCheckpoint() *v1alpha1.Checkpoint {
+	if m != nil {
+		return m.PreviousJustifiedCheckpoint
+	}
+	return nil
+}
+
+func (m *EpochTransitionSimulation) GetCurrentJustifiedCheckpoint() *v1alpha1.Checkpoint {
+	if m != nil {
+		return m.CurrentJustifiedCheckpoint
+	}
+	return nil
+}
+
+func (m *EpochTransitionSimulation) GetFinalizedCheckpoint() *v1alpha1.Checkpoint {
+	if m != nil {
+		return m.FinalizedCheckpoint
+	}
+	return nil
+}
+
+func (m *EpochTransitionSimulation) GetPostPreviousJustifiedCheckpoint() *v1alpha1.Checkpoint {
+	if m != nil {
+		return m.PostPreviousJustifiedCheckpoint
+	}
+	return nil
+}
+
+func (m *EpochTransitionSimulation) GetPostCurrentJustifiedCheckpoint() *v1alpha1.Checkpoint {
+	if m != nil {
+		return m.PostCurrentJustifiedCheckpoint
+	}
+	return nil
+}
+
+func (m *EpochTransitionSimulation) GetPostFinalizedCheckpoint() *v1alpha1.Checkpoint {
+	if m != nil {
+		return m.PostFinalizedCheckpoint
+	}
+	return nil
+}
+
+func (m *EpochTransitionSimulation) GetJustificationChanged() bool {
+	if m != nil {
+		return m.JustificationChanged
+	}
+	return false
+}
+
+func (m *EpochTransitionSimulation) GetFinalizationChanged() bool {
+	if m != nil {
+		return m.FinalizationChanged
+	}
+	return false
+}
+
+func (m *EpochTransitionSimulation) GetPenalties() []*ValidatorPenalty {
+	if m != nil {
+		return m.Penalties
+	}
+	return nil
+}
+
+func (m *EpochTransitionSimulation) GetTotalPenalties() uint64 {
+	if m != nil {
+		return m.TotalPenalties
+	}
+	return 0
+}
+
+func (m *EpochTransitionSimulation) GetEjectedValidators() []github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.EjectedValidators
+	}
+	return nil
+}
+
+func (m *EpochTransitionSimulation) GetNextEpochProposers() [][]byte {
+	if m != nil {
+		return m.NextEpochProposers
+	}
+	return nil
+}
+
+func (m *EpochTransitionSimulation) GetProposerListRoot() []byte {
+	if m != nil {
+		return m.ProposerListRoot
+	}
+	return nil
+}
+
+type ValidatorPenalty struct {
+	Index                github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,1,opt,name=index,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"index,omitempty"`
+	Amount               uint64                                             `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *ValidatorPenalty) Reset()         { *m = ValidatorPenalty{} }
+func (m *ValidatorPenalty) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPenalty) ProtoMessage()    {}
+func (*ValidatorPenalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{61}
+}
+func (m *ValidatorPenalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPenalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPenalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPenalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPenalty.Merge(m, src)
+}
+func (m *ValidatorPenalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPenalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPenalty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPenalty proto.InternalMessageInfo
+
+func (m *ValidatorPenalty) GetIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ValidatorPenalty) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type ListCanonicalBlockHeadersRequest struct {
+	FromSlot             github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=from_slot,json=fromSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"from_slot,omitempty"`
+	ToSlot               github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,2,opt,name=to_slot,json=toSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"to_slot,omitempty"`
+	PageSize             int32                                    `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string                                   `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
+}
+
+func (m *ListCanonicalBlockHeadersRequest) Reset()         { *m = ListCanonicalBlockHeadersRequest{} }
+func (m *ListCanonicalBlockHeadersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCanonicalBlockHeadersRequest) ProtoMessage()    {}
+func (*ListCanonicalBlockHeadersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{62}
+}
+func (m *ListCanonicalBlockHeadersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListCanonicalBlockHeadersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListCanonicalBlockHeadersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListCanonicalBlockHeadersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCanonicalBlockHeadersRequest.Merge(m, src)
+}
+func (m *ListCanonicalBlockHeadersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListCanonicalBlockHeadersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCanonicalBlockHeadersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCanonicalBlockHeadersRequest proto.InternalMessageInfo
+
+func (m *ListCanonicalBlockHeadersRequest) GetFromSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.FromSlot
+	}
+	return 0
+}
+
+func (m *ListCanonicalBlockHeadersRequest) GetToSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.ToSlot
+	}
+	return 0
+}
+
+func (m *ListCanonicalBlockHeadersRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListCanonicalBlockHeadersRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type CanonicalBlockHeaders struct {
+	Headers              []*CanonicalBlockHeader `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty"`
+	NextPageToken        string                  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *CanonicalBlockHeaders) Reset()         { *m = CanonicalBlockHeaders{} }
+func (m *CanonicalBlockHeaders) String() string { return proto.CompactTextString(m) }
+func (*CanonicalBlockHeaders) ProtoMessage()    {}
+func (*CanonicalBlockHeaders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{63}
+}
+func (m *CanonicalBlockHeaders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CanonicalBlockHeaders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CanonicalBlockHeaders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CanonicalBlockHeaders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanonicalBlockHeaders.Merge(m, src)
+}
+func (m *CanonicalBlockHeaders) XXX_Size() int {
+	return m.Size()
+}
+func (m *CanonicalBlockHeaders) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanonicalBlockHeaders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanonicalBlockHeaders proto.InternalMessageInfo
+
+func (m *CanonicalBlockHeaders) GetHeaders() []*CanonicalBlockHeader {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *CanonicalBlockHeaders) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type CanonicalBlockHeader struct {
+	Root                 []byte                      `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty" ssz-size:"32"`
+	Header               *v1alpha1.BeaconBlockHeader `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+	Confirmation         *PandoraConfirmation        `protobuf:"bytes,3,opt,name=confirmation,proto3" json:"confirmation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *CanonicalBlockHeader) Reset()         { *m = CanonicalBlockHeader{} }
+func (m *CanonicalBlockHeader) String() string { return proto.CompactTextString(m) }
+func (*CanonicalBlockHeader) ProtoMessage()    {}
+func (*CanonicalBlockHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{64}
+}
+func (m *CanonicalBlockHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CanonicalBlockHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CanonicalBlockHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CanonicalBlockHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanonicalBlockHeader.Merge(m, src)
+}
+func (m *CanonicalBlockHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *CanonicalBlockHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanonicalBlockHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanonicalBlockHeader proto.InternalMessageInfo
+
+func (m *CanonicalBlockHeader) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *CanonicalBlockHeader) GetHeader() *v1alpha1.BeaconBlockHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *CanonicalBlockHeader) GetConfirmation() *PandoraConfirmation {
+	if m != nil {
+		return m.Confirmation
+	}
+	return nil
+}
+
+type PandoraConfirmation struct {
+	Slot                 github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+	BlockRoot            []byte                                   `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty" ssz-size:"32"`
+	PandoraHash          []byte                                   `protobuf:"bytes,3,opt,name=pandora_hash,json=pandoraHash,proto3" json:"pandora_hash,omitempty" ssz-size:"32"`
+	Status               PandoraConfirmation_Status               `protobuf:"varint,4,opt,name=status,proto3,enum=ethereum.beacon.rpc.v1.PandoraConfirmation_Status" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
+}
+
+func (m *PandoraConfirmation) Reset()         { *m = PandoraConfirmation{} }
+func (m *PandoraConfirmation) String() string { return proto.CompactTextString(m) }
+func (*PandoraConfirmation) ProtoMessage()    {}
+func (*PandoraConfirmation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{65}
+}
+func (m *PandoraConfirmation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PandoraConfirmation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PandoraConfirmation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PandoraConfirmation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PandoraConfirmation.Merge(m, src)
+}
+func (m *PandoraConfirmation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PandoraConfirmation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PandoraConfirmation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PandoraConfirmation proto.InternalMessageInfo
+
+func (m *PandoraConfirmation) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *PandoraConfirmation) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
+	}
+	return nil
+}
+
+func (m *PandoraConfirmation) GetPandoraHash() []byte {
+	if m != nil {
+		return m.PandoraHash
+	}
+	return nil
+}
+
+func (m *PandoraConfirmation) GetStatus() PandoraConfirmation_Status {
+	if m != nil {
+		return m.Status
+	}
+	return PandoraConfirmation_PENDING
+}
+
+type GetBlockByPandoraHashRequest struct {
+	PandoraHash          []byte   `protobuf:"bytes,1,opt,name=pandora_hash,json=pandoraHash,proto3" json:"pandora_hash,omitempty" ssz-size:"32"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBlockByPandoraHashRequest) Reset()         { *m = GetBlockByPandoraHashRequest{} }
+func (m *GetBlockByPandoraHashRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockByPandoraHashRequest) ProtoMessage()    {}
+func (*GetBlockByPandoraHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{66}
+}
+func (m *GetBlockByPandoraHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockByPandoraHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockByPandoraHashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBlockByPandoraHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockByPandoraHashRequest.Merge(m, src)
+}
+func (m *GetBlockByPandoraHashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockByPandoraHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockByPandoraHashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockByPandoraHashRequest proto.InternalMessageInfo
+
+func (m *GetBlockByPandoraHashRequest) GetPandoraHash() []byte {
+	if m != nil {
+		return m.PandoraHash
+	}
+	return nil
+}
+
+type PairedBlock struct {
+	Block                *v1alpha1.BeaconBlockContainer `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Confirmation         *PandoraConfirmation           `protobuf:"bytes,2,opt,name=confirmation,proto3" json:"confirmation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *PairedBlock) Reset()         { *m = PairedBlock{} }
+func (m *PairedBlock) String() string { return proto.CompactTextString(m) }
+func (*PairedBlock) ProtoMessage()    {}
+func (*PairedBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{67}
+}
+func (m *PairedBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairedBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairedBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairedBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairedBlock.Merge(m, src)
+}
+func (m *PairedBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairedBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairedBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairedBlock proto.InternalMessageInfo
+
+func (m *PairedBlock) GetBlock() *v1alpha1.BeaconBlockContainer {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *PairedBlock) GetConfirmation() *PandoraConfirmation {
+	if m != nil {
+		return m.Confirmation
+	}
+	return nil
+}
+
+type ProposerListConsistencyRequest struct {
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *ProposerListConsistencyRequest) Reset()         { *m = ProposerListConsistencyRequest{} }
+func (m *ProposerListConsistencyRequest) String() string { return proto.CompactTextString(m) }
+func (*ProposerListConsistencyRequest) ProtoMessage()    {}
+func (*ProposerListConsistencyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{68}
+}
+func (m *ProposerListConsistencyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposerListConsistencyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposerListConsistencyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposerListConsistencyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerListConsistencyRequest.Merge(m, src)
+}
+func (m *ProposerListConsistencyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposerListConsistencyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerListConsistencyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerListConsistencyRequest proto.InternalMessageInfo
+
+func (m *ProposerListConsistencyRequest) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+type ProposerListConsistency struct {
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	Digest               []byte                                    `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty" ssz-size:"32"`
+	Peers                uint64                                    `protobuf:"varint,3,opt,name=peers,proto3" json:"peers,omitempty"`
+	MatchingPeers        uint64                                    `protobuf:"varint,4,opt,name=matching_peers,json=matchingPeers,proto3" json:"matching_peers,omitempty"`
+	Score                float64                                   `protobuf:"fixed64,5,opt,name=score,proto3" json:"score,omitempty"`
+	DivergentPeers       []string                                  `protobuf:"bytes,6,rep,name=divergent_peers,json=divergentPeers,proto3" json:"divergent_peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *ProposerListConsistency) Reset()         { *m = ProposerListConsistency{} }
+func (m *ProposerListConsistency) String() string { return proto.CompactTextString(m) }
+func (*ProposerListConsistency) ProtoMessage()    {}
+func (*ProposerListConsistency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{69}
+}
+func (m *ProposerListConsistency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposerListConsistency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposerListConsistency.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposerListConsistency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerListConsistency.Merge(m, src)
+}
+func (m *ProposerListConsistency) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposerListConsistency) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerListConsistency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerListConsistency proto.InternalMessageInfo
+
+func (m *ProposerListConsistency) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ProposerListConsistency) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
+}
+
+func (m *ProposerListConsistency) GetPeers() uint64 {
+	if m != nil {
+		return m.Peers
+	}
+	return 0
+}
+
+func (m *ProposerListConsistency) GetMatchingPeers() uint64 {
+	if m != nil {
+		return m.MatchingPeers
+	}
+	return 0
+}
+
+func (m *ProposerListConsistency) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *ProposerListConsistency) GetDivergentPeers() []string {
+	if m != nil {
+		return m.DivergentPeers
+	}
+	return nil
+}
+
+type CommitteeParticipation struct {
+	CommitteeIndex       github_com_prysmaticlabs_eth2_types.CommitteeIndex `protobuf:"varint,1,opt,name=committee_index,json=committeeIndex,proto3,casttype=github.com/prysmaticlabs/eth2-types.CommitteeIndex" json:"committee_index,omitempty"`
+	Expected             uint64                                             `protobuf:"varint,2,opt,name=expected,proto3" json:"expected,omitempty"`
+	Seen                 uint64                                             `protobuf:"varint,3,opt,name=seen,proto3" json:"seen,omitempty"`
+	Included             uint64                                             `protobuf:"varint,4,opt,name=included,proto3" json:"included,omitempty"`
+	Rate                 float64                                            `protobuf:"fixed64,5,opt,name=rate,proto3" json:"rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *CommitteeParticipation) Reset()         { *m = CommitteeParticipation{} }
+func (m *CommitteeParticipation) String() string { return proto.CompactTextString(m) }
+func (*CommitteeParticipation) ProtoMessage()    {}
+func (*CommitteeParticipation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{70}
+}
+func (m *CommitteeParticipation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitteeParticipation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitteeParticipation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitteeParticipation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitteeParticipation.Merge(m, src)
+}
+func (m *CommitteeParticipation) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitteeParticipation) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitteeParticipation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitteeParticipation proto.InternalMessageInfo
+
+func (m *CommitteeParticipation) GetCommitteeIndex() github_com_prysmaticlabs_eth2_types.CommitteeIndex {
+	if m != nil {
+		return m.CommitteeIndex
+	}
+	return 0
+}
+
+func (m *CommitteeParticipation) GetExpected() uint64 {
+	if m != nil {
+		return m.Expected
+	}
+	return 0
+}
+
+func (m *CommitteeParticipation) GetSeen() uint64 {
+	if m != nil {
+		return m.Seen
+	}
+	return 0
+}
+
+func (m *CommitteeParticipation) GetIncluded() uint64 {
+	if m != nil {
+		return m.Included
+	}
+	return 0
+}
+
+func (m *CommitteeParticipation) GetRate() float64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+type SlotCommitteeParticipation struct {
+	Slot                 github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+	Committees           []*CommitteeParticipation                `protobuf:"bytes,2,rep,name=committees,proto3" json:"committees,omitempty"`
+	Expected             uint64                                   `protobuf:"varint,3,opt,name=expected,proto3" json:"expected,omitempty"`
+	Seen                 uint64                                   `protobuf:"varint,4,opt,name=seen,proto3" json:"seen,omitempty"`
+	Included             uint64                                   `protobuf:"varint,5,opt,name=included,proto3" json:"included,omitempty"`
+	Rate                 float64                                  `protobuf:"fixed64,6,opt,name=rate,proto3" json:"rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
+}
+
+func (m *SlotCommitteeParticipation) Reset()         { *m = SlotCommitteeParticipation{} }
+func (m *SlotCommitteeParticipation) String() string { return proto.CompactTextString(m) }
+func (*SlotCommitteeParticipation) ProtoMessage()    {}
+func (*SlotCommitteeParticipation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{71}
+}
+func (m *SlotCommitteeParticipation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlotCommitteeParticipation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlotCommitteeParticipation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlotCommitteeParticipation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlotCommitteeParticipation.Merge(m, src)
+}
+func (m *SlotCommitteeParticipation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlotCommitteeParticipation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlotCommitteeParticipation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlotCommitteeParticipation proto.InternalMessageInfo
+
+func (m *SlotCommitteeParticipation) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *SlotCommitteeParticipation) GetCommittees() []*CommitteeParticipation {
+	if m != nil {
+		return m.Committees
+	}
+	return nil
+}
+
+func (m *SlotCommitteeParticipation) GetExpected() uint64 {
+	if m != nil {
+		return m.Expected
+	}
+	return 0
+}
+
+func (m *SlotCommitteeParticipation) GetSeen() uint64 {
+	if m != nil {
+		return m.Seen
+	}
+	return 0
+}
+
+func (m *SlotCommitteeParticipation) GetIncluded() uint64 {
+	if m != nil {
+		return m.Included
+	}
+	return 0
+}
+
+func (m *SlotCommitteeParticipation) GetRate() float64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+type CurrentEpochParticipation struct {
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	CurrentSlot          github_com_prysmaticlabs_eth2_types.Slot  `protobuf:"varint,2,opt,name=current_slot,json=currentSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"current_slot,omitempty"`
+	Slots                []*SlotCommitteeParticipation             `protobuf:"bytes,3,rep,name=slots,proto3" json:"slots,omitempty"`
+	Expected             uint64                                    `protobuf:"varint,4,opt,name=expected,proto3" json:"expected,omitempty"`
+	Seen                 uint64                                    `protobuf:"varint,5,opt,name=seen,proto3" json:"seen,omitempty"`
+	Included             uint64                                    `protobuf:"varint,6,opt,name=included,proto3" json:"included,omitempty"`
+	Rate                 float64                                   `protobuf:"fixed64,7,opt,name=rate,proto3" json:"rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *CurrentEpochParticipation) Reset()         { *m = CurrentEpochParticipation{} }
+func (m *CurrentEpochParticipation) String() string { return proto.CompactTextString(m) }
+func (*CurrentEpochParticipation) ProtoMessage()    {}
+func (*CurrentEpochParticipation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{72}
+}
+func (m *CurrentEpochParticipation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CurrentEpochParticipation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CurrentEpochParticipation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CurrentEpochParticipation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrentEpochParticipation.Merge(m, src)
+}
+func (m *CurrentEpochParticipation) XXX_Size() int {
+	return m.Size()
+}
+func (m *CurrentEpochParticipation) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrentEpochParticipation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurrentEpochParticipation proto.InternalMessageInfo
+
+func (m *CurrentEpochParticipation) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *CurrentEpochParticipation) GetCurrentSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.CurrentSlot
+	}
+	return 0
+}
+
+func (m *CurrentEpochParticipation) GetSlots() []*SlotCommitteeParticipation {
+	if m != nil {
+		return m.Slots
+	}
+	return nil
+}
+
+func (m *CurrentEpochParticipation) GetExpected() uint64 {
+	if m != nil {
+		return m.Expected
+	}
+	return 0
+}
+
+func (m *CurrentEpochParticipation) GetSeen() uint64 {
+	if m != nil {
+		return m.Seen
+	}
+	return 0
+}
+
+func (m *CurrentEpochParticipation) GetIncluded() uint64 {
+	if m != nil {
+		return m.Included
+	}
+	return 0
+}
+
+func (m *CurrentEpochParticipation) GetRate() float64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+type AnnotatedValidatorAssignmentsRequest struct {
+	// Types that are valid to be assigned to QueryFilter:
+	//	*AnnotatedValidatorAssignmentsRequest_Epoch
+	//	*AnnotatedValidatorAssignmentsRequest_Genesis
+	//	*AnnotatedValidatorAssignmentsRequest_BlockRoot
+	//	*AnnotatedValidatorAssignmentsRequest_StateRoot
+	QueryFilter                 isAnnotatedValidatorAssignmentsRequest_QueryFilter   `protobuf_oneof:"query_filter"`
+	PublicKeys                  [][]byte                                             `protobuf:"bytes,3,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty" ssz-size:"?,48"`
+	Indices                     []github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,4,rep,packed,name=indices,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"indices,omitempty"`
+	PageSize                    int32                                                `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken                   string                                               `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	CommitteeWeights            bool                                                 `protobuf:"varint,7,opt,name=committee_weights,json=committeeWeights,proto3" json:"committee_weights,omitempty"`
+	IncludeValidatorFields      bool                                                 `protobuf:"varint,10,opt,name=include_validator_fields,json=includeValidatorFields,proto3" json:"include_validator_fields,omitempty"`
+	TrackedOnly                 bool                                                 `protobuf:"varint,11,opt,name=tracked_only,json=trackedOnly,proto3" json:"tracked_only,omitempty"`
+	IndexOnly                   bool                                                 `protobuf:"varint,12,opt,name=index_only,json=indexOnly,proto3" json:"index_only,omitempty"`
+	WithdrawalCredentialsPrefix []byte                                               `protobuf:"bytes,13,opt,name=withdrawal_credentials_prefix,json=withdrawalCredentialsPrefix,proto3" json:"withdrawal_credentials_prefix,omitempty"`
+	Compact                     bool                                                 `protobuf:"varint,14,opt,name=compact,proto3" json:"compact,omitempty"`
+	XXX_NoUnkeyedLiteral        struct{}                                             `json:"-"`
+	XXX_unrecognized            []byte                                               `json:"-"`
+	XXX_sizecache               int32                                                `json:"-"`
+}
+
+func (m *AnnotatedValidatorAssignmentsRequest) Reset()         { *m = AnnotatedValidatorAssignmentsRequest{} }
+func (m *AnnotatedValidatorAssignmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*AnnotatedValidatorAssignmentsRequest) ProtoMessage()    {}
+func (*AnnotatedValidatorAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{73}
+}
+func (m *AnnotatedValidatorAssignmentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AnnotatedValidatorAssignmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AnnotatedValidatorAssignmentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AnnotatedValidatorAssignmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnnotatedValidatorAssignmentsRequest.Merge(m, src)
+}
+func (m *AnnotatedValidatorAssignmentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AnnotatedValidatorAssignmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnnotatedValidatorAssignmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnnotatedValidatorAssignmentsRequest proto.InternalMessageInfo
+
+type isAnnotatedValidatorAssignmentsRequest_QueryFilter interface {
+	isAnnotatedValidatorAssignmentsRequest_QueryFilter()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type AnnotatedValidatorAssignmentsRequest_Epoch struct {
+	Epoch github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=epoch,proto3,oneof,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+}
+type AnnotatedValidatorAssignmentsRequest_Genesis struct {
+	Genesis bool `protobuf:"varint,2,opt,name=genesis,proto3,oneof" json:"genesis,omitempty"`
+}
+type AnnotatedValidatorAssignmentsRequest_BlockRoot struct {
+	BlockRoot []byte `protobuf:"bytes,8,opt,name=block_root,json=blockRoot,proto3,oneof" json:"block_root,omitempty" ssz-size:"32"`
+}
+type AnnotatedValidatorAssignmentsRequest_StateRoot struct {
+	StateRoot []byte `protobuf:"bytes,9,opt,name=state_root,json=stateRoot,proto3,oneof" json:"state_root,omitempty" ssz-size:"32"`
+}
+
+func (*AnnotatedValidatorAssignmentsRequest_Epoch) isAnnotatedValidatorAssignmentsRequest_QueryFilter() {
+}
+func (*AnnotatedValidatorAssignmentsRequest_Genesis) isAnnotatedValidatorAssignmentsRequest_QueryFilter() {
+}
+func (*AnnotatedValidatorAssignmentsRequest_BlockRoot) isAnnotatedValidatorAssignmentsRequest_QueryFilter() {
+}
+func (*AnnotatedValidatorAssignmentsRequest_StateRoot) isAnnotatedValidatorAssignmentsRequest_QueryFilter() {
+}
+
+func (m *AnnotatedValidatorAssignmentsRequest) GetQueryFilter() isAnnotatedValidatorAssignmentsRequest_QueryFilter {
+	if m != nil {
+		return m.QueryFilter
+	}
+	return nil
+}
+
+func (m *AnnotatedValidatorAssignmentsRequest) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if x, ok := m.GetQueryFilter().(*AnnotatedValidatorAssignmentsRequest_Epoch); ok {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (m *AnnotatedValidatorAssignmentsRequest) GetGenesis() bool {
+	if x, ok := m.GetQueryFilter().(*AnnotatedValidatorAssignmentsRequest_Genesis); ok {
+		return x.Genesis
+	}
+	return false
+}
+
+func (m *AnnotatedValidatorAssignmentsRequest) GetBlockRoot() []byte {
+	if x, ok := m.GetQueryFilter().(*AnnotatedValidatorAssignmentsRequest_BlockRoot); ok {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+func (m *AnnotatedValidatorAssignmentsRequest) GetStateRoot() []byte {
+	if x, ok := m.GetQueryFilter().(*AnnotatedValidatorAssignmentsRequest_StateRoot); ok {
+		return x.StateRoot
+	}
+	return nil
+}
+
+func (m *AnnotatedValidatorAssignmentsRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+func (m *AnnotatedValidatorAssignmentsRequest) GetIndices() []github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
+}
+
+func (m *AnnotatedValidatorAssignmentsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *AnnotatedValidatorAssignmentsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *AnnotatedValidatorAssignmentsRequest) GetCommitteeWeights() bool {
+	if m != nil {
+		return m.CommitteeWeights
+	}
+	return false
+}
+
+func (m *AnnotatedValidatorAssignmentsRequest) GetIncludeValidatorFields() bool {
+	if m != nil {
+		return m.IncludeValidatorFields
+	}
+	return false
+}
+
+func (m *AnnotatedValidatorAssignmentsRequest) GetTrackedOnly() bool {
+	if m != nil {
+		return m.TrackedOnly
+	}
+	return false
+}
+
+func (m *AnnotatedValidatorAssignmentsRequest) GetIndexOnly() bool {
+	if m != nil {
+		return m.IndexOnly
+	}
+	return false
+}
+
+func (m *AnnotatedValidatorAssignmentsRequest) GetWithdrawalCredentialsPrefix() []byte {
+	if m != nil {
+		return m.WithdrawalCredentialsPrefix
+	}
+	return nil
+}
+
+func (m *AnnotatedValidatorAssignmentsRequest) GetCompact() bool {
+	if m != nil {
+		return m.Compact
+	}
+	return false
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*AnnotatedValidatorAssignmentsRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*AnnotatedValidatorAssignmentsRequest_Epoch)(nil),
+		(*AnnotatedValidatorAssignmentsRequest_Genesis)(nil),
+		(*AnnotatedValidatorAssignmentsRequest_BlockRoot)(nil),
+		(*AnnotatedValidatorAssignmentsRequest_StateRoot)(nil),
+	}
+}
+
+type AnnotatedValidatorAssignments struct {
+	Assignments          *v1alpha1.ValidatorAssignments `protobuf:"bytes,1,opt,name=assignments,proto3" json:"assignments,omitempty"`
+	ProposerListRoot     []byte                         `protobuf:"bytes,2,opt,name=proposer_list_root,json=proposerListRoot,proto3" json:"proposer_list_root,omitempty" ssz-size:"32"`
+	IndexMismatches      []*ValidatorIndexMismatch      `protobuf:"bytes,3,rep,name=index_mismatches,json=indexMismatches,proto3" json:"index_mismatches,omitempty"`
+	CommitteeWeights     []*CommitteeWeight             `protobuf:"bytes,4,rep,name=committee_weights,json=committeeWeights,proto3" json:"committee_weights,omitempty"`
+	AppliedPageSize      int32                          `protobuf:"varint,5,opt,name=applied_page_size,json=appliedPageSize,proto3" json:"applied_page_size,omitempty"`
+	ValidatorFields      []*ValidatorFields             `protobuf:"bytes,6,rep,name=validator_fields,json=validatorFields,proto3" json:"validator_fields,omitempty"`
+	CommitteePositions   []*CommitteePosition           `protobuf:"bytes,7,rep,name=committee_positions,json=committeePositions,proto3" json:"committee_positions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *AnnotatedValidatorAssignments) Reset()         { *m = AnnotatedValidatorAssignments{} }
+func (m *AnnotatedValidatorAssignments) String() string { return proto.CompactTextString(m) }
+func (*AnnotatedValidatorAssignments) ProtoMessage()    {}
+func (*AnnotatedValidatorAssignments) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{74}
+}
+func (m *AnnotatedValidatorAssignments) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AnnotatedValidatorAssignments) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AnnotatedValidatorAssignments.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AnnotatedValidatorAssignments) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnnotatedValidatorAssignments.Merge(m, src)
+}
+func (m *AnnotatedValidatorAssignments) XXX_Size() int {
+	return m.Size()
+}
+func (m *AnnotatedValidatorAssignments) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnnotatedValidatorAssignments.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnnotatedValidatorAssignments proto.InternalMessageInfo
+
+func (m *AnnotatedValidatorAssignments) GetAssignments() *v1alpha1.ValidatorAssignments {
+	if m != nil {
+		return m.Assignments
+	}
+	return nil
+}
+
+func (m *AnnotatedValidatorAssignments) GetProposerListRoot() []byte {
+	if m != nil {
+		return m.ProposerListRoot
+	}
+	return nil
+}
+
+func (m *AnnotatedValidatorAssignments) GetIndexMismatches() []*ValidatorIndexMismatch {
+	if m != nil {
+		return m.IndexMismatches
+	}
+	return nil
+}
+
+func (m *AnnotatedValidatorAssignments) GetCommitteeWeights() []*CommitteeWeight {
+	if m != nil {
+		return m.CommitteeWeights
+	}
+	return nil
+}
+
+func (m *AnnotatedValidatorAssignments) GetAppliedPageSize() int32 {
+	if m != nil {
+		return m.AppliedPageSize
+	}
+	return 0
+}
+
+func (m *AnnotatedValidatorAssignments) GetValidatorFields() []*ValidatorFields {
+	if m != nil {
+		return m.ValidatorFields
+	}
+	return nil
+}
+
+func (m *AnnotatedValidatorAssignments) GetCommitteePositions() []*CommitteePosition {
+	if m != nil {
+		return m.CommitteePositions
+	}
+	return nil
+}
+
+type ValidatorIndexMismatch struct {
+	PublicKey            []byte                                             `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty" ssz-size:"48"`
+	RequestedIndex       github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,2,opt,name=requested_index,json=requestedIndex,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"requested_index,omitempty"`
+	HeadIndex            github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,3,opt,name=head_index,json=headIndex,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"head_index,omitempty"`
+	InHead               bool                                               `protobuf:"varint,4,opt,name=in_head,json=inHead,proto3" json:"in_head,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *ValidatorIndexMismatch) Reset()         { *m = ValidatorIndexMismatch{} }
+func (m *ValidatorIndexMismatch) String() string { return proto.CompactTextString(m) }
+func (*ValidatorIndexMismatch) ProtoMessage()    {}
+func (*ValidatorIndexMismatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{75}
+}
+func (m *ValidatorIndexMismatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorIndexMismatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorIndexMismatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorIndexMismatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorIndexMismatch.Merge(m, src)
+}
+func (m *ValidatorIndexMismatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorIndexMismatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorIndexMismatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorIndexMismatch proto.InternalMessageInfo
+
+func (m *ValidatorIndexMismatch) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *ValidatorIndexMismatch) GetRequestedIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.RequestedIndex
+	}
+	return 0
+}
+
+func (m *ValidatorIndexMismatch) GetHeadIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.HeadIndex
+	}
+	return 0
+}
+
+func (m *ValidatorIndexMismatch) GetInHead() bool {
+	if m != nil {
+		return m.InHead
+	}
+	return false
+}
+
+type CommitteeWeight struct {
+	Slot                  github_com_prysmaticlabs_eth2_types.Slot           `protobuf:"varint,1,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+	CommitteeIndex        github_com_prysmaticlabs_eth2_types.CommitteeIndex `protobuf:"varint,2,opt,name=committee_index,json=committeeIndex,proto3,casttype=github.com/prysmaticlabs/eth2-types.CommitteeIndex" json:"committee_index,omitempty"`
+	TotalEffectiveBalance uint64                                             `protobuf:"varint,3,opt,name=total_effective_balance,json=totalEffectiveBalance,proto3" json:"total_effective_balance,omitempty"`
+	AttestationWeight     float64                                            `protobuf:"fixed64,4,opt,name=attestation_weight,json=attestationWeight,proto3" json:"attestation_weight,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                                           `json:"-"`
+	XXX_unrecognized      []byte                                             `json:"-"`
+	XXX_sizecache         int32                                              `json:"-"`
+}
+
+func (m *CommitteeWeight) Reset()         { *m = CommitteeWeight{} }
+func (m *CommitteeWeight) String() string { return proto.CompactTextString(m) }
+func (*CommitteeWeight) ProtoMessage()    {}
+func (*CommitteeWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{76}
+}
+func (m *CommitteeWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitteeWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitteeWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitteeWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitteeWeight.Merge(m, src)
+}
+func (m *CommitteeWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitteeWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitteeWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitteeWeight proto.InternalMessageInfo
+
+func (m *CommitteeWeight) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *CommitteeWeight) GetCommitteeIndex() github_com_prysmaticlabs_eth2_types.CommitteeIndex {
+	if m != nil {
+		return m.CommitteeIndex
+	}
+	return 0
+}
+
+func (m *CommitteeWeight) GetTotalEffectiveBalance() uint64 {
+	if m != nil {
+		return m.TotalEffectiveBalance
+	}
+	return 0
+}
+
+func (m *CommitteeWeight) GetAttestationWeight() float64 {
+	if m != nil {
+		return m.AttestationWeight
+	}
+	return 0
+}
+
+type ValidatorFields struct {
+	ValidatorIndex             github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"validator_index,omitempty"`
+	EffectiveBalance           uint64                                             `protobuf:"varint,2,opt,name=effective_balance,json=effectiveBalance,proto3" json:"effective_balance,omitempty"`
+	ActivationEligibilityEpoch github_com_prysmaticlabs_eth2_types.Epoch          `protobuf:"varint,3,opt,name=activation_eligibility_epoch,json=activationEligibilityEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"activation_eligibility_epoch,omitempty"`
+	ActivationEpoch            github_com_prysmaticlabs_eth2_types.Epoch          `protobuf:"varint,4,opt,name=activation_epoch,json=activationEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"activation_epoch,omitempty"`
+	ExitEpoch                  github_com_prysmaticlabs_eth2_types.Epoch          `protobuf:"varint,5,opt,name=exit_epoch,json=exitEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"exit_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{}                                           `json:"-"`
+	XXX_unrecognized           []byte                                             `json:"-"`
+	XXX_sizecache              int32                                              `json:"-"`
+}
+
+func (m *ValidatorFields) Reset()         { *m = ValidatorFields{} }
+func (m *ValidatorFields) String() string { return proto.CompactTextString(m) }
+func (*ValidatorFields) ProtoMessage()    {}
+func (*ValidatorFields) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{77}
+}
+func (m *ValidatorFields) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorFields) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorFields.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorFields) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorFields.Merge(m, src)
+}
+func (m *ValidatorFields) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorFields) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorFields.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorFields proto.InternalMessageInfo
+
+func (m *ValidatorFields) GetValidatorIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *ValidatorFields) GetEffectiveBalance() uint64 {
+	if m != nil {
+		return m.EffectiveBalance
+	}
+	return 0
+}
+
+func (m *ValidatorFields) GetActivationEligibilityEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.ActivationEligibilityEpoch
+	}
+	return 0
+}
+
+func (m *ValidatorFields) GetActivationEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.ActivationEpoch
+	}
+	return 0
+}
+
+func (m *ValidatorFields) GetExitEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.ExitEpoch
+	}
+	return 0
+}
+
+type ValidatorsByWithdrawalCredentialsRequest struct {
+	Request                     *v1alpha1.ListValidatorsRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	WithdrawalCredentialsPrefix []byte                          `protobuf:"bytes,2,opt,name=withdrawal_credentials_prefix,json=withdrawalCredentialsPrefix,proto3" json:"withdrawal_credentials_prefix,omitempty"`
+	XXX_NoUnkeyedLiteral        struct{}                        `json:"-"`
+	XXX_unrecognized            []byte                          `json:"-"`
+	XXX_sizecache               int32                           `json:"-"`
+}
+
+func (m *ValidatorsByWithdrawalCredentialsRequest) Reset() {
+	*m = ValidatorsByWithdrawalCredentialsRequest{}
+}
+func (m *ValidatorsByWithdrawalCredentialsRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorsByWithdrawalCredentialsRequest) ProtoMessage()    {}
+func (*ValidatorsByWithdrawalCredentialsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{78}
+}
+func (m *ValidatorsByWithdrawalCredentialsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorsByWithdrawalCredentialsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorsByWithdrawalCredentialsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorsByWithdrawalCredentialsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorsByWithdrawalCredentialsRequest.Merge(m, src)
+}
+func (m *ValidatorsByWithdrawalCredentialsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorsByWithdrawalCredentialsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorsByWithdrawalCredentialsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorsByWithdrawalCredentialsRequest proto.InternalMessageInfo
+
+func (m *ValidatorsByWithdrawalCredentialsRequest) GetRequest() *v1alpha1.ListValidatorsRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *ValidatorsByWithdrawalCredentialsRequest) GetWithdrawalCredentialsPrefix() []byte {
+	if m != nil {
+		return m.WithdrawalCredentialsPrefix
+	}
+	return nil
+}
+
+type CommitteePosition struct {
+	ValidatorIndex       github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"validator_index,omitempty"`
+	Position             uint64                                             `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	CommitteeSize        uint64                                             `protobuf:"varint,3,opt,name=committee_size,json=committeeSize,proto3" json:"committee_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *CommitteePosition) Reset()         { *m = CommitteePosition{} }
+func (m *CommitteePosition) String() string { return proto.CompactTextString(m) }
+func (*CommitteePosition) ProtoMessage()    {}
+func (*CommitteePosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{79}
+}
+func (m *CommitteePosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitteePosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitteePosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitteePosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitteePosition.Merge(m, src)
+}
+func (m *CommitteePosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitteePosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitteePosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitteePosition proto.InternalMessageInfo
+
+func (m *CommitteePosition) GetValidatorIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *CommitteePosition) GetPosition() uint64 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *CommitteePosition) GetCommitteeSize() uint64 {
+	if m != nil {
+		return m.CommitteeSize
+	}
+	return 0
+}
+
+type StorageGrowthRequest struct {
+	Days                 uint64   `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StorageGrowthRequest) Reset()         { *m = StorageGrowthRequest{} }
+func (m *StorageGrowthRequest) String() string { return proto.CompactTextString(m) }
+func (*StorageGrowthRequest) ProtoMessage()    {}
+func (*StorageGrowthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{80}
+}
+func (m *StorageGrowthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageGrowthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageGrowthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageGrowthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageGrowthRequest.Merge(m, src)
+}
+func (m *StorageGrowthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageGrowthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageGrowthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageGrowthRequest proto.InternalMessageInfo
+
+func (m *StorageGrowthRequest) GetDays() uint64 {
+	if m != nil {
+		return m.Days
+	}
+	return 0
+}
+
+type StorageGrowthEstimate struct {
+	ValidatorCount                uint64                                   `protobuf:"varint,1,opt,name=validator_count,json=validatorCount,proto3" json:"validator_count,omitempty"`
+	SlotsPerArchivedPoint         github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,2,opt,name=slots_per_archived_point,json=slotsPerArchivedPoint,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slots_per_archived_point,omitempty"`
+	ColdStateStore                bool                                     `protobuf:"varint,3,opt,name=cold_state_store,json=coldStateStore,proto3" json:"cold_state_store,omitempty"`
+	ValidatorDedup                bool                                     `protobuf:"varint,4,opt,name=validator_dedup,json=validatorDedup,proto3" json:"validator_dedup,omitempty"`
+	CurrentSizeBytes              uint64                                   `protobuf:"varint,5,opt,name=current_size_bytes,json=currentSizeBytes,proto3" json:"current_size_bytes,omitempty"`
+	StateSizeBytes                uint64                                   `protobuf:"varint,6,opt,name=state_size_bytes,json=stateSizeBytes,proto3" json:"state_size_bytes,omitempty"`
+	ArchivedStateSizeBytes        uint64                                   `protobuf:"varint,7,opt,name=archived_state_size_bytes,json=archivedStateSizeBytes,proto3" json:"archived_state_size_bytes,omitempty"`
+	BlockSizeBytes                uint64                                   `protobuf:"varint,8,opt,name=block_size_bytes,json=blockSizeBytes,proto3" json:"block_size_bytes,omitempty"`
+	BytesPerDay                   uint64                                   `protobuf:"varint,9,opt,name=bytes_per_day,json=bytesPerDay,proto3" json:"bytes_per_day,omitempty"`
+	ColdStoreBytesPerDay          uint64                                   `protobuf:"varint,10,opt,name=cold_store_bytes_per_day,json=coldStoreBytesPerDay,proto3" json:"cold_store_bytes_per_day,omitempty"`
+	Days                          uint64                                   `protobuf:"varint,11,opt,name=days,proto3" json:"days,omitempty"`
+	ProjectedSizeBytes            uint64                                   `protobuf:"varint,12,opt,name=projected_size_bytes,json=projectedSizeBytes,proto3" json:"projected_size_bytes,omitempty"`
+	ProjectedColdStoreGrowthBytes uint64                                   `protobuf:"varint,13,opt,name=projected_cold_store_growth_bytes,json=projectedColdStoreGrowthBytes,proto3" json:"projected_cold_store_growth_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral          struct{}                                 `json:"-"`
+	XXX_unrecognized              []byte                                   `json:"-"`
+	XXX_sizecache                 int32                                    `json:"-"`
+}
+
+func (m *StorageGrowthEstimate) Reset()         { *m = StorageGrowthEstimate{} }
+func (m *StorageGrowthEstimate) String() string { return proto.CompactTextString(m) }
+func (*StorageGrowthEstimate) ProtoMessage()    {}
+func (*StorageGrowthEstimate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{81}
+}
+func (m *StorageGrowthEstimate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageGrowthEstimate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageGrowthEstimate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageGrowthEstimate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageGrowthEstimate.Merge(m, src)
+}
+func (m *StorageGrowthEstimate) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageGrowthEstimate) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageGrowthEstimate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageGrowthEstimate proto.InternalMessageInfo
+
+func (m *StorageGrowthEstimate) GetValidatorCount() uint64 {
+	if m != nil {
+		return m.ValidatorCount
+	}
+	return 0
+}
+
+func (m *StorageGrowthEstimate) GetSlotsPerArchivedPoint() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.SlotsPerArchivedPoint
+	}
+	return 0
+}
+
+func (m *StorageGrowthEstimate) GetColdStateStore() bool {
+	if m != nil {
+		return m.ColdStateStore
+	}
+	return false
+}
+
+func (m *StorageGrowthEstimate) GetValidatorDedup() bool {
+	if m != nil {
+		return m.ValidatorDedup
+	}
+	return false
+}
+
+func (m *StorageGrowthEstimate) GetCurrentSizeBytes() uint64 {
+	if m != nil {
+		return m.CurrentSizeBytes
+	}
+	return 0
+}
+
+func (m *StorageGrowthEstimate) GetStateSizeBytes() uint64 {
+	if m != nil {
+		return m.StateSizeBytes
+	}
+	return 0
+}
+
+func (m *StorageGrowthEstimate) GetArchivedStateSizeBytes() uint64 {
+	if m != nil {
+		return m.ArchivedStateSizeBytes
+	}
+	return 0
+}
+
+func (m *StorageGrowthEstimate) GetBlockSizeBytes() uint64 {
+	if m != nil {
+		return m.BlockSizeBytes
+	}
+	return 0
+}
+
+func (m *StorageGrowthEstimate) GetBytesPerDay() uint64 {
+	if m != nil {
+		return m.BytesPerDay
+	}
+	return 0
+}
+
+func (m *StorageGrowthEstimate) GetColdStoreBytesPerDay() uint64 {
+	if m != nil {
+		return m.ColdStoreBytesPerDay
+	}
+	return 0
+}
+
+func (m *StorageGrowthEstimate) GetDays() uint64 {
+	if m != nil {
+		return m.Days
+	}
+	return 0
+}
+
+func (m *StorageGrowthEstimate) GetProjectedSizeBytes() uint64 {
+	if m != nil {
+		return m.ProjectedSizeBytes
+	}
+	return 0
+}
+
+func (m *StorageGrowthEstimate) GetProjectedColdStoreGrowthBytes() uint64 {
+	if m != nil {
+		return m.ProjectedColdStoreGrowthBytes
+	}
+	return 0
+}
+
+type PandoraBlockHashConfirmation struct {
+	Slot                 github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+	Hash                 []byte                                   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty" ssz-size:"32"`
+	Status               PandoraConfirmation_Status               `protobuf:"varint,3,opt,name=status,proto3,enum=ethereum.beacon.rpc.v1.PandoraConfirmation_Status" json:"status,omitempty"`
+	BlockRoot            []byte                                   `protobuf:"bytes,4,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty" ssz-size:"32"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
+}
+
+func (m *PandoraBlockHashConfirmation) Reset()         { *m = PandoraBlockHashConfirmation{} }
+func (m *PandoraBlockHashConfirmation) String() string { return proto.CompactTextString(m) }
+func (*PandoraBlockHashConfirmation) ProtoMessage()    {}
+func (*PandoraBlockHashConfirmation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{82}
+}
+func (m *PandoraBlockHashConfirmation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PandoraBlockHashConfirmation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PandoraBlockHashConfirmation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PandoraBlockHashConfirmation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PandoraBlockHashConfirmation.Merge(m, src)
+}
+func (m *PandoraBlockHashConfirmation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PandoraBlockHashConfirmation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PandoraBlockHashConfirmation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PandoraBlockHashConfirmation proto.InternalMessageInfo
+
+func (m *PandoraBlockHashConfirmation) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *PandoraBlockHashConfirmation) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *PandoraBlockHashConfirmation) GetStatus() PandoraConfirmation_Status {
+	if m != nil {
+		return m.Status
+	}
+	return PandoraConfirmation_PENDING
+}
+
+func (m *PandoraBlockHashConfirmation) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
+	}
+	return nil
+}
+
+type ConfirmPandoraBlockHashesRequest struct {
+	Confirmations        []*PandoraBlockHashConfirmation `protobuf:"bytes,1,rep,name=confirmations,proto3" json:"confirmations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *ConfirmPandoraBlockHashesRequest) Reset()         { *m = ConfirmPandoraBlockHashesRequest{} }
+func (m *ConfirmPandoraBlockHashesRequest) String() string { return proto.CompactTextString(m) }
+func (*ConfirmPandoraBlockHashesRequest) ProtoMessage()    {}
+func (*ConfirmPandoraBlockHashesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{83}
+}
+func (m *ConfirmPandoraBlockHashesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfirmPandoraBlockHashesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfirmPandoraBlockHashesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConfirmPandoraBlockHashesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmPandoraBlockHashesRequest.Merge(m, src)
+}
+func (m *ConfirmPandoraBlockHashesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfirmPandoraBlockHashesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmPandoraBlockHashesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmPandoraBlockHashesRequest proto.InternalMessageInfo
+
+func (m *ConfirmPandoraBlockHashesRequest) GetConfirmations() []*PandoraBlockHashConfirmation {
+	if m != nil {
+		return m.Confirmations
+	}
+	return nil
+}
+
+type ConfirmPandoraBlockHashesResponse struct {
+	Stored               uint64                                     `protobuf:"varint,1,opt,name=stored,proto3" json:"stored,omitempty"`
+	UnknownSlots         []github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,2,rep,packed,name=unknown_slots,json=unknownSlots,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"unknown_slots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                   `json:"-"`
+	XXX_unrecognized     []byte                                     `json:"-"`
+	XXX_sizecache        int32                                      `json:"-"`
+}
+
+func (m *ConfirmPandoraBlockHashesResponse) Reset()         { *m = ConfirmPandoraBlockHashesResponse{} }
+func (m *ConfirmPandoraBlockHashesResponse) String() string { return proto.CompactTextString(m) }
+func (*ConfirmPandoraBlockHashesResponse) ProtoMessage()    {}
+func (*ConfirmPandoraBlockHashesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{84}
+}
+func (m *ConfirmPandoraBlockHashesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfirmPandoraBlockHashesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfirmPandoraBlockHashesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConfirmPandoraBlockHashesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmPandoraBlockHashesResponse.Merge(m, src)
+}
+func (m *ConfirmPandoraBlockHashesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfirmPandoraBlockHashesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmPandoraBlockHashesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmPandoraBlockHashesResponse proto.InternalMessageInfo
+
+func (m *ConfirmPandoraBlockHashesResponse) GetStored() uint64 {
+	if m != nil {
+		return m.Stored
+	}
+	return 0
+}
+
+func (m *ConfirmPandoraBlockHashesResponse) GetUnknownSlots() []github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.UnknownSlots
+	}
+	return nil
+}
+
+type ProposerStatsRequest struct {
+	PublicKey            []byte                                             `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty" ssz-size:"48"`
+	Index                github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,2,opt,name=index,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"index,omitempty"`
+	FromEpoch            github_com_prysmaticlabs_eth2_types.Epoch          `protobuf:"varint,3,opt,name=from_epoch,json=fromEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"from_epoch,omitempty"`
+	ToEpoch              github_com_prysmaticlabs_eth2_types.Epoch          `protobuf:"varint,4,opt,name=to_epoch,json=toEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"to_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *ProposerStatsRequest) Reset()         { *m = ProposerStatsRequest{} }
+func (m *ProposerStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*ProposerStatsRequest) ProtoMessage()    {}
+func (*ProposerStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{85}
+}
+func (m *ProposerStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposerStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposerStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposerStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerStatsRequest.Merge(m, src)
+}
+func (m *ProposerStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposerStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerStatsRequest proto.InternalMessageInfo
+
+func (m *ProposerStatsRequest) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *ProposerStatsRequest) GetIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ProposerStatsRequest) GetFromEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
+}
+
+func (m *ProposerStatsRequest) GetToEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.ToEpoch
+	}
+	return 0
+}
+
+type ProposerStats struct {
+	Index                 github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,1,opt,name=index,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"index,omitempty"`
+	ProposalDuties        uint64                                             `protobuf:"varint,2,opt,name=proposal_duties,json=proposalDuties,proto3" json:"proposal_duties,omitempty"`
+	ProposedBlocks        uint64                                             `protobuf:"varint,3,opt,name=proposed_blocks,json=proposedBlocks,proto3" json:"proposed_blocks,omitempty"`
+	MissedProposals       uint64                                             `protobuf:"varint,4,opt,name=missed_proposals,json=missedProposals,proto3" json:"missed_proposals,omitempty"`
+	AverageInclusionDelay float64                                            `protobuf:"fixed64,5,opt,name=average_inclusion_delay,json=averageInclusionDelay,proto3" json:"average_inclusion_delay,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                                           `json:"-"`
+	XXX_unrecognized      []byte                                             `json:"-"`
+	XXX_sizecache         int32                                              `json:"-"`
+}
+
+func (m *ProposerStats) Reset()         { *m = ProposerStats{} }
+func (m *ProposerStats) String() string { return proto.CompactTextString(m) }
+func (*ProposerStats) ProtoMessage()    {}
+func (*ProposerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{86}
+}
+func (m *ProposerStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposerStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerStats.Merge(m, src)
+}
+func (m *ProposerStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerStats proto.InternalMessageInfo
+
+func (m *ProposerStats) GetIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ProposerStats) GetProposalDuties() uint64 {
+	if m != nil {
+		return m.ProposalDuties
+	}
+	return 0
+}
+
+func (m *ProposerStats) GetProposedBlocks() uint64 {
+	if m != nil {
+		return m.ProposedBlocks
+	}
+	return 0
+}
+
+func (m *ProposerStats) GetMissedProposals() uint64 {
+	if m != nil {
+		return m.MissedProposals
+	}
+	return 0
+}
+
+func (m *ProposerStats) GetAverageInclusionDelay() float64 {
+	if m != nil {
+		return m.AverageInclusionDelay
+	}
+	return 0
+}
+
+type SubnetAssignmentsRequest struct {
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	PublicKeys           [][]byte                                  `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty" ssz-size:"?,48"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *SubnetAssignmentsRequest) Reset()         { *m = SubnetAssignmentsRequest{} }
+func (m *SubnetAssignmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*SubnetAssignmentsRequest) ProtoMessage()    {}
+func (*SubnetAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{87}
+}
+func (m *SubnetAssignmentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubnetAssignmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubnetAssignmentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubnetAssignmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubnetAssignmentsRequest.Merge(m, src)
+}
+func (m *SubnetAssignmentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubnetAssignmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubnetAssignmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubnetAssignmentsRequest proto.InternalMessageInfo
+
+func (m *SubnetAssignmentsRequest) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *SubnetAssignmentsRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+type SubnetAssignment struct {
+	PublicKey            []byte                                             `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty" ssz-size:"48"`
+	ValidatorIndex       github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,2,opt,name=validator_index,json=validatorIndex,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"validator_index,omitempty"`
+	AttesterSlot         github_com_prysmaticlabs_eth2_types.Slot           `protobuf:"varint,3,opt,name=attester_slot,json=attesterSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"attester_slot,omitempty"`
+	CommitteeIndex       github_com_prysmaticlabs_eth2_types.CommitteeIndex `protobuf:"varint,4,opt,name=committee_index,json=committeeIndex,proto3,casttype=github.com/prysmaticlabs/eth2-types.CommitteeIndex" json:"committee_index,omitempty"`
+	SubnetId             uint64                                             `protobuf:"varint,5,opt,name=subnet_id,json=subnetId,proto3" json:"subnet_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *SubnetAssignment) Reset()         { *m = SubnetAssignment{} }
+func (m *SubnetAssignment) String() string { return proto.CompactTextString(m) }
+func (*SubnetAssignment) ProtoMessage()    {}
+func (*SubnetAssignment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{88}
+}
+func (m *SubnetAssignment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubnetAssignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubnetAssignment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubnetAssignment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubnetAssignment.Merge(m, src)
+}
+func (m *SubnetAssignment) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubnetAssignment) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubnetAssignment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubnetAssignment proto.InternalMessageInfo
+
+func (m *SubnetAssignment) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *SubnetAssignment) GetValidatorIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *SubnetAssignment) GetAttesterSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.AttesterSlot
+	}
+	return 0
+}
+
+func (m *SubnetAssignment) GetCommitteeIndex() github_com_prysmaticlabs_eth2_types.CommitteeIndex {
+	if m != nil {
+		return m.CommitteeIndex
+	}
+	return 0
+}
+
+func (m *SubnetAssignment) GetSubnetId() uint64 {
+	if m != nil {
+		return m.SubnetId
+	}
+	return 0
+}
+
+type SubnetAssignments struct {
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	Assignments          []*SubnetAssignment                       `protobuf:"bytes,2,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *SubnetAssignments) Reset()         { *m = SubnetAssignments{} }
+func (m *SubnetAssignments) String() string { return proto.CompactTextString(m) }
+func (*SubnetAssignments) ProtoMessage()    {}
+func (*SubnetAssignments) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{89}
+}
+func (m *SubnetAssignments) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubnetAssignments) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubnetAssignments.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubnetAssignments) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubnetAssignments.Merge(m, src)
+}
+func (m *SubnetAssignments) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubnetAssignments) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubnetAssignments.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubnetAssignments proto.InternalMessageInfo
+
+func (m *SubnetAssignments) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *SubnetAssignments) GetAssignments() []*SubnetAssignment {
+	if m != nil {
+		return m.Assignments
+	}
+	return nil
+}
+
+type ListValidatorAssignmentsRangeRequest struct {
+	FromEpoch                   github_com_prysmaticlabs_eth2_types.Epoch            `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"from_epoch,omitempty"`
+	ToEpoch                     github_com_prysmaticlabs_eth2_types.Epoch            `protobuf:"varint,2,opt,name=to_epoch,json=toEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"to_epoch,omitempty"`
+	PublicKeys                  [][]byte                                             `protobuf:"bytes,3,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty" ssz-size:"?,48"`
+	Indices                     []github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,4,rep,packed,name=indices,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"indices,omitempty"`
+	TrackedOnly                 bool                                                 `protobuf:"varint,5,opt,name=tracked_only,json=trackedOnly,proto3" json:"tracked_only,omitempty"`
+	IndexOnly                   bool                                                 `protobuf:"varint,6,opt,name=index_only,json=indexOnly,proto3" json:"index_only,omitempty"`
+	CommitteeWeights            bool                                                 `protobuf:"varint,7,opt,name=committee_weights,json=committeeWeights,proto3" json:"committee_weights,omitempty"`
+	IncludeValidatorFields      bool                                                 `protobuf:"varint,8,opt,name=include_validator_fields,json=includeValidatorFields,proto3" json:"include_validator_fields,omitempty"`
+	WithdrawalCredentialsPrefix []byte                                               `protobuf:"bytes,9,opt,name=withdrawal_credentials_prefix,json=withdrawalCredentialsPrefix,proto3" json:"withdrawal_credentials_prefix,omitempty"`
+	Compact                     bool                                                 `protobuf:"varint,10,opt,name=compact,proto3" json:"compact,omitempty"`
+	XXX_NoUnkeyedLiteral        struct{}                                             `json:"-"`
+	XXX_unrecognized            []byte                                               `json:"-"`
+	XXX_sizecache               int32                                                `json:"-"`
+}
+
+func (m *ListValidatorAssignmentsRangeRequest) Reset()         { *m = ListValidatorAssignmentsRangeRequest{} }
+func (m *ListValidatorAssignmentsRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*ListValidatorAssignmentsRangeRequest) ProtoMessage()    {}
+func (*ListValidatorAssignmentsRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{90}
+}
+func (m *ListValidatorAssignmentsRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListValidatorAssignmentsRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListValidatorAssignmentsRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListValidatorAssignmentsRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListValidatorAssignmentsRangeRequest.Merge(m, src)
+}
+func (m *ListValidatorAssignmentsRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListValidatorAssignmentsRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListValidatorAssignmentsRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListValidatorAssignmentsRangeRequest proto.InternalMessageInfo
+
+func (m *ListValidatorAssignmentsRangeRequest) GetFromEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
+}
+
+func (m *ListValidatorAssignmentsRangeRequest) GetToEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.ToEpoch
+	}
+	return 0
+}
+
+func (m *ListValidatorAssignmentsRangeRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+func (m *ListValidatorAssignmentsRangeRequest) GetIndices() []github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
+}
+
+func (m *ListValidatorAssignmentsRangeRequest) GetTrackedOnly() bool {
+	if m != nil {
+		return m.TrackedOnly
+	}
+	return false
+}
+
+func (m *ListValidatorAssignmentsRangeRequest) GetIndexOnly() bool {
+	if m != nil {
+		return m.IndexOnly
+	}
+	return false
+}
+
+func (m *ListValidatorAssignmentsRangeRequest) GetCommitteeWeights() bool {
+	if m != nil {
+		return m.CommitteeWeights
+	}
+	return false
+}
+
+func (m *ListValidatorAssignmentsRangeRequest) GetIncludeValidatorFields() bool {
+	if m != nil {
+		return m.IncludeValidatorFields
+	}
+	return false
+}
+
+func (m *ListValidatorAssignmentsRangeRequest) GetWithdrawalCredentialsPrefix() []byte {
+	if m != nil {
+		return m.WithdrawalCredentialsPrefix
+	}
+	return nil
+}
+
+func (m *ListValidatorAssignmentsRangeRequest) GetCompact() bool {
+	if m != nil {
+		return m.Compact
+	}
+	return false
+}
+
+type ValidatorAssignmentsRange struct {
+	Epochs               []*v1alpha1.ValidatorAssignments `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs,omitempty"`
+	IndexMismatches      []*ValidatorIndexMismatch        `protobuf:"bytes,2,rep,name=index_mismatches,json=indexMismatches,proto3" json:"index_mismatches,omitempty"`
+	ProposerListRoots    [][]byte                         `protobuf:"bytes,3,rep,name=proposer_list_roots,json=proposerListRoots,proto3" json:"proposer_list_roots,omitempty" ssz-size:"?,32"`
+	CommitteeWeights     []*EpochCommitteeWeights         `protobuf:"bytes,4,rep,name=committee_weights,json=committeeWeights,proto3" json:"committee_weights,omitempty"`
+	ValidatorFields      []*EpochValidatorFields          `protobuf:"bytes,5,rep,name=validator_fields,json=validatorFields,proto3" json:"validator_fields,omitempty"`
+	CommitteePositions   []*EpochCommitteePositions       `protobuf:"bytes,6,rep,name=committee_positions,json=committeePositions,proto3" json:"committee_positions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *ValidatorAssignmentsRange) Reset()         { *m = ValidatorAssignmentsRange{} }
+func (m *ValidatorAssignmentsRange) String() string { return proto.CompactTextString(m) }
+func (*ValidatorAssignmentsRange) ProtoMessage()    {}
+func (*ValidatorAssignmentsRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{91}
+}
+func (m *ValidatorAssignmentsRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorAssignmentsRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorAssignmentsRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorAssignmentsRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorAssignmentsRange.Merge(m, src)
+}
+func (m *ValidatorAssignmentsRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorAssignmentsRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorAssignmentsRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorAssignmentsRange proto.InternalMessageInfo
+
+func (m *ValidatorAssignmentsRange) GetEpochs() []*v1alpha1.ValidatorAssignments {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
+func (m *ValidatorAssignmentsRange) GetIndexMismatches() []*ValidatorIndexMismatch {
+	if m != nil {
+		return m.IndexMismatches
+	}
+	return nil
+}
+
+func (m *ValidatorAssignmentsRange) GetProposerListRoots() [][]byte {
+	if m != nil {
+		return m.ProposerListRoots
+	}
+	return nil
+}
+
+func (m *ValidatorAssignmentsRange) GetCommitteeWeights() []*EpochCommitteeWeights {
+	if m != nil {
+		return m.CommitteeWeights
+	}
+	return nil
+}
+
+func (m *ValidatorAssignmentsRange) GetValidatorFields() []*EpochValidatorFields {
+	if m != nil {
+		return m.ValidatorFields
+	}
+	return nil
+}
+
+func (m *ValidatorAssignmentsRange) GetCommitteePositions() []*EpochCommitteePositions {
+	if m != nil {
+		return m.CommitteePositions
+	}
+	return nil
+}
+
+type EpochCommitteeWeights struct {
+	Weights              []*CommitteeWeight `protobuf:"bytes,1,rep,name=weights,proto3" json:"weights,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *EpochCommitteeWeights) Reset()         { *m = EpochCommitteeWeights{} }
+func (m *EpochCommitteeWeights) String() string { return proto.CompactTextString(m) }
+func (*EpochCommitteeWeights) ProtoMessage()    {}
+func (*EpochCommitteeWeights) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{92}
+}
+func (m *EpochCommitteeWeights) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochCommitteeWeights) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochCommitteeWeights.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochCommitteeWeights) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochCommitteeWeights.Merge(m, src)
+}
+func (m *EpochCommitteeWeights) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochCommitteeWeights) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochCommitteeWeights.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochCommitteeWeights proto.InternalMessageInfo
+
+func (m *EpochCommitteeWeights) GetWeights() []*CommitteeWeight {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
+type EpochValidatorFields struct {
+	Fields               []*ValidatorFields `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *EpochValidatorFields) Reset()         { *m = EpochValidatorFields{} }
+func (m *EpochValidatorFields) String() string { return proto.CompactTextString(m) }
+func (*EpochValidatorFields) ProtoMessage()    {}
+func (*EpochValidatorFields) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{93}
+}
+func (m *EpochValidatorFields) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochValidatorFields) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochValidatorFields.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochValidatorFields) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochValidatorFields.Merge(m, src)
+}
+func (m *EpochValidatorFields) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochValidatorFields) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochValidatorFields.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochValidatorFields proto.InternalMessageInfo
+
+func (m *EpochValidatorFields) GetFields() []*ValidatorFields {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+type EpochCommitteePositions struct {
+	Positions            []*CommitteePosition `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *EpochCommitteePositions) Reset()         { *m = EpochCommitteePositions{} }
+func (m *EpochCommitteePositions) String() string { return proto.CompactTextString(m) }
+func (*EpochCommitteePositions) ProtoMessage()    {}
+func (*EpochCommitteePositions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{94}
+}
+func (m *EpochCommitteePositions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochCommitteePositions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochCommitteePositions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochCommitteePositions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochCommitteePositions.Merge(m, src)
+}
+func (m *EpochCommitteePositions) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochCommitteePositions) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochCommitteePositions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochCommitteePositions proto.InternalMessageInfo
+
+func (m *EpochCommitteePositions) GetPositions() []*CommitteePosition {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+type PrecomputationStatus struct {
+	Enabled              bool                                      `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	LastPrecomputedEpoch github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,2,opt,name=last_precomputed_epoch,json=lastPrecomputedEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"last_precomputed_epoch,omitempty"`
+	Stalled              bool                                      `protobuf:"varint,3,opt,name=stalled,proto3" json:"stalled,omitempty"`
+	StalledEpoch         github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,4,opt,name=stalled_epoch,json=stalledEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"stalled_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *PrecomputationStatus) Reset()         { *m = PrecomputationStatus{} }
+func (m *PrecomputationStatus) String() string { return proto.CompactTextString(m) }
+func (*PrecomputationStatus) ProtoMessage()    {}
+func (*PrecomputationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{95}
+}
+func (m *PrecomputationStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrecomputationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrecomputationStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrecomputationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrecomputationStatus.Merge(m, src)
+}
+func (m *PrecomputationStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrecomputationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrecomputationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrecomputationStatus proto.InternalMessageInfo
+
+func (m *PrecomputationStatus) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *PrecomputationStatus) GetLastPrecomputedEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.LastPrecomputedEpoch
+	}
+	return 0
+}
+
+func (m *PrecomputationStatus) GetStalled() bool {
+	if m != nil {
+		return m.Stalled
+	}
+	return false
+}
+
+func (m *PrecomputationStatus) GetStalledEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.StalledEpoch
+	}
+	return 0
+}
+
+type StreamEpochInfoRequest struct {
+	FromEpoch github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"from_epoch,omitempty"`
+	// Types that are valid to be assigned to Resume:
+	//	*StreamEpochInfoRequest_ResumeFromEpoch
+	Resume               isStreamEpochInfoRequest_Resume `protobuf_oneof:"resume"`
+	Subscriber           string                          `protobuf:"bytes,3,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
+	AllowGaps            bool                            `protobuf:"varint,4,opt,name=allow_gaps,json=allowGaps,proto3" json:"allow_gaps,omitempty"`
+	Shard                *EpochShard                     `protobuf:"bytes,5,opt,name=shard,proto3" json:"shard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *StreamEpochInfoRequest) Reset()         { *m = StreamEpochInfoRequest{} }
+func (m *StreamEpochInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamEpochInfoRequest) ProtoMessage()    {}
+func (*StreamEpochInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{96}
+}
+func (m *StreamEpochInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamEpochInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamEpochInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamEpochInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamEpochInfoRequest.Merge(m, src)
+}
+func (m *StreamEpochInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamEpochInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamEpochInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamEpochInfoRequest proto.InternalMessageInfo
+
+type isStreamEpochInfoRequest_Resume interface {
+	isStreamEpochInfoRequest_Resume()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type StreamEpochInfoRequest_ResumeFromEpoch struct {
+	ResumeFromEpoch github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,2,opt,name=resume_from_epoch,json=resumeFromEpoch,proto3,oneof,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"resume_from_epoch,omitempty"`
+}
+
+func (*StreamEpochInfoRequest_ResumeFromEpoch) isStreamEpochInfoRequest_Resume() {}
+
+func (m *StreamEpochInfoRequest) GetResume() isStreamEpochInfoRequest_Resume {
+	if m != nil {
+		return m.Resume
+	}
+	return nil
+}
+
+func (m *StreamEpochInfoRequest) GetFromEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
+}
+
+func (m *StreamEpochInfoRequest) GetResumeFromEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if x, ok := m.GetResume().(*StreamEpochInfoRequest_ResumeFromEpoch); ok {
+		return x.ResumeFromEpoch
+	}
+	return 0
+}
+
+func (m *StreamEpochInfoRequest) GetSubscriber() string {
+	if m != nil {
+		return m.Subscriber
+	}
+	return ""
+}
+
+func (m *StreamEpochInfoRequest) GetAllowGaps() bool {
+	if m != nil {
+		return m.AllowGaps
+	}
+	return false
+}
+
+func (m *StreamEpochInfoRequest) GetShard() *EpochShard {
+	if m != nil {
+		return m.Shard
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*StreamEpochInfoRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*StreamEpochInfoRequest_ResumeFromEpoch)(nil),
+	}
+}
+
+type EpochShard struct {
+	Modulus              uint64   `protobuf:"varint,1,opt,name=modulus,proto3" json:"modulus,omitempty"`
+	Remainder            uint64   `protobuf:"varint,2,opt,name=remainder,proto3" json:"remainder,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EpochShard) Reset()         { *m = EpochShard{} }
+func (m *EpochShard) String() string { return proto.CompactTextString(m) }
+func (*EpochShard) ProtoMessage()    {}
+func (*EpochShard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{97}
+}
+func (m *EpochShard) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochShard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochShard.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochShard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochShard.Merge(m, src)
+}
+func (m *EpochShard) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochShard) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochShard.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochShard proto.InternalMessageInfo
+
+func (m *EpochShard) GetModulus() uint64 {
+	if m != nil {
+		return m.Modulus
+	}
+	return 0
+}
+
+func (m *EpochShard) GetRemainder() uint64 {
+	if m != nil {
+		return m.Remainder
+	}
+	return 0
+}
+
+type EpochInfoStreamMessage struct {
+	// Types that are valid to be assigned to Message:
+	//	*EpochInfoStreamMessage_Request
+	//	*EpochInfoStreamMessage_AckEpoch
+	Message              isEpochInfoStreamMessage_Message `protobuf_oneof:"message"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *EpochInfoStreamMessage) Reset()         { *m = EpochInfoStreamMessage{} }
+func (m *EpochInfoStreamMessage) String() string { return proto.CompactTextString(m) }
+func (*EpochInfoStreamMessage) ProtoMessage()    {}
+func (*EpochInfoStreamMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{98}
+}
+func (m *EpochInfoStreamMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochInfoStreamMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochInfoStreamMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochInfoStreamMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochInfoStreamMessage.Merge(m, src)
+}
+func (m *EpochInfoStreamMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochInfoStreamMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochInfoStreamMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochInfoStreamMessage proto.InternalMessageInfo
+
+type isEpochInfoStreamMessage_Message interface {
+	isEpochInfoStreamMessage_Message()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type EpochInfoStreamMessage_Request struct {
+	Request *StreamEpochInfoRequest `protobuf:"bytes,1,opt,name=request,proto3,oneof" json:"request,omitempty"`
+}
+type EpochInfoStreamMessage_AckEpoch struct {
+	AckEpoch github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,2,opt,name=ack_epoch,json=ackEpoch,proto3,oneof,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"ack_epoch,omitempty"`
+}
+
+func (*EpochInfoStreamMessage_Request) isEpochInfoStreamMessage_Message()  {}
+func (*EpochInfoStreamMessage_AckEpoch) isEpochInfoStreamMessage_Message() {}
+
+func (m *EpochInfoStreamMessage) GetMessage() isEpochInfoStreamMessage_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *EpochInfoStreamMessage) GetRequest() *StreamEpochInfoRequest {
+	if x, ok := m.GetMessage().(*EpochInfoStreamMessage_Request); ok {
+		return x.Request
+	}
+	return nil
+}
+
+func (m *EpochInfoStreamMessage) GetAckEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if x, ok := m.GetMessage().(*EpochInfoStreamMessage_AckEpoch); ok {
+		return x.AckEpoch
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*EpochInfoStreamMessage) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*EpochInfoStreamMessage_Request)(nil),
+		(*EpochInfoStreamMessage_AckEpoch)(nil),
+	}
+}
+
+type EpochInfo struct {
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	EpochStartTime       uint64                                    `protobuf:"varint,2,opt,name=epoch_start_time,json=epochStartTime,proto3" json:"epoch_start_time,omitempty"`
+	SlotDuration         uint64                                    `protobuf:"varint,3,opt,name=slot_duration,json=slotDuration,proto3" json:"slot_duration,omitempty"`
+	Proposers            [][]byte                                  `protobuf:"bytes,4,rep,name=proposers,proto3" json:"proposers,omitempty" ssz-size:"?,48"`
+	ProposerListRoot     []byte                                    `protobuf:"bytes,5,opt,name=proposer_list_root,json=proposerListRoot,proto3" json:"proposer_list_root,omitempty" ssz-size:"32"`
+	ForkVersion          []byte                                    `protobuf:"bytes,6,opt,name=fork_version,json=forkVersion,proto3" json:"fork_version,omitempty" ssz-size:"4"`
+	ForkDigest           []byte                                    `protobuf:"bytes,7,opt,name=fork_digest,json=forkDigest,proto3" json:"fork_digest,omitempty" ssz-size:"4"`
+	ReorgFlag            bool                                      `protobuf:"varint,8,opt,name=reorg_flag,json=reorgFlag,proto3" json:"reorg_flag,omitempty"`
+	Provisional          bool                                      `protobuf:"varint,9,opt,name=provisional,proto3" json:"provisional,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *EpochInfo) Reset()         { *m = EpochInfo{} }
+func (m *EpochInfo) String() string { return proto.CompactTextString(m) }
+func (*EpochInfo) ProtoMessage()    {}
+func (*EpochInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{99}
+}
+func (m *EpochInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochInfo.Merge(m, src)
+}
+func (m *EpochInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochInfo proto.InternalMessageInfo
+
+func (m *EpochInfo) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochInfo) GetEpochStartTime() uint64 {
+	if m != nil {
+		return m.EpochStartTime
+	}
+	return 0
+}
+
+func (m *EpochInfo) GetSlotDuration() uint64 {
+	if m != nil {
+		return m.SlotDuration
+	}
+	return 0
+}
+
+func (m *EpochInfo) GetProposers() [][]byte {
+	if m != nil {
+		return m.Proposers
+	}
+	return nil
+}
+
+func (m *EpochInfo) GetProposerListRoot() []byte {
+	if m != nil {
+		return m.ProposerListRoot
+	}
+	return nil
+}
+
+func (m *EpochInfo) GetForkVersion() []byte {
+	if m != nil {
+		return m.ForkVersion
+	}
+	return nil
+}
+
+func (m *EpochInfo) GetForkDigest() []byte {
+	if m != nil {
+		return m.ForkDigest
+	}
+	return nil
+}
+
+func (m *EpochInfo) GetReorgFlag() bool {
+	if m != nil {
+		return m.ReorgFlag
+	}
+	return false
+}
+
+func (m *EpochInfo) GetProvisional() bool {
+	if m != nil {
+		return m.Provisional
+	}
+	return false
+}
+
+type EpochInfoAccumulatorRequest struct {
+	// Types that are valid to be assigned to QueryFilter:
+	//	*EpochInfoAccumulatorRequest_Epoch
+	QueryFilter          isEpochInfoAccumulatorRequest_QueryFilter `protobuf_oneof:"query_filter"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *EpochInfoAccumulatorRequest) Reset()         { *m = EpochInfoAccumulatorRequest{} }
+func (m *EpochInfoAccumulatorRequest) String() string { return proto.CompactTextString(m) }
+func (*EpochInfoAccumulatorRequest) ProtoMessage()    {}
+func (*EpochInfoAccumulatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{100}
+}
+func (m *EpochInfoAccumulatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochInfoAccumulatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochInfoAccumulatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochInfoAccumulatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochInfoAccumulatorRequest.Merge(m, src)
+}
+func (m *EpochInfoAccumulatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochInfoAccumulatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochInfoAccumulatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochInfoAccumulatorRequest proto.InternalMessageInfo
+
+type isEpochInfoAccumulatorRequest_QueryFilter interface {
+	isEpochInfoAccumulatorRequest_QueryFilter()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type EpochInfoAccumulatorRequest_Epoch struct {
+	Epoch github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=epoch,proto3,oneof,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+}
+
+func (*EpochInfoAccumulatorRequest_Epoch) isEpochInfoAccumulatorRequest_QueryFilter() {}
+
+func (m *EpochInfoAccumulatorRequest) GetQueryFilter() isEpochInfoAccumulatorRequest_QueryFilter {
+	if m != nil {
+		return m.QueryFilter
+	}
+	return nil
+}
+
+func (m *EpochInfoAccumulatorRequest) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if x, ok := m.GetQueryFilter().(*EpochInfoAccumulatorRequest_Epoch); ok {
+		return x.Epoch
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*EpochInfoAccumulatorRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*EpochInfoAccumulatorRequest_Epoch)(nil),
+	}
+}
+
+type EpochInfoAccumulator struct {
+	AnchorEpoch          github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=anchor_epoch,json=anchorEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"anchor_epoch,omitempty"`
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,2,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	Root                 []byte                                    `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty" ssz-size:"32"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *EpochInfoAccumulator) Reset()         { *m = EpochInfoAccumulator{} }
+func (m *EpochInfoAccumulator) String() string { return proto.CompactTextString(m) }
+func (*EpochInfoAccumulator) ProtoMessage()    {}
+func (*EpochInfoAccumulator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{101}
+}
+func (m *EpochInfoAccumulator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochInfoAccumulator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochInfoAccumulator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochInfoAccumulator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochInfoAccumulator.Merge(m, src)
+}
+func (m *EpochInfoAccumulator) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochInfoAccumulator) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochInfoAccumulator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochInfoAccumulator proto.InternalMessageInfo
+
+func (m *EpochInfoAccumulator) GetAnchorEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.AnchorEpoch
+	}
+	return 0
+}
+
+func (m *EpochInfoAccumulator) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochInfoAccumulator) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+type ProposerListRequest struct {
+	// Types that are valid to be assigned to QueryFilter:
+	//	*ProposerListRequest_Epoch
+	QueryFilter          isProposerListRequest_QueryFilter `protobuf_oneof:"query_filter"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *ProposerListRequest) Reset()         { *m = ProposerListRequest{} }
+func (m *ProposerListRequest) String() string { return proto.CompactTextString(m) }
+func (*ProposerListRequest) ProtoMessage()    {}
+func (*ProposerListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{102}
+}
+func (m *ProposerListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposerListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposerListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposerListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerListRequest.Merge(m, src)
+}
+func (m *ProposerListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposerListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerListRequest proto.InternalMessageInfo
+
+type isProposerListRequest_QueryFilter interface {
+	isProposerListRequest_QueryFilter()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type ProposerListRequest_Epoch struct {
+	Epoch github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=epoch,proto3,oneof,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+}
+
+func (*ProposerListRequest_Epoch) isProposerListRequest_QueryFilter() {}
+
+func (m *ProposerListRequest) GetQueryFilter() isProposerListRequest_QueryFilter {
+	if m != nil {
+		return m.QueryFilter
+	}
+	return nil
+}
+
+func (m *ProposerListRequest) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if x, ok := m.GetQueryFilter().(*ProposerListRequest_Epoch); ok {
+		return x.Epoch
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ProposerListRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ProposerListRequest_Epoch)(nil),
+	}
+}
+
+type ProposerList struct {
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	Proposers            []*SlotProposer                           `protobuf:"bytes,2,rep,name=proposers,proto3" json:"proposers,omitempty"`
+	ProposerListRoot     []byte                                    `protobuf:"bytes,3,opt,name=proposer_list_root,json=proposerListRoot,proto3" json:"proposer_list_root,omitempty" ssz-size:"32"`
+	Provisional          bool                                      `protobuf:"varint,4,opt,name=provisional,proto3" json:"provisional,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *ProposerList) Reset()         { *m = ProposerList{} }
+func (m *ProposerList) String() string { return proto.CompactTextString(m) }
+func (*ProposerList) ProtoMessage()    {}
+func (*ProposerList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{103}
+}
+func (m *ProposerList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposerList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposerList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposerList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerList.Merge(m, src)
+}
+func (m *ProposerList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposerList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerList proto.InternalMessageInfo
+
+func (m *ProposerList) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ProposerList) GetProposers() []*SlotProposer {
+	if m != nil {
+		return m.Proposers
+	}
+	return nil
+}
+
+func (m *ProposerList) GetProposerListRoot() []byte {
+	if m != nil {
+		return m.ProposerListRoot
+	}
+	return nil
+}
+
+func (m *ProposerList) GetProvisional() bool {
+	if m != nil {
+		return m.Provisional
+	}
+	return false
+}
+
+type SlotProposer struct {
+	Slot                 github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+	PublicKey            []byte                                   `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty" ssz-size:"48"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
+}
+
+func (m *SlotProposer) Reset()         { *m = SlotProposer{} }
+func (m *SlotProposer) String() string { return proto.CompactTextString(m) }
+func (*SlotProposer) ProtoMessage()    {}
+func (*SlotProposer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3049e7705474e70e, []int{104}
+}
+func (m *SlotProposer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlotProposer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlotProposer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlotProposer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlotProposer.Merge(m, src)
+}
+func (m *SlotProposer) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlotProposer) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlotProposer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlotProposer proto.InternalMessageInfo
+
+func (m *SlotProposer) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *SlotProposer) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.ProposerAudit_Outcome", ProposerAudit_Outcome_name, ProposerAudit_Outcome_value)
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.PandoraConfirmation_Status", PandoraConfirmation_Status_name, PandoraConfirmation_Status_value)
+	proto.RegisterType((*ValidatorLivenessRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorLivenessRequest")
+	proto.RegisterType((*ValidatorLivenessResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorLivenessResponse")
+	proto.RegisterType((*ValidatorLiveness)(nil), "ethereum.beacon.rpc.v1.ValidatorLiveness")
+	proto.RegisterType((*CommitteeRootsRequest)(nil), "ethereum.beacon.rpc.v1.CommitteeRootsRequest")
+	proto.RegisterType((*CommitteeRoots)(nil), "ethereum.beacon.rpc.v1.CommitteeRoots")
+	proto.RegisterType((*CommitteeRoot)(nil), "ethereum.beacon.rpc.v1.CommitteeRoot")
+	proto.RegisterType((*ExplainShuffleRequest)(nil), "ethereum.beacon.rpc.v1.ExplainShuffleRequest")
+	proto.RegisterType((*ShuffleExplanation)(nil), "ethereum.beacon.rpc.v1.ShuffleExplanation")
+	proto.RegisterType((*GetStateDiffRequest)(nil), "ethereum.beacon.rpc.v1.GetStateDiffRequest")
+	proto.RegisterType((*StateDiff)(nil), "ethereum.beacon.rpc.v1.StateDiff")
+	proto.RegisterType((*ValidatorChange)(nil), "ethereum.beacon.rpc.v1.ValidatorChange")
+	proto.RegisterType((*BalanceDelta)(nil), "ethereum.beacon.rpc.v1.BalanceDelta")
+	proto.RegisterType((*RandaoMixesRequest)(nil), "ethereum.beacon.rpc.v1.RandaoMixesRequest")
+	proto.RegisterType((*RandaoMixes)(nil), "ethereum.beacon.rpc.v1.RandaoMixes")
+	proto.RegisterType((*EpochRandaoMix)(nil), "ethereum.beacon.rpc.v1.EpochRandaoMix")
+	proto.RegisterType((*RandaoContribution)(nil), "ethereum.beacon.rpc.v1.RandaoContribution")
+	proto.RegisterType((*ListReorgsRequest)(nil), "ethereum.beacon.rpc.v1.ListReorgsRequest")
+	proto.RegisterType((*Reorgs)(nil), "ethereum.beacon.rpc.v1.Reorgs")
+	proto.RegisterType((*ReorgEvent)(nil), "ethereum.beacon.rpc.v1.ReorgEvent")
+	proto.RegisterType((*ListValidatorBalanceHistoryRequest)(nil), "ethereum.beacon.rpc.v1.ListValidatorBalanceHistoryRequest")
+	proto.RegisterType((*ValidatorBalanceHistory)(nil), "ethereum.beacon.rpc.v1.ValidatorBalanceHistory")
+	proto.RegisterType((*EpochBalance)(nil), "ethereum.beacon.rpc.v1.EpochBalance")
+	proto.RegisterType((*SlotParticipation)(nil), "ethereum.beacon.rpc.v1.SlotParticipation")
+	proto.RegisterType((*GetEpochSummaryRequest)(nil), "ethereum.beacon.rpc.v1.GetEpochSummaryRequest")
+	proto.RegisterType((*ListEpochSummariesRequest)(nil), "ethereum.beacon.rpc.v1.ListEpochSummariesRequest")
+	proto.RegisterType((*EpochSummaries)(nil), "ethereum.beacon.rpc.v1.EpochSummaries")
+	proto.RegisterType((*EpochSummary)(nil), "ethereum.beacon.rpc.v1.EpochSummary")
+	proto.RegisterType((*ListValidatorPublicKeysRequest)(nil), "ethereum.beacon.rpc.v1.ListValidatorPublicKeysRequest")
+	proto.RegisterType((*ValidatorPublicKeys)(nil), "ethereum.beacon.rpc.v1.ValidatorPublicKeys")
+	proto.RegisterType((*ValidatorPublicKey)(nil), "ethereum.beacon.rpc.v1.ValidatorPublicKey")
+	proto.RegisterType((*ValidatorQueueDetailsRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorQueueDetailsRequest")
+	proto.RegisterType((*ValidatorQueueDetails)(nil), "ethereum.beacon.rpc.v1.ValidatorQueueDetails")
+	proto.RegisterType((*QueuedValidator)(nil), "ethereum.beacon.rpc.v1.QueuedValidator")
+	proto.RegisterType((*AnnotatedChainHead)(nil), "ethereum.beacon.rpc.v1.AnnotatedChainHead")
+	proto.RegisterType((*BlockAvailabilityRequest)(nil), "ethereum.beacon.rpc.v1.BlockAvailabilityRequest")
+	proto.RegisterType((*BlockAvailability)(nil), "ethereum.beacon.rpc.v1.BlockAvailability")
+	proto.RegisterType((*NetworkConfig)(nil), "ethereum.beacon.rpc.v1.NetworkConfig")
+	proto.RegisterType((*GetValidatorSetDeltaRequest)(nil), "ethereum.beacon.rpc.v1.GetValidatorSetDeltaRequest")
+	proto.RegisterType((*ValidatorRecord)(nil), "ethereum.beacon.rpc.v1.ValidatorRecord")
+	proto.RegisterType((*ValidatorSetDelta)(nil), "ethereum.beacon.rpc.v1.ValidatorSetDelta")
+	proto.RegisterType((*PrefetchEpochInfoRequest)(nil), "ethereum.beacon.rpc.v1.PrefetchEpochInfoRequest")
+	proto.RegisterType((*PrefetchJob)(nil), "ethereum.beacon.rpc.v1.PrefetchJob")
+	proto.RegisterType((*GetPrefetchStatusRequest)(nil), "ethereum.beacon.rpc.v1.GetPrefetchStatusRequest")
+	proto.RegisterType((*PrefetchStatus)(nil), "ethereum.beacon.rpc.v1.PrefetchStatus")
+	proto.RegisterType((*EpochGap)(nil), "ethereum.beacon.rpc.v1.EpochGap")
+	proto.RegisterType((*ListCanonicalBlockRootsRequest)(nil), "ethereum.beacon.rpc.v1.ListCanonicalBlockRootsRequest")
+	proto.RegisterType((*CanonicalBlockRoots)(nil), "ethereum.beacon.rpc.v1.CanonicalBlockRoots")
+	proto.RegisterType((*CanonicalBlockRoot)(nil), "ethereum.beacon.rpc.v1.CanonicalBlockRoot")
+	proto.RegisterType((*StreamProposerAuditRequest)(nil), "ethereum.beacon.rpc.v1.StreamProposerAuditRequest")
+	proto.RegisterType((*ProposerAudit)(nil), "ethereum.beacon.rpc.v1.ProposerAudit")
+	proto.RegisterType((*TrackValidatorsRequest)(nil), "ethereum.beacon.rpc.v1.TrackValidatorsRequest")
+	proto.RegisterType((*TrackedValidatorsResponse)(nil), "ethereum.beacon.rpc.v1.TrackedValidatorsResponse")
+	proto.RegisterType((*ImportTrackedValidatorsRequest)(nil), "ethereum.beacon.rpc.v1.ImportTrackedValidatorsRequest")
+	proto.RegisterType((*TrackedValidatorsExport)(nil), "ethereum.beacon.rpc.v1.TrackedValidatorsExport")
+	proto.RegisterType((*ListPoolAttestationsRequest)(nil), "ethereum.beacon.rpc.v1.ListPoolAttestationsRequest")
+	proto.RegisterType((*PoolAttestations)(nil), "ethereum.beacon.rpc.v1.PoolAttestations")
+	proto.RegisterType((*PoolCommitteeAttestations)(nil), "ethereum.beacon.rpc.v1.PoolCommitteeAttestations")
+	proto.RegisterType((*Eth1DataStatus)(nil), "ethereum.beacon.rpc.v1.Eth1DataStatus")
+	proto.RegisterType((*DepositInclusion)(nil), "ethereum.beacon.rpc.v1.DepositInclusion")
+	proto.RegisterType((*SimulateEpochTransitionRequest)(nil), "ethereum.beacon.rpc.v1.SimulateEpochTransitionRequest")
+	proto.RegisterType((*EpochTransitionSimulation)(nil), "ethereum.beacon.rpc.v1.EpochTransitionSimulation")
+	proto.RegisterType((*ValidatorPenalty)(nil), "ethereum.beacon.rpc.v1.ValidatorPenalty")
+	proto.RegisterType((*ListCanonicalBlockHeadersRequest)(nil), "ethereum.beacon.rpc.v1.ListCanonicalBlockHeadersRequest")
+	proto.RegisterType((*CanonicalBlockHeaders)(nil), "ethereum.beacon.rpc.v1.CanonicalBlockHeaders")
+	proto.RegisterType((*CanonicalBlockHeader)(nil), "ethereum.beacon.rpc.v1.CanonicalBlockHeader")
+	proto.RegisterType((*PandoraConfirmation)(nil), "ethereum.beacon.rpc.v1.PandoraConfirmation")
+	proto.RegisterType((*GetBlockByPandoraHashRequest)(nil), "ethereum.beacon.rpc.v1.GetBlockByPandoraHashRequest")
+	proto.RegisterType((*PairedBlock)(nil), "ethereum.beacon.rpc.v1.PairedBlock")
+	proto.RegisterType((*ProposerListConsistencyRequest)(nil), "ethereum.beacon.rpc.v1.ProposerListConsistencyRequest")
+	proto.RegisterType((*ProposerListConsistency)(nil), "ethereum.beacon.rpc.v1.ProposerListConsistency")
+	proto.RegisterType((*CommitteeParticipation)(nil), "ethereum.beacon.rpc.v1.CommitteeParticipation")
+	proto.RegisterType((*SlotCommitteeParticipation)(nil), "ethereum.beacon.rpc.v1.SlotCommitteeParticipation")
+	proto.RegisterType((*CurrentEpochParticipation)(nil), "ethereum.beacon.rpc.v1.CurrentEpochParticipation")
+	proto.RegisterType((*AnnotatedValidatorAssignmentsRequest)(nil), "ethereum.beacon.rpc.v1.AnnotatedValidatorAssignmentsRequest")
+	proto.RegisterType((*AnnotatedValidatorAssignments)(nil), "ethereum.beacon.rpc.v1.AnnotatedValidatorAssignments")
+	proto.RegisterType((*ValidatorIndexMismatch)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexMismatch")
+	proto.RegisterType((*CommitteeWeight)(nil), "ethereum.beacon.rpc.v1.CommitteeWeight")
+	proto.RegisterType((*ValidatorFields)(nil), "ethereum.beacon.rpc.v1.ValidatorFields")
+	proto.RegisterType((*ValidatorsByWithdrawalCredentialsRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorsByWithdrawalCredentialsRequest")
+	proto.RegisterType((*CommitteePosition)(nil), "ethereum.beacon.rpc.v1.CommitteePosition")
+	proto.RegisterType((*StorageGrowthRequest)(nil), "ethereum.beacon.rpc.v1.StorageGrowthRequest")
+	proto.RegisterType((*StorageGrowthEstimate)(nil), "ethereum.beacon.rpc.v1.StorageGrowthEstimate")
+	proto.RegisterType((*PandoraBlockHashConfirmation)(nil), "ethereum.beacon.rpc.v1.PandoraBlockHashConfirmation")
+	proto.RegisterType((*ConfirmPandoraBlockHashesRequest)(nil), "ethereum.beacon.rpc.v1.ConfirmPandoraBlockHashesRequest")
+	proto.RegisterType((*ConfirmPandoraBlockHashesResponse)(nil), "ethereum.beacon.rpc.v1.ConfirmPandoraBlockHashesResponse")
+	proto.RegisterType((*ProposerStatsRequest)(nil), "ethereum.beacon.rpc.v1.ProposerStatsRequest")
+	proto.RegisterType((*ProposerStats)(nil), "ethereum.beacon.rpc.v1.ProposerStats")
+	proto.RegisterType((*SubnetAssignmentsRequest)(nil), "ethereum.beacon.rpc.v1.SubnetAssignmentsRequest")
+	proto.RegisterType((*SubnetAssignment)(nil), "ethereum.beacon.rpc.v1.SubnetAssignment")
+	proto.RegisterType((*SubnetAssignments)(nil), "ethereum.beacon.rpc.v1.SubnetAssignments")
+	proto.RegisterType((*ListValidatorAssignmentsRangeRequest)(nil), "ethereum.beacon.rpc.v1.ListValidatorAssignmentsRangeRequest")
+	proto.RegisterType((*ValidatorAssignmentsRange)(nil), "ethereum.beacon.rpc.v1.ValidatorAssignmentsRange")
+	proto.RegisterType((*EpochCommitteeWeights)(nil), "ethereum.beacon.rpc.v1.EpochCommitteeWeights")
+	proto.RegisterType((*EpochValidatorFields)(nil), "ethereum.beacon.rpc.v1.EpochValidatorFields")
+	proto.RegisterType((*EpochCommitteePositions)(nil), "ethereum.beacon.rpc.v1.EpochCommitteePositions")
+	proto.RegisterType((*PrecomputationStatus)(nil), "ethereum.beacon.rpc.v1.PrecomputationStatus")
+	proto.RegisterType((*StreamEpochInfoRequest)(nil), "ethereum.beacon.rpc.v1.StreamEpochInfoRequest")
+	proto.RegisterType((*EpochShard)(nil), "ethereum.beacon.rpc.v1.EpochShard")
+	proto.RegisterType((*EpochInfoStreamMessage)(nil), "ethereum.beacon.rpc.v1.EpochInfoStreamMessage")
+	proto.RegisterType((*EpochInfo)(nil), "ethereum.beacon.rpc.v1.EpochInfo")
+	proto.RegisterType((*EpochInfoAccumulatorRequest)(nil), "ethereum.beacon.rpc.v1.EpochInfoAccumulatorRequest")
+	proto.RegisterType((*EpochInfoAccumulator)(nil), "ethereum.beacon.rpc.v1.EpochInfoAccumulator")
+	proto.RegisterType((*ProposerListRequest)(nil), "ethereum.beacon.rpc.v1.ProposerListRequest")
+	proto.RegisterType((*ProposerList)(nil), "ethereum.beacon.rpc.v1.ProposerList")
+	proto.RegisterType((*SlotProposer)(nil), "ethereum.beacon.rpc.v1.SlotProposer")
+}
+
+func init() {
+	proto.RegisterFile("proto/beacon/rpc/v1/beacon_query.proto", fileDescriptor_3049e7705474e70e)
+}
+
+var fileDescriptor_3049e7705474e70e = []byte{
+	// 7486 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5d, 0x6c, 0x24, 0xc7,
+	0x71, 0xf0, 0xcd, 0xee, 0x92, 0xdc, 0xad, 0x25, 0x97, 0x64, 0x1f, 0xc9, 0xdb, 0xdb, 0xfb, 0x1f,
+	0xdd, 0x9d, 0x78, 0x3f, 0xe4, 0x1e, 0x79, 0xa7, 0xfb, 0x24, 0x59, 0xb6, 0xc4, 0xbf, 0xe3, 0x51,
+	0xd2, 0x49, 0xd4, 0xf0, 0x2c, 0x7d, 0x9f, 0xfd, 0x39, 0xeb, 0xe1, 0x4e, 0x73, 0x77, 0x74, 0xb3,
+	0x33, 0xab, 0x99, 0x59, 0xde, 0x51, 0x88, 0x0d, 0xc4, 0x41, 0xe2, 0x38, 0x09, 0x0c, 0x24, 0x76,
+	0xe2, 0x38, 0xbf, 0x30, 0x12, 0xc3, 0x49, 0xe0, 0xd8, 0x4e, 0x8c, 0xd8, 0x31, 0x62, 0x23, 0x2f,
+	0x4e, 0x10, 0x03, 0x09, 0xa0, 0xc0, 0x79, 0x09, 0x02, 0x1c, 0x02, 0x23, 0x48, 0x1e, 0x02, 0x04,
+	0x81, 0x1e, 0xf2, 0xa0, 0x00, 0x49, 0xd0, 0x7f, 0xf3, 0xb3, 0x3b, 0xbd, 0xbb, 0x24, 0x57, 0xd2,
+	0x05, 0xc8, 0x13, 0x39, 0xdd, 0x55, 0xd5, 0xd5, 0xd5, 0xdd, 0xd5, 0xd5, 0xd5, 0xd5, 0xb5, 0x70,
+	0xb1, 0xe9, 0x3a, 0xbe, 0x53, 0xde, 0xc6, 0x7a, 0xd5, 0xb1, 0xcb, 0x6e, 0xb3, 0x5a, 0xde, 0x5d,
+	0xe0, 0x5f, 0x95, 0x37, 0x5a, 0xd8, 0xdd, 0x9b, 0xa7, 0x00, 0x68, 0x06, 0xfb, 0x75, 0xec, 0xe2,
+	0x56, 0x63, 0x9e, 0x55, 0xce, 0xbb, 0xcd, 0xea, 0xfc, 0xee, 0x42, 0xe9, 0x34, 0xf6, 0xeb, 0xe5,
+	0xdd, 0x05, 0xdd, 0x6a, 0xd6, 0xf5, 0x85, 0xb2, 0xee, 0xfb, 0xd8, 0xf3, 0x75, 0xdf, 0x74, 0x6c,
+	0x86, 0x57, 0x3a, 0x13, 0xab, 0xe7, 0x84, 0xb7, 0x2d, 0xa7, 0x7a, 0xaf, 0x1b, 0x40, 0xb5, 0xae,
+	0x9b, 0x82, 0xc2, 0xc9, 0x18, 0xc0, 0xae, 0x6e, 0x99, 0x86, 0xee, 0x3b, 0xae, 0xa8, 0xad, 0x39,
+	0x4e, 0xcd, 0xc2, 0x65, 0xbd, 0x69, 0x96, 0x75, 0xdb, 0x76, 0x58, 0xe3, 0x1e, 0xaf, 0x3d, 0xc1,
+	0x6b, 0xe9, 0xd7, 0x76, 0x6b, 0xa7, 0x8c, 0x1b, 0x4d, 0x9f, 0x77, 0xa9, 0x34, 0x57, 0x33, 0xfd,
+	0x7a, 0x6b, 0x7b, 0xbe, 0xea, 0x34, 0xca, 0x35, 0xa7, 0xe6, 0x84, 0x50, 0xe4, 0x8b, 0xc9, 0x85,
+	0xfc, 0xc7, 0xc0, 0xd5, 0x3f, 0x54, 0xa0, 0xf8, 0xaa, 0x68, 0xfd, 0x45, 0x73, 0x17, 0xdb, 0xd8,
+	0xf3, 0x34, 0xfc, 0x46, 0x0b, 0x7b, 0x3e, 0x5a, 0x81, 0x21, 0xdc, 0x74, 0xaa, 0xf5, 0xa2, 0x72,
+	0x56, 0x99, 0xcd, 0x2c, 0xcf, 0xbd, 0xf3, 0xf0, 0xcc, 0xa5, 0x08, 0xf9, 0xa6, 0xbb, 0xe7, 0x35,
+	0x74, 0xdf, 0xac, 0x5a, 0xfa, 0xb6, 0x57, 0xc6, 0x7e, 0x7d, 0x71, 0xce, 0xdf, 0x6b, 0x62, 0x6f,
+	0x7e, 0x8d, 0x20, 0x69, 0x0c, 0x17, 0x6d, 0xc2, 0x88, 0x69, 0x1b, 0x66, 0x15, 0x7b, 0xc5, 0xd4,
+	0xd9, 0xf4, 0x6c, 0x66, 0xf9, 0xe6, 0x3b, 0x0f, 0xcf, 0x2c, 0xf6, 0x43, 0x26, 0xe0, 0x6b, 0xc3,
+	0x36, 0xf0, 0x03, 0x4d, 0x90, 0x51, 0xbf, 0xa2, 0xc0, 0xf1, 0x04, 0x9e, 0xbd, 0xa6, 0x63, 0x7b,
+	0x78, 0x30, 0x4c, 0xaf, 0x41, 0xd6, 0xe2, 0x84, 0x29, 0xd7, 0xf9, 0xc5, 0x4b, 0xf3, 0xc9, 0x73,
+	0x65, 0xbe, 0x93, 0x93, 0x00, 0x55, 0x7d, 0x13, 0x26, 0x3b, 0xaa, 0xd1, 0x8b, 0x30, 0x64, 0x92,
+	0x0e, 0x71, 0x06, 0x0f, 0x2a, 0x0e, 0x46, 0x04, 0x1d, 0x83, 0x11, 0xd3, 0xab, 0x90, 0x16, 0x8b,
+	0xa9, 0xb3, 0xca, 0x6c, 0x56, 0x1b, 0x36, 0x3d, 0xd2, 0x94, 0xfa, 0x75, 0x05, 0xa6, 0x57, 0x9c,
+	0x46, 0xc3, 0xf4, 0x7d, 0x8c, 0x35, 0xc7, 0xf1, 0x83, 0x61, 0x7d, 0x11, 0x60, 0xc7, 0x75, 0x1a,
+	0x95, 0x43, 0x88, 0x29, 0x47, 0x08, 0xd0, 0x7f, 0xd1, 0x6d, 0xc8, 0xfa, 0x0e, 0xa7, 0x95, 0x3a,
+	0x08, 0xad, 0x11, 0xdf, 0xa1, 0xff, 0xa8, 0x77, 0xa0, 0x10, 0x67, 0x18, 0x7d, 0x00, 0x86, 0x5c,
+	0xf2, 0x4f, 0x51, 0xa1, 0x63, 0x70, 0x41, 0x36, 0x06, 0x31, 0x34, 0x8d, 0xe1, 0xa8, 0xff, 0x92,
+	0x82, 0xb1, 0x58, 0xc5, 0x60, 0xa6, 0xc6, 0x35, 0x00, 0x57, 0xb7, 0x0d, 0xdd, 0xa9, 0x34, 0xcc,
+	0x07, 0xb4, 0xc7, 0xa3, 0xcb, 0x93, 0x6f, 0x3f, 0x3c, 0x33, 0xe6, 0x79, 0x6f, 0xce, 0x79, 0xe6,
+	0x9b, 0xf8, 0x69, 0xf5, 0xfa, 0xa2, 0xaa, 0xe5, 0x18, 0xd0, 0x1d, 0xf3, 0x01, 0xba, 0x09, 0x63,
+	0x4d, 0xd7, 0x69, 0x3a, 0x1e, 0x76, 0x2b, 0x1e, 0xc6, 0x46, 0x31, 0x2d, 0x43, 0x1a, 0x15, 0x70,
+	0x5b, 0x18, 0x1b, 0x04, 0x8f, 0xa9, 0x1e, 0x81, 0x97, 0x91, 0xe2, 0x09, 0x38, 0x8a, 0xf7, 0x41,
+	0x98, 0xd4, 0xab, 0xbe, 0xb9, 0x8b, 0x2b, 0x74, 0x8a, 0x54, 0x88, 0x38, 0x8a, 0x43, 0x32, 0xdc,
+	0x71, 0x06, 0xcb, 0x26, 0x15, 0x91, 0xd2, 0x0d, 0x98, 0xe1, 0xe8, 0x81, 0x5a, 0xaa, 0x54, 0x9d,
+	0x96, 0xed, 0x17, 0x87, 0x89, 0xd8, 0xb4, 0x29, 0x56, 0x1b, 0x4c, 0xc7, 0x15, 0x52, 0xa7, 0xfe,
+	0xbe, 0x02, 0xd3, 0x6b, 0x0f, 0x9a, 0x96, 0x6e, 0xda, 0x5b, 0xf5, 0xd6, 0xce, 0x8e, 0x85, 0x07,
+	0xaa, 0x45, 0x82, 0x45, 0x93, 0x1a, 0xc0, 0xa2, 0x51, 0x3f, 0x33, 0x04, 0x88, 0x73, 0x49, 0x79,
+	0xb6, 0xa9, 0x7e, 0x7d, 0x04, 0x39, 0x45, 0x17, 0x20, 0xd3, 0x7d, 0xca, 0xd0, 0xea, 0x2e, 0x63,
+	0x96, 0x91, 0x8f, 0x19, 0x7a, 0x1c, 0xf8, 0xe0, 0x57, 0x9a, 0x8e, 0x67, 0x12, 0x11, 0xd0, 0x69,
+	0x92, 0xd1, 0x0a, 0xac, 0x78, 0x93, 0x97, 0xa2, 0x2b, 0x30, 0xe9, 0x31, 0x71, 0x19, 0x21, 0x28,
+	0x9b, 0x0d, 0x13, 0xa2, 0x22, 0x00, 0xfe, 0x28, 0x8c, 0xb9, 0x4e, 0xcb, 0x36, 0x2a, 0x4e, 0xcb,
+	0x6f, 0xb6, 0x7c, 0xaf, 0x38, 0x72, 0x28, 0xb5, 0x3f, 0x4a, 0x89, 0xbd, 0xcc, 0x68, 0xa1, 0xe7,
+	0x20, 0xe3, 0x59, 0x8e, 0x5f, 0xcc, 0x52, 0xe1, 0x5e, 0x7d, 0xe7, 0xe1, 0x99, 0xd9, 0x7e, 0x68,
+	0x6e, 0x59, 0x8e, 0xaf, 0x51, 0x4c, 0x54, 0x81, 0xf1, 0xaa, 0xd0, 0x0a, 0x6c, 0x81, 0x14, 0x73,
+	0xfb, 0x1b, 0xa9, 0x40, 0xa9, 0x30, 0x06, 0x0b, 0xd5, 0xd8, 0x37, 0x9a, 0x03, 0x14, 0x36, 0x10,
+	0x48, 0x0b, 0xa8, 0xb4, 0x26, 0x83, 0x1a, 0x21, 0x2e, 0xf5, 0xbf, 0x14, 0x38, 0xba, 0x8e, 0xfd,
+	0x2d, 0x5f, 0xf7, 0xf1, 0xaa, 0xb9, 0xb3, 0xf3, 0x88, 0x6b, 0xe9, 0xe8, 0x7e, 0x9e, 0x1e, 0xd0,
+	0x7e, 0x3e, 0x02, 0xb9, 0xa0, 0xfb, 0x8f, 0x6c, 0xbf, 0x5f, 0x05, 0x54, 0xad, 0xeb, 0x76, 0x0d,
+	0x1b, 0xe1, 0x1a, 0x63, 0x22, 0xc8, 0x2f, 0x3e, 0xde, 0xd3, 0x38, 0x58, 0xa1, 0xa8, 0xda, 0x24,
+	0x27, 0x11, 0x94, 0x7b, 0xe8, 0x05, 0x28, 0x6c, 0xeb, 0x96, 0x6e, 0x57, 0x71, 0xc5, 0xc0, 0x96,
+	0xaf, 0x7b, 0xc5, 0x0c, 0xa5, 0x79, 0x5e, 0x46, 0x73, 0x99, 0x41, 0xaf, 0x12, 0x60, 0x6d, 0x6c,
+	0x3b, 0xf2, 0xe5, 0x21, 0x0c, 0xa7, 0x9a, 0x2e, 0xde, 0x35, 0x9d, 0x96, 0x57, 0x79, 0xbd, 0xe5,
+	0xf9, 0xe6, 0x8e, 0x89, 0x8d, 0x4a, 0xb5, 0x8e, 0xab, 0xf7, 0x9a, 0x8e, 0x69, 0xb3, 0x6d, 0x20,
+	0xbf, 0x78, 0x2e, 0xa4, 0x8d, 0xfd, 0xfa, 0xbc, 0xb0, 0x43, 0xe7, 0x57, 0x02, 0x40, 0xed, 0x84,
+	0xa0, 0xf3, 0xbc, 0x20, 0x13, 0x56, 0xa2, 0x2a, 0x9c, 0xac, 0xb6, 0x5c, 0x17, 0xdb, 0x7e, 0x72,
+	0x2b, 0xc3, 0xfd, 0xb6, 0x52, 0xe2, 0x64, 0x92, 0x1a, 0xb9, 0x0b, 0x53, 0x3b, 0xa6, 0xad, 0x5b,
+	0xe6, 0x9b, 0x71, 0xe2, 0x23, 0xfd, 0x12, 0x3f, 0x1a, 0xa0, 0x47, 0xa8, 0xda, 0xa0, 0x36, 0x1d,
+	0xcf, 0xaf, 0x74, 0x17, 0x53, 0xb6, 0xdf, 0x36, 0xce, 0x10, 0x62, 0x9b, 0x5d, 0x44, 0x65, 0xc1,
+	0x39, 0xda, 0x5e, 0x57, 0x79, 0xe5, 0xfa, 0x6d, 0xee, 0x34, 0xa1, 0xb5, 0x22, 0x97, 0xd9, 0xc7,
+	0xe0, 0x38, 0x6d, 0x2d, 0x51, 0x70, 0xd0, 0x6f, 0x2b, 0xc7, 0x08, 0x8d, 0x5b, 0x9d, 0xc2, 0x53,
+	0xff, 0x4e, 0x81, 0xf1, 0xb6, 0x29, 0x3d, 0x60, 0x73, 0xf6, 0x19, 0xc8, 0x8a, 0x91, 0xa1, 0xeb,
+	0x35, 0xbf, 0x78, 0x56, 0xc2, 0x6f, 0x80, 0xaf, 0x05, 0x18, 0xe8, 0x69, 0x18, 0xe1, 0x72, 0x2e,
+	0xa6, 0xfb, 0x44, 0x16, 0x08, 0xea, 0xef, 0x2a, 0x30, 0x1a, 0x5d, 0x5a, 0x03, 0xee, 0x58, 0xa9,
+	0xad, 0x63, 0x99, 0x08, 0xdb, 0xc5, 0x38, 0xdb, 0x99, 0x80, 0x29, 0x34, 0x05, 0x43, 0x54, 0x29,
+	0xd0, 0x6d, 0x3c, 0xad, 0xb1, 0x0f, 0xf5, 0xab, 0x0a, 0x20, 0x4d, 0x98, 0x97, 0xf8, 0x91, 0xb7,
+	0xeb, 0x5f, 0x80, 0x7c, 0x84, 0x5b, 0xf4, 0x0c, 0x0c, 0x35, 0xc8, 0x3f, 0xdc, 0xa8, 0xbf, 0x28,
+	0xd3, 0x73, 0x8c, 0x8a, 0x40, 0xd4, 0x18, 0x92, 0xfa, 0x4f, 0x29, 0x28, 0xc4, 0x6b, 0x06, 0x65,
+	0xb6, 0x01, 0xb1, 0xa4, 0x0e, 0xd3, 0xe1, 0x1c, 0x21, 0xc0, 0x84, 0x37, 0x0f, 0x39, 0xcf, 0xd7,
+	0x5d, 0x9f, 0x9e, 0x11, 0xa4, 0xb6, 0x5b, 0x96, 0xc2, 0x90, 0x2e, 0x3c, 0x06, 0x69, 0x02, 0x29,
+	0x35, 0xf0, 0x49, 0x2d, 0xda, 0x84, 0xb1, 0xaa, 0x63, 0xfb, 0xae, 0xb9, 0xdd, 0xa2, 0xee, 0x80,
+	0xe2, 0x10, 0x15, 0xe0, 0x65, 0x99, 0x00, 0x99, 0x84, 0x56, 0x22, 0x28, 0x5a, 0x9c, 0x00, 0x99,
+	0x94, 0xbb, 0xd8, 0xa5, 0x4a, 0x84, 0xea, 0xec, 0xac, 0x16, 0x7c, 0xab, 0xdf, 0x4f, 0x01, 0xea,
+	0xa4, 0x10, 0x18, 0x60, 0xca, 0x81, 0x0d, 0xb0, 0x6b, 0x00, 0xd4, 0x55, 0xc2, 0xce, 0x25, 0xf2,
+	0x03, 0x14, 0x05, 0xa2, 0x27, 0x92, 0x8f, 0x41, 0x21, 0x38, 0x40, 0xb1, 0x25, 0x99, 0x3e, 0xd4,
+	0x92, 0x0c, 0x8e, 0x63, 0xf4, 0x93, 0x30, 0xd4, 0x6c, 0x6d, 0x5b, 0x66, 0xb5, 0x72, 0x0f, 0xef,
+	0x25, 0x8f, 0xc1, 0x8d, 0x27, 0x55, 0x2d, 0xc7, 0x80, 0x5e, 0xc0, 0x7b, 0xe8, 0x12, 0x0c, 0xbb,
+	0x78, 0x17, 0xeb, 0x56, 0xf2, 0xb1, 0xea, 0xa9, 0x9b, 0xaa, 0xc6, 0x01, 0x54, 0x1d, 0x26, 0x5f,
+	0x34, 0x3d, 0x5f, 0xc3, 0x8e, 0x5b, 0x7b, 0x77, 0x56, 0xaa, 0xba, 0x0a, 0xc3, 0x8c, 0x3c, 0x7a,
+	0x1a, 0x86, 0xf1, 0x2e, 0xb6, 0x83, 0x03, 0xb3, 0x2a, 0x9d, 0x1a, 0x04, 0x7e, 0x8d, 0x80, 0x6a,
+	0x1c, 0x43, 0xfd, 0x6a, 0x06, 0x20, 0x2c, 0x46, 0x4f, 0xc0, 0x98, 0x63, 0x19, 0x95, 0x3a, 0xd6,
+	0x0d, 0x36, 0x50, 0x8a, 0x6c, 0xa0, 0xf2, 0x8e, 0x65, 0xdc, 0xc6, 0xba, 0x41, 0x87, 0xea, 0x09,
+	0x18, 0xb3, 0xf1, 0xfd, 0x08, 0x9a, 0x74, 0x7c, 0xf3, 0x36, 0xbe, 0x1f, 0xa0, 0x6d, 0x46, 0x5a,
+	0xa3, 0xd3, 0x2b, 0x7d, 0x80, 0xe9, 0x25, 0x18, 0xd9, 0xb2, 0x18, 0xc5, 0x80, 0x11, 0x4a, 0x31,
+	0x73, 0x10, 0x8a, 0x9c, 0x47, 0x4a, 0xf1, 0xc7, 0x60, 0x8a, 0x58, 0xef, 0x8e, 0x5d, 0x21, 0x7b,
+	0x84, 0x47, 0x8e, 0x58, 0x94, 0xf0, 0xd0, 0x01, 0x08, 0x23, 0x46, 0x69, 0x89, 0x13, 0xa2, 0xf4,
+	0xa9, 0xae, 0x6f, 0xfa, 0x75, 0x7e, 0xb0, 0x62, 0x1f, 0x6d, 0x53, 0x65, 0x64, 0x80, 0x4a, 0x3d,
+	0x7b, 0x28, 0xa5, 0xfe, 0xdd, 0x14, 0xa8, 0x64, 0x62, 0x07, 0x4b, 0x8b, 0xef, 0x9d, 0xb7, 0x4d,
+	0xd2, 0xa1, 0x3d, 0x31, 0xd3, 0xe3, 0x6b, 0x4b, 0xe9, 0x63, 0x6d, 0x0d, 0xf6, 0xfc, 0x1c, 0x17,
+	0x5f, 0x7a, 0x80, 0xe2, 0xcb, 0x1c, 0x4a, 0x7c, 0xbf, 0xa7, 0xc0, 0x31, 0x89, 0xe8, 0x06, 0x6c,
+	0x78, 0x3c, 0x07, 0x59, 0x7e, 0x46, 0x10, 0xae, 0xcc, 0xf3, 0x5d, 0x77, 0x5c, 0xce, 0x8c, 0x16,
+	0x60, 0xa9, 0x0d, 0x18, 0x8d, 0xd6, 0x0c, 0x66, 0xbf, 0x2d, 0xc2, 0x08, 0x6f, 0x80, 0x9b, 0x43,
+	0xe2, 0x53, 0xfd, 0x4e, 0x1a, 0x26, 0xc9, 0x82, 0xd8, 0xd4, 0x5d, 0xdf, 0xac, 0x9a, 0x4d, 0x7d,
+	0x40, 0xfb, 0xce, 0x0b, 0x62, 0xdf, 0xa1, 0x74, 0x52, 0x07, 0xa0, 0xc3, 0xb6, 0xa4, 0xad, 0xce,
+	0x4d, 0x2c, 0xdd, 0xc7, 0x26, 0x76, 0x09, 0x26, 0xf0, 0x83, 0x26, 0xae, 0xfa, 0xd8, 0xa8, 0x88,
+	0x9e, 0x33, 0xe7, 0xcc, 0xb8, 0x28, 0x17, 0x02, 0xbe, 0x02, 0x93, 0xcc, 0xa1, 0x67, 0xda, 0xb5,
+	0x00, 0x96, 0x79, 0x66, 0x26, 0x82, 0x0a, 0x01, 0x7c, 0x0d, 0xa6, 0xa8, 0x92, 0xab, 0x3a, 0xae,
+	0x8b, 0xab, 0x7e, 0x00, 0xcf, 0xb4, 0x08, 0x22, 0x75, 0x2b, 0xac, 0x4a, 0x60, 0xcc, 0x01, 0x6a,
+	0x46, 0x65, 0x5b, 0x71, 0x75, 0x1f, 0x53, 0xd5, 0xa2, 0x68, 0x93, 0xb1, 0x1a, 0x4d, 0xf7, 0x31,
+	0xba, 0x0c, 0x93, 0xb1, 0x06, 0x28, 0x74, 0x96, 0x42, 0x8f, 0x47, 0xa8, 0x13, 0x58, 0xf5, 0x63,
+	0x30, 0xb3, 0x8e, 0x7d, 0x3a, 0xd0, 0x5b, 0xad, 0x46, 0x43, 0x0f, 0x15, 0xc1, 0x20, 0x26, 0x8d,
+	0xfa, 0x4d, 0x05, 0x8e, 0x13, 0xa5, 0x13, 0x69, 0xc0, 0x7c, 0xf4, 0xed, 0xdf, 0xbb, 0x50, 0x88,
+	0x33, 0x8c, 0x96, 0x21, 0xe7, 0x89, 0x8f, 0xa2, 0xd2, 0xc7, 0xa2, 0x14, 0xc2, 0x0c, 0xd1, 0xd4,
+	0xcf, 0x0c, 0xc3, 0x68, 0xb4, 0x6e, 0x30, 0xcb, 0xf2, 0x71, 0x18, 0x6f, 0xf7, 0x20, 0xb2, 0xe5,
+	0x59, 0xd8, 0x8d, 0xfb, 0x0e, 0xe5, 0x1e, 0xc7, 0x74, 0x17, 0x8f, 0xe3, 0x63, 0x30, 0xe6, 0x3b,
+	0xbe, 0x6e, 0xb5, 0xad, 0x80, 0x51, 0x5a, 0x18, 0x99, 0xd1, 0x0c, 0x88, 0x37, 0x10, 0x5f, 0x01,
+	0x88, 0xd6, 0x2d, 0xd1, 0x2a, 0x81, 0x41, 0xd6, 0x96, 0x65, 0xd6, 0xcc, 0x6d, 0x0b, 0xb7, 0xcd,
+	0xff, 0x71, 0x51, 0x2e, 0x40, 0x9f, 0x84, 0xa2, 0xaf, 0xbb, 0x35, 0xec, 0x57, 0x3a, 0x97, 0x18,
+	0xdd, 0x5d, 0xb5, 0x19, 0x56, 0xbf, 0xd4, 0xbe, 0xd0, 0x6e, 0xc0, 0x0c, 0x5d, 0x07, 0x9d, 0x78,
+	0x59, 0xd6, 0x63, 0x52, 0xdb, 0x81, 0xf5, 0x2c, 0xa0, 0xc0, 0x76, 0xb5, 0x4c, 0xcf, 0xaf, 0xd4,
+	0x75, 0xaf, 0x5e, 0xcc, 0xc9, 0x14, 0xc6, 0x84, 0x00, 0x26, 0xd3, 0xfc, 0xb6, 0xee, 0x11, 0xbf,
+	0xd3, 0x78, 0xe8, 0x33, 0x60, 0x03, 0x0c, 0x07, 0x19, 0xe0, 0x42, 0x40, 0x85, 0xcd, 0xef, 0x27,
+	0x21, 0x2c, 0x61, 0x5a, 0x2c, 0x2f, 0x63, 0x6a, 0x2c, 0x00, 0xa4, 0x9a, 0xec, 0x55, 0x18, 0x0f,
+	0xfd, 0x0b, 0x8c, 0xa3, 0xd1, 0x03, 0x71, 0x14, 0x50, 0x09, 0x38, 0x0a, 0xe9, 0x52, 0x8e, 0xc6,
+	0xa4, 0x1c, 0x05, 0x80, 0x84, 0x23, 0xf5, 0x6b, 0x0a, 0x9c, 0x8e, 0x19, 0x23, 0x9b, 0xc2, 0x9c,
+	0x08, 0x94, 0x43, 0xc4, 0x6d, 0xa9, 0x0c, 0xc4, 0x6d, 0x89, 0x4e, 0x40, 0xae, 0xa9, 0xd7, 0x70,
+	0x85, 0x70, 0x45, 0x17, 0xc9, 0x90, 0x96, 0x25, 0x05, 0x5b, 0xe6, 0x9b, 0x18, 0x9d, 0x02, 0xa0,
+	0x95, 0xbe, 0x73, 0x0f, 0xdb, 0x74, 0x49, 0xe4, 0x34, 0x0a, 0x7e, 0x97, 0x14, 0x90, 0xed, 0xff,
+	0x68, 0x02, 0xb3, 0xe8, 0x05, 0xc8, 0x87, 0xe6, 0x92, 0x50, 0x0d, 0x97, 0x7b, 0x7a, 0x17, 0x03,
+	0x0a, 0x1a, 0x34, 0x43, 0x62, 0x17, 0x61, 0xdc, 0xc6, 0x0f, 0xfc, 0x4a, 0x84, 0x91, 0x14, 0x65,
+	0x64, 0x8c, 0x14, 0x6f, 0x0a, 0x66, 0x08, 0xaf, 0x6c, 0xbd, 0xd1, 0x9e, 0xa4, 0x69, 0x4f, 0x72,
+	0xb4, 0x84, 0x74, 0x45, 0xfd, 0xbc, 0x02, 0xa8, 0xb3, 0xa5, 0x01, 0x5b, 0x29, 0x71, 0x3b, 0x31,
+	0xd5, 0xdb, 0x4e, 0x54, 0x97, 0xe0, 0x64, 0x40, 0xea, 0x95, 0x16, 0x6e, 0xe1, 0x55, 0xec, 0xeb,
+	0xa6, 0x15, 0x0c, 0xf8, 0x39, 0x18, 0xf5, 0x5d, 0xbd, 0x7a, 0x0f, 0x1b, 0x15, 0xc7, 0xb6, 0x98,
+	0xed, 0x99, 0xd5, 0xf2, 0xbc, 0xec, 0x65, 0xdb, 0xda, 0x53, 0x3f, 0x9d, 0x82, 0xe9, 0x44, 0x1a,
+	0x83, 0xd1, 0xa5, 0x67, 0x20, 0x5f, 0xad, 0xb7, 0x5c, 0xbb, 0x62, 0x99, 0x0d, 0x53, 0xe8, 0x51,
+	0xa0, 0x45, 0x2f, 0x92, 0x12, 0xb4, 0x01, 0x79, 0xaa, 0xe2, 0xd8, 0xed, 0x7e, 0x2f, 0x5f, 0x32,
+	0x65, 0x30, 0xf4, 0x1c, 0x6b, 0x51, 0x5c, 0xf4, 0x41, 0x18, 0xc2, 0x0f, 0x4c, 0x5f, 0x38, 0x8f,
+	0xfb, 0x26, 0xc2, 0xb0, 0xd4, 0x9f, 0xce, 0xc0, 0x78, 0x5b, 0xd5, 0xfb, 0x3d, 0xc0, 0xc8, 0x81,
+	0x93, 0x61, 0x0f, 0x2b, 0x4c, 0x8f, 0x9b, 0x96, 0xe9, 0xef, 0x1d, 0xc6, 0x98, 0x2f, 0x85, 0x24,
+	0xd7, 0x42, 0x8a, 0xb4, 0x0e, 0xdd, 0x85, 0x42, 0x60, 0xa1, 0x1d, 0xc2, 0xc6, 0x1f, 0x13, 0x44,
+	0x18, 0xd5, 0xff, 0x0f, 0xe8, 0xbe, 0xe9, 0xd7, 0x0d, 0x57, 0xbf, 0xaf, 0x93, 0xfd, 0x89, 0x51,
+	0x1e, 0x3a, 0x08, 0xe5, 0xc9, 0x28, 0x21, 0x46, 0x7d, 0x8a, 0x8c, 0xbb, 0x5e, 0xf5, 0xb9, 0xfb,
+	0x86, 0x7d, 0x10, 0x4d, 0x4a, 0x76, 0xa1, 0x86, 0x4e, 0xba, 0x72, 0x5f, 0x37, 0x99, 0xd3, 0x3c,
+	0xbd, 0x3c, 0xf9, 0xce, 0xc3, 0x33, 0x63, 0xbe, 0xd9, 0xc0, 0xf3, 0xab, 0x2d, 0x97, 0x59, 0x78,
+	0x63, 0x01, 0xe0, 0x6b, 0xba, 0xe9, 0xab, 0x3f, 0x48, 0x01, 0x5a, 0x62, 0x11, 0x27, 0xc4, 0xf3,
+	0xab, 0x9b, 0x36, 0x39, 0xff, 0xa2, 0x1b, 0x90, 0x21, 0xbb, 0x5b, 0x51, 0xe9, 0xea, 0x55, 0x0d,
+	0xe0, 0x35, 0x0a, 0x8d, 0x36, 0x20, 0x47, 0x15, 0xd0, 0x81, 0x0d, 0xee, 0x2c, 0x41, 0x27, 0xff,
+	0xa1, 0x1d, 0x38, 0xca, 0x74, 0xd9, 0x20, 0xfd, 0x40, 0x93, 0x54, 0x0f, 0xc6, 0x7c, 0x41, 0xcf,
+	0x43, 0x31, 0xde, 0x4e, 0x3f, 0x9e, 0xa1, 0xe9, 0x28, 0x9d, 0x40, 0x43, 0x12, 0x37, 0x6d, 0x71,
+	0x99, 0xd8, 0xff, 0x4b, 0xbb, 0xba, 0x69, 0xe9, 0x6c, 0xaa, 0x09, 0xf5, 0xb4, 0x01, 0xd4, 0xd6,
+	0xac, 0x1c, 0xf8, 0x50, 0x93, 0x25, 0xe8, 0x54, 0x36, 0x6b, 0x30, 0xe2, 0x3b, 0x07, 0x17, 0xf2,
+	0xb0, 0xef, 0x90, 0xbf, 0x44, 0x1b, 0x4e, 0x76, 0xb0, 0xfb, 0xe8, 0xf1, 0x89, 0x3e, 0x0e, 0x39,
+	0x9d, 0x71, 0x68, 0x61, 0x7e, 0xf2, 0x5a, 0x7e, 0xfb, 0xe1, 0x99, 0x02, 0x19, 0x93, 0x86, 0xfe,
+	0xe0, 0x69, 0xf5, 0xc9, 0x85, 0xa7, 0x16, 0xd5, 0x77, 0x1e, 0x9e, 0xb9, 0x2a, 0x25, 0x5d, 0x73,
+	0xe6, 0xb6, 0x4d, 0x7f, 0xc7, 0xc4, 0x96, 0x31, 0xbf, 0x6c, 0xfa, 0xc4, 0x2e, 0xd3, 0x42, 0xa2,
+	0xea, 0xe7, 0xd2, 0x30, 0xf6, 0x12, 0xf6, 0xef, 0x3b, 0xee, 0xbd, 0x15, 0xc7, 0xde, 0x31, 0x6b,
+	0x08, 0x41, 0xc6, 0xd6, 0x1b, 0x98, 0x0a, 0x20, 0xa7, 0xd1, 0xff, 0xd1, 0x5d, 0x18, 0x27, 0x7d,
+	0xf1, 0x2a, 0x4d, 0xec, 0xc6, 0xce, 0x09, 0xfb, 0xeb, 0xd6, 0x18, 0x25, 0xb2, 0x89, 0x5d, 0xb6,
+	0xa0, 0x67, 0x61, 0xc2, 0xc3, 0x55, 0xc7, 0x36, 0x18, 0xdd, 0xd0, 0x19, 0xa6, 0x15, 0x78, 0xf9,
+	0x26, 0x66, 0xfe, 0xa2, 0x65, 0x98, 0xaa, 0x61, 0x1b, 0x7b, 0xa6, 0x57, 0xd9, 0x71, 0xdc, 0x7b,
+	0x95, 0x5d, 0xec, 0x7a, 0xe4, 0xa6, 0x99, 0x4d, 0xd3, 0x89, 0xb7, 0x1f, 0x9e, 0x19, 0x8d, 0x4c,
+	0x53, 0x55, 0x43, 0x1c, 0xfa, 0x96, 0xe3, 0xde, 0x7b, 0x95, 0xc1, 0x12, 0x6b, 0xd8, 0xc0, 0xf4,
+	0x8e, 0xba, 0x42, 0x3d, 0xc3, 0x7a, 0xd5, 0xaf, 0xe8, 0x86, 0xe1, 0x62, 0xcf, 0xa3, 0x2a, 0x2a,
+	0xa7, 0xcd, 0xf0, 0xfa, 0x15, 0x5e, 0xbd, 0xc4, 0x6a, 0x09, 0x9f, 0x01, 0x26, 0x59, 0xf6, 0x15,
+	0xd3, 0xe0, 0x26, 0x77, 0x41, 0x60, 0x90, 0xe2, 0x0d, 0x03, 0x5d, 0x05, 0x24, 0x20, 0x6d, 0x26,
+	0x54, 0x02, 0xcb, 0x6c, 0x6d, 0x41, 0x83, 0x4b, 0x7b, 0xc3, 0x20, 0x7e, 0x81, 0xa6, 0x8b, 0x3d,
+	0xec, 0x7b, 0xc5, 0xec, 0xd9, 0xf4, 0x6c, 0x4e, 0x13, 0x9f, 0xea, 0x1f, 0x2b, 0x70, 0x62, 0x1d,
+	0x87, 0x36, 0xde, 0x16, 0xf6, 0xd9, 0x1d, 0xe8, 0x23, 0x7e, 0xfc, 0xfb, 0xcf, 0xe8, 0xa5, 0x99,
+	0x86, 0xab, 0x8e, 0x6b, 0xbc, 0xef, 0x7b, 0xeb, 0x87, 0x60, 0xd8, 0xf3, 0x75, 0xbf, 0xe5, 0xd1,
+	0xb9, 0x55, 0x58, 0xbc, 0x28, 0xd1, 0xe8, 0xa1, 0xb0, 0x29, 0xb4, 0xc6, 0xb1, 0x88, 0x87, 0x02,
+	0xef, 0xec, 0xe0, 0xf8, 0xf9, 0x8c, 0x9d, 0xe5, 0x26, 0x82, 0x0a, 0x7e, 0x04, 0x52, 0xbf, 0x98,
+	0x86, 0xc9, 0x8e, 0x51, 0x7b, 0x64, 0xef, 0xf9, 0x13, 0x4e, 0xc0, 0xe9, 0xc4, 0x13, 0xf0, 0x07,
+	0x61, 0x48, 0x37, 0x0c, 0x6c, 0xf4, 0x32, 0xb9, 0xda, 0xc6, 0x5e, 0x63, 0x58, 0x68, 0x09, 0x46,
+	0x78, 0x30, 0x40, 0x71, 0x68, 0x7f, 0x04, 0x04, 0x1e, 0x21, 0xe1, 0xe2, 0x86, 0xb3, 0x4b, 0x6f,
+	0x6f, 0xf6, 0x47, 0x82, 0xe3, 0xa9, 0x7f, 0xa3, 0x40, 0x71, 0xd3, 0xc5, 0x3b, 0xd8, 0xaf, 0xd6,
+	0x69, 0xff, 0x37, 0xec, 0x1d, 0xe7, 0x51, 0x0f, 0x41, 0x39, 0x05, 0xa0, 0x5b, 0x96, 0x73, 0xbf,
+	0x52, 0xd3, 0x9b, 0x6c, 0x06, 0x67, 0xb5, 0x1c, 0x2d, 0x59, 0xd7, 0x9b, 0x9e, 0x7a, 0x1e, 0xf2,
+	0xa2, 0x4b, 0xcf, 0x3b, 0xdb, 0x68, 0x1a, 0x86, 0x5f, 0x77, 0xb6, 0x89, 0xce, 0x51, 0x98, 0x63,
+	0xfd, 0x75, 0x67, 0x7b, 0xc3, 0x50, 0x17, 0xa0, 0xb8, 0x8e, 0x7d, 0x01, 0xc8, 0xe7, 0x37, 0xef,
+	0xb8, 0x04, 0xe5, 0x87, 0x29, 0x28, 0xc4, 0x11, 0x24, 0x90, 0x6d, 0x92, 0x4b, 0x0d, 0x50, 0x72,
+	0xe9, 0x43, 0x49, 0xee, 0x24, 0xe4, 0xaa, 0x4e, 0xa3, 0x69, 0x61, 0x9f, 0x87, 0x13, 0x66, 0xb4,
+	0xb0, 0x80, 0x18, 0x93, 0xf4, 0xd8, 0xc7, 0x3d, 0x2d, 0xec, 0x83, 0xec, 0x7d, 0x86, 0x63, 0x63,
+	0x6e, 0x61, 0xd2, 0xff, 0x09, 0x24, 0x76, 0x5d, 0xc7, 0xa5, 0x6a, 0x3c, 0xa7, 0xb1, 0x0f, 0x62,
+	0x25, 0xd2, 0x11, 0xc9, 0x9e, 0x4d, 0xc7, 0xad, 0xc4, 0x04, 0x8f, 0xd6, 0xba, 0xde, 0xd4, 0x28,
+	0xb4, 0x5a, 0x83, 0xac, 0x28, 0x19, 0xcc, 0xb9, 0x6b, 0x86, 0xdc, 0xce, 0xe9, 0x9e, 0x23, 0x8e,
+	0xbb, 0xfc, 0x4b, 0xfd, 0x23, 0xee, 0x25, 0x58, 0xd1, 0x6d, 0xc7, 0x36, 0xab, 0xba, 0xb5, 0x2c,
+	0x9c, 0xb3, 0xde, 0xa3, 0x6b, 0x95, 0xbd, 0x06, 0x47, 0x13, 0xf8, 0x45, 0xcf, 0xc5, 0x23, 0x63,
+	0xa5, 0x2e, 0x82, 0x4e, 0x5c, 0x11, 0x1e, 0xfb, 0x09, 0x40, 0x9d, 0x95, 0x03, 0x70, 0xb3, 0x5f,
+	0x80, 0x4c, 0xf7, 0x8b, 0x3f, 0x5a, 0xad, 0x3e, 0x0b, 0xa5, 0x2d, 0xdf, 0xc5, 0x7a, 0x43, 0xd8,
+	0xcd, 0x4b, 0x2d, 0xc3, 0xf4, 0xf7, 0x71, 0x78, 0xff, 0x8f, 0x14, 0x8c, 0xc5, 0x70, 0x07, 0xc0,
+	0xfb, 0x87, 0x60, 0x32, 0x38, 0x01, 0x8a, 0x13, 0x80, 0x7c, 0x3f, 0x0d, 0xfc, 0xf9, 0x82, 0x8d,
+	0x03, 0xdc, 0x0a, 0x3c, 0x4d, 0x43, 0x30, 0x5b, 0xba, 0x15, 0xb6, 0x27, 0x3d, 0x66, 0x14, 0x18,
+	0x64, 0xd0, 0xda, 0x3a, 0x8c, 0x38, 0x2d, 0xbf, 0xea, 0x34, 0x98, 0x6b, 0xb4, 0xb0, 0x38, 0x27,
+	0x9b, 0x05, 0x31, 0x39, 0xcd, 0xbf, 0xcc, 0x90, 0x34, 0x81, 0xad, 0x2e, 0xc0, 0x08, 0x2f, 0x43,
+	0xa3, 0x90, 0xdd, 0xd4, 0x5e, 0x5e, 0xfd, 0xf0, 0xca, 0xda, 0xea, 0xc4, 0x11, 0x04, 0x30, 0x7c,
+	0x67, 0x63, 0x6b, 0x6b, 0x6d, 0x75, 0x42, 0x21, 0x35, 0x77, 0x36, 0xb6, 0xee, 0x2c, 0xdd, 0x5d,
+	0xb9, 0x3d, 0x91, 0x52, 0x2d, 0x98, 0xb9, 0x4b, 0x06, 0x23, 0x0c, 0x64, 0x13, 0x43, 0x77, 0x01,
+	0xd2, 0xba, 0x61, 0xd0, 0x79, 0x39, 0xba, 0x7c, 0xf4, 0xed, 0x87, 0x67, 0xc6, 0xc3, 0x5e, 0x3c,
+	0x7b, 0x95, 0xf4, 0x83, 0xd4, 0xa3, 0x2b, 0x30, 0xcc, 0xf6, 0xa0, 0x62, 0x4a, 0x0e, 0xc9, 0x41,
+	0xd4, 0x57, 0xe0, 0xf8, 0x5d, 0x36, 0xf4, 0xd1, 0xf6, 0x78, 0xc0, 0xff, 0x8d, 0x4e, 0x9f, 0x99,
+	0x84, 0x5c, 0xc4, 0x39, 0xa6, 0xbe, 0x04, 0xa7, 0x37, 0x1a, 0x4d, 0xc7, 0xf5, 0x13, 0x08, 0xb3,
+	0x8e, 0x10, 0xbd, 0xa7, 0xfb, 0x3a, 0xbb, 0xb4, 0xd4, 0xe8, 0xff, 0xc4, 0x3a, 0x75, 0x71, 0xd3,
+	0xd2, 0xab, 0x22, 0xda, 0x5e, 0x7c, 0xaa, 0x73, 0x70, 0xac, 0x83, 0xd2, 0xda, 0x03, 0xd2, 0x40,
+	0x12, 0x21, 0xf5, 0x9f, 0x15, 0x38, 0x41, 0x74, 0xd1, 0xa6, 0xe3, 0x58, 0x4b, 0xe1, 0xfb, 0x92,
+	0xa0, 0xf1, 0xe5, 0x83, 0xcf, 0xe5, 0xdb, 0x47, 0xf8, 0x6c, 0xd6, 0x3b, 0x23, 0x5d, 0x53, 0x87,
+	0x89, 0x74, 0xbd, 0xad, 0xb4, 0xc7, 0xba, 0x2e, 0x8f, 0x41, 0x9e, 0x34, 0x55, 0xd9, 0x31, 0x2d,
+	0x1f, 0xbb, 0xcb, 0x08, 0x26, 0xc2, 0x16, 0x59, 0x99, 0x8a, 0x61, 0xa2, 0xbd, 0x93, 0xe8, 0x15,
+	0x80, 0x00, 0x4e, 0xa8, 0xb0, 0x05, 0xe9, 0xe4, 0x75, 0x1c, 0x2b, 0x60, 0x24, 0x26, 0xab, 0x08,
+	0x11, 0xf5, 0x5f, 0x53, 0x70, 0x5c, 0x0a, 0x39, 0x00, 0xd5, 0x50, 0x19, 0xb0, 0x30, 0x3b, 0xc2,
+	0x86, 0x6f, 0xc1, 0x68, 0xcb, 0xd6, 0x6b, 0x35, 0x17, 0xd7, 0x74, 0x9f, 0x46, 0x7c, 0xb7, 0x45,
+	0x70, 0xc4, 0x0c, 0xf3, 0x48, 0xef, 0xb4, 0x18, 0x1e, 0x5a, 0x06, 0x88, 0x50, 0xc9, 0xf4, 0x4d,
+	0x25, 0x82, 0x85, 0x54, 0x18, 0x0d, 0xee, 0x01, 0x6d, 0xdf, 0xe3, 0xf6, 0x40, 0xac, 0x4c, 0xfd,
+	0x42, 0x06, 0x0a, 0x6b, 0x7e, 0x7d, 0x61, 0x55, 0xf7, 0x75, 0x6e, 0x0c, 0x61, 0x28, 0xee, 0x3a,
+	0xf4, 0x66, 0xa4, 0x89, 0x5d, 0xd3, 0x31, 0x2a, 0x2c, 0x06, 0xea, 0xc0, 0x92, 0x9f, 0x66, 0xd4,
+	0x36, 0x29, 0xb1, 0x2d, 0x42, 0x8b, 0x14, 0x23, 0x1b, 0x4e, 0x51, 0x1f, 0x8d, 0xb4, 0xad, 0x83,
+	0xec, 0xb7, 0xc7, 0x09, 0xc9, 0x57, 0x13, 0xdb, 0x7b, 0x06, 0x72, 0xd8, 0xaf, 0x2f, 0x54, 0xe8,
+	0x22, 0x66, 0x71, 0x85, 0x67, 0x24, 0x02, 0x15, 0x02, 0xd1, 0xb2, 0x98, 0xff, 0x47, 0x8e, 0xbf,
+	0x0c, 0x9b, 0x9f, 0x81, 0xd9, 0xdc, 0x11, 0x67, 0x25, 0x02, 0xc5, 0x2a, 0xd8, 0x2c, 0xb8, 0x04,
+	0x13, 0x4d, 0x6c, 0x1b, 0xa4, 0x5f, 0x1c, 0x41, 0x48, 0x7f, 0x9c, 0x97, 0x73, 0x70, 0x8f, 0xd8,
+	0x60, 0xbb, 0x8e, 0x8f, 0x3d, 0x11, 0x2f, 0x42, 0x3f, 0xd0, 0x75, 0xc8, 0x90, 0x7f, 0x8a, 0x23,
+	0xfd, 0xf1, 0x49, 0x81, 0xc9, 0x76, 0x4b, 0xfe, 0x56, 0xbc, 0x56, 0x93, 0x68, 0x2c, 0x7e, 0xa1,
+	0x95, 0x27, 0x65, 0x5b, 0xac, 0x88, 0x30, 0xe6, 0xe2, 0x37, 0x5a, 0xa6, 0x8b, 0x8d, 0x00, 0x2c,
+	0xc7, 0x18, 0x13, 0xe5, 0x1c, 0x54, 0xfd, 0x46, 0x0a, 0x26, 0x82, 0x4e, 0x55, 0xad, 0x96, 0xf7,
+	0x7e, 0xc5, 0x8d, 0x4d, 0x89, 0x53, 0x36, 0x3b, 0xc0, 0x25, 0x9e, 0x96, 0xfb, 0x09, 0xf7, 0xba,
+	0x0d, 0x33, 0x81, 0xe7, 0xd5, 0xaa, 0x54, 0x5d, 0x6c, 0x60, 0xdb, 0x37, 0x75, 0xcb, 0x93, 0xbf,
+	0xaa, 0x99, 0x0e, 0x11, 0x56, 0x42, 0x78, 0x62, 0x9a, 0xea, 0x8d, 0xc8, 0x5b, 0x1a, 0xfe, 0x45,
+	0x82, 0x4f, 0x4f, 0x6f, 0x99, 0x8d, 0x96, 0xa5, 0xfb, 0xcc, 0xb1, 0x7b, 0xd7, 0xd5, 0x6d, 0xf6,
+	0x40, 0x40, 0xec, 0x08, 0x8b, 0x00, 0x64, 0xa9, 0xe2, 0xee, 0xd1, 0x58, 0xb7, 0x8f, 0x68, 0x39,
+	0x0a, 0x46, 0x05, 0x20, 0x76, 0x91, 0xd4, 0xc1, 0x77, 0x91, 0xe5, 0x02, 0x8c, 0xb2, 0x76, 0xb9,
+	0x3e, 0xff, 0x41, 0x0e, 0x8e, 0xb7, 0xb1, 0xc8, 0x39, 0x1f, 0xcc, 0x30, 0x07, 0x47, 0x80, 0xd4,
+	0x21, 0x8e, 0x00, 0x3d, 0xe3, 0xe0, 0xd3, 0xef, 0x49, 0x1c, 0x7c, 0xe6, 0xdd, 0x8c, 0x83, 0x1f,
+	0x7a, 0x0f, 0xe2, 0xe0, 0x87, 0xdf, 0xdb, 0x38, 0xf8, 0x91, 0xf7, 0x24, 0x0e, 0x3e, 0x7b, 0xd8,
+	0x38, 0x78, 0x74, 0x1d, 0xa6, 0x39, 0xff, 0x55, 0x76, 0x3b, 0x25, 0x3c, 0x39, 0x39, 0x6a, 0x14,
+	0x4e, 0xc5, 0x2a, 0x59, 0x9c, 0xbc, 0x81, 0x16, 0x82, 0x71, 0x8c, 0xe3, 0x00, 0xc5, 0x39, 0x1a,
+	0xad, 0x13, 0x28, 0xb7, 0x20, 0xd7, 0xc4, 0xb6, 0x6e, 0xf9, 0x26, 0xf6, 0x8a, 0x79, 0xba, 0x95,
+	0xcf, 0xf6, 0xbe, 0x0c, 0xa6, 0x18, 0x7b, 0x5a, 0x88, 0x4a, 0x7c, 0x5a, 0xec, 0x86, 0x37, 0xa4,
+	0x36, 0xca, 0x7c, 0x5a, 0xb4, 0x78, 0x33, 0x00, 0xc4, 0x80, 0xf0, 0xeb, 0xec, 0xfc, 0x13, 0x79,
+	0xe4, 0x32, 0x76, 0xa8, 0x0b, 0xf3, 0x49, 0x4e, 0x31, 0x28, 0xf6, 0xd0, 0x1a, 0x4c, 0xd1, 0x1d,
+	0x9c, 0x2e, 0xd6, 0xe0, 0xe4, 0xe3, 0x15, 0x0b, 0x72, 0xdb, 0x1d, 0x11, 0x04, 0xba, 0xc6, 0xc5,
+	0x61, 0xc6, 0xeb, 0x8c, 0xad, 0xa0, 0xaa, 0x71, 0xbc, 0xaf, 0xd8, 0x0a, 0x1a, 0x37, 0xf0, 0x00,
+	0x26, 0xda, 0xc5, 0x36, 0x60, 0xd7, 0x6c, 0xa8, 0xf0, 0x53, 0x31, 0x85, 0xff, 0x6f, 0x0a, 0x9c,
+	0xed, 0xf4, 0x45, 0x90, 0xbb, 0x33, 0xec, 0x3e, 0xba, 0xde, 0x88, 0x78, 0xcc, 0x43, 0xba, 0x6b,
+	0xcc, 0x43, 0xa6, 0x3d, 0xe6, 0xe1, 0xd3, 0xe4, 0x41, 0x72, 0x52, 0x77, 0xd1, 0x2d, 0x18, 0xa9,
+	0xb3, 0x7f, 0xf9, 0x59, 0xe0, 0x6a, 0x7f, 0xee, 0x0c, 0x86, 0xaf, 0x09, 0xe4, 0x7e, 0x03, 0x1e,
+	0xd4, 0xb7, 0x14, 0x98, 0x4a, 0xa2, 0x14, 0xf8, 0x2e, 0x94, 0xae, 0xbe, 0x0b, 0xf4, 0x1c, 0x0c,
+	0xb3, 0x26, 0xf9, 0x13, 0x95, 0x59, 0x89, 0x2a, 0x59, 0xa6, 0xbc, 0x47, 0x59, 0xe5, 0x78, 0xe8,
+	0x65, 0x18, 0xad, 0x92, 0x9b, 0x25, 0xb7, 0x41, 0xd7, 0x3b, 0xdf, 0x8e, 0xae, 0x48, 0x8f, 0x40,
+	0xba, 0x6d, 0x38, 0xae, 0xbe, 0x12, 0x41, 0xd1, 0x62, 0x04, 0xd4, 0xef, 0xa5, 0xe0, 0x68, 0x02,
+	0xd4, 0xfb, 0x62, 0x76, 0xdd, 0x20, 0xa7, 0x07, 0xca, 0x0a, 0x0b, 0x76, 0x92, 0xfa, 0x41, 0xf2,
+	0x1c, 0x8c, 0xc6, 0x39, 0x3d, 0x1f, 0x5c, 0x49, 0x64, 0xa8, 0x33, 0x63, 0x71, 0x1f, 0xc2, 0x98,
+	0x8f, 0x5f, 0x4f, 0xa8, 0xd7, 0x60, 0x98, 0x95, 0xa0, 0x3c, 0x8c, 0x6c, 0xae, 0xbd, 0xb4, 0xba,
+	0xf1, 0xd2, 0xfa, 0xc4, 0x11, 0xe2, 0xc2, 0x78, 0x75, 0x4d, 0xdb, 0xb8, 0xb5, 0x41, 0x1d, 0x1a,
+	0x79, 0x18, 0xd9, 0x78, 0xe9, 0xd5, 0xa5, 0x17, 0x37, 0x56, 0x27, 0x52, 0xea, 0x5d, 0x38, 0xb9,
+	0x8e, 0x7d, 0x3a, 0x54, 0xcb, 0x7b, 0x9b, 0x21, 0x5b, 0x62, 0x29, 0xb6, 0xf7, 0x49, 0xe9, 0xa7,
+	0x4f, 0xea, 0x97, 0x14, 0xc8, 0x6f, 0xea, 0xc4, 0x36, 0xa6, 0x94, 0xd1, 0x12, 0x0c, 0x51, 0x31,
+	0x15, 0x95, 0xf6, 0xf1, 0x96, 0xcd, 0x1b, 0x72, 0xed, 0xa6, 0x9b, 0x36, 0x76, 0x35, 0x86, 0xd9,
+	0x31, 0x73, 0x52, 0x87, 0x9d, 0x39, 0x18, 0x4e, 0x6f, 0x46, 0xf4, 0xe2, 0x8a, 0x63, 0x7b, 0xa6,
+	0xe7, 0x63, 0xbb, 0x3a, 0xd8, 0xd0, 0xcd, 0x9f, 0x4a, 0xc1, 0x31, 0x49, 0x3b, 0x03, 0x69, 0x80,
+	0xbc, 0xc9, 0x30, 0xcc, 0x1a, 0xf6, 0xba, 0xcc, 0x51, 0x0e, 0x40, 0xce, 0x05, 0x4d, 0x8c, 0x5d,
+	0x4f, 0x9c, 0x0b, 0xe8, 0x07, 0xba, 0x00, 0x85, 0x86, 0xee, 0x57, 0xeb, 0xec, 0x4c, 0x89, 0x5d,
+	0x36, 0x11, 0x33, 0xda, 0x98, 0x28, 0xdd, 0xa4, 0x60, 0x53, 0x30, 0xe4, 0x55, 0x1d, 0x97, 0xf9,
+	0xdc, 0x14, 0x8d, 0x7d, 0x90, 0x1d, 0xd6, 0x30, 0x77, 0xb1, 0x5b, 0x23, 0xb6, 0x0d, 0xc3, 0x1e,
+	0xa6, 0xd7, 0x97, 0x85, 0xa0, 0x98, 0xa2, 0x93, 0x27, 0x74, 0x33, 0x81, 0x27, 0x20, 0x1e, 0xe2,
+	0x9c, 0xe0, 0x62, 0x50, 0x06, 0xea, 0x62, 0x28, 0x41, 0x56, 0xb8, 0x2c, 0xc5, 0x1b, 0x34, 0xf1,
+	0x4d, 0x9c, 0x54, 0x1e, 0xe6, 0xa1, 0x6a, 0x19, 0xfa, 0xaa, 0xdc, 0x26, 0xf0, 0x26, 0x39, 0xc0,
+	0x19, 0xc1, 0x65, 0x41, 0xf0, 0x4d, 0xe0, 0x69, 0x20, 0x30, 0x93, 0x02, 0xfd, 0x5f, 0xfd, 0x6c,
+	0x0a, 0x4a, 0x44, 0x73, 0x48, 0xfa, 0x77, 0x78, 0x5d, 0xf4, 0x52, 0xcc, 0x6f, 0xc4, 0xa2, 0xd9,
+	0xe7, 0x7b, 0x26, 0x85, 0x88, 0x71, 0x11, 0x75, 0x1a, 0xc5, 0x04, 0x92, 0x96, 0x08, 0x24, 0x23,
+	0x11, 0xc8, 0x90, 0x44, 0x20, 0xc3, 0x11, 0x81, 0xfc, 0x7d, 0x0a, 0x8e, 0x73, 0x2b, 0x95, 0x99,
+	0x2e, 0x31, 0x79, 0x0c, 0x64, 0xda, 0x13, 0x7d, 0xc0, 0x4d, 0xea, 0x03, 0xef, 0xee, 0x79, 0x4e,
+	0x81, 0x7c, 0xa0, 0xdb, 0x30, 0x44, 0x08, 0x89, 0x70, 0x34, 0xa9, 0x1a, 0x96, 0x0f, 0xb4, 0xc6,
+	0x08, 0xc4, 0xa4, 0x9b, 0x91, 0x48, 0x77, 0x48, 0x22, 0xdd, 0x61, 0x89, 0x74, 0x47, 0x22, 0xd2,
+	0xfd, 0xab, 0x21, 0x38, 0x1f, 0xc4, 0x2a, 0x05, 0xe6, 0xd7, 0x92, 0xe7, 0x99, 0x35, 0xbb, 0x81,
+	0xed, 0xf0, 0x56, 0x67, 0xed, 0x30, 0x82, 0xbe, 0x7d, 0x44, 0x88, 0xba, 0x04, 0x23, 0x3c, 0x84,
+	0x82, 0x39, 0x7f, 0x6f, 0x1f, 0xd1, 0x44, 0x01, 0x39, 0x9d, 0x47, 0x76, 0xc9, 0x6c, 0x97, 0xd3,
+	0x79, 0xb8, 0x4f, 0xc6, 0x4f, 0xf4, 0xb9, 0xbe, 0x4e, 0xf4, 0x6d, 0xce, 0xee, 0x74, 0x5f, 0xce,
+	0xee, 0x68, 0xf0, 0x6b, 0xe6, 0x5d, 0x08, 0x7e, 0x1d, 0xea, 0x6a, 0x08, 0x0e, 0xb7, 0x19, 0x82,
+	0x24, 0x78, 0x20, 0xd4, 0x73, 0xf7, 0xb1, 0x59, 0xab, 0xd3, 0x24, 0x11, 0xe4, 0x14, 0x14, 0xba,
+	0x8f, 0x5f, 0x63, 0xe5, 0x24, 0x42, 0x85, 0x4f, 0x82, 0x48, 0xa0, 0x39, 0x8d, 0xdc, 0xf1, 0xf8,
+	0xc9, 0x69, 0x86, 0xd7, 0x07, 0xac, 0xde, 0xa2, 0xb5, 0x1d, 0x77, 0x48, 0xf9, 0x8e, 0x3b, 0x24,
+	0xc2, 0x28, 0x4b, 0x91, 0x42, 0x01, 0x46, 0xd9, 0x45, 0x32, 0x2d, 0xa1, 0xd5, 0xcb, 0x70, 0x2a,
+	0xd9, 0xef, 0x43, 0x4e, 0xcd, 0x3b, 0xe6, 0x03, 0x16, 0x9f, 0xac, 0x9d, 0x48, 0xf4, 0xf5, 0x6c,
+	0x52, 0x10, 0xfa, 0xb6, 0xd7, 0x69, 0x34, 0xf5, 0xaa, 0x5f, 0x2c, 0xb0, 0x1b, 0x03, 0xfe, 0x49,
+	0x1c, 0x2b, 0x34, 0x17, 0x95, 0x70, 0xac, 0x7c, 0x37, 0x03, 0xa7, 0xba, 0x4e, 0x67, 0x74, 0x07,
+	0xf2, 0x7a, 0xf8, 0xd9, 0xc3, 0x88, 0x48, 0x5c, 0x10, 0x51, 0x7c, 0xc9, 0xf1, 0x29, 0xd5, 0xf7,
+	0xf1, 0x09, 0xfd, 0x3f, 0x98, 0x60, 0xe2, 0x6b, 0x98, 0x1e, 0xdd, 0x24, 0xb1, 0xd0, 0x1a, 0xf3,
+	0x3d, 0x4f, 0xa9, 0x74, 0x3e, 0xdd, 0xe1, 0x78, 0xda, 0xb8, 0x19, 0xfd, 0xc4, 0x1e, 0xba, 0x9b,
+	0x34, 0x47, 0x7a, 0x04, 0x5a, 0xac, 0xc4, 0xe7, 0x4e, 0xc2, 0x64, 0xba, 0x0c, 0x93, 0x7a, 0xb3,
+	0x69, 0x11, 0xaf, 0x43, 0xfb, 0xec, 0x1d, 0xe7, 0x15, 0x9b, 0x62, 0x12, 0x6b, 0x30, 0xd1, 0x31,
+	0xe1, 0xfa, 0x8d, 0xb2, 0x60, 0x33, 0x50, 0x1b, 0xdf, 0x8d, 0x17, 0xa0, 0x8f, 0xc0, 0xd1, 0xce,
+	0xd4, 0x20, 0x2c, 0x41, 0x4a, 0x97, 0x0c, 0x53, 0x2b, 0xed, 0x39, 0x43, 0x34, 0xd4, 0x91, 0x46,
+	0xc4, 0x53, 0x7f, 0x2d, 0x05, 0x33, 0xc9, 0xd2, 0x3d, 0xc0, 0x23, 0xbc, 0x0a, 0x50, 0xaf, 0x2e,
+	0xf6, 0x88, 0x27, 0x60, 0x10, 0xcf, 0xf1, 0x0a, 0x01, 0x39, 0xfa, 0x8d, 0x3e, 0x0c, 0x40, 0x1f,
+	0x53, 0x0c, 0x22, 0x8c, 0x33, 0x47, 0x28, 0x6d, 0x04, 0xd9, 0xb0, 0x6c, 0xfa, 0xe8, 0xb3, 0x98,
+	0xe1, 0xd9, 0xb0, 0x68, 0x40, 0x2a, 0x91, 0xce, 0x78, 0xdb, 0xfc, 0xf8, 0x9f, 0x70, 0x29, 0x74,
+	0x13, 0x8e, 0x31, 0xc7, 0x4d, 0x67, 0xb4, 0x15, 0xb3, 0x57, 0xa6, 0x69, 0xf5, 0x5a, 0x5b, 0xc8,
+	0x15, 0x79, 0xe2, 0x15, 0xc9, 0x5a, 0xc7, 0x17, 0x10, 0x15, 0x89, 0xa2, 0x4d, 0x46, 0x6a, 0x98,
+	0x24, 0xd4, 0x3f, 0x49, 0x47, 0x42, 0xd4, 0xf8, 0x5c, 0xad, 0x44, 0xe3, 0xa0, 0x06, 0xe1, 0x11,
+	0x29, 0xec, 0xc6, 0xbe, 0x93, 0x63, 0xc8, 0x52, 0xc9, 0x31, 0x64, 0xef, 0x7d, 0x30, 0xf8, 0xff,
+	0x85, 0x89, 0x68, 0x83, 0x07, 0x0f, 0x07, 0x1f, 0x8f, 0x34, 0x22, 0x32, 0x0d, 0x90, 0xa0, 0xfb,
+	0xc3, 0x04, 0x82, 0xe7, 0x08, 0x01, 0xfa, 0xaf, 0xfa, 0x2d, 0x05, 0x66, 0x03, 0x41, 0x7b, 0xcb,
+	0x7b, 0xaf, 0x25, 0xed, 0x45, 0xc2, 0x10, 0xba, 0x45, 0xae, 0xaf, 0xe9, 0xbf, 0x7c, 0xf3, 0xb8,
+	0x2a, 0xd9, 0x3c, 0x62, 0x8f, 0x69, 0x04, 0xba, 0x26, 0x90, 0x7b, 0x6f, 0x8c, 0xa9, 0x9e, 0x1b,
+	0xa3, 0xfa, 0x6d, 0x05, 0x26, 0x3b, 0x34, 0xdb, 0xbb, 0x3f, 0xeb, 0x48, 0x1e, 0x0e, 0xde, 0x58,
+	0x90, 0x87, 0x43, 0x34, 0x7e, 0x01, 0xc2, 0xf5, 0x17, 0xba, 0xb8, 0x32, 0xda, 0x58, 0x50, 0x4a,
+	0x1f, 0xc4, 0x5c, 0x86, 0xa9, 0x2d, 0xdf, 0x71, 0xf5, 0x1a, 0x5e, 0x77, 0x9d, 0xfb, 0x7e, 0x3d,
+	0x16, 0x30, 0xb0, 0xc7, 0xf6, 0xe5, 0x8c, 0x46, 0xff, 0x57, 0x7f, 0x7b, 0x08, 0xa6, 0x63, 0xc0,
+	0x6b, 0x3c, 0xdc, 0x3e, 0x29, 0xce, 0x50, 0x49, 0x8c, 0x33, 0xc4, 0x50, 0x0c, 0xe3, 0x8c, 0x75,
+	0xb7, 0x5a, 0x37, 0x77, 0xc9, 0xfe, 0x45, 0x5d, 0xd9, 0x07, 0xb1, 0xf6, 0xa7, 0x45, 0xc0, 0xf1,
+	0x12, 0xa7, 0xb5, 0x49, 0x48, 0x91, 0x80, 0xde, 0x2a, 0x79, 0x83, 0xcf, 0x4c, 0x52, 0xcf, 0x77,
+	0x5c, 0xd6, 0xfd, 0x2c, 0x51, 0x4a, 0x96, 0x41, 0x13, 0x34, 0x91, 0x9e, 0xb4, 0x71, 0x6e, 0x60,
+	0xa3, 0xd5, 0xe4, 0xca, 0x36, 0xe4, 0x7c, 0x95, 0x94, 0x92, 0xab, 0xcf, 0xe0, 0x6c, 0x62, 0xbe,
+	0x89, 0x2b, 0xdb, 0x7b, 0x3e, 0x16, 0xd7, 0x99, 0x13, 0xe2, 0xcc, 0x61, 0xbe, 0x89, 0x97, 0x49,
+	0x39, 0x61, 0x80, 0xb7, 0x1d, 0xc2, 0xf2, 0x88, 0x62, 0x5a, 0x1e, 0x42, 0x3e, 0x05, 0xc7, 0x03,
+	0x39, 0x74, 0xa0, 0xf0, 0x47, 0x7c, 0x02, 0x60, 0x2b, 0x8e, 0x3a, 0x0b, 0x13, 0xfc, 0x11, 0x70,
+	0x88, 0xc1, 0x6e, 0x3b, 0x0b, 0xb4, 0x3c, 0x84, 0x54, 0x61, 0x8c, 0x56, 0x53, 0xb1, 0x1b, 0xfa,
+	0x1e, 0xbf, 0xed, 0xcc, 0xd3, 0xc2, 0x4d, 0xec, 0xae, 0xea, 0x7b, 0xe8, 0x26, 0x14, 0xb9, 0xcc,
+	0x1c, 0x17, 0x57, 0xe2, 0xe0, 0x2c, 0xe1, 0xd7, 0x14, 0x93, 0x9d, 0xe3, 0xe2, 0xe5, 0x08, 0x9e,
+	0x98, 0x29, 0xf9, 0x70, 0xa6, 0x90, 0x57, 0x8f, 0x4d, 0xd7, 0xe1, 0xce, 0xf7, 0x08, 0x77, 0xcc,
+	0x51, 0x8f, 0x82, 0xba, 0x90, 0xc3, 0xdb, 0x70, 0x2e, 0xc4, 0x88, 0xf0, 0x51, 0xa3, 0x13, 0x8d,
+	0xa3, 0x8f, 0x51, 0xf4, 0x53, 0x01, 0xe0, 0x8a, 0xe0, 0x87, 0x4d, 0x47, 0x4a, 0x89, 0x38, 0x67,
+	0x4e, 0x72, 0x4f, 0x11, 0xf3, 0x56, 0xea, 0x5e, 0x7d, 0xc0, 0x6e, 0xc4, 0x0b, 0x90, 0xa1, 0x8e,
+	0x33, 0x79, 0x58, 0x58, 0x3d, 0xee, 0x05, 0x4c, 0x1f, 0xd6, 0x0b, 0xd8, 0xe6, 0xb9, 0xcc, 0xf4,
+	0xf6, 0x5c, 0xaa, 0x9f, 0x84, 0xb3, 0x9c, 0x60, 0xbb, 0x34, 0xc2, 0x57, 0xc6, 0x1f, 0xa1, 0x59,
+	0x58, 0x82, 0x46, 0x85, 0xcb, 0xfa, 0x46, 0x0f, 0x46, 0x13, 0xe5, 0xaa, 0xc5, 0x49, 0xa9, 0x9f,
+	0x55, 0xe0, 0x5c, 0x17, 0x06, 0x78, 0xc0, 0xd3, 0x0c, 0x91, 0x91, 0xe3, 0x62, 0x11, 0x73, 0xca,
+	0xbf, 0xd0, 0x2b, 0x30, 0xd6, 0xb2, 0xef, 0xd9, 0xce, 0x7d, 0xbb, 0xc2, 0x4e, 0xf0, 0x2c, 0xdf,
+	0xea, 0xfe, 0x46, 0x6b, 0x94, 0x93, 0x20, 0x1f, 0x9e, 0xfa, 0xb5, 0x14, 0x4c, 0x09, 0xaf, 0x1d,
+	0x91, 0xae, 0xf7, 0xbf, 0x79, 0x1d, 0xba, 0x07, 0xfb, 0xff, 0x62, 0x24, 0x2a, 0x91, 0x0a, 0x6c,
+	0xc0, 0xf7, 0x49, 0x8f, 0xc3, 0x38, 0x3b, 0x86, 0xe9, 0x56, 0xc5, 0x68, 0xd1, 0x9b, 0x3c, 0xfe,
+	0x3e, 0x5b, 0x14, 0xaf, 0xb6, 0xc4, 0x95, 0x1f, 0x2b, 0x21, 0xe9, 0x06, 0xc8, 0x24, 0x12, 0xde,
+	0xce, 0x82, 0x28, 0xa6, 0x53, 0xcb, 0x23, 0x81, 0x1d, 0x0d, 0xd3, 0xf3, 0x82, 0x88, 0x47, 0xdd,
+	0x12, 0x8e, 0xcf, 0x71, 0x56, 0xbe, 0x29, 0x8a, 0x89, 0x35, 0xaa, 0xef, 0x62, 0xb2, 0x97, 0x55,
+	0x4c, 0x11, 0xd8, 0x41, 0x92, 0xd6, 0xe9, 0x7b, 0xdc, 0x0d, 0x38, 0xcd, 0xab, 0x83, 0xb0, 0x8f,
+	0x55, 0x52, 0xa9, 0xfe, 0x92, 0x02, 0xc5, 0xad, 0xd6, 0xb6, 0x8d, 0xfd, 0x04, 0xe7, 0xcc, 0x40,
+	0xbc, 0x60, 0x6d, 0x6e, 0x91, 0x54, 0x7f, 0x31, 0x80, 0xff, 0x9e, 0x82, 0x89, 0x76, 0xbe, 0x0e,
+	0x76, 0x58, 0x6a, 0xb7, 0x59, 0x52, 0x03, 0xb5, 0x59, 0x5e, 0x89, 0x26, 0x82, 0x3d, 0x68, 0x76,
+	0x9c, 0x30, 0x47, 0xac, 0xe4, 0xe4, 0x92, 0x19, 0xe8, 0xc9, 0xe5, 0x04, 0x49, 0x71, 0x40, 0x44,
+	0x4b, 0x62, 0xe3, 0xb9, 0xaf, 0x94, 0x15, 0x6c, 0x18, 0xea, 0xef, 0x28, 0x30, 0xd9, 0x31, 0x21,
+	0x06, 0x33, 0x13, 0x9e, 0x8f, 0xfb, 0x48, 0x52, 0xdd, 0x2f, 0xcd, 0xdb, 0x99, 0x88, 0x39, 0x48,
+	0xd4, 0xbf, 0xce, 0xc0, 0xf9, 0x98, 0x25, 0x1c, 0x9d, 0xbe, 0x34, 0x9f, 0xe3, 0x23, 0xfe, 0x50,
+	0xe2, 0x51, 0xf1, 0x16, 0xb6, 0xbb, 0xe2, 0x86, 0x7a, 0xb9, 0xe2, 0x86, 0xdb, 0x5d, 0x71, 0x03,
+	0xf3, 0x19, 0x66, 0xbb, 0xfa, 0x0c, 0x7b, 0x1e, 0x6c, 0x72, 0xfb, 0xf2, 0xf8, 0x41, 0xcc, 0xe3,
+	0x47, 0x42, 0x26, 0x8f, 0x4b, 0xe7, 0x12, 0x5a, 0x81, 0x61, 0x3a, 0xe6, 0xc2, 0xa2, 0xd8, 0x97,
+	0x63, 0x8f, 0xa3, 0x26, 0xba, 0xe4, 0x52, 0x83, 0x71, 0xc9, 0xad, 0xc0, 0xd1, 0x4e, 0x77, 0xa1,
+	0x74, 0x52, 0x11, 0xcb, 0x6a, 0xb2, 0xdd, 0x63, 0x48, 0x3c, 0x60, 0x52, 0xbf, 0xde, 0x5c, 0xd7,
+	0xf7, 0x22, 0x6d, 0xce, 0x1b, 0x2f, 0x61, 0xd8, 0x5f, 0x4b, 0xf0, 0xd8, 0x0d, 0x75, 0x8f, 0x27,
+	0xa0, 0xa4, 0x7b, 0xba, 0xed, 0x3e, 0x9e, 0xec, 0xb6, 0x63, 0xde, 0xc0, 0x72, 0x7f, 0x6c, 0x07,
+	0x8e, 0xba, 0x44, 0xe7, 0xdd, 0x47, 0x60, 0x3a, 0xb1, 0x97, 0xe4, 0x89, 0x97, 0x90, 0x92, 0xb2,
+	0x3f, 0xef, 0xa7, 0xc0, 0x53, 0x5f, 0x83, 0xa9, 0xa4, 0x6e, 0xa2, 0x67, 0x61, 0x98, 0x0b, 0x49,
+	0xd9, 0x9f, 0x5b, 0x93, 0xa3, 0xa9, 0xdb, 0x70, 0x4c, 0xd2, 0x47, 0xb4, 0x0e, 0xb9, 0x50, 0x4e,
+	0xca, 0x7e, 0xdd, 0x9b, 0x21, 0xae, 0xfa, 0x93, 0xd4, 0x00, 0xc5, 0x64, 0x05, 0xb5, 0x98, 0xcb,
+	0x8a, 0x5f, 0xec, 0x17, 0x61, 0x04, 0xdb, 0xfa, 0xb6, 0xc5, 0xad, 0xe0, 0xac, 0x26, 0x3e, 0x51,
+	0x15, 0x66, 0x2c, 0x9d, 0x45, 0xb6, 0x31, 0xb4, 0xc3, 0x65, 0x75, 0x9c, 0x22, 0xc4, 0x36, 0x43,
+	0x5a, 0x6b, 0x22, 0x7d, 0x95, 0xe7, 0xeb, 0x96, 0xc5, 0x2f, 0x0e, 0xb3, 0x9a, 0xf8, 0x44, 0x1a,
+	0x8c, 0xf1, 0x7f, 0x0f, 0x63, 0x50, 0x8e, 0x72, 0x1a, 0xf4, 0x4b, 0xfd, 0x8b, 0x14, 0xcc, 0xb0,
+	0xd7, 0x32, 0xef, 0xf2, 0x1b, 0xbd, 0x8f, 0xc2, 0xa4, 0x8b, 0xbd, 0x56, 0x03, 0x57, 0x0e, 0xf9,
+	0x7c, 0xed, 0xf6, 0x11, 0x6d, 0x9c, 0x51, 0xba, 0x15, 0x10, 0x3f, 0x0d, 0xe0, 0xb5, 0xb6, 0xbd,
+	0xaa, 0x6b, 0x6e, 0x63, 0x97, 0xe7, 0x44, 0x89, 0x94, 0xb4, 0x3d, 0xeb, 0xcb, 0xb4, 0x3d, 0xeb,
+	0x43, 0x4f, 0xc2, 0x90, 0x57, 0xd7, 0x5d, 0x83, 0x07, 0x3e, 0xaa, 0xdd, 0x13, 0x26, 0x11, 0x48,
+	0x8d, 0x21, 0x2c, 0x67, 0x61, 0x98, 0xf1, 0xa2, 0xae, 0x02, 0x84, 0xd5, 0x64, 0x10, 0x1b, 0x8e,
+	0xd1, 0xb2, 0x5a, 0xc2, 0x67, 0x23, 0x3e, 0xc9, 0x3b, 0x39, 0x17, 0x37, 0x74, 0xd3, 0x16, 0x41,
+	0x3e, 0x19, 0x2d, 0x2c, 0x50, 0xbf, 0xa7, 0xc0, 0x4c, 0x30, 0x10, 0x6c, 0x5c, 0xee, 0x60, 0xcf,
+	0xd3, 0x6b, 0x18, 0x3d, 0xdf, 0xee, 0x61, 0x93, 0xaa, 0xdd, 0xe4, 0xf1, 0x24, 0x77, 0x8a, 0x6e,
+	0x30, 0xb4, 0x39, 0xbd, 0x7a, 0xef, 0x70, 0x83, 0x90, 0xd5, 0xab, 0xf7, 0xe8, 0xff, 0xcb, 0x39,
+	0x18, 0x69, 0x30, 0x26, 0xd5, 0xef, 0xa6, 0x21, 0x17, 0x34, 0x3c, 0x18, 0xb3, 0x6b, 0x16, 0x26,
+	0xe8, 0x3f, 0x3c, 0x0c, 0xdf, 0x37, 0x1b, 0xc2, 0x97, 0x5b, 0xa0, 0xe5, 0x34, 0x9a, 0xfe, 0xae,
+	0xd9, 0xc0, 0x24, 0x05, 0x14, 0x7d, 0x32, 0x62, 0xf0, 0x0c, 0x14, 0xfc, 0x58, 0x32, 0x4a, 0x0a,
+	0x45, 0x56, 0x0a, 0xb4, 0x00, 0xb9, 0x30, 0x2a, 0x30, 0x23, 0x37, 0x5b, 0x42, 0x28, 0xc9, 0x6d,
+	0xd6, 0x50, 0xff, 0xb7, 0x59, 0xd7, 0x61, 0x34, 0xf6, 0x8e, 0x7e, 0x58, 0xf2, 0x8e, 0x3e, 0xbf,
+	0x13, 0x79, 0x40, 0xbf, 0x00, 0xf4, 0xb3, 0xc2, 0x43, 0x4f, 0x46, 0x24, 0x38, 0x40, 0x80, 0x56,
+	0x29, 0x0c, 0x99, 0xe6, 0x2e, 0x76, 0xdc, 0x5a, 0x65, 0xc7, 0xd2, 0x6b, 0xdc, 0x1e, 0xc9, 0xd1,
+	0x92, 0x5b, 0x96, 0x5e, 0x43, 0x67, 0x21, 0xdf, 0x74, 0x9d, 0x5d, 0x93, 0x90, 0xd7, 0x2d, 0x1e,
+	0x51, 0x1a, 0x2d, 0x52, 0x7d, 0x38, 0x11, 0x8c, 0xde, 0x52, 0xb5, 0xda, 0xa2, 0xc1, 0xd7, 0x8e,
+	0x3b, 0xd8, 0xdb, 0xee, 0x8e, 0xeb, 0xc9, 0xbf, 0x55, 0x60, 0x2a, 0xa9, 0x59, 0xb4, 0x09, 0xa3,
+	0xba, 0x5d, 0xad, 0x3b, 0xee, 0x61, 0x74, 0x50, 0x9e, 0x91, 0xa0, 0x1f, 0x83, 0x09, 0x01, 0x17,
+	0xb1, 0x7c, 0xe9, 0xee, 0xef, 0x10, 0x2d, 0x38, 0x1a, 0x0d, 0x4b, 0x7a, 0x97, 0x85, 0xf8, 0x8e,
+	0x02, 0xa3, 0xd1, 0xe6, 0x06, 0xb3, 0xf8, 0x96, 0xa3, 0xab, 0xa5, 0x47, 0x8e, 0x47, 0x9a, 0x59,
+	0x91, 0x03, 0xf7, 0x5e, 0x3e, 0xe9, 0xfe, 0x97, 0x4f, 0xdb, 0xbc, 0xcd, 0x74, 0xce, 0xdb, 0x4f,
+	0x29, 0x30, 0x1a, 0x6d, 0x7e, 0x30, 0xc1, 0x89, 0xfb, 0xcb, 0x7c, 0xb0, 0xf8, 0xd6, 0x07, 0x20,
+	0xcf, 0xe2, 0xeb, 0x5e, 0x21, 0x03, 0x83, 0xfe, 0x40, 0x81, 0xa9, 0x68, 0x56, 0x89, 0xe0, 0x67,
+	0x7a, 0xae, 0xf5, 0xff, 0x83, 0x3f, 0x6c, 0xce, 0x94, 0x16, 0xf6, 0x81, 0xc1, 0x5c, 0x79, 0xea,
+	0xb5, 0x4f, 0xfd, 0xf0, 0x1f, 0x3f, 0x97, 0xba, 0x8c, 0x66, 0xcb, 0x09, 0x3f, 0x18, 0x15, 0xfe,
+	0x2c, 0x94, 0x57, 0x16, 0x3f, 0x29, 0x84, 0xbe, 0xa8, 0xc0, 0xe4, 0x3a, 0xf6, 0xdb, 0x7e, 0x28,
+	0x67, 0xae, 0xaf, 0x5f, 0xc6, 0x09, 0x38, 0xbd, 0xd8, 0x1f, 0xb8, 0x3a, 0x47, 0xd9, 0x7b, 0x1c,
+	0x5d, 0x48, 0x64, 0x2f, 0x0c, 0xa4, 0x2a, 0xd3, 0xa3, 0x00, 0xfa, 0x75, 0x05, 0x0a, 0xf1, 0xdf,
+	0x80, 0x91, 0x33, 0x96, 0xf8, 0x5b, 0x31, 0x25, 0xe9, 0x3b, 0xe6, 0xce, 0x5f, 0x6b, 0x51, 0xcb,
+	0x94, 0xb9, 0x4b, 0xe8, 0xf1, 0x5e, 0xcc, 0xf1, 0x5f, 0x28, 0x41, 0x3f, 0xa3, 0xc0, 0x68, 0xf4,
+	0x97, 0x36, 0x90, 0x34, 0x6a, 0x32, 0xe1, 0xf7, 0x38, 0x4a, 0xe7, 0xe4, 0x1b, 0x39, 0x87, 0x54,
+	0x67, 0x29, 0x47, 0x2a, 0x3a, 0x9b, 0xc8, 0x11, 0xbd, 0xa9, 0xf0, 0xca, 0x06, 0x69, 0xf9, 0xe7,
+	0x15, 0x28, 0xac, 0x63, 0x3f, 0x9a, 0x16, 0xbd, 0x47, 0x1a, 0xef, 0x68, 0xa6, 0xf7, 0xd2, 0x63,
+	0x7d, 0xc0, 0xaa, 0x97, 0x28, 0x37, 0x8f, 0xa1, 0x73, 0x89, 0xdc, 0xb0, 0x9f, 0x27, 0x2a, 0xd3,
+	0xa4, 0xea, 0xe8, 0xc7, 0x01, 0xc2, 0x24, 0xd5, 0x48, 0x6a, 0xa9, 0x77, 0x24, 0xb2, 0x2e, 0x9d,
+	0xee, 0x9a, 0x60, 0xda, 0x53, 0x1f, 0xa3, 0x3c, 0x9c, 0x42, 0x27, 0x92, 0x79, 0x60, 0xed, 0xfd,
+	0x1c, 0xd1, 0x0b, 0xd4, 0x1a, 0xda, 0x3f, 0x03, 0x7d, 0x64, 0xb8, 0x56, 0x2f, 0x53, 0x26, 0xce,
+	0x23, 0xb5, 0x0b, 0x13, 0x65, 0x8f, 0x32, 0x70, 0x4d, 0x41, 0x9f, 0x80, 0xdc, 0x3a, 0xf6, 0xb9,
+	0x17, 0xf5, 0xbc, 0xe4, 0x0c, 0xce, 0xaa, 0x05, 0x13, 0x17, 0x7a, 0x40, 0xf1, 0xc5, 0xde, 0x5d,
+	0x18, 0xcc, 0x9b, 0x8b, 0xbe, 0xcf, 0x1f, 0x06, 0xcb, 0x92, 0x03, 0x3f, 0xdd, 0x4d, 0x36, 0xdd,
+	0x93, 0x31, 0x97, 0xca, 0x3d, 0x15, 0x54, 0x1c, 0x4f, 0x7d, 0x92, 0x72, 0xbc, 0x88, 0xae, 0xf5,
+	0x52, 0x4f, 0x22, 0x57, 0x70, 0xb9, 0xce, 0xd9, 0xfc, 0x05, 0x05, 0x8e, 0xb1, 0x31, 0xed, 0x4c,
+	0xe5, 0x3b, 0x33, 0xcf, 0x7e, 0xc0, 0x6e, 0x5e, 0xfc, 0x34, 0xdd, 0xfc, 0x1a, 0xf9, 0x01, 0xbb,
+	0xd2, 0xa5, 0xae, 0x7b, 0x56, 0x94, 0x84, 0xba, 0x40, 0x19, 0xbb, 0x82, 0x2e, 0x25, 0x32, 0x16,
+	0xcb, 0x61, 0x1b, 0x8e, 0xec, 0xe7, 0x15, 0x18, 0x6f, 0xcb, 0x4e, 0x8b, 0xe6, 0xbb, 0xa8, 0x80,
+	0x84, 0x34, 0xb6, 0xa5, 0xbe, 0xd2, 0xb4, 0xaa, 0x57, 0x28, 0x7b, 0x17, 0xd0, 0x63, 0x89, 0xec,
+	0x31, 0x57, 0x4d, 0xd9, 0xe3, 0x2c, 0xfc, 0xa6, 0x02, 0xa8, 0x33, 0xa9, 0x2d, 0x5a, 0xe8, 0x36,
+	0xd0, 0x89, 0x09, 0x70, 0x4b, 0x17, 0xfb, 0x60, 0xce, 0xc4, 0xbd, 0xd4, 0x7a, 0x8c, 0x3d, 0xc2,
+	0xc9, 0xd7, 0x15, 0x38, 0x26, 0xc9, 0xae, 0x89, 0x6e, 0xf6, 0x35, 0x1d, 0x3b, 0xd2, 0x71, 0x96,
+	0xae, 0xf4, 0x9f, 0xd3, 0xd2, 0xeb, 0xa1, 0xe9, 0x23, 0xd3, 0xb0, 0xd9, 0xda, 0x26, 0x8e, 0x4e,
+	0xf4, 0x2d, 0x85, 0x26, 0x77, 0x49, 0xce, 0xed, 0x78, 0xa3, 0x67, 0xd3, 0x09, 0xe9, 0x24, 0x4b,
+	0x73, 0xfb, 0xc2, 0x52, 0x9f, 0xa0, 0x2c, 0x97, 0xd1, 0x5c, 0x2f, 0x96, 0xdf, 0x20, 0x58, 0x65,
+	0x83, 0xf3, 0xf6, 0x45, 0x72, 0x53, 0x42, 0xe7, 0x6b, 0x42, 0x12, 0x3e, 0xd9, 0xba, 0x91, 0xee,
+	0x1c, 0x9d, 0x34, 0xd4, 0xff, 0x43, 0xf9, 0x5a, 0x40, 0xe5, 0xe4, 0x4d, 0x93, 0xc0, 0xd5, 0xb1,
+	0x6e, 0x88, 0x5f, 0x9d, 0xc4, 0x46, 0xb8, 0x7c, 0xbe, 0xcc, 0x2c, 0xa5, 0xce, 0x14, 0x71, 0x52,
+	0x4b, 0x49, 0x96, 0xfc, 0xae, 0x74, 0xa9, 0x6f, 0x8c, 0x1e, 0x16, 0x12, 0xbb, 0xd9, 0x2a, 0xeb,
+	0x51, 0x76, 0x3e, 0x09, 0x13, 0xeb, 0xd8, 0x8f, 0xe7, 0x6f, 0x93, 0x89, 0x4e, 0xfa, 0x8b, 0x82,
+	0x31, 0xf4, 0x1e, 0xeb, 0x99, 0xde, 0xe1, 0xd6, 0xca, 0x3c, 0xb9, 0x99, 0x90, 0x53, 0x67, 0xc6,
+	0xab, 0xeb, 0x5d, 0x74, 0x8d, 0x2c, 0xab, 0x59, 0xa9, 0xf7, 0xef, 0x4e, 0x0a, 0x8c, 0x1e, 0xcb,
+	0x3a, 0x32, 0xe7, 0xe8, 0xaf, 0xc8, 0x10, 0xbd, 0x33, 0xd9, 0x91, 0xfa, 0x49, 0x3e, 0x98, 0xb2,
+	0x2c, 0x51, 0xa5, 0xc7, 0x7a, 0x61, 0x3c, 0xef, 0x6c, 0xab, 0x8b, 0x94, 0xb7, 0xab, 0xea, 0xe3,
+	0x72, 0x95, 0x63, 0xda, 0x3b, 0x4e, 0xb9, 0xc9, 0x71, 0x9e, 0x56, 0x2e, 0xa3, 0x2f, 0x33, 0x53,
+	0xb7, 0x2d, 0xe3, 0xd2, 0xb5, 0x2e, 0x52, 0x4c, 0xcc, 0xe6, 0x24, 0x57, 0x8b, 0x71, 0x70, 0xf5,
+	0x26, 0xe5, 0xf1, 0x1a, 0x9a, 0xef, 0x93, 0xc7, 0x32, 0x8f, 0x33, 0xf8, 0x26, 0xd7, 0x8f, 0x49,
+	0x79, 0x7a, 0xba, 0xea, 0x47, 0x79, 0x22, 0x22, 0xb9, 0x7e, 0x4c, 0xc0, 0x51, 0xaf, 0x53, 0xc6,
+	0xe7, 0xd0, 0x95, 0x6e, 0x6b, 0xa4, 0x2a, 0x10, 0xb9, 0xb1, 0xfe, 0x15, 0x05, 0x8e, 0x26, 0x64,
+	0xe0, 0x41, 0x8b, 0xdd, 0x1d, 0x56, 0x49, 0xe9, 0x7a, 0xe4, 0xcb, 0x28, 0x06, 0xdd, 0x83, 0xcf,
+	0xe0, 0x2c, 0x5a, 0xd6, 0x09, 0x74, 0xa8, 0x78, 0xbe, 0xa1, 0xc0, 0xb1, 0x0f, 0x37, 0x0d, 0xdd,
+	0xc7, 0x1d, 0x19, 0x56, 0xe4, 0xfb, 0x77, 0x72, 0x76, 0x9a, 0xd2, 0x42, 0x57, 0xf8, 0xa4, 0xfc,
+	0x32, 0x3d, 0xa6, 0x6e, 0x64, 0x59, 0xf1, 0xeb, 0x2c, 0x32, 0x75, 0xff, 0x4c, 0x81, 0x63, 0x92,
+	0xf4, 0x32, 0xf2, 0x29, 0xd1, 0x3d, 0x1f, 0xcd, 0x41, 0x58, 0x7f, 0x8a, 0xb2, 0x7e, 0x5d, 0x9d,
+	0xef, 0x93, 0xf5, 0xb2, 0x49, 0x59, 0x20, 0x3d, 0xf8, 0x55, 0x05, 0x8e, 0xb1, 0xfc, 0x35, 0x9d,
+	0x3d, 0x90, 0x69, 0xd3, 0x72, 0xdf, 0x1c, 0x32, 0xca, 0x3d, 0x56, 0x5c, 0x02, 0x7f, 0x98, 0xe2,
+	0x51, 0x15, 0x9b, 0x94, 0x3d, 0x47, 0xae, 0x62, 0xbb, 0xe4, 0xda, 0x29, 0xcd, 0x76, 0xcb, 0x3c,
+	0x13, 0x45, 0x50, 0xe7, 0x29, 0xbf, 0xb3, 0xe8, 0x62, 0xf2, 0x04, 0x76, 0x1c, 0x2b, 0xfa, 0x63,
+	0xd1, 0x1e, 0xfa, 0x09, 0xa6, 0xc1, 0xda, 0xd2, 0xa4, 0xc8, 0xc4, 0x27, 0x37, 0xdf, 0x62, 0xf8,
+	0xea, 0x55, 0xca, 0xc5, 0x45, 0x74, 0x3e, 0x59, 0x4f, 0xf9, 0xf5, 0x05, 0x43, 0xf7, 0x75, 0xa1,
+	0x9d, 0x7e, 0x39, 0xb0, 0xc4, 0xdb, 0x73, 0x72, 0xc8, 0x39, 0x91, 0x4a, 0xa4, 0x9d, 0x44, 0x0f,
+	0x7b, 0x42, 0xa4, 0x30, 0x29, 0x07, 0xe1, 0x22, 0x91, 0x83, 0xd6, 0xf7, 0x08, 0x63, 0xc9, 0x39,
+	0x2f, 0xe4, 0x6b, 0xa4, 0x7b, 0x92, 0x0c, 0xf9, 0x1a, 0x91, 0x66, 0xac, 0xe8, 0xd1, 0x03, 0x6e,
+	0x0c, 0xfb, 0x01, 0x66, 0xd9, 0xe3, 0x1c, 0xa0, 0x3f, 0xe5, 0x3f, 0x46, 0x91, 0xfc, 0xa6, 0xf9,
+	0xc9, 0xfe, 0x15, 0x7f, 0xfc, 0xd5, 0xb7, 0xdc, 0xd2, 0x4c, 0xc4, 0xea, 0x61, 0x69, 0x76, 0x28,
+	0x7f, 0xf1, 0x56, 0xfa, 0xb7, 0x14, 0x98, 0x4e, 0x7c, 0xf1, 0x2a, 0xb7, 0x8f, 0xbb, 0x3d, 0x90,
+	0xed, 0x62, 0x05, 0x84, 0xef, 0x5f, 0x7b, 0xd8, 0x51, 0x9c, 0x57, 0xfe, 0x80, 0x16, 0x7d, 0x47,
+	0x81, 0x12, 0xdd, 0xd3, 0x93, 0x1f, 0x8d, 0xde, 0xec, 0xb5, 0xe7, 0x24, 0xbf, 0x66, 0x2d, 0x95,
+	0xf7, 0x89, 0x27, 0xf4, 0x3f, 0xba, 0xdc, 0x63, 0xd7, 0xaa, 0x46, 0x98, 0xfb, 0x92, 0x42, 0xdf,
+	0x13, 0xcb, 0xdf, 0xfe, 0xc9, 0x56, 0x9e, 0x74, 0x02, 0x4b, 0x49, 0xc9, 0x94, 0x68, 0xf4, 0x58,
+	0x14, 0x85, 0x2f, 0x8b, 0xdf, 0x16, 0x7c, 0x4b, 0x81, 0x73, 0xa4, 0xaf, 0xdd, 0xdf, 0x1c, 0x3d,
+	0xd3, 0xf3, 0x70, 0xd1, 0xe5, 0xe5, 0x5d, 0xe9, 0x89, 0x03, 0x61, 0xf7, 0xd1, 0xa5, 0x48, 0x98,
+	0x4e, 0x78, 0x56, 0x21, 0x96, 0xc2, 0x49, 0xd2, 0xa5, 0xf6, 0xfd, 0x86, 0xbb, 0x35, 0x62, 0xfb,
+	0x83, 0x3c, 0xde, 0x5d, 0x40, 0x27, 0xec, 0x0f, 0xc9, 0x81, 0x18, 0x02, 0x41, 0xe6, 0x96, 0x48,
+	0x72, 0x94, 0xf0, 0x1d, 0x8d, 0x58, 0x0a, 0xa7, 0xd7, 0x71, 0x07, 0xc7, 0x9b, 0xd8, 0xdd, 0x71,
+	0xdc, 0x06, 0x81, 0x45, 0x8b, 0xbd, 0xda, 0x8f, 0x00, 0x0b, 0x9e, 0xaf, 0xef, 0x0b, 0x87, 0x9b,
+	0x0b, 0x37, 0x28, 0xfb, 0xf3, 0xe8, 0xaa, 0x7c, 0x26, 0x85, 0x58, 0x41, 0x0f, 0xfe, 0x5c, 0x81,
+	0x0b, 0x31, 0xf9, 0xc9, 0x5e, 0x21, 0xa0, 0xe7, 0x7a, 0x9e, 0x65, 0x7a, 0x3c, 0x60, 0x28, 0x9d,
+	0xeb, 0xd5, 0x2d, 0x4f, 0xa6, 0xcf, 0x23, 0x9d, 0x48, 0x8e, 0xf0, 0xa1, 0x1a, 0x51, 0x44, 0xe7,
+	0xc7, 0x42, 0xf6, 0xd1, 0x55, 0xb9, 0x49, 0xdc, 0xf9, 0x0c, 0xa0, 0x34, 0xd7, 0x17, 0xb4, 0x68,
+	0x49, 0xe6, 0xa6, 0xb5, 0x1d, 0x03, 0x97, 0x3d, 0x86, 0x51, 0x66, 0x11, 0xdd, 0x44, 0xd2, 0xc7,
+	0xa5, 0xe1, 0xc1, 0xf2, 0x1d, 0xa7, 0x57, 0x48, 0x73, 0xe9, 0xa9, 0x03, 0x60, 0xf2, 0x29, 0xc3,
+	0x4d, 0x7a, 0xb5, 0xed, 0x78, 0xee, 0xb8, 0xd5, 0x3a, 0xf6, 0x7c, 0x97, 0x08, 0xbc, 0x1c, 0x8b,
+	0x71, 0x26, 0xb6, 0xe5, 0x17, 0x14, 0x7a, 0x44, 0x8f, 0xc7, 0xc9, 0x5e, 0xed, 0xa5, 0x97, 0xa3,
+	0xf1, 0xc7, 0xa5, 0x0b, 0x7d, 0x41, 0xcb, 0x0c, 0xb6, 0x60, 0x32, 0x94, 0xc3, 0x1f, 0xe6, 0xa7,
+	0x4c, 0xfc, 0x06, 0x3b, 0xbb, 0x77, 0xc6, 0x26, 0x5e, 0xeb, 0x37, 0x82, 0xd0, 0xeb, 0x79, 0x70,
+	0xef, 0xc0, 0x90, 0xdd, 0x1b, 0x44, 0xa6, 0x2c, 0x8b, 0x9c, 0xa4, 0xde, 0xe1, 0x53, 0x5d, 0x23,
+	0x12, 0xe5, 0xfa, 0xba, 0x9f, 0x40, 0xc6, 0x3e, 0xae, 0xb0, 0xda, 0x31, 0x65, 0xdb, 0xa3, 0x44,
+	0x57, 0xbb, 0x94, 0xc9, 0x9f, 0x55, 0xe0, 0xd8, 0x3a, 0x0e, 0xa3, 0x6a, 0xa2, 0x81, 0x3d, 0xb2,
+	0x9d, 0xb1, 0xcb, 0xfc, 0xe8, 0xa4, 0xd2, 0x75, 0x55, 0x35, 0x63, 0x08, 0xe8, 0x75, 0x18, 0x6f,
+	0x8b, 0xc5, 0x90, 0x9f, 0x2a, 0x93, 0xa3, 0x3e, 0x4a, 0xe7, 0x7a, 0xc2, 0xab, 0x47, 0x66, 0x95,
+	0x6b, 0x0a, 0xfa, 0x36, 0xeb, 0x78, 0xe2, 0x3d, 0xfa, 0xf5, 0x9e, 0x44, 0x3a, 0x2f, 0xfb, 0x4b,
+	0x57, 0xf7, 0x83, 0x24, 0xce, 0x83, 0x68, 0xa1, 0xcb, 0x6a, 0x65, 0x61, 0x1c, 0xd4, 0xd1, 0xa1,
+	0x47, 0xb8, 0xfb, 0x15, 0x05, 0xa6, 0x5f, 0x6a, 0x4f, 0xc1, 0x44, 0xaf, 0xb0, 0xaf, 0xf4, 0x63,
+	0x50, 0xf5, 0xf4, 0x9f, 0x47, 0x81, 0x65, 0x27, 0x9c, 0x18, 0x9f, 0x81, 0xe1, 0xb5, 0x3c, 0xfa,
+	0x97, 0x3f, 0x3a, 0xad, 0xbc, 0xf5, 0xa3, 0xd3, 0xca, 0x3f, 0xfc, 0xe8, 0xb4, 0xb2, 0x3d, 0x4c,
+	0xe7, 0xcd, 0xf5, 0xff, 0x1e, 0x00, 0xda, 0xb6, 0xa0, 0x35, 0x04, 0x88, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BeaconQueryClient is the client API for BeaconQuery service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BeaconQueryClient interface {
+	GetValidatorLiveness(ctx context.Context, in *ValidatorLivenessRequest, opts ...grpc.CallOption) (*ValidatorLivenessResponse, error)
+	GetCommitteeRoots(ctx context.Context, in *CommitteeRootsRequest, opts ...grpc.CallOption) (*CommitteeRoots, error)
+	ExplainShuffle(ctx context.Context, in *ExplainShuffleRequest, opts ...grpc.CallOption) (*ShuffleExplanation, error)
+	GetStateDiff(ctx context.Context, in *GetStateDiffRequest, opts ...grpc.CallOption) (*StateDiff, error)
+	GetRandaoMixes(ctx context.Context, in *RandaoMixesRequest, opts ...grpc.CallOption) (*RandaoMixes, error)
+	ListReorgs(ctx context.Context, in *ListReorgsRequest, opts ...grpc.CallOption) (*Reorgs, error)
+	StreamReorgs(ctx context.Context, in *ListReorgsRequest, opts ...grpc.CallOption) (BeaconQuery_StreamReorgsClient, error)
+	GetDuties(ctx context.Context, in *v1alpha1.DutiesRequest, opts ...grpc.CallOption) (*v1alpha1.DutiesResponse, error)
+	ListValidatorBalanceHistory(ctx context.Context, in *ListValidatorBalanceHistoryRequest, opts ...grpc.CallOption) (*ValidatorBalanceHistory, error)
+	StreamSlotParticipation(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconQuery_StreamSlotParticipationClient, error)
+	GetEpochSummary(ctx context.Context, in *GetEpochSummaryRequest, opts ...grpc.CallOption) (*EpochSummary, error)
+	ListEpochSummaries(ctx context.Context, in *ListEpochSummariesRequest, opts ...grpc.CallOption) (*EpochSummaries, error)
+	ListValidatorPublicKeys(ctx context.Context, in *ListValidatorPublicKeysRequest, opts ...grpc.CallOption) (*ValidatorPublicKeys, error)
+	GetValidatorQueueDetails(ctx context.Context, in *ValidatorQueueDetailsRequest, opts ...grpc.CallOption) (*ValidatorQueueDetails, error)
+	StreamAnnotatedChainHead(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconQuery_StreamAnnotatedChainHeadClient, error)
+	GetBlockAvailability(ctx context.Context, in *BlockAvailabilityRequest, opts ...grpc.CallOption) (*BlockAvailability, error)
+	GetNetworkConfig(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*NetworkConfig, error)
+	GetValidatorSetDelta(ctx context.Context, in *GetValidatorSetDeltaRequest, opts ...grpc.CallOption) (*ValidatorSetDelta, error)
+	PrefetchEpochInfo(ctx context.Context, in *PrefetchEpochInfoRequest, opts ...grpc.CallOption) (*PrefetchJob, error)
+	GetPrefetchStatus(ctx context.Context, in *GetPrefetchStatusRequest, opts ...grpc.CallOption) (*PrefetchStatus, error)
+	ListCanonicalBlockRoots(ctx context.Context, in *ListCanonicalBlockRootsRequest, opts ...grpc.CallOption) (*CanonicalBlockRoots, error)
+	StreamProposerAudit(ctx context.Context, in *StreamProposerAuditRequest, opts ...grpc.CallOption) (BeaconQuery_StreamProposerAuditClient, error)
+	UpdateTrackedValidators(ctx context.Context, in *TrackValidatorsRequest, opts ...grpc.CallOption) (*TrackedValidatorsResponse, error)
+	ImportTrackedValidators(ctx context.Context, in *ImportTrackedValidatorsRequest, opts ...grpc.CallOption) (*TrackedValidatorsResponse, error)
+	ExportTrackedValidators(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*TrackedValidatorsExport, error)
+	ListPoolAttestations(ctx context.Context, in *ListPoolAttestationsRequest, opts ...grpc.CallOption) (*PoolAttestations, error)
+	GetEth1DataStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Eth1DataStatus, error)
+	StreamDepositInclusions(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconQuery_StreamDepositInclusionsClient, error)
+	SimulateEpochTransition(ctx context.Context, in *SimulateEpochTransitionRequest, opts ...grpc.CallOption) (*EpochTransitionSimulation, error)
+	ListCanonicalBlockHeaders(ctx context.Context, in *ListCanonicalBlockHeadersRequest, opts ...grpc.CallOption) (*CanonicalBlockHeaders, error)
+	GetBlockByPandoraHash(ctx context.Context, in *GetBlockByPandoraHashRequest, opts ...grpc.CallOption) (*PairedBlock, error)
+	GetProposerListConsistency(ctx context.Context, in *ProposerListConsistencyRequest, opts ...grpc.CallOption) (*ProposerListConsistency, error)
+	GetCurrentEpochParticipation(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CurrentEpochParticipation, error)
+	ListAnnotatedValidatorAssignments(ctx context.Context, in *AnnotatedValidatorAssignmentsRequest, opts ...grpc.CallOption) (*AnnotatedValidatorAssignments, error)
+	ListTrackedValidatorBalances(ctx context.Context, in *v1alpha1.ListValidatorBalancesRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorBalances, error)
+	GetTrackedValidatorPerformance(ctx context.Context, in *v1alpha1.ValidatorPerformanceRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorPerformanceResponse, error)
+	ListValidatorsByWithdrawalCredentials(ctx context.Context, in *ValidatorsByWithdrawalCredentialsRequest, opts ...grpc.CallOption) (*v1alpha1.Validators, error)
+	EstimateStorageGrowth(ctx context.Context, in *StorageGrowthRequest, opts ...grpc.CallOption) (*StorageGrowthEstimate, error)
+	ConfirmPandoraBlockHashes(ctx context.Context, in *ConfirmPandoraBlockHashesRequest, opts ...grpc.CallOption) (*ConfirmPandoraBlockHashesResponse, error)
+	GetProposerStats(ctx context.Context, in *ProposerStatsRequest, opts ...grpc.CallOption) (*ProposerStats, error)
+	GetSubnetAssignments(ctx context.Context, in *SubnetAssignmentsRequest, opts ...grpc.CallOption) (*SubnetAssignments, error)
+	ListValidatorAssignmentsRange(ctx context.Context, in *ListValidatorAssignmentsRangeRequest, opts ...grpc.CallOption) (*ValidatorAssignmentsRange, error)
+	GetPrecomputationStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PrecomputationStatus, error)
+	StreamEpochInfo(ctx context.Context, opts ...grpc.CallOption) (BeaconQuery_StreamEpochInfoClient, error)
+	GetEpochInfoAccumulator(ctx context.Context, in *EpochInfoAccumulatorRequest, opts ...grpc.CallOption) (*EpochInfoAccumulator, error)
+	NextEpochProposerList(ctx context.Context, in *ProposerListRequest, opts ...grpc.CallOption) (*ProposerList, error)
+}
+
+type beaconQueryClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBeaconQueryClient(cc *grpc.ClientConn) BeaconQueryClient {
+	return &beaconQueryClient{cc}
+}
+
+func (c *beaconQueryClient) GetValidatorLiveness(ctx context.Context, in *ValidatorLivenessRequest, opts ...grpc.CallOption) (*ValidatorLivenessResponse, error) {
+	out := new(ValidatorLivenessResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetValidatorLiveness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetCommitteeRoots(ctx context.Context, in *CommitteeRootsRequest, opts ...grpc.CallOption) (*CommitteeRoots, error) {
+	out := new(CommitteeRoots)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetCommitteeRoots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ExplainShuffle(ctx context.Context, in *ExplainShuffleRequest, opts ...grpc.CallOption) (*ShuffleExplanation, error) {
+	out := new(ShuffleExplanation)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ExplainShuffle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetStateDiff(ctx context.Context, in *GetStateDiffRequest, opts ...grpc.CallOption) (*StateDiff, error) {
+	out := new(StateDiff)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetStateDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetRandaoMixes(ctx context.Context, in *RandaoMixesRequest, opts ...grpc.CallOption) (*RandaoMixes, error) {
+	out := new(RandaoMixes)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetRandaoMixes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ListReorgs(ctx context.Context, in *ListReorgsRequest, opts ...grpc.CallOption) (*Reorgs, error) {
+	out := new(Reorgs)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ListReorgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) StreamReorgs(ctx context.Context, in *ListReorgsRequest, opts ...grpc.CallOption) (BeaconQuery_StreamReorgsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconQuery_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.BeaconQuery/StreamReorgs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconQueryStreamReorgsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconQuery_StreamReorgsClient interface {
+	Recv() (*ReorgEvent, error)
+	grpc.ClientStream
+}
+
+type beaconQueryStreamReorgsClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconQueryStreamReorgsClient) Recv() (*ReorgEvent, error) {
+	m := new(ReorgEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconQueryClient) GetDuties(ctx context.Context, in *v1alpha1.DutiesRequest, opts ...grpc.CallOption) (*v1alpha1.DutiesResponse, error) {
+	out := new(v1alpha1.DutiesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetDuties", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ListValidatorBalanceHistory(ctx context.Context, in *ListValidatorBalanceHistoryRequest, opts ...grpc.CallOption) (*ValidatorBalanceHistory, error) {
+	out := new(ValidatorBalanceHistory)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ListValidatorBalanceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) StreamSlotParticipation(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconQuery_StreamSlotParticipationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconQuery_serviceDesc.Streams[1], "/ethereum.beacon.rpc.v1.BeaconQuery/StreamSlotParticipation", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconQueryStreamSlotParticipationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconQuery_StreamSlotParticipationClient interface {
+	Recv() (*SlotParticipation, error)
+	grpc.ClientStream
+}
+
+type beaconQueryStreamSlotParticipationClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconQueryStreamSlotParticipationClient) Recv() (*SlotParticipation, error) {
+	m := new(SlotParticipation)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconQueryClient) GetEpochSummary(ctx context.Context, in *GetEpochSummaryRequest, opts ...grpc.CallOption) (*EpochSummary, error) {
+	out := new(EpochSummary)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetEpochSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ListEpochSummaries(ctx context.Context, in *ListEpochSummariesRequest, opts ...grpc.CallOption) (*EpochSummaries, error) {
+	out := new(EpochSummaries)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ListEpochSummaries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ListValidatorPublicKeys(ctx context.Context, in *ListValidatorPublicKeysRequest, opts ...grpc.CallOption) (*ValidatorPublicKeys, error) {
+	out := new(ValidatorPublicKeys)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ListValidatorPublicKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetValidatorQueueDetails(ctx context.Context, in *ValidatorQueueDetailsRequest, opts ...grpc.CallOption) (*ValidatorQueueDetails, error) {
+	out := new(ValidatorQueueDetails)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetValidatorQueueDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) StreamAnnotatedChainHead(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconQuery_StreamAnnotatedChainHeadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconQuery_serviceDesc.Streams[2], "/ethereum.beacon.rpc.v1.BeaconQuery/StreamAnnotatedChainHead", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconQueryStreamAnnotatedChainHeadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconQuery_StreamAnnotatedChainHeadClient interface {
+	Recv() (*AnnotatedChainHead, error)
+	grpc.ClientStream
+}
+
+type beaconQueryStreamAnnotatedChainHeadClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconQueryStreamAnnotatedChainHeadClient) Recv() (*AnnotatedChainHead, error) {
+	m := new(AnnotatedChainHead)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconQueryClient) GetBlockAvailability(ctx context.Context, in *BlockAvailabilityRequest, opts ...grpc.CallOption) (*BlockAvailability, error) {
+	out := new(BlockAvailability)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetBlockAvailability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetNetworkConfig(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*NetworkConfig, error) {
+	out := new(NetworkConfig)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetNetworkConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetValidatorSetDelta(ctx context.Context, in *GetValidatorSetDeltaRequest, opts ...grpc.CallOption) (*ValidatorSetDelta, error) {
+	out := new(ValidatorSetDelta)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetValidatorSetDelta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) PrefetchEpochInfo(ctx context.Context, in *PrefetchEpochInfoRequest, opts ...grpc.CallOption) (*PrefetchJob, error) {
+	out := new(PrefetchJob)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/PrefetchEpochInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetPrefetchStatus(ctx context.Context, in *GetPrefetchStatusRequest, opts ...grpc.CallOption) (*PrefetchStatus, error) {
+	out := new(PrefetchStatus)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetPrefetchStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ListCanonicalBlockRoots(ctx context.Context, in *ListCanonicalBlockRootsRequest, opts ...grpc.CallOption) (*CanonicalBlockRoots, error) {
+	out := new(CanonicalBlockRoots)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ListCanonicalBlockRoots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) StreamProposerAudit(ctx context.Context, in *StreamProposerAuditRequest, opts ...grpc.CallOption) (BeaconQuery_StreamProposerAuditClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconQuery_serviceDesc.Streams[3], "/ethereum.beacon.rpc.v1.BeaconQuery/StreamProposerAudit", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconQueryStreamProposerAuditClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconQuery_StreamProposerAuditClient interface {
+	Recv() (*ProposerAudit, error)
+	grpc.ClientStream
+}
+
+type beaconQueryStreamProposerAuditClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconQueryStreamProposerAuditClient) Recv() (*ProposerAudit, error) {
+	m := new(ProposerAudit)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconQueryClient) UpdateTrackedValidators(ctx context.Context, in *TrackValidatorsRequest, opts ...grpc.CallOption) (*TrackedValidatorsResponse, error) {
+	out := new(TrackedValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/UpdateTrackedValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ImportTrackedValidators(ctx context.Context, in *ImportTrackedValidatorsRequest, opts ...grpc.CallOption) (*TrackedValidatorsResponse, error) {
+	out := new(TrackedValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ImportTrackedValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ExportTrackedValidators(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*TrackedValidatorsExport, error) {
+	out := new(TrackedValidatorsExport)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ExportTrackedValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ListPoolAttestations(ctx context.Context, in *ListPoolAttestationsRequest, opts ...grpc.CallOption) (*PoolAttestations, error) {
+	out := new(PoolAttestations)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ListPoolAttestations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetEth1DataStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Eth1DataStatus, error) {
+	out := new(Eth1DataStatus)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetEth1DataStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) StreamDepositInclusions(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconQuery_StreamDepositInclusionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconQuery_serviceDesc.Streams[4], "/ethereum.beacon.rpc.v1.BeaconQuery/StreamDepositInclusions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconQueryStreamDepositInclusionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconQuery_StreamDepositInclusionsClient interface {
+	Recv() (*DepositInclusion, error)
+	grpc.ClientStream
+}
+
+type beaconQueryStreamDepositInclusionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconQueryStreamDepositInclusionsClient) Recv() (*DepositInclusion, error) {
+	m := new(DepositInclusion)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconQueryClient) SimulateEpochTransition(ctx context.Context, in *SimulateEpochTransitionRequest, opts ...grpc.CallOption) (*EpochTransitionSimulation, error) {
+	out := new(EpochTransitionSimulation)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/SimulateEpochTransition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ListCanonicalBlockHeaders(ctx context.Context, in *ListCanonicalBlockHeadersRequest, opts ...grpc.CallOption) (*CanonicalBlockHeaders, error) {
+	out := new(CanonicalBlockHeaders)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ListCanonicalBlockHeaders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetBlockByPandoraHash(ctx context.Context, in *GetBlockByPandoraHashRequest, opts ...grpc.CallOption) (*PairedBlock, error) {
+	out := new(PairedBlock)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetBlockByPandoraHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetProposerListConsistency(ctx context.Context, in *ProposerListConsistencyRequest, opts ...grpc.CallOption) (*ProposerListConsistency, error) {
+	out := new(ProposerListConsistency)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetProposerListConsistency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetCurrentEpochParticipation(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CurrentEpochParticipation, error) {
+	out := new(CurrentEpochParticipation)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetCurrentEpochParticipation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ListAnnotatedValidatorAssignments(ctx context.Context, in *AnnotatedValidatorAssignmentsRequest, opts ...grpc.CallOption) (*AnnotatedValidatorAssignments, error) {
+	out := new(AnnotatedValidatorAssignments)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ListAnnotatedValidatorAssignments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ListTrackedValidatorBalances(ctx context.Context, in *v1alpha1.ListValidatorBalancesRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorBalances, error) {
+	out := new(v1alpha1.ValidatorBalances)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ListTrackedValidatorBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetTrackedValidatorPerformance(ctx context.Context, in *v1alpha1.ValidatorPerformanceRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorPerformanceResponse, error) {
+	out := new(v1alpha1.ValidatorPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetTrackedValidatorPerformance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ListValidatorsByWithdrawalCredentials(ctx context.Context, in *ValidatorsByWithdrawalCredentialsRequest, opts ...grpc.CallOption) (*v1alpha1.Validators, error) {
+	out := new(v1alpha1.Validators)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ListValidatorsByWithdrawalCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) EstimateStorageGrowth(ctx context.Context, in *StorageGrowthRequest, opts ...grpc.CallOption) (*StorageGrowthEstimate, error) {
+	out := new(StorageGrowthEstimate)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/EstimateStorageGrowth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ConfirmPandoraBlockHashes(ctx context.Context, in *ConfirmPandoraBlockHashesRequest, opts ...grpc.CallOption) (*ConfirmPandoraBlockHashesResponse, error) {
+	out := new(ConfirmPandoraBlockHashesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ConfirmPandoraBlockHashes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetProposerStats(ctx context.Context, in *ProposerStatsRequest, opts ...grpc.CallOption) (*ProposerStats, error) {
+	out := new(ProposerStats)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetProposerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetSubnetAssignments(ctx context.Context, in *SubnetAssignmentsRequest, opts ...grpc.CallOption) (*SubnetAssignments, error) {
+	out := new(SubnetAssignments)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetSubnetAssignments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ListValidatorAssignmentsRange(ctx context.Context, in *ListValidatorAssignmentsRangeRequest, opts ...grpc.CallOption) (*ValidatorAssignmentsRange, error) {
+	out := new(ValidatorAssignmentsRange)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ListValidatorAssignmentsRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetPrecomputationStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PrecomputationStatus, error) {
+	out := new(PrecomputationStatus)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetPrecomputationStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) StreamEpochInfo(ctx context.Context, opts ...grpc.CallOption) (BeaconQuery_StreamEpochInfoClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconQuery_serviceDesc.Streams[5], "/ethereum.beacon.rpc.v1.BeaconQuery/StreamEpochInfo", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconQueryStreamEpochInfoClient{stream}
+	return x, nil
+}
+
+type BeaconQuery_StreamEpochInfoClient interface {
+	Send(*EpochInfoStreamMessage) error
+	Recv() (*EpochInfo, error)
+	grpc.ClientStream
+}
+
+type beaconQueryStreamEpochInfoClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconQueryStreamEpochInfoClient) Send(m *EpochInfoStreamMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *beaconQueryStreamEpochInfoClient) Recv() (*EpochInfo, error) {
+	m := new(EpochInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconQueryClient) GetEpochInfoAccumulator(ctx context.Context, in *EpochInfoAccumulatorRequest, opts ...grpc.CallOption) (*EpochInfoAccumulator, error) {
+	out := new(EpochInfoAccumulator)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetEpochInfoAccumulator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) NextEpochProposerList(ctx context.Context, in *ProposerListRequest, opts ...grpc.CallOption) (*ProposerList, error) {
+	out := new(ProposerList)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/NextEpochProposerList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BeaconQueryServer is the server API for BeaconQuery service.
+type BeaconQueryServer interface {
+	GetValidatorLiveness(context.Context, *ValidatorLivenessRequest) (*ValidatorLivenessResponse, error)
+	GetCommitteeRoots(context.Context, *CommitteeRootsRequest) (*CommitteeRoots, error)
+	ExplainShuffle(context.Context, *ExplainShuffleRequest) (*ShuffleExplanation, error)
+	GetStateDiff(context.Context, *GetStateDiffRequest) (*StateDiff, error)
+	GetRandaoMixes(context.Context, *RandaoMixesRequest) (*RandaoMixes, error)
+	ListReorgs(context.Context, *ListReorgsRequest) (*Reorgs, error)
+	StreamReorgs(*ListReorgsRequest, BeaconQuery_StreamReorgsServer) error
+	GetDuties(context.Context, *v1alpha1.DutiesRequest) (*v1alpha1.DutiesResponse, error)
+	ListValidatorBalanceHistory(context.Context, *ListValidatorBalanceHistoryRequest) (*ValidatorBalanceHistory, error)
+	StreamSlotParticipation(*empty.Empty, BeaconQuery_StreamSlotParticipationServer) error
+	GetEpochSummary(context.Context, *GetEpochSummaryRequest) (*EpochSummary, error)
+	ListEpochSummaries(context.Context, *ListEpochSummariesRequest) (*EpochSummaries, error)
+	ListValidatorPublicKeys(context.Context, *ListValidatorPublicKeysRequest) (*ValidatorPublicKeys, error)
+	GetValidatorQueueDetails(context.Context, *ValidatorQueueDetailsRequest) (*ValidatorQueueDetails, error)
+	StreamAnnotatedChainHead(*empty.Empty, BeaconQuery_StreamAnnotatedChainHeadServer) error
+	GetBlockAvailability(context.Context, *BlockAvailabilityRequest) (*BlockAvailability, error)
+	GetNetworkConfig(context.Context, *empty.Empty) (*NetworkConfig, error)
+	GetValidatorSetDelta(context.Context, *GetValidatorSetDeltaRequest) (*ValidatorSetDelta, error)
+	PrefetchEpochInfo(context.Context, *PrefetchEpochInfoRequest) (*PrefetchJob, error)
+	GetPrefetchStatus(context.Context, *GetPrefetchStatusRequest) (*PrefetchStatus, error)
+	ListCanonicalBlockRoots(context.Context, *ListCanonicalBlockRootsRequest) (*CanonicalBlockRoots, error)
+	StreamProposerAudit(*StreamProposerAuditRequest, BeaconQuery_StreamProposerAuditServer) error
+	UpdateTrackedValidators(context.Context, *TrackValidatorsRequest) (*TrackedValidatorsResponse, error)
+	ImportTrackedValidators(context.Context, *ImportTrackedValidatorsRequest) (*TrackedValidatorsResponse, error)
+	ExportTrackedValidators(context.Context, *empty.Empty) (*TrackedValidatorsExport, error)
+	ListPoolAttestations(context.Context, *ListPoolAttestationsRequest) (*PoolAttestations, error)
+	GetEth1DataStatus(context.Context, *empty.Empty) (*Eth1DataStatus, error)
+	StreamDepositInclusions(*empty.Empty, BeaconQuery_StreamDepositInclusionsServer) error
+	SimulateEpochTransition(context.Context, *SimulateEpochTransitionRequest) (*EpochTransitionSimulation, error)
+	ListCanonicalBlockHeaders(context.Context, *ListCanonicalBlockHeadersRequest) (*CanonicalBlockHeaders, error)
+	GetBlockByPandoraHash(context.Context, *GetBlockByPandoraHashRequest) (*PairedBlock, error)
+	GetProposerListConsistency(context.Context, *ProposerListConsistencyRequest) (*ProposerListConsistency, error)
+	GetCurrentEpochParticipation(context.Context, *empty.Empty) (*CurrentEpochParticipation, error)
+	ListAnnotatedValidatorAssignments(context.Context, *AnnotatedValidatorAssignmentsRequest) (*AnnotatedValidatorAssignments, error)
+	ListTrackedValidatorBalances(context.Context, *v1alpha1.ListValidatorBalancesRequest) (*v1alpha1.ValidatorBalances, error)
+	GetTrackedValidatorPerformance(context.Context, *v1alpha1.ValidatorPerformanceRequest) (*v1alpha1.ValidatorPerformanceResponse, error)
+	ListValidatorsByWithdrawalCredentials(context.Context, *ValidatorsByWithdrawalCredentialsRequest) (*v1alpha1.Validators, error)
+	EstimateStorageGrowth(context.Context, *StorageGrowthRequest) (*StorageGrowthEstimate, error)
+	ConfirmPandoraBlockHashes(context.Context, *ConfirmPandoraBlockHashesRequest) (*ConfirmPandoraBlockHashesResponse, error)
+	GetProposerStats(context.Context, *ProposerStatsRequest) (*ProposerStats, error)
+	GetSubnetAssignments(context.Context, *SubnetAssignmentsRequest) (*SubnetAssignments, error)
+	ListValidatorAssignmentsRange(context.Context, *ListValidatorAssignmentsRangeRequest) (*ValidatorAssignmentsRange, error)
+	GetPrecomputationStatus(context.Context, *empty.Empty) (*PrecomputationStatus, error)
+	StreamEpochInfo(BeaconQuery_StreamEpochInfoServer) error
+	GetEpochInfoAccumulator(context.Context, *EpochInfoAccumulatorRequest) (*EpochInfoAccumulator, error)
+	NextEpochProposerList(context.Context, *ProposerListRequest) (*ProposerList, error)
+}
+
+// UnimplementedBeaconQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedBeaconQueryServer struct {
+}
+
+func (*UnimplementedBeaconQueryServer) GetValidatorLiveness(ctx context.Context, req *ValidatorLivenessRequest) (*ValidatorLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorLiveness not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetCommitteeRoots(ctx context.Context, req *CommitteeRootsRequest) (*CommitteeRoots, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommitteeRoots not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ExplainShuffle(ctx context.Context, req *ExplainShuffleRequest) (*ShuffleExplanation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainShuffle not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetStateDiff(ctx context.Context, req *GetStateDiffRequest) (*StateDiff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateDiff not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetRandaoMixes(ctx context.Context, req *RandaoMixesRequest) (*RandaoMixes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRandaoMixes not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ListReorgs(ctx context.Context, req *ListReorgsRequest) (*Reorgs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReorgs not implemented")
+}
+func (*UnimplementedBeaconQueryServer) StreamReorgs(req *ListReorgsRequest, srv BeaconQuery_StreamReorgsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamReorgs not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetDuties(ctx context.Context, req *v1alpha1.DutiesRequest) (*v1alpha1.DutiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDuties not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ListValidatorBalanceHistory(ctx context.Context, req *ListValidatorBalanceHistoryRequest) (*ValidatorBalanceHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListValidatorBalanceHistory not implemented")
+}
+func (*UnimplementedBeaconQueryServer) StreamSlotParticipation(req *empty.Empty, srv BeaconQuery_StreamSlotParticipationServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamSlotParticipation not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetEpochSummary(ctx context.Context, req *GetEpochSummaryRequest) (*EpochSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEpochSummary not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ListEpochSummaries(ctx context.Context, req *ListEpochSummariesRequest) (*EpochSummaries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEpochSummaries not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ListValidatorPublicKeys(ctx context.Context, req *ListValidatorPublicKeysRequest) (*ValidatorPublicKeys, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListValidatorPublicKeys not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetValidatorQueueDetails(ctx context.Context, req *ValidatorQueueDetailsRequest) (*ValidatorQueueDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorQueueDetails not implemented")
+}
+func (*UnimplementedBeaconQueryServer) StreamAnnotatedChainHead(req *empty.Empty, srv BeaconQuery_StreamAnnotatedChainHeadServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAnnotatedChainHead not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetBlockAvailability(ctx context.Context, req *BlockAvailabilityRequest) (*BlockAvailability, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockAvailability not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetNetworkConfig(ctx context.Context, req *empty.Empty) (*NetworkConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkConfig not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetValidatorSetDelta(ctx context.Context, req *GetValidatorSetDeltaRequest) (*ValidatorSetDelta, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorSetDelta not implemented")
+}
+func (*UnimplementedBeaconQueryServer) PrefetchEpochInfo(ctx context.Context, req *PrefetchEpochInfoRequest) (*PrefetchJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrefetchEpochInfo not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetPrefetchStatus(ctx context.Context, req *GetPrefetchStatusRequest) (*PrefetchStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrefetchStatus not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ListCanonicalBlockRoots(ctx context.Context, req *ListCanonicalBlockRootsRequest) (*CanonicalBlockRoots, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCanonicalBlockRoots not implemented")
+}
+func (*UnimplementedBeaconQueryServer) StreamProposerAudit(req *StreamProposerAuditRequest, srv BeaconQuery_StreamProposerAuditServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamProposerAudit not implemented")
+}
+func (*UnimplementedBeaconQueryServer) UpdateTrackedValidators(ctx context.Context, req *TrackValidatorsRequest) (*TrackedValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTrackedValidators not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ImportTrackedValidators(ctx context.Context, req *ImportTrackedValidatorsRequest) (*TrackedValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportTrackedValidators not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ExportTrackedValidators(ctx context.Context, req *empty.Empty) (*TrackedValidatorsExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportTrackedValidators not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ListPoolAttestations(ctx context.Context, req *ListPoolAttestationsRequest) (*PoolAttestations, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPoolAttestations not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetEth1DataStatus(ctx context.Context, req *empty.Empty) (*Eth1DataStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEth1DataStatus not implemented")
+}
+func (*UnimplementedBeaconQueryServer) StreamDepositInclusions(req *empty.Empty, srv BeaconQuery_StreamDepositInclusionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamDepositInclusions not implemented")
+}
+func (*UnimplementedBeaconQueryServer) SimulateEpochTransition(ctx context.Context, req *SimulateEpochTransitionRequest) (*EpochTransitionSimulation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateEpochTransition not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ListCanonicalBlockHeaders(ctx context.Context, req *ListCanonicalBlockHeadersRequest) (*CanonicalBlockHeaders, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCanonicalBlockHeaders not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetBlockByPandoraHash(ctx context.Context, req *GetBlockByPandoraHashRequest) (*PairedBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByPandoraHash not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetProposerListConsistency(ctx context.Context, req *ProposerListConsistencyRequest) (*ProposerListConsistency, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProposerListConsistency not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetCurrentEpochParticipation(ctx context.Context, req *empty.Empty) (*CurrentEpochParticipation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentEpochParticipation not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ListAnnotatedValidatorAssignments(ctx context.Context, req *AnnotatedValidatorAssignmentsRequest) (*AnnotatedValidatorAssignments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAnnotatedValidatorAssignments not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ListTrackedValidatorBalances(ctx context.Context, req *v1alpha1.ListValidatorBalancesRequest) (*v1alpha1.ValidatorBalances, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrackedValidatorBalances not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetTrackedValidatorPerformance(ctx context.Context, req *v1alpha1.ValidatorPerformanceRequest) (*v1alpha1.ValidatorPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrackedValidatorPerformance not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ListValidatorsByWithdrawalCredentials(ctx context.Context, req *ValidatorsByWithdrawalCredentialsRequest) (*v1alpha1.Validators, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListValidatorsByWithdrawalCredentials not implemented")
+}
+func (*UnimplementedBeaconQueryServer) EstimateStorageGrowth(ctx context.Context, req *StorageGrowthRequest) (*StorageGrowthEstimate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateStorageGrowth not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ConfirmPandoraBlockHashes(ctx context.Context, req *ConfirmPandoraBlockHashesRequest) (*ConfirmPandoraBlockHashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmPandoraBlockHashes not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetProposerStats(ctx context.Context, req *ProposerStatsRequest) (*ProposerStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProposerStats not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetSubnetAssignments(ctx context.Context, req *SubnetAssignmentsRequest) (*SubnetAssignments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubnetAssignments not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ListValidatorAssignmentsRange(ctx context.Context, req *ListValidatorAssignmentsRangeRequest) (*ValidatorAssignmentsRange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListValidatorAssignmentsRange not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetPrecomputationStatus(ctx context.Context, req *empty.Empty) (*PrecomputationStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrecomputationStatus not implemented")
+}
+func (*UnimplementedBeaconQueryServer) StreamEpochInfo(srv BeaconQuery_StreamEpochInfoServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEpochInfo not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetEpochInfoAccumulator(ctx context.Context, req *EpochInfoAccumulatorRequest) (*EpochInfoAccumulator, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEpochInfoAccumulator not implemented")
+}
+func (*UnimplementedBeaconQueryServer) NextEpochProposerList(ctx context.Context, req *ProposerListRequest) (*ProposerList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextEpochProposerList not implemented")
+}
+
+func RegisterBeaconQueryServer(s *grpc.Server, srv BeaconQueryServer) {
+	s.RegisterService(&_BeaconQuery_serviceDesc, srv)
+}
+
+func _BeaconQuery_GetValidatorLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetValidatorLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetValidatorLiveness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetValidatorLiveness(ctx, req.(*ValidatorLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetCommitteeRoots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitteeRootsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetCommitteeRoots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetCommitteeRoots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetCommitteeRoots(ctx, req.(*CommitteeRootsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ExplainShuffle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainShuffleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ExplainShuffle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ExplainShuffle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ExplainShuffle(ctx, req.(*ExplainShuffleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetStateDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStateDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetStateDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetStateDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetStateDiff(ctx, req.(*GetStateDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetRandaoMixes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RandaoMixesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetRandaoMixes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetRandaoMixes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetRandaoMixes(ctx, req.(*RandaoMixesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ListReorgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReorgsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ListReorgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ListReorgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ListReorgs(ctx, req.(*ListReorgsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_StreamReorgs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListReorgsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconQueryServer).StreamReorgs(m, &beaconQueryStreamReorgsServer{stream})
+}
+
+type BeaconQuery_StreamReorgsServer interface {
+	Send(*ReorgEvent) error
+	grpc.ServerStream
+}
+
+type beaconQueryStreamReorgsServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconQueryStreamReorgsServer) Send(m *ReorgEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeaconQuery_GetDuties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.DutiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetDuties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetDuties",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetDuties(ctx, req.(*v1alpha1.DutiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ListValidatorBalanceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListValidatorBalanceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ListValidatorBalanceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ListValidatorBalanceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ListValidatorBalanceHistory(ctx, req.(*ListValidatorBalanceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_StreamSlotParticipation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconQueryServer).StreamSlotParticipation(m, &beaconQueryStreamSlotParticipationServer{stream})
+}
+
+type BeaconQuery_StreamSlotParticipationServer interface {
+	Send(*SlotParticipation) error
+	grpc.ServerStream
+}
+
+type beaconQueryStreamSlotParticipationServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconQueryStreamSlotParticipationServer) Send(m *SlotParticipation) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeaconQuery_GetEpochSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEpochSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetEpochSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetEpochSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetEpochSummary(ctx, req.(*GetEpochSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ListEpochSummaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEpochSummariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ListEpochSummaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ListEpochSummaries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ListEpochSummaries(ctx, req.(*ListEpochSummariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ListValidatorPublicKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListValidatorPublicKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ListValidatorPublicKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ListValidatorPublicKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ListValidatorPublicKeys(ctx, req.(*ListValidatorPublicKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetValidatorQueueDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorQueueDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetValidatorQueueDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetValidatorQueueDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetValidatorQueueDetails(ctx, req.(*ValidatorQueueDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_StreamAnnotatedChainHead_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconQueryServer).StreamAnnotatedChainHead(m, &beaconQueryStreamAnnotatedChainHeadServer{stream})
+}
+
+type BeaconQuery_StreamAnnotatedChainHeadServer interface {
+	Send(*AnnotatedChainHead) error
+	grpc.ServerStream
+}
+
+type beaconQueryStreamAnnotatedChainHeadServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconQueryStreamAnnotatedChainHeadServer) Send(m *AnnotatedChainHead) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeaconQuery_GetBlockAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetBlockAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetBlockAvailability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetBlockAvailability(ctx, req.(*BlockAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetNetworkConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetNetworkConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetNetworkConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetNetworkConfig(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetValidatorSetDelta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorSetDeltaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetValidatorSetDelta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetValidatorSetDelta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetValidatorSetDelta(ctx, req.(*GetValidatorSetDeltaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_PrefetchEpochInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrefetchEpochInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).PrefetchEpochInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/PrefetchEpochInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).PrefetchEpochInfo(ctx, req.(*PrefetchEpochInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetPrefetchStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrefetchStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetPrefetchStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetPrefetchStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetPrefetchStatus(ctx, req.(*GetPrefetchStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ListCanonicalBlockRoots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCanonicalBlockRootsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ListCanonicalBlockRoots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ListCanonicalBlockRoots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ListCanonicalBlockRoots(ctx, req.(*ListCanonicalBlockRootsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_StreamProposerAudit_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamProposerAuditRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconQueryServer).StreamProposerAudit(m, &beaconQueryStreamProposerAuditServer{stream})
+}
+
+type BeaconQuery_StreamProposerAuditServer interface {
+	Send(*ProposerAudit) error
+	grpc.ServerStream
+}
+
+type beaconQueryStreamProposerAuditServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconQueryStreamProposerAuditServer) Send(m *ProposerAudit) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeaconQuery_UpdateTrackedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrackValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).UpdateTrackedValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/UpdateTrackedValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).UpdateTrackedValidators(ctx, req.(*TrackValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ImportTrackedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTrackedValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ImportTrackedValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ImportTrackedValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ImportTrackedValidators(ctx, req.(*ImportTrackedValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ExportTrackedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ExportTrackedValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ExportTrackedValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ExportTrackedValidators(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ListPoolAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPoolAttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ListPoolAttestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ListPoolAttestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ListPoolAttestations(ctx, req.(*ListPoolAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetEth1DataStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetEth1DataStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetEth1DataStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetEth1DataStatus(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_StreamDepositInclusions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconQueryServer).StreamDepositInclusions(m, &beaconQueryStreamDepositInclusionsServer{stream})
+}
+
+type BeaconQuery_StreamDepositInclusionsServer interface {
+	Send(*DepositInclusion) error
+	grpc.ServerStream
+}
+
+type beaconQueryStreamDepositInclusionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconQueryStreamDepositInclusionsServer) Send(m *DepositInclusion) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeaconQuery_SimulateEpochTransition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateEpochTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).SimulateEpochTransition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/SimulateEpochTransition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).SimulateEpochTransition(ctx, req.(*SimulateEpochTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ListCanonicalBlockHeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCanonicalBlockHeadersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ListCanonicalBlockHeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ListCanonicalBlockHeaders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ListCanonicalBlockHeaders(ctx, req.(*ListCanonicalBlockHeadersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetBlockByPandoraHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockByPandoraHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetBlockByPandoraHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetBlockByPandoraHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetBlockByPandoraHash(ctx, req.(*GetBlockByPandoraHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetProposerListConsistency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposerListConsistencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetProposerListConsistency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetProposerListConsistency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetProposerListConsistency(ctx, req.(*ProposerListConsistencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetCurrentEpochParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetCurrentEpochParticipation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetCurrentEpochParticipation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetCurrentEpochParticipation(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ListAnnotatedValidatorAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnotatedValidatorAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ListAnnotatedValidatorAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ListAnnotatedValidatorAssignments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ListAnnotatedValidatorAssignments(ctx, req.(*AnnotatedValidatorAssignmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ListTrackedValidatorBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.ListValidatorBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ListTrackedValidatorBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ListTrackedValidatorBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ListTrackedValidatorBalances(ctx, req.(*v1alpha1.ListValidatorBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetTrackedValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.ValidatorPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetTrackedValidatorPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetTrackedValidatorPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetTrackedValidatorPerformance(ctx, req.(*v1alpha1.ValidatorPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ListValidatorsByWithdrawalCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorsByWithdrawalCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ListValidatorsByWithdrawalCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ListValidatorsByWithdrawalCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ListValidatorsByWithdrawalCredentials(ctx, req.(*ValidatorsByWithdrawalCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_EstimateStorageGrowth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageGrowthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).EstimateStorageGrowth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/EstimateStorageGrowth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).EstimateStorageGrowth(ctx, req.(*StorageGrowthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ConfirmPandoraBlockHashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmPandoraBlockHashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ConfirmPandoraBlockHashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ConfirmPandoraBlockHashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ConfirmPandoraBlockHashes(ctx, req.(*ConfirmPandoraBlockHashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetProposerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetProposerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetProposerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetProposerStats(ctx, req.(*ProposerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetSubnetAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubnetAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetSubnetAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetSubnetAssignments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetSubnetAssignments(ctx, req.(*SubnetAssignmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ListValidatorAssignmentsRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListValidatorAssignmentsRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ListValidatorAssignmentsRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ListValidatorAssignmentsRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ListValidatorAssignmentsRange(ctx, req.(*ListValidatorAssignmentsRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetPrecomputationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetPrecomputationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetPrecomputationStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetPrecomputationStatus(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_StreamEpochInfo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BeaconQueryServer).StreamEpochInfo(&beaconQueryStreamEpochInfoServer{stream})
+}
+
+type BeaconQuery_StreamEpochInfoServer interface {
+	Send(*EpochInfo) error
+	Recv() (*EpochInfoStreamMessage, error)
+	grpc.ServerStream
+}
+
+type beaconQueryStreamEpochInfoServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconQueryStreamEpochInfoServer) Send(m *EpochInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *beaconQueryStreamEpochInfoServer) Recv() (*EpochInfoStreamMessage, error) {
+	m := new(EpochInfoStreamMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _BeaconQuery_GetEpochInfoAccumulator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EpochInfoAccumulatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetEpochInfoAccumulator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetEpochInfoAccumulator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetEpochInfoAccumulator(ctx, req.(*EpochInfoAccumulatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_NextEpochProposerList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposerListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).NextEpochProposerList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/NextEpochProposerList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).NextEpochProposerList(ctx, req.(*ProposerListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BeaconQuery_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.BeaconQuery",
+	HandlerType: (*BeaconQueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetValidatorLiveness",
+			Handler:    _BeaconQuery_GetValidatorLiveness_Handler,
+		},
+		{
+			MethodName: "GetCommitteeRoots",
+			Handler:    _BeaconQuery_GetCommitteeRoots_Handler,
+		},
+		{
+			MethodName: "ExplainShuffle",
+			Handler:    _BeaconQuery_ExplainShuffle_Handler,
+		},
+		{
+			MethodName: "GetStateDiff",
+			Handler:    _BeaconQuery_GetStateDiff_Handler,
+		},
+		{
+			MethodName: "GetRandaoMixes",
+			Handler:    _BeaconQuery_GetRandaoMixes_Handler,
+		},
+		{
+			MethodName: "ListReorgs",
+			Handler:    _BeaconQuery_ListReorgs_Handler,
+		},
+		{
+			MethodName: "GetDuties",
+			Handler:    _BeaconQuery_GetDuties_Handler,
+		},
+		{
+			MethodName: "ListValidatorBalanceHistory",
+			Handler:    _BeaconQuery_ListValidatorBalanceHistory_Handler,
+		},
+		{
+			MethodName: "GetEpochSummary",
+			Handler:    _BeaconQuery_GetEpochSummary_Handler,
+		},
+		{
+			MethodName: "ListEpochSummaries",
+			Handler:    _BeaconQuery_ListEpochSummaries_Handler,
+		},
+		{
+			MethodName: "ListValidatorPublicKeys",
+			Handler:    _BeaconQuery_ListValidatorPublicKeys_Handler,
+		},
+		{
+			MethodName: "GetValidatorQueueDetails",
+			Handler:    _BeaconQuery_GetValidatorQueueDetails_Handler,
+		},
+		{
+			MethodName: "GetBlockAvailability",
+			Handler:    _BeaconQuery_GetBlockAvailability_Handler,
+		},
+		{
+			MethodName: "GetNetworkConfig",
+			Handler:    _BeaconQuery_GetNetworkConfig_Handler,
+		},
+		{
+			MethodName: "GetValidatorSetDelta",
+			Handler:    _BeaconQuery_GetValidatorSetDelta_Handler,
+		},
+		{
+			MethodName: "PrefetchEpochInfo",
+			Handler:    _BeaconQuery_PrefetchEpochInfo_Handler,
+		},
+		{
+			MethodName: "GetPrefetchStatus",
+			Handler:    _BeaconQuery_GetPrefetchStatus_Handler,
+		},
+		{
+			MethodName: "ListCanonicalBlockRoots",
+			Handler:    _BeaconQuery_ListCanonicalBlockRoots_Handler,
+		},
+		{
+			MethodName: "UpdateTrackedValidators",
+			Handler:    _BeaconQuery_UpdateTrackedValidators_Handler,
+		},
+		{
+			MethodName: "ImportTrackedValidators",
+			Handler:    _BeaconQuery_ImportTrackedValidators_Handler,
+		},
+		{
+			MethodName: "ExportTrackedValidators",
+			Handler:    _BeaconQuery_ExportTrackedValidators_Handler,
+		},
+		{
+			MethodName: "ListPoolAttestations",
+			Handler:    _BeaconQuery_ListPoolAttestations_Handler,
+		},
+		{
+			MethodName: "GetEth1DataStatus",
+			Handler:    _BeaconQuery_GetEth1DataStatus_Handler,
+		},
+		{
+			MethodName: "SimulateEpochTransition",
+			Handler:    _BeaconQuery_SimulateEpochTransition_Handler,
+		},
+		{
+			MethodName: "ListCanonicalBlockHeaders",
+			Handler:    _BeaconQuery_ListCanonicalBlockHeaders_Handler,
+		},
+		{
+			MethodName: "GetBlockByPandoraHash",
+			Handler:    _BeaconQuery_GetBlockByPandoraHash_Handler,
+		},
+		{
+			MethodName: "GetProposerListConsistency",
+			Handler:    _BeaconQuery_GetProposerListConsistency_Handler,
+		},
+		{
+			MethodName: "GetCurrentEpochParticipation",
+			Handler:    _BeaconQuery_GetCurrentEpochParticipation_Handler,
+		},
+		{
+			MethodName: "ListAnnotatedValidatorAssignments",
+			Handler:    _BeaconQuery_ListAnnotatedValidatorAssignments_Handler,
+		},
+		{
+			MethodName: "ListTrackedValidatorBalances",
+			Handler:    _BeaconQuery_ListTrackedValidatorBalances_Handler,
+		},
+		{
+			MethodName: "GetTrackedValidatorPerformance",
+			Handler:    _BeaconQuery_GetTrackedValidatorPerformance_Handler,
+		},
+		{
+			MethodName: "ListValidatorsByWithdrawalCredentials",
+			Handler:    _BeaconQuery_ListValidatorsByWithdrawalCredentials_Handler,
+		},
+		{
+			MethodName: "EstimateStorageGrowth",
+			Handler:    _BeaconQuery_EstimateStorageGrowth_Handler,
+		},
+		{
+			MethodName: "ConfirmPandoraBlockHashes",
+			Handler:    _BeaconQuery_ConfirmPandoraBlockHashes_Handler,
+		},
+		{
+			MethodName: "GetProposerStats",
+			Handler:    _BeaconQuery_GetProposerStats_Handler,
+		},
+		{
+			MethodName: "GetSubnetAssignments",
+			Handler:    _BeaconQuery_GetSubnetAssignments_Handler,
+		},
+		{
+			MethodName: "ListValidatorAssignmentsRange",
+			Handler:    _BeaconQuery_ListValidatorAssignmentsRange_Handler,
+		},
+		{
+			MethodName: "GetPrecomputationStatus",
+			Handler:    _BeaconQuery_GetPrecomputationStatus_Handler,
+		},
+		{
+			MethodName: "GetEpochInfoAccumulator",
+			Handler:    _BeaconQuery_GetEpochInfoAccumulator_Handler,
+		},
+		{
+			MethodName: "NextEpochProposerList",
+			Handler:    _BeaconQuery_NextEpochProposerList_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamReorgs",
+			Handler:       _BeaconQuery_StreamReorgs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamSlotParticipation",
+			Handler:       _BeaconQuery_StreamSlotParticipation_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamAnnotatedChainHead",
+			Handler:       _BeaconQuery_StreamAnnotatedChainHead_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamProposerAudit",
+			Handler:       _BeaconQuery_StreamProposerAudit_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamDepositInclusions",
+			Handler:       _BeaconQuery_StreamDepositInclusions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamEpochInfo",
+			Handler:       _BeaconQuery_StreamEpochInfo_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "proto/beacon/rpc/v1/beacon_query.proto",
+}
+
+func (m *ValidatorLivenessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorLivenessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorLivenessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Indices) > 0 {
+		dAtA2 := make([]byte, len(m.Indices)*10)
+		var j1 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorLivenessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorLivenessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorLivenessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Liveness) > 0 {
+		for iNdEx := len(m.Liveness) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liveness[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorLiveness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorLiveness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IsLive {
+		i--
+		if m.IsLive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Index != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitteeRootsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitteeRootsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitteeRootsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ToEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitteeRoots) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitteeRoots) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitteeRoots) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Roots) > 0 {
+		for iNdEx := len(m.Roots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitteeRoot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitteeRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitteeRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ActiveValidatorCount != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ActiveValidatorCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ActiveIndexRoot) > 0 {
+		i -= len(m.ActiveIndexRoot)
+		copy(dAtA[i:], m.ActiveIndexRoot)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.ActiveIndexRoot)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AttesterSeed) > 0 {
+		i -= len(m.AttesterSeed)
+		copy(dAtA[i:], m.AttesterSeed)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.AttesterSeed)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ProposerSeed) > 0 {
+		i -= len(m.ProposerSeed)
+		copy(dAtA[i:], m.ProposerSeed)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.ProposerSeed)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RandaoMix) > 0 {
+		i -= len(m.RandaoMix)
+		copy(dAtA[i:], m.RandaoMix)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.RandaoMix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExplainShuffleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExplainShuffleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExplainShuffleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Index != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShuffleExplanation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShuffleExplanation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShuffleExplanation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CommitteePosition != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.CommitteePosition))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CommitteeIndex != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.CommitteeIndex))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Slot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.RoundOutputs) > 0 {
+		dAtA4 := make([]byte, len(m.RoundOutputs)*10)
+		var j3 int
+		for _, num := range m.RoundOutputs {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ShuffledPosition != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ShuffledPosition))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ActivePosition != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ActivePosition))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ActiveValidatorCount != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ActiveValidatorCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Seed) > 0 {
+		i -= len(m.Seed)
+		copy(dAtA[i:], m.Seed)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.Seed)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetStateDiffRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetStateDiffRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetStateDiffRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Indices) > 0 {
+		dAtA6 := make([]byte, len(m.Indices)*10)
+		var j5 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ToEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PostFinalizedCheckpoint != nil {
+		{
+			size, err := m.PostFinalizedCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.PostCurrentJustifiedCheckpoint != nil {
+		{
+			size, err := m.PostCurrentJustifiedCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.PostPreviousJustifiedCheckpoint != nil {
+		{
+			size, err := m.PostPreviousJustifiedCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.FinalizedCheckpoint != nil {
+		{
+			size, err := m.FinalizedCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CurrentJustifiedCheckpoint != nil {
+		{
+			size, err := m.CurrentJustifiedCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PreviousJustifiedCheckpoint != nil {
+		{
+			size, err := m.PreviousJustifiedCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BalanceDeltas) > 0 {
+		for iNdEx := len(m.BalanceDeltas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BalanceDeltas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ChangedValidators) > 0 {
+		for iNdEx := len(m.ChangedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChangedValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ToEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Current != nil {
+		{
+			size, err := m.Current.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Previous != nil {
+		{
+			size, err := m.Previous.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BalanceDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceDelta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BalanceDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Delta != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Delta))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Current != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Current))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Previous != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Previous))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Index != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RandaoMixesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RandaoMixesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RandaoMixesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ToEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RandaoMixes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RandaoMixes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RandaoMixes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Mixes) > 0 {
+		for iNdEx := len(m.Mixes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mixes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochRandaoMix) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochRandaoMix) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochRandaoMix) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Contributions) > 0 {
+		for iNdEx := len(m.Contributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Mix) > 0 {
+		i -= len(m.Mix)
+		copy(dAtA[i:], m.Mix)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.Mix)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StartMix) > 0 {
+		i -= len(m.StartMix)
+		copy(dAtA[i:], m.StartMix)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.StartMix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SeedEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.SeedEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RandaoContribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RandaoContribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RandaoContribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Reveal) > 0 {
+		i -= len(m.Reveal)
+		copy(dAtA[i:], m.Reveal)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.Reveal)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ProposerIndex != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ProposerIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListReorgsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListReorgsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListReorgsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Reorgs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Reorgs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Reorgs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReorgEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReorgEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReorgEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ToEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Depth != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CommonAncestorSlot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.CommonAncestorSlot))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NewHeadSlot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.NewHeadSlot))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.OldHeadSlot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.OldHeadSlot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NewHeadRoot) > 0 {
+		i -= len(m.NewHeadRoot)
+		copy(dAtA[i:], m.NewHeadRoot)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.NewHeadRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OldHeadRoot) > 0 {
+		i -= len(m.OldHeadRoot)
+		copy(dAtA[i:], m.OldHeadRoot)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.OldHeadRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListValidatorBalanceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListValidatorBalanceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListValidatorBalanceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ToEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Index != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorBalanceHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorBalanceHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorBalanceHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Index != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Balance != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Balance))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlotParticipation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlotParticipation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlotParticipation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.HeadCorrectRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.HeadCorrectRate))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.ParticipationRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ParticipationRate))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.HeadCorrectBalance != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.HeadCorrectBalance))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AttestingBalance != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.AttestingBalance))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpectedBalance != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ExpectedBalance))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockSlot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.BlockSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Slot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetEpochSummaryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetEpochSummaryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetEpochSummaryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListEpochSummariesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListEpochSummariesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListEpochSummariesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ToEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochSummaries) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochSummaries) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochSummaries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Summaries) > 0 {
+		for iNdEx := len(m.Summaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Summaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.FinalizedRoot) > 0 {
+		i -= len(m.FinalizedRoot)
+		copy(dAtA[i:], m.FinalizedRoot)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.FinalizedRoot)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.FinalizedEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.FinalizedEpoch))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.JustifiedRoot) > 0 {
+		i -= len(m.JustifiedRoot)
+		copy(dAtA[i:], m.JustifiedRoot)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.JustifiedRoot)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.JustifiedEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.JustifiedEpoch))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ProposerListHash) > 0 {
+		i -= len(m.ProposerListHash)
+		copy(dAtA[i:], m.ProposerListHash)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.ProposerListHash)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.HeadAttestingBalance != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.HeadAttestingBalance))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TargetAttestingBalance != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.TargetAttestingBalance))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EligibleBalance != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.EligibleBalance))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TotalActiveBalance != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.TotalActiveBalance))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalBalance != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.TotalBalance))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ActiveValidatorCount != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ActiveValidatorCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ValidatorCount != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ValidatorCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListValidatorPublicKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListValidatorPublicKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListValidatorPublicKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Indices) > 0 {
+		dAtA16 := make([]byte, len(m.Indices)*10)
+		var j15 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
+		}
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(j15))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPublicKeys) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPublicKeys) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPublicKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TotalSize != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PublicKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPublicKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPublicKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPublicKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorQueueDetailsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorQueueDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorQueueDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TrackedOnly {
+		i--
+		if m.TrackedOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorQueueDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorQueueDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorQueueDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Exits) > 0 {
+		for iNdEx := len(m.Exits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Exits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Activations) > 0 {
+		for iNdEx := len(m.Activations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Activations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ChurnLimit != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ChurnLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.EstimatedWait != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.EstimatedWait))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Exact {
+		i--
+		if m.Exact {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.WithdrawableEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.WithdrawableEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpectedEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ExpectedEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ActivationEligibilityEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ActivationEligibilityEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AnnotatedChainHead) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AnnotatedChainHead) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AnnotatedChainHead) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NextProposerPublicKey) > 0 {
+		i -= len(m.NextProposerPublicKey)
+		copy(dAtA[i:], m.NextProposerPublicKey)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.NextProposerPublicKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NextProposerIndex != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.NextProposerIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NextSlot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.NextSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockAvailabilityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockAvailabilityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockAvailabilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ToSlot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ToSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromSlot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.FromSlot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockAvailability) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockAvailability) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockAvailability) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Available) > 0 {
+		i -= len(m.Available)
+		copy(dAtA[i:], m.Available)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.Available)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ToSlot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ToSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromSlot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.FromSlot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NetworkConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetworkConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Presets) > 0 {
+		for iNdEx := len(m.Presets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Presets[iNdEx])
+			copy(dAtA[i:], m.Presets[iNdEx])
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.Presets[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.DepositNetworkId != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.DepositNetworkId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DepositChainId != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.DepositChainId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DepositContractAddress) > 0 {
+		i -= len(m.DepositContractAddress)
+		copy(dAtA[i:], m.DepositContractAddress)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.DepositContractAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.GenesisForkVersion) > 0 {
+		i -= len(m.GenesisForkVersion)
+		copy(dAtA[i:], m.GenesisForkVersion)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.GenesisForkVersion)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SecondsPerSlot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.SecondsPerSlot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SlotsPerEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.SlotsPerEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetValidatorSetDeltaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValidatorSetDeltaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetValidatorSetDeltaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ToEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.EffectiveBalance != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.EffectiveBalance))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Status != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSetDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSetDelta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSetDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Removed) > 0 {
+		for iNdEx := len(m.Removed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Removed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Changed) > 0 {
+		for iNdEx := len(m.Changed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Added) > 0 {
+		for iNdEx := len(m.Added) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Added[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ValidatorCount != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ValidatorCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ToEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrefetchEpochInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrefetchEpochInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrefetchEpochInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AllowGaps {
+		i--
+		if m.AllowGaps {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ToEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrefetchJob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrefetchJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrefetchJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.JobId != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.JobId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPrefetchStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPrefetchStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPrefetchStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.JobId != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.JobId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrefetchStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrefetchStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrefetchStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Gaps) > 0 {
+		for iNdEx := len(m.Gaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Done {
+		i--
+		if m.Done {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Total != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Completed != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Completed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ToEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.JobId != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.JobId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochGap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochGap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochGap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListCanonicalBlockRootsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListCanonicalBlockRootsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListCanonicalBlockRootsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ToSlot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ToSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromSlot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.FromSlot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CanonicalBlockRoots) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CanonicalBlockRoots) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CanonicalBlockRoots) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Roots) > 0 {
+		for iNdEx := len(m.Roots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CanonicalBlockRoot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CanonicalBlockRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CanonicalBlockRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamProposerAuditRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamProposerAuditRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamProposerAuditRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TrackedOnly {
+		i--
+		if m.TrackedOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposerAudit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerAudit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerAudit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Outcome != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ActualProposer) > 0 {
+		i -= len(m.ActualProposer)
+		copy(dAtA[i:], m.ActualProposer)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.ActualProposer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ExpectedProposer) > 0 {
+		i -= len(m.ExpectedProposer)
+		copy(dAtA[i:], m.ExpectedProposer)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.ExpectedProposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TrackValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrackValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrackValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Remove) > 0 {
+		for iNdEx := len(m.Remove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Remove[iNdEx])
+			copy(dAtA[i:], m.Remove[iNdEx])
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.Remove[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Add) > 0 {
+		for iNdEx := len(m.Add) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Add[iNdEx])
+			copy(dAtA[i:], m.Add[iNdEx])
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.Add[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TrackedValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrackedValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrackedValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportTrackedValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportTrackedValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportTrackedValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Replace {
+		i--
+		if m.Replace {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TrackedValidatorsExport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrackedValidatorsExport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrackedValidatorsExport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListPoolAttestationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListPoolAttestationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListPoolAttestationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CommitteeFilter != nil {
+		{
+			size := m.CommitteeFilter.Size()
+			i -= size
+			if _, err := m.CommitteeFilter.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.SlotFilter != nil {
+		{
+			size := m.SlotFilter.Size()
+			i -= size
+			if _, err := m.SlotFilter.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListPoolAttestationsRequest_Slot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListPoolAttestationsRequest_Slot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Slot))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+func (m *ListPoolAttestationsRequest_CommitteeIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListPoolAttestationsRequest_CommitteeIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintBeaconQuery(dAtA, i, uint64(m.CommitteeIndex))
+	i--
+	dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+func (m *PoolAttestations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolAttestations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolAttestations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Committees) > 0 {
+		for iNdEx := len(m.Committees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Committees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolCommitteeAttestations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolCommitteeAttestations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolCommitteeAttestations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Participants != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Participants))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Aggregated) > 0 {
+		for iNdEx := len(m.Aggregated) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Aggregated[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Unaggregated) > 0 {
+		for iNdEx := len(m.Unaggregated) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unaggregated[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.CommitteeIndex != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.CommitteeIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Slot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Eth1DataStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Eth1DataStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Eth1DataStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RequiredSupport != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.RequiredSupport))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.VoteSupport != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.VoteSupport))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Vote != nil {
+		{
+			size, err := m.Vote.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Votes != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Votes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PendingDeposits != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.PendingDeposits))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Eth1DepositIndex != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Eth1DepositIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Eth1Data != nil {
+		{
+			size, err := m.Eth1Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NextVotingPeriodStartSlot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.NextVotingPeriodStartSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.VotingPeriodStartSlot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.VotingPeriodStartSlot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositInclusion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositInclusion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositInclusion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Amount != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.WithdrawalCredentials) > 0 {
+		i -= len(m.WithdrawalCredentials)
+		copy(dAtA[i:], m.WithdrawalCredentials)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.WithdrawalCredentials)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Index != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateEpochTransitionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateEpochTransitionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateEpochTransitionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.StateFilter != nil {
+		{
+			size := m.StateFilter.Size()
+			i -= size
+			if _, err := m.StateFilter.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateEpochTransitionRequest_StateRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateEpochTransitionRequest_StateRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.StateRoot != nil {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SimulateEpochTransitionRequest_Slot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateEpochTransitionRequest_Slot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Slot))
+	i--
+	dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+func (m *EpochTransitionSimulation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochTransitionSimulation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochTransitionSimulation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ProposerListRoot) > 0 {
+		i -= len(m.ProposerListRoot)
+		copy(dAtA[i:], m.ProposerListRoot)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.ProposerListRoot)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.NextEpochProposers) > 0 {
+		for iNdEx := len(m.NextEpochProposers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NextEpochProposers[iNdEx])
+			copy(dAtA[i:], m.NextEpochProposers[iNdEx])
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.NextEpochProposers[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.EjectedValidators) > 0 {
+		dAtA21 := make([]byte, len(m.EjectedValidators)*10)
+		var j20 int
+		for _, num := range m.EjectedValidators {
+			for num >= 1<<7 {
+				dAtA21[j20] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j20++
+			}
+			dAtA21[j20] = uint8(num)
+			j20++
+		}
+		i -= j20
+		copy(dAtA[i:], dAtA21[:j20])
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(j20))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.TotalPenalties != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.TotalPenalties))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Penalties) > 0 {
+		for iNdEx := len(m.Penalties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Penalties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.FinalizationChanged {
+		i--
+		if m.FinalizationChanged {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.JustificationChanged {
+		i--
+		if m.JustificationChanged {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PostFinalizedCheckpoint != nil {
+		{
+			size, err := m.PostFinalizedCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.PostCurrentJustifiedCheckpoint != nil {
+		{
+			size, err := m.PostCurrentJustifiedCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PostPreviousJustifiedCheckpoint != nil {
+		{
+			size, err := m.PostPreviousJustifiedCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.FinalizedCheckpoint != nil {
+		{
+			size, err := m.FinalizedCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CurrentJustifiedCheckpoint != nil {
+		{
+			size, err := m.CurrentJustifiedCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PreviousJustifiedCheckpoint != nil {
+		{
+			size, err := m.PreviousJustifiedCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Slot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPenalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPenalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPenalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Amount != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Index != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListCanonicalBlockHeadersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListCanonicalBlockHeadersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListCanonicalBlockHeadersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ToSlot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ToSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromSlot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.FromSlot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CanonicalBlockHeaders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CanonicalBlockHeaders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CanonicalBlockHeaders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CanonicalBlockHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CanonicalBlockHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CanonicalBlockHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Confirmation != nil {
+		{
+			size, err := m.Confirmation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PandoraConfirmation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PandoraConfirmation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PandoraConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PandoraHash) > 0 {
+		i -= len(m.PandoraHash)
+		copy(dAtA[i:], m.PandoraHash)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.PandoraHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockByPandoraHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockByPandoraHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockByPandoraHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PandoraHash) > 0 {
+		i -= len(m.PandoraHash)
+		copy(dAtA[i:], m.PandoraHash)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.PandoraHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PairedBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairedBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairedBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Confirmation != nil {
+		{
+			size, err := m.Confirmation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposerListConsistencyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerListConsistencyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerListConsistencyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposerListConsistency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerListConsistency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerListConsistency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DivergentPeers) > 0 {
+		for iNdEx := len(m.DivergentPeers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DivergentPeers[iNdEx])
+			copy(dAtA[i:], m.DivergentPeers[iNdEx])
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.DivergentPeers[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Score != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Score))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.MatchingPeers != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.MatchingPeers))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Peers != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Peers))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitteeParticipation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitteeParticipation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitteeParticipation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Rate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Rate))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.Included != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Included))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Seen != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Seen))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Expected != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Expected))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CommitteeIndex != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.CommitteeIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlotCommitteeParticipation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlotCommitteeParticipation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlotCommitteeParticipation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Rate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Rate))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.Included != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Included))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Seen != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Seen))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Expected != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Expected))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Committees) > 0 {
+		for iNdEx := len(m.Committees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Committees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Slot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CurrentEpochParticipation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CurrentEpochParticipation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrentEpochParticipation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Rate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Rate))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.Included != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Included))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Seen != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Seen))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Expected != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Expected))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Slots) > 0 {
+		for iNdEx := len(m.Slots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.CurrentSlot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.CurrentSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AnnotatedValidatorAssignmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AnnotatedValidatorAssignmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AnnotatedValidatorAssignmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Compact {
+		i--
+		if m.Compact {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.WithdrawalCredentialsPrefix) > 0 {
+		i -= len(m.WithdrawalCredentialsPrefix)
+		copy(dAtA[i:], m.WithdrawalCredentialsPrefix)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.WithdrawalCredentialsPrefix)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.IndexOnly {
+		i--
+		if m.IndexOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.TrackedOnly {
+		i--
+		if m.TrackedOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.IncludeValidatorFields {
+		i--
+		if m.IncludeValidatorFields {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.QueryFilter != nil {
+		{
+			size := m.QueryFilter.Size()
+			i -= size
+			if _, err := m.QueryFilter.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.CommitteeWeights {
+		i--
+		if m.CommitteeWeights {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Indices) > 0 {
+		dAtA33 := make([]byte, len(m.Indices)*10)
+		var j32 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA33[j32] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j32++
+			}
+			dAtA33[j32] = uint8(num)
+			j32++
+		}
+		i -= j32
+		copy(dAtA[i:], dAtA33[:j32])
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(j32))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AnnotatedValidatorAssignmentsRequest_Epoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AnnotatedValidatorAssignmentsRequest_Epoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Epoch))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+func (m *AnnotatedValidatorAssignmentsRequest_Genesis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AnnotatedValidatorAssignmentsRequest_Genesis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i--
+	if m.Genesis {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+func (m *AnnotatedValidatorAssignmentsRequest_BlockRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AnnotatedValidatorAssignmentsRequest_BlockRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BlockRoot != nil {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AnnotatedValidatorAssignmentsRequest_StateRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AnnotatedValidatorAssignmentsRequest_StateRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.StateRoot != nil {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AnnotatedValidatorAssignments) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AnnotatedValidatorAssignments) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AnnotatedValidatorAssignments) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CommitteePositions) > 0 {
+		for iNdEx := len(m.CommitteePositions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommitteePositions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ValidatorFields) > 0 {
+		for iNdEx := len(m.ValidatorFields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorFields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.AppliedPageSize != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.AppliedPageSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.CommitteeWeights) > 0 {
+		for iNdEx := len(m.CommitteeWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommitteeWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.IndexMismatches) > 0 {
+		for iNdEx := len(m.IndexMismatches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IndexMismatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ProposerListRoot) > 0 {
+		i -= len(m.ProposerListRoot)
+		copy(dAtA[i:], m.ProposerListRoot)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.ProposerListRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Assignments != nil {
+		{
+			size, err := m.Assignments.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorIndexMismatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorIndexMismatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorIndexMismatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.InHead {
+		i--
+		if m.InHead {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.HeadIndex != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.HeadIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RequestedIndex != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.RequestedIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitteeWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitteeWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitteeWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AttestationWeight != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.AttestationWeight))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.TotalEffectiveBalance != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.TotalEffectiveBalance))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CommitteeIndex != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.CommitteeIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Slot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorFields) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorFields) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorFields) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExitEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ExitEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ActivationEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ActivationEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ActivationEligibilityEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ActivationEligibilityEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EffectiveBalance != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.EffectiveBalance))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorsByWithdrawalCredentialsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorsByWithdrawalCredentialsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorsByWithdrawalCredentialsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.WithdrawalCredentialsPrefix) > 0 {
+		i -= len(m.WithdrawalCredentialsPrefix)
+		copy(dAtA[i:], m.WithdrawalCredentialsPrefix)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.WithdrawalCredentialsPrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitteePosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitteePosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitteePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CommitteeSize != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.CommitteeSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Position != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageGrowthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageGrowthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageGrowthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Days != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Days))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageGrowthEstimate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageGrowthEstimate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageGrowthEstimate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ProjectedColdStoreGrowthBytes != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ProjectedColdStoreGrowthBytes))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.ProjectedSizeBytes != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ProjectedSizeBytes))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Days != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Days))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ColdStoreBytesPerDay != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ColdStoreBytesPerDay))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.BytesPerDay != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.BytesPerDay))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.BlockSizeBytes != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.BlockSizeBytes))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ArchivedStateSizeBytes != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ArchivedStateSizeBytes))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StateSizeBytes != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.StateSizeBytes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CurrentSizeBytes != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.CurrentSizeBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ValidatorDedup {
+		i--
+		if m.ValidatorDedup {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ColdStateStore {
+		i--
+		if m.ColdStateStore {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SlotsPerArchivedPoint != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.SlotsPerArchivedPoint))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidatorCount != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ValidatorCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PandoraBlockHashConfirmation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PandoraBlockHashConfirmation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PandoraBlockHashConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Status != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfirmPandoraBlockHashesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfirmPandoraBlockHashesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfirmPandoraBlockHashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Confirmations) > 0 {
+		for iNdEx := len(m.Confirmations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Confirmations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfirmPandoraBlockHashesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfirmPandoraBlockHashesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfirmPandoraBlockHashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UnknownSlots) > 0 {
+		dAtA37 := make([]byte, len(m.UnknownSlots)*10)
+		var j36 int
+		for _, num := range m.UnknownSlots {
+			for num >= 1<<7 {
+				dAtA37[j36] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j36++
+			}
+			dAtA37[j36] = uint8(num)
+			j36++
+		}
+		i -= j36
+		copy(dAtA[i:], dAtA37[:j36])
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(j36))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Stored != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Stored))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposerStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ToEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Index != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposerStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AverageInclusionDelay != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.AverageInclusionDelay))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.MissedProposals != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.MissedProposals))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ProposedBlocks != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ProposedBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProposalDuties != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ProposalDuties))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Index != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubnetAssignmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubnetAssignmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubnetAssignmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubnetAssignment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubnetAssignment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubnetAssignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SubnetId != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.SubnetId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CommitteeIndex != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.CommitteeIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AttesterSlot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.AttesterSlot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubnetAssignments) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubnetAssignments) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubnetAssignments) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Assignments) > 0 {
+		for iNdEx := len(m.Assignments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assignments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListValidatorAssignmentsRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListValidatorAssignmentsRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListValidatorAssignmentsRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Compact {
+		i--
+		if m.Compact {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.WithdrawalCredentialsPrefix) > 0 {
+		i -= len(m.WithdrawalCredentialsPrefix)
+		copy(dAtA[i:], m.WithdrawalCredentialsPrefix)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.WithdrawalCredentialsPrefix)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.IncludeValidatorFields {
+		i--
+		if m.IncludeValidatorFields {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CommitteeWeights {
+		i--
+		if m.CommitteeWeights {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.IndexOnly {
+		i--
+		if m.IndexOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TrackedOnly {
+		i--
+		if m.TrackedOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Indices) > 0 {
+		dAtA39 := make([]byte, len(m.Indices)*10)
+		var j38 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA39[j38] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j38++
+			}
+			dAtA39[j38] = uint8(num)
+			j38++
+		}
+		i -= j38
+		copy(dAtA[i:], dAtA39[:j38])
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(j38))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ToEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorAssignmentsRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorAssignmentsRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorAssignmentsRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CommitteePositions) > 0 {
+		for iNdEx := len(m.CommitteePositions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommitteePositions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ValidatorFields) > 0 {
+		for iNdEx := len(m.ValidatorFields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorFields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CommitteeWeights) > 0 {
+		for iNdEx := len(m.CommitteeWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommitteeWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ProposerListRoots) > 0 {
+		for iNdEx := len(m.ProposerListRoots) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProposerListRoots[iNdEx])
+			copy(dAtA[i:], m.ProposerListRoots[iNdEx])
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.ProposerListRoots[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.IndexMismatches) > 0 {
+		for iNdEx := len(m.IndexMismatches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IndexMismatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochCommitteeWeights) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochCommitteeWeights) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochCommitteeWeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Weights) > 0 {
+		for iNdEx := len(m.Weights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Weights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochValidatorFields) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochValidatorFields) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochValidatorFields) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochCommitteePositions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochCommitteePositions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochCommitteePositions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrecomputationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrecomputationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrecomputationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.StalledEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.StalledEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Stalled {
+		i--
+		if m.Stalled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastPrecomputedEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.LastPrecomputedEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamEpochInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamEpochInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamEpochInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Shard != nil {
+		{
+			size, err := m.Shard.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AllowGaps {
+		i--
+		if m.AllowGaps {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Subscriber) > 0 {
+		i -= len(m.Subscriber)
+		copy(dAtA[i:], m.Subscriber)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.Subscriber)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Resume != nil {
+		{
+			size := m.Resume.Size()
+			i -= size
+			if _, err := m.Resume.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamEpochInfoRequest_ResumeFromEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamEpochInfoRequest_ResumeFromEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintBeaconQuery(dAtA, i, uint64(m.ResumeFromEpoch))
+	i--
+	dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+func (m *EpochShard) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochShard) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochShard) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Remainder != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Remainder))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Modulus != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Modulus))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochInfoStreamMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochInfoStreamMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochInfoStreamMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Message != nil {
+		{
+			size := m.Message.Size()
+			i -= size
+			if _, err := m.Message.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochInfoStreamMessage_Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochInfoStreamMessage_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EpochInfoStreamMessage_AckEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochInfoStreamMessage_AckEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintBeaconQuery(dAtA, i, uint64(m.AckEpoch))
+	i--
+	dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+func (m *EpochInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Provisional {
+		i--
+		if m.Provisional {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ReorgFlag {
+		i--
+		if m.ReorgFlag {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ForkDigest) > 0 {
+		i -= len(m.ForkDigest)
+		copy(dAtA[i:], m.ForkDigest)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.ForkDigest)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ForkVersion) > 0 {
+		i -= len(m.ForkVersion)
+		copy(dAtA[i:], m.ForkVersion)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.ForkVersion)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ProposerListRoot) > 0 {
+		i -= len(m.ProposerListRoot)
+		copy(dAtA[i:], m.ProposerListRoot)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.ProposerListRoot)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Proposers) > 0 {
+		for iNdEx := len(m.Proposers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proposers[iNdEx])
+			copy(dAtA[i:], m.Proposers[iNdEx])
+			i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.Proposers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.SlotDuration != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.SlotDuration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochStartTime != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.EpochStartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochInfoAccumulatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochInfoAccumulatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochInfoAccumulatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.QueryFilter != nil {
+		{
+			size := m.QueryFilter.Size()
+			i -= size
+			if _, err := m.QueryFilter.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochInfoAccumulatorRequest_Epoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochInfoAccumulatorRequest_Epoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Epoch))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+func (m *EpochInfoAccumulator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochInfoAccumulator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochInfoAccumulator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AnchorEpoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.AnchorEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposerListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.QueryFilter != nil {
+		{
+			size := m.QueryFilter.Size()
+			i -= size
+			if _, err := m.QueryFilter.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposerListRequest_Epoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerListRequest_Epoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Epoch))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+func (m *ProposerList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Provisional {
+		i--
+		if m.Provisional {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProposerListRoot) > 0 {
+		i -= len(m.ProposerListRoot)
+		copy(dAtA[i:], m.ProposerListRoot)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.ProposerListRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Proposers) > 0 {
+		for iNdEx := len(m.Proposers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeaconQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlotProposer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlotProposer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlotProposer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintBeaconQuery(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBeaconQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBeaconQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorLivenessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Epoch))
+	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovBeaconQuery(uint64(e))
+		}
+		n += 1 + sovBeaconQuery(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorLivenessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Epoch))
+	}
+	if len(m.Liveness) > 0 {
+		for _, e := range m.Liveness {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorLiveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Index))
+	}
+	if m.IsLive {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitteeRootsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ToEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitteeRoots) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Roots) > 0 {
+		for _, e := range m.Roots {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitteeRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Epoch))
+	}
+	l = len(m.RandaoMix)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	l = len(m.ProposerSeed)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	l = len(m.AttesterSeed)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	l = len(m.ActiveIndexRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.ActiveValidatorCount != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ActiveValidatorCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExplainShuffleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Epoch))
+	}
+	if m.Index != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Index))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ShuffleExplanation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Epoch))
+	}
+	if m.Index != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Index))
+	}
+	l = len(m.Seed)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.ActiveValidatorCount != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ActiveValidatorCount))
+	}
+	if m.ActivePosition != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ActivePosition))
+	}
+	if m.ShuffledPosition != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ShuffledPosition))
+	}
+	if len(m.RoundOutputs) > 0 {
+		l = 0
+		for _, e := range m.RoundOutputs {
+			l += sovBeaconQuery(uint64(e))
+		}
+		n += 1 + sovBeaconQuery(uint64(l)) + l
+	}
+	if m.Slot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Slot))
+	}
+	if m.CommitteeIndex != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.CommitteeIndex))
+	}
+	if m.CommitteePosition != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.CommitteePosition))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetStateDiffRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ToEpoch))
+	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovBeaconQuery(uint64(e))
+		}
+		n += 1 + sovBeaconQuery(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StateDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ToEpoch))
+	}
+	if len(m.ChangedValidators) > 0 {
+		for _, e := range m.ChangedValidators {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if len(m.BalanceDeltas) > 0 {
+		for _, e := range m.BalanceDeltas {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.PreviousJustifiedCheckpoint != nil {
+		l = m.PreviousJustifiedCheckpoint.Size()
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.CurrentJustifiedCheckpoint != nil {
+		l = m.CurrentJustifiedCheckpoint.Size()
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.FinalizedCheckpoint != nil {
+		l = m.FinalizedCheckpoint.Size()
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.PostPreviousJustifiedCheckpoint != nil {
+		l = m.PostPreviousJustifiedCheckpoint.Size()
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.PostCurrentJustifiedCheckpoint != nil {
+		l = m.PostCurrentJustifiedCheckpoint.Size()
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.PostFinalizedCheckpoint != nil {
+		l = m.PostFinalizedCheckpoint.Size()
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Index))
+	}
+	if m.Previous != nil {
+		l = m.Previous.Size()
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.Current != nil {
+		l = m.Current.Size()
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BalanceDelta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Index))
+	}
+	if m.Previous != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Previous))
+	}
+	if m.Current != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Current))
+	}
+	if m.Delta != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Delta))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RandaoMixesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ToEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RandaoMixes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Mixes) > 0 {
+		for _, e := range m.Mixes {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EpochRandaoMix) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Epoch))
+	}
+	if m.SeedEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.SeedEpoch))
+	}
+	l = len(m.StartMix)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	l = len(m.Mix)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if len(m.Contributions) > 0 {
+		for _, e := range m.Contributions {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.Verified {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RandaoContribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Slot))
+	}
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.ProposerIndex != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ProposerIndex))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	l = len(m.Reveal)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListReorgsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.FromEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Reorgs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReorgEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldHeadRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	l = len(m.NewHeadRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.OldHeadSlot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.OldHeadSlot))
+	}
+	if m.NewHeadSlot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.NewHeadSlot))
+	}
+	if m.CommonAncestorSlot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.CommonAncestorSlot))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Depth))
+	}
+	if m.FromEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ToEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListValidatorBalanceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Index))
+	}
+	if m.FromEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ToEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorBalanceHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Index))
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EpochBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Epoch))
+	}
+	if m.Balance != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Balance))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SlotParticipation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Slot))
+	}
+	if m.BlockSlot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.BlockSlot))
+	}
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.ExpectedBalance != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ExpectedBalance))
+	}
+	if m.AttestingBalance != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.AttestingBalance))
+	}
+	if m.HeadCorrectBalance != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.HeadCorrectBalance))
+	}
+	if m.ParticipationRate != 0 {
+		n += 9
+	}
+	if m.HeadCorrectRate != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetEpochSummaryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListEpochSummariesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ToEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EpochSummaries) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Summaries) > 0 {
+		for _, e := range m.Summaries {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EpochSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Epoch))
+	}
+	if m.ValidatorCount != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ValidatorCount))
+	}
+	if m.ActiveValidatorCount != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ActiveValidatorCount))
+	}
+	if m.TotalBalance != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.TotalBalance))
+	}
+	if m.TotalActiveBalance != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.TotalActiveBalance))
+	}
+	if m.EligibleBalance != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.EligibleBalance))
+	}
+	if m.TargetAttestingBalance != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.TargetAttestingBalance))
+	}
+	if m.HeadAttestingBalance != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.HeadAttestingBalance))
+	}
+	l = len(m.ProposerListHash)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.JustifiedEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.JustifiedEpoch))
+	}
+	l = len(m.JustifiedRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.FinalizedEpoch))
+	}
+	l = len(m.FinalizedRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListValidatorPublicKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovBeaconQuery(uint64(e))
+		}
+		n += 1 + sovBeaconQuery(uint64(l)) + l
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorPublicKeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, e := range m.PublicKeys {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorPublicKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Index))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorQueueDetailsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TrackedOnly {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorQueueDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Epoch))
+	}
+	if m.ChurnLimit != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ChurnLimit))
+	}
+	if len(m.Activations) > 0 {
+		for _, e := range m.Activations {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if len(m.Exits) > 0 {
+		for _, e := range m.Exits {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *QueuedValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Index))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.ActivationEligibilityEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ActivationEligibilityEpoch))
+	}
+	if m.ExpectedEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ExpectedEpoch))
+	}
+	if m.WithdrawableEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.WithdrawableEpoch))
+	}
+	if m.Exact {
+		n += 2
+	}
+	if m.EstimatedWait != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.EstimatedWait))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AnnotatedChainHead) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.NextSlot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.NextSlot))
+	}
+	if m.NextProposerIndex != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.NextProposerIndex))
+	}
+	l = len(m.NextProposerPublicKey)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockAvailabilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromSlot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.FromSlot))
+	}
+	if m.ToSlot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ToSlot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockAvailability) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromSlot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.FromSlot))
+	}
+	if m.ToSlot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ToSlot))
+	}
+	l = len(m.Available)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NetworkConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.SlotsPerEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.SlotsPerEpoch))
+	}
+	if m.SecondsPerSlot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.SecondsPerSlot))
+	}
+	l = len(m.GenesisForkVersion)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	l = len(m.DepositContractAddress)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.DepositChainId != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.DepositChainId))
+	}
+	if m.DepositNetworkId != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.DepositNetworkId))
+	}
+	if len(m.Presets) > 0 {
+		for _, s := range m.Presets {
+			l = len(s)
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetValidatorSetDeltaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ToEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Index))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Status))
+	}
+	if m.EffectiveBalance != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.EffectiveBalance))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorSetDelta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ToEpoch))
+	}
+	if m.ValidatorCount != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ValidatorCount))
+	}
+	if len(m.Added) > 0 {
+		for _, e := range m.Added {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if len(m.Changed) > 0 {
+		for _, e := range m.Changed {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if len(m.Removed) > 0 {
+		for _, e := range m.Removed {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PrefetchEpochInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ToEpoch))
+	}
+	if m.AllowGaps {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PrefetchJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobId != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.JobId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetPrefetchStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobId != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.JobId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PrefetchStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobId != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.JobId))
+	}
+	if m.FromEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ToEpoch))
+	}
+	if m.Completed != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Completed))
+	}
+	if m.Total != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Total))
+	}
+	if m.Done {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if len(m.Gaps) > 0 {
+		for _, e := range m.Gaps {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EpochGap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Epoch))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListCanonicalBlockRootsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromSlot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.FromSlot))
+	}
+	if m.ToSlot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ToSlot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CanonicalBlockRoots) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Roots) > 0 {
+		for _, e := range m.Roots {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CanonicalBlockRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Slot))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StreamProposerAuditRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TrackedOnly {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProposerAudit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Slot))
+	}
+	l = len(m.ExpectedProposer)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	l = len(m.ActualProposer)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.Outcome != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Outcome))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TrackValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Add) > 0 {
+		for _, b := range m.Add {
+			l = len(b)
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if len(m.Remove) > 0 {
+		for _, b := range m.Remove {
+			l = len(b)
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TrackedValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportTrackedValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.Replace {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TrackedValidatorsExport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListPoolAttestationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlotFilter != nil {
+		n += m.SlotFilter.Size()
+	}
+	if m.CommitteeFilter != nil {
+		n += m.CommitteeFilter.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListPoolAttestationsRequest_Slot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovBeaconQuery(uint64(m.Slot))
+	return n
+}
+func (m *ListPoolAttestationsRequest_CommitteeIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovBeaconQuery(uint64(m.CommitteeIndex))
+	return n
+}
+func (m *PoolAttestations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Committees) > 0 {
+		for _, e := range m.Committees {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PoolCommitteeAttestations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Slot))
+	}
+	if m.CommitteeIndex != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.CommitteeIndex))
+	}
+	if len(m.Unaggregated) > 0 {
+		for _, e := range m.Unaggregated {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if len(m.Aggregated) > 0 {
+		for _, e := range m.Aggregated {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.Participants != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Participants))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Eth1DataStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotingPeriodStartSlot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.VotingPeriodStartSlot))
+	}
+	if m.NextVotingPeriodStartSlot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.NextVotingPeriodStartSlot))
+	}
+	if m.Eth1Data != nil {
+		l = m.Eth1Data.Size()
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.Eth1DepositIndex != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Eth1DepositIndex))
+	}
+	if m.PendingDeposits != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.PendingDeposits))
+	}
+	if m.Votes != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Votes))
+	}
+	if m.Vote != nil {
+		l = m.Vote.Size()
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.VoteSupport != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.VoteSupport))
+	}
+	if m.RequiredSupport != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.RequiredSupport))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DepositInclusion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Slot))
+	}
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Index))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	l = len(m.WithdrawalCredentials)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Amount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SimulateEpochTransitionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StateFilter != nil {
+		n += m.StateFilter.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SimulateEpochTransitionRequest_StateRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StateRoot != nil {
+		l = len(m.StateRoot)
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	return n
+}
+func (m *SimulateEpochTransitionRequest_Slot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovBeaconQuery(uint64(m.Slot))
+	return n
+}
+func (m *EpochTransitionSimulation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Slot))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Epoch))
+	}
+	if m.PreviousJustifiedCheckpoint != nil {
+		l = m.PreviousJustifiedCheckpoint.Size()
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.CurrentJustifiedCheckpoint != nil {
+		l = m.CurrentJustifiedCheckpoint.Size()
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.FinalizedCheckpoint != nil {
+		l = m.FinalizedCheckpoint.Size()
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.PostPreviousJustifiedCheckpoint != nil {
+		l = m.PostPreviousJustifiedCheckpoint.Size()
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.PostCurrentJustifiedCheckpoint != nil {
+		l = m.PostCurrentJustifiedCheckpoint.Size()
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.PostFinalizedCheckpoint != nil {
+		l = m.PostFinalizedCheckpoint.Size()
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.JustificationChanged {
+		n += 2
+	}
+	if m.FinalizationChanged {
+		n += 2
+	}
+	if len(m.Penalties) > 0 {
+		for _, e := range m.Penalties {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.TotalPenalties != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.TotalPenalties))
+	}
+	if len(m.EjectedValidators) > 0 {
+		l = 0
+		for _, e := range m.EjectedValidators {
+			l += sovBeaconQuery(uint64(e))
+		}
+		n += 1 + sovBeaconQuery(uint64(l)) + l
+	}
+	if len(m.NextEpochProposers) > 0 {
+		for _, b := range m.NextEpochProposers {
+			l = len(b)
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	l = len(m.ProposerListRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorPenalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Index))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Amount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListCanonicalBlockHeadersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromSlot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.FromSlot))
+	}
+	if m.ToSlot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ToSlot))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CanonicalBlockHeaders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CanonicalBlockHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.Confirmation != nil {
+		l = m.Confirmation.Size()
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PandoraConfirmation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Slot))
+	}
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	l = len(m.PandoraHash)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Status))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetBlockByPandoraHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PandoraHash)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PairedBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.Confirmation != nil {
+		l = m.Confirmation.Size()
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProposerListConsistencyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProposerListConsistency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Epoch))
+	}
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.Peers != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Peers))
+	}
+	if m.MatchingPeers != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.MatchingPeers))
+	}
+	if m.Score != 0 {
+		n += 9
+	}
+	if len(m.DivergentPeers) > 0 {
+		for _, s := range m.DivergentPeers {
+			l = len(s)
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitteeParticipation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommitteeIndex != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.CommitteeIndex))
+	}
+	if m.Expected != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Expected))
+	}
+	if m.Seen != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Seen))
+	}
+	if m.Included != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Included))
+	}
+	if m.Rate != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SlotCommitteeParticipation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Slot))
+	}
+	if len(m.Committees) > 0 {
+		for _, e := range m.Committees {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.Expected != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Expected))
+	}
+	if m.Seen != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Seen))
+	}
+	if m.Included != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Included))
+	}
+	if m.Rate != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CurrentEpochParticipation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Epoch))
+	}
+	if m.CurrentSlot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.CurrentSlot))
+	}
+	if len(m.Slots) > 0 {
+		for _, e := range m.Slots {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.Expected != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Expected))
+	}
+	if m.Seen != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Seen))
+	}
+	if m.Included != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Included))
+	}
+	if m.Rate != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AnnotatedValidatorAssignmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryFilter != nil {
+		n += m.QueryFilter.Size()
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovBeaconQuery(uint64(e))
+		}
+		n += 1 + sovBeaconQuery(uint64(l)) + l
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.CommitteeWeights {
+		n += 2
+	}
+	if m.IncludeValidatorFields {
+		n += 2
+	}
+	if m.TrackedOnly {
+		n += 2
+	}
+	if m.IndexOnly {
+		n += 2
+	}
+	l = len(m.WithdrawalCredentialsPrefix)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.Compact {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AnnotatedValidatorAssignmentsRequest_Epoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovBeaconQuery(uint64(m.Epoch))
+	return n
+}
+func (m *AnnotatedValidatorAssignmentsRequest_Genesis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	return n
+}
+func (m *AnnotatedValidatorAssignmentsRequest_BlockRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRoot != nil {
+		l = len(m.BlockRoot)
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	return n
+}
+func (m *AnnotatedValidatorAssignmentsRequest_StateRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StateRoot != nil {
+		l = len(m.StateRoot)
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	return n
+}
+func (m *AnnotatedValidatorAssignments) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Assignments != nil {
+		l = m.Assignments.Size()
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	l = len(m.ProposerListRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if len(m.IndexMismatches) > 0 {
+		for _, e := range m.IndexMismatches {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if len(m.CommitteeWeights) > 0 {
+		for _, e := range m.CommitteeWeights {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.AppliedPageSize != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.AppliedPageSize))
+	}
+	if len(m.ValidatorFields) > 0 {
+		for _, e := range m.ValidatorFields {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if len(m.CommitteePositions) > 0 {
+		for _, e := range m.CommitteePositions {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorIndexMismatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.RequestedIndex != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.RequestedIndex))
+	}
+	if m.HeadIndex != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.HeadIndex))
+	}
+	if m.InHead {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitteeWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Slot))
+	}
+	if m.CommitteeIndex != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.CommitteeIndex))
+	}
+	if m.TotalEffectiveBalance != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.TotalEffectiveBalance))
+	}
+	if m.AttestationWeight != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorFields) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ValidatorIndex))
+	}
+	if m.EffectiveBalance != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.EffectiveBalance))
+	}
+	if m.ActivationEligibilityEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ActivationEligibilityEpoch))
+	}
+	if m.ActivationEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ActivationEpoch))
+	}
+	if m.ExitEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ExitEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorsByWithdrawalCredentialsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	l = len(m.WithdrawalCredentialsPrefix)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitteePosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ValidatorIndex))
+	}
+	if m.Position != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Position))
+	}
+	if m.CommitteeSize != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.CommitteeSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StorageGrowthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Days != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Days))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StorageGrowthEstimate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorCount != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ValidatorCount))
+	}
+	if m.SlotsPerArchivedPoint != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.SlotsPerArchivedPoint))
+	}
+	if m.ColdStateStore {
+		n += 2
+	}
+	if m.ValidatorDedup {
+		n += 2
+	}
+	if m.CurrentSizeBytes != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.CurrentSizeBytes))
+	}
+	if m.StateSizeBytes != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.StateSizeBytes))
+	}
+	if m.ArchivedStateSizeBytes != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ArchivedStateSizeBytes))
+	}
+	if m.BlockSizeBytes != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.BlockSizeBytes))
+	}
+	if m.BytesPerDay != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.BytesPerDay))
+	}
+	if m.ColdStoreBytesPerDay != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ColdStoreBytesPerDay))
+	}
+	if m.Days != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Days))
+	}
+	if m.ProjectedSizeBytes != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ProjectedSizeBytes))
+	}
+	if m.ProjectedColdStoreGrowthBytes != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ProjectedColdStoreGrowthBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PandoraBlockHashConfirmation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Slot))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Status))
+	}
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ConfirmPandoraBlockHashesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Confirmations) > 0 {
+		for _, e := range m.Confirmations {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ConfirmPandoraBlockHashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Stored != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Stored))
+	}
+	if len(m.UnknownSlots) > 0 {
+		l = 0
+		for _, e := range m.UnknownSlots {
+			l += sovBeaconQuery(uint64(e))
+		}
+		n += 1 + sovBeaconQuery(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProposerStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Index))
+	}
+	if m.FromEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ToEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProposerStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Index))
+	}
+	if m.ProposalDuties != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ProposalDuties))
+	}
+	if m.ProposedBlocks != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ProposedBlocks))
+	}
+	if m.MissedProposals != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.MissedProposals))
+	}
+	if m.AverageInclusionDelay != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubnetAssignmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Epoch))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubnetAssignment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ValidatorIndex))
+	}
+	if m.AttesterSlot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.AttesterSlot))
+	}
+	if m.CommitteeIndex != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.CommitteeIndex))
+	}
+	if m.SubnetId != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.SubnetId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubnetAssignments) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Epoch))
+	}
+	if len(m.Assignments) > 0 {
+		for _, e := range m.Assignments {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListValidatorAssignmentsRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.ToEpoch))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovBeaconQuery(uint64(e))
+		}
+		n += 1 + sovBeaconQuery(uint64(l)) + l
+	}
+	if m.TrackedOnly {
+		n += 2
+	}
+	if m.IndexOnly {
+		n += 2
+	}
+	if m.CommitteeWeights {
+		n += 2
+	}
+	if m.IncludeValidatorFields {
+		n += 2
+	}
+	l = len(m.WithdrawalCredentialsPrefix)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.Compact {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorAssignmentsRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if len(m.IndexMismatches) > 0 {
+		for _, e := range m.IndexMismatches {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if len(m.ProposerListRoots) > 0 {
+		for _, b := range m.ProposerListRoots {
+			l = len(b)
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if len(m.CommitteeWeights) > 0 {
+		for _, e := range m.CommitteeWeights {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if len(m.ValidatorFields) > 0 {
+		for _, e := range m.ValidatorFields {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if len(m.CommitteePositions) > 0 {
+		for _, e := range m.CommitteePositions {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EpochCommitteeWeights) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Weights) > 0 {
+		for _, e := range m.Weights {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EpochValidatorFields) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EpochCommitteePositions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PrecomputationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.LastPrecomputedEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.LastPrecomputedEpoch))
+	}
+	if m.Stalled {
+		n += 2
+	}
+	if m.StalledEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.StalledEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StreamEpochInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.FromEpoch))
+	}
+	if m.Resume != nil {
+		n += m.Resume.Size()
+	}
+	l = len(m.Subscriber)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.AllowGaps {
+		n += 2
+	}
+	if m.Shard != nil {
+		l = m.Shard.Size()
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StreamEpochInfoRequest_ResumeFromEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovBeaconQuery(uint64(m.ResumeFromEpoch))
+	return n
+}
+func (m *EpochShard) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Modulus != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Modulus))
+	}
+	if m.Remainder != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Remainder))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EpochInfoStreamMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Message != nil {
+		n += m.Message.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EpochInfoStreamMessage_Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	return n
+}
+func (m *EpochInfoStreamMessage_AckEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovBeaconQuery(uint64(m.AckEpoch))
+	return n
+}
+func (m *EpochInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Epoch))
+	}
+	if m.EpochStartTime != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.EpochStartTime))
+	}
+	if m.SlotDuration != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.SlotDuration))
+	}
+	if len(m.Proposers) > 0 {
+		for _, b := range m.Proposers {
+			l = len(b)
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	l = len(m.ProposerListRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	l = len(m.ForkVersion)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	l = len(m.ForkDigest)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.ReorgFlag {
+		n += 2
+	}
+	if m.Provisional {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EpochInfoAccumulatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryFilter != nil {
+		n += m.QueryFilter.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EpochInfoAccumulatorRequest_Epoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovBeaconQuery(uint64(m.Epoch))
+	return n
+}
+func (m *EpochInfoAccumulator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AnchorEpoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.AnchorEpoch))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Epoch))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProposerListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryFilter != nil {
+		n += m.QueryFilter.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProposerListRequest_Epoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovBeaconQuery(uint64(m.Epoch))
+	return n
+}
+func (m *ProposerList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Epoch))
+	}
+	if len(m.Proposers) > 0 {
+		for _, e := range m.Proposers {
+			l = e.Size()
+			n += 1 + l + sovBeaconQuery(uint64(l))
+		}
+	}
+	l = len(m.ProposerListRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.Provisional {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SlotProposer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovBeaconQuery(uint64(m.Slot))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovBeaconQuery(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovBeaconQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBeaconQuery(x uint64) (n int) {
+	return sovBeaconQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorLivenessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorLivenessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorLivenessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v github_com_prysmaticlabs_eth2_types.ValidatorIndex
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBeaconQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBeaconQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]github_com_prysmaticlabs_eth2_types.ValidatorIndex, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v github_com_prysmaticlabs_eth2_types.ValidatorIndex
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBeaconQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorLivenessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorLivenessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorLivenessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liveness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liveness = append(m.Liveness, &ValidatorLiveness{})
+			if err := m.Liveness[len(m.Liveness)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorLiveness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorLiveness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLive = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitteeRootsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitteeRootsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitteeRootsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitteeRoots) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitteeRoots: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitteeRoots: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roots = append(m.Roots, &CommitteeRoot{})
+			if err := m.Roots[len(m.Roots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitteeRoot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitteeRoot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitteeRoot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandaoMix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RandaoMix = append(m.RandaoMix[:0], dAtA[iNdEx:postIndex]...)
+			if m.RandaoMix == nil {
+				m.RandaoMix = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSeed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerSeed = append(m.ProposerSeed[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerSeed == nil {
+				m.ProposerSeed = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttesterSeed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttesterSeed = append(m.AttesterSeed[:0], dAtA[iNdEx:postIndex]...)
+			if m.AttesterSeed == nil {
+				m.AttesterSeed = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveIndexRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveIndexRoot = append(m.ActiveIndexRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.ActiveIndexRoot == nil {
+				m.ActiveIndexRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveValidatorCount", wireType)
+			}
+			m.ActiveValidatorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveValidatorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExplainShuffleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExplainShuffleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExplainShuffleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShuffleExplanation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShuffleExplanation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShuffleExplanation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seed = append(m.Seed[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seed == nil {
+				m.Seed = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveValidatorCount", wireType)
+			}
+			m.ActiveValidatorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveValidatorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivePosition", wireType)
+			}
+			m.ActivePosition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivePosition |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShuffledPosition", wireType)
+			}
+			m.ShuffledPosition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShuffledPosition |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType == 0 {
+				var v github_com_prysmaticlabs_eth2_types.ValidatorIndex
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RoundOutputs = append(m.RoundOutputs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBeaconQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBeaconQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RoundOutputs) == 0 {
+					m.RoundOutputs = make([]github_com_prysmaticlabs_eth2_types.ValidatorIndex, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v github_com_prysmaticlabs_eth2_types.ValidatorIndex
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBeaconQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RoundOutputs = append(m.RoundOutputs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundOutputs", wireType)
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+			}
+			m.CommitteeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeIndex |= github_com_prysmaticlabs_eth2_types.CommitteeIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteePosition", wireType)
+			}
+			m.CommitteePosition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteePosition |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetStateDiffRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetStateDiffRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetStateDiffRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v github_com_prysmaticlabs_eth2_types.ValidatorIndex
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBeaconQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBeaconQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]github_com_prysmaticlabs_eth2_types.ValidatorIndex, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v github_com_prysmaticlabs_eth2_types.ValidatorIndex
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBeaconQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangedValidators = append(m.ChangedValidators, &ValidatorChange{})
+			if err := m.ChangedValidators[len(m.ChangedValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceDeltas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BalanceDeltas = append(m.BalanceDeltas, &BalanceDelta{})
+			if err := m.BalanceDeltas[len(m.BalanceDeltas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousJustifiedCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousJustifiedCheckpoint == nil {
+				m.PreviousJustifiedCheckpoint = &v1alpha1.Checkpoint{}
+			}
+			if err := m.PreviousJustifiedCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentJustifiedCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CurrentJustifiedCheckpoint == nil {
+				m.CurrentJustifiedCheckpoint = &v1alpha1.Checkpoint{}
+			}
+			if err := m.CurrentJustifiedCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalizedCheckpoint == nil {
+				m.FinalizedCheckpoint = &v1alpha1.Checkpoint{}
+			}
+			if err := m.FinalizedCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostPreviousJustifiedCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PostPreviousJustifiedCheckpoint == nil {
+				m.PostPreviousJustifiedCheckpoint = &v1alpha1.Checkpoint{}
+			}
+			if err := m.PostPreviousJustifiedCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostCurrentJustifiedCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PostCurrentJustifiedCheckpoint == nil {
+				m.PostCurrentJustifiedCheckpoint = &v1alpha1.Checkpoint{}
+			}
+			if err := m.PostCurrentJustifiedCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostFinalizedCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PostFinalizedCheckpoint == nil {
+				m.PostFinalizedCheckpoint = &v1alpha1.Checkpoint{}
+			}
+			if err := m.PostFinalizedCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Previous", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Previous == nil {
+				m.Previous = &v1alpha1.Validator{}
+			}
+			if err := m.Previous.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Current == nil {
+				m.Current = &v1alpha1.Validator{}
+			}
+			if err := m.Current.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BalanceDelta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceDelta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Previous", wireType)
+			}
+			m.Previous = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Previous |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			m.Current = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Current |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+			}
+			m.Delta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Delta |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RandaoMixesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RandaoMixesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RandaoMixesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RandaoMixes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RandaoMixes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RandaoMixes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mixes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mixes = append(m.Mixes, &EpochRandaoMix{})
+			if err := m.Mixes[len(m.Mixes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochRandaoMix) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochRandaoMix: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochRandaoMix: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeedEpoch", wireType)
+			}
+			m.SeedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeedEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartMix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartMix = append(m.StartMix[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartMix == nil {
+				m.StartMix = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mix = append(m.Mix[:0], dAtA[iNdEx:postIndex]...)
+			if m.Mix == nil {
+				m.Mix = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contributions = append(m.Contributions, &RandaoContribution{})
+			if err := m.Contributions[len(m.Contributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RandaoContribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RandaoContribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RandaoContribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerIndex", wireType)
+			}
+			m.ProposerIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposerIndex |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reveal", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reveal = append(m.Reveal[:0], dAtA[iNdEx:postIndex]...)
+			if m.Reveal == nil {
+				m.Reveal = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListReorgsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListReorgsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListReorgsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Reorgs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reorgs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reorgs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &ReorgEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReorgEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReorgEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReorgEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldHeadRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldHeadRoot = append(m.OldHeadRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.OldHeadRoot == nil {
+				m.OldHeadRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewHeadRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewHeadRoot = append(m.NewHeadRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewHeadRoot == nil {
+				m.NewHeadRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldHeadSlot", wireType)
+			}
+			m.OldHeadSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldHeadSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewHeadSlot", wireType)
+			}
+			m.NewHeadSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewHeadSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonAncestorSlot", wireType)
+			}
+			m.CommonAncestorSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommonAncestorSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListValidatorBalanceHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListValidatorBalanceHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListValidatorBalanceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorBalanceHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorBalanceHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorBalanceHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, &EpochBalance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlotParticipation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlotParticipation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlotParticipation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSlot", wireType)
+			}
+			m.BlockSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedBalance", wireType)
+			}
+			m.ExpectedBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedBalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestingBalance", wireType)
+			}
+			m.AttestingBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestingBalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadCorrectBalance", wireType)
+			}
+			m.HeadCorrectBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadCorrectBalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipationRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ParticipationRate = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadCorrectRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.HeadCorrectRate = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetEpochSummaryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetEpochSummaryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetEpochSummaryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListEpochSummariesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListEpochSummariesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListEpochSummariesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochSummaries) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochSummaries: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochSummaries: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summaries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summaries = append(m.Summaries, &EpochSummary{})
+			if err := m.Summaries[len(m.Summaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCount", wireType)
+			}
+			m.ValidatorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveValidatorCount", wireType)
+			}
+			m.ActiveValidatorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveValidatorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBalance", wireType)
+			}
+			m.TotalBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalBalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalActiveBalance", wireType)
+			}
+			m.TotalActiveBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalActiveBalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EligibleBalance", wireType)
+			}
+			m.EligibleBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EligibleBalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAttestingBalance", wireType)
+			}
+			m.TargetAttestingBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetAttestingBalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadAttestingBalance", wireType)
+			}
+			m.HeadAttestingBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadAttestingBalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerListHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerListHash = append(m.ProposerListHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerListHash == nil {
+				m.ProposerListHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedEpoch", wireType)
+			}
+			m.JustifiedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JustifiedEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JustifiedRoot = append(m.JustifiedRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.JustifiedRoot == nil {
+				m.JustifiedRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+			}
+			m.FinalizedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalizedRoot = append(m.FinalizedRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.FinalizedRoot == nil {
+				m.FinalizedRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListValidatorPublicKeysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListValidatorPublicKeysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListValidatorPublicKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v github_com_prysmaticlabs_eth2_types.ValidatorIndex
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBeaconQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBeaconQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]github_com_prysmaticlabs_eth2_types.ValidatorIndex, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v github_com_prysmaticlabs_eth2_types.ValidatorIndex
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBeaconQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPublicKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPublicKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPublicKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, &ValidatorPublicKey{})
+			if err := m.PublicKeys[len(m.PublicKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPublicKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPublicKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPublicKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorQueueDetailsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorQueueDetailsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorQueueDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackedOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TrackedOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorQueueDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorQueueDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorQueueDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChurnLimit", wireType)
+			}
+			m.ChurnLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChurnLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Activations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Activations = append(m.Activations, &QueuedValidator{})
+			if err := m.Activations[len(m.Activations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exits = append(m.Exits, &QueuedValidator{})
+			if err := m.Exits[len(m.Exits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationEligibilityEpoch", wireType)
+			}
+			m.ActivationEligibilityEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationEligibilityEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedEpoch", wireType)
+			}
+			m.ExpectedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawableEpoch", wireType)
+			}
+			m.WithdrawableEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawableEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exact", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exact = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedWait", wireType)
+			}
+			m.EstimatedWait = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedWait |= time.Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnnotatedChainHead) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnnotatedChainHead: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnnotatedChainHead: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &v1alpha1.ChainHead{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSlot", wireType)
+			}
+			m.NextSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextProposerIndex", wireType)
+			}
+			m.NextProposerIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextProposerIndex |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextProposerPublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextProposerPublicKey = append(m.NextProposerPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextProposerPublicKey == nil {
+				m.NextProposerPublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockAvailabilityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockAvailabilityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockAvailabilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSlot", wireType)
+			}
+			m.FromSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToSlot", wireType)
+			}
+			m.ToSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockAvailability) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockAvailability: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockAvailability: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSlot", wireType)
+			}
+			m.FromSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToSlot", wireType)
+			}
+			m.ToSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Available = append(m.Available[:0], dAtA[iNdEx:postIndex]...)
+			if m.Available == nil {
+				m.Available = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotsPerEpoch", wireType)
+			}
+			m.SlotsPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlotsPerEpoch |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsPerSlot", wireType)
+			}
+			m.SecondsPerSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SecondsPerSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisForkVersion", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisForkVersion = append(m.GenesisForkVersion[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisForkVersion == nil {
+				m.GenesisForkVersion = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositChainId", wireType)
+			}
+			m.DepositChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositNetworkId", wireType)
+			}
+			m.DepositNetworkId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositNetworkId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Presets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Presets = append(m.Presets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetValidatorSetDeltaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetValidatorSetDeltaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetValidatorSetDeltaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= v1alpha1.ValidatorStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveBalance", wireType)
+			}
+			m.EffectiveBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveBalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSetDelta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSetDelta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSetDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCount", wireType)
+			}
+			m.ValidatorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Added", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Added = append(m.Added, &ValidatorRecord{})
+			if err := m.Added[len(m.Added)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changed = append(m.Changed, &ValidatorRecord{})
+			if err := m.Changed[len(m.Changed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Removed = append(m.Removed, &ValidatorRecord{})
+			if err := m.Removed[len(m.Removed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrefetchEpochInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrefetchEpochInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrefetchEpochInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowGaps", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowGaps = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrefetchJob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrefetchJob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrefetchJob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			m.JobId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPrefetchStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPrefetchStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPrefetchStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			m.JobId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrefetchStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrefetchStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrefetchStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			m.JobId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			m.Completed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Completed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Done = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gaps = append(m.Gaps, &EpochGap{})
+			if err := m.Gaps[len(m.Gaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochGap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochGap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochGap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListCanonicalBlockRootsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListCanonicalBlockRootsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListCanonicalBlockRootsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSlot", wireType)
+			}
+			m.FromSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToSlot", wireType)
+			}
+			m.ToSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CanonicalBlockRoots) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanonicalBlockRoots: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanonicalBlockRoots: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roots = append(m.Roots, &CanonicalBlockRoot{})
+			if err := m.Roots[len(m.Roots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CanonicalBlockRoot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanonicalBlockRoot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanonicalBlockRoot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamProposerAuditRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamProposerAuditRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamProposerAuditRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackedOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TrackedOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposerAudit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposerAudit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposerAudit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedProposer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedProposer = append(m.ExpectedProposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExpectedProposer == nil {
+				m.ExpectedProposer = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualProposer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActualProposer = append(m.ActualProposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.ActualProposer == nil {
+				m.ActualProposer = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= ProposerAudit_Outcome(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrackValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrackValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrackValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Add", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Add = append(m.Add, make([]byte, postIndex-iNdEx))
+			copy(m.Add[len(m.Add)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remove = append(m.Remove, make([]byte, postIndex-iNdEx))
+			copy(m.Remove[len(m.Remove)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrackedValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrackedValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrackedValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImportTrackedValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportTrackedValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportTrackedValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replace", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replace = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrackedValidatorsExport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrackedValidatorsExport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrackedValidatorsExport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListPoolAttestationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListPoolAttestationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListPoolAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			var v github_com_prysmaticlabs_eth2_types.Slot
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SlotFilter = &ListPoolAttestationsRequest_Slot{v}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+			}
+			var v github_com_prysmaticlabs_eth2_types.CommitteeIndex
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= github_com_prysmaticlabs_eth2_types.CommitteeIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommitteeFilter = &ListPoolAttestationsRequest_CommitteeIndex{v}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolAttestations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolAttestations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolAttestations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Committees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Committees = append(m.Committees, &PoolCommitteeAttestations{})
+			if err := m.Committees[len(m.Committees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolCommitteeAttestations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolCommitteeAttestations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolCommitteeAttestations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+			}
+			m.CommitteeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeIndex |= github_com_prysmaticlabs_eth2_types.CommitteeIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unaggregated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unaggregated = append(m.Unaggregated, &v1alpha1.Attestation{})
+			if err := m.Unaggregated[len(m.Unaggregated)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aggregated = append(m.Aggregated, &v1alpha1.Attestation{})
+			if err := m.Aggregated[len(m.Aggregated)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			m.Participants = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Participants |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Eth1DataStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Eth1DataStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Eth1DataStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodStartSlot", wireType)
+			}
+			m.VotingPeriodStartSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPeriodStartSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextVotingPeriodStartSlot", wireType)
+			}
+			m.NextVotingPeriodStartSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextVotingPeriodStartSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Eth1Data == nil {
+				m.Eth1Data = &v1alpha1.Eth1Data{}
+			}
+			if err := m.Eth1Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1DepositIndex", wireType)
+			}
+			m.Eth1DepositIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Eth1DepositIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDeposits", wireType)
+			}
+			m.PendingDeposits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingDeposits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			m.Votes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Votes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Vote == nil {
+				m.Vote = &v1alpha1.Eth1Data{}
+			}
+			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteSupport", wireType)
+			}
+			m.VoteSupport = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteSupport |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredSupport", wireType)
+			}
+			m.RequiredSupport = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequiredSupport |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositInclusion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositInclusion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositInclusion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalCredentials", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalCredentials = append(m.WithdrawalCredentials[:0], dAtA[iNdEx:postIndex]...)
+			if m.WithdrawalCredentials == nil {
+				m.WithdrawalCredentials = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateEpochTransitionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateEpochTransitionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateEpochTransitionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.StateFilter = &SimulateEpochTransitionRequest_StateRoot{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			var v github_com_prysmaticlabs_eth2_types.Slot
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StateFilter = &SimulateEpochTransitionRequest_Slot{v}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochTransitionSimulation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochTransitionSimulation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochTransitionSimulation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousJustifiedCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousJustifiedCheckpoint == nil {
+				m.PreviousJustifiedCheckpoint = &v1alpha1.Checkpoint{}
+			}
+			if err := m.PreviousJustifiedCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentJustifiedCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CurrentJustifiedCheckpoint == nil {
+				m.CurrentJustifiedCheckpoint = &v1alpha1.Checkpoint{}
+			}
+			if err := m.CurrentJustifiedCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalizedCheckpoint == nil {
+				m.FinalizedCheckpoint = &v1alpha1.Checkpoint{}
+			}
+			if err := m.FinalizedCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostPreviousJustifiedCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PostPreviousJustifiedCheckpoint == nil {
+				m.PostPreviousJustifiedCheckpoint = &v1alpha1.Checkpoint{}
+			}
+			if err := m.PostPreviousJustifiedCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostCurrentJustifiedCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PostCurrentJustifiedCheckpoint == nil {
+				m.PostCurrentJustifiedCheckpoint = &v1alpha1.Checkpoint{}
+			}
+			if err := m.PostCurrentJustifiedCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostFinalizedCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PostFinalizedCheckpoint == nil {
+				m.PostFinalizedCheckpoint = &v1alpha1.Checkpoint{}
+			}
+			if err := m.PostFinalizedCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustificationChanged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.JustificationChanged = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizationChanged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FinalizationChanged = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Penalties = append(m.Penalties, &ValidatorPenalty{})
+			if err := m.Penalties[len(m.Penalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPenalties", wireType)
+			}
+			m.TotalPenalties = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPenalties |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType == 0 {
+				var v github_com_prysmaticlabs_eth2_types.ValidatorIndex
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.EjectedValidators = append(m.EjectedValidators, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBeaconQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBeaconQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.EjectedValidators) == 0 {
+					m.EjectedValidators = make([]github_com_prysmaticlabs_eth2_types.ValidatorIndex, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v github_com_prysmaticlabs_eth2_types.ValidatorIndex
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBeaconQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.EjectedValidators = append(m.EjectedValidators, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field EjectedValidators", wireType)
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochProposers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextEpochProposers = append(m.NextEpochProposers, make([]byte, postIndex-iNdEx))
+			copy(m.NextEpochProposers[len(m.NextEpochProposers)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerListRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerListRoot = append(m.ProposerListRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerListRoot == nil {
+				m.ProposerListRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPenalty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPenalty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPenalty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListCanonicalBlockHeadersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListCanonicalBlockHeadersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListCanonicalBlockHeadersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSlot", wireType)
+			}
+			m.FromSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToSlot", wireType)
+			}
+			m.ToSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CanonicalBlockHeaders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanonicalBlockHeaders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanonicalBlockHeaders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, &CanonicalBlockHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CanonicalBlockHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanonicalBlockHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanonicalBlockHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &v1alpha1.BeaconBlockHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Confirmation == nil {
+				m.Confirmation = &PandoraConfirmation{}
+			}
+			if err := m.Confirmation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PandoraConfirmation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PandoraConfirmation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PandoraConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PandoraHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PandoraHash = append(m.PandoraHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.PandoraHash == nil {
+				m.PandoraHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PandoraConfirmation_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockByPandoraHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockByPandoraHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockByPandoraHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PandoraHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PandoraHash = append(m.PandoraHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.PandoraHash == nil {
+				m.PandoraHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairedBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairedBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairedBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &v1alpha1.BeaconBlockContainer{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Confirmation == nil {
+				m.Confirmation = &PandoraConfirmation{}
+			}
+			if err := m.Confirmation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposerListConsistencyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposerListConsistencyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposerListConsistencyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposerListConsistency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposerListConsistency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposerListConsistency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = append(m.Digest[:0], dAtA[iNdEx:postIndex]...)
+			if m.Digest == nil {
+				m.Digest = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peers", wireType)
+			}
+			m.Peers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Peers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchingPeers", wireType)
+			}
+			m.MatchingPeers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchingPeers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Score = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DivergentPeers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DivergentPeers = append(m.DivergentPeers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitteeParticipation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitteeParticipation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitteeParticipation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+			}
+			m.CommitteeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeIndex |= github_com_prysmaticlabs_eth2_types.CommitteeIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expected", wireType)
+			}
+			m.Expected = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expected |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seen", wireType)
+			}
+			m.Seen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Included", wireType)
+			}
+			m.Included = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Included |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Rate = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlotCommitteeParticipation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlotCommitteeParticipation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlotCommitteeParticipation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Committees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Committees = append(m.Committees, &CommitteeParticipation{})
+			if err := m.Committees[len(m.Committees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expected", wireType)
+			}
+			m.Expected = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expected |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seen", wireType)
+			}
+			m.Seen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Included", wireType)
+			}
+			m.Included = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Included |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Rate = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurrentEpochParticipation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrentEpochParticipation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrentEpochParticipation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSlot", wireType)
+			}
+			m.CurrentSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slots = append(m.Slots, &SlotCommitteeParticipation{})
+			if err := m.Slots[len(m.Slots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expected", wireType)
+			}
+			m.Expected = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expected |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seen", wireType)
+			}
+			m.Seen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Included", wireType)
+			}
+			m.Included = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Included |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Rate = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnnotatedValidatorAssignmentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnnotatedValidatorAssignmentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnnotatedValidatorAssignmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var v github_com_prysmaticlabs_eth2_types.Epoch
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QueryFilter = &AnnotatedValidatorAssignmentsRequest_Epoch{v}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Genesis", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.QueryFilter = &AnnotatedValidatorAssignmentsRequest_Genesis{b}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v github_com_prysmaticlabs_eth2_types.ValidatorIndex
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBeaconQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBeaconQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]github_com_prysmaticlabs_eth2_types.ValidatorIndex, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v github_com_prysmaticlabs_eth2_types.ValidatorIndex
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBeaconQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeWeights", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommitteeWeights = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.QueryFilter = &AnnotatedValidatorAssignmentsRequest_BlockRoot{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.QueryFilter = &AnnotatedValidatorAssignmentsRequest_StateRoot{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeValidatorFields", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeValidatorFields = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackedOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TrackedOnly = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IndexOnly = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalCredentialsPrefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalCredentialsPrefix = append(m.WithdrawalCredentialsPrefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.WithdrawalCredentialsPrefix == nil {
+				m.WithdrawalCredentialsPrefix = []byte{}
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compact", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Compact = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnnotatedValidatorAssignments) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnnotatedValidatorAssignments: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnnotatedValidatorAssignments: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assignments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Assignments == nil {
+				m.Assignments = &v1alpha1.ValidatorAssignments{}
+			}
+			if err := m.Assignments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerListRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerListRoot = append(m.ProposerListRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerListRoot == nil {
+				m.ProposerListRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexMismatches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexMismatches = append(m.IndexMismatches, &ValidatorIndexMismatch{})
+			if err := m.IndexMismatches[len(m.IndexMismatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitteeWeights = append(m.CommitteeWeights, &CommitteeWeight{})
+			if err := m.CommitteeWeights[len(m.CommitteeWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedPageSize", wireType)
+			}
+			m.AppliedPageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppliedPageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorFields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorFields = append(m.ValidatorFields, &ValidatorFields{})
+			if err := m.ValidatorFields[len(m.ValidatorFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteePositions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitteePositions = append(m.CommitteePositions, &CommitteePosition{})
+			if err := m.CommitteePositions[len(m.CommitteePositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorIndexMismatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorIndexMismatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorIndexMismatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedIndex", wireType)
+			}
+			m.RequestedIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestedIndex |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadIndex", wireType)
+			}
+			m.HeadIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadIndex |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InHead", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InHead = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitteeWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitteeWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitteeWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+			}
+			m.CommitteeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeIndex |= github_com_prysmaticlabs_eth2_types.CommitteeIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEffectiveBalance", wireType)
+			}
+			m.TotalEffectiveBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalEffectiveBalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationWeight", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.AttestationWeight = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorFields) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorFields: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorFields: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveBalance", wireType)
+			}
+			m.EffectiveBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveBalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationEligibilityEpoch", wireType)
+			}
+			m.ActivationEligibilityEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationEligibilityEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationEpoch", wireType)
+			}
+			m.ActivationEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitEpoch", wireType)
+			}
+			m.ExitEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorsByWithdrawalCredentialsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorsByWithdrawalCredentialsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorsByWithdrawalCredentialsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &v1alpha1.ListValidatorsRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalCredentialsPrefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalCredentialsPrefix = append(m.WithdrawalCredentialsPrefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.WithdrawalCredentialsPrefix == nil {
+				m.WithdrawalCredentialsPrefix = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitteePosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitteePosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitteePosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeSize", wireType)
+			}
+			m.CommitteeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageGrowthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageGrowthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageGrowthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Days", wireType)
+			}
+			m.Days = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Days |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageGrowthEstimate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageGrowthEstimate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageGrowthEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCount", wireType)
+			}
+			m.ValidatorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotsPerArchivedPoint", wireType)
+			}
+			m.SlotsPerArchivedPoint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlotsPerArchivedPoint |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColdStateStore", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ColdStateStore = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDedup", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ValidatorDedup = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSizeBytes", wireType)
+			}
+			m.CurrentSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentSizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateSizeBytes", wireType)
+			}
+			m.StateSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StateSizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedStateSizeBytes", wireType)
+			}
+			m.ArchivedStateSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ArchivedStateSizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSizeBytes", wireType)
+			}
+			m.BlockSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockSizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesPerDay", wireType)
+			}
+			m.BytesPerDay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesPerDay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColdStoreBytesPerDay", wireType)
+			}
+			m.ColdStoreBytesPerDay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ColdStoreBytesPerDay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Days", wireType)
+			}
+			m.Days = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Days |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedSizeBytes", wireType)
+			}
+			m.ProjectedSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProjectedSizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedColdStoreGrowthBytes", wireType)
+			}
+			m.ProjectedColdStoreGrowthBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProjectedColdStoreGrowthBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PandoraBlockHashConfirmation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PandoraBlockHashConfirmation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PandoraBlockHashConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PandoraConfirmation_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfirmPandoraBlockHashesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfirmPandoraBlockHashesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfirmPandoraBlockHashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Confirmations = append(m.Confirmations, &PandoraBlockHashConfirmation{})
+			if err := m.Confirmations[len(m.Confirmations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfirmPandoraBlockHashesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfirmPandoraBlockHashesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfirmPandoraBlockHashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stored", wireType)
+			}
+			m.Stored = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stored |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v github_com_prysmaticlabs_eth2_types.Slot
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UnknownSlots = append(m.UnknownSlots, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBeaconQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBeaconQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UnknownSlots) == 0 {
+					m.UnknownSlots = make([]github_com_prysmaticlabs_eth2_types.Slot, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v github_com_prysmaticlabs_eth2_types.Slot
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBeaconQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UnknownSlots = append(m.UnknownSlots, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnknownSlots", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposerStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposerStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposerStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposerStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposerStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposerStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalDuties", wireType)
+			}
+			m.ProposalDuties = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalDuties |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedBlocks", wireType)
+			}
+			m.ProposedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposedBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedProposals", wireType)
+			}
+			m.MissedProposals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedProposals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageInclusionDelay", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.AverageInclusionDelay = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubnetAssignmentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubnetAssignmentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubnetAssignmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubnetAssignment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubnetAssignment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubnetAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttesterSlot", wireType)
+			}
+			m.AttesterSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttesterSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+			}
+			m.CommitteeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeIndex |= github_com_prysmaticlabs_eth2_types.CommitteeIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubnetId", wireType)
+			}
+			m.SubnetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubnetId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubnetAssignments) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubnetAssignments: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubnetAssignments: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assignments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assignments = append(m.Assignments, &SubnetAssignment{})
+			if err := m.Assignments[len(m.Assignments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListValidatorAssignmentsRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListValidatorAssignmentsRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListValidatorAssignmentsRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v github_com_prysmaticlabs_eth2_types.ValidatorIndex
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBeaconQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBeaconQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]github_com_prysmaticlabs_eth2_types.ValidatorIndex, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v github_com_prysmaticlabs_eth2_types.ValidatorIndex
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBeaconQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackedOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TrackedOnly = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IndexOnly = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeWeights", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommitteeWeights = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeValidatorFields", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeValidatorFields = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalCredentialsPrefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalCredentialsPrefix = append(m.WithdrawalCredentialsPrefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.WithdrawalCredentialsPrefix == nil {
+				m.WithdrawalCredentialsPrefix = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compact", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Compact = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorAssignmentsRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorAssignmentsRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorAssignmentsRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, &v1alpha1.ValidatorAssignments{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexMismatches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexMismatches = append(m.IndexMismatches, &ValidatorIndexMismatch{})
+			if err := m.IndexMismatches[len(m.IndexMismatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerListRoots", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerListRoots = append(m.ProposerListRoots, make([]byte, postIndex-iNdEx))
+			copy(m.ProposerListRoots[len(m.ProposerListRoots)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitteeWeights = append(m.CommitteeWeights, &EpochCommitteeWeights{})
+			if err := m.CommitteeWeights[len(m.CommitteeWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorFields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorFields = append(m.ValidatorFields, &EpochValidatorFields{})
+			if err := m.ValidatorFields[len(m.ValidatorFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteePositions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitteePositions = append(m.CommitteePositions, &EpochCommitteePositions{})
+			if err := m.CommitteePositions[len(m.CommitteePositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochCommitteeWeights) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochCommitteeWeights: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochCommitteeWeights: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weights = append(m.Weights, &CommitteeWeight{})
+			if err := m.Weights[len(m.Weights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochValidatorFields) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochValidatorFields: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochValidatorFields: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, &ValidatorFields{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochCommitteePositions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochCommitteePositions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochCommitteePositions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, &CommitteePosition{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrecomputationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrecomputationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrecomputationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrecomputedEpoch", wireType)
+			}
+			m.LastPrecomputedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastPrecomputedEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stalled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stalled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StalledEpoch", wireType)
+			}
+			m.StalledEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StalledEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamEpochInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamEpochInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamEpochInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeFromEpoch", wireType)
+			}
+			var v github_com_prysmaticlabs_eth2_types.Epoch
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Resume = &StreamEpochInfoRequest_ResumeFromEpoch{v}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowGaps", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowGaps = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Shard == nil {
+				m.Shard = &EpochShard{}
+			}
+			if err := m.Shard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochShard) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochShard: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochShard: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modulus", wireType)
+			}
+			m.Modulus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Modulus |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remainder", wireType)
+			}
+			m.Remainder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remainder |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochInfoStreamMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochInfoStreamMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochInfoStreamMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &StreamEpochInfoRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Message = &EpochInfoStreamMessage_Request{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckEpoch", wireType)
+			}
+			var v github_com_prysmaticlabs_eth2_types.Epoch
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Message = &EpochInfoStreamMessage_AckEpoch{v}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartTime", wireType)
+			}
+			m.EpochStartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochStartTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotDuration", wireType)
+			}
+			m.SlotDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlotDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposers = append(m.Proposers, make([]byte, postIndex-iNdEx))
+			copy(m.Proposers[len(m.Proposers)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerListRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerListRoot = append(m.ProposerListRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerListRoot == nil {
+				m.ProposerListRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkVersion", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForkVersion = append(m.ForkVersion[:0], dAtA[iNdEx:postIndex]...)
+			if m.ForkVersion == nil {
+				m.ForkVersion = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkDigest", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForkDigest = append(m.ForkDigest[:0], dAtA[iNdEx:postIndex]...)
+			if m.ForkDigest == nil {
+				m.ForkDigest = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReorgFlag", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReorgFlag = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provisional", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Provisional = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochInfoAccumulatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochInfoAccumulatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochInfoAccumulatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var v github_com_prysmaticlabs_eth2_types.Epoch
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QueryFilter = &EpochInfoAccumulatorRequest_Epoch{v}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochInfoAccumulator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochInfoAccumulator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochInfoAccumulator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnchorEpoch", wireType)
+			}
+			m.AnchorEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AnchorEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposerListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposerListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposerListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var v github_com_prysmaticlabs_eth2_types.Epoch
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QueryFilter = &ProposerListRequest_Epoch{v}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposerList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposerList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposerList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposers = append(m.Proposers, &SlotProposer{})
+			if err := m.Proposers[len(m.Proposers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerListRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerListRoot = append(m.ProposerListRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerListRoot == nil {
+				m.ProposerListRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provisional", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Provisional = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlotProposer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlotProposer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlotProposer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeaconQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBeaconQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBeaconQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBeaconQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBeaconQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBeaconQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBeaconQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBeaconQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBeaconQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBeaconQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,444 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.13.0
+// source: proto/beacon/rpc/v1/admin.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	reflect "reflect"
+	sync "sync"
+
+	proto "github.com/golang/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type AdminSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to MaxPageSizeSetting:
+	//	*AdminSettings_MaxPageSize
+	MaxPageSizeSetting isAdminSettings_MaxPageSizeSetting `protobuf_oneof:"max_page_size_setting"`
+	// Types that are assignable to MaxStateReplaysSetting:
+	//	*AdminSettings_MaxStateReplays
+	MaxStateReplaysSetting isAdminSettings_MaxStateReplaysSetting `protobuf_oneof:"max_state_replays_setting"`
+	// Types that are assignable to EpochInfoCacheSizeSetting:
+	//	*AdminSettings_EpochInfoCacheSize
+	EpochInfoCacheSizeSetting isAdminSettings_EpochInfoCacheSizeSetting `protobuf_oneof:"epoch_info_cache_size_setting"`
+	// Types that are assignable to EpochStateCacheSizeSetting:
+	//	*AdminSettings_EpochStateCacheSize
+	EpochStateCacheSizeSetting isAdminSettings_EpochStateCacheSizeSetting `protobuf_oneof:"epoch_state_cache_size_setting"`
+	// Types that are assignable to LogLevelSetting:
+	//	*AdminSettings_LogLevel
+	LogLevelSetting isAdminSettings_LogLevelSetting `protobuf_oneof:"log_level_setting"`
+}
+
+func (x *AdminSettings) Reset() {
+	*x = AdminSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_admin_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSettings) ProtoMessage() {}
+
+func (x *AdminSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_admin_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSettings.ProtoReflect.Descriptor instead.
+func (*AdminSettings) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *AdminSettings) GetMaxPageSizeSetting() isAdminSettings_MaxPageSizeSetting {
+	if m != nil {
+		return m.MaxPageSizeSetting
+	}
+	return nil
+}
+
+func (x *AdminSettings) GetMaxPageSize() uint64 {
+	if x, ok := x.GetMaxPageSizeSetting().(*AdminSettings_MaxPageSize); ok {
+		return x.MaxPageSize
+	}
+	return 0
+}
+
+func (m *AdminSettings) GetMaxStateReplaysSetting() isAdminSettings_MaxStateReplaysSetting {
+	if m != nil {
+		return m.MaxStateReplaysSetting
+	}
+	return nil
+}
+
+func (x *AdminSettings) GetMaxStateReplays() uint64 {
+	if x, ok := x.GetMaxStateReplaysSetting().(*AdminSettings_MaxStateReplays); ok {
+		return x.MaxStateReplays
+	}
+	return 0
+}
+
+func (m *AdminSettings) GetEpochInfoCacheSizeSetting() isAdminSettings_EpochInfoCacheSizeSetting {
+	if m != nil {
+		return m.EpochInfoCacheSizeSetting
+	}
+	return nil
+}
+
+func (x *AdminSettings) GetEpochInfoCacheSize() uint64 {
+	if x, ok := x.GetEpochInfoCacheSizeSetting().(*AdminSettings_EpochInfoCacheSize); ok {
+		return x.EpochInfoCacheSize
+	}
+	return 0
+}
+
+func (m *AdminSettings) GetEpochStateCacheSizeSetting() isAdminSettings_EpochStateCacheSizeSetting {
+	if m != nil {
+		return m.EpochStateCacheSizeSetting
+	}
+	return nil
+}
+
+func (x *AdminSettings) GetEpochStateCacheSize() uint64 {
+	if x, ok := x.GetEpochStateCacheSizeSetting().(*AdminSettings_EpochStateCacheSize); ok {
+		return x.EpochStateCacheSize
+	}
+	return 0
+}
+
+func (m *AdminSettings) GetLogLevelSetting() isAdminSettings_LogLevelSetting {
+	if m != nil {
+		return m.LogLevelSetting
+	}
+	return nil
+}
+
+func (x *AdminSettings) GetLogLevel() string {
+	if x, ok := x.GetLogLevelSetting().(*AdminSettings_LogLevel); ok {
+		return x.LogLevel
+	}
+	return ""
+}
+
+type isAdminSettings_MaxPageSizeSetting interface {
+	isAdminSettings_MaxPageSizeSetting()
+}
+
+type AdminSettings_MaxPageSize struct {
+	MaxPageSize uint64 `protobuf:"varint,1,opt,name=max_page_size,json=maxPageSize,proto3,oneof"`
+}
+
+func (*AdminSettings_MaxPageSize) isAdminSettings_MaxPageSizeSetting() {}
+
+type isAdminSettings_MaxStateReplaysSetting interface {
+	isAdminSettings_MaxStateReplaysSetting()
+}
+
+type AdminSettings_MaxStateReplays struct {
+	MaxStateReplays uint64 `protobuf:"varint,2,opt,name=max_state_replays,json=maxStateReplays,proto3,oneof"`
+}
+
+func (*AdminSettings_MaxStateReplays) isAdminSettings_MaxStateReplaysSetting() {}
+
+type isAdminSettings_EpochInfoCacheSizeSetting interface {
+	isAdminSettings_EpochInfoCacheSizeSetting()
+}
+
+type AdminSettings_EpochInfoCacheSize struct {
+	EpochInfoCacheSize uint64 `protobuf:"varint,3,opt,name=epoch_info_cache_size,json=epochInfoCacheSize,proto3,oneof"`
+}
+
+func (*AdminSettings_EpochInfoCacheSize) isAdminSettings_EpochInfoCacheSizeSetting() {}
+
+type isAdminSettings_EpochStateCacheSizeSetting interface {
+	isAdminSettings_EpochStateCacheSizeSetting()
+}
+
+type AdminSettings_EpochStateCacheSize struct {
+	EpochStateCacheSize uint64 `protobuf:"varint,4,opt,name=epoch_state_cache_size,json=epochStateCacheSize,proto3,oneof"`
+}
+
+func (*AdminSettings_EpochStateCacheSize) isAdminSettings_EpochStateCacheSizeSetting() {}
+
+type isAdminSettings_LogLevelSetting interface {
+	isAdminSettings_LogLevelSetting()
+}
+
+type AdminSettings_LogLevel struct {
+	LogLevel string `protobuf:"bytes,5,opt,name=log_level,json=logLevel,proto3,oneof"`
+}
+
+func (*AdminSettings_LogLevel) isAdminSettings_LogLevelSetting() {}
+
+var File_proto_beacon_rpc_v1_admin_proto protoreflect.FileDescriptor
+
+var file_proto_beacon_rpc_v1_admin_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x72,
+	0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x16, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfc, 0x02, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48,
+	0x00, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2c,
+	0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x0f, 0x6d, 0x61, 0x78,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x33, 0x0a, 0x15,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x02, 0x52, 0x12, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x35, 0x0a, 0x16, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x48, 0x03, 0x52, 0x13, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x08, 0x6c,
+	0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x17, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x42, 0x1b, 0x0a, 0x19, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x73, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x1f, 0x0a,
+	0x1d, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x20,
+	0x0a, 0x1e, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x42, 0x13, 0x0a, 0x11, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x32, 0xb9, 0x01, 0x0a, 0x05, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12,
+	0x4e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x25, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
+	0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x00, 0x12,
+	0x60, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x25, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x25, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72,
+	0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22,
+	0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_beacon_rpc_v1_admin_proto_rawDescOnce sync.Once
+	file_proto_beacon_rpc_v1_admin_proto_rawDescData = file_proto_beacon_rpc_v1_admin_proto_rawDesc
+)
+
+func file_proto_beacon_rpc_v1_admin_proto_rawDescGZIP() []byte {
+	file_proto_beacon_rpc_v1_admin_proto_rawDescOnce.Do(func() {
+		file_proto_beacon_rpc_v1_admin_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_beacon_rpc_v1_admin_proto_rawDescData)
+	})
+	return file_proto_beacon_rpc_v1_admin_proto_rawDescData
+}
+
+var file_proto_beacon_rpc_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_proto_beacon_rpc_v1_admin_proto_goTypes = []interface{}{
+	(*AdminSettings)(nil), // 0: ethereum.beacon.rpc.v1.AdminSettings
+	(*empty.Empty)(nil),   // 1: google.protobuf.Empty
+}
+var file_proto_beacon_rpc_v1_admin_proto_depIdxs = []int32{
+	1, // 0: ethereum.beacon.rpc.v1.Admin.GetSettings:input_type -> google.protobuf.Empty
+	0, // 1: ethereum.beacon.rpc.v1.Admin.UpdateSettings:input_type -> ethereum.beacon.rpc.v1.AdminSettings
+	0, // 2: ethereum.beacon.rpc.v1.Admin.GetSettings:output_type -> ethereum.beacon.rpc.v1.AdminSettings
+	0, // 3: ethereum.beacon.rpc.v1.Admin.UpdateSettings:output_type -> ethereum.beacon.rpc.v1.AdminSettings
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_proto_beacon_rpc_v1_admin_proto_init() }
+func file_proto_beacon_rpc_v1_admin_proto_init() {
+	if File_proto_beacon_rpc_v1_admin_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_beacon_rpc_v1_admin_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_proto_beacon_rpc_v1_admin_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*AdminSettings_MaxPageSize)(nil),
+		(*AdminSettings_MaxStateReplays)(nil),
+		(*AdminSettings_EpochInfoCacheSize)(nil),
+		(*AdminSettings_EpochStateCacheSize)(nil),
+		(*AdminSettings_LogLevel)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_beacon_rpc_v1_admin_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_beacon_rpc_v1_admin_proto_goTypes,
+		DependencyIndexes: file_proto_beacon_rpc_v1_admin_proto_depIdxs,
+		MessageInfos:      file_proto_beacon_rpc_v1_admin_proto_msgTypes,
+	}.Build()
+	File_proto_beacon_rpc_v1_admin_proto = out.File
+	file_proto_beacon_rpc_v1_admin_proto_rawDesc = nil
+	file_proto_beacon_rpc_v1_admin_proto_goTypes = nil
+	file_proto_beacon_rpc_v1_admin_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AdminClient interface {
+	GetSettings(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*AdminSettings, error)
+	UpdateSettings(ctx context.Context, in *AdminSettings, opts ...grpc.CallOption) (*AdminSettings, error)
+}
+
+type adminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminClient(cc grpc.ClientConnInterface) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) GetSettings(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*AdminSettings, error) {
+	out := new(AdminSettings)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Admin/GetSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) UpdateSettings(ctx context.Context, in *AdminSettings, opts ...grpc.CallOption) (*AdminSettings, error) {
+	out := new(AdminSettings)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Admin/UpdateSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServer is the server API for Admin service.
+type AdminServer interface {
+	GetSettings(context.Context, *empty.Empty) (*AdminSettings, error)
+	UpdateSettings(context.Context, *AdminSettings) (*AdminSettings, error)
+}
+
+// UnimplementedAdminServer can be embedded to have forward compatible implementations.
+type UnimplementedAdminServer struct {
+}
+
+func (*UnimplementedAdminServer) GetSettings(context.Context, *empty.Empty) (*AdminSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSettings not implemented")
+}
+func (*UnimplementedAdminServer) UpdateSettings(context.Context, *AdminSettings) (*AdminSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSettings not implemented")
+}
+
+func RegisterAdminServer(s *grpc.Server, srv AdminServer) {
+	s.RegisterService(&_Admin_serviceDesc, srv)
+}
+
+func _Admin_GetSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Admin/GetSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetSettings(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_UpdateSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSettings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).UpdateSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Admin/UpdateSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).UpdateSettings(ctx, req.(*AdminSettings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Admin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.Admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSettings",
+			Handler:    _Admin_GetSettings_Handler,
+		},
+		{
+			MethodName: "UpdateSettings",
+			Handler:    _Admin_UpdateSettings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/admin.proto",
+}
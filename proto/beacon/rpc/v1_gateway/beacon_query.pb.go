@@ -0,0 +1,13271 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.13.0
+// source: proto/beacon/rpc/v1/beacon_query.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	reflect "reflect"
+	sync "sync"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/golang/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	v1alpha1 "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type ProposerAudit_Outcome int32
+
+const (
+	ProposerAudit_PRODUCED ProposerAudit_Outcome = 0
+	ProposerAudit_MISSED   ProposerAudit_Outcome = 1
+	ProposerAudit_MISMATCH ProposerAudit_Outcome = 2
+)
+
+// Enum value maps for ProposerAudit_Outcome.
+var (
+	ProposerAudit_Outcome_name = map[int32]string{
+		0: "PRODUCED",
+		1: "MISSED",
+		2: "MISMATCH",
+	}
+	ProposerAudit_Outcome_value = map[string]int32{
+		"PRODUCED": 0,
+		"MISSED":   1,
+		"MISMATCH": 2,
+	}
+)
+
+func (x ProposerAudit_Outcome) Enum() *ProposerAudit_Outcome {
+	p := new(ProposerAudit_Outcome)
+	*p = x
+	return p
+}
+
+func (x ProposerAudit_Outcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProposerAudit_Outcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_enumTypes[0].Descriptor()
+}
+
+func (ProposerAudit_Outcome) Type() protoreflect.EnumType {
+	return &file_proto_beacon_rpc_v1_beacon_query_proto_enumTypes[0]
+}
+
+func (x ProposerAudit_Outcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProposerAudit_Outcome.Descriptor instead.
+func (ProposerAudit_Outcome) EnumDescriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{49, 0}
+}
+
+type PandoraConfirmation_Status int32
+
+const (
+	PandoraConfirmation_PENDING  PandoraConfirmation_Status = 0
+	PandoraConfirmation_VERIFIED PandoraConfirmation_Status = 1
+	PandoraConfirmation_INVALID  PandoraConfirmation_Status = 2
+)
+
+// Enum value maps for PandoraConfirmation_Status.
+var (
+	PandoraConfirmation_Status_name = map[int32]string{
+		0: "PENDING",
+		1: "VERIFIED",
+		2: "INVALID",
+	}
+	PandoraConfirmation_Status_value = map[string]int32{
+		"PENDING":  0,
+		"VERIFIED": 1,
+		"INVALID":  2,
+	}
+)
+
+func (x PandoraConfirmation_Status) Enum() *PandoraConfirmation_Status {
+	p := new(PandoraConfirmation_Status)
+	*p = x
+	return p
+}
+
+func (x PandoraConfirmation_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PandoraConfirmation_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_enumTypes[1].Descriptor()
+}
+
+func (PandoraConfirmation_Status) Type() protoreflect.EnumType {
+	return &file_proto_beacon_rpc_v1_beacon_query_proto_enumTypes[1]
+}
+
+func (x PandoraConfirmation_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PandoraConfirmation_Status.Descriptor instead.
+func (PandoraConfirmation_Status) EnumDescriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{65, 0}
+}
+
+type ValidatorLivenessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch   uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Indices []uint64 `protobuf:"varint,2,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+}
+
+func (x *ValidatorLivenessRequest) Reset() {
+	*x = ValidatorLivenessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorLivenessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorLivenessRequest) ProtoMessage() {}
+
+func (x *ValidatorLivenessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorLivenessRequest.ProtoReflect.Descriptor instead.
+func (*ValidatorLivenessRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ValidatorLivenessRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ValidatorLivenessRequest) GetIndices() []uint64 {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+type ValidatorLivenessResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch    uint64               `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Liveness []*ValidatorLiveness `protobuf:"bytes,2,rep,name=liveness,proto3" json:"liveness,omitempty"`
+}
+
+func (x *ValidatorLivenessResponse) Reset() {
+	*x = ValidatorLivenessResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorLivenessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorLivenessResponse) ProtoMessage() {}
+
+func (x *ValidatorLivenessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorLivenessResponse.ProtoReflect.Descriptor instead.
+func (*ValidatorLivenessResponse) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ValidatorLivenessResponse) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ValidatorLivenessResponse) GetLiveness() []*ValidatorLiveness {
+	if x != nil {
+		return x.Liveness
+	}
+	return nil
+}
+
+type ValidatorLiveness struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index  uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	IsLive bool   `protobuf:"varint,2,opt,name=is_live,json=isLive,proto3" json:"is_live,omitempty"`
+}
+
+func (x *ValidatorLiveness) Reset() {
+	*x = ValidatorLiveness{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorLiveness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorLiveness) ProtoMessage() {}
+
+func (x *ValidatorLiveness) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorLiveness.ProtoReflect.Descriptor instead.
+func (*ValidatorLiveness) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ValidatorLiveness) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ValidatorLiveness) GetIsLive() bool {
+	if x != nil {
+		return x.IsLive
+	}
+	return false
+}
+
+type CommitteeRootsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromEpoch uint64 `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	ToEpoch   uint64 `protobuf:"varint,2,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty"`
+}
+
+func (x *CommitteeRootsRequest) Reset() {
+	*x = CommitteeRootsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitteeRootsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitteeRootsRequest) ProtoMessage() {}
+
+func (x *CommitteeRootsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitteeRootsRequest.ProtoReflect.Descriptor instead.
+func (*CommitteeRootsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CommitteeRootsRequest) GetFromEpoch() uint64 {
+	if x != nil {
+		return x.FromEpoch
+	}
+	return 0
+}
+
+func (x *CommitteeRootsRequest) GetToEpoch() uint64 {
+	if x != nil {
+		return x.ToEpoch
+	}
+	return 0
+}
+
+type CommitteeRoots struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Roots []*CommitteeRoot `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+}
+
+func (x *CommitteeRoots) Reset() {
+	*x = CommitteeRoots{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitteeRoots) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitteeRoots) ProtoMessage() {}
+
+func (x *CommitteeRoots) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitteeRoots.ProtoReflect.Descriptor instead.
+func (*CommitteeRoots) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CommitteeRoots) GetRoots() []*CommitteeRoot {
+	if x != nil {
+		return x.Roots
+	}
+	return nil
+}
+
+type CommitteeRoot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch                uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	RandaoMix            []byte `protobuf:"bytes,2,opt,name=randao_mix,json=randaoMix,proto3" json:"randao_mix,omitempty"`
+	ProposerSeed         []byte `protobuf:"bytes,3,opt,name=proposer_seed,json=proposerSeed,proto3" json:"proposer_seed,omitempty"`
+	AttesterSeed         []byte `protobuf:"bytes,4,opt,name=attester_seed,json=attesterSeed,proto3" json:"attester_seed,omitempty"`
+	ActiveIndexRoot      []byte `protobuf:"bytes,5,opt,name=active_index_root,json=activeIndexRoot,proto3" json:"active_index_root,omitempty"`
+	ActiveValidatorCount uint64 `protobuf:"varint,6,opt,name=active_validator_count,json=activeValidatorCount,proto3" json:"active_validator_count,omitempty"`
+}
+
+func (x *CommitteeRoot) Reset() {
+	*x = CommitteeRoot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitteeRoot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitteeRoot) ProtoMessage() {}
+
+func (x *CommitteeRoot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitteeRoot.ProtoReflect.Descriptor instead.
+func (*CommitteeRoot) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CommitteeRoot) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *CommitteeRoot) GetRandaoMix() []byte {
+	if x != nil {
+		return x.RandaoMix
+	}
+	return nil
+}
+
+func (x *CommitteeRoot) GetProposerSeed() []byte {
+	if x != nil {
+		return x.ProposerSeed
+	}
+	return nil
+}
+
+func (x *CommitteeRoot) GetAttesterSeed() []byte {
+	if x != nil {
+		return x.AttesterSeed
+	}
+	return nil
+}
+
+func (x *CommitteeRoot) GetActiveIndexRoot() []byte {
+	if x != nil {
+		return x.ActiveIndexRoot
+	}
+	return nil
+}
+
+func (x *CommitteeRoot) GetActiveValidatorCount() uint64 {
+	if x != nil {
+		return x.ActiveValidatorCount
+	}
+	return 0
+}
+
+type ExplainShuffleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *ExplainShuffleRequest) Reset() {
+	*x = ExplainShuffleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainShuffleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainShuffleRequest) ProtoMessage() {}
+
+func (x *ExplainShuffleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainShuffleRequest.ProtoReflect.Descriptor instead.
+func (*ExplainShuffleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ExplainShuffleRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ExplainShuffleRequest) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type ShuffleExplanation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Index                uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Seed                 []byte   `protobuf:"bytes,3,opt,name=seed,proto3" json:"seed,omitempty"`
+	ActiveValidatorCount uint64   `protobuf:"varint,4,opt,name=active_validator_count,json=activeValidatorCount,proto3" json:"active_validator_count,omitempty"`
+	ActivePosition       uint64   `protobuf:"varint,5,opt,name=active_position,json=activePosition,proto3" json:"active_position,omitempty"`
+	ShuffledPosition     uint64   `protobuf:"varint,6,opt,name=shuffled_position,json=shuffledPosition,proto3" json:"shuffled_position,omitempty"`
+	RoundOutputs         []uint64 `protobuf:"varint,7,rep,packed,name=round_outputs,json=roundOutputs,proto3" json:"round_outputs,omitempty"`
+	Slot                 uint64   `protobuf:"varint,8,opt,name=slot,proto3" json:"slot,omitempty"`
+	CommitteeIndex       uint64   `protobuf:"varint,9,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
+	CommitteePosition    uint64   `protobuf:"varint,10,opt,name=committee_position,json=committeePosition,proto3" json:"committee_position,omitempty"`
+}
+
+func (x *ShuffleExplanation) Reset() {
+	*x = ShuffleExplanation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShuffleExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShuffleExplanation) ProtoMessage() {}
+
+func (x *ShuffleExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShuffleExplanation.ProtoReflect.Descriptor instead.
+func (*ShuffleExplanation) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ShuffleExplanation) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ShuffleExplanation) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ShuffleExplanation) GetSeed() []byte {
+	if x != nil {
+		return x.Seed
+	}
+	return nil
+}
+
+func (x *ShuffleExplanation) GetActiveValidatorCount() uint64 {
+	if x != nil {
+		return x.ActiveValidatorCount
+	}
+	return 0
+}
+
+func (x *ShuffleExplanation) GetActivePosition() uint64 {
+	if x != nil {
+		return x.ActivePosition
+	}
+	return 0
+}
+
+func (x *ShuffleExplanation) GetShuffledPosition() uint64 {
+	if x != nil {
+		return x.ShuffledPosition
+	}
+	return 0
+}
+
+func (x *ShuffleExplanation) GetRoundOutputs() []uint64 {
+	if x != nil {
+		return x.RoundOutputs
+	}
+	return nil
+}
+
+func (x *ShuffleExplanation) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *ShuffleExplanation) GetCommitteeIndex() uint64 {
+	if x != nil {
+		return x.CommitteeIndex
+	}
+	return 0
+}
+
+func (x *ShuffleExplanation) GetCommitteePosition() uint64 {
+	if x != nil {
+		return x.CommitteePosition
+	}
+	return 0
+}
+
+type GetStateDiffRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromEpoch uint64   `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	ToEpoch   uint64   `protobuf:"varint,2,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty"`
+	Indices   []uint64 `protobuf:"varint,3,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+}
+
+func (x *GetStateDiffRequest) Reset() {
+	*x = GetStateDiffRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStateDiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStateDiffRequest) ProtoMessage() {}
+
+func (x *GetStateDiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStateDiffRequest.ProtoReflect.Descriptor instead.
+func (*GetStateDiffRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetStateDiffRequest) GetFromEpoch() uint64 {
+	if x != nil {
+		return x.FromEpoch
+	}
+	return 0
+}
+
+func (x *GetStateDiffRequest) GetToEpoch() uint64 {
+	if x != nil {
+		return x.ToEpoch
+	}
+	return 0
+}
+
+func (x *GetStateDiffRequest) GetIndices() []uint64 {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+type StateDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromEpoch                       uint64               `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	ToEpoch                         uint64               `protobuf:"varint,2,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty"`
+	ChangedValidators               []*ValidatorChange   `protobuf:"bytes,3,rep,name=changed_validators,json=changedValidators,proto3" json:"changed_validators,omitempty"`
+	BalanceDeltas                   []*BalanceDelta      `protobuf:"bytes,4,rep,name=balance_deltas,json=balanceDeltas,proto3" json:"balance_deltas,omitempty"`
+	PreviousJustifiedCheckpoint     *v1alpha1.Checkpoint `protobuf:"bytes,5,opt,name=previous_justified_checkpoint,json=previousJustifiedCheckpoint,proto3" json:"previous_justified_checkpoint,omitempty"`
+	CurrentJustifiedCheckpoint      *v1alpha1.Checkpoint `protobuf:"bytes,6,opt,name=current_justified_checkpoint,json=currentJustifiedCheckpoint,proto3" json:"current_justified_checkpoint,omitempty"`
+	FinalizedCheckpoint             *v1alpha1.Checkpoint `protobuf:"bytes,7,opt,name=finalized_checkpoint,json=finalizedCheckpoint,proto3" json:"finalized_checkpoint,omitempty"`
+	PostPreviousJustifiedCheckpoint *v1alpha1.Checkpoint `protobuf:"bytes,8,opt,name=post_previous_justified_checkpoint,json=postPreviousJustifiedCheckpoint,proto3" json:"post_previous_justified_checkpoint,omitempty"`
+	PostCurrentJustifiedCheckpoint  *v1alpha1.Checkpoint `protobuf:"bytes,9,opt,name=post_current_justified_checkpoint,json=postCurrentJustifiedCheckpoint,proto3" json:"post_current_justified_checkpoint,omitempty"`
+	PostFinalizedCheckpoint         *v1alpha1.Checkpoint `protobuf:"bytes,10,opt,name=post_finalized_checkpoint,json=postFinalizedCheckpoint,proto3" json:"post_finalized_checkpoint,omitempty"`
+}
+
+func (x *StateDiff) Reset() {
+	*x = StateDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateDiff) ProtoMessage() {}
+
+func (x *StateDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateDiff.ProtoReflect.Descriptor instead.
+func (*StateDiff) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StateDiff) GetFromEpoch() uint64 {
+	if x != nil {
+		return x.FromEpoch
+	}
+	return 0
+}
+
+func (x *StateDiff) GetToEpoch() uint64 {
+	if x != nil {
+		return x.ToEpoch
+	}
+	return 0
+}
+
+func (x *StateDiff) GetChangedValidators() []*ValidatorChange {
+	if x != nil {
+		return x.ChangedValidators
+	}
+	return nil
+}
+
+func (x *StateDiff) GetBalanceDeltas() []*BalanceDelta {
+	if x != nil {
+		return x.BalanceDeltas
+	}
+	return nil
+}
+
+func (x *StateDiff) GetPreviousJustifiedCheckpoint() *v1alpha1.Checkpoint {
+	if x != nil {
+		return x.PreviousJustifiedCheckpoint
+	}
+	return nil
+}
+
+func (x *StateDiff) GetCurrentJustifiedCheckpoint() *v1alpha1.Checkpoint {
+	if x != nil {
+		return x.CurrentJustifiedCheckpoint
+	}
+	return nil
+}
+
+func (x *StateDiff) GetFinalizedCheckpoint() *v1alpha1.Checkpoint {
+	if x != nil {
+		return x.FinalizedCheckpoint
+	}
+	return nil
+}
+
+func (x *StateDiff) GetPostPreviousJustifiedCheckpoint() *v1alpha1.Checkpoint {
+	if x != nil {
+		return x.PostPreviousJustifiedCheckpoint
+	}
+	return nil
+}
+
+func (x *StateDiff) GetPostCurrentJustifiedCheckpoint() *v1alpha1.Checkpoint {
+	if x != nil {
+		return x.PostCurrentJustifiedCheckpoint
+	}
+	return nil
+}
+
+func (x *StateDiff) GetPostFinalizedCheckpoint() *v1alpha1.Checkpoint {
+	if x != nil {
+		return x.PostFinalizedCheckpoint
+	}
+	return nil
+}
+
+type ValidatorChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index    uint64              `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Previous *v1alpha1.Validator `protobuf:"bytes,2,opt,name=previous,proto3" json:"previous,omitempty"`
+	Current  *v1alpha1.Validator `protobuf:"bytes,3,opt,name=current,proto3" json:"current,omitempty"`
+}
+
+func (x *ValidatorChange) Reset() {
+	*x = ValidatorChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorChange) ProtoMessage() {}
+
+func (x *ValidatorChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorChange.ProtoReflect.Descriptor instead.
+func (*ValidatorChange) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ValidatorChange) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ValidatorChange) GetPrevious() *v1alpha1.Validator {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *ValidatorChange) GetCurrent() *v1alpha1.Validator {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+type BalanceDelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index    uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Previous uint64 `protobuf:"varint,2,opt,name=previous,proto3" json:"previous,omitempty"`
+	Current  uint64 `protobuf:"varint,3,opt,name=current,proto3" json:"current,omitempty"`
+	Delta    int64  `protobuf:"varint,4,opt,name=delta,proto3" json:"delta,omitempty"`
+}
+
+func (x *BalanceDelta) Reset() {
+	*x = BalanceDelta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceDelta) ProtoMessage() {}
+
+func (x *BalanceDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceDelta.ProtoReflect.Descriptor instead.
+func (*BalanceDelta) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BalanceDelta) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BalanceDelta) GetPrevious() uint64 {
+	if x != nil {
+		return x.Previous
+	}
+	return 0
+}
+
+func (x *BalanceDelta) GetCurrent() uint64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *BalanceDelta) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+type RandaoMixesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromEpoch uint64 `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	ToEpoch   uint64 `protobuf:"varint,2,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty"`
+}
+
+func (x *RandaoMixesRequest) Reset() {
+	*x = RandaoMixesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RandaoMixesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandaoMixesRequest) ProtoMessage() {}
+
+func (x *RandaoMixesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandaoMixesRequest.ProtoReflect.Descriptor instead.
+func (*RandaoMixesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RandaoMixesRequest) GetFromEpoch() uint64 {
+	if x != nil {
+		return x.FromEpoch
+	}
+	return 0
+}
+
+func (x *RandaoMixesRequest) GetToEpoch() uint64 {
+	if x != nil {
+		return x.ToEpoch
+	}
+	return 0
+}
+
+type RandaoMixes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mixes []*EpochRandaoMix `protobuf:"bytes,1,rep,name=mixes,proto3" json:"mixes,omitempty"`
+}
+
+func (x *RandaoMixes) Reset() {
+	*x = RandaoMixes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RandaoMixes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandaoMixes) ProtoMessage() {}
+
+func (x *RandaoMixes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandaoMixes.ProtoReflect.Descriptor instead.
+func (*RandaoMixes) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RandaoMixes) GetMixes() []*EpochRandaoMix {
+	if x != nil {
+		return x.Mixes
+	}
+	return nil
+}
+
+type EpochRandaoMix struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch         uint64                `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	SeedEpoch     uint64                `protobuf:"varint,2,opt,name=seed_epoch,json=seedEpoch,proto3" json:"seed_epoch,omitempty"`
+	StartMix      []byte                `protobuf:"bytes,3,opt,name=start_mix,json=startMix,proto3" json:"start_mix,omitempty"`
+	Mix           []byte                `protobuf:"bytes,4,opt,name=mix,proto3" json:"mix,omitempty"`
+	Contributions []*RandaoContribution `protobuf:"bytes,5,rep,name=contributions,proto3" json:"contributions,omitempty"`
+	Verified      bool                  `protobuf:"varint,6,opt,name=verified,proto3" json:"verified,omitempty"`
+}
+
+func (x *EpochRandaoMix) Reset() {
+	*x = EpochRandaoMix{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochRandaoMix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochRandaoMix) ProtoMessage() {}
+
+func (x *EpochRandaoMix) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochRandaoMix.ProtoReflect.Descriptor instead.
+func (*EpochRandaoMix) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EpochRandaoMix) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *EpochRandaoMix) GetSeedEpoch() uint64 {
+	if x != nil {
+		return x.SeedEpoch
+	}
+	return 0
+}
+
+func (x *EpochRandaoMix) GetStartMix() []byte {
+	if x != nil {
+		return x.StartMix
+	}
+	return nil
+}
+
+func (x *EpochRandaoMix) GetMix() []byte {
+	if x != nil {
+		return x.Mix
+	}
+	return nil
+}
+
+func (x *EpochRandaoMix) GetContributions() []*RandaoContribution {
+	if x != nil {
+		return x.Contributions
+	}
+	return nil
+}
+
+func (x *EpochRandaoMix) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+type RandaoContribution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot          uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	BlockRoot     []byte `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	ProposerIndex uint64 `protobuf:"varint,3,opt,name=proposer_index,json=proposerIndex,proto3" json:"proposer_index,omitempty"`
+	PublicKey     []byte `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Reveal        []byte `protobuf:"bytes,5,opt,name=reveal,proto3" json:"reveal,omitempty"`
+}
+
+func (x *RandaoContribution) Reset() {
+	*x = RandaoContribution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RandaoContribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandaoContribution) ProtoMessage() {}
+
+func (x *RandaoContribution) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandaoContribution.ProtoReflect.Descriptor instead.
+func (*RandaoContribution) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RandaoContribution) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *RandaoContribution) GetBlockRoot() []byte {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+func (x *RandaoContribution) GetProposerIndex() uint64 {
+	if x != nil {
+		return x.ProposerIndex
+	}
+	return 0
+}
+
+func (x *RandaoContribution) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *RandaoContribution) GetReveal() []byte {
+	if x != nil {
+		return x.Reveal
+	}
+	return nil
+}
+
+type ListReorgsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromEpoch uint64 `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+}
+
+func (x *ListReorgsRequest) Reset() {
+	*x = ListReorgsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReorgsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReorgsRequest) ProtoMessage() {}
+
+func (x *ListReorgsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReorgsRequest.ProtoReflect.Descriptor instead.
+func (*ListReorgsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListReorgsRequest) GetFromEpoch() uint64 {
+	if x != nil {
+		return x.FromEpoch
+	}
+	return 0
+}
+
+type Reorgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*ReorgEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *Reorgs) Reset() {
+	*x = Reorgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reorgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reorgs) ProtoMessage() {}
+
+func (x *Reorgs) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reorgs.ProtoReflect.Descriptor instead.
+func (*Reorgs) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Reorgs) GetEvents() []*ReorgEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type ReorgEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OldHeadRoot        []byte `protobuf:"bytes,1,opt,name=old_head_root,json=oldHeadRoot,proto3" json:"old_head_root,omitempty"`
+	NewHeadRoot        []byte `protobuf:"bytes,2,opt,name=new_head_root,json=newHeadRoot,proto3" json:"new_head_root,omitempty"`
+	OldHeadSlot        uint64 `protobuf:"varint,3,opt,name=old_head_slot,json=oldHeadSlot,proto3" json:"old_head_slot,omitempty"`
+	NewHeadSlot        uint64 `protobuf:"varint,4,opt,name=new_head_slot,json=newHeadSlot,proto3" json:"new_head_slot,omitempty"`
+	CommonAncestorSlot uint64 `protobuf:"varint,5,opt,name=common_ancestor_slot,json=commonAncestorSlot,proto3" json:"common_ancestor_slot,omitempty"`
+	Depth              uint64 `protobuf:"varint,6,opt,name=depth,proto3" json:"depth,omitempty"`
+	FromEpoch          uint64 `protobuf:"varint,7,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	ToEpoch            uint64 `protobuf:"varint,8,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty"`
+}
+
+func (x *ReorgEvent) Reset() {
+	*x = ReorgEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReorgEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorgEvent) ProtoMessage() {}
+
+func (x *ReorgEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorgEvent.ProtoReflect.Descriptor instead.
+func (*ReorgEvent) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReorgEvent) GetOldHeadRoot() []byte {
+	if x != nil {
+		return x.OldHeadRoot
+	}
+	return nil
+}
+
+func (x *ReorgEvent) GetNewHeadRoot() []byte {
+	if x != nil {
+		return x.NewHeadRoot
+	}
+	return nil
+}
+
+func (x *ReorgEvent) GetOldHeadSlot() uint64 {
+	if x != nil {
+		return x.OldHeadSlot
+	}
+	return 0
+}
+
+func (x *ReorgEvent) GetNewHeadSlot() uint64 {
+	if x != nil {
+		return x.NewHeadSlot
+	}
+	return 0
+}
+
+func (x *ReorgEvent) GetCommonAncestorSlot() uint64 {
+	if x != nil {
+		return x.CommonAncestorSlot
+	}
+	return 0
+}
+
+func (x *ReorgEvent) GetDepth() uint64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *ReorgEvent) GetFromEpoch() uint64 {
+	if x != nil {
+		return x.FromEpoch
+	}
+	return 0
+}
+
+func (x *ReorgEvent) GetToEpoch() uint64 {
+	if x != nil {
+		return x.ToEpoch
+	}
+	return 0
+}
+
+type ListValidatorBalanceHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Index     uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	FromEpoch uint64 `protobuf:"varint,3,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	ToEpoch   uint64 `protobuf:"varint,4,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty"`
+}
+
+func (x *ListValidatorBalanceHistoryRequest) Reset() {
+	*x = ListValidatorBalanceHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListValidatorBalanceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListValidatorBalanceHistoryRequest) ProtoMessage() {}
+
+func (x *ListValidatorBalanceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListValidatorBalanceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListValidatorBalanceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListValidatorBalanceHistoryRequest) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *ListValidatorBalanceHistoryRequest) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ListValidatorBalanceHistoryRequest) GetFromEpoch() uint64 {
+	if x != nil {
+		return x.FromEpoch
+	}
+	return 0
+}
+
+func (x *ListValidatorBalanceHistoryRequest) GetToEpoch() uint64 {
+	if x != nil {
+		return x.ToEpoch
+	}
+	return 0
+}
+
+type ValidatorBalanceHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index    uint64          `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Balances []*EpochBalance `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances,omitempty"`
+}
+
+func (x *ValidatorBalanceHistory) Reset() {
+	*x = ValidatorBalanceHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorBalanceHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorBalanceHistory) ProtoMessage() {}
+
+func (x *ValidatorBalanceHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorBalanceHistory.ProtoReflect.Descriptor instead.
+func (*ValidatorBalanceHistory) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ValidatorBalanceHistory) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ValidatorBalanceHistory) GetBalances() []*EpochBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+type EpochBalance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch   uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Balance uint64 `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (x *EpochBalance) Reset() {
+	*x = EpochBalance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochBalance) ProtoMessage() {}
+
+func (x *EpochBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochBalance.ProtoReflect.Descriptor instead.
+func (*EpochBalance) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *EpochBalance) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *EpochBalance) GetBalance() uint64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+type SlotParticipation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot               uint64  `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	BlockSlot          uint64  `protobuf:"varint,2,opt,name=block_slot,json=blockSlot,proto3" json:"block_slot,omitempty"`
+	BlockRoot          []byte  `protobuf:"bytes,3,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	ExpectedBalance    uint64  `protobuf:"varint,4,opt,name=expected_balance,json=expectedBalance,proto3" json:"expected_balance,omitempty"`
+	AttestingBalance   uint64  `protobuf:"varint,5,opt,name=attesting_balance,json=attestingBalance,proto3" json:"attesting_balance,omitempty"`
+	HeadCorrectBalance uint64  `protobuf:"varint,6,opt,name=head_correct_balance,json=headCorrectBalance,proto3" json:"head_correct_balance,omitempty"`
+	ParticipationRate  float64 `protobuf:"fixed64,7,opt,name=participation_rate,json=participationRate,proto3" json:"participation_rate,omitempty"`
+	HeadCorrectRate    float64 `protobuf:"fixed64,8,opt,name=head_correct_rate,json=headCorrectRate,proto3" json:"head_correct_rate,omitempty"`
+}
+
+func (x *SlotParticipation) Reset() {
+	*x = SlotParticipation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlotParticipation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotParticipation) ProtoMessage() {}
+
+func (x *SlotParticipation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotParticipation.ProtoReflect.Descriptor instead.
+func (*SlotParticipation) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SlotParticipation) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *SlotParticipation) GetBlockSlot() uint64 {
+	if x != nil {
+		return x.BlockSlot
+	}
+	return 0
+}
+
+func (x *SlotParticipation) GetBlockRoot() []byte {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+func (x *SlotParticipation) GetExpectedBalance() uint64 {
+	if x != nil {
+		return x.ExpectedBalance
+	}
+	return 0
+}
+
+func (x *SlotParticipation) GetAttestingBalance() uint64 {
+	if x != nil {
+		return x.AttestingBalance
+	}
+	return 0
+}
+
+func (x *SlotParticipation) GetHeadCorrectBalance() uint64 {
+	if x != nil {
+		return x.HeadCorrectBalance
+	}
+	return 0
+}
+
+func (x *SlotParticipation) GetParticipationRate() float64 {
+	if x != nil {
+		return x.ParticipationRate
+	}
+	return 0
+}
+
+func (x *SlotParticipation) GetHeadCorrectRate() float64 {
+	if x != nil {
+		return x.HeadCorrectRate
+	}
+	return 0
+}
+
+type GetEpochSummaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *GetEpochSummaryRequest) Reset() {
+	*x = GetEpochSummaryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEpochSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpochSummaryRequest) ProtoMessage() {}
+
+func (x *GetEpochSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpochSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetEpochSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetEpochSummaryRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+type ListEpochSummariesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromEpoch uint64 `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	ToEpoch   uint64 `protobuf:"varint,2,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty"`
+}
+
+func (x *ListEpochSummariesRequest) Reset() {
+	*x = ListEpochSummariesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEpochSummariesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEpochSummariesRequest) ProtoMessage() {}
+
+func (x *ListEpochSummariesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEpochSummariesRequest.ProtoReflect.Descriptor instead.
+func (*ListEpochSummariesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListEpochSummariesRequest) GetFromEpoch() uint64 {
+	if x != nil {
+		return x.FromEpoch
+	}
+	return 0
+}
+
+func (x *ListEpochSummariesRequest) GetToEpoch() uint64 {
+	if x != nil {
+		return x.ToEpoch
+	}
+	return 0
+}
+
+type EpochSummaries struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Summaries []*EpochSummary `protobuf:"bytes,1,rep,name=summaries,proto3" json:"summaries,omitempty"`
+}
+
+func (x *EpochSummaries) Reset() {
+	*x = EpochSummaries{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochSummaries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochSummaries) ProtoMessage() {}
+
+func (x *EpochSummaries) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochSummaries.ProtoReflect.Descriptor instead.
+func (*EpochSummaries) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *EpochSummaries) GetSummaries() []*EpochSummary {
+	if x != nil {
+		return x.Summaries
+	}
+	return nil
+}
+
+type EpochSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch                  uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ValidatorCount         uint64 `protobuf:"varint,2,opt,name=validator_count,json=validatorCount,proto3" json:"validator_count,omitempty"`
+	ActiveValidatorCount   uint64 `protobuf:"varint,3,opt,name=active_validator_count,json=activeValidatorCount,proto3" json:"active_validator_count,omitempty"`
+	TotalBalance           uint64 `protobuf:"varint,4,opt,name=total_balance,json=totalBalance,proto3" json:"total_balance,omitempty"`
+	TotalActiveBalance     uint64 `protobuf:"varint,5,opt,name=total_active_balance,json=totalActiveBalance,proto3" json:"total_active_balance,omitempty"`
+	EligibleBalance        uint64 `protobuf:"varint,6,opt,name=eligible_balance,json=eligibleBalance,proto3" json:"eligible_balance,omitempty"`
+	TargetAttestingBalance uint64 `protobuf:"varint,7,opt,name=target_attesting_balance,json=targetAttestingBalance,proto3" json:"target_attesting_balance,omitempty"`
+	HeadAttestingBalance   uint64 `protobuf:"varint,8,opt,name=head_attesting_balance,json=headAttestingBalance,proto3" json:"head_attesting_balance,omitempty"`
+	ProposerListHash       []byte `protobuf:"bytes,9,opt,name=proposer_list_hash,json=proposerListHash,proto3" json:"proposer_list_hash,omitempty"`
+	JustifiedEpoch         uint64 `protobuf:"varint,10,opt,name=justified_epoch,json=justifiedEpoch,proto3" json:"justified_epoch,omitempty"`
+	JustifiedRoot          []byte `protobuf:"bytes,11,opt,name=justified_root,json=justifiedRoot,proto3" json:"justified_root,omitempty"`
+	FinalizedEpoch         uint64 `protobuf:"varint,12,opt,name=finalized_epoch,json=finalizedEpoch,proto3" json:"finalized_epoch,omitempty"`
+	FinalizedRoot          []byte `protobuf:"bytes,13,opt,name=finalized_root,json=finalizedRoot,proto3" json:"finalized_root,omitempty"`
+}
+
+func (x *EpochSummary) Reset() {
+	*x = EpochSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochSummary) ProtoMessage() {}
+
+func (x *EpochSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochSummary.ProtoReflect.Descriptor instead.
+func (*EpochSummary) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *EpochSummary) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *EpochSummary) GetValidatorCount() uint64 {
+	if x != nil {
+		return x.ValidatorCount
+	}
+	return 0
+}
+
+func (x *EpochSummary) GetActiveValidatorCount() uint64 {
+	if x != nil {
+		return x.ActiveValidatorCount
+	}
+	return 0
+}
+
+func (x *EpochSummary) GetTotalBalance() uint64 {
+	if x != nil {
+		return x.TotalBalance
+	}
+	return 0
+}
+
+func (x *EpochSummary) GetTotalActiveBalance() uint64 {
+	if x != nil {
+		return x.TotalActiveBalance
+	}
+	return 0
+}
+
+func (x *EpochSummary) GetEligibleBalance() uint64 {
+	if x != nil {
+		return x.EligibleBalance
+	}
+	return 0
+}
+
+func (x *EpochSummary) GetTargetAttestingBalance() uint64 {
+	if x != nil {
+		return x.TargetAttestingBalance
+	}
+	return 0
+}
+
+func (x *EpochSummary) GetHeadAttestingBalance() uint64 {
+	if x != nil {
+		return x.HeadAttestingBalance
+	}
+	return 0
+}
+
+func (x *EpochSummary) GetProposerListHash() []byte {
+	if x != nil {
+		return x.ProposerListHash
+	}
+	return nil
+}
+
+func (x *EpochSummary) GetJustifiedEpoch() uint64 {
+	if x != nil {
+		return x.JustifiedEpoch
+	}
+	return 0
+}
+
+func (x *EpochSummary) GetJustifiedRoot() []byte {
+	if x != nil {
+		return x.JustifiedRoot
+	}
+	return nil
+}
+
+func (x *EpochSummary) GetFinalizedEpoch() uint64 {
+	if x != nil {
+		return x.FinalizedEpoch
+	}
+	return 0
+}
+
+func (x *EpochSummary) GetFinalizedRoot() []byte {
+	if x != nil {
+		return x.FinalizedRoot
+	}
+	return nil
+}
+
+type ListValidatorPublicKeysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Indices   []uint64 `protobuf:"varint,1,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	PageSize  int32    `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string   `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListValidatorPublicKeysRequest) Reset() {
+	*x = ListValidatorPublicKeysRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListValidatorPublicKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListValidatorPublicKeysRequest) ProtoMessage() {}
+
+func (x *ListValidatorPublicKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListValidatorPublicKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListValidatorPublicKeysRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListValidatorPublicKeysRequest) GetIndices() []uint64 {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+func (x *ListValidatorPublicKeysRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListValidatorPublicKeysRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ValidatorPublicKeys struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKeys    []*ValidatorPublicKey `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	NextPageToken string                `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int32                 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+}
+
+func (x *ValidatorPublicKeys) Reset() {
+	*x = ValidatorPublicKeys{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorPublicKeys) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorPublicKeys) ProtoMessage() {}
+
+func (x *ValidatorPublicKeys) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorPublicKeys.ProtoReflect.Descriptor instead.
+func (*ValidatorPublicKeys) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ValidatorPublicKeys) GetPublicKeys() []*ValidatorPublicKey {
+	if x != nil {
+		return x.PublicKeys
+	}
+	return nil
+}
+
+func (x *ValidatorPublicKeys) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ValidatorPublicKeys) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type ValidatorPublicKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index     uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	PublicKey []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+}
+
+func (x *ValidatorPublicKey) Reset() {
+	*x = ValidatorPublicKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorPublicKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorPublicKey) ProtoMessage() {}
+
+func (x *ValidatorPublicKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorPublicKey.ProtoReflect.Descriptor instead.
+func (*ValidatorPublicKey) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ValidatorPublicKey) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ValidatorPublicKey) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+type ValidatorQueueDetailsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrackedOnly bool `protobuf:"varint,1,opt,name=tracked_only,json=trackedOnly,proto3" json:"tracked_only,omitempty"`
+}
+
+func (x *ValidatorQueueDetailsRequest) Reset() {
+	*x = ValidatorQueueDetailsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorQueueDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorQueueDetailsRequest) ProtoMessage() {}
+
+func (x *ValidatorQueueDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorQueueDetailsRequest.ProtoReflect.Descriptor instead.
+func (*ValidatorQueueDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ValidatorQueueDetailsRequest) GetTrackedOnly() bool {
+	if x != nil {
+		return x.TrackedOnly
+	}
+	return false
+}
+
+type ValidatorQueueDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch       uint64             `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ChurnLimit  uint64             `protobuf:"varint,2,opt,name=churn_limit,json=churnLimit,proto3" json:"churn_limit,omitempty"`
+	Activations []*QueuedValidator `protobuf:"bytes,3,rep,name=activations,proto3" json:"activations,omitempty"`
+	Exits       []*QueuedValidator `protobuf:"bytes,4,rep,name=exits,proto3" json:"exits,omitempty"`
+}
+
+func (x *ValidatorQueueDetails) Reset() {
+	*x = ValidatorQueueDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorQueueDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorQueueDetails) ProtoMessage() {}
+
+func (x *ValidatorQueueDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorQueueDetails.ProtoReflect.Descriptor instead.
+func (*ValidatorQueueDetails) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ValidatorQueueDetails) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ValidatorQueueDetails) GetChurnLimit() uint64 {
+	if x != nil {
+		return x.ChurnLimit
+	}
+	return 0
+}
+
+func (x *ValidatorQueueDetails) GetActivations() []*QueuedValidator {
+	if x != nil {
+		return x.Activations
+	}
+	return nil
+}
+
+func (x *ValidatorQueueDetails) GetExits() []*QueuedValidator {
+	if x != nil {
+		return x.Exits
+	}
+	return nil
+}
+
+type QueuedValidator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index                      uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	PublicKey                  []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	ActivationEligibilityEpoch uint64 `protobuf:"varint,3,opt,name=activation_eligibility_epoch,json=activationEligibilityEpoch,proto3" json:"activation_eligibility_epoch,omitempty"`
+	ExpectedEpoch              uint64 `protobuf:"varint,4,opt,name=expected_epoch,json=expectedEpoch,proto3" json:"expected_epoch,omitempty"`
+	WithdrawableEpoch          uint64 `protobuf:"varint,5,opt,name=withdrawable_epoch,json=withdrawableEpoch,proto3" json:"withdrawable_epoch,omitempty"`
+	Exact                      bool   `protobuf:"varint,6,opt,name=exact,proto3" json:"exact,omitempty"`
+	EstimatedWait              int64  `protobuf:"varint,7,opt,name=estimated_wait,json=estimatedWait,proto3" json:"estimated_wait,omitempty"`
+}
+
+func (x *QueuedValidator) Reset() {
+	*x = QueuedValidator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueuedValidator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueuedValidator) ProtoMessage() {}
+
+func (x *QueuedValidator) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueuedValidator.ProtoReflect.Descriptor instead.
+func (*QueuedValidator) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *QueuedValidator) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *QueuedValidator) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *QueuedValidator) GetActivationEligibilityEpoch() uint64 {
+	if x != nil {
+		return x.ActivationEligibilityEpoch
+	}
+	return 0
+}
+
+func (x *QueuedValidator) GetExpectedEpoch() uint64 {
+	if x != nil {
+		return x.ExpectedEpoch
+	}
+	return 0
+}
+
+func (x *QueuedValidator) GetWithdrawableEpoch() uint64 {
+	if x != nil {
+		return x.WithdrawableEpoch
+	}
+	return 0
+}
+
+func (x *QueuedValidator) GetExact() bool {
+	if x != nil {
+		return x.Exact
+	}
+	return false
+}
+
+func (x *QueuedValidator) GetEstimatedWait() int64 {
+	if x != nil {
+		return x.EstimatedWait
+	}
+	return 0
+}
+
+type AnnotatedChainHead struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Head                  *v1alpha1.ChainHead `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	NextSlot              uint64              `protobuf:"varint,2,opt,name=next_slot,json=nextSlot,proto3" json:"next_slot,omitempty"`
+	NextProposerIndex     uint64              `protobuf:"varint,3,opt,name=next_proposer_index,json=nextProposerIndex,proto3" json:"next_proposer_index,omitempty"`
+	NextProposerPublicKey []byte              `protobuf:"bytes,4,opt,name=next_proposer_public_key,json=nextProposerPublicKey,proto3" json:"next_proposer_public_key,omitempty"`
+}
+
+func (x *AnnotatedChainHead) Reset() {
+	*x = AnnotatedChainHead{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnnotatedChainHead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnotatedChainHead) ProtoMessage() {}
+
+func (x *AnnotatedChainHead) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnotatedChainHead.ProtoReflect.Descriptor instead.
+func (*AnnotatedChainHead) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AnnotatedChainHead) GetHead() *v1alpha1.ChainHead {
+	if x != nil {
+		return x.Head
+	}
+	return nil
+}
+
+func (x *AnnotatedChainHead) GetNextSlot() uint64 {
+	if x != nil {
+		return x.NextSlot
+	}
+	return 0
+}
+
+func (x *AnnotatedChainHead) GetNextProposerIndex() uint64 {
+	if x != nil {
+		return x.NextProposerIndex
+	}
+	return 0
+}
+
+func (x *AnnotatedChainHead) GetNextProposerPublicKey() []byte {
+	if x != nil {
+		return x.NextProposerPublicKey
+	}
+	return nil
+}
+
+type BlockAvailabilityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromSlot uint64 `protobuf:"varint,1,opt,name=from_slot,json=fromSlot,proto3" json:"from_slot,omitempty"`
+	ToSlot   uint64 `protobuf:"varint,2,opt,name=to_slot,json=toSlot,proto3" json:"to_slot,omitempty"`
+}
+
+func (x *BlockAvailabilityRequest) Reset() {
+	*x = BlockAvailabilityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockAvailabilityRequest) ProtoMessage() {}
+
+func (x *BlockAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*BlockAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BlockAvailabilityRequest) GetFromSlot() uint64 {
+	if x != nil {
+		return x.FromSlot
+	}
+	return 0
+}
+
+func (x *BlockAvailabilityRequest) GetToSlot() uint64 {
+	if x != nil {
+		return x.ToSlot
+	}
+	return 0
+}
+
+type BlockAvailability struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromSlot  uint64 `protobuf:"varint,1,opt,name=from_slot,json=fromSlot,proto3" json:"from_slot,omitempty"`
+	ToSlot    uint64 `protobuf:"varint,2,opt,name=to_slot,json=toSlot,proto3" json:"to_slot,omitempty"`
+	Available []byte `protobuf:"bytes,3,opt,name=available,proto3" json:"available,omitempty"`
+}
+
+func (x *BlockAvailability) Reset() {
+	*x = BlockAvailability{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockAvailability) ProtoMessage() {}
+
+func (x *BlockAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockAvailability.ProtoReflect.Descriptor instead.
+func (*BlockAvailability) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BlockAvailability) GetFromSlot() uint64 {
+	if x != nil {
+		return x.FromSlot
+	}
+	return 0
+}
+
+func (x *BlockAvailability) GetToSlot() uint64 {
+	if x != nil {
+		return x.ToSlot
+	}
+	return 0
+}
+
+func (x *BlockAvailability) GetAvailable() []byte {
+	if x != nil {
+		return x.Available
+	}
+	return nil
+}
+
+type NetworkConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                   string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SlotsPerEpoch          uint64   `protobuf:"varint,2,opt,name=slots_per_epoch,json=slotsPerEpoch,proto3" json:"slots_per_epoch,omitempty"`
+	SecondsPerSlot         uint64   `protobuf:"varint,3,opt,name=seconds_per_slot,json=secondsPerSlot,proto3" json:"seconds_per_slot,omitempty"`
+	GenesisForkVersion     []byte   `protobuf:"bytes,4,opt,name=genesis_fork_version,json=genesisForkVersion,proto3" json:"genesis_fork_version,omitempty"`
+	DepositContractAddress string   `protobuf:"bytes,5,opt,name=deposit_contract_address,json=depositContractAddress,proto3" json:"deposit_contract_address,omitempty"`
+	DepositChainId         uint64   `protobuf:"varint,6,opt,name=deposit_chain_id,json=depositChainId,proto3" json:"deposit_chain_id,omitempty"`
+	DepositNetworkId       uint64   `protobuf:"varint,7,opt,name=deposit_network_id,json=depositNetworkId,proto3" json:"deposit_network_id,omitempty"`
+	Presets                []string `protobuf:"bytes,8,rep,name=presets,proto3" json:"presets,omitempty"`
+}
+
+func (x *NetworkConfig) Reset() {
+	*x = NetworkConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkConfig) ProtoMessage() {}
+
+func (x *NetworkConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkConfig.ProtoReflect.Descriptor instead.
+func (*NetworkConfig) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *NetworkConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NetworkConfig) GetSlotsPerEpoch() uint64 {
+	if x != nil {
+		return x.SlotsPerEpoch
+	}
+	return 0
+}
+
+func (x *NetworkConfig) GetSecondsPerSlot() uint64 {
+	if x != nil {
+		return x.SecondsPerSlot
+	}
+	return 0
+}
+
+func (x *NetworkConfig) GetGenesisForkVersion() []byte {
+	if x != nil {
+		return x.GenesisForkVersion
+	}
+	return nil
+}
+
+func (x *NetworkConfig) GetDepositContractAddress() string {
+	if x != nil {
+		return x.DepositContractAddress
+	}
+	return ""
+}
+
+func (x *NetworkConfig) GetDepositChainId() uint64 {
+	if x != nil {
+		return x.DepositChainId
+	}
+	return 0
+}
+
+func (x *NetworkConfig) GetDepositNetworkId() uint64 {
+	if x != nil {
+		return x.DepositNetworkId
+	}
+	return 0
+}
+
+func (x *NetworkConfig) GetPresets() []string {
+	if x != nil {
+		return x.Presets
+	}
+	return nil
+}
+
+type GetValidatorSetDeltaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromEpoch uint64 `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	ToEpoch   uint64 `protobuf:"varint,2,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty"`
+}
+
+func (x *GetValidatorSetDeltaRequest) Reset() {
+	*x = GetValidatorSetDeltaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetValidatorSetDeltaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidatorSetDeltaRequest) ProtoMessage() {}
+
+func (x *GetValidatorSetDeltaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidatorSetDeltaRequest.ProtoReflect.Descriptor instead.
+func (*GetValidatorSetDeltaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetValidatorSetDeltaRequest) GetFromEpoch() uint64 {
+	if x != nil {
+		return x.FromEpoch
+	}
+	return 0
+}
+
+func (x *GetValidatorSetDeltaRequest) GetToEpoch() uint64 {
+	if x != nil {
+		return x.ToEpoch
+	}
+	return 0
+}
+
+type ValidatorRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index            uint64                   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	PublicKey        []byte                   `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Status           v1alpha1.ValidatorStatus `protobuf:"varint,3,opt,name=status,proto3,enum=ethereum.eth.v1alpha1.ValidatorStatus" json:"status,omitempty"`
+	EffectiveBalance uint64                   `protobuf:"varint,4,opt,name=effective_balance,json=effectiveBalance,proto3" json:"effective_balance,omitempty"`
+}
+
+func (x *ValidatorRecord) Reset() {
+	*x = ValidatorRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorRecord) ProtoMessage() {}
+
+func (x *ValidatorRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorRecord.ProtoReflect.Descriptor instead.
+func (*ValidatorRecord) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ValidatorRecord) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ValidatorRecord) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *ValidatorRecord) GetStatus() v1alpha1.ValidatorStatus {
+	if x != nil {
+		return x.Status
+	}
+	return v1alpha1.ValidatorStatus_UNKNOWN_STATUS
+}
+
+func (x *ValidatorRecord) GetEffectiveBalance() uint64 {
+	if x != nil {
+		return x.EffectiveBalance
+	}
+	return 0
+}
+
+type ValidatorSetDelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromEpoch      uint64             `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	ToEpoch        uint64             `protobuf:"varint,2,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty"`
+	ValidatorCount uint64             `protobuf:"varint,3,opt,name=validator_count,json=validatorCount,proto3" json:"validator_count,omitempty"`
+	Added          []*ValidatorRecord `protobuf:"bytes,4,rep,name=added,proto3" json:"added,omitempty"`
+	Changed        []*ValidatorRecord `protobuf:"bytes,5,rep,name=changed,proto3" json:"changed,omitempty"`
+	Removed        []*ValidatorRecord `protobuf:"bytes,6,rep,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (x *ValidatorSetDelta) Reset() {
+	*x = ValidatorSetDelta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorSetDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorSetDelta) ProtoMessage() {}
+
+func (x *ValidatorSetDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorSetDelta.ProtoReflect.Descriptor instead.
+func (*ValidatorSetDelta) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ValidatorSetDelta) GetFromEpoch() uint64 {
+	if x != nil {
+		return x.FromEpoch
+	}
+	return 0
+}
+
+func (x *ValidatorSetDelta) GetToEpoch() uint64 {
+	if x != nil {
+		return x.ToEpoch
+	}
+	return 0
+}
+
+func (x *ValidatorSetDelta) GetValidatorCount() uint64 {
+	if x != nil {
+		return x.ValidatorCount
+	}
+	return 0
+}
+
+func (x *ValidatorSetDelta) GetAdded() []*ValidatorRecord {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *ValidatorSetDelta) GetChanged() []*ValidatorRecord {
+	if x != nil {
+		return x.Changed
+	}
+	return nil
+}
+
+func (x *ValidatorSetDelta) GetRemoved() []*ValidatorRecord {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+type PrefetchEpochInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromEpoch uint64 `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	ToEpoch   uint64 `protobuf:"varint,2,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty"`
+	AllowGaps bool   `protobuf:"varint,3,opt,name=allow_gaps,json=allowGaps,proto3" json:"allow_gaps,omitempty"`
+}
+
+func (x *PrefetchEpochInfoRequest) Reset() {
+	*x = PrefetchEpochInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrefetchEpochInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrefetchEpochInfoRequest) ProtoMessage() {}
+
+func (x *PrefetchEpochInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrefetchEpochInfoRequest.ProtoReflect.Descriptor instead.
+func (*PrefetchEpochInfoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PrefetchEpochInfoRequest) GetFromEpoch() uint64 {
+	if x != nil {
+		return x.FromEpoch
+	}
+	return 0
+}
+
+func (x *PrefetchEpochInfoRequest) GetToEpoch() uint64 {
+	if x != nil {
+		return x.ToEpoch
+	}
+	return 0
+}
+
+func (x *PrefetchEpochInfoRequest) GetAllowGaps() bool {
+	if x != nil {
+		return x.AllowGaps
+	}
+	return false
+}
+
+type PrefetchJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId uint64 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (x *PrefetchJob) Reset() {
+	*x = PrefetchJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrefetchJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrefetchJob) ProtoMessage() {}
+
+func (x *PrefetchJob) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrefetchJob.ProtoReflect.Descriptor instead.
+func (*PrefetchJob) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PrefetchJob) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+type GetPrefetchStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId uint64 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (x *GetPrefetchStatusRequest) Reset() {
+	*x = GetPrefetchStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPrefetchStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrefetchStatusRequest) ProtoMessage() {}
+
+func (x *GetPrefetchStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrefetchStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetPrefetchStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetPrefetchStatusRequest) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+type PrefetchStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId     uint64      `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	FromEpoch uint64      `protobuf:"varint,2,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	ToEpoch   uint64      `protobuf:"varint,3,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty"`
+	Completed uint64      `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
+	Total     uint64      `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	Done      bool        `protobuf:"varint,6,opt,name=done,proto3" json:"done,omitempty"`
+	Error     string      `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	Gaps      []*EpochGap `protobuf:"bytes,8,rep,name=gaps,proto3" json:"gaps,omitempty"`
+}
+
+func (x *PrefetchStatus) Reset() {
+	*x = PrefetchStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrefetchStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrefetchStatus) ProtoMessage() {}
+
+func (x *PrefetchStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrefetchStatus.ProtoReflect.Descriptor instead.
+func (*PrefetchStatus) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PrefetchStatus) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *PrefetchStatus) GetFromEpoch() uint64 {
+	if x != nil {
+		return x.FromEpoch
+	}
+	return 0
+}
+
+func (x *PrefetchStatus) GetToEpoch() uint64 {
+	if x != nil {
+		return x.ToEpoch
+	}
+	return 0
+}
+
+func (x *PrefetchStatus) GetCompleted() uint64 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *PrefetchStatus) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PrefetchStatus) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *PrefetchStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *PrefetchStatus) GetGaps() []*EpochGap {
+	if x != nil {
+		return x.Gaps
+	}
+	return nil
+}
+
+type EpochGap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch  uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *EpochGap) Reset() {
+	*x = EpochGap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochGap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochGap) ProtoMessage() {}
+
+func (x *EpochGap) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochGap.ProtoReflect.Descriptor instead.
+func (*EpochGap) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *EpochGap) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *EpochGap) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListCanonicalBlockRootsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromSlot uint64 `protobuf:"varint,1,opt,name=from_slot,json=fromSlot,proto3" json:"from_slot,omitempty"`
+	ToSlot   uint64 `protobuf:"varint,2,opt,name=to_slot,json=toSlot,proto3" json:"to_slot,omitempty"`
+}
+
+func (x *ListCanonicalBlockRootsRequest) Reset() {
+	*x = ListCanonicalBlockRootsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCanonicalBlockRootsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCanonicalBlockRootsRequest) ProtoMessage() {}
+
+func (x *ListCanonicalBlockRootsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCanonicalBlockRootsRequest.ProtoReflect.Descriptor instead.
+func (*ListCanonicalBlockRootsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListCanonicalBlockRootsRequest) GetFromSlot() uint64 {
+	if x != nil {
+		return x.FromSlot
+	}
+	return 0
+}
+
+func (x *ListCanonicalBlockRootsRequest) GetToSlot() uint64 {
+	if x != nil {
+		return x.ToSlot
+	}
+	return 0
+}
+
+type CanonicalBlockRoots struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Roots []*CanonicalBlockRoot `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+}
+
+func (x *CanonicalBlockRoots) Reset() {
+	*x = CanonicalBlockRoots{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CanonicalBlockRoots) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanonicalBlockRoots) ProtoMessage() {}
+
+func (x *CanonicalBlockRoots) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanonicalBlockRoots.ProtoReflect.Descriptor instead.
+func (*CanonicalBlockRoots) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CanonicalBlockRoots) GetRoots() []*CanonicalBlockRoot {
+	if x != nil {
+		return x.Roots
+	}
+	return nil
+}
+
+type CanonicalBlockRoot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Root []byte `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+}
+
+func (x *CanonicalBlockRoot) Reset() {
+	*x = CanonicalBlockRoot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CanonicalBlockRoot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanonicalBlockRoot) ProtoMessage() {}
+
+func (x *CanonicalBlockRoot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanonicalBlockRoot.ProtoReflect.Descriptor instead.
+func (*CanonicalBlockRoot) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CanonicalBlockRoot) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *CanonicalBlockRoot) GetRoot() []byte {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+type StreamProposerAuditRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrackedOnly bool `protobuf:"varint,1,opt,name=tracked_only,json=trackedOnly,proto3" json:"tracked_only,omitempty"`
+}
+
+func (x *StreamProposerAuditRequest) Reset() {
+	*x = StreamProposerAuditRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamProposerAuditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamProposerAuditRequest) ProtoMessage() {}
+
+func (x *StreamProposerAuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamProposerAuditRequest.ProtoReflect.Descriptor instead.
+func (*StreamProposerAuditRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *StreamProposerAuditRequest) GetTrackedOnly() bool {
+	if x != nil {
+		return x.TrackedOnly
+	}
+	return false
+}
+
+type ProposerAudit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot             uint64                `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	ExpectedProposer []byte                `protobuf:"bytes,2,opt,name=expected_proposer,json=expectedProposer,proto3" json:"expected_proposer,omitempty"`
+	BlockRoot        []byte                `protobuf:"bytes,3,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	ActualProposer   []byte                `protobuf:"bytes,4,opt,name=actual_proposer,json=actualProposer,proto3" json:"actual_proposer,omitempty"`
+	Outcome          ProposerAudit_Outcome `protobuf:"varint,5,opt,name=outcome,proto3,enum=ethereum.beacon.rpc.v1.ProposerAudit_Outcome" json:"outcome,omitempty"`
+}
+
+func (x *ProposerAudit) Reset() {
+	*x = ProposerAudit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposerAudit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposerAudit) ProtoMessage() {}
+
+func (x *ProposerAudit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposerAudit.ProtoReflect.Descriptor instead.
+func (*ProposerAudit) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ProposerAudit) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *ProposerAudit) GetExpectedProposer() []byte {
+	if x != nil {
+		return x.ExpectedProposer
+	}
+	return nil
+}
+
+func (x *ProposerAudit) GetBlockRoot() []byte {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+func (x *ProposerAudit) GetActualProposer() []byte {
+	if x != nil {
+		return x.ActualProposer
+	}
+	return nil
+}
+
+func (x *ProposerAudit) GetOutcome() ProposerAudit_Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return ProposerAudit_PRODUCED
+}
+
+type TrackValidatorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Add    [][]byte `protobuf:"bytes,1,rep,name=add,proto3" json:"add,omitempty"`
+	Remove [][]byte `protobuf:"bytes,2,rep,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (x *TrackValidatorsRequest) Reset() {
+	*x = TrackValidatorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackValidatorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackValidatorsRequest) ProtoMessage() {}
+
+func (x *TrackValidatorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackValidatorsRequest.ProtoReflect.Descriptor instead.
+func (*TrackValidatorsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *TrackValidatorsRequest) GetAdd() [][]byte {
+	if x != nil {
+		return x.Add
+	}
+	return nil
+}
+
+func (x *TrackValidatorsRequest) GetRemove() [][]byte {
+	if x != nil {
+		return x.Remove
+	}
+	return nil
+}
+
+type TrackedValidatorsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKeys [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+}
+
+func (x *TrackedValidatorsResponse) Reset() {
+	*x = TrackedValidatorsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackedValidatorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackedValidatorsResponse) ProtoMessage() {}
+
+func (x *TrackedValidatorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackedValidatorsResponse.ProtoReflect.Descriptor instead.
+func (*TrackedValidatorsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *TrackedValidatorsResponse) GetPublicKeys() [][]byte {
+	if x != nil {
+		return x.PublicKeys
+	}
+	return nil
+}
+
+type ImportTrackedValidatorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data    []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Replace bool   `protobuf:"varint,2,opt,name=replace,proto3" json:"replace,omitempty"`
+}
+
+func (x *ImportTrackedValidatorsRequest) Reset() {
+	*x = ImportTrackedValidatorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportTrackedValidatorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTrackedValidatorsRequest) ProtoMessage() {}
+
+func (x *ImportTrackedValidatorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTrackedValidatorsRequest.ProtoReflect.Descriptor instead.
+func (*ImportTrackedValidatorsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ImportTrackedValidatorsRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ImportTrackedValidatorsRequest) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+type TrackedValidatorsExport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *TrackedValidatorsExport) Reset() {
+	*x = TrackedValidatorsExport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackedValidatorsExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackedValidatorsExport) ProtoMessage() {}
+
+func (x *TrackedValidatorsExport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackedValidatorsExport.ProtoReflect.Descriptor instead.
+func (*TrackedValidatorsExport) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *TrackedValidatorsExport) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListPoolAttestationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to SlotFilter:
+	//	*ListPoolAttestationsRequest_Slot
+	SlotFilter isListPoolAttestationsRequest_SlotFilter `protobuf_oneof:"slot_filter"`
+	// Types that are assignable to CommitteeFilter:
+	//	*ListPoolAttestationsRequest_CommitteeIndex
+	CommitteeFilter isListPoolAttestationsRequest_CommitteeFilter `protobuf_oneof:"committee_filter"`
+}
+
+func (x *ListPoolAttestationsRequest) Reset() {
+	*x = ListPoolAttestationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPoolAttestationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPoolAttestationsRequest) ProtoMessage() {}
+
+func (x *ListPoolAttestationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPoolAttestationsRequest.ProtoReflect.Descriptor instead.
+func (*ListPoolAttestationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{54}
+}
+
+func (m *ListPoolAttestationsRequest) GetSlotFilter() isListPoolAttestationsRequest_SlotFilter {
+	if m != nil {
+		return m.SlotFilter
+	}
+	return nil
+}
+
+func (x *ListPoolAttestationsRequest) GetSlot() uint64 {
+	if x, ok := x.GetSlotFilter().(*ListPoolAttestationsRequest_Slot); ok {
+		return x.Slot
+	}
+	return 0
+}
+
+func (m *ListPoolAttestationsRequest) GetCommitteeFilter() isListPoolAttestationsRequest_CommitteeFilter {
+	if m != nil {
+		return m.CommitteeFilter
+	}
+	return nil
+}
+
+func (x *ListPoolAttestationsRequest) GetCommitteeIndex() uint64 {
+	if x, ok := x.GetCommitteeFilter().(*ListPoolAttestationsRequest_CommitteeIndex); ok {
+		return x.CommitteeIndex
+	}
+	return 0
+}
+
+type isListPoolAttestationsRequest_SlotFilter interface {
+	isListPoolAttestationsRequest_SlotFilter()
+}
+
+type ListPoolAttestationsRequest_Slot struct {
+	Slot uint64 `protobuf:"varint,1,opt,name=slot,proto3,oneof"`
+}
+
+func (*ListPoolAttestationsRequest_Slot) isListPoolAttestationsRequest_SlotFilter() {}
+
+type isListPoolAttestationsRequest_CommitteeFilter interface {
+	isListPoolAttestationsRequest_CommitteeFilter()
+}
+
+type ListPoolAttestationsRequest_CommitteeIndex struct {
+	CommitteeIndex uint64 `protobuf:"varint,2,opt,name=committee_index,json=committeeIndex,proto3,oneof"`
+}
+
+func (*ListPoolAttestationsRequest_CommitteeIndex) isListPoolAttestationsRequest_CommitteeFilter() {}
+
+type PoolAttestations struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Committees []*PoolCommitteeAttestations `protobuf:"bytes,1,rep,name=committees,proto3" json:"committees,omitempty"`
+}
+
+func (x *PoolAttestations) Reset() {
+	*x = PoolAttestations{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolAttestations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolAttestations) ProtoMessage() {}
+
+func (x *PoolAttestations) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolAttestations.ProtoReflect.Descriptor instead.
+func (*PoolAttestations) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *PoolAttestations) GetCommittees() []*PoolCommitteeAttestations {
+	if x != nil {
+		return x.Committees
+	}
+	return nil
+}
+
+type PoolCommitteeAttestations struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot           uint64                  `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	CommitteeIndex uint64                  `protobuf:"varint,2,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
+	Unaggregated   []*v1alpha1.Attestation `protobuf:"bytes,3,rep,name=unaggregated,proto3" json:"unaggregated,omitempty"`
+	Aggregated     []*v1alpha1.Attestation `protobuf:"bytes,4,rep,name=aggregated,proto3" json:"aggregated,omitempty"`
+	Participants   uint64                  `protobuf:"varint,5,opt,name=participants,proto3" json:"participants,omitempty"`
+}
+
+func (x *PoolCommitteeAttestations) Reset() {
+	*x = PoolCommitteeAttestations{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolCommitteeAttestations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolCommitteeAttestations) ProtoMessage() {}
+
+func (x *PoolCommitteeAttestations) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolCommitteeAttestations.ProtoReflect.Descriptor instead.
+func (*PoolCommitteeAttestations) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PoolCommitteeAttestations) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *PoolCommitteeAttestations) GetCommitteeIndex() uint64 {
+	if x != nil {
+		return x.CommitteeIndex
+	}
+	return 0
+}
+
+func (x *PoolCommitteeAttestations) GetUnaggregated() []*v1alpha1.Attestation {
+	if x != nil {
+		return x.Unaggregated
+	}
+	return nil
+}
+
+func (x *PoolCommitteeAttestations) GetAggregated() []*v1alpha1.Attestation {
+	if x != nil {
+		return x.Aggregated
+	}
+	return nil
+}
+
+func (x *PoolCommitteeAttestations) GetParticipants() uint64 {
+	if x != nil {
+		return x.Participants
+	}
+	return 0
+}
+
+type Eth1DataStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VotingPeriodStartSlot     uint64             `protobuf:"varint,1,opt,name=voting_period_start_slot,json=votingPeriodStartSlot,proto3" json:"voting_period_start_slot,omitempty"`
+	NextVotingPeriodStartSlot uint64             `protobuf:"varint,2,opt,name=next_voting_period_start_slot,json=nextVotingPeriodStartSlot,proto3" json:"next_voting_period_start_slot,omitempty"`
+	Eth1Data                  *v1alpha1.Eth1Data `protobuf:"bytes,3,opt,name=eth1_data,json=eth1Data,proto3" json:"eth1_data,omitempty"`
+	Eth1DepositIndex          uint64             `protobuf:"varint,4,opt,name=eth1_deposit_index,json=eth1DepositIndex,proto3" json:"eth1_deposit_index,omitempty"`
+	PendingDeposits           uint64             `protobuf:"varint,5,opt,name=pending_deposits,json=pendingDeposits,proto3" json:"pending_deposits,omitempty"`
+	Votes                     uint64             `protobuf:"varint,6,opt,name=votes,proto3" json:"votes,omitempty"`
+	Vote                      *v1alpha1.Eth1Data `protobuf:"bytes,7,opt,name=vote,proto3" json:"vote,omitempty"`
+	VoteSupport               uint64             `protobuf:"varint,8,opt,name=vote_support,json=voteSupport,proto3" json:"vote_support,omitempty"`
+	RequiredSupport           uint64             `protobuf:"varint,9,opt,name=required_support,json=requiredSupport,proto3" json:"required_support,omitempty"`
+}
+
+func (x *Eth1DataStatus) Reset() {
+	*x = Eth1DataStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Eth1DataStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Eth1DataStatus) ProtoMessage() {}
+
+func (x *Eth1DataStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Eth1DataStatus.ProtoReflect.Descriptor instead.
+func (*Eth1DataStatus) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Eth1DataStatus) GetVotingPeriodStartSlot() uint64 {
+	if x != nil {
+		return x.VotingPeriodStartSlot
+	}
+	return 0
+}
+
+func (x *Eth1DataStatus) GetNextVotingPeriodStartSlot() uint64 {
+	if x != nil {
+		return x.NextVotingPeriodStartSlot
+	}
+	return 0
+}
+
+func (x *Eth1DataStatus) GetEth1Data() *v1alpha1.Eth1Data {
+	if x != nil {
+		return x.Eth1Data
+	}
+	return nil
+}
+
+func (x *Eth1DataStatus) GetEth1DepositIndex() uint64 {
+	if x != nil {
+		return x.Eth1DepositIndex
+	}
+	return 0
+}
+
+func (x *Eth1DataStatus) GetPendingDeposits() uint64 {
+	if x != nil {
+		return x.PendingDeposits
+	}
+	return 0
+}
+
+func (x *Eth1DataStatus) GetVotes() uint64 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *Eth1DataStatus) GetVote() *v1alpha1.Eth1Data {
+	if x != nil {
+		return x.Vote
+	}
+	return nil
+}
+
+func (x *Eth1DataStatus) GetVoteSupport() uint64 {
+	if x != nil {
+		return x.VoteSupport
+	}
+	return 0
+}
+
+func (x *Eth1DataStatus) GetRequiredSupport() uint64 {
+	if x != nil {
+		return x.RequiredSupport
+	}
+	return 0
+}
+
+type DepositInclusion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot                  uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	BlockRoot             []byte `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	Index                 uint64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	PublicKey             []byte `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	WithdrawalCredentials []byte `protobuf:"bytes,5,opt,name=withdrawal_credentials,json=withdrawalCredentials,proto3" json:"withdrawal_credentials,omitempty"`
+	Amount                uint64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *DepositInclusion) Reset() {
+	*x = DepositInclusion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositInclusion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositInclusion) ProtoMessage() {}
+
+func (x *DepositInclusion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositInclusion.ProtoReflect.Descriptor instead.
+func (*DepositInclusion) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DepositInclusion) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *DepositInclusion) GetBlockRoot() []byte {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+func (x *DepositInclusion) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *DepositInclusion) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *DepositInclusion) GetWithdrawalCredentials() []byte {
+	if x != nil {
+		return x.WithdrawalCredentials
+	}
+	return nil
+}
+
+func (x *DepositInclusion) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type SimulateEpochTransitionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to StateFilter:
+	//	*SimulateEpochTransitionRequest_StateRoot
+	//	*SimulateEpochTransitionRequest_Slot
+	StateFilter isSimulateEpochTransitionRequest_StateFilter `protobuf_oneof:"state_filter"`
+}
+
+func (x *SimulateEpochTransitionRequest) Reset() {
+	*x = SimulateEpochTransitionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulateEpochTransitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateEpochTransitionRequest) ProtoMessage() {}
+
+func (x *SimulateEpochTransitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateEpochTransitionRequest.ProtoReflect.Descriptor instead.
+func (*SimulateEpochTransitionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{59}
+}
+
+func (m *SimulateEpochTransitionRequest) GetStateFilter() isSimulateEpochTransitionRequest_StateFilter {
+	if m != nil {
+		return m.StateFilter
+	}
+	return nil
+}
+
+func (x *SimulateEpochTransitionRequest) GetStateRoot() []byte {
+	if x, ok := x.GetStateFilter().(*SimulateEpochTransitionRequest_StateRoot); ok {
+		return x.StateRoot
+	}
+	return nil
+}
+
+func (x *SimulateEpochTransitionRequest) GetSlot() uint64 {
+	if x, ok := x.GetStateFilter().(*SimulateEpochTransitionRequest_Slot); ok {
+		return x.Slot
+	}
+	return 0
+}
+
+type isSimulateEpochTransitionRequest_StateFilter interface {
+	isSimulateEpochTransitionRequest_StateFilter()
+}
+
+type SimulateEpochTransitionRequest_StateRoot struct {
+	StateRoot []byte `protobuf:"bytes,1,opt,name=state_root,json=stateRoot,proto3,oneof"`
+}
+
+type SimulateEpochTransitionRequest_Slot struct {
+	Slot uint64 `protobuf:"varint,2,opt,name=slot,proto3,oneof"`
+}
+
+func (*SimulateEpochTransitionRequest_StateRoot) isSimulateEpochTransitionRequest_StateFilter() {}
+
+func (*SimulateEpochTransitionRequest_Slot) isSimulateEpochTransitionRequest_StateFilter() {}
+
+type EpochTransitionSimulation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot                            uint64               `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                           uint64               `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	PreviousJustifiedCheckpoint     *v1alpha1.Checkpoint `protobuf:"bytes,3,opt,name=previous_justified_checkpoint,json=previousJustifiedCheckpoint,proto3" json:"previous_justified_checkpoint,omitempty"`
+	CurrentJustifiedCheckpoint      *v1alpha1.Checkpoint `protobuf:"bytes,4,opt,name=current_justified_checkpoint,json=currentJustifiedCheckpoint,proto3" json:"current_justified_checkpoint,omitempty"`
+	FinalizedCheckpoint             *v1alpha1.Checkpoint `protobuf:"bytes,5,opt,name=finalized_checkpoint,json=finalizedCheckpoint,proto3" json:"finalized_checkpoint,omitempty"`
+	PostPreviousJustifiedCheckpoint *v1alpha1.Checkpoint `protobuf:"bytes,6,opt,name=post_previous_justified_checkpoint,json=postPreviousJustifiedCheckpoint,proto3" json:"post_previous_justified_checkpoint,omitempty"`
+	PostCurrentJustifiedCheckpoint  *v1alpha1.Checkpoint `protobuf:"bytes,7,opt,name=post_current_justified_checkpoint,json=postCurrentJustifiedCheckpoint,proto3" json:"post_current_justified_checkpoint,omitempty"`
+	PostFinalizedCheckpoint         *v1alpha1.Checkpoint `protobuf:"bytes,8,opt,name=post_finalized_checkpoint,json=postFinalizedCheckpoint,proto3" json:"post_finalized_checkpoint,omitempty"`
+	JustificationChanged            bool                 `protobuf:"varint,9,opt,name=justification_changed,json=justificationChanged,proto3" json:"justification_changed,omitempty"`
+	FinalizationChanged             bool                 `protobuf:"varint,10,opt,name=finalization_changed,json=finalizationChanged,proto3" json:"finalization_changed,omitempty"`
+	Penalties                       []*ValidatorPenalty  `protobuf:"bytes,11,rep,name=penalties,proto3" json:"penalties,omitempty"`
+	TotalPenalties                  uint64               `protobuf:"varint,12,opt,name=total_penalties,json=totalPenalties,proto3" json:"total_penalties,omitempty"`
+	EjectedValidators               []uint64             `protobuf:"varint,13,rep,packed,name=ejected_validators,json=ejectedValidators,proto3" json:"ejected_validators,omitempty"`
+	NextEpochProposers              [][]byte             `protobuf:"bytes,14,rep,name=next_epoch_proposers,json=nextEpochProposers,proto3" json:"next_epoch_proposers,omitempty"`
+	ProposerListRoot                []byte               `protobuf:"bytes,15,opt,name=proposer_list_root,json=proposerListRoot,proto3" json:"proposer_list_root,omitempty"`
+}
+
+func (x *EpochTransitionSimulation) Reset() {
+	*x = EpochTransitionSimulation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochTransitionSimulation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochTransitionSimulation) ProtoMessage() {}
+
+func (x *EpochTransitionSimulation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochTransitionSimulation.ProtoReflect.Descriptor instead.
+func (*EpochTransitionSimulation) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *EpochTransitionSimulation) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *EpochTransitionSimulation) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *EpochTransitionSimulation) GetPreviousJustifiedCheckpoint() *v1alpha1.Checkpoint {
+	if x != nil {
+		return x.PreviousJustifiedCheckpoint
+	}
+	return nil
+}
+
+func (x *EpochTransitionSimulation) GetCurrentJustifiedCheckpoint() *v1alpha1.Checkpoint {
+	if x != nil {
+		return x.CurrentJustifiedCheckpoint
+	}
+	return nil
+}
+
+func (x *EpochTransitionSimulation) GetFinalizedCheckpoint() *v1alpha1.Checkpoint {
+	if x != nil {
+		return x.FinalizedCheckpoint
+	}
+	return nil
+}
+
+func (x *EpochTransitionSimulation) GetPostPreviousJustifiedCheckpoint() *v1alpha1.Checkpoint {
+	if x != nil {
+		return x.PostPreviousJustifiedCheckpoint
+	}
+	return nil
+}
+
+func (x *EpochTransitionSimulation) GetPostCurrentJustifiedCheckpoint() *v1alpha1.Checkpoint {
+	if x != nil {
+		return x.PostCurrentJustifiedCheckpoint
+	}
+	return nil
+}
+
+func (x *EpochTransitionSimulation) GetPostFinalizedCheckpoint() *v1alpha1.Checkpoint {
+	if x != nil {
+		return x.PostFinalizedCheckpoint
+	}
+	return nil
+}
+
+func (x *EpochTransitionSimulation) GetJustificationChanged() bool {
+	if x != nil {
+		return x.JustificationChanged
+	}
+	return false
+}
+
+func (x *EpochTransitionSimulation) GetFinalizationChanged() bool {
+	if x != nil {
+		return x.FinalizationChanged
+	}
+	return false
+}
+
+func (x *EpochTransitionSimulation) GetPenalties() []*ValidatorPenalty {
+	if x != nil {
+		return x.Penalties
+	}
+	return nil
+}
+
+func (x *EpochTransitionSimulation) GetTotalPenalties() uint64 {
+	if x != nil {
+		return x.TotalPenalties
+	}
+	return 0
+}
+
+func (x *EpochTransitionSimulation) GetEjectedValidators() []uint64 {
+	if x != nil {
+		return x.EjectedValidators
+	}
+	return nil
+}
+
+func (x *EpochTransitionSimulation) GetNextEpochProposers() [][]byte {
+	if x != nil {
+		return x.NextEpochProposers
+	}
+	return nil
+}
+
+func (x *EpochTransitionSimulation) GetProposerListRoot() []byte {
+	if x != nil {
+		return x.ProposerListRoot
+	}
+	return nil
+}
+
+type ValidatorPenalty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index  uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *ValidatorPenalty) Reset() {
+	*x = ValidatorPenalty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorPenalty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorPenalty) ProtoMessage() {}
+
+func (x *ValidatorPenalty) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorPenalty.ProtoReflect.Descriptor instead.
+func (*ValidatorPenalty) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ValidatorPenalty) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ValidatorPenalty) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type ListCanonicalBlockHeadersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromSlot  uint64 `protobuf:"varint,1,opt,name=from_slot,json=fromSlot,proto3" json:"from_slot,omitempty"`
+	ToSlot    uint64 `protobuf:"varint,2,opt,name=to_slot,json=toSlot,proto3" json:"to_slot,omitempty"`
+	PageSize  int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListCanonicalBlockHeadersRequest) Reset() {
+	*x = ListCanonicalBlockHeadersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCanonicalBlockHeadersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCanonicalBlockHeadersRequest) ProtoMessage() {}
+
+func (x *ListCanonicalBlockHeadersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCanonicalBlockHeadersRequest.ProtoReflect.Descriptor instead.
+func (*ListCanonicalBlockHeadersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListCanonicalBlockHeadersRequest) GetFromSlot() uint64 {
+	if x != nil {
+		return x.FromSlot
+	}
+	return 0
+}
+
+func (x *ListCanonicalBlockHeadersRequest) GetToSlot() uint64 {
+	if x != nil {
+		return x.ToSlot
+	}
+	return 0
+}
+
+func (x *ListCanonicalBlockHeadersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCanonicalBlockHeadersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type CanonicalBlockHeaders struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Headers       []*CanonicalBlockHeader `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty"`
+	NextPageToken string                  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *CanonicalBlockHeaders) Reset() {
+	*x = CanonicalBlockHeaders{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CanonicalBlockHeaders) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanonicalBlockHeaders) ProtoMessage() {}
+
+func (x *CanonicalBlockHeaders) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanonicalBlockHeaders.ProtoReflect.Descriptor instead.
+func (*CanonicalBlockHeaders) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CanonicalBlockHeaders) GetHeaders() []*CanonicalBlockHeader {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *CanonicalBlockHeaders) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CanonicalBlockHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Root         []byte                      `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	Header       *v1alpha1.BeaconBlockHeader `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+	Confirmation *PandoraConfirmation        `protobuf:"bytes,3,opt,name=confirmation,proto3" json:"confirmation,omitempty"`
+}
+
+func (x *CanonicalBlockHeader) Reset() {
+	*x = CanonicalBlockHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CanonicalBlockHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanonicalBlockHeader) ProtoMessage() {}
+
+func (x *CanonicalBlockHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanonicalBlockHeader.ProtoReflect.Descriptor instead.
+func (*CanonicalBlockHeader) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CanonicalBlockHeader) GetRoot() []byte {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *CanonicalBlockHeader) GetHeader() *v1alpha1.BeaconBlockHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *CanonicalBlockHeader) GetConfirmation() *PandoraConfirmation {
+	if x != nil {
+		return x.Confirmation
+	}
+	return nil
+}
+
+type PandoraConfirmation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot        uint64                     `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	BlockRoot   []byte                     `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	PandoraHash []byte                     `protobuf:"bytes,3,opt,name=pandora_hash,json=pandoraHash,proto3" json:"pandora_hash,omitempty"`
+	Status      PandoraConfirmation_Status `protobuf:"varint,4,opt,name=status,proto3,enum=ethereum.beacon.rpc.v1.PandoraConfirmation_Status" json:"status,omitempty"`
+}
+
+func (x *PandoraConfirmation) Reset() {
+	*x = PandoraConfirmation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PandoraConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PandoraConfirmation) ProtoMessage() {}
+
+func (x *PandoraConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PandoraConfirmation.ProtoReflect.Descriptor instead.
+func (*PandoraConfirmation) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *PandoraConfirmation) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *PandoraConfirmation) GetBlockRoot() []byte {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+func (x *PandoraConfirmation) GetPandoraHash() []byte {
+	if x != nil {
+		return x.PandoraHash
+	}
+	return nil
+}
+
+func (x *PandoraConfirmation) GetStatus() PandoraConfirmation_Status {
+	if x != nil {
+		return x.Status
+	}
+	return PandoraConfirmation_PENDING
+}
+
+type GetBlockByPandoraHashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PandoraHash []byte `protobuf:"bytes,1,opt,name=pandora_hash,json=pandoraHash,proto3" json:"pandora_hash,omitempty"`
+}
+
+func (x *GetBlockByPandoraHashRequest) Reset() {
+	*x = GetBlockByPandoraHashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlockByPandoraHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockByPandoraHashRequest) ProtoMessage() {}
+
+func (x *GetBlockByPandoraHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockByPandoraHashRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockByPandoraHashRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetBlockByPandoraHashRequest) GetPandoraHash() []byte {
+	if x != nil {
+		return x.PandoraHash
+	}
+	return nil
+}
+
+type PairedBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Block        *v1alpha1.BeaconBlockContainer `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Confirmation *PandoraConfirmation           `protobuf:"bytes,2,opt,name=confirmation,proto3" json:"confirmation,omitempty"`
+}
+
+func (x *PairedBlock) Reset() {
+	*x = PairedBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairedBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairedBlock) ProtoMessage() {}
+
+func (x *PairedBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairedBlock.ProtoReflect.Descriptor instead.
+func (*PairedBlock) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *PairedBlock) GetBlock() *v1alpha1.BeaconBlockContainer {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *PairedBlock) GetConfirmation() *PandoraConfirmation {
+	if x != nil {
+		return x.Confirmation
+	}
+	return nil
+}
+
+type ProposerListConsistencyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *ProposerListConsistencyRequest) Reset() {
+	*x = ProposerListConsistencyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposerListConsistencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposerListConsistencyRequest) ProtoMessage() {}
+
+func (x *ProposerListConsistencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposerListConsistencyRequest.ProtoReflect.Descriptor instead.
+func (*ProposerListConsistencyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ProposerListConsistencyRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+type ProposerListConsistency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch          uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Digest         []byte   `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+	Peers          uint64   `protobuf:"varint,3,opt,name=peers,proto3" json:"peers,omitempty"`
+	MatchingPeers  uint64   `protobuf:"varint,4,opt,name=matching_peers,json=matchingPeers,proto3" json:"matching_peers,omitempty"`
+	Score          float64  `protobuf:"fixed64,5,opt,name=score,proto3" json:"score,omitempty"`
+	DivergentPeers []string `protobuf:"bytes,6,rep,name=divergent_peers,json=divergentPeers,proto3" json:"divergent_peers,omitempty"`
+}
+
+func (x *ProposerListConsistency) Reset() {
+	*x = ProposerListConsistency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposerListConsistency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposerListConsistency) ProtoMessage() {}
+
+func (x *ProposerListConsistency) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposerListConsistency.ProtoReflect.Descriptor instead.
+func (*ProposerListConsistency) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ProposerListConsistency) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ProposerListConsistency) GetDigest() []byte {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+func (x *ProposerListConsistency) GetPeers() uint64 {
+	if x != nil {
+		return x.Peers
+	}
+	return 0
+}
+
+func (x *ProposerListConsistency) GetMatchingPeers() uint64 {
+	if x != nil {
+		return x.MatchingPeers
+	}
+	return 0
+}
+
+func (x *ProposerListConsistency) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ProposerListConsistency) GetDivergentPeers() []string {
+	if x != nil {
+		return x.DivergentPeers
+	}
+	return nil
+}
+
+type CommitteeParticipation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommitteeIndex uint64  `protobuf:"varint,1,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
+	Expected       uint64  `protobuf:"varint,2,opt,name=expected,proto3" json:"expected,omitempty"`
+	Seen           uint64  `protobuf:"varint,3,opt,name=seen,proto3" json:"seen,omitempty"`
+	Included       uint64  `protobuf:"varint,4,opt,name=included,proto3" json:"included,omitempty"`
+	Rate           float64 `protobuf:"fixed64,5,opt,name=rate,proto3" json:"rate,omitempty"`
+}
+
+func (x *CommitteeParticipation) Reset() {
+	*x = CommitteeParticipation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitteeParticipation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitteeParticipation) ProtoMessage() {}
+
+func (x *CommitteeParticipation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitteeParticipation.ProtoReflect.Descriptor instead.
+func (*CommitteeParticipation) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CommitteeParticipation) GetCommitteeIndex() uint64 {
+	if x != nil {
+		return x.CommitteeIndex
+	}
+	return 0
+}
+
+func (x *CommitteeParticipation) GetExpected() uint64 {
+	if x != nil {
+		return x.Expected
+	}
+	return 0
+}
+
+func (x *CommitteeParticipation) GetSeen() uint64 {
+	if x != nil {
+		return x.Seen
+	}
+	return 0
+}
+
+func (x *CommitteeParticipation) GetIncluded() uint64 {
+	if x != nil {
+		return x.Included
+	}
+	return 0
+}
+
+func (x *CommitteeParticipation) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+type SlotCommitteeParticipation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot       uint64                    `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Committees []*CommitteeParticipation `protobuf:"bytes,2,rep,name=committees,proto3" json:"committees,omitempty"`
+	Expected   uint64                    `protobuf:"varint,3,opt,name=expected,proto3" json:"expected,omitempty"`
+	Seen       uint64                    `protobuf:"varint,4,opt,name=seen,proto3" json:"seen,omitempty"`
+	Included   uint64                    `protobuf:"varint,5,opt,name=included,proto3" json:"included,omitempty"`
+	Rate       float64                   `protobuf:"fixed64,6,opt,name=rate,proto3" json:"rate,omitempty"`
+}
+
+func (x *SlotCommitteeParticipation) Reset() {
+	*x = SlotCommitteeParticipation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlotCommitteeParticipation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotCommitteeParticipation) ProtoMessage() {}
+
+func (x *SlotCommitteeParticipation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotCommitteeParticipation.ProtoReflect.Descriptor instead.
+func (*SlotCommitteeParticipation) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SlotCommitteeParticipation) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *SlotCommitteeParticipation) GetCommittees() []*CommitteeParticipation {
+	if x != nil {
+		return x.Committees
+	}
+	return nil
+}
+
+func (x *SlotCommitteeParticipation) GetExpected() uint64 {
+	if x != nil {
+		return x.Expected
+	}
+	return 0
+}
+
+func (x *SlotCommitteeParticipation) GetSeen() uint64 {
+	if x != nil {
+		return x.Seen
+	}
+	return 0
+}
+
+func (x *SlotCommitteeParticipation) GetIncluded() uint64 {
+	if x != nil {
+		return x.Included
+	}
+	return 0
+}
+
+func (x *SlotCommitteeParticipation) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+type CurrentEpochParticipation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch       uint64                        `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	CurrentSlot uint64                        `protobuf:"varint,2,opt,name=current_slot,json=currentSlot,proto3" json:"current_slot,omitempty"`
+	Slots       []*SlotCommitteeParticipation `protobuf:"bytes,3,rep,name=slots,proto3" json:"slots,omitempty"`
+	Expected    uint64                        `protobuf:"varint,4,opt,name=expected,proto3" json:"expected,omitempty"`
+	Seen        uint64                        `protobuf:"varint,5,opt,name=seen,proto3" json:"seen,omitempty"`
+	Included    uint64                        `protobuf:"varint,6,opt,name=included,proto3" json:"included,omitempty"`
+	Rate        float64                       `protobuf:"fixed64,7,opt,name=rate,proto3" json:"rate,omitempty"`
+}
+
+func (x *CurrentEpochParticipation) Reset() {
+	*x = CurrentEpochParticipation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CurrentEpochParticipation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrentEpochParticipation) ProtoMessage() {}
+
+func (x *CurrentEpochParticipation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrentEpochParticipation.ProtoReflect.Descriptor instead.
+func (*CurrentEpochParticipation) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CurrentEpochParticipation) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *CurrentEpochParticipation) GetCurrentSlot() uint64 {
+	if x != nil {
+		return x.CurrentSlot
+	}
+	return 0
+}
+
+func (x *CurrentEpochParticipation) GetSlots() []*SlotCommitteeParticipation {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *CurrentEpochParticipation) GetExpected() uint64 {
+	if x != nil {
+		return x.Expected
+	}
+	return 0
+}
+
+func (x *CurrentEpochParticipation) GetSeen() uint64 {
+	if x != nil {
+		return x.Seen
+	}
+	return 0
+}
+
+func (x *CurrentEpochParticipation) GetIncluded() uint64 {
+	if x != nil {
+		return x.Included
+	}
+	return 0
+}
+
+func (x *CurrentEpochParticipation) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+type AnnotatedValidatorAssignmentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to QueryFilter:
+	//	*AnnotatedValidatorAssignmentsRequest_Epoch
+	//	*AnnotatedValidatorAssignmentsRequest_Genesis
+	//	*AnnotatedValidatorAssignmentsRequest_BlockRoot
+	//	*AnnotatedValidatorAssignmentsRequest_StateRoot
+	QueryFilter                 isAnnotatedValidatorAssignmentsRequest_QueryFilter `protobuf_oneof:"query_filter"`
+	PublicKeys                  [][]byte                                           `protobuf:"bytes,3,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	Indices                     []uint64                                           `protobuf:"varint,4,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	PageSize                    int32                                              `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken                   string                                             `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	CommitteeWeights            bool                                               `protobuf:"varint,7,opt,name=committee_weights,json=committeeWeights,proto3" json:"committee_weights,omitempty"`
+	IncludeValidatorFields      bool                                               `protobuf:"varint,10,opt,name=include_validator_fields,json=includeValidatorFields,proto3" json:"include_validator_fields,omitempty"`
+	TrackedOnly                 bool                                               `protobuf:"varint,11,opt,name=tracked_only,json=trackedOnly,proto3" json:"tracked_only,omitempty"`
+	IndexOnly                   bool                                               `protobuf:"varint,12,opt,name=index_only,json=indexOnly,proto3" json:"index_only,omitempty"`
+	WithdrawalCredentialsPrefix []byte                                             `protobuf:"bytes,13,opt,name=withdrawal_credentials_prefix,json=withdrawalCredentialsPrefix,proto3" json:"withdrawal_credentials_prefix,omitempty"`
+	Compact                     bool                                               `protobuf:"varint,14,opt,name=compact,proto3" json:"compact,omitempty"`
+}
+
+func (x *AnnotatedValidatorAssignmentsRequest) Reset() {
+	*x = AnnotatedValidatorAssignmentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnnotatedValidatorAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnotatedValidatorAssignmentsRequest) ProtoMessage() {}
+
+func (x *AnnotatedValidatorAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnotatedValidatorAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*AnnotatedValidatorAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{73}
+}
+
+func (m *AnnotatedValidatorAssignmentsRequest) GetQueryFilter() isAnnotatedValidatorAssignmentsRequest_QueryFilter {
+	if m != nil {
+		return m.QueryFilter
+	}
+	return nil
+}
+
+func (x *AnnotatedValidatorAssignmentsRequest) GetEpoch() uint64 {
+	if x, ok := x.GetQueryFilter().(*AnnotatedValidatorAssignmentsRequest_Epoch); ok {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *AnnotatedValidatorAssignmentsRequest) GetGenesis() bool {
+	if x, ok := x.GetQueryFilter().(*AnnotatedValidatorAssignmentsRequest_Genesis); ok {
+		return x.Genesis
+	}
+	return false
+}
+
+func (x *AnnotatedValidatorAssignmentsRequest) GetBlockRoot() []byte {
+	if x, ok := x.GetQueryFilter().(*AnnotatedValidatorAssignmentsRequest_BlockRoot); ok {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+func (x *AnnotatedValidatorAssignmentsRequest) GetStateRoot() []byte {
+	if x, ok := x.GetQueryFilter().(*AnnotatedValidatorAssignmentsRequest_StateRoot); ok {
+		return x.StateRoot
+	}
+	return nil
+}
+
+func (x *AnnotatedValidatorAssignmentsRequest) GetPublicKeys() [][]byte {
+	if x != nil {
+		return x.PublicKeys
+	}
+	return nil
+}
+
+func (x *AnnotatedValidatorAssignmentsRequest) GetIndices() []uint64 {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+func (x *AnnotatedValidatorAssignmentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *AnnotatedValidatorAssignmentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *AnnotatedValidatorAssignmentsRequest) GetCommitteeWeights() bool {
+	if x != nil {
+		return x.CommitteeWeights
+	}
+	return false
+}
+
+func (x *AnnotatedValidatorAssignmentsRequest) GetIncludeValidatorFields() bool {
+	if x != nil {
+		return x.IncludeValidatorFields
+	}
+	return false
+}
+
+func (x *AnnotatedValidatorAssignmentsRequest) GetTrackedOnly() bool {
+	if x != nil {
+		return x.TrackedOnly
+	}
+	return false
+}
+
+func (x *AnnotatedValidatorAssignmentsRequest) GetIndexOnly() bool {
+	if x != nil {
+		return x.IndexOnly
+	}
+	return false
+}
+
+func (x *AnnotatedValidatorAssignmentsRequest) GetWithdrawalCredentialsPrefix() []byte {
+	if x != nil {
+		return x.WithdrawalCredentialsPrefix
+	}
+	return nil
+}
+
+func (x *AnnotatedValidatorAssignmentsRequest) GetCompact() bool {
+	if x != nil {
+		return x.Compact
+	}
+	return false
+}
+
+type isAnnotatedValidatorAssignmentsRequest_QueryFilter interface {
+	isAnnotatedValidatorAssignmentsRequest_QueryFilter()
+}
+
+type AnnotatedValidatorAssignmentsRequest_Epoch struct {
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3,oneof"`
+}
+
+type AnnotatedValidatorAssignmentsRequest_Genesis struct {
+	Genesis bool `protobuf:"varint,2,opt,name=genesis,proto3,oneof"`
+}
+
+type AnnotatedValidatorAssignmentsRequest_BlockRoot struct {
+	BlockRoot []byte `protobuf:"bytes,8,opt,name=block_root,json=blockRoot,proto3,oneof"`
+}
+
+type AnnotatedValidatorAssignmentsRequest_StateRoot struct {
+	StateRoot []byte `protobuf:"bytes,9,opt,name=state_root,json=stateRoot,proto3,oneof"`
+}
+
+func (*AnnotatedValidatorAssignmentsRequest_Epoch) isAnnotatedValidatorAssignmentsRequest_QueryFilter() {
+}
+
+func (*AnnotatedValidatorAssignmentsRequest_Genesis) isAnnotatedValidatorAssignmentsRequest_QueryFilter() {
+}
+
+func (*AnnotatedValidatorAssignmentsRequest_BlockRoot) isAnnotatedValidatorAssignmentsRequest_QueryFilter() {
+}
+
+func (*AnnotatedValidatorAssignmentsRequest_StateRoot) isAnnotatedValidatorAssignmentsRequest_QueryFilter() {
+}
+
+type AnnotatedValidatorAssignments struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Assignments        *v1alpha1.ValidatorAssignments `protobuf:"bytes,1,opt,name=assignments,proto3" json:"assignments,omitempty"`
+	ProposerListRoot   []byte                         `protobuf:"bytes,2,opt,name=proposer_list_root,json=proposerListRoot,proto3" json:"proposer_list_root,omitempty"`
+	IndexMismatches    []*ValidatorIndexMismatch      `protobuf:"bytes,3,rep,name=index_mismatches,json=indexMismatches,proto3" json:"index_mismatches,omitempty"`
+	CommitteeWeights   []*CommitteeWeight             `protobuf:"bytes,4,rep,name=committee_weights,json=committeeWeights,proto3" json:"committee_weights,omitempty"`
+	AppliedPageSize    int32                          `protobuf:"varint,5,opt,name=applied_page_size,json=appliedPageSize,proto3" json:"applied_page_size,omitempty"`
+	ValidatorFields    []*ValidatorFields             `protobuf:"bytes,6,rep,name=validator_fields,json=validatorFields,proto3" json:"validator_fields,omitempty"`
+	CommitteePositions []*CommitteePosition           `protobuf:"bytes,7,rep,name=committee_positions,json=committeePositions,proto3" json:"committee_positions,omitempty"`
+}
+
+func (x *AnnotatedValidatorAssignments) Reset() {
+	*x = AnnotatedValidatorAssignments{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnnotatedValidatorAssignments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnotatedValidatorAssignments) ProtoMessage() {}
+
+func (x *AnnotatedValidatorAssignments) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnotatedValidatorAssignments.ProtoReflect.Descriptor instead.
+func (*AnnotatedValidatorAssignments) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AnnotatedValidatorAssignments) GetAssignments() *v1alpha1.ValidatorAssignments {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+func (x *AnnotatedValidatorAssignments) GetProposerListRoot() []byte {
+	if x != nil {
+		return x.ProposerListRoot
+	}
+	return nil
+}
+
+func (x *AnnotatedValidatorAssignments) GetIndexMismatches() []*ValidatorIndexMismatch {
+	if x != nil {
+		return x.IndexMismatches
+	}
+	return nil
+}
+
+func (x *AnnotatedValidatorAssignments) GetCommitteeWeights() []*CommitteeWeight {
+	if x != nil {
+		return x.CommitteeWeights
+	}
+	return nil
+}
+
+func (x *AnnotatedValidatorAssignments) GetAppliedPageSize() int32 {
+	if x != nil {
+		return x.AppliedPageSize
+	}
+	return 0
+}
+
+func (x *AnnotatedValidatorAssignments) GetValidatorFields() []*ValidatorFields {
+	if x != nil {
+		return x.ValidatorFields
+	}
+	return nil
+}
+
+func (x *AnnotatedValidatorAssignments) GetCommitteePositions() []*CommitteePosition {
+	if x != nil {
+		return x.CommitteePositions
+	}
+	return nil
+}
+
+type ValidatorIndexMismatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey      []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	RequestedIndex uint64 `protobuf:"varint,2,opt,name=requested_index,json=requestedIndex,proto3" json:"requested_index,omitempty"`
+	HeadIndex      uint64 `protobuf:"varint,3,opt,name=head_index,json=headIndex,proto3" json:"head_index,omitempty"`
+	InHead         bool   `protobuf:"varint,4,opt,name=in_head,json=inHead,proto3" json:"in_head,omitempty"`
+}
+
+func (x *ValidatorIndexMismatch) Reset() {
+	*x = ValidatorIndexMismatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorIndexMismatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorIndexMismatch) ProtoMessage() {}
+
+func (x *ValidatorIndexMismatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorIndexMismatch.ProtoReflect.Descriptor instead.
+func (*ValidatorIndexMismatch) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ValidatorIndexMismatch) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *ValidatorIndexMismatch) GetRequestedIndex() uint64 {
+	if x != nil {
+		return x.RequestedIndex
+	}
+	return 0
+}
+
+func (x *ValidatorIndexMismatch) GetHeadIndex() uint64 {
+	if x != nil {
+		return x.HeadIndex
+	}
+	return 0
+}
+
+func (x *ValidatorIndexMismatch) GetInHead() bool {
+	if x != nil {
+		return x.InHead
+	}
+	return false
+}
+
+type CommitteeWeight struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot                  uint64  `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	CommitteeIndex        uint64  `protobuf:"varint,2,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
+	TotalEffectiveBalance uint64  `protobuf:"varint,3,opt,name=total_effective_balance,json=totalEffectiveBalance,proto3" json:"total_effective_balance,omitempty"`
+	AttestationWeight     float64 `protobuf:"fixed64,4,opt,name=attestation_weight,json=attestationWeight,proto3" json:"attestation_weight,omitempty"`
+}
+
+func (x *CommitteeWeight) Reset() {
+	*x = CommitteeWeight{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitteeWeight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitteeWeight) ProtoMessage() {}
+
+func (x *CommitteeWeight) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitteeWeight.ProtoReflect.Descriptor instead.
+func (*CommitteeWeight) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *CommitteeWeight) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *CommitteeWeight) GetCommitteeIndex() uint64 {
+	if x != nil {
+		return x.CommitteeIndex
+	}
+	return 0
+}
+
+func (x *CommitteeWeight) GetTotalEffectiveBalance() uint64 {
+	if x != nil {
+		return x.TotalEffectiveBalance
+	}
+	return 0
+}
+
+func (x *CommitteeWeight) GetAttestationWeight() float64 {
+	if x != nil {
+		return x.AttestationWeight
+	}
+	return 0
+}
+
+type ValidatorFields struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidatorIndex             uint64 `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	EffectiveBalance           uint64 `protobuf:"varint,2,opt,name=effective_balance,json=effectiveBalance,proto3" json:"effective_balance,omitempty"`
+	ActivationEligibilityEpoch uint64 `protobuf:"varint,3,opt,name=activation_eligibility_epoch,json=activationEligibilityEpoch,proto3" json:"activation_eligibility_epoch,omitempty"`
+	ActivationEpoch            uint64 `protobuf:"varint,4,opt,name=activation_epoch,json=activationEpoch,proto3" json:"activation_epoch,omitempty"`
+	ExitEpoch                  uint64 `protobuf:"varint,5,opt,name=exit_epoch,json=exitEpoch,proto3" json:"exit_epoch,omitempty"`
+}
+
+func (x *ValidatorFields) Reset() {
+	*x = ValidatorFields{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorFields) ProtoMessage() {}
+
+func (x *ValidatorFields) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorFields.ProtoReflect.Descriptor instead.
+func (*ValidatorFields) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ValidatorFields) GetValidatorIndex() uint64 {
+	if x != nil {
+		return x.ValidatorIndex
+	}
+	return 0
+}
+
+func (x *ValidatorFields) GetEffectiveBalance() uint64 {
+	if x != nil {
+		return x.EffectiveBalance
+	}
+	return 0
+}
+
+func (x *ValidatorFields) GetActivationEligibilityEpoch() uint64 {
+	if x != nil {
+		return x.ActivationEligibilityEpoch
+	}
+	return 0
+}
+
+func (x *ValidatorFields) GetActivationEpoch() uint64 {
+	if x != nil {
+		return x.ActivationEpoch
+	}
+	return 0
+}
+
+func (x *ValidatorFields) GetExitEpoch() uint64 {
+	if x != nil {
+		return x.ExitEpoch
+	}
+	return 0
+}
+
+type ValidatorsByWithdrawalCredentialsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Request                     *v1alpha1.ListValidatorsRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	WithdrawalCredentialsPrefix []byte                          `protobuf:"bytes,2,opt,name=withdrawal_credentials_prefix,json=withdrawalCredentialsPrefix,proto3" json:"withdrawal_credentials_prefix,omitempty"`
+}
+
+func (x *ValidatorsByWithdrawalCredentialsRequest) Reset() {
+	*x = ValidatorsByWithdrawalCredentialsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorsByWithdrawalCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorsByWithdrawalCredentialsRequest) ProtoMessage() {}
+
+func (x *ValidatorsByWithdrawalCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorsByWithdrawalCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*ValidatorsByWithdrawalCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ValidatorsByWithdrawalCredentialsRequest) GetRequest() *v1alpha1.ListValidatorsRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *ValidatorsByWithdrawalCredentialsRequest) GetWithdrawalCredentialsPrefix() []byte {
+	if x != nil {
+		return x.WithdrawalCredentialsPrefix
+	}
+	return nil
+}
+
+type CommitteePosition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidatorIndex uint64 `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	Position       uint64 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	CommitteeSize  uint64 `protobuf:"varint,3,opt,name=committee_size,json=committeeSize,proto3" json:"committee_size,omitempty"`
+}
+
+func (x *CommitteePosition) Reset() {
+	*x = CommitteePosition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitteePosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitteePosition) ProtoMessage() {}
+
+func (x *CommitteePosition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitteePosition.ProtoReflect.Descriptor instead.
+func (*CommitteePosition) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *CommitteePosition) GetValidatorIndex() uint64 {
+	if x != nil {
+		return x.ValidatorIndex
+	}
+	return 0
+}
+
+func (x *CommitteePosition) GetPosition() uint64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *CommitteePosition) GetCommitteeSize() uint64 {
+	if x != nil {
+		return x.CommitteeSize
+	}
+	return 0
+}
+
+type StorageGrowthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Days uint64 `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
+}
+
+func (x *StorageGrowthRequest) Reset() {
+	*x = StorageGrowthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageGrowthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageGrowthRequest) ProtoMessage() {}
+
+func (x *StorageGrowthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageGrowthRequest.ProtoReflect.Descriptor instead.
+func (*StorageGrowthRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *StorageGrowthRequest) GetDays() uint64 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type StorageGrowthEstimate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidatorCount                uint64 `protobuf:"varint,1,opt,name=validator_count,json=validatorCount,proto3" json:"validator_count,omitempty"`
+	SlotsPerArchivedPoint         uint64 `protobuf:"varint,2,opt,name=slots_per_archived_point,json=slotsPerArchivedPoint,proto3" json:"slots_per_archived_point,omitempty"`
+	ColdStateStore                bool   `protobuf:"varint,3,opt,name=cold_state_store,json=coldStateStore,proto3" json:"cold_state_store,omitempty"`
+	ValidatorDedup                bool   `protobuf:"varint,4,opt,name=validator_dedup,json=validatorDedup,proto3" json:"validator_dedup,omitempty"`
+	CurrentSizeBytes              uint64 `protobuf:"varint,5,opt,name=current_size_bytes,json=currentSizeBytes,proto3" json:"current_size_bytes,omitempty"`
+	StateSizeBytes                uint64 `protobuf:"varint,6,opt,name=state_size_bytes,json=stateSizeBytes,proto3" json:"state_size_bytes,omitempty"`
+	ArchivedStateSizeBytes        uint64 `protobuf:"varint,7,opt,name=archived_state_size_bytes,json=archivedStateSizeBytes,proto3" json:"archived_state_size_bytes,omitempty"`
+	BlockSizeBytes                uint64 `protobuf:"varint,8,opt,name=block_size_bytes,json=blockSizeBytes,proto3" json:"block_size_bytes,omitempty"`
+	BytesPerDay                   uint64 `protobuf:"varint,9,opt,name=bytes_per_day,json=bytesPerDay,proto3" json:"bytes_per_day,omitempty"`
+	ColdStoreBytesPerDay          uint64 `protobuf:"varint,10,opt,name=cold_store_bytes_per_day,json=coldStoreBytesPerDay,proto3" json:"cold_store_bytes_per_day,omitempty"`
+	Days                          uint64 `protobuf:"varint,11,opt,name=days,proto3" json:"days,omitempty"`
+	ProjectedSizeBytes            uint64 `protobuf:"varint,12,opt,name=projected_size_bytes,json=projectedSizeBytes,proto3" json:"projected_size_bytes,omitempty"`
+	ProjectedColdStoreGrowthBytes uint64 `protobuf:"varint,13,opt,name=projected_cold_store_growth_bytes,json=projectedColdStoreGrowthBytes,proto3" json:"projected_cold_store_growth_bytes,omitempty"`
+}
+
+func (x *StorageGrowthEstimate) Reset() {
+	*x = StorageGrowthEstimate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageGrowthEstimate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageGrowthEstimate) ProtoMessage() {}
+
+func (x *StorageGrowthEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageGrowthEstimate.ProtoReflect.Descriptor instead.
+func (*StorageGrowthEstimate) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *StorageGrowthEstimate) GetValidatorCount() uint64 {
+	if x != nil {
+		return x.ValidatorCount
+	}
+	return 0
+}
+
+func (x *StorageGrowthEstimate) GetSlotsPerArchivedPoint() uint64 {
+	if x != nil {
+		return x.SlotsPerArchivedPoint
+	}
+	return 0
+}
+
+func (x *StorageGrowthEstimate) GetColdStateStore() bool {
+	if x != nil {
+		return x.ColdStateStore
+	}
+	return false
+}
+
+func (x *StorageGrowthEstimate) GetValidatorDedup() bool {
+	if x != nil {
+		return x.ValidatorDedup
+	}
+	return false
+}
+
+func (x *StorageGrowthEstimate) GetCurrentSizeBytes() uint64 {
+	if x != nil {
+		return x.CurrentSizeBytes
+	}
+	return 0
+}
+
+func (x *StorageGrowthEstimate) GetStateSizeBytes() uint64 {
+	if x != nil {
+		return x.StateSizeBytes
+	}
+	return 0
+}
+
+func (x *StorageGrowthEstimate) GetArchivedStateSizeBytes() uint64 {
+	if x != nil {
+		return x.ArchivedStateSizeBytes
+	}
+	return 0
+}
+
+func (x *StorageGrowthEstimate) GetBlockSizeBytes() uint64 {
+	if x != nil {
+		return x.BlockSizeBytes
+	}
+	return 0
+}
+
+func (x *StorageGrowthEstimate) GetBytesPerDay() uint64 {
+	if x != nil {
+		return x.BytesPerDay
+	}
+	return 0
+}
+
+func (x *StorageGrowthEstimate) GetColdStoreBytesPerDay() uint64 {
+	if x != nil {
+		return x.ColdStoreBytesPerDay
+	}
+	return 0
+}
+
+func (x *StorageGrowthEstimate) GetDays() uint64 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *StorageGrowthEstimate) GetProjectedSizeBytes() uint64 {
+	if x != nil {
+		return x.ProjectedSizeBytes
+	}
+	return 0
+}
+
+func (x *StorageGrowthEstimate) GetProjectedColdStoreGrowthBytes() uint64 {
+	if x != nil {
+		return x.ProjectedColdStoreGrowthBytes
+	}
+	return 0
+}
+
+type PandoraBlockHashConfirmation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot      uint64                     `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Hash      []byte                     `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Status    PandoraConfirmation_Status `protobuf:"varint,3,opt,name=status,proto3,enum=ethereum.beacon.rpc.v1.PandoraConfirmation_Status" json:"status,omitempty"`
+	BlockRoot []byte                     `protobuf:"bytes,4,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+}
+
+func (x *PandoraBlockHashConfirmation) Reset() {
+	*x = PandoraBlockHashConfirmation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PandoraBlockHashConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PandoraBlockHashConfirmation) ProtoMessage() {}
+
+func (x *PandoraBlockHashConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PandoraBlockHashConfirmation.ProtoReflect.Descriptor instead.
+func (*PandoraBlockHashConfirmation) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *PandoraBlockHashConfirmation) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *PandoraBlockHashConfirmation) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *PandoraBlockHashConfirmation) GetStatus() PandoraConfirmation_Status {
+	if x != nil {
+		return x.Status
+	}
+	return PandoraConfirmation_PENDING
+}
+
+func (x *PandoraBlockHashConfirmation) GetBlockRoot() []byte {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+type ConfirmPandoraBlockHashesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Confirmations []*PandoraBlockHashConfirmation `protobuf:"bytes,1,rep,name=confirmations,proto3" json:"confirmations,omitempty"`
+}
+
+func (x *ConfirmPandoraBlockHashesRequest) Reset() {
+	*x = ConfirmPandoraBlockHashesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmPandoraBlockHashesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPandoraBlockHashesRequest) ProtoMessage() {}
+
+func (x *ConfirmPandoraBlockHashesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPandoraBlockHashesRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmPandoraBlockHashesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ConfirmPandoraBlockHashesRequest) GetConfirmations() []*PandoraBlockHashConfirmation {
+	if x != nil {
+		return x.Confirmations
+	}
+	return nil
+}
+
+type ConfirmPandoraBlockHashesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stored       uint64   `protobuf:"varint,1,opt,name=stored,proto3" json:"stored,omitempty"`
+	UnknownSlots []uint64 `protobuf:"varint,2,rep,packed,name=unknown_slots,json=unknownSlots,proto3" json:"unknown_slots,omitempty"`
+}
+
+func (x *ConfirmPandoraBlockHashesResponse) Reset() {
+	*x = ConfirmPandoraBlockHashesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmPandoraBlockHashesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPandoraBlockHashesResponse) ProtoMessage() {}
+
+func (x *ConfirmPandoraBlockHashesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPandoraBlockHashesResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmPandoraBlockHashesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ConfirmPandoraBlockHashesResponse) GetStored() uint64 {
+	if x != nil {
+		return x.Stored
+	}
+	return 0
+}
+
+func (x *ConfirmPandoraBlockHashesResponse) GetUnknownSlots() []uint64 {
+	if x != nil {
+		return x.UnknownSlots
+	}
+	return nil
+}
+
+type ProposerStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Index     uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	FromEpoch uint64 `protobuf:"varint,3,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	ToEpoch   uint64 `protobuf:"varint,4,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty"`
+}
+
+func (x *ProposerStatsRequest) Reset() {
+	*x = ProposerStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposerStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposerStatsRequest) ProtoMessage() {}
+
+func (x *ProposerStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposerStatsRequest.ProtoReflect.Descriptor instead.
+func (*ProposerStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ProposerStatsRequest) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *ProposerStatsRequest) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ProposerStatsRequest) GetFromEpoch() uint64 {
+	if x != nil {
+		return x.FromEpoch
+	}
+	return 0
+}
+
+func (x *ProposerStatsRequest) GetToEpoch() uint64 {
+	if x != nil {
+		return x.ToEpoch
+	}
+	return 0
+}
+
+type ProposerStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index                 uint64  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	ProposalDuties        uint64  `protobuf:"varint,2,opt,name=proposal_duties,json=proposalDuties,proto3" json:"proposal_duties,omitempty"`
+	ProposedBlocks        uint64  `protobuf:"varint,3,opt,name=proposed_blocks,json=proposedBlocks,proto3" json:"proposed_blocks,omitempty"`
+	MissedProposals       uint64  `protobuf:"varint,4,opt,name=missed_proposals,json=missedProposals,proto3" json:"missed_proposals,omitempty"`
+	AverageInclusionDelay float64 `protobuf:"fixed64,5,opt,name=average_inclusion_delay,json=averageInclusionDelay,proto3" json:"average_inclusion_delay,omitempty"`
+}
+
+func (x *ProposerStats) Reset() {
+	*x = ProposerStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposerStats) ProtoMessage() {}
+
+func (x *ProposerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposerStats.ProtoReflect.Descriptor instead.
+func (*ProposerStats) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ProposerStats) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ProposerStats) GetProposalDuties() uint64 {
+	if x != nil {
+		return x.ProposalDuties
+	}
+	return 0
+}
+
+func (x *ProposerStats) GetProposedBlocks() uint64 {
+	if x != nil {
+		return x.ProposedBlocks
+	}
+	return 0
+}
+
+func (x *ProposerStats) GetMissedProposals() uint64 {
+	if x != nil {
+		return x.MissedProposals
+	}
+	return 0
+}
+
+func (x *ProposerStats) GetAverageInclusionDelay() float64 {
+	if x != nil {
+		return x.AverageInclusionDelay
+	}
+	return 0
+}
+
+type SubnetAssignmentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch      uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	PublicKeys [][]byte `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+}
+
+func (x *SubnetAssignmentsRequest) Reset() {
+	*x = SubnetAssignmentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubnetAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubnetAssignmentsRequest) ProtoMessage() {}
+
+func (x *SubnetAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubnetAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*SubnetAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SubnetAssignmentsRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *SubnetAssignmentsRequest) GetPublicKeys() [][]byte {
+	if x != nil {
+		return x.PublicKeys
+	}
+	return nil
+}
+
+type SubnetAssignment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey      []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	ValidatorIndex uint64 `protobuf:"varint,2,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	AttesterSlot   uint64 `protobuf:"varint,3,opt,name=attester_slot,json=attesterSlot,proto3" json:"attester_slot,omitempty"`
+	CommitteeIndex uint64 `protobuf:"varint,4,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
+	SubnetId       uint64 `protobuf:"varint,5,opt,name=subnet_id,json=subnetId,proto3" json:"subnet_id,omitempty"`
+}
+
+func (x *SubnetAssignment) Reset() {
+	*x = SubnetAssignment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubnetAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubnetAssignment) ProtoMessage() {}
+
+func (x *SubnetAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubnetAssignment.ProtoReflect.Descriptor instead.
+func (*SubnetAssignment) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SubnetAssignment) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *SubnetAssignment) GetValidatorIndex() uint64 {
+	if x != nil {
+		return x.ValidatorIndex
+	}
+	return 0
+}
+
+func (x *SubnetAssignment) GetAttesterSlot() uint64 {
+	if x != nil {
+		return x.AttesterSlot
+	}
+	return 0
+}
+
+func (x *SubnetAssignment) GetCommitteeIndex() uint64 {
+	if x != nil {
+		return x.CommitteeIndex
+	}
+	return 0
+}
+
+func (x *SubnetAssignment) GetSubnetId() uint64 {
+	if x != nil {
+		return x.SubnetId
+	}
+	return 0
+}
+
+type SubnetAssignments struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch       uint64              `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Assignments []*SubnetAssignment `protobuf:"bytes,2,rep,name=assignments,proto3" json:"assignments,omitempty"`
+}
+
+func (x *SubnetAssignments) Reset() {
+	*x = SubnetAssignments{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubnetAssignments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubnetAssignments) ProtoMessage() {}
+
+func (x *SubnetAssignments) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubnetAssignments.ProtoReflect.Descriptor instead.
+func (*SubnetAssignments) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SubnetAssignments) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *SubnetAssignments) GetAssignments() []*SubnetAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+type ListValidatorAssignmentsRangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromEpoch                   uint64   `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	ToEpoch                     uint64   `protobuf:"varint,2,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty"`
+	PublicKeys                  [][]byte `protobuf:"bytes,3,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	Indices                     []uint64 `protobuf:"varint,4,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	TrackedOnly                 bool     `protobuf:"varint,5,opt,name=tracked_only,json=trackedOnly,proto3" json:"tracked_only,omitempty"`
+	IndexOnly                   bool     `protobuf:"varint,6,opt,name=index_only,json=indexOnly,proto3" json:"index_only,omitempty"`
+	CommitteeWeights            bool     `protobuf:"varint,7,opt,name=committee_weights,json=committeeWeights,proto3" json:"committee_weights,omitempty"`
+	IncludeValidatorFields      bool     `protobuf:"varint,8,opt,name=include_validator_fields,json=includeValidatorFields,proto3" json:"include_validator_fields,omitempty"`
+	WithdrawalCredentialsPrefix []byte   `protobuf:"bytes,9,opt,name=withdrawal_credentials_prefix,json=withdrawalCredentialsPrefix,proto3" json:"withdrawal_credentials_prefix,omitempty"`
+	Compact                     bool     `protobuf:"varint,10,opt,name=compact,proto3" json:"compact,omitempty"`
+}
+
+func (x *ListValidatorAssignmentsRangeRequest) Reset() {
+	*x = ListValidatorAssignmentsRangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListValidatorAssignmentsRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListValidatorAssignmentsRangeRequest) ProtoMessage() {}
+
+func (x *ListValidatorAssignmentsRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListValidatorAssignmentsRangeRequest.ProtoReflect.Descriptor instead.
+func (*ListValidatorAssignmentsRangeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListValidatorAssignmentsRangeRequest) GetFromEpoch() uint64 {
+	if x != nil {
+		return x.FromEpoch
+	}
+	return 0
+}
+
+func (x *ListValidatorAssignmentsRangeRequest) GetToEpoch() uint64 {
+	if x != nil {
+		return x.ToEpoch
+	}
+	return 0
+}
+
+func (x *ListValidatorAssignmentsRangeRequest) GetPublicKeys() [][]byte {
+	if x != nil {
+		return x.PublicKeys
+	}
+	return nil
+}
+
+func (x *ListValidatorAssignmentsRangeRequest) GetIndices() []uint64 {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+func (x *ListValidatorAssignmentsRangeRequest) GetTrackedOnly() bool {
+	if x != nil {
+		return x.TrackedOnly
+	}
+	return false
+}
+
+func (x *ListValidatorAssignmentsRangeRequest) GetIndexOnly() bool {
+	if x != nil {
+		return x.IndexOnly
+	}
+	return false
+}
+
+func (x *ListValidatorAssignmentsRangeRequest) GetCommitteeWeights() bool {
+	if x != nil {
+		return x.CommitteeWeights
+	}
+	return false
+}
+
+func (x *ListValidatorAssignmentsRangeRequest) GetIncludeValidatorFields() bool {
+	if x != nil {
+		return x.IncludeValidatorFields
+	}
+	return false
+}
+
+func (x *ListValidatorAssignmentsRangeRequest) GetWithdrawalCredentialsPrefix() []byte {
+	if x != nil {
+		return x.WithdrawalCredentialsPrefix
+	}
+	return nil
+}
+
+func (x *ListValidatorAssignmentsRangeRequest) GetCompact() bool {
+	if x != nil {
+		return x.Compact
+	}
+	return false
+}
+
+type ValidatorAssignmentsRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epochs             []*v1alpha1.ValidatorAssignments `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs,omitempty"`
+	IndexMismatches    []*ValidatorIndexMismatch        `protobuf:"bytes,2,rep,name=index_mismatches,json=indexMismatches,proto3" json:"index_mismatches,omitempty"`
+	ProposerListRoots  [][]byte                         `protobuf:"bytes,3,rep,name=proposer_list_roots,json=proposerListRoots,proto3" json:"proposer_list_roots,omitempty"`
+	CommitteeWeights   []*EpochCommitteeWeights         `protobuf:"bytes,4,rep,name=committee_weights,json=committeeWeights,proto3" json:"committee_weights,omitempty"`
+	ValidatorFields    []*EpochValidatorFields          `protobuf:"bytes,5,rep,name=validator_fields,json=validatorFields,proto3" json:"validator_fields,omitempty"`
+	CommitteePositions []*EpochCommitteePositions       `protobuf:"bytes,6,rep,name=committee_positions,json=committeePositions,proto3" json:"committee_positions,omitempty"`
+}
+
+func (x *ValidatorAssignmentsRange) Reset() {
+	*x = ValidatorAssignmentsRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorAssignmentsRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorAssignmentsRange) ProtoMessage() {}
+
+func (x *ValidatorAssignmentsRange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorAssignmentsRange.ProtoReflect.Descriptor instead.
+func (*ValidatorAssignmentsRange) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ValidatorAssignmentsRange) GetEpochs() []*v1alpha1.ValidatorAssignments {
+	if x != nil {
+		return x.Epochs
+	}
+	return nil
+}
+
+func (x *ValidatorAssignmentsRange) GetIndexMismatches() []*ValidatorIndexMismatch {
+	if x != nil {
+		return x.IndexMismatches
+	}
+	return nil
+}
+
+func (x *ValidatorAssignmentsRange) GetProposerListRoots() [][]byte {
+	if x != nil {
+		return x.ProposerListRoots
+	}
+	return nil
+}
+
+func (x *ValidatorAssignmentsRange) GetCommitteeWeights() []*EpochCommitteeWeights {
+	if x != nil {
+		return x.CommitteeWeights
+	}
+	return nil
+}
+
+func (x *ValidatorAssignmentsRange) GetValidatorFields() []*EpochValidatorFields {
+	if x != nil {
+		return x.ValidatorFields
+	}
+	return nil
+}
+
+func (x *ValidatorAssignmentsRange) GetCommitteePositions() []*EpochCommitteePositions {
+	if x != nil {
+		return x.CommitteePositions
+	}
+	return nil
+}
+
+type EpochCommitteeWeights struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Weights []*CommitteeWeight `protobuf:"bytes,1,rep,name=weights,proto3" json:"weights,omitempty"`
+}
+
+func (x *EpochCommitteeWeights) Reset() {
+	*x = EpochCommitteeWeights{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochCommitteeWeights) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochCommitteeWeights) ProtoMessage() {}
+
+func (x *EpochCommitteeWeights) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochCommitteeWeights.ProtoReflect.Descriptor instead.
+func (*EpochCommitteeWeights) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *EpochCommitteeWeights) GetWeights() []*CommitteeWeight {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+type EpochValidatorFields struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields []*ValidatorFields `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *EpochValidatorFields) Reset() {
+	*x = EpochValidatorFields{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochValidatorFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochValidatorFields) ProtoMessage() {}
+
+func (x *EpochValidatorFields) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochValidatorFields.ProtoReflect.Descriptor instead.
+func (*EpochValidatorFields) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *EpochValidatorFields) GetFields() []*ValidatorFields {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type EpochCommitteePositions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Positions []*CommitteePosition `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+}
+
+func (x *EpochCommitteePositions) Reset() {
+	*x = EpochCommitteePositions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochCommitteePositions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochCommitteePositions) ProtoMessage() {}
+
+func (x *EpochCommitteePositions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochCommitteePositions.ProtoReflect.Descriptor instead.
+func (*EpochCommitteePositions) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *EpochCommitteePositions) GetPositions() []*CommitteePosition {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+type PrecomputationStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled              bool   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	LastPrecomputedEpoch uint64 `protobuf:"varint,2,opt,name=last_precomputed_epoch,json=lastPrecomputedEpoch,proto3" json:"last_precomputed_epoch,omitempty"`
+	Stalled              bool   `protobuf:"varint,3,opt,name=stalled,proto3" json:"stalled,omitempty"`
+	StalledEpoch         uint64 `protobuf:"varint,4,opt,name=stalled_epoch,json=stalledEpoch,proto3" json:"stalled_epoch,omitempty"`
+}
+
+func (x *PrecomputationStatus) Reset() {
+	*x = PrecomputationStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrecomputationStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrecomputationStatus) ProtoMessage() {}
+
+func (x *PrecomputationStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrecomputationStatus.ProtoReflect.Descriptor instead.
+func (*PrecomputationStatus) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *PrecomputationStatus) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *PrecomputationStatus) GetLastPrecomputedEpoch() uint64 {
+	if x != nil {
+		return x.LastPrecomputedEpoch
+	}
+	return 0
+}
+
+func (x *PrecomputationStatus) GetStalled() bool {
+	if x != nil {
+		return x.Stalled
+	}
+	return false
+}
+
+func (x *PrecomputationStatus) GetStalledEpoch() uint64 {
+	if x != nil {
+		return x.StalledEpoch
+	}
+	return 0
+}
+
+type StreamEpochInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromEpoch uint64 `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	// Types that are assignable to Resume:
+	//	*StreamEpochInfoRequest_ResumeFromEpoch
+	Resume     isStreamEpochInfoRequest_Resume `protobuf_oneof:"resume"`
+	Subscriber string                          `protobuf:"bytes,3,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
+	AllowGaps  bool                            `protobuf:"varint,4,opt,name=allow_gaps,json=allowGaps,proto3" json:"allow_gaps,omitempty"`
+	Shard      *EpochShard                     `protobuf:"bytes,5,opt,name=shard,proto3" json:"shard,omitempty"`
+}
+
+func (x *StreamEpochInfoRequest) Reset() {
+	*x = StreamEpochInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamEpochInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEpochInfoRequest) ProtoMessage() {}
+
+func (x *StreamEpochInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEpochInfoRequest.ProtoReflect.Descriptor instead.
+func (*StreamEpochInfoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *StreamEpochInfoRequest) GetFromEpoch() uint64 {
+	if x != nil {
+		return x.FromEpoch
+	}
+	return 0
+}
+
+func (m *StreamEpochInfoRequest) GetResume() isStreamEpochInfoRequest_Resume {
+	if m != nil {
+		return m.Resume
+	}
+	return nil
+}
+
+func (x *StreamEpochInfoRequest) GetResumeFromEpoch() uint64 {
+	if x, ok := x.GetResume().(*StreamEpochInfoRequest_ResumeFromEpoch); ok {
+		return x.ResumeFromEpoch
+	}
+	return 0
+}
+
+func (x *StreamEpochInfoRequest) GetSubscriber() string {
+	if x != nil {
+		return x.Subscriber
+	}
+	return ""
+}
+
+func (x *StreamEpochInfoRequest) GetAllowGaps() bool {
+	if x != nil {
+		return x.AllowGaps
+	}
+	return false
+}
+
+func (x *StreamEpochInfoRequest) GetShard() *EpochShard {
+	if x != nil {
+		return x.Shard
+	}
+	return nil
+}
+
+type isStreamEpochInfoRequest_Resume interface {
+	isStreamEpochInfoRequest_Resume()
+}
+
+type StreamEpochInfoRequest_ResumeFromEpoch struct {
+	ResumeFromEpoch uint64 `protobuf:"varint,2,opt,name=resume_from_epoch,json=resumeFromEpoch,proto3,oneof"`
+}
+
+func (*StreamEpochInfoRequest_ResumeFromEpoch) isStreamEpochInfoRequest_Resume() {}
+
+type EpochShard struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Modulus   uint64 `protobuf:"varint,1,opt,name=modulus,proto3" json:"modulus,omitempty"`
+	Remainder uint64 `protobuf:"varint,2,opt,name=remainder,proto3" json:"remainder,omitempty"`
+}
+
+func (x *EpochShard) Reset() {
+	*x = EpochShard{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochShard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochShard) ProtoMessage() {}
+
+func (x *EpochShard) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochShard.ProtoReflect.Descriptor instead.
+func (*EpochShard) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *EpochShard) GetModulus() uint64 {
+	if x != nil {
+		return x.Modulus
+	}
+	return 0
+}
+
+func (x *EpochShard) GetRemainder() uint64 {
+	if x != nil {
+		return x.Remainder
+	}
+	return 0
+}
+
+type EpochInfoStreamMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Message:
+	//	*EpochInfoStreamMessage_Request
+	//	*EpochInfoStreamMessage_AckEpoch
+	Message isEpochInfoStreamMessage_Message `protobuf_oneof:"message"`
+}
+
+func (x *EpochInfoStreamMessage) Reset() {
+	*x = EpochInfoStreamMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochInfoStreamMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochInfoStreamMessage) ProtoMessage() {}
+
+func (x *EpochInfoStreamMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochInfoStreamMessage.ProtoReflect.Descriptor instead.
+func (*EpochInfoStreamMessage) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{98}
+}
+
+func (m *EpochInfoStreamMessage) GetMessage() isEpochInfoStreamMessage_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (x *EpochInfoStreamMessage) GetRequest() *StreamEpochInfoRequest {
+	if x, ok := x.GetMessage().(*EpochInfoStreamMessage_Request); ok {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *EpochInfoStreamMessage) GetAckEpoch() uint64 {
+	if x, ok := x.GetMessage().(*EpochInfoStreamMessage_AckEpoch); ok {
+		return x.AckEpoch
+	}
+	return 0
+}
+
+type isEpochInfoStreamMessage_Message interface {
+	isEpochInfoStreamMessage_Message()
+}
+
+type EpochInfoStreamMessage_Request struct {
+	Request *StreamEpochInfoRequest `protobuf:"bytes,1,opt,name=request,proto3,oneof"`
+}
+
+type EpochInfoStreamMessage_AckEpoch struct {
+	AckEpoch uint64 `protobuf:"varint,2,opt,name=ack_epoch,json=ackEpoch,proto3,oneof"`
+}
+
+func (*EpochInfoStreamMessage_Request) isEpochInfoStreamMessage_Message() {}
+
+func (*EpochInfoStreamMessage_AckEpoch) isEpochInfoStreamMessage_Message() {}
+
+type EpochInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch            uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	EpochStartTime   uint64   `protobuf:"varint,2,opt,name=epoch_start_time,json=epochStartTime,proto3" json:"epoch_start_time,omitempty"`
+	SlotDuration     uint64   `protobuf:"varint,3,opt,name=slot_duration,json=slotDuration,proto3" json:"slot_duration,omitempty"`
+	Proposers        [][]byte `protobuf:"bytes,4,rep,name=proposers,proto3" json:"proposers,omitempty"`
+	ProposerListRoot []byte   `protobuf:"bytes,5,opt,name=proposer_list_root,json=proposerListRoot,proto3" json:"proposer_list_root,omitempty"`
+	ForkVersion      []byte   `protobuf:"bytes,6,opt,name=fork_version,json=forkVersion,proto3" json:"fork_version,omitempty"`
+	ForkDigest       []byte   `protobuf:"bytes,7,opt,name=fork_digest,json=forkDigest,proto3" json:"fork_digest,omitempty"`
+	ReorgFlag        bool     `protobuf:"varint,8,opt,name=reorg_flag,json=reorgFlag,proto3" json:"reorg_flag,omitempty"`
+	Provisional      bool     `protobuf:"varint,9,opt,name=provisional,proto3" json:"provisional,omitempty"`
+}
+
+func (x *EpochInfo) Reset() {
+	*x = EpochInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochInfo) ProtoMessage() {}
+
+func (x *EpochInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochInfo.ProtoReflect.Descriptor instead.
+func (*EpochInfo) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *EpochInfo) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *EpochInfo) GetEpochStartTime() uint64 {
+	if x != nil {
+		return x.EpochStartTime
+	}
+	return 0
+}
+
+func (x *EpochInfo) GetSlotDuration() uint64 {
+	if x != nil {
+		return x.SlotDuration
+	}
+	return 0
+}
+
+func (x *EpochInfo) GetProposers() [][]byte {
+	if x != nil {
+		return x.Proposers
+	}
+	return nil
+}
+
+func (x *EpochInfo) GetProposerListRoot() []byte {
+	if x != nil {
+		return x.ProposerListRoot
+	}
+	return nil
+}
+
+func (x *EpochInfo) GetForkVersion() []byte {
+	if x != nil {
+		return x.ForkVersion
+	}
+	return nil
+}
+
+func (x *EpochInfo) GetForkDigest() []byte {
+	if x != nil {
+		return x.ForkDigest
+	}
+	return nil
+}
+
+func (x *EpochInfo) GetReorgFlag() bool {
+	if x != nil {
+		return x.ReorgFlag
+	}
+	return false
+}
+
+func (x *EpochInfo) GetProvisional() bool {
+	if x != nil {
+		return x.Provisional
+	}
+	return false
+}
+
+type EpochInfoAccumulatorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to QueryFilter:
+	//	*EpochInfoAccumulatorRequest_Epoch
+	QueryFilter isEpochInfoAccumulatorRequest_QueryFilter `protobuf_oneof:"query_filter"`
+}
+
+func (x *EpochInfoAccumulatorRequest) Reset() {
+	*x = EpochInfoAccumulatorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochInfoAccumulatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochInfoAccumulatorRequest) ProtoMessage() {}
+
+func (x *EpochInfoAccumulatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochInfoAccumulatorRequest.ProtoReflect.Descriptor instead.
+func (*EpochInfoAccumulatorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{100}
+}
+
+func (m *EpochInfoAccumulatorRequest) GetQueryFilter() isEpochInfoAccumulatorRequest_QueryFilter {
+	if m != nil {
+		return m.QueryFilter
+	}
+	return nil
+}
+
+func (x *EpochInfoAccumulatorRequest) GetEpoch() uint64 {
+	if x, ok := x.GetQueryFilter().(*EpochInfoAccumulatorRequest_Epoch); ok {
+		return x.Epoch
+	}
+	return 0
+}
+
+type isEpochInfoAccumulatorRequest_QueryFilter interface {
+	isEpochInfoAccumulatorRequest_QueryFilter()
+}
+
+type EpochInfoAccumulatorRequest_Epoch struct {
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3,oneof"`
+}
+
+func (*EpochInfoAccumulatorRequest_Epoch) isEpochInfoAccumulatorRequest_QueryFilter() {}
+
+type EpochInfoAccumulator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AnchorEpoch uint64 `protobuf:"varint,1,opt,name=anchor_epoch,json=anchorEpoch,proto3" json:"anchor_epoch,omitempty"`
+	Epoch       uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Root        []byte `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+}
+
+func (x *EpochInfoAccumulator) Reset() {
+	*x = EpochInfoAccumulator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochInfoAccumulator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochInfoAccumulator) ProtoMessage() {}
+
+func (x *EpochInfoAccumulator) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochInfoAccumulator.ProtoReflect.Descriptor instead.
+func (*EpochInfoAccumulator) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *EpochInfoAccumulator) GetAnchorEpoch() uint64 {
+	if x != nil {
+		return x.AnchorEpoch
+	}
+	return 0
+}
+
+func (x *EpochInfoAccumulator) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *EpochInfoAccumulator) GetRoot() []byte {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+type ProposerListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to QueryFilter:
+	//	*ProposerListRequest_Epoch
+	QueryFilter isProposerListRequest_QueryFilter `protobuf_oneof:"query_filter"`
+}
+
+func (x *ProposerListRequest) Reset() {
+	*x = ProposerListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposerListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposerListRequest) ProtoMessage() {}
+
+func (x *ProposerListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposerListRequest.ProtoReflect.Descriptor instead.
+func (*ProposerListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{102}
+}
+
+func (m *ProposerListRequest) GetQueryFilter() isProposerListRequest_QueryFilter {
+	if m != nil {
+		return m.QueryFilter
+	}
+	return nil
+}
+
+func (x *ProposerListRequest) GetEpoch() uint64 {
+	if x, ok := x.GetQueryFilter().(*ProposerListRequest_Epoch); ok {
+		return x.Epoch
+	}
+	return 0
+}
+
+type isProposerListRequest_QueryFilter interface {
+	isProposerListRequest_QueryFilter()
+}
+
+type ProposerListRequest_Epoch struct {
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3,oneof"`
+}
+
+func (*ProposerListRequest_Epoch) isProposerListRequest_QueryFilter() {}
+
+type ProposerList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch            uint64          `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Proposers        []*SlotProposer `protobuf:"bytes,2,rep,name=proposers,proto3" json:"proposers,omitempty"`
+	ProposerListRoot []byte          `protobuf:"bytes,3,opt,name=proposer_list_root,json=proposerListRoot,proto3" json:"proposer_list_root,omitempty"`
+	Provisional      bool            `protobuf:"varint,4,opt,name=provisional,proto3" json:"provisional,omitempty"`
+}
+
+func (x *ProposerList) Reset() {
+	*x = ProposerList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposerList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposerList) ProtoMessage() {}
+
+func (x *ProposerList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposerList.ProtoReflect.Descriptor instead.
+func (*ProposerList) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ProposerList) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ProposerList) GetProposers() []*SlotProposer {
+	if x != nil {
+		return x.Proposers
+	}
+	return nil
+}
+
+func (x *ProposerList) GetProposerListRoot() []byte {
+	if x != nil {
+		return x.ProposerListRoot
+	}
+	return nil
+}
+
+func (x *ProposerList) GetProvisional() bool {
+	if x != nil {
+		return x.Provisional
+	}
+	return false
+}
+
+type SlotProposer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot      uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	PublicKey []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+}
+
+func (x *SlotProposer) Reset() {
+	*x = SlotProposer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlotProposer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotProposer) ProtoMessage() {}
+
+func (x *SlotProposer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotProposer.ProtoReflect.Descriptor instead.
+func (*SlotProposer) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *SlotProposer) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *SlotProposer) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+var File_proto_beacon_rpc_v1_beacon_query_proto protoreflect.FileDescriptor
+
+var file_proto_beacon_rpc_v1_beacon_query_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x72,
+	0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
+	0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
+	0x1a, 0x1e, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x61,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1c, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2d, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb1, 0x01, 0x0a, 0x18, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63,
+	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x50, 0x0a, 0x07,
+	0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x42, 0x36, 0xfa,
+	0xde, 0x1f, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72,
+	0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32,
+	0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x22, 0xa7,
+	0x01, 0x0a, 0x19, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x76, 0x65,
+	0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f,
+	0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73,
+	0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x12, 0x45, 0x0a, 0x08, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x08,
+	0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x22, 0x7a, 0x0a, 0x11, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x4c, 0x0a,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x36, 0xfa, 0xde,
+	0x1f, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79,
+	0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x69,
+	0x73, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73,
+	0x4c, 0x69, 0x76, 0x65, 0x22, 0xaf, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4c,
+	0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73,
+	0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x48, 0x0a, 0x08,
+	0x74, 0x6f, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d,
+	0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70,
+	0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68,
+	0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x07, 0x74,
+	0x6f, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x4d, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
+	0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x05,
+	0x72, 0x6f, 0x6f, 0x74, 0x73, 0x22, 0xeb, 0x02, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x43, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63,
+	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x30, 0x0a, 0x0a,
+	0x72, 0x61, 0x6e, 0x64, 0x61, 0x6f, 0x5f, 0x6d, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22,
+	0x33, 0x32, 0x22, 0x52, 0x09, 0x72, 0x61, 0x6e, 0x64, 0x61, 0x6f, 0x4d, 0x69, 0x78, 0x12, 0x36,
+	0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73,
+	0x69, 0x7a, 0x65, 0x3a, 0x22, 0x33, 0x32, 0x22, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x72, 0x53, 0x65, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x0d, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2,
+	0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x33, 0x32, 0x22,
+	0x52, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x65, 0x64, 0x12, 0x3d,
+	0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x72,
+	0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73,
+	0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x33, 0x32, 0x22, 0x52, 0x0f, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x34, 0x0a,
+	0x16, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0xaa, 0x01, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x53,
+	0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde,
+	0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79,
+	0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x12, 0x4c, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x36, 0xfa, 0xde, 0x1f, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f,
+	0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x22, 0x89, 0x05, 0x0a, 0x12, 0x53, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x45, 0x78, 0x70, 0x6c,
+	0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63,
+	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x4c, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x36, 0xfa, 0xde, 0x1f,
+	0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73,
+	0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x25, 0x0a, 0x04, 0x73, 0x65,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73,
+	0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x33, 0x32, 0x22, 0x52, 0x04, 0x73, 0x65, 0x65,
+	0x64, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x14, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2b, 0x0a, 0x11, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x68, 0x75,
+	0x66, 0x66, 0x6c, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5b, 0x0a,
+	0x0d, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x04, 0x42, 0x36, 0xfa, 0xde, 0x1f, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61,
+	0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x0c, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x04, 0x73, 0x6c,
+	0x6f, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0xfa, 0xde, 0x1f, 0x28, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74,
+	0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x5f, 0x0a, 0x0f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x04, 0x42, 0x36, 0xfa, 0xde, 0x1f, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c,
+	0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x0e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2d, 0x0a,
+	0x12, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xff, 0x01, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74,
+	0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x12, 0x48, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61,
+	0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x52, 0x07, 0x74, 0x6f, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x50, 0x0a, 0x07,
+	0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x42, 0x36, 0xfa,
+	0xde, 0x1f, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72,
+	0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32,
+	0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x22, 0xa7,
+	0x07, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x12, 0x4c, 0x0a, 0x0a,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65,
+	0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52,
+	0x09, 0x66, 0x72, 0x6f, 0x6d, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x48, 0x0a, 0x08, 0x74, 0x6f,
+	0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde,
+	0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79,
+	0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x07, 0x74, 0x6f, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x56, 0x0a, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x4b, 0x0a, 0x0e,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x0d, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x73, 0x12, 0x65, 0x0a, 0x1d, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x1b, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x4a, 0x75, 0x73,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x63, 0x0a, 0x1c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6a, 0x75, 0x73, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
+	0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x1a, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x4a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x54, 0x0a, 0x14, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65,
+	0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x13, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x6e, 0x0a, 0x22, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x6a, 0x75, 0x73,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
+	0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x1f, 0x70, 0x6f, 0x73, 0x74,
+	0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x4a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x6c, 0x0a, 0x21, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6a, 0x75, 0x73, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
+	0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x1e, 0x70, 0x6f, 0x73, 0x74, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x5d, 0x0a, 0x19, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52,
+	0x17, 0x70, 0x6f, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0xd9, 0x01, 0x0a, 0x0f, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x4c, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x36, 0xfa, 0xde, 0x1f,
+	0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73,
+	0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x3c, 0x0a, 0x08, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x12, 0x3a, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x07, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x22, 0xa8, 0x01, 0x0a, 0x0c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x4c, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x36, 0xfa, 0xde, 0x1f, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61,
+	0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c,
+	0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x22,
+	0xac, 0x01, 0x0a, 0x12, 0x52, 0x61, 0x6e, 0x64, 0x61, 0x6f, 0x4d, 0x69, 0x78, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d,
+	0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x48, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63,
+	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x07, 0x74, 0x6f, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x4b,
+	0x0a, 0x0b, 0x52, 0x61, 0x6e, 0x64, 0x61, 0x6f, 0x4d, 0x69, 0x78, 0x65, 0x73, 0x12, 0x3c, 0x0a,
+	0x05, 0x6d, 0x69, 0x78, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x61, 0x6e, 0x64, 0x61,
+	0x6f, 0x4d, 0x69, 0x78, 0x52, 0x05, 0x6d, 0x69, 0x78, 0x65, 0x73, 0x22, 0xe6, 0x02, 0x0a, 0x0e,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x61, 0x6e, 0x64, 0x61, 0x6f, 0x4d, 0x69, 0x78, 0x12, 0x43,
+	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa,
+	0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72,
+	0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32,
+	0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x4c, 0x0a, 0x0a, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69,
+	0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x09, 0x73, 0x65, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x12, 0x2e, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6d, 0x69, 0x78, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69,
+	0x7a, 0x65, 0x3a, 0x22, 0x33, 0x32, 0x22, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x69,
+	0x78, 0x12, 0x23, 0x0a, 0x03, 0x6d, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11,
+	0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x33, 0x32,
+	0x22, 0x52, 0x03, 0x6d, 0x69, 0x78, 0x12, 0x50, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x61, 0x6f, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x22, 0xc4, 0x02, 0x0a, 0x12, 0x52, 0x61, 0x6e, 0x64, 0x61, 0x6f, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x04, 0x73,
+	0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0xfa, 0xde, 0x1f, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61,
+	0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x30, 0x0a,
+	0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a,
+	0x22, 0x33, 0x32, 0x22, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12,
+	0x5d, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x36, 0xfa, 0xde, 0x1f, 0x32, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69,
+	0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52,
+	0x0d, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x30,
+	0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65,
+	0x3a, 0x22, 0x34, 0x38, 0x22, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x12, 0x29, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22,
+	0x39, 0x36, 0x22, 0x52, 0x06, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x22, 0x61, 0x0a, 0x11, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x4c, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61,
+	0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x44,
+	0x0a, 0x06, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x73, 0x12, 0x3a, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72,
+	0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0xac, 0x04, 0x0a, 0x0a, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x0d, 0x6f, 0x6c, 0x64, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d,
+	0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x33, 0x32, 0x22, 0x52, 0x0b, 0x6f,
+	0x6c, 0x64, 0x48, 0x65, 0x61, 0x64, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x35, 0x0a, 0x0d, 0x6e, 0x65,
+	0x77, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a,
+	0x22, 0x33, 0x32, 0x22, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x48, 0x65, 0x61, 0x64, 0x52, 0x6f, 0x6f,
+	0x74, 0x12, 0x50, 0x0a, 0x0d, 0x6f, 0x6c, 0x64, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x73, 0x6c,
+	0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0xfa, 0xde, 0x1f, 0x28, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74,
+	0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x48, 0x65, 0x61, 0x64, 0x53,
+	0x6c, 0x6f, 0x74, 0x12, 0x50, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x5f,
+	0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0xfa, 0xde, 0x1f, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d,
+	0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x48, 0x65, 0x61,
+	0x64, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x5e, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x2c, 0xfa, 0xde, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62,
+	0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f,
+	0x74, 0x52, 0x12, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x4c, 0x0a, 0x0a, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74,
+	0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x09,
+	0x66, 0x72, 0x6f, 0x6d, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x48, 0x0a, 0x08, 0x74, 0x6f, 0x5f,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f,
+	0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73,
+	0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x07, 0x74, 0x6f, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x22, 0xbc, 0x02, 0x0a, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11,
+	0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x34, 0x38,
+	0x22, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x4c, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x36, 0xfa, 0xde, 0x1f,
+	0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73,
+	0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x4c, 0x0a, 0x0a, 0x66, 0x72,
+	0x6f, 0x6d, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d,
+	0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70,
+	0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68,
+	0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x09, 0x66,
+	0x72, 0x6f, 0x6d, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x48, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d,
+	0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x07, 0x74, 0x6f, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x22, 0xa9, 0x01, 0x0a, 0x17, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x4c,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x36, 0xfa,
+	0xde, 0x1f, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72,
+	0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32,
+	0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x40, 0x0a, 0x08,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x6d,
+	0x0a, 0x0c, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x43,
+	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa,
+	0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72,
+	0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32,
+	0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xb9, 0x03,
+	0x0a, 0x11, 0x53, 0x6c, 0x6f, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x2c, 0xfa, 0xde, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f,
+	0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x4b, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73,
+	0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0xfa, 0xde, 0x1f, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61,
+	0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x6c,
+	0x6f, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d,
+	0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x33, 0x32, 0x22, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x6f, 0x6f, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x2b, 0x0a, 0x11, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x61, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x14,
+	0x68, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x68, 0x65, 0x61, 0x64,
+	0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2d,
+	0x0a, 0x12, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a,
+	0x11, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x68, 0x65, 0x61, 0x64, 0x43, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x52, 0x61, 0x74, 0x65, 0x22, 0x5d, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73,
+	0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0xb3, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d,
+	0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x48, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63,
+	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x07, 0x74, 0x6f, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x54,
+	0x0a, 0x0e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x42, 0x0a, 0x09, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x09, 0x73, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x69, 0x65, 0x73, 0x22, 0x89, 0x06, 0x0a, 0x0c, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x43, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61,
+	0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x14, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x30,
+	0x0a, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x29, 0x0a, 0x10, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x6c, 0x69, 0x67,
+	0x69, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x61, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x68, 0x65, 0x61, 0x64, 0x41, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3f, 0x0a, 0x12, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a,
+	0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x33, 0x32, 0x22, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x56, 0x0a, 0x0f,
+	0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c,
+	0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x52, 0x0e, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x38, 0x0a, 0x0e, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde,
+	0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x33, 0x32, 0x22, 0x52,
+	0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x56,
+	0x0a, 0x0f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69,
+	0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x0e, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x38, 0x0a, 0x0e, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11,
+	0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x33, 0x32,
+	0x22, 0x52, 0x0d, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x52, 0x6f, 0x6f, 0x74,
+	0x22, 0xae, 0x01, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x50, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x04, 0x42, 0x36, 0xfa, 0xde, 0x1f, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61,
+	0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x07, 0x69, 0x6e,
+	0x64, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0xa9, 0x01, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x4b, 0x0a, 0x0b, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x94, 0x01,
+	0x0a, 0x12, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4b, 0x65, 0x79, 0x12, 0x4c, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x36, 0xfa, 0xde, 0x1f, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62,
+	0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x30, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d,
+	0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x34, 0x38, 0x22, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4b, 0x65, 0x79, 0x22, 0x41, 0x0a, 0x1c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x51, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f,
+	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x87, 0x02, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x51, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x12, 0x43, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65,
+	0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x75, 0x72, 0x6e, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x68, 0x75,
+	0x72, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x49, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x3d, 0x0a, 0x05, 0x65, 0x78, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x78, 0x69, 0x74,
+	0x73, 0x22, 0x86, 0x04, 0x0a, 0x0f, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x4c, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x36, 0xfa, 0xde, 0x1f, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61,
+	0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x30, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a,
+	0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x34, 0x38, 0x22, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x6f, 0x0a, 0x1c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f,
+	0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73,
+	0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x1a, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x54, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d,
+	0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70,
+	0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68,
+	0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x0d, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x5c, 0x0a, 0x12,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74,
+	0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x11, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x78,
+	0x61, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74,
+	0x12, 0x38, 0x0a, 0x0e, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x77, 0x61,
+	0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x11, 0xfa, 0xde, 0x1f, 0x0d, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x65, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x57, 0x61, 0x69, 0x74, 0x22, 0xc9, 0x02, 0x0a, 0x12, 0x41,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x48, 0x65, 0x61,
+	0x64, 0x12, 0x34, 0x0a, 0x04, 0x68, 0x65, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x48, 0x65, 0x61,
+	0x64, 0x52, 0x04, 0x68, 0x65, 0x61, 0x64, 0x12, 0x49, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0xfa, 0xde, 0x1f, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d,
+	0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x53, 0x6c,
+	0x6f, 0x74, 0x12, 0x66, 0x0a, 0x13, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x36, 0xfa, 0xde, 0x1f, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74,
+	0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x11, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x4a, 0x0a, 0x18, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde,
+	0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x34, 0x38, 0x22, 0x52,
+	0x15, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0xac, 0x01, 0x0a, 0x18, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x6c, 0x6f, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0xfa, 0xde, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63,
+	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x53, 0x6c, 0x6f, 0x74, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x45,
+	0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x2c, 0xfa, 0xde, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74,
+	0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x06, 0x74,
+	0x6f, 0x53, 0x6c, 0x6f, 0x74, 0x22, 0x87, 0x02, 0x0a, 0x11, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x49, 0x0a, 0x09, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c,
+	0xfa, 0xde, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70,
+	0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68,
+	0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x08, 0x66, 0x72,
+	0x6f, 0x6d, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x45, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x73, 0x6c, 0x6f,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0xfa, 0xde, 0x1f, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69,
+	0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x06, 0x74, 0x6f, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x60, 0x0a,
+	0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x42, 0xf2, 0xde, 0x1f, 0x0e, 0x73, 0x73, 0x7a, 0x2d, 0x6d, 0x61, 0x78, 0x3a, 0x22, 0x38,
+	0x31, 0x39, 0x32, 0x22, 0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73,
+	0x2f, 0x67, 0x6f, 0x2d, 0x62, 0x69, 0x74, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x42, 0x69, 0x74,
+	0x6c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x22,
+	0x93, 0x03, 0x0a, 0x0d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c,
+	0xfa, 0xde, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70,
+	0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68,
+	0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x0d, 0x73, 0x6c,
+	0x6f, 0x74, 0x73, 0x50, 0x65, 0x72, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x50, 0x65,
+	0x72, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x42, 0x0a, 0x14, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x10, 0xf2, 0xde, 0x1f, 0x0c, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a,
+	0x65, 0x3a, 0x22, 0x34, 0x22, 0x52, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x46, 0x6f,
+	0x72, 0x6b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x18, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x2c, 0x0a,
+	0x12, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61,
+	0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x48, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c,
+	0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x52, 0x07, 0x74, 0x6f, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x22, 0xfe, 0x01,
+	0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x12, 0x4c, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x36, 0xfa, 0xde, 0x1f, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65,
+	0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x30, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a,
+	0x65, 0x3a, 0x22, 0x34, 0x38, 0x22, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x26, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x99,
+	0x03, 0x0a, 0x11, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x44,
+	0x65, 0x6c, 0x74, 0x61, 0x12, 0x4c, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74,
+	0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x12, 0x48, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61,
+	0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x52, 0x07, 0x74, 0x6f, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x27, 0x0a, 0x0f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x05, 0x61,
+	0x64, 0x64, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72,
+	0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0xd1, 0x01, 0x0a, 0x18, 0x50,
+	0x72, 0x65, 0x66, 0x65, 0x74, 0x63, 0x68, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f,
+	0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73,
+	0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x48, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69,
+	0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x07, 0x74, 0x6f, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x1d, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x67, 0x61, 0x70, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x47, 0x61, 0x70, 0x73, 0x22, 0x24,
+	0x0a, 0x0b, 0x50, 0x72, 0x65, 0x66, 0x65, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x12, 0x15, 0x0a,
+	0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6a,
+	0x6f, 0x62, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65,
+	0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0xd3, 0x02, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x66,
+	0x65, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f,
+	0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49,
+	0x64, 0x12, 0x4c, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c,
+	0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x48, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f,
+	0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x52, 0x07, 0x74, 0x6f, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x6e,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x04, 0x67, 0x61, 0x70, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x47, 0x61, 0x70, 0x52, 0x04, 0x67, 0x61, 0x70, 0x73, 0x22, 0x67, 0x0a,
+	0x08, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x47, 0x61, 0x70, 0x12, 0x43, 0x0a, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74,
+	0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xb2, 0x01, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x09, 0x66, 0x72, 0x6f,
+	0x6d, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0xfa, 0xde,
+	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79,
+	0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d,
+	0x53, 0x6c, 0x6f, 0x74, 0x12, 0x45, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0xfa, 0xde, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c,
+	0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53,
+	0x6c, 0x6f, 0x74, 0x52, 0x06, 0x74, 0x6f, 0x53, 0x6c, 0x6f, 0x74, 0x22, 0x57, 0x0a, 0x13, 0x43,
+	0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f,
+	0x74, 0x73, 0x12, 0x40, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x6f, 0x6e,
+	0x69, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x05, 0x72,
+	0x6f, 0x6f, 0x74, 0x73, 0x22, 0x7d, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61,
+	0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x40, 0x0a, 0x04, 0x73, 0x6c,
+	0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0xfa, 0xde, 0x1f, 0x28, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74,
+	0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x25, 0x0a, 0x04,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d,
+	0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x33, 0x32, 0x22, 0x52, 0x04, 0x72,
+	0x6f, 0x6f, 0x74, 0x22, 0x3f, 0x0a, 0x1a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x72, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x6c,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64,
+	0x4f, 0x6e, 0x6c, 0x79, 0x22, 0xfb, 0x02, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
+	0x72, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x40, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0xfa, 0xde, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61,
+	0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c,
+	0x6f, 0x74, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x3e, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a,
+	0x65, 0x3a, 0x22, 0x34, 0x38, 0x22, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde,
+	0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x33, 0x32, 0x22, 0x52,
+	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3a, 0x0a, 0x0f, 0x61, 0x63,
+	0x74, 0x75, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a,
+	0x65, 0x3a, 0x22, 0x34, 0x38, 0x22, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x47, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
+	0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x41, 0x75, 0x64, 0x69, 0x74, 0x2e, 0x4f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22,
+	0x31, 0x0a, 0x07, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x52,
+	0x4f, 0x44, 0x55, 0x43, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x49, 0x53, 0x53,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48,
+	0x10, 0x02, 0x22, 0x6c, 0x0a, 0x16, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x03,
+	0x61, 0x64, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x13, 0xf2, 0xde, 0x1f, 0x0f, 0x73,
+	0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x3f, 0x2c, 0x34, 0x38, 0x22, 0x52, 0x03,
+	0x61, 0x64, 0x64, 0x12, 0x2b, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0c, 0x42, 0x13, 0xf2, 0xde, 0x1f, 0x0f, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a,
+	0x65, 0x3a, 0x22, 0x3f, 0x2c, 0x34, 0x38, 0x22, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x22, 0x51, 0x0a, 0x19, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a,
+	0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0c, 0x42, 0x13, 0xf2, 0xde, 0x1f, 0x0f, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65,
+	0x3a, 0x22, 0x3f, 0x2c, 0x34, 0x38, 0x22, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
+	0x65, 0x79, 0x73, 0x22, 0x4e, 0x0a, 0x1e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x22, 0x2d, 0x0a, 0x17, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0xe7, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x41,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x42, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x2c, 0xfa, 0xde, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65,
+	0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x48, 0x00,
+	0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x61, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x36, 0xfa, 0xde, 0x1f, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74,
+	0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x48, 0x01, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x42, 0x0d, 0x0a, 0x0b, 0x73, 0x6c, 0x6f,
+	0x74, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x12, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x65, 0x0a, 0x10,
+	0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x51, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
+	0x6f, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x65, 0x73, 0x22, 0xee, 0x02, 0x0a, 0x19, 0x50, 0x6f, 0x6f, 0x6c, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x40, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x2c, 0xfa, 0xde, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74,
+	0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x04, 0x73,
+	0x6c, 0x6f, 0x74, 0x12, 0x5f, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x36, 0xfa, 0xde,
+	0x1f, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79,
+	0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x46, 0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c,
+	0x75, 0x6e, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x12, 0x42, 0x0a, 0x0a,
+	0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x22, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x73, 0x22, 0x97, 0x04, 0x0a, 0x0e, 0x45, 0x74, 0x68, 0x31, 0x44, 0x61, 0x74,
+	0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x65, 0x0a, 0x18, 0x76, 0x6f, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x73,
+	0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0xfa, 0xde, 0x1f, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61,
+	0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x15, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x6e,
+	0x0a, 0x1d, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0xfa, 0xde, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c,
+	0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53,
+	0x6c, 0x6f, 0x74, 0x52, 0x19, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x3c,
+	0x0a, 0x09, 0x65, 0x74, 0x68, 0x31, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x74, 0x68, 0x31, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x08, 0x65, 0x74, 0x68, 0x31, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2c, 0x0a, 0x12,
+	0x65, 0x74, 0x68, 0x31, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x65, 0x74, 0x68, 0x31, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x04, 0x76,
+	0x6f, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x45, 0x74, 0x68, 0x31, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x76, 0x6f, 0x74, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x76, 0x6f, 0x74, 0x65, 0x53, 0x75, 0x70, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f,
+	0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xb0,
+	0x02, 0x0a, 0x10, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x2c, 0xfa, 0xde, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f,
+	0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72,
+	0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73,
+	0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x33, 0x32, 0x22, 0x52, 0x09, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x30, 0x0a,
+	0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a,
+	0x22, 0x34, 0x38, 0x22, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12,
+	0x48, 0x0a, 0x16, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x63, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x33,
+	0x32, 0x22, 0x52, 0x15, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0xa8, 0x01, 0x0a, 0x1e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f,
+	0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73,
+	0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x33, 0x32, 0x22, 0x48, 0x00, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x42, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0xfa, 0xde, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63,
+	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x53, 0x6c, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x42, 0x0e, 0x0a, 0x0c,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xc9, 0x09, 0x0a,
+	0x19, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x04, 0x73, 0x6c,
+	0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0xfa, 0xde, 0x1f, 0x28, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74,
+	0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x43, 0x0a, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f,
+	0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73,
+	0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x12, 0x65, 0x0a, 0x1d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x6a, 0x75,
+	0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72,
+	0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x1b, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x4a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x63, 0x0a, 0x1c, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x1a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4a, 0x75, 0x73, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x54, 0x0a,
+	0x14, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x13,
+	0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x12, 0x6e, 0x0a, 0x22, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x5f, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x1f, 0x70, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x4a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x12, 0x6c, 0x0a, 0x21, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x1e, 0x70, 0x6f, 0x73, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4a, 0x75,
+	0x73, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x5d, 0x0a, 0x19, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x17, 0x70, 0x6f, 0x73, 0x74, 0x46, 0x69, 0x6e,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x33, 0x0a, 0x15, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x14, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x13, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x46, 0x0a, 0x09, 0x70, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x69, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65,
+	0x6e, 0x61, 0x6c, 0x74, 0x79, 0x52, 0x09, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74,
+	0x69, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x12, 0x65, 0x0a, 0x12, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18,
+	0x0d, 0x20, 0x03, 0x28, 0x04, 0x42, 0x36, 0xfa, 0xde, 0x1f, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c,
+	0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x11, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x12, 0x45, 0x0a, 0x14, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x13,
+	0xf2, 0xde, 0x1f, 0x0f, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x3f, 0x2c,
+	0x34, 0x38, 0x22, 0x52, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x12, 0x3f, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a,
+	0x65, 0x3a, 0x22, 0x33, 0x32, 0x22, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x78, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x4c, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x36, 0xfa, 0xde, 0x1f,
+	0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73,
+	0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0xf0, 0x01, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6e, 0x6f, 0x6e,
+	0x69, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
+	0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0xfa, 0xde, 0x1f, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d,
+	0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x6c,
+	0x6f, 0x74, 0x12, 0x45, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x2c, 0xfa, 0xde, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62,
+	0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f,
+	0x74, 0x52, 0x06, 0x74, 0x6f, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x87, 0x01, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x6f, 0x6e, 0x69,
+	0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x46, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x6f, 0x6e, 0x69,
+	0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x07,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0xd0, 0x01, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d,
+	0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x33, 0x32, 0x22, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12,
+	0x40, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x4f, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
+	0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x6e, 0x64, 0x6f, 0x72, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xbd, 0x02, 0x0a, 0x13, 0x50, 0x61, 0x6e, 0x64, 0x6f, 0x72, 0x61, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x04, 0x73, 0x6c,
+	0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0xfa, 0xde, 0x1f, 0x28, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74,
+	0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x30, 0x0a, 0x0a,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22,
+	0x33, 0x32, 0x22, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x34,
+	0x0a, 0x0c, 0x70, 0x61, 0x6e, 0x64, 0x6f, 0x72, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69,
+	0x7a, 0x65, 0x3a, 0x22, 0x33, 0x32, 0x22, 0x52, 0x0b, 0x70, 0x61, 0x6e, 0x64, 0x6f, 0x72, 0x61,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x4a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+	0x6e, 0x64, 0x6f, 0x72, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x30, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45,
+	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x56, 0x45, 0x52, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x10, 0x02, 0x22, 0x54, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79,
+	0x50, 0x61, 0x6e, 0x64, 0x6f, 0x72, 0x61, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x34, 0x0a, 0x0c, 0x70, 0x61, 0x6e, 0x64, 0x6f, 0x72, 0x61, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73,
+	0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x33, 0x32, 0x22, 0x52, 0x0b, 0x70, 0x61, 0x6e,
+	0x64, 0x6f, 0x72, 0x61, 0x48, 0x61, 0x73, 0x68, 0x22, 0xa1, 0x01, 0x0a, 0x0b, 0x50, 0x61, 0x69,
+	0x72, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x41, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
+	0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x4f, 0x0a, 0x0c, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x64, 0x6f,
+	0x72, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x65, 0x0a, 0x1e,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43,
+	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa,
+	0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72,
+	0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32,
+	0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x22, 0x85, 0x02, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x43, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d,
+	0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70,
+	0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68,
+	0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x29, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69,
+	0x7a, 0x65, 0x3a, 0x22, 0x33, 0x32, 0x22, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e,
+	0x67, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x74, 0x5f,
+	0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x69, 0x76,
+	0x65, 0x72, 0x67, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x22, 0xd9, 0x01, 0x0a, 0x16,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5f, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x36, 0xfa, 0xde, 0x1f, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74,
+	0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x73, 0x65, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22, 0x8e, 0x02, 0x0a, 0x1a, 0x53, 0x6c, 0x6f, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0xfa, 0xde, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61,
+	0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c,
+	0x6f, 0x74, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x4e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x73, 0x65, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22, 0xdb, 0x02, 0x0a, 0x19, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c,
+	0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x4f, 0x0a, 0x0c, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x2c, 0xfa, 0xde, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f,
+	0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52,
+	0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x48, 0x0a, 0x05,
+	0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x73, 0x65, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22, 0xcc, 0x05, 0x0a, 0x24, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x45, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d,
+	0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70,
+	0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68,
+	0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x48, 0x00, 0x52,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d,
+	0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x33, 0x32, 0x22, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d,
+	0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x33, 0x32, 0x22, 0x48, 0x00, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x34, 0x0a, 0x0b, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x42,
+	0x13, 0xf2, 0xde, 0x1f, 0x0f, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x3f,
+	0x2c, 0x34, 0x38, 0x22, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73,
+	0x12, 0x50, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x04, 0x42, 0x36, 0xfa, 0xde, 0x1f, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f,
+	0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63,
+	0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2b,
+	0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64,
+	0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x42, 0x0a, 0x1d, 0x77, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1b,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x63, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xbc, 0x04, 0x0a, 0x1d, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x4d, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65,
+	0x3a, 0x22, 0x33, 0x32, 0x22, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x59, 0x0a, 0x10, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x5f, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x0f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x12, 0x54, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x5f,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x50, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x52, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x5a, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x12, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9b, 0x02, 0x0a, 0x16, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x30, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a,
+	0x65, 0x3a, 0x22, 0x34, 0x38, 0x22, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x12, 0x5f, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x36, 0xfa, 0xde, 0x1f, 0x32,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d,
+	0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x55, 0x0a, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x36, 0xfa, 0xde, 0x1f, 0x32, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63,
+	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x09,
+	0x68, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6e, 0x5f,
+	0x68, 0x65, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x6e, 0x48, 0x65,
+	0x61, 0x64, 0x22, 0x9b, 0x02, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x40, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0xfa, 0xde, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61,
+	0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c,
+	0x6f, 0x74, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x5f, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x36, 0xfa, 0xde, 0x1f, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f,
+	0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x61,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0xb8, 0x03, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x12, 0x5f, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x36, 0xfa,
+	0xde, 0x1f, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72,
+	0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32,
+	0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x6f, 0x0a, 0x1c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74,
+	0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x1a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x58, 0x0a, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa,
+	0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72,
+	0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32,
+	0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x0f, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x4c, 0x0a,
+	0x0a, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f,
+	0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x52, 0x09, 0x65, 0x78, 0x69, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x22, 0xb6, 0x01, 0x0a, 0x28,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x42, 0x79, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x42, 0x0a, 0x1d, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x63,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x61, 0x6c, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x22, 0xb7, 0x01, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5f, 0x0a, 0x0f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x36, 0xfa, 0xde, 0x1f, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62,
+	0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x0e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x2a,
+	0x0a, 0x14, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x22, 0xa3, 0x05, 0x0a, 0x15, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x45, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x65, 0x0a,
+	0x18, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x2c, 0xfa, 0xde, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74,
+	0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x15, 0x73,
+	0x6c, 0x6f, 0x74, 0x73, 0x50, 0x65, 0x72, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x63, 0x6f, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x65, 0x64, 0x75,
+	0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x44, 0x65, 0x64, 0x75, 0x70, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x7a, 0x65,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x39, 0x0a, 0x19, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x16, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x36, 0x0a, 0x18, 0x63, 0x6f, 0x6c, 0x64,
+	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x64, 0x61, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x63, 0x6f, 0x6c, 0x64,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x64, 0x61, 0x79, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x69, 0x7a,
+	0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x21, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x67,
+	0x72, 0x6f, 0x77, 0x74, 0x68, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x1d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x64,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x22, 0x85, 0x02, 0x0a, 0x1c, 0x50, 0x61, 0x6e, 0x64, 0x6f, 0x72, 0x61, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x40, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x2c, 0xfa, 0xde, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74,
+	0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x04, 0x73,
+	0x6c, 0x6f, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a,
+	0x22, 0x33, 0x32, 0x22, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x4a, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x64, 0x6f, 0x72, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d,
+	0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x33, 0x32, 0x22, 0x52, 0x09, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x7e, 0x0a, 0x20, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x50, 0x61, 0x6e, 0x64, 0x6f, 0x72, 0x61, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x61, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5a, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e,
+	0x64, 0x6f, 0x72, 0x61, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x21, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x6e, 0x64, 0x6f, 0x72, 0x61, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x51, 0x0a, 0x0d, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6e, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x42, 0x2c, 0xfa,
+	0xde, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72,
+	0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32,
+	0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x0c, 0x75, 0x6e, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0xae, 0x02, 0x0a, 0x14, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d,
+	0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x34, 0x38, 0x22, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4b, 0x65, 0x79, 0x12, 0x4c, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x36, 0xfa, 0xde, 0x1f, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62,
+	0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x4c, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63,
+	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x12, 0x48, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73,
+	0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x52, 0x07, 0x74, 0x6f, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x92, 0x02, 0x0a, 0x0d, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x4c, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x36, 0xfa, 0xde, 0x1f,
+	0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73,
+	0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x64, 0x75, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x44, 0x75, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x29, 0x0a, 0x10,
+	0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x61, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6c,
+	0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x22,
+	0x95, 0x01, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f,
+	0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73,
+	0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x12, 0x34, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x13, 0xf2, 0xde, 0x1f, 0x0f, 0x73, 0x73, 0x7a, 0x2d,
+	0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x3f, 0x2c, 0x34, 0x38, 0x22, 0x52, 0x0a, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xf6, 0x02, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x6e,
+	0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x0a,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22,
+	0x34, 0x38, 0x22, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x5f,
+	0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x36, 0xfa, 0xde, 0x1f, 0x32, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69,
+	0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52,
+	0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x51, 0x0a, 0x0d, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0xfa, 0xde, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63,
+	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x53, 0x6c, 0x6f, 0x74, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53, 0x6c,
+	0x6f, 0x74, 0x12, 0x5f, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x36, 0xfa, 0xde, 0x1f,
+	0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73,
+	0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x64,
+	0x22, 0xa4, 0x01, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c,
+	0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x4a, 0x0a, 0x0b, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xcd, 0x04, 0x0a, 0x24, 0x4c, 0x69, 0x73, 0x74,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x4c, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61,
+	0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x48,
+	0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65,
+	0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52,
+	0x07, 0x74, 0x6f, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x34, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x13, 0xf2,
+	0xde, 0x1f, 0x0f, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x3f, 0x2c, 0x34,
+	0x38, 0x22, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x50,
+	0x0a, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x04, 0x42,
+	0x36, 0xfa, 0xde, 0x1f, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74,
+	0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x4f,
+	0x6e, 0x6c, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x6f, 0x6e, 0x6c,
+	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x4f, 0x6e,
+	0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x5f,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12,
+	0x38, 0x0a, 0x18, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x16, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x42, 0x0a, 0x1d, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x1b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x22, 0x97, 0x04, 0x0a, 0x19, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x06, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12, 0x59, 0x0a, 0x10, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x5f, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4d, 0x69, 0x73, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x0f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x4d, 0x69, 0x73, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
+	0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0c, 0x42, 0x13, 0xf2, 0xde, 0x1f, 0x0f, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65,
+	0x3a, 0x22, 0x3f, 0x2c, 0x33, 0x32, 0x22, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x12, 0x5a, 0x0a, 0x11, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x73, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x57, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x0f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12,
+	0x60, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x5f, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x12, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x5a, 0x0a, 0x15, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x07, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x52, 0x07, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22, 0x57, 0x0a,
+	0x14, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x3f, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x62, 0x0a, 0x17, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x47, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x83, 0x02, 0x0a, 0x14, 0x50,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x63, 0x0a,
+	0x16, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65,
+	0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa,
+	0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72,
+	0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32,
+	0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x14, 0x6c, 0x61,
+	0x73, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x52, 0x0a, 0x0d,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62,
+	0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x22, 0xc6, 0x02, 0x0a, 0x16, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x0a, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74,
+	0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x09,
+	0x66, 0x72, 0x6f, 0x6d, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x5b, 0x0a, 0x11, 0x72, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61,
+	0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x48, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x46, 0x72, 0x6f,
+	0x6d, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f,
+	0x67, 0x61, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x47, 0x61, 0x70, 0x73, 0x12, 0x38, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x53, 0x68, 0x61, 0x72, 0x64, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x64, 0x42,
+	0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x22, 0x44, 0x0a, 0x0a, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x53, 0x68, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x75,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x22,
+	0xbd, 0x01, 0x0a, 0x16, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4a, 0x0a, 0x07, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x09, 0x61, 0x63, 0x6b, 0x5f, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61,
+	0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x48, 0x00, 0x52, 0x08, 0x61, 0x63, 0x6b, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0xbc, 0x03, 0x0a, 0x09, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x43, 0x0a,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde,
+	0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79,
+	0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x6c, 0x6f, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x31, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0c, 0x42, 0x13, 0xf2, 0xde, 0x1f, 0x0f, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69,
+	0x7a, 0x65, 0x3a, 0x22, 0x3f, 0x2c, 0x34, 0x38, 0x22, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x72, 0x73, 0x12, 0x3f, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22,
+	0x33, 0x32, 0x22, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x33, 0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x10, 0xf2, 0xde, 0x1f,
+	0x0c, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x34, 0x22, 0x52, 0x0b, 0x66,
+	0x6f, 0x72, 0x6b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x0b, 0x66, 0x6f,
+	0x72, 0x6b, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x10, 0xf2, 0xde, 0x1f, 0x0c, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x34,
+	0x22, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x6b, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x65, 0x6f, 0x72, 0x67, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x72, 0x65, 0x6f, 0x72, 0x67, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x20, 0x0a, 0x0b,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x74,
+	0x0a, 0x1b, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x41, 0x63, 0x63, 0x75, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde,
+	0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79,
+	0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x42, 0x0e, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x22, 0xd4, 0x01, 0x0a, 0x14, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e,
+	0x66, 0x6f, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x50, 0x0a,
+	0x0c, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62,
+	0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x52, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x43, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d,
+	0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70,
+	0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68,
+	0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65,
+	0x3a, 0x22, 0x33, 0x32, 0x22, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x22, 0x6c, 0x0a, 0x13, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x45, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f,
+	0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x48, 0x00, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x0e, 0x0a, 0x0c, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xfa, 0x01, 0x0a, 0x0c, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xfa, 0xde, 0x1f, 0x29, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61,
+	0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x42, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6c, 0x6f, 0x74,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x72, 0x73, 0x12, 0x3f, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x11, 0xf2, 0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x33,
+	0x32, 0x22, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x6f, 0x6f, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x82, 0x01, 0x0a, 0x0c, 0x53, 0x6c, 0x6f, 0x74, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0xfa, 0xde, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c,
+	0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53,
+	0x6c, 0x6f, 0x74, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0xf2,
+	0xde, 0x1f, 0x0d, 0x73, 0x73, 0x7a, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x3a, 0x22, 0x34, 0x38, 0x22,
+	0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x32, 0xd0, 0x3b, 0x0a, 0x0b,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0xad, 0x01, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x76, 0x65,
+	0x6e, 0x65, 0x73, 0x73, 0x12, 0x30, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
+	0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2a, 0x12, 0x28, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x2f, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x99, 0x01, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74,
+	0x73, 0x12, 0x2d, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27,
+	0x12, 0x25, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65,
+	0x73, 0x2f, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x12, 0x9c, 0x01, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6c,
+	0x61, 0x69, 0x6e, 0x53, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x12, 0x2d, 0x2e, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x68, 0x75, 0x66, 0x66,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f,
+	0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x73, 0x2f, 0x73,
+	0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x12, 0x2b, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
+	0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12,
+	0x20, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x64, 0x69, 0x66,
+	0x66, 0x12, 0x8c, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x61, 0x6f, 0x4d,
+	0x69, 0x78, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61,
+	0x6e, 0x64, 0x61, 0x6f, 0x4d, 0x69, 0x78, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x61, 0x6f,
+	0x4d, 0x69, 0x78, 0x65, 0x73, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f,
+	0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x2f, 0x72, 0x61, 0x6e, 0x64, 0x61, 0x6f, 0x2f, 0x6d, 0x69, 0x78, 0x65, 0x73,
+	0x12, 0x7c, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x73, 0x12, 0x29,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6f, 0x72,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x73, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1d, 0x12, 0x1b, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x6f, 0x72, 0x67, 0x73, 0x12, 0x8b,
+	0x01, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x73, 0x12,
+	0x29, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6f,
+	0x72, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x2a,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x6f,
+	0x72, 0x67, 0x73, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x30, 0x01, 0x12, 0x7d, 0x0a, 0x09,
+	0x47, 0x65, 0x74, 0x44, 0x75, 0x74, 0x69, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x44, 0x75, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x75, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b,
+	0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x62, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x64, 0x75, 0x74, 0x69, 0x65, 0x73, 0x12, 0xc4, 0x01, 0x0a, 0x1b,
+	0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x3a, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
+	0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32,
+	0x12, 0x30, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x91, 0x01, 0x0a, 0x17, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x6c, 0x6f,
+	0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x29, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
+	0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x6c, 0x6f, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x65, 0x74, 0x68, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x30, 0x01, 0x12, 0x94, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x2e, 0x2e, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x73, 0x2f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x9e, 0x01,
+	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x31, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
+	0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x22,
+	0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x73, 0x2f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0xaf,
+	0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x36, 0x2e, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x22,
+	0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73,
+	0x12, 0xb6, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x51, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x34, 0x2e,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x51, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x65, 0x74, 0x68,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x2f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x99, 0x01, 0x0a, 0x18, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2a,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x65,
+	0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x31, 0x12, 0x2f, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x68, 0x65,
+	0x61, 0x64, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x2f, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x30, 0x01, 0x12, 0xa5, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x30,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x30, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x7e, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x25, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0xa5, 0x01,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65,
+	0x74, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x33, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
+	0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x44,
+	0x65, 0x6c, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65,
+	0x74, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25,
+	0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x62, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f,
+	0x64, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x9e, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x65, 0x66, 0x65, 0x74,
+	0x63, 0x68, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x30, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x74, 0x63, 0x68, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x74, 0x63, 0x68, 0x4a,
+	0x6f, 0x62, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x22, 0x27, 0x2f, 0x65, 0x74, 0x68,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x2f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x69, 0x6e, 0x66, 0x6f, 0x2f, 0x70, 0x72, 0x65, 0x66, 0x65,
+	0x74, 0x63, 0x68, 0x3a, 0x01, 0x2a, 0x12, 0xa5, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x65, 0x66, 0x65, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x2e, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x74, 0x63,
+	0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x74, 0x63, 0x68,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e,
+	0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x62, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x69, 0x6e, 0x66, 0x6f, 0x2f, 0x70,
+	0x72, 0x65, 0x66, 0x65, 0x74, 0x63, 0x68, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0xb3,
+	0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x12, 0x36, 0x2e, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61,
+	0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x6f,
+	0x6e, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x22,
+	0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x2f, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x2f, 0x72,
+	0x6f, 0x6f, 0x74, 0x73, 0x12, 0xa7, 0x01, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x32, 0x2e, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x72, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x72, 0x41, 0x75, 0x64, 0x69, 0x74, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12,
+	0x2b, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x2f,
+	0x61, 0x75, 0x64, 0x69, 0x74, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x30, 0x01, 0x12, 0xb0,
+	0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2e, 0x2e, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x22, 0x27, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x3a, 0x01,
+	0x2a, 0x12, 0xbf, 0x01, 0x0a, 0x17, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x36, 0x2e,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33,
+	0x22, 0x2e, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x2f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x3a, 0x01, 0x2a, 0x12, 0x9a, 0x01, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2f, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
+	0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30,
+	0x12, 0x2e, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0xa5, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28,
+	0x12, 0x26, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x61, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x81, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x45, 0x74, 0x68, 0x31, 0x44, 0x61, 0x74, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x26, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
+	0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x74, 0x68, 0x31, 0x44, 0x61, 0x74, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x65, 0x74, 0x68,
+	0x31, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x96, 0x01, 0x0a,
+	0x17, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x49, 0x6e,
+	0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x28, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x31, 0x12, 0x2f, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x73, 0x2f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x30, 0x01, 0x12, 0xbd, 0x01, 0x0a, 0x17, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x36, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x73, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x69, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x12, 0xbb, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61,
+	0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x12, 0x38, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0x35, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x2f, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x2f, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x12, 0x9f, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x42, 0x79, 0x50, 0x61, 0x6e, 0x64, 0x6f, 0x72, 0x61, 0x48, 0x61, 0x73, 0x68, 0x12, 0x34, 0x2e,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42,
+	0x79, 0x50, 0x61, 0x6e, 0x64, 0x6f, 0x72, 0x61, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x69,
+	0x72, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25,
+	0x12, 0x23, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2f, 0x70, 0x61,
+	0x6e, 0x64, 0x6f, 0x72, 0x61, 0x12, 0xb9, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x36, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x32, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x12, 0xa1, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x31, 0x2e, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x36, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0xd0, 0x01, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3c, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x2f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x12, 0xb0, 0x01, 0x0a, 0x1c, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x33, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b,
+	0x12, 0x29, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x12, 0xbf, 0x01, 0x0a, 0x1e,
+	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x32,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x33, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12,
+	0x2c, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x12, 0xc5, 0x01,
+	0x0a, 0x25, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x42, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x40, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
+	0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x42, 0x79, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x37, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x77,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x9f, 0x01, 0x0a, 0x15, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x12,
+	0x2c, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x47, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x47, 0x72,
+	0x6f, 0x77, 0x74, 0x68, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x22, 0x29, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2f, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x12, 0xc5, 0x01, 0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x50, 0x61, 0x6e, 0x64, 0x6f, 0x72, 0x61, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x38, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x6e, 0x64, 0x6f, 0x72, 0x61, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x39, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x50, 0x61, 0x6e, 0x64, 0x6f, 0x72, 0x61, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x2d, 0x22, 0x28, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2f, 0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x12,
+	0x97, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x2c, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x28, 0x12, 0x26, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x9d, 0x01, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x30, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6e,
+	0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75,
+	0x62, 0x6e, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x2f, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x12, 0xc4, 0x01, 0x0a, 0x1d, 0x4c, 0x69,
+	0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3c, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x32, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x8a, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2c, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x65, 0x74, 0x68,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x70,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6a, 0x0a,
+	0x0f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x2e, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49,
+	0x6e, 0x66, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x1a, 0x21, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49,
+	0x6e, 0x66, 0x6f, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0xb7, 0x01, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x33, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x41, 0x63, 0x63,
+	0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33,
+	0x12, 0x31, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x2f, 0x61, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x98, 0x01, 0x0a, 0x15, 0x4e, 0x65, 0x78, 0x74, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x2e,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_beacon_rpc_v1_beacon_query_proto_rawDescOnce sync.Once
+	file_proto_beacon_rpc_v1_beacon_query_proto_rawDescData = file_proto_beacon_rpc_v1_beacon_query_proto_rawDesc
+)
+
+func file_proto_beacon_rpc_v1_beacon_query_proto_rawDescGZIP() []byte {
+	file_proto_beacon_rpc_v1_beacon_query_proto_rawDescOnce.Do(func() {
+		file_proto_beacon_rpc_v1_beacon_query_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_beacon_rpc_v1_beacon_query_proto_rawDescData)
+	})
+	return file_proto_beacon_rpc_v1_beacon_query_proto_rawDescData
+}
+
+var file_proto_beacon_rpc_v1_beacon_query_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes = make([]protoimpl.MessageInfo, 105)
+var file_proto_beacon_rpc_v1_beacon_query_proto_goTypes = []interface{}{
+	(ProposerAudit_Outcome)(0),                       // 0: ethereum.beacon.rpc.v1.ProposerAudit.Outcome
+	(PandoraConfirmation_Status)(0),                  // 1: ethereum.beacon.rpc.v1.PandoraConfirmation.Status
+	(*ValidatorLivenessRequest)(nil),                 // 2: ethereum.beacon.rpc.v1.ValidatorLivenessRequest
+	(*ValidatorLivenessResponse)(nil),                // 3: ethereum.beacon.rpc.v1.ValidatorLivenessResponse
+	(*ValidatorLiveness)(nil),                        // 4: ethereum.beacon.rpc.v1.ValidatorLiveness
+	(*CommitteeRootsRequest)(nil),                    // 5: ethereum.beacon.rpc.v1.CommitteeRootsRequest
+	(*CommitteeRoots)(nil),                           // 6: ethereum.beacon.rpc.v1.CommitteeRoots
+	(*CommitteeRoot)(nil),                            // 7: ethereum.beacon.rpc.v1.CommitteeRoot
+	(*ExplainShuffleRequest)(nil),                    // 8: ethereum.beacon.rpc.v1.ExplainShuffleRequest
+	(*ShuffleExplanation)(nil),                       // 9: ethereum.beacon.rpc.v1.ShuffleExplanation
+	(*GetStateDiffRequest)(nil),                      // 10: ethereum.beacon.rpc.v1.GetStateDiffRequest
+	(*StateDiff)(nil),                                // 11: ethereum.beacon.rpc.v1.StateDiff
+	(*ValidatorChange)(nil),                          // 12: ethereum.beacon.rpc.v1.ValidatorChange
+	(*BalanceDelta)(nil),                             // 13: ethereum.beacon.rpc.v1.BalanceDelta
+	(*RandaoMixesRequest)(nil),                       // 14: ethereum.beacon.rpc.v1.RandaoMixesRequest
+	(*RandaoMixes)(nil),                              // 15: ethereum.beacon.rpc.v1.RandaoMixes
+	(*EpochRandaoMix)(nil),                           // 16: ethereum.beacon.rpc.v1.EpochRandaoMix
+	(*RandaoContribution)(nil),                       // 17: ethereum.beacon.rpc.v1.RandaoContribution
+	(*ListReorgsRequest)(nil),                        // 18: ethereum.beacon.rpc.v1.ListReorgsRequest
+	(*Reorgs)(nil),                                   // 19: ethereum.beacon.rpc.v1.Reorgs
+	(*ReorgEvent)(nil),                               // 20: ethereum.beacon.rpc.v1.ReorgEvent
+	(*ListValidatorBalanceHistoryRequest)(nil),       // 21: ethereum.beacon.rpc.v1.ListValidatorBalanceHistoryRequest
+	(*ValidatorBalanceHistory)(nil),                  // 22: ethereum.beacon.rpc.v1.ValidatorBalanceHistory
+	(*EpochBalance)(nil),                             // 23: ethereum.beacon.rpc.v1.EpochBalance
+	(*SlotParticipation)(nil),                        // 24: ethereum.beacon.rpc.v1.SlotParticipation
+	(*GetEpochSummaryRequest)(nil),                   // 25: ethereum.beacon.rpc.v1.GetEpochSummaryRequest
+	(*ListEpochSummariesRequest)(nil),                // 26: ethereum.beacon.rpc.v1.ListEpochSummariesRequest
+	(*EpochSummaries)(nil),                           // 27: ethereum.beacon.rpc.v1.EpochSummaries
+	(*EpochSummary)(nil),                             // 28: ethereum.beacon.rpc.v1.EpochSummary
+	(*ListValidatorPublicKeysRequest)(nil),           // 29: ethereum.beacon.rpc.v1.ListValidatorPublicKeysRequest
+	(*ValidatorPublicKeys)(nil),                      // 30: ethereum.beacon.rpc.v1.ValidatorPublicKeys
+	(*ValidatorPublicKey)(nil),                       // 31: ethereum.beacon.rpc.v1.ValidatorPublicKey
+	(*ValidatorQueueDetailsRequest)(nil),             // 32: ethereum.beacon.rpc.v1.ValidatorQueueDetailsRequest
+	(*ValidatorQueueDetails)(nil),                    // 33: ethereum.beacon.rpc.v1.ValidatorQueueDetails
+	(*QueuedValidator)(nil),                          // 34: ethereum.beacon.rpc.v1.QueuedValidator
+	(*AnnotatedChainHead)(nil),                       // 35: ethereum.beacon.rpc.v1.AnnotatedChainHead
+	(*BlockAvailabilityRequest)(nil),                 // 36: ethereum.beacon.rpc.v1.BlockAvailabilityRequest
+	(*BlockAvailability)(nil),                        // 37: ethereum.beacon.rpc.v1.BlockAvailability
+	(*NetworkConfig)(nil),                            // 38: ethereum.beacon.rpc.v1.NetworkConfig
+	(*GetValidatorSetDeltaRequest)(nil),              // 39: ethereum.beacon.rpc.v1.GetValidatorSetDeltaRequest
+	(*ValidatorRecord)(nil),                          // 40: ethereum.beacon.rpc.v1.ValidatorRecord
+	(*ValidatorSetDelta)(nil),                        // 41: ethereum.beacon.rpc.v1.ValidatorSetDelta
+	(*PrefetchEpochInfoRequest)(nil),                 // 42: ethereum.beacon.rpc.v1.PrefetchEpochInfoRequest
+	(*PrefetchJob)(nil),                              // 43: ethereum.beacon.rpc.v1.PrefetchJob
+	(*GetPrefetchStatusRequest)(nil),                 // 44: ethereum.beacon.rpc.v1.GetPrefetchStatusRequest
+	(*PrefetchStatus)(nil),                           // 45: ethereum.beacon.rpc.v1.PrefetchStatus
+	(*EpochGap)(nil),                                 // 46: ethereum.beacon.rpc.v1.EpochGap
+	(*ListCanonicalBlockRootsRequest)(nil),           // 47: ethereum.beacon.rpc.v1.ListCanonicalBlockRootsRequest
+	(*CanonicalBlockRoots)(nil),                      // 48: ethereum.beacon.rpc.v1.CanonicalBlockRoots
+	(*CanonicalBlockRoot)(nil),                       // 49: ethereum.beacon.rpc.v1.CanonicalBlockRoot
+	(*StreamProposerAuditRequest)(nil),               // 50: ethereum.beacon.rpc.v1.StreamProposerAuditRequest
+	(*ProposerAudit)(nil),                            // 51: ethereum.beacon.rpc.v1.ProposerAudit
+	(*TrackValidatorsRequest)(nil),                   // 52: ethereum.beacon.rpc.v1.TrackValidatorsRequest
+	(*TrackedValidatorsResponse)(nil),                // 53: ethereum.beacon.rpc.v1.TrackedValidatorsResponse
+	(*ImportTrackedValidatorsRequest)(nil),           // 54: ethereum.beacon.rpc.v1.ImportTrackedValidatorsRequest
+	(*TrackedValidatorsExport)(nil),                  // 55: ethereum.beacon.rpc.v1.TrackedValidatorsExport
+	(*ListPoolAttestationsRequest)(nil),              // 56: ethereum.beacon.rpc.v1.ListPoolAttestationsRequest
+	(*PoolAttestations)(nil),                         // 57: ethereum.beacon.rpc.v1.PoolAttestations
+	(*PoolCommitteeAttestations)(nil),                // 58: ethereum.beacon.rpc.v1.PoolCommitteeAttestations
+	(*Eth1DataStatus)(nil),                           // 59: ethereum.beacon.rpc.v1.Eth1DataStatus
+	(*DepositInclusion)(nil),                         // 60: ethereum.beacon.rpc.v1.DepositInclusion
+	(*SimulateEpochTransitionRequest)(nil),           // 61: ethereum.beacon.rpc.v1.SimulateEpochTransitionRequest
+	(*EpochTransitionSimulation)(nil),                // 62: ethereum.beacon.rpc.v1.EpochTransitionSimulation
+	(*ValidatorPenalty)(nil),                         // 63: ethereum.beacon.rpc.v1.ValidatorPenalty
+	(*ListCanonicalBlockHeadersRequest)(nil),         // 64: ethereum.beacon.rpc.v1.ListCanonicalBlockHeadersRequest
+	(*CanonicalBlockHeaders)(nil),                    // 65: ethereum.beacon.rpc.v1.CanonicalBlockHeaders
+	(*CanonicalBlockHeader)(nil),                     // 66: ethereum.beacon.rpc.v1.CanonicalBlockHeader
+	(*PandoraConfirmation)(nil),                      // 67: ethereum.beacon.rpc.v1.PandoraConfirmation
+	(*GetBlockByPandoraHashRequest)(nil),             // 68: ethereum.beacon.rpc.v1.GetBlockByPandoraHashRequest
+	(*PairedBlock)(nil),                              // 69: ethereum.beacon.rpc.v1.PairedBlock
+	(*ProposerListConsistencyRequest)(nil),           // 70: ethereum.beacon.rpc.v1.ProposerListConsistencyRequest
+	(*ProposerListConsistency)(nil),                  // 71: ethereum.beacon.rpc.v1.ProposerListConsistency
+	(*CommitteeParticipation)(nil),                   // 72: ethereum.beacon.rpc.v1.CommitteeParticipation
+	(*SlotCommitteeParticipation)(nil),               // 73: ethereum.beacon.rpc.v1.SlotCommitteeParticipation
+	(*CurrentEpochParticipation)(nil),                // 74: ethereum.beacon.rpc.v1.CurrentEpochParticipation
+	(*AnnotatedValidatorAssignmentsRequest)(nil),     // 75: ethereum.beacon.rpc.v1.AnnotatedValidatorAssignmentsRequest
+	(*AnnotatedValidatorAssignments)(nil),            // 76: ethereum.beacon.rpc.v1.AnnotatedValidatorAssignments
+	(*ValidatorIndexMismatch)(nil),                   // 77: ethereum.beacon.rpc.v1.ValidatorIndexMismatch
+	(*CommitteeWeight)(nil),                          // 78: ethereum.beacon.rpc.v1.CommitteeWeight
+	(*ValidatorFields)(nil),                          // 79: ethereum.beacon.rpc.v1.ValidatorFields
+	(*ValidatorsByWithdrawalCredentialsRequest)(nil), // 80: ethereum.beacon.rpc.v1.ValidatorsByWithdrawalCredentialsRequest
+	(*CommitteePosition)(nil),                        // 81: ethereum.beacon.rpc.v1.CommitteePosition
+	(*StorageGrowthRequest)(nil),                     // 82: ethereum.beacon.rpc.v1.StorageGrowthRequest
+	(*StorageGrowthEstimate)(nil),                    // 83: ethereum.beacon.rpc.v1.StorageGrowthEstimate
+	(*PandoraBlockHashConfirmation)(nil),             // 84: ethereum.beacon.rpc.v1.PandoraBlockHashConfirmation
+	(*ConfirmPandoraBlockHashesRequest)(nil),         // 85: ethereum.beacon.rpc.v1.ConfirmPandoraBlockHashesRequest
+	(*ConfirmPandoraBlockHashesResponse)(nil),        // 86: ethereum.beacon.rpc.v1.ConfirmPandoraBlockHashesResponse
+	(*ProposerStatsRequest)(nil),                     // 87: ethereum.beacon.rpc.v1.ProposerStatsRequest
+	(*ProposerStats)(nil),                            // 88: ethereum.beacon.rpc.v1.ProposerStats
+	(*SubnetAssignmentsRequest)(nil),                 // 89: ethereum.beacon.rpc.v1.SubnetAssignmentsRequest
+	(*SubnetAssignment)(nil),                         // 90: ethereum.beacon.rpc.v1.SubnetAssignment
+	(*SubnetAssignments)(nil),                        // 91: ethereum.beacon.rpc.v1.SubnetAssignments
+	(*ListValidatorAssignmentsRangeRequest)(nil),     // 92: ethereum.beacon.rpc.v1.ListValidatorAssignmentsRangeRequest
+	(*ValidatorAssignmentsRange)(nil),                // 93: ethereum.beacon.rpc.v1.ValidatorAssignmentsRange
+	(*EpochCommitteeWeights)(nil),                    // 94: ethereum.beacon.rpc.v1.EpochCommitteeWeights
+	(*EpochValidatorFields)(nil),                     // 95: ethereum.beacon.rpc.v1.EpochValidatorFields
+	(*EpochCommitteePositions)(nil),                  // 96: ethereum.beacon.rpc.v1.EpochCommitteePositions
+	(*PrecomputationStatus)(nil),                     // 97: ethereum.beacon.rpc.v1.PrecomputationStatus
+	(*StreamEpochInfoRequest)(nil),                   // 98: ethereum.beacon.rpc.v1.StreamEpochInfoRequest
+	(*EpochShard)(nil),                               // 99: ethereum.beacon.rpc.v1.EpochShard
+	(*EpochInfoStreamMessage)(nil),                   // 100: ethereum.beacon.rpc.v1.EpochInfoStreamMessage
+	(*EpochInfo)(nil),                                // 101: ethereum.beacon.rpc.v1.EpochInfo
+	(*EpochInfoAccumulatorRequest)(nil),              // 102: ethereum.beacon.rpc.v1.EpochInfoAccumulatorRequest
+	(*EpochInfoAccumulator)(nil),                     // 103: ethereum.beacon.rpc.v1.EpochInfoAccumulator
+	(*ProposerListRequest)(nil),                      // 104: ethereum.beacon.rpc.v1.ProposerListRequest
+	(*ProposerList)(nil),                             // 105: ethereum.beacon.rpc.v1.ProposerList
+	(*SlotProposer)(nil),                             // 106: ethereum.beacon.rpc.v1.SlotProposer
+	(*v1alpha1.Checkpoint)(nil),                      // 107: ethereum.eth.v1alpha1.Checkpoint
+	(*v1alpha1.Validator)(nil),                       // 108: ethereum.eth.v1alpha1.Validator
+	(*v1alpha1.ChainHead)(nil),                       // 109: ethereum.eth.v1alpha1.ChainHead
+	(v1alpha1.ValidatorStatus)(0),                    // 110: ethereum.eth.v1alpha1.ValidatorStatus
+	(*v1alpha1.Attestation)(nil),                     // 111: ethereum.eth.v1alpha1.Attestation
+	(*v1alpha1.Eth1Data)(nil),                        // 112: ethereum.eth.v1alpha1.Eth1Data
+	(*v1alpha1.BeaconBlockHeader)(nil),               // 113: ethereum.eth.v1alpha1.BeaconBlockHeader
+	(*v1alpha1.BeaconBlockContainer)(nil),            // 114: ethereum.eth.v1alpha1.BeaconBlockContainer
+	(*v1alpha1.ValidatorAssignments)(nil),            // 115: ethereum.eth.v1alpha1.ValidatorAssignments
+	(*v1alpha1.ListValidatorsRequest)(nil),           // 116: ethereum.eth.v1alpha1.ListValidatorsRequest
+	(*v1alpha1.DutiesRequest)(nil),                   // 117: ethereum.eth.v1alpha1.DutiesRequest
+	(*empty.Empty)(nil),                              // 118: google.protobuf.Empty
+	(*v1alpha1.ListValidatorBalancesRequest)(nil),    // 119: ethereum.eth.v1alpha1.ListValidatorBalancesRequest
+	(*v1alpha1.ValidatorPerformanceRequest)(nil),     // 120: ethereum.eth.v1alpha1.ValidatorPerformanceRequest
+	(*v1alpha1.DutiesResponse)(nil),                  // 121: ethereum.eth.v1alpha1.DutiesResponse
+	(*v1alpha1.ValidatorBalances)(nil),               // 122: ethereum.eth.v1alpha1.ValidatorBalances
+	(*v1alpha1.ValidatorPerformanceResponse)(nil),    // 123: ethereum.eth.v1alpha1.ValidatorPerformanceResponse
+	(*v1alpha1.Validators)(nil),                      // 124: ethereum.eth.v1alpha1.Validators
+}
+var file_proto_beacon_rpc_v1_beacon_query_proto_depIdxs = []int32{
+	4,   // 0: ethereum.beacon.rpc.v1.ValidatorLivenessResponse.liveness:type_name -> ethereum.beacon.rpc.v1.ValidatorLiveness
+	7,   // 1: ethereum.beacon.rpc.v1.CommitteeRoots.roots:type_name -> ethereum.beacon.rpc.v1.CommitteeRoot
+	12,  // 2: ethereum.beacon.rpc.v1.StateDiff.changed_validators:type_name -> ethereum.beacon.rpc.v1.ValidatorChange
+	13,  // 3: ethereum.beacon.rpc.v1.StateDiff.balance_deltas:type_name -> ethereum.beacon.rpc.v1.BalanceDelta
+	107, // 4: ethereum.beacon.rpc.v1.StateDiff.previous_justified_checkpoint:type_name -> ethereum.eth.v1alpha1.Checkpoint
+	107, // 5: ethereum.beacon.rpc.v1.StateDiff.current_justified_checkpoint:type_name -> ethereum.eth.v1alpha1.Checkpoint
+	107, // 6: ethereum.beacon.rpc.v1.StateDiff.finalized_checkpoint:type_name -> ethereum.eth.v1alpha1.Checkpoint
+	107, // 7: ethereum.beacon.rpc.v1.StateDiff.post_previous_justified_checkpoint:type_name -> ethereum.eth.v1alpha1.Checkpoint
+	107, // 8: ethereum.beacon.rpc.v1.StateDiff.post_current_justified_checkpoint:type_name -> ethereum.eth.v1alpha1.Checkpoint
+	107, // 9: ethereum.beacon.rpc.v1.StateDiff.post_finalized_checkpoint:type_name -> ethereum.eth.v1alpha1.Checkpoint
+	108, // 10: ethereum.beacon.rpc.v1.ValidatorChange.previous:type_name -> ethereum.eth.v1alpha1.Validator
+	108, // 11: ethereum.beacon.rpc.v1.ValidatorChange.current:type_name -> ethereum.eth.v1alpha1.Validator
+	16,  // 12: ethereum.beacon.rpc.v1.RandaoMixes.mixes:type_name -> ethereum.beacon.rpc.v1.EpochRandaoMix
+	17,  // 13: ethereum.beacon.rpc.v1.EpochRandaoMix.contributions:type_name -> ethereum.beacon.rpc.v1.RandaoContribution
+	20,  // 14: ethereum.beacon.rpc.v1.Reorgs.events:type_name -> ethereum.beacon.rpc.v1.ReorgEvent
+	23,  // 15: ethereum.beacon.rpc.v1.ValidatorBalanceHistory.balances:type_name -> ethereum.beacon.rpc.v1.EpochBalance
+	28,  // 16: ethereum.beacon.rpc.v1.EpochSummaries.summaries:type_name -> ethereum.beacon.rpc.v1.EpochSummary
+	31,  // 17: ethereum.beacon.rpc.v1.ValidatorPublicKeys.public_keys:type_name -> ethereum.beacon.rpc.v1.ValidatorPublicKey
+	34,  // 18: ethereum.beacon.rpc.v1.ValidatorQueueDetails.activations:type_name -> ethereum.beacon.rpc.v1.QueuedValidator
+	34,  // 19: ethereum.beacon.rpc.v1.ValidatorQueueDetails.exits:type_name -> ethereum.beacon.rpc.v1.QueuedValidator
+	109, // 20: ethereum.beacon.rpc.v1.AnnotatedChainHead.head:type_name -> ethereum.eth.v1alpha1.ChainHead
+	110, // 21: ethereum.beacon.rpc.v1.ValidatorRecord.status:type_name -> ethereum.eth.v1alpha1.ValidatorStatus
+	40,  // 22: ethereum.beacon.rpc.v1.ValidatorSetDelta.added:type_name -> ethereum.beacon.rpc.v1.ValidatorRecord
+	40,  // 23: ethereum.beacon.rpc.v1.ValidatorSetDelta.changed:type_name -> ethereum.beacon.rpc.v1.ValidatorRecord
+	40,  // 24: ethereum.beacon.rpc.v1.ValidatorSetDelta.removed:type_name -> ethereum.beacon.rpc.v1.ValidatorRecord
+	46,  // 25: ethereum.beacon.rpc.v1.PrefetchStatus.gaps:type_name -> ethereum.beacon.rpc.v1.EpochGap
+	49,  // 26: ethereum.beacon.rpc.v1.CanonicalBlockRoots.roots:type_name -> ethereum.beacon.rpc.v1.CanonicalBlockRoot
+	0,   // 27: ethereum.beacon.rpc.v1.ProposerAudit.outcome:type_name -> ethereum.beacon.rpc.v1.ProposerAudit.Outcome
+	58,  // 28: ethereum.beacon.rpc.v1.PoolAttestations.committees:type_name -> ethereum.beacon.rpc.v1.PoolCommitteeAttestations
+	111, // 29: ethereum.beacon.rpc.v1.PoolCommitteeAttestations.unaggregated:type_name -> ethereum.eth.v1alpha1.Attestation
+	111, // 30: ethereum.beacon.rpc.v1.PoolCommitteeAttestations.aggregated:type_name -> ethereum.eth.v1alpha1.Attestation
+	112, // 31: ethereum.beacon.rpc.v1.Eth1DataStatus.eth1_data:type_name -> ethereum.eth.v1alpha1.Eth1Data
+	112, // 32: ethereum.beacon.rpc.v1.Eth1DataStatus.vote:type_name -> ethereum.eth.v1alpha1.Eth1Data
+	107, // 33: ethereum.beacon.rpc.v1.EpochTransitionSimulation.previous_justified_checkpoint:type_name -> ethereum.eth.v1alpha1.Checkpoint
+	107, // 34: ethereum.beacon.rpc.v1.EpochTransitionSimulation.current_justified_checkpoint:type_name -> ethereum.eth.v1alpha1.Checkpoint
+	107, // 35: ethereum.beacon.rpc.v1.EpochTransitionSimulation.finalized_checkpoint:type_name -> ethereum.eth.v1alpha1.Checkpoint
+	107, // 36: ethereum.beacon.rpc.v1.EpochTransitionSimulation.post_previous_justified_checkpoint:type_name -> ethereum.eth.v1alpha1.Checkpoint
+	107, // 37: ethereum.beacon.rpc.v1.EpochTransitionSimulation.post_current_justified_checkpoint:type_name -> ethereum.eth.v1alpha1.Checkpoint
+	107, // 38: ethereum.beacon.rpc.v1.EpochTransitionSimulation.post_finalized_checkpoint:type_name -> ethereum.eth.v1alpha1.Checkpoint
+	63,  // 39: ethereum.beacon.rpc.v1.EpochTransitionSimulation.penalties:type_name -> ethereum.beacon.rpc.v1.ValidatorPenalty
+	66,  // 40: ethereum.beacon.rpc.v1.CanonicalBlockHeaders.headers:type_name -> ethereum.beacon.rpc.v1.CanonicalBlockHeader
+	113, // 41: ethereum.beacon.rpc.v1.CanonicalBlockHeader.header:type_name -> ethereum.eth.v1alpha1.BeaconBlockHeader
+	67,  // 42: ethereum.beacon.rpc.v1.CanonicalBlockHeader.confirmation:type_name -> ethereum.beacon.rpc.v1.PandoraConfirmation
+	1,   // 43: ethereum.beacon.rpc.v1.PandoraConfirmation.status:type_name -> ethereum.beacon.rpc.v1.PandoraConfirmation.Status
+	114, // 44: ethereum.beacon.rpc.v1.PairedBlock.block:type_name -> ethereum.eth.v1alpha1.BeaconBlockContainer
+	67,  // 45: ethereum.beacon.rpc.v1.PairedBlock.confirmation:type_name -> ethereum.beacon.rpc.v1.PandoraConfirmation
+	72,  // 46: ethereum.beacon.rpc.v1.SlotCommitteeParticipation.committees:type_name -> ethereum.beacon.rpc.v1.CommitteeParticipation
+	73,  // 47: ethereum.beacon.rpc.v1.CurrentEpochParticipation.slots:type_name -> ethereum.beacon.rpc.v1.SlotCommitteeParticipation
+	115, // 48: ethereum.beacon.rpc.v1.AnnotatedValidatorAssignments.assignments:type_name -> ethereum.eth.v1alpha1.ValidatorAssignments
+	77,  // 49: ethereum.beacon.rpc.v1.AnnotatedValidatorAssignments.index_mismatches:type_name -> ethereum.beacon.rpc.v1.ValidatorIndexMismatch
+	78,  // 50: ethereum.beacon.rpc.v1.AnnotatedValidatorAssignments.committee_weights:type_name -> ethereum.beacon.rpc.v1.CommitteeWeight
+	79,  // 51: ethereum.beacon.rpc.v1.AnnotatedValidatorAssignments.validator_fields:type_name -> ethereum.beacon.rpc.v1.ValidatorFields
+	81,  // 52: ethereum.beacon.rpc.v1.AnnotatedValidatorAssignments.committee_positions:type_name -> ethereum.beacon.rpc.v1.CommitteePosition
+	116, // 53: ethereum.beacon.rpc.v1.ValidatorsByWithdrawalCredentialsRequest.request:type_name -> ethereum.eth.v1alpha1.ListValidatorsRequest
+	1,   // 54: ethereum.beacon.rpc.v1.PandoraBlockHashConfirmation.status:type_name -> ethereum.beacon.rpc.v1.PandoraConfirmation.Status
+	84,  // 55: ethereum.beacon.rpc.v1.ConfirmPandoraBlockHashesRequest.confirmations:type_name -> ethereum.beacon.rpc.v1.PandoraBlockHashConfirmation
+	90,  // 56: ethereum.beacon.rpc.v1.SubnetAssignments.assignments:type_name -> ethereum.beacon.rpc.v1.SubnetAssignment
+	115, // 57: ethereum.beacon.rpc.v1.ValidatorAssignmentsRange.epochs:type_name -> ethereum.eth.v1alpha1.ValidatorAssignments
+	77,  // 58: ethereum.beacon.rpc.v1.ValidatorAssignmentsRange.index_mismatches:type_name -> ethereum.beacon.rpc.v1.ValidatorIndexMismatch
+	94,  // 59: ethereum.beacon.rpc.v1.ValidatorAssignmentsRange.committee_weights:type_name -> ethereum.beacon.rpc.v1.EpochCommitteeWeights
+	95,  // 60: ethereum.beacon.rpc.v1.ValidatorAssignmentsRange.validator_fields:type_name -> ethereum.beacon.rpc.v1.EpochValidatorFields
+	96,  // 61: ethereum.beacon.rpc.v1.ValidatorAssignmentsRange.committee_positions:type_name -> ethereum.beacon.rpc.v1.EpochCommitteePositions
+	78,  // 62: ethereum.beacon.rpc.v1.EpochCommitteeWeights.weights:type_name -> ethereum.beacon.rpc.v1.CommitteeWeight
+	79,  // 63: ethereum.beacon.rpc.v1.EpochValidatorFields.fields:type_name -> ethereum.beacon.rpc.v1.ValidatorFields
+	81,  // 64: ethereum.beacon.rpc.v1.EpochCommitteePositions.positions:type_name -> ethereum.beacon.rpc.v1.CommitteePosition
+	99,  // 65: ethereum.beacon.rpc.v1.StreamEpochInfoRequest.shard:type_name -> ethereum.beacon.rpc.v1.EpochShard
+	98,  // 66: ethereum.beacon.rpc.v1.EpochInfoStreamMessage.request:type_name -> ethereum.beacon.rpc.v1.StreamEpochInfoRequest
+	106, // 67: ethereum.beacon.rpc.v1.ProposerList.proposers:type_name -> ethereum.beacon.rpc.v1.SlotProposer
+	2,   // 68: ethereum.beacon.rpc.v1.BeaconQuery.GetValidatorLiveness:input_type -> ethereum.beacon.rpc.v1.ValidatorLivenessRequest
+	5,   // 69: ethereum.beacon.rpc.v1.BeaconQuery.GetCommitteeRoots:input_type -> ethereum.beacon.rpc.v1.CommitteeRootsRequest
+	8,   // 70: ethereum.beacon.rpc.v1.BeaconQuery.ExplainShuffle:input_type -> ethereum.beacon.rpc.v1.ExplainShuffleRequest
+	10,  // 71: ethereum.beacon.rpc.v1.BeaconQuery.GetStateDiff:input_type -> ethereum.beacon.rpc.v1.GetStateDiffRequest
+	14,  // 72: ethereum.beacon.rpc.v1.BeaconQuery.GetRandaoMixes:input_type -> ethereum.beacon.rpc.v1.RandaoMixesRequest
+	18,  // 73: ethereum.beacon.rpc.v1.BeaconQuery.ListReorgs:input_type -> ethereum.beacon.rpc.v1.ListReorgsRequest
+	18,  // 74: ethereum.beacon.rpc.v1.BeaconQuery.StreamReorgs:input_type -> ethereum.beacon.rpc.v1.ListReorgsRequest
+	117, // 75: ethereum.beacon.rpc.v1.BeaconQuery.GetDuties:input_type -> ethereum.eth.v1alpha1.DutiesRequest
+	21,  // 76: ethereum.beacon.rpc.v1.BeaconQuery.ListValidatorBalanceHistory:input_type -> ethereum.beacon.rpc.v1.ListValidatorBalanceHistoryRequest
+	118, // 77: ethereum.beacon.rpc.v1.BeaconQuery.StreamSlotParticipation:input_type -> google.protobuf.Empty
+	25,  // 78: ethereum.beacon.rpc.v1.BeaconQuery.GetEpochSummary:input_type -> ethereum.beacon.rpc.v1.GetEpochSummaryRequest
+	26,  // 79: ethereum.beacon.rpc.v1.BeaconQuery.ListEpochSummaries:input_type -> ethereum.beacon.rpc.v1.ListEpochSummariesRequest
+	29,  // 80: ethereum.beacon.rpc.v1.BeaconQuery.ListValidatorPublicKeys:input_type -> ethereum.beacon.rpc.v1.ListValidatorPublicKeysRequest
+	32,  // 81: ethereum.beacon.rpc.v1.BeaconQuery.GetValidatorQueueDetails:input_type -> ethereum.beacon.rpc.v1.ValidatorQueueDetailsRequest
+	118, // 82: ethereum.beacon.rpc.v1.BeaconQuery.StreamAnnotatedChainHead:input_type -> google.protobuf.Empty
+	36,  // 83: ethereum.beacon.rpc.v1.BeaconQuery.GetBlockAvailability:input_type -> ethereum.beacon.rpc.v1.BlockAvailabilityRequest
+	118, // 84: ethereum.beacon.rpc.v1.BeaconQuery.GetNetworkConfig:input_type -> google.protobuf.Empty
+	39,  // 85: ethereum.beacon.rpc.v1.BeaconQuery.GetValidatorSetDelta:input_type -> ethereum.beacon.rpc.v1.GetValidatorSetDeltaRequest
+	42,  // 86: ethereum.beacon.rpc.v1.BeaconQuery.PrefetchEpochInfo:input_type -> ethereum.beacon.rpc.v1.PrefetchEpochInfoRequest
+	44,  // 87: ethereum.beacon.rpc.v1.BeaconQuery.GetPrefetchStatus:input_type -> ethereum.beacon.rpc.v1.GetPrefetchStatusRequest
+	47,  // 88: ethereum.beacon.rpc.v1.BeaconQuery.ListCanonicalBlockRoots:input_type -> ethereum.beacon.rpc.v1.ListCanonicalBlockRootsRequest
+	50,  // 89: ethereum.beacon.rpc.v1.BeaconQuery.StreamProposerAudit:input_type -> ethereum.beacon.rpc.v1.StreamProposerAuditRequest
+	52,  // 90: ethereum.beacon.rpc.v1.BeaconQuery.UpdateTrackedValidators:input_type -> ethereum.beacon.rpc.v1.TrackValidatorsRequest
+	54,  // 91: ethereum.beacon.rpc.v1.BeaconQuery.ImportTrackedValidators:input_type -> ethereum.beacon.rpc.v1.ImportTrackedValidatorsRequest
+	118, // 92: ethereum.beacon.rpc.v1.BeaconQuery.ExportTrackedValidators:input_type -> google.protobuf.Empty
+	56,  // 93: ethereum.beacon.rpc.v1.BeaconQuery.ListPoolAttestations:input_type -> ethereum.beacon.rpc.v1.ListPoolAttestationsRequest
+	118, // 94: ethereum.beacon.rpc.v1.BeaconQuery.GetEth1DataStatus:input_type -> google.protobuf.Empty
+	118, // 95: ethereum.beacon.rpc.v1.BeaconQuery.StreamDepositInclusions:input_type -> google.protobuf.Empty
+	61,  // 96: ethereum.beacon.rpc.v1.BeaconQuery.SimulateEpochTransition:input_type -> ethereum.beacon.rpc.v1.SimulateEpochTransitionRequest
+	64,  // 97: ethereum.beacon.rpc.v1.BeaconQuery.ListCanonicalBlockHeaders:input_type -> ethereum.beacon.rpc.v1.ListCanonicalBlockHeadersRequest
+	68,  // 98: ethereum.beacon.rpc.v1.BeaconQuery.GetBlockByPandoraHash:input_type -> ethereum.beacon.rpc.v1.GetBlockByPandoraHashRequest
+	70,  // 99: ethereum.beacon.rpc.v1.BeaconQuery.GetProposerListConsistency:input_type -> ethereum.beacon.rpc.v1.ProposerListConsistencyRequest
+	118, // 100: ethereum.beacon.rpc.v1.BeaconQuery.GetCurrentEpochParticipation:input_type -> google.protobuf.Empty
+	75,  // 101: ethereum.beacon.rpc.v1.BeaconQuery.ListAnnotatedValidatorAssignments:input_type -> ethereum.beacon.rpc.v1.AnnotatedValidatorAssignmentsRequest
+	119, // 102: ethereum.beacon.rpc.v1.BeaconQuery.ListTrackedValidatorBalances:input_type -> ethereum.eth.v1alpha1.ListValidatorBalancesRequest
+	120, // 103: ethereum.beacon.rpc.v1.BeaconQuery.GetTrackedValidatorPerformance:input_type -> ethereum.eth.v1alpha1.ValidatorPerformanceRequest
+	80,  // 104: ethereum.beacon.rpc.v1.BeaconQuery.ListValidatorsByWithdrawalCredentials:input_type -> ethereum.beacon.rpc.v1.ValidatorsByWithdrawalCredentialsRequest
+	82,  // 105: ethereum.beacon.rpc.v1.BeaconQuery.EstimateStorageGrowth:input_type -> ethereum.beacon.rpc.v1.StorageGrowthRequest
+	85,  // 106: ethereum.beacon.rpc.v1.BeaconQuery.ConfirmPandoraBlockHashes:input_type -> ethereum.beacon.rpc.v1.ConfirmPandoraBlockHashesRequest
+	87,  // 107: ethereum.beacon.rpc.v1.BeaconQuery.GetProposerStats:input_type -> ethereum.beacon.rpc.v1.ProposerStatsRequest
+	89,  // 108: ethereum.beacon.rpc.v1.BeaconQuery.GetSubnetAssignments:input_type -> ethereum.beacon.rpc.v1.SubnetAssignmentsRequest
+	92,  // 109: ethereum.beacon.rpc.v1.BeaconQuery.ListValidatorAssignmentsRange:input_type -> ethereum.beacon.rpc.v1.ListValidatorAssignmentsRangeRequest
+	118, // 110: ethereum.beacon.rpc.v1.BeaconQuery.GetPrecomputationStatus:input_type -> google.protobuf.Empty
+	100, // 111: ethereum.beacon.rpc.v1.BeaconQuery.StreamEpochInfo:input_type -> ethereum.beacon.rpc.v1.EpochInfoStreamMessage
+	102, // 112: ethereum.beacon.rpc.v1.BeaconQuery.GetEpochInfoAccumulator:input_type -> ethereum.beacon.rpc.v1.EpochInfoAccumulatorRequest
+	104, // 113: ethereum.beacon.rpc.v1.BeaconQuery.NextEpochProposerList:input_type -> ethereum.beacon.rpc.v1.ProposerListRequest
+	3,   // 114: ethereum.beacon.rpc.v1.BeaconQuery.GetValidatorLiveness:output_type -> ethereum.beacon.rpc.v1.ValidatorLivenessResponse
+	6,   // 115: ethereum.beacon.rpc.v1.BeaconQuery.GetCommitteeRoots:output_type -> ethereum.beacon.rpc.v1.CommitteeRoots
+	9,   // 116: ethereum.beacon.rpc.v1.BeaconQuery.ExplainShuffle:output_type -> ethereum.beacon.rpc.v1.ShuffleExplanation
+	11,  // 117: ethereum.beacon.rpc.v1.BeaconQuery.GetStateDiff:output_type -> ethereum.beacon.rpc.v1.StateDiff
+	15,  // 118: ethereum.beacon.rpc.v1.BeaconQuery.GetRandaoMixes:output_type -> ethereum.beacon.rpc.v1.RandaoMixes
+	19,  // 119: ethereum.beacon.rpc.v1.BeaconQuery.ListReorgs:output_type -> ethereum.beacon.rpc.v1.Reorgs
+	20,  // 120: ethereum.beacon.rpc.v1.BeaconQuery.StreamReorgs:output_type -> ethereum.beacon.rpc.v1.ReorgEvent
+	121, // 121: ethereum.beacon.rpc.v1.BeaconQuery.GetDuties:output_type -> ethereum.eth.v1alpha1.DutiesResponse
+	22,  // 122: ethereum.beacon.rpc.v1.BeaconQuery.ListValidatorBalanceHistory:output_type -> ethereum.beacon.rpc.v1.ValidatorBalanceHistory
+	24,  // 123: ethereum.beacon.rpc.v1.BeaconQuery.StreamSlotParticipation:output_type -> ethereum.beacon.rpc.v1.SlotParticipation
+	28,  // 124: ethereum.beacon.rpc.v1.BeaconQuery.GetEpochSummary:output_type -> ethereum.beacon.rpc.v1.EpochSummary
+	27,  // 125: ethereum.beacon.rpc.v1.BeaconQuery.ListEpochSummaries:output_type -> ethereum.beacon.rpc.v1.EpochSummaries
+	30,  // 126: ethereum.beacon.rpc.v1.BeaconQuery.ListValidatorPublicKeys:output_type -> ethereum.beacon.rpc.v1.ValidatorPublicKeys
+	33,  // 127: ethereum.beacon.rpc.v1.BeaconQuery.GetValidatorQueueDetails:output_type -> ethereum.beacon.rpc.v1.ValidatorQueueDetails
+	35,  // 128: ethereum.beacon.rpc.v1.BeaconQuery.StreamAnnotatedChainHead:output_type -> ethereum.beacon.rpc.v1.AnnotatedChainHead
+	37,  // 129: ethereum.beacon.rpc.v1.BeaconQuery.GetBlockAvailability:output_type -> ethereum.beacon.rpc.v1.BlockAvailability
+	38,  // 130: ethereum.beacon.rpc.v1.BeaconQuery.GetNetworkConfig:output_type -> ethereum.beacon.rpc.v1.NetworkConfig
+	41,  // 131: ethereum.beacon.rpc.v1.BeaconQuery.GetValidatorSetDelta:output_type -> ethereum.beacon.rpc.v1.ValidatorSetDelta
+	43,  // 132: ethereum.beacon.rpc.v1.BeaconQuery.PrefetchEpochInfo:output_type -> ethereum.beacon.rpc.v1.PrefetchJob
+	45,  // 133: ethereum.beacon.rpc.v1.BeaconQuery.GetPrefetchStatus:output_type -> ethereum.beacon.rpc.v1.PrefetchStatus
+	48,  // 134: ethereum.beacon.rpc.v1.BeaconQuery.ListCanonicalBlockRoots:output_type -> ethereum.beacon.rpc.v1.CanonicalBlockRoots
+	51,  // 135: ethereum.beacon.rpc.v1.BeaconQuery.StreamProposerAudit:output_type -> ethereum.beacon.rpc.v1.ProposerAudit
+	53,  // 136: ethereum.beacon.rpc.v1.BeaconQuery.UpdateTrackedValidators:output_type -> ethereum.beacon.rpc.v1.TrackedValidatorsResponse
+	53,  // 137: ethereum.beacon.rpc.v1.BeaconQuery.ImportTrackedValidators:output_type -> ethereum.beacon.rpc.v1.TrackedValidatorsResponse
+	55,  // 138: ethereum.beacon.rpc.v1.BeaconQuery.ExportTrackedValidators:output_type -> ethereum.beacon.rpc.v1.TrackedValidatorsExport
+	57,  // 139: ethereum.beacon.rpc.v1.BeaconQuery.ListPoolAttestations:output_type -> ethereum.beacon.rpc.v1.PoolAttestations
+	59,  // 140: ethereum.beacon.rpc.v1.BeaconQuery.GetEth1DataStatus:output_type -> ethereum.beacon.rpc.v1.Eth1DataStatus
+	60,  // 141: ethereum.beacon.rpc.v1.BeaconQuery.StreamDepositInclusions:output_type -> ethereum.beacon.rpc.v1.DepositInclusion
+	62,  // 142: ethereum.beacon.rpc.v1.BeaconQuery.SimulateEpochTransition:output_type -> ethereum.beacon.rpc.v1.EpochTransitionSimulation
+	65,  // 143: ethereum.beacon.rpc.v1.BeaconQuery.ListCanonicalBlockHeaders:output_type -> ethereum.beacon.rpc.v1.CanonicalBlockHeaders
+	69,  // 144: ethereum.beacon.rpc.v1.BeaconQuery.GetBlockByPandoraHash:output_type -> ethereum.beacon.rpc.v1.PairedBlock
+	71,  // 145: ethereum.beacon.rpc.v1.BeaconQuery.GetProposerListConsistency:output_type -> ethereum.beacon.rpc.v1.ProposerListConsistency
+	74,  // 146: ethereum.beacon.rpc.v1.BeaconQuery.GetCurrentEpochParticipation:output_type -> ethereum.beacon.rpc.v1.CurrentEpochParticipation
+	76,  // 147: ethereum.beacon.rpc.v1.BeaconQuery.ListAnnotatedValidatorAssignments:output_type -> ethereum.beacon.rpc.v1.AnnotatedValidatorAssignments
+	122, // 148: ethereum.beacon.rpc.v1.BeaconQuery.ListTrackedValidatorBalances:output_type -> ethereum.eth.v1alpha1.ValidatorBalances
+	123, // 149: ethereum.beacon.rpc.v1.BeaconQuery.GetTrackedValidatorPerformance:output_type -> ethereum.eth.v1alpha1.ValidatorPerformanceResponse
+	124, // 150: ethereum.beacon.rpc.v1.BeaconQuery.ListValidatorsByWithdrawalCredentials:output_type -> ethereum.eth.v1alpha1.Validators
+	83,  // 151: ethereum.beacon.rpc.v1.BeaconQuery.EstimateStorageGrowth:output_type -> ethereum.beacon.rpc.v1.StorageGrowthEstimate
+	86,  // 152: ethereum.beacon.rpc.v1.BeaconQuery.ConfirmPandoraBlockHashes:output_type -> ethereum.beacon.rpc.v1.ConfirmPandoraBlockHashesResponse
+	88,  // 153: ethereum.beacon.rpc.v1.BeaconQuery.GetProposerStats:output_type -> ethereum.beacon.rpc.v1.ProposerStats
+	91,  // 154: ethereum.beacon.rpc.v1.BeaconQuery.GetSubnetAssignments:output_type -> ethereum.beacon.rpc.v1.SubnetAssignments
+	93,  // 155: ethereum.beacon.rpc.v1.BeaconQuery.ListValidatorAssignmentsRange:output_type -> ethereum.beacon.rpc.v1.ValidatorAssignmentsRange
+	97,  // 156: ethereum.beacon.rpc.v1.BeaconQuery.GetPrecomputationStatus:output_type -> ethereum.beacon.rpc.v1.PrecomputationStatus
+	101, // 157: ethereum.beacon.rpc.v1.BeaconQuery.StreamEpochInfo:output_type -> ethereum.beacon.rpc.v1.EpochInfo
+	103, // 158: ethereum.beacon.rpc.v1.BeaconQuery.GetEpochInfoAccumulator:output_type -> ethereum.beacon.rpc.v1.EpochInfoAccumulator
+	105, // 159: ethereum.beacon.rpc.v1.BeaconQuery.NextEpochProposerList:output_type -> ethereum.beacon.rpc.v1.ProposerList
+	114, // [114:160] is the sub-list for method output_type
+	68,  // [68:114] is the sub-list for method input_type
+	68,  // [68:68] is the sub-list for extension type_name
+	68,  // [68:68] is the sub-list for extension extendee
+	0,   // [0:68] is the sub-list for field type_name
+}
+
+func init() { file_proto_beacon_rpc_v1_beacon_query_proto_init() }
+func file_proto_beacon_rpc_v1_beacon_query_proto_init() {
+	if File_proto_beacon_rpc_v1_beacon_query_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorLivenessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorLivenessResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorLiveness); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitteeRootsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitteeRoots); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitteeRoot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainShuffleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShuffleExplanation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStateDiffRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceDelta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandaoMixesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandaoMixes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochRandaoMix); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandaoContribution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReorgsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Reorgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReorgEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListValidatorBalanceHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorBalanceHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochBalance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlotParticipation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEpochSummaryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEpochSummariesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochSummaries); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListValidatorPublicKeysRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorPublicKeys); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorPublicKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorQueueDetailsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorQueueDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueuedValidator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnnotatedChainHead); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockAvailabilityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockAvailability); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValidatorSetDeltaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorSetDelta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrefetchEpochInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrefetchJob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPrefetchStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrefetchStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochGap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCanonicalBlockRootsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CanonicalBlockRoots); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CanonicalBlockRoot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamProposerAuditRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposerAudit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackValidatorsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackedValidatorsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportTrackedValidatorsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackedValidatorsExport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPoolAttestationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolAttestations); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolCommitteeAttestations); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Eth1DataStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositInclusion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulateEpochTransitionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochTransitionSimulation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorPenalty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCanonicalBlockHeadersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CanonicalBlockHeaders); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CanonicalBlockHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PandoraConfirmation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockByPandoraHashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairedBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposerListConsistencyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposerListConsistency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitteeParticipation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlotCommitteeParticipation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CurrentEpochParticipation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnnotatedValidatorAssignmentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnnotatedValidatorAssignments); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorIndexMismatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitteeWeight); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorFields); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorsByWithdrawalCredentialsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitteePosition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageGrowthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageGrowthEstimate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PandoraBlockHashConfirmation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmPandoraBlockHashesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmPandoraBlockHashesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposerStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposerStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubnetAssignmentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubnetAssignment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubnetAssignments); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListValidatorAssignmentsRangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorAssignmentsRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochCommitteeWeights); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochValidatorFields); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochCommitteePositions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrecomputationStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamEpochInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochShard); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochInfoStreamMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochInfoAccumulatorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochInfoAccumulator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposerListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposerList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlotProposer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[54].OneofWrappers = []interface{}{
+		(*ListPoolAttestationsRequest_Slot)(nil),
+		(*ListPoolAttestationsRequest_CommitteeIndex)(nil),
+	}
+	file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[59].OneofWrappers = []interface{}{
+		(*SimulateEpochTransitionRequest_StateRoot)(nil),
+		(*SimulateEpochTransitionRequest_Slot)(nil),
+	}
+	file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[73].OneofWrappers = []interface{}{
+		(*AnnotatedValidatorAssignmentsRequest_Epoch)(nil),
+		(*AnnotatedValidatorAssignmentsRequest_Genesis)(nil),
+		(*AnnotatedValidatorAssignmentsRequest_BlockRoot)(nil),
+		(*AnnotatedValidatorAssignmentsRequest_StateRoot)(nil),
+	}
+	file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[96].OneofWrappers = []interface{}{
+		(*StreamEpochInfoRequest_ResumeFromEpoch)(nil),
+	}
+	file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[98].OneofWrappers = []interface{}{
+		(*EpochInfoStreamMessage_Request)(nil),
+		(*EpochInfoStreamMessage_AckEpoch)(nil),
+	}
+	file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[100].OneofWrappers = []interface{}{
+		(*EpochInfoAccumulatorRequest_Epoch)(nil),
+	}
+	file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes[102].OneofWrappers = []interface{}{
+		(*ProposerListRequest_Epoch)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_beacon_rpc_v1_beacon_query_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   105,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_beacon_rpc_v1_beacon_query_proto_goTypes,
+		DependencyIndexes: file_proto_beacon_rpc_v1_beacon_query_proto_depIdxs,
+		EnumInfos:         file_proto_beacon_rpc_v1_beacon_query_proto_enumTypes,
+		MessageInfos:      file_proto_beacon_rpc_v1_beacon_query_proto_msgTypes,
+	}.Build()
+	File_proto_beacon_rpc_v1_beacon_query_proto = out.File
+	file_proto_beacon_rpc_v1_beacon_query_proto_rawDesc = nil
+	file_proto_beacon_rpc_v1_beacon_query_proto_goTypes = nil
+	file_proto_beacon_rpc_v1_beacon_query_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// BeaconQueryClient is the client API for BeaconQuery service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BeaconQueryClient interface {
+	GetValidatorLiveness(ctx context.Context, in *ValidatorLivenessRequest, opts ...grpc.CallOption) (*ValidatorLivenessResponse, error)
+	GetCommitteeRoots(ctx context.Context, in *CommitteeRootsRequest, opts ...grpc.CallOption) (*CommitteeRoots, error)
+	ExplainShuffle(ctx context.Context, in *ExplainShuffleRequest, opts ...grpc.CallOption) (*ShuffleExplanation, error)
+	GetStateDiff(ctx context.Context, in *GetStateDiffRequest, opts ...grpc.CallOption) (*StateDiff, error)
+	GetRandaoMixes(ctx context.Context, in *RandaoMixesRequest, opts ...grpc.CallOption) (*RandaoMixes, error)
+	ListReorgs(ctx context.Context, in *ListReorgsRequest, opts ...grpc.CallOption) (*Reorgs, error)
+	StreamReorgs(ctx context.Context, in *ListReorgsRequest, opts ...grpc.CallOption) (BeaconQuery_StreamReorgsClient, error)
+	GetDuties(ctx context.Context, in *v1alpha1.DutiesRequest, opts ...grpc.CallOption) (*v1alpha1.DutiesResponse, error)
+	ListValidatorBalanceHistory(ctx context.Context, in *ListValidatorBalanceHistoryRequest, opts ...grpc.CallOption) (*ValidatorBalanceHistory, error)
+	StreamSlotParticipation(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconQuery_StreamSlotParticipationClient, error)
+	GetEpochSummary(ctx context.Context, in *GetEpochSummaryRequest, opts ...grpc.CallOption) (*EpochSummary, error)
+	ListEpochSummaries(ctx context.Context, in *ListEpochSummariesRequest, opts ...grpc.CallOption) (*EpochSummaries, error)
+	ListValidatorPublicKeys(ctx context.Context, in *ListValidatorPublicKeysRequest, opts ...grpc.CallOption) (*ValidatorPublicKeys, error)
+	GetValidatorQueueDetails(ctx context.Context, in *ValidatorQueueDetailsRequest, opts ...grpc.CallOption) (*ValidatorQueueDetails, error)
+	StreamAnnotatedChainHead(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconQuery_StreamAnnotatedChainHeadClient, error)
+	GetBlockAvailability(ctx context.Context, in *BlockAvailabilityRequest, opts ...grpc.CallOption) (*BlockAvailability, error)
+	GetNetworkConfig(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*NetworkConfig, error)
+	GetValidatorSetDelta(ctx context.Context, in *GetValidatorSetDeltaRequest, opts ...grpc.CallOption) (*ValidatorSetDelta, error)
+	PrefetchEpochInfo(ctx context.Context, in *PrefetchEpochInfoRequest, opts ...grpc.CallOption) (*PrefetchJob, error)
+	GetPrefetchStatus(ctx context.Context, in *GetPrefetchStatusRequest, opts ...grpc.CallOption) (*PrefetchStatus, error)
+	ListCanonicalBlockRoots(ctx context.Context, in *ListCanonicalBlockRootsRequest, opts ...grpc.CallOption) (*CanonicalBlockRoots, error)
+	StreamProposerAudit(ctx context.Context, in *StreamProposerAuditRequest, opts ...grpc.CallOption) (BeaconQuery_StreamProposerAuditClient, error)
+	UpdateTrackedValidators(ctx context.Context, in *TrackValidatorsRequest, opts ...grpc.CallOption) (*TrackedValidatorsResponse, error)
+	ImportTrackedValidators(ctx context.Context, in *ImportTrackedValidatorsRequest, opts ...grpc.CallOption) (*TrackedValidatorsResponse, error)
+	ExportTrackedValidators(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*TrackedValidatorsExport, error)
+	ListPoolAttestations(ctx context.Context, in *ListPoolAttestationsRequest, opts ...grpc.CallOption) (*PoolAttestations, error)
+	GetEth1DataStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Eth1DataStatus, error)
+	StreamDepositInclusions(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconQuery_StreamDepositInclusionsClient, error)
+	SimulateEpochTransition(ctx context.Context, in *SimulateEpochTransitionRequest, opts ...grpc.CallOption) (*EpochTransitionSimulation, error)
+	ListCanonicalBlockHeaders(ctx context.Context, in *ListCanonicalBlockHeadersRequest, opts ...grpc.CallOption) (*CanonicalBlockHeaders, error)
+	GetBlockByPandoraHash(ctx context.Context, in *GetBlockByPandoraHashRequest, opts ...grpc.CallOption) (*PairedBlock, error)
+	GetProposerListConsistency(ctx context.Context, in *ProposerListConsistencyRequest, opts ...grpc.CallOption) (*ProposerListConsistency, error)
+	GetCurrentEpochParticipation(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CurrentEpochParticipation, error)
+	ListAnnotatedValidatorAssignments(ctx context.Context, in *AnnotatedValidatorAssignmentsRequest, opts ...grpc.CallOption) (*AnnotatedValidatorAssignments, error)
+	ListTrackedValidatorBalances(ctx context.Context, in *v1alpha1.ListValidatorBalancesRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorBalances, error)
+	GetTrackedValidatorPerformance(ctx context.Context, in *v1alpha1.ValidatorPerformanceRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorPerformanceResponse, error)
+	ListValidatorsByWithdrawalCredentials(ctx context.Context, in *ValidatorsByWithdrawalCredentialsRequest, opts ...grpc.CallOption) (*v1alpha1.Validators, error)
+	EstimateStorageGrowth(ctx context.Context, in *StorageGrowthRequest, opts ...grpc.CallOption) (*StorageGrowthEstimate, error)
+	ConfirmPandoraBlockHashes(ctx context.Context, in *ConfirmPandoraBlockHashesRequest, opts ...grpc.CallOption) (*ConfirmPandoraBlockHashesResponse, error)
+	GetProposerStats(ctx context.Context, in *ProposerStatsRequest, opts ...grpc.CallOption) (*ProposerStats, error)
+	GetSubnetAssignments(ctx context.Context, in *SubnetAssignmentsRequest, opts ...grpc.CallOption) (*SubnetAssignments, error)
+	ListValidatorAssignmentsRange(ctx context.Context, in *ListValidatorAssignmentsRangeRequest, opts ...grpc.CallOption) (*ValidatorAssignmentsRange, error)
+	GetPrecomputationStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PrecomputationStatus, error)
+	StreamEpochInfo(ctx context.Context, opts ...grpc.CallOption) (BeaconQuery_StreamEpochInfoClient, error)
+	GetEpochInfoAccumulator(ctx context.Context, in *EpochInfoAccumulatorRequest, opts ...grpc.CallOption) (*EpochInfoAccumulator, error)
+	NextEpochProposerList(ctx context.Context, in *ProposerListRequest, opts ...grpc.CallOption) (*ProposerList, error)
+}
+
+type beaconQueryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBeaconQueryClient(cc grpc.ClientConnInterface) BeaconQueryClient {
+	return &beaconQueryClient{cc}
+}
+
+func (c *beaconQueryClient) GetValidatorLiveness(ctx context.Context, in *ValidatorLivenessRequest, opts ...grpc.CallOption) (*ValidatorLivenessResponse, error) {
+	out := new(ValidatorLivenessResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetValidatorLiveness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetCommitteeRoots(ctx context.Context, in *CommitteeRootsRequest, opts ...grpc.CallOption) (*CommitteeRoots, error) {
+	out := new(CommitteeRoots)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetCommitteeRoots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ExplainShuffle(ctx context.Context, in *ExplainShuffleRequest, opts ...grpc.CallOption) (*ShuffleExplanation, error) {
+	out := new(ShuffleExplanation)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ExplainShuffle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetStateDiff(ctx context.Context, in *GetStateDiffRequest, opts ...grpc.CallOption) (*StateDiff, error) {
+	out := new(StateDiff)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetStateDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetRandaoMixes(ctx context.Context, in *RandaoMixesRequest, opts ...grpc.CallOption) (*RandaoMixes, error) {
+	out := new(RandaoMixes)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetRandaoMixes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ListReorgs(ctx context.Context, in *ListReorgsRequest, opts ...grpc.CallOption) (*Reorgs, error) {
+	out := new(Reorgs)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ListReorgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) StreamReorgs(ctx context.Context, in *ListReorgsRequest, opts ...grpc.CallOption) (BeaconQuery_StreamReorgsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconQuery_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.BeaconQuery/StreamReorgs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconQueryStreamReorgsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconQuery_StreamReorgsClient interface {
+	Recv() (*ReorgEvent, error)
+	grpc.ClientStream
+}
+
+type beaconQueryStreamReorgsClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconQueryStreamReorgsClient) Recv() (*ReorgEvent, error) {
+	m := new(ReorgEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconQueryClient) GetDuties(ctx context.Context, in *v1alpha1.DutiesRequest, opts ...grpc.CallOption) (*v1alpha1.DutiesResponse, error) {
+	out := new(v1alpha1.DutiesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetDuties", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ListValidatorBalanceHistory(ctx context.Context, in *ListValidatorBalanceHistoryRequest, opts ...grpc.CallOption) (*ValidatorBalanceHistory, error) {
+	out := new(ValidatorBalanceHistory)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ListValidatorBalanceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) StreamSlotParticipation(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconQuery_StreamSlotParticipationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconQuery_serviceDesc.Streams[1], "/ethereum.beacon.rpc.v1.BeaconQuery/StreamSlotParticipation", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconQueryStreamSlotParticipationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconQuery_StreamSlotParticipationClient interface {
+	Recv() (*SlotParticipation, error)
+	grpc.ClientStream
+}
+
+type beaconQueryStreamSlotParticipationClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconQueryStreamSlotParticipationClient) Recv() (*SlotParticipation, error) {
+	m := new(SlotParticipation)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconQueryClient) GetEpochSummary(ctx context.Context, in *GetEpochSummaryRequest, opts ...grpc.CallOption) (*EpochSummary, error) {
+	out := new(EpochSummary)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetEpochSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ListEpochSummaries(ctx context.Context, in *ListEpochSummariesRequest, opts ...grpc.CallOption) (*EpochSummaries, error) {
+	out := new(EpochSummaries)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ListEpochSummaries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ListValidatorPublicKeys(ctx context.Context, in *ListValidatorPublicKeysRequest, opts ...grpc.CallOption) (*ValidatorPublicKeys, error) {
+	out := new(ValidatorPublicKeys)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ListValidatorPublicKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetValidatorQueueDetails(ctx context.Context, in *ValidatorQueueDetailsRequest, opts ...grpc.CallOption) (*ValidatorQueueDetails, error) {
+	out := new(ValidatorQueueDetails)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetValidatorQueueDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) StreamAnnotatedChainHead(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconQuery_StreamAnnotatedChainHeadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconQuery_serviceDesc.Streams[2], "/ethereum.beacon.rpc.v1.BeaconQuery/StreamAnnotatedChainHead", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconQueryStreamAnnotatedChainHeadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconQuery_StreamAnnotatedChainHeadClient interface {
+	Recv() (*AnnotatedChainHead, error)
+	grpc.ClientStream
+}
+
+type beaconQueryStreamAnnotatedChainHeadClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconQueryStreamAnnotatedChainHeadClient) Recv() (*AnnotatedChainHead, error) {
+	m := new(AnnotatedChainHead)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconQueryClient) GetBlockAvailability(ctx context.Context, in *BlockAvailabilityRequest, opts ...grpc.CallOption) (*BlockAvailability, error) {
+	out := new(BlockAvailability)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetBlockAvailability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetNetworkConfig(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*NetworkConfig, error) {
+	out := new(NetworkConfig)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetNetworkConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetValidatorSetDelta(ctx context.Context, in *GetValidatorSetDeltaRequest, opts ...grpc.CallOption) (*ValidatorSetDelta, error) {
+	out := new(ValidatorSetDelta)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetValidatorSetDelta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) PrefetchEpochInfo(ctx context.Context, in *PrefetchEpochInfoRequest, opts ...grpc.CallOption) (*PrefetchJob, error) {
+	out := new(PrefetchJob)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/PrefetchEpochInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetPrefetchStatus(ctx context.Context, in *GetPrefetchStatusRequest, opts ...grpc.CallOption) (*PrefetchStatus, error) {
+	out := new(PrefetchStatus)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetPrefetchStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ListCanonicalBlockRoots(ctx context.Context, in *ListCanonicalBlockRootsRequest, opts ...grpc.CallOption) (*CanonicalBlockRoots, error) {
+	out := new(CanonicalBlockRoots)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ListCanonicalBlockRoots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) StreamProposerAudit(ctx context.Context, in *StreamProposerAuditRequest, opts ...grpc.CallOption) (BeaconQuery_StreamProposerAuditClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconQuery_serviceDesc.Streams[3], "/ethereum.beacon.rpc.v1.BeaconQuery/StreamProposerAudit", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconQueryStreamProposerAuditClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconQuery_StreamProposerAuditClient interface {
+	Recv() (*ProposerAudit, error)
+	grpc.ClientStream
+}
+
+type beaconQueryStreamProposerAuditClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconQueryStreamProposerAuditClient) Recv() (*ProposerAudit, error) {
+	m := new(ProposerAudit)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconQueryClient) UpdateTrackedValidators(ctx context.Context, in *TrackValidatorsRequest, opts ...grpc.CallOption) (*TrackedValidatorsResponse, error) {
+	out := new(TrackedValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/UpdateTrackedValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ImportTrackedValidators(ctx context.Context, in *ImportTrackedValidatorsRequest, opts ...grpc.CallOption) (*TrackedValidatorsResponse, error) {
+	out := new(TrackedValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ImportTrackedValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ExportTrackedValidators(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*TrackedValidatorsExport, error) {
+	out := new(TrackedValidatorsExport)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ExportTrackedValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ListPoolAttestations(ctx context.Context, in *ListPoolAttestationsRequest, opts ...grpc.CallOption) (*PoolAttestations, error) {
+	out := new(PoolAttestations)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ListPoolAttestations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetEth1DataStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Eth1DataStatus, error) {
+	out := new(Eth1DataStatus)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetEth1DataStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) StreamDepositInclusions(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconQuery_StreamDepositInclusionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconQuery_serviceDesc.Streams[4], "/ethereum.beacon.rpc.v1.BeaconQuery/StreamDepositInclusions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconQueryStreamDepositInclusionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconQuery_StreamDepositInclusionsClient interface {
+	Recv() (*DepositInclusion, error)
+	grpc.ClientStream
+}
+
+type beaconQueryStreamDepositInclusionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconQueryStreamDepositInclusionsClient) Recv() (*DepositInclusion, error) {
+	m := new(DepositInclusion)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconQueryClient) SimulateEpochTransition(ctx context.Context, in *SimulateEpochTransitionRequest, opts ...grpc.CallOption) (*EpochTransitionSimulation, error) {
+	out := new(EpochTransitionSimulation)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/SimulateEpochTransition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ListCanonicalBlockHeaders(ctx context.Context, in *ListCanonicalBlockHeadersRequest, opts ...grpc.CallOption) (*CanonicalBlockHeaders, error) {
+	out := new(CanonicalBlockHeaders)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ListCanonicalBlockHeaders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetBlockByPandoraHash(ctx context.Context, in *GetBlockByPandoraHashRequest, opts ...grpc.CallOption) (*PairedBlock, error) {
+	out := new(PairedBlock)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetBlockByPandoraHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetProposerListConsistency(ctx context.Context, in *ProposerListConsistencyRequest, opts ...grpc.CallOption) (*ProposerListConsistency, error) {
+	out := new(ProposerListConsistency)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetProposerListConsistency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetCurrentEpochParticipation(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CurrentEpochParticipation, error) {
+	out := new(CurrentEpochParticipation)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetCurrentEpochParticipation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ListAnnotatedValidatorAssignments(ctx context.Context, in *AnnotatedValidatorAssignmentsRequest, opts ...grpc.CallOption) (*AnnotatedValidatorAssignments, error) {
+	out := new(AnnotatedValidatorAssignments)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ListAnnotatedValidatorAssignments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ListTrackedValidatorBalances(ctx context.Context, in *v1alpha1.ListValidatorBalancesRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorBalances, error) {
+	out := new(v1alpha1.ValidatorBalances)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ListTrackedValidatorBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetTrackedValidatorPerformance(ctx context.Context, in *v1alpha1.ValidatorPerformanceRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorPerformanceResponse, error) {
+	out := new(v1alpha1.ValidatorPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetTrackedValidatorPerformance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ListValidatorsByWithdrawalCredentials(ctx context.Context, in *ValidatorsByWithdrawalCredentialsRequest, opts ...grpc.CallOption) (*v1alpha1.Validators, error) {
+	out := new(v1alpha1.Validators)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ListValidatorsByWithdrawalCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) EstimateStorageGrowth(ctx context.Context, in *StorageGrowthRequest, opts ...grpc.CallOption) (*StorageGrowthEstimate, error) {
+	out := new(StorageGrowthEstimate)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/EstimateStorageGrowth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ConfirmPandoraBlockHashes(ctx context.Context, in *ConfirmPandoraBlockHashesRequest, opts ...grpc.CallOption) (*ConfirmPandoraBlockHashesResponse, error) {
+	out := new(ConfirmPandoraBlockHashesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ConfirmPandoraBlockHashes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetProposerStats(ctx context.Context, in *ProposerStatsRequest, opts ...grpc.CallOption) (*ProposerStats, error) {
+	out := new(ProposerStats)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetProposerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetSubnetAssignments(ctx context.Context, in *SubnetAssignmentsRequest, opts ...grpc.CallOption) (*SubnetAssignments, error) {
+	out := new(SubnetAssignments)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetSubnetAssignments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) ListValidatorAssignmentsRange(ctx context.Context, in *ListValidatorAssignmentsRangeRequest, opts ...grpc.CallOption) (*ValidatorAssignmentsRange, error) {
+	out := new(ValidatorAssignmentsRange)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/ListValidatorAssignmentsRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) GetPrecomputationStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PrecomputationStatus, error) {
+	out := new(PrecomputationStatus)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetPrecomputationStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) StreamEpochInfo(ctx context.Context, opts ...grpc.CallOption) (BeaconQuery_StreamEpochInfoClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconQuery_serviceDesc.Streams[5], "/ethereum.beacon.rpc.v1.BeaconQuery/StreamEpochInfo", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconQueryStreamEpochInfoClient{stream}
+	return x, nil
+}
+
+type BeaconQuery_StreamEpochInfoClient interface {
+	Send(*EpochInfoStreamMessage) error
+	Recv() (*EpochInfo, error)
+	grpc.ClientStream
+}
+
+type beaconQueryStreamEpochInfoClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconQueryStreamEpochInfoClient) Send(m *EpochInfoStreamMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *beaconQueryStreamEpochInfoClient) Recv() (*EpochInfo, error) {
+	m := new(EpochInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconQueryClient) GetEpochInfoAccumulator(ctx context.Context, in *EpochInfoAccumulatorRequest, opts ...grpc.CallOption) (*EpochInfoAccumulator, error) {
+	out := new(EpochInfoAccumulator)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/GetEpochInfoAccumulator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconQueryClient) NextEpochProposerList(ctx context.Context, in *ProposerListRequest, opts ...grpc.CallOption) (*ProposerList, error) {
+	out := new(ProposerList)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconQuery/NextEpochProposerList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BeaconQueryServer is the server API for BeaconQuery service.
+type BeaconQueryServer interface {
+	GetValidatorLiveness(context.Context, *ValidatorLivenessRequest) (*ValidatorLivenessResponse, error)
+	GetCommitteeRoots(context.Context, *CommitteeRootsRequest) (*CommitteeRoots, error)
+	ExplainShuffle(context.Context, *ExplainShuffleRequest) (*ShuffleExplanation, error)
+	GetStateDiff(context.Context, *GetStateDiffRequest) (*StateDiff, error)
+	GetRandaoMixes(context.Context, *RandaoMixesRequest) (*RandaoMixes, error)
+	ListReorgs(context.Context, *ListReorgsRequest) (*Reorgs, error)
+	StreamReorgs(*ListReorgsRequest, BeaconQuery_StreamReorgsServer) error
+	GetDuties(context.Context, *v1alpha1.DutiesRequest) (*v1alpha1.DutiesResponse, error)
+	ListValidatorBalanceHistory(context.Context, *ListValidatorBalanceHistoryRequest) (*ValidatorBalanceHistory, error)
+	StreamSlotParticipation(*empty.Empty, BeaconQuery_StreamSlotParticipationServer) error
+	GetEpochSummary(context.Context, *GetEpochSummaryRequest) (*EpochSummary, error)
+	ListEpochSummaries(context.Context, *ListEpochSummariesRequest) (*EpochSummaries, error)
+	ListValidatorPublicKeys(context.Context, *ListValidatorPublicKeysRequest) (*ValidatorPublicKeys, error)
+	GetValidatorQueueDetails(context.Context, *ValidatorQueueDetailsRequest) (*ValidatorQueueDetails, error)
+	StreamAnnotatedChainHead(*empty.Empty, BeaconQuery_StreamAnnotatedChainHeadServer) error
+	GetBlockAvailability(context.Context, *BlockAvailabilityRequest) (*BlockAvailability, error)
+	GetNetworkConfig(context.Context, *empty.Empty) (*NetworkConfig, error)
+	GetValidatorSetDelta(context.Context, *GetValidatorSetDeltaRequest) (*ValidatorSetDelta, error)
+	PrefetchEpochInfo(context.Context, *PrefetchEpochInfoRequest) (*PrefetchJob, error)
+	GetPrefetchStatus(context.Context, *GetPrefetchStatusRequest) (*PrefetchStatus, error)
+	ListCanonicalBlockRoots(context.Context, *ListCanonicalBlockRootsRequest) (*CanonicalBlockRoots, error)
+	StreamProposerAudit(*StreamProposerAuditRequest, BeaconQuery_StreamProposerAuditServer) error
+	UpdateTrackedValidators(context.Context, *TrackValidatorsRequest) (*TrackedValidatorsResponse, error)
+	ImportTrackedValidators(context.Context, *ImportTrackedValidatorsRequest) (*TrackedValidatorsResponse, error)
+	ExportTrackedValidators(context.Context, *empty.Empty) (*TrackedValidatorsExport, error)
+	ListPoolAttestations(context.Context, *ListPoolAttestationsRequest) (*PoolAttestations, error)
+	GetEth1DataStatus(context.Context, *empty.Empty) (*Eth1DataStatus, error)
+	StreamDepositInclusions(*empty.Empty, BeaconQuery_StreamDepositInclusionsServer) error
+	SimulateEpochTransition(context.Context, *SimulateEpochTransitionRequest) (*EpochTransitionSimulation, error)
+	ListCanonicalBlockHeaders(context.Context, *ListCanonicalBlockHeadersRequest) (*CanonicalBlockHeaders, error)
+	GetBlockByPandoraHash(context.Context, *GetBlockByPandoraHashRequest) (*PairedBlock, error)
+	GetProposerListConsistency(context.Context, *ProposerListConsistencyRequest) (*ProposerListConsistency, error)
+	GetCurrentEpochParticipation(context.Context, *empty.Empty) (*CurrentEpochParticipation, error)
+	ListAnnotatedValidatorAssignments(context.Context, *AnnotatedValidatorAssignmentsRequest) (*AnnotatedValidatorAssignments, error)
+	ListTrackedValidatorBalances(context.Context, *v1alpha1.ListValidatorBalancesRequest) (*v1alpha1.ValidatorBalances, error)
+	GetTrackedValidatorPerformance(context.Context, *v1alpha1.ValidatorPerformanceRequest) (*v1alpha1.ValidatorPerformanceResponse, error)
+	ListValidatorsByWithdrawalCredentials(context.Context, *ValidatorsByWithdrawalCredentialsRequest) (*v1alpha1.Validators, error)
+	EstimateStorageGrowth(context.Context, *StorageGrowthRequest) (*StorageGrowthEstimate, error)
+	ConfirmPandoraBlockHashes(context.Context, *ConfirmPandoraBlockHashesRequest) (*ConfirmPandoraBlockHashesResponse, error)
+	GetProposerStats(context.Context, *ProposerStatsRequest) (*ProposerStats, error)
+	GetSubnetAssignments(context.Context, *SubnetAssignmentsRequest) (*SubnetAssignments, error)
+	ListValidatorAssignmentsRange(context.Context, *ListValidatorAssignmentsRangeRequest) (*ValidatorAssignmentsRange, error)
+	GetPrecomputationStatus(context.Context, *empty.Empty) (*PrecomputationStatus, error)
+	StreamEpochInfo(BeaconQuery_StreamEpochInfoServer) error
+	GetEpochInfoAccumulator(context.Context, *EpochInfoAccumulatorRequest) (*EpochInfoAccumulator, error)
+	NextEpochProposerList(context.Context, *ProposerListRequest) (*ProposerList, error)
+}
+
+// UnimplementedBeaconQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedBeaconQueryServer struct {
+}
+
+func (*UnimplementedBeaconQueryServer) GetValidatorLiveness(context.Context, *ValidatorLivenessRequest) (*ValidatorLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorLiveness not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetCommitteeRoots(context.Context, *CommitteeRootsRequest) (*CommitteeRoots, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommitteeRoots not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ExplainShuffle(context.Context, *ExplainShuffleRequest) (*ShuffleExplanation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainShuffle not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetStateDiff(context.Context, *GetStateDiffRequest) (*StateDiff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateDiff not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetRandaoMixes(context.Context, *RandaoMixesRequest) (*RandaoMixes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRandaoMixes not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ListReorgs(context.Context, *ListReorgsRequest) (*Reorgs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReorgs not implemented")
+}
+func (*UnimplementedBeaconQueryServer) StreamReorgs(*ListReorgsRequest, BeaconQuery_StreamReorgsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamReorgs not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetDuties(context.Context, *v1alpha1.DutiesRequest) (*v1alpha1.DutiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDuties not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ListValidatorBalanceHistory(context.Context, *ListValidatorBalanceHistoryRequest) (*ValidatorBalanceHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListValidatorBalanceHistory not implemented")
+}
+func (*UnimplementedBeaconQueryServer) StreamSlotParticipation(*empty.Empty, BeaconQuery_StreamSlotParticipationServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamSlotParticipation not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetEpochSummary(context.Context, *GetEpochSummaryRequest) (*EpochSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEpochSummary not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ListEpochSummaries(context.Context, *ListEpochSummariesRequest) (*EpochSummaries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEpochSummaries not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ListValidatorPublicKeys(context.Context, *ListValidatorPublicKeysRequest) (*ValidatorPublicKeys, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListValidatorPublicKeys not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetValidatorQueueDetails(context.Context, *ValidatorQueueDetailsRequest) (*ValidatorQueueDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorQueueDetails not implemented")
+}
+func (*UnimplementedBeaconQueryServer) StreamAnnotatedChainHead(*empty.Empty, BeaconQuery_StreamAnnotatedChainHeadServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAnnotatedChainHead not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetBlockAvailability(context.Context, *BlockAvailabilityRequest) (*BlockAvailability, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockAvailability not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetNetworkConfig(context.Context, *empty.Empty) (*NetworkConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkConfig not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetValidatorSetDelta(context.Context, *GetValidatorSetDeltaRequest) (*ValidatorSetDelta, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorSetDelta not implemented")
+}
+func (*UnimplementedBeaconQueryServer) PrefetchEpochInfo(context.Context, *PrefetchEpochInfoRequest) (*PrefetchJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrefetchEpochInfo not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetPrefetchStatus(context.Context, *GetPrefetchStatusRequest) (*PrefetchStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrefetchStatus not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ListCanonicalBlockRoots(context.Context, *ListCanonicalBlockRootsRequest) (*CanonicalBlockRoots, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCanonicalBlockRoots not implemented")
+}
+func (*UnimplementedBeaconQueryServer) StreamProposerAudit(*StreamProposerAuditRequest, BeaconQuery_StreamProposerAuditServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamProposerAudit not implemented")
+}
+func (*UnimplementedBeaconQueryServer) UpdateTrackedValidators(context.Context, *TrackValidatorsRequest) (*TrackedValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTrackedValidators not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ImportTrackedValidators(context.Context, *ImportTrackedValidatorsRequest) (*TrackedValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportTrackedValidators not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ExportTrackedValidators(context.Context, *empty.Empty) (*TrackedValidatorsExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportTrackedValidators not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ListPoolAttestations(context.Context, *ListPoolAttestationsRequest) (*PoolAttestations, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPoolAttestations not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetEth1DataStatus(context.Context, *empty.Empty) (*Eth1DataStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEth1DataStatus not implemented")
+}
+func (*UnimplementedBeaconQueryServer) StreamDepositInclusions(*empty.Empty, BeaconQuery_StreamDepositInclusionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamDepositInclusions not implemented")
+}
+func (*UnimplementedBeaconQueryServer) SimulateEpochTransition(context.Context, *SimulateEpochTransitionRequest) (*EpochTransitionSimulation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateEpochTransition not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ListCanonicalBlockHeaders(context.Context, *ListCanonicalBlockHeadersRequest) (*CanonicalBlockHeaders, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCanonicalBlockHeaders not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetBlockByPandoraHash(context.Context, *GetBlockByPandoraHashRequest) (*PairedBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByPandoraHash not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetProposerListConsistency(context.Context, *ProposerListConsistencyRequest) (*ProposerListConsistency, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProposerListConsistency not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetCurrentEpochParticipation(context.Context, *empty.Empty) (*CurrentEpochParticipation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentEpochParticipation not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ListAnnotatedValidatorAssignments(context.Context, *AnnotatedValidatorAssignmentsRequest) (*AnnotatedValidatorAssignments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAnnotatedValidatorAssignments not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ListTrackedValidatorBalances(context.Context, *v1alpha1.ListValidatorBalancesRequest) (*v1alpha1.ValidatorBalances, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrackedValidatorBalances not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetTrackedValidatorPerformance(context.Context, *v1alpha1.ValidatorPerformanceRequest) (*v1alpha1.ValidatorPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrackedValidatorPerformance not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ListValidatorsByWithdrawalCredentials(context.Context, *ValidatorsByWithdrawalCredentialsRequest) (*v1alpha1.Validators, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListValidatorsByWithdrawalCredentials not implemented")
+}
+func (*UnimplementedBeaconQueryServer) EstimateStorageGrowth(context.Context, *StorageGrowthRequest) (*StorageGrowthEstimate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateStorageGrowth not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ConfirmPandoraBlockHashes(context.Context, *ConfirmPandoraBlockHashesRequest) (*ConfirmPandoraBlockHashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmPandoraBlockHashes not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetProposerStats(context.Context, *ProposerStatsRequest) (*ProposerStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProposerStats not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetSubnetAssignments(context.Context, *SubnetAssignmentsRequest) (*SubnetAssignments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubnetAssignments not implemented")
+}
+func (*UnimplementedBeaconQueryServer) ListValidatorAssignmentsRange(context.Context, *ListValidatorAssignmentsRangeRequest) (*ValidatorAssignmentsRange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListValidatorAssignmentsRange not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetPrecomputationStatus(context.Context, *empty.Empty) (*PrecomputationStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrecomputationStatus not implemented")
+}
+func (*UnimplementedBeaconQueryServer) StreamEpochInfo(BeaconQuery_StreamEpochInfoServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEpochInfo not implemented")
+}
+func (*UnimplementedBeaconQueryServer) GetEpochInfoAccumulator(context.Context, *EpochInfoAccumulatorRequest) (*EpochInfoAccumulator, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEpochInfoAccumulator not implemented")
+}
+func (*UnimplementedBeaconQueryServer) NextEpochProposerList(context.Context, *ProposerListRequest) (*ProposerList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextEpochProposerList not implemented")
+}
+
+func RegisterBeaconQueryServer(s *grpc.Server, srv BeaconQueryServer) {
+	s.RegisterService(&_BeaconQuery_serviceDesc, srv)
+}
+
+func _BeaconQuery_GetValidatorLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetValidatorLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetValidatorLiveness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetValidatorLiveness(ctx, req.(*ValidatorLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetCommitteeRoots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitteeRootsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetCommitteeRoots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetCommitteeRoots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetCommitteeRoots(ctx, req.(*CommitteeRootsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ExplainShuffle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainShuffleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ExplainShuffle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ExplainShuffle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ExplainShuffle(ctx, req.(*ExplainShuffleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetStateDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStateDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetStateDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetStateDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetStateDiff(ctx, req.(*GetStateDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetRandaoMixes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RandaoMixesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetRandaoMixes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetRandaoMixes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetRandaoMixes(ctx, req.(*RandaoMixesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ListReorgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReorgsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ListReorgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ListReorgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ListReorgs(ctx, req.(*ListReorgsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_StreamReorgs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListReorgsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconQueryServer).StreamReorgs(m, &beaconQueryStreamReorgsServer{stream})
+}
+
+type BeaconQuery_StreamReorgsServer interface {
+	Send(*ReorgEvent) error
+	grpc.ServerStream
+}
+
+type beaconQueryStreamReorgsServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconQueryStreamReorgsServer) Send(m *ReorgEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeaconQuery_GetDuties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.DutiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetDuties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetDuties",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetDuties(ctx, req.(*v1alpha1.DutiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ListValidatorBalanceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListValidatorBalanceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ListValidatorBalanceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ListValidatorBalanceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ListValidatorBalanceHistory(ctx, req.(*ListValidatorBalanceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_StreamSlotParticipation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconQueryServer).StreamSlotParticipation(m, &beaconQueryStreamSlotParticipationServer{stream})
+}
+
+type BeaconQuery_StreamSlotParticipationServer interface {
+	Send(*SlotParticipation) error
+	grpc.ServerStream
+}
+
+type beaconQueryStreamSlotParticipationServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconQueryStreamSlotParticipationServer) Send(m *SlotParticipation) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeaconQuery_GetEpochSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEpochSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetEpochSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetEpochSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetEpochSummary(ctx, req.(*GetEpochSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ListEpochSummaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEpochSummariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ListEpochSummaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ListEpochSummaries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ListEpochSummaries(ctx, req.(*ListEpochSummariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ListValidatorPublicKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListValidatorPublicKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ListValidatorPublicKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ListValidatorPublicKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ListValidatorPublicKeys(ctx, req.(*ListValidatorPublicKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetValidatorQueueDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorQueueDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetValidatorQueueDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetValidatorQueueDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetValidatorQueueDetails(ctx, req.(*ValidatorQueueDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_StreamAnnotatedChainHead_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconQueryServer).StreamAnnotatedChainHead(m, &beaconQueryStreamAnnotatedChainHeadServer{stream})
+}
+
+type BeaconQuery_StreamAnnotatedChainHeadServer interface {
+	Send(*AnnotatedChainHead) error
+	grpc.ServerStream
+}
+
+type beaconQueryStreamAnnotatedChainHeadServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconQueryStreamAnnotatedChainHeadServer) Send(m *AnnotatedChainHead) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeaconQuery_GetBlockAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetBlockAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetBlockAvailability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetBlockAvailability(ctx, req.(*BlockAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetNetworkConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetNetworkConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetNetworkConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetNetworkConfig(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetValidatorSetDelta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorSetDeltaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetValidatorSetDelta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetValidatorSetDelta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetValidatorSetDelta(ctx, req.(*GetValidatorSetDeltaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_PrefetchEpochInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrefetchEpochInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).PrefetchEpochInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/PrefetchEpochInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).PrefetchEpochInfo(ctx, req.(*PrefetchEpochInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetPrefetchStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrefetchStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetPrefetchStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetPrefetchStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetPrefetchStatus(ctx, req.(*GetPrefetchStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ListCanonicalBlockRoots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCanonicalBlockRootsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ListCanonicalBlockRoots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ListCanonicalBlockRoots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ListCanonicalBlockRoots(ctx, req.(*ListCanonicalBlockRootsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_StreamProposerAudit_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamProposerAuditRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconQueryServer).StreamProposerAudit(m, &beaconQueryStreamProposerAuditServer{stream})
+}
+
+type BeaconQuery_StreamProposerAuditServer interface {
+	Send(*ProposerAudit) error
+	grpc.ServerStream
+}
+
+type beaconQueryStreamProposerAuditServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconQueryStreamProposerAuditServer) Send(m *ProposerAudit) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeaconQuery_UpdateTrackedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrackValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).UpdateTrackedValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/UpdateTrackedValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).UpdateTrackedValidators(ctx, req.(*TrackValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ImportTrackedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTrackedValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ImportTrackedValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ImportTrackedValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ImportTrackedValidators(ctx, req.(*ImportTrackedValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ExportTrackedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ExportTrackedValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ExportTrackedValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ExportTrackedValidators(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ListPoolAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPoolAttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ListPoolAttestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ListPoolAttestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ListPoolAttestations(ctx, req.(*ListPoolAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetEth1DataStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetEth1DataStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetEth1DataStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetEth1DataStatus(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_StreamDepositInclusions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconQueryServer).StreamDepositInclusions(m, &beaconQueryStreamDepositInclusionsServer{stream})
+}
+
+type BeaconQuery_StreamDepositInclusionsServer interface {
+	Send(*DepositInclusion) error
+	grpc.ServerStream
+}
+
+type beaconQueryStreamDepositInclusionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconQueryStreamDepositInclusionsServer) Send(m *DepositInclusion) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeaconQuery_SimulateEpochTransition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateEpochTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).SimulateEpochTransition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/SimulateEpochTransition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).SimulateEpochTransition(ctx, req.(*SimulateEpochTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ListCanonicalBlockHeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCanonicalBlockHeadersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ListCanonicalBlockHeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ListCanonicalBlockHeaders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ListCanonicalBlockHeaders(ctx, req.(*ListCanonicalBlockHeadersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetBlockByPandoraHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockByPandoraHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetBlockByPandoraHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetBlockByPandoraHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetBlockByPandoraHash(ctx, req.(*GetBlockByPandoraHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetProposerListConsistency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposerListConsistencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetProposerListConsistency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetProposerListConsistency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetProposerListConsistency(ctx, req.(*ProposerListConsistencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetCurrentEpochParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetCurrentEpochParticipation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetCurrentEpochParticipation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetCurrentEpochParticipation(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ListAnnotatedValidatorAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnotatedValidatorAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ListAnnotatedValidatorAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ListAnnotatedValidatorAssignments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ListAnnotatedValidatorAssignments(ctx, req.(*AnnotatedValidatorAssignmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ListTrackedValidatorBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.ListValidatorBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ListTrackedValidatorBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ListTrackedValidatorBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ListTrackedValidatorBalances(ctx, req.(*v1alpha1.ListValidatorBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetTrackedValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.ValidatorPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetTrackedValidatorPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetTrackedValidatorPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetTrackedValidatorPerformance(ctx, req.(*v1alpha1.ValidatorPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ListValidatorsByWithdrawalCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorsByWithdrawalCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ListValidatorsByWithdrawalCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ListValidatorsByWithdrawalCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ListValidatorsByWithdrawalCredentials(ctx, req.(*ValidatorsByWithdrawalCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_EstimateStorageGrowth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageGrowthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).EstimateStorageGrowth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/EstimateStorageGrowth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).EstimateStorageGrowth(ctx, req.(*StorageGrowthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ConfirmPandoraBlockHashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmPandoraBlockHashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ConfirmPandoraBlockHashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ConfirmPandoraBlockHashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ConfirmPandoraBlockHashes(ctx, req.(*ConfirmPandoraBlockHashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetProposerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetProposerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetProposerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetProposerStats(ctx, req.(*ProposerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetSubnetAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubnetAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetSubnetAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetSubnetAssignments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetSubnetAssignments(ctx, req.(*SubnetAssignmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_ListValidatorAssignmentsRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListValidatorAssignmentsRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).ListValidatorAssignmentsRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/ListValidatorAssignmentsRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).ListValidatorAssignmentsRange(ctx, req.(*ListValidatorAssignmentsRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_GetPrecomputationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetPrecomputationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetPrecomputationStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetPrecomputationStatus(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_StreamEpochInfo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BeaconQueryServer).StreamEpochInfo(&beaconQueryStreamEpochInfoServer{stream})
+}
+
+type BeaconQuery_StreamEpochInfoServer interface {
+	Send(*EpochInfo) error
+	Recv() (*EpochInfoStreamMessage, error)
+	grpc.ServerStream
+}
+
+type beaconQueryStreamEpochInfoServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconQueryStreamEpochInfoServer) Send(m *EpochInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *beaconQueryStreamEpochInfoServer) Recv() (*EpochInfoStreamMessage, error) {
+	m := new(EpochInfoStreamMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _BeaconQuery_GetEpochInfoAccumulator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EpochInfoAccumulatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).GetEpochInfoAccumulator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/GetEpochInfoAccumulator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).GetEpochInfoAccumulator(ctx, req.(*EpochInfoAccumulatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconQuery_NextEpochProposerList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposerListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconQueryServer).NextEpochProposerList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconQuery/NextEpochProposerList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconQueryServer).NextEpochProposerList(ctx, req.(*ProposerListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BeaconQuery_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.BeaconQuery",
+	HandlerType: (*BeaconQueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetValidatorLiveness",
+			Handler:    _BeaconQuery_GetValidatorLiveness_Handler,
+		},
+		{
+			MethodName: "GetCommitteeRoots",
+			Handler:    _BeaconQuery_GetCommitteeRoots_Handler,
+		},
+		{
+			MethodName: "ExplainShuffle",
+			Handler:    _BeaconQuery_ExplainShuffle_Handler,
+		},
+		{
+			MethodName: "GetStateDiff",
+			Handler:    _BeaconQuery_GetStateDiff_Handler,
+		},
+		{
+			MethodName: "GetRandaoMixes",
+			Handler:    _BeaconQuery_GetRandaoMixes_Handler,
+		},
+		{
+			MethodName: "ListReorgs",
+			Handler:    _BeaconQuery_ListReorgs_Handler,
+		},
+		{
+			MethodName: "GetDuties",
+			Handler:    _BeaconQuery_GetDuties_Handler,
+		},
+		{
+			MethodName: "ListValidatorBalanceHistory",
+			Handler:    _BeaconQuery_ListValidatorBalanceHistory_Handler,
+		},
+		{
+			MethodName: "GetEpochSummary",
+			Handler:    _BeaconQuery_GetEpochSummary_Handler,
+		},
+		{
+			MethodName: "ListEpochSummaries",
+			Handler:    _BeaconQuery_ListEpochSummaries_Handler,
+		},
+		{
+			MethodName: "ListValidatorPublicKeys",
+			Handler:    _BeaconQuery_ListValidatorPublicKeys_Handler,
+		},
+		{
+			MethodName: "GetValidatorQueueDetails",
+			Handler:    _BeaconQuery_GetValidatorQueueDetails_Handler,
+		},
+		{
+			MethodName: "GetBlockAvailability",
+			Handler:    _BeaconQuery_GetBlockAvailability_Handler,
+		},
+		{
+			MethodName: "GetNetworkConfig",
+			Handler:    _BeaconQuery_GetNetworkConfig_Handler,
+		},
+		{
+			MethodName: "GetValidatorSetDelta",
+			Handler:    _BeaconQuery_GetValidatorSetDelta_Handler,
+		},
+		{
+			MethodName: "PrefetchEpochInfo",
+			Handler:    _BeaconQuery_PrefetchEpochInfo_Handler,
+		},
+		{
+			MethodName: "GetPrefetchStatus",
+			Handler:    _BeaconQuery_GetPrefetchStatus_Handler,
+		},
+		{
+			MethodName: "ListCanonicalBlockRoots",
+			Handler:    _BeaconQuery_ListCanonicalBlockRoots_Handler,
+		},
+		{
+			MethodName: "UpdateTrackedValidators",
+			Handler:    _BeaconQuery_UpdateTrackedValidators_Handler,
+		},
+		{
+			MethodName: "ImportTrackedValidators",
+			Handler:    _BeaconQuery_ImportTrackedValidators_Handler,
+		},
+		{
+			MethodName: "ExportTrackedValidators",
+			Handler:    _BeaconQuery_ExportTrackedValidators_Handler,
+		},
+		{
+			MethodName: "ListPoolAttestations",
+			Handler:    _BeaconQuery_ListPoolAttestations_Handler,
+		},
+		{
+			MethodName: "GetEth1DataStatus",
+			Handler:    _BeaconQuery_GetEth1DataStatus_Handler,
+		},
+		{
+			MethodName: "SimulateEpochTransition",
+			Handler:    _BeaconQuery_SimulateEpochTransition_Handler,
+		},
+		{
+			MethodName: "ListCanonicalBlockHeaders",
+			Handler:    _BeaconQuery_ListCanonicalBlockHeaders_Handler,
+		},
+		{
+			MethodName: "GetBlockByPandoraHash",
+			Handler:    _BeaconQuery_GetBlockByPandoraHash_Handler,
+		},
+		{
+			MethodName: "GetProposerListConsistency",
+			Handler:    _BeaconQuery_GetProposerListConsistency_Handler,
+		},
+		{
+			MethodName: "GetCurrentEpochParticipation",
+			Handler:    _BeaconQuery_GetCurrentEpochParticipation_Handler,
+		},
+		{
+			MethodName: "ListAnnotatedValidatorAssignments",
+			Handler:    _BeaconQuery_ListAnnotatedValidatorAssignments_Handler,
+		},
+		{
+			MethodName: "ListTrackedValidatorBalances",
+			Handler:    _BeaconQuery_ListTrackedValidatorBalances_Handler,
+		},
+		{
+			MethodName: "GetTrackedValidatorPerformance",
+			Handler:    _BeaconQuery_GetTrackedValidatorPerformance_Handler,
+		},
+		{
+			MethodName: "ListValidatorsByWithdrawalCredentials",
+			Handler:    _BeaconQuery_ListValidatorsByWithdrawalCredentials_Handler,
+		},
+		{
+			MethodName: "EstimateStorageGrowth",
+			Handler:    _BeaconQuery_EstimateStorageGrowth_Handler,
+		},
+		{
+			MethodName: "ConfirmPandoraBlockHashes",
+			Handler:    _BeaconQuery_ConfirmPandoraBlockHashes_Handler,
+		},
+		{
+			MethodName: "GetProposerStats",
+			Handler:    _BeaconQuery_GetProposerStats_Handler,
+		},
+		{
+			MethodName: "GetSubnetAssignments",
+			Handler:    _BeaconQuery_GetSubnetAssignments_Handler,
+		},
+		{
+			MethodName: "ListValidatorAssignmentsRange",
+			Handler:    _BeaconQuery_ListValidatorAssignmentsRange_Handler,
+		},
+		{
+			MethodName: "GetPrecomputationStatus",
+			Handler:    _BeaconQuery_GetPrecomputationStatus_Handler,
+		},
+		{
+			MethodName: "GetEpochInfoAccumulator",
+			Handler:    _BeaconQuery_GetEpochInfoAccumulator_Handler,
+		},
+		{
+			MethodName: "NextEpochProposerList",
+			Handler:    _BeaconQuery_NextEpochProposerList_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamReorgs",
+			Handler:       _BeaconQuery_StreamReorgs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamSlotParticipation",
+			Handler:       _BeaconQuery_StreamSlotParticipation_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamAnnotatedChainHead",
+			Handler:       _BeaconQuery_StreamAnnotatedChainHead_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamProposerAudit",
+			Handler:       _BeaconQuery_StreamProposerAudit_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamDepositInclusions",
+			Handler:       _BeaconQuery_StreamDepositInclusions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamEpochInfo",
+			Handler:       _BeaconQuery_StreamEpochInfo_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "proto/beacon/rpc/v1/beacon_query.proto",
+}
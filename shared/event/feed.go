@@ -34,15 +34,17 @@ var errBadChannel = errors.New("event: Subscribe argument does not have sendable
 //
 // The zero value is ready to use.
 type Feed struct {
-	once      sync.Once        // ensures that init only runs once
-	sendLock  chan struct{}    // sendLock has a one-element buffer and is empty when held.It protects sendCases.
-	removeSub chan interface{} // interrupts Send
-	sendCases caseList         // the active set of select cases used by Send
+	once      sync.Once                  // ensures that init only runs once
+	sendLock  chan struct{}              // sendLock has a one-element buffer and is empty when held.It protects sendCases.
+	removeSub chan interface{}           // interrupts Send
+	sendCases caseList                   // the active set of select cases used by Send
+	policies  map[interface{}]*subPolicy // buffering policies of the sendCases, protected by sendLock
 
 	// The inbox holds newly subscribed channels until they are added to sendCases.
-	mu    sync.Mutex
-	inbox caseList
-	etype reflect.Type
+	mu            sync.Mutex
+	inbox         caseList
+	inboxPolicies map[interface{}]*subPolicy
+	etype         reflect.Type
 }
 
 // This is the index of the first actual subscription channel in sendCases.
@@ -63,6 +65,7 @@ func (f *Feed) init() {
 	f.sendLock = make(chan struct{}, 1)
 	f.sendLock <- struct{}{}
 	f.sendCases = caseList{{Chan: reflect.ValueOf(f.removeSub), Dir: reflect.SelectRecv}}
+	f.policies = make(map[interface{}]*subPolicy)
 }
 
 // Subscribe adds a channel to the feed. Future sends will be delivered on the channel
@@ -71,6 +74,13 @@ func (f *Feed) init() {
 // The channel should have ample buffer space to avoid blocking other subscribers.
 // Slow subscribers are not dropped.
 func (f *Feed) Subscribe(channel interface{}) Subscription {
+	return f.SubscribeWithPolicy(channel, "", Block)
+}
+
+// SubscribeWithPolicy adds a channel to the feed like Subscribe, applying the buffering policy
+// when the channel is full on Send. Only Block subscribers can stall Send. The name labels the
+// dropped values and disconnections of the subscriber in metrics.
+func (f *Feed) SubscribeWithPolicy(channel interface{}, name string, policy BufferPolicy) Subscription {
 	f.once.Do(f.init)
 
 	chanval := reflect.ValueOf(channel)
@@ -78,6 +88,9 @@ func (f *Feed) Subscribe(channel interface{}) Subscription {
 	if chantyp.Kind() != reflect.Chan || chantyp.ChanDir()&reflect.SendDir == 0 {
 		panic(errBadChannel)
 	}
+	if policy == DropOldest && chantyp.ChanDir()&reflect.RecvDir == 0 {
+		panic(errDropOldestChannel)
+	}
 	sub := &feedSub{feed: f, channel: chanval, err: make(chan error, 1)}
 
 	f.mu.Lock()
@@ -89,6 +102,12 @@ func (f *Feed) Subscribe(channel interface{}) Subscription {
 	// The next Send will add it to f.sendCases.
 	cas := reflect.SelectCase{Dir: reflect.SelectSend, Chan: chanval}
 	f.inbox = append(f.inbox, cas)
+	if policy != Block {
+		if f.inboxPolicies == nil {
+			f.inboxPolicies = make(map[interface{}]*subPolicy)
+		}
+		f.inboxPolicies[channel] = &subPolicy{sub: sub, name: name, policy: policy}
+	}
 	return sub
 }
 
@@ -109,6 +128,7 @@ func (f *Feed) remove(sub *feedSub) {
 	index := f.inbox.find(ch)
 	if index != -1 {
 		f.inbox = f.inbox.delete(index)
+		delete(f.inboxPolicies, ch)
 		f.mu.Unlock()
 		return
 	}
@@ -118,8 +138,12 @@ func (f *Feed) remove(sub *feedSub) {
 	case f.removeSub <- ch:
 		// Send will remove the channel from f.sendCases.
 	case <-f.sendLock:
-		// No Send is in progress, delete the channel now that we have the send lock.
-		f.sendCases = f.sendCases.delete(f.sendCases.find(ch))
+		// No Send is in progress, delete the channel now that we have the send lock. Disconnected
+		// channels are already deleted.
+		if index := f.sendCases.find(ch); index != -1 {
+			f.sendCases = f.sendCases.delete(index)
+		}
+		delete(f.policies, ch)
 		f.sendLock <- struct{}{}
 	}
 }
@@ -136,6 +160,10 @@ func (f *Feed) Send(value interface{}) (nsent int) {
 	f.mu.Lock()
 	f.sendCases = append(f.sendCases, f.inbox...)
 	f.inbox = nil
+	for ch, p := range f.inboxPolicies {
+		f.policies[ch] = p
+	}
+	f.inboxPolicies = nil
 
 	if !f.typecheck(rvalue.Type()) {
 		f.sendLock <- struct{}{}
@@ -152,15 +180,27 @@ func (f *Feed) Send(value interface{}) (nsent int) {
 	// of sendCases. When a send succeeds, the corresponding case moves to the end of
 	// 'cases' and it shrinks by one element.
 	cases := f.sendCases
+	var disconnected []interface{}
 	for {
 		// Fast path: try sending without blocking before adding to the select set.
 		// This should usually succeed if subscribers are fast enough and have free
-		// buffer space.
+		// buffer space. Full subscribers which do not block are settled here too.
 		for i := firstSubSendCase; i < len(cases); i++ {
 			if cases[i].Chan.TrySend(rvalue) {
 				nsent++
 				cases = cases.deactivate(i)
 				i--
+				continue
+			}
+			if p, ok := f.policies[cases[i].Chan.Interface()]; ok {
+				if p.deliverFull(rvalue) {
+					nsent++
+				}
+				if p.policy == Disconnect {
+					disconnected = append(disconnected, cases[i].Chan.Interface())
+				}
+				cases = cases.deactivate(i)
+				i--
 			}
 		}
 		if len(cases) == firstSubSendCase {
@@ -170,8 +210,12 @@ func (f *Feed) Send(value interface{}) (nsent int) {
 		chosen, recv, _ := reflect.Select(cases)
 		if chosen == 0 /* <-f.removeSub */ {
 			index := f.sendCases.find(recv.Interface())
+			delete(f.policies, recv.Interface())
+			if index == -1 {
+				continue
+			}
 			f.sendCases = f.sendCases.delete(index)
-			if index >= 0 && index < len(cases) {
+			if index < len(cases) {
 				// Shrink 'cases' too because the removed case was still active.
 				cases = f.sendCases[:len(cases)-1]
 			}
@@ -181,6 +225,14 @@ func (f *Feed) Send(value interface{}) (nsent int) {
 		}
 	}
 
+	// Drop the disconnected subscribers, unless they unsubscribed meanwhile.
+	for _, ch := range disconnected {
+		if index := f.sendCases.find(ch); index != -1 {
+			f.sendCases = f.sendCases.delete(index)
+		}
+		delete(f.policies, ch)
+	}
+
 	// Forget about the sent value and hand off the send lock.
 	for i := firstSubSendCase; i < len(f.sendCases); i++ {
 		f.sendCases[i].Send = reflect.Value{}
@@ -54,6 +54,10 @@ func TestFeedPanics(t *testing.T) {
 		var f Feed
 		assert.NoError(t, checkPanic(errBadChannel, func() { f.Subscribe(0) }))
 	}
+	{
+		var f Feed
+		assert.NoError(t, checkPanic(errDropOldestChannel, func() { f.SubscribeWithPolicy(make(chan<- int, 1), "test", DropOldest) }))
+	}
 }
 
 func checkPanic(want error, fn func()) (err error) {
@@ -285,6 +289,55 @@ func TestFeedUnsubscribeFromInbox(t *testing.T) {
 	assert.Equal(t, 1, len(feed.sendCases), "sendCases is non-empty after unsubscribe")
 }
 
+func TestFeedDropOldest(t *testing.T) {
+	var feed Feed
+	slow := make(chan int, 2)
+	sub := feed.SubscribeWithPolicy(slow, "test", DropOldest)
+	defer sub.Unsubscribe()
+	blocking := make(chan int, 3)
+	defer feed.Subscribe(blocking).Unsubscribe()
+
+	// The full channel never stalls Send, and keeps the latest values.
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, 2, feed.Send(i))
+	}
+	assert.Equal(t, 1, <-slow)
+	assert.Equal(t, 2, <-slow)
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, i, <-blocking)
+	}
+
+	unbuffered := make(chan int)
+	defer feed.SubscribeWithPolicy(unbuffered, "test", DropOldest).Unsubscribe()
+	assert.Equal(t, 2, feed.Send(3), "Expected the unbuffered subscriber to miss the value")
+	select {
+	case err := <-sub.Err():
+		t.Fatalf("Unexpected subscription error: %v", err)
+	default:
+	}
+}
+
+func TestFeedDisconnect(t *testing.T) {
+	var feed Feed
+	slow := make(chan int, 1)
+	sub := feed.SubscribeWithPolicy(slow, "test", Disconnect)
+	fast := make(chan int, 3)
+	defer feed.Subscribe(fast).Unsubscribe()
+
+	assert.Equal(t, 2, feed.Send(1))
+	assert.Equal(t, 1, feed.Send(2), "Expected the full subscriber to be skipped")
+	assert.Equal(t, ErrSlowSubscriber, <-sub.Err())
+	assert.Equal(t, 2, len(feed.sendCases), "Expected the disconnected subscriber to be removed")
+
+	// The disconnected subscriber receives nothing else, and can still unsubscribe.
+	<-slow
+	assert.Equal(t, 1, feed.Send(3))
+	assert.Equal(t, 0, len(slow))
+	sub.Unsubscribe()
+	assert.Equal(t, 2, len(feed.sendCases))
+	assert.Equal(t, 0, len(feed.policies))
+}
+
 func BenchmarkFeedSend1000(b *testing.B) {
 	var (
 		done  sync.WaitGroup
@@ -0,0 +1,89 @@
+package event
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// ErrSlowSubscriber is sent on the error channel of a Disconnect subscription whose channel was
+// full when a value was sent. The subscription receives no further values.
+var ErrSlowSubscriber = errors.New("event: subscriber too slow, disconnected")
+
+var errDropOldestChannel = errors.New("event: DropOldest subscription requires a receivable channel")
+
+var (
+	droppedEvents = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "feed_dropped_events_total",
+			Help: "The number of feed values discarded because the channel of a DropOldest subscriber was full.",
+		},
+		[]string{"subscriber"},
+	)
+	disconnectedSubscribers = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "feed_disconnected_subscribers_total",
+			Help: "The number of Disconnect subscribers dropped from a feed because their channel was full.",
+		},
+		[]string{"subscriber"},
+	)
+)
+
+// BufferPolicy decides what Send does with a subscriber whose channel is full.
+type BufferPolicy int
+
+const (
+	// Block makes Send wait until the subscriber has room for the value, stalling the sender and
+	// every other subscriber meanwhile. It is the policy of Subscribe.
+	Block BufferPolicy = iota
+	// DropOldest discards the oldest value buffered in the channel to make room for the new one,
+	// so that the subscriber catches up with the latest values. Unbuffered channels miss the new
+	// value instead.
+	DropOldest
+	// Disconnect ends the subscription, sending ErrSlowSubscriber on its error channel.
+	Disconnect
+)
+
+// String returns the name of the policy.
+func (p BufferPolicy) String() string {
+	switch p {
+	case Block:
+		return "block"
+	case DropOldest:
+		return "drop-oldest"
+	case Disconnect:
+		return "disconnect"
+	default:
+		return "unknown"
+	}
+}
+
+// subPolicy is the buffering policy of a subscription, named after its subscriber in metrics.
+type subPolicy struct {
+	sub    *feedSub
+	name   string
+	policy BufferPolicy
+}
+
+// deliverFull applies the policy to a subscriber whose channel is full, without ever blocking.
+// It returns true if the value was delivered.
+func (p *subPolicy) deliverFull(value reflect.Value) bool {
+	switch p.policy {
+	case DropOldest:
+		droppedEvents.WithLabelValues(p.name).Inc()
+		if p.sub.channel.Cap() == 0 {
+			return false
+		}
+		p.sub.channel.TryRecv()
+		return p.sub.channel.TrySend(value)
+	case Disconnect:
+		disconnectedSubscribers.WithLabelValues(p.name).Inc()
+		select {
+		case p.sub.err <- ErrSlowSubscriber:
+		default:
+		}
+	}
+	return false
+}
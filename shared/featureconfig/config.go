@@ -49,6 +49,7 @@ type Flags struct {
 	DisableAttestingHistoryDBCache     bool // DisableAttestingHistoryDBCache for the validator client increases disk reads/writes.
 	UpdateHeadTimely                   bool // UpdateHeadTimely updates head right after state transition.
 	ProposerAttsSelectionUsingMaxCover bool // ProposerAttsSelectionUsingMaxCover enables max-cover algorithm when selecting attestations for proposing.
+	EnableStateValidatorDedup          bool // EnableStateValidatorDedup stores the validators of saved states once in a content addressed bucket.
 
 	// Logging related toggles.
 	DisableGRPCConnectionLogs bool // Disables logging when a new grpc client has connected.
@@ -114,7 +115,15 @@ func InitWithReset(c *Flags) func() {
 
 // configureTestnet sets the config according to specified testnet flag
 func configureTestnet(ctx *cli.Context, cfg *Flags) {
-	if ctx.Bool(ToledoTestnet.Name) {
+	if ctx.IsSet(Network.Name) {
+		name := ctx.String(Network.Name)
+		if err := params.UsePreset(name); err != nil {
+			log.WithError(err).Fatal("Could not configure network")
+		}
+		log.WithField("network", name).Warn("Running on network preset")
+		cfg.ToledoTestnet = name == params.ConfigNames[params.Toledo]
+		cfg.PyrmontTestnet = name == params.ConfigNames[params.Pyrmont]
+	} else if ctx.Bool(ToledoTestnet.Name) {
 		log.Warn("Running on Toledo Testnet")
 		params.UseToledoConfig()
 		params.UseToledoNetworkConfig()
@@ -196,6 +205,10 @@ func ConfigureBeaconChain(ctx *cli.Context) {
 		log.WithField(proposerAttsSelectionUsingMaxCover.Name, proposerAttsSelectionUsingMaxCover.Usage).Warn(enabledFeatureFlag)
 		cfg.ProposerAttsSelectionUsingMaxCover = true
 	}
+	if ctx.Bool(enableStateValidatorDedup.Name) {
+		log.WithField(enableStateValidatorDedup.Name, enableStateValidatorDedup.Usage).Warn(enabledFeatureFlag)
+		cfg.EnableStateValidatorDedup = true
+	}
 	Init(cfg)
 }
 
@@ -4,7 +4,9 @@ import (
 	"flag"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	"github.com/urfave/cli/v2"
 )
 
@@ -52,3 +54,15 @@ func TestConfigureBeaconConfig(t *testing.T) {
 	c := Get()
 	assert.Equal(t, true, c.PyrmontTestnet)
 }
+
+func TestConfigureBeaconConfig_Network(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(Network.Name, "l16", "test")
+	require.NoError(t, set.Set(Network.Name, "l16"))
+	context := cli.NewContext(&app, set, nil)
+	ConfigureBeaconChain(context)
+	assert.Equal(t, "l16", params.BeaconConfig().ConfigName)
+	assert.Equal(t, uint64(2828), params.BeaconConfig().DepositChainID)
+}
@@ -1,8 +1,11 @@
 package featureconfig
 
 import (
+	"strings"
 	"time"
 
+	"github.com/prysmaticlabs/prysm/shared/params"
+
 	"github.com/urfave/cli/v2"
 )
 
@@ -22,6 +25,11 @@ var (
 		Name:  "prater",
 		Usage: "Run Prysm configured for the Prater test network",
 	}
+	// Network flag selects a registered network preset of the params package.
+	Network = &cli.StringFlag{
+		Name:  "network",
+		Usage: "Run Prysm configured for the named network, one of " + strings.Join(params.PresetNames(), ", "),
+	}
 	// Mainnet flag for easier tooling, no-op
 	Mainnet = &cli.BoolFlag{
 		Value: true,
@@ -122,6 +130,11 @@ var (
 		Name:  "enable-slashing-protection-pruning",
 		Usage: "Enables the pruning of the validator client's slashing protectin database",
 	}
+	enableStateValidatorDedup = &cli.BoolFlag{
+		Name: "enable-state-validator-dedup",
+		Usage: "Stores the validator entries of saved states once in a content addressed bucket, " +
+			"which greatly reduces the size of the database of archive nodes",
+	}
 )
 
 // devModeFlags holds list of flags that are set when development mode is on.
@@ -141,6 +154,7 @@ var ValidatorFlags = append(deprecatedFlags, []cli.Flag{
 	ToledoTestnet,
 	PyrmontTestnet,
 	PraterTestnet,
+	Network,
 	Mainnet,
 	disableAccountsV2,
 	disableBlst,
@@ -155,6 +169,7 @@ var SlasherFlags = append(deprecatedFlags, []cli.Flag{
 	ToledoTestnet,
 	PyrmontTestnet,
 	PraterTestnet,
+	Network,
 	Mainnet,
 }...)
 
@@ -171,6 +186,7 @@ var BeaconChainFlags = append(deprecatedFlags, []cli.Flag{
 	ToledoTestnet,
 	PyrmontTestnet,
 	PraterTestnet,
+	Network,
 	Mainnet,
 	disableBlst,
 	enablePeerScorer,
@@ -181,6 +197,7 @@ var BeaconChainFlags = append(deprecatedFlags, []cli.Flag{
 	forceOptMaxCoverAggregationStategy,
 	updateHeadTimely,
 	proposerAttsSelectionUsingMaxCover,
+	enableStateValidatorDedup,
 }...)
 
 // E2EBeaconChainFlags contains a list of the beacon chain feature flags to be tested in E2E.
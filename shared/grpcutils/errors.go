@@ -0,0 +1,201 @@
+package grpcutils
+
+import (
+	"strconv"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	// ErrorDomain is the domain attached to the structured error details returned by the beacon node.
+	ErrorDomain = "beacon.prysm"
+	// ReasonEpochOutOfRange is the error reason used when a request targets an epoch the node cannot serve.
+	ReasonEpochOutOfRange = "EPOCH_OUT_OF_RANGE"
+	// ReasonShuttingDown is the error reason a stream ends with when the node shuts down.
+	ReasonShuttingDown = "SHUTTING_DOWN"
+	// ReasonOverloaded is the error reason of a call shed while the node is overloaded.
+	ReasonOverloaded = "OVERLOADED"
+	// ReasonEpochGap is the error reason a range ends with at an epoch the node failed to serve.
+	ReasonEpochGap = "EPOCH_GAP"
+
+	currentEpochKey         = "current_epoch"
+	requestedEpochKey       = "requested_epoch"
+	oldestAvailableEpochKey = "oldest_available_epoch"
+	gapEpochKey             = "gap_epoch"
+	gapReasonKey            = "gap_reason"
+)
+
+// EpochOutOfRange describes a request for an epoch outside of the range a node is able to serve.
+type EpochOutOfRange struct {
+	Current         types.Epoch
+	Requested       types.Epoch
+	OldestAvailable types.Epoch
+}
+
+// EpochOutOfRangeError returns a gRPC status error with the given code and message which carries
+// an ErrorInfo detail describing the out of range epoch, so clients can handle the error class
+// programmatically instead of parsing the message.
+func EpochOutOfRangeError(code codes.Code, details *EpochOutOfRange, format string, args ...interface{}) error {
+	st := status.Newf(code, format, args...)
+	withDetails, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: ReasonEpochOutOfRange,
+		Domain: ErrorDomain,
+		Metadata: map[string]string{
+			currentEpochKey:         strconv.FormatUint(uint64(details.Current), 10),
+			requestedEpochKey:       strconv.FormatUint(uint64(details.Requested), 10),
+			oldestAvailableEpochKey: strconv.FormatUint(uint64(details.OldestAvailable), 10),
+		},
+	})
+	if err != nil {
+		// Details can only fail to attach for an OK status, fall back to the plain error.
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// EpochOutOfRangeFromError extracts the out of range epoch details from a gRPC error, if present.
+func EpochOutOfRangeFromError(err error) (*EpochOutOfRange, bool) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil, false
+	}
+	for _, d := range st.Details() {
+		info, ok := d.(*errdetails.ErrorInfo)
+		if !ok || info.Domain != ErrorDomain || info.Reason != ReasonEpochOutOfRange {
+			continue
+		}
+		current, err := strconv.ParseUint(info.Metadata[currentEpochKey], 10, 64)
+		if err != nil {
+			return nil, false
+		}
+		requested, err := strconv.ParseUint(info.Metadata[requestedEpochKey], 10, 64)
+		if err != nil {
+			return nil, false
+		}
+		oldest, err := strconv.ParseUint(info.Metadata[oldestAvailableEpochKey], 10, 64)
+		if err != nil {
+			return nil, false
+		}
+		return &EpochOutOfRange{
+			Current:         types.Epoch(current),
+			Requested:       types.Epoch(requested),
+			OldestAvailable: types.Epoch(oldest),
+		}, true
+	}
+	return nil, false
+}
+
+// ShuttingDownError returns the Unavailable status a stream ends with when the node shuts down. It
+// is the last message of the stream, so that clients fail over to another node right away instead
+// of retrying the same one after the connection resets.
+func ShuttingDownError() error {
+	st := status.New(codes.Unavailable, "Beacon node is shutting down")
+	withDetails, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: ReasonShuttingDown,
+		Domain: ErrorDomain,
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// IsShuttingDown returns true if the error is the status of a stream ended by a node shutdown.
+func IsShuttingDown(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.Domain == ErrorDomain && info.Reason == ReasonShuttingDown {
+			return true
+		}
+	}
+	return false
+}
+
+// OverloadedError returns the Unavailable status of a call shed while the node is overloaded. It
+// carries a RetryInfo detail with the delay after which the call may be retried.
+func OverloadedError(retryAfter time.Duration, format string, args ...interface{}) error {
+	st := status.Newf(codes.Unavailable, format, args...)
+	withDetails, err := st.WithDetails(
+		&errdetails.ErrorInfo{
+			Reason: ReasonOverloaded,
+			Domain: ErrorDomain,
+		},
+		&errdetails.RetryInfo{
+			RetryDelay: durationpb.New(retryAfter),
+		},
+	)
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// RetryAfterFromError returns the retry delay of an error carrying a RetryInfo detail, if present.
+func RetryAfterFromError(err error) (time.Duration, bool) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return 0, false
+	}
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.RetryInfo); ok && info.RetryDelay != nil {
+			return info.RetryDelay.AsDuration(), true
+		}
+	}
+	return 0, false
+}
+
+// EpochGap describes an epoch of a range the node failed to serve, such as an epoch whose state
+// could not be regenerated. The epochs of the range before the gap were served.
+type EpochGap struct {
+	Epoch  types.Epoch
+	Reason string
+}
+
+// EpochGapError returns a gRPC status error with the given code and message which carries an
+// ErrorInfo detail describing the gap a range ended at.
+func EpochGapError(code codes.Code, gap *EpochGap, format string, args ...interface{}) error {
+	st := status.Newf(code, format, args...)
+	withDetails, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: ReasonEpochGap,
+		Domain: ErrorDomain,
+		Metadata: map[string]string{
+			gapEpochKey:  strconv.FormatUint(uint64(gap.Epoch), 10),
+			gapReasonKey: gap.Reason,
+		},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// EpochGapFromError extracts the gap a range ended at from a gRPC error, if present.
+func EpochGapFromError(err error) (*EpochGap, bool) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil, false
+	}
+	for _, d := range st.Details() {
+		info, ok := d.(*errdetails.ErrorInfo)
+		if !ok || info.Domain != ErrorDomain || info.Reason != ReasonEpochGap {
+			continue
+		}
+		epoch, err := strconv.ParseUint(info.Metadata[gapEpochKey], 10, 64)
+		if err != nil {
+			return nil, false
+		}
+		return &EpochGap{
+			Epoch:  types.Epoch(epoch),
+			Reason: info.Metadata[gapReasonKey],
+		}, true
+	}
+	return nil, false
+}
@@ -0,0 +1,64 @@
+package grpcutils
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEpochOutOfRangeError_RoundTrip(t *testing.T) {
+	wanted := &EpochOutOfRange{Current: 10, Requested: 12, OldestAvailable: 2}
+	err := EpochOutOfRangeError(codes.InvalidArgument, wanted, "epoch %d is in the future", 12)
+	assert.ErrorContains(t, "epoch 12 is in the future", err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	details, ok := EpochOutOfRangeFromError(err)
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, wanted, details)
+}
+
+func TestEpochOutOfRangeFromError_NoDetails(t *testing.T) {
+	_, ok := EpochOutOfRangeFromError(status.Error(codes.InvalidArgument, "bad request"))
+	assert.Equal(t, false, ok)
+	_, ok = EpochOutOfRangeFromError(errors.New("not a status error"))
+	assert.Equal(t, false, ok)
+}
+
+func TestShuttingDownError(t *testing.T) {
+	err := ShuttingDownError()
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, true, IsShuttingDown(err))
+	assert.Equal(t, false, IsShuttingDown(status.Error(codes.Unavailable, "shutting down")))
+	assert.Equal(t, false, IsShuttingDown(errors.New("not a status error")))
+}
+
+func TestOverloadedError(t *testing.T) {
+	err := OverloadedError(6*time.Second, "Node is overloaded, retry in %d seconds", 6)
+	assert.ErrorContains(t, "Node is overloaded, retry in 6 seconds", err)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	retryAfter, ok := RetryAfterFromError(err)
+	require.Equal(t, true, ok)
+	assert.Equal(t, 6*time.Second, retryAfter)
+
+	_, ok = RetryAfterFromError(status.Error(codes.Unavailable, "unavailable"))
+	assert.Equal(t, false, ok)
+}
+
+func TestEpochGapError_RoundTrip(t *testing.T) {
+	wanted := &EpochGap{Epoch: 7, Reason: "no state available at slot 224"}
+	err := EpochGapError(codes.Unavailable, wanted, "Could not compute epoch info for epoch %d", 7)
+	assert.ErrorContains(t, "Could not compute epoch info for epoch 7", err)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	gap, ok := EpochGapFromError(err)
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, wanted, gap)
+
+	_, ok = EpochGapFromError(status.Error(codes.Unavailable, "unavailable"))
+	assert.Equal(t, false, ok)
+}
@@ -10,6 +10,11 @@ import (
 	"google.golang.org/grpc/metadata"
 )
 
+// ImmutableResponseHeader is the gRPC response header the beacon node marks responses with which
+// can not change anymore, such as responses computed from finalized states. The gateway serves
+// these responses with cache headers.
+const ImmutableResponseHeader = "x-immutable-response"
+
 // LogRequests logs the gRPC backend as well as request duration when the log level is set to debug
 // or higher.
 func LogRequests(
@@ -0,0 +1,55 @@
+package params
+
+// ChainConfig supplies the beacon chain config of a service. The methods of services given a
+// ChainConfig, such as the blockchain service and the beacon, validator and debug RPC servers,
+// read their parameters from it rather than from BeaconConfig, so that their config is decided
+// where they are constructed instead of by whichever code last called OverrideBeaconConfig. The
+// core helpers and the package level functions of the services still read BeaconConfig, so the
+// scoped config must agree with the global config on the parameters of the state transition.
+type ChainConfig interface {
+	BeaconConfig() *BeaconChainConfig
+}
+
+// globalChainConfig follows the global config.
+type globalChainConfig struct{}
+
+// BeaconConfig returns the global config.
+func (globalChainConfig) BeaconConfig() *BeaconChainConfig {
+	return BeaconConfig()
+}
+
+// GlobalChainConfig returns a ChainConfig following the global config, including its later
+// overrides.
+func GlobalChainConfig() ChainConfig {
+	return globalChainConfig{}
+}
+
+// BeaconConfigOf returns the config of the ChainConfig, or the global config if it is nil, so that
+// components constructed without a ChainConfig keep following the global config.
+func BeaconConfigOf(c ChainConfig) *BeaconChainConfig {
+	if c == nil {
+		return BeaconConfig()
+	}
+	return c.BeaconConfig()
+}
+
+// scopedChainConfig holds a config of its own.
+type scopedChainConfig struct {
+	cfg *BeaconChainConfig
+}
+
+// BeaconConfig returns the scoped config. It must not be modified.
+func (s *scopedChainConfig) BeaconConfig() *BeaconChainConfig {
+	return s.cfg
+}
+
+// NewScopedChainConfig returns a ChainConfig holding a copy of the given config, which neither
+// OverrideBeaconConfig nor later changes to the given config affect.
+func NewScopedChainConfig(cfg *BeaconChainConfig) ChainConfig {
+	return &scopedChainConfig{cfg: cfg.Copy()}
+}
+
+// ChainConfig returns a scoped config of the preset, leaving the global config untouched.
+func (p *Preset) ChainConfig() ChainConfig {
+	return NewScopedChainConfig(p.Config())
+}
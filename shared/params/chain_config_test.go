@@ -0,0 +1,59 @@
+package params
+
+import (
+	"sync"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestScopedChainConfig(t *testing.T) {
+	SetupTestConfigCleanup(t)
+	OverrideBeaconConfig(MainnetConfig())
+	cfg := MinimalSpecConfig()
+	scoped := NewScopedChainConfig(cfg)
+	global := GlobalChainConfig()
+
+	cfg.SlotsPerEpoch = 1
+	OverrideBeaconConfig(E2ETestConfig())
+	assert.Equal(t, types.Slot(8), scoped.BeaconConfig().SlotsPerEpoch, "Expected the scoped config to keep its own copy")
+	assert.Equal(t, E2ETestConfig().ConfigName, global.BeaconConfig().ConfigName, "Expected the global config to follow overrides")
+}
+
+func TestBeaconConfigOf(t *testing.T) {
+	SetupTestConfigCleanup(t)
+	OverrideBeaconConfig(MainnetConfig())
+	assert.Equal(t, BeaconConfig(), BeaconConfigOf(nil))
+	scoped := NewScopedChainConfig(MinimalSpecConfig())
+	assert.Equal(t, scoped.BeaconConfig(), BeaconConfigOf(scoped))
+}
+
+func TestPreset_ChainConfig(t *testing.T) {
+	SetupTestConfigCleanup(t)
+	OverrideBeaconConfig(MainnetConfig())
+	p, ok := PresetByName(ConfigNames[L15])
+	require.Equal(t, true, ok)
+	scoped := p.ChainConfig()
+	assert.Equal(t, ConfigNames[L15], scoped.BeaconConfig().ConfigName)
+	assert.Equal(t, types.Slot(32), scoped.BeaconConfig().SlotsPerEpoch)
+	assert.Equal(t, ConfigNames[Mainnet], BeaconConfig().ConfigName, "Expected the global config to be untouched")
+}
+
+func TestOverrideBeaconConfig_Concurrent(t *testing.T) {
+	SetupTestConfigCleanup(t)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			OverrideBeaconConfig(MainnetConfig())
+		}()
+		go func() {
+			defer wg.Done()
+			assert.NotNil(t, BeaconConfig())
+		}()
+	}
+	wg.Wait()
+}
@@ -3,14 +3,23 @@
 package params
 
 import (
+	"sync/atomic"
+
 	"github.com/mohae/deepcopy"
 )
 
-var beaconConfig = MainnetConfig()
+// beaconConfig holds the *BeaconChainConfig in effect. It is swapped atomically, so that
+// OverrideBeaconConfig is safe while other goroutines read the config, without the lock the
+// develop build takes on every read.
+var beaconConfig atomic.Value
+
+func init() {
+	beaconConfig.Store(MainnetConfig())
+}
 
 // BeaconConfig retrieves beacon chain config.
 func BeaconConfig() *BeaconChainConfig {
-	return beaconConfig
+	return beaconConfig.Load().(*BeaconChainConfig)
 }
 
 // OverrideBeaconConfig by replacing the config. The preferred pattern is to
@@ -18,14 +27,14 @@ func BeaconConfig() *BeaconChainConfig {
 // OverrideBeaconConfig(c). Any subsequent calls to params.BeaconConfig() will
 // return this new configuration.
 func OverrideBeaconConfig(c *BeaconChainConfig) {
-	beaconConfig = c
+	beaconConfig.Store(c)
 }
 
 // Copy returns a copy of the config object.
 func (c *BeaconChainConfig) Copy() *BeaconChainConfig {
 	config, ok := deepcopy.Copy(*c).(BeaconChainConfig)
 	if !ok {
-		config = *beaconConfig
+		config = *BeaconConfig()
 	}
 	return &config
 }
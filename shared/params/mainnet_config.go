@@ -14,7 +14,7 @@ func MainnetConfig() *BeaconChainConfig {
 
 // UseMainnetConfig for beacon chain services.
 func UseMainnetConfig() {
-	beaconConfig = MainnetConfig()
+	OverrideBeaconConfig(MainnetConfig())
 }
 
 var mainnetNetworkConfig = &NetworkConfig{
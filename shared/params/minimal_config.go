@@ -4,7 +4,7 @@ import "github.com/prysmaticlabs/prysm/shared/bytesutil"
 
 // UseMinimalConfig for beacon chain services.
 func UseMinimalConfig() {
-	beaconConfig = MinimalSpecConfig()
+	OverrideBeaconConfig(MinimalSpecConfig())
 }
 
 // MinimalSpecConfig retrieves the minimal config used in spec tests.
@@ -0,0 +1,87 @@
+package params
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Preset is a named network configuration which can be selected at startup, instead of
+// overriding the config values of a network one by one.
+type Preset struct {
+	Name string
+	// Config returns the beacon chain config of the network.
+	Config func() *BeaconChainConfig
+	// NetworkConfig adjusts a copy of the current network config to the network, if set.
+	NetworkConfig func(cfg *NetworkConfig)
+}
+
+var (
+	presets     = make(map[string]*Preset)
+	presetsLock sync.RWMutex
+)
+
+func init() {
+	for _, p := range []*Preset{
+		{Name: ConfigNames[Mainnet], Config: MainnetConfig},
+		{Name: ConfigNames[Pyrmont], Config: PyrmontConfig, NetworkConfig: pyrmontNetworkConfig},
+		{Name: ConfigNames[Prater], Config: PraterConfig, NetworkConfig: praterNetworkConfig},
+		{Name: ConfigNames[Toledo], Config: ToledoConfig, NetworkConfig: toledoNetworkConfig},
+		{Name: ConfigNames[L15], Config: L15Config},
+		{Name: ConfigNames[L16], Config: L16Config},
+		{Name: ConfigNames[MainnetVan], Config: MainnetVanConfig},
+	} {
+		if err := RegisterPreset(p); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// RegisterPreset makes a network preset selectable by its name.
+func RegisterPreset(p *Preset) error {
+	if p == nil || p.Name == "" || p.Config == nil {
+		return fmt.Errorf("preset needs a name and a config")
+	}
+	presetsLock.Lock()
+	defer presetsLock.Unlock()
+	if _, ok := presets[p.Name]; ok {
+		return fmt.Errorf("preset %q is already registered", p.Name)
+	}
+	presets[p.Name] = p
+	return nil
+}
+
+// PresetByName returns the registered network preset of the given name.
+func PresetByName(name string) (*Preset, bool) {
+	presetsLock.RLock()
+	defer presetsLock.RUnlock()
+	p, ok := presets[name]
+	return p, ok
+}
+
+// PresetNames returns the names of the registered network presets in alphabetical order.
+func PresetNames() []string {
+	presetsLock.RLock()
+	defer presetsLock.RUnlock()
+	names := make([]string, 0, len(presets))
+	for name := range presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// UsePreset sets the beacon chain and network configs of the named network preset.
+func UsePreset(name string) error {
+	p, ok := PresetByName(name)
+	if !ok {
+		return fmt.Errorf("unknown network %q, wanted one of %v", name, PresetNames())
+	}
+	OverrideBeaconConfig(p.Config())
+	if p.NetworkConfig != nil {
+		cfg := BeaconNetworkConfig().Copy()
+		p.NetworkConfig(cfg)
+		OverrideBeaconNetworkConfig(cfg)
+	}
+	return nil
+}
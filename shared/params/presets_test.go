@@ -0,0 +1,37 @@
+package params
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestUsePreset(t *testing.T) {
+	SetupTestConfigCleanup(t)
+	prevNetworkCfg := BeaconNetworkConfig()
+	defer OverrideBeaconNetworkConfig(prevNetworkCfg)
+	for _, name := range []string{"l15", "l16", "mainnet-van", "prater"} {
+		require.NoError(t, UsePreset(name))
+		assert.Equal(t, name, BeaconConfig().ConfigName)
+	}
+	assert.Equal(t, uint64(4367322), BeaconNetworkConfig().ContractDeploymentBlock)
+
+	require.NoError(t, UsePreset("l15"))
+	assert.Equal(t, uint64(6), BeaconConfig().SecondsPerSlot)
+	assert.DeepEqual(t, []byte{0x83, 0xa5, 0x53, 0x17}, BeaconConfig().GenesisForkVersion)
+	assert.ErrorContains(t, "unknown network \"l14\"", UsePreset("l14"))
+}
+
+func TestRegisterPreset(t *testing.T) {
+	assert.ErrorContains(t, "already registered", RegisterPreset(&Preset{Name: "mainnet", Config: MainnetConfig}))
+	assert.ErrorContains(t, "needs a name and a config", RegisterPreset(&Preset{Name: "custom"}))
+	names := PresetNames()
+	for _, name := range []string{"l15", "l16", "mainnet", "mainnet-van", "prater", "pyrmont", "toledo"} {
+		_, ok := PresetByName(name)
+		assert.Equal(t, true, ok, "Missing preset %s", name)
+	}
+	for i := 1; i < len(names); i++ {
+		assert.Equal(t, true, names[i-1] < names[i], "Preset names are not sorted")
+	}
+}
@@ -2,7 +2,7 @@ package params
 
 // UseE2EConfig for beacon chain services.
 func UseE2EConfig() {
-	beaconConfig = E2ETestConfig()
+	OverrideBeaconConfig(E2ETestConfig())
 
 	cfg := BeaconNetworkConfig().Copy()
 	// Due to the small number of peers in the e2e test network
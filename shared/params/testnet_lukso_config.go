@@ -0,0 +1,41 @@
+package params
+
+// luksoDepositContractAddress is the genesis deposit contract of the LUKSO networks.
+const luksoDepositContractAddress = "0x000000000000000000000000000000000000cafe"
+
+// L15Config defines the config for the
+// LUKSO L15 test network.
+func L15Config() *BeaconChainConfig {
+	cfg := MainnetConfig().Copy()
+	cfg.ConfigName = ConfigNames[L15]
+	cfg.SecondsPerSlot = 6
+	cfg.SlotsPerEpoch = 32
+	cfg.GenesisForkVersion = []byte{0x83, 0xa5, 0x53, 0x17}
+	cfg.DepositChainID = 23
+	cfg.DepositNetworkID = 23
+	cfg.DepositContractAddress = luksoDepositContractAddress
+	return cfg
+}
+
+// L16Config defines the config for the
+// LUKSO L16 test network.
+func L16Config() *BeaconChainConfig {
+	cfg := MainnetConfig().Copy()
+	cfg.ConfigName = ConfigNames[L16]
+	cfg.SecondsPerSlot = 12
+	cfg.SlotsPerEpoch = 32
+	cfg.GenesisForkVersion = []byte{0x60, 0x00, 0x00, 0x69}
+	cfg.DepositChainID = 2828
+	cfg.DepositNetworkID = 2828
+	cfg.DepositContractAddress = luksoDepositContractAddress
+	return cfg
+}
+
+// MainnetVanConfig defines the config of the Vanguard
+// chain running alongside the LUKSO orchestrator.
+func MainnetVanConfig() *BeaconChainConfig {
+	cfg := L15Config()
+	cfg.ConfigName = ConfigNames[MainnetVan]
+	cfg.GenesisForkVersion = []byte{0x83, 0xa5, 0x53, 0x20}
+	return cfg
+}
@@ -8,6 +8,13 @@ import (
 // network config.
 func UsePraterNetworkConfig() {
 	cfg := BeaconNetworkConfig().Copy()
+	praterNetworkConfig(cfg)
+	OverrideBeaconNetworkConfig(cfg)
+}
+
+// praterNetworkConfig sets the Prater specific
+// values of the network config.
+func praterNetworkConfig(cfg *NetworkConfig) {
 	cfg.ContractDeploymentBlock = 4367322
 	cfg.BootstrapNodes = []string{
 		// Prysm's bootnode
@@ -19,13 +26,12 @@ func UsePraterNetworkConfig() {
 		// Teku's bootnode By Afri
 		"enr:-KG4QCIzJZTY_fs_2vqWEatJL9RrtnPwDCv-jRBuO5FQ2qBrfJubWOWazri6s9HsyZdu-fRUfEzkebhf1nvO42_FVzwDhGV0aDKQed8EKAAAECD__________4JpZIJ2NIJpcISHtbYziXNlY3AyNTZrMaED4m9AqVs6F32rSCGsjtYcsyfQE2K8nDiGmocUY_iq-TSDdGNwgiMog3VkcIIjKA",
 	}
-	OverrideBeaconNetworkConfig(cfg)
 }
 
 // UsePraterConfig sets the main beacon chain
 // config for Prater.
 func UsePraterConfig() {
-	beaconConfig = PraterConfig()
+	OverrideBeaconConfig(PraterConfig())
 }
 
 // PraterConfig defines the config for the
@@ -4,18 +4,24 @@ package params
 // network config.
 func UsePyrmontNetworkConfig() {
 	cfg := BeaconNetworkConfig().Copy()
+	pyrmontNetworkConfig(cfg)
+	OverrideBeaconNetworkConfig(cfg)
+}
+
+// pyrmontNetworkConfig sets the Pyrmont specific
+// values of the network config.
+func pyrmontNetworkConfig(cfg *NetworkConfig) {
 	cfg.ContractDeploymentBlock = 3743587
 	cfg.BootstrapNodes = []string{
 		"enr:-Ku4QOA5OGWObY8ep_x35NlGBEj7IuQULTjkgxC_0G1AszqGEA0Wn2RNlyLFx9zGTNB1gdFBA6ZDYxCgIza1uJUUOj4Dh2F0dG5ldHOIAAAAAAAAAACEZXRoMpDVTPWXAAAgCf__________gmlkgnY0gmlwhDQPSjiJc2VjcDI1NmsxoQM6yTQB6XGWYJbI7NZFBjp4Yb9AYKQPBhVrfUclQUobb4N1ZHCCIyg",
 		"enr:-Ku4QOksdA2tabOGrfOOr6NynThMoio6Ggka2oDPqUuFeWCqcRM2alNb8778O_5bK95p3EFt0cngTUXm2H7o1jkSJ_8Dh2F0dG5ldHOIAAAAAAAAAACEZXRoMpDVTPWXAAAgCf__________gmlkgnY0gmlwhDaa13aJc2VjcDI1NmsxoQKdNQJvnohpf0VO0ZYCAJxGjT0uwJoAHbAiBMujGjK0SoN1ZHCCIyg",
 	}
-	OverrideBeaconNetworkConfig(cfg)
 }
 
 // UsePyrmontConfig sets the main beacon chain
 // config for Pyrmont.
 func UsePyrmontConfig() {
-	beaconConfig = PyrmontConfig()
+	OverrideBeaconConfig(PyrmontConfig())
 }
 
 // PyrmontConfig defines the config for the
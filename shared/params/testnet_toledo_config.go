@@ -4,18 +4,24 @@ package params
 // network config.
 func UseToledoNetworkConfig() {
 	cfg := BeaconNetworkConfig().Copy()
+	toledoNetworkConfig(cfg)
+	OverrideBeaconNetworkConfig(cfg)
+}
+
+// toledoNetworkConfig sets the Toledo specific
+// values of the network config.
+func toledoNetworkConfig(cfg *NetworkConfig) {
 	cfg.ContractDeploymentBlock = 3702432
 	cfg.BootstrapNodes = []string{
 		// Prysm Bootnode 1
 		"enr:-Ku4QL5E378NT4-vqP6v1mZ7kHxiTHJvuBvQixQsuTTCffa0PJNWMBlG3Mduvsvd6T2YP1U3l5tBKO5H-9wyX2SCtPkBh2F0dG5ldHOIAAAAAAAAAACEZXRoMpC4EvfsAHAe0P__________gmlkgnY0gmlwhDaetEeJc2VjcDI1NmsxoQKtGC2CAuba7goLLdle899M3esUmoWRvzi7GBVhq6ViCYN1ZHCCIyg",
 	}
-	OverrideBeaconNetworkConfig(cfg)
 }
 
 // UseToledoConfig sets the main beacon chain
 // config for Toledo testnet.
 func UseToledoConfig() {
-	beaconConfig = ToledoConfig()
+	OverrideBeaconConfig(ToledoConfig())
 }
 
 // ToledoConfig defines the config for the
@@ -6,11 +6,11 @@ import "testing"
 // restrictions, everything is restored after the test.
 func SetupTestConfigCleanup(t testing.TB) {
 	prevDefaultBeaconConfig := mainnetBeaconConfig.Copy()
-	prevBeaconConfig := beaconConfig.Copy()
+	prevBeaconConfig := BeaconConfig().Copy()
 	prevNetworkCfg := mainnetNetworkConfig.Copy()
 	t.Cleanup(func() {
 		mainnetBeaconConfig = prevDefaultBeaconConfig
-		beaconConfig = prevBeaconConfig
+		OverrideBeaconConfig(prevBeaconConfig)
 		mainnetNetworkConfig = prevNetworkCfg
 	})
 }
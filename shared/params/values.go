@@ -6,15 +6,21 @@ const (
 	Pyrmont
 	Toledo
 	Prater
+	L15
+	L16
+	MainnetVan
 )
 
 // ConfigNames provides network configuration names.
 var ConfigNames = map[ConfigName]string{
-	Mainnet:  "mainnet",
-	EndToEnd: "end-to-end",
-	Pyrmont:  "pyrmont",
-	Toledo:   "toledo",
-	Prater:   "prater",
+	Mainnet:    "mainnet",
+	EndToEnd:   "end-to-end",
+	Pyrmont:    "pyrmont",
+	Toledo:     "toledo",
+	Prater:     "prater",
+	L15:        "l15",
+	L16:        "l16",
+	MainnetVan: "mainnet-van",
 }
 
 // ConfigName enum describes the type of known network in use.
@@ -69,7 +69,7 @@ func GenerateAttestations(
 			return nil, err
 		}
 		headState := iface.BeaconState(genState)
-		headState, err = state.ProcessSlots(context.Background(), headState, slot+1)
+		headState, err = state.ProcessSlots(context.Background(), nil, headState, slot+1)
 		if err != nil {
 			return nil, err
 		}
@@ -97,7 +97,7 @@ func GenerateAttestations(
 		headRoot = b
 	}
 
-	activeValidatorCount, err := helpers.ActiveValidatorCount(bState, currentEpoch)
+	activeValidatorCount, err := helpers.ActiveValidatorCount(nil, bState, currentEpoch)
 	if err != nil {
 		return nil, err
 	}
@@ -132,7 +132,7 @@ func GenerateAttestations(
 		return nil, err
 	}
 	for c := types.CommitteeIndex(0); uint64(c) < committeesPerSlot && uint64(c) < numToGen; c++ {
-		committee, err := helpers.BeaconCommitteeFromState(bState, slot, c)
+		committee, err := helpers.BeaconCommitteeFromState(nil, bState, slot, c)
 		if err != nil {
 			return nil, err
 		}
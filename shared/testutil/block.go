@@ -158,7 +158,7 @@ func GenerateFullBlock(
 		return nil, err
 	}
 
-	idx, err := helpers.BeaconProposerIndex(bState)
+	idx, err := helpers.BeaconProposerIndex(nil, bState)
 	if err != nil {
 		return nil, err
 	}
@@ -316,7 +316,7 @@ func generateAttesterSlashings(
 	randGen := rand.NewDeterministicGenerator()
 	for i := uint64(0); i < numSlashings; i++ {
 		committeeIndex := randGen.Uint64() % params.BeaconConfig().MaxCommitteesPerSlot
-		committee, err := helpers.BeaconCommitteeFromState(bState, bState.Slot(), types.CommitteeIndex(committeeIndex))
+		committee, err := helpers.BeaconCommitteeFromState(nil, bState, bState.Slot(), types.CommitteeIndex(committeeIndex))
 		if err != nil {
 			return nil, err
 		}
@@ -380,7 +380,7 @@ func generateVoluntaryExits(
 }
 
 func randValIndex(bState iface.BeaconState) (types.ValidatorIndex, error) {
-	activeCount, err := helpers.ActiveValidatorCount(bState, helpers.CurrentEpoch(bState))
+	activeCount, err := helpers.ActiveValidatorCount(nil, bState, helpers.CurrentEpoch(bState))
 	if err != nil {
 		return 0, err
 	}
@@ -22,7 +22,7 @@ func TestGenerateFullBlock_PassesStateTransition(t *testing.T) {
 	}
 	block, err := GenerateFullBlock(beaconState, privs, conf, beaconState.Slot())
 	require.NoError(t, err)
-	_, err = state.ExecuteStateTransition(context.Background(), beaconState, block)
+	_, err = state.ExecuteStateTransition(context.Background(), nil, beaconState, block)
 	require.NoError(t, err)
 }
 
@@ -35,7 +35,7 @@ func TestGenerateFullBlock_ThousandValidators(t *testing.T) {
 	}
 	block, err := GenerateFullBlock(beaconState, privs, conf, beaconState.Slot())
 	require.NoError(t, err)
-	_, err = state.ExecuteStateTransition(context.Background(), beaconState, block)
+	_, err = state.ExecuteStateTransition(context.Background(), nil, beaconState, block)
 	require.NoError(t, err)
 }
 
@@ -53,7 +53,7 @@ func TestGenerateFullBlock_Passes4Epochs(t *testing.T) {
 		helpers.ClearCache()
 		block, err := GenerateFullBlock(beaconState, privs, conf, beaconState.Slot())
 		require.NoError(t, err)
-		beaconState, err = state.ExecuteStateTransition(context.Background(), beaconState, block)
+		beaconState, err = state.ExecuteStateTransition(context.Background(), nil, beaconState, block)
 		require.NoError(t, err)
 	}
 
@@ -78,7 +78,7 @@ func TestGenerateFullBlock_ValidProposerSlashings(t *testing.T) {
 	}
 	block, err := GenerateFullBlock(beaconState, privs, conf, beaconState.Slot()+1)
 	require.NoError(t, err)
-	beaconState, err = state.ExecuteStateTransition(context.Background(), beaconState, block)
+	beaconState, err = state.ExecuteStateTransition(context.Background(), nil, beaconState, block)
 	require.NoError(t, err)
 
 	slashableIndice := block.Block.Body.ProposerSlashings[0].Header_1.Header.ProposerIndex
@@ -97,7 +97,7 @@ func TestGenerateFullBlock_ValidAttesterSlashings(t *testing.T) {
 	}
 	block, err := GenerateFullBlock(beaconState, privs, conf, beaconState.Slot())
 	require.NoError(t, err)
-	beaconState, err = state.ExecuteStateTransition(context.Background(), beaconState, block)
+	beaconState, err = state.ExecuteStateTransition(context.Background(), nil, beaconState, block)
 	require.NoError(t, err)
 
 	slashableIndices := block.Block.Body.AttesterSlashings[0].Attestation_1.AttestingIndices
@@ -117,7 +117,7 @@ func TestGenerateFullBlock_ValidAttestations(t *testing.T) {
 	}
 	block, err := GenerateFullBlock(beaconState, privs, conf, beaconState.Slot())
 	require.NoError(t, err)
-	beaconState, err = state.ExecuteStateTransition(context.Background(), beaconState, block)
+	beaconState, err = state.ExecuteStateTransition(context.Background(), nil, beaconState, block)
 	require.NoError(t, err)
 	atts, err := beaconState.CurrentEpochAttestations()
 	require.NoError(t, err)
@@ -138,7 +138,7 @@ func TestGenerateFullBlock_ValidDeposits(t *testing.T) {
 	}
 	block, err := GenerateFullBlock(beaconState, privs, conf, beaconState.Slot())
 	require.NoError(t, err)
-	beaconState, err = state.ExecuteStateTransition(context.Background(), beaconState, block)
+	beaconState, err = state.ExecuteStateTransition(context.Background(), nil, beaconState, block)
 	require.NoError(t, err)
 
 	depositedPubkey := block.Block.Body.Deposits[0].Data.PublicKey
@@ -164,7 +164,7 @@ func TestGenerateFullBlock_ValidVoluntaryExits(t *testing.T) {
 	}
 	block, err := GenerateFullBlock(beaconState, privs, conf, beaconState.Slot())
 	require.NoError(t, err)
-	beaconState, err = state.ExecuteStateTransition(context.Background(), beaconState, block)
+	beaconState, err = state.ExecuteStateTransition(context.Background(), nil, beaconState, block)
 	require.NoError(t, err)
 
 	exitedIndex := block.Block.Body.VoluntaryExits[0].Exit.ValidatorIndex
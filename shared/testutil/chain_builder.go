@@ -0,0 +1,194 @@
+package testutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+)
+
+// defaultChainValidators is the number of deterministic validators of a chain built without
+// WithValidators.
+const defaultChainValidators = 64
+
+// ChainOption configures a ChainBuilder.
+type ChainOption func(b *ChainBuilder)
+
+// WithValidators sets the number of deterministic validators of the genesis state.
+func WithValidators(numValidators uint64) ChainOption {
+	return func(b *ChainBuilder) {
+		b.numValidators = numValidators
+	}
+}
+
+// WithMissedSlots leaves the given slots without a block. The chain still has the requested
+// number of blocks, so it extends past the missed slots.
+func WithMissedSlots(slots ...types.Slot) ChainOption {
+	return func(b *ChainBuilder) {
+		for _, slot := range slots {
+			b.missedSlots[slot] = true
+		}
+	}
+}
+
+// ChainBuilder builds a deterministic chain of blocks on the deterministic genesis state, so that
+// tests needing a chain share a single setup instead of generating blocks and states inline. The
+// same options always build the same chain under a given config.
+type ChainBuilder struct {
+	numBlocks     uint64
+	numValidators uint64
+	missedSlots   map[types.Slot]bool
+}
+
+// Chain is a chain built by a ChainBuilder.
+type Chain struct {
+	// Genesis is the genesis state.
+	Genesis iface.BeaconState
+	// PrivKeys are the keys of the validators, for tests extending the chain.
+	PrivKeys []bls.SecretKey
+	// Blocks holds the genesis block followed by the blocks of the chain, in slot order.
+	Blocks []*ethpb.SignedBeaconBlock
+	// Roots holds the roots of Blocks, in the same order.
+	Roots [][32]byte
+	// States holds the state at the start slot of every epoch up to the head, by slot. The state
+	// of an epoch whose start slot was missed is the post state of the latest block before it,
+	// advanced to the start slot.
+	States map[types.Slot]iface.BeaconState
+	// Head is the post state of the last block.
+	Head iface.BeaconState
+}
+
+// ChainStore is the part of the beacon database a chain is registered into.
+type ChainStore interface {
+	ChainSnapshotStore
+	SaveStateSummaries(ctx context.Context, summaries []*pb.StateSummary) error
+	SaveHeadBlockRoot(ctx context.Context, blockRoot [32]byte) error
+}
+
+// NewChainBuilder returns a builder of a chain of numBlocks blocks following the genesis block.
+func NewChainBuilder(numBlocks uint64, opts ...ChainOption) *ChainBuilder {
+	b := &ChainBuilder{
+		numBlocks:     numBlocks,
+		numValidators: defaultChainValidators,
+		missedSlots:   make(map[types.Slot]bool),
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
+}
+
+// Build builds the chain.
+func (b *ChainBuilder) Build(ctx context.Context) (*Chain, error) {
+	genesis, privKeys, err := deterministicGenesisState(b.numValidators)
+	if err != nil {
+		return nil, err
+	}
+	stateRoot, err := genesis.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	genesisBlk := blocks.NewGenesisBlock(stateRoot[:])
+	genesisRoot, err := genesisBlk.Block.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	chain := &Chain{
+		Genesis:  genesis.Copy(),
+		PrivKeys: privKeys,
+		Blocks:   []*ethpb.SignedBeaconBlock{genesisBlk},
+		Roots:    [][32]byte{genesisRoot},
+		States:   make(map[types.Slot]iface.BeaconState),
+	}
+	st := genesis
+	for slot := types.Slot(1); uint64(len(chain.Blocks)) <= b.numBlocks; slot++ {
+		if b.missedSlots[slot] {
+			if helpers.IsEpochStart(slot) {
+				boundary, err := state.ProcessSlots(ctx, nil, st.Copy(), slot)
+				if err != nil {
+					return nil, errors.Wrapf(err, "could not process slots up to %d", slot)
+				}
+				chain.States[slot] = boundary
+			}
+			continue
+		}
+		blk, err := GenerateFullBlock(st, privKeys, DefaultBlockGenConfig(), slot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not generate block of slot %d", slot)
+		}
+		st, err = state.ExecuteStateTransition(ctx, nil, st, blk)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not process block of slot %d", slot)
+		}
+		root, err := blk.Block.HashTreeRoot()
+		if err != nil {
+			return nil, err
+		}
+		chain.Blocks = append(chain.Blocks, blk)
+		chain.Roots = append(chain.Roots, root)
+		if helpers.IsEpochStart(slot) {
+			chain.States[slot] = st.Copy()
+		}
+	}
+	chain.Head = st
+	return chain, nil
+}
+
+// BuildInto builds the chain and registers it into the database, failing the test on error.
+func (b *ChainBuilder) BuildInto(t testing.TB, db ChainStore) *Chain {
+	ctx := context.Background()
+	chain, err := b.Build(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := chain.SaveTo(ctx, db); err != nil {
+		t.Fatal(errors.Wrap(err, "could not save chain"))
+	}
+	return chain
+}
+
+// SaveTo saves the blocks of the chain along with their state summaries, the genesis state and
+// the epoch boundary states, and marks the last block as head. A boundary state is saved under
+// the root of the latest block at or before its slot, as the state generator does.
+func (c *Chain) SaveTo(ctx context.Context, db ChainStore) error {
+	if err := db.SaveBlocks(ctx, c.Blocks); err != nil {
+		return err
+	}
+	summaries := make([]*pb.StateSummary, len(c.Blocks))
+	for i, blk := range c.Blocks {
+		summaries[i] = &pb.StateSummary{Slot: blk.Block.Slot, Root: c.Roots[i][:]}
+	}
+	if err := db.SaveStateSummaries(ctx, summaries); err != nil {
+		return err
+	}
+	if err := db.SaveGenesisBlockRoot(ctx, c.Roots[0]); err != nil {
+		return err
+	}
+	if err := db.SaveState(ctx, c.Genesis, c.Roots[0]); err != nil {
+		return err
+	}
+	for slot, st := range c.States {
+		if err := db.SaveState(ctx, st, c.BlockRootAtOrBefore(slot)); err != nil {
+			return err
+		}
+	}
+	return db.SaveHeadBlockRoot(ctx, c.Roots[len(c.Roots)-1])
+}
+
+// BlockRootAtOrBefore returns the root of the latest block of the chain at or before the slot.
+func (c *Chain) BlockRootAtOrBefore(slot types.Slot) [32]byte {
+	for i := len(c.Blocks) - 1; i > 0; i-- {
+		if c.Blocks[i].Block.Slot <= slot {
+			return c.Roots[i]
+		}
+	}
+	return c.Roots[0]
+}
@@ -0,0 +1,60 @@
+package testutil
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestChainBuilder_BuildInto(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	ctx := context.Background()
+	db := dbTest.SetupDB(t)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	numBlocks := uint64(2 * slotsPerEpoch)
+	chain := NewChainBuilder(numBlocks, WithValidators(32), WithMissedSlots(3, slotsPerEpoch)).BuildInto(t, db)
+	require.Equal(t, int(numBlocks)+1, len(chain.Blocks))
+	assert.Equal(t, 32, len(chain.PrivKeys))
+	for i, blk := range chain.Blocks[1:] {
+		assert.Equal(t, chain.Roots[i], bytesutil.ToBytes32(blk.Block.ParentRoot), "Block %d is not connected", i+1)
+		assert.NotEqual(t, types.Slot(3), blk.Block.Slot)
+		assert.NotEqual(t, slotsPerEpoch, blk.Block.Slot)
+	}
+	headSlot := chain.Blocks[numBlocks].Block.Slot
+	assert.Equal(t, 2*slotsPerEpoch+2, headSlot, "Expected the chain to extend past the missed slots")
+	assert.Equal(t, headSlot, chain.Head.Slot())
+
+	// The boundary state of the missed epoch start slot is advanced from the latest block.
+	require.Equal(t, 2, len(chain.States))
+	assert.Equal(t, slotsPerEpoch, chain.States[slotsPerEpoch].Slot())
+	assert.Equal(t, 2*slotsPerEpoch, chain.States[2*slotsPerEpoch].Slot())
+	assert.Equal(t, chain.Roots[slotsPerEpoch-2], chain.BlockRootAtOrBefore(slotsPerEpoch))
+
+	headBlk, err := db.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, headSlot, headBlk.Block.Slot)
+	genesisBlk, err := db.GenesisBlock(ctx)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, chain.Blocks[0], genesisBlk)
+	boundary, err := db.State(ctx, chain.BlockRootAtOrBefore(slotsPerEpoch))
+	require.NoError(t, err)
+	require.NotNil(t, boundary)
+	assert.Equal(t, slotsPerEpoch, boundary.Slot())
+
+	// The state generator replays the head state from the saved chain.
+	head, err := stategen.New(db).StateByRoot(ctx, chain.Roots[numBlocks])
+	require.NoError(t, err)
+	wantRoot, err := chain.Head.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	gotRoot, err := head.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, wantRoot, gotRoot)
+}
@@ -0,0 +1,276 @@
+package testutil
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// Entries of a chain snapshot archive.
+const (
+	chainSnapshotManifest = "manifest.json"
+	chainSnapshotGenesis  = "genesis.ssz"
+	chainSnapshotBlocks   = "blocks"
+	chainSnapshotStates   = "states"
+)
+
+// ChainSnapshotManifest describes the chain of a snapshot.
+type ChainSnapshotManifest struct {
+	// ConfigName is the name of the beacon config the chain was built with. Snapshots only load
+	// under a config of the same name and slots per epoch, as derived configs keep the name.
+	ConfigName    string     `json:"config_name"`
+	SlotsPerEpoch types.Slot `json:"slots_per_epoch"`
+	// Validators is the number of deterministic validators of the genesis state.
+	Validators uint64 `json:"validators"`
+	// Blocks is the number of blocks following the genesis block, one per slot.
+	Blocks uint64 `json:"blocks"`
+}
+
+// ChainSnapshot is a deterministic minimal chain of a block per slot, built from the deterministic
+// genesis state, along with the post states of the blocks at epoch boundaries. Tests needing a
+// long chain load a snapshot rather than building the chain inline.
+type ChainSnapshot struct {
+	Manifest ChainSnapshotManifest
+	// Genesis is the genesis state.
+	Genesis iface.BeaconState
+	// Blocks holds the genesis block followed by a block per slot, in slot order.
+	Blocks []*ethpb.SignedBeaconBlock
+	// States holds the post states of the blocks at the start slot of an epoch, by slot.
+	States map[types.Slot]iface.BeaconState
+}
+
+// GenerateChainSnapshot builds a chain of numBlocks blocks on the deterministic genesis state of
+// numValidators validators. The same arguments always build the same chain under a given config.
+func GenerateChainSnapshot(ctx context.Context, numValidators, numBlocks uint64) (*ChainSnapshot, error) {
+	chain, err := NewChainBuilder(numBlocks, WithValidators(numValidators)).Build(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ChainSnapshot{
+		Manifest: ChainSnapshotManifest{
+			ConfigName:    params.BeaconConfig().ConfigName,
+			SlotsPerEpoch: params.BeaconConfig().SlotsPerEpoch,
+			Validators:    numValidators,
+			Blocks:        numBlocks,
+		},
+		Genesis: chain.Genesis,
+		Blocks:  chain.Blocks,
+		States:  chain.States,
+	}, nil
+}
+
+// Write writes the snapshot as a gzipped tar archive. The archive of a snapshot is byte for byte
+// deterministic.
+func (s *ChainSnapshot) Write(w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	writeEntry := func(name string, data []byte) error {
+		if err := tw.WriteHeader(&tar.Header{
+			Name:    name,
+			Mode:    0644,
+			Size:    int64(len(data)),
+			ModTime: time.Unix(0, 0),
+			Format:  tar.FormatPAX,
+		}); err != nil {
+			return err
+		}
+		_, err := tw.Write(data)
+		return err
+	}
+
+	manifest, err := json.Marshal(&s.Manifest)
+	if err != nil {
+		return err
+	}
+	if err := writeEntry(chainSnapshotManifest, manifest); err != nil {
+		return err
+	}
+	genesis, err := s.Genesis.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	if err := writeEntry(chainSnapshotGenesis, genesis); err != nil {
+		return err
+	}
+	for _, blk := range s.Blocks {
+		enc, err := blk.MarshalSSZ()
+		if err != nil {
+			return err
+		}
+		if err := writeEntry(chainSnapshotEntryName(chainSnapshotBlocks, blk.Block.Slot), enc); err != nil {
+			return err
+		}
+	}
+	slots := make([]types.Slot, 0, len(s.States))
+	for slot := range s.States {
+		slots = append(slots, slot)
+	}
+	sort.Slice(slots, func(i, j int) bool { return slots[i] < slots[j] })
+	for _, slot := range slots {
+		enc, err := s.States[slot].MarshalSSZ()
+		if err != nil {
+			return err
+		}
+		if err := writeEntry(chainSnapshotEntryName(chainSnapshotStates, slot), enc); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// ReadChainSnapshot reads a snapshot written by ChainSnapshot.Write. The snapshot must have been
+// built under the current beacon config.
+func ReadChainSnapshot(r io.Reader) (*ChainSnapshot, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	tr := tar.NewReader(gz)
+	snapshot := &ChainSnapshot{States: make(map[types.Slot]iface.BeaconState)}
+	var hasManifest bool
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		switch dir := path.Dir(hdr.Name); {
+		case hdr.Name == chainSnapshotManifest:
+			if err := json.Unmarshal(data, &snapshot.Manifest); err != nil {
+				return nil, errors.Wrap(err, "invalid manifest")
+			}
+			hasManifest = true
+		case hdr.Name == chainSnapshotGenesis:
+			snapshot.Genesis, err = unmarshalSnapshotState(data)
+			if err != nil {
+				return nil, errors.Wrap(err, "invalid genesis state")
+			}
+		case dir == chainSnapshotBlocks:
+			blk := &ethpb.SignedBeaconBlock{}
+			if err := blk.UnmarshalSSZ(data); err != nil {
+				return nil, errors.Wrapf(err, "invalid block %s", hdr.Name)
+			}
+			snapshot.Blocks = append(snapshot.Blocks, blk)
+		case dir == chainSnapshotStates:
+			st, err := unmarshalSnapshotState(data)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid state %s", hdr.Name)
+			}
+			snapshot.States[st.Slot()] = st
+		default:
+			return nil, fmt.Errorf("unexpected snapshot entry %s", hdr.Name)
+		}
+	}
+	if !hasManifest || snapshot.Genesis == nil {
+		return nil, errors.New("snapshot has no manifest or genesis state")
+	}
+	cfg := params.BeaconConfig()
+	if snapshot.Manifest.ConfigName != cfg.ConfigName || snapshot.Manifest.SlotsPerEpoch != cfg.SlotsPerEpoch {
+		return nil, fmt.Errorf(
+			"snapshot was built with config %s of %d slots per epoch, not %s of %d slots per epoch",
+			snapshot.Manifest.ConfigName,
+			snapshot.Manifest.SlotsPerEpoch,
+			cfg.ConfigName,
+			cfg.SlotsPerEpoch,
+		)
+	}
+	if uint64(len(snapshot.Blocks)) != snapshot.Manifest.Blocks+1 {
+		return nil, fmt.Errorf("snapshot has %d blocks, wanted %d", len(snapshot.Blocks), snapshot.Manifest.Blocks+1)
+	}
+	return snapshot, nil
+}
+
+// LoadChainSnapshot reads the snapshot archive at the given path, failing the test on error.
+func LoadChainSnapshot(t testing.TB, path string) *ChainSnapshot {
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	snapshot, err := ReadChainSnapshot(f)
+	if err != nil {
+		t.Fatal(errors.Wrapf(err, "could not read chain snapshot %s", path))
+	}
+	return snapshot
+}
+
+// ChainSnapshotStore is the part of the beacon database a snapshot is saved to.
+type ChainSnapshotStore interface {
+	SaveBlocks(ctx context.Context, blocks []*ethpb.SignedBeaconBlock) error
+	SaveState(ctx context.Context, state iface.ReadOnlyBeaconState, blockRoot [32]byte) error
+	SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte) error
+}
+
+// SaveTo saves the blocks, the genesis state and the epoch boundary states of the snapshot.
+func (s *ChainSnapshot) SaveTo(ctx context.Context, db ChainSnapshotStore) error {
+	if err := db.SaveBlocks(ctx, s.Blocks); err != nil {
+		return err
+	}
+	genesisRoot, err := s.Blocks[0].Block.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	if err := db.SaveGenesisBlockRoot(ctx, genesisRoot); err != nil {
+		return err
+	}
+	if err := db.SaveState(ctx, s.Genesis, genesisRoot); err != nil {
+		return err
+	}
+	for _, blk := range s.Blocks[1:] {
+		st, ok := s.States[blk.Block.Slot]
+		if !ok {
+			continue
+		}
+		root, err := blk.Block.HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		if err := db.SaveState(ctx, st, root); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// chainSnapshotEntryName returns the archive entry name of the block or state of a slot. Slots
+// are zero padded so that entries are listed in slot order.
+func chainSnapshotEntryName(dir string, slot types.Slot) string {
+	return fmt.Sprintf("%s/%010d.ssz", dir, slot)
+}
+
+func unmarshalSnapshotState(data []byte) (iface.BeaconState, error) {
+	st := &pb.BeaconState{}
+	if err := st.UnmarshalSSZ(data); err != nil {
+		return nil, err
+	}
+	return stateV0.InitializeFromProtoUnsafe(st)
+}
@@ -0,0 +1,86 @@
+package testutil
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type snapshotStore struct {
+	blocks      []*ethpb.SignedBeaconBlock
+	states      map[[32]byte]iface.ReadOnlyBeaconState
+	genesisRoot [32]byte
+}
+
+func (s *snapshotStore) SaveBlocks(_ context.Context, blocks []*ethpb.SignedBeaconBlock) error {
+	s.blocks = append(s.blocks, blocks...)
+	return nil
+}
+
+func (s *snapshotStore) SaveState(_ context.Context, st iface.ReadOnlyBeaconState, root [32]byte) error {
+	s.states[root] = st
+	return nil
+}
+
+func (s *snapshotStore) SaveGenesisBlockRoot(_ context.Context, root [32]byte) error {
+	s.genesisRoot = root
+	return nil
+}
+
+func TestChainSnapshot_WriteRead(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	ctx := context.Background()
+
+	numBlocks := uint64(params.BeaconConfig().SlotsPerEpoch * 2)
+	snapshot, err := GenerateChainSnapshot(ctx, 64, numBlocks)
+	require.NoError(t, err)
+	require.Equal(t, int(numBlocks)+1, len(snapshot.Blocks))
+	assert.Equal(t, 2, len(snapshot.States))
+
+	var first, second bytes.Buffer
+	require.NoError(t, snapshot.Write(&first))
+	again, err := GenerateChainSnapshot(ctx, 64, numBlocks)
+	require.NoError(t, err)
+	require.NoError(t, again.Write(&second))
+	assert.DeepEqual(t, first.Bytes(), second.Bytes(), "Snapshots should be deterministic")
+
+	path := filepath.Join(t.TempDir(), "chain.tar.gz")
+	require.NoError(t, os.WriteFile(path, first.Bytes(), 0600))
+	loaded := LoadChainSnapshot(t, path)
+	assert.DeepEqual(t, snapshot.Manifest, loaded.Manifest)
+	assert.DeepSSZEqual(t, snapshot.Genesis.InnerStateUnsafe(), loaded.Genesis.InnerStateUnsafe())
+	for i, blk := range snapshot.Blocks {
+		assert.DeepSSZEqual(t, blk, loaded.Blocks[i])
+	}
+	for slot, st := range snapshot.States {
+		require.NotNil(t, loaded.States[slot])
+		assert.DeepSSZEqual(t, st.InnerStateUnsafe(), loaded.States[slot].InnerStateUnsafe())
+	}
+
+	store := &snapshotStore{states: make(map[[32]byte]iface.ReadOnlyBeaconState)}
+	require.NoError(t, loaded.SaveTo(ctx, store))
+	assert.Equal(t, len(snapshot.Blocks), len(store.blocks))
+	genesisRoot, err := snapshot.Blocks[0].Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, genesisRoot, store.genesisRoot)
+	assert.Equal(t, 3, len(store.states))
+	boundary := snapshot.Blocks[params.BeaconConfig().SlotsPerEpoch]
+	boundaryRoot, err := boundary.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NotNil(t, store.states[boundaryRoot])
+	assert.Equal(t, types.Slot(params.BeaconConfig().SlotsPerEpoch), store.states[boundaryRoot].Slot())
+
+	// Snapshots only load under the config they were built with.
+	params.OverrideBeaconConfig(params.MinimalSpecConfig())
+	_, err = ReadChainSnapshot(bytes.NewReader(first.Bytes()))
+	assert.ErrorContains(t, "snapshot was built with config mainnet of 32 slots per epoch", err)
+}
@@ -246,20 +246,29 @@ func DeterministicEth1Data(size int) (*ethpb.Eth1Data, error) {
 
 // DeterministicGenesisState returns a genesis state made using the deterministic deposits.
 func DeterministicGenesisState(t testing.TB, numValidators uint64) (iface.BeaconState, []bls.SecretKey) {
+	beaconState, privKeys, err := deterministicGenesisState(numValidators)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return beaconState, privKeys
+}
+
+// deterministicGenesisState returns a genesis state made using the deterministic deposits.
+func deterministicGenesisState(numValidators uint64) (iface.BeaconState, []bls.SecretKey, error) {
 	deposits, privKeys, err := DeterministicDepositsAndKeys(numValidators)
 	if err != nil {
-		t.Fatal(errors.Wrapf(err, "failed to get %d deposits", numValidators))
+		return nil, nil, errors.Wrapf(err, "failed to get %d deposits", numValidators)
 	}
 	eth1Data, err := DeterministicEth1Data(len(deposits))
 	if err != nil {
-		t.Fatal(errors.Wrapf(err, "failed to get eth1data for %d deposits", numValidators))
+		return nil, nil, errors.Wrapf(err, "failed to get eth1data for %d deposits", numValidators)
 	}
 	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), eth1Data)
 	if err != nil {
-		t.Fatal(errors.Wrapf(err, "failed to get genesis beacon state of %d validators", numValidators))
+		return nil, nil, errors.Wrapf(err, "failed to get genesis beacon state of %d validators", numValidators)
 	}
 	ResetCache()
-	return beaconState, privKeys
+	return beaconState, privKeys, nil
 }
 
 // DepositTrieFromDeposits takes an array of deposits and returns the deposit trie.
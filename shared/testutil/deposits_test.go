@@ -254,7 +254,7 @@ func TestSetupInitialDeposits_1024Entries_PartialDeposits(t *testing.T) {
 func TestDeterministicGenesisState_100Validators(t *testing.T) {
 	validatorCount := uint64(100)
 	beaconState, privKeys := DeterministicGenesisState(t, validatorCount)
-	activeValidators, err := helpers.ActiveValidatorCount(beaconState, 0)
+	activeValidators, err := helpers.ActiveValidatorCount(nil, beaconState, 0)
 	require.NoError(t, err)
 
 	if len(privKeys) != int(validatorCount) {
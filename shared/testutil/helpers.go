@@ -19,7 +19,7 @@ import (
 // RandaoReveal returns a signature of the requested epoch using the beacon proposer private key.
 func RandaoReveal(beaconState iface.ReadOnlyBeaconState, epoch types.Epoch, privKeys []bls.SecretKey) ([]byte, error) {
 	// We fetch the proposer's index as that is whom the RANDAO will be verified against.
-	proposerIdx, err := helpers.BeaconProposerIndex(beaconState)
+	proposerIdx, err := helpers.BeaconProposerIndex(nil, beaconState)
 	if err != nil {
 		return []byte{}, errors.Wrap(err, "could not get beacon proposer index")
 	}
@@ -38,7 +38,7 @@ func BlockSignature(
 	privKeys []bls.SecretKey,
 ) (bls.Signature, error) {
 	var err error
-	s, err := state.CalculateStateRoot(context.Background(), bState, &ethpb.SignedBeaconBlock{Block: block})
+	s, err := state.CalculateStateRoot(context.Background(), nil, bState, &ethpb.SignedBeaconBlock{Block: block})
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func BlockSignature(
 	if err := bState.SetSlot(block.Slot); err != nil {
 		return nil, err
 	}
-	proposerIdx, err := helpers.BeaconProposerIndex(bState)
+	proposerIdx, err := helpers.BeaconProposerIndex(nil, bState)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	jsoniter "github.com/json-iterator/go"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
@@ -21,7 +22,7 @@ import (
 	"gopkg.in/d4l3k/messagediff.v1"
 )
 
-type blockOperation func(context.Context, iface.BeaconState, *ethpb.SignedBeaconBlock) (iface.BeaconState, error)
+type blockOperation func(context.Context, *cache.CommitteeCache, iface.BeaconState, *ethpb.SignedBeaconBlock) (iface.BeaconState, error)
 type epochOperation func(*testing.T, iface.BeaconState) (iface.BeaconState, error)
 
 var json = jsoniter.Config{
@@ -108,7 +109,7 @@ func RunBlockOperationTest(
 	helpers.ClearCache()
 	b := NewBeaconBlock()
 	b.Block.Body = body
-	beaconState, err := operationFn(context.Background(), preState, b)
+	beaconState, err := operationFn(context.Background(), nil, preState, b)
 	if postSSZExists {
 		require.NoError(t, err)
 
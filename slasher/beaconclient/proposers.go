@@ -0,0 +1,38 @@
+package beaconclient
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"go.opencensus.io/trace"
+)
+
+// IsAssignedProposer checks whether the validator is the proposer of the slot in the proposer list
+// the beacon node precomputed for the epoch of the slot.
+func (s *Service) IsAssignedProposer(ctx context.Context, slot types.Slot, index types.ValidatorIndex) (bool, error) {
+	ctx, span := trace.StartSpan(ctx, "beaconclient.IsAssignedProposer")
+	defer span.End()
+
+	res, err := s.cfg.QueryClient.ListAnnotatedValidatorAssignments(ctx, &pbrpc.AnnotatedValidatorAssignmentsRequest{
+		QueryFilter: &pbrpc.AnnotatedValidatorAssignmentsRequest_Epoch{Epoch: helpers.SlotToEpoch(slot)},
+		Indices:     []types.ValidatorIndex{index},
+		IndexOnly:   true,
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "could not request assignments of validator %d", index)
+	}
+	for _, assignment := range res.GetAssignments().GetAssignments() {
+		if assignment.ValidatorIndex != index {
+			continue
+		}
+		for _, proposerSlot := range assignment.ProposerSlots {
+			if proposerSlot == slot {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
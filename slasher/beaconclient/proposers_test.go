@@ -0,0 +1,58 @@
+package beaconclient
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+// assignmentsQueryClient serves the annotated validator assignments of a beacon query client.
+type assignmentsQueryClient struct {
+	pbrpc.BeaconQueryClient
+	t        *testing.T
+	response *pbrpc.AnnotatedValidatorAssignments
+	calls    int
+}
+
+func (c *assignmentsQueryClient) ListAnnotatedValidatorAssignments(
+	_ context.Context, req *pbrpc.AnnotatedValidatorAssignmentsRequest, _ ...grpc.CallOption,
+) (*pbrpc.AnnotatedValidatorAssignments, error) {
+	c.calls++
+	assert.DeepEqual(c.t, &pbrpc.AnnotatedValidatorAssignmentsRequest{
+		QueryFilter: &pbrpc.AnnotatedValidatorAssignmentsRequest_Epoch{Epoch: 1},
+		Indices:     []types.ValidatorIndex{5},
+		IndexOnly:   true,
+	}, req)
+	return c.response, nil
+}
+
+func TestService_IsAssignedProposer(t *testing.T) {
+	slot := params.BeaconConfig().SlotsPerEpoch + 3
+	client := &assignmentsQueryClient{
+		t: t,
+		response: &pbrpc.AnnotatedValidatorAssignments{
+			Assignments: &ethpb.ValidatorAssignments{
+				Epoch: 1,
+				Assignments: []*ethpb.ValidatorAssignments_CommitteeAssignment{
+					{ValidatorIndex: 5, ProposerSlots: []types.Slot{slot - 1, slot}},
+				},
+			},
+		},
+	}
+	bs := Service{cfg: &Config{QueryClient: client}}
+
+	assigned, err := bs.IsAssignedProposer(context.Background(), slot, 5)
+	require.NoError(t, err)
+	assert.Equal(t, true, assigned)
+	assigned, err = bs.IsAssignedProposer(context.Background(), slot+1, 5)
+	require.NoError(t, err)
+	assert.Equal(t, false, assigned)
+	assert.Equal(t, 2, client.calls)
+}
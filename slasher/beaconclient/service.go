@@ -14,6 +14,7 @@ import (
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"github.com/prysmaticlabs/prysm/slasher/cache"
@@ -63,6 +64,7 @@ type Config struct {
 	ProposerSlashingsFeed *event.Feed
 	AttesterSlashingsFeed *event.Feed
 	BeaconClient          ethpb.BeaconChainClient
+	QueryClient           pbrpc.BeaconQueryClient
 	NodeClient            ethpb.NodeClient
 }
 
@@ -169,6 +171,7 @@ func (s *Service) Start() {
 	log.Info("Successfully started gRPC connection")
 	s.conn = conn
 	s.cfg.BeaconClient = ethpb.NewBeaconChainClient(s.conn)
+	s.cfg.QueryClient = pbrpc.NewBeaconQueryClient(s.conn)
 	s.cfg.NodeClient = ethpb.NewNodeClient(s.conn)
 
 	// We poll for the sync status of the beacon node until it is fully synced.
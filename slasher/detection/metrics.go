@@ -18,4 +18,8 @@ var (
 		Name: "surrounded_votes_detected_total",
 		Help: "The # of surrounded slashable events detected",
 	})
+	proposerEquivocationsDetected = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "proposer_equivocations_detected_total",
+		Help: "The # of double proposals by the assigned proposer of a slot detected",
+	})
 )
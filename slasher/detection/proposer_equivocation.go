@@ -0,0 +1,54 @@
+package detection
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// ProposerChecker checks blocks against the proposer lists precomputed by a beacon node.
+type ProposerChecker interface {
+	IsAssignedProposer(ctx context.Context, slot types.Slot, index types.ValidatorIndex) (bool, error)
+}
+
+// ProposerEquivocation is sent over the proposer equivocation feed when the proposer assigned to a
+// slot signed two different blocks for it, so that the orchestrator can react to the slashing on the
+// paired chain.
+type ProposerEquivocation struct {
+	Slot          types.Slot
+	ProposerIndex types.ValidatorIndex
+	Slashing      *ethpb.ProposerSlashing
+}
+
+// notifyProposerEquivocation sends a proposer equivocation event for the slashing if its proposer is
+// the one assigned to the slot. Double proposals of other validators are invalid blocks, which the
+// paired chain never saw as canonical.
+func (s *Service) notifyProposerEquivocation(ctx context.Context, slashing *ethpb.ProposerSlashing) {
+	ctx, span := trace.StartSpan(ctx, "detection.notifyProposerEquivocation")
+	defer span.End()
+	if s.cfg.ProposerChecker == nil || s.cfg.ProposerEquivocationFeed == nil {
+		return
+	}
+	header := slashing.Header_1.Header
+	assigned, err := s.cfg.ProposerChecker.IsAssignedProposer(ctx, header.Slot, header.ProposerIndex)
+	if err != nil {
+		log.WithError(err).WithField("slot", header.Slot).Error("Could not check the assigned proposer of a proposer slashing")
+		return
+	}
+	if !assigned {
+		return
+	}
+	proposerEquivocationsDetected.Inc()
+	log.WithFields(logrus.Fields{
+		"slot":          header.Slot,
+		"proposerIndex": header.ProposerIndex,
+	}).Warn("Assigned proposer signed two blocks for its slot")
+	s.cfg.ProposerEquivocationFeed.Send(&ProposerEquivocation{
+		Slot:          header.Slot,
+		ProposerIndex: header.ProposerIndex,
+		Slashing:      slashing,
+	})
+}
@@ -0,0 +1,66 @@
+package detection
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+type mockProposerChecker struct {
+	proposers map[types.Slot]types.ValidatorIndex
+}
+
+func (m *mockProposerChecker) IsAssignedProposer(_ context.Context, slot types.Slot, index types.ValidatorIndex) (bool, error) {
+	proposer, ok := m.proposers[slot]
+	return ok && proposer == index, nil
+}
+
+func TestService_NotifyProposerEquivocation(t *testing.T) {
+	feed := new(event.Feed)
+	ch := make(chan *ProposerEquivocation, 1)
+	sub := feed.Subscribe(ch)
+	defer sub.Unsubscribe()
+	ds := Service{
+		cfg: &Config{
+			ProposerSlashingsFeed:    new(event.Feed),
+			ProposerChecker:          &mockProposerChecker{proposers: map[types.Slot]types.ValidatorIndex{3: 7}},
+			ProposerEquivocationFeed: feed,
+		},
+	}
+	slashing := func(slot types.Slot, proposer types.ValidatorIndex) *ethpb.ProposerSlashing {
+		header := func(root byte) *ethpb.SignedBeaconBlockHeader {
+			return &ethpb.SignedBeaconBlockHeader{
+				Header: &ethpb.BeaconBlockHeader{
+					Slot:          slot,
+					ProposerIndex: proposer,
+					BodyRoot:      []byte{root},
+				},
+			}
+		}
+		return &ethpb.ProposerSlashing{Header_1: header(1), Header_2: header(2)}
+	}
+
+	// Double proposals of validators which are not assigned to the slot raise no event.
+	ds.submitProposerSlashing(context.Background(), slashing(3, 8))
+	ds.submitProposerSlashing(context.Background(), slashing(4, 7))
+	select {
+	case ev := <-ch:
+		t.Fatalf("Unexpected proposer equivocation at slot %d", ev.Slot)
+	default:
+	}
+
+	s := slashing(3, 7)
+	ds.submitProposerSlashing(context.Background(), s)
+	select {
+	case ev := <-ch:
+		assert.Equal(t, types.Slot(3), ev.Slot)
+		assert.Equal(t, types.ValidatorIndex(7), ev.ProposerIndex)
+		assert.DeepEqual(t, s, ev.Slashing)
+	default:
+		t.Fatal("Expected a proposer equivocation")
+	}
+}
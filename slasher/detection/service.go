@@ -67,6 +67,10 @@ type Config struct {
 	AttesterSlashingsFeed *event.Feed
 	ProposerSlashingsFeed *event.Feed
 	HistoricalDetection   bool
+	// ProposerChecker and ProposerEquivocationFeed are optional, proposer equivocation events are
+	// only sent if both are set.
+	ProposerChecker          ProposerChecker
+	ProposerEquivocationFeed *event.Feed
 }
 
 // NewService instantiation.
@@ -227,5 +231,6 @@ func (s *Service) submitProposerSlashing(ctx context.Context, slashing *ethpb.Pr
 			"proposerIdxHeader2": slashing.Header_2.Header.ProposerIndex,
 		}).Info("Found a proposer slashing! Submitting to beacon node")
 		s.cfg.ProposerSlashingsFeed.Send(slashing)
+		s.notifyProposerEquivocation(ctx, slashing)
 	}
 }
@@ -38,15 +38,16 @@ import (
 // for eth2. It handles the lifecycle of the entire system and registers
 // services to a service registry.
 type SlasherNode struct {
-	cliCtx                *cli.Context
-	ctx                   context.Context
-	cancel                context.CancelFunc
-	lock                  sync.RWMutex
-	services              *shared.ServiceRegistry
-	proposerSlashingsFeed *event.Feed
-	attesterSlashingsFeed *event.Feed
-	stop                  chan struct{} // Channel to wait for termination notifications.
-	db                    db.Database
+	cliCtx                   *cli.Context
+	ctx                      context.Context
+	cancel                   context.CancelFunc
+	lock                     sync.RWMutex
+	services                 *shared.ServiceRegistry
+	proposerSlashingsFeed    *event.Feed
+	attesterSlashingsFeed    *event.Feed
+	proposerEquivocationFeed *event.Feed
+	stop                     chan struct{} // Channel to wait for termination notifications.
+	db                       db.Database
 }
 
 // New creates a new node instance, sets up configuration options,
@@ -78,13 +79,14 @@ func New(cliCtx *cli.Context) (*SlasherNode, error) {
 
 	ctx, cancel := context.WithCancel(cliCtx.Context)
 	slasher := &SlasherNode{
-		cliCtx:                cliCtx,
-		ctx:                   ctx,
-		cancel:                cancel,
-		proposerSlashingsFeed: new(event.Feed),
-		attesterSlashingsFeed: new(event.Feed),
-		services:              registry,
-		stop:                  make(chan struct{}),
+		cliCtx:                   cliCtx,
+		ctx:                      ctx,
+		cancel:                   cancel,
+		proposerSlashingsFeed:    new(event.Feed),
+		attesterSlashingsFeed:    new(event.Feed),
+		proposerEquivocationFeed: new(event.Feed),
+		services:                 registry,
+		stop:                     make(chan struct{}),
 	}
 
 	if err := slasher.startDB(); err != nil {
@@ -245,13 +247,15 @@ func (n *SlasherNode) registerDetectionService() error {
 		panic(err)
 	}
 	ds := detection.NewService(n.ctx, &detection.Config{
-		Notifier:              bs,
-		SlasherDB:             n.db,
-		BeaconClient:          bs,
-		ChainFetcher:          bs,
-		AttesterSlashingsFeed: n.attesterSlashingsFeed,
-		ProposerSlashingsFeed: n.proposerSlashingsFeed,
-		HistoricalDetection:   n.cliCtx.Bool(flags.EnableHistoricalDetectionFlag.Name),
+		Notifier:                 bs,
+		SlasherDB:                n.db,
+		BeaconClient:             bs,
+		ChainFetcher:             bs,
+		AttesterSlashingsFeed:    n.attesterSlashingsFeed,
+		ProposerSlashingsFeed:    n.proposerSlashingsFeed,
+		HistoricalDetection:      n.cliCtx.Bool(flags.EnableHistoricalDetectionFlag.Name),
+		ProposerChecker:          bs,
+		ProposerEquivocationFeed: n.proposerEquivocationFeed,
 	})
 	return n.services.RegisterService(ds)
 }
@@ -270,13 +274,14 @@ func (n *SlasherNode) registerRPCService() error {
 	cert := n.cliCtx.String(flags.CertFlag.Name)
 	key := n.cliCtx.String(flags.KeyFlag.Name)
 	rpcService := rpc.NewService(n.ctx, &rpc.Config{
-		Host:         host,
-		Port:         port,
-		CertFlag:     cert,
-		KeyFlag:      key,
-		Detector:     detectionService,
-		SlasherDB:    n.db,
-		BeaconClient: bs,
+		Host:                     host,
+		Port:                     port,
+		CertFlag:                 cert,
+		KeyFlag:                  key,
+		Detector:                 detectionService,
+		SlasherDB:                n.db,
+		BeaconClient:             bs,
+		ProposerEquivocationFeed: n.proposerEquivocationFeed,
 	})
 
 	return n.services.RegisterService(rpcService)
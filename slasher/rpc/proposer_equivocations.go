@@ -0,0 +1,40 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/slasher/detection"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ProposerEquivocationStream is the server side of a proposer equivocation stream.
+type ProposerEquivocationStream interface {
+	Context() context.Context
+	Send(*detection.ProposerEquivocation) error
+}
+
+// StreamProposerEquivocations sends every double proposal of the assigned proposer of a slot as it
+// is detected, which the orchestrator consumes to react to slashings on the paired chain.
+func (s *Server) StreamProposerEquivocations(stream ProposerEquivocationStream) error {
+	if s.proposerEquivocationFeed == nil {
+		return status.Error(codes.Unavailable, "Proposer equivocations are not available")
+	}
+	ch := make(chan *detection.ProposerEquivocation, 1)
+	sub := s.proposerEquivocationFeed.Subscribe(ch)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case ev := <-ch:
+			if err := stream.Send(ev); err != nil {
+				return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+			}
+		case <-sub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-s.ctx.Done():
+			return status.Error(codes.Canceled, "RPC context canceled")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
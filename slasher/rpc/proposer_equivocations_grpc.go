@@ -0,0 +1,100 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/slasher/detection"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// ProposerEquivocationServiceName is the name of the gRPC service streaming the proposer
+	// equivocations detected by the slasher. Events are JSON encoded in bytes values.
+	ProposerEquivocationServiceName = "ethereum.slashing.ProposerEquivocations"
+	// StreamProposerEquivocationsMethod is the full gRPC method name of StreamProposerEquivocations.
+	StreamProposerEquivocationsMethod = "/" + ProposerEquivocationServiceName + "/StreamProposerEquivocations"
+)
+
+// proposerEquivocationServer is the handler type of the proposer equivocation gRPC service.
+type proposerEquivocationServer interface {
+	StreamProposerEquivocations(stream ProposerEquivocationStream) error
+}
+
+var proposerEquivocationServiceDesc = grpc.ServiceDesc{
+	ServiceName: ProposerEquivocationServiceName,
+	HandlerType: (*proposerEquivocationServer)(nil),
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamProposerEquivocations",
+			Handler:       streamProposerEquivocationsHandler,
+			ServerStreams: true,
+		},
+	},
+}
+
+// RegisterProposerEquivocationServer serves the proposer equivocation stream of the server over
+// gRPC, so that the orchestrator can follow it through NewProposerEquivocationStream.
+func RegisterProposerEquivocationServer(s *grpc.Server, srv *Server) {
+	s.RegisterService(&proposerEquivocationServiceDesc, srv)
+}
+
+func streamProposerEquivocationsHandler(srv interface{}, stream grpc.ServerStream) error {
+	if err := stream.RecvMsg(&ptypes.BytesValue{}); err != nil {
+		return err
+	}
+	return srv.(proposerEquivocationServer).StreamProposerEquivocations(&grpcProposerEquivocationStream{ServerStream: stream})
+}
+
+// grpcProposerEquivocationStream sends the proposer equivocations of a gRPC stream as JSON encoded
+// bytes values.
+type grpcProposerEquivocationStream struct {
+	grpc.ServerStream
+}
+
+// Send sends a proposer equivocation.
+func (s *grpcProposerEquivocationStream) Send(ev *detection.ProposerEquivocation) error {
+	enc, err := json.Marshal(ev)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not encode proposer equivocation: %v", err)
+	}
+	return s.SendMsg(&ptypes.BytesValue{Value: enc})
+}
+
+// ProposerEquivocationStreamClient is the client side of a proposer equivocation stream.
+type ProposerEquivocationStreamClient struct {
+	stream grpc.ClientStream
+}
+
+// NewProposerEquivocationStream subscribes to the proposer equivocations detected by the slasher
+// at the other end of the connection.
+func NewProposerEquivocationStream(ctx context.Context, conn grpc.ClientConnInterface) (*ProposerEquivocationStreamClient, error) {
+	desc := &grpc.StreamDesc{StreamName: "StreamProposerEquivocations", ServerStreams: true}
+	stream, err := conn.NewStream(ctx, desc, StreamProposerEquivocationsMethod)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.SendMsg(&ptypes.BytesValue{}); err != nil {
+		return nil, err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return &ProposerEquivocationStreamClient{stream: stream}, nil
+}
+
+// Recv receives the next proposer equivocation.
+func (c *ProposerEquivocationStreamClient) Recv() (*detection.ProposerEquivocation, error) {
+	msg := &ptypes.BytesValue{}
+	if err := c.stream.RecvMsg(msg); err != nil {
+		return nil, err
+	}
+	ev := &detection.ProposerEquivocation{}
+	if err := json.Unmarshal(msg.Value, ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
@@ -0,0 +1,52 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/slasher/detection"
+	"google.golang.org/grpc"
+)
+
+func TestProposerEquivocationService_Stream(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	feed := new(event.Feed)
+	grpcServer := grpc.NewServer()
+	RegisterProposerEquivocationServer(grpcServer, &Server{ctx: ctx, proposerEquivocationFeed: feed})
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() {
+		_ = grpcServer.Serve(lis)
+	}()
+	defer grpcServer.Stop()
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure(), grpc.WithBlock())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, conn.Close())
+	}()
+
+	stream, err := NewProposerEquivocationStream(ctx, conn)
+	require.NoError(t, err)
+	ev := &detection.ProposerEquivocation{
+		Slot:          3,
+		ProposerIndex: 7,
+		Slashing: &ethpb.ProposerSlashing{
+			Header_1: &ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{Slot: 3, ProposerIndex: 7}},
+			Header_2: &ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{Slot: 3, ProposerIndex: 7}},
+		},
+	}
+	// The event is sent once the stream subscribed to the feed.
+	for feed.Send(ev) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	got, err := stream.Recv()
+	require.NoError(t, err)
+	assert.DeepEqual(t, ev, got)
+}
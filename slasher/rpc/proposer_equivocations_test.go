@@ -0,0 +1,55 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/slasher/detection"
+)
+
+type equivocationTestStream struct {
+	ctx  context.Context
+	sent chan *detection.ProposerEquivocation
+}
+
+func (s *equivocationTestStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *equivocationTestStream) Send(ev *detection.ProposerEquivocation) error {
+	s.sent <- ev
+	return nil
+}
+
+func TestServer_StreamProposerEquivocations(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	err := (&Server{ctx: ctx}).StreamProposerEquivocations(&equivocationTestStream{ctx: ctx})
+	assert.ErrorContains(t, "Proposer equivocations are not available", err)
+
+	feed := new(event.Feed)
+	s := &Server{ctx: ctx, proposerEquivocationFeed: feed}
+	streamCtx, cancelStream := context.WithCancel(ctx)
+	stream := &equivocationTestStream{ctx: streamCtx, sent: make(chan *detection.ProposerEquivocation, 1)}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- s.StreamProposerEquivocations(stream)
+	}()
+
+	ev := &detection.ProposerEquivocation{Slot: 3, ProposerIndex: 7, Slashing: &ethpb.ProposerSlashing{}}
+	for feed.Send(ev) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	select {
+	case got := <-stream.sent:
+		assert.Equal(t, ev, got)
+	case <-time.After(10 * time.Second):
+		t.Fatal("Timed out waiting for proposer equivocation")
+	}
+	cancelStream()
+	assert.ErrorContains(t, "Context canceled", <-errs)
+}
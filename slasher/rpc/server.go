@@ -11,6 +11,7 @@ import (
 	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
 	"github.com/prysmaticlabs/prysm/shared/attestationutil"
 	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/p2putils"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/slasher/beaconclient"
@@ -25,12 +26,13 @@ import (
 // Server defines a server implementation of the gRPC Slasher service,
 // providing RPC endpoints for retrieving slashing proofs for malicious validators.
 type Server struct {
-	ctx             context.Context
-	detector        *detection.Service
-	slasherDB       db.Database
-	beaconClient    *beaconclient.Service
-	attestationLock sync.Mutex
-	proposeLock     sync.Mutex
+	ctx                      context.Context
+	detector                 *detection.Service
+	slasherDB                db.Database
+	beaconClient             *beaconclient.Service
+	proposerEquivocationFeed *event.Feed
+	attestationLock          sync.Mutex
+	proposeLock              sync.Mutex
 }
 
 // HighestAttestations returns the highest observed attestation source and epoch for a given validator id.
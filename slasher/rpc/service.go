@@ -13,6 +13,7 @@ import (
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"github.com/prysmaticlabs/prysm/slasher/beaconclient"
 	"github.com/prysmaticlabs/prysm/slasher/db"
@@ -36,13 +37,14 @@ type Service struct {
 
 // Config options for the slasher node RPC server.
 type Config struct {
-	Host         string
-	Port         string
-	CertFlag     string
-	KeyFlag      string
-	Detector     *detection.Service
-	SlasherDB    db.Database
-	BeaconClient *beaconclient.Service
+	Host                     string
+	Port                     string
+	CertFlag                 string
+	KeyFlag                  string
+	Detector                 *detection.Service
+	SlasherDB                db.Database
+	BeaconClient             *beaconclient.Service
+	ProposerEquivocationFeed *event.Feed
 }
 
 // NewService instantiates a new RPC service instance that will
@@ -101,12 +103,14 @@ func (s *Service) Start() {
 	s.grpcServer = grpc.NewServer(opts...)
 
 	slasherServer := &Server{
-		ctx:          s.ctx,
-		detector:     s.cfg.Detector,
-		slasherDB:    s.cfg.SlasherDB,
-		beaconClient: s.cfg.BeaconClient,
+		ctx:                      s.ctx,
+		detector:                 s.cfg.Detector,
+		slasherDB:                s.cfg.SlasherDB,
+		beaconClient:             s.cfg.BeaconClient,
+		proposerEquivocationFeed: s.cfg.ProposerEquivocationFeed,
 	}
 	slashpb.RegisterSlasherServer(s.grpcServer, slasherServer)
+	RegisterProposerEquivocationServer(s.grpcServer, slasherServer)
 
 	// Register reflection service on gRPC server.
 	reflection.Register(s.grpcServer)
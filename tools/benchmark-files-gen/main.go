@@ -102,7 +102,7 @@ func generateMarshalledFullStateAndBlock() error {
 	if err != nil {
 		return err
 	}
-	beaconState, err = state.ExecuteStateTransition(context.Background(), beaconState, block)
+	beaconState, err = state.ExecuteStateTransition(context.Background(), nil, beaconState, block)
 	if err != nil {
 		return err
 	}
@@ -126,7 +126,7 @@ func generateMarshalledFullStateAndBlock() error {
 	}
 	block.Block.Body.Attestations = append(atts, block.Block.Body.Attestations...)
 
-	s, err := state.CalculateStateRoot(context.Background(), beaconState, block)
+	s, err := state.CalculateStateRoot(context.Background(), nil, beaconState, block)
 	if err != nil {
 		return errors.Wrap(err, "could not calculate state root")
 	}
@@ -136,7 +136,7 @@ func generateMarshalledFullStateAndBlock() error {
 	if err := beaconState.SetSlot(beaconState.Slot() + 1); err != nil {
 		return err
 	}
-	proposerIdx, err := helpers.BeaconProposerIndex(beaconState)
+	proposerIdx, err := helpers.BeaconProposerIndex(nil, beaconState)
 	if err != nil {
 		return err
 	}
@@ -157,7 +157,7 @@ func generateMarshalledFullStateAndBlock() error {
 	}
 
 	// Running a single state transition to make sure the generated files aren't broken.
-	_, err = state.ExecuteStateTransition(context.Background(), beaconState, block)
+	_, err = state.ExecuteStateTransition(context.Background(), nil, beaconState, block)
 	if err != nil {
 		return err
 	}
@@ -191,7 +191,7 @@ func generate2FullEpochState() error {
 		if err != nil {
 			return err
 		}
-		beaconState, err = state.ExecuteStateTransition(context.Background(), beaconState, block)
+		beaconState, err = state.ExecuteStateTransition(context.Background(), nil, beaconState, block)
 		if err != nil {
 			return err
 		}
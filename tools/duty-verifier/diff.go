@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bytes"
+	"sort"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+// proposerMismatch is a slot for which the two sources disagree on the proposer.
+type proposerMismatch struct {
+	slot  types.Slot
+	left  []types.ValidatorIndex
+	right []types.ValidatorIndex
+}
+
+// attesterMismatch is a validator whose attester duties differ between the two sources.
+type attesterMismatch struct {
+	index  types.ValidatorIndex
+	reason string
+}
+
+// epochReport holds every mismatch found in the assignments of an epoch.
+type epochReport struct {
+	epoch     types.Epoch
+	proposers []proposerMismatch
+	attesters []attesterMismatch
+}
+
+func (r *epochReport) empty() bool {
+	return len(r.proposers) == 0 && len(r.attesters) == 0
+}
+
+// compareAssignments diffs the assignments of an epoch as returned by two sources.
+func compareAssignments(epoch types.Epoch, left, right []*ethpb.ValidatorAssignments_CommitteeAssignment) *epochReport {
+	report := &epochReport{epoch: epoch}
+
+	leftProposers := proposerSchedule(left)
+	rightProposers := proposerSchedule(right)
+	slots := make(map[types.Slot]bool)
+	for slot := range leftProposers {
+		slots[slot] = true
+	}
+	for slot := range rightProposers {
+		slots[slot] = true
+	}
+	for slot := range slots {
+		if !equalIndices(leftProposers[slot], rightProposers[slot]) {
+			report.proposers = append(report.proposers, proposerMismatch{
+				slot:  slot,
+				left:  leftProposers[slot],
+				right: rightProposers[slot],
+			})
+		}
+	}
+	sort.Slice(report.proposers, func(i, j int) bool {
+		return report.proposers[i].slot < report.proposers[j].slot
+	})
+
+	leftByIndex := assignmentsByIndex(left)
+	rightByIndex := assignmentsByIndex(right)
+	for index, l := range leftByIndex {
+		r, ok := rightByIndex[index]
+		if !ok {
+			report.attesters = append(report.attesters, attesterMismatch{index: index, reason: "missing from right source"})
+			continue
+		}
+		if reason := attesterDiff(l, r); reason != "" {
+			report.attesters = append(report.attesters, attesterMismatch{index: index, reason: reason})
+		}
+	}
+	for index := range rightByIndex {
+		if _, ok := leftByIndex[index]; !ok {
+			report.attesters = append(report.attesters, attesterMismatch{index: index, reason: "missing from left source"})
+		}
+	}
+	sort.Slice(report.attesters, func(i, j int) bool {
+		return report.attesters[i].index < report.attesters[j].index
+	})
+
+	return report
+}
+
+// proposerSchedule maps every proposer slot to the validators assigned to it. More than one
+// validator per slot is itself an inconsistency and is kept so that it shows up in the report.
+func proposerSchedule(assignments []*ethpb.ValidatorAssignments_CommitteeAssignment) map[types.Slot][]types.ValidatorIndex {
+	schedule := make(map[types.Slot][]types.ValidatorIndex)
+	for _, a := range assignments {
+		for _, slot := range a.ProposerSlots {
+			schedule[slot] = append(schedule[slot], a.ValidatorIndex)
+		}
+	}
+	for _, indices := range schedule {
+		sort.Slice(indices, func(i, j int) bool {
+			return indices[i] < indices[j]
+		})
+	}
+	return schedule
+}
+
+func assignmentsByIndex(
+	assignments []*ethpb.ValidatorAssignments_CommitteeAssignment,
+) map[types.ValidatorIndex]*ethpb.ValidatorAssignments_CommitteeAssignment {
+	m := make(map[types.ValidatorIndex]*ethpb.ValidatorAssignments_CommitteeAssignment, len(assignments))
+	for _, a := range assignments {
+		m[a.ValidatorIndex] = a
+	}
+	return m
+}
+
+// attesterDiff returns a description of the first difference between the attester duties of
+// two assignments of the same validator, or an empty string if they match.
+func attesterDiff(l, r *ethpb.ValidatorAssignments_CommitteeAssignment) string {
+	switch {
+	case !bytes.Equal(l.PublicKey, r.PublicKey):
+		return "public key"
+	case l.AttesterSlot != r.AttesterSlot:
+		return "attester slot"
+	case l.CommitteeIndex != r.CommitteeIndex:
+		return "committee index"
+	case !equalIndices(l.BeaconCommittees, r.BeaconCommittees):
+		return "beacon committee"
+	}
+	return ""
+}
+
+func equalIndices(a, b []types.ValidatorIndex) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func testAssignments() []*ethpb.ValidatorAssignments_CommitteeAssignment {
+	return []*ethpb.ValidatorAssignments_CommitteeAssignment{
+		{
+			ValidatorIndex:   0,
+			PublicKey:        []byte{'a'},
+			BeaconCommittees: []types.ValidatorIndex{0, 1},
+			AttesterSlot:     32,
+			ProposerSlots:    []types.Slot{33},
+		},
+		{
+			ValidatorIndex:   1,
+			PublicKey:        []byte{'b'},
+			BeaconCommittees: []types.ValidatorIndex{0, 1},
+			AttesterSlot:     32,
+			ProposerSlots:    []types.Slot{32, 34},
+		},
+	}
+}
+
+func TestCompareAssignments_Match(t *testing.T) {
+	report := compareAssignments(1, testAssignments(), testAssignments())
+	assert.Equal(t, true, report.empty())
+}
+
+func TestCompareAssignments_ProposerMismatch(t *testing.T) {
+	right := testAssignments()
+	right[0].ProposerSlots = []types.Slot{34}
+	right[1].ProposerSlots = []types.Slot{32, 33}
+
+	report := compareAssignments(1, testAssignments(), right)
+	require.Equal(t, 2, len(report.proposers))
+	assert.Equal(t, 0, len(report.attesters))
+	assert.Equal(t, types.Slot(33), report.proposers[0].slot)
+	assert.DeepEqual(t, []types.ValidatorIndex{0}, report.proposers[0].left)
+	assert.DeepEqual(t, []types.ValidatorIndex{1}, report.proposers[0].right)
+	assert.Equal(t, types.Slot(34), report.proposers[1].slot)
+	assert.DeepEqual(t, []types.ValidatorIndex{1}, report.proposers[1].left)
+	assert.DeepEqual(t, []types.ValidatorIndex{0}, report.proposers[1].right)
+}
+
+func TestCompareAssignments_AttesterMismatch(t *testing.T) {
+	right := testAssignments()
+	right[1].AttesterSlot = 33
+	right = append(right, &ethpb.ValidatorAssignments_CommitteeAssignment{ValidatorIndex: 2})
+
+	report := compareAssignments(1, testAssignments(), right)
+	assert.Equal(t, 0, len(report.proposers))
+	require.Equal(t, 2, len(report.attesters))
+	assert.Equal(t, types.ValidatorIndex(1), report.attesters[0].index)
+	assert.Equal(t, "attester slot", report.attesters[0].reason)
+	assert.Equal(t, types.ValidatorIndex(2), report.attesters[1].index)
+	assert.Equal(t, "missing from left source", report.attesters[1].reason)
+}
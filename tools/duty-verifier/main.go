@@ -0,0 +1,216 @@
+/**
+ * Duty verifier
+ *
+ * A gRPC client that fetches validator assignments for a range of epochs from two sources and
+ * reports every slot where the proposer lists disagree, as well as validators whose attester
+ * duties differ. A source is either a beacon node gRPC end point or an archived beacon node
+ * database, from which assignments are recomputed locally.
+ *
+ * Example: comparing two beacon nodes at 127.0.0.1:4000 and 127.0.0.1:4001
+ * duty-verifier --endpoint 127.0.0.1:4000 --endpoint 127.0.0.1:4001 --from-epoch 10 --to-epoch 20
+ *
+ * Example: comparing a beacon node against an archived database
+ * duty-verifier --endpoint 127.0.0.1:4000 --datadir /path/to/beaconchaindata --from-epoch 10 --to-epoch 20
+ */
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+var log = logrus.WithField("prefix", "duty_verifier")
+
+type endpoint []string
+
+func (e *endpoint) String() string {
+	return "gRPC endpoints"
+}
+
+func (e *endpoint) Set(value string) error {
+	*e = append(*e, value)
+	return nil
+}
+
+// dutySource returns the validator assignments of an epoch.
+type dutySource interface {
+	Name() string
+	Assignments(ctx context.Context, epoch types.Epoch) ([]*ethpb.ValidatorAssignments_CommitteeAssignment, error)
+}
+
+func main() {
+	var endpts endpoint
+	flag.Var(&endpts, "endpoint", "Specify gRPC end points for beacon node, may be given twice")
+	datadir := flag.String("datadir", "", "Path to an archived beacon node data directory to compare a single end point against")
+	fromEpoch := flag.Uint64("from-epoch", 0, "First epoch to compare")
+	toEpoch := flag.Uint64("to-epoch", 0, "Last epoch to compare (inclusive)")
+	pageSize := flag.Int("page-size", 250, "Page size used when listing assignments over gRPC")
+	flag.Parse()
+
+	if *toEpoch < *fromEpoch {
+		log.Fatalf("to-epoch %d is lower than from-epoch %d", *toEpoch, *fromEpoch)
+	}
+
+	ctx := context.Background()
+	var sources []dutySource
+	for _, endpt := range endpts {
+		conn, err := grpc.Dial(endpt, grpc.WithInsecure())
+		if err != nil {
+			log.Fatalf("fail to dial: %v", err)
+		}
+		sources = append(sources, &rpcSource{
+			endpoint: endpt,
+			client:   ethpb.NewBeaconChainClient(conn),
+			pageSize: int32(*pageSize),
+		})
+	}
+	if *datadir != "" {
+		d, err := db.NewDB(ctx, *datadir, &kv.Config{})
+		if err != nil {
+			log.Fatalf("could not open database: %v", err)
+		}
+		defer func() {
+			if err := d.Close(); err != nil {
+				log.WithError(err).Error("Could not close database")
+			}
+		}()
+		sources = append(sources, &dbSource{datadir: *datadir, stateGen: stategen.New(d)})
+	}
+	if len(sources) != 2 {
+		log.Fatal("Exactly two sources are required: two --endpoint flags, or one --endpoint and --datadir")
+	}
+
+	mismatchedEpochs := 0
+	for epoch := types.Epoch(*fromEpoch); epoch <= types.Epoch(*toEpoch); epoch++ {
+		left, err := sources[0].Assignments(ctx, epoch)
+		if err != nil {
+			log.Fatalf("could not fetch assignments of epoch %d from %s: %v", epoch, sources[0].Name(), err)
+		}
+		right, err := sources[1].Assignments(ctx, epoch)
+		if err != nil {
+			log.Fatalf("could not fetch assignments of epoch %d from %s: %v", epoch, sources[1].Name(), err)
+		}
+		report := compareAssignments(epoch, left, right)
+		if report.empty() {
+			log.WithField("epoch", epoch).Info("Assignments match")
+			continue
+		}
+		mismatchedEpochs++
+		logReport(sources[0].Name(), sources[1].Name(), report)
+	}
+	if mismatchedEpochs > 0 {
+		log.Errorf("Assignments mismatched in %d of %d epochs", mismatchedEpochs, *toEpoch-*fromEpoch+1)
+		os.Exit(1)
+	}
+}
+
+// rpcSource lists the assignments of all active validators from a beacon node.
+type rpcSource struct {
+	endpoint string
+	client   ethpb.BeaconChainClient
+	pageSize int32
+}
+
+func (s *rpcSource) Name() string {
+	return s.endpoint
+}
+
+func (s *rpcSource) Assignments(ctx context.Context, epoch types.Epoch) ([]*ethpb.ValidatorAssignments_CommitteeAssignment, error) {
+	var assignments []*ethpb.ValidatorAssignments_CommitteeAssignment
+	req := &ethpb.ListValidatorAssignmentsRequest{
+		QueryFilter: &ethpb.ListValidatorAssignmentsRequest_Epoch{Epoch: epoch},
+		PageSize:    s.pageSize,
+	}
+	for {
+		res, err := s.client.ListValidatorAssignments(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, res.Assignments...)
+		if res.NextPageToken == "" || len(assignments) >= int(res.TotalSize) {
+			return assignments, nil
+		}
+		req.PageToken = res.NextPageToken
+	}
+}
+
+// dbSource recomputes the assignments of all active validators from an archived database.
+type dbSource struct {
+	datadir  string
+	stateGen *stategen.State
+}
+
+func (s *dbSource) Name() string {
+	return s.datadir
+}
+
+func (s *dbSource) Assignments(ctx context.Context, epoch types.Epoch) ([]*ethpb.ValidatorAssignments_CommitteeAssignment, error) {
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, err
+	}
+	st, err := s.stateGen.StateBySlot(ctx, startSlot)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve state at slot %d: %v", startSlot, err)
+	}
+	committeeAssignments, proposerIndexToSlots, err := helpers.CommitteeAssignments(ctx, nil, st, epoch)
+	if err != nil {
+		return nil, fmt.Errorf("could not compute committee assignments: %v", err)
+	}
+	activeIndices, err := helpers.ActiveValidatorIndices(nil, st, epoch)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve active validator indices: %v", err)
+	}
+	assignments := make([]*ethpb.ValidatorAssignments_CommitteeAssignment, 0, len(activeIndices))
+	for _, index := range activeIndices {
+		pubKey := st.PubkeyAtIndex(index)
+		assignment := &ethpb.ValidatorAssignments_CommitteeAssignment{
+			ValidatorIndex: index,
+			PublicKey:      pubKey[:],
+			ProposerSlots:  proposerIndexToSlots[index],
+		}
+		if comAssignment, ok := committeeAssignments[index]; ok {
+			assignment.BeaconCommittees = comAssignment.Committee
+			assignment.CommitteeIndex = comAssignment.CommitteeIndex
+			assignment.AttesterSlot = comAssignment.AttesterSlot
+		}
+		assignments = append(assignments, assignment)
+	}
+	return assignments, nil
+}
+
+func logReport(leftName, rightName string, r *epochReport) {
+	for _, m := range r.proposers {
+		log.WithFields(logrus.Fields{
+			"epoch":   r.epoch,
+			"slot":    m.slot,
+			leftName:  formatProposers(m.left),
+			rightName: formatProposers(m.right),
+		}).Error("Proposer mismatch")
+	}
+	for _, m := range r.attesters {
+		log.WithFields(logrus.Fields{
+			"epoch":          r.epoch,
+			"validatorIndex": m.index,
+			"reason":         m.reason,
+		}).Error("Attester duty mismatch")
+	}
+}
+
+func formatProposers(indices []types.ValidatorIndex) string {
+	if len(indices) == 0 {
+		return "none"
+	}
+	return fmt.Sprintf("%v", indices)
+}
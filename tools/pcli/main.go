@@ -175,7 +175,7 @@ func main() {
 					blkRoot,
 					preStateRoot,
 				)
-				postState, err := state.ExecuteStateTransition(context.Background(), stateObj, block)
+				postState, err := state.ExecuteStateTransition(context.Background(), nil, stateObj, block)
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+)
+
+// streamedProposerEpochs is the number of epochs before the current one whose streamed proposers are kept.
+const streamedProposerEpochs = 2
+
+// streamedProposers holds the proposers of the epochs received over the epoch info stream of the
+// beacon node, which take precedence over the proposer slots of the polled duties. The epochs it
+// receives drive the duty updates of the validator while the stream is up.
+type streamedProposers struct {
+	lock      sync.RWMutex
+	proposers map[types.Epoch][][48]byte
+	lastEpoch *types.Epoch
+	// healthy is set while the stream delivers epoch infos, and cleared when it fails.
+	healthy bool
+	// refresh holds the epochs whose epoch info was received, new or changed by a reorg, since the
+	// duties were last updated.
+	refresh map[types.Epoch]bool
+}
+
+func newStreamedProposers() *streamedProposers {
+	return &streamedProposers{
+		proposers: make(map[types.Epoch][][48]byte),
+		refresh:   make(map[types.Epoch]bool),
+	}
+}
+
+// update stores the proposers of an epoch info, replacing those of an earlier send of the epoch
+// as sent again after a reorg or once finalized, and prunes the epochs long past. A new epoch or a
+// reorg makes the duties due for an update.
+func (s *streamedProposers) update(info *orchestrator.EpochInfo) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.proposers[info.Epoch]; !ok || info.ReorgFlag {
+		s.refresh[info.Epoch] = true
+	}
+	s.proposers[info.Epoch] = info.Proposers
+	s.healthy = true
+	if s.lastEpoch == nil || info.Epoch > *s.lastEpoch {
+		epoch := info.Epoch
+		s.lastEpoch = &epoch
+	}
+	for epoch := range s.proposers {
+		if epoch+streamedProposerEpochs < *s.lastEpoch {
+			delete(s.proposers, epoch)
+			delete(s.refresh, epoch)
+		}
+	}
+}
+
+// failed records that the stream failed, so that the duties are polled until it delivers again.
+func (s *streamedProposers) failed() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.healthy = false
+}
+
+// isHealthy returns true if the stream delivers epoch infos.
+func (s *streamedProposers) isHealthy() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.healthy
+}
+
+// takeDutyRefresh returns true if an epoch info of the given epoch or the next one was received
+// since the duties were last updated, and clears it. The duties of an epoch received ahead of time
+// are updated then, as the duties of the next epoch come along with those of the current one.
+func (s *streamedProposers) takeDutyRefresh(epoch types.Epoch) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	due := false
+	for e := range s.refresh {
+		if e <= epoch+1 {
+			delete(s.refresh, e)
+			due = true
+		}
+	}
+	return due
+}
+
+// proposerAt returns the proposer of the slot, and false if the proposers of its epoch were not received.
+func (s *streamedProposers) proposerAt(slot types.Slot) ([48]byte, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	proposers, ok := s.proposers[helpers.SlotToEpoch(slot)]
+	idx := uint64(slot % params.BeaconConfig().SlotsPerEpoch)
+	if !ok || idx >= uint64(len(proposers)) {
+		return [48]byte{}, false
+	}
+	return proposers[idx], true
+}
+
+// resumeFrom returns the last received epoch, to resume the stream after on reconnecting.
+func (s *streamedProposers) resumeFrom() *types.Epoch {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.lastEpoch == nil {
+		return nil
+	}
+	epoch := *s.lastEpoch
+	return &epoch
+}
+
+// streamProposers follows the epoch info stream of the beacon node from the current epoch, and
+// reconnects until the context is canceled. The duties are polled every epoch while it is down.
+func (v *validator) streamProposers(ctx context.Context, client pbrpc.BeaconQueryClient) {
+	for {
+		err := v.receiveProposers(ctx, client)
+		if ctx.Err() != nil {
+			return
+		}
+		v.streamedProposers.failed()
+		log.WithError(err).Warn("Epoch info stream of the beacon node closed, reconnecting")
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconnectPeriod):
+		}
+	}
+}
+
+// receiveProposers receives the proposers of a single epoch info stream until it fails.
+func (v *validator) receiveProposers(ctx context.Context, client pbrpc.BeaconQueryClient) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	genesis, err := v.node.GetGenesis(ctx, &ptypes.Empty{})
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis time")
+	}
+	currentEpoch := helpers.SlotToEpoch(slotutil.SlotsSinceGenesis(time.Unix(genesis.GenesisTime.Seconds, 0)))
+	stream, err := orchestrator.NewEpochInfoStream(ctx, client, currentEpoch, v.streamedProposers.resumeFrom())
+	if err != nil {
+		return err
+	}
+	for {
+		info, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		v.streamedProposers.update(info)
+	}
+}
@@ -0,0 +1,153 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/orchestrator"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/client/iface"
+)
+
+func TestStreamedProposers_UpdateAndPrune(t *testing.T) {
+	s := newStreamedProposers()
+	proposers := make([][48]byte, params.BeaconConfig().SlotsPerEpoch)
+	proposers[1] = [48]byte{'a'}
+	s.update(&orchestrator.EpochInfo{Epoch: 0, Proposers: proposers})
+
+	proposer, ok := s.proposerAt(1)
+	require.Equal(t, true, ok)
+	assert.Equal(t, [48]byte{'a'}, proposer)
+	_, ok = s.proposerAt(params.BeaconConfig().SlotsPerEpoch)
+	assert.Equal(t, false, ok)
+
+	// A resend of the epoch, as after a reorg, replaces its proposers.
+	reorged := make([][48]byte, params.BeaconConfig().SlotsPerEpoch)
+	reorged[1] = [48]byte{'b'}
+	s.update(&orchestrator.EpochInfo{Epoch: 0, Proposers: reorged})
+	proposer, ok = s.proposerAt(1)
+	require.Equal(t, true, ok)
+	assert.Equal(t, [48]byte{'b'}, proposer)
+
+	s.update(&orchestrator.EpochInfo{Epoch: 3, Proposers: proposers})
+	_, ok = s.proposerAt(1)
+	assert.Equal(t, false, ok, "Expected epoch 0 to be pruned")
+	require.NotNil(t, s.resumeFrom())
+	assert.Equal(t, types.Epoch(3), *s.resumeFrom())
+}
+
+func TestRolesAt_StreamedProposers(t *testing.T) {
+	v, _, validatorKey, finish := setup(t)
+	defer finish()
+
+	pubKey := validatorKey.PublicKey().Marshal()
+	v.duties = &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{
+			{
+				AttesterSlot:  10,
+				ProposerSlots: []types.Slot{2},
+				PublicKey:     pubKey,
+			},
+		},
+	}
+	proposers := make([][48]byte, params.BeaconConfig().SlotsPerEpoch)
+	proposers[3] = bytesutil.ToBytes48(pubKey)
+	v.streamedProposers = newStreamedProposers()
+	v.streamedProposers.update(&orchestrator.EpochInfo{Epoch: 0, Proposers: proposers})
+
+	// The streamed proposers take precedence over the proposer slots of the duties.
+	roleMap, err := v.RolesAt(context.Background(), 3)
+	require.NoError(t, err)
+	assert.Equal(t, iface.ValidatorRole(iface.RoleProposer), roleMap[bytesutil.ToBytes48(pubKey)][0])
+	roleMap, err = v.RolesAt(context.Background(), 2)
+	require.NoError(t, err)
+	assert.Equal(t, iface.ValidatorRole(iface.RoleUnknown), roleMap[bytesutil.ToBytes48(pubKey)][0])
+
+	// The duties are used for epochs not received over the stream.
+	v.duties.Duties[0].ProposerSlots = []types.Slot{params.BeaconConfig().SlotsPerEpoch + 2}
+	roleMap, err = v.RolesAt(context.Background(), params.BeaconConfig().SlotsPerEpoch+2)
+	require.NoError(t, err)
+	assert.Equal(t, iface.ValidatorRole(iface.RoleProposer), roleMap[bytesutil.ToBytes48(pubKey)][0])
+}
+
+func TestStreamedProposers_DutyRefresh(t *testing.T) {
+	s := newStreamedProposers()
+	assert.Equal(t, false, s.isHealthy())
+	proposers := make([][48]byte, params.BeaconConfig().SlotsPerEpoch)
+	s.update(&orchestrator.EpochInfo{Epoch: 2, Proposers: proposers})
+	assert.Equal(t, true, s.isHealthy())
+
+	// An epoch sent ahead of time is due along with the epoch before it.
+	assert.Equal(t, false, s.takeDutyRefresh(0))
+	assert.Equal(t, true, s.takeDutyRefresh(1))
+	assert.Equal(t, false, s.takeDutyRefresh(2))
+
+	// Sending the epoch again once finalized does not change the duties, but a reorg does.
+	s.update(&orchestrator.EpochInfo{Epoch: 2, Proposers: proposers})
+	assert.Equal(t, false, s.takeDutyRefresh(2))
+	s.update(&orchestrator.EpochInfo{Epoch: 2, Proposers: proposers, ReorgFlag: true})
+	assert.Equal(t, true, s.takeDutyRefresh(2))
+
+	s.failed()
+	assert.Equal(t, false, s.isHealthy())
+}
+
+func TestUpdateDuties_DrivenByEpochInfoStream(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	privKey, err := bls.RandKey()
+	require.NoError(t, err)
+	pubKey := bytesutil.ToBytes48(privKey.PublicKey().Marshal())
+	v := validator{
+		keyManager:        &mockKeymanager{keysMap: map[[48]byte]bls.SecretKey{pubKey: privKey}},
+		validatorClient:   client,
+		streamedProposers: newStreamedProposers(),
+	}
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	dutiesOf := func(epoch types.Epoch) *ethpb.DutiesResponse {
+		current := []*ethpb.DutiesResponse_Duty{{PublicKey: pubKey[:], AttesterSlot: slotsPerEpoch.Mul(uint64(epoch))}}
+		next := []*ethpb.DutiesResponse_Duty{{PublicKey: pubKey[:], AttesterSlot: slotsPerEpoch.Mul(uint64(epoch + 1))}}
+		return &ethpb.DutiesResponse{Duties: current, CurrentEpochDuties: current, NextEpochDuties: next}
+	}
+	polled := make([]types.Epoch, 0)
+	client.EXPECT().GetDuties(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error) {
+			polled = append(polled, req.Epoch)
+			return dutiesOf(req.Epoch), nil
+		}).AnyTimes()
+	client.EXPECT().SubscribeCommitteeSubnets(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	proposers := make([][48]byte, slotsPerEpoch)
+	ctx := context.Background()
+
+	// The duties are polled on startup.
+	v.streamedProposers.update(&orchestrator.EpochInfo{Epoch: 1, Proposers: proposers})
+	require.NoError(t, v.UpdateDuties(ctx, slotsPerEpoch))
+	require.NoError(t, v.UpdateDuties(ctx, slotsPerEpoch+1))
+	assert.DeepEqual(t, []types.Epoch{1}, polled)
+
+	// At the next epoch start, the duties of the next epoch take over without polling.
+	require.NoError(t, v.UpdateDuties(ctx, 2*slotsPerEpoch))
+	assert.DeepEqual(t, []types.Epoch{1}, polled)
+	assert.Equal(t, 2*slotsPerEpoch, v.duties.Duties[0].AttesterSlot)
+
+	// The duties are updated once the stream sends the epoch.
+	v.streamedProposers.update(&orchestrator.EpochInfo{Epoch: 2, Proposers: proposers})
+	require.NoError(t, v.UpdateDuties(ctx, 2*slotsPerEpoch+1))
+	require.NoError(t, v.UpdateDuties(ctx, 2*slotsPerEpoch+2))
+	assert.DeepEqual(t, []types.Epoch{1, 2}, polled)
+
+	// While the stream is down, the duties are polled at every epoch start.
+	v.streamedProposers.failed()
+	require.NoError(t, v.UpdateDuties(ctx, 3*slotsPerEpoch))
+	require.NoError(t, v.UpdateDuties(ctx, 3*slotsPerEpoch+1))
+	assert.DeepEqual(t, []types.Epoch{1, 2, 3}, polled)
+}
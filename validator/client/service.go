@@ -16,6 +16,7 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/grpcutils"
@@ -53,6 +54,7 @@ type ValidatorService struct {
 	emitAccountMetrics    bool
 	logValidatorBalances  bool
 	logDutyCountDown      bool
+	proposersFromStream   bool
 	conn                  *grpc.ClientConn
 	grpcRetryDelay        time.Duration
 	grpcRetries           uint
@@ -79,6 +81,7 @@ type Config struct {
 	LogValidatorBalances       bool
 	EmitAccountMetrics         bool
 	LogDutyCountDown           bool
+	ProposerDutiesFromStream   bool
 	WalletInitializedFeed      *event.Feed
 	GrpcRetriesFlag            uint
 	GrpcRetryDelay             time.Duration
@@ -121,6 +124,7 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		useWeb:                cfg.UseWeb,
 		graffitiStruct:        cfg.GraffitiStruct,
 		logDutyCountDown:      cfg.LogDutyCountDown,
+		proposersFromStream:   cfg.ProposerDutiesFromStream,
 		pandoraService:        cfg.PandoraService,
 	}, nil
 }
@@ -181,7 +185,7 @@ func (v *ValidatorService) Start() {
 		return
 	}
 
-	v.validator = &validator{
+	val := &validator{
 		db:                             v.db,
 		validatorClient:                ethpb.NewBeaconNodeValidatorClient(v.conn),
 		beaconClient:                   ethpb.NewBeaconChainClient(v.conn),
@@ -206,6 +210,11 @@ func (v *ValidatorService) Start() {
 		logDutyCountDown:               v.logDutyCountDown,
 		pandoraService:                 v.pandoraService,
 	}
+	if v.proposersFromStream {
+		val.streamedProposers = newStreamedProposers()
+		go val.streamProposers(v.ctx, pbrpc.NewBeaconQueryClient(v.conn))
+	}
+	v.validator = val
 	go run(v.ctx, v.validator)
 	go v.recheckKeys(v.ctx)
 }
@@ -72,6 +72,7 @@ type validator struct {
 	ticker                             slotutil.Ticker
 	prevBalance                        map[[48]byte]uint64
 	duties                             *ethpb.DutiesResponse
+	dutiesEpoch                        types.Epoch
 	startBalances                      map[[48]byte]uint64
 	attLogs                            map[[32]byte]*attSubmitted
 	node                               ethpb.NodeClient
@@ -86,6 +87,7 @@ type validator struct {
 	graffitiOrderedIndex               uint64
 	eipImportBlacklistedPublicKeys     map[[48]byte]bool
 	pandoraService                     pandora.PandoraService
+	streamedProposers                  *streamedProposers
 }
 
 type validatorStatus struct {
@@ -375,7 +377,15 @@ func (v *validator) SlotDeadline(slot types.Slot) time.Time {
 // list of upcoming assignments needs to be updated. For example, at the
 // beginning of a new epoch.
 func (v *validator) UpdateDuties(ctx context.Context, slot types.Slot) error {
-	if slot%params.BeaconConfig().SlotsPerEpoch != 0 && v.duties != nil {
+	epoch := helpers.SlotToEpoch(slot)
+	if v.streamedProposers != nil && v.duties != nil && v.streamedProposers.isHealthy() {
+		// While the epoch info stream is up, the duties are updated when it sends an epoch rather
+		// than polled at every epoch start. At the epoch start, the duties of the next epoch
+		// received along with the current ones take over.
+		if !v.streamedProposers.takeDutyRefresh(epoch) && (v.dutiesEpoch == epoch || v.advanceDuties(slot)) {
+			return nil
+		}
+	} else if slot%params.BeaconConfig().SlotsPerEpoch != 0 && v.duties != nil {
 		// Do nothing if not epoch start AND assignments already exist.
 		return nil
 	}
@@ -423,6 +433,11 @@ func (v *validator) UpdateDuties(ctx context.Context, slot types.Slot) error {
 	}
 
 	v.duties = resp
+	v.dutiesEpoch = req.Epoch
+	if v.streamedProposers != nil {
+		// The epochs the stream sent so far are covered by the polled duties.
+		v.streamedProposers.takeDutyRefresh(req.Epoch)
+	}
 	v.logDuties(slot, v.duties.CurrentEpochDuties)
 
 	// Non-blocking call for beacon node to start subscriptions for aggregators.
@@ -435,6 +450,22 @@ func (v *validator) UpdateDuties(ctx context.Context, slot types.Slot) error {
 	return nil
 }
 
+// advanceDuties makes the duties of the next epoch received with the current duties the duties of
+// the epoch of the slot, and returns false if they are not the duties of that epoch.
+func (v *validator) advanceDuties(slot types.Slot) bool {
+	epoch := helpers.SlotToEpoch(slot)
+	if v.dutiesEpoch+1 != epoch || len(v.duties.NextEpochDuties) == 0 {
+		return false
+	}
+	v.duties = &ethpb.DutiesResponse{
+		Duties:             v.duties.NextEpochDuties,
+		CurrentEpochDuties: v.duties.NextEpochDuties,
+	}
+	v.dutiesEpoch = epoch
+	v.logDuties(slot, v.duties.CurrentEpochDuties)
+	return true
+}
+
 // subscribeToSubnets iterates through each validator duty, signs each slot, and asks beacon node
 // to eagerly subscribe to subnets so that the aggregator has attestations to aggregate.
 func (v *validator) subscribeToSubnets(ctx context.Context, res *ethpb.DutiesResponse) error {
@@ -512,13 +543,8 @@ func (v *validator) RolesAt(ctx context.Context, slot types.Slot) (map[[48]byte]
 		if duty == nil {
 			continue
 		}
-		if len(duty.ProposerSlots) > 0 {
-			for _, proposerSlot := range duty.ProposerSlots {
-				if proposerSlot != 0 && proposerSlot == slot {
-					roles = append(roles, iface.RoleProposer)
-					break
-				}
-			}
+		if v.isProposer(duty, slot) {
+			roles = append(roles, iface.RoleProposer)
 		}
 		if duty.AttesterSlot == slot {
 			roles = append(roles, iface.RoleAttester)
@@ -543,6 +569,23 @@ func (v *validator) RolesAt(ctx context.Context, slot types.Slot) (map[[48]byte]
 	return rolesAt, nil
 }
 
+// isProposer returns true if the validator of the duty proposes at the slot. Proposers streamed by
+// the beacon node take precedence over the proposer slots of the duties, which are only updated
+// once per epoch.
+func (v *validator) isProposer(duty *ethpb.DutiesResponse_Duty, slot types.Slot) bool {
+	if v.streamedProposers != nil {
+		if proposer, ok := v.streamedProposers.proposerAt(slot); ok {
+			return bytes.Equal(proposer[:], duty.PublicKey)
+		}
+	}
+	for _, proposerSlot := range duty.ProposerSlots {
+		if proposerSlot != 0 && proposerSlot == slot {
+			return true
+		}
+	}
+	return false
+}
+
 // GetKeymanager returns the underlying validator's keymanager.
 func (v *validator) GetKeymanager() keymanager.IKeymanager {
 	return v.keyManager
@@ -434,6 +434,7 @@ func (c *ValidatorClient) registerValidatorService(
 		WalletInitializedFeed:      c.walletInitialized,
 		GraffitiStruct:             gStruct,
 		LogDutyCountDown:           c.cliCtx.Bool(flags.EnableDutyCountDown.Name),
+		ProposerDutiesFromStream:   c.cliCtx.Bool(flags.ProposerDutiesFromStream.Name),
 		PandoraService:             pandoraService,
 	})
 